@@ -24,7 +24,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/graphdb"
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/clearly_defined"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/deps_dev"
 	"github.com/guacsec/guac/pkg/certifier/osv"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -56,6 +58,18 @@ var certifierCmd = &cobra.Command{
 		if err := certify.RegisterCertifier(osv.NewOSVCertificationParser, certifier.CertifierOSV); err != nil {
 			logger.Fatalf("unable to register certifier: %w", err)
 		}
+		// All the components share the same deps.dev certifier, and so
+		// its rate limiter.
+		depsDev := deps_dev.NewDepsDevCertifier(deps_dev.Config{QPS: viper.GetFloat64("deps-dev-qps")})
+		if err := certify.RegisterCertifier(func() certifier.Certifier { return depsDev }, certifier.CertifierDepsDev); err != nil {
+			logger.Fatalf("unable to register certifier: %w", err)
+		}
+
+		// Likewise for the ClearlyDefined certifier and its cache.
+		clearlyDefined := clearly_defined.NewClearlyDefinedCertifier(clearly_defined.Config{CacheTTL: viper.GetDuration("clearly-defined-cache-ttl")})
+		if err := certify.RegisterCertifier(func() certifier.Certifier { return clearlyDefined }, certifier.CertifierClearlyDefined); err != nil {
+			logger.Fatalf("unable to register certifier: %w", err)
+		}
 
 		authToken := graphdb.CreateAuthTokenWithUsernameAndPassword(opts.user, opts.pass, opts.realm)
 		client, err := graphdb.NewGraphClient(opts.dbAddr, authToken)
@@ -156,5 +170,15 @@ func getPackageQuery(client neo4j.Driver) (func() certifier.QueryComponents, err
 }
 
 func init() {
+	certifierCmd.Flags().Float64("deps-dev-qps", deps_dev.DefaultQPS, "maximum number of requests per second sent to deps.dev")
+	if err := viper.BindPFlag("deps-dev-qps", certifierCmd.Flags().Lookup("deps-dev-qps")); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flag: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.Flags().Duration("clearly-defined-cache-ttl", clearly_defined.DefaultCacheTTL, "duration for which the ClearlyDefined definitions are cached")
+	if err := viper.BindPFlag("clearly-defined-cache-ttl", certifierCmd.Flags().Lookup("clearly-defined-cache-ttl")); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flag: %v", err)
+		os.Exit(1)
+	}
 	rootCmd.AddCommand(certifierCmd)
 }
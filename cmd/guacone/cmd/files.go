@@ -52,6 +52,8 @@ type options struct {
 	path string
 	// map of image repo and tags
 	repoTags map[string][]string
+	// URL of the bucket with documents to collect
+	bucketURL string
 }
 
 var exampleCmd = &cobra.Command{
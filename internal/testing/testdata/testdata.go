@@ -39,6 +39,10 @@ var (
 	//go:embed exampledata/alpine-small-spdx.json
 	SpdxExampleAlpine []byte
 
+	// SPDX document with each type of relationship handled by the parser
+	//go:embed exampledata/spdx-relationships.json
+	SpdxExampleRelationships []byte
+
 	// Invalid types for field spdxVersion
 	//go:embed exampledata/invalid-spdx.json
 	SpdxInvalidExample []byte
@@ -218,6 +222,23 @@ var (
 		),
 	}
 
+	hasSLSA = assembler.HasSLSANode{
+		Digest:           "sha256:cf194aa4315da360a262ff73ce63e2ff68a128c3a9ee7d97163c998fd1690cec",
+		SlsaVersion:      "v0.2",
+		BuildType:        "https://github.com/Attestations/GitHubActionsWorkflow@v1",
+		BuilderId:        "https://github.com/Attestations/GitHubHostedActions@v1",
+		MaterialURIs:     []string{"git+https://github.com/curl/curl-docker@master", "github_hosted_vm:ubuntu-18.04:20210123.1"},
+		MaterialDigests:  []string{"sha1:d6525c840a62b398424a78d792f457477135d0cf", "sha1:d6525c840a62b398424a78d792f457477135d0cf"},
+		ByproductURIs:    []string{},
+		ByproductDigests: []string{},
+		NodeData: *assembler.NewObjectMetadata(
+			processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		),
+	}
+
 	EcdsaPubKey, pemBytes, _ = keyutil.GetECDSAPubKey()
 	keyHash, _               = dsse.SHA256KeyID(EcdsaPubKey)
 
@@ -238,7 +259,7 @@ var (
 	DsseNodes = []assembler.GuacNode{Ident}
 	DsseEdges = []assembler.GuacEdge{}
 
-	SlsaNodes = []assembler.GuacNode{art, att, mat1, mat2, build}
+	SlsaNodes = []assembler.GuacNode{art, att, mat1, mat2, build, hasSLSA}
 	SlsaEdges = []assembler.GuacEdge{
 		assembler.IdentityForEdge{
 			IdentityNode:    Ident,
@@ -260,6 +281,14 @@ var (
 			ArtifactNode:       art,
 			ArtifactDependency: mat2,
 		},
+		assembler.HasSLSASubjectEdge{
+			HasSLSANode:  hasSLSA,
+			ArtifactNode: art,
+		},
+		assembler.HasSLSABuiltByEdge{
+			HasSLSANode: hasSLSA,
+			BuilderNode: build,
+		},
 	}
 
 	// SPDX Testdata
@@ -424,6 +453,117 @@ var (
 		},
 	}
 
+	// SPDX relationships Testdata
+
+	relationshipsSource = processor.SourceInformation{
+		Collector: "TestCollector",
+		Source:    "TestSource",
+	}
+	relationshipsAppPack = assembler.PackageNode{
+		Name:     "app",
+		Purl:     "pkg:npm/app@1.0.0",
+		Version:  "1.0.0",
+		NodeData: *assembler.NewObjectMetadata(relationshipsSource),
+	}
+	relationshipsLibPack = assembler.PackageNode{
+		Name:     "lib",
+		Purl:     "pkg:npm/lib@2.0.0",
+		Version:  "2.0.0",
+		NodeData: *assembler.NewObjectMetadata(relationshipsSource),
+	}
+	relationshipsBundledPack = assembler.PackageNode{
+		Name:     "bundled",
+		Purl:     "pkg:npm/bundled@0.1.0",
+		Version:  "0.1.0",
+		NodeData: *assembler.NewObjectMetadata(relationshipsSource),
+	}
+	relationshipsAppFile = assembler.ArtifactNode{
+		Name:     "/usr/bin/app",
+		Digest:   "sha256:f4ba4f1d3a5d5e9e3dc4a5a2b7d1c38c245b4a3a2b24d1ab376ff515b4a8d2e1",
+		Tags:     []string{"BINARY"},
+		NodeData: *assembler.NewObjectMetadata(relationshipsSource),
+	}
+	relationshipsReadmeFile = assembler.ArtifactNode{
+		Name:     "/usr/share/doc/app/README",
+		Digest:   "sha256:0c5b4d0d6bd5d05d0e54fe6cb1cfbb9f4c0e1a6e8ac0d15c19ee3dd6c3cb2a98",
+		Tags:     []string{"DOCUMENTATION"},
+		NodeData: *assembler.NewObjectMetadata(relationshipsSource),
+	}
+	relationshipsVariantMetadata = assembler.MetadataNode{
+		MetadataType: "spdx_relationship",
+		ID:           "https://example.com/spdx/example-app-1.0.0#Package-bundled:VARIANT_OF:Package-lib",
+		Details: map[string]interface{}{
+			"relationship":         "VARIANT_OF",
+			"spdx_element_id":      "SPDXRef-Package-bundled",
+			"related_spdx_element": "SPDXRef-Package-lib",
+		},
+	}
+	relationshipsDocumentationMetadata = assembler.MetadataNode{
+		MetadataType: "spdx_relationship",
+		ID:           "https://example.com/spdx/example-app-1.0.0#File-readme:DOCUMENTATION_OF:Package-app",
+		Details: map[string]interface{}{
+			"relationship":         "DOCUMENTATION_OF",
+			"spdx_element_id":      "SPDXRef-File-readme",
+			"related_spdx_element": "SPDXRef-Package-app",
+		},
+	}
+
+	// The document describes the app package, which is the top level
+	// package instead of one named after the document.
+	SpdxRelationshipsNodes = []assembler.GuacNode{
+		relationshipsAppPack, relationshipsLibPack, relationshipsBundledPack,
+		relationshipsAppFile, relationshipsReadmeFile,
+		relationshipsVariantMetadata, relationshipsDocumentationMetadata,
+	}
+	SpdxRelationshipsEdges = []assembler.GuacEdge{
+		// top level edges
+		assembler.DependsOnEdge{
+			PackageNode:       relationshipsAppPack,
+			PackageDependency: relationshipsLibPack,
+		},
+		assembler.DependsOnEdge{
+			PackageNode:       relationshipsAppPack,
+			PackageDependency: relationshipsBundledPack,
+		},
+		assembler.DependsOnEdge{
+			PackageNode:        relationshipsAppPack,
+			ArtifactDependency: relationshipsAppFile,
+		},
+		assembler.DependsOnEdge{
+			PackageNode:        relationshipsAppPack,
+			ArtifactDependency: relationshipsReadmeFile,
+		},
+		// DEPENDS_ON
+		assembler.DependsOnEdge{
+			PackageNode:       relationshipsAppPack,
+			PackageDependency: relationshipsLibPack,
+		},
+		// CONTAINS of a package
+		assembler.DependsOnEdge{
+			PackageNode:       relationshipsAppPack,
+			PackageDependency: relationshipsBundledPack,
+		},
+		// CONTAINS of a file
+		assembler.ContainsEdge{
+			PackageNode:       relationshipsAppPack,
+			ContainedArtifact: relationshipsReadmeFile,
+		},
+		// GENERATED_FROM
+		assembler.IsOccurrenceEdge{
+			PackageNode:  relationshipsAppPack,
+			ArtifactNode: relationshipsAppFile,
+		},
+		// other relationships
+		assembler.MetadataForEdge{
+			MetadataNode: relationshipsVariantMetadata,
+			ForPackage:   relationshipsBundledPack,
+		},
+		assembler.MetadataForEdge{
+			MetadataNode: relationshipsDocumentationMetadata,
+			ForArtifact:  relationshipsReadmeFile,
+		},
+	}
+
 	// CycloneDX Testdata
 
 	cdxTopLevelPack = assembler.PackageNode{
@@ -545,8 +685,54 @@ var (
 		),
 	}
 
-	CycloneDXQuarkusNodes = []assembler.GuacNode{cdxTopQuarkusPack, cdxResteasyPack, cdxReactiveCommonPack}
-	CyloneDXQuarkusEdges  = []assembler.GuacEdge{
+	cdxResteasyMD5Artifact = assembler.ArtifactNode{
+		Name:   "pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar",
+		Digest: "md5:bf39044af8c6ba66fc3beb034bc82ae8",
+		NodeData: *assembler.NewObjectMetadata(
+			processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		),
+	}
+
+	cdxResteasySHA3Artifact = assembler.ArtifactNode{
+		Name:   "pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar",
+		Digest: "sha3-512:615e56bdfeb591af8b5fdeadf019f8fa729643232d7e0768674411a7d959bb00e12e114280a6949f871514e1a86e01e0033372a0a826d15720050d7cffb80e69",
+		NodeData: *assembler.NewObjectMetadata(
+			processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		),
+	}
+
+	cdxReactiveCommonArtifact = assembler.ArtifactNode{
+		Name:   "pkg:maven/io.quarkus/quarkus-resteasy-reactive-common@2.13.4.Final?type=jar",
+		Digest: "sha3-512:54ffa51cb2fb25e70871e4b69489814ebb3d23d4f958e83ef1f811c00a8753c6c30c5bbc1b48b6427357eb70e5c35c7b357f5252e246fbfa00b90ee22ad095e1",
+		NodeData: *assembler.NewObjectMetadata(
+			processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		),
+	}
+
+	CycloneDXQuarkusNodes = []assembler.GuacNode{cdxTopQuarkusPack, cdxResteasyPack, cdxReactiveCommonPack,
+		cdxResteasyMD5Artifact, cdxResteasySHA3Artifact, cdxReactiveCommonArtifact}
+	CyloneDXQuarkusEdges = []assembler.GuacEdge{
+		assembler.IsOccurrenceEdge{
+			PackageNode:  cdxResteasyPack,
+			ArtifactNode: cdxResteasyMD5Artifact,
+		},
+		assembler.IsOccurrenceEdge{
+			PackageNode:  cdxResteasyPack,
+			ArtifactNode: cdxResteasySHA3Artifact,
+		},
+		assembler.IsOccurrenceEdge{
+			PackageNode:  cdxReactiveCommonPack,
+			ArtifactNode: cdxReactiveCommonArtifact,
+		},
 		assembler.DependsOnEdge{
 			PackageDependency: cdxResteasyPack,
 			PackageNode:       cdxTopQuarkusPack,
@@ -620,7 +806,8 @@ var (
 			  "uri":"osv.dev",
 			  "version":"0.0.14",
 			  "db":{
-				 
+				 "uri":"https://osv.dev",
+				 "version":"v1"
 			  },
 			  "result":[
 				 {
@@ -651,7 +838,8 @@ var (
 			  "uri":"osv.dev",
 			  "version":"0.0.14",
 			  "db":{
-				 
+				 "uri":"https://osv.dev",
+				 "version":"v1"
 			  },
 			  "result":[
 				 {
@@ -682,7 +870,8 @@ var (
 			  "uri":"osv.dev",
 			  "version":"0.0.14",
 			  "db":{
-				 
+				 "uri":"https://osv.dev",
+				 "version":"v1"
 			  },
 			  "result":[
 				 {
@@ -731,7 +920,8 @@ var (
 			  "uri":"osv.dev",
 			  "version":"0.0.14",
 			  "db":{
-				 
+				 "uri":"https://osv.dev",
+				 "version":"v1"
 			  },
 			  "result":[
 				 {
@@ -850,6 +1040,20 @@ func GuacNodeSliceEqual(slice1, slice2 []assembler.GuacNode) bool {
 						break
 					}
 				}
+			} else if node1.Type() == "Metadata" && node2.Type() == "Metadata" {
+				if node1.(assembler.MetadataNode).ID == node2.(assembler.MetadataNode).ID {
+					if reflect.DeepEqual(node1, node2) {
+						e = true
+						break
+					}
+				}
+			} else if node1.Type() == "HasSLSA" && node2.Type() == "HasSLSA" {
+				if node1.(assembler.HasSLSANode).Digest == node2.(assembler.HasSLSANode).Digest {
+					if reflect.DeepEqual(node1, node2) {
+						e = true
+						break
+					}
+				}
 			}
 		}
 		if !e {
@@ -874,6 +1078,11 @@ func GuacEdgeSliceEqual(slice1, slice2 []assembler.GuacEdge) bool {
 					e = true
 					break
 				}
+			} else if edge1.Type() == "IsOccurrence" && edge2.Type() == "IsOccurrence" {
+				if reflect.DeepEqual(edge1, edge2) {
+					e = true
+					break
+				}
 			} else if edge1.Type() == "Contains" && edge2.Type() == "Contains" {
 				if reflect.DeepEqual(edge1, edge2) {
 					e = true
@@ -899,6 +1108,21 @@ func GuacEdgeSliceEqual(slice1, slice2 []assembler.GuacEdge) bool {
 					e = true
 					break
 				}
+			} else if edge1.Type() == "SLSASubject" && edge2.Type() == "SLSASubject" {
+				if reflect.DeepEqual(edge1, edge2) {
+					e = true
+					break
+				}
+			} else if edge1.Type() == "MetadataFor" && edge2.Type() == "MetadataFor" {
+				if reflect.DeepEqual(edge1, edge2) {
+					e = true
+					break
+				}
+			} else if edge1.Type() == "SLSABuiltBy" && edge2.Type() == "SLSABuiltBy" {
+				if reflect.DeepEqual(edge1, edge2) {
+					e = true
+					break
+				}
 			}
 		}
 		if !e {
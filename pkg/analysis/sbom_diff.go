@@ -0,0 +1,327 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analysis implements analyses of the GUAC trees stored by a
+// backend.
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Dependency is a package reachable from the subject of an SBOM through the
+// IsDependency edges.
+type Dependency struct {
+	Type      string
+	Namespace string
+	Name      string
+	// Version is the version range of the IsDependency edge reaching the
+	// package, which SBOMs set to the version of the dependency.
+	Version string
+	// DependencyType is the type of the edge from the subject, or INDIRECT if
+	// the package is only reachable through other dependencies.
+	DependencyType model.DependencyType
+}
+
+func (d Dependency) String() string {
+	s := d.Type + "/"
+	if d.Namespace != "" {
+		s += d.Namespace + "/"
+	}
+	return s + d.Name + "@" + d.Version
+}
+
+// Change is a dependency of both SBOMs whose version or dependency type
+// differs between them.
+type Change struct {
+	Before Dependency
+	After  Dependency
+}
+
+// SBOMDiff is the difference between the dependencies of two SBOMs. All the
+// lists are sorted by package, then version.
+type SBOMDiff struct {
+	Added   []Dependency
+	Removed []Dependency
+	Changed []Change
+}
+
+// DiffSBOMs returns the difference between the dependencies of the SBOMs
+// matching before and the ones of the SBOMs matching after. See Dependencies
+// and Diff.
+func DiffSBOMs(ctx context.Context, b backends.Backend, before, after *model.HasSBOMSpec) (*SBOMDiff, error) {
+	beforeDeps, err := Dependencies(ctx, b, before)
+	if err != nil {
+		return nil, err
+	}
+	afterDeps, err := Dependencies(ctx, b, after)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(beforeDeps, afterDeps), nil
+}
+
+// Dependencies returns the packages reachable through the IsDependency edges
+// from the subjects of the SBOMs matching hasSBOMSpec, sorted by package,
+// then version. The subjects which are artifacts are resolved to the
+// packages occurring as them.
+//
+// The edges from a dependency are the ones of the package version equal to
+// the version range of the edge reaching it. A range which is not a single
+// version does not match any package version, so the walk stops there.
+func Dependencies(ctx context.Context, b backends.Backend, hasSBOMSpec *model.HasSBOMSpec) ([]Dependency, error) {
+	sboms, err := b.HasSBOM(ctx, hasSBOMSpec)
+	if err != nil {
+		return nil, err
+	}
+	if len(sboms) == 0 {
+		return nil, fmt.Errorf("%w: no SBOM matches the spec", backends.ErrNotFound)
+	}
+
+	var subjects []*model.PkgSpec
+	for _, sbom := range sboms {
+		switch subject := sbom.Subject.(type) {
+		case *model.Package:
+			subjects = append(subjects, versionSpec(subject))
+		case *model.Artifact:
+			occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{
+				Artifact: &model.ArtifactSpec{Algorithm: &subject.Algorithm, Digest: &subject.Digest},
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range occurrences {
+				if p, ok := o.Subject.(*model.Package); ok {
+					subjects = append(subjects, versionSpec(p))
+				}
+			}
+		}
+	}
+
+	w := &walker{deps: map[Dependency]reached{}}
+	if err := w.walk(ctx, b, subjects); err != nil {
+		return nil, err
+	}
+	deps := make([]Dependency, 0, len(w.deps))
+	for d, r := range w.deps {
+		d.DependencyType = r.dependencyType
+		deps = append(deps, d)
+	}
+	sortDependencies(deps)
+	return deps, nil
+}
+
+// walker walks the IsDependency edges breadth first, so that the packages
+// are first reached through the shortest path.
+type walker struct {
+	// deps maps the dependencies, without their type, to how they have been
+	// reached.
+	deps map[Dependency]reached
+}
+
+// reached is the type of a dependency, from the shallowest edges reaching
+// it.
+type reached struct {
+	depth          int
+	dependencyType model.DependencyType
+}
+
+func (w *walker) walk(ctx context.Context, b backends.Backend, subjects []*model.PkgSpec) error {
+	pkgs := subjects
+	for depth := 0; len(pkgs) > 0; depth++ {
+		var next []*model.PkgSpec
+		for _, pkg := range pkgs {
+			edges, err := b.IsDependency(ctx, &model.IsDependencySpec{Package: pkg})
+			if err != nil {
+				return err
+			}
+			for _, e := range edges {
+				d := nameDependency(e.DependentPackage)
+				d.Version = e.VersionRange
+				t := model.DependencyTypeIndirect
+				if depth == 0 {
+					t = e.DependencyType
+				}
+				existing, seen := w.deps[d]
+				if !seen || (existing.depth == depth && rank(t) < rank(existing.dependencyType)) {
+					w.deps[d] = reached{depth: depth, dependencyType: t}
+				}
+				if !seen {
+					version := d.Version
+					next = append(next, &model.PkgSpec{Type: &d.Type, Namespace: &d.Namespace, Name: &d.Name, Version: &version})
+				}
+			}
+		}
+		pkgs = next
+	}
+	return nil
+}
+
+// rank orders the dependency types from the most to the least specific, to
+// report a dependency reached by both direct and indirect edges of the same
+// depth as direct.
+func rank(t model.DependencyType) int {
+	switch t {
+	case model.DependencyTypeDirect:
+		return 0
+	case model.DependencyTypeIndirect:
+		return 1
+	}
+	return 2
+}
+
+// versionSpec returns the spec matching the version of the path from the
+// root of the package trie to a single version.
+func versionSpec(p *model.Package) *model.PkgSpec {
+	spec := &model.PkgSpec{Type: &p.Type}
+	if len(p.Namespaces) == 0 {
+		return spec
+	}
+	ns := p.Namespaces[0]
+	spec.Namespace = &ns.Namespace
+	if len(ns.Names) == 0 {
+		return spec
+	}
+	n := ns.Names[0]
+	spec.Name = &n.Name
+	if len(n.Versions) == 0 {
+		return spec
+	}
+	v := n.Versions[0]
+	spec.Version = &v.Version
+	spec.Subpath = &v.Subpath
+	return spec
+}
+
+// nameDependency returns the dependency on the package name at the end of
+// the path from the root of the package trie.
+func nameDependency(p *model.Package) Dependency {
+	d := Dependency{Type: p.Type}
+	if len(p.Namespaces) > 0 {
+		d.Namespace = p.Namespaces[0].Namespace
+		if len(p.Namespaces[0].Names) > 0 {
+			d.Name = p.Namespaces[0].Names[0].Name
+		}
+	}
+	return d
+}
+
+// packageKey identifies a package regardless of its version.
+type packageKey struct {
+	pkgType, namespace, name string
+}
+
+func (d Dependency) key() packageKey {
+	return packageKey{pkgType: d.Type, namespace: d.Namespace, name: d.Name}
+}
+
+func (k packageKey) less(o packageKey) bool {
+	if k.pkgType != o.pkgType {
+		return k.pkgType < o.pkgType
+	}
+	if k.namespace != o.namespace {
+		return k.namespace < o.namespace
+	}
+	return k.name < o.name
+}
+
+func lessDependency(a, b Dependency) bool {
+	if a.key() != b.key() {
+		return a.key().less(b.key())
+	}
+	return a.Version < b.Version
+}
+
+func sortDependencies(deps []Dependency) {
+	sort.Slice(deps, func(i, j int) bool { return lessDependency(deps[i], deps[j]) })
+}
+
+// Diff returns the difference between two lists of dependencies, ignoring
+// their order. The dependencies are matched by package:
+//   - the versions of a package present in both lists are changed if their
+//     dependency type differs, e.g. moving from direct to indirect;
+//   - the remaining versions of a package are paired in order as version
+//     changes;
+//   - the versions left unpaired are added or removed.
+func Diff(before, after []Dependency) *SBOMDiff {
+	beforeByKey := groupByPackage(before)
+	afterByKey := groupByPackage(after)
+	diff := &SBOMDiff{Added: []Dependency{}, Removed: []Dependency{}, Changed: []Change{}}
+	for key, beforeDeps := range beforeByKey {
+		afterDeps := afterByKey[key]
+		afterVersions := map[string]Dependency{}
+		for _, d := range afterDeps {
+			afterVersions[d.Version] = d
+		}
+		var removed, added []Dependency
+		for _, d := range beforeDeps {
+			a, ok := afterVersions[d.Version]
+			if !ok {
+				removed = append(removed, d)
+				continue
+			}
+			if a.DependencyType != d.DependencyType {
+				diff.Changed = append(diff.Changed, Change{Before: d, After: a})
+			}
+		}
+		beforeVersions := map[string]bool{}
+		for _, d := range beforeDeps {
+			beforeVersions[d.Version] = true
+		}
+		for _, d := range afterDeps {
+			if !beforeVersions[d.Version] {
+				added = append(added, d)
+			}
+		}
+		for len(removed) > 0 && len(added) > 0 {
+			diff.Changed = append(diff.Changed, Change{Before: removed[0], After: added[0]})
+			removed, added = removed[1:], added[1:]
+		}
+		diff.Removed = append(diff.Removed, removed...)
+		diff.Added = append(diff.Added, added...)
+	}
+	for key, afterDeps := range afterByKey {
+		if _, ok := beforeByKey[key]; !ok {
+			diff.Added = append(diff.Added, afterDeps...)
+		}
+	}
+	sortDependencies(diff.Added)
+	sortDependencies(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		return lessDependency(diff.Changed[i].Before, diff.Changed[j].Before)
+	})
+	return diff
+}
+
+// groupByPackage groups the dependencies by package, sorting the versions of
+// each package and dropping the duplicates.
+func groupByPackage(deps []Dependency) map[packageKey][]Dependency {
+	sorted := append([]Dependency(nil), deps...)
+	sortDependencies(sorted)
+	groups := map[packageKey][]Dependency{}
+	for _, d := range sorted {
+		group := groups[d.key()]
+		if len(group) > 0 && group[len(group)-1].Version == d.Version {
+			continue
+		}
+		groups[d.key()] = append(group, d)
+	}
+	return groups
+}
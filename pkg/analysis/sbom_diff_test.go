@@ -0,0 +1,226 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func npm(name, version string) *model.PkgInputSpec {
+	return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom(version)}
+}
+
+func dep(name, version string, t model.DependencyType) Dependency {
+	return Dependency{Type: "npm", Name: name, Version: version, DependencyType: t}
+}
+
+// testGraph ingests the dependencies of the packages, from pkg in the
+// nested map to the name and version of the dependency.
+type testGraph map[*model.PkgInputSpec]map[string]string
+
+func newTestBackend(t *testing.T) backends.Backend {
+	t.Helper()
+	b, err := inmem.New(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return b
+}
+
+func ingestDependency(t *testing.T, b backends.Backend, pkg *model.PkgInputSpec, name, version string, depType model.DependencyType) {
+	t.Helper()
+	_, err := b.IngestIsDependency(context.Background(), pkg, npm(name, version), &model.IsDependencyInputSpec{
+		VersionRange:   version,
+		DependencyType: depType,
+		Justification:  "SBOM",
+		Origin:         "test",
+		Collector:      "test",
+	})
+	if err != nil {
+		t.Fatalf("IngestIsDependency() error = %v", err)
+	}
+}
+
+func ingestSBOM(t *testing.T, b backends.Backend, subject *model.PackageOrArtifactInput, uri string) {
+	t.Helper()
+	_, err := b.IngestHasSbom(context.Background(), subject, &model.HasSBOMInputSpec{
+		URI:              uri,
+		Algorithm:        "sha256",
+		Digest:           "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+		DownloadLocation: uri,
+		Origin:           "test",
+		Collector:        "test",
+	})
+	if err != nil {
+		t.Fatalf("IngestHasSbom() error = %v", err)
+	}
+}
+
+func TestDiffSBOMs(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	// The first release of app depends on a, b, d and e, and indirectly on
+	// c through a.
+	app1 := npm("app", "1.0.0")
+	ingestSBOM(t, b, &model.PackageOrArtifactInput{Package: app1}, "https://example.com/app-1.0.0.spdx.json")
+	ingestDependency(t, b, app1, "a", "1.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, app1, "b", "2.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, app1, "d", "4.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, app1, "e", "5.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, npm("a", "1.0.0"), "c", "3.0.0", model.DependencyTypeDirect)
+
+	// The second release, whose SBOM is about the artifact of the package,
+	// bumps a, which now depends on e, removes d and adds f.
+	app2 := npm("app", "1.1.0")
+	artifact := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "0123456789abcdef"}
+	if _, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: app2}, artifact, &model.IsOccurrenceInputSpec{
+		Justification: "built", Origin: "test", Collector: "test",
+	}); err != nil {
+		t.Fatalf("IngestIsOccurrence() error = %v", err)
+	}
+	ingestSBOM(t, b, &model.PackageOrArtifactInput{Artifact: artifact}, "https://example.com/app-1.1.0.spdx.json")
+	ingestDependency(t, b, app2, "a", "1.1.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, app2, "b", "2.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, app2, "f", "6.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, npm("a", "1.1.0"), "c", "3.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, npm("a", "1.1.0"), "e", "5.0.0", model.DependencyTypeDirect)
+
+	before := &model.HasSBOMSpec{URI: ptrfrom("https://example.com/app-1.0.0.spdx.json")}
+	after := &model.HasSBOMSpec{URI: ptrfrom("https://example.com/app-1.1.0.spdx.json")}
+	deps, err := Dependencies(ctx, b, before)
+	if err != nil {
+		t.Fatalf("Dependencies() error = %v", err)
+	}
+	wantDeps := []Dependency{
+		dep("a", "1.0.0", model.DependencyTypeDirect),
+		dep("b", "2.0.0", model.DependencyTypeDirect),
+		dep("c", "3.0.0", model.DependencyTypeIndirect),
+		dep("d", "4.0.0", model.DependencyTypeDirect),
+		dep("e", "5.0.0", model.DependencyTypeDirect),
+	}
+	if diff := cmp.Diff(wantDeps, deps); diff != "" {
+		t.Errorf("Dependencies() mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err := DiffSBOMs(ctx, b, before, after)
+	if err != nil {
+		t.Fatalf("DiffSBOMs() error = %v", err)
+	}
+	want := &SBOMDiff{
+		Added:   []Dependency{dep("f", "6.0.0", model.DependencyTypeDirect)},
+		Removed: []Dependency{dep("d", "4.0.0", model.DependencyTypeDirect)},
+		Changed: []Change{{
+			Before: dep("a", "1.0.0", model.DependencyTypeDirect),
+			After:  dep("a", "1.1.0", model.DependencyTypeDirect),
+		}, {
+			Before: dep("e", "5.0.0", model.DependencyTypeDirect),
+			After:  dep("e", "5.0.0", model.DependencyTypeIndirect),
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DiffSBOMs() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Diffing an SBOM with itself finds no change.
+	got, err = DiffSBOMs(ctx, b, after, after)
+	if err != nil {
+		t.Fatalf("DiffSBOMs() error = %v", err)
+	}
+	if diff := cmp.Diff(&SBOMDiff{Added: []Dependency{}, Removed: []Dependency{}, Changed: []Change{}}, got); diff != "" {
+		t.Errorf("DiffSBOMs() of the same SBOM mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := DiffSBOMs(ctx, b, before, &model.HasSBOMSpec{URI: ptrfrom("https://example.com/unknown")}); !errors.Is(err, backends.ErrNotFound) {
+		t.Errorf("DiffSBOMs() with an unknown SBOM error = %v, want %v", err, backends.ErrNotFound)
+	}
+}
+
+func TestDependenciesCycle(t *testing.T) {
+	b := newTestBackend(t)
+	app := npm("app", "1.0.0")
+	ingestSBOM(t, b, &model.PackageOrArtifactInput{Package: app}, "https://example.com/app.spdx.json")
+	ingestDependency(t, b, app, "x", "1.0.0", model.DependencyTypeUnknown)
+	ingestDependency(t, b, npm("x", "1.0.0"), "y", "1.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, npm("y", "1.0.0"), "x", "1.0.0", model.DependencyTypeDirect)
+	// The range does not match a version, so z is not walked through.
+	ingestDependency(t, b, npm("y", "1.0.0"), "z", "^1.0.0", model.DependencyTypeDirect)
+	ingestDependency(t, b, npm("z", "1.0.0"), "unreachable", "1.0.0", model.DependencyTypeDirect)
+
+	got, err := Dependencies(context.Background(), b, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("Dependencies() error = %v", err)
+	}
+	want := []Dependency{
+		dep("x", "1.0.0", model.DependencyTypeUnknown),
+		dep("y", "1.0.0", model.DependencyTypeIndirect),
+		dep("z", "^1.0.0", model.DependencyTypeIndirect),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Dependencies() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	direct := model.DependencyTypeDirect
+	tests := []struct {
+		name   string
+		before []Dependency
+		after  []Dependency
+		want   *SBOMDiff
+	}{{
+		name:   "order is ignored",
+		before: []Dependency{dep("a", "1", direct), dep("b", "1", direct)},
+		after:  []Dependency{dep("b", "1", direct), dep("a", "1", direct)},
+		want:   &SBOMDiff{Added: []Dependency{}, Removed: []Dependency{}, Changed: []Change{}},
+	}, {
+		name:   "versions are paired in order",
+		before: []Dependency{dep("a", "2", direct), dep("a", "1", direct), dep("a", "5", direct)},
+		after:  []Dependency{dep("a", "5", direct), dep("a", "4", direct)},
+		want: &SBOMDiff{
+			Added:   []Dependency{},
+			Removed: []Dependency{dep("a", "2", direct)},
+			Changed: []Change{{Before: dep("a", "1", direct), After: dep("a", "4", direct)}},
+		},
+	}, {
+		name:   "packages of different types",
+		before: []Dependency{{Type: "pypi", Name: "a", Version: "1", DependencyType: direct}},
+		after:  []Dependency{dep("a", "1", direct)},
+		want: &SBOMDiff{
+			Added:   []Dependency{dep("a", "1", direct)},
+			Removed: []Dependency{{Type: "pypi", Name: "a", Version: "1", DependencyType: direct}},
+			Changed: []Change{},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, Diff(tt.before, tt.after)); diff != "" {
+				t.Errorf("Diff() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,9 +25,91 @@ import (
 // GraphQL interface. All backends must implement all queries specified by the
 // GraphQL interface and this is enforced by this interface.
 type Backend interface {
-	Artifacts(ctx context.Context) ([]*model.Artifact, error)
+	// Retrieval read-only queries for artifacts, builders, packages, sources, vulnerabilities
+	Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error)
+	ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error)
+	Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error)
+	Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error)
+	PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error)
+	Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error)
+	Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error)
+
+	// Retrieval read-only queries for evidence trees
+	CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error)
+	CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error)
+	CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error)
+	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
+	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
+	HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error)
+	HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error)
+	HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error)
+	HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error)
+	IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error)
+	IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error)
+	PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error)
+	PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error)
+	Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error)
+	VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error)
+
+	// Queries walking the edges between the nodes of all the trees
+	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
+	Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error)
+	FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error)
+	TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error)
+
+	// Mutations for artifacts, builders, packages, sources, vulnerabilities
+	IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error)
+	IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error)
+	IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error)
+	IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error)
+	IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error)
+	IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error)
+	IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error)
+	IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error)
+
+	// Mutations for evidence trees
+	IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error)
+	IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error)
+	IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error)
+	IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error)
+	IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error)
+	IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error)
+	IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error)
+	IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error)
+	IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error)
+	IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error)
+	IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error)
+	IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error)
+	IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error)
+	IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error)
+	IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error)
+
+	// Subscriptions to the nodes being ingested. The returned channel is
+	// closed once ctx is done.
+	SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error)
+
+	// Ping checks that the datastore is reachable, returning an error if it
+	// cannot be reached before ctx is done.
+	Ping(ctx context.Context) error
 }
 
 // BackendArgs interface allows each backend to specify the arguments needed to
 // initialize (e.g., credentials).
 type BackendArgs interface{}
+
+// IDStrategy selects the IDs given to the nodes by the backends whose
+// arguments have an IDStrategy field.
+type IDStrategy int
+
+const (
+	// NativeIDs are the IDs the datastore gives to the nodes, such as the
+	// internal ids of Neo4j. They are only meaningful to the backend which
+	// returned them.
+	NativeIDs IDStrategy = iota
+	// ContentIDs are derived from the canonical identity of the nodes by
+	// the contentid package, so the same node has the same ID in all the
+	// backends using them. The strategy must not change after the first
+	// ingestion, as only the nodes ingested with ContentIDs can be looked
+	// up by their ID.
+	ContentIDs
+)
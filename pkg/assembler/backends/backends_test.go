@@ -0,0 +1,4749 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// The tests in this file check that a backend behaves as required by the
+// Backend interface. They run against the in-memory backend, populated with
+// the data below.
+
+var testPackages = []*model.PkgInputSpec{{
+	Type:       "deb",
+	Namespace:  ptrfrom("debian"),
+	Name:       "attr",
+	Version:    ptrfrom("1:2.4.47-2"),
+	Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "source"}},
+}, {
+	Type:      "deb",
+	Namespace: ptrfrom("debian"),
+	Name:      "curl",
+	Version:   ptrfrom("7.50.3-1"),
+	Qualifiers: []*model.PackageQualifierInputSpec{
+		{Key: "arch", Value: "i386"},
+		{Key: "distro", Value: "jessie"},
+	},
+}, {
+	Type:      "deb",
+	Namespace: ptrfrom("debian"),
+	Name:      "dpkg",
+	Version:   ptrfrom("1.19.0.4"),
+	Qualifiers: []*model.PackageQualifierInputSpec{
+		{Key: "arch", Value: "amd64"},
+		{Key: "distro", Value: "stretch"},
+	},
+}, {
+	Type:       "deb",
+	Namespace:  ptrfrom("ubuntu"),
+	Name:       "dpkg",
+	Version:    ptrfrom("1.19.0.4"),
+	Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "amd64"}},
+}, {
+	Type:      "golang",
+	Namespace: ptrfrom("google.golang.org"),
+	Name:      "genproto",
+	Subpath:   ptrfrom("googleapis/api/annotations"),
+}, {
+	Type:    "npm",
+	Name:    "foobar",
+	Version: ptrfrom("12.3.1"),
+}, {
+	Type:    "pypi",
+	Name:    "django",
+	Version: ptrfrom("1.11.1"),
+}, {
+	Type:    "pypi",
+	Name:    "django",
+	Version: ptrfrom("1.11.1"),
+	Subpath: ptrfrom("subpath"),
+}}
+
+var testSources = []*model.SourceInputSpec{{
+	Type:      "git",
+	Namespace: "github.com/guacsec",
+	Name:      "guac",
+	Tag:       ptrfrom("v0.0.1"),
+}, {
+	Type:      "git",
+	Namespace: "github.com/guacsec",
+	Name:      "guac",
+	Commit:    ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+}, {
+	Type:      "git",
+	Namespace: "github.com/guacsec",
+	Name:      "guac",
+}, {
+	Type:      "git",
+	Namespace: "gitlab.com/guacsec",
+	Name:      "guacdata",
+	Tag:       ptrfrom("v0.0.1"),
+}, {
+	Type:      "svn",
+	Namespace: "svn.apache.org/repos/asf",
+	Name:      "commons-lang",
+}}
+
+func newBackend(t *testing.T) backends.Backend {
+	t.Helper()
+	ctx := context.Background()
+	b, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for _, p := range testPackages {
+		if _, err := b.IngestPackage(ctx, p); err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+	}
+	for _, s := range testSources {
+		if _, err := b.IngestSource(ctx, s); err != nil {
+			t.Fatalf("IngestSource() error = %v", err)
+		}
+	}
+	return b
+}
+
+// ignoreIDs ignores the node IDs as these are backend specific.
+var ignoreIDs = cmp.Options{
+	cmpopts.IgnoreFields(model.Package{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageNamespace{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageName{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageVersion{}, "ID"),
+	cmpopts.IgnoreFields(model.Source{}, "ID"),
+	cmpopts.IgnoreFields(model.SourceNamespace{}, "ID"),
+	cmpopts.IgnoreFields(model.SourceName{}, "ID"),
+	cmpopts.IgnoreFields(model.Artifact{}, "ID"),
+	cmpopts.IgnoreFields(model.HasMetadata{}, "ID"),
+	cmpopts.IgnoreFields(model.HasSbom{}, "ID"),
+	cmpopts.IgnoreFields(model.IsDependency{}, "ID"),
+	cmpopts.IgnoreFields(model.Vulnerability{}, "ID"),
+	cmpopts.IgnoreFields(model.VulnerabilityID{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyVuln{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyLegal{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyVEXStatement{}, "ID"),
+	cmpopts.IgnoreFields(model.IsOccurrence{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyScorecard{}, "ID"),
+	cmpopts.IgnoreFields(model.HashEqual{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyBad{}, "ID"),
+	cmpopts.IgnoreFields(model.CertifyGood{}, "ID"),
+	cmpopts.IgnoreFields(model.Builder{}, "ID"),
+	cmpopts.IgnoreFields(model.HasSlsa{}, "ID"),
+	cmpopts.IgnoreFields(model.PkgEqual{}, "ID"),
+	cmpopts.IgnoreFields(model.PointOfContact{}, "ID"),
+	cmpopts.IgnoreFields(model.VulnEqual{}, "ID"),
+	cmpopts.EquateEmpty(),
+}
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestPackages(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	tests := []struct {
+		name    string
+		pkgSpec *model.PkgSpec
+		want    []*model.Package
+	}{{
+		name: "type only",
+		pkgSpec: &model.PkgSpec{
+			Type: ptrfrom("deb"),
+		},
+		want: []*model.Package{{
+			Type: "deb",
+			Namespaces: []*model.PackageNamespace{{
+				Namespace: "debian",
+				Names: []*model.PackageName{{
+					Name: "attr",
+					Versions: []*model.PackageVersion{{
+						Version:    "1:2.4.47-2",
+						Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "source"}},
+					}},
+				}, {
+					Name: "curl",
+					Versions: []*model.PackageVersion{{
+						Version: "7.50.3-1",
+						Qualifiers: []*model.PackageQualifier{
+							{Key: "arch", Value: "i386"},
+							{Key: "distro", Value: "jessie"},
+						},
+					}},
+				}, {
+					Name: "dpkg",
+					Versions: []*model.PackageVersion{{
+						Version: "1.19.0.4",
+						Qualifiers: []*model.PackageQualifier{
+							{Key: "arch", Value: "amd64"},
+							{Key: "distro", Value: "stretch"},
+						},
+					}},
+				}},
+			}, {
+				Namespace: "ubuntu",
+				Names: []*model.PackageName{{
+					Name: "dpkg",
+					Versions: []*model.PackageVersion{{
+						Version:    "1.19.0.4",
+						Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "amd64"}},
+					}},
+				}},
+			}},
+		}},
+	}, {
+		name: "type and namespace",
+		pkgSpec: &model.PkgSpec{
+			Type:      ptrfrom("deb"),
+			Namespace: ptrfrom("ubuntu"),
+		},
+		want: []*model.Package{{
+			Type: "deb",
+			Namespaces: []*model.PackageNamespace{{
+				Namespace: "ubuntu",
+				Names: []*model.PackageName{{
+					Name: "dpkg",
+					Versions: []*model.PackageVersion{{
+						Version:    "1.19.0.4",
+						Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "amd64"}},
+					}},
+				}},
+			}},
+		}},
+	}, {
+		name: "fully qualified purl",
+		pkgSpec: &model.PkgSpec{
+			Type:      ptrfrom("pypi"),
+			Namespace: ptrfrom(""),
+			Name:      ptrfrom("django"),
+			Version:   ptrfrom("1.11.1"),
+			Subpath:   ptrfrom("subpath"),
+		},
+		want: []*model.Package{{
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				Names: []*model.PackageName{{
+					Name: "django",
+					Versions: []*model.PackageVersion{{
+						Version: "1.11.1",
+						Subpath: "subpath",
+					}},
+				}},
+			}},
+		}},
+	}, {
+		name: "version without match",
+		pkgSpec: &model.PkgSpec{
+			Type:    ptrfrom("pypi"),
+			Version: ptrfrom("0.0.0"),
+		},
+		want: nil,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Packages(ctx, tt.pkgSpec)
+			if err != nil {
+				t.Fatalf("Packages() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Packages() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPackagesEmptySpec(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	for _, pkgSpec := range []*model.PkgSpec{nil, {}} {
+		got, err := b.Packages(ctx, pkgSpec)
+		if err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+		var types, versions int
+		for _, p := range got {
+			types++
+			for _, ns := range p.Namespaces {
+				for _, n := range ns.Names {
+					versions += len(n.Versions)
+				}
+			}
+		}
+		if types != 4 || versions != len(testPackages) {
+			t.Errorf("Packages(%v) returned %d types and %d versions, want 4 and %d", pkgSpec, types, versions, len(testPackages))
+		}
+	}
+}
+
+func TestPackagesQualifiers(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	// Two versions differing only by their arch qualifier, and one without
+	// any qualifier.
+	openssl := func(qualifiers ...*model.PackageQualifierInputSpec) *model.PkgInputSpec {
+		return &model.PkgInputSpec{
+			Type:       "deb",
+			Namespace:  ptrfrom("debian"),
+			Name:       "openssl",
+			Version:    ptrfrom("3.0.11-1"),
+			Qualifiers: qualifiers,
+		}
+	}
+	amd64 := openssl(&model.PackageQualifierInputSpec{Key: "arch", Value: "amd64"}, &model.PackageQualifierInputSpec{Key: "distro", Value: "bookworm"})
+	arm64 := openssl(&model.PackageQualifierInputSpec{Key: "arch", Value: "arm64"}, &model.PackageQualifierInputSpec{Key: "distro", Value: "bookworm"})
+	for i, p := range []*model.PkgInputSpec{amd64, arm64, openssl()} {
+		if _, err := b.IngestPackage(ctx, p); err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		artifact := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: fmt.Sprintf("%064d", i)}
+		if _, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: p}, artifact, &model.IsOccurrenceInputSpec{
+			Justification: "package of the artifact",
+		}); err != nil {
+			t.Fatalf("IngestIsOccurrence() error = %v", err)
+		}
+	}
+	amd64Version := &model.PackageVersion{
+		Version: "3.0.11-1",
+		Qualifiers: []*model.PackageQualifier{
+			{Key: "arch", Value: "amd64"},
+			{Key: "distro", Value: "bookworm"},
+		},
+	}
+	arm64Version := &model.PackageVersion{
+		Version: "3.0.11-1",
+		Qualifiers: []*model.PackageQualifier{
+			{Key: "arch", Value: "arm64"},
+			{Key: "distro", Value: "bookworm"},
+		},
+	}
+	noQualifiersVersion := &model.PackageVersion{Version: "3.0.11-1"}
+	opensslPackage := func(versions ...*model.PackageVersion) []*model.Package {
+		if len(versions) == 0 {
+			return nil
+		}
+		return []*model.Package{{
+			Type: "deb",
+			Namespaces: []*model.PackageNamespace{{
+				Namespace: "debian",
+				Names:     []*model.PackageName{{Name: "openssl", Versions: versions}},
+			}},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		spec *model.PkgSpec
+		want []*model.Package
+	}{{
+		name: "no qualifiers",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl")},
+		want: opensslPackage(amd64Version, arm64Version, noQualifiersVersion),
+	}, {
+		name: "amd64",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom("amd64")}}},
+		want: opensslPackage(amd64Version),
+	}, {
+		name: "arm64",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom("arm64")}}},
+		want: opensslPackage(arm64Version),
+	}, {
+		name: "canonicalized qualifier",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{{Key: " ARCH ", Value: ptrfrom(" arm64 ")}}},
+		want: opensslPackage(arm64Version),
+	}, {
+		name: "all qualifiers",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{
+			{Key: "distro", Value: ptrfrom("bookworm")},
+			{Key: "arch", Value: ptrfrom("arm64")},
+		}},
+		want: opensslPackage(arm64Version),
+	}, {
+		name: "any value",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{{Key: "arch"}}},
+		want: opensslPackage(amd64Version, arm64Version),
+	}, {
+		name: "qualifier without match",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), Qualifiers: []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom("i386")}}},
+	}, {
+		name: "only empty qualifiers",
+		spec: &model.PkgSpec{Name: ptrfrom("openssl"), MatchOnlyEmptyQualifiers: ptrfrom(true)},
+		want: opensslPackage(noQualifiersVersion),
+	}, {
+		name: "only empty qualifiers ignores the qualifiers",
+		spec: &model.PkgSpec{
+			Name:                     ptrfrom("openssl"),
+			Qualifiers:               []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom("amd64")}},
+			MatchOnlyEmptyQualifiers: ptrfrom(true),
+		},
+		want: opensslPackage(noQualifiersVersion),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Packages(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("Packages() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Packages() mismatch (-want +got):\n%s", diff)
+			}
+
+			// The evidence trees filter their package subjects the same way.
+			occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: tt.spec}})
+			if err != nil {
+				t.Fatalf("IsOccurrence() error = %v", err)
+			}
+			var wantVersions, gotVersions []*model.PackageVersion
+			if len(tt.want) > 0 {
+				wantVersions = tt.want[0].Namespaces[0].Names[0].Versions
+			}
+			for _, o := range occurrences {
+				gotVersions = append(gotVersions, o.Subject.(*model.Package).Namespaces[0].Names[0].Versions...)
+			}
+			if diff := cmp.Diff(wantVersions, gotVersions, ignoreIDs); diff != "" {
+				t.Errorf("IsOccurrence() subjects mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPackagesByPurls(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	found := []string{
+		"pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+		"pkg:deb/debian/curl@7.50.3-1?arch=i386",
+		"pkg:pypi/django",
+		"pkg:pypi/django@1.11.1#subpath",
+		"pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+	}
+	nonexistent := []string{
+		"pkg:npm/foobar@0.0.1",
+		"pkg:npm/leftpad",
+		"pkg:deb/debian/curl@7.50.3-1?arch=amd64",
+	}
+	invalid := []string{"not-a-purl", "pkg:deb"}
+	purls := append(append(append([]string{}, found...), nonexistent...), invalid...)
+
+	got, err := b.PackagesByPurls(ctx, purls)
+	var validationErr *backends.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "purls" {
+		t.Fatalf("PackagesByPurls() error = %v, want a ValidationError of purls", err)
+	}
+	for _, purl := range invalid {
+		if !strings.Contains(err.Error(), purl) {
+			t.Errorf("PackagesByPurls() error = %v, want it to list %q", err, purl)
+		}
+	}
+
+	// Each purl resolves to the same package as the equivalent filter.
+	want := map[string]*model.Package{}
+	for _, purl := range found {
+		pkgSpec, err := helpers.PurlToPkgSpec(purl)
+		if err != nil {
+			t.Fatalf("PurlToPkgSpec(%q) error = %v", purl, err)
+		}
+		pkgs, err := b.Packages(ctx, pkgSpec)
+		if err != nil || len(pkgs) != 1 {
+			t.Fatalf("Packages(%q) = %v, %v, want a single package", purl, pkgs, err)
+		}
+		want[purl] = pkgs[0]
+	}
+	if diff := cmp.Diff(want, got, ignoreIDs); diff != "" {
+		t.Errorf("PackagesByPurls() mismatch (-want +got):\n%s", diff)
+	}
+	if versions := got["pkg:pypi/django"].Namespaces[0].Names[0].Versions; len(versions) != 2 {
+		t.Errorf("PackagesByPurls() returned %d versions of pkg:pypi/django, want 2", len(versions))
+	}
+
+	got, err = b.PackagesByPurls(ctx, nil)
+	if err != nil || len(got) != 0 {
+		t.Errorf("PackagesByPurls(nil) = %v, %v, want no packages", got, err)
+	}
+}
+
+func TestSources(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	tests := []struct {
+		name       string
+		sourceSpec *model.SourceSpec
+		want       []*model.Source
+		wantErr    bool
+	}{{
+		name: "tag only",
+		sourceSpec: &model.SourceSpec{
+			Name: ptrfrom("guac"),
+			Tag:  ptrfrom("v0.0.1"),
+		},
+		want: []*model.Source{{
+			Type: "git",
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: "github.com/guacsec",
+				Names: []*model.SourceName{{
+					Name: "guac",
+					Tag:  ptrfrom("v0.0.1"),
+				}},
+			}},
+		}},
+	}, {
+		name: "commit only",
+		sourceSpec: &model.SourceSpec{
+			Name:   ptrfrom("guac"),
+			Commit: ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+		},
+		want: []*model.Source{{
+			Type: "git",
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: "github.com/guacsec",
+				Names: []*model.SourceName{{
+					Name:   "guac",
+					Commit: ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+				}},
+			}},
+		}},
+	}, {
+		name: "neither tag nor commit",
+		sourceSpec: &model.SourceSpec{
+			Name:   ptrfrom("guac"),
+			Tag:    ptrfrom(""),
+			Commit: ptrfrom(""),
+		},
+		want: []*model.Source{{
+			Type: "git",
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: "github.com/guacsec",
+				Names: []*model.SourceName{{
+					Name: "guac",
+				}},
+			}},
+		}},
+	}, {
+		name: "type only",
+		sourceSpec: &model.SourceSpec{
+			Type: ptrfrom("svn"),
+		},
+		want: []*model.Source{{
+			Type: "svn",
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: "svn.apache.org/repos/asf",
+				Names: []*model.SourceName{{
+					Name: "commons-lang",
+				}},
+			}},
+		}},
+	}, {
+		name: "both tag and commit",
+		sourceSpec: &model.SourceSpec{
+			Tag:    ptrfrom("v0.0.1"),
+			Commit: ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Sources(ctx, tt.sourceSpec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Sources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Sources() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSourcesDistinctTagAndCommit(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	tagged, err := b.Sources(ctx, &model.SourceSpec{Name: ptrfrom("guac"), Tag: ptrfrom("v0.0.1")})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	committed, err := b.Sources(ctx, &model.SourceSpec{Name: ptrfrom("guac"), Commit: ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b")})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	tagID := tagged[0].Namespaces[0].Names[0].ID
+	commitID := committed[0].Namespaces[0].Names[0].ID
+	if tagID == commitID {
+		t.Errorf("source with tag and source with commit share the same node %s", tagID)
+	}
+}
+
+func TestCanonicalSpecs(t *testing.T) {
+	artifactSpecs := []*model.ArtifactSpec{
+		{Algorithm: ptrfrom("sha256"), Digest: ptrfrom("6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf")},
+		{Algorithm: ptrfrom(" SHA256"), Digest: ptrfrom("6BBB0DA1891646E58EB3E6A63AF3A6FC3C8EB5A0D44824CBA581D2E14A0450CF ")},
+	}
+	if a, b := backends.CanonicalArtifactSpec(artifactSpecs[0]), backends.CanonicalArtifactSpec(artifactSpecs[1]); !reflect.DeepEqual(a, b) {
+		t.Errorf("CanonicalArtifactSpec() = %+v and %+v, want equal specs", a, b)
+	}
+	artifactInputs := []*model.ArtifactInputSpec{
+		{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+		{Algorithm: "Sha1\t", Digest: " 7A8F47318E4676DACB0142AFA0B83029CD7BEFD9"},
+	}
+	if a, b := backends.CanonicalArtifactInputSpec(artifactInputs[0]), backends.CanonicalArtifactInputSpec(artifactInputs[1]); !reflect.DeepEqual(a, b) {
+		t.Errorf("CanonicalArtifactInputSpec() = %+v and %+v, want equal specs", a, b)
+	}
+	sourceSpecs := []*model.SourceSpec{
+		{Type: ptrfrom("hg"), Namespace: ptrfrom("hg.mozilla.org"), Name: ptrfrom("mozilla-central"), Commit: ptrfrom("abcdef")},
+		{Type: ptrfrom(" Mercurial"), Namespace: ptrfrom("hg.mozilla.org "), Name: ptrfrom(" mozilla-central"), Commit: ptrfrom("ABCDEF")},
+	}
+	if a, b := backends.CanonicalSourceSpec(sourceSpecs[0]), backends.CanonicalSourceSpec(sourceSpecs[1]); !reflect.DeepEqual(a, b) {
+		t.Errorf("CanonicalSourceSpec() = %+v and %+v, want equal specs", a, b)
+	}
+	sourceInputs := []*model.SourceInputSpec{
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1")},
+		{Type: "GIT ", Namespace: " github.com/guacsec", Name: "guac\n", Tag: ptrfrom(" v0.0.1")},
+	}
+	if a, b := backends.CanonicalSourceInputSpec(sourceInputs[0]), backends.CanonicalSourceInputSpec(sourceInputs[1]); !reflect.DeepEqual(a, b) {
+		t.Errorf("CanonicalSourceInputSpec() = %+v and %+v, want equal specs", a, b)
+	}
+
+	// Other casings are preserved, and the arguments are not modified.
+	spec := &model.SourceSpec{Namespace: ptrfrom(" github.com/GUACSec"), Tag: ptrfrom("RC1")}
+	got := backends.CanonicalSourceSpec(spec)
+	want := &model.SourceSpec{Namespace: ptrfrom("github.com/GUACSec"), Tag: ptrfrom("RC1")}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CanonicalSourceSpec() unexpected results (-want +got):\n%s", diff)
+	}
+	if *spec.Namespace != " github.com/GUACSec" {
+		t.Errorf("CanonicalSourceSpec() modified its argument")
+	}
+	if backends.CanonicalArtifactSpec(nil) != nil || backends.CanonicalSourceSpec(nil) != nil || backends.CanonicalPkgSpec(nil) != nil {
+		t.Errorf("canonical nil spec is not nil")
+	}
+}
+
+func TestCanonicalNodes(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	// Ingesting values differing only in casing and whitespace hits the
+	// same node.
+	artifact, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"})
+	if err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	again, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: " SHA1", Digest: "7A8F47318E4676DACB0142AFA0B83029CD7BEFD9 "})
+	if err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	if again.ID != artifact.ID {
+		t.Errorf("IngestArtifact() created %s for the same artifact as %s", again.ID, artifact.ID)
+	}
+	source, err := b.IngestSource(ctx, testSources[0])
+	if err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	sourceAgain, err := b.IngestSource(ctx, &model.SourceInputSpec{Type: " GIT", Namespace: "github.com/guacsec ", Name: " guac", Tag: ptrfrom("v0.0.1\t")})
+	if err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	if diff := cmp.Diff(source, sourceAgain); diff != "" {
+		t.Errorf("IngestSource() created another source (-first +second):\n%s", diff)
+	}
+
+	// And so does querying them.
+	artifacts, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom("Sha1 ")})
+	if err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if len(artifacts) != 1 || artifacts[0].ID != artifact.ID {
+		t.Errorf("Artifacts() = %v, want %v", artifacts, artifact)
+	}
+	sources, err := b.Sources(ctx, &model.SourceSpec{Type: ptrfrom("Git"), Namespace: ptrfrom(" github.com/guacsec"), Tag: ptrfrom("v0.0.1 ")})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.Source{source}, sources); diff != "" {
+		t.Errorf("Sources() unexpected results (-want +got):\n%s", diff)
+	}
+	commit := &model.SourceSpec{Commit: ptrfrom("FCBA958B73E27CAD8B5C8655D46439984D27853B")}
+	if sources, err := b.Sources(ctx, commit); err != nil || len(sources) != 1 {
+		t.Errorf("Sources() = %v, %v, want the source at the commit", sources, err)
+	}
+	scorecard, err := b.IngestScorecard(ctx, &model.SourceInputSpec{Type: "Git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1")}, &model.ScorecardInputSpec{AggregateScore: 5})
+	if err != nil {
+		t.Fatalf("IngestScorecard() error = %v", err)
+	}
+	if diff := cmp.Diff(source, scorecard.Source); diff != "" {
+		t.Errorf("IngestScorecard() created another source (-first +second):\n%s", diff)
+	}
+	scorecards, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{Source: &model.SourceSpec{Type: ptrfrom(" GIT")}})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if len(scorecards) != 1 {
+		t.Errorf("Scorecards() = %v, want the ingested scorecard", scorecards)
+	}
+}
+
+func TestIngestArtifact(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		inputs   []*model.ArtifactInputSpec
+		want     *model.Artifact
+		wantErr  bool
+		sameNode bool
+	}{{
+		name: "same artifact twice",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha256", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0"},
+			{Algorithm: "sha256", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0"},
+		},
+		want:     &model.Artifact{Algorithm: "sha256", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0"},
+		sameNode: true,
+	}, {
+		name: "algorithm and digest are normalized",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha256", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0"},
+			{Algorithm: " SHA256 ", Digest: "CCC8B0BC3E9E1D7D1E1E4FA90A5B7E6F05FA42A6D423D2D0DC0CD7FB856DE0D0 "},
+		},
+		want:     &model.Artifact{Algorithm: "sha256", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0"},
+		sameNode: true,
+	}, {
+		name: "different algorithms",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha1", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6"},
+			{Algorithm: "md5", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f"},
+		},
+		want:     &model.Artifact{Algorithm: "md5", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f"},
+		sameNode: false,
+	}, {
+		name: "digest is not hex",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha256", Digest: "not-a-digest"},
+		},
+		wantErr: true,
+	}, {
+		name: "empty algorithm",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: " ", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f"},
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBackend(t)
+			before, err := b.Artifacts(ctx, nil)
+			if err != nil {
+				t.Fatalf("Artifacts() error = %v", err)
+			}
+
+			var ids []string
+			var got *model.Artifact
+			for _, input := range tt.inputs {
+				got, err = b.IngestArtifact(ctx, input)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("IngestArtifact() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				ids = append(ids, got.ID)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(model.Artifact{}, "ID")); diff != "" {
+				t.Errorf("IngestArtifact() mismatch (-want +got):\n%s", diff)
+			}
+			if same := ids[0] == ids[1]; same != tt.sameNode {
+				t.Errorf("IngestArtifact() returned IDs %v, want same node = %v", ids, tt.sameNode)
+			}
+
+			after, err := b.Artifacts(ctx, nil)
+			if err != nil {
+				t.Fatalf("Artifacts() error = %v", err)
+			}
+			wantNew := 2
+			if tt.sameNode {
+				wantNew = 1
+			}
+			if len(after)-len(before) != wantNew {
+				t.Errorf("Artifacts() returned %d new artifacts, want %d", len(after)-len(before), wantNew)
+			}
+		})
+	}
+}
+
+func TestIngestArtifacts(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		inputs  []*model.ArtifactInputSpec
+		want    []*model.Artifact
+		wantErr bool
+	}{{
+		name: "order is preserved",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha256", Digest: "02"},
+			{Algorithm: "sha1", Digest: "01"},
+			{Algorithm: "SHA256", Digest: "02"},
+			{Algorithm: "md5", Digest: "03"},
+		},
+		want: []*model.Artifact{
+			{Algorithm: "sha256", Digest: "02"},
+			{Algorithm: "sha1", Digest: "01"},
+			{Algorithm: "sha256", Digest: "02"},
+			{Algorithm: "md5", Digest: "03"},
+		},
+	}, {
+		name:   "empty batch",
+		inputs: []*model.ArtifactInputSpec{},
+	}, {
+		name: "invalid artifact rolls back the batch",
+		inputs: []*model.ArtifactInputSpec{
+			{Algorithm: "sha256", Digest: "02"},
+			{Algorithm: "sha256", Digest: "not-a-digest"},
+		},
+		wantErr: true,
+	}, {
+		name:    "missing artifact",
+		inputs:  []*model.ArtifactInputSpec{{Algorithm: "sha256", Digest: "02"}, nil},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBackend(t)
+			got, err := b.IngestArtifacts(ctx, tt.inputs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IngestArtifacts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("IngestArtifacts() mismatch (-want +got):\n%s", diff)
+			}
+			if err == nil && len(got) == 3 && got[0].ID != got[2].ID {
+				t.Errorf("IngestArtifacts() returned different nodes %s and %s for the same artifact", got[0].ID, got[2].ID)
+			}
+
+			artifacts, err := b.Artifacts(ctx, nil)
+			if err != nil {
+				t.Fatalf("Artifacts() error = %v", err)
+			}
+			if tt.wantErr && len(artifacts) != 0 {
+				t.Errorf("Artifacts() returned %d artifacts after a failed batch, want 0", len(artifacts))
+			}
+		})
+	}
+}
+
+// The benchmarks below compare ingesting artifacts one at a time with a
+// single batch. The in-memory backend only saves the locking, the batch makes
+// a difference for backends where each call is a round trip to a database.
+
+// benchmarkArtifacts returns n distinct artifacts.
+func benchmarkArtifacts(n int) []*model.ArtifactInputSpec {
+	artifacts := make([]*model.ArtifactInputSpec, 0, n)
+	for i := 0; i < n; i++ {
+		artifacts = append(artifacts, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: fmt.Sprintf("%064x", i)})
+	}
+	return artifacts
+}
+
+func BenchmarkIngestArtifact(b *testing.B) {
+	ctx := context.Background()
+	artifacts := benchmarkArtifacts(1000)
+	for i := 0; i < b.N; i++ {
+		backend, err := inmem.New(ctx, nil)
+		if err != nil {
+			b.Fatalf("New() error = %v", err)
+		}
+		for _, a := range artifacts {
+			if _, err := backend.IngestArtifact(ctx, a); err != nil {
+				b.Fatalf("IngestArtifact() error = %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkIngestArtifacts(b *testing.B) {
+	ctx := context.Background()
+	artifacts := benchmarkArtifacts(1000)
+	for i := 0; i < b.N; i++ {
+		backend, err := inmem.New(ctx, nil)
+		if err != nil {
+			b.Fatalf("New() error = %v", err)
+		}
+		if _, err := backend.IngestArtifacts(ctx, artifacts); err != nil {
+			b.Fatalf("IngestArtifacts() error = %v", err)
+		}
+	}
+}
+
+// benchmarkPackages returns n versions of the same package name, as an SBOM
+// listing the versions vendored by its dependencies would.
+func benchmarkPackages(n int) []*model.PkgInputSpec {
+	pkgs := make([]*model.PkgInputSpec, 0, n)
+	for i := 0; i < n; i++ {
+		pkgs = append(pkgs, &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/google"), Name: "uuid", Version: ptrfrom(fmt.Sprintf("v1.%d.0", i))})
+	}
+	return pkgs
+}
+
+func BenchmarkIngestPackage(b *testing.B) {
+	ctx := context.Background()
+	pkgs := benchmarkPackages(1000)
+	for i := 0; i < b.N; i++ {
+		backend, err := inmem.New(ctx, nil)
+		if err != nil {
+			b.Fatalf("New() error = %v", err)
+		}
+		for _, p := range pkgs {
+			if _, err := backend.IngestPackage(ctx, p); err != nil {
+				b.Fatalf("IngestPackage() error = %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkIngestPackages(b *testing.B) {
+	ctx := context.Background()
+	pkgs := benchmarkPackages(1000)
+	for i := 0; i < b.N; i++ {
+		backend, err := inmem.New(ctx, nil)
+		if err != nil {
+			b.Fatalf("New() error = %v", err)
+		}
+		if _, err := backend.IngestPackages(ctx, pkgs); err != nil {
+			b.Fatalf("IngestPackages() error = %v", err)
+		}
+	}
+}
+
+// benchmarkPurls ingests the packages of benchmarkPackages and returns their
+// purls.
+func benchmarkPurls(b *testing.B, n int) (backends.Backend, []string) {
+	ctx := context.Background()
+	backend, err := inmem.New(ctx, nil)
+	if err != nil {
+		b.Fatalf("New() error = %v", err)
+	}
+	pkgs := benchmarkPackages(n)
+	if _, err := backend.IngestPackages(ctx, pkgs); err != nil {
+		b.Fatalf("IngestPackages() error = %v", err)
+	}
+	purls := make([]string, 0, n)
+	for _, p := range pkgs {
+		purls = append(purls, "pkg:golang/github.com/google/uuid@"+*p.Version)
+	}
+	return backend, purls
+}
+
+func BenchmarkPackagesByPurls(b *testing.B) {
+	ctx := context.Background()
+	backend, purls := benchmarkPurls(b, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := backend.PackagesByPurls(ctx, purls); err != nil {
+			b.Fatalf("PackagesByPurls() error = %v", err)
+		}
+	}
+}
+
+// BenchmarkPackagesByPurl looks up the same purls as BenchmarkPackagesByPurls,
+// with a Packages query for each.
+func BenchmarkPackagesByPurl(b *testing.B) {
+	ctx := context.Background()
+	backend, purls := benchmarkPurls(b, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, purl := range purls {
+			pkgSpec, err := helpers.PurlToPkgSpec(purl)
+			if err != nil {
+				b.Fatalf("PurlToPkgSpec() error = %v", err)
+			}
+			if _, err := backend.Packages(ctx, pkgSpec); err != nil {
+				b.Fatalf("Packages() error = %v", err)
+			}
+		}
+	}
+}
+
+func TestArtifactsList(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	var ingested []*model.ArtifactInputSpec
+	for _, digest := range []string{"01", "02", "03", "04", "05"} {
+		a := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: digest}
+		if _, err := b.IngestArtifact(ctx, a); err != nil {
+			t.Fatalf("IngestArtifact() error = %v", err)
+		}
+		ingested = append(ingested, a)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "06"}); err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+
+	// Walk the pages, ingesting a new artifact after each of them: every
+	// artifact which existed before must be returned exactly once.
+	seen := map[string]int{}
+	spec := &model.ArtifactSpec{Algorithm: ptrfrom("SHA256")}
+	var after *string
+	for pages := 0; ; pages++ {
+		if pages > len(ingested) {
+			t.Fatalf("ArtifactsList() did not reach the last page")
+		}
+		got, err := b.ArtifactsList(ctx, spec, after, ptrfrom(2))
+		if err != nil {
+			t.Fatalf("ArtifactsList() error = %v", err)
+		}
+		if len(got.Edges) > 2 {
+			t.Errorf("ArtifactsList() returned %d artifacts, want at most 2", len(got.Edges))
+		}
+		for _, e := range got.Edges {
+			if e.Node.Algorithm != "sha256" {
+				t.Errorf("ArtifactsList() returned artifact %v not matching the spec", e.Node)
+			}
+			seen[e.Node.Digest]++
+		}
+		if len(got.Edges) > 0 && *got.PageInfo.EndCursor != got.Edges[len(got.Edges)-1].Cursor {
+			t.Errorf("ArtifactsList() end cursor %s is not the cursor of the last edge", *got.PageInfo.EndCursor)
+		}
+		if !got.PageInfo.HasNextPage {
+			break
+		}
+		after = got.PageInfo.EndCursor
+		if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: fmt.Sprintf("1%d", pages)}); err != nil {
+			t.Fatalf("IngestArtifact() error = %v", err)
+		}
+	}
+	for _, a := range ingested {
+		if seen[a.Digest] != 1 {
+			t.Errorf("ArtifactsList() returned artifact %s %d times, want once", a.Digest, seen[a.Digest])
+		}
+	}
+	for digest, count := range seen {
+		if count != 1 {
+			t.Errorf("ArtifactsList() returned artifact %s %d times, want once", digest, count)
+		}
+	}
+
+	all, err := b.ArtifactsList(ctx, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("ArtifactsList() error = %v", err)
+	}
+	artifacts, err := b.Artifacts(ctx, nil)
+	if err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if all.PageInfo.HasNextPage || len(all.Edges) != len(artifacts) {
+		t.Errorf("ArtifactsList() without first returned %d artifacts, want all %d", len(all.Edges), len(artifacts))
+	}
+
+	empty, err := b.ArtifactsList(ctx, nil, nil, ptrfrom(0))
+	if err != nil {
+		t.Fatalf("ArtifactsList() error = %v", err)
+	}
+	if len(empty.Edges) != 0 || !empty.PageInfo.HasNextPage || empty.PageInfo.EndCursor != nil {
+		t.Errorf("ArtifactsList() with first = 0 returned %+v, want an empty page with a next page", empty)
+	}
+
+	if _, err := b.ArtifactsList(ctx, nil, nil, ptrfrom(-1)); err == nil {
+		t.Errorf("ArtifactsList() with negative first did not return an error")
+	}
+	if _, err := b.ArtifactsList(ctx, nil, ptrfrom("not a cursor!"), nil); err == nil {
+		t.Errorf("ArtifactsList() with an invalid cursor did not return an error")
+	}
+}
+
+func TestIngestPackage(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	first, err := b.IngestPackage(ctx, &model.PkgInputSpec{
+		Type:      "deb",
+		Namespace: ptrfrom("debian"),
+		Name:      "curl",
+		Version:   ptrfrom("7.50.3-1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "distro", Value: "jessie"},
+			{Key: "arch", Value: "i386"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	existing, err := b.Packages(ctx, &model.PkgSpec{
+		Type:    ptrfrom("deb"),
+		Name:    ptrfrom("curl"),
+		Version: ptrfrom("7.50.3-1"),
+	})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if diff := cmp.Diff(existing, []*model.Package{first}, ignoreIDs); diff != "" {
+		t.Errorf("IngestPackage() did not return the path to the version (-want +got):\n%s", diff)
+	}
+	gotID := first.Namespaces[0].Names[0].Versions[0].ID
+	wantID := existing[0].Namespaces[0].Names[0].Versions[0].ID
+	if gotID != wantID {
+		t.Errorf("IngestPackage() with reordered qualifiers created node %s, want existing node %s", gotID, wantID)
+	}
+
+	if _, err := b.IngestPackage(ctx, &model.PkgInputSpec{
+		Type:      "deb",
+		Namespace: ptrfrom("debian"),
+		Name:      "curl",
+		Version:   ptrfrom("7.83.0-r0"),
+	}); err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	names, err := b.Packages(ctx, &model.PkgSpec{Type: ptrfrom("deb"), Name: ptrfrom("curl")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(names) != 1 || len(names[0].Namespaces) != 1 || len(names[0].Namespaces[0].Names) != 1 {
+		t.Fatalf("Packages() returned %v, want a single path to the name node", names)
+	}
+	if got := len(names[0].Namespaces[0].Names[0].Versions); got != 2 {
+		t.Errorf("Packages() returned %d versions for the same name, want 2", got)
+	}
+}
+
+func TestIngestPackageCanonical(t *testing.T) {
+	tests := []struct {
+		name  string
+		first *model.PkgInputSpec
+		again *model.PkgInputSpec
+		same  bool
+	}{{
+		name:  "npm is case insensitive",
+		first: &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom("@babel"), Name: "core", Version: ptrfrom("7.21.0")},
+		again: &model.PkgInputSpec{Type: "NPM", Namespace: ptrfrom("@Babel"), Name: "Core ", Version: ptrfrom("7.21.0")},
+		same:  true,
+	}, {
+		name:  "maven is case sensitive",
+		first: &model.PkgInputSpec{Type: "maven", Namespace: ptrfrom("org.apache.logging.log4j"), Name: "log4j-core", Version: ptrfrom("2.17.1")},
+		again: &model.PkgInputSpec{Type: "Maven", Namespace: ptrfrom("org.Apache.Logging.Log4j"), Name: "Log4j-Core", Version: ptrfrom("2.17.1")},
+	}, {
+		name:  "pypi names",
+		first: &model.PkgInputSpec{Type: "pypi", Name: "typing-extensions", Version: ptrfrom("4.5.0")},
+		again: &model.PkgInputSpec{Type: "pypi", Name: "Typing_Extensions", Version: ptrfrom("4.5.0")},
+		same:  true,
+	}, {
+		name: "reordered qualifiers",
+		first: &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/google"), Name: "uuid", Version: ptrfrom("v1.3.0"), Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "goarch", Value: "amd64"},
+			{Key: "goos", Value: "linux"},
+		}},
+		again: &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/google"), Name: "uuid", Version: ptrfrom("v1.3.0"), Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "GOOS", Value: "linux"},
+			{Key: "arch", Value: ""},
+			{Key: "goarch", Value: "amd64"},
+		}},
+		same: true,
+	}, {
+		name:  "golang is case sensitive",
+		first: &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/sirupsen"), Name: "logrus", Version: ptrfrom("v1.9.0")},
+		again: &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/Sirupsen"), Name: "logrus", Version: ptrfrom("v1.9.0")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			b := newBackend(t)
+
+			first, err := b.IngestPackage(ctx, tt.first)
+			if err != nil {
+				t.Fatalf("IngestPackage() error = %v", err)
+			}
+			again, err := b.IngestPackage(ctx, tt.again)
+			if err != nil {
+				t.Fatalf("IngestPackage() error = %v", err)
+			}
+			firstID := first.Namespaces[0].Names[0].Versions[0].ID
+			againID := again.Namespaces[0].Names[0].Versions[0].ID
+			if (firstID == againID) != tt.same {
+				t.Errorf("IngestPackage() returned versions %s and %s, want the same version: %v", firstID, againID, tt.same)
+			}
+			if tt.same {
+				if diff := cmp.Diff(first, again); diff != "" {
+					t.Errorf("IngestPackage() returned another package (-first +again):\n%s", diff)
+				}
+			}
+
+			// Querying with the second spec finds the second package.
+			pkgs, err := b.Packages(ctx, &model.PkgSpec{
+				Type:      &tt.again.Type,
+				Namespace: tt.again.Namespace,
+				Name:      &tt.again.Name,
+				Version:   tt.again.Version,
+			})
+			if err != nil {
+				t.Fatalf("Packages() error = %v", err)
+			}
+			if diff := cmp.Diff([]*model.Package{again}, pkgs); diff != "" {
+				t.Errorf("Packages() unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestSource(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	got, err := b.IngestSource(ctx, &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+		Tag:       ptrfrom("v0.0.1"),
+	})
+	if err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	existing, err := b.Sources(ctx, &model.SourceSpec{Name: ptrfrom("guac"), Tag: ptrfrom("v0.0.1")})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	if diff := cmp.Diff(existing, []*model.Source{got}); diff != "" {
+		t.Errorf("IngestSource() did not return the existing node (-want +got):\n%s", diff)
+	}
+
+	if _, err := b.IngestSource(ctx, &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+		Tag:       ptrfrom("v0.0.1"),
+		Commit:    ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+	}); err == nil {
+		t.Errorf("IngestSource() with both tag and commit did not return an error")
+	}
+}
+
+func TestIngestPackages(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	var pkgs []*model.PkgInputSpec
+	for _, version := range []string{"1.20.1-1.1", "1.21-1", "1.21.3-1"} {
+		pkgs = append(pkgs, &model.PkgInputSpec{Type: "deb", Namespace: ptrfrom("debian"), Name: "wget", Version: ptrfrom(version)})
+	}
+	pkgs = append(pkgs, &model.PkgInputSpec{Type: "deb", Namespace: ptrfrom("debian"), Name: "wget2"}, pkgs[0])
+	got, err := b.IngestPackages(ctx, pkgs)
+	if err != nil {
+		t.Fatalf("IngestPackages() error = %v", err)
+	}
+	// The batch returns the same nodes as ingesting the packages one at a
+	// time, in the order of the input.
+	want := make([]*model.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		p, err := b.IngestPackage(ctx, pkg)
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		want = append(want, p)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("IngestPackages() mismatch (-want +got):\n%s", diff)
+	}
+
+	// The versions share the path to the name.
+	names, err := b.Packages(ctx, &model.PkgSpec{Type: ptrfrom("deb"), Name: ptrfrom("wget")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(names) != 1 || len(names[0].Namespaces) != 1 || len(names[0].Namespaces[0].Names) != 1 {
+		t.Fatalf("Packages() returned %v, want a single path to the name node", names)
+	}
+	if got := len(names[0].Namespaces[0].Names[0].Versions); got != 3 {
+		t.Errorf("Packages() returned %d versions for the name, want 3", got)
+	}
+
+	if got, err := b.IngestPackages(ctx, []*model.PkgInputSpec{}); err != nil || len(got) != 0 {
+		t.Errorf("IngestPackages() of an empty batch = %v, %v, want no packages", got, err)
+	}
+
+	// A missing package rolls back the batch.
+	if _, err := b.IngestPackages(ctx, []*model.PkgInputSpec{{Type: "apk", Name: "busybox"}, nil}); err == nil {
+		t.Errorf("IngestPackages() with a missing package did not return an error")
+	}
+	if got, err := b.Packages(ctx, &model.PkgSpec{Type: ptrfrom("apk")}); err != nil || len(got) != 0 {
+		t.Errorf("Packages() after a failed batch = %v, %v, want no packages", got, err)
+	}
+}
+
+func TestIngestSources(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	sources := []*model.SourceInputSpec{
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1")},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.1.0")},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac-visualizer"},
+		{Type: "GIT", Namespace: " github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1")},
+	}
+	got, err := b.IngestSources(ctx, sources)
+	if err != nil {
+		t.Fatalf("IngestSources() error = %v", err)
+	}
+	want := make([]*model.Source, 0, len(sources))
+	for _, source := range sources {
+		s, err := b.IngestSource(ctx, source)
+		if err != nil {
+			t.Fatalf("IngestSource() error = %v", err)
+		}
+		want = append(want, s)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("IngestSources() mismatch (-want +got):\n%s", diff)
+	}
+
+	// An invalid source rolls back the batch.
+	if _, err := b.IngestSources(ctx, []*model.SourceInputSpec{
+		{Type: "hg", Namespace: "hg.example.com", Name: "repo"},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1"), Commit: ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b")},
+	}); err == nil {
+		t.Errorf("IngestSources() with both tag and commit did not return an error")
+	}
+	if got, err := b.Sources(ctx, &model.SourceSpec{Type: ptrfrom("hg")}); err != nil || len(got) != 0 {
+		t.Errorf("Sources() after a failed batch = %v, %v, want no sources", got, err)
+	}
+}
+
+// ingestTestSBOMs ingests two SBOMs for the same package version and one for
+// an artifact.
+func ingestTestSBOMs(t *testing.T, b backends.Backend) {
+	t.Helper()
+	ctx := context.Background()
+	for _, in := range testSBOMs {
+		if _, err := b.IngestHasSbom(ctx, in.subject, in.sbom); err != nil {
+			t.Fatalf("IngestHasSbom() error = %v", err)
+		}
+	}
+}
+
+var testArtifact = &model.ArtifactInputSpec{
+	Algorithm: "sha256",
+	Digest:    "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6d423d2d0dc0cd7fb856de0d0",
+}
+
+var testSBOMs = []struct {
+	subject *model.PackageOrArtifactInput
+	sbom    *model.HasSBOMInputSpec
+}{{
+	subject: &model.PackageOrArtifactInput{Package: testPackages[5]},
+	sbom: &model.HasSBOMInputSpec{
+		URI:              "https://example.com/foobar.spdx.json",
+		Algorithm:        "sha256",
+		Digest:           "aaaa",
+		DownloadLocation: "https://example.com/foobar.spdx.json",
+		Origin:           "test",
+		Collector:        "file",
+	},
+}, {
+	subject: &model.PackageOrArtifactInput{Package: testPackages[5]},
+	sbom: &model.HasSBOMInputSpec{
+		URI:              "https://example.com/foobar.cdx.json",
+		Algorithm:        "sha256",
+		Digest:           "bbbb",
+		DownloadLocation: "https://example.com/foobar.cdx.json",
+		Origin:           "test",
+		Collector:        "file",
+	},
+}, {
+	subject: &model.PackageOrArtifactInput{Artifact: testArtifact},
+	sbom: &model.HasSBOMInputSpec{
+		URI:              "https://example.com/image.spdx.json",
+		Algorithm:        "sha256",
+		Digest:           "cccc",
+		DownloadLocation: "https://example.com/image.spdx.json",
+		Origin:           "test",
+		Collector:        "oci",
+	},
+}}
+
+func TestHasSBOM(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+	ingestTestSBOMs(t, b)
+
+	foobar := &model.Package{
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "foobar",
+				Versions: []*model.PackageVersion{{Version: "12.3.1"}},
+			}},
+		}},
+	}
+	artifact := &model.Artifact{Algorithm: testArtifact.Algorithm, Digest: testArtifact.Digest}
+	sbom := func(subject model.PackageOrArtifact, i int) *model.HasSbom {
+		in := testSBOMs[i].sbom
+		return &model.HasSbom{
+			Subject:          subject,
+			URI:              in.URI,
+			Algorithm:        in.Algorithm,
+			Digest:           in.Digest,
+			DownloadLocation: in.DownloadLocation,
+			Origin:           in.Origin,
+			Collector:        in.Collector,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.HasSBOMSpec
+		want    []*model.HasSbom
+		wantErr bool
+	}{{
+		name: "nil spec",
+		want: []*model.HasSbom{sbom(foobar, 0), sbom(foobar, 1), sbom(artifact, 2)},
+	}, {
+		name: "package subject",
+		spec: &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{
+			Package: &model.PkgSpec{Name: ptrfrom("foobar")},
+		}},
+		want: []*model.HasSbom{sbom(foobar, 0), sbom(foobar, 1)},
+	}, {
+		name: "package subject without SBOM",
+		spec: &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{
+			Package: &model.PkgSpec{Name: ptrfrom("django")},
+		}},
+	}, {
+		name: "artifact subject",
+		spec: &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{
+			Artifact: &model.ArtifactSpec{Digest: ptrfrom(strings.ToUpper(testArtifact.Digest))},
+		}},
+		want: []*model.HasSbom{sbom(artifact, 2)},
+	}, {
+		name: "uri",
+		spec: &model.HasSBOMSpec{URI: ptrfrom("https://example.com/foobar.cdx.json")},
+		want: []*model.HasSbom{sbom(foobar, 1)},
+	}, {
+		name: "collector and package subject",
+		spec: &model.HasSBOMSpec{
+			Collector: ptrfrom("oci"),
+			Subject:   &model.PackageOrArtifactSpec{Package: &model.PkgSpec{}},
+		},
+	}, {
+		name: "both subjects",
+		spec: &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{
+			Package:  &model.PkgSpec{},
+			Artifact: &model.ArtifactSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HasSBOM(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HasSBOM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("HasSBOM() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestHasSBOM(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+	ingestTestSBOMs(t, b)
+
+	got, err := b.IngestHasSbom(ctx, testSBOMs[0].subject, testSBOMs[0].sbom)
+	if err != nil {
+		t.Fatalf("IngestHasSbom() error = %v", err)
+	}
+	all, err := b.HasSBOM(ctx, nil)
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(all) != len(testSBOMs) {
+		t.Errorf("HasSBOM() returned %d nodes after re-ingestion, want %d", len(all), len(testSBOMs))
+	}
+	if got.ID != all[0].ID {
+		t.Errorf("IngestHasSbom() created node %s, want existing node %s", got.ID, all[0].ID)
+	}
+
+	// The subject is ingested along with the SBOM.
+	newPkg := &model.PkgInputSpec{Type: "npm", Name: "left-pad", Version: ptrfrom("1.3.0")}
+	if _, err := b.IngestHasSbom(ctx, &model.PackageOrArtifactInput{Package: newPkg}, testSBOMs[0].sbom); err != nil {
+		t.Fatalf("IngestHasSbom() error = %v", err)
+	}
+	pkgs, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom("left-pad")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Errorf("Packages() returned %v, want the subject of the SBOM", pkgs)
+	}
+
+	for _, subject := range []*model.PackageOrArtifactInput{
+		nil,
+		{},
+		{Package: newPkg, Artifact: testArtifact},
+	} {
+		if _, err := b.IngestHasSbom(ctx, subject, testSBOMs[0].sbom); err == nil {
+			t.Errorf("IngestHasSbom(%v) did not return an error", subject)
+		}
+	}
+}
+
+func TestFindSBOMsByArtifact(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	file := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "2f2a3e1b9c7d4e5f60718293a4b5c6d7e8f90123456789abcdef0123456789ab"}
+	imageA := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1"}
+	pkg := func(name string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom("1.0.0")}
+	}
+	lib, mid, appA, appB, other := pkg("lib"), pkg("mid"), pkg("app-a"), pkg("app-b"), pkg("other")
+	sbom := func(uri string) *model.HasSBOMInputSpec {
+		return &model.HasSBOMInputSpec{URI: uri, Algorithm: "sha256", Digest: "abcdef", DownloadLocation: uri, Origin: "test", Collector: "test"}
+	}
+	occurrence := &model.IsOccurrenceInputSpec{Justification: "test", Origin: "test", Collector: "test"}
+	dependency := &model.IsDependencyInputSpec{VersionRange: "1.0.0", DependencyType: model.DependencyTypeDirect, Justification: "test", Origin: "test", Collector: "test"}
+
+	// The file is an occurrence of lib, image A ships app-a, and both
+	// app-a and app-b depend on lib, app-b both directly and through mid.
+	// The dependency of lib on app-b makes a cycle.
+	for _, o := range []struct {
+		pkg      *model.PkgInputSpec
+		artifact *model.ArtifactInputSpec
+	}{{lib, file}, {appA, imageA}} {
+		if _, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: o.pkg}, o.artifact, occurrence); err != nil {
+			t.Fatalf("IngestIsOccurrence() error = %v", err)
+		}
+	}
+	for _, d := range [][2]*model.PkgInputSpec{{appA, lib}, {appB, lib}, {appB, mid}, {mid, lib}, {lib, appB}} {
+		if _, err := b.IngestIsDependency(ctx, d[0], d[1], dependency); err != nil {
+			t.Fatalf("IngestIsDependency() error = %v", err)
+		}
+	}
+	for _, s := range []struct {
+		subject *model.PackageOrArtifactInput
+		uri     string
+	}{
+		{&model.PackageOrArtifactInput{Artifact: file}, "file"},
+		{&model.PackageOrArtifactInput{Artifact: imageA}, "image-a"},
+		{&model.PackageOrArtifactInput{Package: appB}, "app-b"},
+		{&model.PackageOrArtifactInput{Package: other}, "other"},
+		{&model.PackageOrArtifactInput{Package: &model.PkgInputSpec{Type: "npm", Name: "app-b", Version: ptrfrom("2.0.0")}}, "app-b-2"},
+	} {
+		if _, err := b.IngestHasSbom(ctx, s.subject, sbom(s.uri)); err != nil {
+			t.Fatalf("IngestHasSbom() error = %v", err)
+		}
+	}
+
+	uris := func(sboms []*model.HasSbom) []string {
+		out := []string{}
+		for _, s := range sboms {
+			out = append(out, s.URI)
+		}
+		sort.Strings(out)
+		return out
+	}
+	tests := []struct {
+		name     string
+		artifact *model.ArtifactInputSpec
+		want     []string
+	}{{
+		name:     "file in two images",
+		artifact: &model.ArtifactInputSpec{Algorithm: file.Algorithm, Digest: strings.ToUpper(file.Digest)},
+		want:     []string{"app-b", "file", "image-a"},
+	}, {
+		name:     "image",
+		artifact: imageA,
+		want:     []string{"image-a"},
+	}, {
+		name:     "unknown artifact",
+		artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "0000"},
+		want:     []string{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.FindSBOMsByArtifact(ctx, tt.artifact.Algorithm, tt.artifact.Digest)
+			if err != nil {
+				t.Fatalf("FindSBOMsByArtifact() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, uris(got)); diff != "" {
+				t.Errorf("FindSBOMsByArtifact() unexpected SBOMs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransitiveDependencies(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	pkg := func(name, version string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom(version)}
+	}
+	dependency := &model.IsDependencyInputSpec{VersionRange: "^1.0.0", DependencyType: model.DependencyTypeDirect, Justification: "test", Origin: "test", Collector: "test"}
+
+	// a, b and c make a cycle, c also depends on d, and the second versions
+	// of a and b depend on x and y, the one of b being outside of the range
+	// of the dependency of a.
+	for _, d := range [][2]*model.PkgInputSpec{
+		{pkg("a", "1.0.0"), pkg("b", "1.0.0")},
+		{pkg("b", "1.0.0"), pkg("c", "1.0.0")},
+		{pkg("c", "1.0.0"), pkg("a", "1.0.0")},
+		{pkg("c", "1.0.0"), pkg("d", "1.0.0")},
+		{pkg("a", "2.0.0"), pkg("x", "1.0.0")},
+		{pkg("b", "2.0.0"), pkg("y", "1.0.0")},
+	} {
+		if _, err := b.IngestIsDependency(ctx, d[0], d[1], dependency); err != nil {
+			t.Fatalf("IngestIsDependency() error = %v", err)
+		}
+	}
+
+	a1 := &model.PkgSpec{Type: ptrfrom("npm"), Name: ptrfrom("a"), Version: ptrfrom("1.0.0")}
+	tests := []struct {
+		name     string
+		pkgSpec  *model.PkgSpec
+		maxDepth int
+		want     []string
+	}{{
+		name:     "direct dependencies",
+		pkgSpec:  a1,
+		maxDepth: 1,
+		want:     []string{"b"},
+	}, {
+		name:     "truncated before the cycle",
+		pkgSpec:  a1,
+		maxDepth: 2,
+		// The version ranges are not interpreted, so the dependencies of
+		// all the versions of b are followed.
+		want: []string{"b", "c", "y"},
+	}, {
+		name:     "back to the root",
+		pkgSpec:  a1,
+		maxDepth: 3,
+		want:     []string{"a", "b", "c", "d", "y"},
+	}, {
+		name:     "dependencies of the other versions of the root",
+		pkgSpec:  a1,
+		maxDepth: 4,
+		want:     []string{"a", "b", "c", "d", "x", "y"},
+	}, {
+		name:     "cycle walked once",
+		pkgSpec:  a1,
+		maxDepth: 100,
+		want:     []string{"a", "b", "c", "d", "x", "y"},
+	}, {
+		name:     "all versions",
+		pkgSpec:  &model.PkgSpec{Name: ptrfrom("a")},
+		maxDepth: 1,
+		want:     []string{"b", "x"},
+	}, {
+		name:     "no dependencies",
+		pkgSpec:  &model.PkgSpec{Name: ptrfrom("d")},
+		maxDepth: 10,
+		want:     []string{},
+	}, {
+		name:     "unknown package",
+		pkgSpec:  &model.PkgSpec{Name: ptrfrom("unknown")},
+		maxDepth: 10,
+		want:     []string{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.TransitiveDependencies(ctx, tt.pkgSpec, tt.maxDepth)
+			if err != nil {
+				t.Fatalf("TransitiveDependencies() error = %v", err)
+			}
+			names := []string{}
+			for _, p := range got {
+				for _, ns := range p.Namespaces {
+					for _, n := range ns.Names {
+						if len(n.Versions) != 0 {
+							t.Errorf("TransitiveDependencies() returned versions of %s", n.Name)
+						}
+						names = append(names, n.Name)
+					}
+				}
+			}
+			sort.Strings(names)
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("TransitiveDependencies() unexpected dependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDependency(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	// A diamond: a depends on b and c, which both depend on d.
+	pkg := func(name string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom("1.0.0")}
+	}
+	edges := []struct {
+		from, to     string
+		versionRange string
+	}{
+		{"a", "b", "^1.0.0"},
+		{"a", "c", "~1.0.0"},
+		{"b", "d", ">=1.0.0 <2.0.0"},
+		{"c", "d", "1.x"},
+	}
+	for _, e := range edges {
+		depType := model.DependencyTypeIndirect
+		if e.from == "a" {
+			depType = model.DependencyTypeDirect
+		}
+		if _, err := b.IngestIsDependency(ctx, pkg(e.from), pkg(e.to), &model.IsDependencyInputSpec{
+			VersionRange:   e.versionRange,
+			DependencyType: depType,
+			Justification:  "test",
+			Origin:         "test",
+			Collector:      "test",
+		}); err != nil {
+			t.Fatalf("IngestIsDependency() error = %v", err)
+		}
+	}
+
+	dependency := func(from, to, versionRange string, depType model.DependencyType) *model.IsDependency {
+		return &model.IsDependency{
+			Package: &model.Package{
+				Type: "npm",
+				Namespaces: []*model.PackageNamespace{{
+					Names: []*model.PackageName{{
+						Name:     from,
+						Versions: []*model.PackageVersion{{Version: "1.0.0"}},
+					}},
+				}},
+			},
+			DependentPackage: &model.Package{
+				Type: "npm",
+				Namespaces: []*model.PackageNamespace{{
+					Names: []*model.PackageName{{Name: to}},
+				}},
+			},
+			VersionRange:   versionRange,
+			DependencyType: depType,
+			Justification:  "test",
+			Origin:         "test",
+			Collector:      "test",
+		}
+	}
+
+	tests := []struct {
+		name string
+		spec *model.IsDependencySpec
+		want []*model.IsDependency
+	}{{
+		name: "dependent package",
+		spec: &model.IsDependencySpec{Package: &model.PkgSpec{Name: ptrfrom("a")}},
+		want: []*model.IsDependency{
+			dependency("a", "b", "^1.0.0", model.DependencyTypeDirect),
+			dependency("a", "c", "~1.0.0", model.DependencyTypeDirect),
+		},
+	}, {
+		name: "both paths to the same dependency",
+		spec: &model.IsDependencySpec{DependentPackage: &model.PkgSpec{Name: ptrfrom("d")}},
+		want: []*model.IsDependency{
+			dependency("b", "d", ">=1.0.0 <2.0.0", model.DependencyTypeIndirect),
+			dependency("c", "d", "1.x", model.DependencyTypeIndirect),
+		},
+	}, {
+		name: "dependent and dependency packages",
+		spec: &model.IsDependencySpec{
+			Package:          &model.PkgSpec{Name: ptrfrom("c")},
+			DependentPackage: &model.PkgSpec{Name: ptrfrom("d")},
+		},
+		want: []*model.IsDependency{
+			dependency("c", "d", "1.x", model.DependencyTypeIndirect),
+		},
+	}, {
+		name: "versions of the dependency are ignored",
+		spec: &model.IsDependencySpec{DependentPackage: &model.PkgSpec{
+			Name:    ptrfrom("b"),
+			Version: ptrfrom("2.0.0"),
+		}},
+		want: []*model.IsDependency{
+			dependency("a", "b", "^1.0.0", model.DependencyTypeDirect),
+		},
+	}, {
+		name: "version range",
+		spec: &model.IsDependencySpec{VersionRange: ptrfrom(">=1.0.0 <2.0.0")},
+		want: []*model.IsDependency{
+			dependency("b", "d", ">=1.0.0 <2.0.0", model.DependencyTypeIndirect),
+		},
+	}, {
+		name: "dependency type",
+		spec: &model.IsDependencySpec{
+			DependencyType:   ptrfrom(model.DependencyTypeDirect),
+			DependentPackage: &model.PkgSpec{Name: ptrfrom("d")},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.IsDependency(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("IsDependency() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("IsDependency() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	all, err := b.IsDependency(ctx, nil)
+	if err != nil {
+		t.Fatalf("IsDependency() error = %v", err)
+	}
+	if len(all) != len(edges) {
+		t.Errorf("IsDependency() returned %d dependencies, want %d", len(all), len(edges))
+	}
+	if _, err := b.IngestIsDependency(ctx, pkg("a"), pkg("b"), &model.IsDependencyInputSpec{
+		VersionRange:   "^1.0.0",
+		DependencyType: "OPTIONAL",
+	}); err == nil {
+		t.Errorf("IngestIsDependency() with invalid dependency type did not return an error")
+	}
+}
+
+func TestIsOccurrence(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	otherArtifact := &model.ArtifactInputSpec{
+		Algorithm: "sha1",
+		Digest:    "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6",
+	}
+	occurrences := []struct {
+		subject  *model.PackageOrSourceInput
+		artifact *model.ArtifactInputSpec
+	}{
+		{&model.PackageOrSourceInput{Package: testPackages[5]}, testArtifact},
+		{&model.PackageOrSourceInput{Source: testSources[0]}, testArtifact},
+		{&model.PackageOrSourceInput{Source: testSources[0]}, otherArtifact},
+	}
+	for _, o := range occurrences {
+		if _, err := b.IngestIsOccurrence(ctx, o.subject, o.artifact, &model.IsOccurrenceInputSpec{
+			Justification: "built from",
+			Origin:        "test",
+			Collector:     "test",
+		}); err != nil {
+			t.Fatalf("IngestIsOccurrence() error = %v", err)
+		}
+	}
+
+	foobar := &model.Package{
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "foobar",
+				Versions: []*model.PackageVersion{{Version: "12.3.1"}},
+			}},
+		}},
+	}
+	guac := &model.Source{
+		Type: "git",
+		Namespaces: []*model.SourceNamespace{{
+			Namespace: "github.com/guacsec",
+			Names:     []*model.SourceName{{Name: "guac", Tag: ptrfrom("v0.0.1")}},
+		}},
+	}
+	occurrence := func(subject model.PackageOrSource, artifact *model.ArtifactInputSpec) *model.IsOccurrence {
+		return &model.IsOccurrence{
+			Subject:       subject,
+			Artifact:      &model.Artifact{Algorithm: artifact.Algorithm, Digest: artifact.Digest},
+			Justification: "built from",
+			Origin:        "test",
+			Collector:     "test",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.IsOccurrenceSpec
+		want    []*model.IsOccurrence
+		wantErr bool
+	}{{
+		name: "nil spec",
+		want: []*model.IsOccurrence{
+			occurrence(foobar, testArtifact),
+			occurrence(guac, testArtifact),
+			occurrence(guac, otherArtifact),
+		},
+	}, {
+		name: "package subject",
+		spec: &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{
+			Package: &model.PkgSpec{Type: ptrfrom("npm")},
+		}},
+		want: []*model.IsOccurrence{occurrence(foobar, testArtifact)},
+	}, {
+		name: "source subject",
+		spec: &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{
+			Source: &model.SourceSpec{Name: ptrfrom("guac")},
+		}},
+		want: []*model.IsOccurrence{
+			occurrence(guac, testArtifact),
+			occurrence(guac, otherArtifact),
+		},
+	}, {
+		name: "artifact",
+		spec: &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{Algorithm: ptrfrom("SHA1")}},
+		want: []*model.IsOccurrence{occurrence(guac, otherArtifact)},
+	}, {
+		name: "source subject and artifact",
+		spec: &model.IsOccurrenceSpec{
+			Subject:  &model.PackageOrSourceSpec{Source: &model.SourceSpec{}},
+			Artifact: &model.ArtifactSpec{Digest: ptrfrom(testArtifact.Digest)},
+		},
+		want: []*model.IsOccurrence{occurrence(guac, testArtifact)},
+	}, {
+		name: "both subjects",
+		spec: &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{
+			Package: &model.PkgSpec{},
+			Source:  &model.SourceSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.IsOccurrence(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsOccurrence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("IsOccurrence() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestIsOccurrence(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	subject := &model.PackageOrSourceInput{Package: testPackages[5]}
+	first, err := b.IngestIsOccurrence(ctx, subject, testArtifact, &model.IsOccurrenceInputSpec{
+		Justification: "built from",
+		Origin:        "first",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("IngestIsOccurrence() error = %v", err)
+	}
+
+	// Only the subject, the artifact and the justification identify the
+	// occurrence.
+	second, err := b.IngestIsOccurrence(ctx, subject, testArtifact, &model.IsOccurrenceInputSpec{
+		Justification: "built from",
+		Origin:        "second",
+		Collector:     "other",
+	})
+	if err != nil {
+		t.Fatalf("IngestIsOccurrence() error = %v", err)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("IngestIsOccurrence() did not return the existing node (-want +got):\n%s", diff)
+	}
+	third, err := b.IngestIsOccurrence(ctx, subject, testArtifact, &model.IsOccurrenceInputSpec{
+		Justification: "downloaded from",
+		Origin:        "first",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("IngestIsOccurrence() error = %v", err)
+	}
+	if third.ID == first.ID {
+		t.Errorf("IngestIsOccurrence() with a different justification returned the existing node %s", first.ID)
+	}
+
+	for _, subject := range []*model.PackageOrSourceInput{
+		nil,
+		{},
+		{Package: testPackages[5], Source: testSources[0]},
+	} {
+		if _, err := b.IngestIsOccurrence(ctx, subject, testArtifact, &model.IsOccurrenceInputSpec{}); err == nil {
+			t.Errorf("IngestIsOccurrence(%v) did not return an error", subject)
+		}
+	}
+}
+
+func TestCertifyLegal(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2023, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	legal := func(d int, declared, discovered string) *model.CertifyLegalInputSpec {
+		return &model.CertifyLegalInputSpec{
+			DeclaredLicense:   declared,
+			DiscoveredLicense: discovered,
+			Attribution:       "Copyright (c) 2023 The GUAC Authors",
+			Justification:     "scanned",
+			TimeScanned:       day(d),
+			Origin:            "test",
+			Collector:         "test",
+		}
+	}
+	pkgSubject := &model.PackageOrSourceInput{Package: testPackages[5]}
+	srcSubject := &model.PackageOrSourceInput{Source: testSources[0]}
+	scans := []struct {
+		subject *model.PackageOrSourceInput
+		legal   *model.CertifyLegalInputSpec
+	}{
+		{pkgSubject, legal(1, "MIT", "MIT")},
+		{pkgSubject, legal(2, "MIT", "MIT AND Apache-2.0")},
+		{srcSubject, legal(1, "Apache-2.0", "Apache-2.0")},
+		// Ingesting the same scan again is a no-op.
+		{pkgSubject, legal(1, "MIT", "MIT")},
+	}
+	for _, s := range scans {
+		if _, err := b.IngestCertifyLegal(ctx, s.subject, s.legal); err != nil {
+			t.Fatalf("IngestCertifyLegal() error = %v", err)
+		}
+	}
+
+	foobar := &model.Package{
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "foobar",
+				Versions: []*model.PackageVersion{{Version: "12.3.1"}},
+			}},
+		}},
+	}
+	guac := &model.Source{
+		Type: "git",
+		Namespaces: []*model.SourceNamespace{{
+			Namespace: "github.com/guacsec",
+			Names:     []*model.SourceName{{Name: "guac", Tag: ptrfrom("v0.0.1")}},
+		}},
+	}
+	certified := func(subject model.PackageOrSource, l *model.CertifyLegalInputSpec) *model.CertifyLegal {
+		return &model.CertifyLegal{
+			Subject:           subject,
+			DeclaredLicense:   l.DeclaredLicense,
+			DiscoveredLicense: l.DiscoveredLicense,
+			Attribution:       l.Attribution,
+			Justification:     l.Justification,
+			TimeScanned:       l.TimeScanned,
+			Origin:            l.Origin,
+			Collector:         l.Collector,
+		}
+	}
+	first := certified(foobar, legal(1, "MIT", "MIT"))
+	second := certified(foobar, legal(2, "MIT", "MIT AND Apache-2.0"))
+	source := certified(guac, legal(1, "Apache-2.0", "Apache-2.0"))
+
+	tests := []struct {
+		name    string
+		spec    *model.CertifyLegalSpec
+		want    []*model.CertifyLegal
+		wantErr bool
+	}{{
+		name: "nil spec",
+		want: []*model.CertifyLegal{first, second, source},
+	}, {
+		name: "package subject",
+		spec: &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{
+			Package: &model.PkgSpec{Name: ptrfrom("foobar")},
+		}},
+		want: []*model.CertifyLegal{first, second},
+	}, {
+		name: "source subject",
+		spec: &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Source: &model.SourceSpec{}}},
+		want: []*model.CertifyLegal{source},
+	}, {
+		name: "declared license",
+		spec: &model.CertifyLegalSpec{DeclaredLicense: ptrfrom("MIT")},
+		want: []*model.CertifyLegal{first, second},
+	}, {
+		name: "discovered license",
+		spec: &model.CertifyLegalSpec{DiscoveredLicense: ptrfrom("MIT AND Apache-2.0")},
+		want: []*model.CertifyLegal{second},
+	}, {
+		name: "time scanned",
+		spec: &model.CertifyLegalSpec{TimeScanned: ptrfrom(day(1))},
+		want: []*model.CertifyLegal{first, source},
+	}, {
+		name: "no match",
+		spec: &model.CertifyLegalSpec{Collector: ptrfrom("other")},
+	}, {
+		name: "both subjects",
+		spec: &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{
+			Package: &model.PkgSpec{},
+			Source:  &model.SourceSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyLegal(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CertifyLegal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("CertifyLegal() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	for _, subject := range []*model.PackageOrSourceInput{
+		{},
+		{Package: testPackages[5], Source: testSources[0]},
+	} {
+		if _, err := b.IngestCertifyLegal(ctx, subject, legal(1, "MIT", "")); err == nil {
+			t.Errorf("IngestCertifyLegal(%v) did not return an error", subject)
+		}
+	}
+}
+
+func TestIngestVulnerability(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	for _, v := range []*model.VulnerabilityInputSpec{
+		{Type: "cve", VulnerabilityID: "CVE-2023-1234"},
+		{Type: "CVE", VulnerabilityID: "cve-2023-1234"},
+		{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"},
+	} {
+		if _, err := b.IngestVulnerability(ctx, v); err != nil {
+			t.Fatalf("IngestVulnerability() error = %v", err)
+		}
+	}
+	if _, err := b.IngestVulnerability(ctx, &model.VulnerabilityInputSpec{Type: "cve"}); err == nil {
+		t.Errorf("IngestVulnerability() without vulnerability ID did not return an error")
+	}
+
+	tests := []struct {
+		name string
+		spec *model.VulnerabilitySpec
+		want []*model.Vulnerability
+	}{{
+		name: "nil spec",
+		want: []*model.Vulnerability{{
+			Type:             "cve",
+			VulnerabilityIDs: []*model.VulnerabilityID{{VulnerabilityID: "cve-2023-1234"}},
+		}, {
+			Type:             "ghsa",
+			VulnerabilityIDs: []*model.VulnerabilityID{{VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}},
+		}},
+	}, {
+		name: "vulnerability ID",
+		spec: &model.VulnerabilitySpec{VulnerabilityID: ptrfrom("CVE-2023-1234")},
+		want: []*model.Vulnerability{{
+			Type:             "cve",
+			VulnerabilityIDs: []*model.VulnerabilityID{{VulnerabilityID: "cve-2023-1234"}},
+		}},
+	}, {
+		name: "no match",
+		spec: &model.VulnerabilitySpec{Type: ptrfrom("osv")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Vulnerabilities(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("Vulnerabilities() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Vulnerabilities() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyVuln(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2023, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	scan := func(d int, dbVersion string) *model.ScanMetadataInput {
+		return &model.ScanMetadataInput{
+			TimeScanned:    day(d),
+			DbURI:          "https://osv.dev",
+			DbVersion:      dbVersion,
+			ScannerURI:     "osv-scanner",
+			ScannerVersion: "1.0.0",
+			Origin:         "test",
+			Collector:      "test",
+		}
+	}
+	cve := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"}
+	ghsa := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"}
+	scans := []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+		scan *model.ScanMetadataInput
+	}{
+		{testPackages[5], cve, scan(1, "1")},
+		// Re-scanning with a newer database keeps the previous scan.
+		{testPackages[5], cve, scan(10, "2")},
+		{testPackages[6], ghsa, scan(20, "2")},
+		// Ingesting the same scan twice is a no-op.
+		{testPackages[6], ghsa, scan(20, "2")},
+	}
+	for _, s := range scans {
+		if _, err := b.IngestCertifyVuln(ctx, s.pkg, s.vuln, s.scan); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+
+	foobar := &model.Package{
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "foobar",
+				Versions: []*model.PackageVersion{{Version: "12.3.1"}},
+			}},
+		}},
+	}
+	django := &model.Package{
+		Type: "pypi",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "django",
+				Versions: []*model.PackageVersion{{Version: "1.11.1"}},
+			}},
+		}},
+	}
+	certification := func(pkg *model.Package, vulnType, vulnID string, d int, dbVersion string) *model.CertifyVuln {
+		s := scan(d, dbVersion)
+		return &model.CertifyVuln{
+			Package: pkg,
+			Vulnerability: &model.Vulnerability{
+				Type:             vulnType,
+				VulnerabilityIDs: []*model.VulnerabilityID{{VulnerabilityID: vulnID}},
+			},
+			Metadata: &model.ScanMetadata{
+				TimeScanned:    s.TimeScanned,
+				DbURI:          s.DbURI,
+				DbVersion:      s.DbVersion,
+				ScannerURI:     s.ScannerURI,
+				ScannerVersion: s.ScannerVersion,
+				Origin:         s.Origin,
+				Collector:      s.Collector,
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		spec *model.CertifyVulnSpec
+		want []*model.CertifyVuln
+	}{{
+		name: "nil spec",
+		want: []*model.CertifyVuln{
+			certification(foobar, "cve", "cve-2023-1234", 1, "1"),
+			certification(foobar, "cve", "cve-2023-1234", 10, "2"),
+			certification(django, "ghsa", "ghsa-h45f-rjvw-2rv2", 20, "2"),
+		},
+	}, {
+		name: "package",
+		spec: &model.CertifyVulnSpec{Package: &model.PkgSpec{Name: ptrfrom("django")}},
+		want: []*model.CertifyVuln{
+			certification(django, "ghsa", "ghsa-h45f-rjvw-2rv2", 20, "2"),
+		},
+	}, {
+		name: "vulnerability ID",
+		spec: &model.CertifyVulnSpec{Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: ptrfrom("CVE-2023-1234")}},
+		want: []*model.CertifyVuln{
+			certification(foobar, "cve", "cve-2023-1234", 1, "1"),
+			certification(foobar, "cve", "cve-2023-1234", 10, "2"),
+		},
+	}, {
+		name: "time window",
+		spec: &model.CertifyVulnSpec{
+			TimeScannedSince: ptrfrom(day(10)),
+			TimeScannedUntil: ptrfrom(day(20)),
+		},
+		want: []*model.CertifyVuln{
+			certification(foobar, "cve", "cve-2023-1234", 10, "2"),
+			certification(django, "ghsa", "ghsa-h45f-rjvw-2rv2", 20, "2"),
+		},
+	}, {
+		name: "scanned since",
+		spec: &model.CertifyVulnSpec{TimeScannedSince: ptrfrom(day(11))},
+		want: []*model.CertifyVuln{
+			certification(django, "ghsa", "ghsa-h45f-rjvw-2rv2", 20, "2"),
+		},
+	}, {
+		name: "database version",
+		spec: &model.CertifyVulnSpec{
+			Package:   &model.PkgSpec{Name: ptrfrom("foobar")},
+			DbVersion: ptrfrom("1"),
+		},
+		want: []*model.CertifyVuln{
+			certification(foobar, "cve", "cve-2023-1234", 1, "1"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("CertifyVuln() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnLatestOnly(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2023, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	scan := func(d int, dbVersion string) *model.ScanMetadataInput {
+		return &model.ScanMetadataInput{
+			TimeScanned:    day(d),
+			DbURI:          "https://osv.dev",
+			DbVersion:      dbVersion,
+			ScannerURI:     "osv-scanner",
+			ScannerVersion: "1.0.0",
+			Origin:         "test",
+			Collector:      "test",
+		}
+	}
+	cve := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"}
+	otherCVE := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-5678"}
+	ghsa := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"}
+	scans := []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+		scan *model.ScanMetadataInput
+	}{
+		// Three historical scans finding different vulnerabilities, not
+		// ingested in the order they were made.
+		{testPackages[5], cve, scan(1, "1")},
+		{testPackages[5], ghsa, scan(20, "3")},
+		{testPackages[5], otherCVE, scan(10, "2")},
+		// An older scan, and a scan finding two vulnerabilities.
+		{testPackages[6], otherCVE, scan(2, "1")},
+		{testPackages[6], cve, scan(5, "2")},
+		{testPackages[6], ghsa, scan(5, "2")},
+	}
+	for _, s := range scans {
+		if _, err := b.IngestCertifyVuln(ctx, s.pkg, s.vuln, s.scan); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+
+	type certification struct {
+		pkg, vulnID string
+		day         int
+		dbVersion   string
+	}
+	tests := []struct {
+		name string
+		spec *model.CertifyVulnSpec
+		want []certification
+	}{{
+		name: "latest only",
+		spec: &model.CertifyVulnSpec{LatestOnly: ptrfrom(true)},
+		want: []certification{
+			{"foobar", "ghsa-h45f-rjvw-2rv2", 20, "3"},
+			{"django", "cve-2023-1234", 5, "2"},
+			{"django", "ghsa-h45f-rjvw-2rv2", 5, "2"},
+		},
+	}, {
+		name: "latest only of a package",
+		spec: &model.CertifyVulnSpec{
+			Package:    &model.PkgSpec{Name: ptrfrom("foobar")},
+			LatestOnly: ptrfrom(true),
+		},
+		want: []certification{
+			{"foobar", "ghsa-h45f-rjvw-2rv2", 20, "3"},
+		},
+	}, {
+		name: "latest only of a package with a scan finding two vulnerabilities",
+		spec: &model.CertifyVulnSpec{
+			Package:    &model.PkgSpec{Name: ptrfrom("django")},
+			LatestOnly: ptrfrom(true),
+		},
+		want: []certification{
+			{"django", "cve-2023-1234", 5, "2"},
+			{"django", "ghsa-h45f-rjvw-2rv2", 5, "2"},
+		},
+	}, {
+		name: "latest only among matching",
+		spec: &model.CertifyVulnSpec{
+			Package:          &model.PkgSpec{Name: ptrfrom("foobar")},
+			TimeScannedUntil: ptrfrom(day(15)),
+			LatestOnly:       ptrfrom(true),
+		},
+		want: []certification{
+			{"foobar", "cve-2023-5678", 10, "2"},
+		},
+	}, {
+		name: "latest only of a vulnerability",
+		spec: &model.CertifyVulnSpec{
+			Package:       &model.PkgSpec{Name: ptrfrom("foobar")},
+			Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom("cve")},
+			LatestOnly:    ptrfrom(true),
+		},
+		want: []certification{
+			{"foobar", "cve-2023-5678", 10, "2"},
+		},
+	}, {
+		name: "all scans",
+		spec: &model.CertifyVulnSpec{
+			Package:    &model.PkgSpec{Name: ptrfrom("foobar")},
+			LatestOnly: ptrfrom(false),
+		},
+		want: []certification{
+			{"foobar", "cve-2023-1234", 1, "1"},
+			{"foobar", "ghsa-h45f-rjvw-2rv2", 20, "3"},
+			{"foobar", "cve-2023-5678", 10, "2"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certifications, err := b.CertifyVuln(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			var got []certification
+			for _, cv := range certifications {
+				got = append(got, certification{
+					pkg:       cv.Package.Namespaces[0].Names[0].Name,
+					vulnID:    cv.Vulnerability.VulnerabilityIDs[0].VulnerabilityID,
+					day:       cv.Metadata.TimeScanned.Day(),
+					dbVersion: cv.Metadata.DbVersion,
+				})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(certification{})); diff != "" {
+				t.Errorf("CertifyVuln() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyVEXStatement(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2023, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	statement := func(d int, status model.VexStatus, justification model.VexJustification) *model.VexStatementInputSpec {
+		return &model.VexStatementInputSpec{
+			Status:           status,
+			VexJustification: justification,
+			Statement:        "statement " + string(status),
+			KnownSince:       day(d),
+			Origin:           "test",
+			Collector:        "test",
+		}
+	}
+	cve := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"}
+	ghsa := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"}
+	foobarInput := &model.PackageOrArtifactInput{Package: testPackages[5]}
+	artifactInput := &model.PackageOrArtifactInput{Artifact: testArtifact}
+	statements := []struct {
+		subject   *model.PackageOrArtifactInput
+		vuln      *model.VulnerabilityInputSpec
+		statement *model.VexStatementInputSpec
+	}{
+		{foobarInput, cve, statement(1, model.VexStatusUnderInvestigation, model.VexJustificationNotProvided)},
+		// A new status for the same subject and vulnerability keeps the
+		// previous statement.
+		{foobarInput, cve, statement(10, model.VexStatusNotAffected, model.VexJustificationVulnerableCodeNotInExecutePath)},
+		{artifactInput, ghsa, statement(20, model.VexStatusAffected, model.VexJustificationNotProvided)},
+		// Ingesting the same statement twice is a no-op.
+		{artifactInput, ghsa, statement(20, model.VexStatusAffected, model.VexJustificationNotProvided)},
+	}
+	for _, s := range statements {
+		if _, err := b.IngestVEXStatement(ctx, s.subject, s.vuln, s.statement); err != nil {
+			t.Fatalf("IngestVEXStatement() error = %v", err)
+		}
+	}
+
+	foobar := &model.Package{
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name:     "foobar",
+				Versions: []*model.PackageVersion{{Version: "12.3.1"}},
+			}},
+		}},
+	}
+	artifact := &model.Artifact{Algorithm: testArtifact.Algorithm, Digest: testArtifact.Digest}
+	vex := func(subject model.PackageOrArtifact, vulnType, vulnID string, s *model.VexStatementInputSpec) *model.CertifyVEXStatement {
+		return &model.CertifyVEXStatement{
+			Subject: subject,
+			Vulnerability: &model.Vulnerability{
+				Type:             vulnType,
+				VulnerabilityIDs: []*model.VulnerabilityID{{VulnerabilityID: vulnID}},
+			},
+			Status:           s.Status,
+			VexJustification: s.VexJustification,
+			Statement:        s.Statement,
+			KnownSince:       s.KnownSince,
+			Origin:           s.Origin,
+			Collector:        s.Collector,
+		}
+	}
+	investigated := vex(foobar, "cve", "cve-2023-1234", statements[0].statement)
+	notAffected := vex(foobar, "cve", "cve-2023-1234", statements[1].statement)
+	affected := vex(artifact, "ghsa", "ghsa-h45f-rjvw-2rv2", statements[2].statement)
+
+	tests := []struct {
+		name string
+		spec *model.CertifyVEXStatementSpec
+		want []*model.CertifyVEXStatement
+	}{{
+		name: "nil spec",
+		want: []*model.CertifyVEXStatement{investigated, notAffected, affected},
+	}, {
+		name: "package subject",
+		spec: &model.CertifyVEXStatementSpec{Subject: &model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom("foobar")}}},
+		want: []*model.CertifyVEXStatement{investigated, notAffected},
+	}, {
+		name: "artifact subject",
+		spec: &model.CertifyVEXStatementSpec{Subject: &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{}}},
+		want: []*model.CertifyVEXStatement{affected},
+	}, {
+		name: "vulnerability",
+		spec: &model.CertifyVEXStatementSpec{Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom("GHSA")}},
+		want: []*model.CertifyVEXStatement{affected},
+	}, {
+		name: "status",
+		spec: &model.CertifyVEXStatementSpec{Status: ptrfrom(model.VexStatusNotAffected)},
+		want: []*model.CertifyVEXStatement{notAffected},
+	}, {
+		name: "justification",
+		spec: &model.CertifyVEXStatementSpec{VexJustification: ptrfrom(model.VexJustificationNotProvided)},
+		want: []*model.CertifyVEXStatement{investigated, affected},
+	}, {
+		name: "time window",
+		spec: &model.CertifyVEXStatementSpec{
+			KnownSinceFrom:  ptrfrom(day(10)),
+			KnownSinceUntil: ptrfrom(day(20)),
+		},
+		want: []*model.CertifyVEXStatement{notAffected, affected},
+	}, {
+		name: "known until",
+		spec: &model.CertifyVEXStatementSpec{KnownSinceUntil: ptrfrom(day(9))},
+		want: []*model.CertifyVEXStatement{investigated},
+	}, {
+		name: "no match",
+		spec: &model.CertifyVEXStatementSpec{Status: ptrfrom(model.VexStatusFixed)},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyVEXStatement(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyVEXStatement() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("CertifyVEXStatement() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name      string
+		subject   *model.PackageOrArtifactInput
+		statement *model.VexStatementInputSpec
+	}{
+		{"no subject", &model.PackageOrArtifactInput{}, statement(1, model.VexStatusAffected, model.VexJustificationNotProvided)},
+		{"both subjects", &model.PackageOrArtifactInput{Package: testPackages[5], Artifact: testArtifact},
+			statement(1, model.VexStatusAffected, model.VexJustificationNotProvided)},
+		{"unjustified not affected", foobarInput, &model.VexStatementInputSpec{Status: model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationNotProvided, KnownSince: day(1)}},
+		{"invalid status", foobarInput, statement(1, model.VexStatus("UNKNOWN"), model.VexJustificationNotProvided)},
+	}
+	for _, tt := range invalid {
+		if _, err := b.IngestVEXStatement(ctx, tt.subject, cve, tt.statement); err == nil {
+			t.Errorf("IngestVEXStatement() with %s did not return an error", tt.name)
+		}
+	}
+	if _, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{Subject: &model.PackageOrArtifactSpec{
+		Package:  &model.PkgSpec{},
+		Artifact: &model.ArtifactSpec{},
+	}}); err == nil {
+		t.Errorf("CertifyVEXStatement() with both subjects did not return an error")
+	}
+}
+
+func TestScorecards(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	day := func(d int) time.Time {
+		return time.Date(2023, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	scorecard := func(score float64, d int, commit string) *model.ScorecardInputSpec {
+		return &model.ScorecardInputSpec{
+			Checks: []*model.ScorecardCheckInputSpec{
+				{Check: "Pinned-Dependencies", Score: int(score)},
+				{Check: "Binary-Artifacts", Score: 10},
+			},
+			AggregateScore:   score,
+			TimeScanned:      day(d),
+			ScorecardVersion: "v4.10.2",
+			Commit:           commit,
+			Origin:           "test",
+			Collector:        "test",
+		}
+	}
+	guac := testSources[2]
+	guacdata := testSources[3]
+	runs := []struct {
+		source    *model.SourceInputSpec
+		scorecard *model.ScorecardInputSpec
+	}{
+		{guac, scorecard(5.5, 1, "abc")},
+		// Same commit, new run.
+		{guac, scorecard(6.5, 2, "abc")},
+		{guac, scorecard(8, 3, "def")},
+		{guacdata, scorecard(4, 2, "123")},
+		// Same commit and time, ingested twice.
+		{guacdata, scorecard(4, 2, "123")},
+	}
+	for _, r := range runs {
+		if _, err := b.IngestScorecard(ctx, r.source, r.scorecard); err != nil {
+			t.Fatalf("IngestScorecard() error = %v", err)
+		}
+	}
+
+	certification := func(source *model.SourceInputSpec, score float64, d int, commit string) *model.CertifyScorecard {
+		in := scorecard(score, d, commit)
+		return &model.CertifyScorecard{
+			Source: &model.Source{
+				Type: source.Type,
+				Namespaces: []*model.SourceNamespace{{
+					Namespace: source.Namespace,
+					Names:     []*model.SourceName{{Name: source.Name, Tag: source.Tag, Commit: source.Commit}},
+				}},
+			},
+			Scorecard: &model.Scorecard{
+				Checks: []*model.ScorecardCheck{
+					{Check: "Binary-Artifacts", Score: 10},
+					{Check: "Pinned-Dependencies", Score: int(score)},
+				},
+				AggregateScore:   in.AggregateScore,
+				TimeScanned:      in.TimeScanned,
+				ScorecardVersion: in.ScorecardVersion,
+				Commit:           in.Commit,
+				Origin:           in.Origin,
+				Collector:        in.Collector,
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		spec *model.CertifyScorecardSpec
+		want []*model.CertifyScorecard
+	}{{
+		name: "nil spec",
+		want: []*model.CertifyScorecard{
+			certification(guac, 5.5, 1, "abc"),
+			certification(guac, 6.5, 2, "abc"),
+			certification(guac, 8, 3, "def"),
+			certification(guacdata, 4, 2, "123"),
+		},
+	}, {
+		name: "source name",
+		spec: &model.CertifyScorecardSpec{Source: &model.SourceSpec{Name: ptrfrom("guacdata")}},
+		want: []*model.CertifyScorecard{
+			certification(guacdata, 4, 2, "123"),
+		},
+	}, {
+		name: "source namespace",
+		spec: &model.CertifyScorecardSpec{Source: &model.SourceSpec{Namespace: ptrfrom("github.com/guacsec")}},
+		want: []*model.CertifyScorecard{
+			certification(guac, 5.5, 1, "abc"),
+			certification(guac, 6.5, 2, "abc"),
+			certification(guac, 8, 3, "def"),
+		},
+	}, {
+		name: "min aggregate score",
+		spec: &model.CertifyScorecardSpec{MinAggregateScore: ptrfrom(6.5)},
+		want: []*model.CertifyScorecard{
+			certification(guac, 6.5, 2, "abc"),
+			certification(guac, 8, 3, "def"),
+		},
+	}, {
+		name: "latest only",
+		spec: &model.CertifyScorecardSpec{LatestOnly: ptrfrom(true)},
+		want: []*model.CertifyScorecard{
+			certification(guac, 8, 3, "def"),
+			certification(guacdata, 4, 2, "123"),
+		},
+	}, {
+		name: "latest only among matching",
+		spec: &model.CertifyScorecardSpec{Commit: ptrfrom("abc"), LatestOnly: ptrfrom(true)},
+		want: []*model.CertifyScorecard{
+			certification(guac, 6.5, 2, "abc"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Scorecards(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("Scorecards() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Scorecards() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHashEqual(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	sha256 := testArtifact
+	sha1 := &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f05fa42a6"}
+	md5 := &model.ArtifactInputSpec{Algorithm: "md5", Digest: "ccc8b0bc3e9e1d7d1e1e4fa90a5b7e6f"}
+	hashEqual := &model.HashEqualInputSpec{Justification: "same file", Origin: "test", Collector: "test"}
+
+	first, err := b.IngestHashEqual(ctx, sha256, sha1, hashEqual)
+	if err != nil {
+		t.Fatalf("IngestHashEqual() error = %v", err)
+	}
+	// The relation is symmetric, so this is the same node.
+	second, err := b.IngestHashEqual(ctx, sha1, sha256, hashEqual)
+	if err != nil {
+		t.Fatalf("IngestHashEqual() error = %v", err)
+	}
+	if first.ID != second.ID {
+		t.Errorf("IngestHashEqual() with swapped artifacts created node %s, want existing node %s", second.ID, first.ID)
+	}
+	if _, err := b.IngestHashEqual(ctx, sha1, md5, hashEqual); err != nil {
+		t.Fatalf("IngestHashEqual() error = %v", err)
+	}
+	if _, err := b.IngestHashEqual(ctx, sha1, sha1, hashEqual); err == nil {
+		t.Errorf("IngestHashEqual() of an artifact with itself did not return an error")
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.HashEqualSpec
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 2,
+	}, {
+		name:    "first artifact",
+		spec:    &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{Algorithm: ptrfrom("sha256")}}},
+		wantIDs: 1,
+	}, {
+		name:    "second artifact",
+		spec:    &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{Digest: ptrfrom(sha1.Digest)}}},
+		wantIDs: 2,
+	}, {
+		name: "both artifacts in any order",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{
+			{Algorithm: ptrfrom("sha1")},
+			{Algorithm: ptrfrom("sha256")},
+		}},
+		wantIDs: 1,
+	}, {
+		name: "same filter for both artifacts",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{
+			{Algorithm: ptrfrom("sha1")},
+			{Algorithm: ptrfrom("sha1")},
+		}},
+	}, {
+		name:    "too many artifacts",
+		spec:    &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{}, {}, {}}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HashEqual(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HashEqual() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("HashEqual() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+		})
+	}
+
+	// Querying by either artifact returns the same node.
+	for _, a := range []*model.ArtifactInputSpec{sha256, sha1} {
+		got, err := b.HashEqual(ctx, &model.HashEqualSpec{
+			Artifacts:     []*model.ArtifactSpec{{Algorithm: &a.Algorithm, Digest: &a.Digest}},
+			Justification: ptrfrom("same file"),
+		})
+		if err != nil {
+			t.Fatalf("HashEqual() error = %v", err)
+		}
+		var found bool
+		for _, h := range got {
+			if h.ID == first.ID {
+				found = true
+				if diff := cmp.Diff(first, h, cmpopts.SortSlices(func(x, y *model.Artifact) bool { return x.ID < y.ID })); diff != "" {
+					t.Errorf("HashEqual() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("HashEqual() querying by %s did not return node %s", a.Algorithm, first.ID)
+		}
+	}
+}
+
+func TestHashEqualExpandTransitive(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	artifact := func(name string) *model.ArtifactInputSpec {
+		return &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat(name, 64)}
+	}
+	// The chain a-b-c-d, with d-b closing a cycle, and e-f apart from it.
+	links := []struct {
+		artifact, equalArtifact, justification string
+	}{
+		{"a", "b", "same file"},
+		{"b", "c", "same file"},
+		{"c", "d", "same file"},
+		{"d", "b", "same file"},
+		{"e", "f", "same file"},
+		{"d", "e", "other"},
+	}
+	for _, l := range links {
+		if _, err := b.IngestHashEqual(ctx, artifact(l.artifact), artifact(l.equalArtifact), &model.HashEqualInputSpec{
+			Justification: l.justification, Origin: "test", Collector: "test",
+		}); err != nil {
+			t.Fatalf("IngestHashEqual() error = %v", err)
+		}
+	}
+	// pairs returns every equality as the first letters of the digests of
+	// its artifacts, in a stable order.
+	pairs := func(equalities []*model.HashEqual) []string {
+		var out []string
+		for _, e := range equalities {
+			names := []string{e.Artifacts[0].Digest[:1], e.Artifacts[1].Digest[:1]}
+			sort.Strings(names)
+			out = append(out, strings.Join(names, "-"))
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	a := &model.ArtifactSpec{Digest: &artifact("a").Digest}
+	tests := []struct {
+		name string
+		spec *model.HashEqualSpec
+		want []string
+	}{{
+		name: "direct only",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{a}},
+		want: []string{"a-b"},
+	}, {
+		name: "not expanded",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{a}, ExpandTransitive: ptrfrom(false)},
+		want: []string{"a-b"},
+	}, {
+		name: "expanded",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{a}, ExpandTransitive: ptrfrom(true)},
+		want: []string{"a-b", "b-c", "b-d", "c-d", "d-e", "e-f"},
+	}, {
+		name: "expanded on the matching justification",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{a}, Justification: ptrfrom("same file"), ExpandTransitive: ptrfrom(true)},
+		want: []string{"a-b", "b-c", "b-d", "c-d"},
+	}, {
+		name: "expanded from the middle of the chain",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{Digest: &artifact("c").Digest}}, Justification: ptrfrom("same file"), ExpandTransitive: ptrfrom(true)},
+		want: []string{"a-b", "b-c", "b-d", "c-d"},
+	}, {
+		name: "expanded without a match",
+		spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{Digest: &artifact("0").Digest}}, ExpandTransitive: ptrfrom(true)},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HashEqual(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("HashEqual() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, pairs(got)); diff != "" {
+				t.Errorf("HashEqual() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVulnEqual(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	cve := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"}
+	ghsa := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"}
+	osv := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "PYSEC-2023-42"}
+	other := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2016-8615"}
+	vulnEqual := &model.VulnEqualInputSpec{Justification: "same advisory", Origin: "test", Collector: "test"}
+
+	first, err := b.IngestVulnEqual(ctx, cve, ghsa, vulnEqual)
+	if err != nil {
+		t.Fatalf("IngestVulnEqual() error = %v", err)
+	}
+	// The relation is symmetric, so this is the same node.
+	second, err := b.IngestVulnEqual(ctx, ghsa, cve, vulnEqual)
+	if err != nil {
+		t.Fatalf("IngestVulnEqual() error = %v", err)
+	}
+	if first.ID != second.ID {
+		t.Errorf("IngestVulnEqual() with swapped vulnerabilities created node %s, want existing node %s", second.ID, first.ID)
+	}
+	if _, err := b.IngestVulnEqual(ctx, osv, ghsa, vulnEqual); err != nil {
+		t.Fatalf("IngestVulnEqual() error = %v", err)
+	}
+	if _, err := b.IngestVulnEqual(ctx, cve, &model.VulnerabilityInputSpec{Type: "CVE", VulnerabilityID: "cve-2023-1234"}, vulnEqual); err == nil {
+		t.Errorf("IngestVulnEqual() of a vulnerability with itself did not return an error")
+	}
+	if _, err := b.IngestVulnEqual(ctx, cve, &model.VulnerabilityInputSpec{Type: "ghsa"}, vulnEqual); err == nil {
+		t.Errorf("IngestVulnEqual() of an invalid vulnerability did not return an error")
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.VulnEqualSpec
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 2,
+	}, {
+		name:    "first vulnerability",
+		spec:    &model.VulnEqualSpec{Vulnerabilities: []*model.VulnerabilitySpec{{Type: ptrfrom("CVE")}}},
+		wantIDs: 1,
+	}, {
+		name:    "shared vulnerability",
+		spec:    &model.VulnEqualSpec{Vulnerabilities: []*model.VulnerabilitySpec{{VulnerabilityID: ptrfrom(ghsa.VulnerabilityID)}}},
+		wantIDs: 2,
+	}, {
+		name: "both vulnerabilities in any order",
+		spec: &model.VulnEqualSpec{Vulnerabilities: []*model.VulnerabilitySpec{
+			{Type: ptrfrom("ghsa")},
+			{Type: ptrfrom("osv")},
+		}},
+		wantIDs: 1,
+	}, {
+		name: "not directly equal",
+		spec: &model.VulnEqualSpec{Vulnerabilities: []*model.VulnerabilitySpec{
+			{Type: ptrfrom("cve")},
+			{Type: ptrfrom("osv")},
+		}},
+	}, {
+		name:    "justification",
+		spec:    &model.VulnEqualSpec{Justification: ptrfrom("other")},
+		wantIDs: 0,
+	}, {
+		name:    "too many vulnerabilities",
+		spec:    &model.VulnEqualSpec{Vulnerabilities: []*model.VulnerabilitySpec{{}, {}, {}}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.VulnEqual(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VulnEqual() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("VulnEqual() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+		})
+	}
+
+	// The scan only knows about the OSV identifier, but expanding any of the
+	// three equivalent identifiers finds it.
+	certifyVuln, err := b.IngestCertifyVuln(ctx, testPackages[0], osv, &model.ScanMetadataInput{TimeScanned: time.Unix(1e9, 0).UTC()})
+	if err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, testPackages[0], other, &model.ScanMetadataInput{TimeScanned: time.Unix(1e9, 0).UTC()}); err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+	wantSpecs := []*model.VulnerabilitySpec{
+		{Type: ptrfrom("cve"), VulnerabilityID: ptrfrom("cve-2023-1234")},
+		{Type: ptrfrom("ghsa"), VulnerabilityID: ptrfrom("ghsa-h45f-rjvw-2rv2")},
+		{Type: ptrfrom("osv"), VulnerabilityID: ptrfrom("pysec-2023-42")},
+	}
+	sortSpecs := cmpopts.SortSlices(func(x, y *model.VulnerabilitySpec) bool { return *x.Type < *y.Type })
+	for _, v := range []*model.VulnerabilityInputSpec{cve, ghsa, osv} {
+		specs, err := backends.ExpandVulnEqual(ctx, b, &model.VulnerabilitySpec{Type: &v.Type, VulnerabilityID: &v.VulnerabilityID})
+		if err != nil {
+			t.Fatalf("ExpandVulnEqual() error = %v", err)
+		}
+		if diff := cmp.Diff(wantSpecs, specs, cmpopts.IgnoreFields(model.VulnerabilitySpec{}, "ID"), sortSpecs); diff != "" {
+			t.Errorf("ExpandVulnEqual(%s) mismatch (-want +got):\n%s", v.VulnerabilityID, diff)
+		}
+		var got []*model.CertifyVuln
+		for _, spec := range specs {
+			found, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{Vulnerability: spec})
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			got = append(got, found...)
+		}
+		if diff := cmp.Diff([]*model.CertifyVuln{certifyVuln}, got); diff != "" {
+			t.Errorf("CertifyVuln() through %s mismatch (-want +got):\n%s", v.VulnerabilityID, diff)
+		}
+	}
+
+	specs, err := backends.ExpandVulnEqual(ctx, b, &model.VulnerabilitySpec{VulnerabilityID: &other.VulnerabilityID})
+	if err != nil {
+		t.Fatalf("ExpandVulnEqual() error = %v", err)
+	}
+	if len(specs) != 1 {
+		t.Errorf("ExpandVulnEqual() of a vulnerability without equalities returned %d specs, want 1", len(specs))
+	}
+}
+
+func TestCertifyBad(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	certifyBad := &model.CertifyBadInputSpec{Justification: "malicious", Origin: "test", Collector: "test"}
+	curl := testPackages[1]
+	allVersions := model.PkgMatchTypeAllVersions
+	ingest := []struct {
+		subject      *model.PackageSourceOrArtifactInput
+		pkgMatchType *model.PkgMatchType
+	}{
+		{subject: &model.PackageSourceOrArtifactInput{Package: testPackages[0]}},
+		{subject: &model.PackageSourceOrArtifactInput{Package: curl}, pkgMatchType: &allVersions},
+		{subject: &model.PackageSourceOrArtifactInput{Source: testSources[4]}},
+		{subject: &model.PackageSourceOrArtifactInput{Artifact: testArtifact}},
+	}
+	for _, i := range ingest {
+		if _, err := b.IngestCertifyBad(ctx, i.subject, i.pkgMatchType, certifyBad); err != nil {
+			t.Fatalf("IngestCertifyBad() error = %v", err)
+		}
+	}
+	// Ingesting the same certification again is a no-op.
+	if _, err := b.IngestCertifyBad(ctx, ingest[0].subject, nil, certifyBad); err != nil {
+		t.Fatalf("IngestCertifyBad() error = %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, &model.PackageSourceOrArtifactInput{Package: curl, Source: testSources[0]}, nil, certifyBad); err == nil {
+		t.Errorf("IngestCertifyBad() with two subjects did not return an error")
+	}
+	if _, err := b.IngestCertifyBad(ctx, &model.PackageSourceOrArtifactInput{}, nil, certifyBad); err == nil {
+		t.Errorf("IngestCertifyBad() without subject did not return an error")
+	}
+
+	curlName := &model.Package{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names:     []*model.PackageName{{Name: "curl"}},
+		}},
+	}
+	tests := []struct {
+		name    string
+		spec    *model.CertifyBadSpec
+		want    []*model.CertifyBad
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 4,
+	}, {
+		name: "package name matches any version filter",
+		spec: &model.CertifyBadSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+			Name:    ptrfrom("curl"),
+			Version: ptrfrom("8.0.0"),
+		}}},
+		want: []*model.CertifyBad{{
+			Subject:       curlName,
+			Justification: "malicious",
+			Origin:        "test",
+			Collector:     "test",
+		}},
+		wantIDs: 1,
+	}, {
+		name: "package version",
+		spec: &model.CertifyBadSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+			Version: ptrfrom("1:2.4.47-2"),
+		}}},
+		wantIDs: 2,
+	}, {
+		name:    "source",
+		spec:    &model.CertifyBadSpec{Subject: &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{Type: ptrfrom("svn")}}},
+		wantIDs: 1,
+	}, {
+		name: "artifact",
+		spec: &model.CertifyBadSpec{Subject: &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{
+			Algorithm: ptrfrom("SHA256"),
+		}}},
+		want: []*model.CertifyBad{{
+			Subject:       &model.Artifact{Algorithm: "sha256", Digest: testArtifact.Digest},
+			Justification: "malicious",
+			Origin:        "test",
+			Collector:     "test",
+		}},
+		wantIDs: 1,
+	}, {
+		name:    "no match",
+		spec:    &model.CertifyBadSpec{Justification: ptrfrom("vulnerable")},
+		wantIDs: 0,
+	}, {
+		name: "multiple subjects",
+		spec: &model.CertifyBadSpec{Subject: &model.PackageSourceOrArtifactSpec{
+			Package: &model.PkgSpec{},
+			Source:  &model.SourceSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyBad(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CertifyBad() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("CertifyBad() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+			if tt.want != nil {
+				if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+					t.Errorf("CertifyBad() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCertifyGood(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	certifyGood := &model.CertifyGoodInputSpec{Justification: "audited", Origin: "test", Collector: "test"}
+	specificVersion := model.PkgMatchTypeSpecificVersion
+	if _, err := b.IngestCertifyGood(ctx, &model.PackageSourceOrArtifactInput{Package: testPackages[1]}, &specificVersion, certifyGood); err != nil {
+		t.Fatalf("IngestCertifyGood() error = %v", err)
+	}
+
+	// A certification on a specific version does not apply to the other
+	// versions of the package.
+	got, err := b.CertifyGood(ctx, &model.CertifyGoodSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+		Name:    ptrfrom("curl"),
+		Version: ptrfrom("8.0.0"),
+	}}})
+	if err != nil {
+		t.Fatalf("CertifyGood() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CertifyGood() returned %d nodes for another version, want 0", len(got))
+	}
+
+	got, err = b.CertifyGood(ctx, &model.CertifyGoodSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+		Name: ptrfrom("curl"),
+	}}})
+	if err != nil {
+		t.Fatalf("CertifyGood() error = %v", err)
+	}
+	want := []*model.CertifyGood{{
+		Subject: &model.Package{
+			Type: "deb",
+			Namespaces: []*model.PackageNamespace{{
+				Namespace: "debian",
+				Names: []*model.PackageName{{
+					Name: "curl",
+					Versions: []*model.PackageVersion{{
+						Version: "7.50.3-1",
+						Qualifiers: []*model.PackageQualifier{
+							{Key: "arch", Value: "i386"},
+							{Key: "distro", Value: "jessie"},
+						},
+					}},
+				}},
+			}},
+		},
+		Justification: "audited",
+		Origin:        "test",
+		Collector:     "test",
+	}}
+	if diff := cmp.Diff(want, got, ignoreIDs); diff != "" {
+		t.Errorf("CertifyGood() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPointOfContact(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	since := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	curl := testPackages[1]
+	allVersions := model.PkgMatchTypeAllVersions
+	ingest := []struct {
+		subject        *model.PackageSourceOrArtifactInput
+		pkgMatchType   *model.PkgMatchType
+		pointOfContact *model.PointOfContactInputSpec
+	}{{
+		subject:        &model.PackageSourceOrArtifactInput{Package: curl},
+		pointOfContact: &model.PointOfContactInputSpec{Email: "release@curl.se", Since: since, Origin: "test", Collector: "test"},
+	}, {
+		subject:        &model.PackageSourceOrArtifactInput{Package: curl},
+		pkgMatchType:   &allVersions,
+		pointOfContact: &model.PointOfContactInputSpec{Email: "security@curl.se", Info: "security", Since: since, Origin: "test", Collector: "test"},
+	}, {
+		subject:        &model.PackageSourceOrArtifactInput{Source: testSources[4]},
+		pointOfContact: &model.PointOfContactInputSpec{Email: "maintainers@example.com", Since: since, Origin: "test", Collector: "test"},
+	}, {
+		subject:        &model.PackageSourceOrArtifactInput{Artifact: testArtifact},
+		pointOfContact: &model.PointOfContactInputSpec{Email: "security@curl.se", Since: since, Origin: "test", Collector: "test"},
+	}}
+	for _, i := range ingest {
+		if _, err := b.IngestPointOfContact(ctx, i.subject, i.pkgMatchType, i.pointOfContact); err != nil {
+			t.Fatalf("IngestPointOfContact() error = %v", err)
+		}
+	}
+	// Ingesting the same point of contact again is a no-op.
+	if _, err := b.IngestPointOfContact(ctx, ingest[0].subject, nil, ingest[0].pointOfContact); err != nil {
+		t.Fatalf("IngestPointOfContact() error = %v", err)
+	}
+	if _, err := b.IngestPointOfContact(ctx, &model.PackageSourceOrArtifactInput{Package: curl, Artifact: testArtifact}, nil, ingest[0].pointOfContact); err == nil {
+		t.Errorf("IngestPointOfContact() with two subjects did not return an error")
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.PointOfContactSpec
+		want    []*model.PointOfContact
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 4,
+	}, {
+		name: "version and package name contacts",
+		spec: &model.PointOfContactSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+			Name:    ptrfrom("curl"),
+			Version: ptrfrom("7.50.3-1"),
+		}}},
+		want: []*model.PointOfContact{{
+			Subject: &model.Package{
+				Type: "deb",
+				Namespaces: []*model.PackageNamespace{{
+					Namespace: "debian",
+					Names: []*model.PackageName{{
+						Name: "curl",
+						Versions: []*model.PackageVersion{{
+							Version: "7.50.3-1",
+							Qualifiers: []*model.PackageQualifier{
+								{Key: "arch", Value: "i386"},
+								{Key: "distro", Value: "jessie"},
+							},
+						}},
+					}},
+				}},
+			},
+			Email:     "release@curl.se",
+			Since:     since,
+			Origin:    "test",
+			Collector: "test",
+		}, {
+			Subject: &model.Package{
+				Type: "deb",
+				Namespaces: []*model.PackageNamespace{{
+					Namespace: "debian",
+					Names:     []*model.PackageName{{Name: "curl"}},
+				}},
+			},
+			Email:     "security@curl.se",
+			Info:      "security",
+			Since:     since,
+			Origin:    "test",
+			Collector: "test",
+		}},
+		wantIDs: 2,
+	}, {
+		name: "another version only matches the package name contact",
+		spec: &model.PointOfContactSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+			Name:    ptrfrom("curl"),
+			Version: ptrfrom("8.0.0"),
+		}}},
+		wantIDs: 1,
+	}, {
+		name:    "email",
+		spec:    &model.PointOfContactSpec{Email: ptrfrom("security@curl.se")},
+		wantIDs: 2,
+	}, {
+		name: "email and subject",
+		spec: &model.PointOfContactSpec{
+			Email:   ptrfrom("security@curl.se"),
+			Subject: &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom(testArtifact.Digest)}},
+		},
+		want: []*model.PointOfContact{{
+			Subject:   &model.Artifact{Algorithm: "sha256", Digest: testArtifact.Digest},
+			Email:     "security@curl.se",
+			Since:     since,
+			Origin:    "test",
+			Collector: "test",
+		}},
+		wantIDs: 1,
+	}, {
+		name:    "source",
+		spec:    &model.PointOfContactSpec{Subject: &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{Type: ptrfrom("svn")}}},
+		wantIDs: 1,
+	}, {
+		name:    "since",
+		spec:    &model.PointOfContactSpec{Since: ptrfrom(since.Add(time.Hour))},
+		wantIDs: 0,
+	}, {
+		name: "multiple subjects",
+		spec: &model.PointOfContactSpec{Subject: &model.PackageSourceOrArtifactSpec{
+			Package:  &model.PkgSpec{},
+			Artifact: &model.ArtifactSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.PointOfContact(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PointOfContact() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("PointOfContact() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+			if tt.want != nil {
+				if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+					t.Errorf("PointOfContact() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBuilders(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	uris := []string{
+		"https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.5.0",
+		"https://tekton.dev/chains/v2",
+	}
+	var ids []string
+	for _, uri := range uris {
+		builder, err := b.IngestBuilder(ctx, &model.BuilderInputSpec{URI: uri})
+		if err != nil {
+			t.Fatalf("IngestBuilder() error = %v", err)
+		}
+		ids = append(ids, builder.ID)
+	}
+	// Builders are deduplicated on the URI.
+	again, err := b.IngestBuilder(ctx, &model.BuilderInputSpec{URI: uris[1]})
+	if err != nil {
+		t.Fatalf("IngestBuilder() error = %v", err)
+	}
+	if again.ID != ids[1] {
+		t.Errorf("IngestBuilder() of an existing builder created node %s, want existing node %s", again.ID, ids[1])
+	}
+	if _, err := b.IngestBuilder(ctx, &model.BuilderInputSpec{}); err == nil {
+		t.Errorf("IngestBuilder() without URI did not return an error")
+	}
+
+	tests := []struct {
+		name string
+		spec *model.BuilderSpec
+		want []*model.Builder
+	}{{
+		name: "nil spec",
+		want: []*model.Builder{{URI: uris[0]}, {URI: uris[1]}},
+	}, {
+		name: "by uri",
+		spec: &model.BuilderSpec{URI: ptrfrom(uris[1])},
+		want: []*model.Builder{{URI: uris[1]}},
+	}, {
+		name: "by id",
+		spec: &model.BuilderSpec{ID: ptrfrom(ids[0])},
+		want: []*model.Builder{{URI: uris[0]}},
+	}, {
+		name: "no match",
+		spec: &model.BuilderSpec{URI: ptrfrom("https://example.com/builder")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Builders(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("Builders() error = %v", err)
+			}
+			sortBuilders := cmpopts.SortSlices(func(x, y *model.Builder) bool { return x.URI < y.URI })
+			if diff := cmp.Diff(tt.want, got, ignoreIDs, sortBuilders); diff != "" {
+				t.Errorf("Builders() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasMetadata(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	since := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	curl := &model.PackageSourceOrArtifactInput{Package: testPackages[1]}
+	allVersions := model.PkgMatchTypeAllVersions
+	ingest := []struct {
+		subject      *model.PackageSourceOrArtifactInput
+		pkgMatchType *model.PkgMatchType
+		hasMetadata  *model.HasMetadataInputSpec
+	}{{
+		subject:     curl,
+		hasMetadata: &model.HasMetadataInputSpec{Key: "cpe", Value: "cpe:2.3:a:haxx:curl:7.50.3", Since: since, Justification: "nvd", Origin: "test", Collector: "test"},
+	}, {
+		subject:     curl,
+		hasMetadata: &model.HasMetadataInputSpec{Key: "cpe", Value: "cpe:2.3:a:curl:curl:7.50.3", Since: since, Justification: "nvd", Origin: "test", Collector: "test"},
+	}, {
+		subject:      curl,
+		pkgMatchType: &allVersions,
+		hasMetadata:  &model.HasMetadataInputSpec{Key: "homepage", Value: "https://curl.se", Since: since, Justification: "debian", Origin: "test", Collector: "test"},
+	}, {
+		subject:     &model.PackageSourceOrArtifactInput{Artifact: testArtifact},
+		hasMetadata: &model.HasMetadataInputSpec{Key: "reproducible", Value: "true", Since: since, Justification: "rebuild", Origin: "test", Collector: "test"},
+	}}
+	for _, i := range ingest {
+		if _, err := b.IngestHasMetadata(ctx, i.subject, i.pkgMatchType, i.hasMetadata); err != nil {
+			t.Fatalf("IngestHasMetadata() error = %v", err)
+		}
+	}
+	// The nodes are deduplicated on the subject, key, value and
+	// justification, so ingesting them again at another time is a no-op.
+	again := *ingest[0].hasMetadata
+	again.Since = since.Add(24 * time.Hour)
+	again.Origin = "other"
+	got, err := b.IngestHasMetadata(ctx, curl, nil, &again)
+	if err != nil {
+		t.Fatalf("IngestHasMetadata() error = %v", err)
+	}
+	if !got.Since.Equal(since) || got.Origin != "test" {
+		t.Errorf("IngestHasMetadata() of an existing node returned since %v and origin %q, want %v and %q", got.Since, got.Origin, since, "test")
+	}
+	if _, err := b.IngestHasMetadata(ctx, &model.PackageSourceOrArtifactInput{}, nil, ingest[0].hasMetadata); err == nil {
+		t.Errorf("IngestHasMetadata() without subject did not return an error")
+	}
+
+	curlVersion := &model.Package{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names: []*model.PackageName{{
+				Name: "curl",
+				Versions: []*model.PackageVersion{{
+					Version: "7.50.3-1",
+					Qualifiers: []*model.PackageQualifier{
+						{Key: "arch", Value: "i386"},
+						{Key: "distro", Value: "jessie"},
+					},
+				}},
+			}},
+		}},
+	}
+	tests := []struct {
+		name    string
+		spec    *model.HasMetadataSpec
+		want    []*model.HasMetadata
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 4,
+	}, {
+		name: "values of the same key",
+		spec: &model.HasMetadataSpec{Key: ptrfrom("cpe")},
+		want: []*model.HasMetadata{{
+			Subject:       curlVersion,
+			Key:           "cpe",
+			Value:         "cpe:2.3:a:haxx:curl:7.50.3",
+			Since:         since,
+			Justification: "nvd",
+			Origin:        "test",
+			Collector:     "test",
+		}, {
+			Subject:       curlVersion,
+			Key:           "cpe",
+			Value:         "cpe:2.3:a:curl:curl:7.50.3",
+			Since:         since,
+			Justification: "nvd",
+			Origin:        "test",
+			Collector:     "test",
+		}},
+		wantIDs: 2,
+	}, {
+		name:    "key and value",
+		spec:    &model.HasMetadataSpec{Key: ptrfrom("cpe"), Value: ptrfrom("cpe:2.3:a:curl:curl:7.50.3")},
+		wantIDs: 1,
+	}, {
+		name: "package version and name",
+		spec: &model.HasMetadataSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{
+			Name:    ptrfrom("curl"),
+			Version: ptrfrom("7.50.3-1"),
+		}}},
+		wantIDs: 3,
+	}, {
+		name: "artifact",
+		spec: &model.HasMetadataSpec{
+			Subject: &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom(testArtifact.Digest)}},
+			Key:     ptrfrom("reproducible"),
+		},
+		wantIDs: 1,
+	}, {
+		name:    "source",
+		spec:    &model.HasMetadataSpec{Subject: &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{}}},
+		wantIDs: 0,
+	}, {
+		name: "multiple subjects",
+		spec: &model.HasMetadataSpec{Subject: &model.PackageSourceOrArtifactSpec{
+			Source:   &model.SourceSpec{},
+			Artifact: &model.ArtifactSpec{},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HasMetadata(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HasMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("HasMetadata() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+			if tt.want != nil {
+				if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+					t.Errorf("HasMetadata() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestHasSLSA(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	source := &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "d6525c840a62b398424a78d792f457477135d0cf"}
+	image := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "c6166717f7fe0b7da44908c986137ecfeab21f31ec3992f6e128fff8a94be8a5"}
+	other := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "9e183c89765d92a440f44ac7059385c778cbadad0ee8fe3208360efb07c0ba09"}
+	github := &model.BuilderInputSpec{URI: "https://github.com/Attestations/GitHubHostedActions@v1"}
+	tekton := &model.BuilderInputSpec{URI: "https://tekton.dev/chains/v2"}
+	startedOn := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	slsa := &model.SLSAInputSpec{
+		BuildType: "https://github.com/Attestations/GitHubActionsWorkflow@v1",
+		SlsaPredicate: []*model.SLSAPredicateInputSpec{
+			{Key: "slsa.invocation.configSource.entryPoint", Value: "build.yaml:maketgz"},
+			{Key: "slsa.buildConfig", Value: "{}"},
+		},
+		SlsaVersion: "v0.2",
+		StartedOn:   &startedOn,
+		Origin:      "test",
+		Collector:   "test",
+	}
+	tektonSLSA := &model.SLSAInputSpec{
+		BuildType:     "tekton.dev/v1beta1/TaskRun",
+		SlsaPredicate: []*model.SLSAPredicateInputSpec{},
+		SlsaVersion:   "v1.0",
+		Origin:        "test",
+		Collector:     "test",
+	}
+
+	first, err := b.IngestSLSA(ctx, testArtifact, []*model.ArtifactInputSpec{source, image}, github, slsa)
+	if err != nil {
+		t.Fatalf("IngestSLSA() error = %v", err)
+	}
+	// The order of the materials and predicates is not significant.
+	reordered := *slsa
+	reordered.SlsaPredicate = []*model.SLSAPredicateInputSpec{slsa.SlsaPredicate[1], slsa.SlsaPredicate[0]}
+	again, err := b.IngestSLSA(ctx, testArtifact, []*model.ArtifactInputSpec{image, source}, github, &reordered)
+	if err != nil {
+		t.Fatalf("IngestSLSA() error = %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("IngestSLSA() of an existing attestation created node %s, want existing node %s", again.ID, first.ID)
+	}
+	if _, err := b.IngestSLSA(ctx, other, []*model.ArtifactInputSpec{}, tekton, tektonSLSA); err != nil {
+		t.Fatalf("IngestSLSA() error = %v", err)
+	}
+	if _, err := b.IngestSLSA(ctx, other, []*model.ArtifactInputSpec{{Algorithm: "sha256", Digest: "not hex"}}, tekton, tektonSLSA); err == nil {
+		t.Errorf("IngestSLSA() with an invalid material did not return an error")
+	}
+	if _, err := b.IngestSLSA(ctx, other, nil, &model.BuilderInputSpec{}, tektonSLSA); err == nil {
+		t.Errorf("IngestSLSA() without builder URI did not return an error")
+	}
+
+	// Both attestations share the builders ingested with them.
+	builders, err := b.Builders(ctx, nil)
+	if err != nil {
+		t.Fatalf("Builders() error = %v", err)
+	}
+	if len(builders) != 2 {
+		t.Errorf("Builders() returned %d builders, want 2", len(builders))
+	}
+
+	want := &model.HasSlsa{
+		Subject: &model.Artifact{Algorithm: testArtifact.Algorithm, Digest: testArtifact.Digest},
+		Slsa: &model.Slsa{
+			BuiltFrom: []*model.Artifact{
+				{Algorithm: "sha1", Digest: source.Digest},
+				{Algorithm: "sha256", Digest: image.Digest},
+			},
+			BuiltBy:   &model.Builder{URI: github.URI},
+			BuildType: slsa.BuildType,
+			SlsaPredicate: []*model.SLSAPredicate{
+				{Key: "slsa.buildConfig", Value: "{}"},
+				{Key: "slsa.invocation.configSource.entryPoint", Value: "build.yaml:maketgz"},
+			},
+			SlsaVersion: "v0.2",
+			StartedOn:   &startedOn,
+			Origin:      "test",
+			Collector:   "test",
+		},
+	}
+	got, err := b.HasSLSA(ctx, &model.HasSLSASpec{ID: &first.ID})
+	if err != nil {
+		t.Fatalf("HasSLSA() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.HasSlsa{want}, got, ignoreIDs); diff != "" {
+		t.Errorf("HasSLSA() mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.HasSLSASpec
+		wantIDs int
+	}{{
+		name:    "nil spec",
+		wantIDs: 2,
+	}, {
+		name:    "by subject",
+		spec:    &model.HasSLSASpec{Subject: &model.ArtifactSpec{Digest: ptrfrom(strings.ToUpper(other.Digest))}},
+		wantIDs: 1,
+	}, {
+		name:    "by builder",
+		spec:    &model.HasSLSASpec{BuiltBy: &model.BuilderSpec{URI: ptrfrom(tekton.URI)}},
+		wantIDs: 1,
+	}, {
+		name:    "by build type",
+		spec:    &model.HasSLSASpec{BuildType: ptrfrom(slsa.BuildType)},
+		wantIDs: 1,
+	}, {
+		name:    "by material",
+		spec:    &model.HasSLSASpec{BuiltFrom: []*model.ArtifactSpec{{Algorithm: ptrfrom("sha1")}}},
+		wantIDs: 1,
+	}, {
+		name: "by all materials",
+		spec: &model.HasSLSASpec{BuiltFrom: []*model.ArtifactSpec{
+			{Digest: ptrfrom(image.Digest)},
+			{Digest: ptrfrom(source.Digest)},
+		}},
+		wantIDs: 1,
+	}, {
+		name: "by missing material",
+		spec: &model.HasSLSASpec{BuiltFrom: []*model.ArtifactSpec{{Digest: ptrfrom(other.Digest)}}},
+	}, {
+		name: "by subject and other builder",
+		spec: &model.HasSLSASpec{
+			Subject: &model.ArtifactSpec{Digest: ptrfrom(testArtifact.Digest)},
+			BuiltBy: &model.BuilderSpec{URI: ptrfrom(tekton.URI)},
+		},
+	}, {
+		name:    "by version",
+		spec:    &model.HasSLSASpec{SlsaVersion: ptrfrom("v1.0")},
+		wantIDs: 1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HasSLSA(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("HasSLSA() error = %v", err)
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("HasSLSA() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestPkgEqual(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	maven := &model.PkgInputSpec{
+		Type:      "maven",
+		Namespace: ptrfrom("org.apache.logging.log4j"),
+		Name:      "log4j-core",
+		Version:   ptrfrom("2.17.1"),
+	}
+	oci := &model.PkgInputSpec{
+		Type:    "oci",
+		Name:    "log4j-core",
+		Version: ptrfrom("sha256:244fd47e07d1004f0aed9c156aa09083ecf7d8d3d1c1d5f6f5b8b0b4d7c0e3a1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "repository_url", Value: "ghcr.io/example/log4j-core"},
+		},
+	}
+	pkgEqual := &model.PkgEqualInputSpec{Justification: "same jar", Origin: "test", Collector: "test"}
+
+	first, err := b.IngestPkgEqual(ctx, maven, oci, pkgEqual)
+	if err != nil {
+		t.Fatalf("IngestPkgEqual() error = %v", err)
+	}
+	// The relation is symmetric, so this is the same node.
+	second, err := b.IngestPkgEqual(ctx, oci, maven, pkgEqual)
+	if err != nil {
+		t.Fatalf("IngestPkgEqual() error = %v", err)
+	}
+	if first.ID != second.ID {
+		t.Errorf("IngestPkgEqual() with swapped packages created node %s, want existing node %s", second.ID, first.ID)
+	}
+	if _, err := b.IngestPkgEqual(ctx, oci, testPackages[5], pkgEqual); err != nil {
+		t.Fatalf("IngestPkgEqual() error = %v", err)
+	}
+	if _, err := b.IngestPkgEqual(ctx, maven, maven, pkgEqual); err == nil {
+		t.Errorf("IngestPkgEqual() of a package with itself did not return an error")
+	}
+
+	mavenPackage := &model.Package{
+		Type: "maven",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "org.apache.logging.log4j",
+			Names: []*model.PackageName{{
+				Name:     "log4j-core",
+				Versions: []*model.PackageVersion{{Version: "2.17.1"}},
+			}},
+		}},
+	}
+	ociPackage := &model.Package{
+		Type: "oci",
+		Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{
+				Name: "log4j-core",
+				Versions: []*model.PackageVersion{{
+					Version:    *oci.Version,
+					Qualifiers: []*model.PackageQualifier{{Key: "repository_url", Value: "ghcr.io/example/log4j-core"}},
+				}},
+			}},
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		spec    *model.PkgEqualSpec
+		want    []*model.PkgEqual
+		wantIDs int
+		wantErr bool
+	}{{
+		name:    "nil spec",
+		wantIDs: 2,
+	}, {
+		name: "first package",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{{Type: ptrfrom("maven")}}},
+		want: []*model.PkgEqual{{
+			Packages:      []*model.Package{mavenPackage, ociPackage},
+			Justification: "same jar",
+			Origin:        "test",
+			Collector:     "test",
+		}},
+	}, {
+		name: "second package is returned first",
+		spec: &model.PkgEqualSpec{
+			Packages:      []*model.PkgSpec{{Type: ptrfrom("oci")}},
+			Justification: ptrfrom("same jar"),
+		},
+		wantIDs: 2,
+	}, {
+		name: "both packages in any order",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{
+			{Type: ptrfrom("oci")},
+			{Type: ptrfrom("maven")},
+		}},
+		want: []*model.PkgEqual{{
+			Packages:      []*model.Package{ociPackage, mavenPackage},
+			Justification: "same jar",
+			Origin:        "test",
+			Collector:     "test",
+		}},
+	}, {
+		name: "same filter for both packages",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{
+			{Type: ptrfrom("maven")},
+			{Type: ptrfrom("maven")},
+		}},
+	}, {
+		name:    "too many packages",
+		spec:    &model.PkgEqualSpec{Packages: []*model.PkgSpec{{}, {}, {}}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.PkgEqual(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PkgEqual() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+					t.Errorf("PkgEqual() mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if len(got) != tt.wantIDs {
+				t.Errorf("PkgEqual() returned %d nodes, want %d", len(got), tt.wantIDs)
+			}
+			// The package matching the filter always comes first.
+			if tt.spec != nil && len(tt.spec.Packages) == 1 {
+				for _, p := range got {
+					if p.Packages[0].Type != *tt.spec.Packages[0].Type {
+						t.Errorf("PkgEqual() returned package %s first, want %s", p.Packages[0].Type, *tt.spec.Packages[0].Type)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPkgEqualExpandTransitive(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+
+	pkg := func(name string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom("1.0.0")}
+	}
+	// The chain a-b-c-d, with d-b closing a cycle.
+	for _, l := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "b"}} {
+		if _, err := b.IngestPkgEqual(ctx, pkg(l[0]), pkg(l[1]), &model.PkgEqualInputSpec{
+			Justification: "same code", Origin: "test", Collector: "test",
+		}); err != nil {
+			t.Fatalf("IngestPkgEqual() error = %v", err)
+		}
+	}
+	// Another version of a, which is not in the class.
+	other := pkg("a")
+	other.Qualifiers = []*model.PackageQualifierInputSpec{{Key: "arch", Value: "arm64"}}
+	if _, err := b.IngestPkgEqual(ctx, other, pkg("e"), &model.PkgEqualInputSpec{Justification: "same code"}); err != nil {
+		t.Fatalf("IngestPkgEqual() error = %v", err)
+	}
+	pairs := func(equalities []*model.PkgEqual) []string {
+		var out []string
+		for _, e := range equalities {
+			names := []string{e.Packages[0].Namespaces[0].Names[0].Name, e.Packages[1].Namespaces[0].Names[0].Name}
+			sort.Strings(names)
+			out = append(out, strings.Join(names, "-"))
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	tests := []struct {
+		name string
+		spec *model.PkgEqualSpec
+		want []string
+	}{{
+		name: "direct only",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{{Name: ptrfrom("c")}}},
+		want: []string{"b-c", "c-d"},
+	}, {
+		name: "expanded",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{{Name: ptrfrom("c")}}, ExpandTransitive: ptrfrom(true)},
+		want: []string{"a-b", "b-c", "b-d", "c-d"},
+	}, {
+		name: "expanded from another version",
+		spec: &model.PkgEqualSpec{Packages: []*model.PkgSpec{{Name: ptrfrom("e")}}, ExpandTransitive: ptrfrom(true)},
+		want: []string{"a-e"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.PkgEqual(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("PkgEqual() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, pairs(got)); diff != "" {
+				t.Errorf("PkgEqual() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// ingestTestGraph ingests a small graph for the path queries: an artifact
+// which is an occurrence of curl, a package depending on curl, a
+// vulnerability of curl and an isolated artifact. It returns the occurrence,
+// the dependency and the isolated artifact.
+func ingestTestGraph(t *testing.T, b backends.Backend) (*model.IsOccurrence, *model.IsDependency, *model.Artifact) {
+	t.Helper()
+	ctx := context.Background()
+	attr, curl := testPackages[0], testPackages[1]
+
+	occurrence, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: curl}, testArtifact,
+		&model.IsOccurrenceInputSpec{Justification: "built from", Origin: "test", Collector: "test"})
+	if err != nil {
+		t.Fatalf("IngestIsOccurrence() error = %v", err)
+	}
+	dependency, err := b.IngestIsDependency(ctx, attr, curl, &model.IsDependencyInputSpec{
+		VersionRange:   ">=7.50",
+		DependencyType: model.DependencyTypeDirect,
+		Origin:         "test",
+		Collector:      "test",
+	})
+	if err != nil {
+		t.Fatalf("IngestIsDependency() error = %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, curl, &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2016-8615"},
+		&model.ScanMetadataInput{TimeScanned: time.Unix(1e9, 0).UTC(), Origin: "test", Collector: "test"}); err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+	isolated, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"})
+	if err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	return occurrence, dependency, isolated
+}
+
+// The nodes of the test graph, as returned by the path queries.
+var (
+	testGraphArtifact = &model.Artifact{Algorithm: testArtifact.Algorithm, Digest: testArtifact.Digest}
+	testGraphCurl     = &model.Package{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names: []*model.PackageName{{
+				Name: "curl",
+				Versions: []*model.PackageVersion{{
+					Version: "7.50.3-1",
+					Qualifiers: []*model.PackageQualifier{
+						{Key: "arch", Value: "i386"},
+						{Key: "distro", Value: "jessie"},
+					},
+				}},
+			}},
+		}},
+	}
+	testGraphCurlName = &model.Package{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names:     []*model.PackageName{{Name: "curl"}},
+		}},
+	}
+	testGraphAttr = &model.Package{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names: []*model.PackageName{{
+				Name: "attr",
+				Versions: []*model.PackageVersion{{
+					Version:    "1:2.4.47-2",
+					Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "source"}},
+				}},
+			}},
+		}},
+	}
+	testGraphOccurrence = &model.IsOccurrence{
+		Subject:       testGraphCurl,
+		Artifact:      testGraphArtifact,
+		Justification: "built from",
+		Origin:        "test",
+		Collector:     "test",
+	}
+	testGraphDependency = &model.IsDependency{
+		Package:          testGraphAttr,
+		DependentPackage: testGraphCurlName,
+		VersionRange:     ">=7.50",
+		DependencyType:   model.DependencyTypeDirect,
+		Origin:           "test",
+		Collector:        "test",
+	}
+)
+
+func TestNeighbors(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+	occurrence, _, isolated := ingestTestGraph(t, b)
+	curl := occurrence.Subject.(*model.Package).Namespaces[0].Names[0].Versions[0].ID
+
+	tests := []struct {
+		name      string
+		node      string
+		usingOnly []model.Edge
+		want      []model.Node
+		wantCount int
+		wantErr   bool
+	}{{
+		name:      "all edges",
+		node:      curl,
+		wantCount: 3,
+	}, {
+		name:      "trie edges",
+		node:      curl,
+		usingOnly: []model.Edge{model.EdgePackageTrie},
+		want:      []model.Node{testGraphCurlName},
+	}, {
+		name:      "evidence edges",
+		node:      curl,
+		usingOnly: []model.Edge{model.EdgeIsOccurrence, model.EdgeIsDependency},
+		want:      []model.Node{testGraphOccurrence},
+	}, {
+		name:      "evidence node",
+		node:      occurrence.ID,
+		usingOnly: []model.Edge{model.EdgeIsOccurrence},
+		wantCount: 2,
+	}, {
+		name: "no neighbors",
+		node: isolated.ID,
+		want: []model.Node{},
+	}, {
+		name:    "unknown node",
+		node:    "unknown",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Neighbors(ctx, tt.node, tt.usingOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Neighbors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.want == nil {
+				if len(got) != tt.wantCount {
+					t.Errorf("Neighbors() returned %d nodes, want %d", len(got), tt.wantCount)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Neighbors() unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t)
+	occurrence, dependency, isolated := ingestTestGraph(t, b)
+	artifact := occurrence.Artifact.ID
+	attr := dependency.Package.Namespaces[0].Names[0].Versions[0].ID
+
+	// The artifact reaches attr through the dependency on curl in 5 edges,
+	// or through the package trie in 6 edges.
+	viaDependency := []model.Node{
+		testGraphArtifact,
+		testGraphOccurrence,
+		testGraphCurl,
+		testGraphCurlName,
+		testGraphDependency,
+		testGraphAttr,
+	}
+	tests := []struct {
+		name          string
+		subject       string
+		target        string
+		maxPathLength int
+		usingOnly     []model.Edge
+		want          []model.Node
+		wantErr       bool
+	}{{
+		name:          "shortest path",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 10,
+		want:          viaDependency,
+	}, {
+		name:          "shortest path at max length",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 5,
+		want:          viaDependency,
+	}, {
+		name:          "path longer than max length",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 4,
+		want:          []model.Node{},
+	}, {
+		name:          "allowed edges",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 10,
+		usingOnly:     []model.Edge{model.EdgeIsOccurrence, model.EdgeIsDependency, model.EdgePackageTrie},
+		want:          viaDependency,
+	}, {
+		name:          "trie edges not allowed",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 10,
+		usingOnly:     []model.Edge{model.EdgeIsOccurrence, model.EdgeIsDependency},
+		want:          []model.Node{},
+	}, {
+		name:          "unreachable target",
+		subject:       artifact,
+		target:        isolated.ID,
+		maxPathLength: 10,
+		want:          []model.Node{},
+	}, {
+		name:          "same subject and target",
+		subject:       artifact,
+		target:        artifact,
+		maxPathLength: 1,
+		want:          []model.Node{testGraphArtifact},
+	}, {
+		name:          "unknown target",
+		subject:       artifact,
+		target:        "unknown",
+		maxPathLength: 10,
+		wantErr:       true,
+	}, {
+		name:          "max length not positive",
+		subject:       artifact,
+		target:        attr,
+		maxPathLength: 0,
+		wantErr:       true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Path(ctx, tt.subject, tt.target, tt.maxPathLength, tt.usingOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Path() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got == nil {
+				t.Errorf("Path() = nil, want an empty slice")
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreIDs); diff != "" {
+				t.Errorf("Path() unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubscribeArtifacts(t *testing.T) {
+	b := newBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := b.SubscribeArtifacts(ctx)
+	if err != nil {
+		t.Fatalf("SubscribeArtifacts() error = %v", err)
+	}
+	second, err := b.SubscribeArtifacts(ctx)
+	if err != nil {
+		t.Fatalf("SubscribeArtifacts() error = %v", err)
+	}
+
+	sha256 := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	sha1 := &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"}
+	md5 := &model.ArtifactInputSpec{Algorithm: "md5", Digest: "0d2b3a8753c1a4e8c4d4ef3a2ec4e5b6"}
+	var want []*model.Artifact
+	a, err := b.IngestArtifact(ctx, sha256)
+	if err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	want = append(want, a)
+	// Existing artifacts are not sent again, including the ones ingested as
+	// part of other nodes.
+	batch, err := b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{sha1, sha256})
+	if err != nil {
+		t.Fatalf("IngestArtifacts() error = %v", err)
+	}
+	want = append(want, batch[0])
+	hashEqual, err := b.IngestHashEqual(ctx, sha1, md5, &model.HashEqualInputSpec{Justification: "test"})
+	if err != nil {
+		t.Fatalf("IngestHashEqual() error = %v", err)
+	}
+	for _, a := range hashEqual.Artifacts {
+		if a.Algorithm == "md5" {
+			want = append(want, a)
+		}
+	}
+
+	receive := func(events <-chan *model.Artifact, n int) []*model.Artifact {
+		var got []*model.Artifact
+		for len(got) < n {
+			select {
+			case a, ok := <-events:
+				if !ok {
+					t.Fatalf("the subscription was closed after %d events, want %d", len(got), n)
+				}
+				got = append(got, a)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("received %d events, want %d", len(got), n)
+			}
+		}
+		return got
+	}
+	for _, events := range []<-chan *model.Artifact{first, second} {
+		if diff := cmp.Diff(want, receive(events, len(want))); diff != "" {
+			t.Errorf("SubscribeArtifacts() events mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	cancel()
+	for _, events := range []<-chan *model.Artifact{first, second} {
+		select {
+		case a, ok := <-events:
+			if ok {
+				t.Errorf("SubscribeArtifacts() sent %v after the subscription was cancelled", a)
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("SubscribeArtifacts() channel not closed after the subscription was cancelled")
+		}
+	}
+}
+
+func TestPing(t *testing.T) {
+	b := newBackend(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := b.Ping(ctx); err != nil {
+		t.Errorf("Ping() error = %v", err)
+	}
+}
+
+// backendCalls calls every method of the Backend interface with valid
+// arguments, for tests which check the behavior common to all methods.
+var backendCalls = map[string]func(ctx context.Context, b backends.Backend) (interface{}, error){
+	"Artifacts": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Artifacts(ctx, nil)
+	},
+	"ArtifactsList": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.ArtifactsList(ctx, nil, nil, nil)
+	},
+	"Builders": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Builders(ctx, nil)
+	},
+	"Packages": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Packages(ctx, nil)
+	},
+	"PackagesByPurls": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.PackagesByPurls(ctx, []string{"pkg:pypi/django"})
+	},
+	"Sources": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Sources(ctx, nil)
+	},
+	"Vulnerabilities": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Vulnerabilities(ctx, nil)
+	},
+	"CertifyBad": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.CertifyBad(ctx, nil)
+	},
+	"CertifyGood": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.CertifyGood(ctx, nil)
+	},
+	"CertifyLegal": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.CertifyLegal(ctx, nil)
+	},
+	"CertifyVuln": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.CertifyVuln(ctx, nil)
+	},
+	"CertifyVEXStatement": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.CertifyVEXStatement(ctx, nil)
+	},
+	"HashEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.HashEqual(ctx, nil)
+	},
+	"HasMetadata": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.HasMetadata(ctx, nil)
+	},
+	"HasSBOM": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.HasSBOM(ctx, nil)
+	},
+	"HasSLSA": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.HasSLSA(ctx, nil)
+	},
+	"IsDependency": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IsDependency(ctx, nil)
+	},
+	"IsOccurrence": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IsOccurrence(ctx, nil)
+	},
+	"PkgEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.PkgEqual(ctx, nil)
+	},
+	"PointOfContact": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.PointOfContact(ctx, nil)
+	},
+	"Scorecards": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.Scorecards(ctx, nil)
+	},
+	"VulnEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.VulnEqual(ctx, nil)
+	},
+	"Neighbors": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		pkgs, err := b.Packages(context.Background(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return b.Neighbors(ctx, pkgs[0].ID, nil)
+	},
+	"FindSBOMsByArtifact": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.FindSBOMsByArtifact(ctx, "sha256", "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf")
+	},
+	"TransitiveDependencies": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.TransitiveDependencies(ctx, &model.PkgSpec{Name: ptrfrom("django")}, 2)
+	},
+	"Path": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		pkgs, err := b.Packages(context.Background(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return b.Path(ctx, pkgs[0].ID, pkgs[1].ID, 10, nil)
+	},
+	"IngestArtifact": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestArtifact(ctx, testArtifact)
+	},
+	"IngestArtifacts": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{testArtifact})
+	},
+	"IngestBuilder": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestBuilder(ctx, &model.BuilderInputSpec{URI: "https://github.com/actions/runner"})
+	},
+	"IngestPackage": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestPackage(ctx, testPackages[0])
+	},
+	"IngestPackages": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestPackages(ctx, testPackages)
+	},
+	"IngestSource": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestSource(ctx, testSources[0])
+	},
+	"IngestSources": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestSources(ctx, testSources)
+	},
+	"IngestVulnerability": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestVulnerability(ctx, &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"})
+	},
+	"IngestCertifyBad": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestCertifyBad(ctx, &model.PackageSourceOrArtifactInput{Artifact: testArtifact}, nil,
+			&model.CertifyBadInputSpec{Justification: "bad"})
+	},
+	"IngestCertifyGood": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestCertifyGood(ctx, &model.PackageSourceOrArtifactInput{Artifact: testArtifact}, nil,
+			&model.CertifyGoodInputSpec{Justification: "good"})
+	},
+	"IngestCertifyLegal": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestCertifyLegal(ctx, &model.PackageOrSourceInput{Package: testPackages[0]},
+			&model.CertifyLegalInputSpec{DeclaredLicense: "MIT"})
+	},
+	"IngestCertifyVuln": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestCertifyVuln(ctx, testPackages[0], &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"},
+			&model.ScanMetadataInput{TimeScanned: time.Unix(1e9, 0).UTC()})
+	},
+	"IngestHashEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestHashEqual(ctx, testArtifact, &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+			&model.HashEqualInputSpec{Justification: "equal"})
+	},
+	"IngestHasMetadata": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestHasMetadata(ctx, &model.PackageSourceOrArtifactInput{Artifact: testArtifact}, nil,
+			&model.HasMetadataInputSpec{Key: "key", Since: time.Unix(1e9, 0).UTC()})
+	},
+	"IngestHasSbom": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestHasSbom(ctx, &model.PackageOrArtifactInput{Artifact: testArtifact}, &model.HasSBOMInputSpec{URI: "https://example.com/sbom.json"})
+	},
+	"IngestSLSA": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestSLSA(ctx, testArtifact, nil, &model.BuilderInputSpec{URI: "https://github.com/actions/runner"},
+			&model.SLSAInputSpec{BuildType: "test", SlsaVersion: "v1"})
+	},
+	"IngestIsDependency": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestIsDependency(ctx, testPackages[0], testPackages[1], &model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect})
+	},
+	"IngestIsOccurrence": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: testPackages[0]}, testArtifact,
+			&model.IsOccurrenceInputSpec{Justification: "occurrence"})
+	},
+	"IngestPkgEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestPkgEqual(ctx, testPackages[2], testPackages[3], &model.PkgEqualInputSpec{Justification: "equal"})
+	},
+	"IngestPointOfContact": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestPointOfContact(ctx, &model.PackageSourceOrArtifactInput{Artifact: testArtifact}, nil,
+			&model.PointOfContactInputSpec{Email: "security@example.com", Since: time.Unix(1e9, 0).UTC()})
+	},
+	"IngestScorecard": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestScorecard(ctx, testSources[0], &model.ScorecardInputSpec{TimeScanned: time.Unix(1e9, 0).UTC()})
+	},
+	"IngestVEXStatement": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestVEXStatement(ctx, &model.PackageOrArtifactInput{Artifact: testArtifact},
+			&model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"},
+			&model.VexStatementInputSpec{Status: model.VexStatusAffected, VexJustification: model.VexJustificationNotProvided,
+				KnownSince: time.Unix(1e9, 0).UTC()})
+	},
+	"IngestVulnEqual": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.IngestVulnEqual(ctx, &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"},
+			&model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "GHSA-h45f-rjvw-2rv2"}, &model.VulnEqualInputSpec{Justification: "equal"})
+	},
+	"SubscribeArtifacts": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return b.SubscribeArtifacts(ctx)
+	},
+	"Ping": func(ctx context.Context, b backends.Backend) (interface{}, error) {
+		return nil, b.Ping(ctx)
+	},
+}
+
+func TestErrors(t *testing.T) {
+	artifact := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	cve := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2023-1234"}
+	// unknownNode returns the ID of a node which is not stored by b, by
+	// ingesting more artifacts in another backend.
+	unknownNode := func(ctx context.Context, b backends.Backend) (string, error) {
+		other := newBackend(t)
+		if _, err := other.IngestArtifact(ctx, artifact); err != nil {
+			return "", err
+		}
+		a, err := other.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"})
+		if err != nil {
+			return "", err
+		}
+		return a.ID, nil
+	}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, b backends.Backend) (interface{}, error)
+		// Either wantField is the field of the expected ValidationError, or
+		// wantErr is wrapped by the expected error.
+		wantField string
+		wantErr   error
+	}{{
+		name: "artifact with non-hex digest",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "not hex"})
+		},
+		wantField: "digest",
+	}, {
+		name: "transitive dependencies without package",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.TransitiveDependencies(ctx, nil, 1)
+		},
+		wantField: "pkgSpec",
+	}, {
+		name: "transitive dependencies without depth",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.TransitiveDependencies(ctx, &model.PkgSpec{Name: ptrfrom("django")}, 0)
+		},
+		wantField: "maxDepth",
+	}, {
+		name: "artifact without algorithm",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestArtifact(ctx, &model.ArtifactInputSpec{Digest: artifact.Digest})
+		},
+		wantField: "algorithm",
+	}, {
+		name: "missing artifact",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestArtifact(ctx, nil)
+		},
+		wantField: "artifact",
+	}, {
+		name: "invalid artifact in bulk",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{artifact, {Algorithm: "sha256", Digest: "xyz"}})
+		},
+		wantField: "digest",
+	}, {
+		name: "missing artifact in bulk",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{artifact, nil})
+		},
+		wantField: "artifacts",
+	}, {
+		name: "invalid cursor",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.ArtifactsList(ctx, nil, ptrfrom("not a cursor"), nil)
+		},
+		wantField: "after",
+	}, {
+		name: "negative first",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.ArtifactsList(ctx, nil, nil, ptrfrom(-1))
+		},
+		wantField: "first",
+	}, {
+		name: "builder without URI",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestBuilder(ctx, &model.BuilderInputSpec{})
+		},
+		wantField: "uri",
+	}, {
+		name: "missing package",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestPackage(ctx, nil)
+		},
+		wantField: "pkg",
+	}, {
+		name: "missing package in bulk",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestPackages(ctx, []*model.PkgInputSpec{testPackages[0], nil})
+		},
+		wantField: "pkgs",
+	}, {
+		name: "source with commit and tag",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestSource(ctx, &model.SourceInputSpec{Type: "git", Namespace: "github.com", Name: "guac", Tag: ptrfrom("v1"), Commit: ptrfrom("abcd")})
+		},
+		wantField: "commit",
+	}, {
+		name: "source with commit and tag in bulk",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestSources(ctx, []*model.SourceInputSpec{testSources[0], {Type: "git", Namespace: "github.com", Name: "guac", Tag: ptrfrom("v1"), Commit: ptrfrom("abcd")}})
+		},
+		wantField: "commit",
+	}, {
+		name: "SBOMs of an artifact without digest",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.FindSBOMsByArtifact(ctx, "sha256", "")
+		},
+		wantField: "digest",
+	}, {
+		name: "vulnerability without type",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestVulnerability(ctx, &model.VulnerabilityInputSpec{VulnerabilityID: "CVE-2023-1234"})
+		},
+		wantField: "type",
+	}, {
+		name: "vulnerability without ID",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestVulnerability(ctx, &model.VulnerabilityInputSpec{Type: "cve"})
+		},
+		wantField: "vulnerabilityID",
+	}, {
+		name: "subject without value",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestCertifyBad(ctx, &model.PackageSourceOrArtifactInput{}, nil, &model.CertifyBadInputSpec{})
+		},
+		wantField: "subject",
+	}, {
+		name: "conflicting subjects of IsOccurrence",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: testPackages[0], Source: testSources[0]}, artifact, &model.IsOccurrenceInputSpec{})
+		},
+		wantField: "subject",
+	}, {
+		name: "conflicting subjects of HasSBOM query",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{Package: &model.PkgSpec{}, Artifact: &model.ArtifactSpec{}}})
+		},
+		wantField: "subject",
+	}, {
+		name: "conflicting subjects of HasMetadata query",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.HasMetadata(ctx, &model.HasMetadataSpec{Subject: &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{}, Artifact: &model.ArtifactSpec{}}})
+		},
+		wantField: "subject",
+	}, {
+		name: "missing certifyGood",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestCertifyGood(ctx, &model.PackageSourceOrArtifactInput{Artifact: artifact}, nil, nil)
+		},
+		wantField: "certifyGood",
+	}, {
+		name: "missing vulnerability of CertifyVuln",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestCertifyVuln(ctx, testPackages[0], nil, &model.ScanMetadataInput{})
+		},
+		wantField: "vulnerability",
+	}, {
+		name: "artifact equal to itself",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestHashEqual(ctx, artifact, artifact, &model.HashEqualInputSpec{})
+		},
+		wantField: "equalArtifact",
+	}, {
+		name: "HashEqual on more than 2 artifacts",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.HashEqual(ctx, &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{}, {}, {}}})
+		},
+		wantField: "artifacts",
+	}, {
+		name: "package equal to itself",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestPkgEqual(ctx, testPackages[0], testPackages[0], &model.PkgEqualInputSpec{})
+		},
+		wantField: "otherPackage",
+	}, {
+		name: "vulnerability equal to itself",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestVulnEqual(ctx, cve, cve, &model.VulnEqualInputSpec{})
+		},
+		wantField: "otherVulnerability",
+	}, {
+		name: "invalid dependency type",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestIsDependency(ctx, testPackages[0], testPackages[1], &model.IsDependencyInputSpec{DependencyType: "SIBLING"})
+		},
+		wantField: "dependencyType",
+	}, {
+		name: "invalid VEX status",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestVEXStatement(ctx, &model.PackageOrArtifactInput{Artifact: artifact}, cve, &model.VexStatementInputSpec{Status: "BROKEN"})
+		},
+		wantField: "status",
+	}, {
+		name: "missing scorecard",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestScorecard(ctx, testSources[0], nil)
+		},
+		wantField: "scorecard",
+	}, {
+		name: "missing builder of SLSA",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			return b.IngestSLSA(ctx, artifact, []*model.ArtifactInputSpec{artifact}, nil, &model.SLSAInputSpec{})
+		},
+		wantField: "builtBy",
+	}, {
+		name: "non-positive maxPathLength",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			a, err := b.IngestArtifact(ctx, artifact)
+			if err != nil {
+				return nil, err
+			}
+			return b.Path(ctx, a.ID, a.ID, 0, nil)
+		},
+		wantField: "maxPathLength",
+	}, {
+		name: "Neighbors of unknown node",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			id, err := unknownNode(ctx, b)
+			if err != nil {
+				return nil, err
+			}
+			return b.Neighbors(ctx, id, nil)
+		},
+		wantErr: backends.ErrNotFound,
+	}, {
+		name: "Path from unknown node",
+		call: func(ctx context.Context, b backends.Backend) (interface{}, error) {
+			id, err := unknownNode(ctx, b)
+			if err != nil {
+				return nil, err
+			}
+			a, err := b.IngestArtifact(ctx, artifact)
+			if err != nil {
+				return nil, err
+			}
+			return b.Path(ctx, id, a.ID, 2, nil)
+		},
+		wantErr: backends.ErrNotFound,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.call(context.Background(), newBackend(t))
+			if err == nil {
+				t.Fatalf("call did not return an error")
+			}
+			var validationErr *backends.ValidationError
+			isValidation := errors.As(err, &validationErr)
+			if tt.wantField != "" {
+				if !isValidation {
+					t.Fatalf("error = %v, want a ValidationError", err)
+				}
+				if validationErr.Field != tt.wantField {
+					t.Errorf("ValidationError.Field = %q, want %q (error = %v)", validationErr.Field, tt.wantField, err)
+				}
+				return
+			}
+			if isValidation {
+				t.Errorf("error = %v is a ValidationError of %q", err, validationErr.Field)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackendCalls(t *testing.T) {
+	backendType := reflect.TypeOf((*backends.Backend)(nil)).Elem()
+	for i := 0; i < backendType.NumMethod(); i++ {
+		if name := backendType.Method(i).Name; backendCalls[name] == nil {
+			t.Errorf("backendCalls is missing Backend method %s", name)
+		}
+	}
+	if len(backendCalls) != backendType.NumMethod() {
+		t.Errorf("backendCalls has %d methods, Backend has %d", len(backendCalls), backendType.NumMethod())
+	}
+
+	for name, call := range backendCalls {
+		if _, err := call(context.Background(), newBackend(t)); err != nil {
+			t.Errorf("%s() error = %v", name, err)
+		}
+	}
+}
+
+func TestContextDone(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	contexts := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, c := range contexts {
+		for name, call := range backendCalls {
+			t.Run(c.name+"/"+name, func(t *testing.T) {
+				b := newBackend(t)
+				start := time.Now()
+				got, err := call(c.ctx, b)
+				if !errors.Is(err, c.wantErr) {
+					t.Errorf("%s() error = %v, want %v", name, err, c.wantErr)
+				}
+				if v := reflect.ValueOf(got); v.IsValid() && !v.IsNil() {
+					t.Errorf("%s() = %v, want no result", name, got)
+				}
+				if elapsed := time.Since(start); elapsed > time.Second {
+					t.Errorf("%s() took %v to return", name, elapsed)
+				}
+			})
+		}
+	}
+
+	// Nothing was ingested by the calls.
+	b := newBackend(t)
+	for name, call := range backendCalls {
+		if strings.HasPrefix(name, "Ingest") {
+			_, _ = call(cancelled, b)
+		}
+	}
+	artifacts, err := b.Artifacts(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if len(artifacts) != 0 {
+		t.Errorf("Artifacts() = %v, want none ingested with a cancelled context", artifacts)
+	}
+}
@@ -0,0 +1,327 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (b *cacheBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return query(ctx, b, "Artifacts", []interface{}{artifactSpec}, func() ([]*model.Artifact, error) {
+		return b.inner.Artifacts(ctx, artifactSpec)
+	})
+}
+
+func (b *cacheBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	return query(ctx, b, "ArtifactsList", []interface{}{artifactSpec, after, first}, func() (*model.ArtifactConnection, error) {
+		return b.inner.ArtifactsList(ctx, artifactSpec, after, first)
+	})
+}
+
+func (b *cacheBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	return query(ctx, b, "Builders", []interface{}{builderSpec}, func() ([]*model.Builder, error) {
+		return b.inner.Builders(ctx, builderSpec)
+	})
+}
+
+func (b *cacheBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return query(ctx, b, "Packages", []interface{}{pkgSpec}, func() ([]*model.Package, error) {
+		return b.inner.Packages(ctx, pkgSpec)
+	})
+}
+
+func (b *cacheBackend) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	return query(ctx, b, "PackagesByPurls", []interface{}{purls}, func() (map[string]*model.Package, error) {
+		return b.inner.PackagesByPurls(ctx, purls)
+	})
+}
+
+func (b *cacheBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return query(ctx, b, "Sources", []interface{}{sourceSpec}, func() ([]*model.Source, error) {
+		return b.inner.Sources(ctx, sourceSpec)
+	})
+}
+
+func (b *cacheBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return query(ctx, b, "Vulnerabilities", []interface{}{vulnSpec}, func() ([]*model.Vulnerability, error) {
+		return b.inner.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (b *cacheBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return query(ctx, b, "CertifyBad", []interface{}{certifyBadSpec}, func() ([]*model.CertifyBad, error) {
+		return b.inner.CertifyBad(ctx, certifyBadSpec)
+	})
+}
+
+func (b *cacheBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	return query(ctx, b, "CertifyGood", []interface{}{certifyGoodSpec}, func() ([]*model.CertifyGood, error) {
+		return b.inner.CertifyGood(ctx, certifyGoodSpec)
+	})
+}
+
+func (b *cacheBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return query(ctx, b, "CertifyLegal", []interface{}{certifyLegalSpec}, func() ([]*model.CertifyLegal, error) {
+		return b.inner.CertifyLegal(ctx, certifyLegalSpec)
+	})
+}
+
+func (b *cacheBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return query(ctx, b, "CertifyVuln", []interface{}{certifyVulnSpec}, func() ([]*model.CertifyVuln, error) {
+		return b.inner.CertifyVuln(ctx, certifyVulnSpec)
+	})
+}
+
+func (b *cacheBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	return query(ctx, b, "CertifyVEXStatement", []interface{}{certifyVEXStatementSpec}, func() ([]*model.CertifyVEXStatement, error) {
+		return b.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+}
+
+func (b *cacheBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	return query(ctx, b, "HashEqual", []interface{}{hashEqualSpec}, func() ([]*model.HashEqual, error) {
+		return b.inner.HashEqual(ctx, hashEqualSpec)
+	})
+}
+
+func (b *cacheBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return query(ctx, b, "HasSBOM", []interface{}{hasSBOMSpec}, func() ([]*model.HasSbom, error) {
+		return b.inner.HasSBOM(ctx, hasSBOMSpec)
+	})
+}
+
+func (b *cacheBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	return query(ctx, b, "HasMetadata", []interface{}{hasMetadataSpec}, func() ([]*model.HasMetadata, error) {
+		return b.inner.HasMetadata(ctx, hasMetadataSpec)
+	})
+}
+
+func (b *cacheBackend) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	return query(ctx, b, "HasSLSA", []interface{}{hasSLSASpec}, func() ([]*model.HasSlsa, error) {
+		return b.inner.HasSLSA(ctx, hasSLSASpec)
+	})
+}
+
+func (b *cacheBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return query(ctx, b, "IsDependency", []interface{}{isDependencySpec}, func() ([]*model.IsDependency, error) {
+		return b.inner.IsDependency(ctx, isDependencySpec)
+	})
+}
+
+func (b *cacheBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	return query(ctx, b, "IsOccurrence", []interface{}{isOccurrenceSpec}, func() ([]*model.IsOccurrence, error) {
+		return b.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+}
+
+func (b *cacheBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return query(ctx, b, "PkgEqual", []interface{}{pkgEqualSpec}, func() ([]*model.PkgEqual, error) {
+		return b.inner.PkgEqual(ctx, pkgEqualSpec)
+	})
+}
+
+func (b *cacheBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return query(ctx, b, "PointOfContact", []interface{}{pointOfContactSpec}, func() ([]*model.PointOfContact, error) {
+		return b.inner.PointOfContact(ctx, pointOfContactSpec)
+	})
+}
+
+func (b *cacheBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	return query(ctx, b, "Scorecards", []interface{}{certifyScorecardSpec}, func() ([]*model.CertifyScorecard, error) {
+		return b.inner.Scorecards(ctx, certifyScorecardSpec)
+	})
+}
+
+func (b *cacheBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return query(ctx, b, "VulnEqual", []interface{}{vulnEqualSpec}, func() ([]*model.VulnEqual, error) {
+		return b.inner.VulnEqual(ctx, vulnEqualSpec)
+	})
+}
+
+func (b *cacheBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return query(ctx, b, "Neighbors", []interface{}{node, usingOnly}, func() ([]model.Node, error) {
+		return b.inner.Neighbors(ctx, node, usingOnly)
+	})
+}
+
+func (b *cacheBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	return query(ctx, b, "Path", []interface{}{subject, target, maxPathLength, usingOnly}, func() ([]model.Node, error) {
+		return b.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	})
+}
+
+func (b *cacheBackend) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	return query(ctx, b, "FindSBOMsByArtifact", []interface{}{algorithm, digest}, func() ([]*model.HasSbom, error) {
+		return b.inner.FindSBOMsByArtifact(ctx, algorithm, digest)
+	})
+}
+
+func (b *cacheBackend) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	return query(ctx, b, "TransitiveDependencies", []interface{}{pkgSpec, maxDepth}, func() ([]*model.Package, error) {
+		return b.inner.TransitiveDependencies(ctx, pkgSpec, maxDepth)
+	})
+}
+
+func (b *cacheBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return ingest(b, "IngestArtifact", func() (*model.Artifact, error) {
+		return b.inner.IngestArtifact(ctx, artifact)
+	})
+}
+
+func (b *cacheBackend) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return ingest(b, "IngestArtifacts", func() ([]*model.Artifact, error) {
+		return b.inner.IngestArtifacts(ctx, artifacts)
+	})
+}
+
+func (b *cacheBackend) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	return ingest(b, "IngestBuilder", func() (*model.Builder, error) {
+		return b.inner.IngestBuilder(ctx, builder)
+	})
+}
+
+func (b *cacheBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return ingest(b, "IngestPackage", func() (*model.Package, error) {
+		return b.inner.IngestPackage(ctx, pkg)
+	})
+}
+
+func (b *cacheBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return ingest(b, "IngestPackages", func() ([]*model.Package, error) {
+		return b.inner.IngestPackages(ctx, pkgs)
+	})
+}
+
+func (b *cacheBackend) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	return ingest(b, "IngestSource", func() (*model.Source, error) {
+		return b.inner.IngestSource(ctx, source)
+	})
+}
+
+func (b *cacheBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	return ingest(b, "IngestSources", func() ([]*model.Source, error) {
+		return b.inner.IngestSources(ctx, sources)
+	})
+}
+
+func (b *cacheBackend) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	return ingest(b, "IngestVulnerability", func() (*model.Vulnerability, error) {
+		return b.inner.IngestVulnerability(ctx, vuln)
+	})
+}
+
+func (b *cacheBackend) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return ingest(b, "IngestCertifyBad", func() (*model.CertifyBad, error) {
+		return b.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *cacheBackend) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	return ingest(b, "IngestCertifyGood", func() (*model.CertifyGood, error) {
+		return b.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	})
+}
+
+func (b *cacheBackend) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return ingest(b, "IngestCertifyLegal", func() (*model.CertifyLegal, error) {
+		return b.inner.IngestCertifyLegal(ctx, subject, certifyLegal)
+	})
+}
+
+func (b *cacheBackend) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	return ingest(b, "IngestCertifyVuln", func() (*model.CertifyVuln, error) {
+		return b.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *cacheBackend) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	return ingest(b, "IngestHashEqual", func() (*model.HashEqual, error) {
+		return b.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	})
+}
+
+func (b *cacheBackend) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	return ingest(b, "IngestHasMetadata", func() (*model.HasMetadata, error) {
+		return b.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	})
+}
+
+func (b *cacheBackend) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	return ingest(b, "IngestHasSbom", func() (*model.HasSbom, error) {
+		return b.inner.IngestHasSbom(ctx, subject, hasSbom)
+	})
+}
+
+func (b *cacheBackend) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	return ingest(b, "IngestSLSA", func() (*model.HasSlsa, error) {
+		return b.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	})
+}
+
+func (b *cacheBackend) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return ingest(b, "IngestIsDependency", func() (*model.IsDependency, error) {
+		return b.inner.IngestIsDependency(ctx, pkg, depPkg, dependency)
+	})
+}
+
+func (b *cacheBackend) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	return ingest(b, "IngestIsOccurrence", func() (*model.IsOccurrence, error) {
+		return b.inner.IngestIsOccurrence(ctx, subject, artifact, occurrence)
+	})
+}
+
+func (b *cacheBackend) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return ingest(b, "IngestPkgEqual", func() (*model.PkgEqual, error) {
+		return b.inner.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+	})
+}
+
+func (b *cacheBackend) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return ingest(b, "IngestPointOfContact", func() (*model.PointOfContact, error) {
+		return b.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	})
+}
+
+func (b *cacheBackend) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return ingest(b, "IngestScorecard", func() (*model.CertifyScorecard, error) {
+		return b.inner.IngestScorecard(ctx, source, scorecard)
+	})
+}
+
+func (b *cacheBackend) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	return ingest(b, "IngestVEXStatement", func() (*model.CertifyVEXStatement, error) {
+		return b.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *cacheBackend) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return ingest(b, "IngestVulnEqual", func() (*model.VulnEqual, error) {
+		return b.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	})
+}
+
+// SubscribeArtifacts is not cached, the events are sent by the inner backend.
+func (b *cacheBackend) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	return b.inner.SubscribeArtifacts(ctx)
+}
+
+// Ping is not cached, so that a probe reports the current state of the
+// datastore.
+func (b *cacheBackend) Ping(ctx context.Context) error {
+	return b.inner.Ping(ctx)
+}
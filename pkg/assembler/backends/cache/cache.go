@@ -0,0 +1,251 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a backend memoizing the results of the queries of
+// another backend.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Stats are the counters of the cache of a backend returned by Wrap.
+type Stats struct {
+	// Hits is the number of queries answered from the cache.
+	Hits uint64
+	// Misses is the number of queries sent to the inner backend.
+	Misses uint64
+	// Evictions is the number of results removed from the cache to make
+	// room for new ones, or because they expired.
+	Evictions uint64
+	// Entries is the number of results currently cached.
+	Entries int
+}
+
+// StatsReporter is implemented by the backends returned by Wrap.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// pathQueries are invalidated by all the ingestions, as the queries walking
+// the edges may return any node.
+var pathQueries = []string{"Neighbors", "Path", "FindSBOMsByArtifact", "TransitiveDependencies"}
+
+// invalidations lists, for each ingestion, the queries whose results it may
+// change, in addition to pathQueries. The ingestions of evidence also ingest
+// the nodes they refer to.
+var invalidations = map[string][]string{
+	"IngestArtifact":       {"Artifacts", "ArtifactsList"},
+	"IngestArtifacts":      {"Artifacts", "ArtifactsList"},
+	"IngestBuilder":        {"Builders"},
+	"IngestPackage":        {"Packages", "PackagesByPurls"},
+	"IngestPackages":       {"Packages", "PackagesByPurls"},
+	"IngestSource":         {"Sources"},
+	"IngestSources":        {"Sources"},
+	"IngestVulnerability":  {"Vulnerabilities"},
+	"IngestCertifyBad":     {"CertifyBad", "Packages", "PackagesByPurls", "Sources", "Artifacts", "ArtifactsList"},
+	"IngestCertifyGood":    {"CertifyGood", "Packages", "PackagesByPurls", "Sources", "Artifacts", "ArtifactsList"},
+	"IngestCertifyLegal":   {"CertifyLegal", "Packages", "PackagesByPurls", "Sources"},
+	"IngestCertifyVuln":    {"CertifyVuln", "Packages", "PackagesByPurls", "Vulnerabilities"},
+	"IngestHashEqual":      {"HashEqual", "Artifacts", "ArtifactsList"},
+	"IngestHasMetadata":    {"HasMetadata", "Packages", "PackagesByPurls", "Sources", "Artifacts", "ArtifactsList"},
+	"IngestHasSbom":        {"HasSBOM", "Packages", "PackagesByPurls", "Artifacts", "ArtifactsList"},
+	"IngestSLSA":           {"HasSLSA", "Artifacts", "ArtifactsList", "Builders"},
+	"IngestIsDependency":   {"IsDependency", "Packages", "PackagesByPurls"},
+	"IngestIsOccurrence":   {"IsOccurrence", "Packages", "PackagesByPurls", "Sources", "Artifacts", "ArtifactsList"},
+	"IngestPkgEqual":       {"PkgEqual", "Packages", "PackagesByPurls"},
+	"IngestPointOfContact": {"PointOfContact", "Packages", "PackagesByPurls", "Sources", "Artifacts", "ArtifactsList"},
+	"IngestScorecard":      {"Scorecards", "Sources"},
+	"IngestVEXStatement":   {"CertifyVEXStatement", "Packages", "PackagesByPurls", "Artifacts", "ArtifactsList", "Vulnerabilities"},
+	"IngestVulnEqual":      {"VulnEqual", "Vulnerabilities"},
+}
+
+type entry struct {
+	method  string
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+type cacheBackend struct {
+	inner      backends.Backend
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	lock sync.Mutex
+	// lru holds the entries, the most recently used first.
+	lru     *list.List
+	entries map[string]*list.Element
+	// generations counts the invalidations of each query, so that the
+	// results of the queries started before an invalidation are not cached.
+	generations map[string]uint64
+	stats       Stats
+}
+
+// Wrap returns a backend delegating to inner, and memoizing the results of
+// the queries for ttl. At most maxEntries results are cached, evicting the
+// least recently used ones. A ttl or maxEntries which is not positive disables
+// the corresponding limit.
+//
+// The results are keyed by the query and its arguments, so only identical
+// queries share a result, up to the canonicalization of the artifact,
+// package and source specs. The ingestions invalidate the results of all the
+// queries they may change. Cached results are shared between callers, which
+// must not modify them. The returned backend implements StatsReporter.
+func Wrap(inner backends.Backend, ttl time.Duration, maxEntries int) backends.Backend {
+	return &cacheBackend{
+		inner:       inner,
+		ttl:         ttl,
+		maxEntries:  maxEntries,
+		now:         time.Now,
+		lru:         list.New(),
+		entries:     map[string]*list.Element{},
+		generations: map[string]uint64{},
+	}
+}
+
+// IdempotentIngestion implements retry.IdempotentBackend, so that the
+// ingestions are still retried when inner can run them again: each attempt
+// invalidates the cached queries.
+func (b *cacheBackend) IdempotentIngestion(method string) bool {
+	i, ok := b.inner.(retry.IdempotentBackend)
+	return ok && i.IdempotentIngestion(method)
+}
+
+func (b *cacheBackend) Stats() Stats {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	stats := b.stats
+	stats.Entries = b.lru.Len()
+	return stats
+}
+
+// query returns the cached result of method called with args, calling f to
+// get it on a miss. Errors are not cached. The artifact, package and source
+// specs are canonicalized in the key, as the backends do when matching them.
+func query[T any](ctx context.Context, b *cacheBackend, method string, args []interface{}, f func() (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+	for i, arg := range args {
+		switch spec := arg.(type) {
+		case *model.ArtifactSpec:
+			args[i] = backends.CanonicalArtifactSpec(spec)
+		case *model.PkgSpec:
+			args[i] = backends.CanonicalPkgSpec(spec)
+		case *model.SourceSpec:
+			args[i] = backends.CanonicalSourceSpec(spec)
+		}
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return f()
+	}
+	key := method + string(encoded)
+
+	value, ok, generation := b.get(key, method)
+	if ok {
+		return value.(T), nil
+	}
+	result, err := f()
+	if err != nil {
+		return result, err
+	}
+	b.put(key, method, generation, result)
+	return result, nil
+}
+
+// ingest runs the ingestion method through f, then invalidates the queries
+// listed in invalidations. This is done even if f fails, as some nodes may
+// have been ingested.
+func ingest[T any](b *cacheBackend, method string, f func() (T, error)) (T, error) {
+	result, err := f()
+	b.invalidate(append(invalidations[method], pathQueries...))
+	return result, err
+}
+
+// get returns the unexpired value of key, and the current generation of
+// method.
+func (b *cacheBackend) get(key, method string) (interface{}, bool, uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	generation := b.generations[method]
+	elem, ok := b.entries[key]
+	if ok && b.ttl > 0 && !b.now().Before(elem.Value.(*entry).expires) {
+		b.remove(elem)
+		b.stats.Evictions++
+		ok = false
+	}
+	if !ok {
+		b.stats.Misses++
+		return nil, false, generation
+	}
+	b.stats.Hits++
+	b.lru.MoveToFront(elem)
+	return elem.Value.(*entry).value, true, generation
+}
+
+// put caches the value of key, unless method has been invalidated since its
+// generation was returned by get.
+func (b *cacheBackend) put(key, method string, generation uint64, value interface{}) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.generations[method] != generation {
+		return
+	}
+	if elem, ok := b.entries[key]; ok {
+		// Cached by a concurrent query.
+		b.remove(elem)
+	}
+	e := &entry{method: method, key: key, value: value, expires: b.now().Add(b.ttl)}
+	b.entries[key] = b.lru.PushFront(e)
+	for b.maxEntries > 0 && b.lru.Len() > b.maxEntries {
+		b.remove(b.lru.Back())
+		b.stats.Evictions++
+	}
+}
+
+// invalidate removes the results of the methods from the cache.
+func (b *cacheBackend) invalidate(methods []string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	invalidated := map[string]bool{}
+	for _, method := range methods {
+		b.generations[method]++
+		invalidated[method] = true
+	}
+	for elem := b.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if invalidated[elem.Value.(*entry).method] {
+			b.remove(elem)
+		}
+		elem = next
+	}
+}
+
+func (b *cacheBackend) remove(elem *list.Element) {
+	b.lru.Remove(elem)
+	delete(b.entries, elem.Value.(*entry).key)
+}
@@ -0,0 +1,302 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var (
+	sha256Artifact = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	sha1Artifact   = &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"}
+)
+
+func newCache(t *testing.T, ttl time.Duration, maxEntries int) *cacheBackend {
+	t.Helper()
+	inner, err := inmem.New(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("inmem.New() error = %v", err)
+	}
+	return Wrap(inner, ttl, maxEntries).(*cacheBackend)
+}
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+// checkStats checks the hits and misses counted since the previous call.
+func checkStats(t *testing.T, b *cacheBackend, previous *Stats, wantHits, wantMisses uint64) {
+	t.Helper()
+	stats := b.Stats()
+	if hits := stats.Hits - previous.Hits; hits != wantHits {
+		t.Errorf("%d hits, want %d", hits, wantHits)
+	}
+	if misses := stats.Misses - previous.Misses; misses != wantMisses {
+		t.Errorf("%d misses, want %d", misses, wantMisses)
+	}
+	*previous = stats
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	b := newCache(t, time.Hour, 100)
+	var stats Stats
+
+	if _, err := b.IngestArtifact(ctx, sha256Artifact); err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom("sha256")}); err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+	}
+	checkStats(t, b, &stats, 2, 1)
+
+	// Canonical specs share the key.
+	if _, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom(" SHA256 ")}); err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	checkStats(t, b, &stats, 1, 0)
+
+	// Other arguments are another key.
+	if _, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom("sha1")}); err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if _, err := b.Artifacts(ctx, nil); err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if _, err := b.Packages(ctx, nil); err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	checkStats(t, b, &stats, 0, 3)
+	if entries := b.Stats().Entries; entries != 4 {
+		t.Errorf("%d entries, want 4", entries)
+	}
+
+	// Errors are not cached.
+	for i := 0; i < 2; i++ {
+		if _, err := b.ArtifactsList(ctx, nil, ptrfrom("invalid"), nil); err == nil {
+			t.Fatalf("ArtifactsList() with an invalid cursor did not return an error")
+		}
+	}
+	checkStats(t, b, &stats, 0, 2)
+
+	// Nor are the calls with a done context.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if got, err := b.Artifacts(cancelled, nil); err != context.Canceled || got != nil {
+		t.Errorf("Artifacts() = %v, %v, want no result and %v", got, err, context.Canceled)
+	}
+	checkStats(t, b, &stats, 0, 0)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+	b := newCache(t, time.Hour, 100)
+	var stats Stats
+
+	read := func() []*model.Artifact {
+		t.Helper()
+		artifacts, err := b.Artifacts(ctx, nil)
+		if err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+		if _, err := b.Packages(ctx, nil); err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+		return artifacts
+	}
+
+	if got := read(); len(got) != 0 {
+		t.Errorf("Artifacts() = %v, want none", got)
+	}
+	if _, err := b.IngestArtifact(ctx, sha256Artifact); err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	// The artifacts are read again, the packages are still cached.
+	if got := read(); len(got) != 1 {
+		t.Errorf("Artifacts() = %v, want the ingested artifact", got)
+	}
+	checkStats(t, b, &stats, 1, 3)
+
+	// Evidence ingestion invalidates the nodes it refers to.
+	if _, err := b.IngestHashEqual(ctx, sha256Artifact, sha1Artifact, &model.HashEqualInputSpec{Justification: "test"}); err != nil {
+		t.Fatalf("IngestHashEqual() error = %v", err)
+	}
+	if got := read(); len(got) != 2 {
+		t.Errorf("Artifacts() = %v, want 2 artifacts", got)
+	}
+	checkStats(t, b, &stats, 1, 1)
+
+	// Failed ingestions invalidate too.
+	if _, err := b.IngestPackage(ctx, nil); err == nil {
+		t.Fatalf("IngestPackage() without package did not return an error")
+	}
+	read()
+	checkStats(t, b, &stats, 1, 1)
+}
+
+// flakyBackend fails the first ingestion of a package with a transient error.
+type flakyBackend struct {
+	backends.Backend
+	idempotent bool
+	calls      int
+}
+
+func (b *flakyBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	b.calls++
+	if b.calls == 1 {
+		return nil, backends.ErrTransient
+	}
+	return b.Backend.IngestPackage(ctx, pkg)
+}
+
+func (b *flakyBackend) IdempotentIngestion(method string) bool {
+	return b.idempotent
+}
+
+func TestCacheRetried(t *testing.T) {
+	ctx := context.Background()
+	pkg := &model.PkgInputSpec{Type: "pypi", Name: "django"}
+	for _, idempotent := range []bool{true, false} {
+		inner, err := inmem.New(ctx, nil)
+		if err != nil {
+			t.Fatalf("inmem.New() error = %v", err)
+		}
+		flaky := &flakyBackend{Backend: inner, idempotent: idempotent}
+		b := retry.Wrap(Wrap(flaky, time.Hour, 100), retry.RetryOptions{InitialBackoff: time.Millisecond})
+		if _, err := b.Packages(ctx, nil); err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+
+		_, err = b.IngestPackage(ctx, pkg)
+		if !idempotent {
+			if !errors.Is(err, backends.ErrTransient) || flaky.calls != 1 {
+				t.Errorf("IngestPackage() of a backend without idempotent ingestions error = %v after %d calls, want %v after 1", err, flaky.calls, backends.ErrTransient)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if flaky.calls != 2 {
+			t.Errorf("IngestPackage() called the backend %d times, want 2", flaky.calls)
+		}
+		// The retried ingestion invalidates the packages.
+		packages, err := b.Packages(ctx, nil)
+		if err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+		if len(packages) != 1 {
+			t.Errorf("Packages() = %v, want the ingested package", packages)
+		}
+	}
+}
+
+func TestCacheInvalidationDuringQuery(t *testing.T) {
+	b := newCache(t, time.Hour, 100)
+	_, _, generation := b.get("Artifacts[null]", "Artifacts")
+	b.invalidate([]string{"Artifacts"})
+	// The result read before the invalidation is not cached.
+	b.put("Artifacts[null]", "Artifacts", generation, []*model.Artifact{})
+	if _, ok, _ := b.get("Artifacts[null]", "Artifacts"); ok {
+		t.Errorf("result of a query started before an invalidation is cached")
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	b := newCache(t, time.Minute, 100)
+	now := time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC)
+	b.now = func() time.Time { return now }
+	var stats Stats
+
+	for i := 0; i < 2; i++ {
+		if _, err := b.Artifacts(ctx, nil); err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+	}
+	checkStats(t, b, &stats, 1, 1)
+
+	now = now.Add(time.Minute)
+	if _, err := b.Artifacts(ctx, nil); err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	checkStats(t, b, &stats, 0, 1)
+	if evictions := b.Stats().Evictions; evictions != 1 {
+		t.Errorf("%d evictions, want 1", evictions)
+	}
+}
+
+func TestCacheLRU(t *testing.T) {
+	ctx := context.Background()
+	b := newCache(t, 0, 2)
+	var stats Stats
+
+	query := func(algorithm string) {
+		t.Helper()
+		if _, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &algorithm}); err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+	}
+	query("sha1")
+	query("sha256")
+	query("sha1")
+	// Evicts sha256, the least recently used.
+	query("sha512")
+	checkStats(t, b, &stats, 1, 3)
+	query("sha1")
+	checkStats(t, b, &stats, 1, 0)
+	query("sha256")
+	checkStats(t, b, &stats, 0, 1)
+
+	got := b.Stats()
+	if got.Entries != 2 || got.Evictions != 2 {
+		t.Errorf("Stats() = %+v, want 2 entries and 2 evictions", got)
+	}
+}
+
+func TestInvalidations(t *testing.T) {
+	backendType := reflect.TypeOf((*backends.Backend)(nil)).Elem()
+	methods := map[string]bool{}
+	for i := 0; i < backendType.NumMethod(); i++ {
+		name := backendType.Method(i).Name
+		methods[name] = true
+		if _, ok := invalidations[name]; strings.HasPrefix(name, "Ingest") && !ok {
+			t.Errorf("invalidations is missing ingestion %s", name)
+		}
+	}
+	for ingestion, queries := range invalidations {
+		if !methods[ingestion] {
+			t.Errorf("invalidations has unknown ingestion %s", ingestion)
+		}
+		for _, q := range append(queries, pathQueries...) {
+			if !methods[q] || strings.HasPrefix(q, "Ingest") {
+				t.Errorf("%s invalidates unknown query %s", ingestion, q)
+			}
+		}
+	}
+}
@@ -0,0 +1,256 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// The backends canonicalize the artifact, package and source specs, both for
+// ingestion and queries, so that values differing only in casing, ordering or
+// surrounding whitespace refer to the same nodes. The functions below return
+// canonical copies, leaving their argument unchanged. Nil specs are returned
+// as is.
+
+// sourceTypeAliases maps the alternative names of the version control
+// systems to the source type used for them.
+var sourceTypeAliases = map[string]string{
+	"bazaar":     "bzr",
+	"mercurial":  "hg",
+	"subversion": "svn",
+}
+
+// CanonicalSourceType returns the trimmed, lowercase source type, replacing
+// the full names of the version control systems by their commands, e.g.
+// "Mercurial" by "hg".
+func CanonicalSourceType(sourceType string) string {
+	sourceType = strings.ToLower(strings.TrimSpace(sourceType))
+	if alias, ok := sourceTypeAliases[sourceType]; ok {
+		return alias
+	}
+	return sourceType
+}
+
+// CanonicalArtifactSpec canonicalizes the algorithm and digest of the spec
+// to their trimmed lowercase values.
+func CanonicalArtifactSpec(spec *model.ArtifactSpec) *model.ArtifactSpec {
+	if spec == nil {
+		return nil
+	}
+	return &model.ArtifactSpec{
+		ID:        spec.ID,
+		Algorithm: mapIfSet(spec.Algorithm, lower),
+		Digest:    mapIfSet(spec.Digest, lower),
+	}
+}
+
+// CanonicalArtifactInputSpec is the same as CanonicalArtifactSpec for
+// ingestion.
+func CanonicalArtifactInputSpec(spec *model.ArtifactInputSpec) *model.ArtifactInputSpec {
+	if spec == nil {
+		return nil
+	}
+	return &model.ArtifactInputSpec{
+		Algorithm: lower(spec.Algorithm),
+		Digest:    lower(spec.Digest),
+	}
+}
+
+// CanonicalArtifact returns the canonical algorithm and digest of the input,
+// validating that the digest is hex encoded.
+func CanonicalArtifact(artifact *model.ArtifactInputSpec) (string, string, error) {
+	canonical := CanonicalArtifactInputSpec(artifact)
+	if canonical.Algorithm == "" {
+		return "", "", NewValidationError("algorithm", "algorithm must not be empty")
+	}
+	if _, err := hex.DecodeString(canonical.Digest); err != nil || canonical.Digest == "" {
+		return "", "", NewValidationError("digest", "digest %q is not hex encoded", artifact.Digest)
+	}
+	return canonical.Algorithm, canonical.Digest, nil
+}
+
+// CanonicalSourceSpec canonicalizes the type of the spec with
+// CanonicalSourceType and the commit to its lowercase value. All the values
+// are trimmed, other casings being preserved.
+func CanonicalSourceSpec(spec *model.SourceSpec) *model.SourceSpec {
+	if spec == nil {
+		return nil
+	}
+	return &model.SourceSpec{
+		Type:      mapIfSet(spec.Type, CanonicalSourceType),
+		Namespace: mapIfSet(spec.Namespace, strings.TrimSpace),
+		Name:      mapIfSet(spec.Name, strings.TrimSpace),
+		Tag:       mapIfSet(spec.Tag, strings.TrimSpace),
+		Commit:    mapIfSet(spec.Commit, lower),
+	}
+}
+
+// CanonicalSourceInputSpec is the same as CanonicalSourceSpec for ingestion.
+func CanonicalSourceInputSpec(spec *model.SourceInputSpec) *model.SourceInputSpec {
+	if spec == nil {
+		return nil
+	}
+	return &model.SourceInputSpec{
+		Type:      CanonicalSourceType(spec.Type),
+		Namespace: strings.TrimSpace(spec.Namespace),
+		Name:      strings.TrimSpace(spec.Name),
+		Tag:       mapIfSet(spec.Tag, strings.TrimSpace),
+		Commit:    mapIfSet(spec.Commit, lower),
+	}
+}
+
+// pkgNameCasing tells which of the namespace and the name of a package type
+// are case insensitive.
+type pkgNameCasing struct {
+	namespace bool
+	name      bool
+}
+
+// caseInsensitivePkgTypes lists the package types whose namespaces or names
+// are case insensitive in the pURL specification, and so lowercased. The
+// namespaces and names of the other types, e.g. maven or golang, are case
+// sensitive.
+var caseInsensitivePkgTypes = map[string]pkgNameCasing{
+	"alpm":      {namespace: true, name: true},
+	"apk":       {namespace: true, name: true},
+	"bitbucket": {namespace: true, name: true},
+	"composer":  {namespace: true, name: true},
+	"deb":       {namespace: true, name: true},
+	"github":    {namespace: true, name: true},
+	"hex":       {namespace: true, name: true},
+	"npm":       {namespace: true, name: true},
+	"pypi":      {name: true},
+	"rpm":       {namespace: true},
+}
+
+// CanonicalPkgNamespace returns the trimmed namespace of a package of the
+// canonical pkgType, lowercased if it is case insensitive for that type.
+func CanonicalPkgNamespace(pkgType, namespace string) string {
+	if caseInsensitivePkgTypes[pkgType].namespace {
+		return lower(namespace)
+	}
+	return strings.TrimSpace(namespace)
+}
+
+// CanonicalPkgName is the same as CanonicalPkgNamespace for the name. The
+// underscores in the names of pypi packages are also replaced by dashes, as
+// pip does.
+func CanonicalPkgName(pkgType, name string) string {
+	if pkgType == "pypi" {
+		name = strings.ReplaceAll(name, "_", "-")
+	}
+	if caseInsensitivePkgTypes[pkgType].name {
+		return lower(name)
+	}
+	return strings.TrimSpace(name)
+}
+
+// CanonicalPkgSpec canonicalizes the type of the spec to its trimmed
+// lowercase value and, if the type is set, the namespace and name with
+// CanonicalPkgNamespace and CanonicalPkgName. Without a type, they are only
+// trimmed, as are the version and subpath. The qualifiers get trimmed
+// lowercase keys and trimmed values, like in CanonicalPkgInputSpec. They are
+// dropped if MatchOnlyEmptyQualifiers is true, which is otherwise set to nil,
+// so that backends only have to check the qualifiers which are left. The pURL
+// is left unchanged.
+func CanonicalPkgSpec(spec *model.PkgSpec) *model.PkgSpec {
+	if spec == nil {
+		return nil
+	}
+	pkgType := mapIfSet(spec.Type, lower)
+	namespace := mapIfSet(spec.Namespace, strings.TrimSpace)
+	name := mapIfSet(spec.Name, strings.TrimSpace)
+	if pkgType != nil {
+		namespace = mapIfSet(spec.Namespace, func(s string) string { return CanonicalPkgNamespace(*pkgType, s) })
+		name = mapIfSet(spec.Name, func(s string) string { return CanonicalPkgName(*pkgType, s) })
+	}
+	var qualifiers []*model.PackageQualifierSpec
+	var matchOnlyEmptyQualifiers *bool
+	if spec.MatchOnlyEmptyQualifiers != nil && *spec.MatchOnlyEmptyQualifiers {
+		matchOnlyEmptyQualifiers = spec.MatchOnlyEmptyQualifiers
+	} else {
+		for _, q := range spec.Qualifiers {
+			qualifiers = append(qualifiers, &model.PackageQualifierSpec{
+				Key:   lower(q.Key),
+				Value: mapIfSet(q.Value, strings.TrimSpace),
+			})
+		}
+	}
+	return &model.PkgSpec{
+		Type:                     pkgType,
+		Namespace:                namespace,
+		Name:                     name,
+		Version:                  mapIfSet(spec.Version, strings.TrimSpace),
+		Qualifiers:               qualifiers,
+		MatchOnlyEmptyQualifiers: matchOnlyEmptyQualifiers,
+		Subpath:                  mapIfSet(spec.Subpath, strings.TrimSpace),
+		Purl:                     spec.Purl,
+	}
+}
+
+// CanonicalPkgInputSpec is the same as CanonicalPkgSpec for ingestion. The
+// qualifiers get trimmed lowercase keys and are sorted by key, then value.
+// As in pURLs, qualifiers with an empty value are dropped.
+func CanonicalPkgInputSpec(spec *model.PkgInputSpec) *model.PkgInputSpec {
+	if spec == nil {
+		return nil
+	}
+	pkgType := lower(spec.Type)
+	var qualifiers []*model.PackageQualifierInputSpec
+	for _, q := range spec.Qualifiers {
+		value := strings.TrimSpace(q.Value)
+		if value == "" {
+			continue
+		}
+		qualifiers = append(qualifiers, &model.PackageQualifierInputSpec{Key: lower(q.Key), Value: value})
+	}
+	sort.Slice(qualifiers, func(i, j int) bool {
+		if qualifiers[i].Key != qualifiers[j].Key {
+			return qualifiers[i].Key < qualifiers[j].Key
+		}
+		return qualifiers[i].Value < qualifiers[j].Value
+	})
+	return &model.PkgInputSpec{
+		Type:       pkgType,
+		Namespace:  mapIfSet(spec.Namespace, func(s string) string { return CanonicalPkgNamespace(pkgType, s) }),
+		Name:       CanonicalPkgName(pkgType, spec.Name),
+		Version:    mapIfSet(spec.Version, strings.TrimSpace),
+		Qualifiers: qualifiers,
+		Subpath:    mapIfSet(spec.Subpath, strings.TrimSpace),
+	}
+}
+
+// LowerIfSet returns the trimmed, lowercase filter value, for the values which
+// are stored in lowercase, such as the vulnerability IDs.
+func LowerIfSet(s *string) *string {
+	return mapIfSet(s, lower)
+}
+
+func lower(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+func mapIfSet(s *string, f func(string) string) *string {
+	if s == nil {
+		return nil
+	}
+	v := f(*s)
+	return &v
+}
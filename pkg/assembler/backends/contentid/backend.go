@@ -0,0 +1,416 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentid
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (b *contentIDBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := b.nativeSpec(ctx, &artifactSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Artifact, error) {
+		return b.inner.Artifacts(ctx, artifactSpec)
+	})
+}
+
+func (b *contentIDBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	if err := b.nativeSpec(ctx, &artifactSpec); err != nil {
+		return nil, err
+	}
+	return query(func() (*model.ArtifactConnection, error) {
+		return b.inner.ArtifactsList(ctx, artifactSpec, after, first)
+	})
+}
+
+func (b *contentIDBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	if err := b.nativeSpec(ctx, &builderSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Builder, error) {
+		return b.inner.Builders(ctx, builderSpec)
+	})
+}
+
+func (b *contentIDBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	if err := b.nativeSpec(ctx, &pkgSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Package, error) {
+		return b.inner.Packages(ctx, pkgSpec)
+	})
+}
+
+func (b *contentIDBackend) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	return query(func() (map[string]*model.Package, error) {
+		return b.inner.PackagesByPurls(ctx, purls)
+	})
+}
+
+func (b *contentIDBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	if err := b.nativeSpec(ctx, &sourceSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Source, error) {
+		return b.inner.Sources(ctx, sourceSpec)
+	})
+}
+
+func (b *contentIDBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	if err := b.nativeSpec(ctx, &vulnSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Vulnerability, error) {
+		return b.inner.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (b *contentIDBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if err := b.nativeSpec(ctx, &certifyBadSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyBad, error) {
+		return b.inner.CertifyBad(ctx, certifyBadSpec)
+	})
+}
+
+func (b *contentIDBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if err := b.nativeSpec(ctx, &certifyGoodSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyGood, error) {
+		return b.inner.CertifyGood(ctx, certifyGoodSpec)
+	})
+}
+
+func (b *contentIDBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	if err := b.nativeSpec(ctx, &certifyLegalSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyLegal, error) {
+		return b.inner.CertifyLegal(ctx, certifyLegalSpec)
+	})
+}
+
+func (b *contentIDBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if err := b.nativeSpec(ctx, &certifyVulnSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyVuln, error) {
+		return b.inner.CertifyVuln(ctx, certifyVulnSpec)
+	})
+}
+
+func (b *contentIDBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if err := b.nativeSpec(ctx, &certifyVEXStatementSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyVEXStatement, error) {
+		return b.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+}
+
+func (b *contentIDBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if err := b.nativeSpec(ctx, &hashEqualSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.HashEqual, error) {
+		return b.inner.HashEqual(ctx, hashEqualSpec)
+	})
+}
+
+func (b *contentIDBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := b.nativeSpec(ctx, &hasSBOMSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.HasSbom, error) {
+		return b.inner.HasSBOM(ctx, hasSBOMSpec)
+	})
+}
+
+func (b *contentIDBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	if err := b.nativeSpec(ctx, &hasMetadataSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.HasMetadata, error) {
+		return b.inner.HasMetadata(ctx, hasMetadataSpec)
+	})
+}
+
+func (b *contentIDBackend) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if err := b.nativeSpec(ctx, &hasSLSASpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.HasSlsa, error) {
+		return b.inner.HasSLSA(ctx, hasSLSASpec)
+	})
+}
+
+func (b *contentIDBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	if err := b.nativeSpec(ctx, &isDependencySpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.IsDependency, error) {
+		return b.inner.IsDependency(ctx, isDependencySpec)
+	})
+}
+
+func (b *contentIDBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if err := b.nativeSpec(ctx, &isOccurrenceSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.IsOccurrence, error) {
+		return b.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+}
+
+func (b *contentIDBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	if err := b.nativeSpec(ctx, &pkgEqualSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.PkgEqual, error) {
+		return b.inner.PkgEqual(ctx, pkgEqualSpec)
+	})
+}
+
+func (b *contentIDBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	if err := b.nativeSpec(ctx, &pointOfContactSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.PointOfContact, error) {
+		return b.inner.PointOfContact(ctx, pointOfContactSpec)
+	})
+}
+
+func (b *contentIDBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if err := b.nativeSpec(ctx, &certifyScorecardSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.CertifyScorecard, error) {
+		return b.inner.Scorecards(ctx, certifyScorecardSpec)
+	})
+}
+
+func (b *contentIDBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	if err := b.nativeSpec(ctx, &vulnEqualSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.VulnEqual, error) {
+		return b.inner.VulnEqual(ctx, vulnEqualSpec)
+	})
+}
+
+func (b *contentIDBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	node, err := b.nativeID(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	return query(func() ([]model.Node, error) {
+		return b.inner.Neighbors(ctx, node, usingOnly)
+	})
+}
+
+func (b *contentIDBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	subject, err := b.nativeID(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	target, err = b.nativeID(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return query(func() ([]model.Node, error) {
+		return b.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	})
+}
+
+func (b *contentIDBackend) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	return query(func() ([]*model.HasSbom, error) {
+		return b.inner.FindSBOMsByArtifact(ctx, algorithm, digest)
+	})
+}
+
+func (b *contentIDBackend) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	if err := b.nativeSpec(ctx, &pkgSpec); err != nil {
+		return nil, err
+	}
+	return query(func() ([]*model.Package, error) {
+		return b.inner.TransitiveDependencies(ctx, pkgSpec, maxDepth)
+	})
+}
+
+func (b *contentIDBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return ingest(ctx, b, func() (*model.Artifact, error) {
+		return b.inner.IngestArtifact(ctx, artifact)
+	})
+}
+
+func (b *contentIDBackend) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return ingest(ctx, b, func() ([]*model.Artifact, error) {
+		return b.inner.IngestArtifacts(ctx, artifacts)
+	})
+}
+
+func (b *contentIDBackend) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	return ingest(ctx, b, func() (*model.Builder, error) {
+		return b.inner.IngestBuilder(ctx, builder)
+	})
+}
+
+func (b *contentIDBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return ingest(ctx, b, func() (*model.Package, error) {
+		return b.inner.IngestPackage(ctx, pkg)
+	})
+}
+
+func (b *contentIDBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return ingest(ctx, b, func() ([]*model.Package, error) {
+		return b.inner.IngestPackages(ctx, pkgs)
+	})
+}
+
+func (b *contentIDBackend) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	return ingest(ctx, b, func() (*model.Source, error) {
+		return b.inner.IngestSource(ctx, source)
+	})
+}
+
+func (b *contentIDBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	return ingest(ctx, b, func() ([]*model.Source, error) {
+		return b.inner.IngestSources(ctx, sources)
+	})
+}
+
+func (b *contentIDBackend) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	return ingest(ctx, b, func() (*model.Vulnerability, error) {
+		return b.inner.IngestVulnerability(ctx, vuln)
+	})
+}
+
+func (b *contentIDBackend) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return ingest(ctx, b, func() (*model.CertifyBad, error) {
+		return b.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *contentIDBackend) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	return ingest(ctx, b, func() (*model.CertifyGood, error) {
+		return b.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	})
+}
+
+func (b *contentIDBackend) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return ingest(ctx, b, func() (*model.CertifyLegal, error) {
+		return b.inner.IngestCertifyLegal(ctx, subject, certifyLegal)
+	})
+}
+
+func (b *contentIDBackend) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	return ingest(ctx, b, func() (*model.CertifyVuln, error) {
+		return b.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *contentIDBackend) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	return ingest(ctx, b, func() (*model.HashEqual, error) {
+		return b.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	})
+}
+
+func (b *contentIDBackend) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	return ingest(ctx, b, func() (*model.HasMetadata, error) {
+		return b.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	})
+}
+
+func (b *contentIDBackend) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	return ingest(ctx, b, func() (*model.HasSbom, error) {
+		return b.inner.IngestHasSbom(ctx, subject, hasSbom)
+	})
+}
+
+func (b *contentIDBackend) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	return ingest(ctx, b, func() (*model.HasSlsa, error) {
+		return b.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	})
+}
+
+func (b *contentIDBackend) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return ingest(ctx, b, func() (*model.IsDependency, error) {
+		return b.inner.IngestIsDependency(ctx, pkg, depPkg, dependency)
+	})
+}
+
+func (b *contentIDBackend) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	return ingest(ctx, b, func() (*model.IsOccurrence, error) {
+		return b.inner.IngestIsOccurrence(ctx, subject, artifact, occurrence)
+	})
+}
+
+func (b *contentIDBackend) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return ingest(ctx, b, func() (*model.PkgEqual, error) {
+		return b.inner.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+	})
+}
+
+func (b *contentIDBackend) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return ingest(ctx, b, func() (*model.PointOfContact, error) {
+		return b.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	})
+}
+
+func (b *contentIDBackend) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return ingest(ctx, b, func() (*model.CertifyScorecard, error) {
+		return b.inner.IngestScorecard(ctx, source, scorecard)
+	})
+}
+
+func (b *contentIDBackend) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	return ingest(ctx, b, func() (*model.CertifyVEXStatement, error) {
+		return b.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *contentIDBackend) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return ingest(ctx, b, func() (*model.VulnEqual, error) {
+		return b.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	})
+}
+
+func (b *contentIDBackend) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	inner, err := b.inner.SubscribeArtifacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	artifacts := make(chan *model.Artifact)
+	go func() {
+		defer close(artifacts)
+		for a := range inner {
+			// The IDs of the artifacts are derived from strings only, so
+			// they are always rewritten.
+			_ = newRewriter().rewrite(a)
+			artifacts <- a
+		}
+	}()
+	return artifacts, nil
+}
+
+func (b *contentIDBackend) Ping(ctx context.Context) error {
+	return b.inner.Ping(ctx)
+}
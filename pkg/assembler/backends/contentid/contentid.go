@@ -0,0 +1,210 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package contentid provides a backend giving content-addressed IDs to the
+// nodes of another backend. The IDs are derived from the canonical identity
+// of the nodes instead of the internals of the datastore, so the same logical
+// node gets the same ID from all the backends wrapped this way, whatever the
+// order of the ingestions.
+package contentid
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+)
+
+// Store maps the content-addressed IDs back to the native IDs of a backend,
+// for the queries taking IDs.
+type Store interface {
+	// Record saves the content IDs of the nodes returned by an ingestion,
+	// keyed by their native IDs.
+	Record(ctx context.Context, ids map[string]string) error
+	// NativeID returns the native ID of the node with the content ID id. The
+	// unknown IDs are mapped to a native ID which matches no node.
+	NativeID(ctx context.Context, id string) (string, error)
+}
+
+type contentIDBackend struct {
+	inner backends.Backend
+	store Store
+}
+
+// Wrap returns a backend delegating to inner, which replaces the native IDs
+// of the nodes returned by inner with content-addressed IDs, and the IDs
+// passed to the queries with the native ones recorded in store.
+//
+// Only the nodes returned by the ingestions through the returned backend are
+// recorded in store: the nodes ingested before wrapping inner still get
+// content IDs, but they cannot be looked up by them.
+func Wrap(inner backends.Backend, store Store) backends.Backend {
+	return &contentIDBackend{inner: inner, store: store}
+}
+
+// IdempotentIngestion implements retry.IdempotentBackend, so that the
+// ingestions are still retried when inner can run them again: recording the
+// content IDs of the nodes again is harmless.
+func (b *contentIDBackend) IdempotentIngestion(method string) bool {
+	i, ok := b.inner.(retry.IdempotentBackend)
+	return ok && i.IdempotentIngestion(method)
+}
+
+// query runs f and rewrites the IDs of its result, including the partial
+// results returned with an error. A result whose IDs cannot all be rewritten
+// is not returned.
+func query[T any](f func() (T, error)) (T, error) {
+	result, err := f()
+	if rewriteErr := newRewriter().rewrite(result); rewriteErr != nil {
+		var zero T
+		if err != nil {
+			return zero, err
+		}
+		return zero, rewriteErr
+	}
+	return result, err
+}
+
+// ingest runs f, rewrites the IDs of its result and records them in the store.
+func ingest[T any](ctx context.Context, b *contentIDBackend, f func() (T, error)) (T, error) {
+	result, err := f()
+	if err != nil {
+		return result, err
+	}
+	r := newRewriter()
+	if err := r.rewrite(result); err != nil {
+		var zero T
+		return zero, err
+	}
+	if err := b.store.Record(ctx, r.native); err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+// nativeID returns the native ID of the node with the content ID id.
+func (b *contentIDBackend) nativeID(ctx context.Context, id string) (string, error) {
+	return b.store.NativeID(ctx, id)
+}
+
+// nativeSpec replaces the spec pointed to by spec with a copy of it where the
+// content IDs of the ID fields, at any depth, are the native ones.
+func (b *contentIDBackend) nativeSpec(ctx context.Context, spec interface{}) error {
+	v := reflect.ValueOf(spec).Elem()
+	native, err := b.nativeValue(ctx, v)
+	if err != nil {
+		return err
+	}
+	v.Set(native)
+	return nil
+}
+
+func (b *contentIDBackend) nativeValue(ctx context.Context, v reflect.Value) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := b.nativeValue(ctx, v.Elem())
+		if err != nil {
+			return v, err
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(elem)
+		return out, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := b.nativeValue(ctx, v.Index(i))
+			if err != nil {
+				return v, err
+			}
+			out.Index(i).Set(elem)
+		}
+		return out, nil
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v, nil
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			field := out.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			if id, ok := field.Interface().(*string); ok && v.Type().Field(i).Name == "ID" {
+				if id == nil {
+					continue
+				}
+				native, err := b.nativeID(ctx, *id)
+				if err != nil {
+					return v, err
+				}
+				field.Set(reflect.ValueOf(&native))
+				continue
+			}
+			value, err := b.nativeValue(ctx, field)
+			if err != nil {
+				return v, err
+			}
+			field.Set(value)
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+// memoryStore is the Store of the backends keeping their nodes in memory.
+type memoryStore struct {
+	lock   sync.RWMutex
+	native map[string]string
+}
+
+// unknownNativeID is the native ID of the unknown content IDs in the memory
+// store. The backends keeping their nodes in memory number them from 1, so it
+// is never the ID of a node, whereas the empty ID could be taken as a missing
+// filter.
+const unknownNativeID = "-1"
+
+// NewMemoryStore returns a Store keeping the IDs in memory, for the backends
+// which lose their nodes when the process exits anyway.
+func NewMemoryStore() Store {
+	return &memoryStore{native: map[string]string{}}
+}
+
+func (s *memoryStore) Record(ctx context.Context, ids map[string]string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for native, content := range ids {
+		s.native[content] = native
+	}
+	return nil
+}
+
+func (s *memoryStore) NativeID(ctx context.Context, id string) (string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if native, ok := s.native[id]; ok {
+		return native, nil
+	}
+	return unknownNativeID, nil
+}
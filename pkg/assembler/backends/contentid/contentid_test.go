@@ -0,0 +1,360 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentid_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/contentid"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+var (
+	curl = &model.PkgInputSpec{
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "curl",
+		Version:    ptrfrom("7.50.3-1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "i386"}, {Key: "distro", Value: "jessie"}},
+	}
+	django = &model.PkgInputSpec{Type: "pypi", Name: "django", Version: ptrfrom("1.11.1")}
+	guac   = &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.0.1")}
+	sha256 = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	sha1   = &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"}
+	osv    = &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "CVE-2014-8140"}
+)
+
+// ingest ingests the same nodes in a different order depending on reversed,
+// so that the native IDs of the backends differ.
+func ingest(t *testing.T, b backends.Backend, reversed bool) {
+	t.Helper()
+	ctx := context.Background()
+	scanned := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	artifacts := []*model.ArtifactInputSpec{sha256, sha1}
+	steps := []func() error{
+		func() error {
+			_, err := b.IngestPackage(ctx, curl)
+			return err
+		},
+		func() error {
+			_, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: django}, sha256,
+				&model.IsOccurrenceInputSpec{Justification: "package of the artifact"})
+			return err
+		},
+		func() error {
+			_, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Source: guac}, sha1,
+				&model.IsOccurrenceInputSpec{Justification: "built from the source"})
+			return err
+		},
+		func() error {
+			a, e := artifacts[0], artifacts[1]
+			if reversed {
+				a, e = e, a
+			}
+			_, err := b.IngestHashEqual(ctx, a, e, &model.HashEqualInputSpec{Justification: "same file"})
+			return err
+		},
+		func() error {
+			// The same time in another time zone is the same scan.
+			timeScanned := scanned
+			if reversed {
+				timeScanned = scanned.In(time.FixedZone("CEST", 2*60*60))
+			}
+			_, err := b.IngestCertifyVuln(ctx, curl, osv, &model.ScanMetadataInput{TimeScanned: timeScanned, DbURI: "osv.dev"})
+			return err
+		},
+	}
+	if reversed {
+		for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+			steps[i], steps[j] = steps[j], steps[i]
+		}
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("ingestion %d error = %v", i, err)
+		}
+	}
+}
+
+// ids returns the sorted IDs of all the nodes reachable from v.
+func ids(v interface{}) []string {
+	seen := map[string]bool{}
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).Name == "ID" && v.Field(i).Kind() == reflect.String {
+					seen[v.Field(i).String()] = true
+				} else if v.Field(i).CanInterface() {
+					walk(v.Field(i))
+				}
+			}
+		}
+	}
+	walk(reflect.ValueOf(v))
+	out := make([]string, 0, len(seen))
+	for id := range seen {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// allIDs returns the IDs of the nodes returned by each query.
+func allIDs(t *testing.T, b backends.Backend) map[string][]string {
+	t.Helper()
+	ctx := context.Background()
+	out := map[string][]string{}
+	add := func(query string, v interface{}, err error) {
+		if err != nil {
+			t.Fatalf("%s() error = %v", query, err)
+		}
+		out[query] = ids(v)
+	}
+	packages, err := b.Packages(ctx, nil)
+	add("Packages", packages, err)
+	sources, err := b.Sources(ctx, nil)
+	add("Sources", sources, err)
+	artifacts, err := b.Artifacts(ctx, nil)
+	add("Artifacts", artifacts, err)
+	vulns, err := b.Vulnerabilities(ctx, nil)
+	add("Vulnerabilities", vulns, err)
+	occurrences, err := b.IsOccurrence(ctx, nil)
+	add("IsOccurrence", occurrences, err)
+	hashEquals, err := b.HashEqual(ctx, nil)
+	add("HashEqual", hashEquals, err)
+	certifyVulns, err := b.CertifyVuln(ctx, nil)
+	add("CertifyVuln", certifyVulns, err)
+	return out
+}
+
+func newBackend(t *testing.T, strategy backends.IDStrategy) backends.Backend {
+	t.Helper()
+	b, err := inmem.New(context.Background(), &inmem.Config{IDStrategy: strategy})
+	if err != nil {
+		t.Fatalf("inmem.New() error = %v", err)
+	}
+	return b
+}
+
+func TestContentIDs(t *testing.T) {
+	a, b := newBackend(t, backends.ContentIDs), newBackend(t, backends.ContentIDs)
+	ingest(t, a, false)
+	ingest(t, b, true)
+
+	got, want := allIDs(t, a), allIDs(t, b)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("IDs mismatch between the backends (-want +got):\n%s", diff)
+	}
+	for query, ids := range got {
+		if len(ids) == 0 {
+			t.Errorf("%s() returned no node", query)
+		}
+	}
+
+	// The native IDs depend on the order of the ingestions.
+	nativeA, nativeB := newBackend(t, backends.NativeIDs), newBackend(t, backends.NativeIDs)
+	ingest(t, nativeA, false)
+	ingest(t, nativeB, true)
+	if cmp.Equal(allIDs(t, nativeA), allIDs(t, nativeB)) {
+		t.Errorf("the native IDs of the backends are the same")
+	}
+}
+
+func TestContentIDsLookup(t *testing.T) {
+	ctx := context.Background()
+	a, b := newBackend(t, backends.ContentIDs), newBackend(t, backends.ContentIDs)
+	ingest(t, a, false)
+	ingest(t, b, true)
+
+	artifacts, err := a.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom("sha256")})
+	if err != nil || len(artifacts) != 1 {
+		t.Fatalf("Artifacts() = %v, %v, want a single artifact", artifacts, err)
+	}
+	id := artifacts[0].ID
+
+	for name, backend := range map[string]backends.Backend{"a": a, "b": b} {
+		// The ID filters of the specs, at any depth, take content IDs.
+		spec := &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{ID: &id}}
+		occurrences, err := backend.IsOccurrence(ctx, spec)
+		if err != nil || len(occurrences) != 1 || occurrences[0].Artifact.ID != id {
+			t.Errorf("%s: IsOccurrence() of the artifact = %v, %v, want its occurrence", name, occurrences, err)
+		}
+		if *spec.Artifact.ID != id {
+			t.Errorf("%s: IsOccurrence() modified the spec", name)
+		}
+
+		neighbors, err := backend.Neighbors(ctx, id, nil)
+		if err != nil {
+			t.Fatalf("%s: Neighbors() error = %v", name, err)
+		}
+		if got := ids(neighbors); len(got) == 0 {
+			t.Errorf("%s: Neighbors() returned no node", name)
+		}
+
+		unknown := "0000000000000000000000000000000000000000000000000000000000000000"
+		artifacts, err := backend.Artifacts(ctx, &model.ArtifactSpec{ID: &unknown})
+		if err != nil || len(artifacts) != 0 {
+			t.Errorf("%s: Artifacts() of an unknown ID = %v, %v, want none", name, artifacts, err)
+		}
+	}
+
+	neighborsA, errA := a.Neighbors(ctx, id, nil)
+	neighborsB, errB := b.Neighbors(ctx, id, nil)
+	if errA != nil || errB != nil {
+		t.Fatalf("Neighbors() errors = %v, %v", errA, errB)
+	}
+	if diff := cmp.Diff(ids(neighborsA), ids(neighborsB)); diff != "" {
+		t.Errorf("IDs of the neighbors mismatch between the backends (-a +b):\n%s", diff)
+	}
+}
+
+// specBackend keeps the ID filter of the last spec of Artifacts, treating an
+// empty one as missing as some datastores do.
+type specBackend struct {
+	backends.Backend
+	id *string
+}
+
+func (b *specBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	b.id = artifactSpec.ID
+	if artifactSpec.ID != nil && *artifactSpec.ID == "" {
+		artifactSpec = &model.ArtifactSpec{}
+	}
+	return b.Backend.Artifacts(ctx, artifactSpec)
+}
+
+func TestContentIDsUnknown(t *testing.T) {
+	ctx := context.Background()
+	inner, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &specBackend{Backend: inner}
+	b := contentid.Wrap(spec, contentid.NewMemoryStore())
+	if _, err := b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{sha256, sha1}); err != nil {
+		t.Fatalf("IngestArtifacts() error = %v", err)
+	}
+
+	for _, unknown := range []string{"", "0000000000000000000000000000000000000000000000000000000000000000"} {
+		artifacts, err := b.Artifacts(ctx, &model.ArtifactSpec{ID: &unknown})
+		if err != nil || len(artifacts) != 0 {
+			t.Errorf("Artifacts() of the unknown ID %q = %v, %v, want none", unknown, artifacts, err)
+		}
+		if spec.id == nil || *spec.id == "" {
+			t.Errorf("Artifacts() of the unknown ID %q queried an empty native ID", unknown)
+		}
+	}
+}
+
+// flakyBackend fails the first ingestion of a package with a transient error.
+type flakyBackend struct {
+	backends.Backend
+	idempotent bool
+	calls      int
+}
+
+func (b *flakyBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	b.calls++
+	if b.calls == 1 {
+		return nil, backends.ErrTransient
+	}
+	return b.Backend.IngestPackage(ctx, pkg)
+}
+
+func (b *flakyBackend) IdempotentIngestion(method string) bool {
+	return b.idempotent
+}
+
+func TestContentIDsRetried(t *testing.T) {
+	ctx := context.Background()
+	for _, idempotent := range []bool{true, false} {
+		inner, err := inmem.New(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		flaky := &flakyBackend{Backend: inner, idempotent: idempotent}
+		b := retry.Wrap(contentid.Wrap(flaky, contentid.NewMemoryStore()), retry.RetryOptions{InitialBackoff: time.Millisecond})
+
+		pkg, err := b.IngestPackage(ctx, django)
+		if !idempotent {
+			if !errors.Is(err, backends.ErrTransient) || flaky.calls != 1 {
+				t.Errorf("IngestPackage() of a backend without idempotent ingestions error = %v after %d calls, want %v after 1", err, flaky.calls, backends.ErrTransient)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if flaky.calls != 2 {
+			t.Errorf("IngestPackage() called the backend %d times, want 2", flaky.calls)
+		}
+		// The IDs of the retried ingestion are recorded.
+		id := pkg.Namespaces[0].Names[0].Versions[0].ID
+		neighbors, err := b.Neighbors(ctx, id, nil)
+		if err != nil {
+			t.Fatalf("Neighbors() error = %v", err)
+		}
+		if len(neighbors) == 0 {
+			t.Errorf("Neighbors() of the version %s returned no node", id)
+		}
+	}
+}
+
+func TestContentIDsUnencodableEvidence(t *testing.T) {
+	ctx := context.Background()
+	inner, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := contentid.Wrap(inner, contentid.NewMemoryStore())
+
+	// NaN cannot be encoded to derive the ID of the scorecard.
+	scorecard := &model.ScorecardInputSpec{AggregateScore: math.NaN(), TimeScanned: time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)}
+	if _, err := b.IngestScorecard(ctx, guac, scorecard); err == nil {
+		t.Errorf("IngestScorecard() of an unencodable scorecard returned no error")
+	}
+	if _, err := b.Scorecards(ctx, nil); err == nil {
+		t.Errorf("Scorecards() of an unencodable scorecard returned no error")
+	}
+	// The other nodes are still rewritten.
+	if _, err := b.Sources(ctx, nil); err != nil {
+		t.Errorf("Sources() error = %v", err)
+	}
+}
@@ -0,0 +1,291 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// The IDs are the hex-encoded SHA-256 of the kind of the node followed by the
+// values identifying it among the nodes of that kind:
+//
+//   - the nodes of the package, source and vulnerability tries are identified
+//     by the ID of their parent and their own values, e.g. the name of a
+//     package name node, so that every path in a trie has a single ID
+//     regardless of the other paths returned with it;
+//   - artifacts by their algorithm and digest, and builders by their URI;
+//   - the evidence nodes by all their fields, the nodes they point to being
+//     replaced by their IDs (the ID of the last node of a path in a trie),
+//     in any order for the lists of nodes.
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// hashID returns the ID of the node of kind identified by values. Every value
+// is prefixed by its length, so that different values never hash the same.
+func hashID(kind string, values ...string) string {
+	h := sha256.New()
+	for _, v := range append([]string{kind}, values...) {
+		fmt.Fprintf(h, "%d:%s", len(v), v)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// rewriter replaces the native IDs of the nodes it walks with their content
+// IDs, remembering the native ID of each.
+type rewriter struct {
+	// native maps the native IDs to the content IDs.
+	native map[string]string
+}
+
+func newRewriter() *rewriter {
+	return &rewriter{native: map[string]string{}}
+}
+
+// setID sets *id to the content ID.
+func (r *rewriter) setID(id *string, content string) {
+	if *id != content {
+		r.native[*id] = content
+		*id = content
+	}
+}
+
+// rewrite rewrites the IDs of all the nodes reachable from v, such as the
+// result of a query.
+func (r *rewriter) rewrite(v interface{}) error {
+	return r.value(reflect.ValueOf(v))
+}
+
+func (r *rewriter) value(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			return r.value(v.Elem())
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		switch n := v.Interface().(type) {
+		case *model.Package:
+			r.pkg(n)
+		case *model.Source:
+			r.src(n)
+		case *model.Vulnerability:
+			r.vuln(n)
+		case *model.Artifact:
+			r.setID(&n.ID, hashID("Artifact", n.Algorithm, n.Digest))
+		case *model.Builder:
+			r.setID(&n.ID, hashID("Builder", n.URI))
+		case model.Node:
+			// The nodes pointed to by the evidence are rewritten first,
+			// as its ID depends on theirs.
+			if err := r.value(v.Elem()); err != nil {
+				return err
+			}
+			if id := v.Elem().FieldByName("ID"); id.IsValid() && id.Kind() == reflect.String {
+				content, err := evidenceID(v.Elem())
+				if err != nil {
+					return err
+				}
+				r.setID(id.Addr().Interface().(*string), content)
+			}
+		default:
+			return r.value(v.Elem())
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if err := r.value(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := r.value(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := r.value(iter.Value()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *rewriter) pkg(p *model.Package) {
+	r.setID(&p.ID, hashID("PackageType", p.Type))
+	for _, ns := range p.Namespaces {
+		r.setID(&ns.ID, hashID("PackageNamespace", p.ID, ns.Namespace))
+		for _, n := range ns.Names {
+			r.setID(&n.ID, hashID("PackageName", ns.ID, n.Name))
+			for _, v := range n.Versions {
+				values := []string{n.ID, v.Version, v.Subpath}
+				qualifiers := make([]*model.PackageQualifier, len(v.Qualifiers))
+				copy(qualifiers, v.Qualifiers)
+				sort.Slice(qualifiers, func(i, j int) bool {
+					if qualifiers[i].Key != qualifiers[j].Key {
+						return qualifiers[i].Key < qualifiers[j].Key
+					}
+					return qualifiers[i].Value < qualifiers[j].Value
+				})
+				for _, q := range qualifiers {
+					values = append(values, q.Key, q.Value)
+				}
+				r.setID(&v.ID, hashID("PackageVersion", values...))
+			}
+		}
+	}
+}
+
+func (r *rewriter) src(s *model.Source) {
+	r.setID(&s.ID, hashID("SourceType", s.Type))
+	for _, ns := range s.Namespaces {
+		r.setID(&ns.ID, hashID("SourceNamespace", s.ID, ns.Namespace))
+		for _, n := range ns.Names {
+			r.setID(&n.ID, hashID("SourceName", ns.ID, n.Name, derefOrEmpty(n.Tag), derefOrEmpty(n.Commit)))
+		}
+	}
+}
+
+func (r *rewriter) vuln(v *model.Vulnerability) {
+	r.setID(&v.ID, hashID("VulnerabilityType", v.Type))
+	for _, id := range v.VulnerabilityIDs {
+		r.setID(&id.ID, hashID("VulnerabilityID", v.ID, id.VulnerabilityID))
+	}
+}
+
+// evidenceID returns the ID of an evidence node, whose nodes have already been
+// rewritten.
+func evidenceID(v reflect.Value) (string, error) {
+	encoded, err := json.Marshal(canonical(v, true))
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the %s to derive its ID: %w", v.Type().Name(), err)
+	}
+	return hashID(v.Type().Name(), string(encoded)), nil
+}
+
+// canonical returns the values of the fields of v, ignoring its ID if top is
+// set, with the nodes replaced by their IDs and the times in UTC.
+func canonical(v reflect.Value, top bool) interface{} {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		if n, ok := v.Interface().(model.Node); ok && !top {
+			return leafID(n)
+		}
+		return canonical(v.Elem(), top)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).UTC().Format(time.RFC3339Nano)
+		}
+		fields := map[string]interface{}{}
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			if top && name == "ID" {
+				continue
+			}
+			fields[name] = canonical(v.Field(i), false)
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return nil
+		}
+		values := make([]interface{}, 0, v.Len())
+		var ids []string
+		for i := 0; i < v.Len(); i++ {
+			value := canonical(v.Index(i), false)
+			if id, ok := value.(string); ok && isNode(v.Index(i)) {
+				ids = append(ids, id)
+			}
+			values = append(values, value)
+		}
+		if len(ids) == len(values) {
+			// The lists of nodes are sets.
+			sort.Strings(ids)
+			return ids
+		}
+		return values
+	}
+	return v.Interface()
+}
+
+func isNode(v reflect.Value) bool {
+	_, ok := v.Interface().(model.Node)
+	return ok && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil()
+}
+
+// leafID returns the ID of a node, or of the last node of its path for the
+// nodes of the tries.
+func leafID(n model.Node) string {
+	switch n := n.(type) {
+	case *model.Package:
+		id := n.ID
+		for _, ns := range n.Namespaces {
+			id = ns.ID
+			for _, name := range ns.Names {
+				id = name.ID
+				for _, v := range name.Versions {
+					id = v.ID
+				}
+			}
+		}
+		return id
+	case *model.Source:
+		id := n.ID
+		for _, ns := range n.Namespaces {
+			id = ns.ID
+			for _, name := range ns.Names {
+				id = name.ID
+			}
+		}
+		return id
+	case *model.Vulnerability:
+		id := n.ID
+		for _, v := range n.VulnerabilityIDs {
+			id = v.ID
+		}
+		return id
+	}
+	v := reflect.ValueOf(n)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v.FieldByName("ID").String()
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
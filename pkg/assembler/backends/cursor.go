@@ -0,0 +1,39 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"encoding/base64"
+)
+
+// Paginated queries return opaque cursors which encode the key of the last
+// node of a page. Backends resume the query after the node with that key, so
+// pages stay consistent when nodes are ingested between calls.
+
+// EncodeCursor returns the opaque cursor for a node key.
+func EncodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodeCursor returns the node key encoded in a cursor returned by
+// EncodeCursor.
+func DecodeCursor(cursor string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(key) == 0 {
+		return "", NewValidationError("after", "invalid cursor %q", cursor)
+	}
+	return string(key), nil
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TransitiveDependencies returns the packages the packages matching pkgSpec
+// depend on through at most maxDepth IsDependency edges, each once, in the
+// order in which they are found. Backends implement the query of the same
+// name with it.
+//
+// The dependencies are package names, as the dependent packages of
+// IsDependency are, and the dependencies of a name are the ones of all its
+// versions: the version ranges are free-form strings whose syntax depends on
+// the ecosystem, so they are not interpreted, and the result overstates the
+// closure when some versions of a dependency are outside of its range. The
+// walk does not visit a name twice, so it ends on cycles: a package depending
+// on itself through a cycle is returned among its dependencies, once.
+func TransitiveDependencies(ctx context.Context, b Backend, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	if pkgSpec == nil {
+		return nil, Errorf("TransitiveDependencies :: %w", Missing("pkgSpec"))
+	}
+	if maxDepth <= 0 {
+		return nil, Errorf("TransitiveDependencies :: %w", NewValidationError("maxDepth", "maxDepth must be positive"))
+	}
+
+	out := []*model.Package{}
+	visited := map[string]bool{}
+	frontier := []*model.PkgSpec{pkgSpec}
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		current := frontier
+		frontier = nil
+		for _, spec := range current {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			dependencies, err := b.IsDependency(ctx, &model.IsDependencySpec{Package: spec})
+			if err != nil {
+				return nil, err
+			}
+			for _, d := range dependencies {
+				id, nameSpec := pkgNameOf(d.DependentPackage)
+				if id == "" || visited[id] {
+					continue
+				}
+				visited[id] = true
+				out = append(out, d.DependentPackage)
+				frontier = append(frontier, nameSpec)
+			}
+		}
+	}
+	return out, nil
+}
+
+// pkgNameOf returns the ID of the name of a package returned by the evidence
+// queries and the spec matching all its versions, or "" if it has no name.
+func pkgNameOf(p *model.Package) (string, *model.PkgSpec) {
+	if p == nil || len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 {
+		return "", nil
+	}
+	ns := p.Namespaces[0]
+	n := ns.Names[0]
+	return n.ID, &model.PkgSpec{
+		Type:      &p.Type,
+		Namespace: &ns.Namespace,
+		Name:      &n.Name,
+	}
+}
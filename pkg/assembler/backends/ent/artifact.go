@@ -0,0 +1,232 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Ingest Artifact
+
+func (c *entClient) IngestArtifact(ctx context.Context, a *model.ArtifactInputSpec) (*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", backends.Missing("artifact"))
+	}
+	algorithm, digest, err := backends.CanonicalArtifact(a)
+	if err != nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", err)
+	}
+
+	id, err := ingestArtifact(ctx, c.client, algorithm, digest)
+	if err != nil {
+		return nil, queryError(ctx, "IngestArtifact", err)
+	}
+	return &model.Artifact{ID: nodeID(id), Algorithm: algorithm, Digest: digest}, nil
+}
+
+func (c *entClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Validate the whole batch first, so that nothing is sent to the database
+	// if any artifact is invalid.
+	canonical := make([]*model.ArtifactInputSpec, 0, len(artifacts))
+	for i, a := range artifacts {
+		if a == nil {
+			return nil, backends.Errorf("IngestArtifacts :: %w", backends.NewValidationError("artifacts", "missing artifact at index %d", i))
+		}
+		algorithm, digest, err := backends.CanonicalArtifact(a)
+		if err != nil {
+			return nil, backends.Errorf("IngestArtifacts :: artifact at index %d: %w", i, err)
+		}
+		canonical = append(canonical, &model.ArtifactInputSpec{Algorithm: algorithm, Digest: digest})
+	}
+
+	out, err := withTx(ctx, c.client, func(tx *db.Tx) ([]*model.Artifact, error) {
+		out := make([]*model.Artifact, 0, len(canonical))
+		for _, a := range canonical {
+			id, err := ingestArtifact(ctx, tx.Client(), a.Algorithm, a.Digest)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, &model.Artifact{ID: nodeID(id), Algorithm: a.Algorithm, Digest: a.Digest})
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestArtifacts", err)
+	}
+	return out, nil
+}
+
+// ingestArtifact adds the canonical artifact, unless it already exists, and
+// returns its ID.
+func ingestArtifact(ctx context.Context, client *db.Client, algorithm, digest string) (int, error) {
+	return client.Artifact.Create().
+		SetAlgorithm(algorithm).
+		SetDigest(digest).
+		OnConflict(sql.ConflictColumns(artifact.FieldAlgorithm, artifact.FieldDigest)).
+		Ignore().
+		ID(ctx)
+}
+
+// ingestArtifactInput validates and adds the artifact, returning its ID.
+func ingestArtifactInput(ctx context.Context, client *db.Client, a *model.ArtifactInputSpec) (int, error) {
+	algorithm, digest, err := backends.CanonicalArtifact(a)
+	if err != nil {
+		return 0, err
+	}
+	return ingestArtifact(ctx, client, algorithm, digest)
+}
+
+// artifactMatches returns the predicates matching the artifacts against the
+// spec. The spec values are compared ignoring case, as artifacts are stored
+// in lowercase.
+func artifactMatches(artifactSpec *model.ArtifactSpec) ([]predicate.Artifact, error) {
+	if artifactSpec == nil {
+		return nil, nil
+	}
+	artifactSpec = backends.CanonicalArtifactSpec(artifactSpec)
+	var filters []predicate.Artifact
+	if artifactSpec.ID != nil {
+		id, err := parseID(*artifactSpec.ID)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, artifact.ID(id))
+	}
+	if artifactSpec.Algorithm != nil {
+		filters = append(filters, artifact.Algorithm(*artifactSpec.Algorithm))
+	}
+	if artifactSpec.Digest != nil {
+		filters = append(filters, artifact.Digest(*artifactSpec.Digest))
+	}
+	return filters, nil
+}
+
+func toModelArtifact(a *db.Artifact) *model.Artifact {
+	return &model.Artifact{
+		ID:        nodeID(a.ID),
+		Algorithm: a.Algorithm,
+		Digest:    a.Digest,
+	}
+}
+
+// artifactKey is the key encoded in the cursors of ArtifactsList. It is the
+// same as in the in-memory backend.
+func artifactKey(a *db.Artifact) string {
+	return a.Algorithm + ":" + a.Digest
+}
+
+// Query Artifacts
+
+func (c *entClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	connection, err := c.ArtifactsList(ctx, artifactSpec, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Artifact, 0, len(connection.Edges))
+	for _, e := range connection.Edges {
+		out = append(out, e.Node)
+	}
+	return out, nil
+}
+
+// ArtifactsList returns the artifacts ordered by ID, which follows the order
+// of ingestion, so new artifacts always come after the existing cursors.
+func (c *entClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if first != nil && *first < 0 {
+		return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("first", "first must not be negative"))
+	}
+	filters, err := artifactMatches(artifactSpec)
+	if err != nil {
+		return nil, backends.Errorf("ArtifactsList :: %w", err)
+	}
+
+	if after != nil {
+		key, err := backends.DecodeCursor(*after)
+		if err != nil {
+			return nil, backends.Errorf("ArtifactsList :: %w", err)
+		}
+		algorithm, digest, _ := strings.Cut(key, ":")
+		last, err := c.client.Artifact.Query().
+			Where(artifact.Algorithm(algorithm), artifact.Digest(digest)).
+			Only(ctx)
+		if db.IsNotFound(err) {
+			return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("after", "invalid cursor %q", *after))
+		}
+		if err != nil {
+			return nil, queryError(ctx, "ArtifactsList", err)
+		}
+		filters = append(filters, artifact.IDGT(last.ID))
+	}
+
+	query := c.client.Artifact.Query().
+		Where(filters...).
+		Order(db.Asc(artifact.FieldID))
+	if first != nil {
+		// One more artifact is read to know if there is a next page.
+		query.Limit(*first + 1)
+	}
+	artifacts, err := query.All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "ArtifactsList", err)
+	}
+
+	connection := &model.ArtifactConnection{
+		Edges:    []*model.ArtifactEdge{},
+		PageInfo: &model.PageInfo{},
+	}
+	for _, a := range artifacts {
+		if first != nil && len(connection.Edges) == *first {
+			connection.PageInfo.HasNextPage = true
+			break
+		}
+		cursor := backends.EncodeCursor(artifactKey(a))
+		connection.Edges = append(connection.Edges, &model.ArtifactEdge{Cursor: cursor, Node: toModelArtifact(a)})
+		connection.PageInfo.EndCursor = &cursor
+	}
+	return connection, nil
+}
+
+// SubscribeArtifacts is not supported: the database is shared by all the
+// ingestion processes, so the artifacts they ingest cannot be observed from
+// this one.
+func (c *entClient) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, gqlerror.Errorf("SubscribeArtifacts :: subscriptions are not supported by the Postgres backend")
+}
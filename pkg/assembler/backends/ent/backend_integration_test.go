@@ -0,0 +1,275 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package ent
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/lib/pq"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// The tests in this file need a running Postgres database, whose connection
+// string is read from POSTGRES_DSN. The public schema of the database is
+// dropped by every test.
+
+// clearTestDatabase drops the tables of the test database, returning its
+// connection string.
+func clearTestDatabase(t testing.TB) string {
+	t.Helper()
+	dsn := os.Getenv("POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_DSN is not set")
+	}
+
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Exec("DROP SCHEMA public CASCADE; CREATE SCHEMA public"); err != nil {
+		t.Fatalf("clearing the database: %v", err)
+	}
+	return dsn
+}
+
+func newTestClient(t testing.TB) *entClient {
+	t.Helper()
+	dsn := clearTestDatabase(t)
+	b, err := New(context.Background(), &PostgresConfig{DSN: dsn})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c := b.(*entClient)
+	t.Cleanup(func() { c.client.Close() })
+	return c
+}
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestNewMissingDSN(t *testing.T) {
+	if _, err := New(context.Background(), &PostgresConfig{}); err == nil {
+		t.Errorf("New() without a connection string did not return an error")
+	}
+}
+
+func TestIngestPackageIdempotent(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	pkg := &model.PkgInputSpec{
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "curl",
+		Version:    ptrfrom("7.50.3-1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "i386"}},
+	}
+	first, err := c.IngestPackage(ctx, pkg)
+	if err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	second, err := c.IngestPackage(ctx, pkg)
+	if err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("IngestPackage() of the same package created new rows (-first +second):\n%s", diff)
+	}
+
+	got, err := c.Packages(ctx, &model.PkgSpec{Name: ptrfrom("curl")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.Package{first}, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Packages() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIngestCertifyGoodIdempotent(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	// The certifications of the different subjects are deduplicated by
+	// partial unique indexes, which must not conflict with each other.
+	subjects := []*model.PackageSourceOrArtifactInput{
+		{Package: &model.PkgInputSpec{Type: "npm", Name: "foobar", Version: ptrfrom("12.3.1")}},
+		{Source: &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac"}},
+		{Artifact: &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"}},
+	}
+	spec := &model.CertifyGoodInputSpec{Justification: "reviewed", Origin: "test", Collector: "test"}
+	matchTypes := []model.PkgMatchType{model.PkgMatchTypeSpecificVersion, model.PkgMatchTypeAllVersions}
+
+	var ingested []*model.CertifyGood
+	for _, subject := range subjects {
+		for _, matchType := range matchTypes {
+			matchType := matchType
+			first, err := c.IngestCertifyGood(ctx, subject, &matchType, spec)
+			if err != nil {
+				t.Fatalf("IngestCertifyGood() error = %v", err)
+			}
+			second, err := c.IngestCertifyGood(ctx, subject, &matchType, spec)
+			if err != nil {
+				t.Fatalf("IngestCertifyGood() error = %v", err)
+			}
+			if first.ID != second.ID {
+				t.Errorf("IngestCertifyGood() of the same certification returned IDs %s and %s", first.ID, second.ID)
+			}
+			ingested = append(ingested, first)
+			if subject.Package == nil {
+				// The match type only applies to packages.
+				break
+			}
+		}
+	}
+
+	got, err := c.CertifyGood(ctx, &model.CertifyGoodSpec{Justification: ptrfrom("reviewed")})
+	if err != nil {
+		t.Fatalf("CertifyGood() error = %v", err)
+	}
+	sortByID := cmpopts.SortSlices(func(a, b *model.CertifyGood) bool { return a.ID < b.ID })
+	if diff := cmp.Diff(ingested, got, sortByID); diff != "" {
+		t.Errorf("CertifyGood() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestContextDone(t *testing.T) {
+	c := newTestClient(t)
+	pkg := &model.PkgInputSpec{Type: "npm", Name: "foobar", Version: ptrfrom("12.3.1")}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.IngestPackage(cancelled, pkg); !errors.Is(err, context.Canceled) {
+		t.Errorf("IngestPackage() error = %v, want %v", err, context.Canceled)
+	}
+	if _, err := c.Packages(cancelled, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Packages() error = %v, want %v", err, context.Canceled)
+	}
+
+	got, err := c.Packages(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Packages() = %v, want none ingested with a done context", got)
+	}
+}
+
+func TestQueryErrorTransient(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		err           error
+		wantTransient bool
+	}{
+		{name: "serialization failure", err: &pq.Error{Code: "40001"}, wantTransient: true},
+		{name: "deadlock", err: &pq.Error{Code: "40P01"}, wantTransient: true},
+		{name: "connection failure", err: &pq.Error{Code: "08006"}, wantTransient: true},
+		{name: "admin shutdown", err: &pq.Error{Code: "57P01"}, wantTransient: true},
+		{name: "bad connection", err: fmt.Errorf("querying: %w", sqldriver.ErrBadConn), wantTransient: true},
+		{name: "lost connection", err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, wantTransient: true},
+		{name: "syntax error", err: &pq.Error{Code: "42601"}},
+		{name: "other error", err: errors.New("invalid input")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := queryError(ctx, "IngestPackage", tt.err)
+			if got := errors.Is(err, backends.ErrTransient); got != tt.wantTransient {
+				t.Errorf("queryError() = %v, transient = %v, want %v", err, got, tt.wantTransient)
+			}
+			var pqErr *pq.Error
+			if want, ok := tt.err.(*pq.Error); ok && (!errors.As(err, &pqErr) || pqErr != want) {
+				t.Errorf("queryError() = %v, does not wrap the Postgres error", err)
+			}
+		})
+	}
+}
+
+// newCountingClient is like newTestClient, counting in statements the
+// statements sent to the database, including the ones starting and ending
+// the transactions. The statements migrating the schema are not counted.
+func newCountingClient(t testing.TB, statements *int64) *entClient {
+	t.Helper()
+	dsn := clearTestDatabase(t)
+	driver, err := entsql.Open(dialect.Postgres, dsn)
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	counting := dialect.DebugWithContext(driver, func(context.Context, ...any) {
+		atomic.AddInt64(statements, 1)
+	})
+	c, err := newClient(context.Background(), db.NewClient(db.Driver(counting)), driver.DB())
+	if err != nil {
+		t.Fatalf("newClient() error = %v", err)
+	}
+	t.Cleanup(func() { c.client.Close() })
+	atomic.StoreInt64(statements, 0)
+	return c
+}
+
+// BenchmarkIngestPackagesRoundTrips compares the statements sent to ingest
+// the versions of a package one at a time and in a single batch, where the
+// shared path to the name is only ingested once.
+func BenchmarkIngestPackagesRoundTrips(b *testing.B) {
+	ctx := context.Background()
+	pkgs := make([]*model.PkgInputSpec, 0, 100)
+	for i := 0; i < cap(pkgs); i++ {
+		pkgs = append(pkgs, &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom("github.com/google"), Name: "uuid", Version: ptrfrom(fmt.Sprintf("v1.%d.0", i))})
+	}
+
+	b.Run("IngestPackage", func(b *testing.B) {
+		var statements int64
+		c := newCountingClient(b, &statements)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, pkg := range pkgs {
+				if _, err := c.IngestPackage(ctx, pkg); err != nil {
+					b.Fatalf("IngestPackage() error = %v", err)
+				}
+			}
+		}
+		b.ReportMetric(float64(atomic.LoadInt64(&statements))/float64(b.N), "statements/op")
+	})
+	b.Run("IngestPackages", func(b *testing.B) {
+		var statements int64
+		c := newCountingClient(b, &statements)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.IngestPackages(ctx, pkgs); err != nil {
+				b.Fatalf("IngestPackages() error = %v", err)
+			}
+		}
+		b.ReportMetric(float64(atomic.LoadInt64(&statements))/float64(b.N), "statements/op")
+	})
+}
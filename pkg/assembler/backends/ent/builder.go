@@ -0,0 +1,107 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest Builder
+
+func (c *entClient) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if builder == nil {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.Missing("builder"))
+	}
+	if builder.URI == "" {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+
+	id, err := ingestBuilder(ctx, c.client, builder)
+	if err != nil {
+		return nil, queryError(ctx, "IngestBuilder", err)
+	}
+	return &model.Builder{ID: nodeID(id), URI: builder.URI}, nil
+}
+
+// ingestBuilder adds the builder, unless it already exists, and returns its
+// ID.
+func ingestBuilder(ctx context.Context, client *db.Client, builder *model.BuilderInputSpec) (int, error) {
+	return client.BuilderNode.Create().
+		SetURI(builder.URI).
+		OnConflict(sql.ConflictColumns(buildernode.FieldURI)).
+		Ignore().
+		ID(ctx)
+}
+
+// Query Builders
+
+func (c *entClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	filters, err := builderMatches(builderSpec)
+	if err != nil {
+		return nil, backends.Errorf("Builders :: %w", err)
+	}
+
+	builders, err := c.client.BuilderNode.Query().
+		Where(filters...).
+		Order(db.Asc(buildernode.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "Builders", err)
+	}
+
+	out := make([]*model.Builder, 0, len(builders))
+	for _, b := range builders {
+		out = append(out, toModelBuilder(b))
+	}
+	return out, nil
+}
+
+// builderMatches returns the predicates matching the builders against the
+// spec.
+func builderMatches(builderSpec *model.BuilderSpec) ([]predicate.BuilderNode, error) {
+	if builderSpec == nil {
+		return nil, nil
+	}
+	var filters []predicate.BuilderNode
+	if builderSpec.ID != nil {
+		id, err := parseID(*builderSpec.ID)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, buildernode.ID(id))
+	}
+	if builderSpec.URI != nil {
+		filters = append(filters, buildernode.URI(*builderSpec.URI))
+	}
+	return filters, nil
+}
+
+func toModelBuilder(b *db.BuilderNode) *model.Builder {
+	return &model.Builder{ID: nodeID(b.ID), URI: b.URI}
+}
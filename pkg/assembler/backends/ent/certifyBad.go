@@ -0,0 +1,153 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyBad
+
+func (c *entClient) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyBad == nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", backends.Missing("certifyBad"))
+	}
+	if err := validateSubject(subject); err != nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", err)
+	}
+
+	n, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.CertifyBad, error) {
+		s, err := ingestSubject(ctx, tx.Client(), subject, pkgMatchType)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.CertifyBad.Create().
+			SetNillablePackageVersionID(s.pkgVersion).
+			SetNillablePackageNameID(s.pkgName).
+			SetNillableSourceID(s.src).
+			SetNillableArtifactID(s.artifact).
+			SetJustification(certifyBad.Justification).
+			SetOrigin(certifyBad.Origin).
+			SetCollector(certifyBad.Collector).
+			OnConflict(
+				sql.ConflictColumns(s.column(), certifybad.FieldJustification, certifybad.FieldOrigin, certifybad.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(s.column())),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withcertifybadSubject(tx.CertifyBad.Query().Where(certifybad.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestCertifyBad", err)
+	}
+	return toModelCertifyBad(n), nil
+}
+
+// Query CertifyBad
+
+func (c *entClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyBadSpec == nil {
+		certifyBadSpec = &model.CertifyBadSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyBadSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyBad :: %w", err)
+	}
+
+	var filters []predicate.CertifyBad
+	if certifyBadSpec.ID != nil {
+		id, err := parseID(*certifyBadSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("CertifyBad :: %w", err)
+		}
+		filters = append(filters, certifybad.ID(id))
+	}
+	if certifyBadSpec.Justification != nil {
+		filters = append(filters, certifybad.Justification(*certifyBadSpec.Justification))
+	}
+	if certifyBadSpec.Origin != nil {
+		filters = append(filters, certifybad.Origin(*certifyBadSpec.Origin))
+	}
+	if certifyBadSpec.Collector != nil {
+		filters = append(filters, certifybad.Collector(*certifyBadSpec.Collector))
+	}
+	if s := certifyBadSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			// Certifications on a package name match the package filters
+			// regardless of the version filters.
+			filters = append(filters, certifybad.Or(
+				certifybad.HasPackageVersionWith(packageVersionMatches(s.Package)...),
+				certifybad.HasPackageNameWith(packageNameMatches(s.Package)...),
+			))
+		case s.Source != nil:
+			filters = append(filters, certifybad.HasSourceWith(sourceNameMatches(s.Source)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("CertifyBad :: %w", err)
+			}
+			filters = append(filters, certifybad.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	certifications, err := withcertifybadSubject(c.client.CertifyBad.Query().Where(filters...)).
+		Order(db.Asc(certifybad.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "CertifyBad", err)
+	}
+
+	out := make([]*model.CertifyBad, 0, len(certifications))
+	for _, n := range certifications {
+		out = append(out, toModelCertifyBad(n))
+	}
+	return out, nil
+}
+
+// withcertifybadSubject loads the subject of the certifications returned by the
+// query.
+func withcertifybadSubject(q *db.CertifyBadQuery) *db.CertifyBadQuery {
+	return q.WithPackageVersion(withPackageVersionPath).
+		WithPackageName(withPackageNamePath).
+		WithSource(withSourceNamePath).
+		WithArtifact()
+}
+
+func toModelCertifyBad(n *db.CertifyBad) *model.CertifyBad {
+	return &model.CertifyBad{
+		ID:            nodeID(n.ID),
+		Subject:       subjectToModel(n.Edges.PackageVersion, n.Edges.PackageName, n.Edges.Source, n.Edges.Artifact),
+		Justification: n.Justification,
+		Origin:        n.Origin,
+		Collector:     n.Collector,
+	}
+}
@@ -0,0 +1,153 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyGood
+
+func (c *entClient) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyGood == nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", backends.Missing("certifyGood"))
+	}
+	if err := validateSubject(subject); err != nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", err)
+	}
+
+	n, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.CertifyGood, error) {
+		s, err := ingestSubject(ctx, tx.Client(), subject, pkgMatchType)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.CertifyGood.Create().
+			SetNillablePackageVersionID(s.pkgVersion).
+			SetNillablePackageNameID(s.pkgName).
+			SetNillableSourceID(s.src).
+			SetNillableArtifactID(s.artifact).
+			SetJustification(certifyGood.Justification).
+			SetOrigin(certifyGood.Origin).
+			SetCollector(certifyGood.Collector).
+			OnConflict(
+				sql.ConflictColumns(s.column(), certifygood.FieldJustification, certifygood.FieldOrigin, certifygood.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(s.column())),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withcertifygoodSubject(tx.CertifyGood.Query().Where(certifygood.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestCertifyGood", err)
+	}
+	return toModelCertifyGood(n), nil
+}
+
+// Query CertifyGood
+
+func (c *entClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyGoodSpec == nil {
+		certifyGoodSpec = &model.CertifyGoodSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyGoodSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyGood :: %w", err)
+	}
+
+	var filters []predicate.CertifyGood
+	if certifyGoodSpec.ID != nil {
+		id, err := parseID(*certifyGoodSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("CertifyGood :: %w", err)
+		}
+		filters = append(filters, certifygood.ID(id))
+	}
+	if certifyGoodSpec.Justification != nil {
+		filters = append(filters, certifygood.Justification(*certifyGoodSpec.Justification))
+	}
+	if certifyGoodSpec.Origin != nil {
+		filters = append(filters, certifygood.Origin(*certifyGoodSpec.Origin))
+	}
+	if certifyGoodSpec.Collector != nil {
+		filters = append(filters, certifygood.Collector(*certifyGoodSpec.Collector))
+	}
+	if s := certifyGoodSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			// Certifications on a package name match the package filters
+			// regardless of the version filters.
+			filters = append(filters, certifygood.Or(
+				certifygood.HasPackageVersionWith(packageVersionMatches(s.Package)...),
+				certifygood.HasPackageNameWith(packageNameMatches(s.Package)...),
+			))
+		case s.Source != nil:
+			filters = append(filters, certifygood.HasSourceWith(sourceNameMatches(s.Source)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("CertifyGood :: %w", err)
+			}
+			filters = append(filters, certifygood.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	certifications, err := withcertifygoodSubject(c.client.CertifyGood.Query().Where(filters...)).
+		Order(db.Asc(certifygood.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "CertifyGood", err)
+	}
+
+	out := make([]*model.CertifyGood, 0, len(certifications))
+	for _, n := range certifications {
+		out = append(out, toModelCertifyGood(n))
+	}
+	return out, nil
+}
+
+// withcertifygoodSubject loads the subject of the certifications returned by the
+// query.
+func withcertifygoodSubject(q *db.CertifyGoodQuery) *db.CertifyGoodQuery {
+	return q.WithPackageVersion(withPackageVersionPath).
+		WithPackageName(withPackageNamePath).
+		WithSource(withSourceNamePath).
+		WithArtifact()
+}
+
+func toModelCertifyGood(n *db.CertifyGood) *model.CertifyGood {
+	return &model.CertifyGood{
+		ID:            nodeID(n.ID),
+		Subject:       subjectToModel(n.Edges.PackageVersion, n.Edges.PackageName, n.Edges.Source, n.Edges.Artifact),
+		Justification: n.Justification,
+		Origin:        n.Origin,
+		Collector:     n.Collector,
+	}
+}
@@ -0,0 +1,183 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyLegal
+
+func (c *entClient) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("subject"))
+	}
+	if certifyLegal == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("certifyLegal"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", err)
+	}
+	if subject.Source != nil {
+		if err := backends.ValidateSourceInput(subject.Source); err != nil {
+			return nil, backends.Errorf("IngestCertifyLegal :: %w", err)
+		}
+	}
+
+	l, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.CertifyLegal, error) {
+		create := tx.CertifyLegal.Create().
+			SetDeclaredLicense(certifyLegal.DeclaredLicense).
+			SetDiscoveredLicense(certifyLegal.DiscoveredLicense).
+			SetAttribution(certifyLegal.Attribution).
+			SetLegalHash(listHash([]string{certifyLegal.DeclaredLicense, certifyLegal.DiscoveredLicense, certifyLegal.Attribution})).
+			SetJustification(certifyLegal.Justification).
+			SetTimeScanned(certifyLegal.TimeScanned.UTC()).
+			SetOrigin(certifyLegal.Origin).
+			SetCollector(certifyLegal.Collector)
+		subjectColumn := certifylegal.FieldPackageID
+		if subject.Package != nil {
+			pkgID, err := ingestPackage(ctx, tx.Client(), subject.Package)
+			if err != nil {
+				return nil, err
+			}
+			create.SetPackageID(pkgID)
+		} else {
+			srcID, err := ingestSource(ctx, tx.Client(), subject.Source)
+			if err != nil {
+				return nil, err
+			}
+			create.SetSourceID(srcID)
+			subjectColumn = certifylegal.FieldSourceID
+		}
+		id, err := create.
+			OnConflict(
+				sql.ConflictColumns(subjectColumn, certifylegal.FieldLegalHash, certifylegal.FieldJustification,
+					certifylegal.FieldTimeScanned, certifylegal.FieldOrigin, certifylegal.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(subjectColumn)),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withCertifyLegalSubject(tx.CertifyLegal.Query().Where(certifylegal.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestCertifyLegal", err)
+	}
+	return toModelCertifyLegal(l), nil
+}
+
+// Query CertifyLegal
+
+func (c *entClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyLegalSpec == nil {
+		certifyLegalSpec = &model.CertifyLegalSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyLegalSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyLegal :: %w", err)
+	}
+
+	var filters []predicate.CertifyLegal
+	if certifyLegalSpec.ID != nil {
+		id, err := parseID(*certifyLegalSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("CertifyLegal :: %w", err)
+		}
+		filters = append(filters, certifylegal.ID(id))
+	}
+	if certifyLegalSpec.DeclaredLicense != nil {
+		filters = append(filters, certifylegal.DeclaredLicense(*certifyLegalSpec.DeclaredLicense))
+	}
+	if certifyLegalSpec.DiscoveredLicense != nil {
+		filters = append(filters, certifylegal.DiscoveredLicense(*certifyLegalSpec.DiscoveredLicense))
+	}
+	if certifyLegalSpec.Attribution != nil {
+		filters = append(filters, certifylegal.Attribution(*certifyLegalSpec.Attribution))
+	}
+	if certifyLegalSpec.Justification != nil {
+		filters = append(filters, certifylegal.Justification(*certifyLegalSpec.Justification))
+	}
+	if certifyLegalSpec.TimeScanned != nil {
+		filters = append(filters, certifylegal.TimeScanned(certifyLegalSpec.TimeScanned.UTC()))
+	}
+	if certifyLegalSpec.Origin != nil {
+		filters = append(filters, certifylegal.Origin(*certifyLegalSpec.Origin))
+	}
+	if certifyLegalSpec.Collector != nil {
+		filters = append(filters, certifylegal.Collector(*certifyLegalSpec.Collector))
+	}
+	if s := certifyLegalSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			filters = append(filters, certifylegal.HasPackageWith(packageVersionMatches(s.Package)...))
+		case s.Source != nil:
+			filters = append(filters, certifylegal.HasSourceWith(sourceNameMatches(s.Source)...))
+		}
+	}
+
+	legals, err := withCertifyLegalSubject(c.client.CertifyLegal.Query().Where(filters...)).
+		Order(db.Asc(certifylegal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "CertifyLegal", err)
+	}
+
+	out := make([]*model.CertifyLegal, 0, len(legals))
+	for _, l := range legals {
+		out = append(out, toModelCertifyLegal(l))
+	}
+	return out, nil
+}
+
+// withCertifyLegalSubject loads the subject of the rows returned by the query.
+func withCertifyLegalSubject(q *db.CertifyLegalQuery) *db.CertifyLegalQuery {
+	return q.WithPackage(withPackageVersionPath).
+		WithSource(withSourceNamePath)
+}
+
+func toModelCertifyLegal(l *db.CertifyLegal) *model.CertifyLegal {
+	var subject model.PackageOrSource
+	if l.Edges.Package != nil {
+		subject = versionToPackage(l.Edges.Package)
+	} else {
+		subject = nameToSource(l.Edges.Source)
+	}
+	return &model.CertifyLegal{
+		ID:                nodeID(l.ID),
+		Subject:           subject,
+		DeclaredLicense:   l.DeclaredLicense,
+		DiscoveredLicense: l.DiscoveredLicense,
+		Attribution:       l.Attribution,
+		Justification:     l.Justification,
+		TimeScanned:       l.TimeScanned.UTC(),
+		Origin:            l.Origin,
+		Collector:         l.Collector,
+	}
+}
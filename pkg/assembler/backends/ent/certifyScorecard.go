@@ -0,0 +1,160 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/scorecard"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest Scorecard
+
+func (c *entClient) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecardInput *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("source"))
+	}
+	if scorecardInput == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("scorecard"))
+	}
+	if err := backends.ValidateSourceInput(source); err != nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", err)
+	}
+
+	// Checks are sorted by name, to return them in a stable order.
+	checks := make([]model.ScorecardCheck, 0, len(scorecardInput.Checks))
+	for _, check := range scorecardInput.Checks {
+		checks = append(checks, model.ScorecardCheck{Check: check.Check, Score: check.Score})
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Check < checks[j].Check
+	})
+
+	s, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.Scorecard, error) {
+		srcID, err := ingestSource(ctx, tx.Client(), source)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.Scorecard.Create().
+			SetSourceID(srcID).
+			SetChecks(checks).
+			SetAggregateScore(scorecardInput.AggregateScore).
+			SetTimeScanned(scorecardInput.TimeScanned.UTC()).
+			SetScorecardVersion(scorecardInput.ScorecardVersion).
+			SetCommit(scorecardInput.Commit).
+			SetOrigin(scorecardInput.Origin).
+			SetCollector(scorecardInput.Collector).
+			OnConflict(sql.ConflictColumns(scorecard.FieldSourceID, scorecard.FieldCommit, scorecard.FieldTimeScanned)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return tx.Scorecard.Query().
+			Where(scorecard.ID(id)).
+			WithSource(withSourceNamePath).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestScorecard", err)
+	}
+	return toModelScorecard(s), nil
+}
+
+// Query Scorecards
+
+func (c *entClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyScorecardSpec == nil {
+		certifyScorecardSpec = &model.CertifyScorecardSpec{}
+	}
+	var filters []predicate.Scorecard
+	if certifyScorecardSpec.ID != nil {
+		id, err := parseID(*certifyScorecardSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("Scorecards :: %w", err)
+		}
+		filters = append(filters, scorecard.ID(id))
+	}
+	if certifyScorecardSpec.MinAggregateScore != nil {
+		filters = append(filters, scorecard.AggregateScoreGTE(*certifyScorecardSpec.MinAggregateScore))
+	}
+	if certifyScorecardSpec.ScorecardVersion != nil {
+		filters = append(filters, scorecard.ScorecardVersion(*certifyScorecardSpec.ScorecardVersion))
+	}
+	if certifyScorecardSpec.Commit != nil {
+		filters = append(filters, scorecard.Commit(*certifyScorecardSpec.Commit))
+	}
+	if certifyScorecardSpec.Origin != nil {
+		filters = append(filters, scorecard.Origin(*certifyScorecardSpec.Origin))
+	}
+	if certifyScorecardSpec.Collector != nil {
+		filters = append(filters, scorecard.Collector(*certifyScorecardSpec.Collector))
+	}
+	if certifyScorecardSpec.Source != nil {
+		filters = append(filters, scorecard.HasSourceWith(sourceNameMatches(certifyScorecardSpec.Source)...))
+	}
+
+	if certifyScorecardSpec.LatestOnly != nil && *certifyScorecardSpec.LatestOnly {
+		filters = append(filters, latestOnly(scorecard.Table, scorecard.FieldTimeScanned, []string{scorecard.FieldSourceID}, false, filters))
+	}
+
+	scorecards, err := c.client.Scorecard.Query().
+		Where(filters...).
+		WithSource(withSourceNamePath).
+		Order(db.Asc(scorecard.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "Scorecards", err)
+	}
+
+	out := make([]*model.CertifyScorecard, 0, len(scorecards))
+	for _, s := range scorecards {
+		out = append(out, toModelScorecard(s))
+	}
+	return out, nil
+}
+
+func toModelScorecard(s *db.Scorecard) *model.CertifyScorecard {
+	checks := make([]*model.ScorecardCheck, 0, len(s.Checks))
+	for _, check := range s.Checks {
+		checks = append(checks, &model.ScorecardCheck{Check: check.Check, Score: check.Score})
+	}
+	return &model.CertifyScorecard{
+		ID:     nodeID(s.ID),
+		Source: nameToSource(s.Edges.Source),
+		Scorecard: &model.Scorecard{
+			Checks:           checks,
+			AggregateScore:   s.AggregateScore,
+			TimeScanned:      s.TimeScanned.UTC(),
+			ScorecardVersion: s.ScorecardVersion,
+			Commit:           s.Commit,
+			Origin:           s.Origin,
+			Collector:        s.Collector,
+		},
+	}
+}
@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyVEXStatement
+
+func (c *entClient) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("subject"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vulnerability"))
+	}
+	if vexStatement == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vexStatement"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if err := backends.ValidateVEXStatementInput(vexStatement); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if subject.Artifact != nil {
+		if _, _, err := backends.CanonicalArtifact(subject.Artifact); err != nil {
+			return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+		}
+	}
+	vulnType, vulnID, err := canonicalVulnerability(vulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+
+	v, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.CertifyVEXStatement, error) {
+		vulnerabilityID, err := ingestVulnerability(ctx, tx.Client(), vulnType, vulnID)
+		if err != nil {
+			return nil, err
+		}
+		create := tx.CertifyVEXStatement.Create().
+			SetVulnerabilityID(vulnerabilityID).
+			SetStatus(vexStatement.Status).
+			SetVexJustification(vexStatement.VexJustification).
+			SetStatement(vexStatement.Statement).
+			SetStatementHash(listHash([]string{vexStatement.Statement})).
+			SetKnownSince(vexStatement.KnownSince.UTC()).
+			SetOrigin(vexStatement.Origin).
+			SetCollector(vexStatement.Collector)
+		subjectColumn := certifyvexstatement.FieldPackageID
+		if subject.Package != nil {
+			pkgID, err := ingestPackage(ctx, tx.Client(), subject.Package)
+			if err != nil {
+				return nil, err
+			}
+			create.SetPackageID(pkgID)
+		} else {
+			artifactID, err := ingestArtifactInput(ctx, tx.Client(), subject.Artifact)
+			if err != nil {
+				return nil, err
+			}
+			create.SetArtifactID(artifactID)
+			subjectColumn = certifyvexstatement.FieldArtifactID
+		}
+		id, err := create.
+			OnConflict(
+				sql.ConflictColumns(subjectColumn, certifyvexstatement.FieldVulnerabilityID,
+					certifyvexstatement.FieldStatus, certifyvexstatement.FieldVexJustification,
+					certifyvexstatement.FieldStatementHash, certifyvexstatement.FieldKnownSince,
+					certifyvexstatement.FieldOrigin, certifyvexstatement.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(subjectColumn)),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withVEXStatementPaths(tx.CertifyVEXStatement.Query().Where(certifyvexstatement.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestVEXStatement", err)
+	}
+	return toModelVEXStatement(v), nil
+}
+
+// Query CertifyVEXStatement
+
+func (c *entClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyVEXStatementSpec == nil {
+		certifyVEXStatementSpec = &model.CertifyVEXStatementSpec{}
+	}
+	spec := certifyVEXStatementSpec
+	if err := backends.ValidateSubjectSpec(spec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+	}
+
+	var filters []predicate.CertifyVEXStatement
+	if spec.ID != nil {
+		id, err := parseID(*spec.ID)
+		if err != nil {
+			return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+		}
+		filters = append(filters, certifyvexstatement.ID(id))
+	}
+	if spec.Status != nil {
+		filters = append(filters, certifyvexstatement.Status(*spec.Status))
+	}
+	if spec.VexJustification != nil {
+		filters = append(filters, certifyvexstatement.VexJustification(*spec.VexJustification))
+	}
+	if spec.Statement != nil {
+		filters = append(filters, certifyvexstatement.Statement(*spec.Statement))
+	}
+	if spec.KnownSinceFrom != nil {
+		filters = append(filters, certifyvexstatement.KnownSinceGTE(spec.KnownSinceFrom.UTC()))
+	}
+	if spec.KnownSinceUntil != nil {
+		filters = append(filters, certifyvexstatement.KnownSinceLTE(spec.KnownSinceUntil.UTC()))
+	}
+	if spec.Origin != nil {
+		filters = append(filters, certifyvexstatement.Origin(*spec.Origin))
+	}
+	if spec.Collector != nil {
+		filters = append(filters, certifyvexstatement.Collector(*spec.Collector))
+	}
+	if spec.Vulnerability != nil {
+		vulnFilters, err := vulnerabilityIDMatches(spec.Vulnerability)
+		if err != nil {
+			return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+		}
+		filters = append(filters, certifyvexstatement.HasVulnerabilityWith(vulnFilters...))
+	}
+	if s := spec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			filters = append(filters, certifyvexstatement.HasPackageWith(packageVersionMatches(s.Package)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+			}
+			filters = append(filters, certifyvexstatement.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	statements, err := withVEXStatementPaths(c.client.CertifyVEXStatement.Query().Where(filters...)).
+		Order(db.Asc(certifyvexstatement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "CertifyVEXStatement", err)
+	}
+
+	out := make([]*model.CertifyVEXStatement, 0, len(statements))
+	for _, v := range statements {
+		out = append(out, toModelVEXStatement(v))
+	}
+	return out, nil
+}
+
+// withVEXStatementPaths loads the subject and vulnerability of the rows
+// returned by the query.
+func withVEXStatementPaths(q *db.CertifyVEXStatementQuery) *db.CertifyVEXStatementQuery {
+	return q.WithPackage(withPackageVersionPath).
+		WithArtifact().
+		WithVulnerability(func(q *db.VulnerabilityIDQuery) { q.WithType() })
+}
+
+func toModelVEXStatement(v *db.CertifyVEXStatement) *model.CertifyVEXStatement {
+	var subject model.PackageOrArtifact
+	if v.Edges.Package != nil {
+		subject = versionToPackage(v.Edges.Package)
+	} else {
+		subject = toModelArtifact(v.Edges.Artifact)
+	}
+	return &model.CertifyVEXStatement{
+		ID:               nodeID(v.ID),
+		Subject:          subject,
+		Vulnerability:    idToVulnerability(v.Edges.Vulnerability),
+		Status:           v.Status,
+		VexJustification: v.VexJustification,
+		Statement:        v.Statement,
+		KnownSince:       v.KnownSince.UTC(),
+		Origin:           v.Origin,
+		Collector:        v.Collector,
+	}
+}
@@ -0,0 +1,177 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyVuln
+
+func (c *entClient) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("pkg"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("vulnerability"))
+	}
+	if certifyVuln == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("certifyVuln"))
+	}
+	vulnType, vulnID, err := canonicalVulnerability(vulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", err)
+	}
+
+	cv, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.CertifyVuln, error) {
+		pkgID, err := ingestPackage(ctx, tx.Client(), pkg)
+		if err != nil {
+			return nil, err
+		}
+		vulnerabilityID, err := ingestVulnerability(ctx, tx.Client(), vulnType, vulnID)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.CertifyVuln.Create().
+			SetPackageID(pkgID).
+			SetVulnerabilityID(vulnerabilityID).
+			SetTimeScanned(certifyVuln.TimeScanned.UTC()).
+			SetDbURI(certifyVuln.DbURI).
+			SetDbVersion(certifyVuln.DbVersion).
+			SetScannerURI(certifyVuln.ScannerURI).
+			SetScannerVersion(certifyVuln.ScannerVersion).
+			SetOrigin(certifyVuln.Origin).
+			SetCollector(certifyVuln.Collector).
+			OnConflict(sql.ConflictColumns(certifyvuln.FieldPackageID, certifyvuln.FieldVulnerabilityID,
+				certifyvuln.FieldTimeScanned, certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion,
+				certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion,
+				certifyvuln.FieldOrigin, certifyvuln.FieldCollector)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withCertifyVulnPaths(tx.CertifyVuln.Query().Where(certifyvuln.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestCertifyVuln", err)
+	}
+	return toModelCertifyVuln(cv), nil
+}
+
+// Query CertifyVuln
+
+func (c *entClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyVulnSpec == nil {
+		certifyVulnSpec = &model.CertifyVulnSpec{}
+	}
+
+	var filters []predicate.CertifyVuln
+	if certifyVulnSpec.ID != nil {
+		id, err := parseID(*certifyVulnSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("CertifyVuln :: %w", err)
+		}
+		filters = append(filters, certifyvuln.ID(id))
+	}
+	if certifyVulnSpec.TimeScannedSince != nil {
+		filters = append(filters, certifyvuln.TimeScannedGTE(certifyVulnSpec.TimeScannedSince.UTC()))
+	}
+	if certifyVulnSpec.TimeScannedUntil != nil {
+		filters = append(filters, certifyvuln.TimeScannedLTE(certifyVulnSpec.TimeScannedUntil.UTC()))
+	}
+	if certifyVulnSpec.DbURI != nil {
+		filters = append(filters, certifyvuln.DbURI(*certifyVulnSpec.DbURI))
+	}
+	if certifyVulnSpec.DbVersion != nil {
+		filters = append(filters, certifyvuln.DbVersion(*certifyVulnSpec.DbVersion))
+	}
+	if certifyVulnSpec.ScannerURI != nil {
+		filters = append(filters, certifyvuln.ScannerURI(*certifyVulnSpec.ScannerURI))
+	}
+	if certifyVulnSpec.ScannerVersion != nil {
+		filters = append(filters, certifyvuln.ScannerVersion(*certifyVulnSpec.ScannerVersion))
+	}
+	if certifyVulnSpec.Origin != nil {
+		filters = append(filters, certifyvuln.Origin(*certifyVulnSpec.Origin))
+	}
+	if certifyVulnSpec.Collector != nil {
+		filters = append(filters, certifyvuln.Collector(*certifyVulnSpec.Collector))
+	}
+	if certifyVulnSpec.Package != nil {
+		filters = append(filters, certifyvuln.HasPackageWith(packageVersionMatches(certifyVulnSpec.Package)...))
+	}
+	if certifyVulnSpec.Vulnerability != nil {
+		vulnFilters, err := vulnerabilityIDMatches(certifyVulnSpec.Vulnerability)
+		if err != nil {
+			return nil, backends.Errorf("CertifyVuln :: %w", err)
+		}
+		filters = append(filters, certifyvuln.HasVulnerabilityWith(vulnFilters...))
+	}
+	if certifyVulnSpec.LatestOnly != nil && *certifyVulnSpec.LatestOnly {
+		filters = append(filters, latestOnly(certifyvuln.Table, certifyvuln.FieldTimeScanned, []string{certifyvuln.FieldPackageID}, true, filters))
+	}
+
+	vulns, err := withCertifyVulnPaths(c.client.CertifyVuln.Query().Where(filters...)).
+		Order(db.Asc(certifyvuln.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "CertifyVuln", err)
+	}
+
+	out := make([]*model.CertifyVuln, 0, len(vulns))
+	for _, cv := range vulns {
+		out = append(out, toModelCertifyVuln(cv))
+	}
+	return out, nil
+}
+
+// withCertifyVulnPaths loads the package and vulnerability of the rows
+// returned by the query.
+func withCertifyVulnPaths(q *db.CertifyVulnQuery) *db.CertifyVulnQuery {
+	return q.WithPackage(withPackageVersionPath).
+		WithVulnerability(func(q *db.VulnerabilityIDQuery) { q.WithType() })
+}
+
+func toModelCertifyVuln(cv *db.CertifyVuln) *model.CertifyVuln {
+	return &model.CertifyVuln{
+		ID:            nodeID(cv.ID),
+		Package:       versionToPackage(cv.Edges.Package),
+		Vulnerability: idToVulnerability(cv.Edges.Vulnerability),
+		Metadata: &model.ScanMetadata{
+			TimeScanned:    cv.TimeScanned.UTC(),
+			DbURI:          cv.DbURI,
+			DbVersion:      cv.DbVersion,
+			ScannerURI:     cv.ScannerURI,
+			ScannerVersion: cv.ScannerVersion,
+			Origin:         cv.Origin,
+			Collector:      cv.Collector,
+		},
+	}
+}
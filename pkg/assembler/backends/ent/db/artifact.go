@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+)
+
+// Artifact is the model entity for the Artifact schema.
+type Artifact struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArtifactQuery when eager-loading is set.
+	Edges ArtifactEdges `json:"edges"`
+}
+
+// ArtifactEdges holds the relations/edges for other nodes in the graph.
+type ArtifactEdges struct {
+	// MaterialOf holds the value of the material_of edge.
+	MaterialOf []*HasSLSA `json:"material_of,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MaterialOfOrErr returns the MaterialOf value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArtifactEdges) MaterialOfOrErr() ([]*HasSLSA, error) {
+	if e.loadedTypes[0] {
+		return e.MaterialOf, nil
+	}
+	return nil, &NotLoadedError{edge: "material_of"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Artifact) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case artifact.FieldID:
+			values[i] = new(sql.NullInt64)
+		case artifact.FieldAlgorithm, artifact.FieldDigest:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Artifact", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Artifact fields.
+func (a *Artifact) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case artifact.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case artifact.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				a.Algorithm = value.String
+			}
+		case artifact.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				a.Digest = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryMaterialOf queries the "material_of" edge of the Artifact entity.
+func (a *Artifact) QueryMaterialOf() *HasSLSAQuery {
+	return NewArtifactClient(a.config).QueryMaterialOf(a)
+}
+
+// Update returns a builder for updating this Artifact.
+// Note that you need to call Artifact.Unwrap() before calling this method if this Artifact
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Artifact) Update() *ArtifactUpdateOne {
+	return NewArtifactClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Artifact entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Artifact) Unwrap() *Artifact {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Artifact is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Artifact) String() string {
+	var builder strings.Builder
+	builder.WriteString("Artifact(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("algorithm=")
+	builder.WriteString(a.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(a.Digest)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Artifacts is a parsable slice of Artifact.
+type Artifacts []*Artifact
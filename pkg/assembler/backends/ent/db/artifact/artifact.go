@@ -0,0 +1,46 @@
+// Code generated by ent, DO NOT EDIT.
+
+package artifact
+
+const (
+	// Label holds the string label denoting the artifact type in the database.
+	Label = "artifact"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// EdgeMaterialOf holds the string denoting the material_of edge name in mutations.
+	EdgeMaterialOf = "material_of"
+	// Table holds the table name of the artifact in the database.
+	Table = "artifacts"
+	// MaterialOfTable is the table that holds the material_of relation/edge. The primary key declared below.
+	MaterialOfTable = "has_slsa_built_from"
+	// MaterialOfInverseTable is the table name for the HasSLSA entity.
+	// It exists in this package in order to avoid circular dependency with the "hasslsa" package.
+	MaterialOfInverseTable = "has_slsas"
+)
+
+// Columns holds all SQL columns for artifact fields.
+var Columns = []string{
+	FieldID,
+	FieldAlgorithm,
+	FieldDigest,
+}
+
+var (
+	// MaterialOfPrimaryKey and MaterialOfColumn2 are the table columns denoting the
+	// primary key for the material_of relation (M2M).
+	MaterialOfPrimaryKey = []string{"has_slsa_id", "artifact_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+)
+
+// BuilderNode is the model entity for the BuilderNode schema.
+type BuilderNode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BuilderNode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case buildernode.FieldID:
+			values[i] = new(sql.NullInt64)
+		case buildernode.FieldURI:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BuilderNode", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BuilderNode fields.
+func (bn *BuilderNode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case buildernode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bn.ID = int(value.Int64)
+		case buildernode.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				bn.URI = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BuilderNode.
+// Note that you need to call BuilderNode.Unwrap() before calling this method if this BuilderNode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bn *BuilderNode) Update() *BuilderNodeUpdateOne {
+	return NewBuilderNodeClient(bn.config).UpdateOne(bn)
+}
+
+// Unwrap unwraps the BuilderNode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bn *BuilderNode) Unwrap() *BuilderNode {
+	_tx, ok := bn.config.driver.(*txDriver)
+	if !ok {
+		panic("db: BuilderNode is not a transactional entity")
+	}
+	bn.config.driver = _tx.drv
+	return bn
+}
+
+// String implements the fmt.Stringer.
+func (bn *BuilderNode) String() string {
+	var builder strings.Builder
+	builder.WriteString("BuilderNode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bn.ID))
+	builder.WriteString("uri=")
+	builder.WriteString(bn.URI)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BuilderNodes is a parsable slice of BuilderNode.
+type BuilderNodes []*BuilderNode
@@ -0,0 +1,30 @@
+// Code generated by ent, DO NOT EDIT.
+
+package buildernode
+
+const (
+	// Label holds the string label denoting the buildernode type in the database.
+	Label = "builder_node"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// Table holds the table name of the buildernode in the database.
+	Table = "builders"
+)
+
+// Columns holds all SQL columns for buildernode fields.
+var Columns = []string{
+	FieldID,
+	FieldURI,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+)
+
+// BuilderNodeCreate is the builder for creating a BuilderNode entity.
+type BuilderNodeCreate struct {
+	config
+	mutation *BuilderNodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURI sets the "uri" field.
+func (bnc *BuilderNodeCreate) SetURI(s string) *BuilderNodeCreate {
+	bnc.mutation.SetURI(s)
+	return bnc
+}
+
+// Mutation returns the BuilderNodeMutation object of the builder.
+func (bnc *BuilderNodeCreate) Mutation() *BuilderNodeMutation {
+	return bnc.mutation
+}
+
+// Save creates the BuilderNode in the database.
+func (bnc *BuilderNodeCreate) Save(ctx context.Context) (*BuilderNode, error) {
+	return withHooks[*BuilderNode, BuilderNodeMutation](ctx, bnc.sqlSave, bnc.mutation, bnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bnc *BuilderNodeCreate) SaveX(ctx context.Context) *BuilderNode {
+	v, err := bnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bnc *BuilderNodeCreate) Exec(ctx context.Context) error {
+	_, err := bnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bnc *BuilderNodeCreate) ExecX(ctx context.Context) {
+	if err := bnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bnc *BuilderNodeCreate) check() error {
+	if _, ok := bnc.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`db: missing required field "BuilderNode.uri"`)}
+	}
+	return nil
+}
+
+func (bnc *BuilderNodeCreate) sqlSave(ctx context.Context) (*BuilderNode, error) {
+	if err := bnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bnc.mutation.id = &_node.ID
+	bnc.mutation.done = true
+	return _node, nil
+}
+
+func (bnc *BuilderNodeCreate) createSpec() (*BuilderNode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BuilderNode{config: bnc.config}
+		_spec = sqlgraph.NewCreateSpec(buildernode.Table, sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = bnc.conflict
+	if value, ok := bnc.mutation.URI(); ok {
+		_spec.SetField(buildernode.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BuilderNode.Create().
+//		SetURI(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BuilderNodeUpsert) {
+//			SetURI(v+v).
+//		}).
+//		Exec(ctx)
+func (bnc *BuilderNodeCreate) OnConflict(opts ...sql.ConflictOption) *BuilderNodeUpsertOne {
+	bnc.conflict = opts
+	return &BuilderNodeUpsertOne{
+		create: bnc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bnc *BuilderNodeCreate) OnConflictColumns(columns ...string) *BuilderNodeUpsertOne {
+	bnc.conflict = append(bnc.conflict, sql.ConflictColumns(columns...))
+	return &BuilderNodeUpsertOne{
+		create: bnc,
+	}
+}
+
+type (
+	// BuilderNodeUpsertOne is the builder for "upsert"-ing
+	//  one BuilderNode node.
+	BuilderNodeUpsertOne struct {
+		create *BuilderNodeCreate
+	}
+
+	// BuilderNodeUpsert is the "OnConflict" setter.
+	BuilderNodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetURI sets the "uri" field.
+func (u *BuilderNodeUpsert) SetURI(v string) *BuilderNodeUpsert {
+	u.Set(buildernode.FieldURI, v)
+	return u
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *BuilderNodeUpsert) UpdateURI() *BuilderNodeUpsert {
+	u.SetExcluded(buildernode.FieldURI)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BuilderNodeUpsertOne) UpdateNewValues() *BuilderNodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BuilderNodeUpsertOne) Ignore() *BuilderNodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BuilderNodeUpsertOne) DoNothing() *BuilderNodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BuilderNodeCreate.OnConflict
+// documentation for more info.
+func (u *BuilderNodeUpsertOne) Update(set func(*BuilderNodeUpsert)) *BuilderNodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BuilderNodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURI sets the "uri" field.
+func (u *BuilderNodeUpsertOne) SetURI(v string) *BuilderNodeUpsertOne {
+	return u.Update(func(s *BuilderNodeUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *BuilderNodeUpsertOne) UpdateURI() *BuilderNodeUpsertOne {
+	return u.Update(func(s *BuilderNodeUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// Exec executes the query.
+func (u *BuilderNodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for BuilderNodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BuilderNodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BuilderNodeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BuilderNodeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BuilderNodeCreateBulk is the builder for creating many BuilderNode entities in bulk.
+type BuilderNodeCreateBulk struct {
+	config
+	builders []*BuilderNodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BuilderNode entities in the database.
+func (bncb *BuilderNodeCreateBulk) Save(ctx context.Context) ([]*BuilderNode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bncb.builders))
+	nodes := make([]*BuilderNode, len(bncb.builders))
+	mutators := make([]Mutator, len(bncb.builders))
+	for i := range bncb.builders {
+		func(i int, root context.Context) {
+			builder := bncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BuilderNodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bncb *BuilderNodeCreateBulk) SaveX(ctx context.Context) []*BuilderNode {
+	v, err := bncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bncb *BuilderNodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := bncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bncb *BuilderNodeCreateBulk) ExecX(ctx context.Context) {
+	if err := bncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BuilderNode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BuilderNodeUpsert) {
+//			SetURI(v+v).
+//		}).
+//		Exec(ctx)
+func (bncb *BuilderNodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *BuilderNodeUpsertBulk {
+	bncb.conflict = opts
+	return &BuilderNodeUpsertBulk{
+		create: bncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bncb *BuilderNodeCreateBulk) OnConflictColumns(columns ...string) *BuilderNodeUpsertBulk {
+	bncb.conflict = append(bncb.conflict, sql.ConflictColumns(columns...))
+	return &BuilderNodeUpsertBulk{
+		create: bncb,
+	}
+}
+
+// BuilderNodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of BuilderNode nodes.
+type BuilderNodeUpsertBulk struct {
+	create *BuilderNodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BuilderNodeUpsertBulk) UpdateNewValues() *BuilderNodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BuilderNode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BuilderNodeUpsertBulk) Ignore() *BuilderNodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BuilderNodeUpsertBulk) DoNothing() *BuilderNodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BuilderNodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *BuilderNodeUpsertBulk) Update(set func(*BuilderNodeUpsert)) *BuilderNodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BuilderNodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURI sets the "uri" field.
+func (u *BuilderNodeUpsertBulk) SetURI(v string) *BuilderNodeUpsertBulk {
+	return u.Update(func(s *BuilderNodeUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *BuilderNodeUpsertBulk) UpdateURI() *BuilderNodeUpsertBulk {
+	return u.Update(func(s *BuilderNodeUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// Exec executes the query.
+func (u *BuilderNodeUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the BuilderNodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for BuilderNodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BuilderNodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
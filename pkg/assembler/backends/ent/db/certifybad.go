@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyBad is the model entity for the CertifyBad schema.
+type CertifyBad struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageVersionID holds the value of the "package_version_id" field.
+	PackageVersionID *int `json:"package_version_id,omitempty"`
+	// PackageNameID holds the value of the "package_name_id" field.
+	PackageNameID *int `json:"package_name_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID *int `json:"source_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID *int `json:"artifact_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertifyBadQuery when eager-loading is set.
+	Edges CertifyBadEdges `json:"edges"`
+}
+
+// CertifyBadEdges holds the relations/edges for other nodes in the graph.
+type CertifyBadEdges struct {
+	// PackageVersion holds the value of the package_version edge.
+	PackageVersion *PackageVersion `json:"package_version,omitempty"`
+	// PackageName holds the value of the package_name edge.
+	PackageName *PackageName `json:"package_name,omitempty"`
+	// Source holds the value of the source edge.
+	Source *SourceName `json:"source,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PackageVersionOrErr returns the PackageVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyBadEdges) PackageVersionOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.PackageVersion == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.PackageVersion, nil
+	}
+	return nil, &NotLoadedError{edge: "package_version"}
+}
+
+// PackageNameOrErr returns the PackageName value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyBadEdges) PackageNameOrErr() (*PackageName, error) {
+	if e.loadedTypes[1] {
+		if e.PackageName == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packagename.Label}
+		}
+		return e.PackageName, nil
+	}
+	return nil, &NotLoadedError{edge: "package_name"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyBadEdges) SourceOrErr() (*SourceName, error) {
+	if e.loadedTypes[2] {
+		if e.Source == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: sourcename.Label}
+		}
+		return e.Source, nil
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyBadEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[3] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CertifyBad) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certifybad.FieldID, certifybad.FieldPackageVersionID, certifybad.FieldPackageNameID, certifybad.FieldSourceID, certifybad.FieldArtifactID:
+			values[i] = new(sql.NullInt64)
+		case certifybad.FieldJustification, certifybad.FieldOrigin, certifybad.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CertifyBad", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CertifyBad fields.
+func (cb *CertifyBad) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certifybad.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cb.ID = int(value.Int64)
+		case certifybad.FieldPackageVersionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_version_id", values[i])
+			} else if value.Valid {
+				cb.PackageVersionID = new(int)
+				*cb.PackageVersionID = int(value.Int64)
+			}
+		case certifybad.FieldPackageNameID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name_id", values[i])
+			} else if value.Valid {
+				cb.PackageNameID = new(int)
+				*cb.PackageNameID = int(value.Int64)
+			}
+		case certifybad.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				cb.SourceID = new(int)
+				*cb.SourceID = int(value.Int64)
+			}
+		case certifybad.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				cb.ArtifactID = new(int)
+				*cb.ArtifactID = int(value.Int64)
+			}
+		case certifybad.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				cb.Justification = value.String
+			}
+		case certifybad.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				cb.Origin = value.String
+			}
+		case certifybad.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				cb.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackageVersion queries the "package_version" edge of the CertifyBad entity.
+func (cb *CertifyBad) QueryPackageVersion() *PackageVersionQuery {
+	return NewCertifyBadClient(cb.config).QueryPackageVersion(cb)
+}
+
+// QueryPackageName queries the "package_name" edge of the CertifyBad entity.
+func (cb *CertifyBad) QueryPackageName() *PackageNameQuery {
+	return NewCertifyBadClient(cb.config).QueryPackageName(cb)
+}
+
+// QuerySource queries the "source" edge of the CertifyBad entity.
+func (cb *CertifyBad) QuerySource() *SourceNameQuery {
+	return NewCertifyBadClient(cb.config).QuerySource(cb)
+}
+
+// QueryArtifact queries the "artifact" edge of the CertifyBad entity.
+func (cb *CertifyBad) QueryArtifact() *ArtifactQuery {
+	return NewCertifyBadClient(cb.config).QueryArtifact(cb)
+}
+
+// Update returns a builder for updating this CertifyBad.
+// Note that you need to call CertifyBad.Unwrap() before calling this method if this CertifyBad
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cb *CertifyBad) Update() *CertifyBadUpdateOne {
+	return NewCertifyBadClient(cb.config).UpdateOne(cb)
+}
+
+// Unwrap unwraps the CertifyBad entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cb *CertifyBad) Unwrap() *CertifyBad {
+	_tx, ok := cb.config.driver.(*txDriver)
+	if !ok {
+		panic("db: CertifyBad is not a transactional entity")
+	}
+	cb.config.driver = _tx.drv
+	return cb
+}
+
+// String implements the fmt.Stringer.
+func (cb *CertifyBad) String() string {
+	var builder strings.Builder
+	builder.WriteString("CertifyBad(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cb.ID))
+	if v := cb.PackageVersionID; v != nil {
+		builder.WriteString("package_version_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cb.PackageNameID; v != nil {
+		builder.WriteString("package_name_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cb.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cb.ArtifactID; v != nil {
+		builder.WriteString("artifact_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(cb.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(cb.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(cb.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CertifyBads is a parsable slice of CertifyBad.
+type CertifyBads []*CertifyBad
@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifybad
+
+const (
+	// Label holds the string label denoting the certifybad type in the database.
+	Label = "certify_bad"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageVersionID holds the string denoting the package_version_id field in the database.
+	FieldPackageVersionID = "package_version_id"
+	// FieldPackageNameID holds the string denoting the package_name_id field in the database.
+	FieldPackageNameID = "package_name_id"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldArtifactID holds the string denoting the artifact_id field in the database.
+	FieldArtifactID = "artifact_id"
+	// FieldJustification holds the string denoting the justification field in the database.
+	FieldJustification = "justification"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackageVersion holds the string denoting the package_version edge name in mutations.
+	EdgePackageVersion = "package_version"
+	// EdgePackageName holds the string denoting the package_name edge name in mutations.
+	EdgePackageName = "package_name"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// EdgeArtifact holds the string denoting the artifact edge name in mutations.
+	EdgeArtifact = "artifact"
+	// Table holds the table name of the certifybad in the database.
+	Table = "certify_bads"
+	// PackageVersionTable is the table that holds the package_version relation/edge.
+	PackageVersionTable = "certify_bads"
+	// PackageVersionInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageVersionInverseTable = "package_versions"
+	// PackageVersionColumn is the table column denoting the package_version relation/edge.
+	PackageVersionColumn = "package_version_id"
+	// PackageNameTable is the table that holds the package_name relation/edge.
+	PackageNameTable = "certify_bads"
+	// PackageNameInverseTable is the table name for the PackageName entity.
+	// It exists in this package in order to avoid circular dependency with the "packagename" package.
+	PackageNameInverseTable = "package_names"
+	// PackageNameColumn is the table column denoting the package_name relation/edge.
+	PackageNameColumn = "package_name_id"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "certify_bads"
+	// SourceInverseTable is the table name for the SourceName entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcename" package.
+	SourceInverseTable = "source_names"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "source_id"
+	// ArtifactTable is the table that holds the artifact relation/edge.
+	ArtifactTable = "certify_bads"
+	// ArtifactInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactInverseTable = "artifacts"
+	// ArtifactColumn is the table column denoting the artifact relation/edge.
+	ArtifactColumn = "artifact_id"
+)
+
+// Columns holds all SQL columns for certifybad fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageVersionID,
+	FieldPackageNameID,
+	FieldSourceID,
+	FieldArtifactID,
+	FieldJustification,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyBadCreate is the builder for creating a CertifyBad entity.
+type CertifyBadCreate struct {
+	config
+	mutation *CertifyBadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cbc *CertifyBadCreate) SetPackageVersionID(i int) *CertifyBadCreate {
+	cbc.mutation.SetPackageVersionID(i)
+	return cbc
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cbc *CertifyBadCreate) SetNillablePackageVersionID(i *int) *CertifyBadCreate {
+	if i != nil {
+		cbc.SetPackageVersionID(*i)
+	}
+	return cbc
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cbc *CertifyBadCreate) SetPackageNameID(i int) *CertifyBadCreate {
+	cbc.mutation.SetPackageNameID(i)
+	return cbc
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cbc *CertifyBadCreate) SetNillablePackageNameID(i *int) *CertifyBadCreate {
+	if i != nil {
+		cbc.SetPackageNameID(*i)
+	}
+	return cbc
+}
+
+// SetSourceID sets the "source_id" field.
+func (cbc *CertifyBadCreate) SetSourceID(i int) *CertifyBadCreate {
+	cbc.mutation.SetSourceID(i)
+	return cbc
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cbc *CertifyBadCreate) SetNillableSourceID(i *int) *CertifyBadCreate {
+	if i != nil {
+		cbc.SetSourceID(*i)
+	}
+	return cbc
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cbc *CertifyBadCreate) SetArtifactID(i int) *CertifyBadCreate {
+	cbc.mutation.SetArtifactID(i)
+	return cbc
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cbc *CertifyBadCreate) SetNillableArtifactID(i *int) *CertifyBadCreate {
+	if i != nil {
+		cbc.SetArtifactID(*i)
+	}
+	return cbc
+}
+
+// SetJustification sets the "justification" field.
+func (cbc *CertifyBadCreate) SetJustification(s string) *CertifyBadCreate {
+	cbc.mutation.SetJustification(s)
+	return cbc
+}
+
+// SetOrigin sets the "origin" field.
+func (cbc *CertifyBadCreate) SetOrigin(s string) *CertifyBadCreate {
+	cbc.mutation.SetOrigin(s)
+	return cbc
+}
+
+// SetCollector sets the "collector" field.
+func (cbc *CertifyBadCreate) SetCollector(s string) *CertifyBadCreate {
+	cbc.mutation.SetCollector(s)
+	return cbc
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cbc *CertifyBadCreate) SetPackageVersion(p *PackageVersion) *CertifyBadCreate {
+	return cbc.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cbc *CertifyBadCreate) SetPackageName(p *PackageName) *CertifyBadCreate {
+	return cbc.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cbc *CertifyBadCreate) SetSource(s *SourceName) *CertifyBadCreate {
+	return cbc.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cbc *CertifyBadCreate) SetArtifact(a *Artifact) *CertifyBadCreate {
+	return cbc.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyBadMutation object of the builder.
+func (cbc *CertifyBadCreate) Mutation() *CertifyBadMutation {
+	return cbc.mutation
+}
+
+// Save creates the CertifyBad in the database.
+func (cbc *CertifyBadCreate) Save(ctx context.Context) (*CertifyBad, error) {
+	return withHooks[*CertifyBad, CertifyBadMutation](ctx, cbc.sqlSave, cbc.mutation, cbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cbc *CertifyBadCreate) SaveX(ctx context.Context) *CertifyBad {
+	v, err := cbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cbc *CertifyBadCreate) Exec(ctx context.Context) error {
+	_, err := cbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbc *CertifyBadCreate) ExecX(ctx context.Context) {
+	if err := cbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cbc *CertifyBadCreate) check() error {
+	if _, ok := cbc.mutation.Justification(); !ok {
+		return &ValidationError{Name: "justification", err: errors.New(`db: missing required field "CertifyBad.justification"`)}
+	}
+	if _, ok := cbc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`db: missing required field "CertifyBad.origin"`)}
+	}
+	if _, ok := cbc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`db: missing required field "CertifyBad.collector"`)}
+	}
+	return nil
+}
+
+func (cbc *CertifyBadCreate) sqlSave(ctx context.Context) (*CertifyBad, error) {
+	if err := cbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cbc.mutation.id = &_node.ID
+	cbc.mutation.done = true
+	return _node, nil
+}
+
+func (cbc *CertifyBadCreate) createSpec() (*CertifyBad, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CertifyBad{config: cbc.config}
+		_spec = sqlgraph.NewCreateSpec(certifybad.Table, sqlgraph.NewFieldSpec(certifybad.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cbc.conflict
+	if value, ok := cbc.mutation.Justification(); ok {
+		_spec.SetField(certifybad.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := cbc.mutation.Origin(); ok {
+		_spec.SetField(certifybad.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := cbc.mutation.Collector(); ok {
+		_spec.SetField(certifybad.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if nodes := cbc.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageVersionTable,
+			Columns: []string{certifybad.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageVersionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cbc.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageNameTable,
+			Columns: []string{certifybad.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageNameID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cbc.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.SourceTable,
+			Columns: []string{certifybad.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SourceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cbc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.ArtifactTable,
+			Columns: []string{certifybad.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyBad.Create().
+//		SetPackageVersionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyBadUpsert) {
+//			SetPackageVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (cbc *CertifyBadCreate) OnConflict(opts ...sql.ConflictOption) *CertifyBadUpsertOne {
+	cbc.conflict = opts
+	return &CertifyBadUpsertOne{
+		create: cbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cbc *CertifyBadCreate) OnConflictColumns(columns ...string) *CertifyBadUpsertOne {
+	cbc.conflict = append(cbc.conflict, sql.ConflictColumns(columns...))
+	return &CertifyBadUpsertOne{
+		create: cbc,
+	}
+}
+
+type (
+	// CertifyBadUpsertOne is the builder for "upsert"-ing
+	//  one CertifyBad node.
+	CertifyBadUpsertOne struct {
+		create *CertifyBadCreate
+	}
+
+	// CertifyBadUpsert is the "OnConflict" setter.
+	CertifyBadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyBadUpsert) SetPackageVersionID(v int) *CertifyBadUpsert {
+	u.Set(certifybad.FieldPackageVersionID, v)
+	return u
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdatePackageVersionID() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldPackageVersionID)
+	return u
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyBadUpsert) ClearPackageVersionID() *CertifyBadUpsert {
+	u.SetNull(certifybad.FieldPackageVersionID)
+	return u
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyBadUpsert) SetPackageNameID(v int) *CertifyBadUpsert {
+	u.Set(certifybad.FieldPackageNameID, v)
+	return u
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdatePackageNameID() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldPackageNameID)
+	return u
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyBadUpsert) ClearPackageNameID() *CertifyBadUpsert {
+	u.SetNull(certifybad.FieldPackageNameID)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyBadUpsert) SetSourceID(v int) *CertifyBadUpsert {
+	u.Set(certifybad.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdateSourceID() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyBadUpsert) ClearSourceID() *CertifyBadUpsert {
+	u.SetNull(certifybad.FieldSourceID)
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyBadUpsert) SetArtifactID(v int) *CertifyBadUpsert {
+	u.Set(certifybad.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdateArtifactID() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldArtifactID)
+	return u
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyBadUpsert) ClearArtifactID() *CertifyBadUpsert {
+	u.SetNull(certifybad.FieldArtifactID)
+	return u
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyBadUpsert) SetJustification(v string) *CertifyBadUpsert {
+	u.Set(certifybad.FieldJustification, v)
+	return u
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdateJustification() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldJustification)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyBadUpsert) SetOrigin(v string) *CertifyBadUpsert {
+	u.Set(certifybad.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdateOrigin() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyBadUpsert) SetCollector(v string) *CertifyBadUpsert {
+	u.Set(certifybad.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyBadUpsert) UpdateCollector() *CertifyBadUpsert {
+	u.SetExcluded(certifybad.FieldCollector)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyBadUpsertOne) UpdateNewValues() *CertifyBadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CertifyBadUpsertOne) Ignore() *CertifyBadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyBadUpsertOne) DoNothing() *CertifyBadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyBadCreate.OnConflict
+// documentation for more info.
+func (u *CertifyBadUpsertOne) Update(set func(*CertifyBadUpsert)) *CertifyBadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyBadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyBadUpsertOne) SetPackageVersionID(v int) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetPackageVersionID(v)
+	})
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdatePackageVersionID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdatePackageVersionID()
+	})
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyBadUpsertOne) ClearPackageVersionID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearPackageVersionID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyBadUpsertOne) SetPackageNameID(v int) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdatePackageNameID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyBadUpsertOne) ClearPackageNameID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearPackageNameID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyBadUpsertOne) SetSourceID(v int) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdateSourceID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyBadUpsertOne) ClearSourceID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyBadUpsertOne) SetArtifactID(v int) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdateArtifactID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyBadUpsertOne) ClearArtifactID() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyBadUpsertOne) SetJustification(v string) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetJustification(v)
+	})
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdateJustification() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateJustification()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyBadUpsertOne) SetOrigin(v string) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdateOrigin() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyBadUpsertOne) SetCollector(v string) *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyBadUpsertOne) UpdateCollector() *CertifyBadUpsertOne {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyBadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyBadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyBadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CertifyBadUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CertifyBadUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CertifyBadCreateBulk is the builder for creating many CertifyBad entities in bulk.
+type CertifyBadCreateBulk struct {
+	config
+	builders []*CertifyBadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CertifyBad entities in the database.
+func (cbcb *CertifyBadCreateBulk) Save(ctx context.Context) ([]*CertifyBad, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cbcb.builders))
+	nodes := make([]*CertifyBad, len(cbcb.builders))
+	mutators := make([]Mutator, len(cbcb.builders))
+	for i := range cbcb.builders {
+		func(i int, root context.Context) {
+			builder := cbcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertifyBadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbcb *CertifyBadCreateBulk) SaveX(ctx context.Context) []*CertifyBad {
+	v, err := cbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cbcb *CertifyBadCreateBulk) Exec(ctx context.Context) error {
+	_, err := cbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbcb *CertifyBadCreateBulk) ExecX(ctx context.Context) {
+	if err := cbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyBad.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyBadUpsert) {
+//			SetPackageVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (cbcb *CertifyBadCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyBadUpsertBulk {
+	cbcb.conflict = opts
+	return &CertifyBadUpsertBulk{
+		create: cbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cbcb *CertifyBadCreateBulk) OnConflictColumns(columns ...string) *CertifyBadUpsertBulk {
+	cbcb.conflict = append(cbcb.conflict, sql.ConflictColumns(columns...))
+	return &CertifyBadUpsertBulk{
+		create: cbcb,
+	}
+}
+
+// CertifyBadUpsertBulk is the builder for "upsert"-ing
+// a bulk of CertifyBad nodes.
+type CertifyBadUpsertBulk struct {
+	create *CertifyBadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyBadUpsertBulk) UpdateNewValues() *CertifyBadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyBad.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CertifyBadUpsertBulk) Ignore() *CertifyBadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyBadUpsertBulk) DoNothing() *CertifyBadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyBadCreateBulk.OnConflict
+// documentation for more info.
+func (u *CertifyBadUpsertBulk) Update(set func(*CertifyBadUpsert)) *CertifyBadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyBadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyBadUpsertBulk) SetPackageVersionID(v int) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetPackageVersionID(v)
+	})
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdatePackageVersionID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdatePackageVersionID()
+	})
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyBadUpsertBulk) ClearPackageVersionID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearPackageVersionID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyBadUpsertBulk) SetPackageNameID(v int) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdatePackageNameID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyBadUpsertBulk) ClearPackageNameID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearPackageNameID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyBadUpsertBulk) SetSourceID(v int) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdateSourceID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyBadUpsertBulk) ClearSourceID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyBadUpsertBulk) SetArtifactID(v int) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdateArtifactID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyBadUpsertBulk) ClearArtifactID() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyBadUpsertBulk) SetJustification(v string) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetJustification(v)
+	})
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdateJustification() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateJustification()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyBadUpsertBulk) SetOrigin(v string) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdateOrigin() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyBadUpsertBulk) SetCollector(v string) *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyBadUpsertBulk) UpdateCollector() *CertifyBadUpsertBulk {
+	return u.Update(func(s *CertifyBadUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyBadUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the CertifyBadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyBadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyBadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// CertifyBadDelete is the builder for deleting a CertifyBad entity.
+type CertifyBadDelete struct {
+	config
+	hooks    []Hook
+	mutation *CertifyBadMutation
+}
+
+// Where appends a list predicates to the CertifyBadDelete builder.
+func (cbd *CertifyBadDelete) Where(ps ...predicate.CertifyBad) *CertifyBadDelete {
+	cbd.mutation.Where(ps...)
+	return cbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cbd *CertifyBadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyBadMutation](ctx, cbd.sqlExec, cbd.mutation, cbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbd *CertifyBadDelete) ExecX(ctx context.Context) int {
+	n, err := cbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cbd *CertifyBadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(certifybad.Table, sqlgraph.NewFieldSpec(certifybad.FieldID, field.TypeInt))
+	if ps := cbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cbd.mutation.done = true
+	return affected, err
+}
+
+// CertifyBadDeleteOne is the builder for deleting a single CertifyBad entity.
+type CertifyBadDeleteOne struct {
+	cbd *CertifyBadDelete
+}
+
+// Where appends a list predicates to the CertifyBadDelete builder.
+func (cbdo *CertifyBadDeleteOne) Where(ps ...predicate.CertifyBad) *CertifyBadDeleteOne {
+	cbdo.cbd.mutation.Where(ps...)
+	return cbdo
+}
+
+// Exec executes the deletion query.
+func (cbdo *CertifyBadDeleteOne) Exec(ctx context.Context) error {
+	n, err := cbdo.cbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{certifybad.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbdo *CertifyBadDeleteOne) ExecX(ctx context.Context) {
+	if err := cbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
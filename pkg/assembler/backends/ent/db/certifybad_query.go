@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyBadQuery is the builder for querying CertifyBad entities.
+type CertifyBadQuery struct {
+	config
+	ctx                *QueryContext
+	order              []OrderFunc
+	inters             []Interceptor
+	predicates         []predicate.CertifyBad
+	withPackageVersion *PackageVersionQuery
+	withPackageName    *PackageNameQuery
+	withSource         *SourceNameQuery
+	withArtifact       *ArtifactQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CertifyBadQuery builder.
+func (cbq *CertifyBadQuery) Where(ps ...predicate.CertifyBad) *CertifyBadQuery {
+	cbq.predicates = append(cbq.predicates, ps...)
+	return cbq
+}
+
+// Limit the number of records to be returned by this query.
+func (cbq *CertifyBadQuery) Limit(limit int) *CertifyBadQuery {
+	cbq.ctx.Limit = &limit
+	return cbq
+}
+
+// Offset to start from.
+func (cbq *CertifyBadQuery) Offset(offset int) *CertifyBadQuery {
+	cbq.ctx.Offset = &offset
+	return cbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cbq *CertifyBadQuery) Unique(unique bool) *CertifyBadQuery {
+	cbq.ctx.Unique = &unique
+	return cbq
+}
+
+// Order specifies how the records should be ordered.
+func (cbq *CertifyBadQuery) Order(o ...OrderFunc) *CertifyBadQuery {
+	cbq.order = append(cbq.order, o...)
+	return cbq
+}
+
+// QueryPackageVersion chains the current query on the "package_version" edge.
+func (cbq *CertifyBadQuery) QueryPackageVersion() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: cbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.PackageVersionTable, certifybad.PackageVersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackageName chains the current query on the "package_name" edge.
+func (cbq *CertifyBadQuery) QueryPackageName() *PackageNameQuery {
+	query := (&PackageNameClient{config: cbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, selector),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.PackageNameTable, certifybad.PackageNameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySource chains the current query on the "source" edge.
+func (cbq *CertifyBadQuery) QuerySource() *SourceNameQuery {
+	query := (&SourceNameClient{config: cbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, selector),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.SourceTable, certifybad.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (cbq *CertifyBadQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: cbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.ArtifactTable, certifybad.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CertifyBad entity from the query.
+// Returns a *NotFoundError when no CertifyBad was found.
+func (cbq *CertifyBadQuery) First(ctx context.Context) (*CertifyBad, error) {
+	nodes, err := cbq.Limit(1).All(setContextOp(ctx, cbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{certifybad.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cbq *CertifyBadQuery) FirstX(ctx context.Context) *CertifyBad {
+	node, err := cbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CertifyBad ID from the query.
+// Returns a *NotFoundError when no CertifyBad ID was found.
+func (cbq *CertifyBadQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cbq.Limit(1).IDs(setContextOp(ctx, cbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{certifybad.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cbq *CertifyBadQuery) FirstIDX(ctx context.Context) int {
+	id, err := cbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CertifyBad entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CertifyBad entity is found.
+// Returns a *NotFoundError when no CertifyBad entities are found.
+func (cbq *CertifyBadQuery) Only(ctx context.Context) (*CertifyBad, error) {
+	nodes, err := cbq.Limit(2).All(setContextOp(ctx, cbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{certifybad.Label}
+	default:
+		return nil, &NotSingularError{certifybad.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cbq *CertifyBadQuery) OnlyX(ctx context.Context) *CertifyBad {
+	node, err := cbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CertifyBad ID in the query.
+// Returns a *NotSingularError when more than one CertifyBad ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cbq *CertifyBadQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cbq.Limit(2).IDs(setContextOp(ctx, cbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{certifybad.Label}
+	default:
+		err = &NotSingularError{certifybad.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cbq *CertifyBadQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CertifyBads.
+func (cbq *CertifyBadQuery) All(ctx context.Context) ([]*CertifyBad, error) {
+	ctx = setContextOp(ctx, cbq.ctx, "All")
+	if err := cbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CertifyBad, *CertifyBadQuery]()
+	return withInterceptors[[]*CertifyBad](ctx, cbq, qr, cbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cbq *CertifyBadQuery) AllX(ctx context.Context) []*CertifyBad {
+	nodes, err := cbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CertifyBad IDs.
+func (cbq *CertifyBadQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cbq.ctx.Unique == nil && cbq.path != nil {
+		cbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cbq.ctx, "IDs")
+	if err = cbq.Select(certifybad.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cbq *CertifyBadQuery) IDsX(ctx context.Context) []int {
+	ids, err := cbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cbq *CertifyBadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cbq.ctx, "Count")
+	if err := cbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cbq, querierCount[*CertifyBadQuery](), cbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cbq *CertifyBadQuery) CountX(ctx context.Context) int {
+	count, err := cbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cbq *CertifyBadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cbq.ctx, "Exist")
+	switch _, err := cbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cbq *CertifyBadQuery) ExistX(ctx context.Context) bool {
+	exist, err := cbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CertifyBadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cbq *CertifyBadQuery) Clone() *CertifyBadQuery {
+	if cbq == nil {
+		return nil
+	}
+	return &CertifyBadQuery{
+		config:             cbq.config,
+		ctx:                cbq.ctx.Clone(),
+		order:              append([]OrderFunc{}, cbq.order...),
+		inters:             append([]Interceptor{}, cbq.inters...),
+		predicates:         append([]predicate.CertifyBad{}, cbq.predicates...),
+		withPackageVersion: cbq.withPackageVersion.Clone(),
+		withPackageName:    cbq.withPackageName.Clone(),
+		withSource:         cbq.withSource.Clone(),
+		withArtifact:       cbq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  cbq.sql.Clone(),
+		path: cbq.path,
+	}
+}
+
+// WithPackageVersion tells the query-builder to eager-load the nodes that are connected to
+// the "package_version" edge. The optional arguments are used to configure the query builder of the edge.
+func (cbq *CertifyBadQuery) WithPackageVersion(opts ...func(*PackageVersionQuery)) *CertifyBadQuery {
+	query := (&PackageVersionClient{config: cbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cbq.withPackageVersion = query
+	return cbq
+}
+
+// WithPackageName tells the query-builder to eager-load the nodes that are connected to
+// the "package_name" edge. The optional arguments are used to configure the query builder of the edge.
+func (cbq *CertifyBadQuery) WithPackageName(opts ...func(*PackageNameQuery)) *CertifyBadQuery {
+	query := (&PackageNameClient{config: cbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cbq.withPackageName = query
+	return cbq
+}
+
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (cbq *CertifyBadQuery) WithSource(opts ...func(*SourceNameQuery)) *CertifyBadQuery {
+	query := (&SourceNameClient{config: cbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cbq.withSource = query
+	return cbq
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (cbq *CertifyBadQuery) WithArtifact(opts ...func(*ArtifactQuery)) *CertifyBadQuery {
+	query := (&ArtifactClient{config: cbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cbq.withArtifact = query
+	return cbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CertifyBad.Query().
+//		GroupBy(certifybad.FieldPackageVersionID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cbq *CertifyBadQuery) GroupBy(field string, fields ...string) *CertifyBadGroupBy {
+	cbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CertifyBadGroupBy{build: cbq}
+	grbuild.flds = &cbq.ctx.Fields
+	grbuild.label = certifybad.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//	}
+//
+//	client.CertifyBad.Query().
+//		Select(certifybad.FieldPackageVersionID).
+//		Scan(ctx, &v)
+func (cbq *CertifyBadQuery) Select(fields ...string) *CertifyBadSelect {
+	cbq.ctx.Fields = append(cbq.ctx.Fields, fields...)
+	sbuild := &CertifyBadSelect{CertifyBadQuery: cbq}
+	sbuild.label = certifybad.Label
+	sbuild.flds, sbuild.scan = &cbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CertifyBadSelect configured with the given aggregations.
+func (cbq *CertifyBadQuery) Aggregate(fns ...AggregateFunc) *CertifyBadSelect {
+	return cbq.Select().Aggregate(fns...)
+}
+
+func (cbq *CertifyBadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cbq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cbq.ctx.Fields {
+		if !certifybad.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cbq.path != nil {
+		prev, err := cbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cbq.sql = prev
+	}
+	return nil
+}
+
+func (cbq *CertifyBadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CertifyBad, error) {
+	var (
+		nodes       = []*CertifyBad{}
+		_spec       = cbq.querySpec()
+		loadedTypes = [4]bool{
+			cbq.withPackageVersion != nil,
+			cbq.withPackageName != nil,
+			cbq.withSource != nil,
+			cbq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CertifyBad).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CertifyBad{config: cbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cbq.withPackageVersion; query != nil {
+		if err := cbq.loadPackageVersion(ctx, query, nodes, nil,
+			func(n *CertifyBad, e *PackageVersion) { n.Edges.PackageVersion = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cbq.withPackageName; query != nil {
+		if err := cbq.loadPackageName(ctx, query, nodes, nil,
+			func(n *CertifyBad, e *PackageName) { n.Edges.PackageName = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cbq.withSource; query != nil {
+		if err := cbq.loadSource(ctx, query, nodes, nil,
+			func(n *CertifyBad, e *SourceName) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cbq.withArtifact; query != nil {
+		if err := cbq.loadArtifact(ctx, query, nodes, nil,
+			func(n *CertifyBad, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cbq *CertifyBadQuery) loadPackageVersion(ctx context.Context, query *PackageVersionQuery, nodes []*CertifyBad, init func(*CertifyBad), assign func(*CertifyBad, *PackageVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyBad)
+	for i := range nodes {
+		if nodes[i].PackageVersionID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageVersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cbq *CertifyBadQuery) loadPackageName(ctx context.Context, query *PackageNameQuery, nodes []*CertifyBad, init func(*CertifyBad), assign func(*CertifyBad, *PackageName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyBad)
+	for i := range nodes {
+		if nodes[i].PackageNameID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageNameID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_name_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cbq *CertifyBadQuery) loadSource(ctx context.Context, query *SourceNameQuery, nodes []*CertifyBad, init func(*CertifyBad), assign func(*CertifyBad, *SourceName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyBad)
+	for i := range nodes {
+		if nodes[i].SourceID == nil {
+			continue
+		}
+		fk := *nodes[i].SourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sourcename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cbq *CertifyBadQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*CertifyBad, init func(*CertifyBad), assign func(*CertifyBad, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyBad)
+	for i := range nodes {
+		if nodes[i].ArtifactID == nil {
+			continue
+		}
+		fk := *nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cbq *CertifyBadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cbq.querySpec()
+	_spec.Node.Columns = cbq.ctx.Fields
+	if len(cbq.ctx.Fields) > 0 {
+		_spec.Unique = cbq.ctx.Unique != nil && *cbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cbq.driver, _spec)
+}
+
+func (cbq *CertifyBadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(certifybad.Table, certifybad.Columns, sqlgraph.NewFieldSpec(certifybad.FieldID, field.TypeInt))
+	_spec.From = cbq.sql
+	if unique := cbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifybad.FieldID)
+		for i := range fields {
+			if fields[i] != certifybad.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cbq *CertifyBadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cbq.driver.Dialect())
+	t1 := builder.Table(certifybad.Table)
+	columns := cbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = certifybad.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cbq.sql != nil {
+		selector = cbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cbq.ctx.Unique != nil && *cbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cbq.predicates {
+		p(selector)
+	}
+	for _, p := range cbq.order {
+		p(selector)
+	}
+	if offset := cbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CertifyBadGroupBy is the group-by builder for CertifyBad entities.
+type CertifyBadGroupBy struct {
+	selector
+	build *CertifyBadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cbgb *CertifyBadGroupBy) Aggregate(fns ...AggregateFunc) *CertifyBadGroupBy {
+	cbgb.fns = append(cbgb.fns, fns...)
+	return cbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cbgb *CertifyBadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cbgb.build.ctx, "GroupBy")
+	if err := cbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyBadQuery, *CertifyBadGroupBy](ctx, cbgb.build, cbgb, cbgb.build.inters, v)
+}
+
+func (cbgb *CertifyBadGroupBy) sqlScan(ctx context.Context, root *CertifyBadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cbgb.fns))
+	for _, fn := range cbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cbgb.flds)+len(cbgb.fns))
+		for _, f := range *cbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CertifyBadSelect is the builder for selecting fields of CertifyBad entities.
+type CertifyBadSelect struct {
+	*CertifyBadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cbs *CertifyBadSelect) Aggregate(fns ...AggregateFunc) *CertifyBadSelect {
+	cbs.fns = append(cbs.fns, fns...)
+	return cbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cbs *CertifyBadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cbs.ctx, "Select")
+	if err := cbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyBadQuery, *CertifyBadSelect](ctx, cbs.CertifyBadQuery, cbs, cbs.inters, v)
+}
+
+func (cbs *CertifyBadSelect) sqlScan(ctx context.Context, root *CertifyBadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cbs.fns))
+	for _, fn := range cbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
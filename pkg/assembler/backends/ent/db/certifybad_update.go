@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyBadUpdate is the builder for updating CertifyBad entities.
+type CertifyBadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertifyBadMutation
+}
+
+// Where appends a list predicates to the CertifyBadUpdate builder.
+func (cbu *CertifyBadUpdate) Where(ps ...predicate.CertifyBad) *CertifyBadUpdate {
+	cbu.mutation.Where(ps...)
+	return cbu
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cbu *CertifyBadUpdate) SetPackageVersionID(i int) *CertifyBadUpdate {
+	cbu.mutation.SetPackageVersionID(i)
+	return cbu
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cbu *CertifyBadUpdate) SetNillablePackageVersionID(i *int) *CertifyBadUpdate {
+	if i != nil {
+		cbu.SetPackageVersionID(*i)
+	}
+	return cbu
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (cbu *CertifyBadUpdate) ClearPackageVersionID() *CertifyBadUpdate {
+	cbu.mutation.ClearPackageVersionID()
+	return cbu
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cbu *CertifyBadUpdate) SetPackageNameID(i int) *CertifyBadUpdate {
+	cbu.mutation.SetPackageNameID(i)
+	return cbu
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cbu *CertifyBadUpdate) SetNillablePackageNameID(i *int) *CertifyBadUpdate {
+	if i != nil {
+		cbu.SetPackageNameID(*i)
+	}
+	return cbu
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (cbu *CertifyBadUpdate) ClearPackageNameID() *CertifyBadUpdate {
+	cbu.mutation.ClearPackageNameID()
+	return cbu
+}
+
+// SetSourceID sets the "source_id" field.
+func (cbu *CertifyBadUpdate) SetSourceID(i int) *CertifyBadUpdate {
+	cbu.mutation.SetSourceID(i)
+	return cbu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cbu *CertifyBadUpdate) SetNillableSourceID(i *int) *CertifyBadUpdate {
+	if i != nil {
+		cbu.SetSourceID(*i)
+	}
+	return cbu
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (cbu *CertifyBadUpdate) ClearSourceID() *CertifyBadUpdate {
+	cbu.mutation.ClearSourceID()
+	return cbu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cbu *CertifyBadUpdate) SetArtifactID(i int) *CertifyBadUpdate {
+	cbu.mutation.SetArtifactID(i)
+	return cbu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cbu *CertifyBadUpdate) SetNillableArtifactID(i *int) *CertifyBadUpdate {
+	if i != nil {
+		cbu.SetArtifactID(*i)
+	}
+	return cbu
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cbu *CertifyBadUpdate) ClearArtifactID() *CertifyBadUpdate {
+	cbu.mutation.ClearArtifactID()
+	return cbu
+}
+
+// SetJustification sets the "justification" field.
+func (cbu *CertifyBadUpdate) SetJustification(s string) *CertifyBadUpdate {
+	cbu.mutation.SetJustification(s)
+	return cbu
+}
+
+// SetOrigin sets the "origin" field.
+func (cbu *CertifyBadUpdate) SetOrigin(s string) *CertifyBadUpdate {
+	cbu.mutation.SetOrigin(s)
+	return cbu
+}
+
+// SetCollector sets the "collector" field.
+func (cbu *CertifyBadUpdate) SetCollector(s string) *CertifyBadUpdate {
+	cbu.mutation.SetCollector(s)
+	return cbu
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cbu *CertifyBadUpdate) SetPackageVersion(p *PackageVersion) *CertifyBadUpdate {
+	return cbu.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cbu *CertifyBadUpdate) SetPackageName(p *PackageName) *CertifyBadUpdate {
+	return cbu.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cbu *CertifyBadUpdate) SetSource(s *SourceName) *CertifyBadUpdate {
+	return cbu.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cbu *CertifyBadUpdate) SetArtifact(a *Artifact) *CertifyBadUpdate {
+	return cbu.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyBadMutation object of the builder.
+func (cbu *CertifyBadUpdate) Mutation() *CertifyBadMutation {
+	return cbu.mutation
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (cbu *CertifyBadUpdate) ClearPackageVersion() *CertifyBadUpdate {
+	cbu.mutation.ClearPackageVersion()
+	return cbu
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (cbu *CertifyBadUpdate) ClearPackageName() *CertifyBadUpdate {
+	cbu.mutation.ClearPackageName()
+	return cbu
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (cbu *CertifyBadUpdate) ClearSource() *CertifyBadUpdate {
+	cbu.mutation.ClearSource()
+	return cbu
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cbu *CertifyBadUpdate) ClearArtifact() *CertifyBadUpdate {
+	cbu.mutation.ClearArtifact()
+	return cbu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cbu *CertifyBadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyBadMutation](ctx, cbu.sqlSave, cbu.mutation, cbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbu *CertifyBadUpdate) SaveX(ctx context.Context) int {
+	affected, err := cbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cbu *CertifyBadUpdate) Exec(ctx context.Context) error {
+	_, err := cbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbu *CertifyBadUpdate) ExecX(ctx context.Context) {
+	if err := cbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cbu *CertifyBadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certifybad.Table, certifybad.Columns, sqlgraph.NewFieldSpec(certifybad.FieldID, field.TypeInt))
+	if ps := cbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cbu.mutation.Justification(); ok {
+		_spec.SetField(certifybad.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := cbu.mutation.Origin(); ok {
+		_spec.SetField(certifybad.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cbu.mutation.Collector(); ok {
+		_spec.SetField(certifybad.FieldCollector, field.TypeString, value)
+	}
+	if cbu.mutation.PackageVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageVersionTable,
+			Columns: []string{certifybad.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbu.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageVersionTable,
+			Columns: []string{certifybad.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbu.mutation.PackageNameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageNameTable,
+			Columns: []string{certifybad.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbu.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageNameTable,
+			Columns: []string{certifybad.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbu.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.SourceTable,
+			Columns: []string{certifybad.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbu.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.SourceTable,
+			Columns: []string{certifybad.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.ArtifactTable,
+			Columns: []string{certifybad.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.ArtifactTable,
+			Columns: []string{certifybad.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifybad.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cbu.mutation.done = true
+	return n, nil
+}
+
+// CertifyBadUpdateOne is the builder for updating a single CertifyBad entity.
+type CertifyBadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertifyBadMutation
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cbuo *CertifyBadUpdateOne) SetPackageVersionID(i int) *CertifyBadUpdateOne {
+	cbuo.mutation.SetPackageVersionID(i)
+	return cbuo
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cbuo *CertifyBadUpdateOne) SetNillablePackageVersionID(i *int) *CertifyBadUpdateOne {
+	if i != nil {
+		cbuo.SetPackageVersionID(*i)
+	}
+	return cbuo
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (cbuo *CertifyBadUpdateOne) ClearPackageVersionID() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearPackageVersionID()
+	return cbuo
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cbuo *CertifyBadUpdateOne) SetPackageNameID(i int) *CertifyBadUpdateOne {
+	cbuo.mutation.SetPackageNameID(i)
+	return cbuo
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cbuo *CertifyBadUpdateOne) SetNillablePackageNameID(i *int) *CertifyBadUpdateOne {
+	if i != nil {
+		cbuo.SetPackageNameID(*i)
+	}
+	return cbuo
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (cbuo *CertifyBadUpdateOne) ClearPackageNameID() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearPackageNameID()
+	return cbuo
+}
+
+// SetSourceID sets the "source_id" field.
+func (cbuo *CertifyBadUpdateOne) SetSourceID(i int) *CertifyBadUpdateOne {
+	cbuo.mutation.SetSourceID(i)
+	return cbuo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cbuo *CertifyBadUpdateOne) SetNillableSourceID(i *int) *CertifyBadUpdateOne {
+	if i != nil {
+		cbuo.SetSourceID(*i)
+	}
+	return cbuo
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (cbuo *CertifyBadUpdateOne) ClearSourceID() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearSourceID()
+	return cbuo
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cbuo *CertifyBadUpdateOne) SetArtifactID(i int) *CertifyBadUpdateOne {
+	cbuo.mutation.SetArtifactID(i)
+	return cbuo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cbuo *CertifyBadUpdateOne) SetNillableArtifactID(i *int) *CertifyBadUpdateOne {
+	if i != nil {
+		cbuo.SetArtifactID(*i)
+	}
+	return cbuo
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cbuo *CertifyBadUpdateOne) ClearArtifactID() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearArtifactID()
+	return cbuo
+}
+
+// SetJustification sets the "justification" field.
+func (cbuo *CertifyBadUpdateOne) SetJustification(s string) *CertifyBadUpdateOne {
+	cbuo.mutation.SetJustification(s)
+	return cbuo
+}
+
+// SetOrigin sets the "origin" field.
+func (cbuo *CertifyBadUpdateOne) SetOrigin(s string) *CertifyBadUpdateOne {
+	cbuo.mutation.SetOrigin(s)
+	return cbuo
+}
+
+// SetCollector sets the "collector" field.
+func (cbuo *CertifyBadUpdateOne) SetCollector(s string) *CertifyBadUpdateOne {
+	cbuo.mutation.SetCollector(s)
+	return cbuo
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cbuo *CertifyBadUpdateOne) SetPackageVersion(p *PackageVersion) *CertifyBadUpdateOne {
+	return cbuo.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cbuo *CertifyBadUpdateOne) SetPackageName(p *PackageName) *CertifyBadUpdateOne {
+	return cbuo.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cbuo *CertifyBadUpdateOne) SetSource(s *SourceName) *CertifyBadUpdateOne {
+	return cbuo.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cbuo *CertifyBadUpdateOne) SetArtifact(a *Artifact) *CertifyBadUpdateOne {
+	return cbuo.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyBadMutation object of the builder.
+func (cbuo *CertifyBadUpdateOne) Mutation() *CertifyBadMutation {
+	return cbuo.mutation
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (cbuo *CertifyBadUpdateOne) ClearPackageVersion() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearPackageVersion()
+	return cbuo
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (cbuo *CertifyBadUpdateOne) ClearPackageName() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearPackageName()
+	return cbuo
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (cbuo *CertifyBadUpdateOne) ClearSource() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearSource()
+	return cbuo
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cbuo *CertifyBadUpdateOne) ClearArtifact() *CertifyBadUpdateOne {
+	cbuo.mutation.ClearArtifact()
+	return cbuo
+}
+
+// Where appends a list predicates to the CertifyBadUpdate builder.
+func (cbuo *CertifyBadUpdateOne) Where(ps ...predicate.CertifyBad) *CertifyBadUpdateOne {
+	cbuo.mutation.Where(ps...)
+	return cbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cbuo *CertifyBadUpdateOne) Select(field string, fields ...string) *CertifyBadUpdateOne {
+	cbuo.fields = append([]string{field}, fields...)
+	return cbuo
+}
+
+// Save executes the query and returns the updated CertifyBad entity.
+func (cbuo *CertifyBadUpdateOne) Save(ctx context.Context) (*CertifyBad, error) {
+	return withHooks[*CertifyBad, CertifyBadMutation](ctx, cbuo.sqlSave, cbuo.mutation, cbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cbuo *CertifyBadUpdateOne) SaveX(ctx context.Context) *CertifyBad {
+	node, err := cbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cbuo *CertifyBadUpdateOne) Exec(ctx context.Context) error {
+	_, err := cbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cbuo *CertifyBadUpdateOne) ExecX(ctx context.Context) {
+	if err := cbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cbuo *CertifyBadUpdateOne) sqlSave(ctx context.Context) (_node *CertifyBad, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certifybad.Table, certifybad.Columns, sqlgraph.NewFieldSpec(certifybad.FieldID, field.TypeInt))
+	id, ok := cbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "CertifyBad.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifybad.FieldID)
+		for _, f := range fields {
+			if !certifybad.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != certifybad.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cbuo.mutation.Justification(); ok {
+		_spec.SetField(certifybad.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := cbuo.mutation.Origin(); ok {
+		_spec.SetField(certifybad.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cbuo.mutation.Collector(); ok {
+		_spec.SetField(certifybad.FieldCollector, field.TypeString, value)
+	}
+	if cbuo.mutation.PackageVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageVersionTable,
+			Columns: []string{certifybad.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbuo.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageVersionTable,
+			Columns: []string{certifybad.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbuo.mutation.PackageNameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageNameTable,
+			Columns: []string{certifybad.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbuo.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.PackageNameTable,
+			Columns: []string{certifybad.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbuo.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.SourceTable,
+			Columns: []string{certifybad.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbuo.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.SourceTable,
+			Columns: []string{certifybad.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cbuo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.ArtifactTable,
+			Columns: []string{certifybad.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cbuo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifybad.ArtifactTable,
+			Columns: []string{certifybad.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CertifyBad{config: cbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifybad.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyGood is the model entity for the CertifyGood schema.
+type CertifyGood struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageVersionID holds the value of the "package_version_id" field.
+	PackageVersionID *int `json:"package_version_id,omitempty"`
+	// PackageNameID holds the value of the "package_name_id" field.
+	PackageNameID *int `json:"package_name_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID *int `json:"source_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID *int `json:"artifact_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertifyGoodQuery when eager-loading is set.
+	Edges CertifyGoodEdges `json:"edges"`
+}
+
+// CertifyGoodEdges holds the relations/edges for other nodes in the graph.
+type CertifyGoodEdges struct {
+	// PackageVersion holds the value of the package_version edge.
+	PackageVersion *PackageVersion `json:"package_version,omitempty"`
+	// PackageName holds the value of the package_name edge.
+	PackageName *PackageName `json:"package_name,omitempty"`
+	// Source holds the value of the source edge.
+	Source *SourceName `json:"source,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PackageVersionOrErr returns the PackageVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyGoodEdges) PackageVersionOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.PackageVersion == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.PackageVersion, nil
+	}
+	return nil, &NotLoadedError{edge: "package_version"}
+}
+
+// PackageNameOrErr returns the PackageName value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyGoodEdges) PackageNameOrErr() (*PackageName, error) {
+	if e.loadedTypes[1] {
+		if e.PackageName == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packagename.Label}
+		}
+		return e.PackageName, nil
+	}
+	return nil, &NotLoadedError{edge: "package_name"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyGoodEdges) SourceOrErr() (*SourceName, error) {
+	if e.loadedTypes[2] {
+		if e.Source == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: sourcename.Label}
+		}
+		return e.Source, nil
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyGoodEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[3] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CertifyGood) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certifygood.FieldID, certifygood.FieldPackageVersionID, certifygood.FieldPackageNameID, certifygood.FieldSourceID, certifygood.FieldArtifactID:
+			values[i] = new(sql.NullInt64)
+		case certifygood.FieldJustification, certifygood.FieldOrigin, certifygood.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CertifyGood", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CertifyGood fields.
+func (cg *CertifyGood) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certifygood.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cg.ID = int(value.Int64)
+		case certifygood.FieldPackageVersionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_version_id", values[i])
+			} else if value.Valid {
+				cg.PackageVersionID = new(int)
+				*cg.PackageVersionID = int(value.Int64)
+			}
+		case certifygood.FieldPackageNameID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name_id", values[i])
+			} else if value.Valid {
+				cg.PackageNameID = new(int)
+				*cg.PackageNameID = int(value.Int64)
+			}
+		case certifygood.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				cg.SourceID = new(int)
+				*cg.SourceID = int(value.Int64)
+			}
+		case certifygood.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				cg.ArtifactID = new(int)
+				*cg.ArtifactID = int(value.Int64)
+			}
+		case certifygood.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				cg.Justification = value.String
+			}
+		case certifygood.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				cg.Origin = value.String
+			}
+		case certifygood.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				cg.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackageVersion queries the "package_version" edge of the CertifyGood entity.
+func (cg *CertifyGood) QueryPackageVersion() *PackageVersionQuery {
+	return NewCertifyGoodClient(cg.config).QueryPackageVersion(cg)
+}
+
+// QueryPackageName queries the "package_name" edge of the CertifyGood entity.
+func (cg *CertifyGood) QueryPackageName() *PackageNameQuery {
+	return NewCertifyGoodClient(cg.config).QueryPackageName(cg)
+}
+
+// QuerySource queries the "source" edge of the CertifyGood entity.
+func (cg *CertifyGood) QuerySource() *SourceNameQuery {
+	return NewCertifyGoodClient(cg.config).QuerySource(cg)
+}
+
+// QueryArtifact queries the "artifact" edge of the CertifyGood entity.
+func (cg *CertifyGood) QueryArtifact() *ArtifactQuery {
+	return NewCertifyGoodClient(cg.config).QueryArtifact(cg)
+}
+
+// Update returns a builder for updating this CertifyGood.
+// Note that you need to call CertifyGood.Unwrap() before calling this method if this CertifyGood
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cg *CertifyGood) Update() *CertifyGoodUpdateOne {
+	return NewCertifyGoodClient(cg.config).UpdateOne(cg)
+}
+
+// Unwrap unwraps the CertifyGood entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cg *CertifyGood) Unwrap() *CertifyGood {
+	_tx, ok := cg.config.driver.(*txDriver)
+	if !ok {
+		panic("db: CertifyGood is not a transactional entity")
+	}
+	cg.config.driver = _tx.drv
+	return cg
+}
+
+// String implements the fmt.Stringer.
+func (cg *CertifyGood) String() string {
+	var builder strings.Builder
+	builder.WriteString("CertifyGood(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cg.ID))
+	if v := cg.PackageVersionID; v != nil {
+		builder.WriteString("package_version_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cg.PackageNameID; v != nil {
+		builder.WriteString("package_name_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cg.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cg.ArtifactID; v != nil {
+		builder.WriteString("artifact_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(cg.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(cg.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(cg.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CertifyGoods is a parsable slice of CertifyGood.
+type CertifyGoods []*CertifyGood
@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyGoodCreate is the builder for creating a CertifyGood entity.
+type CertifyGoodCreate struct {
+	config
+	mutation *CertifyGoodMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cgc *CertifyGoodCreate) SetPackageVersionID(i int) *CertifyGoodCreate {
+	cgc.mutation.SetPackageVersionID(i)
+	return cgc
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cgc *CertifyGoodCreate) SetNillablePackageVersionID(i *int) *CertifyGoodCreate {
+	if i != nil {
+		cgc.SetPackageVersionID(*i)
+	}
+	return cgc
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cgc *CertifyGoodCreate) SetPackageNameID(i int) *CertifyGoodCreate {
+	cgc.mutation.SetPackageNameID(i)
+	return cgc
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cgc *CertifyGoodCreate) SetNillablePackageNameID(i *int) *CertifyGoodCreate {
+	if i != nil {
+		cgc.SetPackageNameID(*i)
+	}
+	return cgc
+}
+
+// SetSourceID sets the "source_id" field.
+func (cgc *CertifyGoodCreate) SetSourceID(i int) *CertifyGoodCreate {
+	cgc.mutation.SetSourceID(i)
+	return cgc
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cgc *CertifyGoodCreate) SetNillableSourceID(i *int) *CertifyGoodCreate {
+	if i != nil {
+		cgc.SetSourceID(*i)
+	}
+	return cgc
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cgc *CertifyGoodCreate) SetArtifactID(i int) *CertifyGoodCreate {
+	cgc.mutation.SetArtifactID(i)
+	return cgc
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cgc *CertifyGoodCreate) SetNillableArtifactID(i *int) *CertifyGoodCreate {
+	if i != nil {
+		cgc.SetArtifactID(*i)
+	}
+	return cgc
+}
+
+// SetJustification sets the "justification" field.
+func (cgc *CertifyGoodCreate) SetJustification(s string) *CertifyGoodCreate {
+	cgc.mutation.SetJustification(s)
+	return cgc
+}
+
+// SetOrigin sets the "origin" field.
+func (cgc *CertifyGoodCreate) SetOrigin(s string) *CertifyGoodCreate {
+	cgc.mutation.SetOrigin(s)
+	return cgc
+}
+
+// SetCollector sets the "collector" field.
+func (cgc *CertifyGoodCreate) SetCollector(s string) *CertifyGoodCreate {
+	cgc.mutation.SetCollector(s)
+	return cgc
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cgc *CertifyGoodCreate) SetPackageVersion(p *PackageVersion) *CertifyGoodCreate {
+	return cgc.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cgc *CertifyGoodCreate) SetPackageName(p *PackageName) *CertifyGoodCreate {
+	return cgc.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cgc *CertifyGoodCreate) SetSource(s *SourceName) *CertifyGoodCreate {
+	return cgc.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cgc *CertifyGoodCreate) SetArtifact(a *Artifact) *CertifyGoodCreate {
+	return cgc.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyGoodMutation object of the builder.
+func (cgc *CertifyGoodCreate) Mutation() *CertifyGoodMutation {
+	return cgc.mutation
+}
+
+// Save creates the CertifyGood in the database.
+func (cgc *CertifyGoodCreate) Save(ctx context.Context) (*CertifyGood, error) {
+	return withHooks[*CertifyGood, CertifyGoodMutation](ctx, cgc.sqlSave, cgc.mutation, cgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cgc *CertifyGoodCreate) SaveX(ctx context.Context) *CertifyGood {
+	v, err := cgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cgc *CertifyGoodCreate) Exec(ctx context.Context) error {
+	_, err := cgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cgc *CertifyGoodCreate) ExecX(ctx context.Context) {
+	if err := cgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cgc *CertifyGoodCreate) check() error {
+	if _, ok := cgc.mutation.Justification(); !ok {
+		return &ValidationError{Name: "justification", err: errors.New(`db: missing required field "CertifyGood.justification"`)}
+	}
+	if _, ok := cgc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`db: missing required field "CertifyGood.origin"`)}
+	}
+	if _, ok := cgc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`db: missing required field "CertifyGood.collector"`)}
+	}
+	return nil
+}
+
+func (cgc *CertifyGoodCreate) sqlSave(ctx context.Context) (*CertifyGood, error) {
+	if err := cgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cgc.mutation.id = &_node.ID
+	cgc.mutation.done = true
+	return _node, nil
+}
+
+func (cgc *CertifyGoodCreate) createSpec() (*CertifyGood, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CertifyGood{config: cgc.config}
+		_spec = sqlgraph.NewCreateSpec(certifygood.Table, sqlgraph.NewFieldSpec(certifygood.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cgc.conflict
+	if value, ok := cgc.mutation.Justification(); ok {
+		_spec.SetField(certifygood.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := cgc.mutation.Origin(); ok {
+		_spec.SetField(certifygood.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := cgc.mutation.Collector(); ok {
+		_spec.SetField(certifygood.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if nodes := cgc.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageVersionTable,
+			Columns: []string{certifygood.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageVersionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cgc.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageNameTable,
+			Columns: []string{certifygood.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageNameID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cgc.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.SourceTable,
+			Columns: []string{certifygood.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SourceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cgc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.ArtifactTable,
+			Columns: []string{certifygood.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyGood.Create().
+//		SetPackageVersionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyGoodUpsert) {
+//			SetPackageVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (cgc *CertifyGoodCreate) OnConflict(opts ...sql.ConflictOption) *CertifyGoodUpsertOne {
+	cgc.conflict = opts
+	return &CertifyGoodUpsertOne{
+		create: cgc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cgc *CertifyGoodCreate) OnConflictColumns(columns ...string) *CertifyGoodUpsertOne {
+	cgc.conflict = append(cgc.conflict, sql.ConflictColumns(columns...))
+	return &CertifyGoodUpsertOne{
+		create: cgc,
+	}
+}
+
+type (
+	// CertifyGoodUpsertOne is the builder for "upsert"-ing
+	//  one CertifyGood node.
+	CertifyGoodUpsertOne struct {
+		create *CertifyGoodCreate
+	}
+
+	// CertifyGoodUpsert is the "OnConflict" setter.
+	CertifyGoodUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyGoodUpsert) SetPackageVersionID(v int) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldPackageVersionID, v)
+	return u
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdatePackageVersionID() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldPackageVersionID)
+	return u
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyGoodUpsert) ClearPackageVersionID() *CertifyGoodUpsert {
+	u.SetNull(certifygood.FieldPackageVersionID)
+	return u
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyGoodUpsert) SetPackageNameID(v int) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldPackageNameID, v)
+	return u
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdatePackageNameID() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldPackageNameID)
+	return u
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyGoodUpsert) ClearPackageNameID() *CertifyGoodUpsert {
+	u.SetNull(certifygood.FieldPackageNameID)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyGoodUpsert) SetSourceID(v int) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdateSourceID() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyGoodUpsert) ClearSourceID() *CertifyGoodUpsert {
+	u.SetNull(certifygood.FieldSourceID)
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyGoodUpsert) SetArtifactID(v int) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdateArtifactID() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldArtifactID)
+	return u
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyGoodUpsert) ClearArtifactID() *CertifyGoodUpsert {
+	u.SetNull(certifygood.FieldArtifactID)
+	return u
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyGoodUpsert) SetJustification(v string) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldJustification, v)
+	return u
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdateJustification() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldJustification)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyGoodUpsert) SetOrigin(v string) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdateOrigin() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyGoodUpsert) SetCollector(v string) *CertifyGoodUpsert {
+	u.Set(certifygood.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyGoodUpsert) UpdateCollector() *CertifyGoodUpsert {
+	u.SetExcluded(certifygood.FieldCollector)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyGoodUpsertOne) UpdateNewValues() *CertifyGoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CertifyGoodUpsertOne) Ignore() *CertifyGoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyGoodUpsertOne) DoNothing() *CertifyGoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyGoodCreate.OnConflict
+// documentation for more info.
+func (u *CertifyGoodUpsertOne) Update(set func(*CertifyGoodUpsert)) *CertifyGoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyGoodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyGoodUpsertOne) SetPackageVersionID(v int) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetPackageVersionID(v)
+	})
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdatePackageVersionID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdatePackageVersionID()
+	})
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyGoodUpsertOne) ClearPackageVersionID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearPackageVersionID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyGoodUpsertOne) SetPackageNameID(v int) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdatePackageNameID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyGoodUpsertOne) ClearPackageNameID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearPackageNameID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyGoodUpsertOne) SetSourceID(v int) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdateSourceID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyGoodUpsertOne) ClearSourceID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyGoodUpsertOne) SetArtifactID(v int) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdateArtifactID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyGoodUpsertOne) ClearArtifactID() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyGoodUpsertOne) SetJustification(v string) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetJustification(v)
+	})
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdateJustification() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateJustification()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyGoodUpsertOne) SetOrigin(v string) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdateOrigin() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyGoodUpsertOne) SetCollector(v string) *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyGoodUpsertOne) UpdateCollector() *CertifyGoodUpsertOne {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyGoodUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyGoodCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyGoodUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CertifyGoodUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CertifyGoodUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CertifyGoodCreateBulk is the builder for creating many CertifyGood entities in bulk.
+type CertifyGoodCreateBulk struct {
+	config
+	builders []*CertifyGoodCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CertifyGood entities in the database.
+func (cgcb *CertifyGoodCreateBulk) Save(ctx context.Context) ([]*CertifyGood, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cgcb.builders))
+	nodes := make([]*CertifyGood, len(cgcb.builders))
+	mutators := make([]Mutator, len(cgcb.builders))
+	for i := range cgcb.builders {
+		func(i int, root context.Context) {
+			builder := cgcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertifyGoodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cgcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cgcb *CertifyGoodCreateBulk) SaveX(ctx context.Context) []*CertifyGood {
+	v, err := cgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cgcb *CertifyGoodCreateBulk) Exec(ctx context.Context) error {
+	_, err := cgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cgcb *CertifyGoodCreateBulk) ExecX(ctx context.Context) {
+	if err := cgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyGood.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyGoodUpsert) {
+//			SetPackageVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (cgcb *CertifyGoodCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyGoodUpsertBulk {
+	cgcb.conflict = opts
+	return &CertifyGoodUpsertBulk{
+		create: cgcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cgcb *CertifyGoodCreateBulk) OnConflictColumns(columns ...string) *CertifyGoodUpsertBulk {
+	cgcb.conflict = append(cgcb.conflict, sql.ConflictColumns(columns...))
+	return &CertifyGoodUpsertBulk{
+		create: cgcb,
+	}
+}
+
+// CertifyGoodUpsertBulk is the builder for "upsert"-ing
+// a bulk of CertifyGood nodes.
+type CertifyGoodUpsertBulk struct {
+	create *CertifyGoodCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyGoodUpsertBulk) UpdateNewValues() *CertifyGoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyGood.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CertifyGoodUpsertBulk) Ignore() *CertifyGoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyGoodUpsertBulk) DoNothing() *CertifyGoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyGoodCreateBulk.OnConflict
+// documentation for more info.
+func (u *CertifyGoodUpsertBulk) Update(set func(*CertifyGoodUpsert)) *CertifyGoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyGoodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (u *CertifyGoodUpsertBulk) SetPackageVersionID(v int) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetPackageVersionID(v)
+	})
+}
+
+// UpdatePackageVersionID sets the "package_version_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdatePackageVersionID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdatePackageVersionID()
+	})
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (u *CertifyGoodUpsertBulk) ClearPackageVersionID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearPackageVersionID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *CertifyGoodUpsertBulk) SetPackageNameID(v int) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdatePackageNameID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (u *CertifyGoodUpsertBulk) ClearPackageNameID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearPackageNameID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *CertifyGoodUpsertBulk) SetSourceID(v int) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdateSourceID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *CertifyGoodUpsertBulk) ClearSourceID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyGoodUpsertBulk) SetArtifactID(v int) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdateArtifactID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyGoodUpsertBulk) ClearArtifactID() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetJustification sets the "justification" field.
+func (u *CertifyGoodUpsertBulk) SetJustification(v string) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetJustification(v)
+	})
+}
+
+// UpdateJustification sets the "justification" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdateJustification() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateJustification()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyGoodUpsertBulk) SetOrigin(v string) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdateOrigin() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyGoodUpsertBulk) SetCollector(v string) *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyGoodUpsertBulk) UpdateCollector() *CertifyGoodUpsertBulk {
+	return u.Update(func(s *CertifyGoodUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyGoodUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the CertifyGoodCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyGoodCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyGoodUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// CertifyGoodDelete is the builder for deleting a CertifyGood entity.
+type CertifyGoodDelete struct {
+	config
+	hooks    []Hook
+	mutation *CertifyGoodMutation
+}
+
+// Where appends a list predicates to the CertifyGoodDelete builder.
+func (cgd *CertifyGoodDelete) Where(ps ...predicate.CertifyGood) *CertifyGoodDelete {
+	cgd.mutation.Where(ps...)
+	return cgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cgd *CertifyGoodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyGoodMutation](ctx, cgd.sqlExec, cgd.mutation, cgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cgd *CertifyGoodDelete) ExecX(ctx context.Context) int {
+	n, err := cgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cgd *CertifyGoodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(certifygood.Table, sqlgraph.NewFieldSpec(certifygood.FieldID, field.TypeInt))
+	if ps := cgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cgd.mutation.done = true
+	return affected, err
+}
+
+// CertifyGoodDeleteOne is the builder for deleting a single CertifyGood entity.
+type CertifyGoodDeleteOne struct {
+	cgd *CertifyGoodDelete
+}
+
+// Where appends a list predicates to the CertifyGoodDelete builder.
+func (cgdo *CertifyGoodDeleteOne) Where(ps ...predicate.CertifyGood) *CertifyGoodDeleteOne {
+	cgdo.cgd.mutation.Where(ps...)
+	return cgdo
+}
+
+// Exec executes the deletion query.
+func (cgdo *CertifyGoodDeleteOne) Exec(ctx context.Context) error {
+	n, err := cgdo.cgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{certifygood.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cgdo *CertifyGoodDeleteOne) ExecX(ctx context.Context) {
+	if err := cgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
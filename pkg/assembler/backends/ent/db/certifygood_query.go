@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyGoodQuery is the builder for querying CertifyGood entities.
+type CertifyGoodQuery struct {
+	config
+	ctx                *QueryContext
+	order              []OrderFunc
+	inters             []Interceptor
+	predicates         []predicate.CertifyGood
+	withPackageVersion *PackageVersionQuery
+	withPackageName    *PackageNameQuery
+	withSource         *SourceNameQuery
+	withArtifact       *ArtifactQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CertifyGoodQuery builder.
+func (cgq *CertifyGoodQuery) Where(ps ...predicate.CertifyGood) *CertifyGoodQuery {
+	cgq.predicates = append(cgq.predicates, ps...)
+	return cgq
+}
+
+// Limit the number of records to be returned by this query.
+func (cgq *CertifyGoodQuery) Limit(limit int) *CertifyGoodQuery {
+	cgq.ctx.Limit = &limit
+	return cgq
+}
+
+// Offset to start from.
+func (cgq *CertifyGoodQuery) Offset(offset int) *CertifyGoodQuery {
+	cgq.ctx.Offset = &offset
+	return cgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cgq *CertifyGoodQuery) Unique(unique bool) *CertifyGoodQuery {
+	cgq.ctx.Unique = &unique
+	return cgq
+}
+
+// Order specifies how the records should be ordered.
+func (cgq *CertifyGoodQuery) Order(o ...OrderFunc) *CertifyGoodQuery {
+	cgq.order = append(cgq.order, o...)
+	return cgq
+}
+
+// QueryPackageVersion chains the current query on the "package_version" edge.
+func (cgq *CertifyGoodQuery) QueryPackageVersion() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: cgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.PackageVersionTable, certifygood.PackageVersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackageName chains the current query on the "package_name" edge.
+func (cgq *CertifyGoodQuery) QueryPackageName() *PackageNameQuery {
+	query := (&PackageNameClient{config: cgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, selector),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.PackageNameTable, certifygood.PackageNameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySource chains the current query on the "source" edge.
+func (cgq *CertifyGoodQuery) QuerySource() *SourceNameQuery {
+	query := (&SourceNameClient{config: cgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, selector),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.SourceTable, certifygood.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (cgq *CertifyGoodQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: cgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.ArtifactTable, certifygood.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CertifyGood entity from the query.
+// Returns a *NotFoundError when no CertifyGood was found.
+func (cgq *CertifyGoodQuery) First(ctx context.Context) (*CertifyGood, error) {
+	nodes, err := cgq.Limit(1).All(setContextOp(ctx, cgq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{certifygood.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) FirstX(ctx context.Context) *CertifyGood {
+	node, err := cgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CertifyGood ID from the query.
+// Returns a *NotFoundError when no CertifyGood ID was found.
+func (cgq *CertifyGoodQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cgq.Limit(1).IDs(setContextOp(ctx, cgq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{certifygood.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) FirstIDX(ctx context.Context) int {
+	id, err := cgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CertifyGood entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CertifyGood entity is found.
+// Returns a *NotFoundError when no CertifyGood entities are found.
+func (cgq *CertifyGoodQuery) Only(ctx context.Context) (*CertifyGood, error) {
+	nodes, err := cgq.Limit(2).All(setContextOp(ctx, cgq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{certifygood.Label}
+	default:
+		return nil, &NotSingularError{certifygood.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) OnlyX(ctx context.Context) *CertifyGood {
+	node, err := cgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CertifyGood ID in the query.
+// Returns a *NotSingularError when more than one CertifyGood ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cgq *CertifyGoodQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cgq.Limit(2).IDs(setContextOp(ctx, cgq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{certifygood.Label}
+	default:
+		err = &NotSingularError{certifygood.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CertifyGoods.
+func (cgq *CertifyGoodQuery) All(ctx context.Context) ([]*CertifyGood, error) {
+	ctx = setContextOp(ctx, cgq.ctx, "All")
+	if err := cgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CertifyGood, *CertifyGoodQuery]()
+	return withInterceptors[[]*CertifyGood](ctx, cgq, qr, cgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) AllX(ctx context.Context) []*CertifyGood {
+	nodes, err := cgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CertifyGood IDs.
+func (cgq *CertifyGoodQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cgq.ctx.Unique == nil && cgq.path != nil {
+		cgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cgq.ctx, "IDs")
+	if err = cgq.Select(certifygood.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) IDsX(ctx context.Context) []int {
+	ids, err := cgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cgq *CertifyGoodQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cgq.ctx, "Count")
+	if err := cgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cgq, querierCount[*CertifyGoodQuery](), cgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) CountX(ctx context.Context) int {
+	count, err := cgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cgq *CertifyGoodQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cgq.ctx, "Exist")
+	switch _, err := cgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cgq *CertifyGoodQuery) ExistX(ctx context.Context) bool {
+	exist, err := cgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CertifyGoodQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cgq *CertifyGoodQuery) Clone() *CertifyGoodQuery {
+	if cgq == nil {
+		return nil
+	}
+	return &CertifyGoodQuery{
+		config:             cgq.config,
+		ctx:                cgq.ctx.Clone(),
+		order:              append([]OrderFunc{}, cgq.order...),
+		inters:             append([]Interceptor{}, cgq.inters...),
+		predicates:         append([]predicate.CertifyGood{}, cgq.predicates...),
+		withPackageVersion: cgq.withPackageVersion.Clone(),
+		withPackageName:    cgq.withPackageName.Clone(),
+		withSource:         cgq.withSource.Clone(),
+		withArtifact:       cgq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  cgq.sql.Clone(),
+		path: cgq.path,
+	}
+}
+
+// WithPackageVersion tells the query-builder to eager-load the nodes that are connected to
+// the "package_version" edge. The optional arguments are used to configure the query builder of the edge.
+func (cgq *CertifyGoodQuery) WithPackageVersion(opts ...func(*PackageVersionQuery)) *CertifyGoodQuery {
+	query := (&PackageVersionClient{config: cgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cgq.withPackageVersion = query
+	return cgq
+}
+
+// WithPackageName tells the query-builder to eager-load the nodes that are connected to
+// the "package_name" edge. The optional arguments are used to configure the query builder of the edge.
+func (cgq *CertifyGoodQuery) WithPackageName(opts ...func(*PackageNameQuery)) *CertifyGoodQuery {
+	query := (&PackageNameClient{config: cgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cgq.withPackageName = query
+	return cgq
+}
+
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (cgq *CertifyGoodQuery) WithSource(opts ...func(*SourceNameQuery)) *CertifyGoodQuery {
+	query := (&SourceNameClient{config: cgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cgq.withSource = query
+	return cgq
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (cgq *CertifyGoodQuery) WithArtifact(opts ...func(*ArtifactQuery)) *CertifyGoodQuery {
+	query := (&ArtifactClient{config: cgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cgq.withArtifact = query
+	return cgq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CertifyGood.Query().
+//		GroupBy(certifygood.FieldPackageVersionID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cgq *CertifyGoodQuery) GroupBy(field string, fields ...string) *CertifyGoodGroupBy {
+	cgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CertifyGoodGroupBy{build: cgq}
+	grbuild.flds = &cgq.ctx.Fields
+	grbuild.label = certifygood.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//	}
+//
+//	client.CertifyGood.Query().
+//		Select(certifygood.FieldPackageVersionID).
+//		Scan(ctx, &v)
+func (cgq *CertifyGoodQuery) Select(fields ...string) *CertifyGoodSelect {
+	cgq.ctx.Fields = append(cgq.ctx.Fields, fields...)
+	sbuild := &CertifyGoodSelect{CertifyGoodQuery: cgq}
+	sbuild.label = certifygood.Label
+	sbuild.flds, sbuild.scan = &cgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CertifyGoodSelect configured with the given aggregations.
+func (cgq *CertifyGoodQuery) Aggregate(fns ...AggregateFunc) *CertifyGoodSelect {
+	return cgq.Select().Aggregate(fns...)
+}
+
+func (cgq *CertifyGoodQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cgq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cgq.ctx.Fields {
+		if !certifygood.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cgq.path != nil {
+		prev, err := cgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cgq.sql = prev
+	}
+	return nil
+}
+
+func (cgq *CertifyGoodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CertifyGood, error) {
+	var (
+		nodes       = []*CertifyGood{}
+		_spec       = cgq.querySpec()
+		loadedTypes = [4]bool{
+			cgq.withPackageVersion != nil,
+			cgq.withPackageName != nil,
+			cgq.withSource != nil,
+			cgq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CertifyGood).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CertifyGood{config: cgq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cgq.withPackageVersion; query != nil {
+		if err := cgq.loadPackageVersion(ctx, query, nodes, nil,
+			func(n *CertifyGood, e *PackageVersion) { n.Edges.PackageVersion = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cgq.withPackageName; query != nil {
+		if err := cgq.loadPackageName(ctx, query, nodes, nil,
+			func(n *CertifyGood, e *PackageName) { n.Edges.PackageName = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cgq.withSource; query != nil {
+		if err := cgq.loadSource(ctx, query, nodes, nil,
+			func(n *CertifyGood, e *SourceName) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cgq.withArtifact; query != nil {
+		if err := cgq.loadArtifact(ctx, query, nodes, nil,
+			func(n *CertifyGood, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cgq *CertifyGoodQuery) loadPackageVersion(ctx context.Context, query *PackageVersionQuery, nodes []*CertifyGood, init func(*CertifyGood), assign func(*CertifyGood, *PackageVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyGood)
+	for i := range nodes {
+		if nodes[i].PackageVersionID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageVersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cgq *CertifyGoodQuery) loadPackageName(ctx context.Context, query *PackageNameQuery, nodes []*CertifyGood, init func(*CertifyGood), assign func(*CertifyGood, *PackageName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyGood)
+	for i := range nodes {
+		if nodes[i].PackageNameID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageNameID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_name_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cgq *CertifyGoodQuery) loadSource(ctx context.Context, query *SourceNameQuery, nodes []*CertifyGood, init func(*CertifyGood), assign func(*CertifyGood, *SourceName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyGood)
+	for i := range nodes {
+		if nodes[i].SourceID == nil {
+			continue
+		}
+		fk := *nodes[i].SourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sourcename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cgq *CertifyGoodQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*CertifyGood, init func(*CertifyGood), assign func(*CertifyGood, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyGood)
+	for i := range nodes {
+		if nodes[i].ArtifactID == nil {
+			continue
+		}
+		fk := *nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cgq *CertifyGoodQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cgq.querySpec()
+	_spec.Node.Columns = cgq.ctx.Fields
+	if len(cgq.ctx.Fields) > 0 {
+		_spec.Unique = cgq.ctx.Unique != nil && *cgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cgq.driver, _spec)
+}
+
+func (cgq *CertifyGoodQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(certifygood.Table, certifygood.Columns, sqlgraph.NewFieldSpec(certifygood.FieldID, field.TypeInt))
+	_spec.From = cgq.sql
+	if unique := cgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifygood.FieldID)
+		for i := range fields {
+			if fields[i] != certifygood.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cgq *CertifyGoodQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cgq.driver.Dialect())
+	t1 := builder.Table(certifygood.Table)
+	columns := cgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = certifygood.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cgq.sql != nil {
+		selector = cgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cgq.ctx.Unique != nil && *cgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cgq.predicates {
+		p(selector)
+	}
+	for _, p := range cgq.order {
+		p(selector)
+	}
+	if offset := cgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CertifyGoodGroupBy is the group-by builder for CertifyGood entities.
+type CertifyGoodGroupBy struct {
+	selector
+	build *CertifyGoodQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cggb *CertifyGoodGroupBy) Aggregate(fns ...AggregateFunc) *CertifyGoodGroupBy {
+	cggb.fns = append(cggb.fns, fns...)
+	return cggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cggb *CertifyGoodGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cggb.build.ctx, "GroupBy")
+	if err := cggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyGoodQuery, *CertifyGoodGroupBy](ctx, cggb.build, cggb, cggb.build.inters, v)
+}
+
+func (cggb *CertifyGoodGroupBy) sqlScan(ctx context.Context, root *CertifyGoodQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cggb.fns))
+	for _, fn := range cggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cggb.flds)+len(cggb.fns))
+		for _, f := range *cggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CertifyGoodSelect is the builder for selecting fields of CertifyGood entities.
+type CertifyGoodSelect struct {
+	*CertifyGoodQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cgs *CertifyGoodSelect) Aggregate(fns ...AggregateFunc) *CertifyGoodSelect {
+	cgs.fns = append(cgs.fns, fns...)
+	return cgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cgs *CertifyGoodSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cgs.ctx, "Select")
+	if err := cgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyGoodQuery, *CertifyGoodSelect](ctx, cgs.CertifyGoodQuery, cgs, cgs.inters, v)
+}
+
+func (cgs *CertifyGoodSelect) sqlScan(ctx context.Context, root *CertifyGoodQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cgs.fns))
+	for _, fn := range cgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// CertifyGoodUpdate is the builder for updating CertifyGood entities.
+type CertifyGoodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertifyGoodMutation
+}
+
+// Where appends a list predicates to the CertifyGoodUpdate builder.
+func (cgu *CertifyGoodUpdate) Where(ps ...predicate.CertifyGood) *CertifyGoodUpdate {
+	cgu.mutation.Where(ps...)
+	return cgu
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cgu *CertifyGoodUpdate) SetPackageVersionID(i int) *CertifyGoodUpdate {
+	cgu.mutation.SetPackageVersionID(i)
+	return cgu
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cgu *CertifyGoodUpdate) SetNillablePackageVersionID(i *int) *CertifyGoodUpdate {
+	if i != nil {
+		cgu.SetPackageVersionID(*i)
+	}
+	return cgu
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (cgu *CertifyGoodUpdate) ClearPackageVersionID() *CertifyGoodUpdate {
+	cgu.mutation.ClearPackageVersionID()
+	return cgu
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cgu *CertifyGoodUpdate) SetPackageNameID(i int) *CertifyGoodUpdate {
+	cgu.mutation.SetPackageNameID(i)
+	return cgu
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cgu *CertifyGoodUpdate) SetNillablePackageNameID(i *int) *CertifyGoodUpdate {
+	if i != nil {
+		cgu.SetPackageNameID(*i)
+	}
+	return cgu
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (cgu *CertifyGoodUpdate) ClearPackageNameID() *CertifyGoodUpdate {
+	cgu.mutation.ClearPackageNameID()
+	return cgu
+}
+
+// SetSourceID sets the "source_id" field.
+func (cgu *CertifyGoodUpdate) SetSourceID(i int) *CertifyGoodUpdate {
+	cgu.mutation.SetSourceID(i)
+	return cgu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cgu *CertifyGoodUpdate) SetNillableSourceID(i *int) *CertifyGoodUpdate {
+	if i != nil {
+		cgu.SetSourceID(*i)
+	}
+	return cgu
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (cgu *CertifyGoodUpdate) ClearSourceID() *CertifyGoodUpdate {
+	cgu.mutation.ClearSourceID()
+	return cgu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cgu *CertifyGoodUpdate) SetArtifactID(i int) *CertifyGoodUpdate {
+	cgu.mutation.SetArtifactID(i)
+	return cgu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cgu *CertifyGoodUpdate) SetNillableArtifactID(i *int) *CertifyGoodUpdate {
+	if i != nil {
+		cgu.SetArtifactID(*i)
+	}
+	return cgu
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cgu *CertifyGoodUpdate) ClearArtifactID() *CertifyGoodUpdate {
+	cgu.mutation.ClearArtifactID()
+	return cgu
+}
+
+// SetJustification sets the "justification" field.
+func (cgu *CertifyGoodUpdate) SetJustification(s string) *CertifyGoodUpdate {
+	cgu.mutation.SetJustification(s)
+	return cgu
+}
+
+// SetOrigin sets the "origin" field.
+func (cgu *CertifyGoodUpdate) SetOrigin(s string) *CertifyGoodUpdate {
+	cgu.mutation.SetOrigin(s)
+	return cgu
+}
+
+// SetCollector sets the "collector" field.
+func (cgu *CertifyGoodUpdate) SetCollector(s string) *CertifyGoodUpdate {
+	cgu.mutation.SetCollector(s)
+	return cgu
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cgu *CertifyGoodUpdate) SetPackageVersion(p *PackageVersion) *CertifyGoodUpdate {
+	return cgu.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cgu *CertifyGoodUpdate) SetPackageName(p *PackageName) *CertifyGoodUpdate {
+	return cgu.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cgu *CertifyGoodUpdate) SetSource(s *SourceName) *CertifyGoodUpdate {
+	return cgu.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cgu *CertifyGoodUpdate) SetArtifact(a *Artifact) *CertifyGoodUpdate {
+	return cgu.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyGoodMutation object of the builder.
+func (cgu *CertifyGoodUpdate) Mutation() *CertifyGoodMutation {
+	return cgu.mutation
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (cgu *CertifyGoodUpdate) ClearPackageVersion() *CertifyGoodUpdate {
+	cgu.mutation.ClearPackageVersion()
+	return cgu
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (cgu *CertifyGoodUpdate) ClearPackageName() *CertifyGoodUpdate {
+	cgu.mutation.ClearPackageName()
+	return cgu
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (cgu *CertifyGoodUpdate) ClearSource() *CertifyGoodUpdate {
+	cgu.mutation.ClearSource()
+	return cgu
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cgu *CertifyGoodUpdate) ClearArtifact() *CertifyGoodUpdate {
+	cgu.mutation.ClearArtifact()
+	return cgu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cgu *CertifyGoodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyGoodMutation](ctx, cgu.sqlSave, cgu.mutation, cgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cgu *CertifyGoodUpdate) SaveX(ctx context.Context) int {
+	affected, err := cgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cgu *CertifyGoodUpdate) Exec(ctx context.Context) error {
+	_, err := cgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cgu *CertifyGoodUpdate) ExecX(ctx context.Context) {
+	if err := cgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cgu *CertifyGoodUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certifygood.Table, certifygood.Columns, sqlgraph.NewFieldSpec(certifygood.FieldID, field.TypeInt))
+	if ps := cgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cgu.mutation.Justification(); ok {
+		_spec.SetField(certifygood.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := cgu.mutation.Origin(); ok {
+		_spec.SetField(certifygood.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cgu.mutation.Collector(); ok {
+		_spec.SetField(certifygood.FieldCollector, field.TypeString, value)
+	}
+	if cgu.mutation.PackageVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageVersionTable,
+			Columns: []string{certifygood.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cgu.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageVersionTable,
+			Columns: []string{certifygood.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cgu.mutation.PackageNameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageNameTable,
+			Columns: []string{certifygood.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cgu.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageNameTable,
+			Columns: []string{certifygood.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cgu.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.SourceTable,
+			Columns: []string{certifygood.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cgu.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.SourceTable,
+			Columns: []string{certifygood.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cgu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.ArtifactTable,
+			Columns: []string{certifygood.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cgu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.ArtifactTable,
+			Columns: []string{certifygood.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifygood.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cgu.mutation.done = true
+	return n, nil
+}
+
+// CertifyGoodUpdateOne is the builder for updating a single CertifyGood entity.
+type CertifyGoodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertifyGoodMutation
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (cguo *CertifyGoodUpdateOne) SetPackageVersionID(i int) *CertifyGoodUpdateOne {
+	cguo.mutation.SetPackageVersionID(i)
+	return cguo
+}
+
+// SetNillablePackageVersionID sets the "package_version_id" field if the given value is not nil.
+func (cguo *CertifyGoodUpdateOne) SetNillablePackageVersionID(i *int) *CertifyGoodUpdateOne {
+	if i != nil {
+		cguo.SetPackageVersionID(*i)
+	}
+	return cguo
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (cguo *CertifyGoodUpdateOne) ClearPackageVersionID() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearPackageVersionID()
+	return cguo
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (cguo *CertifyGoodUpdateOne) SetPackageNameID(i int) *CertifyGoodUpdateOne {
+	cguo.mutation.SetPackageNameID(i)
+	return cguo
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (cguo *CertifyGoodUpdateOne) SetNillablePackageNameID(i *int) *CertifyGoodUpdateOne {
+	if i != nil {
+		cguo.SetPackageNameID(*i)
+	}
+	return cguo
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (cguo *CertifyGoodUpdateOne) ClearPackageNameID() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearPackageNameID()
+	return cguo
+}
+
+// SetSourceID sets the "source_id" field.
+func (cguo *CertifyGoodUpdateOne) SetSourceID(i int) *CertifyGoodUpdateOne {
+	cguo.mutation.SetSourceID(i)
+	return cguo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (cguo *CertifyGoodUpdateOne) SetNillableSourceID(i *int) *CertifyGoodUpdateOne {
+	if i != nil {
+		cguo.SetSourceID(*i)
+	}
+	return cguo
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (cguo *CertifyGoodUpdateOne) ClearSourceID() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearSourceID()
+	return cguo
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cguo *CertifyGoodUpdateOne) SetArtifactID(i int) *CertifyGoodUpdateOne {
+	cguo.mutation.SetArtifactID(i)
+	return cguo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cguo *CertifyGoodUpdateOne) SetNillableArtifactID(i *int) *CertifyGoodUpdateOne {
+	if i != nil {
+		cguo.SetArtifactID(*i)
+	}
+	return cguo
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cguo *CertifyGoodUpdateOne) ClearArtifactID() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearArtifactID()
+	return cguo
+}
+
+// SetJustification sets the "justification" field.
+func (cguo *CertifyGoodUpdateOne) SetJustification(s string) *CertifyGoodUpdateOne {
+	cguo.mutation.SetJustification(s)
+	return cguo
+}
+
+// SetOrigin sets the "origin" field.
+func (cguo *CertifyGoodUpdateOne) SetOrigin(s string) *CertifyGoodUpdateOne {
+	cguo.mutation.SetOrigin(s)
+	return cguo
+}
+
+// SetCollector sets the "collector" field.
+func (cguo *CertifyGoodUpdateOne) SetCollector(s string) *CertifyGoodUpdateOne {
+	cguo.mutation.SetCollector(s)
+	return cguo
+}
+
+// SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
+func (cguo *CertifyGoodUpdateOne) SetPackageVersion(p *PackageVersion) *CertifyGoodUpdateOne {
+	return cguo.SetPackageVersionID(p.ID)
+}
+
+// SetPackageName sets the "package_name" edge to the PackageName entity.
+func (cguo *CertifyGoodUpdateOne) SetPackageName(p *PackageName) *CertifyGoodUpdateOne {
+	return cguo.SetPackageNameID(p.ID)
+}
+
+// SetSource sets the "source" edge to the SourceName entity.
+func (cguo *CertifyGoodUpdateOne) SetSource(s *SourceName) *CertifyGoodUpdateOne {
+	return cguo.SetSourceID(s.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cguo *CertifyGoodUpdateOne) SetArtifact(a *Artifact) *CertifyGoodUpdateOne {
+	return cguo.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyGoodMutation object of the builder.
+func (cguo *CertifyGoodUpdateOne) Mutation() *CertifyGoodMutation {
+	return cguo.mutation
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (cguo *CertifyGoodUpdateOne) ClearPackageVersion() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearPackageVersion()
+	return cguo
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (cguo *CertifyGoodUpdateOne) ClearPackageName() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearPackageName()
+	return cguo
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (cguo *CertifyGoodUpdateOne) ClearSource() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearSource()
+	return cguo
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cguo *CertifyGoodUpdateOne) ClearArtifact() *CertifyGoodUpdateOne {
+	cguo.mutation.ClearArtifact()
+	return cguo
+}
+
+// Where appends a list predicates to the CertifyGoodUpdate builder.
+func (cguo *CertifyGoodUpdateOne) Where(ps ...predicate.CertifyGood) *CertifyGoodUpdateOne {
+	cguo.mutation.Where(ps...)
+	return cguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cguo *CertifyGoodUpdateOne) Select(field string, fields ...string) *CertifyGoodUpdateOne {
+	cguo.fields = append([]string{field}, fields...)
+	return cguo
+}
+
+// Save executes the query and returns the updated CertifyGood entity.
+func (cguo *CertifyGoodUpdateOne) Save(ctx context.Context) (*CertifyGood, error) {
+	return withHooks[*CertifyGood, CertifyGoodMutation](ctx, cguo.sqlSave, cguo.mutation, cguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cguo *CertifyGoodUpdateOne) SaveX(ctx context.Context) *CertifyGood {
+	node, err := cguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cguo *CertifyGoodUpdateOne) Exec(ctx context.Context) error {
+	_, err := cguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cguo *CertifyGoodUpdateOne) ExecX(ctx context.Context) {
+	if err := cguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cguo *CertifyGoodUpdateOne) sqlSave(ctx context.Context) (_node *CertifyGood, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certifygood.Table, certifygood.Columns, sqlgraph.NewFieldSpec(certifygood.FieldID, field.TypeInt))
+	id, ok := cguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "CertifyGood.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifygood.FieldID)
+		for _, f := range fields {
+			if !certifygood.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != certifygood.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cguo.mutation.Justification(); ok {
+		_spec.SetField(certifygood.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := cguo.mutation.Origin(); ok {
+		_spec.SetField(certifygood.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cguo.mutation.Collector(); ok {
+		_spec.SetField(certifygood.FieldCollector, field.TypeString, value)
+	}
+	if cguo.mutation.PackageVersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageVersionTable,
+			Columns: []string{certifygood.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cguo.mutation.PackageVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageVersionTable,
+			Columns: []string{certifygood.PackageVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cguo.mutation.PackageNameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageNameTable,
+			Columns: []string{certifygood.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cguo.mutation.PackageNameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.PackageNameTable,
+			Columns: []string{certifygood.PackageNameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cguo.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.SourceTable,
+			Columns: []string{certifygood.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cguo.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.SourceTable,
+			Columns: []string{certifygood.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cguo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.ArtifactTable,
+			Columns: []string{certifygood.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cguo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifygood.ArtifactTable,
+			Columns: []string{certifygood.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CertifyGood{config: cguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifygood.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cguo.mutation.done = true
+	return _node, nil
+}
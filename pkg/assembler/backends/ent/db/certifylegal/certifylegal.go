@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifylegal
+
+const (
+	// Label holds the string label denoting the certifylegal type in the database.
+	Label = "certify_legal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldDeclaredLicense holds the string denoting the declared_license field in the database.
+	FieldDeclaredLicense = "declared_license"
+	// FieldDiscoveredLicense holds the string denoting the discovered_license field in the database.
+	FieldDiscoveredLicense = "discovered_license"
+	// FieldAttribution holds the string denoting the attribution field in the database.
+	FieldAttribution = "attribution"
+	// FieldLegalHash holds the string denoting the legal_hash field in the database.
+	FieldLegalHash = "legal_hash"
+	// FieldJustification holds the string denoting the justification field in the database.
+	FieldJustification = "justification"
+	// FieldTimeScanned holds the string denoting the time_scanned field in the database.
+	FieldTimeScanned = "time_scanned"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// Table holds the table name of the certifylegal in the database.
+	Table = "certify_legals"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "certify_legals"
+	// PackageInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageInverseTable = "package_versions"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_id"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "certify_legals"
+	// SourceInverseTable is the table name for the SourceName entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcename" package.
+	SourceInverseTable = "source_names"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "source_id"
+)
+
+// Columns holds all SQL columns for certifylegal fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldSourceID,
+	FieldDeclaredLicense,
+	FieldDiscoveredLicense,
+	FieldAttribution,
+	FieldLegalHash,
+	FieldJustification,
+	FieldTimeScanned,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// CertifyLegalDelete is the builder for deleting a CertifyLegal entity.
+type CertifyLegalDelete struct {
+	config
+	hooks    []Hook
+	mutation *CertifyLegalMutation
+}
+
+// Where appends a list predicates to the CertifyLegalDelete builder.
+func (cld *CertifyLegalDelete) Where(ps ...predicate.CertifyLegal) *CertifyLegalDelete {
+	cld.mutation.Where(ps...)
+	return cld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cld *CertifyLegalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyLegalMutation](ctx, cld.sqlExec, cld.mutation, cld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cld *CertifyLegalDelete) ExecX(ctx context.Context) int {
+	n, err := cld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cld *CertifyLegalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(certifylegal.Table, sqlgraph.NewFieldSpec(certifylegal.FieldID, field.TypeInt))
+	if ps := cld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cld.mutation.done = true
+	return affected, err
+}
+
+// CertifyLegalDeleteOne is the builder for deleting a single CertifyLegal entity.
+type CertifyLegalDeleteOne struct {
+	cld *CertifyLegalDelete
+}
+
+// Where appends a list predicates to the CertifyLegalDelete builder.
+func (cldo *CertifyLegalDeleteOne) Where(ps ...predicate.CertifyLegal) *CertifyLegalDeleteOne {
+	cldo.cld.mutation.Where(ps...)
+	return cldo
+}
+
+// Exec executes the deletion query.
+func (cldo *CertifyLegalDeleteOne) Exec(ctx context.Context) error {
+	n, err := cldo.cld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{certifylegal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cldo *CertifyLegalDeleteOne) ExecX(ctx context.Context) {
+	if err := cldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// CertifyVEXStatement is the model entity for the CertifyVEXStatement schema.
+type CertifyVEXStatement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageID holds the value of the "package_id" field.
+	PackageID *int `json:"package_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID *int `json:"artifact_id,omitempty"`
+	// VulnerabilityID holds the value of the "vulnerability_id" field.
+	VulnerabilityID int `json:"vulnerability_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status model.VexStatus `json:"status,omitempty"`
+	// VexJustification holds the value of the "vex_justification" field.
+	VexJustification model.VexJustification `json:"vex_justification,omitempty"`
+	// Statement holds the value of the "statement" field.
+	Statement string `json:"statement,omitempty"`
+	// StatementHash holds the value of the "statement_hash" field.
+	StatementHash string `json:"statement_hash,omitempty"`
+	// KnownSince holds the value of the "known_since" field.
+	KnownSince time.Time `json:"known_since,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertifyVEXStatementQuery when eager-loading is set.
+	Edges CertifyVEXStatementEdges `json:"edges"`
+}
+
+// CertifyVEXStatementEdges holds the relations/edges for other nodes in the graph.
+type CertifyVEXStatementEdges struct {
+	// Package holds the value of the package edge.
+	Package *PackageVersion `json:"package,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// Vulnerability holds the value of the vulnerability edge.
+	Vulnerability *VulnerabilityID `json:"vulnerability,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PackageOrErr returns the Package value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyVEXStatementEdges) PackageOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.Package == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.Package, nil
+	}
+	return nil, &NotLoadedError{edge: "package"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyVEXStatementEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[1] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// VulnerabilityOrErr returns the Vulnerability value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyVEXStatementEdges) VulnerabilityOrErr() (*VulnerabilityID, error) {
+	if e.loadedTypes[2] {
+		if e.Vulnerability == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: vulnerabilityid.Label}
+		}
+		return e.Vulnerability, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CertifyVEXStatement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certifyvexstatement.FieldID, certifyvexstatement.FieldPackageID, certifyvexstatement.FieldArtifactID, certifyvexstatement.FieldVulnerabilityID:
+			values[i] = new(sql.NullInt64)
+		case certifyvexstatement.FieldStatus, certifyvexstatement.FieldVexJustification, certifyvexstatement.FieldStatement, certifyvexstatement.FieldStatementHash, certifyvexstatement.FieldOrigin, certifyvexstatement.FieldCollector:
+			values[i] = new(sql.NullString)
+		case certifyvexstatement.FieldKnownSince:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CertifyVEXStatement", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CertifyVEXStatement fields.
+func (cvs *CertifyVEXStatement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certifyvexstatement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cvs.ID = int(value.Int64)
+		case certifyvexstatement.FieldPackageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value.Valid {
+				cvs.PackageID = new(int)
+				*cvs.PackageID = int(value.Int64)
+			}
+		case certifyvexstatement.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				cvs.ArtifactID = new(int)
+				*cvs.ArtifactID = int(value.Int64)
+			}
+		case certifyvexstatement.FieldVulnerabilityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_id", values[i])
+			} else if value.Valid {
+				cvs.VulnerabilityID = int(value.Int64)
+			}
+		case certifyvexstatement.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cvs.Status = model.VexStatus(value.String)
+			}
+		case certifyvexstatement.FieldVexJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vex_justification", values[i])
+			} else if value.Valid {
+				cvs.VexJustification = model.VexJustification(value.String)
+			}
+		case certifyvexstatement.FieldStatement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field statement", values[i])
+			} else if value.Valid {
+				cvs.Statement = value.String
+			}
+		case certifyvexstatement.FieldStatementHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_hash", values[i])
+			} else if value.Valid {
+				cvs.StatementHash = value.String
+			}
+		case certifyvexstatement.FieldKnownSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field known_since", values[i])
+			} else if value.Valid {
+				cvs.KnownSince = value.Time
+			}
+		case certifyvexstatement.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				cvs.Origin = value.String
+			}
+		case certifyvexstatement.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				cvs.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackage queries the "package" edge of the CertifyVEXStatement entity.
+func (cvs *CertifyVEXStatement) QueryPackage() *PackageVersionQuery {
+	return NewCertifyVEXStatementClient(cvs.config).QueryPackage(cvs)
+}
+
+// QueryArtifact queries the "artifact" edge of the CertifyVEXStatement entity.
+func (cvs *CertifyVEXStatement) QueryArtifact() *ArtifactQuery {
+	return NewCertifyVEXStatementClient(cvs.config).QueryArtifact(cvs)
+}
+
+// QueryVulnerability queries the "vulnerability" edge of the CertifyVEXStatement entity.
+func (cvs *CertifyVEXStatement) QueryVulnerability() *VulnerabilityIDQuery {
+	return NewCertifyVEXStatementClient(cvs.config).QueryVulnerability(cvs)
+}
+
+// Update returns a builder for updating this CertifyVEXStatement.
+// Note that you need to call CertifyVEXStatement.Unwrap() before calling this method if this CertifyVEXStatement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cvs *CertifyVEXStatement) Update() *CertifyVEXStatementUpdateOne {
+	return NewCertifyVEXStatementClient(cvs.config).UpdateOne(cvs)
+}
+
+// Unwrap unwraps the CertifyVEXStatement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cvs *CertifyVEXStatement) Unwrap() *CertifyVEXStatement {
+	_tx, ok := cvs.config.driver.(*txDriver)
+	if !ok {
+		panic("db: CertifyVEXStatement is not a transactional entity")
+	}
+	cvs.config.driver = _tx.drv
+	return cvs
+}
+
+// String implements the fmt.Stringer.
+func (cvs *CertifyVEXStatement) String() string {
+	var builder strings.Builder
+	builder.WriteString("CertifyVEXStatement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cvs.ID))
+	if v := cvs.PackageID; v != nil {
+		builder.WriteString("package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cvs.ArtifactID; v != nil {
+		builder.WriteString("artifact_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("vulnerability_id=")
+	builder.WriteString(fmt.Sprintf("%v", cvs.VulnerabilityID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", cvs.Status))
+	builder.WriteString(", ")
+	builder.WriteString("vex_justification=")
+	builder.WriteString(fmt.Sprintf("%v", cvs.VexJustification))
+	builder.WriteString(", ")
+	builder.WriteString("statement=")
+	builder.WriteString(cvs.Statement)
+	builder.WriteString(", ")
+	builder.WriteString("statement_hash=")
+	builder.WriteString(cvs.StatementHash)
+	builder.WriteString(", ")
+	builder.WriteString("known_since=")
+	builder.WriteString(cvs.KnownSince.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(cvs.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(cvs.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CertifyVEXStatements is a parsable slice of CertifyVEXStatement.
+type CertifyVEXStatements []*CertifyVEXStatement
@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifyvexstatement
+
+const (
+	// Label holds the string label denoting the certifyvexstatement type in the database.
+	Label = "certify_vex_statement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldArtifactID holds the string denoting the artifact_id field in the database.
+	FieldArtifactID = "artifact_id"
+	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
+	FieldVulnerabilityID = "vulnerability_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVexJustification holds the string denoting the vex_justification field in the database.
+	FieldVexJustification = "vex_justification"
+	// FieldStatement holds the string denoting the statement field in the database.
+	FieldStatement = "statement"
+	// FieldStatementHash holds the string denoting the statement_hash field in the database.
+	FieldStatementHash = "statement_hash"
+	// FieldKnownSince holds the string denoting the known_since field in the database.
+	FieldKnownSince = "known_since"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// EdgeArtifact holds the string denoting the artifact edge name in mutations.
+	EdgeArtifact = "artifact"
+	// EdgeVulnerability holds the string denoting the vulnerability edge name in mutations.
+	EdgeVulnerability = "vulnerability"
+	// Table holds the table name of the certifyvexstatement in the database.
+	Table = "certify_vex_statements"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "certify_vex_statements"
+	// PackageInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageInverseTable = "package_versions"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_id"
+	// ArtifactTable is the table that holds the artifact relation/edge.
+	ArtifactTable = "certify_vex_statements"
+	// ArtifactInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactInverseTable = "artifacts"
+	// ArtifactColumn is the table column denoting the artifact relation/edge.
+	ArtifactColumn = "artifact_id"
+	// VulnerabilityTable is the table that holds the vulnerability relation/edge.
+	VulnerabilityTable = "certify_vex_statements"
+	// VulnerabilityInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityInverseTable = "vulnerability_ids"
+	// VulnerabilityColumn is the table column denoting the vulnerability relation/edge.
+	VulnerabilityColumn = "vulnerability_id"
+)
+
+// Columns holds all SQL columns for certifyvexstatement fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldArtifactID,
+	FieldVulnerabilityID,
+	FieldStatus,
+	FieldVexJustification,
+	FieldStatement,
+	FieldStatementHash,
+	FieldKnownSince,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
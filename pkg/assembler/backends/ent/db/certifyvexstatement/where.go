@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifyvexstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldID, id))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldPackageID, v))
+}
+
+// ArtifactID applies equality check predicate on the "artifact_id" field. It's identical to ArtifactIDEQ.
+func ArtifactID(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// VulnerabilityID applies equality check predicate on the "vulnerability_id" field. It's identical to VulnerabilityIDEQ.
+func VulnerabilityID(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatus, vc))
+}
+
+// VexJustification applies equality check predicate on the "vex_justification" field. It's identical to VexJustificationEQ.
+func VexJustification(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldVexJustification, vc))
+}
+
+// Statement applies equality check predicate on the "statement" field. It's identical to StatementEQ.
+func Statement(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatement, v))
+}
+
+// StatementHash applies equality check predicate on the "statement_hash" field. It's identical to StatementHashEQ.
+func StatementHash(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatementHash, v))
+}
+
+// KnownSince applies equality check predicate on the "known_since" field. It's identical to KnownSinceEQ.
+func KnownSince(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldKnownSince, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldCollector, v))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// PackageIDIsNil applies the IsNil predicate on the "package_id" field.
+func PackageIDIsNil() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIsNull(FieldPackageID))
+}
+
+// PackageIDNotNil applies the NotNil predicate on the "package_id" field.
+func PackageIDNotNil() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotNull(FieldPackageID))
+}
+
+// ArtifactIDEQ applies the EQ predicate on the "artifact_id" field.
+func ArtifactIDEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDNEQ applies the NEQ predicate on the "artifact_id" field.
+func ArtifactIDNEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDIn applies the In predicate on the "artifact_id" field.
+func ArtifactIDIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDNotIn applies the NotIn predicate on the "artifact_id" field.
+func ArtifactIDNotIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDIsNil applies the IsNil predicate on the "artifact_id" field.
+func ArtifactIDIsNil() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIsNull(FieldArtifactID))
+}
+
+// ArtifactIDNotNil applies the NotNil predicate on the "artifact_id" field.
+func ArtifactIDNotNil() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotNull(FieldArtifactID))
+}
+
+// VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDNEQ applies the NEQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDNEQ(v int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDIn applies the In predicate on the "vulnerability_id" field.
+func VulnerabilityIDIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldVulnerabilityID, vs...))
+}
+
+// VulnerabilityIDNotIn applies the NotIn predicate on the "vulnerability_id" field.
+func VulnerabilityIDNotIn(vs ...int) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldVulnerabilityID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...model.VexStatus) predicate.CertifyVEXStatement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...model.VexStatus) predicate.CertifyVEXStatement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v model.VexStatus) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// VexJustificationEQ applies the EQ predicate on the "vex_justification" field.
+func VexJustificationEQ(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldVexJustification, vc))
+}
+
+// VexJustificationNEQ applies the NEQ predicate on the "vex_justification" field.
+func VexJustificationNEQ(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldVexJustification, vc))
+}
+
+// VexJustificationIn applies the In predicate on the "vex_justification" field.
+func VexJustificationIn(vs ...model.VexJustification) predicate.CertifyVEXStatement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldVexJustification, v...))
+}
+
+// VexJustificationNotIn applies the NotIn predicate on the "vex_justification" field.
+func VexJustificationNotIn(vs ...model.VexJustification) predicate.CertifyVEXStatement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldVexJustification, v...))
+}
+
+// VexJustificationGT applies the GT predicate on the "vex_justification" field.
+func VexJustificationGT(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldVexJustification, vc))
+}
+
+// VexJustificationGTE applies the GTE predicate on the "vex_justification" field.
+func VexJustificationGTE(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldVexJustification, vc))
+}
+
+// VexJustificationLT applies the LT predicate on the "vex_justification" field.
+func VexJustificationLT(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldVexJustification, vc))
+}
+
+// VexJustificationLTE applies the LTE predicate on the "vex_justification" field.
+func VexJustificationLTE(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldVexJustification, vc))
+}
+
+// VexJustificationContains applies the Contains predicate on the "vex_justification" field.
+func VexJustificationContains(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldVexJustification, vc))
+}
+
+// VexJustificationHasPrefix applies the HasPrefix predicate on the "vex_justification" field.
+func VexJustificationHasPrefix(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldVexJustification, vc))
+}
+
+// VexJustificationHasSuffix applies the HasSuffix predicate on the "vex_justification" field.
+func VexJustificationHasSuffix(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldVexJustification, vc))
+}
+
+// VexJustificationEqualFold applies the EqualFold predicate on the "vex_justification" field.
+func VexJustificationEqualFold(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldVexJustification, vc))
+}
+
+// VexJustificationContainsFold applies the ContainsFold predicate on the "vex_justification" field.
+func VexJustificationContainsFold(v model.VexJustification) predicate.CertifyVEXStatement {
+	vc := string(v)
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldVexJustification, vc))
+}
+
+// StatementEQ applies the EQ predicate on the "statement" field.
+func StatementEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatement, v))
+}
+
+// StatementNEQ applies the NEQ predicate on the "statement" field.
+func StatementNEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldStatement, v))
+}
+
+// StatementIn applies the In predicate on the "statement" field.
+func StatementIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldStatement, vs...))
+}
+
+// StatementNotIn applies the NotIn predicate on the "statement" field.
+func StatementNotIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldStatement, vs...))
+}
+
+// StatementGT applies the GT predicate on the "statement" field.
+func StatementGT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldStatement, v))
+}
+
+// StatementGTE applies the GTE predicate on the "statement" field.
+func StatementGTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldStatement, v))
+}
+
+// StatementLT applies the LT predicate on the "statement" field.
+func StatementLT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldStatement, v))
+}
+
+// StatementLTE applies the LTE predicate on the "statement" field.
+func StatementLTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldStatement, v))
+}
+
+// StatementContains applies the Contains predicate on the "statement" field.
+func StatementContains(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldStatement, v))
+}
+
+// StatementHasPrefix applies the HasPrefix predicate on the "statement" field.
+func StatementHasPrefix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldStatement, v))
+}
+
+// StatementHasSuffix applies the HasSuffix predicate on the "statement" field.
+func StatementHasSuffix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldStatement, v))
+}
+
+// StatementEqualFold applies the EqualFold predicate on the "statement" field.
+func StatementEqualFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldStatement, v))
+}
+
+// StatementContainsFold applies the ContainsFold predicate on the "statement" field.
+func StatementContainsFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldStatement, v))
+}
+
+// StatementHashEQ applies the EQ predicate on the "statement_hash" field.
+func StatementHashEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldStatementHash, v))
+}
+
+// StatementHashNEQ applies the NEQ predicate on the "statement_hash" field.
+func StatementHashNEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldStatementHash, v))
+}
+
+// StatementHashIn applies the In predicate on the "statement_hash" field.
+func StatementHashIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldStatementHash, vs...))
+}
+
+// StatementHashNotIn applies the NotIn predicate on the "statement_hash" field.
+func StatementHashNotIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldStatementHash, vs...))
+}
+
+// StatementHashGT applies the GT predicate on the "statement_hash" field.
+func StatementHashGT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldStatementHash, v))
+}
+
+// StatementHashGTE applies the GTE predicate on the "statement_hash" field.
+func StatementHashGTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldStatementHash, v))
+}
+
+// StatementHashLT applies the LT predicate on the "statement_hash" field.
+func StatementHashLT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldStatementHash, v))
+}
+
+// StatementHashLTE applies the LTE predicate on the "statement_hash" field.
+func StatementHashLTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldStatementHash, v))
+}
+
+// StatementHashContains applies the Contains predicate on the "statement_hash" field.
+func StatementHashContains(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldStatementHash, v))
+}
+
+// StatementHashHasPrefix applies the HasPrefix predicate on the "statement_hash" field.
+func StatementHashHasPrefix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldStatementHash, v))
+}
+
+// StatementHashHasSuffix applies the HasSuffix predicate on the "statement_hash" field.
+func StatementHashHasSuffix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldStatementHash, v))
+}
+
+// StatementHashEqualFold applies the EqualFold predicate on the "statement_hash" field.
+func StatementHashEqualFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldStatementHash, v))
+}
+
+// StatementHashContainsFold applies the ContainsFold predicate on the "statement_hash" field.
+func StatementHashContainsFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldStatementHash, v))
+}
+
+// KnownSinceEQ applies the EQ predicate on the "known_since" field.
+func KnownSinceEQ(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldKnownSince, v))
+}
+
+// KnownSinceNEQ applies the NEQ predicate on the "known_since" field.
+func KnownSinceNEQ(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldKnownSince, v))
+}
+
+// KnownSinceIn applies the In predicate on the "known_since" field.
+func KnownSinceIn(vs ...time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldKnownSince, vs...))
+}
+
+// KnownSinceNotIn applies the NotIn predicate on the "known_since" field.
+func KnownSinceNotIn(vs ...time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldKnownSince, vs...))
+}
+
+// KnownSinceGT applies the GT predicate on the "known_since" field.
+func KnownSinceGT(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldKnownSince, v))
+}
+
+// KnownSinceGTE applies the GTE predicate on the "known_since" field.
+func KnownSinceGTE(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldKnownSince, v))
+}
+
+// KnownSinceLT applies the LT predicate on the "known_since" field.
+func KnownSinceLT(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldKnownSince, v))
+}
+
+// KnownSinceLTE applies the LTE predicate on the "known_since" field.
+func KnownSinceLTE(v time.Time) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldKnownSince, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// HasPackage applies the HasEdge predicate on the "package" edge.
+func HasPackage() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackageWith applies the HasEdge predicate on the "package" edge with a given conditions (other predicates).
+func HasPackageWith(preds ...predicate.PackageVersion) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PackageInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtifact applies the HasEdge predicate on the "artifact" edge.
+func HasArtifact() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactWith applies the HasEdge predicate on the "artifact" edge with a given conditions (other predicates).
+func HasArtifactWith(preds ...predicate.Artifact) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ArtifactInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVulnerability applies the HasEdge predicate on the "vulnerability" edge.
+func HasVulnerability() predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityTable, VulnerabilityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityWith applies the HasEdge predicate on the "vulnerability" edge with a given conditions (other predicates).
+func HasVulnerabilityWith(preds ...predicate.VulnerabilityID) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VulnerabilityInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityTable, VulnerabilityColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CertifyVEXStatement) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CertifyVEXStatement) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CertifyVEXStatement) predicate.CertifyVEXStatement {
+	return predicate.CertifyVEXStatement(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,1036 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// CertifyVEXStatementCreate is the builder for creating a CertifyVEXStatement entity.
+type CertifyVEXStatementCreate struct {
+	config
+	mutation *CertifyVEXStatementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvsc *CertifyVEXStatementCreate) SetPackageID(i int) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetPackageID(i)
+	return cvsc
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (cvsc *CertifyVEXStatementCreate) SetNillablePackageID(i *int) *CertifyVEXStatementCreate {
+	if i != nil {
+		cvsc.SetPackageID(*i)
+	}
+	return cvsc
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cvsc *CertifyVEXStatementCreate) SetArtifactID(i int) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetArtifactID(i)
+	return cvsc
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cvsc *CertifyVEXStatementCreate) SetNillableArtifactID(i *int) *CertifyVEXStatementCreate {
+	if i != nil {
+		cvsc.SetArtifactID(*i)
+	}
+	return cvsc
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvsc *CertifyVEXStatementCreate) SetVulnerabilityID(i int) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetVulnerabilityID(i)
+	return cvsc
+}
+
+// SetStatus sets the "status" field.
+func (cvsc *CertifyVEXStatementCreate) SetStatus(ms model.VexStatus) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetStatus(ms)
+	return cvsc
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (cvsc *CertifyVEXStatementCreate) SetVexJustification(mj model.VexJustification) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetVexJustification(mj)
+	return cvsc
+}
+
+// SetStatement sets the "statement" field.
+func (cvsc *CertifyVEXStatementCreate) SetStatement(s string) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetStatement(s)
+	return cvsc
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (cvsc *CertifyVEXStatementCreate) SetStatementHash(s string) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetStatementHash(s)
+	return cvsc
+}
+
+// SetKnownSince sets the "known_since" field.
+func (cvsc *CertifyVEXStatementCreate) SetKnownSince(t time.Time) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetKnownSince(t)
+	return cvsc
+}
+
+// SetOrigin sets the "origin" field.
+func (cvsc *CertifyVEXStatementCreate) SetOrigin(s string) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetOrigin(s)
+	return cvsc
+}
+
+// SetCollector sets the "collector" field.
+func (cvsc *CertifyVEXStatementCreate) SetCollector(s string) *CertifyVEXStatementCreate {
+	cvsc.mutation.SetCollector(s)
+	return cvsc
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvsc *CertifyVEXStatementCreate) SetPackage(p *PackageVersion) *CertifyVEXStatementCreate {
+	return cvsc.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cvsc *CertifyVEXStatementCreate) SetArtifact(a *Artifact) *CertifyVEXStatementCreate {
+	return cvsc.SetArtifactID(a.ID)
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvsc *CertifyVEXStatementCreate) SetVulnerability(v *VulnerabilityID) *CertifyVEXStatementCreate {
+	return cvsc.SetVulnerabilityID(v.ID)
+}
+
+// Mutation returns the CertifyVEXStatementMutation object of the builder.
+func (cvsc *CertifyVEXStatementCreate) Mutation() *CertifyVEXStatementMutation {
+	return cvsc.mutation
+}
+
+// Save creates the CertifyVEXStatement in the database.
+func (cvsc *CertifyVEXStatementCreate) Save(ctx context.Context) (*CertifyVEXStatement, error) {
+	return withHooks[*CertifyVEXStatement, CertifyVEXStatementMutation](ctx, cvsc.sqlSave, cvsc.mutation, cvsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cvsc *CertifyVEXStatementCreate) SaveX(ctx context.Context) *CertifyVEXStatement {
+	v, err := cvsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvsc *CertifyVEXStatementCreate) Exec(ctx context.Context) error {
+	_, err := cvsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvsc *CertifyVEXStatementCreate) ExecX(ctx context.Context) {
+	if err := cvsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvsc *CertifyVEXStatementCreate) check() error {
+	if _, ok := cvsc.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability_id", err: errors.New(`db: missing required field "CertifyVEXStatement.vulnerability_id"`)}
+	}
+	if _, ok := cvsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "CertifyVEXStatement.status"`)}
+	}
+	if _, ok := cvsc.mutation.VexJustification(); !ok {
+		return &ValidationError{Name: "vex_justification", err: errors.New(`db: missing required field "CertifyVEXStatement.vex_justification"`)}
+	}
+	if _, ok := cvsc.mutation.Statement(); !ok {
+		return &ValidationError{Name: "statement", err: errors.New(`db: missing required field "CertifyVEXStatement.statement"`)}
+	}
+	if _, ok := cvsc.mutation.StatementHash(); !ok {
+		return &ValidationError{Name: "statement_hash", err: errors.New(`db: missing required field "CertifyVEXStatement.statement_hash"`)}
+	}
+	if _, ok := cvsc.mutation.KnownSince(); !ok {
+		return &ValidationError{Name: "known_since", err: errors.New(`db: missing required field "CertifyVEXStatement.known_since"`)}
+	}
+	if _, ok := cvsc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`db: missing required field "CertifyVEXStatement.origin"`)}
+	}
+	if _, ok := cvsc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`db: missing required field "CertifyVEXStatement.collector"`)}
+	}
+	if _, ok := cvsc.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability", err: errors.New(`db: missing required edge "CertifyVEXStatement.vulnerability"`)}
+	}
+	return nil
+}
+
+func (cvsc *CertifyVEXStatementCreate) sqlSave(ctx context.Context) (*CertifyVEXStatement, error) {
+	if err := cvsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cvsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cvsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cvsc.mutation.id = &_node.ID
+	cvsc.mutation.done = true
+	return _node, nil
+}
+
+func (cvsc *CertifyVEXStatementCreate) createSpec() (*CertifyVEXStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CertifyVEXStatement{config: cvsc.config}
+		_spec = sqlgraph.NewCreateSpec(certifyvexstatement.Table, sqlgraph.NewFieldSpec(certifyvexstatement.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cvsc.conflict
+	if value, ok := cvsc.mutation.Status(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := cvsc.mutation.VexJustification(); ok {
+		_spec.SetField(certifyvexstatement.FieldVexJustification, field.TypeString, value)
+		_node.VexJustification = value
+	}
+	if value, ok := cvsc.mutation.Statement(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatement, field.TypeString, value)
+		_node.Statement = value
+	}
+	if value, ok := cvsc.mutation.StatementHash(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatementHash, field.TypeString, value)
+		_node.StatementHash = value
+	}
+	if value, ok := cvsc.mutation.KnownSince(); ok {
+		_spec.SetField(certifyvexstatement.FieldKnownSince, field.TypeTime, value)
+		_node.KnownSince = value
+	}
+	if value, ok := cvsc.mutation.Origin(); ok {
+		_spec.SetField(certifyvexstatement.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := cvsc.mutation.Collector(); ok {
+		_spec.SetField(certifyvexstatement.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if nodes := cvsc.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.PackageTable,
+			Columns: []string{certifyvexstatement.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cvsc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.ArtifactTable,
+			Columns: []string{certifyvexstatement.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cvsc.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.VulnerabilityTable,
+			Columns: []string{certifyvexstatement.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VulnerabilityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyVEXStatement.Create().
+//		SetPackageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyVEXStatementUpsert) {
+//			SetPackageID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvsc *CertifyVEXStatementCreate) OnConflict(opts ...sql.ConflictOption) *CertifyVEXStatementUpsertOne {
+	cvsc.conflict = opts
+	return &CertifyVEXStatementUpsertOne{
+		create: cvsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvsc *CertifyVEXStatementCreate) OnConflictColumns(columns ...string) *CertifyVEXStatementUpsertOne {
+	cvsc.conflict = append(cvsc.conflict, sql.ConflictColumns(columns...))
+	return &CertifyVEXStatementUpsertOne{
+		create: cvsc,
+	}
+}
+
+type (
+	// CertifyVEXStatementUpsertOne is the builder for "upsert"-ing
+	//  one CertifyVEXStatement node.
+	CertifyVEXStatementUpsertOne struct {
+		create *CertifyVEXStatementCreate
+	}
+
+	// CertifyVEXStatementUpsert is the "OnConflict" setter.
+	CertifyVEXStatementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVEXStatementUpsert) SetPackageID(v int) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldPackageID, v)
+	return u
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdatePackageID() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldPackageID)
+	return u
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *CertifyVEXStatementUpsert) ClearPackageID() *CertifyVEXStatementUpsert {
+	u.SetNull(certifyvexstatement.FieldPackageID)
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyVEXStatementUpsert) SetArtifactID(v int) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateArtifactID() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldArtifactID)
+	return u
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyVEXStatementUpsert) ClearArtifactID() *CertifyVEXStatementUpsert {
+	u.SetNull(certifyvexstatement.FieldArtifactID)
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVEXStatementUpsert) SetVulnerabilityID(v int) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldVulnerabilityID, v)
+	return u
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateVulnerabilityID() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldVulnerabilityID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CertifyVEXStatementUpsert) SetStatus(v model.VexStatus) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateStatus() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldStatus)
+	return u
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (u *CertifyVEXStatementUpsert) SetVexJustification(v model.VexJustification) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldVexJustification, v)
+	return u
+}
+
+// UpdateVexJustification sets the "vex_justification" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateVexJustification() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldVexJustification)
+	return u
+}
+
+// SetStatement sets the "statement" field.
+func (u *CertifyVEXStatementUpsert) SetStatement(v string) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldStatement, v)
+	return u
+}
+
+// UpdateStatement sets the "statement" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateStatement() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldStatement)
+	return u
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (u *CertifyVEXStatementUpsert) SetStatementHash(v string) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldStatementHash, v)
+	return u
+}
+
+// UpdateStatementHash sets the "statement_hash" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateStatementHash() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldStatementHash)
+	return u
+}
+
+// SetKnownSince sets the "known_since" field.
+func (u *CertifyVEXStatementUpsert) SetKnownSince(v time.Time) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldKnownSince, v)
+	return u
+}
+
+// UpdateKnownSince sets the "known_since" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateKnownSince() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldKnownSince)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVEXStatementUpsert) SetOrigin(v string) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateOrigin() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVEXStatementUpsert) SetCollector(v string) *CertifyVEXStatementUpsert {
+	u.Set(certifyvexstatement.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsert) UpdateCollector() *CertifyVEXStatementUpsert {
+	u.SetExcluded(certifyvexstatement.FieldCollector)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyVEXStatementUpsertOne) UpdateNewValues() *CertifyVEXStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CertifyVEXStatementUpsertOne) Ignore() *CertifyVEXStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyVEXStatementUpsertOne) DoNothing() *CertifyVEXStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyVEXStatementCreate.OnConflict
+// documentation for more info.
+func (u *CertifyVEXStatementUpsertOne) Update(set func(*CertifyVEXStatementUpsert)) *CertifyVEXStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyVEXStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVEXStatementUpsertOne) SetPackageID(v int) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdatePackageID() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *CertifyVEXStatementUpsertOne) ClearPackageID() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyVEXStatementUpsertOne) SetArtifactID(v int) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateArtifactID() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyVEXStatementUpsertOne) ClearArtifactID() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVEXStatementUpsertOne) SetVulnerabilityID(v int) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateVulnerabilityID() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CertifyVEXStatementUpsertOne) SetStatus(v model.VexStatus) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateStatus() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (u *CertifyVEXStatementUpsertOne) SetVexJustification(v model.VexJustification) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetVexJustification(v)
+	})
+}
+
+// UpdateVexJustification sets the "vex_justification" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateVexJustification() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateVexJustification()
+	})
+}
+
+// SetStatement sets the "statement" field.
+func (u *CertifyVEXStatementUpsertOne) SetStatement(v string) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatement(v)
+	})
+}
+
+// UpdateStatement sets the "statement" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateStatement() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatement()
+	})
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (u *CertifyVEXStatementUpsertOne) SetStatementHash(v string) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatementHash(v)
+	})
+}
+
+// UpdateStatementHash sets the "statement_hash" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateStatementHash() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatementHash()
+	})
+}
+
+// SetKnownSince sets the "known_since" field.
+func (u *CertifyVEXStatementUpsertOne) SetKnownSince(v time.Time) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetKnownSince(v)
+	})
+}
+
+// UpdateKnownSince sets the "known_since" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateKnownSince() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateKnownSince()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVEXStatementUpsertOne) SetOrigin(v string) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateOrigin() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVEXStatementUpsertOne) SetCollector(v string) *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertOne) UpdateCollector() *CertifyVEXStatementUpsertOne {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyVEXStatementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyVEXStatementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyVEXStatementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CertifyVEXStatementUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CertifyVEXStatementUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CertifyVEXStatementCreateBulk is the builder for creating many CertifyVEXStatement entities in bulk.
+type CertifyVEXStatementCreateBulk struct {
+	config
+	builders []*CertifyVEXStatementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CertifyVEXStatement entities in the database.
+func (cvscb *CertifyVEXStatementCreateBulk) Save(ctx context.Context) ([]*CertifyVEXStatement, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cvscb.builders))
+	nodes := make([]*CertifyVEXStatement, len(cvscb.builders))
+	mutators := make([]Mutator, len(cvscb.builders))
+	for i := range cvscb.builders {
+		func(i int, root context.Context) {
+			builder := cvscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertifyVEXStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cvscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cvscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cvscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cvscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvscb *CertifyVEXStatementCreateBulk) SaveX(ctx context.Context) []*CertifyVEXStatement {
+	v, err := cvscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvscb *CertifyVEXStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := cvscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvscb *CertifyVEXStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := cvscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyVEXStatement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyVEXStatementUpsert) {
+//			SetPackageID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvscb *CertifyVEXStatementCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyVEXStatementUpsertBulk {
+	cvscb.conflict = opts
+	return &CertifyVEXStatementUpsertBulk{
+		create: cvscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvscb *CertifyVEXStatementCreateBulk) OnConflictColumns(columns ...string) *CertifyVEXStatementUpsertBulk {
+	cvscb.conflict = append(cvscb.conflict, sql.ConflictColumns(columns...))
+	return &CertifyVEXStatementUpsertBulk{
+		create: cvscb,
+	}
+}
+
+// CertifyVEXStatementUpsertBulk is the builder for "upsert"-ing
+// a bulk of CertifyVEXStatement nodes.
+type CertifyVEXStatementUpsertBulk struct {
+	create *CertifyVEXStatementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CertifyVEXStatementUpsertBulk) UpdateNewValues() *CertifyVEXStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVEXStatement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CertifyVEXStatementUpsertBulk) Ignore() *CertifyVEXStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyVEXStatementUpsertBulk) DoNothing() *CertifyVEXStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyVEXStatementCreateBulk.OnConflict
+// documentation for more info.
+func (u *CertifyVEXStatementUpsertBulk) Update(set func(*CertifyVEXStatementUpsert)) *CertifyVEXStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyVEXStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVEXStatementUpsertBulk) SetPackageID(v int) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdatePackageID() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *CertifyVEXStatementUpsertBulk) ClearPackageID() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyVEXStatementUpsertBulk) SetArtifactID(v int) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateArtifactID() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *CertifyVEXStatementUpsertBulk) ClearArtifactID() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVEXStatementUpsertBulk) SetVulnerabilityID(v int) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateVulnerabilityID() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CertifyVEXStatementUpsertBulk) SetStatus(v model.VexStatus) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateStatus() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (u *CertifyVEXStatementUpsertBulk) SetVexJustification(v model.VexJustification) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetVexJustification(v)
+	})
+}
+
+// UpdateVexJustification sets the "vex_justification" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateVexJustification() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateVexJustification()
+	})
+}
+
+// SetStatement sets the "statement" field.
+func (u *CertifyVEXStatementUpsertBulk) SetStatement(v string) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatement(v)
+	})
+}
+
+// UpdateStatement sets the "statement" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateStatement() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatement()
+	})
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (u *CertifyVEXStatementUpsertBulk) SetStatementHash(v string) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetStatementHash(v)
+	})
+}
+
+// UpdateStatementHash sets the "statement_hash" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateStatementHash() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateStatementHash()
+	})
+}
+
+// SetKnownSince sets the "known_since" field.
+func (u *CertifyVEXStatementUpsertBulk) SetKnownSince(v time.Time) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetKnownSince(v)
+	})
+}
+
+// UpdateKnownSince sets the "known_since" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateKnownSince() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateKnownSince()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVEXStatementUpsertBulk) SetOrigin(v string) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateOrigin() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVEXStatementUpsertBulk) SetCollector(v string) *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVEXStatementUpsertBulk) UpdateCollector() *CertifyVEXStatementUpsertBulk {
+	return u.Update(func(s *CertifyVEXStatementUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyVEXStatementUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the CertifyVEXStatementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for CertifyVEXStatementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyVEXStatementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// CertifyVEXStatementDelete is the builder for deleting a CertifyVEXStatement entity.
+type CertifyVEXStatementDelete struct {
+	config
+	hooks    []Hook
+	mutation *CertifyVEXStatementMutation
+}
+
+// Where appends a list predicates to the CertifyVEXStatementDelete builder.
+func (cvsd *CertifyVEXStatementDelete) Where(ps ...predicate.CertifyVEXStatement) *CertifyVEXStatementDelete {
+	cvsd.mutation.Where(ps...)
+	return cvsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cvsd *CertifyVEXStatementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyVEXStatementMutation](ctx, cvsd.sqlExec, cvsd.mutation, cvsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvsd *CertifyVEXStatementDelete) ExecX(ctx context.Context) int {
+	n, err := cvsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cvsd *CertifyVEXStatementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(certifyvexstatement.Table, sqlgraph.NewFieldSpec(certifyvexstatement.FieldID, field.TypeInt))
+	if ps := cvsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cvsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cvsd.mutation.done = true
+	return affected, err
+}
+
+// CertifyVEXStatementDeleteOne is the builder for deleting a single CertifyVEXStatement entity.
+type CertifyVEXStatementDeleteOne struct {
+	cvsd *CertifyVEXStatementDelete
+}
+
+// Where appends a list predicates to the CertifyVEXStatementDelete builder.
+func (cvsdo *CertifyVEXStatementDeleteOne) Where(ps ...predicate.CertifyVEXStatement) *CertifyVEXStatementDeleteOne {
+	cvsdo.cvsd.mutation.Where(ps...)
+	return cvsdo
+}
+
+// Exec executes the deletion query.
+func (cvsdo *CertifyVEXStatementDeleteOne) Exec(ctx context.Context) error {
+	n, err := cvsdo.cvsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{certifyvexstatement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvsdo *CertifyVEXStatementDeleteOne) ExecX(ctx context.Context) {
+	if err := cvsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,752 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+)
+
+// CertifyVEXStatementQuery is the builder for querying CertifyVEXStatement entities.
+type CertifyVEXStatementQuery struct {
+	config
+	ctx               *QueryContext
+	order             []OrderFunc
+	inters            []Interceptor
+	predicates        []predicate.CertifyVEXStatement
+	withPackage       *PackageVersionQuery
+	withArtifact      *ArtifactQuery
+	withVulnerability *VulnerabilityIDQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CertifyVEXStatementQuery builder.
+func (cvsq *CertifyVEXStatementQuery) Where(ps ...predicate.CertifyVEXStatement) *CertifyVEXStatementQuery {
+	cvsq.predicates = append(cvsq.predicates, ps...)
+	return cvsq
+}
+
+// Limit the number of records to be returned by this query.
+func (cvsq *CertifyVEXStatementQuery) Limit(limit int) *CertifyVEXStatementQuery {
+	cvsq.ctx.Limit = &limit
+	return cvsq
+}
+
+// Offset to start from.
+func (cvsq *CertifyVEXStatementQuery) Offset(offset int) *CertifyVEXStatementQuery {
+	cvsq.ctx.Offset = &offset
+	return cvsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cvsq *CertifyVEXStatementQuery) Unique(unique bool) *CertifyVEXStatementQuery {
+	cvsq.ctx.Unique = &unique
+	return cvsq
+}
+
+// Order specifies how the records should be ordered.
+func (cvsq *CertifyVEXStatementQuery) Order(o ...OrderFunc) *CertifyVEXStatementQuery {
+	cvsq.order = append(cvsq.order, o...)
+	return cvsq
+}
+
+// QueryPackage chains the current query on the "package" edge.
+func (cvsq *CertifyVEXStatementQuery) QueryPackage() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: cvsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.PackageTable, certifyvexstatement.PackageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (cvsq *CertifyVEXStatementQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: cvsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.ArtifactTable, certifyvexstatement.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVulnerability chains the current query on the "vulnerability" edge.
+func (cvsq *CertifyVEXStatementQuery) QueryVulnerability() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: cvsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.VulnerabilityTable, certifyvexstatement.VulnerabilityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CertifyVEXStatement entity from the query.
+// Returns a *NotFoundError when no CertifyVEXStatement was found.
+func (cvsq *CertifyVEXStatementQuery) First(ctx context.Context) (*CertifyVEXStatement, error) {
+	nodes, err := cvsq.Limit(1).All(setContextOp(ctx, cvsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{certifyvexstatement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) FirstX(ctx context.Context) *CertifyVEXStatement {
+	node, err := cvsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CertifyVEXStatement ID from the query.
+// Returns a *NotFoundError when no CertifyVEXStatement ID was found.
+func (cvsq *CertifyVEXStatementQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cvsq.Limit(1).IDs(setContextOp(ctx, cvsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{certifyvexstatement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) FirstIDX(ctx context.Context) int {
+	id, err := cvsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CertifyVEXStatement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CertifyVEXStatement entity is found.
+// Returns a *NotFoundError when no CertifyVEXStatement entities are found.
+func (cvsq *CertifyVEXStatementQuery) Only(ctx context.Context) (*CertifyVEXStatement, error) {
+	nodes, err := cvsq.Limit(2).All(setContextOp(ctx, cvsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{certifyvexstatement.Label}
+	default:
+		return nil, &NotSingularError{certifyvexstatement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) OnlyX(ctx context.Context) *CertifyVEXStatement {
+	node, err := cvsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CertifyVEXStatement ID in the query.
+// Returns a *NotSingularError when more than one CertifyVEXStatement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cvsq *CertifyVEXStatementQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cvsq.Limit(2).IDs(setContextOp(ctx, cvsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{certifyvexstatement.Label}
+	default:
+		err = &NotSingularError{certifyvexstatement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cvsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CertifyVEXStatements.
+func (cvsq *CertifyVEXStatementQuery) All(ctx context.Context) ([]*CertifyVEXStatement, error) {
+	ctx = setContextOp(ctx, cvsq.ctx, "All")
+	if err := cvsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CertifyVEXStatement, *CertifyVEXStatementQuery]()
+	return withInterceptors[[]*CertifyVEXStatement](ctx, cvsq, qr, cvsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) AllX(ctx context.Context) []*CertifyVEXStatement {
+	nodes, err := cvsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CertifyVEXStatement IDs.
+func (cvsq *CertifyVEXStatementQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cvsq.ctx.Unique == nil && cvsq.path != nil {
+		cvsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cvsq.ctx, "IDs")
+	if err = cvsq.Select(certifyvexstatement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) IDsX(ctx context.Context) []int {
+	ids, err := cvsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cvsq *CertifyVEXStatementQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cvsq.ctx, "Count")
+	if err := cvsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cvsq, querierCount[*CertifyVEXStatementQuery](), cvsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) CountX(ctx context.Context) int {
+	count, err := cvsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cvsq *CertifyVEXStatementQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cvsq.ctx, "Exist")
+	switch _, err := cvsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cvsq *CertifyVEXStatementQuery) ExistX(ctx context.Context) bool {
+	exist, err := cvsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CertifyVEXStatementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cvsq *CertifyVEXStatementQuery) Clone() *CertifyVEXStatementQuery {
+	if cvsq == nil {
+		return nil
+	}
+	return &CertifyVEXStatementQuery{
+		config:            cvsq.config,
+		ctx:               cvsq.ctx.Clone(),
+		order:             append([]OrderFunc{}, cvsq.order...),
+		inters:            append([]Interceptor{}, cvsq.inters...),
+		predicates:        append([]predicate.CertifyVEXStatement{}, cvsq.predicates...),
+		withPackage:       cvsq.withPackage.Clone(),
+		withArtifact:      cvsq.withArtifact.Clone(),
+		withVulnerability: cvsq.withVulnerability.Clone(),
+		// clone intermediate query.
+		sql:  cvsq.sql.Clone(),
+		path: cvsq.path,
+	}
+}
+
+// WithPackage tells the query-builder to eager-load the nodes that are connected to
+// the "package" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvsq *CertifyVEXStatementQuery) WithPackage(opts ...func(*PackageVersionQuery)) *CertifyVEXStatementQuery {
+	query := (&PackageVersionClient{config: cvsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvsq.withPackage = query
+	return cvsq
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvsq *CertifyVEXStatementQuery) WithArtifact(opts ...func(*ArtifactQuery)) *CertifyVEXStatementQuery {
+	query := (&ArtifactClient{config: cvsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvsq.withArtifact = query
+	return cvsq
+}
+
+// WithVulnerability tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvsq *CertifyVEXStatementQuery) WithVulnerability(opts ...func(*VulnerabilityIDQuery)) *CertifyVEXStatementQuery {
+	query := (&VulnerabilityIDClient{config: cvsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvsq.withVulnerability = query
+	return cvsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CertifyVEXStatement.Query().
+//		GroupBy(certifyvexstatement.FieldPackageID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (cvsq *CertifyVEXStatementQuery) GroupBy(field string, fields ...string) *CertifyVEXStatementGroupBy {
+	cvsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CertifyVEXStatementGroupBy{build: cvsq}
+	grbuild.flds = &cvsq.ctx.Fields
+	grbuild.label = certifyvexstatement.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//	}
+//
+//	client.CertifyVEXStatement.Query().
+//		Select(certifyvexstatement.FieldPackageID).
+//		Scan(ctx, &v)
+func (cvsq *CertifyVEXStatementQuery) Select(fields ...string) *CertifyVEXStatementSelect {
+	cvsq.ctx.Fields = append(cvsq.ctx.Fields, fields...)
+	sbuild := &CertifyVEXStatementSelect{CertifyVEXStatementQuery: cvsq}
+	sbuild.label = certifyvexstatement.Label
+	sbuild.flds, sbuild.scan = &cvsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CertifyVEXStatementSelect configured with the given aggregations.
+func (cvsq *CertifyVEXStatementQuery) Aggregate(fns ...AggregateFunc) *CertifyVEXStatementSelect {
+	return cvsq.Select().Aggregate(fns...)
+}
+
+func (cvsq *CertifyVEXStatementQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cvsq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cvsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cvsq.ctx.Fields {
+		if !certifyvexstatement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if cvsq.path != nil {
+		prev, err := cvsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cvsq.sql = prev
+	}
+	return nil
+}
+
+func (cvsq *CertifyVEXStatementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CertifyVEXStatement, error) {
+	var (
+		nodes       = []*CertifyVEXStatement{}
+		_spec       = cvsq.querySpec()
+		loadedTypes = [3]bool{
+			cvsq.withPackage != nil,
+			cvsq.withArtifact != nil,
+			cvsq.withVulnerability != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CertifyVEXStatement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CertifyVEXStatement{config: cvsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cvsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cvsq.withPackage; query != nil {
+		if err := cvsq.loadPackage(ctx, query, nodes, nil,
+			func(n *CertifyVEXStatement, e *PackageVersion) { n.Edges.Package = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cvsq.withArtifact; query != nil {
+		if err := cvsq.loadArtifact(ctx, query, nodes, nil,
+			func(n *CertifyVEXStatement, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cvsq.withVulnerability; query != nil {
+		if err := cvsq.loadVulnerability(ctx, query, nodes, nil,
+			func(n *CertifyVEXStatement, e *VulnerabilityID) { n.Edges.Vulnerability = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cvsq *CertifyVEXStatementQuery) loadPackage(ctx context.Context, query *PackageVersionQuery, nodes []*CertifyVEXStatement, init func(*CertifyVEXStatement), assign func(*CertifyVEXStatement, *PackageVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyVEXStatement)
+	for i := range nodes {
+		if nodes[i].PackageID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cvsq *CertifyVEXStatementQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*CertifyVEXStatement, init func(*CertifyVEXStatement), assign func(*CertifyVEXStatement, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyVEXStatement)
+	for i := range nodes {
+		if nodes[i].ArtifactID == nil {
+			continue
+		}
+		fk := *nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cvsq *CertifyVEXStatementQuery) loadVulnerability(ctx context.Context, query *VulnerabilityIDQuery, nodes []*CertifyVEXStatement, init func(*CertifyVEXStatement), assign func(*CertifyVEXStatement, *VulnerabilityID)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CertifyVEXStatement)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cvsq *CertifyVEXStatementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cvsq.querySpec()
+	_spec.Node.Columns = cvsq.ctx.Fields
+	if len(cvsq.ctx.Fields) > 0 {
+		_spec.Unique = cvsq.ctx.Unique != nil && *cvsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cvsq.driver, _spec)
+}
+
+func (cvsq *CertifyVEXStatementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(certifyvexstatement.Table, certifyvexstatement.Columns, sqlgraph.NewFieldSpec(certifyvexstatement.FieldID, field.TypeInt))
+	_spec.From = cvsq.sql
+	if unique := cvsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cvsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cvsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifyvexstatement.FieldID)
+		for i := range fields {
+			if fields[i] != certifyvexstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cvsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cvsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cvsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cvsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cvsq *CertifyVEXStatementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cvsq.driver.Dialect())
+	t1 := builder.Table(certifyvexstatement.Table)
+	columns := cvsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = certifyvexstatement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cvsq.sql != nil {
+		selector = cvsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cvsq.ctx.Unique != nil && *cvsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cvsq.predicates {
+		p(selector)
+	}
+	for _, p := range cvsq.order {
+		p(selector)
+	}
+	if offset := cvsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cvsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CertifyVEXStatementGroupBy is the group-by builder for CertifyVEXStatement entities.
+type CertifyVEXStatementGroupBy struct {
+	selector
+	build *CertifyVEXStatementQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cvsgb *CertifyVEXStatementGroupBy) Aggregate(fns ...AggregateFunc) *CertifyVEXStatementGroupBy {
+	cvsgb.fns = append(cvsgb.fns, fns...)
+	return cvsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvsgb *CertifyVEXStatementGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvsgb.build.ctx, "GroupBy")
+	if err := cvsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyVEXStatementQuery, *CertifyVEXStatementGroupBy](ctx, cvsgb.build, cvsgb, cvsgb.build.inters, v)
+}
+
+func (cvsgb *CertifyVEXStatementGroupBy) sqlScan(ctx context.Context, root *CertifyVEXStatementQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cvsgb.fns))
+	for _, fn := range cvsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cvsgb.flds)+len(cvsgb.fns))
+		for _, f := range *cvsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cvsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CertifyVEXStatementSelect is the builder for selecting fields of CertifyVEXStatement entities.
+type CertifyVEXStatementSelect struct {
+	*CertifyVEXStatementQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cvss *CertifyVEXStatementSelect) Aggregate(fns ...AggregateFunc) *CertifyVEXStatementSelect {
+	cvss.fns = append(cvss.fns, fns...)
+	return cvss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvss *CertifyVEXStatementSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvss.ctx, "Select")
+	if err := cvss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyVEXStatementQuery, *CertifyVEXStatementSelect](ctx, cvss.CertifyVEXStatementQuery, cvss, cvss.inters, v)
+}
+
+func (cvss *CertifyVEXStatementSelect) sqlScan(ctx context.Context, root *CertifyVEXStatementQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cvss.fns))
+	for _, fn := range cvss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cvss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// CertifyVEXStatementUpdate is the builder for updating CertifyVEXStatement entities.
+type CertifyVEXStatementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertifyVEXStatementMutation
+}
+
+// Where appends a list predicates to the CertifyVEXStatementUpdate builder.
+func (cvsu *CertifyVEXStatementUpdate) Where(ps ...predicate.CertifyVEXStatement) *CertifyVEXStatementUpdate {
+	cvsu.mutation.Where(ps...)
+	return cvsu
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvsu *CertifyVEXStatementUpdate) SetPackageID(i int) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetPackageID(i)
+	return cvsu
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (cvsu *CertifyVEXStatementUpdate) SetNillablePackageID(i *int) *CertifyVEXStatementUpdate {
+	if i != nil {
+		cvsu.SetPackageID(*i)
+	}
+	return cvsu
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (cvsu *CertifyVEXStatementUpdate) ClearPackageID() *CertifyVEXStatementUpdate {
+	cvsu.mutation.ClearPackageID()
+	return cvsu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cvsu *CertifyVEXStatementUpdate) SetArtifactID(i int) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetArtifactID(i)
+	return cvsu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cvsu *CertifyVEXStatementUpdate) SetNillableArtifactID(i *int) *CertifyVEXStatementUpdate {
+	if i != nil {
+		cvsu.SetArtifactID(*i)
+	}
+	return cvsu
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cvsu *CertifyVEXStatementUpdate) ClearArtifactID() *CertifyVEXStatementUpdate {
+	cvsu.mutation.ClearArtifactID()
+	return cvsu
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvsu *CertifyVEXStatementUpdate) SetVulnerabilityID(i int) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetVulnerabilityID(i)
+	return cvsu
+}
+
+// SetStatus sets the "status" field.
+func (cvsu *CertifyVEXStatementUpdate) SetStatus(ms model.VexStatus) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetStatus(ms)
+	return cvsu
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (cvsu *CertifyVEXStatementUpdate) SetVexJustification(mj model.VexJustification) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetVexJustification(mj)
+	return cvsu
+}
+
+// SetStatement sets the "statement" field.
+func (cvsu *CertifyVEXStatementUpdate) SetStatement(s string) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetStatement(s)
+	return cvsu
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (cvsu *CertifyVEXStatementUpdate) SetStatementHash(s string) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetStatementHash(s)
+	return cvsu
+}
+
+// SetKnownSince sets the "known_since" field.
+func (cvsu *CertifyVEXStatementUpdate) SetKnownSince(t time.Time) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetKnownSince(t)
+	return cvsu
+}
+
+// SetOrigin sets the "origin" field.
+func (cvsu *CertifyVEXStatementUpdate) SetOrigin(s string) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetOrigin(s)
+	return cvsu
+}
+
+// SetCollector sets the "collector" field.
+func (cvsu *CertifyVEXStatementUpdate) SetCollector(s string) *CertifyVEXStatementUpdate {
+	cvsu.mutation.SetCollector(s)
+	return cvsu
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvsu *CertifyVEXStatementUpdate) SetPackage(p *PackageVersion) *CertifyVEXStatementUpdate {
+	return cvsu.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cvsu *CertifyVEXStatementUpdate) SetArtifact(a *Artifact) *CertifyVEXStatementUpdate {
+	return cvsu.SetArtifactID(a.ID)
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvsu *CertifyVEXStatementUpdate) SetVulnerability(v *VulnerabilityID) *CertifyVEXStatementUpdate {
+	return cvsu.SetVulnerabilityID(v.ID)
+}
+
+// Mutation returns the CertifyVEXStatementMutation object of the builder.
+func (cvsu *CertifyVEXStatementUpdate) Mutation() *CertifyVEXStatementMutation {
+	return cvsu.mutation
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (cvsu *CertifyVEXStatementUpdate) ClearPackage() *CertifyVEXStatementUpdate {
+	cvsu.mutation.ClearPackage()
+	return cvsu
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cvsu *CertifyVEXStatementUpdate) ClearArtifact() *CertifyVEXStatementUpdate {
+	cvsu.mutation.ClearArtifact()
+	return cvsu
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (cvsu *CertifyVEXStatementUpdate) ClearVulnerability() *CertifyVEXStatementUpdate {
+	cvsu.mutation.ClearVulnerability()
+	return cvsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cvsu *CertifyVEXStatementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, CertifyVEXStatementMutation](ctx, cvsu.sqlSave, cvsu.mutation, cvsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvsu *CertifyVEXStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := cvsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cvsu *CertifyVEXStatementUpdate) Exec(ctx context.Context) error {
+	_, err := cvsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvsu *CertifyVEXStatementUpdate) ExecX(ctx context.Context) {
+	if err := cvsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvsu *CertifyVEXStatementUpdate) check() error {
+	if _, ok := cvsu.mutation.VulnerabilityID(); cvsu.mutation.VulnerabilityCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "CertifyVEXStatement.vulnerability"`)
+	}
+	return nil
+}
+
+func (cvsu *CertifyVEXStatementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cvsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifyvexstatement.Table, certifyvexstatement.Columns, sqlgraph.NewFieldSpec(certifyvexstatement.FieldID, field.TypeInt))
+	if ps := cvsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvsu.mutation.Status(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cvsu.mutation.VexJustification(); ok {
+		_spec.SetField(certifyvexstatement.FieldVexJustification, field.TypeString, value)
+	}
+	if value, ok := cvsu.mutation.Statement(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatement, field.TypeString, value)
+	}
+	if value, ok := cvsu.mutation.StatementHash(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatementHash, field.TypeString, value)
+	}
+	if value, ok := cvsu.mutation.KnownSince(); ok {
+		_spec.SetField(certifyvexstatement.FieldKnownSince, field.TypeTime, value)
+	}
+	if value, ok := cvsu.mutation.Origin(); ok {
+		_spec.SetField(certifyvexstatement.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cvsu.mutation.Collector(); ok {
+		_spec.SetField(certifyvexstatement.FieldCollector, field.TypeString, value)
+	}
+	if cvsu.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.PackageTable,
+			Columns: []string{certifyvexstatement.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsu.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.PackageTable,
+			Columns: []string{certifyvexstatement.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvsu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.ArtifactTable,
+			Columns: []string{certifyvexstatement.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.ArtifactTable,
+			Columns: []string{certifyvexstatement.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvsu.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.VulnerabilityTable,
+			Columns: []string{certifyvexstatement.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsu.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.VulnerabilityTable,
+			Columns: []string{certifyvexstatement.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cvsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifyvexstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cvsu.mutation.done = true
+	return n, nil
+}
+
+// CertifyVEXStatementUpdateOne is the builder for updating a single CertifyVEXStatement entity.
+type CertifyVEXStatementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertifyVEXStatementMutation
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetPackageID(i int) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetPackageID(i)
+	return cvsuo
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetNillablePackageID(i *int) *CertifyVEXStatementUpdateOne {
+	if i != nil {
+		cvsuo.SetPackageID(*i)
+	}
+	return cvsuo
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) ClearPackageID() *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.ClearPackageID()
+	return cvsuo
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetArtifactID(i int) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetArtifactID(i)
+	return cvsuo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetNillableArtifactID(i *int) *CertifyVEXStatementUpdateOne {
+	if i != nil {
+		cvsuo.SetArtifactID(*i)
+	}
+	return cvsuo
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) ClearArtifactID() *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.ClearArtifactID()
+	return cvsuo
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetVulnerabilityID(i int) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetVulnerabilityID(i)
+	return cvsuo
+}
+
+// SetStatus sets the "status" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetStatus(ms model.VexStatus) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetStatus(ms)
+	return cvsuo
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetVexJustification(mj model.VexJustification) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetVexJustification(mj)
+	return cvsuo
+}
+
+// SetStatement sets the "statement" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetStatement(s string) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetStatement(s)
+	return cvsuo
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetStatementHash(s string) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetStatementHash(s)
+	return cvsuo
+}
+
+// SetKnownSince sets the "known_since" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetKnownSince(t time.Time) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetKnownSince(t)
+	return cvsuo
+}
+
+// SetOrigin sets the "origin" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetOrigin(s string) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetOrigin(s)
+	return cvsuo
+}
+
+// SetCollector sets the "collector" field.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetCollector(s string) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.SetCollector(s)
+	return cvsuo
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetPackage(p *PackageVersion) *CertifyVEXStatementUpdateOne {
+	return cvsuo.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetArtifact(a *Artifact) *CertifyVEXStatementUpdateOne {
+	return cvsuo.SetArtifactID(a.ID)
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) SetVulnerability(v *VulnerabilityID) *CertifyVEXStatementUpdateOne {
+	return cvsuo.SetVulnerabilityID(v.ID)
+}
+
+// Mutation returns the CertifyVEXStatementMutation object of the builder.
+func (cvsuo *CertifyVEXStatementUpdateOne) Mutation() *CertifyVEXStatementMutation {
+	return cvsuo.mutation
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) ClearPackage() *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.ClearPackage()
+	return cvsuo
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) ClearArtifact() *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.ClearArtifact()
+	return cvsuo
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) ClearVulnerability() *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.ClearVulnerability()
+	return cvsuo
+}
+
+// Where appends a list predicates to the CertifyVEXStatementUpdate builder.
+func (cvsuo *CertifyVEXStatementUpdateOne) Where(ps ...predicate.CertifyVEXStatement) *CertifyVEXStatementUpdateOne {
+	cvsuo.mutation.Where(ps...)
+	return cvsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cvsuo *CertifyVEXStatementUpdateOne) Select(field string, fields ...string) *CertifyVEXStatementUpdateOne {
+	cvsuo.fields = append([]string{field}, fields...)
+	return cvsuo
+}
+
+// Save executes the query and returns the updated CertifyVEXStatement entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) Save(ctx context.Context) (*CertifyVEXStatement, error) {
+	return withHooks[*CertifyVEXStatement, CertifyVEXStatementMutation](ctx, cvsuo.sqlSave, cvsuo.mutation, cvsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvsuo *CertifyVEXStatementUpdateOne) SaveX(ctx context.Context) *CertifyVEXStatement {
+	node, err := cvsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cvsuo *CertifyVEXStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := cvsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvsuo *CertifyVEXStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := cvsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvsuo *CertifyVEXStatementUpdateOne) check() error {
+	if _, ok := cvsuo.mutation.VulnerabilityID(); cvsuo.mutation.VulnerabilityCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "CertifyVEXStatement.vulnerability"`)
+	}
+	return nil
+}
+
+func (cvsuo *CertifyVEXStatementUpdateOne) sqlSave(ctx context.Context) (_node *CertifyVEXStatement, err error) {
+	if err := cvsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifyvexstatement.Table, certifyvexstatement.Columns, sqlgraph.NewFieldSpec(certifyvexstatement.FieldID, field.TypeInt))
+	id, ok := cvsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "CertifyVEXStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cvsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifyvexstatement.FieldID)
+		for _, f := range fields {
+			if !certifyvexstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != certifyvexstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cvsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvsuo.mutation.Status(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cvsuo.mutation.VexJustification(); ok {
+		_spec.SetField(certifyvexstatement.FieldVexJustification, field.TypeString, value)
+	}
+	if value, ok := cvsuo.mutation.Statement(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatement, field.TypeString, value)
+	}
+	if value, ok := cvsuo.mutation.StatementHash(); ok {
+		_spec.SetField(certifyvexstatement.FieldStatementHash, field.TypeString, value)
+	}
+	if value, ok := cvsuo.mutation.KnownSince(); ok {
+		_spec.SetField(certifyvexstatement.FieldKnownSince, field.TypeTime, value)
+	}
+	if value, ok := cvsuo.mutation.Origin(); ok {
+		_spec.SetField(certifyvexstatement.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cvsuo.mutation.Collector(); ok {
+		_spec.SetField(certifyvexstatement.FieldCollector, field.TypeString, value)
+	}
+	if cvsuo.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.PackageTable,
+			Columns: []string{certifyvexstatement.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsuo.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.PackageTable,
+			Columns: []string{certifyvexstatement.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvsuo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.ArtifactTable,
+			Columns: []string{certifyvexstatement.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsuo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.ArtifactTable,
+			Columns: []string{certifyvexstatement.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvsuo.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.VulnerabilityTable,
+			Columns: []string{certifyvexstatement.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvsuo.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvexstatement.VulnerabilityTable,
+			Columns: []string{certifyvexstatement.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CertifyVEXStatement{config: cvsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cvsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifyvexstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cvsuo.mutation.done = true
+	return _node, nil
+}
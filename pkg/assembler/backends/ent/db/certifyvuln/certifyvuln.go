@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifyvuln
+
+const (
+	// Label holds the string label denoting the certifyvuln type in the database.
+	Label = "certify_vuln"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
+	FieldVulnerabilityID = "vulnerability_id"
+	// FieldTimeScanned holds the string denoting the time_scanned field in the database.
+	FieldTimeScanned = "time_scanned"
+	// FieldDbURI holds the string denoting the db_uri field in the database.
+	FieldDbURI = "db_uri"
+	// FieldDbVersion holds the string denoting the db_version field in the database.
+	FieldDbVersion = "db_version"
+	// FieldScannerURI holds the string denoting the scanner_uri field in the database.
+	FieldScannerURI = "scanner_uri"
+	// FieldScannerVersion holds the string denoting the scanner_version field in the database.
+	FieldScannerVersion = "scanner_version"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// EdgeVulnerability holds the string denoting the vulnerability edge name in mutations.
+	EdgeVulnerability = "vulnerability"
+	// Table holds the table name of the certifyvuln in the database.
+	Table = "certify_vulns"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "certify_vulns"
+	// PackageInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageInverseTable = "package_versions"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_id"
+	// VulnerabilityTable is the table that holds the vulnerability relation/edge.
+	VulnerabilityTable = "certify_vulns"
+	// VulnerabilityInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityInverseTable = "vulnerability_ids"
+	// VulnerabilityColumn is the table column denoting the vulnerability relation/edge.
+	VulnerabilityColumn = "vulnerability_id"
+)
+
+// Columns holds all SQL columns for certifyvuln fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldVulnerabilityID,
+	FieldTimeScanned,
+	FieldDbURI,
+	FieldDbVersion,
+	FieldScannerURI,
+	FieldScannerVersion,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
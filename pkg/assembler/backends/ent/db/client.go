@@ -0,0 +1,4343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/migrate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isdependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isoccurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/scorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// Artifact is the client for interacting with the Artifact builders.
+	Artifact *ArtifactClient
+	// BuilderNode is the client for interacting with the BuilderNode builders.
+	BuilderNode *BuilderNodeClient
+	// CertifyBad is the client for interacting with the CertifyBad builders.
+	CertifyBad *CertifyBadClient
+	// CertifyGood is the client for interacting with the CertifyGood builders.
+	CertifyGood *CertifyGoodClient
+	// CertifyLegal is the client for interacting with the CertifyLegal builders.
+	CertifyLegal *CertifyLegalClient
+	// CertifyVEXStatement is the client for interacting with the CertifyVEXStatement builders.
+	CertifyVEXStatement *CertifyVEXStatementClient
+	// CertifyVuln is the client for interacting with the CertifyVuln builders.
+	CertifyVuln *CertifyVulnClient
+	// HasMetadata is the client for interacting with the HasMetadata builders.
+	HasMetadata *HasMetadataClient
+	// HasSBOM is the client for interacting with the HasSBOM builders.
+	HasSBOM *HasSBOMClient
+	// HasSLSA is the client for interacting with the HasSLSA builders.
+	HasSLSA *HasSLSAClient
+	// HashEqual is the client for interacting with the HashEqual builders.
+	HashEqual *HashEqualClient
+	// IsDependency is the client for interacting with the IsDependency builders.
+	IsDependency *IsDependencyClient
+	// IsOccurrence is the client for interacting with the IsOccurrence builders.
+	IsOccurrence *IsOccurrenceClient
+	// PackageName is the client for interacting with the PackageName builders.
+	PackageName *PackageNameClient
+	// PackageNamespace is the client for interacting with the PackageNamespace builders.
+	PackageNamespace *PackageNamespaceClient
+	// PackageType is the client for interacting with the PackageType builders.
+	PackageType *PackageTypeClient
+	// PackageVersion is the client for interacting with the PackageVersion builders.
+	PackageVersion *PackageVersionClient
+	// PkgEqual is the client for interacting with the PkgEqual builders.
+	PkgEqual *PkgEqualClient
+	// PointOfContact is the client for interacting with the PointOfContact builders.
+	PointOfContact *PointOfContactClient
+	// Scorecard is the client for interacting with the Scorecard builders.
+	Scorecard *ScorecardClient
+	// SourceName is the client for interacting with the SourceName builders.
+	SourceName *SourceNameClient
+	// SourceNamespace is the client for interacting with the SourceNamespace builders.
+	SourceNamespace *SourceNamespaceClient
+	// SourceType is the client for interacting with the SourceType builders.
+	SourceType *SourceTypeClient
+	// VulnEqual is the client for interacting with the VulnEqual builders.
+	VulnEqual *VulnEqualClient
+	// VulnerabilityID is the client for interacting with the VulnerabilityID builders.
+	VulnerabilityID *VulnerabilityIDClient
+	// VulnerabilityType is the client for interacting with the VulnerabilityType builders.
+	VulnerabilityType *VulnerabilityTypeClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.Artifact = NewArtifactClient(c.config)
+	c.BuilderNode = NewBuilderNodeClient(c.config)
+	c.CertifyBad = NewCertifyBadClient(c.config)
+	c.CertifyGood = NewCertifyGoodClient(c.config)
+	c.CertifyLegal = NewCertifyLegalClient(c.config)
+	c.CertifyVEXStatement = NewCertifyVEXStatementClient(c.config)
+	c.CertifyVuln = NewCertifyVulnClient(c.config)
+	c.HasMetadata = NewHasMetadataClient(c.config)
+	c.HasSBOM = NewHasSBOMClient(c.config)
+	c.HasSLSA = NewHasSLSAClient(c.config)
+	c.HashEqual = NewHashEqualClient(c.config)
+	c.IsDependency = NewIsDependencyClient(c.config)
+	c.IsOccurrence = NewIsOccurrenceClient(c.config)
+	c.PackageName = NewPackageNameClient(c.config)
+	c.PackageNamespace = NewPackageNamespaceClient(c.config)
+	c.PackageType = NewPackageTypeClient(c.config)
+	c.PackageVersion = NewPackageVersionClient(c.config)
+	c.PkgEqual = NewPkgEqualClient(c.config)
+	c.PointOfContact = NewPointOfContactClient(c.config)
+	c.Scorecard = NewScorecardClient(c.config)
+	c.SourceName = NewSourceNameClient(c.config)
+	c.SourceNamespace = NewSourceNamespaceClient(c.config)
+	c.SourceType = NewSourceTypeClient(c.config)
+	c.VulnEqual = NewVulnEqualClient(c.config)
+	c.VulnerabilityID = NewVulnerabilityIDClient(c.config)
+	c.VulnerabilityType = NewVulnerabilityTypeClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("db: cannot start a transaction within a transaction")
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("db: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                 ctx,
+		config:              cfg,
+		Artifact:            NewArtifactClient(cfg),
+		BuilderNode:         NewBuilderNodeClient(cfg),
+		CertifyBad:          NewCertifyBadClient(cfg),
+		CertifyGood:         NewCertifyGoodClient(cfg),
+		CertifyLegal:        NewCertifyLegalClient(cfg),
+		CertifyVEXStatement: NewCertifyVEXStatementClient(cfg),
+		CertifyVuln:         NewCertifyVulnClient(cfg),
+		HasMetadata:         NewHasMetadataClient(cfg),
+		HasSBOM:             NewHasSBOMClient(cfg),
+		HasSLSA:             NewHasSLSAClient(cfg),
+		HashEqual:           NewHashEqualClient(cfg),
+		IsDependency:        NewIsDependencyClient(cfg),
+		IsOccurrence:        NewIsOccurrenceClient(cfg),
+		PackageName:         NewPackageNameClient(cfg),
+		PackageNamespace:    NewPackageNamespaceClient(cfg),
+		PackageType:         NewPackageTypeClient(cfg),
+		PackageVersion:      NewPackageVersionClient(cfg),
+		PkgEqual:            NewPkgEqualClient(cfg),
+		PointOfContact:      NewPointOfContactClient(cfg),
+		Scorecard:           NewScorecardClient(cfg),
+		SourceName:          NewSourceNameClient(cfg),
+		SourceNamespace:     NewSourceNamespaceClient(cfg),
+		SourceType:          NewSourceTypeClient(cfg),
+		VulnEqual:           NewVulnEqualClient(cfg),
+		VulnerabilityID:     NewVulnerabilityIDClient(cfg),
+		VulnerabilityType:   NewVulnerabilityTypeClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                 ctx,
+		config:              cfg,
+		Artifact:            NewArtifactClient(cfg),
+		BuilderNode:         NewBuilderNodeClient(cfg),
+		CertifyBad:          NewCertifyBadClient(cfg),
+		CertifyGood:         NewCertifyGoodClient(cfg),
+		CertifyLegal:        NewCertifyLegalClient(cfg),
+		CertifyVEXStatement: NewCertifyVEXStatementClient(cfg),
+		CertifyVuln:         NewCertifyVulnClient(cfg),
+		HasMetadata:         NewHasMetadataClient(cfg),
+		HasSBOM:             NewHasSBOMClient(cfg),
+		HasSLSA:             NewHasSLSAClient(cfg),
+		HashEqual:           NewHashEqualClient(cfg),
+		IsDependency:        NewIsDependencyClient(cfg),
+		IsOccurrence:        NewIsOccurrenceClient(cfg),
+		PackageName:         NewPackageNameClient(cfg),
+		PackageNamespace:    NewPackageNamespaceClient(cfg),
+		PackageType:         NewPackageTypeClient(cfg),
+		PackageVersion:      NewPackageVersionClient(cfg),
+		PkgEqual:            NewPkgEqualClient(cfg),
+		PointOfContact:      NewPointOfContactClient(cfg),
+		Scorecard:           NewScorecardClient(cfg),
+		SourceName:          NewSourceNameClient(cfg),
+		SourceNamespace:     NewSourceNamespaceClient(cfg),
+		SourceType:          NewSourceTypeClient(cfg),
+		VulnEqual:           NewVulnEqualClient(cfg),
+		VulnerabilityID:     NewVulnerabilityIDClient(cfg),
+		VulnerabilityType:   NewVulnerabilityTypeClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Artifact.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Artifact, c.BuilderNode, c.CertifyBad, c.CertifyGood, c.CertifyLegal,
+		c.CertifyVEXStatement, c.CertifyVuln, c.HasMetadata, c.HasSBOM, c.HasSLSA,
+		c.HashEqual, c.IsDependency, c.IsOccurrence, c.PackageName, c.PackageNamespace,
+		c.PackageType, c.PackageVersion, c.PkgEqual, c.PointOfContact, c.Scorecard,
+		c.SourceName, c.SourceNamespace, c.SourceType, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityType,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Artifact, c.BuilderNode, c.CertifyBad, c.CertifyGood, c.CertifyLegal,
+		c.CertifyVEXStatement, c.CertifyVuln, c.HasMetadata, c.HasSBOM, c.HasSLSA,
+		c.HashEqual, c.IsDependency, c.IsOccurrence, c.PackageName, c.PackageNamespace,
+		c.PackageType, c.PackageVersion, c.PkgEqual, c.PointOfContact, c.Scorecard,
+		c.SourceName, c.SourceNamespace, c.SourceType, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityType,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *ArtifactMutation:
+		return c.Artifact.mutate(ctx, m)
+	case *BuilderNodeMutation:
+		return c.BuilderNode.mutate(ctx, m)
+	case *CertifyBadMutation:
+		return c.CertifyBad.mutate(ctx, m)
+	case *CertifyGoodMutation:
+		return c.CertifyGood.mutate(ctx, m)
+	case *CertifyLegalMutation:
+		return c.CertifyLegal.mutate(ctx, m)
+	case *CertifyVEXStatementMutation:
+		return c.CertifyVEXStatement.mutate(ctx, m)
+	case *CertifyVulnMutation:
+		return c.CertifyVuln.mutate(ctx, m)
+	case *HasMetadataMutation:
+		return c.HasMetadata.mutate(ctx, m)
+	case *HasSBOMMutation:
+		return c.HasSBOM.mutate(ctx, m)
+	case *HasSLSAMutation:
+		return c.HasSLSA.mutate(ctx, m)
+	case *HashEqualMutation:
+		return c.HashEqual.mutate(ctx, m)
+	case *IsDependencyMutation:
+		return c.IsDependency.mutate(ctx, m)
+	case *IsOccurrenceMutation:
+		return c.IsOccurrence.mutate(ctx, m)
+	case *PackageNameMutation:
+		return c.PackageName.mutate(ctx, m)
+	case *PackageNamespaceMutation:
+		return c.PackageNamespace.mutate(ctx, m)
+	case *PackageTypeMutation:
+		return c.PackageType.mutate(ctx, m)
+	case *PackageVersionMutation:
+		return c.PackageVersion.mutate(ctx, m)
+	case *PkgEqualMutation:
+		return c.PkgEqual.mutate(ctx, m)
+	case *PointOfContactMutation:
+		return c.PointOfContact.mutate(ctx, m)
+	case *ScorecardMutation:
+		return c.Scorecard.mutate(ctx, m)
+	case *SourceNameMutation:
+		return c.SourceName.mutate(ctx, m)
+	case *SourceNamespaceMutation:
+		return c.SourceNamespace.mutate(ctx, m)
+	case *SourceTypeMutation:
+		return c.SourceType.mutate(ctx, m)
+	case *VulnEqualMutation:
+		return c.VulnEqual.mutate(ctx, m)
+	case *VulnerabilityIDMutation:
+		return c.VulnerabilityID.mutate(ctx, m)
+	case *VulnerabilityTypeMutation:
+		return c.VulnerabilityType.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("db: unknown mutation type %T", m)
+	}
+}
+
+// ArtifactClient is a client for the Artifact schema.
+type ArtifactClient struct {
+	config
+}
+
+// NewArtifactClient returns a client for the Artifact from the given config.
+func NewArtifactClient(c config) *ArtifactClient {
+	return &ArtifactClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `artifact.Hooks(f(g(h())))`.
+func (c *ArtifactClient) Use(hooks ...Hook) {
+	c.hooks.Artifact = append(c.hooks.Artifact, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `artifact.Intercept(f(g(h())))`.
+func (c *ArtifactClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Artifact = append(c.inters.Artifact, interceptors...)
+}
+
+// Create returns a builder for creating a Artifact entity.
+func (c *ArtifactClient) Create() *ArtifactCreate {
+	mutation := newArtifactMutation(c.config, OpCreate)
+	return &ArtifactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Artifact entities.
+func (c *ArtifactClient) CreateBulk(builders ...*ArtifactCreate) *ArtifactCreateBulk {
+	return &ArtifactCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Artifact.
+func (c *ArtifactClient) Update() *ArtifactUpdate {
+	mutation := newArtifactMutation(c.config, OpUpdate)
+	return &ArtifactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArtifactClient) UpdateOne(a *Artifact) *ArtifactUpdateOne {
+	mutation := newArtifactMutation(c.config, OpUpdateOne, withArtifact(a))
+	return &ArtifactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArtifactClient) UpdateOneID(id int) *ArtifactUpdateOne {
+	mutation := newArtifactMutation(c.config, OpUpdateOne, withArtifactID(id))
+	return &ArtifactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Artifact.
+func (c *ArtifactClient) Delete() *ArtifactDelete {
+	mutation := newArtifactMutation(c.config, OpDelete)
+	return &ArtifactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArtifactClient) DeleteOne(a *Artifact) *ArtifactDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArtifactClient) DeleteOneID(id int) *ArtifactDeleteOne {
+	builder := c.Delete().Where(artifact.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArtifactDeleteOne{builder}
+}
+
+// Query returns a query builder for Artifact.
+func (c *ArtifactClient) Query() *ArtifactQuery {
+	return &ArtifactQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArtifact},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Artifact entity by its id.
+func (c *ArtifactClient) Get(ctx context.Context, id int) (*Artifact, error) {
+	return c.Query().Where(artifact.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArtifactClient) GetX(ctx context.Context, id int) *Artifact {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMaterialOf queries the material_of edge of a Artifact.
+func (c *ArtifactClient) QueryMaterialOf(a *Artifact) *HasSLSAQuery {
+	query := (&HasSLSAClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifact.Table, artifact.FieldID, id),
+			sqlgraph.To(hasslsa.Table, hasslsa.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, artifact.MaterialOfTable, artifact.MaterialOfPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArtifactClient) Hooks() []Hook {
+	return c.hooks.Artifact
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArtifactClient) Interceptors() []Interceptor {
+	return c.inters.Artifact
+}
+
+func (c *ArtifactClient) mutate(ctx context.Context, m *ArtifactMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArtifactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArtifactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArtifactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArtifactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Artifact mutation op: %q", m.Op())
+	}
+}
+
+// BuilderNodeClient is a client for the BuilderNode schema.
+type BuilderNodeClient struct {
+	config
+}
+
+// NewBuilderNodeClient returns a client for the BuilderNode from the given config.
+func NewBuilderNodeClient(c config) *BuilderNodeClient {
+	return &BuilderNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `buildernode.Hooks(f(g(h())))`.
+func (c *BuilderNodeClient) Use(hooks ...Hook) {
+	c.hooks.BuilderNode = append(c.hooks.BuilderNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `buildernode.Intercept(f(g(h())))`.
+func (c *BuilderNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BuilderNode = append(c.inters.BuilderNode, interceptors...)
+}
+
+// Create returns a builder for creating a BuilderNode entity.
+func (c *BuilderNodeClient) Create() *BuilderNodeCreate {
+	mutation := newBuilderNodeMutation(c.config, OpCreate)
+	return &BuilderNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BuilderNode entities.
+func (c *BuilderNodeClient) CreateBulk(builders ...*BuilderNodeCreate) *BuilderNodeCreateBulk {
+	return &BuilderNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BuilderNode.
+func (c *BuilderNodeClient) Update() *BuilderNodeUpdate {
+	mutation := newBuilderNodeMutation(c.config, OpUpdate)
+	return &BuilderNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BuilderNodeClient) UpdateOne(bn *BuilderNode) *BuilderNodeUpdateOne {
+	mutation := newBuilderNodeMutation(c.config, OpUpdateOne, withBuilderNode(bn))
+	return &BuilderNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BuilderNodeClient) UpdateOneID(id int) *BuilderNodeUpdateOne {
+	mutation := newBuilderNodeMutation(c.config, OpUpdateOne, withBuilderNodeID(id))
+	return &BuilderNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BuilderNode.
+func (c *BuilderNodeClient) Delete() *BuilderNodeDelete {
+	mutation := newBuilderNodeMutation(c.config, OpDelete)
+	return &BuilderNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BuilderNodeClient) DeleteOne(bn *BuilderNode) *BuilderNodeDeleteOne {
+	return c.DeleteOneID(bn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BuilderNodeClient) DeleteOneID(id int) *BuilderNodeDeleteOne {
+	builder := c.Delete().Where(buildernode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BuilderNodeDeleteOne{builder}
+}
+
+// Query returns a query builder for BuilderNode.
+func (c *BuilderNodeClient) Query() *BuilderNodeQuery {
+	return &BuilderNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBuilderNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BuilderNode entity by its id.
+func (c *BuilderNodeClient) Get(ctx context.Context, id int) (*BuilderNode, error) {
+	return c.Query().Where(buildernode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BuilderNodeClient) GetX(ctx context.Context, id int) *BuilderNode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BuilderNodeClient) Hooks() []Hook {
+	return c.hooks.BuilderNode
+}
+
+// Interceptors returns the client interceptors.
+func (c *BuilderNodeClient) Interceptors() []Interceptor {
+	return c.inters.BuilderNode
+}
+
+func (c *BuilderNodeClient) mutate(ctx context.Context, m *BuilderNodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BuilderNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BuilderNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BuilderNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BuilderNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown BuilderNode mutation op: %q", m.Op())
+	}
+}
+
+// CertifyBadClient is a client for the CertifyBad schema.
+type CertifyBadClient struct {
+	config
+}
+
+// NewCertifyBadClient returns a client for the CertifyBad from the given config.
+func NewCertifyBadClient(c config) *CertifyBadClient {
+	return &CertifyBadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifybad.Hooks(f(g(h())))`.
+func (c *CertifyBadClient) Use(hooks ...Hook) {
+	c.hooks.CertifyBad = append(c.hooks.CertifyBad, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifybad.Intercept(f(g(h())))`.
+func (c *CertifyBadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyBad = append(c.inters.CertifyBad, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyBad entity.
+func (c *CertifyBadClient) Create() *CertifyBadCreate {
+	mutation := newCertifyBadMutation(c.config, OpCreate)
+	return &CertifyBadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyBad entities.
+func (c *CertifyBadClient) CreateBulk(builders ...*CertifyBadCreate) *CertifyBadCreateBulk {
+	return &CertifyBadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyBad.
+func (c *CertifyBadClient) Update() *CertifyBadUpdate {
+	mutation := newCertifyBadMutation(c.config, OpUpdate)
+	return &CertifyBadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyBadClient) UpdateOne(cb *CertifyBad) *CertifyBadUpdateOne {
+	mutation := newCertifyBadMutation(c.config, OpUpdateOne, withCertifyBad(cb))
+	return &CertifyBadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyBadClient) UpdateOneID(id int) *CertifyBadUpdateOne {
+	mutation := newCertifyBadMutation(c.config, OpUpdateOne, withCertifyBadID(id))
+	return &CertifyBadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyBad.
+func (c *CertifyBadClient) Delete() *CertifyBadDelete {
+	mutation := newCertifyBadMutation(c.config, OpDelete)
+	return &CertifyBadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyBadClient) DeleteOne(cb *CertifyBad) *CertifyBadDeleteOne {
+	return c.DeleteOneID(cb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyBadClient) DeleteOneID(id int) *CertifyBadDeleteOne {
+	builder := c.Delete().Where(certifybad.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyBadDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyBad.
+func (c *CertifyBadClient) Query() *CertifyBadQuery {
+	return &CertifyBadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyBad},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyBad entity by its id.
+func (c *CertifyBadClient) Get(ctx context.Context, id int) (*CertifyBad, error) {
+	return c.Query().Where(certifybad.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyBadClient) GetX(ctx context.Context, id int) *CertifyBad {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackageVersion queries the package_version edge of a CertifyBad.
+func (c *CertifyBadClient) QueryPackageVersion(cb *CertifyBad) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.PackageVersionTable, certifybad.PackageVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(cb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackageName queries the package_name edge of a CertifyBad.
+func (c *CertifyBadClient) QueryPackageName(cb *CertifyBad) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.PackageNameTable, certifybad.PackageNameColumn),
+		)
+		fromV = sqlgraph.Neighbors(cb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a CertifyBad.
+func (c *CertifyBadClient) QuerySource(cb *CertifyBad) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.SourceTable, certifybad.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(cb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a CertifyBad.
+func (c *CertifyBadClient) QueryArtifact(cb *CertifyBad) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifybad.Table, certifybad.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifybad.ArtifactTable, certifybad.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(cb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyBadClient) Hooks() []Hook {
+	return c.hooks.CertifyBad
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyBadClient) Interceptors() []Interceptor {
+	return c.inters.CertifyBad
+}
+
+func (c *CertifyBadClient) mutate(ctx context.Context, m *CertifyBadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyBadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyBadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyBadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyBadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CertifyBad mutation op: %q", m.Op())
+	}
+}
+
+// CertifyGoodClient is a client for the CertifyGood schema.
+type CertifyGoodClient struct {
+	config
+}
+
+// NewCertifyGoodClient returns a client for the CertifyGood from the given config.
+func NewCertifyGoodClient(c config) *CertifyGoodClient {
+	return &CertifyGoodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifygood.Hooks(f(g(h())))`.
+func (c *CertifyGoodClient) Use(hooks ...Hook) {
+	c.hooks.CertifyGood = append(c.hooks.CertifyGood, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifygood.Intercept(f(g(h())))`.
+func (c *CertifyGoodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyGood = append(c.inters.CertifyGood, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyGood entity.
+func (c *CertifyGoodClient) Create() *CertifyGoodCreate {
+	mutation := newCertifyGoodMutation(c.config, OpCreate)
+	return &CertifyGoodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyGood entities.
+func (c *CertifyGoodClient) CreateBulk(builders ...*CertifyGoodCreate) *CertifyGoodCreateBulk {
+	return &CertifyGoodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyGood.
+func (c *CertifyGoodClient) Update() *CertifyGoodUpdate {
+	mutation := newCertifyGoodMutation(c.config, OpUpdate)
+	return &CertifyGoodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyGoodClient) UpdateOne(cg *CertifyGood) *CertifyGoodUpdateOne {
+	mutation := newCertifyGoodMutation(c.config, OpUpdateOne, withCertifyGood(cg))
+	return &CertifyGoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyGoodClient) UpdateOneID(id int) *CertifyGoodUpdateOne {
+	mutation := newCertifyGoodMutation(c.config, OpUpdateOne, withCertifyGoodID(id))
+	return &CertifyGoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyGood.
+func (c *CertifyGoodClient) Delete() *CertifyGoodDelete {
+	mutation := newCertifyGoodMutation(c.config, OpDelete)
+	return &CertifyGoodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyGoodClient) DeleteOne(cg *CertifyGood) *CertifyGoodDeleteOne {
+	return c.DeleteOneID(cg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyGoodClient) DeleteOneID(id int) *CertifyGoodDeleteOne {
+	builder := c.Delete().Where(certifygood.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyGoodDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyGood.
+func (c *CertifyGoodClient) Query() *CertifyGoodQuery {
+	return &CertifyGoodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyGood},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyGood entity by its id.
+func (c *CertifyGoodClient) Get(ctx context.Context, id int) (*CertifyGood, error) {
+	return c.Query().Where(certifygood.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyGoodClient) GetX(ctx context.Context, id int) *CertifyGood {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackageVersion queries the package_version edge of a CertifyGood.
+func (c *CertifyGoodClient) QueryPackageVersion(cg *CertifyGood) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.PackageVersionTable, certifygood.PackageVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(cg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackageName queries the package_name edge of a CertifyGood.
+func (c *CertifyGoodClient) QueryPackageName(cg *CertifyGood) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.PackageNameTable, certifygood.PackageNameColumn),
+		)
+		fromV = sqlgraph.Neighbors(cg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a CertifyGood.
+func (c *CertifyGoodClient) QuerySource(cg *CertifyGood) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.SourceTable, certifygood.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(cg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a CertifyGood.
+func (c *CertifyGoodClient) QueryArtifact(cg *CertifyGood) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifygood.Table, certifygood.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifygood.ArtifactTable, certifygood.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(cg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyGoodClient) Hooks() []Hook {
+	return c.hooks.CertifyGood
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyGoodClient) Interceptors() []Interceptor {
+	return c.inters.CertifyGood
+}
+
+func (c *CertifyGoodClient) mutate(ctx context.Context, m *CertifyGoodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyGoodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyGoodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyGoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyGoodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CertifyGood mutation op: %q", m.Op())
+	}
+}
+
+// CertifyLegalClient is a client for the CertifyLegal schema.
+type CertifyLegalClient struct {
+	config
+}
+
+// NewCertifyLegalClient returns a client for the CertifyLegal from the given config.
+func NewCertifyLegalClient(c config) *CertifyLegalClient {
+	return &CertifyLegalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifylegal.Hooks(f(g(h())))`.
+func (c *CertifyLegalClient) Use(hooks ...Hook) {
+	c.hooks.CertifyLegal = append(c.hooks.CertifyLegal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifylegal.Intercept(f(g(h())))`.
+func (c *CertifyLegalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyLegal = append(c.inters.CertifyLegal, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyLegal entity.
+func (c *CertifyLegalClient) Create() *CertifyLegalCreate {
+	mutation := newCertifyLegalMutation(c.config, OpCreate)
+	return &CertifyLegalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyLegal entities.
+func (c *CertifyLegalClient) CreateBulk(builders ...*CertifyLegalCreate) *CertifyLegalCreateBulk {
+	return &CertifyLegalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyLegal.
+func (c *CertifyLegalClient) Update() *CertifyLegalUpdate {
+	mutation := newCertifyLegalMutation(c.config, OpUpdate)
+	return &CertifyLegalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyLegalClient) UpdateOne(cl *CertifyLegal) *CertifyLegalUpdateOne {
+	mutation := newCertifyLegalMutation(c.config, OpUpdateOne, withCertifyLegal(cl))
+	return &CertifyLegalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyLegalClient) UpdateOneID(id int) *CertifyLegalUpdateOne {
+	mutation := newCertifyLegalMutation(c.config, OpUpdateOne, withCertifyLegalID(id))
+	return &CertifyLegalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyLegal.
+func (c *CertifyLegalClient) Delete() *CertifyLegalDelete {
+	mutation := newCertifyLegalMutation(c.config, OpDelete)
+	return &CertifyLegalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyLegalClient) DeleteOne(cl *CertifyLegal) *CertifyLegalDeleteOne {
+	return c.DeleteOneID(cl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyLegalClient) DeleteOneID(id int) *CertifyLegalDeleteOne {
+	builder := c.Delete().Where(certifylegal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyLegalDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyLegal.
+func (c *CertifyLegalClient) Query() *CertifyLegalQuery {
+	return &CertifyLegalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyLegal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyLegal entity by its id.
+func (c *CertifyLegalClient) Get(ctx context.Context, id int) (*CertifyLegal, error) {
+	return c.Query().Where(certifylegal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyLegalClient) GetX(ctx context.Context, id int) *CertifyLegal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a CertifyLegal.
+func (c *CertifyLegalClient) QueryPackage(cl *CertifyLegal) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifylegal.Table, certifylegal.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifylegal.PackageTable, certifylegal.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a CertifyLegal.
+func (c *CertifyLegalClient) QuerySource(cl *CertifyLegal) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifylegal.Table, certifylegal.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifylegal.SourceTable, certifylegal.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyLegalClient) Hooks() []Hook {
+	return c.hooks.CertifyLegal
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyLegalClient) Interceptors() []Interceptor {
+	return c.inters.CertifyLegal
+}
+
+func (c *CertifyLegalClient) mutate(ctx context.Context, m *CertifyLegalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyLegalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyLegalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyLegalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyLegalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CertifyLegal mutation op: %q", m.Op())
+	}
+}
+
+// CertifyVEXStatementClient is a client for the CertifyVEXStatement schema.
+type CertifyVEXStatementClient struct {
+	config
+}
+
+// NewCertifyVEXStatementClient returns a client for the CertifyVEXStatement from the given config.
+func NewCertifyVEXStatementClient(c config) *CertifyVEXStatementClient {
+	return &CertifyVEXStatementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifyvexstatement.Hooks(f(g(h())))`.
+func (c *CertifyVEXStatementClient) Use(hooks ...Hook) {
+	c.hooks.CertifyVEXStatement = append(c.hooks.CertifyVEXStatement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifyvexstatement.Intercept(f(g(h())))`.
+func (c *CertifyVEXStatementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyVEXStatement = append(c.inters.CertifyVEXStatement, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyVEXStatement entity.
+func (c *CertifyVEXStatementClient) Create() *CertifyVEXStatementCreate {
+	mutation := newCertifyVEXStatementMutation(c.config, OpCreate)
+	return &CertifyVEXStatementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyVEXStatement entities.
+func (c *CertifyVEXStatementClient) CreateBulk(builders ...*CertifyVEXStatementCreate) *CertifyVEXStatementCreateBulk {
+	return &CertifyVEXStatementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) Update() *CertifyVEXStatementUpdate {
+	mutation := newCertifyVEXStatementMutation(c.config, OpUpdate)
+	return &CertifyVEXStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyVEXStatementClient) UpdateOne(cvs *CertifyVEXStatement) *CertifyVEXStatementUpdateOne {
+	mutation := newCertifyVEXStatementMutation(c.config, OpUpdateOne, withCertifyVEXStatement(cvs))
+	return &CertifyVEXStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyVEXStatementClient) UpdateOneID(id int) *CertifyVEXStatementUpdateOne {
+	mutation := newCertifyVEXStatementMutation(c.config, OpUpdateOne, withCertifyVEXStatementID(id))
+	return &CertifyVEXStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) Delete() *CertifyVEXStatementDelete {
+	mutation := newCertifyVEXStatementMutation(c.config, OpDelete)
+	return &CertifyVEXStatementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyVEXStatementClient) DeleteOne(cvs *CertifyVEXStatement) *CertifyVEXStatementDeleteOne {
+	return c.DeleteOneID(cvs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyVEXStatementClient) DeleteOneID(id int) *CertifyVEXStatementDeleteOne {
+	builder := c.Delete().Where(certifyvexstatement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyVEXStatementDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) Query() *CertifyVEXStatementQuery {
+	return &CertifyVEXStatementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyVEXStatement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyVEXStatement entity by its id.
+func (c *CertifyVEXStatementClient) Get(ctx context.Context, id int) (*CertifyVEXStatement, error) {
+	return c.Query().Where(certifyvexstatement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyVEXStatementClient) GetX(ctx context.Context, id int) *CertifyVEXStatement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) QueryPackage(cvs *CertifyVEXStatement) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cvs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.PackageTable, certifyvexstatement.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(cvs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) QueryArtifact(cvs *CertifyVEXStatement) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cvs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.ArtifactTable, certifyvexstatement.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(cvs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVulnerability queries the vulnerability edge of a CertifyVEXStatement.
+func (c *CertifyVEXStatementClient) QueryVulnerability(cvs *CertifyVEXStatement) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cvs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvexstatement.Table, certifyvexstatement.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvexstatement.VulnerabilityTable, certifyvexstatement.VulnerabilityColumn),
+		)
+		fromV = sqlgraph.Neighbors(cvs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyVEXStatementClient) Hooks() []Hook {
+	return c.hooks.CertifyVEXStatement
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyVEXStatementClient) Interceptors() []Interceptor {
+	return c.inters.CertifyVEXStatement
+}
+
+func (c *CertifyVEXStatementClient) mutate(ctx context.Context, m *CertifyVEXStatementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyVEXStatementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyVEXStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyVEXStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyVEXStatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CertifyVEXStatement mutation op: %q", m.Op())
+	}
+}
+
+// CertifyVulnClient is a client for the CertifyVuln schema.
+type CertifyVulnClient struct {
+	config
+}
+
+// NewCertifyVulnClient returns a client for the CertifyVuln from the given config.
+func NewCertifyVulnClient(c config) *CertifyVulnClient {
+	return &CertifyVulnClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifyvuln.Hooks(f(g(h())))`.
+func (c *CertifyVulnClient) Use(hooks ...Hook) {
+	c.hooks.CertifyVuln = append(c.hooks.CertifyVuln, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifyvuln.Intercept(f(g(h())))`.
+func (c *CertifyVulnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyVuln = append(c.inters.CertifyVuln, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyVuln entity.
+func (c *CertifyVulnClient) Create() *CertifyVulnCreate {
+	mutation := newCertifyVulnMutation(c.config, OpCreate)
+	return &CertifyVulnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyVuln entities.
+func (c *CertifyVulnClient) CreateBulk(builders ...*CertifyVulnCreate) *CertifyVulnCreateBulk {
+	return &CertifyVulnCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyVuln.
+func (c *CertifyVulnClient) Update() *CertifyVulnUpdate {
+	mutation := newCertifyVulnMutation(c.config, OpUpdate)
+	return &CertifyVulnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyVulnClient) UpdateOne(cv *CertifyVuln) *CertifyVulnUpdateOne {
+	mutation := newCertifyVulnMutation(c.config, OpUpdateOne, withCertifyVuln(cv))
+	return &CertifyVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyVulnClient) UpdateOneID(id int) *CertifyVulnUpdateOne {
+	mutation := newCertifyVulnMutation(c.config, OpUpdateOne, withCertifyVulnID(id))
+	return &CertifyVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyVuln.
+func (c *CertifyVulnClient) Delete() *CertifyVulnDelete {
+	mutation := newCertifyVulnMutation(c.config, OpDelete)
+	return &CertifyVulnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyVulnClient) DeleteOne(cv *CertifyVuln) *CertifyVulnDeleteOne {
+	return c.DeleteOneID(cv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyVulnClient) DeleteOneID(id int) *CertifyVulnDeleteOne {
+	builder := c.Delete().Where(certifyvuln.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyVulnDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyVuln.
+func (c *CertifyVulnClient) Query() *CertifyVulnQuery {
+	return &CertifyVulnQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyVuln},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyVuln entity by its id.
+func (c *CertifyVulnClient) Get(ctx context.Context, id int) (*CertifyVuln, error) {
+	return c.Query().Where(certifyvuln.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyVulnClient) GetX(ctx context.Context, id int) *CertifyVuln {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a CertifyVuln.
+func (c *CertifyVulnClient) QueryPackage(cv *CertifyVuln) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvuln.Table, certifyvuln.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvuln.PackageTable, certifyvuln.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(cv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVulnerability queries the vulnerability edge of a CertifyVuln.
+func (c *CertifyVulnClient) QueryVulnerability(cv *CertifyVuln) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvuln.Table, certifyvuln.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvuln.VulnerabilityTable, certifyvuln.VulnerabilityColumn),
+		)
+		fromV = sqlgraph.Neighbors(cv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyVulnClient) Hooks() []Hook {
+	return c.hooks.CertifyVuln
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyVulnClient) Interceptors() []Interceptor {
+	return c.inters.CertifyVuln
+}
+
+func (c *CertifyVulnClient) mutate(ctx context.Context, m *CertifyVulnMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyVulnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyVulnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyVulnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CertifyVuln mutation op: %q", m.Op())
+	}
+}
+
+// HasMetadataClient is a client for the HasMetadata schema.
+type HasMetadataClient struct {
+	config
+}
+
+// NewHasMetadataClient returns a client for the HasMetadata from the given config.
+func NewHasMetadataClient(c config) *HasMetadataClient {
+	return &HasMetadataClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hasmetadata.Hooks(f(g(h())))`.
+func (c *HasMetadataClient) Use(hooks ...Hook) {
+	c.hooks.HasMetadata = append(c.hooks.HasMetadata, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hasmetadata.Intercept(f(g(h())))`.
+func (c *HasMetadataClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HasMetadata = append(c.inters.HasMetadata, interceptors...)
+}
+
+// Create returns a builder for creating a HasMetadata entity.
+func (c *HasMetadataClient) Create() *HasMetadataCreate {
+	mutation := newHasMetadataMutation(c.config, OpCreate)
+	return &HasMetadataCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HasMetadata entities.
+func (c *HasMetadataClient) CreateBulk(builders ...*HasMetadataCreate) *HasMetadataCreateBulk {
+	return &HasMetadataCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HasMetadata.
+func (c *HasMetadataClient) Update() *HasMetadataUpdate {
+	mutation := newHasMetadataMutation(c.config, OpUpdate)
+	return &HasMetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HasMetadataClient) UpdateOne(hm *HasMetadata) *HasMetadataUpdateOne {
+	mutation := newHasMetadataMutation(c.config, OpUpdateOne, withHasMetadata(hm))
+	return &HasMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HasMetadataClient) UpdateOneID(id int) *HasMetadataUpdateOne {
+	mutation := newHasMetadataMutation(c.config, OpUpdateOne, withHasMetadataID(id))
+	return &HasMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HasMetadata.
+func (c *HasMetadataClient) Delete() *HasMetadataDelete {
+	mutation := newHasMetadataMutation(c.config, OpDelete)
+	return &HasMetadataDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HasMetadataClient) DeleteOne(hm *HasMetadata) *HasMetadataDeleteOne {
+	return c.DeleteOneID(hm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HasMetadataClient) DeleteOneID(id int) *HasMetadataDeleteOne {
+	builder := c.Delete().Where(hasmetadata.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HasMetadataDeleteOne{builder}
+}
+
+// Query returns a query builder for HasMetadata.
+func (c *HasMetadataClient) Query() *HasMetadataQuery {
+	return &HasMetadataQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHasMetadata},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HasMetadata entity by its id.
+func (c *HasMetadataClient) Get(ctx context.Context, id int) (*HasMetadata, error) {
+	return c.Query().Where(hasmetadata.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HasMetadataClient) GetX(ctx context.Context, id int) *HasMetadata {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackageVersion queries the package_version edge of a HasMetadata.
+func (c *HasMetadataClient) QueryPackageVersion(hm *HasMetadata) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasmetadata.Table, hasmetadata.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasmetadata.PackageVersionTable, hasmetadata.PackageVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackageName queries the package_name edge of a HasMetadata.
+func (c *HasMetadataClient) QueryPackageName(hm *HasMetadata) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasmetadata.Table, hasmetadata.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasmetadata.PackageNameTable, hasmetadata.PackageNameColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a HasMetadata.
+func (c *HasMetadataClient) QuerySource(hm *HasMetadata) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasmetadata.Table, hasmetadata.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasmetadata.SourceTable, hasmetadata.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a HasMetadata.
+func (c *HasMetadataClient) QueryArtifact(hm *HasMetadata) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasmetadata.Table, hasmetadata.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasmetadata.ArtifactTable, hasmetadata.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HasMetadataClient) Hooks() []Hook {
+	return c.hooks.HasMetadata
+}
+
+// Interceptors returns the client interceptors.
+func (c *HasMetadataClient) Interceptors() []Interceptor {
+	return c.inters.HasMetadata
+}
+
+func (c *HasMetadataClient) mutate(ctx context.Context, m *HasMetadataMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HasMetadataCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HasMetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HasMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HasMetadataDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown HasMetadata mutation op: %q", m.Op())
+	}
+}
+
+// HasSBOMClient is a client for the HasSBOM schema.
+type HasSBOMClient struct {
+	config
+}
+
+// NewHasSBOMClient returns a client for the HasSBOM from the given config.
+func NewHasSBOMClient(c config) *HasSBOMClient {
+	return &HasSBOMClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hassbom.Hooks(f(g(h())))`.
+func (c *HasSBOMClient) Use(hooks ...Hook) {
+	c.hooks.HasSBOM = append(c.hooks.HasSBOM, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hassbom.Intercept(f(g(h())))`.
+func (c *HasSBOMClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HasSBOM = append(c.inters.HasSBOM, interceptors...)
+}
+
+// Create returns a builder for creating a HasSBOM entity.
+func (c *HasSBOMClient) Create() *HasSBOMCreate {
+	mutation := newHasSBOMMutation(c.config, OpCreate)
+	return &HasSBOMCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HasSBOM entities.
+func (c *HasSBOMClient) CreateBulk(builders ...*HasSBOMCreate) *HasSBOMCreateBulk {
+	return &HasSBOMCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HasSBOM.
+func (c *HasSBOMClient) Update() *HasSBOMUpdate {
+	mutation := newHasSBOMMutation(c.config, OpUpdate)
+	return &HasSBOMUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HasSBOMClient) UpdateOne(hs *HasSBOM) *HasSBOMUpdateOne {
+	mutation := newHasSBOMMutation(c.config, OpUpdateOne, withHasSBOM(hs))
+	return &HasSBOMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HasSBOMClient) UpdateOneID(id int) *HasSBOMUpdateOne {
+	mutation := newHasSBOMMutation(c.config, OpUpdateOne, withHasSBOMID(id))
+	return &HasSBOMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HasSBOM.
+func (c *HasSBOMClient) Delete() *HasSBOMDelete {
+	mutation := newHasSBOMMutation(c.config, OpDelete)
+	return &HasSBOMDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HasSBOMClient) DeleteOne(hs *HasSBOM) *HasSBOMDeleteOne {
+	return c.DeleteOneID(hs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HasSBOMClient) DeleteOneID(id int) *HasSBOMDeleteOne {
+	builder := c.Delete().Where(hassbom.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HasSBOMDeleteOne{builder}
+}
+
+// Query returns a query builder for HasSBOM.
+func (c *HasSBOMClient) Query() *HasSBOMQuery {
+	return &HasSBOMQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHasSBOM},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HasSBOM entity by its id.
+func (c *HasSBOMClient) Get(ctx context.Context, id int) (*HasSBOM, error) {
+	return c.Query().Where(hassbom.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HasSBOMClient) GetX(ctx context.Context, id int) *HasSBOM {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a HasSBOM.
+func (c *HasSBOMClient) QueryPackage(hs *HasSBOM) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hassbom.Table, hassbom.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hassbom.PackageTable, hassbom.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(hs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a HasSBOM.
+func (c *HasSBOMClient) QueryArtifact(hs *HasSBOM) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hassbom.Table, hassbom.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hassbom.ArtifactTable, hassbom.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(hs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HasSBOMClient) Hooks() []Hook {
+	return c.hooks.HasSBOM
+}
+
+// Interceptors returns the client interceptors.
+func (c *HasSBOMClient) Interceptors() []Interceptor {
+	return c.inters.HasSBOM
+}
+
+func (c *HasSBOMClient) mutate(ctx context.Context, m *HasSBOMMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HasSBOMCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HasSBOMUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HasSBOMUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HasSBOMDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown HasSBOM mutation op: %q", m.Op())
+	}
+}
+
+// HasSLSAClient is a client for the HasSLSA schema.
+type HasSLSAClient struct {
+	config
+}
+
+// NewHasSLSAClient returns a client for the HasSLSA from the given config.
+func NewHasSLSAClient(c config) *HasSLSAClient {
+	return &HasSLSAClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hasslsa.Hooks(f(g(h())))`.
+func (c *HasSLSAClient) Use(hooks ...Hook) {
+	c.hooks.HasSLSA = append(c.hooks.HasSLSA, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hasslsa.Intercept(f(g(h())))`.
+func (c *HasSLSAClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HasSLSA = append(c.inters.HasSLSA, interceptors...)
+}
+
+// Create returns a builder for creating a HasSLSA entity.
+func (c *HasSLSAClient) Create() *HasSLSACreate {
+	mutation := newHasSLSAMutation(c.config, OpCreate)
+	return &HasSLSACreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HasSLSA entities.
+func (c *HasSLSAClient) CreateBulk(builders ...*HasSLSACreate) *HasSLSACreateBulk {
+	return &HasSLSACreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HasSLSA.
+func (c *HasSLSAClient) Update() *HasSLSAUpdate {
+	mutation := newHasSLSAMutation(c.config, OpUpdate)
+	return &HasSLSAUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HasSLSAClient) UpdateOne(hs *HasSLSA) *HasSLSAUpdateOne {
+	mutation := newHasSLSAMutation(c.config, OpUpdateOne, withHasSLSA(hs))
+	return &HasSLSAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HasSLSAClient) UpdateOneID(id int) *HasSLSAUpdateOne {
+	mutation := newHasSLSAMutation(c.config, OpUpdateOne, withHasSLSAID(id))
+	return &HasSLSAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HasSLSA.
+func (c *HasSLSAClient) Delete() *HasSLSADelete {
+	mutation := newHasSLSAMutation(c.config, OpDelete)
+	return &HasSLSADelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HasSLSAClient) DeleteOne(hs *HasSLSA) *HasSLSADeleteOne {
+	return c.DeleteOneID(hs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HasSLSAClient) DeleteOneID(id int) *HasSLSADeleteOne {
+	builder := c.Delete().Where(hasslsa.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HasSLSADeleteOne{builder}
+}
+
+// Query returns a query builder for HasSLSA.
+func (c *HasSLSAClient) Query() *HasSLSAQuery {
+	return &HasSLSAQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHasSLSA},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HasSLSA entity by its id.
+func (c *HasSLSAClient) Get(ctx context.Context, id int) (*HasSLSA, error) {
+	return c.Query().Where(hasslsa.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HasSLSAClient) GetX(ctx context.Context, id int) *HasSLSA {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubject queries the subject edge of a HasSLSA.
+func (c *HasSLSAClient) QuerySubject(hs *HasSLSA) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasslsa.SubjectTable, hasslsa.SubjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(hs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuiltBy queries the built_by edge of a HasSLSA.
+func (c *HasSLSAClient) QueryBuiltBy(hs *HasSLSA) *BuilderNodeQuery {
+	query := (&BuilderNodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, id),
+			sqlgraph.To(buildernode.Table, buildernode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasslsa.BuiltByTable, hasslsa.BuiltByColumn),
+		)
+		fromV = sqlgraph.Neighbors(hs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuiltFrom queries the built_from edge of a HasSLSA.
+func (c *HasSLSAClient) QueryBuiltFrom(hs *HasSLSA) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, hasslsa.BuiltFromTable, hasslsa.BuiltFromPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(hs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HasSLSAClient) Hooks() []Hook {
+	return c.hooks.HasSLSA
+}
+
+// Interceptors returns the client interceptors.
+func (c *HasSLSAClient) Interceptors() []Interceptor {
+	return c.inters.HasSLSA
+}
+
+func (c *HasSLSAClient) mutate(ctx context.Context, m *HasSLSAMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HasSLSACreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HasSLSAUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HasSLSAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HasSLSADelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown HasSLSA mutation op: %q", m.Op())
+	}
+}
+
+// HashEqualClient is a client for the HashEqual schema.
+type HashEqualClient struct {
+	config
+}
+
+// NewHashEqualClient returns a client for the HashEqual from the given config.
+func NewHashEqualClient(c config) *HashEqualClient {
+	return &HashEqualClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hashequal.Hooks(f(g(h())))`.
+func (c *HashEqualClient) Use(hooks ...Hook) {
+	c.hooks.HashEqual = append(c.hooks.HashEqual, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hashequal.Intercept(f(g(h())))`.
+func (c *HashEqualClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HashEqual = append(c.inters.HashEqual, interceptors...)
+}
+
+// Create returns a builder for creating a HashEqual entity.
+func (c *HashEqualClient) Create() *HashEqualCreate {
+	mutation := newHashEqualMutation(c.config, OpCreate)
+	return &HashEqualCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HashEqual entities.
+func (c *HashEqualClient) CreateBulk(builders ...*HashEqualCreate) *HashEqualCreateBulk {
+	return &HashEqualCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HashEqual.
+func (c *HashEqualClient) Update() *HashEqualUpdate {
+	mutation := newHashEqualMutation(c.config, OpUpdate)
+	return &HashEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HashEqualClient) UpdateOne(he *HashEqual) *HashEqualUpdateOne {
+	mutation := newHashEqualMutation(c.config, OpUpdateOne, withHashEqual(he))
+	return &HashEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HashEqualClient) UpdateOneID(id int) *HashEqualUpdateOne {
+	mutation := newHashEqualMutation(c.config, OpUpdateOne, withHashEqualID(id))
+	return &HashEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HashEqual.
+func (c *HashEqualClient) Delete() *HashEqualDelete {
+	mutation := newHashEqualMutation(c.config, OpDelete)
+	return &HashEqualDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HashEqualClient) DeleteOne(he *HashEqual) *HashEqualDeleteOne {
+	return c.DeleteOneID(he.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HashEqualClient) DeleteOneID(id int) *HashEqualDeleteOne {
+	builder := c.Delete().Where(hashequal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HashEqualDeleteOne{builder}
+}
+
+// Query returns a query builder for HashEqual.
+func (c *HashEqualClient) Query() *HashEqualQuery {
+	return &HashEqualQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHashEqual},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HashEqual entity by its id.
+func (c *HashEqualClient) Get(ctx context.Context, id int) (*HashEqual, error) {
+	return c.Query().Where(hashequal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HashEqualClient) GetX(ctx context.Context, id int) *HashEqual {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArtifactA queries the artifact_a edge of a HashEqual.
+func (c *HashEqualClient) QueryArtifactA(he *HashEqual) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := he.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hashequal.Table, hashequal.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hashequal.ArtifactATable, hashequal.ArtifactAColumn),
+		)
+		fromV = sqlgraph.Neighbors(he.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifactB queries the artifact_b edge of a HashEqual.
+func (c *HashEqualClient) QueryArtifactB(he *HashEqual) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := he.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hashequal.Table, hashequal.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hashequal.ArtifactBTable, hashequal.ArtifactBColumn),
+		)
+		fromV = sqlgraph.Neighbors(he.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HashEqualClient) Hooks() []Hook {
+	return c.hooks.HashEqual
+}
+
+// Interceptors returns the client interceptors.
+func (c *HashEqualClient) Interceptors() []Interceptor {
+	return c.inters.HashEqual
+}
+
+func (c *HashEqualClient) mutate(ctx context.Context, m *HashEqualMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HashEqualCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HashEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HashEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HashEqualDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown HashEqual mutation op: %q", m.Op())
+	}
+}
+
+// IsDependencyClient is a client for the IsDependency schema.
+type IsDependencyClient struct {
+	config
+}
+
+// NewIsDependencyClient returns a client for the IsDependency from the given config.
+func NewIsDependencyClient(c config) *IsDependencyClient {
+	return &IsDependencyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `isdependency.Hooks(f(g(h())))`.
+func (c *IsDependencyClient) Use(hooks ...Hook) {
+	c.hooks.IsDependency = append(c.hooks.IsDependency, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `isdependency.Intercept(f(g(h())))`.
+func (c *IsDependencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IsDependency = append(c.inters.IsDependency, interceptors...)
+}
+
+// Create returns a builder for creating a IsDependency entity.
+func (c *IsDependencyClient) Create() *IsDependencyCreate {
+	mutation := newIsDependencyMutation(c.config, OpCreate)
+	return &IsDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IsDependency entities.
+func (c *IsDependencyClient) CreateBulk(builders ...*IsDependencyCreate) *IsDependencyCreateBulk {
+	return &IsDependencyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IsDependency.
+func (c *IsDependencyClient) Update() *IsDependencyUpdate {
+	mutation := newIsDependencyMutation(c.config, OpUpdate)
+	return &IsDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IsDependencyClient) UpdateOne(id *IsDependency) *IsDependencyUpdateOne {
+	mutation := newIsDependencyMutation(c.config, OpUpdateOne, withIsDependency(id))
+	return &IsDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IsDependencyClient) UpdateOneID(id int) *IsDependencyUpdateOne {
+	mutation := newIsDependencyMutation(c.config, OpUpdateOne, withIsDependencyID(id))
+	return &IsDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IsDependency.
+func (c *IsDependencyClient) Delete() *IsDependencyDelete {
+	mutation := newIsDependencyMutation(c.config, OpDelete)
+	return &IsDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IsDependencyClient) DeleteOne(id *IsDependency) *IsDependencyDeleteOne {
+	return c.DeleteOneID(id.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IsDependencyClient) DeleteOneID(id int) *IsDependencyDeleteOne {
+	builder := c.Delete().Where(isdependency.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IsDependencyDeleteOne{builder}
+}
+
+// Query returns a query builder for IsDependency.
+func (c *IsDependencyClient) Query() *IsDependencyQuery {
+	return &IsDependencyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIsDependency},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IsDependency entity by its id.
+func (c *IsDependencyClient) Get(ctx context.Context, id int) (*IsDependency, error) {
+	return c.Query().Where(isdependency.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IsDependencyClient) GetX(ctx context.Context, id int) *IsDependency {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a IsDependency.
+func (c *IsDependencyClient) QueryPackage(node *IsDependency) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := node.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(isdependency.Table, isdependency.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, isdependency.PackageTable, isdependency.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(node.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDependentPackage queries the dependent_package edge of a IsDependency.
+func (c *IsDependencyClient) QueryDependentPackage(node *IsDependency) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := node.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(isdependency.Table, isdependency.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, isdependency.DependentPackageTable, isdependency.DependentPackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(node.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IsDependencyClient) Hooks() []Hook {
+	return c.hooks.IsDependency
+}
+
+// Interceptors returns the client interceptors.
+func (c *IsDependencyClient) Interceptors() []Interceptor {
+	return c.inters.IsDependency
+}
+
+func (c *IsDependencyClient) mutate(ctx context.Context, m *IsDependencyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IsDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IsDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IsDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IsDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown IsDependency mutation op: %q", m.Op())
+	}
+}
+
+// IsOccurrenceClient is a client for the IsOccurrence schema.
+type IsOccurrenceClient struct {
+	config
+}
+
+// NewIsOccurrenceClient returns a client for the IsOccurrence from the given config.
+func NewIsOccurrenceClient(c config) *IsOccurrenceClient {
+	return &IsOccurrenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `isoccurrence.Hooks(f(g(h())))`.
+func (c *IsOccurrenceClient) Use(hooks ...Hook) {
+	c.hooks.IsOccurrence = append(c.hooks.IsOccurrence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `isoccurrence.Intercept(f(g(h())))`.
+func (c *IsOccurrenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IsOccurrence = append(c.inters.IsOccurrence, interceptors...)
+}
+
+// Create returns a builder for creating a IsOccurrence entity.
+func (c *IsOccurrenceClient) Create() *IsOccurrenceCreate {
+	mutation := newIsOccurrenceMutation(c.config, OpCreate)
+	return &IsOccurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IsOccurrence entities.
+func (c *IsOccurrenceClient) CreateBulk(builders ...*IsOccurrenceCreate) *IsOccurrenceCreateBulk {
+	return &IsOccurrenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IsOccurrence.
+func (c *IsOccurrenceClient) Update() *IsOccurrenceUpdate {
+	mutation := newIsOccurrenceMutation(c.config, OpUpdate)
+	return &IsOccurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IsOccurrenceClient) UpdateOne(io *IsOccurrence) *IsOccurrenceUpdateOne {
+	mutation := newIsOccurrenceMutation(c.config, OpUpdateOne, withIsOccurrence(io))
+	return &IsOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IsOccurrenceClient) UpdateOneID(id int) *IsOccurrenceUpdateOne {
+	mutation := newIsOccurrenceMutation(c.config, OpUpdateOne, withIsOccurrenceID(id))
+	return &IsOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IsOccurrence.
+func (c *IsOccurrenceClient) Delete() *IsOccurrenceDelete {
+	mutation := newIsOccurrenceMutation(c.config, OpDelete)
+	return &IsOccurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IsOccurrenceClient) DeleteOne(io *IsOccurrence) *IsOccurrenceDeleteOne {
+	return c.DeleteOneID(io.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IsOccurrenceClient) DeleteOneID(id int) *IsOccurrenceDeleteOne {
+	builder := c.Delete().Where(isoccurrence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IsOccurrenceDeleteOne{builder}
+}
+
+// Query returns a query builder for IsOccurrence.
+func (c *IsOccurrenceClient) Query() *IsOccurrenceQuery {
+	return &IsOccurrenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIsOccurrence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IsOccurrence entity by its id.
+func (c *IsOccurrenceClient) Get(ctx context.Context, id int) (*IsOccurrence, error) {
+	return c.Query().Where(isoccurrence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IsOccurrenceClient) GetX(ctx context.Context, id int) *IsOccurrence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a IsOccurrence.
+func (c *IsOccurrenceClient) QueryPackage(io *IsOccurrence) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := io.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(isoccurrence.Table, isoccurrence.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, isoccurrence.PackageTable, isoccurrence.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(io.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a IsOccurrence.
+func (c *IsOccurrenceClient) QuerySource(io *IsOccurrence) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := io.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(isoccurrence.Table, isoccurrence.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, isoccurrence.SourceTable, isoccurrence.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(io.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a IsOccurrence.
+func (c *IsOccurrenceClient) QueryArtifact(io *IsOccurrence) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := io.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(isoccurrence.Table, isoccurrence.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, isoccurrence.ArtifactTable, isoccurrence.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(io.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IsOccurrenceClient) Hooks() []Hook {
+	return c.hooks.IsOccurrence
+}
+
+// Interceptors returns the client interceptors.
+func (c *IsOccurrenceClient) Interceptors() []Interceptor {
+	return c.inters.IsOccurrence
+}
+
+func (c *IsOccurrenceClient) mutate(ctx context.Context, m *IsOccurrenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IsOccurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IsOccurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IsOccurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IsOccurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown IsOccurrence mutation op: %q", m.Op())
+	}
+}
+
+// PackageNameClient is a client for the PackageName schema.
+type PackageNameClient struct {
+	config
+}
+
+// NewPackageNameClient returns a client for the PackageName from the given config.
+func NewPackageNameClient(c config) *PackageNameClient {
+	return &PackageNameClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `packagename.Hooks(f(g(h())))`.
+func (c *PackageNameClient) Use(hooks ...Hook) {
+	c.hooks.PackageName = append(c.hooks.PackageName, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `packagename.Intercept(f(g(h())))`.
+func (c *PackageNameClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PackageName = append(c.inters.PackageName, interceptors...)
+}
+
+// Create returns a builder for creating a PackageName entity.
+func (c *PackageNameClient) Create() *PackageNameCreate {
+	mutation := newPackageNameMutation(c.config, OpCreate)
+	return &PackageNameCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PackageName entities.
+func (c *PackageNameClient) CreateBulk(builders ...*PackageNameCreate) *PackageNameCreateBulk {
+	return &PackageNameCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PackageName.
+func (c *PackageNameClient) Update() *PackageNameUpdate {
+	mutation := newPackageNameMutation(c.config, OpUpdate)
+	return &PackageNameUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PackageNameClient) UpdateOne(pn *PackageName) *PackageNameUpdateOne {
+	mutation := newPackageNameMutation(c.config, OpUpdateOne, withPackageName(pn))
+	return &PackageNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PackageNameClient) UpdateOneID(id int) *PackageNameUpdateOne {
+	mutation := newPackageNameMutation(c.config, OpUpdateOne, withPackageNameID(id))
+	return &PackageNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PackageName.
+func (c *PackageNameClient) Delete() *PackageNameDelete {
+	mutation := newPackageNameMutation(c.config, OpDelete)
+	return &PackageNameDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PackageNameClient) DeleteOne(pn *PackageName) *PackageNameDeleteOne {
+	return c.DeleteOneID(pn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PackageNameClient) DeleteOneID(id int) *PackageNameDeleteOne {
+	builder := c.Delete().Where(packagename.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PackageNameDeleteOne{builder}
+}
+
+// Query returns a query builder for PackageName.
+func (c *PackageNameClient) Query() *PackageNameQuery {
+	return &PackageNameQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePackageName},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PackageName entity by its id.
+func (c *PackageNameClient) Get(ctx context.Context, id int) (*PackageName, error) {
+	return c.Query().Where(packagename.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PackageNameClient) GetX(ctx context.Context, id int) *PackageName {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNamespace queries the namespace edge of a PackageName.
+func (c *PackageNameClient) QueryNamespace(pn *PackageName) *PackageNamespaceQuery {
+	query := (&PackageNamespaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, id),
+			sqlgraph.To(packagenamespace.Table, packagenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, packagename.NamespaceTable, packagename.NamespaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(pn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersions queries the versions edge of a PackageName.
+func (c *PackageNameClient) QueryVersions(pn *PackageName) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, packagename.VersionsTable, packagename.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PackageNameClient) Hooks() []Hook {
+	return c.hooks.PackageName
+}
+
+// Interceptors returns the client interceptors.
+func (c *PackageNameClient) Interceptors() []Interceptor {
+	return c.inters.PackageName
+}
+
+func (c *PackageNameClient) mutate(ctx context.Context, m *PackageNameMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PackageNameCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PackageNameUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PackageNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PackageNameDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PackageName mutation op: %q", m.Op())
+	}
+}
+
+// PackageNamespaceClient is a client for the PackageNamespace schema.
+type PackageNamespaceClient struct {
+	config
+}
+
+// NewPackageNamespaceClient returns a client for the PackageNamespace from the given config.
+func NewPackageNamespaceClient(c config) *PackageNamespaceClient {
+	return &PackageNamespaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `packagenamespace.Hooks(f(g(h())))`.
+func (c *PackageNamespaceClient) Use(hooks ...Hook) {
+	c.hooks.PackageNamespace = append(c.hooks.PackageNamespace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `packagenamespace.Intercept(f(g(h())))`.
+func (c *PackageNamespaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PackageNamespace = append(c.inters.PackageNamespace, interceptors...)
+}
+
+// Create returns a builder for creating a PackageNamespace entity.
+func (c *PackageNamespaceClient) Create() *PackageNamespaceCreate {
+	mutation := newPackageNamespaceMutation(c.config, OpCreate)
+	return &PackageNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PackageNamespace entities.
+func (c *PackageNamespaceClient) CreateBulk(builders ...*PackageNamespaceCreate) *PackageNamespaceCreateBulk {
+	return &PackageNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PackageNamespace.
+func (c *PackageNamespaceClient) Update() *PackageNamespaceUpdate {
+	mutation := newPackageNamespaceMutation(c.config, OpUpdate)
+	return &PackageNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PackageNamespaceClient) UpdateOne(pn *PackageNamespace) *PackageNamespaceUpdateOne {
+	mutation := newPackageNamespaceMutation(c.config, OpUpdateOne, withPackageNamespace(pn))
+	return &PackageNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PackageNamespaceClient) UpdateOneID(id int) *PackageNamespaceUpdateOne {
+	mutation := newPackageNamespaceMutation(c.config, OpUpdateOne, withPackageNamespaceID(id))
+	return &PackageNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PackageNamespace.
+func (c *PackageNamespaceClient) Delete() *PackageNamespaceDelete {
+	mutation := newPackageNamespaceMutation(c.config, OpDelete)
+	return &PackageNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PackageNamespaceClient) DeleteOne(pn *PackageNamespace) *PackageNamespaceDeleteOne {
+	return c.DeleteOneID(pn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PackageNamespaceClient) DeleteOneID(id int) *PackageNamespaceDeleteOne {
+	builder := c.Delete().Where(packagenamespace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PackageNamespaceDeleteOne{builder}
+}
+
+// Query returns a query builder for PackageNamespace.
+func (c *PackageNamespaceClient) Query() *PackageNamespaceQuery {
+	return &PackageNamespaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePackageNamespace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PackageNamespace entity by its id.
+func (c *PackageNamespaceClient) Get(ctx context.Context, id int) (*PackageNamespace, error) {
+	return c.Query().Where(packagenamespace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PackageNamespaceClient) GetX(ctx context.Context, id int) *PackageNamespace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackage queries the package edge of a PackageNamespace.
+func (c *PackageNamespaceClient) QueryPackage(pn *PackageNamespace) *PackageTypeQuery {
+	query := (&PackageTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagenamespace.Table, packagenamespace.FieldID, id),
+			sqlgraph.To(packagetype.Table, packagetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, packagenamespace.PackageTable, packagenamespace.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(pn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNames queries the names edge of a PackageNamespace.
+func (c *PackageNamespaceClient) QueryNames(pn *PackageNamespace) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagenamespace.Table, packagenamespace.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, packagenamespace.NamesTable, packagenamespace.NamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PackageNamespaceClient) Hooks() []Hook {
+	return c.hooks.PackageNamespace
+}
+
+// Interceptors returns the client interceptors.
+func (c *PackageNamespaceClient) Interceptors() []Interceptor {
+	return c.inters.PackageNamespace
+}
+
+func (c *PackageNamespaceClient) mutate(ctx context.Context, m *PackageNamespaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PackageNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PackageNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PackageNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PackageNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PackageNamespace mutation op: %q", m.Op())
+	}
+}
+
+// PackageTypeClient is a client for the PackageType schema.
+type PackageTypeClient struct {
+	config
+}
+
+// NewPackageTypeClient returns a client for the PackageType from the given config.
+func NewPackageTypeClient(c config) *PackageTypeClient {
+	return &PackageTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `packagetype.Hooks(f(g(h())))`.
+func (c *PackageTypeClient) Use(hooks ...Hook) {
+	c.hooks.PackageType = append(c.hooks.PackageType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `packagetype.Intercept(f(g(h())))`.
+func (c *PackageTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PackageType = append(c.inters.PackageType, interceptors...)
+}
+
+// Create returns a builder for creating a PackageType entity.
+func (c *PackageTypeClient) Create() *PackageTypeCreate {
+	mutation := newPackageTypeMutation(c.config, OpCreate)
+	return &PackageTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PackageType entities.
+func (c *PackageTypeClient) CreateBulk(builders ...*PackageTypeCreate) *PackageTypeCreateBulk {
+	return &PackageTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PackageType.
+func (c *PackageTypeClient) Update() *PackageTypeUpdate {
+	mutation := newPackageTypeMutation(c.config, OpUpdate)
+	return &PackageTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PackageTypeClient) UpdateOne(pt *PackageType) *PackageTypeUpdateOne {
+	mutation := newPackageTypeMutation(c.config, OpUpdateOne, withPackageType(pt))
+	return &PackageTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PackageTypeClient) UpdateOneID(id int) *PackageTypeUpdateOne {
+	mutation := newPackageTypeMutation(c.config, OpUpdateOne, withPackageTypeID(id))
+	return &PackageTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PackageType.
+func (c *PackageTypeClient) Delete() *PackageTypeDelete {
+	mutation := newPackageTypeMutation(c.config, OpDelete)
+	return &PackageTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PackageTypeClient) DeleteOne(pt *PackageType) *PackageTypeDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PackageTypeClient) DeleteOneID(id int) *PackageTypeDeleteOne {
+	builder := c.Delete().Where(packagetype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PackageTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for PackageType.
+func (c *PackageTypeClient) Query() *PackageTypeQuery {
+	return &PackageTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePackageType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PackageType entity by its id.
+func (c *PackageTypeClient) Get(ctx context.Context, id int) (*PackageType, error) {
+	return c.Query().Where(packagetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PackageTypeClient) GetX(ctx context.Context, id int) *PackageType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNamespaces queries the namespaces edge of a PackageType.
+func (c *PackageTypeClient) QueryNamespaces(pt *PackageType) *PackageNamespaceQuery {
+	query := (&PackageNamespaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagetype.Table, packagetype.FieldID, id),
+			sqlgraph.To(packagenamespace.Table, packagenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, packagetype.NamespacesTable, packagetype.NamespacesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PackageTypeClient) Hooks() []Hook {
+	return c.hooks.PackageType
+}
+
+// Interceptors returns the client interceptors.
+func (c *PackageTypeClient) Interceptors() []Interceptor {
+	return c.inters.PackageType
+}
+
+func (c *PackageTypeClient) mutate(ctx context.Context, m *PackageTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PackageTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PackageTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PackageTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PackageTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PackageType mutation op: %q", m.Op())
+	}
+}
+
+// PackageVersionClient is a client for the PackageVersion schema.
+type PackageVersionClient struct {
+	config
+}
+
+// NewPackageVersionClient returns a client for the PackageVersion from the given config.
+func NewPackageVersionClient(c config) *PackageVersionClient {
+	return &PackageVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `packageversion.Hooks(f(g(h())))`.
+func (c *PackageVersionClient) Use(hooks ...Hook) {
+	c.hooks.PackageVersion = append(c.hooks.PackageVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `packageversion.Intercept(f(g(h())))`.
+func (c *PackageVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PackageVersion = append(c.inters.PackageVersion, interceptors...)
+}
+
+// Create returns a builder for creating a PackageVersion entity.
+func (c *PackageVersionClient) Create() *PackageVersionCreate {
+	mutation := newPackageVersionMutation(c.config, OpCreate)
+	return &PackageVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PackageVersion entities.
+func (c *PackageVersionClient) CreateBulk(builders ...*PackageVersionCreate) *PackageVersionCreateBulk {
+	return &PackageVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PackageVersion.
+func (c *PackageVersionClient) Update() *PackageVersionUpdate {
+	mutation := newPackageVersionMutation(c.config, OpUpdate)
+	return &PackageVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PackageVersionClient) UpdateOne(pv *PackageVersion) *PackageVersionUpdateOne {
+	mutation := newPackageVersionMutation(c.config, OpUpdateOne, withPackageVersion(pv))
+	return &PackageVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PackageVersionClient) UpdateOneID(id int) *PackageVersionUpdateOne {
+	mutation := newPackageVersionMutation(c.config, OpUpdateOne, withPackageVersionID(id))
+	return &PackageVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PackageVersion.
+func (c *PackageVersionClient) Delete() *PackageVersionDelete {
+	mutation := newPackageVersionMutation(c.config, OpDelete)
+	return &PackageVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PackageVersionClient) DeleteOne(pv *PackageVersion) *PackageVersionDeleteOne {
+	return c.DeleteOneID(pv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PackageVersionClient) DeleteOneID(id int) *PackageVersionDeleteOne {
+	builder := c.Delete().Where(packageversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PackageVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for PackageVersion.
+func (c *PackageVersionClient) Query() *PackageVersionQuery {
+	return &PackageVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePackageVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PackageVersion entity by its id.
+func (c *PackageVersionClient) Get(ctx context.Context, id int) (*PackageVersion, error) {
+	return c.Query().Where(packageversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PackageVersionClient) GetX(ctx context.Context, id int) *PackageVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryName queries the name edge of a PackageVersion.
+func (c *PackageVersionClient) QueryName(pv *PackageVersion) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packageversion.Table, packageversion.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, packageversion.NameTable, packageversion.NameColumn),
+		)
+		fromV = sqlgraph.Neighbors(pv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PackageVersionClient) Hooks() []Hook {
+	return c.hooks.PackageVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *PackageVersionClient) Interceptors() []Interceptor {
+	return c.inters.PackageVersion
+}
+
+func (c *PackageVersionClient) mutate(ctx context.Context, m *PackageVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PackageVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PackageVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PackageVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PackageVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PackageVersion mutation op: %q", m.Op())
+	}
+}
+
+// PkgEqualClient is a client for the PkgEqual schema.
+type PkgEqualClient struct {
+	config
+}
+
+// NewPkgEqualClient returns a client for the PkgEqual from the given config.
+func NewPkgEqualClient(c config) *PkgEqualClient {
+	return &PkgEqualClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pkgequal.Hooks(f(g(h())))`.
+func (c *PkgEqualClient) Use(hooks ...Hook) {
+	c.hooks.PkgEqual = append(c.hooks.PkgEqual, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pkgequal.Intercept(f(g(h())))`.
+func (c *PkgEqualClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PkgEqual = append(c.inters.PkgEqual, interceptors...)
+}
+
+// Create returns a builder for creating a PkgEqual entity.
+func (c *PkgEqualClient) Create() *PkgEqualCreate {
+	mutation := newPkgEqualMutation(c.config, OpCreate)
+	return &PkgEqualCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PkgEqual entities.
+func (c *PkgEqualClient) CreateBulk(builders ...*PkgEqualCreate) *PkgEqualCreateBulk {
+	return &PkgEqualCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PkgEqual.
+func (c *PkgEqualClient) Update() *PkgEqualUpdate {
+	mutation := newPkgEqualMutation(c.config, OpUpdate)
+	return &PkgEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PkgEqualClient) UpdateOne(pe *PkgEqual) *PkgEqualUpdateOne {
+	mutation := newPkgEqualMutation(c.config, OpUpdateOne, withPkgEqual(pe))
+	return &PkgEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PkgEqualClient) UpdateOneID(id int) *PkgEqualUpdateOne {
+	mutation := newPkgEqualMutation(c.config, OpUpdateOne, withPkgEqualID(id))
+	return &PkgEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PkgEqual.
+func (c *PkgEqualClient) Delete() *PkgEqualDelete {
+	mutation := newPkgEqualMutation(c.config, OpDelete)
+	return &PkgEqualDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PkgEqualClient) DeleteOne(pe *PkgEqual) *PkgEqualDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PkgEqualClient) DeleteOneID(id int) *PkgEqualDeleteOne {
+	builder := c.Delete().Where(pkgequal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PkgEqualDeleteOne{builder}
+}
+
+// Query returns a query builder for PkgEqual.
+func (c *PkgEqualClient) Query() *PkgEqualQuery {
+	return &PkgEqualQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePkgEqual},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PkgEqual entity by its id.
+func (c *PkgEqualClient) Get(ctx context.Context, id int) (*PkgEqual, error) {
+	return c.Query().Where(pkgequal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PkgEqualClient) GetX(ctx context.Context, id int) *PkgEqual {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackageA queries the package_a edge of a PkgEqual.
+func (c *PkgEqualClient) QueryPackageA(pe *PkgEqual) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pkgequal.Table, pkgequal.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pkgequal.PackageATable, pkgequal.PackageAColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackageB queries the package_b edge of a PkgEqual.
+func (c *PkgEqualClient) QueryPackageB(pe *PkgEqual) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pkgequal.Table, pkgequal.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pkgequal.PackageBTable, pkgequal.PackageBColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PkgEqualClient) Hooks() []Hook {
+	return c.hooks.PkgEqual
+}
+
+// Interceptors returns the client interceptors.
+func (c *PkgEqualClient) Interceptors() []Interceptor {
+	return c.inters.PkgEqual
+}
+
+func (c *PkgEqualClient) mutate(ctx context.Context, m *PkgEqualMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PkgEqualCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PkgEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PkgEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PkgEqualDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PkgEqual mutation op: %q", m.Op())
+	}
+}
+
+// PointOfContactClient is a client for the PointOfContact schema.
+type PointOfContactClient struct {
+	config
+}
+
+// NewPointOfContactClient returns a client for the PointOfContact from the given config.
+func NewPointOfContactClient(c config) *PointOfContactClient {
+	return &PointOfContactClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pointofcontact.Hooks(f(g(h())))`.
+func (c *PointOfContactClient) Use(hooks ...Hook) {
+	c.hooks.PointOfContact = append(c.hooks.PointOfContact, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pointofcontact.Intercept(f(g(h())))`.
+func (c *PointOfContactClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PointOfContact = append(c.inters.PointOfContact, interceptors...)
+}
+
+// Create returns a builder for creating a PointOfContact entity.
+func (c *PointOfContactClient) Create() *PointOfContactCreate {
+	mutation := newPointOfContactMutation(c.config, OpCreate)
+	return &PointOfContactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PointOfContact entities.
+func (c *PointOfContactClient) CreateBulk(builders ...*PointOfContactCreate) *PointOfContactCreateBulk {
+	return &PointOfContactCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PointOfContact.
+func (c *PointOfContactClient) Update() *PointOfContactUpdate {
+	mutation := newPointOfContactMutation(c.config, OpUpdate)
+	return &PointOfContactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PointOfContactClient) UpdateOne(poc *PointOfContact) *PointOfContactUpdateOne {
+	mutation := newPointOfContactMutation(c.config, OpUpdateOne, withPointOfContact(poc))
+	return &PointOfContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PointOfContactClient) UpdateOneID(id int) *PointOfContactUpdateOne {
+	mutation := newPointOfContactMutation(c.config, OpUpdateOne, withPointOfContactID(id))
+	return &PointOfContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PointOfContact.
+func (c *PointOfContactClient) Delete() *PointOfContactDelete {
+	mutation := newPointOfContactMutation(c.config, OpDelete)
+	return &PointOfContactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PointOfContactClient) DeleteOne(poc *PointOfContact) *PointOfContactDeleteOne {
+	return c.DeleteOneID(poc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PointOfContactClient) DeleteOneID(id int) *PointOfContactDeleteOne {
+	builder := c.Delete().Where(pointofcontact.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PointOfContactDeleteOne{builder}
+}
+
+// Query returns a query builder for PointOfContact.
+func (c *PointOfContactClient) Query() *PointOfContactQuery {
+	return &PointOfContactQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePointOfContact},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PointOfContact entity by its id.
+func (c *PointOfContactClient) Get(ctx context.Context, id int) (*PointOfContact, error) {
+	return c.Query().Where(pointofcontact.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PointOfContactClient) GetX(ctx context.Context, id int) *PointOfContact {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPackageVersion queries the package_version edge of a PointOfContact.
+func (c *PointOfContactClient) QueryPackageVersion(poc *PointOfContact) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := poc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.PackageVersionTable, pointofcontact.PackageVersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(poc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackageName queries the package_name edge of a PointOfContact.
+func (c *PointOfContactClient) QueryPackageName(poc *PointOfContact) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := poc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.PackageNameTable, pointofcontact.PackageNameColumn),
+		)
+		fromV = sqlgraph.Neighbors(poc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySource queries the source edge of a PointOfContact.
+func (c *PointOfContactClient) QuerySource(poc *PointOfContact) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := poc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.SourceTable, pointofcontact.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(poc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtifact queries the artifact edge of a PointOfContact.
+func (c *PointOfContactClient) QueryArtifact(poc *PointOfContact) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := poc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.ArtifactTable, pointofcontact.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(poc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PointOfContactClient) Hooks() []Hook {
+	return c.hooks.PointOfContact
+}
+
+// Interceptors returns the client interceptors.
+func (c *PointOfContactClient) Interceptors() []Interceptor {
+	return c.inters.PointOfContact
+}
+
+func (c *PointOfContactClient) mutate(ctx context.Context, m *PointOfContactMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PointOfContactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PointOfContactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PointOfContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PointOfContactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown PointOfContact mutation op: %q", m.Op())
+	}
+}
+
+// ScorecardClient is a client for the Scorecard schema.
+type ScorecardClient struct {
+	config
+}
+
+// NewScorecardClient returns a client for the Scorecard from the given config.
+func NewScorecardClient(c config) *ScorecardClient {
+	return &ScorecardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scorecard.Hooks(f(g(h())))`.
+func (c *ScorecardClient) Use(hooks ...Hook) {
+	c.hooks.Scorecard = append(c.hooks.Scorecard, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scorecard.Intercept(f(g(h())))`.
+func (c *ScorecardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Scorecard = append(c.inters.Scorecard, interceptors...)
+}
+
+// Create returns a builder for creating a Scorecard entity.
+func (c *ScorecardClient) Create() *ScorecardCreate {
+	mutation := newScorecardMutation(c.config, OpCreate)
+	return &ScorecardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Scorecard entities.
+func (c *ScorecardClient) CreateBulk(builders ...*ScorecardCreate) *ScorecardCreateBulk {
+	return &ScorecardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Scorecard.
+func (c *ScorecardClient) Update() *ScorecardUpdate {
+	mutation := newScorecardMutation(c.config, OpUpdate)
+	return &ScorecardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScorecardClient) UpdateOne(s *Scorecard) *ScorecardUpdateOne {
+	mutation := newScorecardMutation(c.config, OpUpdateOne, withScorecard(s))
+	return &ScorecardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScorecardClient) UpdateOneID(id int) *ScorecardUpdateOne {
+	mutation := newScorecardMutation(c.config, OpUpdateOne, withScorecardID(id))
+	return &ScorecardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Scorecard.
+func (c *ScorecardClient) Delete() *ScorecardDelete {
+	mutation := newScorecardMutation(c.config, OpDelete)
+	return &ScorecardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScorecardClient) DeleteOne(s *Scorecard) *ScorecardDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScorecardClient) DeleteOneID(id int) *ScorecardDeleteOne {
+	builder := c.Delete().Where(scorecard.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScorecardDeleteOne{builder}
+}
+
+// Query returns a query builder for Scorecard.
+func (c *ScorecardClient) Query() *ScorecardQuery {
+	return &ScorecardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScorecard},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Scorecard entity by its id.
+func (c *ScorecardClient) Get(ctx context.Context, id int) (*Scorecard, error) {
+	return c.Query().Where(scorecard.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScorecardClient) GetX(ctx context.Context, id int) *Scorecard {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySource queries the source edge of a Scorecard.
+func (c *ScorecardClient) QuerySource(s *Scorecard) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scorecard.Table, scorecard.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scorecard.SourceTable, scorecard.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScorecardClient) Hooks() []Hook {
+	return c.hooks.Scorecard
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScorecardClient) Interceptors() []Interceptor {
+	return c.inters.Scorecard
+}
+
+func (c *ScorecardClient) mutate(ctx context.Context, m *ScorecardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScorecardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScorecardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScorecardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScorecardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Scorecard mutation op: %q", m.Op())
+	}
+}
+
+// SourceNameClient is a client for the SourceName schema.
+type SourceNameClient struct {
+	config
+}
+
+// NewSourceNameClient returns a client for the SourceName from the given config.
+func NewSourceNameClient(c config) *SourceNameClient {
+	return &SourceNameClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcename.Hooks(f(g(h())))`.
+func (c *SourceNameClient) Use(hooks ...Hook) {
+	c.hooks.SourceName = append(c.hooks.SourceName, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcename.Intercept(f(g(h())))`.
+func (c *SourceNameClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceName = append(c.inters.SourceName, interceptors...)
+}
+
+// Create returns a builder for creating a SourceName entity.
+func (c *SourceNameClient) Create() *SourceNameCreate {
+	mutation := newSourceNameMutation(c.config, OpCreate)
+	return &SourceNameCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceName entities.
+func (c *SourceNameClient) CreateBulk(builders ...*SourceNameCreate) *SourceNameCreateBulk {
+	return &SourceNameCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceName.
+func (c *SourceNameClient) Update() *SourceNameUpdate {
+	mutation := newSourceNameMutation(c.config, OpUpdate)
+	return &SourceNameUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceNameClient) UpdateOne(sn *SourceName) *SourceNameUpdateOne {
+	mutation := newSourceNameMutation(c.config, OpUpdateOne, withSourceName(sn))
+	return &SourceNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceNameClient) UpdateOneID(id int) *SourceNameUpdateOne {
+	mutation := newSourceNameMutation(c.config, OpUpdateOne, withSourceNameID(id))
+	return &SourceNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceName.
+func (c *SourceNameClient) Delete() *SourceNameDelete {
+	mutation := newSourceNameMutation(c.config, OpDelete)
+	return &SourceNameDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceNameClient) DeleteOne(sn *SourceName) *SourceNameDeleteOne {
+	return c.DeleteOneID(sn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceNameClient) DeleteOneID(id int) *SourceNameDeleteOne {
+	builder := c.Delete().Where(sourcename.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceNameDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceName.
+func (c *SourceNameClient) Query() *SourceNameQuery {
+	return &SourceNameQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceName},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceName entity by its id.
+func (c *SourceNameClient) Get(ctx context.Context, id int) (*SourceName, error) {
+	return c.Query().Where(sourcename.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceNameClient) GetX(ctx context.Context, id int) *SourceName {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNamespace queries the namespace edge of a SourceName.
+func (c *SourceNameClient) QueryNamespace(sn *SourceName) *SourceNamespaceQuery {
+	query := (&SourceNamespaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcename.Table, sourcename.FieldID, id),
+			sqlgraph.To(sourcenamespace.Table, sourcenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sourcename.NamespaceTable, sourcename.NamespaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(sn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceNameClient) Hooks() []Hook {
+	return c.hooks.SourceName
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceNameClient) Interceptors() []Interceptor {
+	return c.inters.SourceName
+}
+
+func (c *SourceNameClient) mutate(ctx context.Context, m *SourceNameMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceNameCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceNameUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceNameDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SourceName mutation op: %q", m.Op())
+	}
+}
+
+// SourceNamespaceClient is a client for the SourceNamespace schema.
+type SourceNamespaceClient struct {
+	config
+}
+
+// NewSourceNamespaceClient returns a client for the SourceNamespace from the given config.
+func NewSourceNamespaceClient(c config) *SourceNamespaceClient {
+	return &SourceNamespaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcenamespace.Hooks(f(g(h())))`.
+func (c *SourceNamespaceClient) Use(hooks ...Hook) {
+	c.hooks.SourceNamespace = append(c.hooks.SourceNamespace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcenamespace.Intercept(f(g(h())))`.
+func (c *SourceNamespaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceNamespace = append(c.inters.SourceNamespace, interceptors...)
+}
+
+// Create returns a builder for creating a SourceNamespace entity.
+func (c *SourceNamespaceClient) Create() *SourceNamespaceCreate {
+	mutation := newSourceNamespaceMutation(c.config, OpCreate)
+	return &SourceNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceNamespace entities.
+func (c *SourceNamespaceClient) CreateBulk(builders ...*SourceNamespaceCreate) *SourceNamespaceCreateBulk {
+	return &SourceNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceNamespace.
+func (c *SourceNamespaceClient) Update() *SourceNamespaceUpdate {
+	mutation := newSourceNamespaceMutation(c.config, OpUpdate)
+	return &SourceNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceNamespaceClient) UpdateOne(sn *SourceNamespace) *SourceNamespaceUpdateOne {
+	mutation := newSourceNamespaceMutation(c.config, OpUpdateOne, withSourceNamespace(sn))
+	return &SourceNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceNamespaceClient) UpdateOneID(id int) *SourceNamespaceUpdateOne {
+	mutation := newSourceNamespaceMutation(c.config, OpUpdateOne, withSourceNamespaceID(id))
+	return &SourceNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceNamespace.
+func (c *SourceNamespaceClient) Delete() *SourceNamespaceDelete {
+	mutation := newSourceNamespaceMutation(c.config, OpDelete)
+	return &SourceNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceNamespaceClient) DeleteOne(sn *SourceNamespace) *SourceNamespaceDeleteOne {
+	return c.DeleteOneID(sn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceNamespaceClient) DeleteOneID(id int) *SourceNamespaceDeleteOne {
+	builder := c.Delete().Where(sourcenamespace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceNamespaceDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceNamespace.
+func (c *SourceNamespaceClient) Query() *SourceNamespaceQuery {
+	return &SourceNamespaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceNamespace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceNamespace entity by its id.
+func (c *SourceNamespaceClient) Get(ctx context.Context, id int) (*SourceNamespace, error) {
+	return c.Query().Where(sourcenamespace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceNamespaceClient) GetX(ctx context.Context, id int) *SourceNamespace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySource queries the source edge of a SourceNamespace.
+func (c *SourceNamespaceClient) QuerySource(sn *SourceNamespace) *SourceTypeQuery {
+	query := (&SourceTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcenamespace.Table, sourcenamespace.FieldID, id),
+			sqlgraph.To(sourcetype.Table, sourcetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sourcenamespace.SourceTable, sourcenamespace.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(sn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNames queries the names edge of a SourceNamespace.
+func (c *SourceNamespaceClient) QueryNames(sn *SourceNamespace) *SourceNameQuery {
+	query := (&SourceNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcenamespace.Table, sourcenamespace.FieldID, id),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sourcenamespace.NamesTable, sourcenamespace.NamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceNamespaceClient) Hooks() []Hook {
+	return c.hooks.SourceNamespace
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceNamespaceClient) Interceptors() []Interceptor {
+	return c.inters.SourceNamespace
+}
+
+func (c *SourceNamespaceClient) mutate(ctx context.Context, m *SourceNamespaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SourceNamespace mutation op: %q", m.Op())
+	}
+}
+
+// SourceTypeClient is a client for the SourceType schema.
+type SourceTypeClient struct {
+	config
+}
+
+// NewSourceTypeClient returns a client for the SourceType from the given config.
+func NewSourceTypeClient(c config) *SourceTypeClient {
+	return &SourceTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcetype.Hooks(f(g(h())))`.
+func (c *SourceTypeClient) Use(hooks ...Hook) {
+	c.hooks.SourceType = append(c.hooks.SourceType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcetype.Intercept(f(g(h())))`.
+func (c *SourceTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceType = append(c.inters.SourceType, interceptors...)
+}
+
+// Create returns a builder for creating a SourceType entity.
+func (c *SourceTypeClient) Create() *SourceTypeCreate {
+	mutation := newSourceTypeMutation(c.config, OpCreate)
+	return &SourceTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceType entities.
+func (c *SourceTypeClient) CreateBulk(builders ...*SourceTypeCreate) *SourceTypeCreateBulk {
+	return &SourceTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceType.
+func (c *SourceTypeClient) Update() *SourceTypeUpdate {
+	mutation := newSourceTypeMutation(c.config, OpUpdate)
+	return &SourceTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceTypeClient) UpdateOne(st *SourceType) *SourceTypeUpdateOne {
+	mutation := newSourceTypeMutation(c.config, OpUpdateOne, withSourceType(st))
+	return &SourceTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceTypeClient) UpdateOneID(id int) *SourceTypeUpdateOne {
+	mutation := newSourceTypeMutation(c.config, OpUpdateOne, withSourceTypeID(id))
+	return &SourceTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceType.
+func (c *SourceTypeClient) Delete() *SourceTypeDelete {
+	mutation := newSourceTypeMutation(c.config, OpDelete)
+	return &SourceTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceTypeClient) DeleteOne(st *SourceType) *SourceTypeDeleteOne {
+	return c.DeleteOneID(st.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceTypeClient) DeleteOneID(id int) *SourceTypeDeleteOne {
+	builder := c.Delete().Where(sourcetype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceType.
+func (c *SourceTypeClient) Query() *SourceTypeQuery {
+	return &SourceTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceType entity by its id.
+func (c *SourceTypeClient) Get(ctx context.Context, id int) (*SourceType, error) {
+	return c.Query().Where(sourcetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceTypeClient) GetX(ctx context.Context, id int) *SourceType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNamespaces queries the namespaces edge of a SourceType.
+func (c *SourceTypeClient) QueryNamespaces(st *SourceType) *SourceNamespaceQuery {
+	query := (&SourceNamespaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := st.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcetype.Table, sourcetype.FieldID, id),
+			sqlgraph.To(sourcenamespace.Table, sourcenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sourcetype.NamespacesTable, sourcetype.NamespacesColumn),
+		)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceTypeClient) Hooks() []Hook {
+	return c.hooks.SourceType
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceTypeClient) Interceptors() []Interceptor {
+	return c.inters.SourceType
+}
+
+func (c *SourceTypeClient) mutate(ctx context.Context, m *SourceTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SourceType mutation op: %q", m.Op())
+	}
+}
+
+// VulnEqualClient is a client for the VulnEqual schema.
+type VulnEqualClient struct {
+	config
+}
+
+// NewVulnEqualClient returns a client for the VulnEqual from the given config.
+func NewVulnEqualClient(c config) *VulnEqualClient {
+	return &VulnEqualClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vulnequal.Hooks(f(g(h())))`.
+func (c *VulnEqualClient) Use(hooks ...Hook) {
+	c.hooks.VulnEqual = append(c.hooks.VulnEqual, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vulnequal.Intercept(f(g(h())))`.
+func (c *VulnEqualClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VulnEqual = append(c.inters.VulnEqual, interceptors...)
+}
+
+// Create returns a builder for creating a VulnEqual entity.
+func (c *VulnEqualClient) Create() *VulnEqualCreate {
+	mutation := newVulnEqualMutation(c.config, OpCreate)
+	return &VulnEqualCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VulnEqual entities.
+func (c *VulnEqualClient) CreateBulk(builders ...*VulnEqualCreate) *VulnEqualCreateBulk {
+	return &VulnEqualCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VulnEqual.
+func (c *VulnEqualClient) Update() *VulnEqualUpdate {
+	mutation := newVulnEqualMutation(c.config, OpUpdate)
+	return &VulnEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VulnEqualClient) UpdateOne(ve *VulnEqual) *VulnEqualUpdateOne {
+	mutation := newVulnEqualMutation(c.config, OpUpdateOne, withVulnEqual(ve))
+	return &VulnEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VulnEqualClient) UpdateOneID(id int) *VulnEqualUpdateOne {
+	mutation := newVulnEqualMutation(c.config, OpUpdateOne, withVulnEqualID(id))
+	return &VulnEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VulnEqual.
+func (c *VulnEqualClient) Delete() *VulnEqualDelete {
+	mutation := newVulnEqualMutation(c.config, OpDelete)
+	return &VulnEqualDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VulnEqualClient) DeleteOne(ve *VulnEqual) *VulnEqualDeleteOne {
+	return c.DeleteOneID(ve.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VulnEqualClient) DeleteOneID(id int) *VulnEqualDeleteOne {
+	builder := c.Delete().Where(vulnequal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VulnEqualDeleteOne{builder}
+}
+
+// Query returns a query builder for VulnEqual.
+func (c *VulnEqualClient) Query() *VulnEqualQuery {
+	return &VulnEqualQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVulnEqual},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VulnEqual entity by its id.
+func (c *VulnEqualClient) Get(ctx context.Context, id int) (*VulnEqual, error) {
+	return c.Query().Where(vulnequal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VulnEqualClient) GetX(ctx context.Context, id int) *VulnEqual {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVulnerabilityA queries the vulnerability_a edge of a VulnEqual.
+func (c *VulnEqualClient) QueryVulnerabilityA(ve *VulnEqual) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ve.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnequal.Table, vulnequal.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnequal.VulnerabilityATable, vulnequal.VulnerabilityAColumn),
+		)
+		fromV = sqlgraph.Neighbors(ve.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVulnerabilityB queries the vulnerability_b edge of a VulnEqual.
+func (c *VulnEqualClient) QueryVulnerabilityB(ve *VulnEqual) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ve.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnequal.Table, vulnequal.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnequal.VulnerabilityBTable, vulnequal.VulnerabilityBColumn),
+		)
+		fromV = sqlgraph.Neighbors(ve.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VulnEqualClient) Hooks() []Hook {
+	return c.hooks.VulnEqual
+}
+
+// Interceptors returns the client interceptors.
+func (c *VulnEqualClient) Interceptors() []Interceptor {
+	return c.inters.VulnEqual
+}
+
+func (c *VulnEqualClient) mutate(ctx context.Context, m *VulnEqualMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VulnEqualCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VulnEqualUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VulnEqualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VulnEqualDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown VulnEqual mutation op: %q", m.Op())
+	}
+}
+
+// VulnerabilityIDClient is a client for the VulnerabilityID schema.
+type VulnerabilityIDClient struct {
+	config
+}
+
+// NewVulnerabilityIDClient returns a client for the VulnerabilityID from the given config.
+func NewVulnerabilityIDClient(c config) *VulnerabilityIDClient {
+	return &VulnerabilityIDClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vulnerabilityid.Hooks(f(g(h())))`.
+func (c *VulnerabilityIDClient) Use(hooks ...Hook) {
+	c.hooks.VulnerabilityID = append(c.hooks.VulnerabilityID, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vulnerabilityid.Intercept(f(g(h())))`.
+func (c *VulnerabilityIDClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VulnerabilityID = append(c.inters.VulnerabilityID, interceptors...)
+}
+
+// Create returns a builder for creating a VulnerabilityID entity.
+func (c *VulnerabilityIDClient) Create() *VulnerabilityIDCreate {
+	mutation := newVulnerabilityIDMutation(c.config, OpCreate)
+	return &VulnerabilityIDCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VulnerabilityID entities.
+func (c *VulnerabilityIDClient) CreateBulk(builders ...*VulnerabilityIDCreate) *VulnerabilityIDCreateBulk {
+	return &VulnerabilityIDCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VulnerabilityID.
+func (c *VulnerabilityIDClient) Update() *VulnerabilityIDUpdate {
+	mutation := newVulnerabilityIDMutation(c.config, OpUpdate)
+	return &VulnerabilityIDUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VulnerabilityIDClient) UpdateOne(vi *VulnerabilityID) *VulnerabilityIDUpdateOne {
+	mutation := newVulnerabilityIDMutation(c.config, OpUpdateOne, withVulnerabilityID(vi))
+	return &VulnerabilityIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VulnerabilityIDClient) UpdateOneID(id int) *VulnerabilityIDUpdateOne {
+	mutation := newVulnerabilityIDMutation(c.config, OpUpdateOne, withVulnerabilityIDID(id))
+	return &VulnerabilityIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VulnerabilityID.
+func (c *VulnerabilityIDClient) Delete() *VulnerabilityIDDelete {
+	mutation := newVulnerabilityIDMutation(c.config, OpDelete)
+	return &VulnerabilityIDDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VulnerabilityIDClient) DeleteOne(vi *VulnerabilityID) *VulnerabilityIDDeleteOne {
+	return c.DeleteOneID(vi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VulnerabilityIDClient) DeleteOneID(id int) *VulnerabilityIDDeleteOne {
+	builder := c.Delete().Where(vulnerabilityid.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VulnerabilityIDDeleteOne{builder}
+}
+
+// Query returns a query builder for VulnerabilityID.
+func (c *VulnerabilityIDClient) Query() *VulnerabilityIDQuery {
+	return &VulnerabilityIDQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVulnerabilityID},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VulnerabilityID entity by its id.
+func (c *VulnerabilityIDClient) Get(ctx context.Context, id int) (*VulnerabilityID, error) {
+	return c.Query().Where(vulnerabilityid.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VulnerabilityIDClient) GetX(ctx context.Context, id int) *VulnerabilityID {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryType queries the type edge of a VulnerabilityID.
+func (c *VulnerabilityIDClient) QueryType(vi *VulnerabilityID) *VulnerabilityTypeQuery {
+	query := (&VulnerabilityTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityid.Table, vulnerabilityid.FieldID, id),
+			sqlgraph.To(vulnerabilitytype.Table, vulnerabilitytype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vulnerabilityid.TypeTable, vulnerabilityid.TypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(vi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VulnerabilityIDClient) Hooks() []Hook {
+	return c.hooks.VulnerabilityID
+}
+
+// Interceptors returns the client interceptors.
+func (c *VulnerabilityIDClient) Interceptors() []Interceptor {
+	return c.inters.VulnerabilityID
+}
+
+func (c *VulnerabilityIDClient) mutate(ctx context.Context, m *VulnerabilityIDMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VulnerabilityIDCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VulnerabilityIDUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VulnerabilityIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VulnerabilityIDDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown VulnerabilityID mutation op: %q", m.Op())
+	}
+}
+
+// VulnerabilityTypeClient is a client for the VulnerabilityType schema.
+type VulnerabilityTypeClient struct {
+	config
+}
+
+// NewVulnerabilityTypeClient returns a client for the VulnerabilityType from the given config.
+func NewVulnerabilityTypeClient(c config) *VulnerabilityTypeClient {
+	return &VulnerabilityTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vulnerabilitytype.Hooks(f(g(h())))`.
+func (c *VulnerabilityTypeClient) Use(hooks ...Hook) {
+	c.hooks.VulnerabilityType = append(c.hooks.VulnerabilityType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vulnerabilitytype.Intercept(f(g(h())))`.
+func (c *VulnerabilityTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VulnerabilityType = append(c.inters.VulnerabilityType, interceptors...)
+}
+
+// Create returns a builder for creating a VulnerabilityType entity.
+func (c *VulnerabilityTypeClient) Create() *VulnerabilityTypeCreate {
+	mutation := newVulnerabilityTypeMutation(c.config, OpCreate)
+	return &VulnerabilityTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VulnerabilityType entities.
+func (c *VulnerabilityTypeClient) CreateBulk(builders ...*VulnerabilityTypeCreate) *VulnerabilityTypeCreateBulk {
+	return &VulnerabilityTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VulnerabilityType.
+func (c *VulnerabilityTypeClient) Update() *VulnerabilityTypeUpdate {
+	mutation := newVulnerabilityTypeMutation(c.config, OpUpdate)
+	return &VulnerabilityTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VulnerabilityTypeClient) UpdateOne(vt *VulnerabilityType) *VulnerabilityTypeUpdateOne {
+	mutation := newVulnerabilityTypeMutation(c.config, OpUpdateOne, withVulnerabilityType(vt))
+	return &VulnerabilityTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VulnerabilityTypeClient) UpdateOneID(id int) *VulnerabilityTypeUpdateOne {
+	mutation := newVulnerabilityTypeMutation(c.config, OpUpdateOne, withVulnerabilityTypeID(id))
+	return &VulnerabilityTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VulnerabilityType.
+func (c *VulnerabilityTypeClient) Delete() *VulnerabilityTypeDelete {
+	mutation := newVulnerabilityTypeMutation(c.config, OpDelete)
+	return &VulnerabilityTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VulnerabilityTypeClient) DeleteOne(vt *VulnerabilityType) *VulnerabilityTypeDeleteOne {
+	return c.DeleteOneID(vt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VulnerabilityTypeClient) DeleteOneID(id int) *VulnerabilityTypeDeleteOne {
+	builder := c.Delete().Where(vulnerabilitytype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VulnerabilityTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for VulnerabilityType.
+func (c *VulnerabilityTypeClient) Query() *VulnerabilityTypeQuery {
+	return &VulnerabilityTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVulnerabilityType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VulnerabilityType entity by its id.
+func (c *VulnerabilityTypeClient) Get(ctx context.Context, id int) (*VulnerabilityType, error) {
+	return c.Query().Where(vulnerabilitytype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VulnerabilityTypeClient) GetX(ctx context.Context, id int) *VulnerabilityType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVulnerabilityIds queries the vulnerability_ids edge of a VulnerabilityType.
+func (c *VulnerabilityTypeClient) QueryVulnerabilityIds(vt *VulnerabilityType) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilitytype.Table, vulnerabilitytype.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vulnerabilitytype.VulnerabilityIdsTable, vulnerabilitytype.VulnerabilityIdsColumn),
+		)
+		fromV = sqlgraph.Neighbors(vt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VulnerabilityTypeClient) Hooks() []Hook {
+	return c.hooks.VulnerabilityType
+}
+
+// Interceptors returns the client interceptors.
+func (c *VulnerabilityTypeClient) Interceptors() []Interceptor {
+	return c.inters.VulnerabilityType
+}
+
+func (c *VulnerabilityTypeClient) mutate(ctx context.Context, m *VulnerabilityTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VulnerabilityTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VulnerabilityTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VulnerabilityTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VulnerabilityTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown VulnerabilityType mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Artifact, BuilderNode, CertifyBad, CertifyGood, CertifyLegal,
+		CertifyVEXStatement, CertifyVuln, HasMetadata, HasSBOM, HasSLSA, HashEqual,
+		IsDependency, IsOccurrence, PackageName, PackageNamespace, PackageType,
+		PackageVersion, PkgEqual, PointOfContact, Scorecard, SourceName,
+		SourceNamespace, SourceType, VulnEqual, VulnerabilityID,
+		VulnerabilityType []ent.Hook
+	}
+	inters struct {
+		Artifact, BuilderNode, CertifyBad, CertifyGood, CertifyLegal,
+		CertifyVEXStatement, CertifyVuln, HasMetadata, HasSBOM, HasSLSA, HashEqual,
+		IsDependency, IsOccurrence, PackageName, PackageNamespace, PackageType,
+		PackageVersion, PkgEqual, PointOfContact, Scorecard, SourceName,
+		SourceNamespace, SourceType, VulnEqual, VulnerabilityID,
+		VulnerabilityType []ent.Interceptor
+	}
+)
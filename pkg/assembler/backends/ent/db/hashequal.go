@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+)
+
+// HashEqual is the model entity for the HashEqual schema.
+type HashEqual struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArtifactAID holds the value of the "artifact_a_id" field.
+	ArtifactAID int `json:"artifact_a_id,omitempty"`
+	// ArtifactBID holds the value of the "artifact_b_id" field.
+	ArtifactBID int `json:"artifact_b_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HashEqualQuery when eager-loading is set.
+	Edges HashEqualEdges `json:"edges"`
+}
+
+// HashEqualEdges holds the relations/edges for other nodes in the graph.
+type HashEqualEdges struct {
+	// ArtifactA holds the value of the artifact_a edge.
+	ArtifactA *Artifact `json:"artifact_a,omitempty"`
+	// ArtifactB holds the value of the artifact_b edge.
+	ArtifactB *Artifact `json:"artifact_b,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ArtifactAOrErr returns the ArtifactA value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HashEqualEdges) ArtifactAOrErr() (*Artifact, error) {
+	if e.loadedTypes[0] {
+		if e.ArtifactA == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.ArtifactA, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact_a"}
+}
+
+// ArtifactBOrErr returns the ArtifactB value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HashEqualEdges) ArtifactBOrErr() (*Artifact, error) {
+	if e.loadedTypes[1] {
+		if e.ArtifactB == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.ArtifactB, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact_b"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HashEqual) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hashequal.FieldID, hashequal.FieldArtifactAID, hashequal.FieldArtifactBID:
+			values[i] = new(sql.NullInt64)
+		case hashequal.FieldJustification, hashequal.FieldOrigin, hashequal.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type HashEqual", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HashEqual fields.
+func (he *HashEqual) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hashequal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			he.ID = int(value.Int64)
+		case hashequal.FieldArtifactAID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_a_id", values[i])
+			} else if value.Valid {
+				he.ArtifactAID = int(value.Int64)
+			}
+		case hashequal.FieldArtifactBID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_b_id", values[i])
+			} else if value.Valid {
+				he.ArtifactBID = int(value.Int64)
+			}
+		case hashequal.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				he.Justification = value.String
+			}
+		case hashequal.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				he.Origin = value.String
+			}
+		case hashequal.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				he.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryArtifactA queries the "artifact_a" edge of the HashEqual entity.
+func (he *HashEqual) QueryArtifactA() *ArtifactQuery {
+	return NewHashEqualClient(he.config).QueryArtifactA(he)
+}
+
+// QueryArtifactB queries the "artifact_b" edge of the HashEqual entity.
+func (he *HashEqual) QueryArtifactB() *ArtifactQuery {
+	return NewHashEqualClient(he.config).QueryArtifactB(he)
+}
+
+// Update returns a builder for updating this HashEqual.
+// Note that you need to call HashEqual.Unwrap() before calling this method if this HashEqual
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (he *HashEqual) Update() *HashEqualUpdateOne {
+	return NewHashEqualClient(he.config).UpdateOne(he)
+}
+
+// Unwrap unwraps the HashEqual entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (he *HashEqual) Unwrap() *HashEqual {
+	_tx, ok := he.config.driver.(*txDriver)
+	if !ok {
+		panic("db: HashEqual is not a transactional entity")
+	}
+	he.config.driver = _tx.drv
+	return he
+}
+
+// String implements the fmt.Stringer.
+func (he *HashEqual) String() string {
+	var builder strings.Builder
+	builder.WriteString("HashEqual(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", he.ID))
+	builder.WriteString("artifact_a_id=")
+	builder.WriteString(fmt.Sprintf("%v", he.ArtifactAID))
+	builder.WriteString(", ")
+	builder.WriteString("artifact_b_id=")
+	builder.WriteString(fmt.Sprintf("%v", he.ArtifactBID))
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(he.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(he.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(he.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HashEquals is a parsable slice of HashEqual.
+type HashEquals []*HashEqual
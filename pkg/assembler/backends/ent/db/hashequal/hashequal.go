@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hashequal
+
+const (
+	// Label holds the string label denoting the hashequal type in the database.
+	Label = "hash_equal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldArtifactAID holds the string denoting the artifact_a_id field in the database.
+	FieldArtifactAID = "artifact_a_id"
+	// FieldArtifactBID holds the string denoting the artifact_b_id field in the database.
+	FieldArtifactBID = "artifact_b_id"
+	// FieldJustification holds the string denoting the justification field in the database.
+	FieldJustification = "justification"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgeArtifactA holds the string denoting the artifact_a edge name in mutations.
+	EdgeArtifactA = "artifact_a"
+	// EdgeArtifactB holds the string denoting the artifact_b edge name in mutations.
+	EdgeArtifactB = "artifact_b"
+	// Table holds the table name of the hashequal in the database.
+	Table = "hash_equals"
+	// ArtifactATable is the table that holds the artifact_a relation/edge.
+	ArtifactATable = "hash_equals"
+	// ArtifactAInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactAInverseTable = "artifacts"
+	// ArtifactAColumn is the table column denoting the artifact_a relation/edge.
+	ArtifactAColumn = "artifact_a_id"
+	// ArtifactBTable is the table that holds the artifact_b relation/edge.
+	ArtifactBTable = "hash_equals"
+	// ArtifactBInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactBInverseTable = "artifacts"
+	// ArtifactBColumn is the table column denoting the artifact_b relation/edge.
+	ArtifactBColumn = "artifact_b_id"
+)
+
+// Columns holds all SQL columns for hashequal fields.
+var Columns = []string{
+	FieldID,
+	FieldArtifactAID,
+	FieldArtifactBID,
+	FieldJustification,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
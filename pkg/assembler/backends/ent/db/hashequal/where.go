@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hashequal
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLTE(FieldID, id))
+}
+
+// ArtifactAID applies equality check predicate on the "artifact_a_id" field. It's identical to ArtifactAIDEQ.
+func ArtifactAID(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldArtifactAID, v))
+}
+
+// ArtifactBID applies equality check predicate on the "artifact_b_id" field. It's identical to ArtifactBIDEQ.
+func ArtifactBID(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldArtifactBID, v))
+}
+
+// Justification applies equality check predicate on the "justification" field. It's identical to JustificationEQ.
+func Justification(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldJustification, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldCollector, v))
+}
+
+// ArtifactAIDEQ applies the EQ predicate on the "artifact_a_id" field.
+func ArtifactAIDEQ(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldArtifactAID, v))
+}
+
+// ArtifactAIDNEQ applies the NEQ predicate on the "artifact_a_id" field.
+func ArtifactAIDNEQ(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldArtifactAID, v))
+}
+
+// ArtifactAIDIn applies the In predicate on the "artifact_a_id" field.
+func ArtifactAIDIn(vs ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldArtifactAID, vs...))
+}
+
+// ArtifactAIDNotIn applies the NotIn predicate on the "artifact_a_id" field.
+func ArtifactAIDNotIn(vs ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldArtifactAID, vs...))
+}
+
+// ArtifactBIDEQ applies the EQ predicate on the "artifact_b_id" field.
+func ArtifactBIDEQ(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldArtifactBID, v))
+}
+
+// ArtifactBIDNEQ applies the NEQ predicate on the "artifact_b_id" field.
+func ArtifactBIDNEQ(v int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldArtifactBID, v))
+}
+
+// ArtifactBIDIn applies the In predicate on the "artifact_b_id" field.
+func ArtifactBIDIn(vs ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldArtifactBID, vs...))
+}
+
+// ArtifactBIDNotIn applies the NotIn predicate on the "artifact_b_id" field.
+func ArtifactBIDNotIn(vs ...int) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldArtifactBID, vs...))
+}
+
+// JustificationEQ applies the EQ predicate on the "justification" field.
+func JustificationEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldJustification, v))
+}
+
+// JustificationNEQ applies the NEQ predicate on the "justification" field.
+func JustificationNEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldJustification, v))
+}
+
+// JustificationIn applies the In predicate on the "justification" field.
+func JustificationIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldJustification, vs...))
+}
+
+// JustificationNotIn applies the NotIn predicate on the "justification" field.
+func JustificationNotIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldJustification, vs...))
+}
+
+// JustificationGT applies the GT predicate on the "justification" field.
+func JustificationGT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGT(FieldJustification, v))
+}
+
+// JustificationGTE applies the GTE predicate on the "justification" field.
+func JustificationGTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGTE(FieldJustification, v))
+}
+
+// JustificationLT applies the LT predicate on the "justification" field.
+func JustificationLT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLT(FieldJustification, v))
+}
+
+// JustificationLTE applies the LTE predicate on the "justification" field.
+func JustificationLTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLTE(FieldJustification, v))
+}
+
+// JustificationContains applies the Contains predicate on the "justification" field.
+func JustificationContains(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContains(FieldJustification, v))
+}
+
+// JustificationHasPrefix applies the HasPrefix predicate on the "justification" field.
+func JustificationHasPrefix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasPrefix(FieldJustification, v))
+}
+
+// JustificationHasSuffix applies the HasSuffix predicate on the "justification" field.
+func JustificationHasSuffix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasSuffix(FieldJustification, v))
+}
+
+// JustificationEqualFold applies the EqualFold predicate on the "justification" field.
+func JustificationEqualFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEqualFold(FieldJustification, v))
+}
+
+// JustificationContainsFold applies the ContainsFold predicate on the "justification" field.
+func JustificationContainsFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContainsFold(FieldJustification, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// HasArtifactA applies the HasEdge predicate on the "artifact_a" edge.
+func HasArtifactA() predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactATable, ArtifactAColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactAWith applies the HasEdge predicate on the "artifact_a" edge with a given conditions (other predicates).
+func HasArtifactAWith(preds ...predicate.Artifact) predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ArtifactAInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactATable, ArtifactAColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtifactB applies the HasEdge predicate on the "artifact_b" edge.
+func HasArtifactB() predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactBTable, ArtifactBColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactBWith applies the HasEdge predicate on the "artifact_b" edge with a given conditions (other predicates).
+func HasArtifactBWith(preds ...predicate.Artifact) predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ArtifactBInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactBTable, ArtifactBColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HashEqual) predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HashEqual) predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HashEqual) predicate.HashEqual {
+	return predicate.HashEqual(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
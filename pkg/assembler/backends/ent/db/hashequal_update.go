@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HashEqualUpdate is the builder for updating HashEqual entities.
+type HashEqualUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HashEqualMutation
+}
+
+// Where appends a list predicates to the HashEqualUpdate builder.
+func (heu *HashEqualUpdate) Where(ps ...predicate.HashEqual) *HashEqualUpdate {
+	heu.mutation.Where(ps...)
+	return heu
+}
+
+// SetArtifactAID sets the "artifact_a_id" field.
+func (heu *HashEqualUpdate) SetArtifactAID(i int) *HashEqualUpdate {
+	heu.mutation.SetArtifactAID(i)
+	return heu
+}
+
+// SetArtifactBID sets the "artifact_b_id" field.
+func (heu *HashEqualUpdate) SetArtifactBID(i int) *HashEqualUpdate {
+	heu.mutation.SetArtifactBID(i)
+	return heu
+}
+
+// SetJustification sets the "justification" field.
+func (heu *HashEqualUpdate) SetJustification(s string) *HashEqualUpdate {
+	heu.mutation.SetJustification(s)
+	return heu
+}
+
+// SetOrigin sets the "origin" field.
+func (heu *HashEqualUpdate) SetOrigin(s string) *HashEqualUpdate {
+	heu.mutation.SetOrigin(s)
+	return heu
+}
+
+// SetCollector sets the "collector" field.
+func (heu *HashEqualUpdate) SetCollector(s string) *HashEqualUpdate {
+	heu.mutation.SetCollector(s)
+	return heu
+}
+
+// SetArtifactA sets the "artifact_a" edge to the Artifact entity.
+func (heu *HashEqualUpdate) SetArtifactA(a *Artifact) *HashEqualUpdate {
+	return heu.SetArtifactAID(a.ID)
+}
+
+// SetArtifactB sets the "artifact_b" edge to the Artifact entity.
+func (heu *HashEqualUpdate) SetArtifactB(a *Artifact) *HashEqualUpdate {
+	return heu.SetArtifactBID(a.ID)
+}
+
+// Mutation returns the HashEqualMutation object of the builder.
+func (heu *HashEqualUpdate) Mutation() *HashEqualMutation {
+	return heu.mutation
+}
+
+// ClearArtifactA clears the "artifact_a" edge to the Artifact entity.
+func (heu *HashEqualUpdate) ClearArtifactA() *HashEqualUpdate {
+	heu.mutation.ClearArtifactA()
+	return heu
+}
+
+// ClearArtifactB clears the "artifact_b" edge to the Artifact entity.
+func (heu *HashEqualUpdate) ClearArtifactB() *HashEqualUpdate {
+	heu.mutation.ClearArtifactB()
+	return heu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (heu *HashEqualUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, HashEqualMutation](ctx, heu.sqlSave, heu.mutation, heu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (heu *HashEqualUpdate) SaveX(ctx context.Context) int {
+	affected, err := heu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (heu *HashEqualUpdate) Exec(ctx context.Context) error {
+	_, err := heu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (heu *HashEqualUpdate) ExecX(ctx context.Context) {
+	if err := heu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (heu *HashEqualUpdate) check() error {
+	if _, ok := heu.mutation.ArtifactAID(); heu.mutation.ArtifactACleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HashEqual.artifact_a"`)
+	}
+	if _, ok := heu.mutation.ArtifactBID(); heu.mutation.ArtifactBCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HashEqual.artifact_b"`)
+	}
+	return nil
+}
+
+func (heu *HashEqualUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := heu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hashequal.Table, hashequal.Columns, sqlgraph.NewFieldSpec(hashequal.FieldID, field.TypeInt))
+	if ps := heu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := heu.mutation.Justification(); ok {
+		_spec.SetField(hashequal.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := heu.mutation.Origin(); ok {
+		_spec.SetField(hashequal.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := heu.mutation.Collector(); ok {
+		_spec.SetField(hashequal.FieldCollector, field.TypeString, value)
+	}
+	if heu.mutation.ArtifactACleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactATable,
+			Columns: []string{hashequal.ArtifactAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := heu.mutation.ArtifactAIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactATable,
+			Columns: []string{hashequal.ArtifactAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if heu.mutation.ArtifactBCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactBTable,
+			Columns: []string{hashequal.ArtifactBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := heu.mutation.ArtifactBIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactBTable,
+			Columns: []string{hashequal.ArtifactBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, heu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hashequal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	heu.mutation.done = true
+	return n, nil
+}
+
+// HashEqualUpdateOne is the builder for updating a single HashEqual entity.
+type HashEqualUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HashEqualMutation
+}
+
+// SetArtifactAID sets the "artifact_a_id" field.
+func (heuo *HashEqualUpdateOne) SetArtifactAID(i int) *HashEqualUpdateOne {
+	heuo.mutation.SetArtifactAID(i)
+	return heuo
+}
+
+// SetArtifactBID sets the "artifact_b_id" field.
+func (heuo *HashEqualUpdateOne) SetArtifactBID(i int) *HashEqualUpdateOne {
+	heuo.mutation.SetArtifactBID(i)
+	return heuo
+}
+
+// SetJustification sets the "justification" field.
+func (heuo *HashEqualUpdateOne) SetJustification(s string) *HashEqualUpdateOne {
+	heuo.mutation.SetJustification(s)
+	return heuo
+}
+
+// SetOrigin sets the "origin" field.
+func (heuo *HashEqualUpdateOne) SetOrigin(s string) *HashEqualUpdateOne {
+	heuo.mutation.SetOrigin(s)
+	return heuo
+}
+
+// SetCollector sets the "collector" field.
+func (heuo *HashEqualUpdateOne) SetCollector(s string) *HashEqualUpdateOne {
+	heuo.mutation.SetCollector(s)
+	return heuo
+}
+
+// SetArtifactA sets the "artifact_a" edge to the Artifact entity.
+func (heuo *HashEqualUpdateOne) SetArtifactA(a *Artifact) *HashEqualUpdateOne {
+	return heuo.SetArtifactAID(a.ID)
+}
+
+// SetArtifactB sets the "artifact_b" edge to the Artifact entity.
+func (heuo *HashEqualUpdateOne) SetArtifactB(a *Artifact) *HashEqualUpdateOne {
+	return heuo.SetArtifactBID(a.ID)
+}
+
+// Mutation returns the HashEqualMutation object of the builder.
+func (heuo *HashEqualUpdateOne) Mutation() *HashEqualMutation {
+	return heuo.mutation
+}
+
+// ClearArtifactA clears the "artifact_a" edge to the Artifact entity.
+func (heuo *HashEqualUpdateOne) ClearArtifactA() *HashEqualUpdateOne {
+	heuo.mutation.ClearArtifactA()
+	return heuo
+}
+
+// ClearArtifactB clears the "artifact_b" edge to the Artifact entity.
+func (heuo *HashEqualUpdateOne) ClearArtifactB() *HashEqualUpdateOne {
+	heuo.mutation.ClearArtifactB()
+	return heuo
+}
+
+// Where appends a list predicates to the HashEqualUpdate builder.
+func (heuo *HashEqualUpdateOne) Where(ps ...predicate.HashEqual) *HashEqualUpdateOne {
+	heuo.mutation.Where(ps...)
+	return heuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (heuo *HashEqualUpdateOne) Select(field string, fields ...string) *HashEqualUpdateOne {
+	heuo.fields = append([]string{field}, fields...)
+	return heuo
+}
+
+// Save executes the query and returns the updated HashEqual entity.
+func (heuo *HashEqualUpdateOne) Save(ctx context.Context) (*HashEqual, error) {
+	return withHooks[*HashEqual, HashEqualMutation](ctx, heuo.sqlSave, heuo.mutation, heuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (heuo *HashEqualUpdateOne) SaveX(ctx context.Context) *HashEqual {
+	node, err := heuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (heuo *HashEqualUpdateOne) Exec(ctx context.Context) error {
+	_, err := heuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (heuo *HashEqualUpdateOne) ExecX(ctx context.Context) {
+	if err := heuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (heuo *HashEqualUpdateOne) check() error {
+	if _, ok := heuo.mutation.ArtifactAID(); heuo.mutation.ArtifactACleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HashEqual.artifact_a"`)
+	}
+	if _, ok := heuo.mutation.ArtifactBID(); heuo.mutation.ArtifactBCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HashEqual.artifact_b"`)
+	}
+	return nil
+}
+
+func (heuo *HashEqualUpdateOne) sqlSave(ctx context.Context) (_node *HashEqual, err error) {
+	if err := heuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hashequal.Table, hashequal.Columns, sqlgraph.NewFieldSpec(hashequal.FieldID, field.TypeInt))
+	id, ok := heuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "HashEqual.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := heuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hashequal.FieldID)
+		for _, f := range fields {
+			if !hashequal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != hashequal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := heuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := heuo.mutation.Justification(); ok {
+		_spec.SetField(hashequal.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := heuo.mutation.Origin(); ok {
+		_spec.SetField(hashequal.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := heuo.mutation.Collector(); ok {
+		_spec.SetField(hashequal.FieldCollector, field.TypeString, value)
+	}
+	if heuo.mutation.ArtifactACleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactATable,
+			Columns: []string{hashequal.ArtifactAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := heuo.mutation.ArtifactAIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactATable,
+			Columns: []string{hashequal.ArtifactAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if heuo.mutation.ArtifactBCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactBTable,
+			Columns: []string{hashequal.ArtifactBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := heuo.mutation.ArtifactBIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hashequal.ArtifactBTable,
+			Columns: []string{hashequal.ArtifactBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HashEqual{config: heuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, heuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hashequal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	heuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+)
+
+// HasSBOM is the model entity for the HasSBOM schema.
+type HasSBOM struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageID holds the value of the "package_id" field.
+	PackageID *int `json:"package_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID *int `json:"artifact_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// DownloadLocation holds the value of the "download_location" field.
+	DownloadLocation string `json:"download_location,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HasSBOMQuery when eager-loading is set.
+	Edges HasSBOMEdges `json:"edges"`
+}
+
+// HasSBOMEdges holds the relations/edges for other nodes in the graph.
+type HasSBOMEdges struct {
+	// Package holds the value of the package edge.
+	Package *PackageVersion `json:"package,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PackageOrErr returns the Package value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HasSBOMEdges) PackageOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.Package == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.Package, nil
+	}
+	return nil, &NotLoadedError{edge: "package"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HasSBOMEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[1] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HasSBOM) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hassbom.FieldID, hassbom.FieldPackageID, hassbom.FieldArtifactID:
+			values[i] = new(sql.NullInt64)
+		case hassbom.FieldURI, hassbom.FieldAlgorithm, hassbom.FieldDigest, hassbom.FieldDownloadLocation, hassbom.FieldOrigin, hassbom.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type HasSBOM", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HasSBOM fields.
+func (hs *HasSBOM) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hassbom.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			hs.ID = int(value.Int64)
+		case hassbom.FieldPackageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value.Valid {
+				hs.PackageID = new(int)
+				*hs.PackageID = int(value.Int64)
+			}
+		case hassbom.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				hs.ArtifactID = new(int)
+				*hs.ArtifactID = int(value.Int64)
+			}
+		case hassbom.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				hs.URI = value.String
+			}
+		case hassbom.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				hs.Algorithm = value.String
+			}
+		case hassbom.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				hs.Digest = value.String
+			}
+		case hassbom.FieldDownloadLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field download_location", values[i])
+			} else if value.Valid {
+				hs.DownloadLocation = value.String
+			}
+		case hassbom.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				hs.Origin = value.String
+			}
+		case hassbom.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				hs.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackage queries the "package" edge of the HasSBOM entity.
+func (hs *HasSBOM) QueryPackage() *PackageVersionQuery {
+	return NewHasSBOMClient(hs.config).QueryPackage(hs)
+}
+
+// QueryArtifact queries the "artifact" edge of the HasSBOM entity.
+func (hs *HasSBOM) QueryArtifact() *ArtifactQuery {
+	return NewHasSBOMClient(hs.config).QueryArtifact(hs)
+}
+
+// Update returns a builder for updating this HasSBOM.
+// Note that you need to call HasSBOM.Unwrap() before calling this method if this HasSBOM
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hs *HasSBOM) Update() *HasSBOMUpdateOne {
+	return NewHasSBOMClient(hs.config).UpdateOne(hs)
+}
+
+// Unwrap unwraps the HasSBOM entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hs *HasSBOM) Unwrap() *HasSBOM {
+	_tx, ok := hs.config.driver.(*txDriver)
+	if !ok {
+		panic("db: HasSBOM is not a transactional entity")
+	}
+	hs.config.driver = _tx.drv
+	return hs
+}
+
+// String implements the fmt.Stringer.
+func (hs *HasSBOM) String() string {
+	var builder strings.Builder
+	builder.WriteString("HasSBOM(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hs.ID))
+	if v := hs.PackageID; v != nil {
+		builder.WriteString("package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := hs.ArtifactID; v != nil {
+		builder.WriteString("artifact_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(hs.URI)
+	builder.WriteString(", ")
+	builder.WriteString("algorithm=")
+	builder.WriteString(hs.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(hs.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("download_location=")
+	builder.WriteString(hs.DownloadLocation)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(hs.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(hs.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HasSBOMs is a parsable slice of HasSBOM.
+type HasSBOMs []*HasSBOM
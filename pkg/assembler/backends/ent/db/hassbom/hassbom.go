@@ -0,0 +1,69 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hassbom
+
+const (
+	// Label holds the string label denoting the hassbom type in the database.
+	Label = "has_sbom"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldArtifactID holds the string denoting the artifact_id field in the database.
+	FieldArtifactID = "artifact_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldDownloadLocation holds the string denoting the download_location field in the database.
+	FieldDownloadLocation = "download_location"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// EdgeArtifact holds the string denoting the artifact edge name in mutations.
+	EdgeArtifact = "artifact"
+	// Table holds the table name of the hassbom in the database.
+	Table = "has_sboms"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "has_sboms"
+	// PackageInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageInverseTable = "package_versions"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_id"
+	// ArtifactTable is the table that holds the artifact relation/edge.
+	ArtifactTable = "has_sboms"
+	// ArtifactInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactInverseTable = "artifacts"
+	// ArtifactColumn is the table column denoting the artifact relation/edge.
+	ArtifactColumn = "artifact_id"
+)
+
+// Columns holds all SQL columns for hassbom fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldArtifactID,
+	FieldURI,
+	FieldAlgorithm,
+	FieldDigest,
+	FieldDownloadLocation,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
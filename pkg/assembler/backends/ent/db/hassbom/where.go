@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hassbom
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldID, id))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldPackageID, v))
+}
+
+// ArtifactID applies equality check predicate on the "artifact_id" field. It's identical to ArtifactIDEQ.
+func ArtifactID(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldURI, v))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldDigest, v))
+}
+
+// DownloadLocation applies equality check predicate on the "download_location" field. It's identical to DownloadLocationEQ.
+func DownloadLocation(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldDownloadLocation, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldCollector, v))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// PackageIDIsNil applies the IsNil predicate on the "package_id" field.
+func PackageIDIsNil() predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIsNull(FieldPackageID))
+}
+
+// PackageIDNotNil applies the NotNil predicate on the "package_id" field.
+func PackageIDNotNil() predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotNull(FieldPackageID))
+}
+
+// ArtifactIDEQ applies the EQ predicate on the "artifact_id" field.
+func ArtifactIDEQ(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDNEQ applies the NEQ predicate on the "artifact_id" field.
+func ArtifactIDNEQ(v int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDIn applies the In predicate on the "artifact_id" field.
+func ArtifactIDIn(vs ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDNotIn applies the NotIn predicate on the "artifact_id" field.
+func ArtifactIDNotIn(vs ...int) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDIsNil applies the IsNil predicate on the "artifact_id" field.
+func ArtifactIDIsNil() predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIsNull(FieldArtifactID))
+}
+
+// ArtifactIDNotNil applies the NotNil predicate on the "artifact_id" field.
+func ArtifactIDNotNil() predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotNull(FieldArtifactID))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldURI, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// DownloadLocationEQ applies the EQ predicate on the "download_location" field.
+func DownloadLocationEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldDownloadLocation, v))
+}
+
+// DownloadLocationNEQ applies the NEQ predicate on the "download_location" field.
+func DownloadLocationNEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldDownloadLocation, v))
+}
+
+// DownloadLocationIn applies the In predicate on the "download_location" field.
+func DownloadLocationIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldDownloadLocation, vs...))
+}
+
+// DownloadLocationNotIn applies the NotIn predicate on the "download_location" field.
+func DownloadLocationNotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldDownloadLocation, vs...))
+}
+
+// DownloadLocationGT applies the GT predicate on the "download_location" field.
+func DownloadLocationGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldDownloadLocation, v))
+}
+
+// DownloadLocationGTE applies the GTE predicate on the "download_location" field.
+func DownloadLocationGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldDownloadLocation, v))
+}
+
+// DownloadLocationLT applies the LT predicate on the "download_location" field.
+func DownloadLocationLT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldDownloadLocation, v))
+}
+
+// DownloadLocationLTE applies the LTE predicate on the "download_location" field.
+func DownloadLocationLTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldDownloadLocation, v))
+}
+
+// DownloadLocationContains applies the Contains predicate on the "download_location" field.
+func DownloadLocationContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldDownloadLocation, v))
+}
+
+// DownloadLocationHasPrefix applies the HasPrefix predicate on the "download_location" field.
+func DownloadLocationHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldDownloadLocation, v))
+}
+
+// DownloadLocationHasSuffix applies the HasSuffix predicate on the "download_location" field.
+func DownloadLocationHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldDownloadLocation, v))
+}
+
+// DownloadLocationEqualFold applies the EqualFold predicate on the "download_location" field.
+func DownloadLocationEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldDownloadLocation, v))
+}
+
+// DownloadLocationContainsFold applies the ContainsFold predicate on the "download_location" field.
+func DownloadLocationContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldDownloadLocation, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.HasSBOM {
+	return predicate.HasSBOM(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// HasPackage applies the HasEdge predicate on the "package" edge.
+func HasPackage() predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackageWith applies the HasEdge predicate on the "package" edge with a given conditions (other predicates).
+func HasPackageWith(preds ...predicate.PackageVersion) predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PackageInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtifact applies the HasEdge predicate on the "artifact" edge.
+func HasArtifact() predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactWith applies the HasEdge predicate on the "artifact" edge with a given conditions (other predicates).
+func HasArtifactWith(preds ...predicate.Artifact) predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ArtifactInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HasSBOM) predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HasSBOM) predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HasSBOM) predicate.HasSBOM {
+	return predicate.HasSBOM(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
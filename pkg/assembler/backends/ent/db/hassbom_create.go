@@ -0,0 +1,906 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+)
+
+// HasSBOMCreate is the builder for creating a HasSBOM entity.
+type HasSBOMCreate struct {
+	config
+	mutation *HasSBOMMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackageID sets the "package_id" field.
+func (hsc *HasSBOMCreate) SetPackageID(i int) *HasSBOMCreate {
+	hsc.mutation.SetPackageID(i)
+	return hsc
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (hsc *HasSBOMCreate) SetNillablePackageID(i *int) *HasSBOMCreate {
+	if i != nil {
+		hsc.SetPackageID(*i)
+	}
+	return hsc
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hsc *HasSBOMCreate) SetArtifactID(i int) *HasSBOMCreate {
+	hsc.mutation.SetArtifactID(i)
+	return hsc
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (hsc *HasSBOMCreate) SetNillableArtifactID(i *int) *HasSBOMCreate {
+	if i != nil {
+		hsc.SetArtifactID(*i)
+	}
+	return hsc
+}
+
+// SetURI sets the "uri" field.
+func (hsc *HasSBOMCreate) SetURI(s string) *HasSBOMCreate {
+	hsc.mutation.SetURI(s)
+	return hsc
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (hsc *HasSBOMCreate) SetAlgorithm(s string) *HasSBOMCreate {
+	hsc.mutation.SetAlgorithm(s)
+	return hsc
+}
+
+// SetDigest sets the "digest" field.
+func (hsc *HasSBOMCreate) SetDigest(s string) *HasSBOMCreate {
+	hsc.mutation.SetDigest(s)
+	return hsc
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (hsc *HasSBOMCreate) SetDownloadLocation(s string) *HasSBOMCreate {
+	hsc.mutation.SetDownloadLocation(s)
+	return hsc
+}
+
+// SetOrigin sets the "origin" field.
+func (hsc *HasSBOMCreate) SetOrigin(s string) *HasSBOMCreate {
+	hsc.mutation.SetOrigin(s)
+	return hsc
+}
+
+// SetCollector sets the "collector" field.
+func (hsc *HasSBOMCreate) SetCollector(s string) *HasSBOMCreate {
+	hsc.mutation.SetCollector(s)
+	return hsc
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (hsc *HasSBOMCreate) SetPackage(p *PackageVersion) *HasSBOMCreate {
+	return hsc.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hsc *HasSBOMCreate) SetArtifact(a *Artifact) *HasSBOMCreate {
+	return hsc.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasSBOMMutation object of the builder.
+func (hsc *HasSBOMCreate) Mutation() *HasSBOMMutation {
+	return hsc.mutation
+}
+
+// Save creates the HasSBOM in the database.
+func (hsc *HasSBOMCreate) Save(ctx context.Context) (*HasSBOM, error) {
+	return withHooks[*HasSBOM, HasSBOMMutation](ctx, hsc.sqlSave, hsc.mutation, hsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hsc *HasSBOMCreate) SaveX(ctx context.Context) *HasSBOM {
+	v, err := hsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hsc *HasSBOMCreate) Exec(ctx context.Context) error {
+	_, err := hsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsc *HasSBOMCreate) ExecX(ctx context.Context) {
+	if err := hsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hsc *HasSBOMCreate) check() error {
+	if _, ok := hsc.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`db: missing required field "HasSBOM.uri"`)}
+	}
+	if _, ok := hsc.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`db: missing required field "HasSBOM.algorithm"`)}
+	}
+	if _, ok := hsc.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`db: missing required field "HasSBOM.digest"`)}
+	}
+	if _, ok := hsc.mutation.DownloadLocation(); !ok {
+		return &ValidationError{Name: "download_location", err: errors.New(`db: missing required field "HasSBOM.download_location"`)}
+	}
+	if _, ok := hsc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`db: missing required field "HasSBOM.origin"`)}
+	}
+	if _, ok := hsc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`db: missing required field "HasSBOM.collector"`)}
+	}
+	return nil
+}
+
+func (hsc *HasSBOMCreate) sqlSave(ctx context.Context) (*HasSBOM, error) {
+	if err := hsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hsc.mutation.id = &_node.ID
+	hsc.mutation.done = true
+	return _node, nil
+}
+
+func (hsc *HasSBOMCreate) createSpec() (*HasSBOM, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HasSBOM{config: hsc.config}
+		_spec = sqlgraph.NewCreateSpec(hassbom.Table, sqlgraph.NewFieldSpec(hassbom.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = hsc.conflict
+	if value, ok := hsc.mutation.URI(); ok {
+		_spec.SetField(hassbom.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := hsc.mutation.Algorithm(); ok {
+		_spec.SetField(hassbom.FieldAlgorithm, field.TypeString, value)
+		_node.Algorithm = value
+	}
+	if value, ok := hsc.mutation.Digest(); ok {
+		_spec.SetField(hassbom.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := hsc.mutation.DownloadLocation(); ok {
+		_spec.SetField(hassbom.FieldDownloadLocation, field.TypeString, value)
+		_node.DownloadLocation = value
+	}
+	if value, ok := hsc.mutation.Origin(); ok {
+		_spec.SetField(hassbom.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := hsc.mutation.Collector(); ok {
+		_spec.SetField(hassbom.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if nodes := hsc.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.PackageTable,
+			Columns: []string{hassbom.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hsc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.ArtifactTable,
+			Columns: []string{hassbom.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasSBOM.Create().
+//		SetPackageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasSBOMUpsert) {
+//			SetPackageID(v+v).
+//		}).
+//		Exec(ctx)
+func (hsc *HasSBOMCreate) OnConflict(opts ...sql.ConflictOption) *HasSBOMUpsertOne {
+	hsc.conflict = opts
+	return &HasSBOMUpsertOne{
+		create: hsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hsc *HasSBOMCreate) OnConflictColumns(columns ...string) *HasSBOMUpsertOne {
+	hsc.conflict = append(hsc.conflict, sql.ConflictColumns(columns...))
+	return &HasSBOMUpsertOne{
+		create: hsc,
+	}
+}
+
+type (
+	// HasSBOMUpsertOne is the builder for "upsert"-ing
+	//  one HasSBOM node.
+	HasSBOMUpsertOne struct {
+		create *HasSBOMCreate
+	}
+
+	// HasSBOMUpsert is the "OnConflict" setter.
+	HasSBOMUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackageID sets the "package_id" field.
+func (u *HasSBOMUpsert) SetPackageID(v int) *HasSBOMUpsert {
+	u.Set(hassbom.FieldPackageID, v)
+	return u
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdatePackageID() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldPackageID)
+	return u
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *HasSBOMUpsert) ClearPackageID() *HasSBOMUpsert {
+	u.SetNull(hassbom.FieldPackageID)
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasSBOMUpsert) SetArtifactID(v int) *HasSBOMUpsert {
+	u.Set(hassbom.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateArtifactID() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldArtifactID)
+	return u
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *HasSBOMUpsert) ClearArtifactID() *HasSBOMUpsert {
+	u.SetNull(hassbom.FieldArtifactID)
+	return u
+}
+
+// SetURI sets the "uri" field.
+func (u *HasSBOMUpsert) SetURI(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldURI, v)
+	return u
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateURI() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldURI)
+	return u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *HasSBOMUpsert) SetAlgorithm(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldAlgorithm, v)
+	return u
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateAlgorithm() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldAlgorithm)
+	return u
+}
+
+// SetDigest sets the "digest" field.
+func (u *HasSBOMUpsert) SetDigest(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldDigest, v)
+	return u
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateDigest() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldDigest)
+	return u
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (u *HasSBOMUpsert) SetDownloadLocation(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldDownloadLocation, v)
+	return u
+}
+
+// UpdateDownloadLocation sets the "download_location" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateDownloadLocation() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldDownloadLocation)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSBOMUpsert) SetOrigin(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateOrigin() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSBOMUpsert) SetCollector(v string) *HasSBOMUpsert {
+	u.Set(hassbom.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSBOMUpsert) UpdateCollector() *HasSBOMUpsert {
+	u.SetExcluded(hassbom.FieldCollector)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HasSBOMUpsertOne) UpdateNewValues() *HasSBOMUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HasSBOMUpsertOne) Ignore() *HasSBOMUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasSBOMUpsertOne) DoNothing() *HasSBOMUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasSBOMCreate.OnConflict
+// documentation for more info.
+func (u *HasSBOMUpsertOne) Update(set func(*HasSBOMUpsert)) *HasSBOMUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasSBOMUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *HasSBOMUpsertOne) SetPackageID(v int) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdatePackageID() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *HasSBOMUpsertOne) ClearPackageID() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasSBOMUpsertOne) SetArtifactID(v int) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateArtifactID() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *HasSBOMUpsertOne) ClearArtifactID() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *HasSBOMUpsertOne) SetURI(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateURI() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *HasSBOMUpsertOne) SetAlgorithm(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetAlgorithm(v)
+	})
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateAlgorithm() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateAlgorithm()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *HasSBOMUpsertOne) SetDigest(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateDigest() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (u *HasSBOMUpsertOne) SetDownloadLocation(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetDownloadLocation(v)
+	})
+}
+
+// UpdateDownloadLocation sets the "download_location" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateDownloadLocation() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateDownloadLocation()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSBOMUpsertOne) SetOrigin(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateOrigin() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSBOMUpsertOne) SetCollector(v string) *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSBOMUpsertOne) UpdateCollector() *HasSBOMUpsertOne {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *HasSBOMUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for HasSBOMCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasSBOMUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HasSBOMUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HasSBOMUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HasSBOMCreateBulk is the builder for creating many HasSBOM entities in bulk.
+type HasSBOMCreateBulk struct {
+	config
+	builders []*HasSBOMCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HasSBOM entities in the database.
+func (hscb *HasSBOMCreateBulk) Save(ctx context.Context) ([]*HasSBOM, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(hscb.builders))
+	nodes := make([]*HasSBOM, len(hscb.builders))
+	mutators := make([]Mutator, len(hscb.builders))
+	for i := range hscb.builders {
+		func(i int, root context.Context) {
+			builder := hscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HasSBOMMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hscb *HasSBOMCreateBulk) SaveX(ctx context.Context) []*HasSBOM {
+	v, err := hscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hscb *HasSBOMCreateBulk) Exec(ctx context.Context) error {
+	_, err := hscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hscb *HasSBOMCreateBulk) ExecX(ctx context.Context) {
+	if err := hscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasSBOM.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasSBOMUpsert) {
+//			SetPackageID(v+v).
+//		}).
+//		Exec(ctx)
+func (hscb *HasSBOMCreateBulk) OnConflict(opts ...sql.ConflictOption) *HasSBOMUpsertBulk {
+	hscb.conflict = opts
+	return &HasSBOMUpsertBulk{
+		create: hscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hscb *HasSBOMCreateBulk) OnConflictColumns(columns ...string) *HasSBOMUpsertBulk {
+	hscb.conflict = append(hscb.conflict, sql.ConflictColumns(columns...))
+	return &HasSBOMUpsertBulk{
+		create: hscb,
+	}
+}
+
+// HasSBOMUpsertBulk is the builder for "upsert"-ing
+// a bulk of HasSBOM nodes.
+type HasSBOMUpsertBulk struct {
+	create *HasSBOMCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HasSBOMUpsertBulk) UpdateNewValues() *HasSBOMUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasSBOM.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HasSBOMUpsertBulk) Ignore() *HasSBOMUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasSBOMUpsertBulk) DoNothing() *HasSBOMUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasSBOMCreateBulk.OnConflict
+// documentation for more info.
+func (u *HasSBOMUpsertBulk) Update(set func(*HasSBOMUpsert)) *HasSBOMUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasSBOMUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *HasSBOMUpsertBulk) SetPackageID(v int) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdatePackageID() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (u *HasSBOMUpsertBulk) ClearPackageID() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.ClearPackageID()
+	})
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasSBOMUpsertBulk) SetArtifactID(v int) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateArtifactID() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (u *HasSBOMUpsertBulk) ClearArtifactID() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.ClearArtifactID()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *HasSBOMUpsertBulk) SetURI(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateURI() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (u *HasSBOMUpsertBulk) SetAlgorithm(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetAlgorithm(v)
+	})
+}
+
+// UpdateAlgorithm sets the "algorithm" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateAlgorithm() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateAlgorithm()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *HasSBOMUpsertBulk) SetDigest(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateDigest() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (u *HasSBOMUpsertBulk) SetDownloadLocation(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetDownloadLocation(v)
+	})
+}
+
+// UpdateDownloadLocation sets the "download_location" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateDownloadLocation() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateDownloadLocation()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSBOMUpsertBulk) SetOrigin(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateOrigin() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSBOMUpsertBulk) SetCollector(v string) *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSBOMUpsertBulk) UpdateCollector() *HasSBOMUpsertBulk {
+	return u.Update(func(s *HasSBOMUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// Exec executes the query.
+func (u *HasSBOMUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the HasSBOMCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for HasSBOMCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasSBOMUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HasSBOMDelete is the builder for deleting a HasSBOM entity.
+type HasSBOMDelete struct {
+	config
+	hooks    []Hook
+	mutation *HasSBOMMutation
+}
+
+// Where appends a list predicates to the HasSBOMDelete builder.
+func (hsd *HasSBOMDelete) Where(ps ...predicate.HasSBOM) *HasSBOMDelete {
+	hsd.mutation.Where(ps...)
+	return hsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hsd *HasSBOMDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, HasSBOMMutation](ctx, hsd.sqlExec, hsd.mutation, hsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsd *HasSBOMDelete) ExecX(ctx context.Context) int {
+	n, err := hsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hsd *HasSBOMDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hassbom.Table, sqlgraph.NewFieldSpec(hassbom.FieldID, field.TypeInt))
+	if ps := hsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hsd.mutation.done = true
+	return affected, err
+}
+
+// HasSBOMDeleteOne is the builder for deleting a single HasSBOM entity.
+type HasSBOMDeleteOne struct {
+	hsd *HasSBOMDelete
+}
+
+// Where appends a list predicates to the HasSBOMDelete builder.
+func (hsdo *HasSBOMDeleteOne) Where(ps ...predicate.HasSBOM) *HasSBOMDeleteOne {
+	hsdo.hsd.mutation.Where(ps...)
+	return hsdo
+}
+
+// Exec executes the deletion query.
+func (hsdo *HasSBOMDeleteOne) Exec(ctx context.Context) error {
+	n, err := hsdo.hsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hassbom.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsdo *HasSBOMDeleteOne) ExecX(ctx context.Context) {
+	if err := hsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
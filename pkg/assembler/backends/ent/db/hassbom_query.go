@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HasSBOMQuery is the builder for querying HasSBOM entities.
+type HasSBOMQuery struct {
+	config
+	ctx          *QueryContext
+	order        []OrderFunc
+	inters       []Interceptor
+	predicates   []predicate.HasSBOM
+	withPackage  *PackageVersionQuery
+	withArtifact *ArtifactQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HasSBOMQuery builder.
+func (hsq *HasSBOMQuery) Where(ps ...predicate.HasSBOM) *HasSBOMQuery {
+	hsq.predicates = append(hsq.predicates, ps...)
+	return hsq
+}
+
+// Limit the number of records to be returned by this query.
+func (hsq *HasSBOMQuery) Limit(limit int) *HasSBOMQuery {
+	hsq.ctx.Limit = &limit
+	return hsq
+}
+
+// Offset to start from.
+func (hsq *HasSBOMQuery) Offset(offset int) *HasSBOMQuery {
+	hsq.ctx.Offset = &offset
+	return hsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hsq *HasSBOMQuery) Unique(unique bool) *HasSBOMQuery {
+	hsq.ctx.Unique = &unique
+	return hsq
+}
+
+// Order specifies how the records should be ordered.
+func (hsq *HasSBOMQuery) Order(o ...OrderFunc) *HasSBOMQuery {
+	hsq.order = append(hsq.order, o...)
+	return hsq
+}
+
+// QueryPackage chains the current query on the "package" edge.
+func (hsq *HasSBOMQuery) QueryPackage() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: hsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hassbom.Table, hassbom.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hassbom.PackageTable, hassbom.PackageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (hsq *HasSBOMQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hassbom.Table, hassbom.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hassbom.ArtifactTable, hassbom.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HasSBOM entity from the query.
+// Returns a *NotFoundError when no HasSBOM was found.
+func (hsq *HasSBOMQuery) First(ctx context.Context) (*HasSBOM, error) {
+	nodes, err := hsq.Limit(1).All(setContextOp(ctx, hsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hassbom.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hsq *HasSBOMQuery) FirstX(ctx context.Context) *HasSBOM {
+	node, err := hsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HasSBOM ID from the query.
+// Returns a *NotFoundError when no HasSBOM ID was found.
+func (hsq *HasSBOMQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hsq.Limit(1).IDs(setContextOp(ctx, hsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hassbom.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hsq *HasSBOMQuery) FirstIDX(ctx context.Context) int {
+	id, err := hsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HasSBOM entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HasSBOM entity is found.
+// Returns a *NotFoundError when no HasSBOM entities are found.
+func (hsq *HasSBOMQuery) Only(ctx context.Context) (*HasSBOM, error) {
+	nodes, err := hsq.Limit(2).All(setContextOp(ctx, hsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hassbom.Label}
+	default:
+		return nil, &NotSingularError{hassbom.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hsq *HasSBOMQuery) OnlyX(ctx context.Context) *HasSBOM {
+	node, err := hsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HasSBOM ID in the query.
+// Returns a *NotSingularError when more than one HasSBOM ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hsq *HasSBOMQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hsq.Limit(2).IDs(setContextOp(ctx, hsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hassbom.Label}
+	default:
+		err = &NotSingularError{hassbom.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hsq *HasSBOMQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HasSBOMs.
+func (hsq *HasSBOMQuery) All(ctx context.Context) ([]*HasSBOM, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "All")
+	if err := hsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HasSBOM, *HasSBOMQuery]()
+	return withInterceptors[[]*HasSBOM](ctx, hsq, qr, hsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hsq *HasSBOMQuery) AllX(ctx context.Context) []*HasSBOM {
+	nodes, err := hsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HasSBOM IDs.
+func (hsq *HasSBOMQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hsq.ctx.Unique == nil && hsq.path != nil {
+		hsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hsq.ctx, "IDs")
+	if err = hsq.Select(hassbom.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hsq *HasSBOMQuery) IDsX(ctx context.Context) []int {
+	ids, err := hsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hsq *HasSBOMQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "Count")
+	if err := hsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hsq, querierCount[*HasSBOMQuery](), hsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hsq *HasSBOMQuery) CountX(ctx context.Context) int {
+	count, err := hsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hsq *HasSBOMQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "Exist")
+	switch _, err := hsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hsq *HasSBOMQuery) ExistX(ctx context.Context) bool {
+	exist, err := hsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HasSBOMQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hsq *HasSBOMQuery) Clone() *HasSBOMQuery {
+	if hsq == nil {
+		return nil
+	}
+	return &HasSBOMQuery{
+		config:       hsq.config,
+		ctx:          hsq.ctx.Clone(),
+		order:        append([]OrderFunc{}, hsq.order...),
+		inters:       append([]Interceptor{}, hsq.inters...),
+		predicates:   append([]predicate.HasSBOM{}, hsq.predicates...),
+		withPackage:  hsq.withPackage.Clone(),
+		withArtifact: hsq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  hsq.sql.Clone(),
+		path: hsq.path,
+	}
+}
+
+// WithPackage tells the query-builder to eager-load the nodes that are connected to
+// the "package" edge. The optional arguments are used to configure the query builder of the edge.
+func (hsq *HasSBOMQuery) WithPackage(opts ...func(*PackageVersionQuery)) *HasSBOMQuery {
+	query := (&PackageVersionClient{config: hsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hsq.withPackage = query
+	return hsq
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (hsq *HasSBOMQuery) WithArtifact(opts ...func(*ArtifactQuery)) *HasSBOMQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hsq.withArtifact = query
+	return hsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HasSBOM.Query().
+//		GroupBy(hassbom.FieldPackageID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (hsq *HasSBOMQuery) GroupBy(field string, fields ...string) *HasSBOMGroupBy {
+	hsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HasSBOMGroupBy{build: hsq}
+	grbuild.flds = &hsq.ctx.Fields
+	grbuild.label = hassbom.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//	}
+//
+//	client.HasSBOM.Query().
+//		Select(hassbom.FieldPackageID).
+//		Scan(ctx, &v)
+func (hsq *HasSBOMQuery) Select(fields ...string) *HasSBOMSelect {
+	hsq.ctx.Fields = append(hsq.ctx.Fields, fields...)
+	sbuild := &HasSBOMSelect{HasSBOMQuery: hsq}
+	sbuild.label = hassbom.Label
+	sbuild.flds, sbuild.scan = &hsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HasSBOMSelect configured with the given aggregations.
+func (hsq *HasSBOMQuery) Aggregate(fns ...AggregateFunc) *HasSBOMSelect {
+	return hsq.Select().Aggregate(fns...)
+}
+
+func (hsq *HasSBOMQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hsq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hsq.ctx.Fields {
+		if !hassbom.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if hsq.path != nil {
+		prev, err := hsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hsq.sql = prev
+	}
+	return nil
+}
+
+func (hsq *HasSBOMQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HasSBOM, error) {
+	var (
+		nodes       = []*HasSBOM{}
+		_spec       = hsq.querySpec()
+		loadedTypes = [2]bool{
+			hsq.withPackage != nil,
+			hsq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HasSBOM).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HasSBOM{config: hsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hsq.withPackage; query != nil {
+		if err := hsq.loadPackage(ctx, query, nodes, nil,
+			func(n *HasSBOM, e *PackageVersion) { n.Edges.Package = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hsq.withArtifact; query != nil {
+		if err := hsq.loadArtifact(ctx, query, nodes, nil,
+			func(n *HasSBOM, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hsq *HasSBOMQuery) loadPackage(ctx context.Context, query *PackageVersionQuery, nodes []*HasSBOM, init func(*HasSBOM), assign func(*HasSBOM, *PackageVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HasSBOM)
+	for i := range nodes {
+		if nodes[i].PackageID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hsq *HasSBOMQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*HasSBOM, init func(*HasSBOM), assign func(*HasSBOM, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HasSBOM)
+	for i := range nodes {
+		if nodes[i].ArtifactID == nil {
+			continue
+		}
+		fk := *nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hsq *HasSBOMQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hsq.querySpec()
+	_spec.Node.Columns = hsq.ctx.Fields
+	if len(hsq.ctx.Fields) > 0 {
+		_spec.Unique = hsq.ctx.Unique != nil && *hsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hsq.driver, _spec)
+}
+
+func (hsq *HasSBOMQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hassbom.Table, hassbom.Columns, sqlgraph.NewFieldSpec(hassbom.FieldID, field.TypeInt))
+	_spec.From = hsq.sql
+	if unique := hsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hassbom.FieldID)
+		for i := range fields {
+			if fields[i] != hassbom.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := hsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hsq *HasSBOMQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hsq.driver.Dialect())
+	t1 := builder.Table(hassbom.Table)
+	columns := hsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hassbom.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hsq.sql != nil {
+		selector = hsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hsq.ctx.Unique != nil && *hsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hsq.predicates {
+		p(selector)
+	}
+	for _, p := range hsq.order {
+		p(selector)
+	}
+	if offset := hsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HasSBOMGroupBy is the group-by builder for HasSBOM entities.
+type HasSBOMGroupBy struct {
+	selector
+	build *HasSBOMQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hsgb *HasSBOMGroupBy) Aggregate(fns ...AggregateFunc) *HasSBOMGroupBy {
+	hsgb.fns = append(hsgb.fns, fns...)
+	return hsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hsgb *HasSBOMGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hsgb.build.ctx, "GroupBy")
+	if err := hsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasSBOMQuery, *HasSBOMGroupBy](ctx, hsgb.build, hsgb, hsgb.build.inters, v)
+}
+
+func (hsgb *HasSBOMGroupBy) sqlScan(ctx context.Context, root *HasSBOMQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hsgb.fns))
+	for _, fn := range hsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hsgb.flds)+len(hsgb.fns))
+		for _, f := range *hsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HasSBOMSelect is the builder for selecting fields of HasSBOM entities.
+type HasSBOMSelect struct {
+	*HasSBOMQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hss *HasSBOMSelect) Aggregate(fns ...AggregateFunc) *HasSBOMSelect {
+	hss.fns = append(hss.fns, fns...)
+	return hss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hss *HasSBOMSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hss.ctx, "Select")
+	if err := hss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasSBOMQuery, *HasSBOMSelect](ctx, hss.HasSBOMQuery, hss, hss.inters, v)
+}
+
+func (hss *HasSBOMSelect) sqlScan(ctx context.Context, root *HasSBOMQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hss.fns))
+	for _, fn := range hss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HasSBOMUpdate is the builder for updating HasSBOM entities.
+type HasSBOMUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HasSBOMMutation
+}
+
+// Where appends a list predicates to the HasSBOMUpdate builder.
+func (hsu *HasSBOMUpdate) Where(ps ...predicate.HasSBOM) *HasSBOMUpdate {
+	hsu.mutation.Where(ps...)
+	return hsu
+}
+
+// SetPackageID sets the "package_id" field.
+func (hsu *HasSBOMUpdate) SetPackageID(i int) *HasSBOMUpdate {
+	hsu.mutation.SetPackageID(i)
+	return hsu
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (hsu *HasSBOMUpdate) SetNillablePackageID(i *int) *HasSBOMUpdate {
+	if i != nil {
+		hsu.SetPackageID(*i)
+	}
+	return hsu
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (hsu *HasSBOMUpdate) ClearPackageID() *HasSBOMUpdate {
+	hsu.mutation.ClearPackageID()
+	return hsu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hsu *HasSBOMUpdate) SetArtifactID(i int) *HasSBOMUpdate {
+	hsu.mutation.SetArtifactID(i)
+	return hsu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (hsu *HasSBOMUpdate) SetNillableArtifactID(i *int) *HasSBOMUpdate {
+	if i != nil {
+		hsu.SetArtifactID(*i)
+	}
+	return hsu
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (hsu *HasSBOMUpdate) ClearArtifactID() *HasSBOMUpdate {
+	hsu.mutation.ClearArtifactID()
+	return hsu
+}
+
+// SetURI sets the "uri" field.
+func (hsu *HasSBOMUpdate) SetURI(s string) *HasSBOMUpdate {
+	hsu.mutation.SetURI(s)
+	return hsu
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (hsu *HasSBOMUpdate) SetAlgorithm(s string) *HasSBOMUpdate {
+	hsu.mutation.SetAlgorithm(s)
+	return hsu
+}
+
+// SetDigest sets the "digest" field.
+func (hsu *HasSBOMUpdate) SetDigest(s string) *HasSBOMUpdate {
+	hsu.mutation.SetDigest(s)
+	return hsu
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (hsu *HasSBOMUpdate) SetDownloadLocation(s string) *HasSBOMUpdate {
+	hsu.mutation.SetDownloadLocation(s)
+	return hsu
+}
+
+// SetOrigin sets the "origin" field.
+func (hsu *HasSBOMUpdate) SetOrigin(s string) *HasSBOMUpdate {
+	hsu.mutation.SetOrigin(s)
+	return hsu
+}
+
+// SetCollector sets the "collector" field.
+func (hsu *HasSBOMUpdate) SetCollector(s string) *HasSBOMUpdate {
+	hsu.mutation.SetCollector(s)
+	return hsu
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (hsu *HasSBOMUpdate) SetPackage(p *PackageVersion) *HasSBOMUpdate {
+	return hsu.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hsu *HasSBOMUpdate) SetArtifact(a *Artifact) *HasSBOMUpdate {
+	return hsu.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasSBOMMutation object of the builder.
+func (hsu *HasSBOMUpdate) Mutation() *HasSBOMMutation {
+	return hsu.mutation
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (hsu *HasSBOMUpdate) ClearPackage() *HasSBOMUpdate {
+	hsu.mutation.ClearPackage()
+	return hsu
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (hsu *HasSBOMUpdate) ClearArtifact() *HasSBOMUpdate {
+	hsu.mutation.ClearArtifact()
+	return hsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hsu *HasSBOMUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, HasSBOMMutation](ctx, hsu.sqlSave, hsu.mutation, hsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hsu *HasSBOMUpdate) SaveX(ctx context.Context) int {
+	affected, err := hsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hsu *HasSBOMUpdate) Exec(ctx context.Context) error {
+	_, err := hsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsu *HasSBOMUpdate) ExecX(ctx context.Context) {
+	if err := hsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (hsu *HasSBOMUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(hassbom.Table, hassbom.Columns, sqlgraph.NewFieldSpec(hassbom.FieldID, field.TypeInt))
+	if ps := hsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hsu.mutation.URI(); ok {
+		_spec.SetField(hassbom.FieldURI, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.Algorithm(); ok {
+		_spec.SetField(hassbom.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.Digest(); ok {
+		_spec.SetField(hassbom.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.DownloadLocation(); ok {
+		_spec.SetField(hassbom.FieldDownloadLocation, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.Origin(); ok {
+		_spec.SetField(hassbom.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.Collector(); ok {
+		_spec.SetField(hassbom.FieldCollector, field.TypeString, value)
+	}
+	if hsu.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.PackageTable,
+			Columns: []string{hassbom.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.PackageTable,
+			Columns: []string{hassbom.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.ArtifactTable,
+			Columns: []string{hassbom.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.ArtifactTable,
+			Columns: []string{hassbom.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hassbom.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hsu.mutation.done = true
+	return n, nil
+}
+
+// HasSBOMUpdateOne is the builder for updating a single HasSBOM entity.
+type HasSBOMUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HasSBOMMutation
+}
+
+// SetPackageID sets the "package_id" field.
+func (hsuo *HasSBOMUpdateOne) SetPackageID(i int) *HasSBOMUpdateOne {
+	hsuo.mutation.SetPackageID(i)
+	return hsuo
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (hsuo *HasSBOMUpdateOne) SetNillablePackageID(i *int) *HasSBOMUpdateOne {
+	if i != nil {
+		hsuo.SetPackageID(*i)
+	}
+	return hsuo
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (hsuo *HasSBOMUpdateOne) ClearPackageID() *HasSBOMUpdateOne {
+	hsuo.mutation.ClearPackageID()
+	return hsuo
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hsuo *HasSBOMUpdateOne) SetArtifactID(i int) *HasSBOMUpdateOne {
+	hsuo.mutation.SetArtifactID(i)
+	return hsuo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (hsuo *HasSBOMUpdateOne) SetNillableArtifactID(i *int) *HasSBOMUpdateOne {
+	if i != nil {
+		hsuo.SetArtifactID(*i)
+	}
+	return hsuo
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (hsuo *HasSBOMUpdateOne) ClearArtifactID() *HasSBOMUpdateOne {
+	hsuo.mutation.ClearArtifactID()
+	return hsuo
+}
+
+// SetURI sets the "uri" field.
+func (hsuo *HasSBOMUpdateOne) SetURI(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetURI(s)
+	return hsuo
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (hsuo *HasSBOMUpdateOne) SetAlgorithm(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetAlgorithm(s)
+	return hsuo
+}
+
+// SetDigest sets the "digest" field.
+func (hsuo *HasSBOMUpdateOne) SetDigest(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetDigest(s)
+	return hsuo
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (hsuo *HasSBOMUpdateOne) SetDownloadLocation(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetDownloadLocation(s)
+	return hsuo
+}
+
+// SetOrigin sets the "origin" field.
+func (hsuo *HasSBOMUpdateOne) SetOrigin(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetOrigin(s)
+	return hsuo
+}
+
+// SetCollector sets the "collector" field.
+func (hsuo *HasSBOMUpdateOne) SetCollector(s string) *HasSBOMUpdateOne {
+	hsuo.mutation.SetCollector(s)
+	return hsuo
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (hsuo *HasSBOMUpdateOne) SetPackage(p *PackageVersion) *HasSBOMUpdateOne {
+	return hsuo.SetPackageID(p.ID)
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hsuo *HasSBOMUpdateOne) SetArtifact(a *Artifact) *HasSBOMUpdateOne {
+	return hsuo.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasSBOMMutation object of the builder.
+func (hsuo *HasSBOMUpdateOne) Mutation() *HasSBOMMutation {
+	return hsuo.mutation
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (hsuo *HasSBOMUpdateOne) ClearPackage() *HasSBOMUpdateOne {
+	hsuo.mutation.ClearPackage()
+	return hsuo
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (hsuo *HasSBOMUpdateOne) ClearArtifact() *HasSBOMUpdateOne {
+	hsuo.mutation.ClearArtifact()
+	return hsuo
+}
+
+// Where appends a list predicates to the HasSBOMUpdate builder.
+func (hsuo *HasSBOMUpdateOne) Where(ps ...predicate.HasSBOM) *HasSBOMUpdateOne {
+	hsuo.mutation.Where(ps...)
+	return hsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hsuo *HasSBOMUpdateOne) Select(field string, fields ...string) *HasSBOMUpdateOne {
+	hsuo.fields = append([]string{field}, fields...)
+	return hsuo
+}
+
+// Save executes the query and returns the updated HasSBOM entity.
+func (hsuo *HasSBOMUpdateOne) Save(ctx context.Context) (*HasSBOM, error) {
+	return withHooks[*HasSBOM, HasSBOMMutation](ctx, hsuo.sqlSave, hsuo.mutation, hsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hsuo *HasSBOMUpdateOne) SaveX(ctx context.Context) *HasSBOM {
+	node, err := hsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hsuo *HasSBOMUpdateOne) Exec(ctx context.Context) error {
+	_, err := hsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsuo *HasSBOMUpdateOne) ExecX(ctx context.Context) {
+	if err := hsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (hsuo *HasSBOMUpdateOne) sqlSave(ctx context.Context) (_node *HasSBOM, err error) {
+	_spec := sqlgraph.NewUpdateSpec(hassbom.Table, hassbom.Columns, sqlgraph.NewFieldSpec(hassbom.FieldID, field.TypeInt))
+	id, ok := hsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "HasSBOM.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hassbom.FieldID)
+		for _, f := range fields {
+			if !hassbom.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != hassbom.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hsuo.mutation.URI(); ok {
+		_spec.SetField(hassbom.FieldURI, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.Algorithm(); ok {
+		_spec.SetField(hassbom.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.Digest(); ok {
+		_spec.SetField(hassbom.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.DownloadLocation(); ok {
+		_spec.SetField(hassbom.FieldDownloadLocation, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.Origin(); ok {
+		_spec.SetField(hassbom.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.Collector(); ok {
+		_spec.SetField(hassbom.FieldCollector, field.TypeString, value)
+	}
+	if hsuo.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.PackageTable,
+			Columns: []string{hassbom.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.PackageTable,
+			Columns: []string{hassbom.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsuo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.ArtifactTable,
+			Columns: []string{hassbom.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hassbom.ArtifactTable,
+			Columns: []string{hassbom.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HasSBOM{config: hsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hassbom.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hsuo.mutation.done = true
+	return _node, nil
+}
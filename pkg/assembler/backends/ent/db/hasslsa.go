@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// HasSLSA is the model entity for the HasSLSA schema.
+type HasSLSA struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SubjectID holds the value of the "subject_id" field.
+	SubjectID int `json:"subject_id,omitempty"`
+	// BuiltByID holds the value of the "built_by_id" field.
+	BuiltByID int `json:"built_by_id,omitempty"`
+	// BuildType holds the value of the "build_type" field.
+	BuildType string `json:"build_type,omitempty"`
+	// SlsaPredicates holds the value of the "slsa_predicates" field.
+	SlsaPredicates []model.SLSAPredicate `json:"slsa_predicates,omitempty"`
+	// SlsaVersion holds the value of the "slsa_version" field.
+	SlsaVersion string `json:"slsa_version,omitempty"`
+	// StartedOn holds the value of the "started_on" field.
+	StartedOn *time.Time `json:"started_on,omitempty"`
+	// FinishedOn holds the value of the "finished_on" field.
+	FinishedOn *time.Time `json:"finished_on,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// BuiltFromHash holds the value of the "built_from_hash" field.
+	BuiltFromHash string `json:"built_from_hash,omitempty"`
+	// PredicatesHash holds the value of the "predicates_hash" field.
+	PredicatesHash string `json:"predicates_hash,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HasSLSAQuery when eager-loading is set.
+	Edges HasSLSAEdges `json:"edges"`
+}
+
+// HasSLSAEdges holds the relations/edges for other nodes in the graph.
+type HasSLSAEdges struct {
+	// Subject holds the value of the subject edge.
+	Subject *Artifact `json:"subject,omitempty"`
+	// BuiltBy holds the value of the built_by edge.
+	BuiltBy *BuilderNode `json:"built_by,omitempty"`
+	// BuiltFrom holds the value of the built_from edge.
+	BuiltFrom []*Artifact `json:"built_from,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SubjectOrErr returns the Subject value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HasSLSAEdges) SubjectOrErr() (*Artifact, error) {
+	if e.loadedTypes[0] {
+		if e.Subject == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Subject, nil
+	}
+	return nil, &NotLoadedError{edge: "subject"}
+}
+
+// BuiltByOrErr returns the BuiltBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HasSLSAEdges) BuiltByOrErr() (*BuilderNode, error) {
+	if e.loadedTypes[1] {
+		if e.BuiltBy == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: buildernode.Label}
+		}
+		return e.BuiltBy, nil
+	}
+	return nil, &NotLoadedError{edge: "built_by"}
+}
+
+// BuiltFromOrErr returns the BuiltFrom value or an error if the edge
+// was not loaded in eager-loading.
+func (e HasSLSAEdges) BuiltFromOrErr() ([]*Artifact, error) {
+	if e.loadedTypes[2] {
+		return e.BuiltFrom, nil
+	}
+	return nil, &NotLoadedError{edge: "built_from"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HasSLSA) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hasslsa.FieldSlsaPredicates:
+			values[i] = new([]byte)
+		case hasslsa.FieldID, hasslsa.FieldSubjectID, hasslsa.FieldBuiltByID:
+			values[i] = new(sql.NullInt64)
+		case hasslsa.FieldBuildType, hasslsa.FieldSlsaVersion, hasslsa.FieldOrigin, hasslsa.FieldCollector, hasslsa.FieldBuiltFromHash, hasslsa.FieldPredicatesHash:
+			values[i] = new(sql.NullString)
+		case hasslsa.FieldStartedOn, hasslsa.FieldFinishedOn:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type HasSLSA", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HasSLSA fields.
+func (hs *HasSLSA) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hasslsa.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			hs.ID = int(value.Int64)
+		case hasslsa.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				hs.SubjectID = int(value.Int64)
+			}
+		case hasslsa.FieldBuiltByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field built_by_id", values[i])
+			} else if value.Valid {
+				hs.BuiltByID = int(value.Int64)
+			}
+		case hasslsa.FieldBuildType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_type", values[i])
+			} else if value.Valid {
+				hs.BuildType = value.String
+			}
+		case hasslsa.FieldSlsaPredicates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field slsa_predicates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &hs.SlsaPredicates); err != nil {
+					return fmt.Errorf("unmarshal field slsa_predicates: %w", err)
+				}
+			}
+		case hasslsa.FieldSlsaVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slsa_version", values[i])
+			} else if value.Valid {
+				hs.SlsaVersion = value.String
+			}
+		case hasslsa.FieldStartedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_on", values[i])
+			} else if value.Valid {
+				hs.StartedOn = new(time.Time)
+				*hs.StartedOn = value.Time
+			}
+		case hasslsa.FieldFinishedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_on", values[i])
+			} else if value.Valid {
+				hs.FinishedOn = new(time.Time)
+				*hs.FinishedOn = value.Time
+			}
+		case hasslsa.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				hs.Origin = value.String
+			}
+		case hasslsa.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				hs.Collector = value.String
+			}
+		case hasslsa.FieldBuiltFromHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field built_from_hash", values[i])
+			} else if value.Valid {
+				hs.BuiltFromHash = value.String
+			}
+		case hasslsa.FieldPredicatesHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field predicates_hash", values[i])
+			} else if value.Valid {
+				hs.PredicatesHash = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QuerySubject queries the "subject" edge of the HasSLSA entity.
+func (hs *HasSLSA) QuerySubject() *ArtifactQuery {
+	return NewHasSLSAClient(hs.config).QuerySubject(hs)
+}
+
+// QueryBuiltBy queries the "built_by" edge of the HasSLSA entity.
+func (hs *HasSLSA) QueryBuiltBy() *BuilderNodeQuery {
+	return NewHasSLSAClient(hs.config).QueryBuiltBy(hs)
+}
+
+// QueryBuiltFrom queries the "built_from" edge of the HasSLSA entity.
+func (hs *HasSLSA) QueryBuiltFrom() *ArtifactQuery {
+	return NewHasSLSAClient(hs.config).QueryBuiltFrom(hs)
+}
+
+// Update returns a builder for updating this HasSLSA.
+// Note that you need to call HasSLSA.Unwrap() before calling this method if this HasSLSA
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hs *HasSLSA) Update() *HasSLSAUpdateOne {
+	return NewHasSLSAClient(hs.config).UpdateOne(hs)
+}
+
+// Unwrap unwraps the HasSLSA entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hs *HasSLSA) Unwrap() *HasSLSA {
+	_tx, ok := hs.config.driver.(*txDriver)
+	if !ok {
+		panic("db: HasSLSA is not a transactional entity")
+	}
+	hs.config.driver = _tx.drv
+	return hs
+}
+
+// String implements the fmt.Stringer.
+func (hs *HasSLSA) String() string {
+	var builder strings.Builder
+	builder.WriteString("HasSLSA(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hs.ID))
+	builder.WriteString("subject_id=")
+	builder.WriteString(fmt.Sprintf("%v", hs.SubjectID))
+	builder.WriteString(", ")
+	builder.WriteString("built_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", hs.BuiltByID))
+	builder.WriteString(", ")
+	builder.WriteString("build_type=")
+	builder.WriteString(hs.BuildType)
+	builder.WriteString(", ")
+	builder.WriteString("slsa_predicates=")
+	builder.WriteString(fmt.Sprintf("%v", hs.SlsaPredicates))
+	builder.WriteString(", ")
+	builder.WriteString("slsa_version=")
+	builder.WriteString(hs.SlsaVersion)
+	builder.WriteString(", ")
+	if v := hs.StartedOn; v != nil {
+		builder.WriteString("started_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := hs.FinishedOn; v != nil {
+		builder.WriteString("finished_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(hs.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(hs.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("built_from_hash=")
+	builder.WriteString(hs.BuiltFromHash)
+	builder.WriteString(", ")
+	builder.WriteString("predicates_hash=")
+	builder.WriteString(hs.PredicatesHash)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HasSLSAs is a parsable slice of HasSLSA.
+type HasSLSAs []*HasSLSA
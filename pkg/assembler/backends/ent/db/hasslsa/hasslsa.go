@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hasslsa
+
+const (
+	// Label holds the string label denoting the hasslsa type in the database.
+	Label = "has_slsa"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldBuiltByID holds the string denoting the built_by_id field in the database.
+	FieldBuiltByID = "built_by_id"
+	// FieldBuildType holds the string denoting the build_type field in the database.
+	FieldBuildType = "build_type"
+	// FieldSlsaPredicates holds the string denoting the slsa_predicates field in the database.
+	FieldSlsaPredicates = "slsa_predicates"
+	// FieldSlsaVersion holds the string denoting the slsa_version field in the database.
+	FieldSlsaVersion = "slsa_version"
+	// FieldStartedOn holds the string denoting the started_on field in the database.
+	FieldStartedOn = "started_on"
+	// FieldFinishedOn holds the string denoting the finished_on field in the database.
+	FieldFinishedOn = "finished_on"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldBuiltFromHash holds the string denoting the built_from_hash field in the database.
+	FieldBuiltFromHash = "built_from_hash"
+	// FieldPredicatesHash holds the string denoting the predicates_hash field in the database.
+	FieldPredicatesHash = "predicates_hash"
+	// EdgeSubject holds the string denoting the subject edge name in mutations.
+	EdgeSubject = "subject"
+	// EdgeBuiltBy holds the string denoting the built_by edge name in mutations.
+	EdgeBuiltBy = "built_by"
+	// EdgeBuiltFrom holds the string denoting the built_from edge name in mutations.
+	EdgeBuiltFrom = "built_from"
+	// Table holds the table name of the hasslsa in the database.
+	Table = "has_slsas"
+	// SubjectTable is the table that holds the subject relation/edge.
+	SubjectTable = "has_slsas"
+	// SubjectInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	SubjectInverseTable = "artifacts"
+	// SubjectColumn is the table column denoting the subject relation/edge.
+	SubjectColumn = "subject_id"
+	// BuiltByTable is the table that holds the built_by relation/edge.
+	BuiltByTable = "has_slsas"
+	// BuiltByInverseTable is the table name for the BuilderNode entity.
+	// It exists in this package in order to avoid circular dependency with the "buildernode" package.
+	BuiltByInverseTable = "builders"
+	// BuiltByColumn is the table column denoting the built_by relation/edge.
+	BuiltByColumn = "built_by_id"
+	// BuiltFromTable is the table that holds the built_from relation/edge. The primary key declared below.
+	BuiltFromTable = "has_slsa_built_from"
+	// BuiltFromInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	BuiltFromInverseTable = "artifacts"
+)
+
+// Columns holds all SQL columns for hasslsa fields.
+var Columns = []string{
+	FieldID,
+	FieldSubjectID,
+	FieldBuiltByID,
+	FieldBuildType,
+	FieldSlsaPredicates,
+	FieldSlsaVersion,
+	FieldStartedOn,
+	FieldFinishedOn,
+	FieldOrigin,
+	FieldCollector,
+	FieldBuiltFromHash,
+	FieldPredicatesHash,
+}
+
+var (
+	// BuiltFromPrimaryKey and BuiltFromColumn2 are the table columns denoting the
+	// primary key for the built_from relation (M2M).
+	BuiltFromPrimaryKey = []string{"has_slsa_id", "artifact_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
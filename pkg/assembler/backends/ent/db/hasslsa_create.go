@@ -0,0 +1,1104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// HasSLSACreate is the builder for creating a HasSLSA entity.
+type HasSLSACreate struct {
+	config
+	mutation *HasSLSAMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (hsc *HasSLSACreate) SetSubjectID(i int) *HasSLSACreate {
+	hsc.mutation.SetSubjectID(i)
+	return hsc
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (hsc *HasSLSACreate) SetBuiltByID(i int) *HasSLSACreate {
+	hsc.mutation.SetBuiltByID(i)
+	return hsc
+}
+
+// SetBuildType sets the "build_type" field.
+func (hsc *HasSLSACreate) SetBuildType(s string) *HasSLSACreate {
+	hsc.mutation.SetBuildType(s)
+	return hsc
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (hsc *HasSLSACreate) SetSlsaPredicates(mp []model.SLSAPredicate) *HasSLSACreate {
+	hsc.mutation.SetSlsaPredicates(mp)
+	return hsc
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (hsc *HasSLSACreate) SetSlsaVersion(s string) *HasSLSACreate {
+	hsc.mutation.SetSlsaVersion(s)
+	return hsc
+}
+
+// SetStartedOn sets the "started_on" field.
+func (hsc *HasSLSACreate) SetStartedOn(t time.Time) *HasSLSACreate {
+	hsc.mutation.SetStartedOn(t)
+	return hsc
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (hsc *HasSLSACreate) SetNillableStartedOn(t *time.Time) *HasSLSACreate {
+	if t != nil {
+		hsc.SetStartedOn(*t)
+	}
+	return hsc
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (hsc *HasSLSACreate) SetFinishedOn(t time.Time) *HasSLSACreate {
+	hsc.mutation.SetFinishedOn(t)
+	return hsc
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (hsc *HasSLSACreate) SetNillableFinishedOn(t *time.Time) *HasSLSACreate {
+	if t != nil {
+		hsc.SetFinishedOn(*t)
+	}
+	return hsc
+}
+
+// SetOrigin sets the "origin" field.
+func (hsc *HasSLSACreate) SetOrigin(s string) *HasSLSACreate {
+	hsc.mutation.SetOrigin(s)
+	return hsc
+}
+
+// SetCollector sets the "collector" field.
+func (hsc *HasSLSACreate) SetCollector(s string) *HasSLSACreate {
+	hsc.mutation.SetCollector(s)
+	return hsc
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (hsc *HasSLSACreate) SetBuiltFromHash(s string) *HasSLSACreate {
+	hsc.mutation.SetBuiltFromHash(s)
+	return hsc
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (hsc *HasSLSACreate) SetPredicatesHash(s string) *HasSLSACreate {
+	hsc.mutation.SetPredicatesHash(s)
+	return hsc
+}
+
+// SetSubject sets the "subject" edge to the Artifact entity.
+func (hsc *HasSLSACreate) SetSubject(a *Artifact) *HasSLSACreate {
+	return hsc.SetSubjectID(a.ID)
+}
+
+// SetBuiltBy sets the "built_by" edge to the BuilderNode entity.
+func (hsc *HasSLSACreate) SetBuiltBy(b *BuilderNode) *HasSLSACreate {
+	return hsc.SetBuiltByID(b.ID)
+}
+
+// AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by IDs.
+func (hsc *HasSLSACreate) AddBuiltFromIDs(ids ...int) *HasSLSACreate {
+	hsc.mutation.AddBuiltFromIDs(ids...)
+	return hsc
+}
+
+// AddBuiltFrom adds the "built_from" edges to the Artifact entity.
+func (hsc *HasSLSACreate) AddBuiltFrom(a ...*Artifact) *HasSLSACreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hsc.AddBuiltFromIDs(ids...)
+}
+
+// Mutation returns the HasSLSAMutation object of the builder.
+func (hsc *HasSLSACreate) Mutation() *HasSLSAMutation {
+	return hsc.mutation
+}
+
+// Save creates the HasSLSA in the database.
+func (hsc *HasSLSACreate) Save(ctx context.Context) (*HasSLSA, error) {
+	return withHooks[*HasSLSA, HasSLSAMutation](ctx, hsc.sqlSave, hsc.mutation, hsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hsc *HasSLSACreate) SaveX(ctx context.Context) *HasSLSA {
+	v, err := hsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hsc *HasSLSACreate) Exec(ctx context.Context) error {
+	_, err := hsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsc *HasSLSACreate) ExecX(ctx context.Context) {
+	if err := hsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hsc *HasSLSACreate) check() error {
+	if _, ok := hsc.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`db: missing required field "HasSLSA.subject_id"`)}
+	}
+	if _, ok := hsc.mutation.BuiltByID(); !ok {
+		return &ValidationError{Name: "built_by_id", err: errors.New(`db: missing required field "HasSLSA.built_by_id"`)}
+	}
+	if _, ok := hsc.mutation.BuildType(); !ok {
+		return &ValidationError{Name: "build_type", err: errors.New(`db: missing required field "HasSLSA.build_type"`)}
+	}
+	if _, ok := hsc.mutation.SlsaPredicates(); !ok {
+		return &ValidationError{Name: "slsa_predicates", err: errors.New(`db: missing required field "HasSLSA.slsa_predicates"`)}
+	}
+	if _, ok := hsc.mutation.SlsaVersion(); !ok {
+		return &ValidationError{Name: "slsa_version", err: errors.New(`db: missing required field "HasSLSA.slsa_version"`)}
+	}
+	if _, ok := hsc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`db: missing required field "HasSLSA.origin"`)}
+	}
+	if _, ok := hsc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`db: missing required field "HasSLSA.collector"`)}
+	}
+	if _, ok := hsc.mutation.BuiltFromHash(); !ok {
+		return &ValidationError{Name: "built_from_hash", err: errors.New(`db: missing required field "HasSLSA.built_from_hash"`)}
+	}
+	if _, ok := hsc.mutation.PredicatesHash(); !ok {
+		return &ValidationError{Name: "predicates_hash", err: errors.New(`db: missing required field "HasSLSA.predicates_hash"`)}
+	}
+	if _, ok := hsc.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`db: missing required edge "HasSLSA.subject"`)}
+	}
+	if _, ok := hsc.mutation.BuiltByID(); !ok {
+		return &ValidationError{Name: "built_by", err: errors.New(`db: missing required edge "HasSLSA.built_by"`)}
+	}
+	return nil
+}
+
+func (hsc *HasSLSACreate) sqlSave(ctx context.Context) (*HasSLSA, error) {
+	if err := hsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hsc.mutation.id = &_node.ID
+	hsc.mutation.done = true
+	return _node, nil
+}
+
+func (hsc *HasSLSACreate) createSpec() (*HasSLSA, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HasSLSA{config: hsc.config}
+		_spec = sqlgraph.NewCreateSpec(hasslsa.Table, sqlgraph.NewFieldSpec(hasslsa.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = hsc.conflict
+	if value, ok := hsc.mutation.BuildType(); ok {
+		_spec.SetField(hasslsa.FieldBuildType, field.TypeString, value)
+		_node.BuildType = value
+	}
+	if value, ok := hsc.mutation.SlsaPredicates(); ok {
+		_spec.SetField(hasslsa.FieldSlsaPredicates, field.TypeJSON, value)
+		_node.SlsaPredicates = value
+	}
+	if value, ok := hsc.mutation.SlsaVersion(); ok {
+		_spec.SetField(hasslsa.FieldSlsaVersion, field.TypeString, value)
+		_node.SlsaVersion = value
+	}
+	if value, ok := hsc.mutation.StartedOn(); ok {
+		_spec.SetField(hasslsa.FieldStartedOn, field.TypeTime, value)
+		_node.StartedOn = &value
+	}
+	if value, ok := hsc.mutation.FinishedOn(); ok {
+		_spec.SetField(hasslsa.FieldFinishedOn, field.TypeTime, value)
+		_node.FinishedOn = &value
+	}
+	if value, ok := hsc.mutation.Origin(); ok {
+		_spec.SetField(hasslsa.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := hsc.mutation.Collector(); ok {
+		_spec.SetField(hasslsa.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := hsc.mutation.BuiltFromHash(); ok {
+		_spec.SetField(hasslsa.FieldBuiltFromHash, field.TypeString, value)
+		_node.BuiltFromHash = value
+	}
+	if value, ok := hsc.mutation.PredicatesHash(); ok {
+		_spec.SetField(hasslsa.FieldPredicatesHash, field.TypeString, value)
+		_node.PredicatesHash = value
+	}
+	if nodes := hsc.mutation.SubjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.SubjectTable,
+			Columns: []string{hasslsa.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hsc.mutation.BuiltByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.BuiltByTable,
+			Columns: []string{hasslsa.BuiltByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuiltByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hsc.mutation.BuiltFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasSLSA.Create().
+//		SetSubjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasSLSAUpsert) {
+//			SetSubjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (hsc *HasSLSACreate) OnConflict(opts ...sql.ConflictOption) *HasSLSAUpsertOne {
+	hsc.conflict = opts
+	return &HasSLSAUpsertOne{
+		create: hsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hsc *HasSLSACreate) OnConflictColumns(columns ...string) *HasSLSAUpsertOne {
+	hsc.conflict = append(hsc.conflict, sql.ConflictColumns(columns...))
+	return &HasSLSAUpsertOne{
+		create: hsc,
+	}
+}
+
+type (
+	// HasSLSAUpsertOne is the builder for "upsert"-ing
+	//  one HasSLSA node.
+	HasSLSAUpsertOne struct {
+		create *HasSLSACreate
+	}
+
+	// HasSLSAUpsert is the "OnConflict" setter.
+	HasSLSAUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSubjectID sets the "subject_id" field.
+func (u *HasSLSAUpsert) SetSubjectID(v int) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateSubjectID() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldSubjectID)
+	return u
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (u *HasSLSAUpsert) SetBuiltByID(v int) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldBuiltByID, v)
+	return u
+}
+
+// UpdateBuiltByID sets the "built_by_id" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateBuiltByID() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldBuiltByID)
+	return u
+}
+
+// SetBuildType sets the "build_type" field.
+func (u *HasSLSAUpsert) SetBuildType(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldBuildType, v)
+	return u
+}
+
+// UpdateBuildType sets the "build_type" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateBuildType() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldBuildType)
+	return u
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (u *HasSLSAUpsert) SetSlsaPredicates(v []model.SLSAPredicate) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldSlsaPredicates, v)
+	return u
+}
+
+// UpdateSlsaPredicates sets the "slsa_predicates" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateSlsaPredicates() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldSlsaPredicates)
+	return u
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (u *HasSLSAUpsert) SetSlsaVersion(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldSlsaVersion, v)
+	return u
+}
+
+// UpdateSlsaVersion sets the "slsa_version" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateSlsaVersion() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldSlsaVersion)
+	return u
+}
+
+// SetStartedOn sets the "started_on" field.
+func (u *HasSLSAUpsert) SetStartedOn(v time.Time) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldStartedOn, v)
+	return u
+}
+
+// UpdateStartedOn sets the "started_on" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateStartedOn() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldStartedOn)
+	return u
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (u *HasSLSAUpsert) ClearStartedOn() *HasSLSAUpsert {
+	u.SetNull(hasslsa.FieldStartedOn)
+	return u
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (u *HasSLSAUpsert) SetFinishedOn(v time.Time) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldFinishedOn, v)
+	return u
+}
+
+// UpdateFinishedOn sets the "finished_on" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateFinishedOn() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldFinishedOn)
+	return u
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (u *HasSLSAUpsert) ClearFinishedOn() *HasSLSAUpsert {
+	u.SetNull(hasslsa.FieldFinishedOn)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSLSAUpsert) SetOrigin(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateOrigin() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSLSAUpsert) SetCollector(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateCollector() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldCollector)
+	return u
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (u *HasSLSAUpsert) SetBuiltFromHash(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldBuiltFromHash, v)
+	return u
+}
+
+// UpdateBuiltFromHash sets the "built_from_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdateBuiltFromHash() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldBuiltFromHash)
+	return u
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (u *HasSLSAUpsert) SetPredicatesHash(v string) *HasSLSAUpsert {
+	u.Set(hasslsa.FieldPredicatesHash, v)
+	return u
+}
+
+// UpdatePredicatesHash sets the "predicates_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsert) UpdatePredicatesHash() *HasSLSAUpsert {
+	u.SetExcluded(hasslsa.FieldPredicatesHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HasSLSAUpsertOne) UpdateNewValues() *HasSLSAUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HasSLSAUpsertOne) Ignore() *HasSLSAUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasSLSAUpsertOne) DoNothing() *HasSLSAUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasSLSACreate.OnConflict
+// documentation for more info.
+func (u *HasSLSAUpsertOne) Update(set func(*HasSLSAUpsert)) *HasSLSAUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasSLSAUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *HasSLSAUpsertOne) SetSubjectID(v int) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateSubjectID() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (u *HasSLSAUpsertOne) SetBuiltByID(v int) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuiltByID(v)
+	})
+}
+
+// UpdateBuiltByID sets the "built_by_id" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateBuiltByID() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuiltByID()
+	})
+}
+
+// SetBuildType sets the "build_type" field.
+func (u *HasSLSAUpsertOne) SetBuildType(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuildType(v)
+	})
+}
+
+// UpdateBuildType sets the "build_type" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateBuildType() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuildType()
+	})
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (u *HasSLSAUpsertOne) SetSlsaPredicates(v []model.SLSAPredicate) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSlsaPredicates(v)
+	})
+}
+
+// UpdateSlsaPredicates sets the "slsa_predicates" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateSlsaPredicates() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSlsaPredicates()
+	})
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (u *HasSLSAUpsertOne) SetSlsaVersion(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSlsaVersion(v)
+	})
+}
+
+// UpdateSlsaVersion sets the "slsa_version" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateSlsaVersion() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSlsaVersion()
+	})
+}
+
+// SetStartedOn sets the "started_on" field.
+func (u *HasSLSAUpsertOne) SetStartedOn(v time.Time) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetStartedOn(v)
+	})
+}
+
+// UpdateStartedOn sets the "started_on" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateStartedOn() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateStartedOn()
+	})
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (u *HasSLSAUpsertOne) ClearStartedOn() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.ClearStartedOn()
+	})
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (u *HasSLSAUpsertOne) SetFinishedOn(v time.Time) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetFinishedOn(v)
+	})
+}
+
+// UpdateFinishedOn sets the "finished_on" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateFinishedOn() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateFinishedOn()
+	})
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (u *HasSLSAUpsertOne) ClearFinishedOn() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.ClearFinishedOn()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSLSAUpsertOne) SetOrigin(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateOrigin() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSLSAUpsertOne) SetCollector(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateCollector() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (u *HasSLSAUpsertOne) SetBuiltFromHash(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuiltFromHash(v)
+	})
+}
+
+// UpdateBuiltFromHash sets the "built_from_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdateBuiltFromHash() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuiltFromHash()
+	})
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (u *HasSLSAUpsertOne) SetPredicatesHash(v string) *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetPredicatesHash(v)
+	})
+}
+
+// UpdatePredicatesHash sets the "predicates_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsertOne) UpdatePredicatesHash() *HasSLSAUpsertOne {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdatePredicatesHash()
+	})
+}
+
+// Exec executes the query.
+func (u *HasSLSAUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for HasSLSACreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasSLSAUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HasSLSAUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HasSLSAUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HasSLSACreateBulk is the builder for creating many HasSLSA entities in bulk.
+type HasSLSACreateBulk struct {
+	config
+	builders []*HasSLSACreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HasSLSA entities in the database.
+func (hscb *HasSLSACreateBulk) Save(ctx context.Context) ([]*HasSLSA, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(hscb.builders))
+	nodes := make([]*HasSLSA, len(hscb.builders))
+	mutators := make([]Mutator, len(hscb.builders))
+	for i := range hscb.builders {
+		func(i int, root context.Context) {
+			builder := hscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HasSLSAMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hscb *HasSLSACreateBulk) SaveX(ctx context.Context) []*HasSLSA {
+	v, err := hscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hscb *HasSLSACreateBulk) Exec(ctx context.Context) error {
+	_, err := hscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hscb *HasSLSACreateBulk) ExecX(ctx context.Context) {
+	if err := hscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasSLSA.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasSLSAUpsert) {
+//			SetSubjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (hscb *HasSLSACreateBulk) OnConflict(opts ...sql.ConflictOption) *HasSLSAUpsertBulk {
+	hscb.conflict = opts
+	return &HasSLSAUpsertBulk{
+		create: hscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hscb *HasSLSACreateBulk) OnConflictColumns(columns ...string) *HasSLSAUpsertBulk {
+	hscb.conflict = append(hscb.conflict, sql.ConflictColumns(columns...))
+	return &HasSLSAUpsertBulk{
+		create: hscb,
+	}
+}
+
+// HasSLSAUpsertBulk is the builder for "upsert"-ing
+// a bulk of HasSLSA nodes.
+type HasSLSAUpsertBulk struct {
+	create *HasSLSACreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HasSLSAUpsertBulk) UpdateNewValues() *HasSLSAUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasSLSA.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HasSLSAUpsertBulk) Ignore() *HasSLSAUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasSLSAUpsertBulk) DoNothing() *HasSLSAUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasSLSACreateBulk.OnConflict
+// documentation for more info.
+func (u *HasSLSAUpsertBulk) Update(set func(*HasSLSAUpsert)) *HasSLSAUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasSLSAUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *HasSLSAUpsertBulk) SetSubjectID(v int) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateSubjectID() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (u *HasSLSAUpsertBulk) SetBuiltByID(v int) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuiltByID(v)
+	})
+}
+
+// UpdateBuiltByID sets the "built_by_id" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateBuiltByID() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuiltByID()
+	})
+}
+
+// SetBuildType sets the "build_type" field.
+func (u *HasSLSAUpsertBulk) SetBuildType(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuildType(v)
+	})
+}
+
+// UpdateBuildType sets the "build_type" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateBuildType() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuildType()
+	})
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (u *HasSLSAUpsertBulk) SetSlsaPredicates(v []model.SLSAPredicate) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSlsaPredicates(v)
+	})
+}
+
+// UpdateSlsaPredicates sets the "slsa_predicates" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateSlsaPredicates() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSlsaPredicates()
+	})
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (u *HasSLSAUpsertBulk) SetSlsaVersion(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetSlsaVersion(v)
+	})
+}
+
+// UpdateSlsaVersion sets the "slsa_version" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateSlsaVersion() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateSlsaVersion()
+	})
+}
+
+// SetStartedOn sets the "started_on" field.
+func (u *HasSLSAUpsertBulk) SetStartedOn(v time.Time) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetStartedOn(v)
+	})
+}
+
+// UpdateStartedOn sets the "started_on" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateStartedOn() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateStartedOn()
+	})
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (u *HasSLSAUpsertBulk) ClearStartedOn() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.ClearStartedOn()
+	})
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (u *HasSLSAUpsertBulk) SetFinishedOn(v time.Time) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetFinishedOn(v)
+	})
+}
+
+// UpdateFinishedOn sets the "finished_on" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateFinishedOn() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateFinishedOn()
+	})
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (u *HasSLSAUpsertBulk) ClearFinishedOn() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.ClearFinishedOn()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasSLSAUpsertBulk) SetOrigin(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateOrigin() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasSLSAUpsertBulk) SetCollector(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateCollector() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (u *HasSLSAUpsertBulk) SetBuiltFromHash(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetBuiltFromHash(v)
+	})
+}
+
+// UpdateBuiltFromHash sets the "built_from_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdateBuiltFromHash() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdateBuiltFromHash()
+	})
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (u *HasSLSAUpsertBulk) SetPredicatesHash(v string) *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.SetPredicatesHash(v)
+	})
+}
+
+// UpdatePredicatesHash sets the "predicates_hash" field to the value that was provided on create.
+func (u *HasSLSAUpsertBulk) UpdatePredicatesHash() *HasSLSAUpsertBulk {
+	return u.Update(func(s *HasSLSAUpsert) {
+		s.UpdatePredicatesHash()
+	})
+}
+
+// Exec executes the query.
+func (u *HasSLSAUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the HasSLSACreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for HasSLSACreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasSLSAUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HasSLSADelete is the builder for deleting a HasSLSA entity.
+type HasSLSADelete struct {
+	config
+	hooks    []Hook
+	mutation *HasSLSAMutation
+}
+
+// Where appends a list predicates to the HasSLSADelete builder.
+func (hsd *HasSLSADelete) Where(ps ...predicate.HasSLSA) *HasSLSADelete {
+	hsd.mutation.Where(ps...)
+	return hsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hsd *HasSLSADelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, HasSLSAMutation](ctx, hsd.sqlExec, hsd.mutation, hsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsd *HasSLSADelete) ExecX(ctx context.Context) int {
+	n, err := hsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hsd *HasSLSADelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hasslsa.Table, sqlgraph.NewFieldSpec(hasslsa.FieldID, field.TypeInt))
+	if ps := hsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hsd.mutation.done = true
+	return affected, err
+}
+
+// HasSLSADeleteOne is the builder for deleting a single HasSLSA entity.
+type HasSLSADeleteOne struct {
+	hsd *HasSLSADelete
+}
+
+// Where appends a list predicates to the HasSLSADelete builder.
+func (hsdo *HasSLSADeleteOne) Where(ps ...predicate.HasSLSA) *HasSLSADeleteOne {
+	hsdo.hsd.mutation.Where(ps...)
+	return hsdo
+}
+
+// Exec executes the deletion query.
+func (hsdo *HasSLSADeleteOne) Exec(ctx context.Context) error {
+	n, err := hsdo.hsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hasslsa.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsdo *HasSLSADeleteOne) ExecX(ctx context.Context) {
+	if err := hsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// HasSLSAQuery is the builder for querying HasSLSA entities.
+type HasSLSAQuery struct {
+	config
+	ctx           *QueryContext
+	order         []OrderFunc
+	inters        []Interceptor
+	predicates    []predicate.HasSLSA
+	withSubject   *ArtifactQuery
+	withBuiltBy   *BuilderNodeQuery
+	withBuiltFrom *ArtifactQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HasSLSAQuery builder.
+func (hsq *HasSLSAQuery) Where(ps ...predicate.HasSLSA) *HasSLSAQuery {
+	hsq.predicates = append(hsq.predicates, ps...)
+	return hsq
+}
+
+// Limit the number of records to be returned by this query.
+func (hsq *HasSLSAQuery) Limit(limit int) *HasSLSAQuery {
+	hsq.ctx.Limit = &limit
+	return hsq
+}
+
+// Offset to start from.
+func (hsq *HasSLSAQuery) Offset(offset int) *HasSLSAQuery {
+	hsq.ctx.Offset = &offset
+	return hsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hsq *HasSLSAQuery) Unique(unique bool) *HasSLSAQuery {
+	hsq.ctx.Unique = &unique
+	return hsq
+}
+
+// Order specifies how the records should be ordered.
+func (hsq *HasSLSAQuery) Order(o ...OrderFunc) *HasSLSAQuery {
+	hsq.order = append(hsq.order, o...)
+	return hsq
+}
+
+// QuerySubject chains the current query on the "subject" edge.
+func (hsq *HasSLSAQuery) QuerySubject() *ArtifactQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasslsa.SubjectTable, hasslsa.SubjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuiltBy chains the current query on the "built_by" edge.
+func (hsq *HasSLSAQuery) QueryBuiltBy() *BuilderNodeQuery {
+	query := (&BuilderNodeClient{config: hsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, selector),
+			sqlgraph.To(buildernode.Table, buildernode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasslsa.BuiltByTable, hasslsa.BuiltByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuiltFrom chains the current query on the "built_from" edge.
+func (hsq *HasSLSAQuery) QueryBuiltFrom() *ArtifactQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasslsa.Table, hasslsa.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, hasslsa.BuiltFromTable, hasslsa.BuiltFromPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(hsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HasSLSA entity from the query.
+// Returns a *NotFoundError when no HasSLSA was found.
+func (hsq *HasSLSAQuery) First(ctx context.Context) (*HasSLSA, error) {
+	nodes, err := hsq.Limit(1).All(setContextOp(ctx, hsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hasslsa.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hsq *HasSLSAQuery) FirstX(ctx context.Context) *HasSLSA {
+	node, err := hsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HasSLSA ID from the query.
+// Returns a *NotFoundError when no HasSLSA ID was found.
+func (hsq *HasSLSAQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hsq.Limit(1).IDs(setContextOp(ctx, hsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hasslsa.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hsq *HasSLSAQuery) FirstIDX(ctx context.Context) int {
+	id, err := hsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HasSLSA entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HasSLSA entity is found.
+// Returns a *NotFoundError when no HasSLSA entities are found.
+func (hsq *HasSLSAQuery) Only(ctx context.Context) (*HasSLSA, error) {
+	nodes, err := hsq.Limit(2).All(setContextOp(ctx, hsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hasslsa.Label}
+	default:
+		return nil, &NotSingularError{hasslsa.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hsq *HasSLSAQuery) OnlyX(ctx context.Context) *HasSLSA {
+	node, err := hsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HasSLSA ID in the query.
+// Returns a *NotSingularError when more than one HasSLSA ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hsq *HasSLSAQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hsq.Limit(2).IDs(setContextOp(ctx, hsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hasslsa.Label}
+	default:
+		err = &NotSingularError{hasslsa.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hsq *HasSLSAQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HasSLSAs.
+func (hsq *HasSLSAQuery) All(ctx context.Context) ([]*HasSLSA, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "All")
+	if err := hsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HasSLSA, *HasSLSAQuery]()
+	return withInterceptors[[]*HasSLSA](ctx, hsq, qr, hsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hsq *HasSLSAQuery) AllX(ctx context.Context) []*HasSLSA {
+	nodes, err := hsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HasSLSA IDs.
+func (hsq *HasSLSAQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hsq.ctx.Unique == nil && hsq.path != nil {
+		hsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hsq.ctx, "IDs")
+	if err = hsq.Select(hasslsa.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hsq *HasSLSAQuery) IDsX(ctx context.Context) []int {
+	ids, err := hsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hsq *HasSLSAQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "Count")
+	if err := hsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hsq, querierCount[*HasSLSAQuery](), hsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hsq *HasSLSAQuery) CountX(ctx context.Context) int {
+	count, err := hsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hsq *HasSLSAQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hsq.ctx, "Exist")
+	switch _, err := hsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hsq *HasSLSAQuery) ExistX(ctx context.Context) bool {
+	exist, err := hsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HasSLSAQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hsq *HasSLSAQuery) Clone() *HasSLSAQuery {
+	if hsq == nil {
+		return nil
+	}
+	return &HasSLSAQuery{
+		config:        hsq.config,
+		ctx:           hsq.ctx.Clone(),
+		order:         append([]OrderFunc{}, hsq.order...),
+		inters:        append([]Interceptor{}, hsq.inters...),
+		predicates:    append([]predicate.HasSLSA{}, hsq.predicates...),
+		withSubject:   hsq.withSubject.Clone(),
+		withBuiltBy:   hsq.withBuiltBy.Clone(),
+		withBuiltFrom: hsq.withBuiltFrom.Clone(),
+		// clone intermediate query.
+		sql:  hsq.sql.Clone(),
+		path: hsq.path,
+	}
+}
+
+// WithSubject tells the query-builder to eager-load the nodes that are connected to
+// the "subject" edge. The optional arguments are used to configure the query builder of the edge.
+func (hsq *HasSLSAQuery) WithSubject(opts ...func(*ArtifactQuery)) *HasSLSAQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hsq.withSubject = query
+	return hsq
+}
+
+// WithBuiltBy tells the query-builder to eager-load the nodes that are connected to
+// the "built_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (hsq *HasSLSAQuery) WithBuiltBy(opts ...func(*BuilderNodeQuery)) *HasSLSAQuery {
+	query := (&BuilderNodeClient{config: hsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hsq.withBuiltBy = query
+	return hsq
+}
+
+// WithBuiltFrom tells the query-builder to eager-load the nodes that are connected to
+// the "built_from" edge. The optional arguments are used to configure the query builder of the edge.
+func (hsq *HasSLSAQuery) WithBuiltFrom(opts ...func(*ArtifactQuery)) *HasSLSAQuery {
+	query := (&ArtifactClient{config: hsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hsq.withBuiltFrom = query
+	return hsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SubjectID int `json:"subject_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HasSLSA.Query().
+//		GroupBy(hasslsa.FieldSubjectID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (hsq *HasSLSAQuery) GroupBy(field string, fields ...string) *HasSLSAGroupBy {
+	hsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HasSLSAGroupBy{build: hsq}
+	grbuild.flds = &hsq.ctx.Fields
+	grbuild.label = hasslsa.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SubjectID int `json:"subject_id,omitempty"`
+//	}
+//
+//	client.HasSLSA.Query().
+//		Select(hasslsa.FieldSubjectID).
+//		Scan(ctx, &v)
+func (hsq *HasSLSAQuery) Select(fields ...string) *HasSLSASelect {
+	hsq.ctx.Fields = append(hsq.ctx.Fields, fields...)
+	sbuild := &HasSLSASelect{HasSLSAQuery: hsq}
+	sbuild.label = hasslsa.Label
+	sbuild.flds, sbuild.scan = &hsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HasSLSASelect configured with the given aggregations.
+func (hsq *HasSLSAQuery) Aggregate(fns ...AggregateFunc) *HasSLSASelect {
+	return hsq.Select().Aggregate(fns...)
+}
+
+func (hsq *HasSLSAQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hsq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hsq.ctx.Fields {
+		if !hasslsa.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if hsq.path != nil {
+		prev, err := hsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hsq.sql = prev
+	}
+	return nil
+}
+
+func (hsq *HasSLSAQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HasSLSA, error) {
+	var (
+		nodes       = []*HasSLSA{}
+		_spec       = hsq.querySpec()
+		loadedTypes = [3]bool{
+			hsq.withSubject != nil,
+			hsq.withBuiltBy != nil,
+			hsq.withBuiltFrom != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HasSLSA).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HasSLSA{config: hsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hsq.withSubject; query != nil {
+		if err := hsq.loadSubject(ctx, query, nodes, nil,
+			func(n *HasSLSA, e *Artifact) { n.Edges.Subject = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hsq.withBuiltBy; query != nil {
+		if err := hsq.loadBuiltBy(ctx, query, nodes, nil,
+			func(n *HasSLSA, e *BuilderNode) { n.Edges.BuiltBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hsq.withBuiltFrom; query != nil {
+		if err := hsq.loadBuiltFrom(ctx, query, nodes,
+			func(n *HasSLSA) { n.Edges.BuiltFrom = []*Artifact{} },
+			func(n *HasSLSA, e *Artifact) { n.Edges.BuiltFrom = append(n.Edges.BuiltFrom, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hsq *HasSLSAQuery) loadSubject(ctx context.Context, query *ArtifactQuery, nodes []*HasSLSA, init func(*HasSLSA), assign func(*HasSLSA, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HasSLSA)
+	for i := range nodes {
+		fk := nodes[i].SubjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "subject_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hsq *HasSLSAQuery) loadBuiltBy(ctx context.Context, query *BuilderNodeQuery, nodes []*HasSLSA, init func(*HasSLSA), assign func(*HasSLSA, *BuilderNode)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HasSLSA)
+	for i := range nodes {
+		fk := nodes[i].BuiltByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(buildernode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "built_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hsq *HasSLSAQuery) loadBuiltFrom(ctx context.Context, query *ArtifactQuery, nodes []*HasSLSA, init func(*HasSLSA), assign func(*HasSLSA, *Artifact)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*HasSLSA)
+	nids := make(map[int]map[*HasSLSA]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(hasslsa.BuiltFromTable)
+		s.Join(joinT).On(s.C(artifact.FieldID), joinT.C(hasslsa.BuiltFromPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(hasslsa.BuiltFromPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(hasslsa.BuiltFromPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*HasSLSA]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Artifact](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "built_from" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (hsq *HasSLSAQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hsq.querySpec()
+	_spec.Node.Columns = hsq.ctx.Fields
+	if len(hsq.ctx.Fields) > 0 {
+		_spec.Unique = hsq.ctx.Unique != nil && *hsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hsq.driver, _spec)
+}
+
+func (hsq *HasSLSAQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hasslsa.Table, hasslsa.Columns, sqlgraph.NewFieldSpec(hasslsa.FieldID, field.TypeInt))
+	_spec.From = hsq.sql
+	if unique := hsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hasslsa.FieldID)
+		for i := range fields {
+			if fields[i] != hasslsa.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := hsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hsq *HasSLSAQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hsq.driver.Dialect())
+	t1 := builder.Table(hasslsa.Table)
+	columns := hsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hasslsa.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hsq.sql != nil {
+		selector = hsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hsq.ctx.Unique != nil && *hsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hsq.predicates {
+		p(selector)
+	}
+	for _, p := range hsq.order {
+		p(selector)
+	}
+	if offset := hsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HasSLSAGroupBy is the group-by builder for HasSLSA entities.
+type HasSLSAGroupBy struct {
+	selector
+	build *HasSLSAQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hsgb *HasSLSAGroupBy) Aggregate(fns ...AggregateFunc) *HasSLSAGroupBy {
+	hsgb.fns = append(hsgb.fns, fns...)
+	return hsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hsgb *HasSLSAGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hsgb.build.ctx, "GroupBy")
+	if err := hsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasSLSAQuery, *HasSLSAGroupBy](ctx, hsgb.build, hsgb, hsgb.build.inters, v)
+}
+
+func (hsgb *HasSLSAGroupBy) sqlScan(ctx context.Context, root *HasSLSAQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hsgb.fns))
+	for _, fn := range hsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hsgb.flds)+len(hsgb.fns))
+		for _, f := range *hsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HasSLSASelect is the builder for selecting fields of HasSLSA entities.
+type HasSLSASelect struct {
+	*HasSLSAQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hss *HasSLSASelect) Aggregate(fns ...AggregateFunc) *HasSLSASelect {
+	hss.fns = append(hss.fns, fns...)
+	return hss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hss *HasSLSASelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hss.ctx, "Select")
+	if err := hss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasSLSAQuery, *HasSLSASelect](ctx, hss.HasSLSAQuery, hss, hss.inters, v)
+}
+
+func (hss *HasSLSASelect) sqlScan(ctx context.Context, root *HasSLSAQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hss.fns))
+	for _, fn := range hss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
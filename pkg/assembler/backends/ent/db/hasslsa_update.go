@@ -0,0 +1,806 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// HasSLSAUpdate is the builder for updating HasSLSA entities.
+type HasSLSAUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HasSLSAMutation
+}
+
+// Where appends a list predicates to the HasSLSAUpdate builder.
+func (hsu *HasSLSAUpdate) Where(ps ...predicate.HasSLSA) *HasSLSAUpdate {
+	hsu.mutation.Where(ps...)
+	return hsu
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (hsu *HasSLSAUpdate) SetSubjectID(i int) *HasSLSAUpdate {
+	hsu.mutation.SetSubjectID(i)
+	return hsu
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (hsu *HasSLSAUpdate) SetBuiltByID(i int) *HasSLSAUpdate {
+	hsu.mutation.SetBuiltByID(i)
+	return hsu
+}
+
+// SetBuildType sets the "build_type" field.
+func (hsu *HasSLSAUpdate) SetBuildType(s string) *HasSLSAUpdate {
+	hsu.mutation.SetBuildType(s)
+	return hsu
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (hsu *HasSLSAUpdate) SetSlsaPredicates(mp []model.SLSAPredicate) *HasSLSAUpdate {
+	hsu.mutation.SetSlsaPredicates(mp)
+	return hsu
+}
+
+// AppendSlsaPredicates appends mp to the "slsa_predicates" field.
+func (hsu *HasSLSAUpdate) AppendSlsaPredicates(mp []model.SLSAPredicate) *HasSLSAUpdate {
+	hsu.mutation.AppendSlsaPredicates(mp)
+	return hsu
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (hsu *HasSLSAUpdate) SetSlsaVersion(s string) *HasSLSAUpdate {
+	hsu.mutation.SetSlsaVersion(s)
+	return hsu
+}
+
+// SetStartedOn sets the "started_on" field.
+func (hsu *HasSLSAUpdate) SetStartedOn(t time.Time) *HasSLSAUpdate {
+	hsu.mutation.SetStartedOn(t)
+	return hsu
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (hsu *HasSLSAUpdate) SetNillableStartedOn(t *time.Time) *HasSLSAUpdate {
+	if t != nil {
+		hsu.SetStartedOn(*t)
+	}
+	return hsu
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (hsu *HasSLSAUpdate) ClearStartedOn() *HasSLSAUpdate {
+	hsu.mutation.ClearStartedOn()
+	return hsu
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (hsu *HasSLSAUpdate) SetFinishedOn(t time.Time) *HasSLSAUpdate {
+	hsu.mutation.SetFinishedOn(t)
+	return hsu
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (hsu *HasSLSAUpdate) SetNillableFinishedOn(t *time.Time) *HasSLSAUpdate {
+	if t != nil {
+		hsu.SetFinishedOn(*t)
+	}
+	return hsu
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (hsu *HasSLSAUpdate) ClearFinishedOn() *HasSLSAUpdate {
+	hsu.mutation.ClearFinishedOn()
+	return hsu
+}
+
+// SetOrigin sets the "origin" field.
+func (hsu *HasSLSAUpdate) SetOrigin(s string) *HasSLSAUpdate {
+	hsu.mutation.SetOrigin(s)
+	return hsu
+}
+
+// SetCollector sets the "collector" field.
+func (hsu *HasSLSAUpdate) SetCollector(s string) *HasSLSAUpdate {
+	hsu.mutation.SetCollector(s)
+	return hsu
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (hsu *HasSLSAUpdate) SetBuiltFromHash(s string) *HasSLSAUpdate {
+	hsu.mutation.SetBuiltFromHash(s)
+	return hsu
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (hsu *HasSLSAUpdate) SetPredicatesHash(s string) *HasSLSAUpdate {
+	hsu.mutation.SetPredicatesHash(s)
+	return hsu
+}
+
+// SetSubject sets the "subject" edge to the Artifact entity.
+func (hsu *HasSLSAUpdate) SetSubject(a *Artifact) *HasSLSAUpdate {
+	return hsu.SetSubjectID(a.ID)
+}
+
+// SetBuiltBy sets the "built_by" edge to the BuilderNode entity.
+func (hsu *HasSLSAUpdate) SetBuiltBy(b *BuilderNode) *HasSLSAUpdate {
+	return hsu.SetBuiltByID(b.ID)
+}
+
+// AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by IDs.
+func (hsu *HasSLSAUpdate) AddBuiltFromIDs(ids ...int) *HasSLSAUpdate {
+	hsu.mutation.AddBuiltFromIDs(ids...)
+	return hsu
+}
+
+// AddBuiltFrom adds the "built_from" edges to the Artifact entity.
+func (hsu *HasSLSAUpdate) AddBuiltFrom(a ...*Artifact) *HasSLSAUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hsu.AddBuiltFromIDs(ids...)
+}
+
+// Mutation returns the HasSLSAMutation object of the builder.
+func (hsu *HasSLSAUpdate) Mutation() *HasSLSAMutation {
+	return hsu.mutation
+}
+
+// ClearSubject clears the "subject" edge to the Artifact entity.
+func (hsu *HasSLSAUpdate) ClearSubject() *HasSLSAUpdate {
+	hsu.mutation.ClearSubject()
+	return hsu
+}
+
+// ClearBuiltBy clears the "built_by" edge to the BuilderNode entity.
+func (hsu *HasSLSAUpdate) ClearBuiltBy() *HasSLSAUpdate {
+	hsu.mutation.ClearBuiltBy()
+	return hsu
+}
+
+// ClearBuiltFrom clears all "built_from" edges to the Artifact entity.
+func (hsu *HasSLSAUpdate) ClearBuiltFrom() *HasSLSAUpdate {
+	hsu.mutation.ClearBuiltFrom()
+	return hsu
+}
+
+// RemoveBuiltFromIDs removes the "built_from" edge to Artifact entities by IDs.
+func (hsu *HasSLSAUpdate) RemoveBuiltFromIDs(ids ...int) *HasSLSAUpdate {
+	hsu.mutation.RemoveBuiltFromIDs(ids...)
+	return hsu
+}
+
+// RemoveBuiltFrom removes "built_from" edges to Artifact entities.
+func (hsu *HasSLSAUpdate) RemoveBuiltFrom(a ...*Artifact) *HasSLSAUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hsu.RemoveBuiltFromIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hsu *HasSLSAUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, HasSLSAMutation](ctx, hsu.sqlSave, hsu.mutation, hsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hsu *HasSLSAUpdate) SaveX(ctx context.Context) int {
+	affected, err := hsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hsu *HasSLSAUpdate) Exec(ctx context.Context) error {
+	_, err := hsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsu *HasSLSAUpdate) ExecX(ctx context.Context) {
+	if err := hsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hsu *HasSLSAUpdate) check() error {
+	if _, ok := hsu.mutation.SubjectID(); hsu.mutation.SubjectCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HasSLSA.subject"`)
+	}
+	if _, ok := hsu.mutation.BuiltByID(); hsu.mutation.BuiltByCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HasSLSA.built_by"`)
+	}
+	return nil
+}
+
+func (hsu *HasSLSAUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hasslsa.Table, hasslsa.Columns, sqlgraph.NewFieldSpec(hasslsa.FieldID, field.TypeInt))
+	if ps := hsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hsu.mutation.BuildType(); ok {
+		_spec.SetField(hasslsa.FieldBuildType, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.SlsaPredicates(); ok {
+		_spec.SetField(hasslsa.FieldSlsaPredicates, field.TypeJSON, value)
+	}
+	if value, ok := hsu.mutation.AppendedSlsaPredicates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, hasslsa.FieldSlsaPredicates, value)
+		})
+	}
+	if value, ok := hsu.mutation.SlsaVersion(); ok {
+		_spec.SetField(hasslsa.FieldSlsaVersion, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.StartedOn(); ok {
+		_spec.SetField(hasslsa.FieldStartedOn, field.TypeTime, value)
+	}
+	if hsu.mutation.StartedOnCleared() {
+		_spec.ClearField(hasslsa.FieldStartedOn, field.TypeTime)
+	}
+	if value, ok := hsu.mutation.FinishedOn(); ok {
+		_spec.SetField(hasslsa.FieldFinishedOn, field.TypeTime, value)
+	}
+	if hsu.mutation.FinishedOnCleared() {
+		_spec.ClearField(hasslsa.FieldFinishedOn, field.TypeTime)
+	}
+	if value, ok := hsu.mutation.Origin(); ok {
+		_spec.SetField(hasslsa.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.Collector(); ok {
+		_spec.SetField(hasslsa.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.BuiltFromHash(); ok {
+		_spec.SetField(hasslsa.FieldBuiltFromHash, field.TypeString, value)
+	}
+	if value, ok := hsu.mutation.PredicatesHash(); ok {
+		_spec.SetField(hasslsa.FieldPredicatesHash, field.TypeString, value)
+	}
+	if hsu.mutation.SubjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.SubjectTable,
+			Columns: []string{hasslsa.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.SubjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.SubjectTable,
+			Columns: []string{hasslsa.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsu.mutation.BuiltByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.BuiltByTable,
+			Columns: []string{hasslsa.BuiltByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.BuiltByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.BuiltByTable,
+			Columns: []string{hasslsa.BuiltByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsu.mutation.BuiltFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.RemovedBuiltFromIDs(); len(nodes) > 0 && !hsu.mutation.BuiltFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsu.mutation.BuiltFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hasslsa.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hsu.mutation.done = true
+	return n, nil
+}
+
+// HasSLSAUpdateOne is the builder for updating a single HasSLSA entity.
+type HasSLSAUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HasSLSAMutation
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (hsuo *HasSLSAUpdateOne) SetSubjectID(i int) *HasSLSAUpdateOne {
+	hsuo.mutation.SetSubjectID(i)
+	return hsuo
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (hsuo *HasSLSAUpdateOne) SetBuiltByID(i int) *HasSLSAUpdateOne {
+	hsuo.mutation.SetBuiltByID(i)
+	return hsuo
+}
+
+// SetBuildType sets the "build_type" field.
+func (hsuo *HasSLSAUpdateOne) SetBuildType(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetBuildType(s)
+	return hsuo
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (hsuo *HasSLSAUpdateOne) SetSlsaPredicates(mp []model.SLSAPredicate) *HasSLSAUpdateOne {
+	hsuo.mutation.SetSlsaPredicates(mp)
+	return hsuo
+}
+
+// AppendSlsaPredicates appends mp to the "slsa_predicates" field.
+func (hsuo *HasSLSAUpdateOne) AppendSlsaPredicates(mp []model.SLSAPredicate) *HasSLSAUpdateOne {
+	hsuo.mutation.AppendSlsaPredicates(mp)
+	return hsuo
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (hsuo *HasSLSAUpdateOne) SetSlsaVersion(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetSlsaVersion(s)
+	return hsuo
+}
+
+// SetStartedOn sets the "started_on" field.
+func (hsuo *HasSLSAUpdateOne) SetStartedOn(t time.Time) *HasSLSAUpdateOne {
+	hsuo.mutation.SetStartedOn(t)
+	return hsuo
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (hsuo *HasSLSAUpdateOne) SetNillableStartedOn(t *time.Time) *HasSLSAUpdateOne {
+	if t != nil {
+		hsuo.SetStartedOn(*t)
+	}
+	return hsuo
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (hsuo *HasSLSAUpdateOne) ClearStartedOn() *HasSLSAUpdateOne {
+	hsuo.mutation.ClearStartedOn()
+	return hsuo
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (hsuo *HasSLSAUpdateOne) SetFinishedOn(t time.Time) *HasSLSAUpdateOne {
+	hsuo.mutation.SetFinishedOn(t)
+	return hsuo
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (hsuo *HasSLSAUpdateOne) SetNillableFinishedOn(t *time.Time) *HasSLSAUpdateOne {
+	if t != nil {
+		hsuo.SetFinishedOn(*t)
+	}
+	return hsuo
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (hsuo *HasSLSAUpdateOne) ClearFinishedOn() *HasSLSAUpdateOne {
+	hsuo.mutation.ClearFinishedOn()
+	return hsuo
+}
+
+// SetOrigin sets the "origin" field.
+func (hsuo *HasSLSAUpdateOne) SetOrigin(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetOrigin(s)
+	return hsuo
+}
+
+// SetCollector sets the "collector" field.
+func (hsuo *HasSLSAUpdateOne) SetCollector(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetCollector(s)
+	return hsuo
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (hsuo *HasSLSAUpdateOne) SetBuiltFromHash(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetBuiltFromHash(s)
+	return hsuo
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (hsuo *HasSLSAUpdateOne) SetPredicatesHash(s string) *HasSLSAUpdateOne {
+	hsuo.mutation.SetPredicatesHash(s)
+	return hsuo
+}
+
+// SetSubject sets the "subject" edge to the Artifact entity.
+func (hsuo *HasSLSAUpdateOne) SetSubject(a *Artifact) *HasSLSAUpdateOne {
+	return hsuo.SetSubjectID(a.ID)
+}
+
+// SetBuiltBy sets the "built_by" edge to the BuilderNode entity.
+func (hsuo *HasSLSAUpdateOne) SetBuiltBy(b *BuilderNode) *HasSLSAUpdateOne {
+	return hsuo.SetBuiltByID(b.ID)
+}
+
+// AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by IDs.
+func (hsuo *HasSLSAUpdateOne) AddBuiltFromIDs(ids ...int) *HasSLSAUpdateOne {
+	hsuo.mutation.AddBuiltFromIDs(ids...)
+	return hsuo
+}
+
+// AddBuiltFrom adds the "built_from" edges to the Artifact entity.
+func (hsuo *HasSLSAUpdateOne) AddBuiltFrom(a ...*Artifact) *HasSLSAUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hsuo.AddBuiltFromIDs(ids...)
+}
+
+// Mutation returns the HasSLSAMutation object of the builder.
+func (hsuo *HasSLSAUpdateOne) Mutation() *HasSLSAMutation {
+	return hsuo.mutation
+}
+
+// ClearSubject clears the "subject" edge to the Artifact entity.
+func (hsuo *HasSLSAUpdateOne) ClearSubject() *HasSLSAUpdateOne {
+	hsuo.mutation.ClearSubject()
+	return hsuo
+}
+
+// ClearBuiltBy clears the "built_by" edge to the BuilderNode entity.
+func (hsuo *HasSLSAUpdateOne) ClearBuiltBy() *HasSLSAUpdateOne {
+	hsuo.mutation.ClearBuiltBy()
+	return hsuo
+}
+
+// ClearBuiltFrom clears all "built_from" edges to the Artifact entity.
+func (hsuo *HasSLSAUpdateOne) ClearBuiltFrom() *HasSLSAUpdateOne {
+	hsuo.mutation.ClearBuiltFrom()
+	return hsuo
+}
+
+// RemoveBuiltFromIDs removes the "built_from" edge to Artifact entities by IDs.
+func (hsuo *HasSLSAUpdateOne) RemoveBuiltFromIDs(ids ...int) *HasSLSAUpdateOne {
+	hsuo.mutation.RemoveBuiltFromIDs(ids...)
+	return hsuo
+}
+
+// RemoveBuiltFrom removes "built_from" edges to Artifact entities.
+func (hsuo *HasSLSAUpdateOne) RemoveBuiltFrom(a ...*Artifact) *HasSLSAUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hsuo.RemoveBuiltFromIDs(ids...)
+}
+
+// Where appends a list predicates to the HasSLSAUpdate builder.
+func (hsuo *HasSLSAUpdateOne) Where(ps ...predicate.HasSLSA) *HasSLSAUpdateOne {
+	hsuo.mutation.Where(ps...)
+	return hsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hsuo *HasSLSAUpdateOne) Select(field string, fields ...string) *HasSLSAUpdateOne {
+	hsuo.fields = append([]string{field}, fields...)
+	return hsuo
+}
+
+// Save executes the query and returns the updated HasSLSA entity.
+func (hsuo *HasSLSAUpdateOne) Save(ctx context.Context) (*HasSLSA, error) {
+	return withHooks[*HasSLSA, HasSLSAMutation](ctx, hsuo.sqlSave, hsuo.mutation, hsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hsuo *HasSLSAUpdateOne) SaveX(ctx context.Context) *HasSLSA {
+	node, err := hsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hsuo *HasSLSAUpdateOne) Exec(ctx context.Context) error {
+	_, err := hsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hsuo *HasSLSAUpdateOne) ExecX(ctx context.Context) {
+	if err := hsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hsuo *HasSLSAUpdateOne) check() error {
+	if _, ok := hsuo.mutation.SubjectID(); hsuo.mutation.SubjectCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HasSLSA.subject"`)
+	}
+	if _, ok := hsuo.mutation.BuiltByID(); hsuo.mutation.BuiltByCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "HasSLSA.built_by"`)
+	}
+	return nil
+}
+
+func (hsuo *HasSLSAUpdateOne) sqlSave(ctx context.Context) (_node *HasSLSA, err error) {
+	if err := hsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hasslsa.Table, hasslsa.Columns, sqlgraph.NewFieldSpec(hasslsa.FieldID, field.TypeInt))
+	id, ok := hsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "HasSLSA.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hasslsa.FieldID)
+		for _, f := range fields {
+			if !hasslsa.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != hasslsa.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hsuo.mutation.BuildType(); ok {
+		_spec.SetField(hasslsa.FieldBuildType, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.SlsaPredicates(); ok {
+		_spec.SetField(hasslsa.FieldSlsaPredicates, field.TypeJSON, value)
+	}
+	if value, ok := hsuo.mutation.AppendedSlsaPredicates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, hasslsa.FieldSlsaPredicates, value)
+		})
+	}
+	if value, ok := hsuo.mutation.SlsaVersion(); ok {
+		_spec.SetField(hasslsa.FieldSlsaVersion, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.StartedOn(); ok {
+		_spec.SetField(hasslsa.FieldStartedOn, field.TypeTime, value)
+	}
+	if hsuo.mutation.StartedOnCleared() {
+		_spec.ClearField(hasslsa.FieldStartedOn, field.TypeTime)
+	}
+	if value, ok := hsuo.mutation.FinishedOn(); ok {
+		_spec.SetField(hasslsa.FieldFinishedOn, field.TypeTime, value)
+	}
+	if hsuo.mutation.FinishedOnCleared() {
+		_spec.ClearField(hasslsa.FieldFinishedOn, field.TypeTime)
+	}
+	if value, ok := hsuo.mutation.Origin(); ok {
+		_spec.SetField(hasslsa.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.Collector(); ok {
+		_spec.SetField(hasslsa.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.BuiltFromHash(); ok {
+		_spec.SetField(hasslsa.FieldBuiltFromHash, field.TypeString, value)
+	}
+	if value, ok := hsuo.mutation.PredicatesHash(); ok {
+		_spec.SetField(hasslsa.FieldPredicatesHash, field.TypeString, value)
+	}
+	if hsuo.mutation.SubjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.SubjectTable,
+			Columns: []string{hasslsa.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.SubjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.SubjectTable,
+			Columns: []string{hasslsa.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsuo.mutation.BuiltByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.BuiltByTable,
+			Columns: []string{hasslsa.BuiltByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.BuiltByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasslsa.BuiltByTable,
+			Columns: []string{hasslsa.BuiltByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildernode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hsuo.mutation.BuiltFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.RemovedBuiltFromIDs(); len(nodes) > 0 && !hsuo.mutation.BuiltFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hsuo.mutation.BuiltFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   hasslsa.BuiltFromTable,
+			Columns: hasslsa.BuiltFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HasSLSA{config: hsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hasslsa.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+)
+
+// The ArtifactFunc type is an adapter to allow the use of ordinary
+// function as Artifact mutator.
+type ArtifactFunc func(context.Context, *db.ArtifactMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArtifactFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ArtifactMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ArtifactMutation", m)
+}
+
+// The BuilderNodeFunc type is an adapter to allow the use of ordinary
+// function as BuilderNode mutator.
+type BuilderNodeFunc func(context.Context, *db.BuilderNodeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BuilderNodeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.BuilderNodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.BuilderNodeMutation", m)
+}
+
+// The CertifyBadFunc type is an adapter to allow the use of ordinary
+// function as CertifyBad mutator.
+type CertifyBadFunc func(context.Context, *db.CertifyBadMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyBadFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CertifyBadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CertifyBadMutation", m)
+}
+
+// The CertifyGoodFunc type is an adapter to allow the use of ordinary
+// function as CertifyGood mutator.
+type CertifyGoodFunc func(context.Context, *db.CertifyGoodMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyGoodFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CertifyGoodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CertifyGoodMutation", m)
+}
+
+// The CertifyLegalFunc type is an adapter to allow the use of ordinary
+// function as CertifyLegal mutator.
+type CertifyLegalFunc func(context.Context, *db.CertifyLegalMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyLegalFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CertifyLegalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CertifyLegalMutation", m)
+}
+
+// The CertifyVEXStatementFunc type is an adapter to allow the use of ordinary
+// function as CertifyVEXStatement mutator.
+type CertifyVEXStatementFunc func(context.Context, *db.CertifyVEXStatementMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyVEXStatementFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CertifyVEXStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CertifyVEXStatementMutation", m)
+}
+
+// The CertifyVulnFunc type is an adapter to allow the use of ordinary
+// function as CertifyVuln mutator.
+type CertifyVulnFunc func(context.Context, *db.CertifyVulnMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyVulnFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CertifyVulnMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CertifyVulnMutation", m)
+}
+
+// The HasMetadataFunc type is an adapter to allow the use of ordinary
+// function as HasMetadata mutator.
+type HasMetadataFunc func(context.Context, *db.HasMetadataMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HasMetadataFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.HasMetadataMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.HasMetadataMutation", m)
+}
+
+// The HasSBOMFunc type is an adapter to allow the use of ordinary
+// function as HasSBOM mutator.
+type HasSBOMFunc func(context.Context, *db.HasSBOMMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HasSBOMFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.HasSBOMMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.HasSBOMMutation", m)
+}
+
+// The HasSLSAFunc type is an adapter to allow the use of ordinary
+// function as HasSLSA mutator.
+type HasSLSAFunc func(context.Context, *db.HasSLSAMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HasSLSAFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.HasSLSAMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.HasSLSAMutation", m)
+}
+
+// The HashEqualFunc type is an adapter to allow the use of ordinary
+// function as HashEqual mutator.
+type HashEqualFunc func(context.Context, *db.HashEqualMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HashEqualFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.HashEqualMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.HashEqualMutation", m)
+}
+
+// The IsDependencyFunc type is an adapter to allow the use of ordinary
+// function as IsDependency mutator.
+type IsDependencyFunc func(context.Context, *db.IsDependencyMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IsDependencyFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.IsDependencyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.IsDependencyMutation", m)
+}
+
+// The IsOccurrenceFunc type is an adapter to allow the use of ordinary
+// function as IsOccurrence mutator.
+type IsOccurrenceFunc func(context.Context, *db.IsOccurrenceMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IsOccurrenceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.IsOccurrenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.IsOccurrenceMutation", m)
+}
+
+// The PackageNameFunc type is an adapter to allow the use of ordinary
+// function as PackageName mutator.
+type PackageNameFunc func(context.Context, *db.PackageNameMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PackageNameFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PackageNameMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PackageNameMutation", m)
+}
+
+// The PackageNamespaceFunc type is an adapter to allow the use of ordinary
+// function as PackageNamespace mutator.
+type PackageNamespaceFunc func(context.Context, *db.PackageNamespaceMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PackageNamespaceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PackageNamespaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PackageNamespaceMutation", m)
+}
+
+// The PackageTypeFunc type is an adapter to allow the use of ordinary
+// function as PackageType mutator.
+type PackageTypeFunc func(context.Context, *db.PackageTypeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PackageTypeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PackageTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PackageTypeMutation", m)
+}
+
+// The PackageVersionFunc type is an adapter to allow the use of ordinary
+// function as PackageVersion mutator.
+type PackageVersionFunc func(context.Context, *db.PackageVersionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PackageVersionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PackageVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PackageVersionMutation", m)
+}
+
+// The PkgEqualFunc type is an adapter to allow the use of ordinary
+// function as PkgEqual mutator.
+type PkgEqualFunc func(context.Context, *db.PkgEqualMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PkgEqualFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PkgEqualMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PkgEqualMutation", m)
+}
+
+// The PointOfContactFunc type is an adapter to allow the use of ordinary
+// function as PointOfContact mutator.
+type PointOfContactFunc func(context.Context, *db.PointOfContactMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PointOfContactFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PointOfContactMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PointOfContactMutation", m)
+}
+
+// The ScorecardFunc type is an adapter to allow the use of ordinary
+// function as Scorecard mutator.
+type ScorecardFunc func(context.Context, *db.ScorecardMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScorecardFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ScorecardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ScorecardMutation", m)
+}
+
+// The SourceNameFunc type is an adapter to allow the use of ordinary
+// function as SourceName mutator.
+type SourceNameFunc func(context.Context, *db.SourceNameMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceNameFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SourceNameMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SourceNameMutation", m)
+}
+
+// The SourceNamespaceFunc type is an adapter to allow the use of ordinary
+// function as SourceNamespace mutator.
+type SourceNamespaceFunc func(context.Context, *db.SourceNamespaceMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceNamespaceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SourceNamespaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SourceNamespaceMutation", m)
+}
+
+// The SourceTypeFunc type is an adapter to allow the use of ordinary
+// function as SourceType mutator.
+type SourceTypeFunc func(context.Context, *db.SourceTypeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceTypeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SourceTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SourceTypeMutation", m)
+}
+
+// The VulnEqualFunc type is an adapter to allow the use of ordinary
+// function as VulnEqual mutator.
+type VulnEqualFunc func(context.Context, *db.VulnEqualMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VulnEqualFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.VulnEqualMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.VulnEqualMutation", m)
+}
+
+// The VulnerabilityIDFunc type is an adapter to allow the use of ordinary
+// function as VulnerabilityID mutator.
+type VulnerabilityIDFunc func(context.Context, *db.VulnerabilityIDMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VulnerabilityIDFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.VulnerabilityIDMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.VulnerabilityIDMutation", m)
+}
+
+// The VulnerabilityTypeFunc type is an adapter to allow the use of ordinary
+// function as VulnerabilityType mutator.
+type VulnerabilityTypeFunc func(context.Context, *db.VulnerabilityTypeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VulnerabilityTypeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.VulnerabilityTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.VulnerabilityTypeMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, db.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m db.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m db.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m db.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op db.Op) Condition {
+	return func(_ context.Context, m db.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m db.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m db.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m db.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk db.Hook, cond Condition) db.Hook {
+	return func(next db.Mutator) db.Mutator {
+		return db.MutateFunc(func(ctx context.Context, m db.Mutation) (db.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, db.Delete|db.Create)
+func On(hk db.Hook, op db.Op) db.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, db.Update|db.UpdateOne)
+func Unless(hk db.Hook, op db.Op) db.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) db.Hook {
+	return func(db.Mutator) db.Mutator {
+		return db.MutateFunc(func(context.Context, db.Mutation) (db.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []db.Hook {
+//		return []db.Hook{
+//			Reject(db.Delete|db.Update),
+//		}
+//	}
+func Reject(op db.Op) db.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []db.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...db.Hook) Chain {
+	return Chain{append([]db.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() db.Hook {
+	return func(mutator db.Mutator) db.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...db.Hook) Chain {
+	newHooks := make([]db.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
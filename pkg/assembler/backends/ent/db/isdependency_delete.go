@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isdependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// IsDependencyDelete is the builder for deleting a IsDependency entity.
+type IsDependencyDelete struct {
+	config
+	hooks    []Hook
+	mutation *IsDependencyMutation
+}
+
+// Where appends a list predicates to the IsDependencyDelete builder.
+func (idd *IsDependencyDelete) Where(ps ...predicate.IsDependency) *IsDependencyDelete {
+	idd.mutation.Where(ps...)
+	return idd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (idd *IsDependencyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, IsDependencyMutation](ctx, idd.sqlExec, idd.mutation, idd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idd *IsDependencyDelete) ExecX(ctx context.Context) int {
+	n, err := idd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (idd *IsDependencyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(isdependency.Table, sqlgraph.NewFieldSpec(isdependency.FieldID, field.TypeInt))
+	if ps := idd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, idd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	idd.mutation.done = true
+	return affected, err
+}
+
+// IsDependencyDeleteOne is the builder for deleting a single IsDependency entity.
+type IsDependencyDeleteOne struct {
+	idd *IsDependencyDelete
+}
+
+// Where appends a list predicates to the IsDependencyDelete builder.
+func (iddo *IsDependencyDeleteOne) Where(ps ...predicate.IsDependency) *IsDependencyDeleteOne {
+	iddo.idd.mutation.Where(ps...)
+	return iddo
+}
+
+// Exec executes the deletion query.
+func (iddo *IsDependencyDeleteOne) Exec(ctx context.Context) error {
+	n, err := iddo.idd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{isdependency.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iddo *IsDependencyDeleteOne) ExecX(ctx context.Context) {
+	if err := iddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isoccurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// IsOccurrence is the model entity for the IsOccurrence schema.
+type IsOccurrence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageID holds the value of the "package_id" field.
+	PackageID *int `json:"package_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID *int `json:"source_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID int `json:"artifact_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IsOccurrenceQuery when eager-loading is set.
+	Edges IsOccurrenceEdges `json:"edges"`
+}
+
+// IsOccurrenceEdges holds the relations/edges for other nodes in the graph.
+type IsOccurrenceEdges struct {
+	// Package holds the value of the package edge.
+	Package *PackageVersion `json:"package,omitempty"`
+	// Source holds the value of the source edge.
+	Source *SourceName `json:"source,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PackageOrErr returns the Package value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IsOccurrenceEdges) PackageOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.Package == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.Package, nil
+	}
+	return nil, &NotLoadedError{edge: "package"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IsOccurrenceEdges) SourceOrErr() (*SourceName, error) {
+	if e.loadedTypes[1] {
+		if e.Source == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: sourcename.Label}
+		}
+		return e.Source, nil
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IsOccurrenceEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[2] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IsOccurrence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case isoccurrence.FieldID, isoccurrence.FieldPackageID, isoccurrence.FieldSourceID, isoccurrence.FieldArtifactID:
+			values[i] = new(sql.NullInt64)
+		case isoccurrence.FieldJustification, isoccurrence.FieldOrigin, isoccurrence.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IsOccurrence", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IsOccurrence fields.
+func (io *IsOccurrence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case isoccurrence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			io.ID = int(value.Int64)
+		case isoccurrence.FieldPackageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value.Valid {
+				io.PackageID = new(int)
+				*io.PackageID = int(value.Int64)
+			}
+		case isoccurrence.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				io.SourceID = new(int)
+				*io.SourceID = int(value.Int64)
+			}
+		case isoccurrence.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				io.ArtifactID = int(value.Int64)
+			}
+		case isoccurrence.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				io.Justification = value.String
+			}
+		case isoccurrence.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				io.Origin = value.String
+			}
+		case isoccurrence.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				io.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackage queries the "package" edge of the IsOccurrence entity.
+func (io *IsOccurrence) QueryPackage() *PackageVersionQuery {
+	return NewIsOccurrenceClient(io.config).QueryPackage(io)
+}
+
+// QuerySource queries the "source" edge of the IsOccurrence entity.
+func (io *IsOccurrence) QuerySource() *SourceNameQuery {
+	return NewIsOccurrenceClient(io.config).QuerySource(io)
+}
+
+// QueryArtifact queries the "artifact" edge of the IsOccurrence entity.
+func (io *IsOccurrence) QueryArtifact() *ArtifactQuery {
+	return NewIsOccurrenceClient(io.config).QueryArtifact(io)
+}
+
+// Update returns a builder for updating this IsOccurrence.
+// Note that you need to call IsOccurrence.Unwrap() before calling this method if this IsOccurrence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (io *IsOccurrence) Update() *IsOccurrenceUpdateOne {
+	return NewIsOccurrenceClient(io.config).UpdateOne(io)
+}
+
+// Unwrap unwraps the IsOccurrence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (io *IsOccurrence) Unwrap() *IsOccurrence {
+	_tx, ok := io.config.driver.(*txDriver)
+	if !ok {
+		panic("db: IsOccurrence is not a transactional entity")
+	}
+	io.config.driver = _tx.drv
+	return io
+}
+
+// String implements the fmt.Stringer.
+func (io *IsOccurrence) String() string {
+	var builder strings.Builder
+	builder.WriteString("IsOccurrence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", io.ID))
+	if v := io.PackageID; v != nil {
+		builder.WriteString("package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := io.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("artifact_id=")
+	builder.WriteString(fmt.Sprintf("%v", io.ArtifactID))
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(io.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(io.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(io.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsOccurrences is a parsable slice of IsOccurrence.
+type IsOccurrences []*IsOccurrence
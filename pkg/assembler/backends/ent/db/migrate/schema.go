@@ -0,0 +1,1157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// ArtifactsColumns holds the columns for the "artifacts" table.
+	ArtifactsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString},
+	}
+	// ArtifactsTable holds the schema information for the "artifacts" table.
+	ArtifactsTable = &schema.Table{
+		Name:       "artifacts",
+		Columns:    ArtifactsColumns,
+		PrimaryKey: []*schema.Column{ArtifactsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "artifacts_unique",
+				Unique:  true,
+				Columns: []*schema.Column{ArtifactsColumns[1], ArtifactsColumns[2]},
+			},
+		},
+	}
+	// BuildersColumns holds the columns for the "builders" table.
+	BuildersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uri", Type: field.TypeString, Unique: true},
+	}
+	// BuildersTable holds the schema information for the "builders" table.
+	BuildersTable = &schema.Table{
+		Name:       "builders",
+		Columns:    BuildersColumns,
+		PrimaryKey: []*schema.Column{BuildersColumns[0]},
+	}
+	// CertifyBadsColumns holds the columns for the "certify_bads" table.
+	CertifyBadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
+		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CertifyBadsTable holds the schema information for the "certify_bads" table.
+	CertifyBadsTable = &schema.Table{
+		Name:       "certify_bads",
+		Columns:    CertifyBadsColumns,
+		PrimaryKey: []*schema.Column{CertifyBadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_bads_package_versions_package_version",
+				Columns:    []*schema.Column{CertifyBadsColumns[4]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_bads_package_names_package_name",
+				Columns:    []*schema.Column{CertifyBadsColumns[5]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_bads_source_names_source",
+				Columns:    []*schema.Column{CertifyBadsColumns[6]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_bads_artifacts_artifact",
+				Columns:    []*schema.Column{CertifyBadsColumns[7]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certify_bads_package_version_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyBadsColumns[4], CertifyBadsColumns[1], CertifyBadsColumns[2], CertifyBadsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_version_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_bads_package_name_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyBadsColumns[5], CertifyBadsColumns[1], CertifyBadsColumns[2], CertifyBadsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_name_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_bads_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyBadsColumns[6], CertifyBadsColumns[1], CertifyBadsColumns[2], CertifyBadsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_bads_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyBadsColumns[7], CertifyBadsColumns[1], CertifyBadsColumns[2], CertifyBadsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// CertifyGoodsColumns holds the columns for the "certify_goods" table.
+	CertifyGoodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
+		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CertifyGoodsTable holds the schema information for the "certify_goods" table.
+	CertifyGoodsTable = &schema.Table{
+		Name:       "certify_goods",
+		Columns:    CertifyGoodsColumns,
+		PrimaryKey: []*schema.Column{CertifyGoodsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_goods_package_versions_package_version",
+				Columns:    []*schema.Column{CertifyGoodsColumns[4]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_goods_package_names_package_name",
+				Columns:    []*schema.Column{CertifyGoodsColumns[5]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_goods_source_names_source",
+				Columns:    []*schema.Column{CertifyGoodsColumns[6]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_goods_artifacts_artifact",
+				Columns:    []*schema.Column{CertifyGoodsColumns[7]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certify_goods_package_version_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyGoodsColumns[4], CertifyGoodsColumns[1], CertifyGoodsColumns[2], CertifyGoodsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_version_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_goods_package_name_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyGoodsColumns[5], CertifyGoodsColumns[1], CertifyGoodsColumns[2], CertifyGoodsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_name_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_goods_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyGoodsColumns[6], CertifyGoodsColumns[1], CertifyGoodsColumns[2], CertifyGoodsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_goods_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyGoodsColumns[7], CertifyGoodsColumns[1], CertifyGoodsColumns[2], CertifyGoodsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// CertifyLegalsColumns holds the columns for the "certify_legals" table.
+	CertifyLegalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "declared_license", Type: field.TypeString, Size: 2147483647},
+		{Name: "discovered_license", Type: field.TypeString, Size: 2147483647},
+		{Name: "attribution", Type: field.TypeString, Size: 2147483647},
+		{Name: "legal_hash", Type: field.TypeString},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "time_scanned", Type: field.TypeTime},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CertifyLegalsTable holds the schema information for the "certify_legals" table.
+	CertifyLegalsTable = &schema.Table{
+		Name:       "certify_legals",
+		Columns:    CertifyLegalsColumns,
+		PrimaryKey: []*schema.Column{CertifyLegalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_legals_package_versions_package",
+				Columns:    []*schema.Column{CertifyLegalsColumns[9]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_legals_source_names_source",
+				Columns:    []*schema.Column{CertifyLegalsColumns[10]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certify_legals_package_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyLegalsColumns[9], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_legals_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyLegalsColumns[10], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// CertifyVexStatementsColumns holds the columns for the "certify_vex_statements" table.
+	CertifyVexStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "vex_justification", Type: field.TypeString},
+		{Name: "statement", Type: field.TypeString, Size: 2147483647},
+		{Name: "statement_hash", Type: field.TypeString},
+		{Name: "known_since", Type: field.TypeTime},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+		{Name: "vulnerability_id", Type: field.TypeInt},
+	}
+	// CertifyVexStatementsTable holds the schema information for the "certify_vex_statements" table.
+	CertifyVexStatementsTable = &schema.Table{
+		Name:       "certify_vex_statements",
+		Columns:    CertifyVexStatementsColumns,
+		PrimaryKey: []*schema.Column{CertifyVexStatementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_vex_statements_package_versions_package",
+				Columns:    []*schema.Column{CertifyVexStatementsColumns[8]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_vex_statements_artifacts_artifact",
+				Columns:    []*schema.Column{CertifyVexStatementsColumns[9]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "certify_vex_statements_vulnerability_ids_vulnerability",
+				Columns:    []*schema.Column{CertifyVexStatementsColumns[10]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certify_vex_statements_package_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyVexStatementsColumns[8], CertifyVexStatementsColumns[10], CertifyVexStatementsColumns[1], CertifyVexStatementsColumns[2], CertifyVexStatementsColumns[4], CertifyVexStatementsColumns[5], CertifyVexStatementsColumns[6], CertifyVexStatementsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "certify_vex_statements_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyVexStatementsColumns[9], CertifyVexStatementsColumns[10], CertifyVexStatementsColumns[1], CertifyVexStatementsColumns[2], CertifyVexStatementsColumns[4], CertifyVexStatementsColumns[5], CertifyVexStatementsColumns[6], CertifyVexStatementsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// CertifyVulnsColumns holds the columns for the "certify_vulns" table.
+	CertifyVulnsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "time_scanned", Type: field.TypeTime},
+		{Name: "db_uri", Type: field.TypeString},
+		{Name: "db_version", Type: field.TypeString},
+		{Name: "scanner_uri", Type: field.TypeString},
+		{Name: "scanner_version", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt},
+		{Name: "vulnerability_id", Type: field.TypeInt},
+	}
+	// CertifyVulnsTable holds the schema information for the "certify_vulns" table.
+	CertifyVulnsTable = &schema.Table{
+		Name:       "certify_vulns",
+		Columns:    CertifyVulnsColumns,
+		PrimaryKey: []*schema.Column{CertifyVulnsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_vulns_package_versions_package",
+				Columns:    []*schema.Column{CertifyVulnsColumns[8]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "certify_vulns_vulnerability_ids_vulnerability",
+				Columns:    []*schema.Column{CertifyVulnsColumns[9]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certify_vulns_unique",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyVulnsColumns[8], CertifyVulnsColumns[9], CertifyVulnsColumns[1], CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7]},
+			},
+		},
+	}
+	// HasMetadataColumns holds the columns for the "has_metadata" table.
+	HasMetadataColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "since", Type: field.TypeTime},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
+		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+	}
+	// HasMetadataTable holds the schema information for the "has_metadata" table.
+	HasMetadataTable = &schema.Table{
+		Name:       "has_metadata",
+		Columns:    HasMetadataColumns,
+		PrimaryKey: []*schema.Column{HasMetadataColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "has_metadata_package_versions_package_version",
+				Columns:    []*schema.Column{HasMetadataColumns[7]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "has_metadata_package_names_package_name",
+				Columns:    []*schema.Column{HasMetadataColumns[8]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "has_metadata_source_names_source",
+				Columns:    []*schema.Column{HasMetadataColumns[9]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "has_metadata_artifacts_artifact",
+				Columns:    []*schema.Column{HasMetadataColumns[10]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "has_metadata_package_version_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasMetadataColumns[7], HasMetadataColumns[1], HasMetadataColumns[2], HasMetadataColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_version_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "has_metadata_package_name_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasMetadataColumns[8], HasMetadataColumns[1], HasMetadataColumns[2], HasMetadataColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_name_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "has_metadata_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasMetadataColumns[9], HasMetadataColumns[1], HasMetadataColumns[2], HasMetadataColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "has_metadata_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasMetadataColumns[10], HasMetadataColumns[1], HasMetadataColumns[2], HasMetadataColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// HasSbomsColumns holds the columns for the "has_sboms" table.
+	HasSbomsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString},
+		{Name: "download_location", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+	}
+	// HasSbomsTable holds the schema information for the "has_sboms" table.
+	HasSbomsTable = &schema.Table{
+		Name:       "has_sboms",
+		Columns:    HasSbomsColumns,
+		PrimaryKey: []*schema.Column{HasSbomsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "has_sboms_package_versions_package",
+				Columns:    []*schema.Column{HasSbomsColumns[7]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "has_sboms_artifacts_artifact",
+				Columns:    []*schema.Column{HasSbomsColumns[8]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "has_sboms_package_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasSbomsColumns[7], HasSbomsColumns[1], HasSbomsColumns[2], HasSbomsColumns[3], HasSbomsColumns[4], HasSbomsColumns[5], HasSbomsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "has_sboms_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasSbomsColumns[8], HasSbomsColumns[1], HasSbomsColumns[2], HasSbomsColumns[3], HasSbomsColumns[4], HasSbomsColumns[5], HasSbomsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// HasSlsasColumns holds the columns for the "has_slsas" table.
+	HasSlsasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "build_type", Type: field.TypeString},
+		{Name: "slsa_predicates", Type: field.TypeJSON},
+		{Name: "slsa_version", Type: field.TypeString},
+		{Name: "started_on", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_on", Type: field.TypeTime, Nullable: true},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "built_from_hash", Type: field.TypeString},
+		{Name: "predicates_hash", Type: field.TypeString},
+		{Name: "subject_id", Type: field.TypeInt},
+		{Name: "built_by_id", Type: field.TypeInt},
+	}
+	// HasSlsasTable holds the schema information for the "has_slsas" table.
+	HasSlsasTable = &schema.Table{
+		Name:       "has_slsas",
+		Columns:    HasSlsasColumns,
+		PrimaryKey: []*schema.Column{HasSlsasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "has_slsas_artifacts_subject",
+				Columns:    []*schema.Column{HasSlsasColumns[10]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "has_slsas_builders_built_by",
+				Columns:    []*schema.Column{HasSlsasColumns[11]},
+				RefColumns: []*schema.Column{BuildersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "has_slsas_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HasSlsasColumns[10], HasSlsasColumns[11], HasSlsasColumns[1], HasSlsasColumns[3], HasSlsasColumns[6], HasSlsasColumns[7], HasSlsasColumns[8], HasSlsasColumns[9]},
+			},
+		},
+	}
+	// HashEqualsColumns holds the columns for the "hash_equals" table.
+	HashEqualsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "artifact_a_id", Type: field.TypeInt},
+		{Name: "artifact_b_id", Type: field.TypeInt},
+	}
+	// HashEqualsTable holds the schema information for the "hash_equals" table.
+	HashEqualsTable = &schema.Table{
+		Name:       "hash_equals",
+		Columns:    HashEqualsColumns,
+		PrimaryKey: []*schema.Column{HashEqualsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hash_equals_artifacts_artifact_a",
+				Columns:    []*schema.Column{HashEqualsColumns[4]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "hash_equals_artifacts_artifact_b",
+				Columns:    []*schema.Column{HashEqualsColumns[5]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hash_equals_unique",
+				Unique:  true,
+				Columns: []*schema.Column{HashEqualsColumns[4], HashEqualsColumns[5], HashEqualsColumns[1], HashEqualsColumns[2], HashEqualsColumns[3]},
+			},
+		},
+	}
+	// IsDependenciesColumns holds the columns for the "is_dependencies" table.
+	IsDependenciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version_range", Type: field.TypeString},
+		{Name: "dependency_type", Type: field.TypeString},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt},
+		{Name: "dependent_package_id", Type: field.TypeInt},
+	}
+	// IsDependenciesTable holds the schema information for the "is_dependencies" table.
+	IsDependenciesTable = &schema.Table{
+		Name:       "is_dependencies",
+		Columns:    IsDependenciesColumns,
+		PrimaryKey: []*schema.Column{IsDependenciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "is_dependencies_package_versions_package",
+				Columns:    []*schema.Column{IsDependenciesColumns[6]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "is_dependencies_package_names_dependent_package",
+				Columns:    []*schema.Column{IsDependenciesColumns[7]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "is_dependencies_unique",
+				Unique:  true,
+				Columns: []*schema.Column{IsDependenciesColumns[6], IsDependenciesColumns[7], IsDependenciesColumns[1], IsDependenciesColumns[2], IsDependenciesColumns[3], IsDependenciesColumns[4], IsDependenciesColumns[5]},
+			},
+		},
+	}
+	// IsOccurrencesColumns holds the columns for the "is_occurrences" table.
+	IsOccurrencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt},
+	}
+	// IsOccurrencesTable holds the schema information for the "is_occurrences" table.
+	IsOccurrencesTable = &schema.Table{
+		Name:       "is_occurrences",
+		Columns:    IsOccurrencesColumns,
+		PrimaryKey: []*schema.Column{IsOccurrencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "is_occurrences_package_versions_package",
+				Columns:    []*schema.Column{IsOccurrencesColumns[4]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "is_occurrences_source_names_source",
+				Columns:    []*schema.Column{IsOccurrencesColumns[5]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "is_occurrences_artifacts_artifact",
+				Columns:    []*schema.Column{IsOccurrencesColumns[6]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "is_occurrences_package_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{IsOccurrencesColumns[4], IsOccurrencesColumns[6], IsOccurrencesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "is_occurrences_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{IsOccurrencesColumns[5], IsOccurrencesColumns[6], IsOccurrencesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// PackageNamesColumns holds the columns for the "package_names" table.
+	PackageNamesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "namespace_id", Type: field.TypeInt},
+	}
+	// PackageNamesTable holds the schema information for the "package_names" table.
+	PackageNamesTable = &schema.Table{
+		Name:       "package_names",
+		Columns:    PackageNamesColumns,
+		PrimaryKey: []*schema.Column{PackageNamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "package_names_package_namespaces_names",
+				Columns:    []*schema.Column{PackageNamesColumns[2]},
+				RefColumns: []*schema.Column{PackageNamespacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "package_names_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PackageNamesColumns[2], PackageNamesColumns[1]},
+			},
+		},
+	}
+	// PackageNamespacesColumns holds the columns for the "package_namespaces" table.
+	PackageNamespacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "package_id", Type: field.TypeInt},
+	}
+	// PackageNamespacesTable holds the schema information for the "package_namespaces" table.
+	PackageNamespacesTable = &schema.Table{
+		Name:       "package_namespaces",
+		Columns:    PackageNamespacesColumns,
+		PrimaryKey: []*schema.Column{PackageNamespacesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "package_namespaces_package_types_namespaces",
+				Columns:    []*schema.Column{PackageNamespacesColumns[2]},
+				RefColumns: []*schema.Column{PackageTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "package_namespaces_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PackageNamespacesColumns[2], PackageNamespacesColumns[1]},
+			},
+		},
+	}
+	// PackageTypesColumns holds the columns for the "package_types" table.
+	PackageTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Unique: true},
+	}
+	// PackageTypesTable holds the schema information for the "package_types" table.
+	PackageTypesTable = &schema.Table{
+		Name:       "package_types",
+		Columns:    PackageTypesColumns,
+		PrimaryKey: []*schema.Column{PackageTypesColumns[0]},
+	}
+	// PackageVersionsColumns holds the columns for the "package_versions" table.
+	PackageVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeString, Default: ""},
+		{Name: "subpath", Type: field.TypeString, Default: ""},
+		{Name: "qualifiers", Type: field.TypeJSON},
+		{Name: "qualifiers_key", Type: field.TypeString, Default: ""},
+		{Name: "name_id", Type: field.TypeInt},
+	}
+	// PackageVersionsTable holds the schema information for the "package_versions" table.
+	PackageVersionsTable = &schema.Table{
+		Name:       "package_versions",
+		Columns:    PackageVersionsColumns,
+		PrimaryKey: []*schema.Column{PackageVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "package_versions_package_names_versions",
+				Columns:    []*schema.Column{PackageVersionsColumns[5]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "package_versions_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PackageVersionsColumns[5], PackageVersionsColumns[1], PackageVersionsColumns[2], PackageVersionsColumns[4]},
+			},
+		},
+	}
+	// PkgEqualsColumns holds the columns for the "pkg_equals" table.
+	PkgEqualsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_a_id", Type: field.TypeInt},
+		{Name: "package_b_id", Type: field.TypeInt},
+	}
+	// PkgEqualsTable holds the schema information for the "pkg_equals" table.
+	PkgEqualsTable = &schema.Table{
+		Name:       "pkg_equals",
+		Columns:    PkgEqualsColumns,
+		PrimaryKey: []*schema.Column{PkgEqualsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pkg_equals_package_versions_package_a",
+				Columns:    []*schema.Column{PkgEqualsColumns[4]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pkg_equals_package_versions_package_b",
+				Columns:    []*schema.Column{PkgEqualsColumns[5]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pkg_equals_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PkgEqualsColumns[4], PkgEqualsColumns[5], PkgEqualsColumns[1], PkgEqualsColumns[2], PkgEqualsColumns[3]},
+			},
+		},
+	}
+	// PointOfContactsColumns holds the columns for the "point_of_contacts" table.
+	PointOfContactsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "info", Type: field.TypeString},
+		{Name: "since", Type: field.TypeTime},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
+		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PointOfContactsTable holds the schema information for the "point_of_contacts" table.
+	PointOfContactsTable = &schema.Table{
+		Name:       "point_of_contacts",
+		Columns:    PointOfContactsColumns,
+		PrimaryKey: []*schema.Column{PointOfContactsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "point_of_contacts_package_versions_package_version",
+				Columns:    []*schema.Column{PointOfContactsColumns[7]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "point_of_contacts_package_names_package_name",
+				Columns:    []*schema.Column{PointOfContactsColumns[8]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "point_of_contacts_source_names_source",
+				Columns:    []*schema.Column{PointOfContactsColumns[9]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "point_of_contacts_artifacts_artifact",
+				Columns:    []*schema.Column{PointOfContactsColumns[10]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "point_of_contacts_package_version_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PointOfContactsColumns[7], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_version_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "point_of_contacts_package_name_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PointOfContactsColumns[8], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "package_name_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "point_of_contacts_source_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PointOfContactsColumns[9], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "source_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "point_of_contacts_artifact_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PointOfContactsColumns[10], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "artifact_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// ScorecardsColumns holds the columns for the "scorecards" table.
+	ScorecardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "checks", Type: field.TypeJSON},
+		{Name: "aggregate_score", Type: field.TypeFloat64},
+		{Name: "time_scanned", Type: field.TypeTime},
+		{Name: "scorecard_version", Type: field.TypeString},
+		{Name: "commit", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeInt},
+	}
+	// ScorecardsTable holds the schema information for the "scorecards" table.
+	ScorecardsTable = &schema.Table{
+		Name:       "scorecards",
+		Columns:    ScorecardsColumns,
+		PrimaryKey: []*schema.Column{ScorecardsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scorecards_source_names_source",
+				Columns:    []*schema.Column{ScorecardsColumns[8]},
+				RefColumns: []*schema.Column{SourceNamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scorecards_unique",
+				Unique:  true,
+				Columns: []*schema.Column{ScorecardsColumns[8], ScorecardsColumns[5], ScorecardsColumns[3]},
+			},
+		},
+	}
+	// SourceNamesColumns holds the columns for the "source_names" table.
+	SourceNamesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "tag", Type: field.TypeString, Default: ""},
+		{Name: "commit", Type: field.TypeString, Default: ""},
+		{Name: "namespace_id", Type: field.TypeInt},
+	}
+	// SourceNamesTable holds the schema information for the "source_names" table.
+	SourceNamesTable = &schema.Table{
+		Name:       "source_names",
+		Columns:    SourceNamesColumns,
+		PrimaryKey: []*schema.Column{SourceNamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "source_names_source_namespaces_names",
+				Columns:    []*schema.Column{SourceNamesColumns[4]},
+				RefColumns: []*schema.Column{SourceNamespacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "source_names_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SourceNamesColumns[4], SourceNamesColumns[1], SourceNamesColumns[2], SourceNamesColumns[3]},
+			},
+		},
+	}
+	// SourceNamespacesColumns holds the columns for the "source_namespaces" table.
+	SourceNamespacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeInt},
+	}
+	// SourceNamespacesTable holds the schema information for the "source_namespaces" table.
+	SourceNamespacesTable = &schema.Table{
+		Name:       "source_namespaces",
+		Columns:    SourceNamespacesColumns,
+		PrimaryKey: []*schema.Column{SourceNamespacesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "source_namespaces_source_types_namespaces",
+				Columns:    []*schema.Column{SourceNamespacesColumns[2]},
+				RefColumns: []*schema.Column{SourceTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "source_namespaces_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SourceNamespacesColumns[2], SourceNamespacesColumns[1]},
+			},
+		},
+	}
+	// SourceTypesColumns holds the columns for the "source_types" table.
+	SourceTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Unique: true},
+	}
+	// SourceTypesTable holds the schema information for the "source_types" table.
+	SourceTypesTable = &schema.Table{
+		Name:       "source_types",
+		Columns:    SourceTypesColumns,
+		PrimaryKey: []*schema.Column{SourceTypesColumns[0]},
+	}
+	// VulnEqualsColumns holds the columns for the "vuln_equals" table.
+	VulnEqualsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "justification", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "vulnerability_a_id", Type: field.TypeInt},
+		{Name: "vulnerability_b_id", Type: field.TypeInt},
+	}
+	// VulnEqualsTable holds the schema information for the "vuln_equals" table.
+	VulnEqualsTable = &schema.Table{
+		Name:       "vuln_equals",
+		Columns:    VulnEqualsColumns,
+		PrimaryKey: []*schema.Column{VulnEqualsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vuln_equals_vulnerability_ids_vulnerability_a",
+				Columns:    []*schema.Column{VulnEqualsColumns[4]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "vuln_equals_vulnerability_ids_vulnerability_b",
+				Columns:    []*schema.Column{VulnEqualsColumns[5]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vuln_equals_unique",
+				Unique:  true,
+				Columns: []*schema.Column{VulnEqualsColumns[4], VulnEqualsColumns[5], VulnEqualsColumns[1], VulnEqualsColumns[2], VulnEqualsColumns[3]},
+			},
+		},
+	}
+	// VulnerabilityIdsColumns holds the columns for the "vulnerability_ids" table.
+	VulnerabilityIdsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vulnerability_id", Type: field.TypeString},
+		{Name: "type_id", Type: field.TypeInt},
+	}
+	// VulnerabilityIdsTable holds the schema information for the "vulnerability_ids" table.
+	VulnerabilityIdsTable = &schema.Table{
+		Name:       "vulnerability_ids",
+		Columns:    VulnerabilityIdsColumns,
+		PrimaryKey: []*schema.Column{VulnerabilityIdsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vulnerability_ids_vulnerability_types_vulnerability_ids",
+				Columns:    []*schema.Column{VulnerabilityIdsColumns[2]},
+				RefColumns: []*schema.Column{VulnerabilityTypesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vulnerability_ids_unique",
+				Unique:  true,
+				Columns: []*schema.Column{VulnerabilityIdsColumns[2], VulnerabilityIdsColumns[1]},
+			},
+		},
+	}
+	// VulnerabilityTypesColumns holds the columns for the "vulnerability_types" table.
+	VulnerabilityTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Unique: true},
+	}
+	// VulnerabilityTypesTable holds the schema information for the "vulnerability_types" table.
+	VulnerabilityTypesTable = &schema.Table{
+		Name:       "vulnerability_types",
+		Columns:    VulnerabilityTypesColumns,
+		PrimaryKey: []*schema.Column{VulnerabilityTypesColumns[0]},
+	}
+	// HasSlsaBuiltFromColumns holds the columns for the "has_slsa_built_from" table.
+	HasSlsaBuiltFromColumns = []*schema.Column{
+		{Name: "has_slsa_id", Type: field.TypeInt},
+		{Name: "artifact_id", Type: field.TypeInt},
+	}
+	// HasSlsaBuiltFromTable holds the schema information for the "has_slsa_built_from" table.
+	HasSlsaBuiltFromTable = &schema.Table{
+		Name:       "has_slsa_built_from",
+		Columns:    HasSlsaBuiltFromColumns,
+		PrimaryKey: []*schema.Column{HasSlsaBuiltFromColumns[0], HasSlsaBuiltFromColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "has_slsa_built_from_has_slsa_id",
+				Columns:    []*schema.Column{HasSlsaBuiltFromColumns[0]},
+				RefColumns: []*schema.Column{HasSlsasColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "has_slsa_built_from_artifact_id",
+				Columns:    []*schema.Column{HasSlsaBuiltFromColumns[1]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		ArtifactsTable,
+		BuildersTable,
+		CertifyBadsTable,
+		CertifyGoodsTable,
+		CertifyLegalsTable,
+		CertifyVexStatementsTable,
+		CertifyVulnsTable,
+		HasMetadataTable,
+		HasSbomsTable,
+		HasSlsasTable,
+		HashEqualsTable,
+		IsDependenciesTable,
+		IsOccurrencesTable,
+		PackageNamesTable,
+		PackageNamespacesTable,
+		PackageTypesTable,
+		PackageVersionsTable,
+		PkgEqualsTable,
+		PointOfContactsTable,
+		ScorecardsTable,
+		SourceNamesTable,
+		SourceNamespacesTable,
+		SourceTypesTable,
+		VulnEqualsTable,
+		VulnerabilityIdsTable,
+		VulnerabilityTypesTable,
+		HasSlsaBuiltFromTable,
+	}
+)
+
+func init() {
+	BuildersTable.Annotation = &entsql.Annotation{
+		Table: "builders",
+	}
+	CertifyBadsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	CertifyBadsTable.ForeignKeys[1].RefTable = PackageNamesTable
+	CertifyBadsTable.ForeignKeys[2].RefTable = SourceNamesTable
+	CertifyBadsTable.ForeignKeys[3].RefTable = ArtifactsTable
+	CertifyGoodsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	CertifyGoodsTable.ForeignKeys[1].RefTable = PackageNamesTable
+	CertifyGoodsTable.ForeignKeys[2].RefTable = SourceNamesTable
+	CertifyGoodsTable.ForeignKeys[3].RefTable = ArtifactsTable
+	CertifyLegalsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	CertifyLegalsTable.ForeignKeys[1].RefTable = SourceNamesTable
+	CertifyLegalsTable.Annotation = &entsql.Annotation{
+		Table: "certify_legals",
+	}
+	CertifyVexStatementsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	CertifyVexStatementsTable.ForeignKeys[1].RefTable = ArtifactsTable
+	CertifyVexStatementsTable.ForeignKeys[2].RefTable = VulnerabilityIdsTable
+	CertifyVexStatementsTable.Annotation = &entsql.Annotation{
+		Table: "certify_vex_statements",
+	}
+	CertifyVulnsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	CertifyVulnsTable.ForeignKeys[1].RefTable = VulnerabilityIdsTable
+	HasMetadataTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	HasMetadataTable.ForeignKeys[1].RefTable = PackageNamesTable
+	HasMetadataTable.ForeignKeys[2].RefTable = SourceNamesTable
+	HasMetadataTable.ForeignKeys[3].RefTable = ArtifactsTable
+	HasMetadataTable.Annotation = &entsql.Annotation{
+		Table: "has_metadata",
+	}
+	HasSbomsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	HasSbomsTable.ForeignKeys[1].RefTable = ArtifactsTable
+	HasSbomsTable.Annotation = &entsql.Annotation{
+		Table: "has_sboms",
+	}
+	HasSlsasTable.ForeignKeys[0].RefTable = ArtifactsTable
+	HasSlsasTable.ForeignKeys[1].RefTable = BuildersTable
+	HasSlsasTable.Annotation = &entsql.Annotation{
+		Table: "has_slsas",
+	}
+	HashEqualsTable.ForeignKeys[0].RefTable = ArtifactsTable
+	HashEqualsTable.ForeignKeys[1].RefTable = ArtifactsTable
+	IsDependenciesTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	IsDependenciesTable.ForeignKeys[1].RefTable = PackageNamesTable
+	IsOccurrencesTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	IsOccurrencesTable.ForeignKeys[1].RefTable = SourceNamesTable
+	IsOccurrencesTable.ForeignKeys[2].RefTable = ArtifactsTable
+	PackageNamesTable.ForeignKeys[0].RefTable = PackageNamespacesTable
+	PackageNamespacesTable.ForeignKeys[0].RefTable = PackageTypesTable
+	PackageVersionsTable.ForeignKeys[0].RefTable = PackageNamesTable
+	PkgEqualsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	PkgEqualsTable.ForeignKeys[1].RefTable = PackageVersionsTable
+	PointOfContactsTable.ForeignKeys[0].RefTable = PackageVersionsTable
+	PointOfContactsTable.ForeignKeys[1].RefTable = PackageNamesTable
+	PointOfContactsTable.ForeignKeys[2].RefTable = SourceNamesTable
+	PointOfContactsTable.ForeignKeys[3].RefTable = ArtifactsTable
+	PointOfContactsTable.Annotation = &entsql.Annotation{
+		Table: "point_of_contacts",
+	}
+	ScorecardsTable.ForeignKeys[0].RefTable = SourceNamesTable
+	SourceNamesTable.ForeignKeys[0].RefTable = SourceNamespacesTable
+	SourceNamespacesTable.ForeignKeys[0].RefTable = SourceTypesTable
+	VulnEqualsTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
+	VulnEqualsTable.ForeignKeys[1].RefTable = VulnerabilityIdsTable
+	VulnerabilityIdsTable.ForeignKeys[0].RefTable = VulnerabilityTypesTable
+	HasSlsaBuiltFromTable.ForeignKeys[0].RefTable = HasSlsasTable
+	HasSlsaBuiltFromTable.ForeignKeys[1].RefTable = ArtifactsTable
+}
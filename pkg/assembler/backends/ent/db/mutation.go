@@ -0,0 +1,18335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isdependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isoccurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/scorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeArtifact            = "Artifact"
+	TypeBuilderNode         = "BuilderNode"
+	TypeCertifyBad          = "CertifyBad"
+	TypeCertifyGood         = "CertifyGood"
+	TypeCertifyLegal        = "CertifyLegal"
+	TypeCertifyVEXStatement = "CertifyVEXStatement"
+	TypeCertifyVuln         = "CertifyVuln"
+	TypeHasMetadata         = "HasMetadata"
+	TypeHasSBOM             = "HasSBOM"
+	TypeHasSLSA             = "HasSLSA"
+	TypeHashEqual           = "HashEqual"
+	TypeIsDependency        = "IsDependency"
+	TypeIsOccurrence        = "IsOccurrence"
+	TypePackageName         = "PackageName"
+	TypePackageNamespace    = "PackageNamespace"
+	TypePackageType         = "PackageType"
+	TypePackageVersion      = "PackageVersion"
+	TypePkgEqual            = "PkgEqual"
+	TypePointOfContact      = "PointOfContact"
+	TypeScorecard           = "Scorecard"
+	TypeSourceName          = "SourceName"
+	TypeSourceNamespace     = "SourceNamespace"
+	TypeSourceType          = "SourceType"
+	TypeVulnEqual           = "VulnEqual"
+	TypeVulnerabilityID     = "VulnerabilityID"
+	TypeVulnerabilityType   = "VulnerabilityType"
+)
+
+// ArtifactMutation represents an operation that mutates the Artifact nodes in the graph.
+type ArtifactMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	algorithm          *string
+	digest             *string
+	clearedFields      map[string]struct{}
+	material_of        map[int]struct{}
+	removedmaterial_of map[int]struct{}
+	clearedmaterial_of bool
+	done               bool
+	oldValue           func(context.Context) (*Artifact, error)
+	predicates         []predicate.Artifact
+}
+
+var _ ent.Mutation = (*ArtifactMutation)(nil)
+
+// artifactOption allows management of the mutation configuration using functional options.
+type artifactOption func(*ArtifactMutation)
+
+// newArtifactMutation creates new mutation for the Artifact entity.
+func newArtifactMutation(c config, op Op, opts ...artifactOption) *ArtifactMutation {
+	m := &ArtifactMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeArtifact,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withArtifactID sets the ID field of the mutation.
+func withArtifactID(id int) artifactOption {
+	return func(m *ArtifactMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Artifact
+		)
+		m.oldValue = func(ctx context.Context) (*Artifact, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Artifact.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withArtifact sets the old Artifact of the mutation.
+func withArtifact(node *Artifact) artifactOption {
+	return func(m *ArtifactMutation) {
+		m.oldValue = func(context.Context) (*Artifact, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ArtifactMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ArtifactMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ArtifactMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ArtifactMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Artifact.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *ArtifactMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *ArtifactMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the Artifact entity.
+// If the Artifact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtifactMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *ArtifactMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *ArtifactMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *ArtifactMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the Artifact entity.
+// If the Artifact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtifactMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *ArtifactMutation) ResetDigest() {
+	m.digest = nil
+}
+
+// AddMaterialOfIDs adds the "material_of" edge to the HasSLSA entity by ids.
+func (m *ArtifactMutation) AddMaterialOfIDs(ids ...int) {
+	if m.material_of == nil {
+		m.material_of = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.material_of[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaterialOf clears the "material_of" edge to the HasSLSA entity.
+func (m *ArtifactMutation) ClearMaterialOf() {
+	m.clearedmaterial_of = true
+}
+
+// MaterialOfCleared reports if the "material_of" edge to the HasSLSA entity was cleared.
+func (m *ArtifactMutation) MaterialOfCleared() bool {
+	return m.clearedmaterial_of
+}
+
+// RemoveMaterialOfIDs removes the "material_of" edge to the HasSLSA entity by IDs.
+func (m *ArtifactMutation) RemoveMaterialOfIDs(ids ...int) {
+	if m.removedmaterial_of == nil {
+		m.removedmaterial_of = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.material_of, ids[i])
+		m.removedmaterial_of[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaterialOf returns the removed IDs of the "material_of" edge to the HasSLSA entity.
+func (m *ArtifactMutation) RemovedMaterialOfIDs() (ids []int) {
+	for id := range m.removedmaterial_of {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaterialOfIDs returns the "material_of" edge IDs in the mutation.
+func (m *ArtifactMutation) MaterialOfIDs() (ids []int) {
+	for id := range m.material_of {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaterialOf resets all changes to the "material_of" edge.
+func (m *ArtifactMutation) ResetMaterialOf() {
+	m.material_of = nil
+	m.clearedmaterial_of = false
+	m.removedmaterial_of = nil
+}
+
+// Where appends a list predicates to the ArtifactMutation builder.
+func (m *ArtifactMutation) Where(ps ...predicate.Artifact) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArtifactMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArtifactMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Artifact, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ArtifactMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ArtifactMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Artifact).
+func (m *ArtifactMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ArtifactMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.algorithm != nil {
+		fields = append(fields, artifact.FieldAlgorithm)
+	}
+	if m.digest != nil {
+		fields = append(fields, artifact.FieldDigest)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ArtifactMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case artifact.FieldAlgorithm:
+		return m.Algorithm()
+	case artifact.FieldDigest:
+		return m.Digest()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ArtifactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case artifact.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case artifact.FieldDigest:
+		return m.OldDigest(ctx)
+	}
+	return nil, fmt.Errorf("unknown Artifact field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArtifactMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case artifact.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case artifact.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Artifact field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ArtifactMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ArtifactMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArtifactMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Artifact numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ArtifactMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ArtifactMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ArtifactMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Artifact nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ArtifactMutation) ResetField(name string) error {
+	switch name {
+	case artifact.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case artifact.FieldDigest:
+		m.ResetDigest()
+		return nil
+	}
+	return fmt.Errorf("unknown Artifact field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ArtifactMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.material_of != nil {
+		edges = append(edges, artifact.EdgeMaterialOf)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ArtifactMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case artifact.EdgeMaterialOf:
+		ids := make([]ent.Value, 0, len(m.material_of))
+		for id := range m.material_of {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ArtifactMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmaterial_of != nil {
+		edges = append(edges, artifact.EdgeMaterialOf)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ArtifactMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case artifact.EdgeMaterialOf:
+		ids := make([]ent.Value, 0, len(m.removedmaterial_of))
+		for id := range m.removedmaterial_of {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ArtifactMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmaterial_of {
+		edges = append(edges, artifact.EdgeMaterialOf)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ArtifactMutation) EdgeCleared(name string) bool {
+	switch name {
+	case artifact.EdgeMaterialOf:
+		return m.clearedmaterial_of
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ArtifactMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Artifact unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ArtifactMutation) ResetEdge(name string) error {
+	switch name {
+	case artifact.EdgeMaterialOf:
+		m.ResetMaterialOf()
+		return nil
+	}
+	return fmt.Errorf("unknown Artifact edge %s", name)
+}
+
+// BuilderNodeMutation represents an operation that mutates the BuilderNode nodes in the graph.
+type BuilderNodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	uri           *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BuilderNode, error)
+	predicates    []predicate.BuilderNode
+}
+
+var _ ent.Mutation = (*BuilderNodeMutation)(nil)
+
+// buildernodeOption allows management of the mutation configuration using functional options.
+type buildernodeOption func(*BuilderNodeMutation)
+
+// newBuilderNodeMutation creates new mutation for the BuilderNode entity.
+func newBuilderNodeMutation(c config, op Op, opts ...buildernodeOption) *BuilderNodeMutation {
+	m := &BuilderNodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBuilderNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBuilderNodeID sets the ID field of the mutation.
+func withBuilderNodeID(id int) buildernodeOption {
+	return func(m *BuilderNodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BuilderNode
+		)
+		m.oldValue = func(ctx context.Context) (*BuilderNode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BuilderNode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBuilderNode sets the old BuilderNode of the mutation.
+func withBuilderNode(node *BuilderNode) buildernodeOption {
+	return func(m *BuilderNodeMutation) {
+		m.oldValue = func(context.Context) (*BuilderNode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BuilderNodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BuilderNodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BuilderNodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BuilderNodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BuilderNode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURI sets the "uri" field.
+func (m *BuilderNodeMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *BuilderNodeMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the BuilderNode entity.
+// If the BuilderNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuilderNodeMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *BuilderNodeMutation) ResetURI() {
+	m.uri = nil
+}
+
+// Where appends a list predicates to the BuilderNodeMutation builder.
+func (m *BuilderNodeMutation) Where(ps ...predicate.BuilderNode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BuilderNodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BuilderNodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BuilderNode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BuilderNodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BuilderNodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BuilderNode).
+func (m *BuilderNodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BuilderNodeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.uri != nil {
+		fields = append(fields, buildernode.FieldURI)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BuilderNodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case buildernode.FieldURI:
+		return m.URI()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BuilderNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case buildernode.FieldURI:
+		return m.OldURI(ctx)
+	}
+	return nil, fmt.Errorf("unknown BuilderNode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuilderNodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case buildernode.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BuilderNode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BuilderNodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BuilderNodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuilderNodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BuilderNode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BuilderNodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BuilderNodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BuilderNodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BuilderNode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BuilderNodeMutation) ResetField(name string) error {
+	switch name {
+	case buildernode.FieldURI:
+		m.ResetURI()
+		return nil
+	}
+	return fmt.Errorf("unknown BuilderNode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BuilderNodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BuilderNodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BuilderNodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BuilderNodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BuilderNodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BuilderNodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BuilderNodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BuilderNode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BuilderNodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BuilderNode edge %s", name)
+}
+
+// CertifyBadMutation represents an operation that mutates the CertifyBad nodes in the graph.
+type CertifyBadMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	justification          *string
+	origin                 *string
+	collector              *string
+	clearedFields          map[string]struct{}
+	package_version        *int
+	clearedpackage_version bool
+	package_name           *int
+	clearedpackage_name    bool
+	source                 *int
+	clearedsource          bool
+	artifact               *int
+	clearedartifact        bool
+	done                   bool
+	oldValue               func(context.Context) (*CertifyBad, error)
+	predicates             []predicate.CertifyBad
+}
+
+var _ ent.Mutation = (*CertifyBadMutation)(nil)
+
+// certifybadOption allows management of the mutation configuration using functional options.
+type certifybadOption func(*CertifyBadMutation)
+
+// newCertifyBadMutation creates new mutation for the CertifyBad entity.
+func newCertifyBadMutation(c config, op Op, opts ...certifybadOption) *CertifyBadMutation {
+	m := &CertifyBadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertifyBad,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertifyBadID sets the ID field of the mutation.
+func withCertifyBadID(id int) certifybadOption {
+	return func(m *CertifyBadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertifyBad
+		)
+		m.oldValue = func(ctx context.Context) (*CertifyBad, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertifyBad.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertifyBad sets the old CertifyBad of the mutation.
+func withCertifyBad(node *CertifyBad) certifybadOption {
+	return func(m *CertifyBadMutation) {
+		m.oldValue = func(context.Context) (*CertifyBad, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertifyBadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertifyBadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertifyBadMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertifyBadMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertifyBad.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (m *CertifyBadMutation) SetPackageVersionID(i int) {
+	m.package_version = &i
+}
+
+// PackageVersionID returns the value of the "package_version_id" field in the mutation.
+func (m *CertifyBadMutation) PackageVersionID() (r int, exists bool) {
+	v := m.package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageVersionID returns the old "package_version_id" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldPackageVersionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageVersionID: %w", err)
+	}
+	return oldValue.PackageVersionID, nil
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (m *CertifyBadMutation) ClearPackageVersionID() {
+	m.package_version = nil
+	m.clearedFields[certifybad.FieldPackageVersionID] = struct{}{}
+}
+
+// PackageVersionIDCleared returns if the "package_version_id" field was cleared in this mutation.
+func (m *CertifyBadMutation) PackageVersionIDCleared() bool {
+	_, ok := m.clearedFields[certifybad.FieldPackageVersionID]
+	return ok
+}
+
+// ResetPackageVersionID resets all changes to the "package_version_id" field.
+func (m *CertifyBadMutation) ResetPackageVersionID() {
+	m.package_version = nil
+	delete(m.clearedFields, certifybad.FieldPackageVersionID)
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (m *CertifyBadMutation) SetPackageNameID(i int) {
+	m.package_name = &i
+}
+
+// PackageNameID returns the value of the "package_name_id" field in the mutation.
+func (m *CertifyBadMutation) PackageNameID() (r int, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageNameID returns the old "package_name_id" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldPackageNameID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageNameID: %w", err)
+	}
+	return oldValue.PackageNameID, nil
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (m *CertifyBadMutation) ClearPackageNameID() {
+	m.package_name = nil
+	m.clearedFields[certifybad.FieldPackageNameID] = struct{}{}
+}
+
+// PackageNameIDCleared returns if the "package_name_id" field was cleared in this mutation.
+func (m *CertifyBadMutation) PackageNameIDCleared() bool {
+	_, ok := m.clearedFields[certifybad.FieldPackageNameID]
+	return ok
+}
+
+// ResetPackageNameID resets all changes to the "package_name_id" field.
+func (m *CertifyBadMutation) ResetPackageNameID() {
+	m.package_name = nil
+	delete(m.clearedFields, certifybad.FieldPackageNameID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *CertifyBadMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *CertifyBadMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *CertifyBadMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[certifybad.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *CertifyBadMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[certifybad.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *CertifyBadMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, certifybad.FieldSourceID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *CertifyBadMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *CertifyBadMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *CertifyBadMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[certifybad.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *CertifyBadMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[certifybad.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *CertifyBadMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, certifybad.FieldArtifactID)
+}
+
+// SetJustification sets the "justification" field.
+func (m *CertifyBadMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *CertifyBadMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *CertifyBadMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *CertifyBadMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *CertifyBadMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *CertifyBadMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *CertifyBadMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *CertifyBadMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the CertifyBad entity.
+// If the CertifyBad object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyBadMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *CertifyBadMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (m *CertifyBadMutation) ClearPackageVersion() {
+	m.clearedpackage_version = true
+}
+
+// PackageVersionCleared reports if the "package_version" edge to the PackageVersion entity was cleared.
+func (m *CertifyBadMutation) PackageVersionCleared() bool {
+	return m.PackageVersionIDCleared() || m.clearedpackage_version
+}
+
+// PackageVersionIDs returns the "package_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageVersionID instead. It exists only for internal usage by the builders.
+func (m *CertifyBadMutation) PackageVersionIDs() (ids []int) {
+	if id := m.package_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageVersion resets all changes to the "package_version" edge.
+func (m *CertifyBadMutation) ResetPackageVersion() {
+	m.package_version = nil
+	m.clearedpackage_version = false
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (m *CertifyBadMutation) ClearPackageName() {
+	m.clearedpackage_name = true
+}
+
+// PackageNameCleared reports if the "package_name" edge to the PackageName entity was cleared.
+func (m *CertifyBadMutation) PackageNameCleared() bool {
+	return m.PackageNameIDCleared() || m.clearedpackage_name
+}
+
+// PackageNameIDs returns the "package_name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageNameID instead. It exists only for internal usage by the builders.
+func (m *CertifyBadMutation) PackageNameIDs() (ids []int) {
+	if id := m.package_name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageName resets all changes to the "package_name" edge.
+func (m *CertifyBadMutation) ResetPackageName() {
+	m.package_name = nil
+	m.clearedpackage_name = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *CertifyBadMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *CertifyBadMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *CertifyBadMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *CertifyBadMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *CertifyBadMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *CertifyBadMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *CertifyBadMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *CertifyBadMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the CertifyBadMutation builder.
+func (m *CertifyBadMutation) Where(ps ...predicate.CertifyBad) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertifyBadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertifyBadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyBad, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertifyBadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertifyBadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertifyBad).
+func (m *CertifyBadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertifyBadMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.package_version != nil {
+		fields = append(fields, certifybad.FieldPackageVersionID)
+	}
+	if m.package_name != nil {
+		fields = append(fields, certifybad.FieldPackageNameID)
+	}
+	if m.source != nil {
+		fields = append(fields, certifybad.FieldSourceID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, certifybad.FieldArtifactID)
+	}
+	if m.justification != nil {
+		fields = append(fields, certifybad.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, certifybad.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, certifybad.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertifyBadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certifybad.FieldPackageVersionID:
+		return m.PackageVersionID()
+	case certifybad.FieldPackageNameID:
+		return m.PackageNameID()
+	case certifybad.FieldSourceID:
+		return m.SourceID()
+	case certifybad.FieldArtifactID:
+		return m.ArtifactID()
+	case certifybad.FieldJustification:
+		return m.Justification()
+	case certifybad.FieldOrigin:
+		return m.Origin()
+	case certifybad.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertifyBadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certifybad.FieldPackageVersionID:
+		return m.OldPackageVersionID(ctx)
+	case certifybad.FieldPackageNameID:
+		return m.OldPackageNameID(ctx)
+	case certifybad.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case certifybad.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case certifybad.FieldJustification:
+		return m.OldJustification(ctx)
+	case certifybad.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case certifybad.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertifyBad field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyBadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certifybad.FieldPackageVersionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageVersionID(v)
+		return nil
+	case certifybad.FieldPackageNameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageNameID(v)
+		return nil
+	case certifybad.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case certifybad.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case certifybad.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case certifybad.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifybad.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyBad field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyBadMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyBadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyBadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CertifyBad numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyBadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certifybad.FieldPackageVersionID) {
+		fields = append(fields, certifybad.FieldPackageVersionID)
+	}
+	if m.FieldCleared(certifybad.FieldPackageNameID) {
+		fields = append(fields, certifybad.FieldPackageNameID)
+	}
+	if m.FieldCleared(certifybad.FieldSourceID) {
+		fields = append(fields, certifybad.FieldSourceID)
+	}
+	if m.FieldCleared(certifybad.FieldArtifactID) {
+		fields = append(fields, certifybad.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyBadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyBadMutation) ClearField(name string) error {
+	switch name {
+	case certifybad.FieldPackageVersionID:
+		m.ClearPackageVersionID()
+		return nil
+	case certifybad.FieldPackageNameID:
+		m.ClearPackageNameID()
+		return nil
+	case certifybad.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case certifybad.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyBad nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyBadMutation) ResetField(name string) error {
+	switch name {
+	case certifybad.FieldPackageVersionID:
+		m.ResetPackageVersionID()
+		return nil
+	case certifybad.FieldPackageNameID:
+		m.ResetPackageNameID()
+		return nil
+	case certifybad.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case certifybad.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case certifybad.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case certifybad.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifybad.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyBad field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyBadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.package_version != nil {
+		edges = append(edges, certifybad.EdgePackageVersion)
+	}
+	if m.package_name != nil {
+		edges = append(edges, certifybad.EdgePackageName)
+	}
+	if m.source != nil {
+		edges = append(edges, certifybad.EdgeSource)
+	}
+	if m.artifact != nil {
+		edges = append(edges, certifybad.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyBadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifybad.EdgePackageVersion:
+		if id := m.package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifybad.EdgePackageName:
+		if id := m.package_name; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifybad.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifybad.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyBadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyBadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyBadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpackage_version {
+		edges = append(edges, certifybad.EdgePackageVersion)
+	}
+	if m.clearedpackage_name {
+		edges = append(edges, certifybad.EdgePackageName)
+	}
+	if m.clearedsource {
+		edges = append(edges, certifybad.EdgeSource)
+	}
+	if m.clearedartifact {
+		edges = append(edges, certifybad.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyBadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifybad.EdgePackageVersion:
+		return m.clearedpackage_version
+	case certifybad.EdgePackageName:
+		return m.clearedpackage_name
+	case certifybad.EdgeSource:
+		return m.clearedsource
+	case certifybad.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyBadMutation) ClearEdge(name string) error {
+	switch name {
+	case certifybad.EdgePackageVersion:
+		m.ClearPackageVersion()
+		return nil
+	case certifybad.EdgePackageName:
+		m.ClearPackageName()
+		return nil
+	case certifybad.EdgeSource:
+		m.ClearSource()
+		return nil
+	case certifybad.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyBad unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyBadMutation) ResetEdge(name string) error {
+	switch name {
+	case certifybad.EdgePackageVersion:
+		m.ResetPackageVersion()
+		return nil
+	case certifybad.EdgePackageName:
+		m.ResetPackageName()
+		return nil
+	case certifybad.EdgeSource:
+		m.ResetSource()
+		return nil
+	case certifybad.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyBad edge %s", name)
+}
+
+// CertifyGoodMutation represents an operation that mutates the CertifyGood nodes in the graph.
+type CertifyGoodMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	justification          *string
+	origin                 *string
+	collector              *string
+	clearedFields          map[string]struct{}
+	package_version        *int
+	clearedpackage_version bool
+	package_name           *int
+	clearedpackage_name    bool
+	source                 *int
+	clearedsource          bool
+	artifact               *int
+	clearedartifact        bool
+	done                   bool
+	oldValue               func(context.Context) (*CertifyGood, error)
+	predicates             []predicate.CertifyGood
+}
+
+var _ ent.Mutation = (*CertifyGoodMutation)(nil)
+
+// certifygoodOption allows management of the mutation configuration using functional options.
+type certifygoodOption func(*CertifyGoodMutation)
+
+// newCertifyGoodMutation creates new mutation for the CertifyGood entity.
+func newCertifyGoodMutation(c config, op Op, opts ...certifygoodOption) *CertifyGoodMutation {
+	m := &CertifyGoodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertifyGood,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertifyGoodID sets the ID field of the mutation.
+func withCertifyGoodID(id int) certifygoodOption {
+	return func(m *CertifyGoodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertifyGood
+		)
+		m.oldValue = func(ctx context.Context) (*CertifyGood, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertifyGood.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertifyGood sets the old CertifyGood of the mutation.
+func withCertifyGood(node *CertifyGood) certifygoodOption {
+	return func(m *CertifyGoodMutation) {
+		m.oldValue = func(context.Context) (*CertifyGood, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertifyGoodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertifyGoodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertifyGoodMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertifyGoodMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertifyGood.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (m *CertifyGoodMutation) SetPackageVersionID(i int) {
+	m.package_version = &i
+}
+
+// PackageVersionID returns the value of the "package_version_id" field in the mutation.
+func (m *CertifyGoodMutation) PackageVersionID() (r int, exists bool) {
+	v := m.package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageVersionID returns the old "package_version_id" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldPackageVersionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageVersionID: %w", err)
+	}
+	return oldValue.PackageVersionID, nil
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (m *CertifyGoodMutation) ClearPackageVersionID() {
+	m.package_version = nil
+	m.clearedFields[certifygood.FieldPackageVersionID] = struct{}{}
+}
+
+// PackageVersionIDCleared returns if the "package_version_id" field was cleared in this mutation.
+func (m *CertifyGoodMutation) PackageVersionIDCleared() bool {
+	_, ok := m.clearedFields[certifygood.FieldPackageVersionID]
+	return ok
+}
+
+// ResetPackageVersionID resets all changes to the "package_version_id" field.
+func (m *CertifyGoodMutation) ResetPackageVersionID() {
+	m.package_version = nil
+	delete(m.clearedFields, certifygood.FieldPackageVersionID)
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (m *CertifyGoodMutation) SetPackageNameID(i int) {
+	m.package_name = &i
+}
+
+// PackageNameID returns the value of the "package_name_id" field in the mutation.
+func (m *CertifyGoodMutation) PackageNameID() (r int, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageNameID returns the old "package_name_id" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldPackageNameID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageNameID: %w", err)
+	}
+	return oldValue.PackageNameID, nil
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (m *CertifyGoodMutation) ClearPackageNameID() {
+	m.package_name = nil
+	m.clearedFields[certifygood.FieldPackageNameID] = struct{}{}
+}
+
+// PackageNameIDCleared returns if the "package_name_id" field was cleared in this mutation.
+func (m *CertifyGoodMutation) PackageNameIDCleared() bool {
+	_, ok := m.clearedFields[certifygood.FieldPackageNameID]
+	return ok
+}
+
+// ResetPackageNameID resets all changes to the "package_name_id" field.
+func (m *CertifyGoodMutation) ResetPackageNameID() {
+	m.package_name = nil
+	delete(m.clearedFields, certifygood.FieldPackageNameID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *CertifyGoodMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *CertifyGoodMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *CertifyGoodMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[certifygood.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *CertifyGoodMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[certifygood.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *CertifyGoodMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, certifygood.FieldSourceID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *CertifyGoodMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *CertifyGoodMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *CertifyGoodMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[certifygood.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *CertifyGoodMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[certifygood.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *CertifyGoodMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, certifygood.FieldArtifactID)
+}
+
+// SetJustification sets the "justification" field.
+func (m *CertifyGoodMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *CertifyGoodMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *CertifyGoodMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *CertifyGoodMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *CertifyGoodMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *CertifyGoodMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *CertifyGoodMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *CertifyGoodMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the CertifyGood entity.
+// If the CertifyGood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyGoodMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *CertifyGoodMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (m *CertifyGoodMutation) ClearPackageVersion() {
+	m.clearedpackage_version = true
+}
+
+// PackageVersionCleared reports if the "package_version" edge to the PackageVersion entity was cleared.
+func (m *CertifyGoodMutation) PackageVersionCleared() bool {
+	return m.PackageVersionIDCleared() || m.clearedpackage_version
+}
+
+// PackageVersionIDs returns the "package_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageVersionID instead. It exists only for internal usage by the builders.
+func (m *CertifyGoodMutation) PackageVersionIDs() (ids []int) {
+	if id := m.package_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageVersion resets all changes to the "package_version" edge.
+func (m *CertifyGoodMutation) ResetPackageVersion() {
+	m.package_version = nil
+	m.clearedpackage_version = false
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (m *CertifyGoodMutation) ClearPackageName() {
+	m.clearedpackage_name = true
+}
+
+// PackageNameCleared reports if the "package_name" edge to the PackageName entity was cleared.
+func (m *CertifyGoodMutation) PackageNameCleared() bool {
+	return m.PackageNameIDCleared() || m.clearedpackage_name
+}
+
+// PackageNameIDs returns the "package_name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageNameID instead. It exists only for internal usage by the builders.
+func (m *CertifyGoodMutation) PackageNameIDs() (ids []int) {
+	if id := m.package_name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageName resets all changes to the "package_name" edge.
+func (m *CertifyGoodMutation) ResetPackageName() {
+	m.package_name = nil
+	m.clearedpackage_name = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *CertifyGoodMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *CertifyGoodMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *CertifyGoodMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *CertifyGoodMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *CertifyGoodMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *CertifyGoodMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *CertifyGoodMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *CertifyGoodMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the CertifyGoodMutation builder.
+func (m *CertifyGoodMutation) Where(ps ...predicate.CertifyGood) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertifyGoodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertifyGoodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyGood, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertifyGoodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertifyGoodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertifyGood).
+func (m *CertifyGoodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertifyGoodMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.package_version != nil {
+		fields = append(fields, certifygood.FieldPackageVersionID)
+	}
+	if m.package_name != nil {
+		fields = append(fields, certifygood.FieldPackageNameID)
+	}
+	if m.source != nil {
+		fields = append(fields, certifygood.FieldSourceID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, certifygood.FieldArtifactID)
+	}
+	if m.justification != nil {
+		fields = append(fields, certifygood.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, certifygood.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, certifygood.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertifyGoodMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certifygood.FieldPackageVersionID:
+		return m.PackageVersionID()
+	case certifygood.FieldPackageNameID:
+		return m.PackageNameID()
+	case certifygood.FieldSourceID:
+		return m.SourceID()
+	case certifygood.FieldArtifactID:
+		return m.ArtifactID()
+	case certifygood.FieldJustification:
+		return m.Justification()
+	case certifygood.FieldOrigin:
+		return m.Origin()
+	case certifygood.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertifyGoodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certifygood.FieldPackageVersionID:
+		return m.OldPackageVersionID(ctx)
+	case certifygood.FieldPackageNameID:
+		return m.OldPackageNameID(ctx)
+	case certifygood.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case certifygood.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case certifygood.FieldJustification:
+		return m.OldJustification(ctx)
+	case certifygood.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case certifygood.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertifyGood field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyGoodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certifygood.FieldPackageVersionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageVersionID(v)
+		return nil
+	case certifygood.FieldPackageNameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageNameID(v)
+		return nil
+	case certifygood.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case certifygood.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case certifygood.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case certifygood.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifygood.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyGood field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyGoodMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyGoodMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyGoodMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CertifyGood numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyGoodMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certifygood.FieldPackageVersionID) {
+		fields = append(fields, certifygood.FieldPackageVersionID)
+	}
+	if m.FieldCleared(certifygood.FieldPackageNameID) {
+		fields = append(fields, certifygood.FieldPackageNameID)
+	}
+	if m.FieldCleared(certifygood.FieldSourceID) {
+		fields = append(fields, certifygood.FieldSourceID)
+	}
+	if m.FieldCleared(certifygood.FieldArtifactID) {
+		fields = append(fields, certifygood.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyGoodMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyGoodMutation) ClearField(name string) error {
+	switch name {
+	case certifygood.FieldPackageVersionID:
+		m.ClearPackageVersionID()
+		return nil
+	case certifygood.FieldPackageNameID:
+		m.ClearPackageNameID()
+		return nil
+	case certifygood.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case certifygood.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyGood nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyGoodMutation) ResetField(name string) error {
+	switch name {
+	case certifygood.FieldPackageVersionID:
+		m.ResetPackageVersionID()
+		return nil
+	case certifygood.FieldPackageNameID:
+		m.ResetPackageNameID()
+		return nil
+	case certifygood.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case certifygood.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case certifygood.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case certifygood.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifygood.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyGood field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyGoodMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.package_version != nil {
+		edges = append(edges, certifygood.EdgePackageVersion)
+	}
+	if m.package_name != nil {
+		edges = append(edges, certifygood.EdgePackageName)
+	}
+	if m.source != nil {
+		edges = append(edges, certifygood.EdgeSource)
+	}
+	if m.artifact != nil {
+		edges = append(edges, certifygood.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyGoodMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifygood.EdgePackageVersion:
+		if id := m.package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifygood.EdgePackageName:
+		if id := m.package_name; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifygood.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifygood.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyGoodMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyGoodMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyGoodMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpackage_version {
+		edges = append(edges, certifygood.EdgePackageVersion)
+	}
+	if m.clearedpackage_name {
+		edges = append(edges, certifygood.EdgePackageName)
+	}
+	if m.clearedsource {
+		edges = append(edges, certifygood.EdgeSource)
+	}
+	if m.clearedartifact {
+		edges = append(edges, certifygood.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyGoodMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifygood.EdgePackageVersion:
+		return m.clearedpackage_version
+	case certifygood.EdgePackageName:
+		return m.clearedpackage_name
+	case certifygood.EdgeSource:
+		return m.clearedsource
+	case certifygood.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyGoodMutation) ClearEdge(name string) error {
+	switch name {
+	case certifygood.EdgePackageVersion:
+		m.ClearPackageVersion()
+		return nil
+	case certifygood.EdgePackageName:
+		m.ClearPackageName()
+		return nil
+	case certifygood.EdgeSource:
+		m.ClearSource()
+		return nil
+	case certifygood.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyGood unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyGoodMutation) ResetEdge(name string) error {
+	switch name {
+	case certifygood.EdgePackageVersion:
+		m.ResetPackageVersion()
+		return nil
+	case certifygood.EdgePackageName:
+		m.ResetPackageName()
+		return nil
+	case certifygood.EdgeSource:
+		m.ResetSource()
+		return nil
+	case certifygood.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyGood edge %s", name)
+}
+
+// CertifyLegalMutation represents an operation that mutates the CertifyLegal nodes in the graph.
+type CertifyLegalMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	declared_license   *string
+	discovered_license *string
+	attribution        *string
+	legal_hash         *string
+	justification      *string
+	time_scanned       *time.Time
+	origin             *string
+	collector          *string
+	clearedFields      map[string]struct{}
+	_package           *int
+	cleared_package    bool
+	source             *int
+	clearedsource      bool
+	done               bool
+	oldValue           func(context.Context) (*CertifyLegal, error)
+	predicates         []predicate.CertifyLegal
+}
+
+var _ ent.Mutation = (*CertifyLegalMutation)(nil)
+
+// certifylegalOption allows management of the mutation configuration using functional options.
+type certifylegalOption func(*CertifyLegalMutation)
+
+// newCertifyLegalMutation creates new mutation for the CertifyLegal entity.
+func newCertifyLegalMutation(c config, op Op, opts ...certifylegalOption) *CertifyLegalMutation {
+	m := &CertifyLegalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertifyLegal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertifyLegalID sets the ID field of the mutation.
+func withCertifyLegalID(id int) certifylegalOption {
+	return func(m *CertifyLegalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertifyLegal
+		)
+		m.oldValue = func(ctx context.Context) (*CertifyLegal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertifyLegal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertifyLegal sets the old CertifyLegal of the mutation.
+func withCertifyLegal(node *CertifyLegal) certifylegalOption {
+	return func(m *CertifyLegalMutation) {
+		m.oldValue = func(context.Context) (*CertifyLegal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertifyLegalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertifyLegalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertifyLegalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertifyLegalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertifyLegal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *CertifyLegalMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *CertifyLegalMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldPackageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (m *CertifyLegalMutation) ClearPackageID() {
+	m._package = nil
+	m.clearedFields[certifylegal.FieldPackageID] = struct{}{}
+}
+
+// PackageIDCleared returns if the "package_id" field was cleared in this mutation.
+func (m *CertifyLegalMutation) PackageIDCleared() bool {
+	_, ok := m.clearedFields[certifylegal.FieldPackageID]
+	return ok
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *CertifyLegalMutation) ResetPackageID() {
+	m._package = nil
+	delete(m.clearedFields, certifylegal.FieldPackageID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *CertifyLegalMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *CertifyLegalMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *CertifyLegalMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[certifylegal.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *CertifyLegalMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[certifylegal.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *CertifyLegalMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, certifylegal.FieldSourceID)
+}
+
+// SetDeclaredLicense sets the "declared_license" field.
+func (m *CertifyLegalMutation) SetDeclaredLicense(s string) {
+	m.declared_license = &s
+}
+
+// DeclaredLicense returns the value of the "declared_license" field in the mutation.
+func (m *CertifyLegalMutation) DeclaredLicense() (r string, exists bool) {
+	v := m.declared_license
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeclaredLicense returns the old "declared_license" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldDeclaredLicense(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeclaredLicense is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeclaredLicense requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeclaredLicense: %w", err)
+	}
+	return oldValue.DeclaredLicense, nil
+}
+
+// ResetDeclaredLicense resets all changes to the "declared_license" field.
+func (m *CertifyLegalMutation) ResetDeclaredLicense() {
+	m.declared_license = nil
+}
+
+// SetDiscoveredLicense sets the "discovered_license" field.
+func (m *CertifyLegalMutation) SetDiscoveredLicense(s string) {
+	m.discovered_license = &s
+}
+
+// DiscoveredLicense returns the value of the "discovered_license" field in the mutation.
+func (m *CertifyLegalMutation) DiscoveredLicense() (r string, exists bool) {
+	v := m.discovered_license
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscoveredLicense returns the old "discovered_license" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldDiscoveredLicense(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscoveredLicense is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscoveredLicense requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscoveredLicense: %w", err)
+	}
+	return oldValue.DiscoveredLicense, nil
+}
+
+// ResetDiscoveredLicense resets all changes to the "discovered_license" field.
+func (m *CertifyLegalMutation) ResetDiscoveredLicense() {
+	m.discovered_license = nil
+}
+
+// SetAttribution sets the "attribution" field.
+func (m *CertifyLegalMutation) SetAttribution(s string) {
+	m.attribution = &s
+}
+
+// Attribution returns the value of the "attribution" field in the mutation.
+func (m *CertifyLegalMutation) Attribution() (r string, exists bool) {
+	v := m.attribution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttribution returns the old "attribution" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldAttribution(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttribution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttribution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttribution: %w", err)
+	}
+	return oldValue.Attribution, nil
+}
+
+// ResetAttribution resets all changes to the "attribution" field.
+func (m *CertifyLegalMutation) ResetAttribution() {
+	m.attribution = nil
+}
+
+// SetLegalHash sets the "legal_hash" field.
+func (m *CertifyLegalMutation) SetLegalHash(s string) {
+	m.legal_hash = &s
+}
+
+// LegalHash returns the value of the "legal_hash" field in the mutation.
+func (m *CertifyLegalMutation) LegalHash() (r string, exists bool) {
+	v := m.legal_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalHash returns the old "legal_hash" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldLegalHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalHash: %w", err)
+	}
+	return oldValue.LegalHash, nil
+}
+
+// ResetLegalHash resets all changes to the "legal_hash" field.
+func (m *CertifyLegalMutation) ResetLegalHash() {
+	m.legal_hash = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *CertifyLegalMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *CertifyLegalMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *CertifyLegalMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (m *CertifyLegalMutation) SetTimeScanned(t time.Time) {
+	m.time_scanned = &t
+}
+
+// TimeScanned returns the value of the "time_scanned" field in the mutation.
+func (m *CertifyLegalMutation) TimeScanned() (r time.Time, exists bool) {
+	v := m.time_scanned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeScanned returns the old "time_scanned" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldTimeScanned(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeScanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeScanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeScanned: %w", err)
+	}
+	return oldValue.TimeScanned, nil
+}
+
+// ResetTimeScanned resets all changes to the "time_scanned" field.
+func (m *CertifyLegalMutation) ResetTimeScanned() {
+	m.time_scanned = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *CertifyLegalMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *CertifyLegalMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *CertifyLegalMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *CertifyLegalMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *CertifyLegalMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *CertifyLegalMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *CertifyLegalMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *CertifyLegalMutation) PackageCleared() bool {
+	return m.PackageIDCleared() || m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *CertifyLegalMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *CertifyLegalMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *CertifyLegalMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *CertifyLegalMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *CertifyLegalMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *CertifyLegalMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// Where appends a list predicates to the CertifyLegalMutation builder.
+func (m *CertifyLegalMutation) Where(ps ...predicate.CertifyLegal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertifyLegalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertifyLegalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyLegal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertifyLegalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertifyLegalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertifyLegal).
+func (m *CertifyLegalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertifyLegalMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m._package != nil {
+		fields = append(fields, certifylegal.FieldPackageID)
+	}
+	if m.source != nil {
+		fields = append(fields, certifylegal.FieldSourceID)
+	}
+	if m.declared_license != nil {
+		fields = append(fields, certifylegal.FieldDeclaredLicense)
+	}
+	if m.discovered_license != nil {
+		fields = append(fields, certifylegal.FieldDiscoveredLicense)
+	}
+	if m.attribution != nil {
+		fields = append(fields, certifylegal.FieldAttribution)
+	}
+	if m.legal_hash != nil {
+		fields = append(fields, certifylegal.FieldLegalHash)
+	}
+	if m.justification != nil {
+		fields = append(fields, certifylegal.FieldJustification)
+	}
+	if m.time_scanned != nil {
+		fields = append(fields, certifylegal.FieldTimeScanned)
+	}
+	if m.origin != nil {
+		fields = append(fields, certifylegal.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, certifylegal.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertifyLegalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certifylegal.FieldPackageID:
+		return m.PackageID()
+	case certifylegal.FieldSourceID:
+		return m.SourceID()
+	case certifylegal.FieldDeclaredLicense:
+		return m.DeclaredLicense()
+	case certifylegal.FieldDiscoveredLicense:
+		return m.DiscoveredLicense()
+	case certifylegal.FieldAttribution:
+		return m.Attribution()
+	case certifylegal.FieldLegalHash:
+		return m.LegalHash()
+	case certifylegal.FieldJustification:
+		return m.Justification()
+	case certifylegal.FieldTimeScanned:
+		return m.TimeScanned()
+	case certifylegal.FieldOrigin:
+		return m.Origin()
+	case certifylegal.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertifyLegalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certifylegal.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case certifylegal.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case certifylegal.FieldDeclaredLicense:
+		return m.OldDeclaredLicense(ctx)
+	case certifylegal.FieldDiscoveredLicense:
+		return m.OldDiscoveredLicense(ctx)
+	case certifylegal.FieldAttribution:
+		return m.OldAttribution(ctx)
+	case certifylegal.FieldLegalHash:
+		return m.OldLegalHash(ctx)
+	case certifylegal.FieldJustification:
+		return m.OldJustification(ctx)
+	case certifylegal.FieldTimeScanned:
+		return m.OldTimeScanned(ctx)
+	case certifylegal.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case certifylegal.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertifyLegal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyLegalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certifylegal.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case certifylegal.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case certifylegal.FieldDeclaredLicense:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeclaredLicense(v)
+		return nil
+	case certifylegal.FieldDiscoveredLicense:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscoveredLicense(v)
+		return nil
+	case certifylegal.FieldAttribution:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttribution(v)
+		return nil
+	case certifylegal.FieldLegalHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalHash(v)
+		return nil
+	case certifylegal.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case certifylegal.FieldTimeScanned:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeScanned(v)
+		return nil
+	case certifylegal.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifylegal.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyLegal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyLegalMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyLegalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyLegalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CertifyLegal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyLegalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certifylegal.FieldPackageID) {
+		fields = append(fields, certifylegal.FieldPackageID)
+	}
+	if m.FieldCleared(certifylegal.FieldSourceID) {
+		fields = append(fields, certifylegal.FieldSourceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyLegalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyLegalMutation) ClearField(name string) error {
+	switch name {
+	case certifylegal.FieldPackageID:
+		m.ClearPackageID()
+		return nil
+	case certifylegal.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyLegal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyLegalMutation) ResetField(name string) error {
+	switch name {
+	case certifylegal.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case certifylegal.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case certifylegal.FieldDeclaredLicense:
+		m.ResetDeclaredLicense()
+		return nil
+	case certifylegal.FieldDiscoveredLicense:
+		m.ResetDiscoveredLicense()
+		return nil
+	case certifylegal.FieldAttribution:
+		m.ResetAttribution()
+		return nil
+	case certifylegal.FieldLegalHash:
+		m.ResetLegalHash()
+		return nil
+	case certifylegal.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case certifylegal.FieldTimeScanned:
+		m.ResetTimeScanned()
+		return nil
+	case certifylegal.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifylegal.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyLegal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyLegalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._package != nil {
+		edges = append(edges, certifylegal.EdgePackage)
+	}
+	if m.source != nil {
+		edges = append(edges, certifylegal.EdgeSource)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyLegalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifylegal.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifylegal.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyLegalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyLegalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyLegalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_package {
+		edges = append(edges, certifylegal.EdgePackage)
+	}
+	if m.clearedsource {
+		edges = append(edges, certifylegal.EdgeSource)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyLegalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifylegal.EdgePackage:
+		return m.cleared_package
+	case certifylegal.EdgeSource:
+		return m.clearedsource
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyLegalMutation) ClearEdge(name string) error {
+	switch name {
+	case certifylegal.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case certifylegal.EdgeSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyLegal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyLegalMutation) ResetEdge(name string) error {
+	switch name {
+	case certifylegal.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case certifylegal.EdgeSource:
+		m.ResetSource()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyLegal edge %s", name)
+}
+
+// CertifyVEXStatementMutation represents an operation that mutates the CertifyVEXStatement nodes in the graph.
+type CertifyVEXStatementMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	status               *model.VexStatus
+	vex_justification    *model.VexJustification
+	statement            *string
+	statement_hash       *string
+	known_since          *time.Time
+	origin               *string
+	collector            *string
+	clearedFields        map[string]struct{}
+	_package             *int
+	cleared_package      bool
+	artifact             *int
+	clearedartifact      bool
+	vulnerability        *int
+	clearedvulnerability bool
+	done                 bool
+	oldValue             func(context.Context) (*CertifyVEXStatement, error)
+	predicates           []predicate.CertifyVEXStatement
+}
+
+var _ ent.Mutation = (*CertifyVEXStatementMutation)(nil)
+
+// certifyvexstatementOption allows management of the mutation configuration using functional options.
+type certifyvexstatementOption func(*CertifyVEXStatementMutation)
+
+// newCertifyVEXStatementMutation creates new mutation for the CertifyVEXStatement entity.
+func newCertifyVEXStatementMutation(c config, op Op, opts ...certifyvexstatementOption) *CertifyVEXStatementMutation {
+	m := &CertifyVEXStatementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertifyVEXStatement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertifyVEXStatementID sets the ID field of the mutation.
+func withCertifyVEXStatementID(id int) certifyvexstatementOption {
+	return func(m *CertifyVEXStatementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertifyVEXStatement
+		)
+		m.oldValue = func(ctx context.Context) (*CertifyVEXStatement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertifyVEXStatement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertifyVEXStatement sets the old CertifyVEXStatement of the mutation.
+func withCertifyVEXStatement(node *CertifyVEXStatement) certifyvexstatementOption {
+	return func(m *CertifyVEXStatementMutation) {
+		m.oldValue = func(context.Context) (*CertifyVEXStatement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertifyVEXStatementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertifyVEXStatementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertifyVEXStatementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertifyVEXStatementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertifyVEXStatement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *CertifyVEXStatementMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *CertifyVEXStatementMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldPackageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (m *CertifyVEXStatementMutation) ClearPackageID() {
+	m._package = nil
+	m.clearedFields[certifyvexstatement.FieldPackageID] = struct{}{}
+}
+
+// PackageIDCleared returns if the "package_id" field was cleared in this mutation.
+func (m *CertifyVEXStatementMutation) PackageIDCleared() bool {
+	_, ok := m.clearedFields[certifyvexstatement.FieldPackageID]
+	return ok
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *CertifyVEXStatementMutation) ResetPackageID() {
+	m._package = nil
+	delete(m.clearedFields, certifyvexstatement.FieldPackageID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *CertifyVEXStatementMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *CertifyVEXStatementMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *CertifyVEXStatementMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[certifyvexstatement.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *CertifyVEXStatementMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[certifyvexstatement.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *CertifyVEXStatementMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, certifyvexstatement.FieldArtifactID)
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (m *CertifyVEXStatementMutation) SetVulnerabilityID(i int) {
+	m.vulnerability = &i
+}
+
+// VulnerabilityID returns the value of the "vulnerability_id" field in the mutation.
+func (m *CertifyVEXStatementMutation) VulnerabilityID() (r int, exists bool) {
+	v := m.vulnerability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityID returns the old "vulnerability_id" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldVulnerabilityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityID: %w", err)
+	}
+	return oldValue.VulnerabilityID, nil
+}
+
+// ResetVulnerabilityID resets all changes to the "vulnerability_id" field.
+func (m *CertifyVEXStatementMutation) ResetVulnerabilityID() {
+	m.vulnerability = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *CertifyVEXStatementMutation) SetStatus(ms model.VexStatus) {
+	m.status = &ms
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CertifyVEXStatementMutation) Status() (r model.VexStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldStatus(ctx context.Context) (v model.VexStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CertifyVEXStatementMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetVexJustification sets the "vex_justification" field.
+func (m *CertifyVEXStatementMutation) SetVexJustification(mj model.VexJustification) {
+	m.vex_justification = &mj
+}
+
+// VexJustification returns the value of the "vex_justification" field in the mutation.
+func (m *CertifyVEXStatementMutation) VexJustification() (r model.VexJustification, exists bool) {
+	v := m.vex_justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVexJustification returns the old "vex_justification" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldVexJustification(ctx context.Context) (v model.VexJustification, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVexJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVexJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVexJustification: %w", err)
+	}
+	return oldValue.VexJustification, nil
+}
+
+// ResetVexJustification resets all changes to the "vex_justification" field.
+func (m *CertifyVEXStatementMutation) ResetVexJustification() {
+	m.vex_justification = nil
+}
+
+// SetStatement sets the "statement" field.
+func (m *CertifyVEXStatementMutation) SetStatement(s string) {
+	m.statement = &s
+}
+
+// Statement returns the value of the "statement" field in the mutation.
+func (m *CertifyVEXStatementMutation) Statement() (r string, exists bool) {
+	v := m.statement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatement returns the old "statement" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldStatement(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatement: %w", err)
+	}
+	return oldValue.Statement, nil
+}
+
+// ResetStatement resets all changes to the "statement" field.
+func (m *CertifyVEXStatementMutation) ResetStatement() {
+	m.statement = nil
+}
+
+// SetStatementHash sets the "statement_hash" field.
+func (m *CertifyVEXStatementMutation) SetStatementHash(s string) {
+	m.statement_hash = &s
+}
+
+// StatementHash returns the value of the "statement_hash" field in the mutation.
+func (m *CertifyVEXStatementMutation) StatementHash() (r string, exists bool) {
+	v := m.statement_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatementHash returns the old "statement_hash" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldStatementHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatementHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatementHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatementHash: %w", err)
+	}
+	return oldValue.StatementHash, nil
+}
+
+// ResetStatementHash resets all changes to the "statement_hash" field.
+func (m *CertifyVEXStatementMutation) ResetStatementHash() {
+	m.statement_hash = nil
+}
+
+// SetKnownSince sets the "known_since" field.
+func (m *CertifyVEXStatementMutation) SetKnownSince(t time.Time) {
+	m.known_since = &t
+}
+
+// KnownSince returns the value of the "known_since" field in the mutation.
+func (m *CertifyVEXStatementMutation) KnownSince() (r time.Time, exists bool) {
+	v := m.known_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKnownSince returns the old "known_since" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldKnownSince(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnownSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnownSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnownSince: %w", err)
+	}
+	return oldValue.KnownSince, nil
+}
+
+// ResetKnownSince resets all changes to the "known_since" field.
+func (m *CertifyVEXStatementMutation) ResetKnownSince() {
+	m.known_since = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *CertifyVEXStatementMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *CertifyVEXStatementMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *CertifyVEXStatementMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *CertifyVEXStatementMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *CertifyVEXStatementMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the CertifyVEXStatement entity.
+// If the CertifyVEXStatement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVEXStatementMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *CertifyVEXStatementMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *CertifyVEXStatementMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *CertifyVEXStatementMutation) PackageCleared() bool {
+	return m.PackageIDCleared() || m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *CertifyVEXStatementMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *CertifyVEXStatementMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *CertifyVEXStatementMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *CertifyVEXStatementMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *CertifyVEXStatementMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *CertifyVEXStatementMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (m *CertifyVEXStatementMutation) ClearVulnerability() {
+	m.clearedvulnerability = true
+}
+
+// VulnerabilityCleared reports if the "vulnerability" edge to the VulnerabilityID entity was cleared.
+func (m *CertifyVEXStatementMutation) VulnerabilityCleared() bool {
+	return m.clearedvulnerability
+}
+
+// VulnerabilityIDs returns the "vulnerability" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityID instead. It exists only for internal usage by the builders.
+func (m *CertifyVEXStatementMutation) VulnerabilityIDs() (ids []int) {
+	if id := m.vulnerability; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVulnerability resets all changes to the "vulnerability" edge.
+func (m *CertifyVEXStatementMutation) ResetVulnerability() {
+	m.vulnerability = nil
+	m.clearedvulnerability = false
+}
+
+// Where appends a list predicates to the CertifyVEXStatementMutation builder.
+func (m *CertifyVEXStatementMutation) Where(ps ...predicate.CertifyVEXStatement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertifyVEXStatementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertifyVEXStatementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyVEXStatement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertifyVEXStatementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertifyVEXStatementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertifyVEXStatement).
+func (m *CertifyVEXStatementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertifyVEXStatementMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m._package != nil {
+		fields = append(fields, certifyvexstatement.FieldPackageID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, certifyvexstatement.FieldArtifactID)
+	}
+	if m.vulnerability != nil {
+		fields = append(fields, certifyvexstatement.FieldVulnerabilityID)
+	}
+	if m.status != nil {
+		fields = append(fields, certifyvexstatement.FieldStatus)
+	}
+	if m.vex_justification != nil {
+		fields = append(fields, certifyvexstatement.FieldVexJustification)
+	}
+	if m.statement != nil {
+		fields = append(fields, certifyvexstatement.FieldStatement)
+	}
+	if m.statement_hash != nil {
+		fields = append(fields, certifyvexstatement.FieldStatementHash)
+	}
+	if m.known_since != nil {
+		fields = append(fields, certifyvexstatement.FieldKnownSince)
+	}
+	if m.origin != nil {
+		fields = append(fields, certifyvexstatement.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, certifyvexstatement.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertifyVEXStatementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certifyvexstatement.FieldPackageID:
+		return m.PackageID()
+	case certifyvexstatement.FieldArtifactID:
+		return m.ArtifactID()
+	case certifyvexstatement.FieldVulnerabilityID:
+		return m.VulnerabilityID()
+	case certifyvexstatement.FieldStatus:
+		return m.Status()
+	case certifyvexstatement.FieldVexJustification:
+		return m.VexJustification()
+	case certifyvexstatement.FieldStatement:
+		return m.Statement()
+	case certifyvexstatement.FieldStatementHash:
+		return m.StatementHash()
+	case certifyvexstatement.FieldKnownSince:
+		return m.KnownSince()
+	case certifyvexstatement.FieldOrigin:
+		return m.Origin()
+	case certifyvexstatement.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertifyVEXStatementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certifyvexstatement.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case certifyvexstatement.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case certifyvexstatement.FieldVulnerabilityID:
+		return m.OldVulnerabilityID(ctx)
+	case certifyvexstatement.FieldStatus:
+		return m.OldStatus(ctx)
+	case certifyvexstatement.FieldVexJustification:
+		return m.OldVexJustification(ctx)
+	case certifyvexstatement.FieldStatement:
+		return m.OldStatement(ctx)
+	case certifyvexstatement.FieldStatementHash:
+		return m.OldStatementHash(ctx)
+	case certifyvexstatement.FieldKnownSince:
+		return m.OldKnownSince(ctx)
+	case certifyvexstatement.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case certifyvexstatement.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertifyVEXStatement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyVEXStatementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certifyvexstatement.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case certifyvexstatement.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case certifyvexstatement.FieldVulnerabilityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityID(v)
+		return nil
+	case certifyvexstatement.FieldStatus:
+		v, ok := value.(model.VexStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case certifyvexstatement.FieldVexJustification:
+		v, ok := value.(model.VexJustification)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVexJustification(v)
+		return nil
+	case certifyvexstatement.FieldStatement:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatement(v)
+		return nil
+	case certifyvexstatement.FieldStatementHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatementHash(v)
+		return nil
+	case certifyvexstatement.FieldKnownSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKnownSince(v)
+		return nil
+	case certifyvexstatement.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifyvexstatement.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyVEXStatementMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyVEXStatementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyVEXStatementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyVEXStatementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certifyvexstatement.FieldPackageID) {
+		fields = append(fields, certifyvexstatement.FieldPackageID)
+	}
+	if m.FieldCleared(certifyvexstatement.FieldArtifactID) {
+		fields = append(fields, certifyvexstatement.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyVEXStatementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyVEXStatementMutation) ClearField(name string) error {
+	switch name {
+	case certifyvexstatement.FieldPackageID:
+		m.ClearPackageID()
+		return nil
+	case certifyvexstatement.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyVEXStatementMutation) ResetField(name string) error {
+	switch name {
+	case certifyvexstatement.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case certifyvexstatement.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case certifyvexstatement.FieldVulnerabilityID:
+		m.ResetVulnerabilityID()
+		return nil
+	case certifyvexstatement.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case certifyvexstatement.FieldVexJustification:
+		m.ResetVexJustification()
+		return nil
+	case certifyvexstatement.FieldStatement:
+		m.ResetStatement()
+		return nil
+	case certifyvexstatement.FieldStatementHash:
+		m.ResetStatementHash()
+		return nil
+	case certifyvexstatement.FieldKnownSince:
+		m.ResetKnownSince()
+		return nil
+	case certifyvexstatement.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifyvexstatement.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyVEXStatementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m._package != nil {
+		edges = append(edges, certifyvexstatement.EdgePackage)
+	}
+	if m.artifact != nil {
+		edges = append(edges, certifyvexstatement.EdgeArtifact)
+	}
+	if m.vulnerability != nil {
+		edges = append(edges, certifyvexstatement.EdgeVulnerability)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyVEXStatementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifyvexstatement.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifyvexstatement.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifyvexstatement.EdgeVulnerability:
+		if id := m.vulnerability; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyVEXStatementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyVEXStatementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyVEXStatementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleared_package {
+		edges = append(edges, certifyvexstatement.EdgePackage)
+	}
+	if m.clearedartifact {
+		edges = append(edges, certifyvexstatement.EdgeArtifact)
+	}
+	if m.clearedvulnerability {
+		edges = append(edges, certifyvexstatement.EdgeVulnerability)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyVEXStatementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifyvexstatement.EdgePackage:
+		return m.cleared_package
+	case certifyvexstatement.EdgeArtifact:
+		return m.clearedartifact
+	case certifyvexstatement.EdgeVulnerability:
+		return m.clearedvulnerability
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyVEXStatementMutation) ClearEdge(name string) error {
+	switch name {
+	case certifyvexstatement.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case certifyvexstatement.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	case certifyvexstatement.EdgeVulnerability:
+		m.ClearVulnerability()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyVEXStatementMutation) ResetEdge(name string) error {
+	switch name {
+	case certifyvexstatement.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case certifyvexstatement.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	case certifyvexstatement.EdgeVulnerability:
+		m.ResetVulnerability()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVEXStatement edge %s", name)
+}
+
+// CertifyVulnMutation represents an operation that mutates the CertifyVuln nodes in the graph.
+type CertifyVulnMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	time_scanned         *time.Time
+	db_uri               *string
+	db_version           *string
+	scanner_uri          *string
+	scanner_version      *string
+	origin               *string
+	collector            *string
+	clearedFields        map[string]struct{}
+	_package             *int
+	cleared_package      bool
+	vulnerability        *int
+	clearedvulnerability bool
+	done                 bool
+	oldValue             func(context.Context) (*CertifyVuln, error)
+	predicates           []predicate.CertifyVuln
+}
+
+var _ ent.Mutation = (*CertifyVulnMutation)(nil)
+
+// certifyvulnOption allows management of the mutation configuration using functional options.
+type certifyvulnOption func(*CertifyVulnMutation)
+
+// newCertifyVulnMutation creates new mutation for the CertifyVuln entity.
+func newCertifyVulnMutation(c config, op Op, opts ...certifyvulnOption) *CertifyVulnMutation {
+	m := &CertifyVulnMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertifyVuln,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCertifyVulnID sets the ID field of the mutation.
+func withCertifyVulnID(id int) certifyvulnOption {
+	return func(m *CertifyVulnMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CertifyVuln
+		)
+		m.oldValue = func(ctx context.Context) (*CertifyVuln, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CertifyVuln.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCertifyVuln sets the old CertifyVuln of the mutation.
+func withCertifyVuln(node *CertifyVuln) certifyvulnOption {
+	return func(m *CertifyVulnMutation) {
+		m.oldValue = func(context.Context) (*CertifyVuln, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertifyVulnMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertifyVulnMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertifyVulnMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertifyVulnMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CertifyVuln.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *CertifyVulnMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *CertifyVulnMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldPackageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *CertifyVulnMutation) ResetPackageID() {
+	m._package = nil
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (m *CertifyVulnMutation) SetVulnerabilityID(i int) {
+	m.vulnerability = &i
+}
+
+// VulnerabilityID returns the value of the "vulnerability_id" field in the mutation.
+func (m *CertifyVulnMutation) VulnerabilityID() (r int, exists bool) {
+	v := m.vulnerability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityID returns the old "vulnerability_id" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldVulnerabilityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityID: %w", err)
+	}
+	return oldValue.VulnerabilityID, nil
+}
+
+// ResetVulnerabilityID resets all changes to the "vulnerability_id" field.
+func (m *CertifyVulnMutation) ResetVulnerabilityID() {
+	m.vulnerability = nil
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (m *CertifyVulnMutation) SetTimeScanned(t time.Time) {
+	m.time_scanned = &t
+}
+
+// TimeScanned returns the value of the "time_scanned" field in the mutation.
+func (m *CertifyVulnMutation) TimeScanned() (r time.Time, exists bool) {
+	v := m.time_scanned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeScanned returns the old "time_scanned" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldTimeScanned(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeScanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeScanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeScanned: %w", err)
+	}
+	return oldValue.TimeScanned, nil
+}
+
+// ResetTimeScanned resets all changes to the "time_scanned" field.
+func (m *CertifyVulnMutation) ResetTimeScanned() {
+	m.time_scanned = nil
+}
+
+// SetDbURI sets the "db_uri" field.
+func (m *CertifyVulnMutation) SetDbURI(s string) {
+	m.db_uri = &s
+}
+
+// DbURI returns the value of the "db_uri" field in the mutation.
+func (m *CertifyVulnMutation) DbURI() (r string, exists bool) {
+	v := m.db_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDbURI returns the old "db_uri" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldDbURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDbURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDbURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDbURI: %w", err)
+	}
+	return oldValue.DbURI, nil
+}
+
+// ResetDbURI resets all changes to the "db_uri" field.
+func (m *CertifyVulnMutation) ResetDbURI() {
+	m.db_uri = nil
+}
+
+// SetDbVersion sets the "db_version" field.
+func (m *CertifyVulnMutation) SetDbVersion(s string) {
+	m.db_version = &s
+}
+
+// DbVersion returns the value of the "db_version" field in the mutation.
+func (m *CertifyVulnMutation) DbVersion() (r string, exists bool) {
+	v := m.db_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDbVersion returns the old "db_version" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldDbVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDbVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDbVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDbVersion: %w", err)
+	}
+	return oldValue.DbVersion, nil
+}
+
+// ResetDbVersion resets all changes to the "db_version" field.
+func (m *CertifyVulnMutation) ResetDbVersion() {
+	m.db_version = nil
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (m *CertifyVulnMutation) SetScannerURI(s string) {
+	m.scanner_uri = &s
+}
+
+// ScannerURI returns the value of the "scanner_uri" field in the mutation.
+func (m *CertifyVulnMutation) ScannerURI() (r string, exists bool) {
+	v := m.scanner_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScannerURI returns the old "scanner_uri" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldScannerURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannerURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannerURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannerURI: %w", err)
+	}
+	return oldValue.ScannerURI, nil
+}
+
+// ResetScannerURI resets all changes to the "scanner_uri" field.
+func (m *CertifyVulnMutation) ResetScannerURI() {
+	m.scanner_uri = nil
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (m *CertifyVulnMutation) SetScannerVersion(s string) {
+	m.scanner_version = &s
+}
+
+// ScannerVersion returns the value of the "scanner_version" field in the mutation.
+func (m *CertifyVulnMutation) ScannerVersion() (r string, exists bool) {
+	v := m.scanner_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScannerVersion returns the old "scanner_version" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldScannerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannerVersion: %w", err)
+	}
+	return oldValue.ScannerVersion, nil
+}
+
+// ResetScannerVersion resets all changes to the "scanner_version" field.
+func (m *CertifyVulnMutation) ResetScannerVersion() {
+	m.scanner_version = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *CertifyVulnMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *CertifyVulnMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *CertifyVulnMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *CertifyVulnMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *CertifyVulnMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *CertifyVulnMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *CertifyVulnMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *CertifyVulnMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *CertifyVulnMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *CertifyVulnMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (m *CertifyVulnMutation) ClearVulnerability() {
+	m.clearedvulnerability = true
+}
+
+// VulnerabilityCleared reports if the "vulnerability" edge to the VulnerabilityID entity was cleared.
+func (m *CertifyVulnMutation) VulnerabilityCleared() bool {
+	return m.clearedvulnerability
+}
+
+// VulnerabilityIDs returns the "vulnerability" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityID instead. It exists only for internal usage by the builders.
+func (m *CertifyVulnMutation) VulnerabilityIDs() (ids []int) {
+	if id := m.vulnerability; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVulnerability resets all changes to the "vulnerability" edge.
+func (m *CertifyVulnMutation) ResetVulnerability() {
+	m.vulnerability = nil
+	m.clearedvulnerability = false
+}
+
+// Where appends a list predicates to the CertifyVulnMutation builder.
+func (m *CertifyVulnMutation) Where(ps ...predicate.CertifyVuln) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertifyVulnMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertifyVulnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyVuln, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertifyVulnMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertifyVulnMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CertifyVuln).
+func (m *CertifyVulnMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertifyVulnMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._package != nil {
+		fields = append(fields, certifyvuln.FieldPackageID)
+	}
+	if m.vulnerability != nil {
+		fields = append(fields, certifyvuln.FieldVulnerabilityID)
+	}
+	if m.time_scanned != nil {
+		fields = append(fields, certifyvuln.FieldTimeScanned)
+	}
+	if m.db_uri != nil {
+		fields = append(fields, certifyvuln.FieldDbURI)
+	}
+	if m.db_version != nil {
+		fields = append(fields, certifyvuln.FieldDbVersion)
+	}
+	if m.scanner_uri != nil {
+		fields = append(fields, certifyvuln.FieldScannerURI)
+	}
+	if m.scanner_version != nil {
+		fields = append(fields, certifyvuln.FieldScannerVersion)
+	}
+	if m.origin != nil {
+		fields = append(fields, certifyvuln.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, certifyvuln.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertifyVulnMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certifyvuln.FieldPackageID:
+		return m.PackageID()
+	case certifyvuln.FieldVulnerabilityID:
+		return m.VulnerabilityID()
+	case certifyvuln.FieldTimeScanned:
+		return m.TimeScanned()
+	case certifyvuln.FieldDbURI:
+		return m.DbURI()
+	case certifyvuln.FieldDbVersion:
+		return m.DbVersion()
+	case certifyvuln.FieldScannerURI:
+		return m.ScannerURI()
+	case certifyvuln.FieldScannerVersion:
+		return m.ScannerVersion()
+	case certifyvuln.FieldOrigin:
+		return m.Origin()
+	case certifyvuln.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertifyVulnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certifyvuln.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case certifyvuln.FieldVulnerabilityID:
+		return m.OldVulnerabilityID(ctx)
+	case certifyvuln.FieldTimeScanned:
+		return m.OldTimeScanned(ctx)
+	case certifyvuln.FieldDbURI:
+		return m.OldDbURI(ctx)
+	case certifyvuln.FieldDbVersion:
+		return m.OldDbVersion(ctx)
+	case certifyvuln.FieldScannerURI:
+		return m.OldScannerURI(ctx)
+	case certifyvuln.FieldScannerVersion:
+		return m.OldScannerVersion(ctx)
+	case certifyvuln.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case certifyvuln.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown CertifyVuln field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyVulnMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certifyvuln.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case certifyvuln.FieldVulnerabilityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityID(v)
+		return nil
+	case certifyvuln.FieldTimeScanned:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeScanned(v)
+		return nil
+	case certifyvuln.FieldDbURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDbURI(v)
+		return nil
+	case certifyvuln.FieldDbVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDbVersion(v)
+		return nil
+	case certifyvuln.FieldScannerURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannerURI(v)
+		return nil
+	case certifyvuln.FieldScannerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannerVersion(v)
+		return nil
+	case certifyvuln.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifyvuln.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVuln field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyVulnMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyVulnMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyVulnMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CertifyVuln numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyVulnMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyVulnMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyVulnMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CertifyVuln nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyVulnMutation) ResetField(name string) error {
+	switch name {
+	case certifyvuln.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case certifyvuln.FieldVulnerabilityID:
+		m.ResetVulnerabilityID()
+		return nil
+	case certifyvuln.FieldTimeScanned:
+		m.ResetTimeScanned()
+		return nil
+	case certifyvuln.FieldDbURI:
+		m.ResetDbURI()
+		return nil
+	case certifyvuln.FieldDbVersion:
+		m.ResetDbVersion()
+		return nil
+	case certifyvuln.FieldScannerURI:
+		m.ResetScannerURI()
+		return nil
+	case certifyvuln.FieldScannerVersion:
+		m.ResetScannerVersion()
+		return nil
+	case certifyvuln.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifyvuln.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVuln field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyVulnMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._package != nil {
+		edges = append(edges, certifyvuln.EdgePackage)
+	}
+	if m.vulnerability != nil {
+		edges = append(edges, certifyvuln.EdgeVulnerability)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyVulnMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifyvuln.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifyvuln.EdgeVulnerability:
+		if id := m.vulnerability; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyVulnMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyVulnMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyVulnMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_package {
+		edges = append(edges, certifyvuln.EdgePackage)
+	}
+	if m.clearedvulnerability {
+		edges = append(edges, certifyvuln.EdgeVulnerability)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyVulnMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifyvuln.EdgePackage:
+		return m.cleared_package
+	case certifyvuln.EdgeVulnerability:
+		return m.clearedvulnerability
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyVulnMutation) ClearEdge(name string) error {
+	switch name {
+	case certifyvuln.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case certifyvuln.EdgeVulnerability:
+		m.ClearVulnerability()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVuln unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyVulnMutation) ResetEdge(name string) error {
+	switch name {
+	case certifyvuln.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case certifyvuln.EdgeVulnerability:
+		m.ResetVulnerability()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVuln edge %s", name)
+}
+
+// HasMetadataMutation represents an operation that mutates the HasMetadata nodes in the graph.
+type HasMetadataMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	key                    *string
+	value                  *string
+	since                  *time.Time
+	justification          *string
+	origin                 *string
+	collector              *string
+	clearedFields          map[string]struct{}
+	package_version        *int
+	clearedpackage_version bool
+	package_name           *int
+	clearedpackage_name    bool
+	source                 *int
+	clearedsource          bool
+	artifact               *int
+	clearedartifact        bool
+	done                   bool
+	oldValue               func(context.Context) (*HasMetadata, error)
+	predicates             []predicate.HasMetadata
+}
+
+var _ ent.Mutation = (*HasMetadataMutation)(nil)
+
+// hasmetadataOption allows management of the mutation configuration using functional options.
+type hasmetadataOption func(*HasMetadataMutation)
+
+// newHasMetadataMutation creates new mutation for the HasMetadata entity.
+func newHasMetadataMutation(c config, op Op, opts ...hasmetadataOption) *HasMetadataMutation {
+	m := &HasMetadataMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHasMetadata,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHasMetadataID sets the ID field of the mutation.
+func withHasMetadataID(id int) hasmetadataOption {
+	return func(m *HasMetadataMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HasMetadata
+		)
+		m.oldValue = func(ctx context.Context) (*HasMetadata, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HasMetadata.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHasMetadata sets the old HasMetadata of the mutation.
+func withHasMetadata(node *HasMetadata) hasmetadataOption {
+	return func(m *HasMetadataMutation) {
+		m.oldValue = func(context.Context) (*HasMetadata, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HasMetadataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HasMetadataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HasMetadataMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HasMetadataMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HasMetadata.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (m *HasMetadataMutation) SetPackageVersionID(i int) {
+	m.package_version = &i
+}
+
+// PackageVersionID returns the value of the "package_version_id" field in the mutation.
+func (m *HasMetadataMutation) PackageVersionID() (r int, exists bool) {
+	v := m.package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageVersionID returns the old "package_version_id" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldPackageVersionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageVersionID: %w", err)
+	}
+	return oldValue.PackageVersionID, nil
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (m *HasMetadataMutation) ClearPackageVersionID() {
+	m.package_version = nil
+	m.clearedFields[hasmetadata.FieldPackageVersionID] = struct{}{}
+}
+
+// PackageVersionIDCleared returns if the "package_version_id" field was cleared in this mutation.
+func (m *HasMetadataMutation) PackageVersionIDCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldPackageVersionID]
+	return ok
+}
+
+// ResetPackageVersionID resets all changes to the "package_version_id" field.
+func (m *HasMetadataMutation) ResetPackageVersionID() {
+	m.package_version = nil
+	delete(m.clearedFields, hasmetadata.FieldPackageVersionID)
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (m *HasMetadataMutation) SetPackageNameID(i int) {
+	m.package_name = &i
+}
+
+// PackageNameID returns the value of the "package_name_id" field in the mutation.
+func (m *HasMetadataMutation) PackageNameID() (r int, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageNameID returns the old "package_name_id" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldPackageNameID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageNameID: %w", err)
+	}
+	return oldValue.PackageNameID, nil
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (m *HasMetadataMutation) ClearPackageNameID() {
+	m.package_name = nil
+	m.clearedFields[hasmetadata.FieldPackageNameID] = struct{}{}
+}
+
+// PackageNameIDCleared returns if the "package_name_id" field was cleared in this mutation.
+func (m *HasMetadataMutation) PackageNameIDCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldPackageNameID]
+	return ok
+}
+
+// ResetPackageNameID resets all changes to the "package_name_id" field.
+func (m *HasMetadataMutation) ResetPackageNameID() {
+	m.package_name = nil
+	delete(m.clearedFields, hasmetadata.FieldPackageNameID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *HasMetadataMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *HasMetadataMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *HasMetadataMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[hasmetadata.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *HasMetadataMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *HasMetadataMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, hasmetadata.FieldSourceID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *HasMetadataMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *HasMetadataMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *HasMetadataMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[hasmetadata.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *HasMetadataMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *HasMetadataMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, hasmetadata.FieldArtifactID)
+}
+
+// SetKey sets the "key" field.
+func (m *HasMetadataMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *HasMetadataMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *HasMetadataMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *HasMetadataMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *HasMetadataMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *HasMetadataMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetSince sets the "since" field.
+func (m *HasMetadataMutation) SetSince(t time.Time) {
+	m.since = &t
+}
+
+// Since returns the value of the "since" field in the mutation.
+func (m *HasMetadataMutation) Since() (r time.Time, exists bool) {
+	v := m.since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSince returns the old "since" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldSince(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSince: %w", err)
+	}
+	return oldValue.Since, nil
+}
+
+// ResetSince resets all changes to the "since" field.
+func (m *HasMetadataMutation) ResetSince() {
+	m.since = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *HasMetadataMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *HasMetadataMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *HasMetadataMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *HasMetadataMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *HasMetadataMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HasMetadataMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HasMetadataMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HasMetadataMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HasMetadataMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (m *HasMetadataMutation) ClearPackageVersion() {
+	m.clearedpackage_version = true
+}
+
+// PackageVersionCleared reports if the "package_version" edge to the PackageVersion entity was cleared.
+func (m *HasMetadataMutation) PackageVersionCleared() bool {
+	return m.PackageVersionIDCleared() || m.clearedpackage_version
+}
+
+// PackageVersionIDs returns the "package_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageVersionID instead. It exists only for internal usage by the builders.
+func (m *HasMetadataMutation) PackageVersionIDs() (ids []int) {
+	if id := m.package_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageVersion resets all changes to the "package_version" edge.
+func (m *HasMetadataMutation) ResetPackageVersion() {
+	m.package_version = nil
+	m.clearedpackage_version = false
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (m *HasMetadataMutation) ClearPackageName() {
+	m.clearedpackage_name = true
+}
+
+// PackageNameCleared reports if the "package_name" edge to the PackageName entity was cleared.
+func (m *HasMetadataMutation) PackageNameCleared() bool {
+	return m.PackageNameIDCleared() || m.clearedpackage_name
+}
+
+// PackageNameIDs returns the "package_name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageNameID instead. It exists only for internal usage by the builders.
+func (m *HasMetadataMutation) PackageNameIDs() (ids []int) {
+	if id := m.package_name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageName resets all changes to the "package_name" edge.
+func (m *HasMetadataMutation) ResetPackageName() {
+	m.package_name = nil
+	m.clearedpackage_name = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *HasMetadataMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *HasMetadataMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *HasMetadataMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *HasMetadataMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *HasMetadataMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *HasMetadataMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *HasMetadataMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *HasMetadataMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the HasMetadataMutation builder.
+func (m *HasMetadataMutation) Where(ps ...predicate.HasMetadata) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HasMetadataMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HasMetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HasMetadata, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HasMetadataMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HasMetadataMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HasMetadata).
+func (m *HasMetadataMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HasMetadataMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.package_version != nil {
+		fields = append(fields, hasmetadata.FieldPackageVersionID)
+	}
+	if m.package_name != nil {
+		fields = append(fields, hasmetadata.FieldPackageNameID)
+	}
+	if m.source != nil {
+		fields = append(fields, hasmetadata.FieldSourceID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, hasmetadata.FieldArtifactID)
+	}
+	if m.key != nil {
+		fields = append(fields, hasmetadata.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, hasmetadata.FieldValue)
+	}
+	if m.since != nil {
+		fields = append(fields, hasmetadata.FieldSince)
+	}
+	if m.justification != nil {
+		fields = append(fields, hasmetadata.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, hasmetadata.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, hasmetadata.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HasMetadataMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hasmetadata.FieldPackageVersionID:
+		return m.PackageVersionID()
+	case hasmetadata.FieldPackageNameID:
+		return m.PackageNameID()
+	case hasmetadata.FieldSourceID:
+		return m.SourceID()
+	case hasmetadata.FieldArtifactID:
+		return m.ArtifactID()
+	case hasmetadata.FieldKey:
+		return m.Key()
+	case hasmetadata.FieldValue:
+		return m.Value()
+	case hasmetadata.FieldSince:
+		return m.Since()
+	case hasmetadata.FieldJustification:
+		return m.Justification()
+	case hasmetadata.FieldOrigin:
+		return m.Origin()
+	case hasmetadata.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HasMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hasmetadata.FieldPackageVersionID:
+		return m.OldPackageVersionID(ctx)
+	case hasmetadata.FieldPackageNameID:
+		return m.OldPackageNameID(ctx)
+	case hasmetadata.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case hasmetadata.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case hasmetadata.FieldKey:
+		return m.OldKey(ctx)
+	case hasmetadata.FieldValue:
+		return m.OldValue(ctx)
+	case hasmetadata.FieldSince:
+		return m.OldSince(ctx)
+	case hasmetadata.FieldJustification:
+		return m.OldJustification(ctx)
+	case hasmetadata.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case hasmetadata.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown HasMetadata field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasMetadataMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hasmetadata.FieldPackageVersionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageVersionID(v)
+		return nil
+	case hasmetadata.FieldPackageNameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageNameID(v)
+		return nil
+	case hasmetadata.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case hasmetadata.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case hasmetadata.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case hasmetadata.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case hasmetadata.FieldSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSince(v)
+		return nil
+	case hasmetadata.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case hasmetadata.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case hasmetadata.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HasMetadata field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HasMetadataMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HasMetadataMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasMetadataMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HasMetadata numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HasMetadataMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hasmetadata.FieldPackageVersionID) {
+		fields = append(fields, hasmetadata.FieldPackageVersionID)
+	}
+	if m.FieldCleared(hasmetadata.FieldPackageNameID) {
+		fields = append(fields, hasmetadata.FieldPackageNameID)
+	}
+	if m.FieldCleared(hasmetadata.FieldSourceID) {
+		fields = append(fields, hasmetadata.FieldSourceID)
+	}
+	if m.FieldCleared(hasmetadata.FieldArtifactID) {
+		fields = append(fields, hasmetadata.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HasMetadataMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HasMetadataMutation) ClearField(name string) error {
+	switch name {
+	case hasmetadata.FieldPackageVersionID:
+		m.ClearPackageVersionID()
+		return nil
+	case hasmetadata.FieldPackageNameID:
+		m.ClearPackageNameID()
+		return nil
+	case hasmetadata.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case hasmetadata.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown HasMetadata nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HasMetadataMutation) ResetField(name string) error {
+	switch name {
+	case hasmetadata.FieldPackageVersionID:
+		m.ResetPackageVersionID()
+		return nil
+	case hasmetadata.FieldPackageNameID:
+		m.ResetPackageNameID()
+		return nil
+	case hasmetadata.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case hasmetadata.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case hasmetadata.FieldKey:
+		m.ResetKey()
+		return nil
+	case hasmetadata.FieldValue:
+		m.ResetValue()
+		return nil
+	case hasmetadata.FieldSince:
+		m.ResetSince()
+		return nil
+	case hasmetadata.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case hasmetadata.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case hasmetadata.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown HasMetadata field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HasMetadataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.package_version != nil {
+		edges = append(edges, hasmetadata.EdgePackageVersion)
+	}
+	if m.package_name != nil {
+		edges = append(edges, hasmetadata.EdgePackageName)
+	}
+	if m.source != nil {
+		edges = append(edges, hasmetadata.EdgeSource)
+	}
+	if m.artifact != nil {
+		edges = append(edges, hasmetadata.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HasMetadataMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hasmetadata.EdgePackageVersion:
+		if id := m.package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case hasmetadata.EdgePackageName:
+		if id := m.package_name; id != nil {
+			return []ent.Value{*id}
+		}
+	case hasmetadata.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case hasmetadata.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HasMetadataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HasMetadataMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HasMetadataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpackage_version {
+		edges = append(edges, hasmetadata.EdgePackageVersion)
+	}
+	if m.clearedpackage_name {
+		edges = append(edges, hasmetadata.EdgePackageName)
+	}
+	if m.clearedsource {
+		edges = append(edges, hasmetadata.EdgeSource)
+	}
+	if m.clearedartifact {
+		edges = append(edges, hasmetadata.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HasMetadataMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hasmetadata.EdgePackageVersion:
+		return m.clearedpackage_version
+	case hasmetadata.EdgePackageName:
+		return m.clearedpackage_name
+	case hasmetadata.EdgeSource:
+		return m.clearedsource
+	case hasmetadata.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HasMetadataMutation) ClearEdge(name string) error {
+	switch name {
+	case hasmetadata.EdgePackageVersion:
+		m.ClearPackageVersion()
+		return nil
+	case hasmetadata.EdgePackageName:
+		m.ClearPackageName()
+		return nil
+	case hasmetadata.EdgeSource:
+		m.ClearSource()
+		return nil
+	case hasmetadata.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown HasMetadata unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HasMetadataMutation) ResetEdge(name string) error {
+	switch name {
+	case hasmetadata.EdgePackageVersion:
+		m.ResetPackageVersion()
+		return nil
+	case hasmetadata.EdgePackageName:
+		m.ResetPackageName()
+		return nil
+	case hasmetadata.EdgeSource:
+		m.ResetSource()
+		return nil
+	case hasmetadata.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown HasMetadata edge %s", name)
+}
+
+// HasSBOMMutation represents an operation that mutates the HasSBOM nodes in the graph.
+type HasSBOMMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	uri               *string
+	algorithm         *string
+	digest            *string
+	download_location *string
+	origin            *string
+	collector         *string
+	clearedFields     map[string]struct{}
+	_package          *int
+	cleared_package   bool
+	artifact          *int
+	clearedartifact   bool
+	done              bool
+	oldValue          func(context.Context) (*HasSBOM, error)
+	predicates        []predicate.HasSBOM
+}
+
+var _ ent.Mutation = (*HasSBOMMutation)(nil)
+
+// hassbomOption allows management of the mutation configuration using functional options.
+type hassbomOption func(*HasSBOMMutation)
+
+// newHasSBOMMutation creates new mutation for the HasSBOM entity.
+func newHasSBOMMutation(c config, op Op, opts ...hassbomOption) *HasSBOMMutation {
+	m := &HasSBOMMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHasSBOM,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHasSBOMID sets the ID field of the mutation.
+func withHasSBOMID(id int) hassbomOption {
+	return func(m *HasSBOMMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HasSBOM
+		)
+		m.oldValue = func(ctx context.Context) (*HasSBOM, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HasSBOM.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHasSBOM sets the old HasSBOM of the mutation.
+func withHasSBOM(node *HasSBOM) hassbomOption {
+	return func(m *HasSBOMMutation) {
+		m.oldValue = func(context.Context) (*HasSBOM, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HasSBOMMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HasSBOMMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HasSBOMMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HasSBOMMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HasSBOM.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *HasSBOMMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *HasSBOMMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldPackageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (m *HasSBOMMutation) ClearPackageID() {
+	m._package = nil
+	m.clearedFields[hassbom.FieldPackageID] = struct{}{}
+}
+
+// PackageIDCleared returns if the "package_id" field was cleared in this mutation.
+func (m *HasSBOMMutation) PackageIDCleared() bool {
+	_, ok := m.clearedFields[hassbom.FieldPackageID]
+	return ok
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *HasSBOMMutation) ResetPackageID() {
+	m._package = nil
+	delete(m.clearedFields, hassbom.FieldPackageID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *HasSBOMMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *HasSBOMMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *HasSBOMMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[hassbom.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *HasSBOMMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[hassbom.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *HasSBOMMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, hassbom.FieldArtifactID)
+}
+
+// SetURI sets the "uri" field.
+func (m *HasSBOMMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *HasSBOMMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *HasSBOMMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *HasSBOMMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *HasSBOMMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *HasSBOMMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *HasSBOMMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *HasSBOMMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *HasSBOMMutation) ResetDigest() {
+	m.digest = nil
+}
+
+// SetDownloadLocation sets the "download_location" field.
+func (m *HasSBOMMutation) SetDownloadLocation(s string) {
+	m.download_location = &s
+}
+
+// DownloadLocation returns the value of the "download_location" field in the mutation.
+func (m *HasSBOMMutation) DownloadLocation() (r string, exists bool) {
+	v := m.download_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadLocation returns the old "download_location" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldDownloadLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadLocation: %w", err)
+	}
+	return oldValue.DownloadLocation, nil
+}
+
+// ResetDownloadLocation resets all changes to the "download_location" field.
+func (m *HasSBOMMutation) ResetDownloadLocation() {
+	m.download_location = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *HasSBOMMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *HasSBOMMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HasSBOMMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HasSBOMMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HasSBOMMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HasSBOM entity.
+// If the HasSBOM object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSBOMMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HasSBOMMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *HasSBOMMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *HasSBOMMutation) PackageCleared() bool {
+	return m.PackageIDCleared() || m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *HasSBOMMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *HasSBOMMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *HasSBOMMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *HasSBOMMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *HasSBOMMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *HasSBOMMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the HasSBOMMutation builder.
+func (m *HasSBOMMutation) Where(ps ...predicate.HasSBOM) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HasSBOMMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HasSBOMMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HasSBOM, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HasSBOMMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HasSBOMMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HasSBOM).
+func (m *HasSBOMMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HasSBOMMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._package != nil {
+		fields = append(fields, hassbom.FieldPackageID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, hassbom.FieldArtifactID)
+	}
+	if m.uri != nil {
+		fields = append(fields, hassbom.FieldURI)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, hassbom.FieldAlgorithm)
+	}
+	if m.digest != nil {
+		fields = append(fields, hassbom.FieldDigest)
+	}
+	if m.download_location != nil {
+		fields = append(fields, hassbom.FieldDownloadLocation)
+	}
+	if m.origin != nil {
+		fields = append(fields, hassbom.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, hassbom.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HasSBOMMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hassbom.FieldPackageID:
+		return m.PackageID()
+	case hassbom.FieldArtifactID:
+		return m.ArtifactID()
+	case hassbom.FieldURI:
+		return m.URI()
+	case hassbom.FieldAlgorithm:
+		return m.Algorithm()
+	case hassbom.FieldDigest:
+		return m.Digest()
+	case hassbom.FieldDownloadLocation:
+		return m.DownloadLocation()
+	case hassbom.FieldOrigin:
+		return m.Origin()
+	case hassbom.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HasSBOMMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hassbom.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case hassbom.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case hassbom.FieldURI:
+		return m.OldURI(ctx)
+	case hassbom.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case hassbom.FieldDigest:
+		return m.OldDigest(ctx)
+	case hassbom.FieldDownloadLocation:
+		return m.OldDownloadLocation(ctx)
+	case hassbom.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case hassbom.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown HasSBOM field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasSBOMMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hassbom.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case hassbom.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case hassbom.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case hassbom.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case hassbom.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case hassbom.FieldDownloadLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadLocation(v)
+		return nil
+	case hassbom.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case hassbom.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HasSBOM field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HasSBOMMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HasSBOMMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasSBOMMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HasSBOM numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HasSBOMMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hassbom.FieldPackageID) {
+		fields = append(fields, hassbom.FieldPackageID)
+	}
+	if m.FieldCleared(hassbom.FieldArtifactID) {
+		fields = append(fields, hassbom.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HasSBOMMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HasSBOMMutation) ClearField(name string) error {
+	switch name {
+	case hassbom.FieldPackageID:
+		m.ClearPackageID()
+		return nil
+	case hassbom.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSBOM nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HasSBOMMutation) ResetField(name string) error {
+	switch name {
+	case hassbom.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case hassbom.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case hassbom.FieldURI:
+		m.ResetURI()
+		return nil
+	case hassbom.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case hassbom.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case hassbom.FieldDownloadLocation:
+		m.ResetDownloadLocation()
+		return nil
+	case hassbom.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case hassbom.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSBOM field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HasSBOMMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._package != nil {
+		edges = append(edges, hassbom.EdgePackage)
+	}
+	if m.artifact != nil {
+		edges = append(edges, hassbom.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HasSBOMMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hassbom.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case hassbom.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HasSBOMMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HasSBOMMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HasSBOMMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_package {
+		edges = append(edges, hassbom.EdgePackage)
+	}
+	if m.clearedartifact {
+		edges = append(edges, hassbom.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HasSBOMMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hassbom.EdgePackage:
+		return m.cleared_package
+	case hassbom.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HasSBOMMutation) ClearEdge(name string) error {
+	switch name {
+	case hassbom.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case hassbom.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSBOM unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HasSBOMMutation) ResetEdge(name string) error {
+	switch name {
+	case hassbom.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case hassbom.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSBOM edge %s", name)
+}
+
+// HasSLSAMutation represents an operation that mutates the HasSLSA nodes in the graph.
+type HasSLSAMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	build_type            *string
+	slsa_predicates       *[]model.SLSAPredicate
+	appendslsa_predicates []model.SLSAPredicate
+	slsa_version          *string
+	started_on            *time.Time
+	finished_on           *time.Time
+	origin                *string
+	collector             *string
+	built_from_hash       *string
+	predicates_hash       *string
+	clearedFields         map[string]struct{}
+	subject               *int
+	clearedsubject        bool
+	built_by              *int
+	clearedbuilt_by       bool
+	built_from            map[int]struct{}
+	removedbuilt_from     map[int]struct{}
+	clearedbuilt_from     bool
+	done                  bool
+	oldValue              func(context.Context) (*HasSLSA, error)
+	predicates            []predicate.HasSLSA
+}
+
+var _ ent.Mutation = (*HasSLSAMutation)(nil)
+
+// hasslsaOption allows management of the mutation configuration using functional options.
+type hasslsaOption func(*HasSLSAMutation)
+
+// newHasSLSAMutation creates new mutation for the HasSLSA entity.
+func newHasSLSAMutation(c config, op Op, opts ...hasslsaOption) *HasSLSAMutation {
+	m := &HasSLSAMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHasSLSA,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHasSLSAID sets the ID field of the mutation.
+func withHasSLSAID(id int) hasslsaOption {
+	return func(m *HasSLSAMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HasSLSA
+		)
+		m.oldValue = func(ctx context.Context) (*HasSLSA, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HasSLSA.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHasSLSA sets the old HasSLSA of the mutation.
+func withHasSLSA(node *HasSLSA) hasslsaOption {
+	return func(m *HasSLSAMutation) {
+		m.oldValue = func(context.Context) (*HasSLSA, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HasSLSAMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HasSLSAMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HasSLSAMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HasSLSAMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HasSLSA.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (m *HasSLSAMutation) SetSubjectID(i int) {
+	m.subject = &i
+}
+
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *HasSLSAMutation) SubjectID() (r int, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectID returns the old "subject_id" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldSubjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
+	}
+	return oldValue.SubjectID, nil
+}
+
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *HasSLSAMutation) ResetSubjectID() {
+	m.subject = nil
+}
+
+// SetBuiltByID sets the "built_by_id" field.
+func (m *HasSLSAMutation) SetBuiltByID(i int) {
+	m.built_by = &i
+}
+
+// BuiltByID returns the value of the "built_by_id" field in the mutation.
+func (m *HasSLSAMutation) BuiltByID() (r int, exists bool) {
+	v := m.built_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuiltByID returns the old "built_by_id" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldBuiltByID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuiltByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuiltByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuiltByID: %w", err)
+	}
+	return oldValue.BuiltByID, nil
+}
+
+// ResetBuiltByID resets all changes to the "built_by_id" field.
+func (m *HasSLSAMutation) ResetBuiltByID() {
+	m.built_by = nil
+}
+
+// SetBuildType sets the "build_type" field.
+func (m *HasSLSAMutation) SetBuildType(s string) {
+	m.build_type = &s
+}
+
+// BuildType returns the value of the "build_type" field in the mutation.
+func (m *HasSLSAMutation) BuildType() (r string, exists bool) {
+	v := m.build_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildType returns the old "build_type" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldBuildType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildType: %w", err)
+	}
+	return oldValue.BuildType, nil
+}
+
+// ResetBuildType resets all changes to the "build_type" field.
+func (m *HasSLSAMutation) ResetBuildType() {
+	m.build_type = nil
+}
+
+// SetSlsaPredicates sets the "slsa_predicates" field.
+func (m *HasSLSAMutation) SetSlsaPredicates(mp []model.SLSAPredicate) {
+	m.slsa_predicates = &mp
+	m.appendslsa_predicates = nil
+}
+
+// SlsaPredicates returns the value of the "slsa_predicates" field in the mutation.
+func (m *HasSLSAMutation) SlsaPredicates() (r []model.SLSAPredicate, exists bool) {
+	v := m.slsa_predicates
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlsaPredicates returns the old "slsa_predicates" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldSlsaPredicates(ctx context.Context) (v []model.SLSAPredicate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlsaPredicates is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlsaPredicates requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlsaPredicates: %w", err)
+	}
+	return oldValue.SlsaPredicates, nil
+}
+
+// AppendSlsaPredicates adds mp to the "slsa_predicates" field.
+func (m *HasSLSAMutation) AppendSlsaPredicates(mp []model.SLSAPredicate) {
+	m.appendslsa_predicates = append(m.appendslsa_predicates, mp...)
+}
+
+// AppendedSlsaPredicates returns the list of values that were appended to the "slsa_predicates" field in this mutation.
+func (m *HasSLSAMutation) AppendedSlsaPredicates() ([]model.SLSAPredicate, bool) {
+	if len(m.appendslsa_predicates) == 0 {
+		return nil, false
+	}
+	return m.appendslsa_predicates, true
+}
+
+// ResetSlsaPredicates resets all changes to the "slsa_predicates" field.
+func (m *HasSLSAMutation) ResetSlsaPredicates() {
+	m.slsa_predicates = nil
+	m.appendslsa_predicates = nil
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (m *HasSLSAMutation) SetSlsaVersion(s string) {
+	m.slsa_version = &s
+}
+
+// SlsaVersion returns the value of the "slsa_version" field in the mutation.
+func (m *HasSLSAMutation) SlsaVersion() (r string, exists bool) {
+	v := m.slsa_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlsaVersion returns the old "slsa_version" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldSlsaVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlsaVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlsaVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlsaVersion: %w", err)
+	}
+	return oldValue.SlsaVersion, nil
+}
+
+// ResetSlsaVersion resets all changes to the "slsa_version" field.
+func (m *HasSLSAMutation) ResetSlsaVersion() {
+	m.slsa_version = nil
+}
+
+// SetStartedOn sets the "started_on" field.
+func (m *HasSLSAMutation) SetStartedOn(t time.Time) {
+	m.started_on = &t
+}
+
+// StartedOn returns the value of the "started_on" field in the mutation.
+func (m *HasSLSAMutation) StartedOn() (r time.Time, exists bool) {
+	v := m.started_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedOn returns the old "started_on" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldStartedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedOn: %w", err)
+	}
+	return oldValue.StartedOn, nil
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (m *HasSLSAMutation) ClearStartedOn() {
+	m.started_on = nil
+	m.clearedFields[hasslsa.FieldStartedOn] = struct{}{}
+}
+
+// StartedOnCleared returns if the "started_on" field was cleared in this mutation.
+func (m *HasSLSAMutation) StartedOnCleared() bool {
+	_, ok := m.clearedFields[hasslsa.FieldStartedOn]
+	return ok
+}
+
+// ResetStartedOn resets all changes to the "started_on" field.
+func (m *HasSLSAMutation) ResetStartedOn() {
+	m.started_on = nil
+	delete(m.clearedFields, hasslsa.FieldStartedOn)
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (m *HasSLSAMutation) SetFinishedOn(t time.Time) {
+	m.finished_on = &t
+}
+
+// FinishedOn returns the value of the "finished_on" field in the mutation.
+func (m *HasSLSAMutation) FinishedOn() (r time.Time, exists bool) {
+	v := m.finished_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedOn returns the old "finished_on" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldFinishedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedOn: %w", err)
+	}
+	return oldValue.FinishedOn, nil
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (m *HasSLSAMutation) ClearFinishedOn() {
+	m.finished_on = nil
+	m.clearedFields[hasslsa.FieldFinishedOn] = struct{}{}
+}
+
+// FinishedOnCleared returns if the "finished_on" field was cleared in this mutation.
+func (m *HasSLSAMutation) FinishedOnCleared() bool {
+	_, ok := m.clearedFields[hasslsa.FieldFinishedOn]
+	return ok
+}
+
+// ResetFinishedOn resets all changes to the "finished_on" field.
+func (m *HasSLSAMutation) ResetFinishedOn() {
+	m.finished_on = nil
+	delete(m.clearedFields, hasslsa.FieldFinishedOn)
+}
+
+// SetOrigin sets the "origin" field.
+func (m *HasSLSAMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *HasSLSAMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HasSLSAMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HasSLSAMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HasSLSAMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HasSLSAMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetBuiltFromHash sets the "built_from_hash" field.
+func (m *HasSLSAMutation) SetBuiltFromHash(s string) {
+	m.built_from_hash = &s
+}
+
+// BuiltFromHash returns the value of the "built_from_hash" field in the mutation.
+func (m *HasSLSAMutation) BuiltFromHash() (r string, exists bool) {
+	v := m.built_from_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuiltFromHash returns the old "built_from_hash" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldBuiltFromHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuiltFromHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuiltFromHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuiltFromHash: %w", err)
+	}
+	return oldValue.BuiltFromHash, nil
+}
+
+// ResetBuiltFromHash resets all changes to the "built_from_hash" field.
+func (m *HasSLSAMutation) ResetBuiltFromHash() {
+	m.built_from_hash = nil
+}
+
+// SetPredicatesHash sets the "predicates_hash" field.
+func (m *HasSLSAMutation) SetPredicatesHash(s string) {
+	m.predicates_hash = &s
+}
+
+// PredicatesHash returns the value of the "predicates_hash" field in the mutation.
+func (m *HasSLSAMutation) PredicatesHash() (r string, exists bool) {
+	v := m.predicates_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPredicatesHash returns the old "predicates_hash" field's value of the HasSLSA entity.
+// If the HasSLSA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSLSAMutation) OldPredicatesHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPredicatesHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPredicatesHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPredicatesHash: %w", err)
+	}
+	return oldValue.PredicatesHash, nil
+}
+
+// ResetPredicatesHash resets all changes to the "predicates_hash" field.
+func (m *HasSLSAMutation) ResetPredicatesHash() {
+	m.predicates_hash = nil
+}
+
+// ClearSubject clears the "subject" edge to the Artifact entity.
+func (m *HasSLSAMutation) ClearSubject() {
+	m.clearedsubject = true
+}
+
+// SubjectCleared reports if the "subject" edge to the Artifact entity was cleared.
+func (m *HasSLSAMutation) SubjectCleared() bool {
+	return m.clearedsubject
+}
+
+// SubjectIDs returns the "subject" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubjectID instead. It exists only for internal usage by the builders.
+func (m *HasSLSAMutation) SubjectIDs() (ids []int) {
+	if id := m.subject; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubject resets all changes to the "subject" edge.
+func (m *HasSLSAMutation) ResetSubject() {
+	m.subject = nil
+	m.clearedsubject = false
+}
+
+// ClearBuiltBy clears the "built_by" edge to the BuilderNode entity.
+func (m *HasSLSAMutation) ClearBuiltBy() {
+	m.clearedbuilt_by = true
+}
+
+// BuiltByCleared reports if the "built_by" edge to the BuilderNode entity was cleared.
+func (m *HasSLSAMutation) BuiltByCleared() bool {
+	return m.clearedbuilt_by
+}
+
+// BuiltByIDs returns the "built_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuiltByID instead. It exists only for internal usage by the builders.
+func (m *HasSLSAMutation) BuiltByIDs() (ids []int) {
+	if id := m.built_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuiltBy resets all changes to the "built_by" edge.
+func (m *HasSLSAMutation) ResetBuiltBy() {
+	m.built_by = nil
+	m.clearedbuilt_by = false
+}
+
+// AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by ids.
+func (m *HasSLSAMutation) AddBuiltFromIDs(ids ...int) {
+	if m.built_from == nil {
+		m.built_from = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.built_from[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuiltFrom clears the "built_from" edge to the Artifact entity.
+func (m *HasSLSAMutation) ClearBuiltFrom() {
+	m.clearedbuilt_from = true
+}
+
+// BuiltFromCleared reports if the "built_from" edge to the Artifact entity was cleared.
+func (m *HasSLSAMutation) BuiltFromCleared() bool {
+	return m.clearedbuilt_from
+}
+
+// RemoveBuiltFromIDs removes the "built_from" edge to the Artifact entity by IDs.
+func (m *HasSLSAMutation) RemoveBuiltFromIDs(ids ...int) {
+	if m.removedbuilt_from == nil {
+		m.removedbuilt_from = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.built_from, ids[i])
+		m.removedbuilt_from[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuiltFrom returns the removed IDs of the "built_from" edge to the Artifact entity.
+func (m *HasSLSAMutation) RemovedBuiltFromIDs() (ids []int) {
+	for id := range m.removedbuilt_from {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuiltFromIDs returns the "built_from" edge IDs in the mutation.
+func (m *HasSLSAMutation) BuiltFromIDs() (ids []int) {
+	for id := range m.built_from {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuiltFrom resets all changes to the "built_from" edge.
+func (m *HasSLSAMutation) ResetBuiltFrom() {
+	m.built_from = nil
+	m.clearedbuilt_from = false
+	m.removedbuilt_from = nil
+}
+
+// Where appends a list predicates to the HasSLSAMutation builder.
+func (m *HasSLSAMutation) Where(ps ...predicate.HasSLSA) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HasSLSAMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HasSLSAMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HasSLSA, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HasSLSAMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HasSLSAMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HasSLSA).
+func (m *HasSLSAMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HasSLSAMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.subject != nil {
+		fields = append(fields, hasslsa.FieldSubjectID)
+	}
+	if m.built_by != nil {
+		fields = append(fields, hasslsa.FieldBuiltByID)
+	}
+	if m.build_type != nil {
+		fields = append(fields, hasslsa.FieldBuildType)
+	}
+	if m.slsa_predicates != nil {
+		fields = append(fields, hasslsa.FieldSlsaPredicates)
+	}
+	if m.slsa_version != nil {
+		fields = append(fields, hasslsa.FieldSlsaVersion)
+	}
+	if m.started_on != nil {
+		fields = append(fields, hasslsa.FieldStartedOn)
+	}
+	if m.finished_on != nil {
+		fields = append(fields, hasslsa.FieldFinishedOn)
+	}
+	if m.origin != nil {
+		fields = append(fields, hasslsa.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, hasslsa.FieldCollector)
+	}
+	if m.built_from_hash != nil {
+		fields = append(fields, hasslsa.FieldBuiltFromHash)
+	}
+	if m.predicates_hash != nil {
+		fields = append(fields, hasslsa.FieldPredicatesHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HasSLSAMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hasslsa.FieldSubjectID:
+		return m.SubjectID()
+	case hasslsa.FieldBuiltByID:
+		return m.BuiltByID()
+	case hasslsa.FieldBuildType:
+		return m.BuildType()
+	case hasslsa.FieldSlsaPredicates:
+		return m.SlsaPredicates()
+	case hasslsa.FieldSlsaVersion:
+		return m.SlsaVersion()
+	case hasslsa.FieldStartedOn:
+		return m.StartedOn()
+	case hasslsa.FieldFinishedOn:
+		return m.FinishedOn()
+	case hasslsa.FieldOrigin:
+		return m.Origin()
+	case hasslsa.FieldCollector:
+		return m.Collector()
+	case hasslsa.FieldBuiltFromHash:
+		return m.BuiltFromHash()
+	case hasslsa.FieldPredicatesHash:
+		return m.PredicatesHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HasSLSAMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hasslsa.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case hasslsa.FieldBuiltByID:
+		return m.OldBuiltByID(ctx)
+	case hasslsa.FieldBuildType:
+		return m.OldBuildType(ctx)
+	case hasslsa.FieldSlsaPredicates:
+		return m.OldSlsaPredicates(ctx)
+	case hasslsa.FieldSlsaVersion:
+		return m.OldSlsaVersion(ctx)
+	case hasslsa.FieldStartedOn:
+		return m.OldStartedOn(ctx)
+	case hasslsa.FieldFinishedOn:
+		return m.OldFinishedOn(ctx)
+	case hasslsa.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case hasslsa.FieldCollector:
+		return m.OldCollector(ctx)
+	case hasslsa.FieldBuiltFromHash:
+		return m.OldBuiltFromHash(ctx)
+	case hasslsa.FieldPredicatesHash:
+		return m.OldPredicatesHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown HasSLSA field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasSLSAMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hasslsa.FieldSubjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectID(v)
+		return nil
+	case hasslsa.FieldBuiltByID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuiltByID(v)
+		return nil
+	case hasslsa.FieldBuildType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildType(v)
+		return nil
+	case hasslsa.FieldSlsaPredicates:
+		v, ok := value.([]model.SLSAPredicate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlsaPredicates(v)
+		return nil
+	case hasslsa.FieldSlsaVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlsaVersion(v)
+		return nil
+	case hasslsa.FieldStartedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedOn(v)
+		return nil
+	case hasslsa.FieldFinishedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedOn(v)
+		return nil
+	case hasslsa.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case hasslsa.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	case hasslsa.FieldBuiltFromHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuiltFromHash(v)
+		return nil
+	case hasslsa.FieldPredicatesHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPredicatesHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HasSLSA field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HasSLSAMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HasSLSAMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasSLSAMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HasSLSA numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HasSLSAMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hasslsa.FieldStartedOn) {
+		fields = append(fields, hasslsa.FieldStartedOn)
+	}
+	if m.FieldCleared(hasslsa.FieldFinishedOn) {
+		fields = append(fields, hasslsa.FieldFinishedOn)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HasSLSAMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HasSLSAMutation) ClearField(name string) error {
+	switch name {
+	case hasslsa.FieldStartedOn:
+		m.ClearStartedOn()
+		return nil
+	case hasslsa.FieldFinishedOn:
+		m.ClearFinishedOn()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSLSA nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HasSLSAMutation) ResetField(name string) error {
+	switch name {
+	case hasslsa.FieldSubjectID:
+		m.ResetSubjectID()
+		return nil
+	case hasslsa.FieldBuiltByID:
+		m.ResetBuiltByID()
+		return nil
+	case hasslsa.FieldBuildType:
+		m.ResetBuildType()
+		return nil
+	case hasslsa.FieldSlsaPredicates:
+		m.ResetSlsaPredicates()
+		return nil
+	case hasslsa.FieldSlsaVersion:
+		m.ResetSlsaVersion()
+		return nil
+	case hasslsa.FieldStartedOn:
+		m.ResetStartedOn()
+		return nil
+	case hasslsa.FieldFinishedOn:
+		m.ResetFinishedOn()
+		return nil
+	case hasslsa.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case hasslsa.FieldCollector:
+		m.ResetCollector()
+		return nil
+	case hasslsa.FieldBuiltFromHash:
+		m.ResetBuiltFromHash()
+		return nil
+	case hasslsa.FieldPredicatesHash:
+		m.ResetPredicatesHash()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSLSA field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HasSLSAMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.subject != nil {
+		edges = append(edges, hasslsa.EdgeSubject)
+	}
+	if m.built_by != nil {
+		edges = append(edges, hasslsa.EdgeBuiltBy)
+	}
+	if m.built_from != nil {
+		edges = append(edges, hasslsa.EdgeBuiltFrom)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HasSLSAMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hasslsa.EdgeSubject:
+		if id := m.subject; id != nil {
+			return []ent.Value{*id}
+		}
+	case hasslsa.EdgeBuiltBy:
+		if id := m.built_by; id != nil {
+			return []ent.Value{*id}
+		}
+	case hasslsa.EdgeBuiltFrom:
+		ids := make([]ent.Value, 0, len(m.built_from))
+		for id := range m.built_from {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HasSLSAMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedbuilt_from != nil {
+		edges = append(edges, hasslsa.EdgeBuiltFrom)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HasSLSAMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case hasslsa.EdgeBuiltFrom:
+		ids := make([]ent.Value, 0, len(m.removedbuilt_from))
+		for id := range m.removedbuilt_from {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HasSLSAMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedsubject {
+		edges = append(edges, hasslsa.EdgeSubject)
+	}
+	if m.clearedbuilt_by {
+		edges = append(edges, hasslsa.EdgeBuiltBy)
+	}
+	if m.clearedbuilt_from {
+		edges = append(edges, hasslsa.EdgeBuiltFrom)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HasSLSAMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hasslsa.EdgeSubject:
+		return m.clearedsubject
+	case hasslsa.EdgeBuiltBy:
+		return m.clearedbuilt_by
+	case hasslsa.EdgeBuiltFrom:
+		return m.clearedbuilt_from
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HasSLSAMutation) ClearEdge(name string) error {
+	switch name {
+	case hasslsa.EdgeSubject:
+		m.ClearSubject()
+		return nil
+	case hasslsa.EdgeBuiltBy:
+		m.ClearBuiltBy()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSLSA unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HasSLSAMutation) ResetEdge(name string) error {
+	switch name {
+	case hasslsa.EdgeSubject:
+		m.ResetSubject()
+		return nil
+	case hasslsa.EdgeBuiltBy:
+		m.ResetBuiltBy()
+		return nil
+	case hasslsa.EdgeBuiltFrom:
+		m.ResetBuiltFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown HasSLSA edge %s", name)
+}
+
+// HashEqualMutation represents an operation that mutates the HashEqual nodes in the graph.
+type HashEqualMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	justification     *string
+	origin            *string
+	collector         *string
+	clearedFields     map[string]struct{}
+	artifact_a        *int
+	clearedartifact_a bool
+	artifact_b        *int
+	clearedartifact_b bool
+	done              bool
+	oldValue          func(context.Context) (*HashEqual, error)
+	predicates        []predicate.HashEqual
+}
+
+var _ ent.Mutation = (*HashEqualMutation)(nil)
+
+// hashequalOption allows management of the mutation configuration using functional options.
+type hashequalOption func(*HashEqualMutation)
+
+// newHashEqualMutation creates new mutation for the HashEqual entity.
+func newHashEqualMutation(c config, op Op, opts ...hashequalOption) *HashEqualMutation {
+	m := &HashEqualMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHashEqual,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHashEqualID sets the ID field of the mutation.
+func withHashEqualID(id int) hashequalOption {
+	return func(m *HashEqualMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HashEqual
+		)
+		m.oldValue = func(ctx context.Context) (*HashEqual, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HashEqual.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHashEqual sets the old HashEqual of the mutation.
+func withHashEqual(node *HashEqual) hashequalOption {
+	return func(m *HashEqualMutation) {
+		m.oldValue = func(context.Context) (*HashEqual, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HashEqualMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HashEqualMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HashEqualMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HashEqualMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HashEqual.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetArtifactAID sets the "artifact_a_id" field.
+func (m *HashEqualMutation) SetArtifactAID(i int) {
+	m.artifact_a = &i
+}
+
+// ArtifactAID returns the value of the "artifact_a_id" field in the mutation.
+func (m *HashEqualMutation) ArtifactAID() (r int, exists bool) {
+	v := m.artifact_a
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactAID returns the old "artifact_a_id" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldArtifactAID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactAID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactAID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactAID: %w", err)
+	}
+	return oldValue.ArtifactAID, nil
+}
+
+// ResetArtifactAID resets all changes to the "artifact_a_id" field.
+func (m *HashEqualMutation) ResetArtifactAID() {
+	m.artifact_a = nil
+}
+
+// SetArtifactBID sets the "artifact_b_id" field.
+func (m *HashEqualMutation) SetArtifactBID(i int) {
+	m.artifact_b = &i
+}
+
+// ArtifactBID returns the value of the "artifact_b_id" field in the mutation.
+func (m *HashEqualMutation) ArtifactBID() (r int, exists bool) {
+	v := m.artifact_b
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactBID returns the old "artifact_b_id" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldArtifactBID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactBID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactBID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactBID: %w", err)
+	}
+	return oldValue.ArtifactBID, nil
+}
+
+// ResetArtifactBID resets all changes to the "artifact_b_id" field.
+func (m *HashEqualMutation) ResetArtifactBID() {
+	m.artifact_b = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *HashEqualMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *HashEqualMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *HashEqualMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *HashEqualMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *HashEqualMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HashEqualMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HashEqualMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HashEqualMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HashEqualMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearArtifactA clears the "artifact_a" edge to the Artifact entity.
+func (m *HashEqualMutation) ClearArtifactA() {
+	m.clearedartifact_a = true
+}
+
+// ArtifactACleared reports if the "artifact_a" edge to the Artifact entity was cleared.
+func (m *HashEqualMutation) ArtifactACleared() bool {
+	return m.clearedartifact_a
+}
+
+// ArtifactAIDs returns the "artifact_a" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactAID instead. It exists only for internal usage by the builders.
+func (m *HashEqualMutation) ArtifactAIDs() (ids []int) {
+	if id := m.artifact_a; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifactA resets all changes to the "artifact_a" edge.
+func (m *HashEqualMutation) ResetArtifactA() {
+	m.artifact_a = nil
+	m.clearedartifact_a = false
+}
+
+// ClearArtifactB clears the "artifact_b" edge to the Artifact entity.
+func (m *HashEqualMutation) ClearArtifactB() {
+	m.clearedartifact_b = true
+}
+
+// ArtifactBCleared reports if the "artifact_b" edge to the Artifact entity was cleared.
+func (m *HashEqualMutation) ArtifactBCleared() bool {
+	return m.clearedartifact_b
+}
+
+// ArtifactBIDs returns the "artifact_b" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactBID instead. It exists only for internal usage by the builders.
+func (m *HashEqualMutation) ArtifactBIDs() (ids []int) {
+	if id := m.artifact_b; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifactB resets all changes to the "artifact_b" edge.
+func (m *HashEqualMutation) ResetArtifactB() {
+	m.artifact_b = nil
+	m.clearedartifact_b = false
+}
+
+// Where appends a list predicates to the HashEqualMutation builder.
+func (m *HashEqualMutation) Where(ps ...predicate.HashEqual) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HashEqualMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HashEqualMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HashEqual, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HashEqualMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HashEqualMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HashEqual).
+func (m *HashEqualMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HashEqualMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.artifact_a != nil {
+		fields = append(fields, hashequal.FieldArtifactAID)
+	}
+	if m.artifact_b != nil {
+		fields = append(fields, hashequal.FieldArtifactBID)
+	}
+	if m.justification != nil {
+		fields = append(fields, hashequal.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, hashequal.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, hashequal.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HashEqualMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hashequal.FieldArtifactAID:
+		return m.ArtifactAID()
+	case hashequal.FieldArtifactBID:
+		return m.ArtifactBID()
+	case hashequal.FieldJustification:
+		return m.Justification()
+	case hashequal.FieldOrigin:
+		return m.Origin()
+	case hashequal.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HashEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hashequal.FieldArtifactAID:
+		return m.OldArtifactAID(ctx)
+	case hashequal.FieldArtifactBID:
+		return m.OldArtifactBID(ctx)
+	case hashequal.FieldJustification:
+		return m.OldJustification(ctx)
+	case hashequal.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case hashequal.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown HashEqual field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HashEqualMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hashequal.FieldArtifactAID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactAID(v)
+		return nil
+	case hashequal.FieldArtifactBID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactBID(v)
+		return nil
+	case hashequal.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case hashequal.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case hashequal.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HashEqual field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HashEqualMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HashEqualMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HashEqualMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HashEqual numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HashEqualMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HashEqualMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HashEqualMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HashEqual nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HashEqualMutation) ResetField(name string) error {
+	switch name {
+	case hashequal.FieldArtifactAID:
+		m.ResetArtifactAID()
+		return nil
+	case hashequal.FieldArtifactBID:
+		m.ResetArtifactBID()
+		return nil
+	case hashequal.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case hashequal.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case hashequal.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown HashEqual field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HashEqualMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.artifact_a != nil {
+		edges = append(edges, hashequal.EdgeArtifactA)
+	}
+	if m.artifact_b != nil {
+		edges = append(edges, hashequal.EdgeArtifactB)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HashEqualMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hashequal.EdgeArtifactA:
+		if id := m.artifact_a; id != nil {
+			return []ent.Value{*id}
+		}
+	case hashequal.EdgeArtifactB:
+		if id := m.artifact_b; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HashEqualMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HashEqualMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HashEqualMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedartifact_a {
+		edges = append(edges, hashequal.EdgeArtifactA)
+	}
+	if m.clearedartifact_b {
+		edges = append(edges, hashequal.EdgeArtifactB)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HashEqualMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hashequal.EdgeArtifactA:
+		return m.clearedartifact_a
+	case hashequal.EdgeArtifactB:
+		return m.clearedartifact_b
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HashEqualMutation) ClearEdge(name string) error {
+	switch name {
+	case hashequal.EdgeArtifactA:
+		m.ClearArtifactA()
+		return nil
+	case hashequal.EdgeArtifactB:
+		m.ClearArtifactB()
+		return nil
+	}
+	return fmt.Errorf("unknown HashEqual unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HashEqualMutation) ResetEdge(name string) error {
+	switch name {
+	case hashequal.EdgeArtifactA:
+		m.ResetArtifactA()
+		return nil
+	case hashequal.EdgeArtifactB:
+		m.ResetArtifactB()
+		return nil
+	}
+	return fmt.Errorf("unknown HashEqual edge %s", name)
+}
+
+// IsDependencyMutation represents an operation that mutates the IsDependency nodes in the graph.
+type IsDependencyMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	version_range            *string
+	dependency_type          *model.DependencyType
+	justification            *string
+	origin                   *string
+	collector                *string
+	clearedFields            map[string]struct{}
+	_package                 *int
+	cleared_package          bool
+	dependent_package        *int
+	cleareddependent_package bool
+	done                     bool
+	oldValue                 func(context.Context) (*IsDependency, error)
+	predicates               []predicate.IsDependency
+}
+
+var _ ent.Mutation = (*IsDependencyMutation)(nil)
+
+// isdependencyOption allows management of the mutation configuration using functional options.
+type isdependencyOption func(*IsDependencyMutation)
+
+// newIsDependencyMutation creates new mutation for the IsDependency entity.
+func newIsDependencyMutation(c config, op Op, opts ...isdependencyOption) *IsDependencyMutation {
+	m := &IsDependencyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIsDependency,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIsDependencyID sets the ID field of the mutation.
+func withIsDependencyID(id int) isdependencyOption {
+	return func(m *IsDependencyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IsDependency
+		)
+		m.oldValue = func(ctx context.Context) (*IsDependency, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IsDependency.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIsDependency sets the old IsDependency of the mutation.
+func withIsDependency(node *IsDependency) isdependencyOption {
+	return func(m *IsDependencyMutation) {
+		m.oldValue = func(context.Context) (*IsDependency, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IsDependencyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IsDependencyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IsDependencyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IsDependencyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IsDependency.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *IsDependencyMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *IsDependencyMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldPackageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *IsDependencyMutation) ResetPackageID() {
+	m._package = nil
+}
+
+// SetDependentPackageID sets the "dependent_package_id" field.
+func (m *IsDependencyMutation) SetDependentPackageID(i int) {
+	m.dependent_package = &i
+}
+
+// DependentPackageID returns the value of the "dependent_package_id" field in the mutation.
+func (m *IsDependencyMutation) DependentPackageID() (r int, exists bool) {
+	v := m.dependent_package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependentPackageID returns the old "dependent_package_id" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldDependentPackageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependentPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependentPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependentPackageID: %w", err)
+	}
+	return oldValue.DependentPackageID, nil
+}
+
+// ResetDependentPackageID resets all changes to the "dependent_package_id" field.
+func (m *IsDependencyMutation) ResetDependentPackageID() {
+	m.dependent_package = nil
+}
+
+// SetVersionRange sets the "version_range" field.
+func (m *IsDependencyMutation) SetVersionRange(s string) {
+	m.version_range = &s
+}
+
+// VersionRange returns the value of the "version_range" field in the mutation.
+func (m *IsDependencyMutation) VersionRange() (r string, exists bool) {
+	v := m.version_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionRange returns the old "version_range" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldVersionRange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionRange: %w", err)
+	}
+	return oldValue.VersionRange, nil
+}
+
+// ResetVersionRange resets all changes to the "version_range" field.
+func (m *IsDependencyMutation) ResetVersionRange() {
+	m.version_range = nil
+}
+
+// SetDependencyType sets the "dependency_type" field.
+func (m *IsDependencyMutation) SetDependencyType(mt model.DependencyType) {
+	m.dependency_type = &mt
+}
+
+// DependencyType returns the value of the "dependency_type" field in the mutation.
+func (m *IsDependencyMutation) DependencyType() (r model.DependencyType, exists bool) {
+	v := m.dependency_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependencyType returns the old "dependency_type" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldDependencyType(ctx context.Context) (v model.DependencyType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependencyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependencyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependencyType: %w", err)
+	}
+	return oldValue.DependencyType, nil
+}
+
+// ResetDependencyType resets all changes to the "dependency_type" field.
+func (m *IsDependencyMutation) ResetDependencyType() {
+	m.dependency_type = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *IsDependencyMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *IsDependencyMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *IsDependencyMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *IsDependencyMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *IsDependencyMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *IsDependencyMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *IsDependencyMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *IsDependencyMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the IsDependency entity.
+// If the IsDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsDependencyMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *IsDependencyMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *IsDependencyMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *IsDependencyMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *IsDependencyMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *IsDependencyMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearDependentPackage clears the "dependent_package" edge to the PackageName entity.
+func (m *IsDependencyMutation) ClearDependentPackage() {
+	m.cleareddependent_package = true
+}
+
+// DependentPackageCleared reports if the "dependent_package" edge to the PackageName entity was cleared.
+func (m *IsDependencyMutation) DependentPackageCleared() bool {
+	return m.cleareddependent_package
+}
+
+// DependentPackageIDs returns the "dependent_package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DependentPackageID instead. It exists only for internal usage by the builders.
+func (m *IsDependencyMutation) DependentPackageIDs() (ids []int) {
+	if id := m.dependent_package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDependentPackage resets all changes to the "dependent_package" edge.
+func (m *IsDependencyMutation) ResetDependentPackage() {
+	m.dependent_package = nil
+	m.cleareddependent_package = false
+}
+
+// Where appends a list predicates to the IsDependencyMutation builder.
+func (m *IsDependencyMutation) Where(ps ...predicate.IsDependency) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IsDependencyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IsDependencyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IsDependency, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IsDependencyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IsDependencyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IsDependency).
+func (m *IsDependencyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IsDependencyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m._package != nil {
+		fields = append(fields, isdependency.FieldPackageID)
+	}
+	if m.dependent_package != nil {
+		fields = append(fields, isdependency.FieldDependentPackageID)
+	}
+	if m.version_range != nil {
+		fields = append(fields, isdependency.FieldVersionRange)
+	}
+	if m.dependency_type != nil {
+		fields = append(fields, isdependency.FieldDependencyType)
+	}
+	if m.justification != nil {
+		fields = append(fields, isdependency.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, isdependency.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, isdependency.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IsDependencyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case isdependency.FieldPackageID:
+		return m.PackageID()
+	case isdependency.FieldDependentPackageID:
+		return m.DependentPackageID()
+	case isdependency.FieldVersionRange:
+		return m.VersionRange()
+	case isdependency.FieldDependencyType:
+		return m.DependencyType()
+	case isdependency.FieldJustification:
+		return m.Justification()
+	case isdependency.FieldOrigin:
+		return m.Origin()
+	case isdependency.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IsDependencyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case isdependency.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case isdependency.FieldDependentPackageID:
+		return m.OldDependentPackageID(ctx)
+	case isdependency.FieldVersionRange:
+		return m.OldVersionRange(ctx)
+	case isdependency.FieldDependencyType:
+		return m.OldDependencyType(ctx)
+	case isdependency.FieldJustification:
+		return m.OldJustification(ctx)
+	case isdependency.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case isdependency.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown IsDependency field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IsDependencyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case isdependency.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case isdependency.FieldDependentPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependentPackageID(v)
+		return nil
+	case isdependency.FieldVersionRange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionRange(v)
+		return nil
+	case isdependency.FieldDependencyType:
+		v, ok := value.(model.DependencyType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependencyType(v)
+		return nil
+	case isdependency.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case isdependency.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case isdependency.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IsDependency field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IsDependencyMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IsDependencyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IsDependencyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IsDependency numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IsDependencyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IsDependencyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IsDependencyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IsDependency nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IsDependencyMutation) ResetField(name string) error {
+	switch name {
+	case isdependency.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case isdependency.FieldDependentPackageID:
+		m.ResetDependentPackageID()
+		return nil
+	case isdependency.FieldVersionRange:
+		m.ResetVersionRange()
+		return nil
+	case isdependency.FieldDependencyType:
+		m.ResetDependencyType()
+		return nil
+	case isdependency.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case isdependency.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case isdependency.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown IsDependency field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IsDependencyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._package != nil {
+		edges = append(edges, isdependency.EdgePackage)
+	}
+	if m.dependent_package != nil {
+		edges = append(edges, isdependency.EdgeDependentPackage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IsDependencyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case isdependency.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case isdependency.EdgeDependentPackage:
+		if id := m.dependent_package; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IsDependencyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IsDependencyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IsDependencyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_package {
+		edges = append(edges, isdependency.EdgePackage)
+	}
+	if m.cleareddependent_package {
+		edges = append(edges, isdependency.EdgeDependentPackage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IsDependencyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case isdependency.EdgePackage:
+		return m.cleared_package
+	case isdependency.EdgeDependentPackage:
+		return m.cleareddependent_package
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IsDependencyMutation) ClearEdge(name string) error {
+	switch name {
+	case isdependency.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case isdependency.EdgeDependentPackage:
+		m.ClearDependentPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown IsDependency unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IsDependencyMutation) ResetEdge(name string) error {
+	switch name {
+	case isdependency.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case isdependency.EdgeDependentPackage:
+		m.ResetDependentPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown IsDependency edge %s", name)
+}
+
+// IsOccurrenceMutation represents an operation that mutates the IsOccurrence nodes in the graph.
+type IsOccurrenceMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	justification   *string
+	origin          *string
+	collector       *string
+	clearedFields   map[string]struct{}
+	_package        *int
+	cleared_package bool
+	source          *int
+	clearedsource   bool
+	artifact        *int
+	clearedartifact bool
+	done            bool
+	oldValue        func(context.Context) (*IsOccurrence, error)
+	predicates      []predicate.IsOccurrence
+}
+
+var _ ent.Mutation = (*IsOccurrenceMutation)(nil)
+
+// isoccurrenceOption allows management of the mutation configuration using functional options.
+type isoccurrenceOption func(*IsOccurrenceMutation)
+
+// newIsOccurrenceMutation creates new mutation for the IsOccurrence entity.
+func newIsOccurrenceMutation(c config, op Op, opts ...isoccurrenceOption) *IsOccurrenceMutation {
+	m := &IsOccurrenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIsOccurrence,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIsOccurrenceID sets the ID field of the mutation.
+func withIsOccurrenceID(id int) isoccurrenceOption {
+	return func(m *IsOccurrenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IsOccurrence
+		)
+		m.oldValue = func(ctx context.Context) (*IsOccurrence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IsOccurrence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIsOccurrence sets the old IsOccurrence of the mutation.
+func withIsOccurrence(node *IsOccurrence) isoccurrenceOption {
+	return func(m *IsOccurrenceMutation) {
+		m.oldValue = func(context.Context) (*IsOccurrence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IsOccurrenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IsOccurrenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IsOccurrenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IsOccurrenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IsOccurrence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *IsOccurrenceMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *IsOccurrenceMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldPackageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (m *IsOccurrenceMutation) ClearPackageID() {
+	m._package = nil
+	m.clearedFields[isoccurrence.FieldPackageID] = struct{}{}
+}
+
+// PackageIDCleared returns if the "package_id" field was cleared in this mutation.
+func (m *IsOccurrenceMutation) PackageIDCleared() bool {
+	_, ok := m.clearedFields[isoccurrence.FieldPackageID]
+	return ok
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *IsOccurrenceMutation) ResetPackageID() {
+	m._package = nil
+	delete(m.clearedFields, isoccurrence.FieldPackageID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *IsOccurrenceMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *IsOccurrenceMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *IsOccurrenceMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[isoccurrence.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *IsOccurrenceMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[isoccurrence.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *IsOccurrenceMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, isoccurrence.FieldSourceID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *IsOccurrenceMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *IsOccurrenceMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldArtifactID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *IsOccurrenceMutation) ResetArtifactID() {
+	m.artifact = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *IsOccurrenceMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *IsOccurrenceMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *IsOccurrenceMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *IsOccurrenceMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *IsOccurrenceMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *IsOccurrenceMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *IsOccurrenceMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *IsOccurrenceMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the IsOccurrence entity.
+// If the IsOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IsOccurrenceMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *IsOccurrenceMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *IsOccurrenceMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *IsOccurrenceMutation) PackageCleared() bool {
+	return m.PackageIDCleared() || m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *IsOccurrenceMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *IsOccurrenceMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *IsOccurrenceMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *IsOccurrenceMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *IsOccurrenceMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *IsOccurrenceMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *IsOccurrenceMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *IsOccurrenceMutation) ArtifactCleared() bool {
+	return m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *IsOccurrenceMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *IsOccurrenceMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the IsOccurrenceMutation builder.
+func (m *IsOccurrenceMutation) Where(ps ...predicate.IsOccurrence) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IsOccurrenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IsOccurrenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IsOccurrence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IsOccurrenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IsOccurrenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IsOccurrence).
+func (m *IsOccurrenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IsOccurrenceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._package != nil {
+		fields = append(fields, isoccurrence.FieldPackageID)
+	}
+	if m.source != nil {
+		fields = append(fields, isoccurrence.FieldSourceID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, isoccurrence.FieldArtifactID)
+	}
+	if m.justification != nil {
+		fields = append(fields, isoccurrence.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, isoccurrence.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, isoccurrence.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IsOccurrenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case isoccurrence.FieldPackageID:
+		return m.PackageID()
+	case isoccurrence.FieldSourceID:
+		return m.SourceID()
+	case isoccurrence.FieldArtifactID:
+		return m.ArtifactID()
+	case isoccurrence.FieldJustification:
+		return m.Justification()
+	case isoccurrence.FieldOrigin:
+		return m.Origin()
+	case isoccurrence.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IsOccurrenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case isoccurrence.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case isoccurrence.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case isoccurrence.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case isoccurrence.FieldJustification:
+		return m.OldJustification(ctx)
+	case isoccurrence.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case isoccurrence.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown IsOccurrence field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IsOccurrenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case isoccurrence.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case isoccurrence.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case isoccurrence.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case isoccurrence.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case isoccurrence.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case isoccurrence.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IsOccurrence field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IsOccurrenceMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IsOccurrenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IsOccurrenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IsOccurrence numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IsOccurrenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(isoccurrence.FieldPackageID) {
+		fields = append(fields, isoccurrence.FieldPackageID)
+	}
+	if m.FieldCleared(isoccurrence.FieldSourceID) {
+		fields = append(fields, isoccurrence.FieldSourceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IsOccurrenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IsOccurrenceMutation) ClearField(name string) error {
+	switch name {
+	case isoccurrence.FieldPackageID:
+		m.ClearPackageID()
+		return nil
+	case isoccurrence.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	}
+	return fmt.Errorf("unknown IsOccurrence nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IsOccurrenceMutation) ResetField(name string) error {
+	switch name {
+	case isoccurrence.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case isoccurrence.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case isoccurrence.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case isoccurrence.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case isoccurrence.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case isoccurrence.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown IsOccurrence field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IsOccurrenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m._package != nil {
+		edges = append(edges, isoccurrence.EdgePackage)
+	}
+	if m.source != nil {
+		edges = append(edges, isoccurrence.EdgeSource)
+	}
+	if m.artifact != nil {
+		edges = append(edges, isoccurrence.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IsOccurrenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case isoccurrence.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case isoccurrence.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case isoccurrence.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IsOccurrenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IsOccurrenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IsOccurrenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleared_package {
+		edges = append(edges, isoccurrence.EdgePackage)
+	}
+	if m.clearedsource {
+		edges = append(edges, isoccurrence.EdgeSource)
+	}
+	if m.clearedartifact {
+		edges = append(edges, isoccurrence.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IsOccurrenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case isoccurrence.EdgePackage:
+		return m.cleared_package
+	case isoccurrence.EdgeSource:
+		return m.clearedsource
+	case isoccurrence.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IsOccurrenceMutation) ClearEdge(name string) error {
+	switch name {
+	case isoccurrence.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case isoccurrence.EdgeSource:
+		m.ClearSource()
+		return nil
+	case isoccurrence.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown IsOccurrence unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IsOccurrenceMutation) ResetEdge(name string) error {
+	switch name {
+	case isoccurrence.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case isoccurrence.EdgeSource:
+		m.ResetSource()
+		return nil
+	case isoccurrence.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown IsOccurrence edge %s", name)
+}
+
+// PackageNameMutation represents an operation that mutates the PackageName nodes in the graph.
+type PackageNameMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	clearedFields    map[string]struct{}
+	namespace        *int
+	clearednamespace bool
+	versions         map[int]struct{}
+	removedversions  map[int]struct{}
+	clearedversions  bool
+	done             bool
+	oldValue         func(context.Context) (*PackageName, error)
+	predicates       []predicate.PackageName
+}
+
+var _ ent.Mutation = (*PackageNameMutation)(nil)
+
+// packagenameOption allows management of the mutation configuration using functional options.
+type packagenameOption func(*PackageNameMutation)
+
+// newPackageNameMutation creates new mutation for the PackageName entity.
+func newPackageNameMutation(c config, op Op, opts ...packagenameOption) *PackageNameMutation {
+	m := &PackageNameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePackageName,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPackageNameID sets the ID field of the mutation.
+func withPackageNameID(id int) packagenameOption {
+	return func(m *PackageNameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PackageName
+		)
+		m.oldValue = func(ctx context.Context) (*PackageName, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PackageName.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPackageName sets the old PackageName of the mutation.
+func withPackageName(node *PackageName) packagenameOption {
+	return func(m *PackageNameMutation) {
+		m.oldValue = func(context.Context) (*PackageName, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PackageNameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PackageNameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PackageNameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PackageNameMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PackageName.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (m *PackageNameMutation) SetNamespaceID(i int) {
+	m.namespace = &i
+}
+
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *PackageNameMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespaceID returns the old "namespace_id" field's value of the PackageName entity.
+// If the PackageName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageNameMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+	}
+	return oldValue.NamespaceID, nil
+}
+
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *PackageNameMutation) ResetNamespaceID() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *PackageNameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PackageNameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PackageName entity.
+// If the PackageName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageNameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PackageNameMutation) ResetName() {
+	m.name = nil
+}
+
+// ClearNamespace clears the "namespace" edge to the PackageNamespace entity.
+func (m *PackageNameMutation) ClearNamespace() {
+	m.clearednamespace = true
+}
+
+// NamespaceCleared reports if the "namespace" edge to the PackageNamespace entity was cleared.
+func (m *PackageNameMutation) NamespaceCleared() bool {
+	return m.clearednamespace
+}
+
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *PackageNameMutation) NamespaceIDs() (ids []int) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *PackageNameMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
+}
+
+// AddVersionIDs adds the "versions" edge to the PackageVersion entity by ids.
+func (m *PackageNameMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the PackageVersion entity.
+func (m *PackageNameMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the PackageVersion entity was cleared.
+func (m *PackageNameMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the PackageVersion entity by IDs.
+func (m *PackageNameMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the PackageVersion entity.
+func (m *PackageNameMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *PackageNameMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *PackageNameMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// Where appends a list predicates to the PackageNameMutation builder.
+func (m *PackageNameMutation) Where(ps ...predicate.PackageName) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PackageNameMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PackageNameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PackageName, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PackageNameMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PackageNameMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PackageName).
+func (m *PackageNameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PackageNameMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.namespace != nil {
+		fields = append(fields, packagename.FieldNamespaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, packagename.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PackageNameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case packagename.FieldNamespaceID:
+		return m.NamespaceID()
+	case packagename.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case packagename.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case packagename.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown PackageName field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageNameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case packagename.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case packagename.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PackageName field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PackageNameMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PackageNameMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageNameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PackageName numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PackageNameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PackageNameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PackageNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PackageName nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PackageNameMutation) ResetField(name string) error {
+	switch name {
+	case packagename.FieldNamespaceID:
+		m.ResetNamespaceID()
+		return nil
+	case packagename.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageName field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PackageNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.namespace != nil {
+		edges = append(edges, packagename.EdgeNamespace)
+	}
+	if m.versions != nil {
+		edges = append(edges, packagename.EdgeVersions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PackageNameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case packagename.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
+		}
+	case packagename.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PackageNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedversions != nil {
+		edges = append(edges, packagename.EdgeVersions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PackageNameMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case packagename.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PackageNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearednamespace {
+		edges = append(edges, packagename.EdgeNamespace)
+	}
+	if m.clearedversions {
+		edges = append(edges, packagename.EdgeVersions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PackageNameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case packagename.EdgeNamespace:
+		return m.clearednamespace
+	case packagename.EdgeVersions:
+		return m.clearedversions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PackageNameMutation) ClearEdge(name string) error {
+	switch name {
+	case packagename.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageName unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PackageNameMutation) ResetEdge(name string) error {
+	switch name {
+	case packagename.EdgeNamespace:
+		m.ResetNamespace()
+		return nil
+	case packagename.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageName edge %s", name)
+}
+
+// PackageNamespaceMutation represents an operation that mutates the PackageNamespace nodes in the graph.
+type PackageNamespaceMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	namespace       *string
+	clearedFields   map[string]struct{}
+	_package        *int
+	cleared_package bool
+	names           map[int]struct{}
+	removednames    map[int]struct{}
+	clearednames    bool
+	done            bool
+	oldValue        func(context.Context) (*PackageNamespace, error)
+	predicates      []predicate.PackageNamespace
+}
+
+var _ ent.Mutation = (*PackageNamespaceMutation)(nil)
+
+// packagenamespaceOption allows management of the mutation configuration using functional options.
+type packagenamespaceOption func(*PackageNamespaceMutation)
+
+// newPackageNamespaceMutation creates new mutation for the PackageNamespace entity.
+func newPackageNamespaceMutation(c config, op Op, opts ...packagenamespaceOption) *PackageNamespaceMutation {
+	m := &PackageNamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePackageNamespace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPackageNamespaceID sets the ID field of the mutation.
+func withPackageNamespaceID(id int) packagenamespaceOption {
+	return func(m *PackageNamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PackageNamespace
+		)
+		m.oldValue = func(ctx context.Context) (*PackageNamespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PackageNamespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPackageNamespace sets the old PackageNamespace of the mutation.
+func withPackageNamespace(node *PackageNamespace) packagenamespaceOption {
+	return func(m *PackageNamespaceMutation) {
+		m.oldValue = func(context.Context) (*PackageNamespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PackageNamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PackageNamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PackageNamespaceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PackageNamespaceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PackageNamespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *PackageNamespaceMutation) SetPackageID(i int) {
+	m._package = &i
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *PackageNamespaceMutation) PackageID() (r int, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the PackageNamespace entity.
+// If the PackageNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageNamespaceMutation) OldPackageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *PackageNamespaceMutation) ResetPackageID() {
+	m._package = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *PackageNamespaceMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *PackageNamespaceMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the PackageNamespace entity.
+// If the PackageNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageNamespaceMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *PackageNamespaceMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageType entity.
+func (m *PackageNamespaceMutation) ClearPackage() {
+	m.cleared_package = true
+}
+
+// PackageCleared reports if the "package" edge to the PackageType entity was cleared.
+func (m *PackageNamespaceMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *PackageNamespaceMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *PackageNamespaceMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// AddNameIDs adds the "names" edge to the PackageName entity by ids.
+func (m *PackageNamespaceMutation) AddNameIDs(ids ...int) {
+	if m.names == nil {
+		m.names = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.names[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNames clears the "names" edge to the PackageName entity.
+func (m *PackageNamespaceMutation) ClearNames() {
+	m.clearednames = true
+}
+
+// NamesCleared reports if the "names" edge to the PackageName entity was cleared.
+func (m *PackageNamespaceMutation) NamesCleared() bool {
+	return m.clearednames
+}
+
+// RemoveNameIDs removes the "names" edge to the PackageName entity by IDs.
+func (m *PackageNamespaceMutation) RemoveNameIDs(ids ...int) {
+	if m.removednames == nil {
+		m.removednames = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.names, ids[i])
+		m.removednames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNames returns the removed IDs of the "names" edge to the PackageName entity.
+func (m *PackageNamespaceMutation) RemovedNamesIDs() (ids []int) {
+	for id := range m.removednames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NamesIDs returns the "names" edge IDs in the mutation.
+func (m *PackageNamespaceMutation) NamesIDs() (ids []int) {
+	for id := range m.names {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNames resets all changes to the "names" edge.
+func (m *PackageNamespaceMutation) ResetNames() {
+	m.names = nil
+	m.clearednames = false
+	m.removednames = nil
+}
+
+// Where appends a list predicates to the PackageNamespaceMutation builder.
+func (m *PackageNamespaceMutation) Where(ps ...predicate.PackageNamespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PackageNamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PackageNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PackageNamespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PackageNamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PackageNamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PackageNamespace).
+func (m *PackageNamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PackageNamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m._package != nil {
+		fields = append(fields, packagenamespace.FieldPackageID)
+	}
+	if m.namespace != nil {
+		fields = append(fields, packagenamespace.FieldNamespace)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PackageNamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case packagenamespace.FieldPackageID:
+		return m.PackageID()
+	case packagenamespace.FieldNamespace:
+		return m.Namespace()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PackageNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case packagenamespace.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case packagenamespace.FieldNamespace:
+		return m.OldNamespace(ctx)
+	}
+	return nil, fmt.Errorf("unknown PackageNamespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageNamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case packagenamespace.FieldPackageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case packagenamespace.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PackageNamespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PackageNamespaceMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PackageNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageNamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PackageNamespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PackageNamespaceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PackageNamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PackageNamespaceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PackageNamespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PackageNamespaceMutation) ResetField(name string) error {
+	switch name {
+	case packagenamespace.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case packagenamespace.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageNamespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PackageNamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._package != nil {
+		edges = append(edges, packagenamespace.EdgePackage)
+	}
+	if m.names != nil {
+		edges = append(edges, packagenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PackageNamespaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case packagenamespace.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case packagenamespace.EdgeNames:
+		ids := make([]ent.Value, 0, len(m.names))
+		for id := range m.names {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PackageNamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removednames != nil {
+		edges = append(edges, packagenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PackageNamespaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case packagenamespace.EdgeNames:
+		ids := make([]ent.Value, 0, len(m.removednames))
+		for id := range m.removednames {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PackageNamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_package {
+		edges = append(edges, packagenamespace.EdgePackage)
+	}
+	if m.clearednames {
+		edges = append(edges, packagenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PackageNamespaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case packagenamespace.EdgePackage:
+		return m.cleared_package
+	case packagenamespace.EdgeNames:
+		return m.clearednames
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PackageNamespaceMutation) ClearEdge(name string) error {
+	switch name {
+	case packagenamespace.EdgePackage:
+		m.ClearPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageNamespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PackageNamespaceMutation) ResetEdge(name string) error {
+	switch name {
+	case packagenamespace.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case packagenamespace.EdgeNames:
+		m.ResetNames()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageNamespace edge %s", name)
+}
+
+// PackageTypeMutation represents an operation that mutates the PackageType nodes in the graph.
+type PackageTypeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	_type             *string
+	clearedFields     map[string]struct{}
+	namespaces        map[int]struct{}
+	removednamespaces map[int]struct{}
+	clearednamespaces bool
+	done              bool
+	oldValue          func(context.Context) (*PackageType, error)
+	predicates        []predicate.PackageType
+}
+
+var _ ent.Mutation = (*PackageTypeMutation)(nil)
+
+// packagetypeOption allows management of the mutation configuration using functional options.
+type packagetypeOption func(*PackageTypeMutation)
+
+// newPackageTypeMutation creates new mutation for the PackageType entity.
+func newPackageTypeMutation(c config, op Op, opts ...packagetypeOption) *PackageTypeMutation {
+	m := &PackageTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePackageType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPackageTypeID sets the ID field of the mutation.
+func withPackageTypeID(id int) packagetypeOption {
+	return func(m *PackageTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PackageType
+		)
+		m.oldValue = func(ctx context.Context) (*PackageType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PackageType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPackageType sets the old PackageType of the mutation.
+func withPackageType(node *PackageType) packagetypeOption {
+	return func(m *PackageTypeMutation) {
+		m.oldValue = func(context.Context) (*PackageType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PackageTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PackageTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PackageTypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PackageTypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PackageType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *PackageTypeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PackageTypeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the PackageType entity.
+// If the PackageType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageTypeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PackageTypeMutation) ResetType() {
+	m._type = nil
+}
+
+// AddNamespaceIDs adds the "namespaces" edge to the PackageNamespace entity by ids.
+func (m *PackageTypeMutation) AddNamespaceIDs(ids ...int) {
+	if m.namespaces == nil {
+		m.namespaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.namespaces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNamespaces clears the "namespaces" edge to the PackageNamespace entity.
+func (m *PackageTypeMutation) ClearNamespaces() {
+	m.clearednamespaces = true
+}
+
+// NamespacesCleared reports if the "namespaces" edge to the PackageNamespace entity was cleared.
+func (m *PackageTypeMutation) NamespacesCleared() bool {
+	return m.clearednamespaces
+}
+
+// RemoveNamespaceIDs removes the "namespaces" edge to the PackageNamespace entity by IDs.
+func (m *PackageTypeMutation) RemoveNamespaceIDs(ids ...int) {
+	if m.removednamespaces == nil {
+		m.removednamespaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.namespaces, ids[i])
+		m.removednamespaces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNamespaces returns the removed IDs of the "namespaces" edge to the PackageNamespace entity.
+func (m *PackageTypeMutation) RemovedNamespacesIDs() (ids []int) {
+	for id := range m.removednamespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NamespacesIDs returns the "namespaces" edge IDs in the mutation.
+func (m *PackageTypeMutation) NamespacesIDs() (ids []int) {
+	for id := range m.namespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNamespaces resets all changes to the "namespaces" edge.
+func (m *PackageTypeMutation) ResetNamespaces() {
+	m.namespaces = nil
+	m.clearednamespaces = false
+	m.removednamespaces = nil
+}
+
+// Where appends a list predicates to the PackageTypeMutation builder.
+func (m *PackageTypeMutation) Where(ps ...predicate.PackageType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PackageTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PackageTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PackageType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PackageTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PackageTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PackageType).
+func (m *PackageTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PackageTypeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m._type != nil {
+		fields = append(fields, packagetype.FieldType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PackageTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case packagetype.FieldType:
+		return m.GetType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PackageTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case packagetype.FieldType:
+		return m.OldType(ctx)
+	}
+	return nil, fmt.Errorf("unknown PackageType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case packagetype.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PackageType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PackageTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PackageTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PackageType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PackageTypeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PackageTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PackageTypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PackageType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PackageTypeMutation) ResetField(name string) error {
+	switch name {
+	case packagetype.FieldType:
+		m.ResetType()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PackageTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.namespaces != nil {
+		edges = append(edges, packagetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PackageTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case packagetype.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.namespaces))
+		for id := range m.namespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PackageTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removednamespaces != nil {
+		edges = append(edges, packagetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PackageTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case packagetype.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.removednamespaces))
+		for id := range m.removednamespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PackageTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednamespaces {
+		edges = append(edges, packagetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PackageTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case packagetype.EdgeNamespaces:
+		return m.clearednamespaces
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PackageTypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PackageType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PackageTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case packagetype.EdgeNamespaces:
+		m.ResetNamespaces()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageType edge %s", name)
+}
+
+// PackageVersionMutation represents an operation that mutates the PackageVersion nodes in the graph.
+type PackageVersionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	version          *string
+	subpath          *string
+	qualifiers       *[]model.PackageQualifier
+	appendqualifiers []model.PackageQualifier
+	qualifiers_key   *string
+	clearedFields    map[string]struct{}
+	name             *int
+	clearedname      bool
+	done             bool
+	oldValue         func(context.Context) (*PackageVersion, error)
+	predicates       []predicate.PackageVersion
+}
+
+var _ ent.Mutation = (*PackageVersionMutation)(nil)
+
+// packageversionOption allows management of the mutation configuration using functional options.
+type packageversionOption func(*PackageVersionMutation)
+
+// newPackageVersionMutation creates new mutation for the PackageVersion entity.
+func newPackageVersionMutation(c config, op Op, opts ...packageversionOption) *PackageVersionMutation {
+	m := &PackageVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePackageVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPackageVersionID sets the ID field of the mutation.
+func withPackageVersionID(id int) packageversionOption {
+	return func(m *PackageVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PackageVersion
+		)
+		m.oldValue = func(ctx context.Context) (*PackageVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PackageVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPackageVersion sets the old PackageVersion of the mutation.
+func withPackageVersion(node *PackageVersion) packageversionOption {
+	return func(m *PackageVersionMutation) {
+		m.oldValue = func(context.Context) (*PackageVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PackageVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PackageVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PackageVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PackageVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PackageVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNameID sets the "name_id" field.
+func (m *PackageVersionMutation) SetNameID(i int) {
+	m.name = &i
+}
+
+// NameID returns the value of the "name_id" field in the mutation.
+func (m *PackageVersionMutation) NameID() (r int, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameID returns the old "name_id" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldNameID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameID: %w", err)
+	}
+	return oldValue.NameID, nil
+}
+
+// ResetNameID resets all changes to the "name_id" field.
+func (m *PackageVersionMutation) ResetNameID() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PackageVersionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PackageVersionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PackageVersionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetSubpath sets the "subpath" field.
+func (m *PackageVersionMutation) SetSubpath(s string) {
+	m.subpath = &s
+}
+
+// Subpath returns the value of the "subpath" field in the mutation.
+func (m *PackageVersionMutation) Subpath() (r string, exists bool) {
+	v := m.subpath
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubpath returns the old "subpath" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldSubpath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubpath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubpath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubpath: %w", err)
+	}
+	return oldValue.Subpath, nil
+}
+
+// ResetSubpath resets all changes to the "subpath" field.
+func (m *PackageVersionMutation) ResetSubpath() {
+	m.subpath = nil
+}
+
+// SetQualifiers sets the "qualifiers" field.
+func (m *PackageVersionMutation) SetQualifiers(mq []model.PackageQualifier) {
+	m.qualifiers = &mq
+	m.appendqualifiers = nil
+}
+
+// Qualifiers returns the value of the "qualifiers" field in the mutation.
+func (m *PackageVersionMutation) Qualifiers() (r []model.PackageQualifier, exists bool) {
+	v := m.qualifiers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQualifiers returns the old "qualifiers" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldQualifiers(ctx context.Context) (v []model.PackageQualifier, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQualifiers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQualifiers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQualifiers: %w", err)
+	}
+	return oldValue.Qualifiers, nil
+}
+
+// AppendQualifiers adds mq to the "qualifiers" field.
+func (m *PackageVersionMutation) AppendQualifiers(mq []model.PackageQualifier) {
+	m.appendqualifiers = append(m.appendqualifiers, mq...)
+}
+
+// AppendedQualifiers returns the list of values that were appended to the "qualifiers" field in this mutation.
+func (m *PackageVersionMutation) AppendedQualifiers() ([]model.PackageQualifier, bool) {
+	if len(m.appendqualifiers) == 0 {
+		return nil, false
+	}
+	return m.appendqualifiers, true
+}
+
+// ResetQualifiers resets all changes to the "qualifiers" field.
+func (m *PackageVersionMutation) ResetQualifiers() {
+	m.qualifiers = nil
+	m.appendqualifiers = nil
+}
+
+// SetQualifiersKey sets the "qualifiers_key" field.
+func (m *PackageVersionMutation) SetQualifiersKey(s string) {
+	m.qualifiers_key = &s
+}
+
+// QualifiersKey returns the value of the "qualifiers_key" field in the mutation.
+func (m *PackageVersionMutation) QualifiersKey() (r string, exists bool) {
+	v := m.qualifiers_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQualifiersKey returns the old "qualifiers_key" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldQualifiersKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQualifiersKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQualifiersKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQualifiersKey: %w", err)
+	}
+	return oldValue.QualifiersKey, nil
+}
+
+// ResetQualifiersKey resets all changes to the "qualifiers_key" field.
+func (m *PackageVersionMutation) ResetQualifiersKey() {
+	m.qualifiers_key = nil
+}
+
+// ClearName clears the "name" edge to the PackageName entity.
+func (m *PackageVersionMutation) ClearName() {
+	m.clearedname = true
+}
+
+// NameCleared reports if the "name" edge to the PackageName entity was cleared.
+func (m *PackageVersionMutation) NameCleared() bool {
+	return m.clearedname
+}
+
+// NameIDs returns the "name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NameID instead. It exists only for internal usage by the builders.
+func (m *PackageVersionMutation) NameIDs() (ids []int) {
+	if id := m.name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetName resets all changes to the "name" edge.
+func (m *PackageVersionMutation) ResetName() {
+	m.name = nil
+	m.clearedname = false
+}
+
+// Where appends a list predicates to the PackageVersionMutation builder.
+func (m *PackageVersionMutation) Where(ps ...predicate.PackageVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PackageVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PackageVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PackageVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PackageVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PackageVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PackageVersion).
+func (m *PackageVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PackageVersionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, packageversion.FieldNameID)
+	}
+	if m.version != nil {
+		fields = append(fields, packageversion.FieldVersion)
+	}
+	if m.subpath != nil {
+		fields = append(fields, packageversion.FieldSubpath)
+	}
+	if m.qualifiers != nil {
+		fields = append(fields, packageversion.FieldQualifiers)
+	}
+	if m.qualifiers_key != nil {
+		fields = append(fields, packageversion.FieldQualifiersKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PackageVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case packageversion.FieldNameID:
+		return m.NameID()
+	case packageversion.FieldVersion:
+		return m.Version()
+	case packageversion.FieldSubpath:
+		return m.Subpath()
+	case packageversion.FieldQualifiers:
+		return m.Qualifiers()
+	case packageversion.FieldQualifiersKey:
+		return m.QualifiersKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PackageVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case packageversion.FieldNameID:
+		return m.OldNameID(ctx)
+	case packageversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case packageversion.FieldSubpath:
+		return m.OldSubpath(ctx)
+	case packageversion.FieldQualifiers:
+		return m.OldQualifiers(ctx)
+	case packageversion.FieldQualifiersKey:
+		return m.OldQualifiersKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown PackageVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case packageversion.FieldNameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameID(v)
+		return nil
+	case packageversion.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case packageversion.FieldSubpath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubpath(v)
+		return nil
+	case packageversion.FieldQualifiers:
+		v, ok := value.([]model.PackageQualifier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQualifiers(v)
+		return nil
+	case packageversion.FieldQualifiersKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQualifiersKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PackageVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PackageVersionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PackageVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackageVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PackageVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PackageVersionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PackageVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PackageVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PackageVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PackageVersionMutation) ResetField(name string) error {
+	switch name {
+	case packageversion.FieldNameID:
+		m.ResetNameID()
+		return nil
+	case packageversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case packageversion.FieldSubpath:
+		m.ResetSubpath()
+		return nil
+	case packageversion.FieldQualifiers:
+		m.ResetQualifiers()
+		return nil
+	case packageversion.FieldQualifiersKey:
+		m.ResetQualifiersKey()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PackageVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.name != nil {
+		edges = append(edges, packageversion.EdgeName)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PackageVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case packageversion.EdgeName:
+		if id := m.name; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PackageVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PackageVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PackageVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedname {
+		edges = append(edges, packageversion.EdgeName)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PackageVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case packageversion.EdgeName:
+		return m.clearedname
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PackageVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case packageversion.EdgeName:
+		m.ClearName()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PackageVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case packageversion.EdgeName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown PackageVersion edge %s", name)
+}
+
+// PkgEqualMutation represents an operation that mutates the PkgEqual nodes in the graph.
+type PkgEqualMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	justification    *string
+	origin           *string
+	collector        *string
+	clearedFields    map[string]struct{}
+	package_a        *int
+	clearedpackage_a bool
+	package_b        *int
+	clearedpackage_b bool
+	done             bool
+	oldValue         func(context.Context) (*PkgEqual, error)
+	predicates       []predicate.PkgEqual
+}
+
+var _ ent.Mutation = (*PkgEqualMutation)(nil)
+
+// pkgequalOption allows management of the mutation configuration using functional options.
+type pkgequalOption func(*PkgEqualMutation)
+
+// newPkgEqualMutation creates new mutation for the PkgEqual entity.
+func newPkgEqualMutation(c config, op Op, opts ...pkgequalOption) *PkgEqualMutation {
+	m := &PkgEqualMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePkgEqual,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPkgEqualID sets the ID field of the mutation.
+func withPkgEqualID(id int) pkgequalOption {
+	return func(m *PkgEqualMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PkgEqual
+		)
+		m.oldValue = func(ctx context.Context) (*PkgEqual, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PkgEqual.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPkgEqual sets the old PkgEqual of the mutation.
+func withPkgEqual(node *PkgEqual) pkgequalOption {
+	return func(m *PkgEqualMutation) {
+		m.oldValue = func(context.Context) (*PkgEqual, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PkgEqualMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PkgEqualMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PkgEqualMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PkgEqualMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PkgEqual.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageAID sets the "package_a_id" field.
+func (m *PkgEqualMutation) SetPackageAID(i int) {
+	m.package_a = &i
+}
+
+// PackageAID returns the value of the "package_a_id" field in the mutation.
+func (m *PkgEqualMutation) PackageAID() (r int, exists bool) {
+	v := m.package_a
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageAID returns the old "package_a_id" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldPackageAID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageAID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageAID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageAID: %w", err)
+	}
+	return oldValue.PackageAID, nil
+}
+
+// ResetPackageAID resets all changes to the "package_a_id" field.
+func (m *PkgEqualMutation) ResetPackageAID() {
+	m.package_a = nil
+}
+
+// SetPackageBID sets the "package_b_id" field.
+func (m *PkgEqualMutation) SetPackageBID(i int) {
+	m.package_b = &i
+}
+
+// PackageBID returns the value of the "package_b_id" field in the mutation.
+func (m *PkgEqualMutation) PackageBID() (r int, exists bool) {
+	v := m.package_b
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageBID returns the old "package_b_id" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldPackageBID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageBID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageBID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageBID: %w", err)
+	}
+	return oldValue.PackageBID, nil
+}
+
+// ResetPackageBID resets all changes to the "package_b_id" field.
+func (m *PkgEqualMutation) ResetPackageBID() {
+	m.package_b = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *PkgEqualMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *PkgEqualMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *PkgEqualMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *PkgEqualMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *PkgEqualMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *PkgEqualMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *PkgEqualMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *PkgEqualMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *PkgEqualMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackageA clears the "package_a" edge to the PackageVersion entity.
+func (m *PkgEqualMutation) ClearPackageA() {
+	m.clearedpackage_a = true
+}
+
+// PackageACleared reports if the "package_a" edge to the PackageVersion entity was cleared.
+func (m *PkgEqualMutation) PackageACleared() bool {
+	return m.clearedpackage_a
+}
+
+// PackageAIDs returns the "package_a" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageAID instead. It exists only for internal usage by the builders.
+func (m *PkgEqualMutation) PackageAIDs() (ids []int) {
+	if id := m.package_a; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageA resets all changes to the "package_a" edge.
+func (m *PkgEqualMutation) ResetPackageA() {
+	m.package_a = nil
+	m.clearedpackage_a = false
+}
+
+// ClearPackageB clears the "package_b" edge to the PackageVersion entity.
+func (m *PkgEqualMutation) ClearPackageB() {
+	m.clearedpackage_b = true
+}
+
+// PackageBCleared reports if the "package_b" edge to the PackageVersion entity was cleared.
+func (m *PkgEqualMutation) PackageBCleared() bool {
+	return m.clearedpackage_b
+}
+
+// PackageBIDs returns the "package_b" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageBID instead. It exists only for internal usage by the builders.
+func (m *PkgEqualMutation) PackageBIDs() (ids []int) {
+	if id := m.package_b; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageB resets all changes to the "package_b" edge.
+func (m *PkgEqualMutation) ResetPackageB() {
+	m.package_b = nil
+	m.clearedpackage_b = false
+}
+
+// Where appends a list predicates to the PkgEqualMutation builder.
+func (m *PkgEqualMutation) Where(ps ...predicate.PkgEqual) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PkgEqualMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PkgEqualMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PkgEqual, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PkgEqualMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PkgEqualMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PkgEqual).
+func (m *PkgEqualMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PkgEqualMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.package_a != nil {
+		fields = append(fields, pkgequal.FieldPackageAID)
+	}
+	if m.package_b != nil {
+		fields = append(fields, pkgequal.FieldPackageBID)
+	}
+	if m.justification != nil {
+		fields = append(fields, pkgequal.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, pkgequal.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, pkgequal.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PkgEqualMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pkgequal.FieldPackageAID:
+		return m.PackageAID()
+	case pkgequal.FieldPackageBID:
+		return m.PackageBID()
+	case pkgequal.FieldJustification:
+		return m.Justification()
+	case pkgequal.FieldOrigin:
+		return m.Origin()
+	case pkgequal.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PkgEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pkgequal.FieldPackageAID:
+		return m.OldPackageAID(ctx)
+	case pkgequal.FieldPackageBID:
+		return m.OldPackageBID(ctx)
+	case pkgequal.FieldJustification:
+		return m.OldJustification(ctx)
+	case pkgequal.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case pkgequal.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown PkgEqual field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PkgEqualMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pkgequal.FieldPackageAID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageAID(v)
+		return nil
+	case pkgequal.FieldPackageBID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageBID(v)
+		return nil
+	case pkgequal.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case pkgequal.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case pkgequal.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PkgEqual field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PkgEqualMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PkgEqualMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PkgEqualMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PkgEqual numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PkgEqualMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PkgEqualMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PkgEqualMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PkgEqual nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PkgEqualMutation) ResetField(name string) error {
+	switch name {
+	case pkgequal.FieldPackageAID:
+		m.ResetPackageAID()
+		return nil
+	case pkgequal.FieldPackageBID:
+		m.ResetPackageBID()
+		return nil
+	case pkgequal.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case pkgequal.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case pkgequal.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown PkgEqual field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PkgEqualMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.package_a != nil {
+		edges = append(edges, pkgequal.EdgePackageA)
+	}
+	if m.package_b != nil {
+		edges = append(edges, pkgequal.EdgePackageB)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PkgEqualMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pkgequal.EdgePackageA:
+		if id := m.package_a; id != nil {
+			return []ent.Value{*id}
+		}
+	case pkgequal.EdgePackageB:
+		if id := m.package_b; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PkgEqualMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PkgEqualMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PkgEqualMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpackage_a {
+		edges = append(edges, pkgequal.EdgePackageA)
+	}
+	if m.clearedpackage_b {
+		edges = append(edges, pkgequal.EdgePackageB)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PkgEqualMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pkgequal.EdgePackageA:
+		return m.clearedpackage_a
+	case pkgequal.EdgePackageB:
+		return m.clearedpackage_b
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PkgEqualMutation) ClearEdge(name string) error {
+	switch name {
+	case pkgequal.EdgePackageA:
+		m.ClearPackageA()
+		return nil
+	case pkgequal.EdgePackageB:
+		m.ClearPackageB()
+		return nil
+	}
+	return fmt.Errorf("unknown PkgEqual unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PkgEqualMutation) ResetEdge(name string) error {
+	switch name {
+	case pkgequal.EdgePackageA:
+		m.ResetPackageA()
+		return nil
+	case pkgequal.EdgePackageB:
+		m.ResetPackageB()
+		return nil
+	}
+	return fmt.Errorf("unknown PkgEqual edge %s", name)
+}
+
+// PointOfContactMutation represents an operation that mutates the PointOfContact nodes in the graph.
+type PointOfContactMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	email                  *string
+	info                   *string
+	since                  *time.Time
+	justification          *string
+	origin                 *string
+	collector              *string
+	clearedFields          map[string]struct{}
+	package_version        *int
+	clearedpackage_version bool
+	package_name           *int
+	clearedpackage_name    bool
+	source                 *int
+	clearedsource          bool
+	artifact               *int
+	clearedartifact        bool
+	done                   bool
+	oldValue               func(context.Context) (*PointOfContact, error)
+	predicates             []predicate.PointOfContact
+}
+
+var _ ent.Mutation = (*PointOfContactMutation)(nil)
+
+// pointofcontactOption allows management of the mutation configuration using functional options.
+type pointofcontactOption func(*PointOfContactMutation)
+
+// newPointOfContactMutation creates new mutation for the PointOfContact entity.
+func newPointOfContactMutation(c config, op Op, opts ...pointofcontactOption) *PointOfContactMutation {
+	m := &PointOfContactMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePointOfContact,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPointOfContactID sets the ID field of the mutation.
+func withPointOfContactID(id int) pointofcontactOption {
+	return func(m *PointOfContactMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PointOfContact
+		)
+		m.oldValue = func(ctx context.Context) (*PointOfContact, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PointOfContact.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPointOfContact sets the old PointOfContact of the mutation.
+func withPointOfContact(node *PointOfContact) pointofcontactOption {
+	return func(m *PointOfContactMutation) {
+		m.oldValue = func(context.Context) (*PointOfContact, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PointOfContactMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PointOfContactMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PointOfContactMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PointOfContactMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PointOfContact.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageVersionID sets the "package_version_id" field.
+func (m *PointOfContactMutation) SetPackageVersionID(i int) {
+	m.package_version = &i
+}
+
+// PackageVersionID returns the value of the "package_version_id" field in the mutation.
+func (m *PointOfContactMutation) PackageVersionID() (r int, exists bool) {
+	v := m.package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageVersionID returns the old "package_version_id" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldPackageVersionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageVersionID: %w", err)
+	}
+	return oldValue.PackageVersionID, nil
+}
+
+// ClearPackageVersionID clears the value of the "package_version_id" field.
+func (m *PointOfContactMutation) ClearPackageVersionID() {
+	m.package_version = nil
+	m.clearedFields[pointofcontact.FieldPackageVersionID] = struct{}{}
+}
+
+// PackageVersionIDCleared returns if the "package_version_id" field was cleared in this mutation.
+func (m *PointOfContactMutation) PackageVersionIDCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldPackageVersionID]
+	return ok
+}
+
+// ResetPackageVersionID resets all changes to the "package_version_id" field.
+func (m *PointOfContactMutation) ResetPackageVersionID() {
+	m.package_version = nil
+	delete(m.clearedFields, pointofcontact.FieldPackageVersionID)
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (m *PointOfContactMutation) SetPackageNameID(i int) {
+	m.package_name = &i
+}
+
+// PackageNameID returns the value of the "package_name_id" field in the mutation.
+func (m *PointOfContactMutation) PackageNameID() (r int, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageNameID returns the old "package_name_id" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldPackageNameID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageNameID: %w", err)
+	}
+	return oldValue.PackageNameID, nil
+}
+
+// ClearPackageNameID clears the value of the "package_name_id" field.
+func (m *PointOfContactMutation) ClearPackageNameID() {
+	m.package_name = nil
+	m.clearedFields[pointofcontact.FieldPackageNameID] = struct{}{}
+}
+
+// PackageNameIDCleared returns if the "package_name_id" field was cleared in this mutation.
+func (m *PointOfContactMutation) PackageNameIDCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldPackageNameID]
+	return ok
+}
+
+// ResetPackageNameID resets all changes to the "package_name_id" field.
+func (m *PointOfContactMutation) ResetPackageNameID() {
+	m.package_name = nil
+	delete(m.clearedFields, pointofcontact.FieldPackageNameID)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *PointOfContactMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *PointOfContactMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldSourceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *PointOfContactMutation) ClearSourceID() {
+	m.source = nil
+	m.clearedFields[pointofcontact.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *PointOfContactMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *PointOfContactMutation) ResetSourceID() {
+	m.source = nil
+	delete(m.clearedFields, pointofcontact.FieldSourceID)
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *PointOfContactMutation) SetArtifactID(i int) {
+	m.artifact = &i
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *PointOfContactMutation) ArtifactID() (r int, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldArtifactID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ClearArtifactID clears the value of the "artifact_id" field.
+func (m *PointOfContactMutation) ClearArtifactID() {
+	m.artifact = nil
+	m.clearedFields[pointofcontact.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactIDCleared returns if the "artifact_id" field was cleared in this mutation.
+func (m *PointOfContactMutation) ArtifactIDCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldArtifactID]
+	return ok
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *PointOfContactMutation) ResetArtifactID() {
+	m.artifact = nil
+	delete(m.clearedFields, pointofcontact.FieldArtifactID)
+}
+
+// SetEmail sets the "email" field.
+func (m *PointOfContactMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PointOfContactMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PointOfContactMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetInfo sets the "info" field.
+func (m *PointOfContactMutation) SetInfo(s string) {
+	m.info = &s
+}
+
+// Info returns the value of the "info" field in the mutation.
+func (m *PointOfContactMutation) Info() (r string, exists bool) {
+	v := m.info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfo returns the old "info" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldInfo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfo: %w", err)
+	}
+	return oldValue.Info, nil
+}
+
+// ResetInfo resets all changes to the "info" field.
+func (m *PointOfContactMutation) ResetInfo() {
+	m.info = nil
+}
+
+// SetSince sets the "since" field.
+func (m *PointOfContactMutation) SetSince(t time.Time) {
+	m.since = &t
+}
+
+// Since returns the value of the "since" field in the mutation.
+func (m *PointOfContactMutation) Since() (r time.Time, exists bool) {
+	v := m.since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSince returns the old "since" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldSince(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSince: %w", err)
+	}
+	return oldValue.Since, nil
+}
+
+// ResetSince resets all changes to the "since" field.
+func (m *PointOfContactMutation) ResetSince() {
+	m.since = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *PointOfContactMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *PointOfContactMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *PointOfContactMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *PointOfContactMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *PointOfContactMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *PointOfContactMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *PointOfContactMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *PointOfContactMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *PointOfContactMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
+func (m *PointOfContactMutation) ClearPackageVersion() {
+	m.clearedpackage_version = true
+}
+
+// PackageVersionCleared reports if the "package_version" edge to the PackageVersion entity was cleared.
+func (m *PointOfContactMutation) PackageVersionCleared() bool {
+	return m.PackageVersionIDCleared() || m.clearedpackage_version
+}
+
+// PackageVersionIDs returns the "package_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageVersionID instead. It exists only for internal usage by the builders.
+func (m *PointOfContactMutation) PackageVersionIDs() (ids []int) {
+	if id := m.package_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageVersion resets all changes to the "package_version" edge.
+func (m *PointOfContactMutation) ResetPackageVersion() {
+	m.package_version = nil
+	m.clearedpackage_version = false
+}
+
+// ClearPackageName clears the "package_name" edge to the PackageName entity.
+func (m *PointOfContactMutation) ClearPackageName() {
+	m.clearedpackage_name = true
+}
+
+// PackageNameCleared reports if the "package_name" edge to the PackageName entity was cleared.
+func (m *PointOfContactMutation) PackageNameCleared() bool {
+	return m.PackageNameIDCleared() || m.clearedpackage_name
+}
+
+// PackageNameIDs returns the "package_name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageNameID instead. It exists only for internal usage by the builders.
+func (m *PointOfContactMutation) PackageNameIDs() (ids []int) {
+	if id := m.package_name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackageName resets all changes to the "package_name" edge.
+func (m *PointOfContactMutation) ResetPackageName() {
+	m.package_name = nil
+	m.clearedpackage_name = false
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *PointOfContactMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *PointOfContactMutation) SourceCleared() bool {
+	return m.SourceIDCleared() || m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *PointOfContactMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *PointOfContactMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *PointOfContactMutation) ClearArtifact() {
+	m.clearedartifact = true
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *PointOfContactMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *PointOfContactMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *PointOfContactMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the PointOfContactMutation builder.
+func (m *PointOfContactMutation) Where(ps ...predicate.PointOfContact) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PointOfContactMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PointOfContactMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PointOfContact, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PointOfContactMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PointOfContactMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PointOfContact).
+func (m *PointOfContactMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PointOfContactMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.package_version != nil {
+		fields = append(fields, pointofcontact.FieldPackageVersionID)
+	}
+	if m.package_name != nil {
+		fields = append(fields, pointofcontact.FieldPackageNameID)
+	}
+	if m.source != nil {
+		fields = append(fields, pointofcontact.FieldSourceID)
+	}
+	if m.artifact != nil {
+		fields = append(fields, pointofcontact.FieldArtifactID)
+	}
+	if m.email != nil {
+		fields = append(fields, pointofcontact.FieldEmail)
+	}
+	if m.info != nil {
+		fields = append(fields, pointofcontact.FieldInfo)
+	}
+	if m.since != nil {
+		fields = append(fields, pointofcontact.FieldSince)
+	}
+	if m.justification != nil {
+		fields = append(fields, pointofcontact.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, pointofcontact.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, pointofcontact.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PointOfContactMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pointofcontact.FieldPackageVersionID:
+		return m.PackageVersionID()
+	case pointofcontact.FieldPackageNameID:
+		return m.PackageNameID()
+	case pointofcontact.FieldSourceID:
+		return m.SourceID()
+	case pointofcontact.FieldArtifactID:
+		return m.ArtifactID()
+	case pointofcontact.FieldEmail:
+		return m.Email()
+	case pointofcontact.FieldInfo:
+		return m.Info()
+	case pointofcontact.FieldSince:
+		return m.Since()
+	case pointofcontact.FieldJustification:
+		return m.Justification()
+	case pointofcontact.FieldOrigin:
+		return m.Origin()
+	case pointofcontact.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PointOfContactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pointofcontact.FieldPackageVersionID:
+		return m.OldPackageVersionID(ctx)
+	case pointofcontact.FieldPackageNameID:
+		return m.OldPackageNameID(ctx)
+	case pointofcontact.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case pointofcontact.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case pointofcontact.FieldEmail:
+		return m.OldEmail(ctx)
+	case pointofcontact.FieldInfo:
+		return m.OldInfo(ctx)
+	case pointofcontact.FieldSince:
+		return m.OldSince(ctx)
+	case pointofcontact.FieldJustification:
+		return m.OldJustification(ctx)
+	case pointofcontact.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case pointofcontact.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown PointOfContact field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointOfContactMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pointofcontact.FieldPackageVersionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageVersionID(v)
+		return nil
+	case pointofcontact.FieldPackageNameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageNameID(v)
+		return nil
+	case pointofcontact.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case pointofcontact.FieldArtifactID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case pointofcontact.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case pointofcontact.FieldInfo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfo(v)
+		return nil
+	case pointofcontact.FieldSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSince(v)
+		return nil
+	case pointofcontact.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case pointofcontact.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case pointofcontact.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfContact field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PointOfContactMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PointOfContactMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointOfContactMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PointOfContact numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PointOfContactMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pointofcontact.FieldPackageVersionID) {
+		fields = append(fields, pointofcontact.FieldPackageVersionID)
+	}
+	if m.FieldCleared(pointofcontact.FieldPackageNameID) {
+		fields = append(fields, pointofcontact.FieldPackageNameID)
+	}
+	if m.FieldCleared(pointofcontact.FieldSourceID) {
+		fields = append(fields, pointofcontact.FieldSourceID)
+	}
+	if m.FieldCleared(pointofcontact.FieldArtifactID) {
+		fields = append(fields, pointofcontact.FieldArtifactID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PointOfContactMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PointOfContactMutation) ClearField(name string) error {
+	switch name {
+	case pointofcontact.FieldPackageVersionID:
+		m.ClearPackageVersionID()
+		return nil
+	case pointofcontact.FieldPackageNameID:
+		m.ClearPackageNameID()
+		return nil
+	case pointofcontact.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case pointofcontact.FieldArtifactID:
+		m.ClearArtifactID()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfContact nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PointOfContactMutation) ResetField(name string) error {
+	switch name {
+	case pointofcontact.FieldPackageVersionID:
+		m.ResetPackageVersionID()
+		return nil
+	case pointofcontact.FieldPackageNameID:
+		m.ResetPackageNameID()
+		return nil
+	case pointofcontact.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case pointofcontact.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case pointofcontact.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case pointofcontact.FieldInfo:
+		m.ResetInfo()
+		return nil
+	case pointofcontact.FieldSince:
+		m.ResetSince()
+		return nil
+	case pointofcontact.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case pointofcontact.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case pointofcontact.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfContact field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PointOfContactMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.package_version != nil {
+		edges = append(edges, pointofcontact.EdgePackageVersion)
+	}
+	if m.package_name != nil {
+		edges = append(edges, pointofcontact.EdgePackageName)
+	}
+	if m.source != nil {
+		edges = append(edges, pointofcontact.EdgeSource)
+	}
+	if m.artifact != nil {
+		edges = append(edges, pointofcontact.EdgeArtifact)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PointOfContactMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pointofcontact.EdgePackageVersion:
+		if id := m.package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case pointofcontact.EdgePackageName:
+		if id := m.package_name; id != nil {
+			return []ent.Value{*id}
+		}
+	case pointofcontact.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case pointofcontact.EdgeArtifact:
+		if id := m.artifact; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PointOfContactMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PointOfContactMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PointOfContactMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpackage_version {
+		edges = append(edges, pointofcontact.EdgePackageVersion)
+	}
+	if m.clearedpackage_name {
+		edges = append(edges, pointofcontact.EdgePackageName)
+	}
+	if m.clearedsource {
+		edges = append(edges, pointofcontact.EdgeSource)
+	}
+	if m.clearedartifact {
+		edges = append(edges, pointofcontact.EdgeArtifact)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PointOfContactMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pointofcontact.EdgePackageVersion:
+		return m.clearedpackage_version
+	case pointofcontact.EdgePackageName:
+		return m.clearedpackage_name
+	case pointofcontact.EdgeSource:
+		return m.clearedsource
+	case pointofcontact.EdgeArtifact:
+		return m.clearedartifact
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PointOfContactMutation) ClearEdge(name string) error {
+	switch name {
+	case pointofcontact.EdgePackageVersion:
+		m.ClearPackageVersion()
+		return nil
+	case pointofcontact.EdgePackageName:
+		m.ClearPackageName()
+		return nil
+	case pointofcontact.EdgeSource:
+		m.ClearSource()
+		return nil
+	case pointofcontact.EdgeArtifact:
+		m.ClearArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfContact unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PointOfContactMutation) ResetEdge(name string) error {
+	switch name {
+	case pointofcontact.EdgePackageVersion:
+		m.ResetPackageVersion()
+		return nil
+	case pointofcontact.EdgePackageName:
+		m.ResetPackageName()
+		return nil
+	case pointofcontact.EdgeSource:
+		m.ResetSource()
+		return nil
+	case pointofcontact.EdgeArtifact:
+		m.ResetArtifact()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfContact edge %s", name)
+}
+
+// ScorecardMutation represents an operation that mutates the Scorecard nodes in the graph.
+type ScorecardMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	checks             *[]model.ScorecardCheck
+	appendchecks       []model.ScorecardCheck
+	aggregate_score    *float64
+	addaggregate_score *float64
+	time_scanned       *time.Time
+	scorecard_version  *string
+	commit             *string
+	origin             *string
+	collector          *string
+	clearedFields      map[string]struct{}
+	source             *int
+	clearedsource      bool
+	done               bool
+	oldValue           func(context.Context) (*Scorecard, error)
+	predicates         []predicate.Scorecard
+}
+
+var _ ent.Mutation = (*ScorecardMutation)(nil)
+
+// scorecardOption allows management of the mutation configuration using functional options.
+type scorecardOption func(*ScorecardMutation)
+
+// newScorecardMutation creates new mutation for the Scorecard entity.
+func newScorecardMutation(c config, op Op, opts ...scorecardOption) *ScorecardMutation {
+	m := &ScorecardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScorecard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScorecardID sets the ID field of the mutation.
+func withScorecardID(id int) scorecardOption {
+	return func(m *ScorecardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Scorecard
+		)
+		m.oldValue = func(ctx context.Context) (*Scorecard, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Scorecard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScorecard sets the old Scorecard of the mutation.
+func withScorecard(node *Scorecard) scorecardOption {
+	return func(m *ScorecardMutation) {
+		m.oldValue = func(context.Context) (*Scorecard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScorecardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScorecardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScorecardMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScorecardMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Scorecard.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *ScorecardMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *ScorecardMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldSourceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *ScorecardMutation) ResetSourceID() {
+	m.source = nil
+}
+
+// SetChecks sets the "checks" field.
+func (m *ScorecardMutation) SetChecks(mc []model.ScorecardCheck) {
+	m.checks = &mc
+	m.appendchecks = nil
+}
+
+// Checks returns the value of the "checks" field in the mutation.
+func (m *ScorecardMutation) Checks() (r []model.ScorecardCheck, exists bool) {
+	v := m.checks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecks returns the old "checks" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldChecks(ctx context.Context) (v []model.ScorecardCheck, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecks: %w", err)
+	}
+	return oldValue.Checks, nil
+}
+
+// AppendChecks adds mc to the "checks" field.
+func (m *ScorecardMutation) AppendChecks(mc []model.ScorecardCheck) {
+	m.appendchecks = append(m.appendchecks, mc...)
+}
+
+// AppendedChecks returns the list of values that were appended to the "checks" field in this mutation.
+func (m *ScorecardMutation) AppendedChecks() ([]model.ScorecardCheck, bool) {
+	if len(m.appendchecks) == 0 {
+		return nil, false
+	}
+	return m.appendchecks, true
+}
+
+// ResetChecks resets all changes to the "checks" field.
+func (m *ScorecardMutation) ResetChecks() {
+	m.checks = nil
+	m.appendchecks = nil
+}
+
+// SetAggregateScore sets the "aggregate_score" field.
+func (m *ScorecardMutation) SetAggregateScore(f float64) {
+	m.aggregate_score = &f
+	m.addaggregate_score = nil
+}
+
+// AggregateScore returns the value of the "aggregate_score" field in the mutation.
+func (m *ScorecardMutation) AggregateScore() (r float64, exists bool) {
+	v := m.aggregate_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateScore returns the old "aggregate_score" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldAggregateScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateScore: %w", err)
+	}
+	return oldValue.AggregateScore, nil
+}
+
+// AddAggregateScore adds f to the "aggregate_score" field.
+func (m *ScorecardMutation) AddAggregateScore(f float64) {
+	if m.addaggregate_score != nil {
+		*m.addaggregate_score += f
+	} else {
+		m.addaggregate_score = &f
+	}
+}
+
+// AddedAggregateScore returns the value that was added to the "aggregate_score" field in this mutation.
+func (m *ScorecardMutation) AddedAggregateScore() (r float64, exists bool) {
+	v := m.addaggregate_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAggregateScore resets all changes to the "aggregate_score" field.
+func (m *ScorecardMutation) ResetAggregateScore() {
+	m.aggregate_score = nil
+	m.addaggregate_score = nil
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (m *ScorecardMutation) SetTimeScanned(t time.Time) {
+	m.time_scanned = &t
+}
+
+// TimeScanned returns the value of the "time_scanned" field in the mutation.
+func (m *ScorecardMutation) TimeScanned() (r time.Time, exists bool) {
+	v := m.time_scanned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeScanned returns the old "time_scanned" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldTimeScanned(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeScanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeScanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeScanned: %w", err)
+	}
+	return oldValue.TimeScanned, nil
+}
+
+// ResetTimeScanned resets all changes to the "time_scanned" field.
+func (m *ScorecardMutation) ResetTimeScanned() {
+	m.time_scanned = nil
+}
+
+// SetScorecardVersion sets the "scorecard_version" field.
+func (m *ScorecardMutation) SetScorecardVersion(s string) {
+	m.scorecard_version = &s
+}
+
+// ScorecardVersion returns the value of the "scorecard_version" field in the mutation.
+func (m *ScorecardMutation) ScorecardVersion() (r string, exists bool) {
+	v := m.scorecard_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScorecardVersion returns the old "scorecard_version" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldScorecardVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScorecardVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScorecardVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScorecardVersion: %w", err)
+	}
+	return oldValue.ScorecardVersion, nil
+}
+
+// ResetScorecardVersion resets all changes to the "scorecard_version" field.
+func (m *ScorecardMutation) ResetScorecardVersion() {
+	m.scorecard_version = nil
+}
+
+// SetCommit sets the "commit" field.
+func (m *ScorecardMutation) SetCommit(s string) {
+	m.commit = &s
+}
+
+// Commit returns the value of the "commit" field in the mutation.
+func (m *ScorecardMutation) Commit() (r string, exists bool) {
+	v := m.commit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommit returns the old "commit" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldCommit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommit: %w", err)
+	}
+	return oldValue.Commit, nil
+}
+
+// ResetCommit resets all changes to the "commit" field.
+func (m *ScorecardMutation) ResetCommit() {
+	m.commit = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *ScorecardMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *ScorecardMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *ScorecardMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *ScorecardMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *ScorecardMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *ScorecardMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearSource clears the "source" edge to the SourceName entity.
+func (m *ScorecardMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceName entity was cleared.
+func (m *ScorecardMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *ScorecardMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *ScorecardMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// Where appends a list predicates to the ScorecardMutation builder.
+func (m *ScorecardMutation) Where(ps ...predicate.Scorecard) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScorecardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScorecardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Scorecard, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScorecardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScorecardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Scorecard).
+func (m *ScorecardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScorecardMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.source != nil {
+		fields = append(fields, scorecard.FieldSourceID)
+	}
+	if m.checks != nil {
+		fields = append(fields, scorecard.FieldChecks)
+	}
+	if m.aggregate_score != nil {
+		fields = append(fields, scorecard.FieldAggregateScore)
+	}
+	if m.time_scanned != nil {
+		fields = append(fields, scorecard.FieldTimeScanned)
+	}
+	if m.scorecard_version != nil {
+		fields = append(fields, scorecard.FieldScorecardVersion)
+	}
+	if m.commit != nil {
+		fields = append(fields, scorecard.FieldCommit)
+	}
+	if m.origin != nil {
+		fields = append(fields, scorecard.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, scorecard.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScorecardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scorecard.FieldSourceID:
+		return m.SourceID()
+	case scorecard.FieldChecks:
+		return m.Checks()
+	case scorecard.FieldAggregateScore:
+		return m.AggregateScore()
+	case scorecard.FieldTimeScanned:
+		return m.TimeScanned()
+	case scorecard.FieldScorecardVersion:
+		return m.ScorecardVersion()
+	case scorecard.FieldCommit:
+		return m.Commit()
+	case scorecard.FieldOrigin:
+		return m.Origin()
+	case scorecard.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScorecardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scorecard.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case scorecard.FieldChecks:
+		return m.OldChecks(ctx)
+	case scorecard.FieldAggregateScore:
+		return m.OldAggregateScore(ctx)
+	case scorecard.FieldTimeScanned:
+		return m.OldTimeScanned(ctx)
+	case scorecard.FieldScorecardVersion:
+		return m.OldScorecardVersion(ctx)
+	case scorecard.FieldCommit:
+		return m.OldCommit(ctx)
+	case scorecard.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case scorecard.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown Scorecard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScorecardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scorecard.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case scorecard.FieldChecks:
+		v, ok := value.([]model.ScorecardCheck)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecks(v)
+		return nil
+	case scorecard.FieldAggregateScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateScore(v)
+		return nil
+	case scorecard.FieldTimeScanned:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeScanned(v)
+		return nil
+	case scorecard.FieldScorecardVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScorecardVersion(v)
+		return nil
+	case scorecard.FieldCommit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommit(v)
+		return nil
+	case scorecard.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case scorecard.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Scorecard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScorecardMutation) AddedFields() []string {
+	var fields []string
+	if m.addaggregate_score != nil {
+		fields = append(fields, scorecard.FieldAggregateScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScorecardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scorecard.FieldAggregateScore:
+		return m.AddedAggregateScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScorecardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case scorecard.FieldAggregateScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAggregateScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Scorecard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScorecardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScorecardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScorecardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Scorecard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScorecardMutation) ResetField(name string) error {
+	switch name {
+	case scorecard.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case scorecard.FieldChecks:
+		m.ResetChecks()
+		return nil
+	case scorecard.FieldAggregateScore:
+		m.ResetAggregateScore()
+		return nil
+	case scorecard.FieldTimeScanned:
+		m.ResetTimeScanned()
+		return nil
+	case scorecard.FieldScorecardVersion:
+		m.ResetScorecardVersion()
+		return nil
+	case scorecard.FieldCommit:
+		m.ResetCommit()
+		return nil
+	case scorecard.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case scorecard.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown Scorecard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScorecardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.source != nil {
+		edges = append(edges, scorecard.EdgeSource)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScorecardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scorecard.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScorecardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScorecardMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScorecardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsource {
+		edges = append(edges, scorecard.EdgeSource)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScorecardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scorecard.EdgeSource:
+		return m.clearedsource
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScorecardMutation) ClearEdge(name string) error {
+	switch name {
+	case scorecard.EdgeSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown Scorecard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScorecardMutation) ResetEdge(name string) error {
+	switch name {
+	case scorecard.EdgeSource:
+		m.ResetSource()
+		return nil
+	}
+	return fmt.Errorf("unknown Scorecard edge %s", name)
+}
+
+// SourceNameMutation represents an operation that mutates the SourceName nodes in the graph.
+type SourceNameMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	tag              *string
+	commit           *string
+	clearedFields    map[string]struct{}
+	namespace        *int
+	clearednamespace bool
+	done             bool
+	oldValue         func(context.Context) (*SourceName, error)
+	predicates       []predicate.SourceName
+}
+
+var _ ent.Mutation = (*SourceNameMutation)(nil)
+
+// sourcenameOption allows management of the mutation configuration using functional options.
+type sourcenameOption func(*SourceNameMutation)
+
+// newSourceNameMutation creates new mutation for the SourceName entity.
+func newSourceNameMutation(c config, op Op, opts ...sourcenameOption) *SourceNameMutation {
+	m := &SourceNameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceName,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceNameID sets the ID field of the mutation.
+func withSourceNameID(id int) sourcenameOption {
+	return func(m *SourceNameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceName
+		)
+		m.oldValue = func(ctx context.Context) (*SourceName, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceName.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceName sets the old SourceName of the mutation.
+func withSourceName(node *SourceName) sourcenameOption {
+	return func(m *SourceNameMutation) {
+		m.oldValue = func(context.Context) (*SourceName, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceNameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceNameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceNameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceNameMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceName.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (m *SourceNameMutation) SetNamespaceID(i int) {
+	m.namespace = &i
+}
+
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *SourceNameMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespaceID returns the old "namespace_id" field's value of the SourceName entity.
+// If the SourceName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNameMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+	}
+	return oldValue.NamespaceID, nil
+}
+
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *SourceNameMutation) ResetNamespaceID() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *SourceNameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SourceNameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SourceName entity.
+// If the SourceName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SourceNameMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTag sets the "tag" field.
+func (m *SourceNameMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the value of the "tag" field in the mutation.
+func (m *SourceNameMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old "tag" field's value of the SourceName entity.
+// If the SourceName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNameMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ResetTag resets all changes to the "tag" field.
+func (m *SourceNameMutation) ResetTag() {
+	m.tag = nil
+}
+
+// SetCommit sets the "commit" field.
+func (m *SourceNameMutation) SetCommit(s string) {
+	m.commit = &s
+}
+
+// Commit returns the value of the "commit" field in the mutation.
+func (m *SourceNameMutation) Commit() (r string, exists bool) {
+	v := m.commit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommit returns the old "commit" field's value of the SourceName entity.
+// If the SourceName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNameMutation) OldCommit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommit: %w", err)
+	}
+	return oldValue.Commit, nil
+}
+
+// ResetCommit resets all changes to the "commit" field.
+func (m *SourceNameMutation) ResetCommit() {
+	m.commit = nil
+}
+
+// ClearNamespace clears the "namespace" edge to the SourceNamespace entity.
+func (m *SourceNameMutation) ClearNamespace() {
+	m.clearednamespace = true
+}
+
+// NamespaceCleared reports if the "namespace" edge to the SourceNamespace entity was cleared.
+func (m *SourceNameMutation) NamespaceCleared() bool {
+	return m.clearednamespace
+}
+
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *SourceNameMutation) NamespaceIDs() (ids []int) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *SourceNameMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
+}
+
+// Where appends a list predicates to the SourceNameMutation builder.
+func (m *SourceNameMutation) Where(ps ...predicate.SourceName) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceNameMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceNameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceName, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceNameMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceNameMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SourceName).
+func (m *SourceNameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceNameMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.namespace != nil {
+		fields = append(fields, sourcename.FieldNamespaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, sourcename.FieldName)
+	}
+	if m.tag != nil {
+		fields = append(fields, sourcename.FieldTag)
+	}
+	if m.commit != nil {
+		fields = append(fields, sourcename.FieldCommit)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceNameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sourcename.FieldNamespaceID:
+		return m.NamespaceID()
+	case sourcename.FieldName:
+		return m.Name()
+	case sourcename.FieldTag:
+		return m.Tag()
+	case sourcename.FieldCommit:
+		return m.Commit()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sourcename.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case sourcename.FieldName:
+		return m.OldName(ctx)
+	case sourcename.FieldTag:
+		return m.OldTag(ctx)
+	case sourcename.FieldCommit:
+		return m.OldCommit(ctx)
+	}
+	return nil, fmt.Errorf("unknown SourceName field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceNameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sourcename.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case sourcename.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sourcename.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case sourcename.FieldCommit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceName field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceNameMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceNameMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceNameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SourceName numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceNameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceNameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SourceName nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceNameMutation) ResetField(name string) error {
+	switch name {
+	case sourcename.FieldNamespaceID:
+		m.ResetNamespaceID()
+		return nil
+	case sourcename.FieldName:
+		m.ResetName()
+		return nil
+	case sourcename.FieldTag:
+		m.ResetTag()
+		return nil
+	case sourcename.FieldCommit:
+		m.ResetCommit()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceName field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.namespace != nil {
+		edges = append(edges, sourcename.EdgeNamespace)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceNameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sourcename.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceNameMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednamespace {
+		edges = append(edges, sourcename.EdgeNamespace)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceNameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sourcename.EdgeNamespace:
+		return m.clearednamespace
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceNameMutation) ClearEdge(name string) error {
+	switch name {
+	case sourcename.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceName unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceNameMutation) ResetEdge(name string) error {
+	switch name {
+	case sourcename.EdgeNamespace:
+		m.ResetNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceName edge %s", name)
+}
+
+// SourceNamespaceMutation represents an operation that mutates the SourceNamespace nodes in the graph.
+type SourceNamespaceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	clearedFields map[string]struct{}
+	source        *int
+	clearedsource bool
+	names         map[int]struct{}
+	removednames  map[int]struct{}
+	clearednames  bool
+	done          bool
+	oldValue      func(context.Context) (*SourceNamespace, error)
+	predicates    []predicate.SourceNamespace
+}
+
+var _ ent.Mutation = (*SourceNamespaceMutation)(nil)
+
+// sourcenamespaceOption allows management of the mutation configuration using functional options.
+type sourcenamespaceOption func(*SourceNamespaceMutation)
+
+// newSourceNamespaceMutation creates new mutation for the SourceNamespace entity.
+func newSourceNamespaceMutation(c config, op Op, opts ...sourcenamespaceOption) *SourceNamespaceMutation {
+	m := &SourceNamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceNamespace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceNamespaceID sets the ID field of the mutation.
+func withSourceNamespaceID(id int) sourcenamespaceOption {
+	return func(m *SourceNamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceNamespace
+		)
+		m.oldValue = func(ctx context.Context) (*SourceNamespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceNamespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceNamespace sets the old SourceNamespace of the mutation.
+func withSourceNamespace(node *SourceNamespace) sourcenamespaceOption {
+	return func(m *SourceNamespaceMutation) {
+		m.oldValue = func(context.Context) (*SourceNamespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceNamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceNamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceNamespaceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceNamespaceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceNamespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *SourceNamespaceMutation) SetSourceID(i int) {
+	m.source = &i
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *SourceNamespaceMutation) SourceID() (r int, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the SourceNamespace entity.
+// If the SourceNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNamespaceMutation) OldSourceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *SourceNamespaceMutation) ResetSourceID() {
+	m.source = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *SourceNamespaceMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *SourceNamespaceMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the SourceNamespace entity.
+// If the SourceNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNamespaceMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *SourceNamespaceMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// ClearSource clears the "source" edge to the SourceType entity.
+func (m *SourceNamespaceMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the SourceType entity was cleared.
+func (m *SourceNamespaceMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *SourceNamespaceMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *SourceNamespaceMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// AddNameIDs adds the "names" edge to the SourceName entity by ids.
+func (m *SourceNamespaceMutation) AddNameIDs(ids ...int) {
+	if m.names == nil {
+		m.names = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.names[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNames clears the "names" edge to the SourceName entity.
+func (m *SourceNamespaceMutation) ClearNames() {
+	m.clearednames = true
+}
+
+// NamesCleared reports if the "names" edge to the SourceName entity was cleared.
+func (m *SourceNamespaceMutation) NamesCleared() bool {
+	return m.clearednames
+}
+
+// RemoveNameIDs removes the "names" edge to the SourceName entity by IDs.
+func (m *SourceNamespaceMutation) RemoveNameIDs(ids ...int) {
+	if m.removednames == nil {
+		m.removednames = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.names, ids[i])
+		m.removednames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNames returns the removed IDs of the "names" edge to the SourceName entity.
+func (m *SourceNamespaceMutation) RemovedNamesIDs() (ids []int) {
+	for id := range m.removednames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NamesIDs returns the "names" edge IDs in the mutation.
+func (m *SourceNamespaceMutation) NamesIDs() (ids []int) {
+	for id := range m.names {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNames resets all changes to the "names" edge.
+func (m *SourceNamespaceMutation) ResetNames() {
+	m.names = nil
+	m.clearednames = false
+	m.removednames = nil
+}
+
+// Where appends a list predicates to the SourceNamespaceMutation builder.
+func (m *SourceNamespaceMutation) Where(ps ...predicate.SourceNamespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceNamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceNamespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceNamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceNamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SourceNamespace).
+func (m *SourceNamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceNamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.source != nil {
+		fields = append(fields, sourcenamespace.FieldSourceID)
+	}
+	if m.namespace != nil {
+		fields = append(fields, sourcenamespace.FieldNamespace)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceNamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sourcenamespace.FieldSourceID:
+		return m.SourceID()
+	case sourcenamespace.FieldNamespace:
+		return m.Namespace()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sourcenamespace.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case sourcenamespace.FieldNamespace:
+		return m.OldNamespace(ctx)
+	}
+	return nil, fmt.Errorf("unknown SourceNamespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceNamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sourcenamespace.FieldSourceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case sourcenamespace.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceNamespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceNamespaceMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceNamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SourceNamespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceNamespaceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceNamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceNamespaceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SourceNamespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceNamespaceMutation) ResetField(name string) error {
+	switch name {
+	case sourcenamespace.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case sourcenamespace.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceNamespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceNamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.source != nil {
+		edges = append(edges, sourcenamespace.EdgeSource)
+	}
+	if m.names != nil {
+		edges = append(edges, sourcenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceNamespaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sourcenamespace.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case sourcenamespace.EdgeNames:
+		ids := make([]ent.Value, 0, len(m.names))
+		for id := range m.names {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceNamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removednames != nil {
+		edges = append(edges, sourcenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceNamespaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sourcenamespace.EdgeNames:
+		ids := make([]ent.Value, 0, len(m.removednames))
+		for id := range m.removednames {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceNamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsource {
+		edges = append(edges, sourcenamespace.EdgeSource)
+	}
+	if m.clearednames {
+		edges = append(edges, sourcenamespace.EdgeNames)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceNamespaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sourcenamespace.EdgeSource:
+		return m.clearedsource
+	case sourcenamespace.EdgeNames:
+		return m.clearednames
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceNamespaceMutation) ClearEdge(name string) error {
+	switch name {
+	case sourcenamespace.EdgeSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceNamespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceNamespaceMutation) ResetEdge(name string) error {
+	switch name {
+	case sourcenamespace.EdgeSource:
+		m.ResetSource()
+		return nil
+	case sourcenamespace.EdgeNames:
+		m.ResetNames()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceNamespace edge %s", name)
+}
+
+// SourceTypeMutation represents an operation that mutates the SourceType nodes in the graph.
+type SourceTypeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	_type             *string
+	clearedFields     map[string]struct{}
+	namespaces        map[int]struct{}
+	removednamespaces map[int]struct{}
+	clearednamespaces bool
+	done              bool
+	oldValue          func(context.Context) (*SourceType, error)
+	predicates        []predicate.SourceType
+}
+
+var _ ent.Mutation = (*SourceTypeMutation)(nil)
+
+// sourcetypeOption allows management of the mutation configuration using functional options.
+type sourcetypeOption func(*SourceTypeMutation)
+
+// newSourceTypeMutation creates new mutation for the SourceType entity.
+func newSourceTypeMutation(c config, op Op, opts ...sourcetypeOption) *SourceTypeMutation {
+	m := &SourceTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceTypeID sets the ID field of the mutation.
+func withSourceTypeID(id int) sourcetypeOption {
+	return func(m *SourceTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceType
+		)
+		m.oldValue = func(ctx context.Context) (*SourceType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceType sets the old SourceType of the mutation.
+func withSourceType(node *SourceType) sourcetypeOption {
+	return func(m *SourceTypeMutation) {
+		m.oldValue = func(context.Context) (*SourceType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceTypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceTypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *SourceTypeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SourceTypeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SourceType entity.
+// If the SourceType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceTypeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SourceTypeMutation) ResetType() {
+	m._type = nil
+}
+
+// AddNamespaceIDs adds the "namespaces" edge to the SourceNamespace entity by ids.
+func (m *SourceTypeMutation) AddNamespaceIDs(ids ...int) {
+	if m.namespaces == nil {
+		m.namespaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.namespaces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNamespaces clears the "namespaces" edge to the SourceNamespace entity.
+func (m *SourceTypeMutation) ClearNamespaces() {
+	m.clearednamespaces = true
+}
+
+// NamespacesCleared reports if the "namespaces" edge to the SourceNamespace entity was cleared.
+func (m *SourceTypeMutation) NamespacesCleared() bool {
+	return m.clearednamespaces
+}
+
+// RemoveNamespaceIDs removes the "namespaces" edge to the SourceNamespace entity by IDs.
+func (m *SourceTypeMutation) RemoveNamespaceIDs(ids ...int) {
+	if m.removednamespaces == nil {
+		m.removednamespaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.namespaces, ids[i])
+		m.removednamespaces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNamespaces returns the removed IDs of the "namespaces" edge to the SourceNamespace entity.
+func (m *SourceTypeMutation) RemovedNamespacesIDs() (ids []int) {
+	for id := range m.removednamespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NamespacesIDs returns the "namespaces" edge IDs in the mutation.
+func (m *SourceTypeMutation) NamespacesIDs() (ids []int) {
+	for id := range m.namespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNamespaces resets all changes to the "namespaces" edge.
+func (m *SourceTypeMutation) ResetNamespaces() {
+	m.namespaces = nil
+	m.clearednamespaces = false
+	m.removednamespaces = nil
+}
+
+// Where appends a list predicates to the SourceTypeMutation builder.
+func (m *SourceTypeMutation) Where(ps ...predicate.SourceType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SourceType).
+func (m *SourceTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceTypeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m._type != nil {
+		fields = append(fields, sourcetype.FieldType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sourcetype.FieldType:
+		return m.GetType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sourcetype.FieldType:
+		return m.OldType(ctx)
+	}
+	return nil, fmt.Errorf("unknown SourceType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sourcetype.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SourceType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceTypeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceTypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SourceType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceTypeMutation) ResetField(name string) error {
+	switch name {
+	case sourcetype.FieldType:
+		m.ResetType()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.namespaces != nil {
+		edges = append(edges, sourcetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sourcetype.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.namespaces))
+		for id := range m.namespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removednamespaces != nil {
+		edges = append(edges, sourcetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sourcetype.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.removednamespaces))
+		for id := range m.removednamespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednamespaces {
+		edges = append(edges, sourcetype.EdgeNamespaces)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sourcetype.EdgeNamespaces:
+		return m.clearednamespaces
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceTypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SourceType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case sourcetype.EdgeNamespaces:
+		m.ResetNamespaces()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceType edge %s", name)
+}
+
+// VulnEqualMutation represents an operation that mutates the VulnEqual nodes in the graph.
+type VulnEqualMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	justification          *string
+	origin                 *string
+	collector              *string
+	clearedFields          map[string]struct{}
+	vulnerability_a        *int
+	clearedvulnerability_a bool
+	vulnerability_b        *int
+	clearedvulnerability_b bool
+	done                   bool
+	oldValue               func(context.Context) (*VulnEqual, error)
+	predicates             []predicate.VulnEqual
+}
+
+var _ ent.Mutation = (*VulnEqualMutation)(nil)
+
+// vulnequalOption allows management of the mutation configuration using functional options.
+type vulnequalOption func(*VulnEqualMutation)
+
+// newVulnEqualMutation creates new mutation for the VulnEqual entity.
+func newVulnEqualMutation(c config, op Op, opts ...vulnequalOption) *VulnEqualMutation {
+	m := &VulnEqualMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVulnEqual,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVulnEqualID sets the ID field of the mutation.
+func withVulnEqualID(id int) vulnequalOption {
+	return func(m *VulnEqualMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VulnEqual
+		)
+		m.oldValue = func(ctx context.Context) (*VulnEqual, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VulnEqual.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVulnEqual sets the old VulnEqual of the mutation.
+func withVulnEqual(node *VulnEqual) vulnequalOption {
+	return func(m *VulnEqualMutation) {
+		m.oldValue = func(context.Context) (*VulnEqual, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VulnEqualMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VulnEqualMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VulnEqualMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VulnEqualMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VulnEqual.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVulnerabilityAID sets the "vulnerability_a_id" field.
+func (m *VulnEqualMutation) SetVulnerabilityAID(i int) {
+	m.vulnerability_a = &i
+}
+
+// VulnerabilityAID returns the value of the "vulnerability_a_id" field in the mutation.
+func (m *VulnEqualMutation) VulnerabilityAID() (r int, exists bool) {
+	v := m.vulnerability_a
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityAID returns the old "vulnerability_a_id" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldVulnerabilityAID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityAID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityAID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityAID: %w", err)
+	}
+	return oldValue.VulnerabilityAID, nil
+}
+
+// ResetVulnerabilityAID resets all changes to the "vulnerability_a_id" field.
+func (m *VulnEqualMutation) ResetVulnerabilityAID() {
+	m.vulnerability_a = nil
+}
+
+// SetVulnerabilityBID sets the "vulnerability_b_id" field.
+func (m *VulnEqualMutation) SetVulnerabilityBID(i int) {
+	m.vulnerability_b = &i
+}
+
+// VulnerabilityBID returns the value of the "vulnerability_b_id" field in the mutation.
+func (m *VulnEqualMutation) VulnerabilityBID() (r int, exists bool) {
+	v := m.vulnerability_b
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityBID returns the old "vulnerability_b_id" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldVulnerabilityBID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityBID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityBID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityBID: %w", err)
+	}
+	return oldValue.VulnerabilityBID, nil
+}
+
+// ResetVulnerabilityBID resets all changes to the "vulnerability_b_id" field.
+func (m *VulnEqualMutation) ResetVulnerabilityBID() {
+	m.vulnerability_b = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *VulnEqualMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *VulnEqualMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *VulnEqualMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *VulnEqualMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *VulnEqualMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *VulnEqualMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *VulnEqualMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *VulnEqualMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *VulnEqualMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// ClearVulnerabilityA clears the "vulnerability_a" edge to the VulnerabilityID entity.
+func (m *VulnEqualMutation) ClearVulnerabilityA() {
+	m.clearedvulnerability_a = true
+}
+
+// VulnerabilityACleared reports if the "vulnerability_a" edge to the VulnerabilityID entity was cleared.
+func (m *VulnEqualMutation) VulnerabilityACleared() bool {
+	return m.clearedvulnerability_a
+}
+
+// VulnerabilityAIDs returns the "vulnerability_a" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityAID instead. It exists only for internal usage by the builders.
+func (m *VulnEqualMutation) VulnerabilityAIDs() (ids []int) {
+	if id := m.vulnerability_a; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVulnerabilityA resets all changes to the "vulnerability_a" edge.
+func (m *VulnEqualMutation) ResetVulnerabilityA() {
+	m.vulnerability_a = nil
+	m.clearedvulnerability_a = false
+}
+
+// ClearVulnerabilityB clears the "vulnerability_b" edge to the VulnerabilityID entity.
+func (m *VulnEqualMutation) ClearVulnerabilityB() {
+	m.clearedvulnerability_b = true
+}
+
+// VulnerabilityBCleared reports if the "vulnerability_b" edge to the VulnerabilityID entity was cleared.
+func (m *VulnEqualMutation) VulnerabilityBCleared() bool {
+	return m.clearedvulnerability_b
+}
+
+// VulnerabilityBIDs returns the "vulnerability_b" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityBID instead. It exists only for internal usage by the builders.
+func (m *VulnEqualMutation) VulnerabilityBIDs() (ids []int) {
+	if id := m.vulnerability_b; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVulnerabilityB resets all changes to the "vulnerability_b" edge.
+func (m *VulnEqualMutation) ResetVulnerabilityB() {
+	m.vulnerability_b = nil
+	m.clearedvulnerability_b = false
+}
+
+// Where appends a list predicates to the VulnEqualMutation builder.
+func (m *VulnEqualMutation) Where(ps ...predicate.VulnEqual) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VulnEqualMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VulnEqualMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VulnEqual, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VulnEqualMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VulnEqualMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VulnEqual).
+func (m *VulnEqualMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VulnEqualMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.vulnerability_a != nil {
+		fields = append(fields, vulnequal.FieldVulnerabilityAID)
+	}
+	if m.vulnerability_b != nil {
+		fields = append(fields, vulnequal.FieldVulnerabilityBID)
+	}
+	if m.justification != nil {
+		fields = append(fields, vulnequal.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, vulnequal.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, vulnequal.FieldCollector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VulnEqualMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vulnequal.FieldVulnerabilityAID:
+		return m.VulnerabilityAID()
+	case vulnequal.FieldVulnerabilityBID:
+		return m.VulnerabilityBID()
+	case vulnequal.FieldJustification:
+		return m.Justification()
+	case vulnequal.FieldOrigin:
+		return m.Origin()
+	case vulnequal.FieldCollector:
+		return m.Collector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VulnEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vulnequal.FieldVulnerabilityAID:
+		return m.OldVulnerabilityAID(ctx)
+	case vulnequal.FieldVulnerabilityBID:
+		return m.OldVulnerabilityBID(ctx)
+	case vulnequal.FieldJustification:
+		return m.OldJustification(ctx)
+	case vulnequal.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case vulnequal.FieldCollector:
+		return m.OldCollector(ctx)
+	}
+	return nil, fmt.Errorf("unknown VulnEqual field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnEqualMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vulnequal.FieldVulnerabilityAID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityAID(v)
+		return nil
+	case vulnequal.FieldVulnerabilityBID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityBID(v)
+		return nil
+	case vulnequal.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case vulnequal.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case vulnequal.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VulnEqual field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VulnEqualMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VulnEqualMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnEqualMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown VulnEqual numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VulnEqualMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VulnEqualMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VulnEqualMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VulnEqual nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VulnEqualMutation) ResetField(name string) error {
+	switch name {
+	case vulnequal.FieldVulnerabilityAID:
+		m.ResetVulnerabilityAID()
+		return nil
+	case vulnequal.FieldVulnerabilityBID:
+		m.ResetVulnerabilityBID()
+		return nil
+	case vulnequal.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case vulnequal.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case vulnequal.FieldCollector:
+		m.ResetCollector()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnEqual field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VulnEqualMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.vulnerability_a != nil {
+		edges = append(edges, vulnequal.EdgeVulnerabilityA)
+	}
+	if m.vulnerability_b != nil {
+		edges = append(edges, vulnequal.EdgeVulnerabilityB)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VulnEqualMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vulnequal.EdgeVulnerabilityA:
+		if id := m.vulnerability_a; id != nil {
+			return []ent.Value{*id}
+		}
+	case vulnequal.EdgeVulnerabilityB:
+		if id := m.vulnerability_b; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VulnEqualMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VulnEqualMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VulnEqualMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedvulnerability_a {
+		edges = append(edges, vulnequal.EdgeVulnerabilityA)
+	}
+	if m.clearedvulnerability_b {
+		edges = append(edges, vulnequal.EdgeVulnerabilityB)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VulnEqualMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vulnequal.EdgeVulnerabilityA:
+		return m.clearedvulnerability_a
+	case vulnequal.EdgeVulnerabilityB:
+		return m.clearedvulnerability_b
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VulnEqualMutation) ClearEdge(name string) error {
+	switch name {
+	case vulnequal.EdgeVulnerabilityA:
+		m.ClearVulnerabilityA()
+		return nil
+	case vulnequal.EdgeVulnerabilityB:
+		m.ClearVulnerabilityB()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnEqual unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VulnEqualMutation) ResetEdge(name string) error {
+	switch name {
+	case vulnequal.EdgeVulnerabilityA:
+		m.ResetVulnerabilityA()
+		return nil
+	case vulnequal.EdgeVulnerabilityB:
+		m.ResetVulnerabilityB()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnEqual edge %s", name)
+}
+
+// VulnerabilityIDMutation represents an operation that mutates the VulnerabilityID nodes in the graph.
+type VulnerabilityIDMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	vulnerability_id *string
+	clearedFields    map[string]struct{}
+	_type            *int
+	cleared_type     bool
+	done             bool
+	oldValue         func(context.Context) (*VulnerabilityID, error)
+	predicates       []predicate.VulnerabilityID
+}
+
+var _ ent.Mutation = (*VulnerabilityIDMutation)(nil)
+
+// vulnerabilityidOption allows management of the mutation configuration using functional options.
+type vulnerabilityidOption func(*VulnerabilityIDMutation)
+
+// newVulnerabilityIDMutation creates new mutation for the VulnerabilityID entity.
+func newVulnerabilityIDMutation(c config, op Op, opts ...vulnerabilityidOption) *VulnerabilityIDMutation {
+	m := &VulnerabilityIDMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVulnerabilityID,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVulnerabilityIDID sets the ID field of the mutation.
+func withVulnerabilityIDID(id int) vulnerabilityidOption {
+	return func(m *VulnerabilityIDMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VulnerabilityID
+		)
+		m.oldValue = func(ctx context.Context) (*VulnerabilityID, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VulnerabilityID.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVulnerabilityID sets the old VulnerabilityID of the mutation.
+func withVulnerabilityID(node *VulnerabilityID) vulnerabilityidOption {
+	return func(m *VulnerabilityIDMutation) {
+		m.oldValue = func(context.Context) (*VulnerabilityID, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VulnerabilityIDMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VulnerabilityIDMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VulnerabilityIDMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VulnerabilityIDMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VulnerabilityID.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTypeID sets the "type_id" field.
+func (m *VulnerabilityIDMutation) SetTypeID(i int) {
+	m._type = &i
+}
+
+// TypeID returns the value of the "type_id" field in the mutation.
+func (m *VulnerabilityIDMutation) TypeID() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeID returns the old "type_id" field's value of the VulnerabilityID entity.
+// If the VulnerabilityID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityIDMutation) OldTypeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeID: %w", err)
+	}
+	return oldValue.TypeID, nil
+}
+
+// ResetTypeID resets all changes to the "type_id" field.
+func (m *VulnerabilityIDMutation) ResetTypeID() {
+	m._type = nil
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (m *VulnerabilityIDMutation) SetVulnerabilityID(s string) {
+	m.vulnerability_id = &s
+}
+
+// VulnerabilityID returns the value of the "vulnerability_id" field in the mutation.
+func (m *VulnerabilityIDMutation) VulnerabilityID() (r string, exists bool) {
+	v := m.vulnerability_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityID returns the old "vulnerability_id" field's value of the VulnerabilityID entity.
+// If the VulnerabilityID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityIDMutation) OldVulnerabilityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityID: %w", err)
+	}
+	return oldValue.VulnerabilityID, nil
+}
+
+// ResetVulnerabilityID resets all changes to the "vulnerability_id" field.
+func (m *VulnerabilityIDMutation) ResetVulnerabilityID() {
+	m.vulnerability_id = nil
+}
+
+// ClearType clears the "type" edge to the VulnerabilityType entity.
+func (m *VulnerabilityIDMutation) ClearType() {
+	m.cleared_type = true
+}
+
+// TypeCleared reports if the "type" edge to the VulnerabilityType entity was cleared.
+func (m *VulnerabilityIDMutation) TypeCleared() bool {
+	return m.cleared_type
+}
+
+// TypeIDs returns the "type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TypeID instead. It exists only for internal usage by the builders.
+func (m *VulnerabilityIDMutation) TypeIDs() (ids []int) {
+	if id := m._type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetType resets all changes to the "type" edge.
+func (m *VulnerabilityIDMutation) ResetType() {
+	m._type = nil
+	m.cleared_type = false
+}
+
+// Where appends a list predicates to the VulnerabilityIDMutation builder.
+func (m *VulnerabilityIDMutation) Where(ps ...predicate.VulnerabilityID) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VulnerabilityIDMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VulnerabilityIDMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VulnerabilityID, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VulnerabilityIDMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VulnerabilityIDMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VulnerabilityID).
+func (m *VulnerabilityIDMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VulnerabilityIDMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m._type != nil {
+		fields = append(fields, vulnerabilityid.FieldTypeID)
+	}
+	if m.vulnerability_id != nil {
+		fields = append(fields, vulnerabilityid.FieldVulnerabilityID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VulnerabilityIDMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vulnerabilityid.FieldTypeID:
+		return m.TypeID()
+	case vulnerabilityid.FieldVulnerabilityID:
+		return m.VulnerabilityID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VulnerabilityIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vulnerabilityid.FieldTypeID:
+		return m.OldTypeID(ctx)
+	case vulnerabilityid.FieldVulnerabilityID:
+		return m.OldVulnerabilityID(ctx)
+	}
+	return nil, fmt.Errorf("unknown VulnerabilityID field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnerabilityIDMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vulnerabilityid.FieldTypeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeID(v)
+		return nil
+	case vulnerabilityid.FieldVulnerabilityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityID field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VulnerabilityIDMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VulnerabilityIDMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnerabilityIDMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown VulnerabilityID numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VulnerabilityIDMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VulnerabilityIDMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VulnerabilityIDMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VulnerabilityID nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VulnerabilityIDMutation) ResetField(name string) error {
+	switch name {
+	case vulnerabilityid.FieldTypeID:
+		m.ResetTypeID()
+		return nil
+	case vulnerabilityid.FieldVulnerabilityID:
+		m.ResetVulnerabilityID()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityID field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VulnerabilityIDMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._type != nil {
+		edges = append(edges, vulnerabilityid.EdgeType)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VulnerabilityIDMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vulnerabilityid.EdgeType:
+		if id := m._type; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VulnerabilityIDMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VulnerabilityIDMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VulnerabilityIDMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_type {
+		edges = append(edges, vulnerabilityid.EdgeType)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VulnerabilityIDMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vulnerabilityid.EdgeType:
+		return m.cleared_type
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VulnerabilityIDMutation) ClearEdge(name string) error {
+	switch name {
+	case vulnerabilityid.EdgeType:
+		m.ClearType()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityID unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VulnerabilityIDMutation) ResetEdge(name string) error {
+	switch name {
+	case vulnerabilityid.EdgeType:
+		m.ResetType()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityID edge %s", name)
+}
+
+// VulnerabilityTypeMutation represents an operation that mutates the VulnerabilityType nodes in the graph.
+type VulnerabilityTypeMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	_type                    *string
+	clearedFields            map[string]struct{}
+	vulnerability_ids        map[int]struct{}
+	removedvulnerability_ids map[int]struct{}
+	clearedvulnerability_ids bool
+	done                     bool
+	oldValue                 func(context.Context) (*VulnerabilityType, error)
+	predicates               []predicate.VulnerabilityType
+}
+
+var _ ent.Mutation = (*VulnerabilityTypeMutation)(nil)
+
+// vulnerabilitytypeOption allows management of the mutation configuration using functional options.
+type vulnerabilitytypeOption func(*VulnerabilityTypeMutation)
+
+// newVulnerabilityTypeMutation creates new mutation for the VulnerabilityType entity.
+func newVulnerabilityTypeMutation(c config, op Op, opts ...vulnerabilitytypeOption) *VulnerabilityTypeMutation {
+	m := &VulnerabilityTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVulnerabilityType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVulnerabilityTypeID sets the ID field of the mutation.
+func withVulnerabilityTypeID(id int) vulnerabilitytypeOption {
+	return func(m *VulnerabilityTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VulnerabilityType
+		)
+		m.oldValue = func(ctx context.Context) (*VulnerabilityType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VulnerabilityType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVulnerabilityType sets the old VulnerabilityType of the mutation.
+func withVulnerabilityType(node *VulnerabilityType) vulnerabilitytypeOption {
+	return func(m *VulnerabilityTypeMutation) {
+		m.oldValue = func(context.Context) (*VulnerabilityType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VulnerabilityTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VulnerabilityTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VulnerabilityTypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VulnerabilityTypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VulnerabilityType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *VulnerabilityTypeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *VulnerabilityTypeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the VulnerabilityType entity.
+// If the VulnerabilityType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityTypeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *VulnerabilityTypeMutation) ResetType() {
+	m._type = nil
+}
+
+// AddVulnerabilityIDIDs adds the "vulnerability_ids" edge to the VulnerabilityID entity by ids.
+func (m *VulnerabilityTypeMutation) AddVulnerabilityIDIDs(ids ...int) {
+	if m.vulnerability_ids == nil {
+		m.vulnerability_ids = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.vulnerability_ids[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVulnerabilityIds clears the "vulnerability_ids" edge to the VulnerabilityID entity.
+func (m *VulnerabilityTypeMutation) ClearVulnerabilityIds() {
+	m.clearedvulnerability_ids = true
+}
+
+// VulnerabilityIdsCleared reports if the "vulnerability_ids" edge to the VulnerabilityID entity was cleared.
+func (m *VulnerabilityTypeMutation) VulnerabilityIdsCleared() bool {
+	return m.clearedvulnerability_ids
+}
+
+// RemoveVulnerabilityIDIDs removes the "vulnerability_ids" edge to the VulnerabilityID entity by IDs.
+func (m *VulnerabilityTypeMutation) RemoveVulnerabilityIDIDs(ids ...int) {
+	if m.removedvulnerability_ids == nil {
+		m.removedvulnerability_ids = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.vulnerability_ids, ids[i])
+		m.removedvulnerability_ids[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVulnerabilityIds returns the removed IDs of the "vulnerability_ids" edge to the VulnerabilityID entity.
+func (m *VulnerabilityTypeMutation) RemovedVulnerabilityIdsIDs() (ids []int) {
+	for id := range m.removedvulnerability_ids {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VulnerabilityIdsIDs returns the "vulnerability_ids" edge IDs in the mutation.
+func (m *VulnerabilityTypeMutation) VulnerabilityIdsIDs() (ids []int) {
+	for id := range m.vulnerability_ids {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVulnerabilityIds resets all changes to the "vulnerability_ids" edge.
+func (m *VulnerabilityTypeMutation) ResetVulnerabilityIds() {
+	m.vulnerability_ids = nil
+	m.clearedvulnerability_ids = false
+	m.removedvulnerability_ids = nil
+}
+
+// Where appends a list predicates to the VulnerabilityTypeMutation builder.
+func (m *VulnerabilityTypeMutation) Where(ps ...predicate.VulnerabilityType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VulnerabilityTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VulnerabilityTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VulnerabilityType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VulnerabilityTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VulnerabilityTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VulnerabilityType).
+func (m *VulnerabilityTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VulnerabilityTypeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m._type != nil {
+		fields = append(fields, vulnerabilitytype.FieldType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VulnerabilityTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vulnerabilitytype.FieldType:
+		return m.GetType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VulnerabilityTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vulnerabilitytype.FieldType:
+		return m.OldType(ctx)
+	}
+	return nil, fmt.Errorf("unknown VulnerabilityType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnerabilityTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vulnerabilitytype.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VulnerabilityTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VulnerabilityTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VulnerabilityTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown VulnerabilityType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VulnerabilityTypeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VulnerabilityTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VulnerabilityTypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VulnerabilityType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VulnerabilityTypeMutation) ResetField(name string) error {
+	switch name {
+	case vulnerabilitytype.FieldType:
+		m.ResetType()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VulnerabilityTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.vulnerability_ids != nil {
+		edges = append(edges, vulnerabilitytype.EdgeVulnerabilityIds)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VulnerabilityTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vulnerabilitytype.EdgeVulnerabilityIds:
+		ids := make([]ent.Value, 0, len(m.vulnerability_ids))
+		for id := range m.vulnerability_ids {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VulnerabilityTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedvulnerability_ids != nil {
+		edges = append(edges, vulnerabilitytype.EdgeVulnerabilityIds)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VulnerabilityTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case vulnerabilitytype.EdgeVulnerabilityIds:
+		ids := make([]ent.Value, 0, len(m.removedvulnerability_ids))
+		for id := range m.removedvulnerability_ids {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VulnerabilityTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvulnerability_ids {
+		edges = append(edges, vulnerabilitytype.EdgeVulnerabilityIds)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VulnerabilityTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vulnerabilitytype.EdgeVulnerabilityIds:
+		return m.clearedvulnerability_ids
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VulnerabilityTypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown VulnerabilityType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VulnerabilityTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case vulnerabilitytype.EdgeVulnerabilityIds:
+		m.ResetVulnerabilityIds()
+		return nil
+	}
+	return fmt.Errorf("unknown VulnerabilityType edge %s", name)
+}
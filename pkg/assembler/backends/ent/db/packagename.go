@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+)
+
+// PackageName is the model entity for the PackageName schema.
+type PackageName struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NamespaceID holds the value of the "namespace_id" field.
+	NamespaceID int `json:"namespace_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PackageNameQuery when eager-loading is set.
+	Edges PackageNameEdges `json:"edges"`
+}
+
+// PackageNameEdges holds the relations/edges for other nodes in the graph.
+type PackageNameEdges struct {
+	// Namespace holds the value of the namespace edge.
+	Namespace *PackageNamespace `json:"namespace,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*PackageVersion `json:"versions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NamespaceOrErr returns the Namespace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PackageNameEdges) NamespaceOrErr() (*PackageNamespace, error) {
+	if e.loadedTypes[0] {
+		if e.Namespace == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packagenamespace.Label}
+		}
+		return e.Namespace, nil
+	}
+	return nil, &NotLoadedError{edge: "namespace"}
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PackageNameEdges) VersionsOrErr() ([]*PackageVersion, error) {
+	if e.loadedTypes[1] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PackageName) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case packagename.FieldID, packagename.FieldNamespaceID:
+			values[i] = new(sql.NullInt64)
+		case packagename.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PackageName", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PackageName fields.
+func (pn *PackageName) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case packagename.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pn.ID = int(value.Int64)
+		case packagename.FieldNamespaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_id", values[i])
+			} else if value.Valid {
+				pn.NamespaceID = int(value.Int64)
+			}
+		case packagename.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pn.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryNamespace queries the "namespace" edge of the PackageName entity.
+func (pn *PackageName) QueryNamespace() *PackageNamespaceQuery {
+	return NewPackageNameClient(pn.config).QueryNamespace(pn)
+}
+
+// QueryVersions queries the "versions" edge of the PackageName entity.
+func (pn *PackageName) QueryVersions() *PackageVersionQuery {
+	return NewPackageNameClient(pn.config).QueryVersions(pn)
+}
+
+// Update returns a builder for updating this PackageName.
+// Note that you need to call PackageName.Unwrap() before calling this method if this PackageName
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pn *PackageName) Update() *PackageNameUpdateOne {
+	return NewPackageNameClient(pn.config).UpdateOne(pn)
+}
+
+// Unwrap unwraps the PackageName entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pn *PackageName) Unwrap() *PackageName {
+	_tx, ok := pn.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PackageName is not a transactional entity")
+	}
+	pn.config.driver = _tx.drv
+	return pn
+}
+
+// String implements the fmt.Stringer.
+func (pn *PackageName) String() string {
+	var builder strings.Builder
+	builder.WriteString("PackageName(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pn.ID))
+	builder.WriteString("namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", pn.NamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pn.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PackageNames is a parsable slice of PackageName.
+type PackageNames []*PackageName
@@ -0,0 +1,51 @@
+// Code generated by ent, DO NOT EDIT.
+
+package packagename
+
+const (
+	// Label holds the string label denoting the packagename type in the database.
+	Label = "package_name"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespaceID holds the string denoting the namespace_id field in the database.
+	FieldNamespaceID = "namespace_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeNamespace holds the string denoting the namespace edge name in mutations.
+	EdgeNamespace = "namespace"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
+	// Table holds the table name of the packagename in the database.
+	Table = "package_names"
+	// NamespaceTable is the table that holds the namespace relation/edge.
+	NamespaceTable = "package_names"
+	// NamespaceInverseTable is the table name for the PackageNamespace entity.
+	// It exists in this package in order to avoid circular dependency with the "packagenamespace" package.
+	NamespaceInverseTable = "package_namespaces"
+	// NamespaceColumn is the table column denoting the namespace relation/edge.
+	NamespaceColumn = "namespace_id"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "package_versions"
+	// VersionsInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	VersionsInverseTable = "package_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "name_id"
+)
+
+// Columns holds all SQL columns for packagename fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespaceID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+)
+
+// PackageNameCreate is the builder for creating a PackageName entity.
+type PackageNameCreate struct {
+	config
+	mutation *PackageNameMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (pnc *PackageNameCreate) SetNamespaceID(i int) *PackageNameCreate {
+	pnc.mutation.SetNamespaceID(i)
+	return pnc
+}
+
+// SetName sets the "name" field.
+func (pnc *PackageNameCreate) SetName(s string) *PackageNameCreate {
+	pnc.mutation.SetName(s)
+	return pnc
+}
+
+// SetNamespace sets the "namespace" edge to the PackageNamespace entity.
+func (pnc *PackageNameCreate) SetNamespace(p *PackageNamespace) *PackageNameCreate {
+	return pnc.SetNamespaceID(p.ID)
+}
+
+// AddVersionIDs adds the "versions" edge to the PackageVersion entity by IDs.
+func (pnc *PackageNameCreate) AddVersionIDs(ids ...int) *PackageNameCreate {
+	pnc.mutation.AddVersionIDs(ids...)
+	return pnc
+}
+
+// AddVersions adds the "versions" edges to the PackageVersion entity.
+func (pnc *PackageNameCreate) AddVersions(p ...*PackageVersion) *PackageNameCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pnc.AddVersionIDs(ids...)
+}
+
+// Mutation returns the PackageNameMutation object of the builder.
+func (pnc *PackageNameCreate) Mutation() *PackageNameMutation {
+	return pnc.mutation
+}
+
+// Save creates the PackageName in the database.
+func (pnc *PackageNameCreate) Save(ctx context.Context) (*PackageName, error) {
+	return withHooks[*PackageName, PackageNameMutation](ctx, pnc.sqlSave, pnc.mutation, pnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pnc *PackageNameCreate) SaveX(ctx context.Context) *PackageName {
+	v, err := pnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pnc *PackageNameCreate) Exec(ctx context.Context) error {
+	_, err := pnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pnc *PackageNameCreate) ExecX(ctx context.Context) {
+	if err := pnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pnc *PackageNameCreate) check() error {
+	if _, ok := pnc.mutation.NamespaceID(); !ok {
+		return &ValidationError{Name: "namespace_id", err: errors.New(`db: missing required field "PackageName.namespace_id"`)}
+	}
+	if _, ok := pnc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "PackageName.name"`)}
+	}
+	if _, ok := pnc.mutation.NamespaceID(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required edge "PackageName.namespace"`)}
+	}
+	return nil
+}
+
+func (pnc *PackageNameCreate) sqlSave(ctx context.Context) (*PackageName, error) {
+	if err := pnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pnc.mutation.id = &_node.ID
+	pnc.mutation.done = true
+	return _node, nil
+}
+
+func (pnc *PackageNameCreate) createSpec() (*PackageName, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PackageName{config: pnc.config}
+		_spec = sqlgraph.NewCreateSpec(packagename.Table, sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pnc.conflict
+	if value, ok := pnc.mutation.Name(); ok {
+		_spec.SetField(packagename.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := pnc.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   packagename.NamespaceTable,
+			Columns: []string{packagename.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NamespaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pnc.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   packagename.VersionsTable,
+			Columns: []string{packagename.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PackageName.Create().
+//		SetNamespaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PackageNameUpsert) {
+//			SetNamespaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (pnc *PackageNameCreate) OnConflict(opts ...sql.ConflictOption) *PackageNameUpsertOne {
+	pnc.conflict = opts
+	return &PackageNameUpsertOne{
+		create: pnc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pnc *PackageNameCreate) OnConflictColumns(columns ...string) *PackageNameUpsertOne {
+	pnc.conflict = append(pnc.conflict, sql.ConflictColumns(columns...))
+	return &PackageNameUpsertOne{
+		create: pnc,
+	}
+}
+
+type (
+	// PackageNameUpsertOne is the builder for "upsert"-ing
+	//  one PackageName node.
+	PackageNameUpsertOne struct {
+		create *PackageNameCreate
+	}
+
+	// PackageNameUpsert is the "OnConflict" setter.
+	PackageNameUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNamespaceID sets the "namespace_id" field.
+func (u *PackageNameUpsert) SetNamespaceID(v int) *PackageNameUpsert {
+	u.Set(packagename.FieldNamespaceID, v)
+	return u
+}
+
+// UpdateNamespaceID sets the "namespace_id" field to the value that was provided on create.
+func (u *PackageNameUpsert) UpdateNamespaceID() *PackageNameUpsert {
+	u.SetExcluded(packagename.FieldNamespaceID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PackageNameUpsert) SetName(v string) *PackageNameUpsert {
+	u.Set(packagename.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PackageNameUpsert) UpdateName() *PackageNameUpsert {
+	u.SetExcluded(packagename.FieldName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PackageNameUpsertOne) UpdateNewValues() *PackageNameUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PackageNameUpsertOne) Ignore() *PackageNameUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PackageNameUpsertOne) DoNothing() *PackageNameUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PackageNameCreate.OnConflict
+// documentation for more info.
+func (u *PackageNameUpsertOne) Update(set func(*PackageNameUpsert)) *PackageNameUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PackageNameUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (u *PackageNameUpsertOne) SetNamespaceID(v int) *PackageNameUpsertOne {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.SetNamespaceID(v)
+	})
+}
+
+// UpdateNamespaceID sets the "namespace_id" field to the value that was provided on create.
+func (u *PackageNameUpsertOne) UpdateNamespaceID() *PackageNameUpsertOne {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.UpdateNamespaceID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PackageNameUpsertOne) SetName(v string) *PackageNameUpsertOne {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PackageNameUpsertOne) UpdateName() *PackageNameUpsertOne {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *PackageNameUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for PackageNameCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PackageNameUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PackageNameUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PackageNameUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PackageNameCreateBulk is the builder for creating many PackageName entities in bulk.
+type PackageNameCreateBulk struct {
+	config
+	builders []*PackageNameCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PackageName entities in the database.
+func (pncb *PackageNameCreateBulk) Save(ctx context.Context) ([]*PackageName, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pncb.builders))
+	nodes := make([]*PackageName, len(pncb.builders))
+	mutators := make([]Mutator, len(pncb.builders))
+	for i := range pncb.builders {
+		func(i int, root context.Context) {
+			builder := pncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PackageNameMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pncb *PackageNameCreateBulk) SaveX(ctx context.Context) []*PackageName {
+	v, err := pncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pncb *PackageNameCreateBulk) Exec(ctx context.Context) error {
+	_, err := pncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pncb *PackageNameCreateBulk) ExecX(ctx context.Context) {
+	if err := pncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PackageName.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PackageNameUpsert) {
+//			SetNamespaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (pncb *PackageNameCreateBulk) OnConflict(opts ...sql.ConflictOption) *PackageNameUpsertBulk {
+	pncb.conflict = opts
+	return &PackageNameUpsertBulk{
+		create: pncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pncb *PackageNameCreateBulk) OnConflictColumns(columns ...string) *PackageNameUpsertBulk {
+	pncb.conflict = append(pncb.conflict, sql.ConflictColumns(columns...))
+	return &PackageNameUpsertBulk{
+		create: pncb,
+	}
+}
+
+// PackageNameUpsertBulk is the builder for "upsert"-ing
+// a bulk of PackageName nodes.
+type PackageNameUpsertBulk struct {
+	create *PackageNameCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PackageNameUpsertBulk) UpdateNewValues() *PackageNameUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PackageName.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PackageNameUpsertBulk) Ignore() *PackageNameUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PackageNameUpsertBulk) DoNothing() *PackageNameUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PackageNameCreateBulk.OnConflict
+// documentation for more info.
+func (u *PackageNameUpsertBulk) Update(set func(*PackageNameUpsert)) *PackageNameUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PackageNameUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (u *PackageNameUpsertBulk) SetNamespaceID(v int) *PackageNameUpsertBulk {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.SetNamespaceID(v)
+	})
+}
+
+// UpdateNamespaceID sets the "namespace_id" field to the value that was provided on create.
+func (u *PackageNameUpsertBulk) UpdateNamespaceID() *PackageNameUpsertBulk {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.UpdateNamespaceID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PackageNameUpsertBulk) SetName(v string) *PackageNameUpsertBulk {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PackageNameUpsertBulk) UpdateName() *PackageNameUpsertBulk {
+	return u.Update(func(s *PackageNameUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *PackageNameUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the PackageNameCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for PackageNameCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PackageNameUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// PackageNameQuery is the builder for querying PackageName entities.
+type PackageNameQuery struct {
+	config
+	ctx           *QueryContext
+	order         []OrderFunc
+	inters        []Interceptor
+	predicates    []predicate.PackageName
+	withNamespace *PackageNamespaceQuery
+	withVersions  *PackageVersionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PackageNameQuery builder.
+func (pnq *PackageNameQuery) Where(ps ...predicate.PackageName) *PackageNameQuery {
+	pnq.predicates = append(pnq.predicates, ps...)
+	return pnq
+}
+
+// Limit the number of records to be returned by this query.
+func (pnq *PackageNameQuery) Limit(limit int) *PackageNameQuery {
+	pnq.ctx.Limit = &limit
+	return pnq
+}
+
+// Offset to start from.
+func (pnq *PackageNameQuery) Offset(offset int) *PackageNameQuery {
+	pnq.ctx.Offset = &offset
+	return pnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pnq *PackageNameQuery) Unique(unique bool) *PackageNameQuery {
+	pnq.ctx.Unique = &unique
+	return pnq
+}
+
+// Order specifies how the records should be ordered.
+func (pnq *PackageNameQuery) Order(o ...OrderFunc) *PackageNameQuery {
+	pnq.order = append(pnq.order, o...)
+	return pnq
+}
+
+// QueryNamespace chains the current query on the "namespace" edge.
+func (pnq *PackageNameQuery) QueryNamespace() *PackageNamespaceQuery {
+	query := (&PackageNamespaceClient{config: pnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, selector),
+			sqlgraph.To(packagenamespace.Table, packagenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, packagename.NamespaceTable, packagename.NamespaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVersions chains the current query on the "versions" edge.
+func (pnq *PackageNameQuery) QueryVersions() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: pnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, packagename.VersionsTable, packagename.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PackageName entity from the query.
+// Returns a *NotFoundError when no PackageName was found.
+func (pnq *PackageNameQuery) First(ctx context.Context) (*PackageName, error) {
+	nodes, err := pnq.Limit(1).All(setContextOp(ctx, pnq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{packagename.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pnq *PackageNameQuery) FirstX(ctx context.Context) *PackageName {
+	node, err := pnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PackageName ID from the query.
+// Returns a *NotFoundError when no PackageName ID was found.
+func (pnq *PackageNameQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pnq.Limit(1).IDs(setContextOp(ctx, pnq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{packagename.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pnq *PackageNameQuery) FirstIDX(ctx context.Context) int {
+	id, err := pnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PackageName entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PackageName entity is found.
+// Returns a *NotFoundError when no PackageName entities are found.
+func (pnq *PackageNameQuery) Only(ctx context.Context) (*PackageName, error) {
+	nodes, err := pnq.Limit(2).All(setContextOp(ctx, pnq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{packagename.Label}
+	default:
+		return nil, &NotSingularError{packagename.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pnq *PackageNameQuery) OnlyX(ctx context.Context) *PackageName {
+	node, err := pnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PackageName ID in the query.
+// Returns a *NotSingularError when more than one PackageName ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pnq *PackageNameQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pnq.Limit(2).IDs(setContextOp(ctx, pnq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{packagename.Label}
+	default:
+		err = &NotSingularError{packagename.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pnq *PackageNameQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PackageNames.
+func (pnq *PackageNameQuery) All(ctx context.Context) ([]*PackageName, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "All")
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PackageName, *PackageNameQuery]()
+	return withInterceptors[[]*PackageName](ctx, pnq, qr, pnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pnq *PackageNameQuery) AllX(ctx context.Context) []*PackageName {
+	nodes, err := pnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PackageName IDs.
+func (pnq *PackageNameQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pnq.ctx.Unique == nil && pnq.path != nil {
+		pnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pnq.ctx, "IDs")
+	if err = pnq.Select(packagename.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pnq *PackageNameQuery) IDsX(ctx context.Context) []int {
+	ids, err := pnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pnq *PackageNameQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "Count")
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pnq, querierCount[*PackageNameQuery](), pnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pnq *PackageNameQuery) CountX(ctx context.Context) int {
+	count, err := pnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pnq *PackageNameQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "Exist")
+	switch _, err := pnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pnq *PackageNameQuery) ExistX(ctx context.Context) bool {
+	exist, err := pnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PackageNameQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pnq *PackageNameQuery) Clone() *PackageNameQuery {
+	if pnq == nil {
+		return nil
+	}
+	return &PackageNameQuery{
+		config:        pnq.config,
+		ctx:           pnq.ctx.Clone(),
+		order:         append([]OrderFunc{}, pnq.order...),
+		inters:        append([]Interceptor{}, pnq.inters...),
+		predicates:    append([]predicate.PackageName{}, pnq.predicates...),
+		withNamespace: pnq.withNamespace.Clone(),
+		withVersions:  pnq.withVersions.Clone(),
+		// clone intermediate query.
+		sql:  pnq.sql.Clone(),
+		path: pnq.path,
+	}
+}
+
+// WithNamespace tells the query-builder to eager-load the nodes that are connected to
+// the "namespace" edge. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNameQuery) WithNamespace(opts ...func(*PackageNamespaceQuery)) *PackageNameQuery {
+	query := (&PackageNamespaceClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pnq.withNamespace = query
+	return pnq
+}
+
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNameQuery) WithVersions(opts ...func(*PackageVersionQuery)) *PackageNameQuery {
+	query := (&PackageVersionClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pnq.withVersions = query
+	return pnq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NamespaceID int `json:"namespace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PackageName.Query().
+//		GroupBy(packagename.FieldNamespaceID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (pnq *PackageNameQuery) GroupBy(field string, fields ...string) *PackageNameGroupBy {
+	pnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PackageNameGroupBy{build: pnq}
+	grbuild.flds = &pnq.ctx.Fields
+	grbuild.label = packagename.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NamespaceID int `json:"namespace_id,omitempty"`
+//	}
+//
+//	client.PackageName.Query().
+//		Select(packagename.FieldNamespaceID).
+//		Scan(ctx, &v)
+func (pnq *PackageNameQuery) Select(fields ...string) *PackageNameSelect {
+	pnq.ctx.Fields = append(pnq.ctx.Fields, fields...)
+	sbuild := &PackageNameSelect{PackageNameQuery: pnq}
+	sbuild.label = packagename.Label
+	sbuild.flds, sbuild.scan = &pnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PackageNameSelect configured with the given aggregations.
+func (pnq *PackageNameQuery) Aggregate(fns ...AggregateFunc) *PackageNameSelect {
+	return pnq.Select().Aggregate(fns...)
+}
+
+func (pnq *PackageNameQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pnq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pnq.ctx.Fields {
+		if !packagename.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if pnq.path != nil {
+		prev, err := pnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pnq.sql = prev
+	}
+	return nil
+}
+
+func (pnq *PackageNameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PackageName, error) {
+	var (
+		nodes       = []*PackageName{}
+		_spec       = pnq.querySpec()
+		loadedTypes = [2]bool{
+			pnq.withNamespace != nil,
+			pnq.withVersions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PackageName).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PackageName{config: pnq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pnq.withNamespace; query != nil {
+		if err := pnq.loadNamespace(ctx, query, nodes, nil,
+			func(n *PackageName, e *PackageNamespace) { n.Edges.Namespace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pnq.withVersions; query != nil {
+		if err := pnq.loadVersions(ctx, query, nodes,
+			func(n *PackageName) { n.Edges.Versions = []*PackageVersion{} },
+			func(n *PackageName, e *PackageVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pnq *PackageNameQuery) loadNamespace(ctx context.Context, query *PackageNamespaceQuery, nodes []*PackageName, init func(*PackageName), assign func(*PackageName, *PackageNamespace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PackageName)
+	for i := range nodes {
+		fk := nodes[i].NamespaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagenamespace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "namespace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pnq *PackageNameQuery) loadVersions(ctx context.Context, query *PackageVersionQuery, nodes []*PackageName, init func(*PackageName), assign func(*PackageName, *PackageVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PackageName)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.PackageVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(packagename.VersionsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "name_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pnq *PackageNameQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pnq.querySpec()
+	_spec.Node.Columns = pnq.ctx.Fields
+	if len(pnq.ctx.Fields) > 0 {
+		_spec.Unique = pnq.ctx.Unique != nil && *pnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pnq.driver, _spec)
+}
+
+func (pnq *PackageNameQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(packagename.Table, packagename.Columns, sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt))
+	_spec.From = pnq.sql
+	if unique := pnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, packagename.FieldID)
+		for i := range fields {
+			if fields[i] != packagename.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pnq *PackageNameQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pnq.driver.Dialect())
+	t1 := builder.Table(packagename.Table)
+	columns := pnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = packagename.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pnq.sql != nil {
+		selector = pnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pnq.ctx.Unique != nil && *pnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pnq.predicates {
+		p(selector)
+	}
+	for _, p := range pnq.order {
+		p(selector)
+	}
+	if offset := pnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PackageNameGroupBy is the group-by builder for PackageName entities.
+type PackageNameGroupBy struct {
+	selector
+	build *PackageNameQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pngb *PackageNameGroupBy) Aggregate(fns ...AggregateFunc) *PackageNameGroupBy {
+	pngb.fns = append(pngb.fns, fns...)
+	return pngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pngb *PackageNameGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pngb.build.ctx, "GroupBy")
+	if err := pngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PackageNameQuery, *PackageNameGroupBy](ctx, pngb.build, pngb, pngb.build.inters, v)
+}
+
+func (pngb *PackageNameGroupBy) sqlScan(ctx context.Context, root *PackageNameQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pngb.fns))
+	for _, fn := range pngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pngb.flds)+len(pngb.fns))
+		for _, f := range *pngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PackageNameSelect is the builder for selecting fields of PackageName entities.
+type PackageNameSelect struct {
+	*PackageNameQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pns *PackageNameSelect) Aggregate(fns ...AggregateFunc) *PackageNameSelect {
+	pns.fns = append(pns.fns, fns...)
+	return pns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pns *PackageNameSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pns.ctx, "Select")
+	if err := pns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PackageNameQuery, *PackageNameSelect](ctx, pns.PackageNameQuery, pns, pns.inters, v)
+}
+
+func (pns *PackageNameSelect) sqlScan(ctx context.Context, root *PackageNameQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pns.fns))
+	for _, fn := range pns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
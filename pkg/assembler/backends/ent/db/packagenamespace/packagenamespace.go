@@ -0,0 +1,51 @@
+// Code generated by ent, DO NOT EDIT.
+
+package packagenamespace
+
+const (
+	// Label holds the string label denoting the packagenamespace type in the database.
+	Label = "package_namespace"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// EdgeNames holds the string denoting the names edge name in mutations.
+	EdgeNames = "names"
+	// Table holds the table name of the packagenamespace in the database.
+	Table = "package_namespaces"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "package_namespaces"
+	// PackageInverseTable is the table name for the PackageType entity.
+	// It exists in this package in order to avoid circular dependency with the "packagetype" package.
+	PackageInverseTable = "package_types"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_id"
+	// NamesTable is the table that holds the names relation/edge.
+	NamesTable = "package_names"
+	// NamesInverseTable is the table name for the PackageName entity.
+	// It exists in this package in order to avoid circular dependency with the "packagename" package.
+	NamesInverseTable = "package_names"
+	// NamesColumn is the table column denoting the names relation/edge.
+	NamesColumn = "namespace_id"
+)
+
+// Columns holds all SQL columns for packagenamespace fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldNamespace,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// PackageNamespaceQuery is the builder for querying PackageNamespace entities.
+type PackageNamespaceQuery struct {
+	config
+	ctx         *QueryContext
+	order       []OrderFunc
+	inters      []Interceptor
+	predicates  []predicate.PackageNamespace
+	withPackage *PackageTypeQuery
+	withNames   *PackageNameQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PackageNamespaceQuery builder.
+func (pnq *PackageNamespaceQuery) Where(ps ...predicate.PackageNamespace) *PackageNamespaceQuery {
+	pnq.predicates = append(pnq.predicates, ps...)
+	return pnq
+}
+
+// Limit the number of records to be returned by this query.
+func (pnq *PackageNamespaceQuery) Limit(limit int) *PackageNamespaceQuery {
+	pnq.ctx.Limit = &limit
+	return pnq
+}
+
+// Offset to start from.
+func (pnq *PackageNamespaceQuery) Offset(offset int) *PackageNamespaceQuery {
+	pnq.ctx.Offset = &offset
+	return pnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pnq *PackageNamespaceQuery) Unique(unique bool) *PackageNamespaceQuery {
+	pnq.ctx.Unique = &unique
+	return pnq
+}
+
+// Order specifies how the records should be ordered.
+func (pnq *PackageNamespaceQuery) Order(o ...OrderFunc) *PackageNamespaceQuery {
+	pnq.order = append(pnq.order, o...)
+	return pnq
+}
+
+// QueryPackage chains the current query on the "package" edge.
+func (pnq *PackageNamespaceQuery) QueryPackage() *PackageTypeQuery {
+	query := (&PackageTypeClient{config: pnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagenamespace.Table, packagenamespace.FieldID, selector),
+			sqlgraph.To(packagetype.Table, packagetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, packagenamespace.PackageTable, packagenamespace.PackageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNames chains the current query on the "names" edge.
+func (pnq *PackageNamespaceQuery) QueryNames() *PackageNameQuery {
+	query := (&PackageNameClient{config: pnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagenamespace.Table, packagenamespace.FieldID, selector),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, packagenamespace.NamesTable, packagenamespace.NamesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PackageNamespace entity from the query.
+// Returns a *NotFoundError when no PackageNamespace was found.
+func (pnq *PackageNamespaceQuery) First(ctx context.Context) (*PackageNamespace, error) {
+	nodes, err := pnq.Limit(1).All(setContextOp(ctx, pnq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{packagenamespace.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) FirstX(ctx context.Context) *PackageNamespace {
+	node, err := pnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PackageNamespace ID from the query.
+// Returns a *NotFoundError when no PackageNamespace ID was found.
+func (pnq *PackageNamespaceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pnq.Limit(1).IDs(setContextOp(ctx, pnq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{packagenamespace.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) FirstIDX(ctx context.Context) int {
+	id, err := pnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PackageNamespace entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PackageNamespace entity is found.
+// Returns a *NotFoundError when no PackageNamespace entities are found.
+func (pnq *PackageNamespaceQuery) Only(ctx context.Context) (*PackageNamespace, error) {
+	nodes, err := pnq.Limit(2).All(setContextOp(ctx, pnq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{packagenamespace.Label}
+	default:
+		return nil, &NotSingularError{packagenamespace.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) OnlyX(ctx context.Context) *PackageNamespace {
+	node, err := pnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PackageNamespace ID in the query.
+// Returns a *NotSingularError when more than one PackageNamespace ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pnq *PackageNamespaceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pnq.Limit(2).IDs(setContextOp(ctx, pnq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{packagenamespace.Label}
+	default:
+		err = &NotSingularError{packagenamespace.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PackageNamespaces.
+func (pnq *PackageNamespaceQuery) All(ctx context.Context) ([]*PackageNamespace, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "All")
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PackageNamespace, *PackageNamespaceQuery]()
+	return withInterceptors[[]*PackageNamespace](ctx, pnq, qr, pnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) AllX(ctx context.Context) []*PackageNamespace {
+	nodes, err := pnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PackageNamespace IDs.
+func (pnq *PackageNamespaceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pnq.ctx.Unique == nil && pnq.path != nil {
+		pnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pnq.ctx, "IDs")
+	if err = pnq.Select(packagenamespace.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) IDsX(ctx context.Context) []int {
+	ids, err := pnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pnq *PackageNamespaceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "Count")
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pnq, querierCount[*PackageNamespaceQuery](), pnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) CountX(ctx context.Context) int {
+	count, err := pnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pnq *PackageNamespaceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pnq.ctx, "Exist")
+	switch _, err := pnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pnq *PackageNamespaceQuery) ExistX(ctx context.Context) bool {
+	exist, err := pnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PackageNamespaceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pnq *PackageNamespaceQuery) Clone() *PackageNamespaceQuery {
+	if pnq == nil {
+		return nil
+	}
+	return &PackageNamespaceQuery{
+		config:      pnq.config,
+		ctx:         pnq.ctx.Clone(),
+		order:       append([]OrderFunc{}, pnq.order...),
+		inters:      append([]Interceptor{}, pnq.inters...),
+		predicates:  append([]predicate.PackageNamespace{}, pnq.predicates...),
+		withPackage: pnq.withPackage.Clone(),
+		withNames:   pnq.withNames.Clone(),
+		// clone intermediate query.
+		sql:  pnq.sql.Clone(),
+		path: pnq.path,
+	}
+}
+
+// WithPackage tells the query-builder to eager-load the nodes that are connected to
+// the "package" edge. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNamespaceQuery) WithPackage(opts ...func(*PackageTypeQuery)) *PackageNamespaceQuery {
+	query := (&PackageTypeClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pnq.withPackage = query
+	return pnq
+}
+
+// WithNames tells the query-builder to eager-load the nodes that are connected to
+// the "names" edge. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNamespaceQuery) WithNames(opts ...func(*PackageNameQuery)) *PackageNamespaceQuery {
+	query := (&PackageNameClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pnq.withNames = query
+	return pnq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PackageNamespace.Query().
+//		GroupBy(packagenamespace.FieldPackageID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (pnq *PackageNamespaceQuery) GroupBy(field string, fields ...string) *PackageNamespaceGroupBy {
+	pnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PackageNamespaceGroupBy{build: pnq}
+	grbuild.flds = &pnq.ctx.Fields
+	grbuild.label = packagenamespace.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageID int `json:"package_id,omitempty"`
+//	}
+//
+//	client.PackageNamespace.Query().
+//		Select(packagenamespace.FieldPackageID).
+//		Scan(ctx, &v)
+func (pnq *PackageNamespaceQuery) Select(fields ...string) *PackageNamespaceSelect {
+	pnq.ctx.Fields = append(pnq.ctx.Fields, fields...)
+	sbuild := &PackageNamespaceSelect{PackageNamespaceQuery: pnq}
+	sbuild.label = packagenamespace.Label
+	sbuild.flds, sbuild.scan = &pnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PackageNamespaceSelect configured with the given aggregations.
+func (pnq *PackageNamespaceQuery) Aggregate(fns ...AggregateFunc) *PackageNamespaceSelect {
+	return pnq.Select().Aggregate(fns...)
+}
+
+func (pnq *PackageNamespaceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pnq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pnq.ctx.Fields {
+		if !packagenamespace.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if pnq.path != nil {
+		prev, err := pnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pnq.sql = prev
+	}
+	return nil
+}
+
+func (pnq *PackageNamespaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PackageNamespace, error) {
+	var (
+		nodes       = []*PackageNamespace{}
+		_spec       = pnq.querySpec()
+		loadedTypes = [2]bool{
+			pnq.withPackage != nil,
+			pnq.withNames != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PackageNamespace).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PackageNamespace{config: pnq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pnq.withPackage; query != nil {
+		if err := pnq.loadPackage(ctx, query, nodes, nil,
+			func(n *PackageNamespace, e *PackageType) { n.Edges.Package = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pnq.withNames; query != nil {
+		if err := pnq.loadNames(ctx, query, nodes,
+			func(n *PackageNamespace) { n.Edges.Names = []*PackageName{} },
+			func(n *PackageNamespace, e *PackageName) { n.Edges.Names = append(n.Edges.Names, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pnq *PackageNamespaceQuery) loadPackage(ctx context.Context, query *PackageTypeQuery, nodes []*PackageNamespace, init func(*PackageNamespace), assign func(*PackageNamespace, *PackageType)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PackageNamespace)
+	for i := range nodes {
+		fk := nodes[i].PackageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagetype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pnq *PackageNamespaceQuery) loadNames(ctx context.Context, query *PackageNameQuery, nodes []*PackageNamespace, init func(*PackageNamespace), assign func(*PackageNamespace, *PackageName)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PackageNamespace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.PackageName(func(s *sql.Selector) {
+		s.Where(sql.InValues(packagenamespace.NamesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NamespaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "namespace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pnq *PackageNamespaceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pnq.querySpec()
+	_spec.Node.Columns = pnq.ctx.Fields
+	if len(pnq.ctx.Fields) > 0 {
+		_spec.Unique = pnq.ctx.Unique != nil && *pnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pnq.driver, _spec)
+}
+
+func (pnq *PackageNamespaceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(packagenamespace.Table, packagenamespace.Columns, sqlgraph.NewFieldSpec(packagenamespace.FieldID, field.TypeInt))
+	_spec.From = pnq.sql
+	if unique := pnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, packagenamespace.FieldID)
+		for i := range fields {
+			if fields[i] != packagenamespace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pnq *PackageNamespaceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pnq.driver.Dialect())
+	t1 := builder.Table(packagenamespace.Table)
+	columns := pnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = packagenamespace.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pnq.sql != nil {
+		selector = pnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pnq.ctx.Unique != nil && *pnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pnq.predicates {
+		p(selector)
+	}
+	for _, p := range pnq.order {
+		p(selector)
+	}
+	if offset := pnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PackageNamespaceGroupBy is the group-by builder for PackageNamespace entities.
+type PackageNamespaceGroupBy struct {
+	selector
+	build *PackageNamespaceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pngb *PackageNamespaceGroupBy) Aggregate(fns ...AggregateFunc) *PackageNamespaceGroupBy {
+	pngb.fns = append(pngb.fns, fns...)
+	return pngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pngb *PackageNamespaceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pngb.build.ctx, "GroupBy")
+	if err := pngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PackageNamespaceQuery, *PackageNamespaceGroupBy](ctx, pngb.build, pngb, pngb.build.inters, v)
+}
+
+func (pngb *PackageNamespaceGroupBy) sqlScan(ctx context.Context, root *PackageNamespaceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pngb.fns))
+	for _, fn := range pngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pngb.flds)+len(pngb.fns))
+		for _, f := range *pngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PackageNamespaceSelect is the builder for selecting fields of PackageNamespace entities.
+type PackageNamespaceSelect struct {
+	*PackageNamespaceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pns *PackageNamespaceSelect) Aggregate(fns ...AggregateFunc) *PackageNamespaceSelect {
+	pns.fns = append(pns.fns, fns...)
+	return pns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pns *PackageNamespaceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pns.ctx, "Select")
+	if err := pns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PackageNamespaceQuery, *PackageNamespaceSelect](ctx, pns.PackageNamespaceQuery, pns, pns.inters, v)
+}
+
+func (pns *PackageNamespaceSelect) sqlScan(ctx context.Context, root *PackageNamespaceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pns.fns))
+	for _, fn := range pns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
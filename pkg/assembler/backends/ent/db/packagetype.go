@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+)
+
+// PackageType is the model entity for the PackageType schema.
+type PackageType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PackageTypeQuery when eager-loading is set.
+	Edges PackageTypeEdges `json:"edges"`
+}
+
+// PackageTypeEdges holds the relations/edges for other nodes in the graph.
+type PackageTypeEdges struct {
+	// Namespaces holds the value of the namespaces edge.
+	Namespaces []*PackageNamespace `json:"namespaces,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NamespacesOrErr returns the Namespaces value or an error if the edge
+// was not loaded in eager-loading.
+func (e PackageTypeEdges) NamespacesOrErr() ([]*PackageNamespace, error) {
+	if e.loadedTypes[0] {
+		return e.Namespaces, nil
+	}
+	return nil, &NotLoadedError{edge: "namespaces"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PackageType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case packagetype.FieldID:
+			values[i] = new(sql.NullInt64)
+		case packagetype.FieldType:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PackageType", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PackageType fields.
+func (pt *PackageType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case packagetype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pt.ID = int(value.Int64)
+		case packagetype.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pt.Type = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryNamespaces queries the "namespaces" edge of the PackageType entity.
+func (pt *PackageType) QueryNamespaces() *PackageNamespaceQuery {
+	return NewPackageTypeClient(pt.config).QueryNamespaces(pt)
+}
+
+// Update returns a builder for updating this PackageType.
+// Note that you need to call PackageType.Unwrap() before calling this method if this PackageType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *PackageType) Update() *PackageTypeUpdateOne {
+	return NewPackageTypeClient(pt.config).UpdateOne(pt)
+}
+
+// Unwrap unwraps the PackageType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *PackageType) Unwrap() *PackageType {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PackageType is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *PackageType) String() string {
+	var builder strings.Builder
+	builder.WriteString("PackageType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("type=")
+	builder.WriteString(pt.Type)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PackageTypes is a parsable slice of PackageType.
+type PackageTypes []*PackageType
@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package packagetype
+
+const (
+	// Label holds the string label denoting the packagetype type in the database.
+	Label = "package_type"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// EdgeNamespaces holds the string denoting the namespaces edge name in mutations.
+	EdgeNamespaces = "namespaces"
+	// Table holds the table name of the packagetype in the database.
+	Table = "package_types"
+	// NamespacesTable is the table that holds the namespaces relation/edge.
+	NamespacesTable = "package_namespaces"
+	// NamespacesInverseTable is the table name for the PackageNamespace entity.
+	// It exists in this package in order to avoid circular dependency with the "packagenamespace" package.
+	NamespacesInverseTable = "package_namespaces"
+	// NamespacesColumn is the table column denoting the namespaces relation/edge.
+	NamespacesColumn = "package_id"
+)
+
+// Columns holds all SQL columns for packagetype fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
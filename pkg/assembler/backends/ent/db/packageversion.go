@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// PackageVersion is the model entity for the PackageVersion schema.
+type PackageVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NameID holds the value of the "name_id" field.
+	NameID int `json:"name_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Subpath holds the value of the "subpath" field.
+	Subpath string `json:"subpath,omitempty"`
+	// Qualifiers holds the value of the "qualifiers" field.
+	Qualifiers []model.PackageQualifier `json:"qualifiers,omitempty"`
+	// QualifiersKey holds the value of the "qualifiers_key" field.
+	QualifiersKey string `json:"qualifiers_key,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PackageVersionQuery when eager-loading is set.
+	Edges PackageVersionEdges `json:"edges"`
+}
+
+// PackageVersionEdges holds the relations/edges for other nodes in the graph.
+type PackageVersionEdges struct {
+	// Name holds the value of the name edge.
+	Name *PackageName `json:"name,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NameOrErr returns the Name value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PackageVersionEdges) NameOrErr() (*PackageName, error) {
+	if e.loadedTypes[0] {
+		if e.Name == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packagename.Label}
+		}
+		return e.Name, nil
+	}
+	return nil, &NotLoadedError{edge: "name"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PackageVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case packageversion.FieldQualifiers:
+			values[i] = new([]byte)
+		case packageversion.FieldID, packageversion.FieldNameID:
+			values[i] = new(sql.NullInt64)
+		case packageversion.FieldVersion, packageversion.FieldSubpath, packageversion.FieldQualifiersKey:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PackageVersion", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PackageVersion fields.
+func (pv *PackageVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case packageversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pv.ID = int(value.Int64)
+		case packageversion.FieldNameID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id", values[i])
+			} else if value.Valid {
+				pv.NameID = int(value.Int64)
+			}
+		case packageversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pv.Version = value.String
+			}
+		case packageversion.FieldSubpath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subpath", values[i])
+			} else if value.Valid {
+				pv.Subpath = value.String
+			}
+		case packageversion.FieldQualifiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field qualifiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Qualifiers); err != nil {
+					return fmt.Errorf("unmarshal field qualifiers: %w", err)
+				}
+			}
+		case packageversion.FieldQualifiersKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field qualifiers_key", values[i])
+			} else if value.Valid {
+				pv.QualifiersKey = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryName queries the "name" edge of the PackageVersion entity.
+func (pv *PackageVersion) QueryName() *PackageNameQuery {
+	return NewPackageVersionClient(pv.config).QueryName(pv)
+}
+
+// Update returns a builder for updating this PackageVersion.
+// Note that you need to call PackageVersion.Unwrap() before calling this method if this PackageVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pv *PackageVersion) Update() *PackageVersionUpdateOne {
+	return NewPackageVersionClient(pv.config).UpdateOne(pv)
+}
+
+// Unwrap unwraps the PackageVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pv *PackageVersion) Unwrap() *PackageVersion {
+	_tx, ok := pv.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PackageVersion is not a transactional entity")
+	}
+	pv.config.driver = _tx.drv
+	return pv
+}
+
+// String implements the fmt.Stringer.
+func (pv *PackageVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("PackageVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("name_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.NameID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(pv.Version)
+	builder.WriteString(", ")
+	builder.WriteString("subpath=")
+	builder.WriteString(pv.Subpath)
+	builder.WriteString(", ")
+	builder.WriteString("qualifiers=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Qualifiers))
+	builder.WriteString(", ")
+	builder.WriteString("qualifiers_key=")
+	builder.WriteString(pv.QualifiersKey)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PackageVersions is a parsable slice of PackageVersion.
+type PackageVersions []*PackageVersion
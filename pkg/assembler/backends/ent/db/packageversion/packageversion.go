@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package packageversion
+
+const (
+	// Label holds the string label denoting the packageversion type in the database.
+	Label = "package_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNameID holds the string denoting the name_id field in the database.
+	FieldNameID = "name_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSubpath holds the string denoting the subpath field in the database.
+	FieldSubpath = "subpath"
+	// FieldQualifiers holds the string denoting the qualifiers field in the database.
+	FieldQualifiers = "qualifiers"
+	// FieldQualifiersKey holds the string denoting the qualifiers_key field in the database.
+	FieldQualifiersKey = "qualifiers_key"
+	// EdgeName holds the string denoting the name edge name in mutations.
+	EdgeName = "name"
+	// Table holds the table name of the packageversion in the database.
+	Table = "package_versions"
+	// NameTable is the table that holds the name relation/edge.
+	NameTable = "package_versions"
+	// NameInverseTable is the table name for the PackageName entity.
+	// It exists in this package in order to avoid circular dependency with the "packagename" package.
+	NameInverseTable = "package_names"
+	// NameColumn is the table column denoting the name relation/edge.
+	NameColumn = "name_id"
+)
+
+// Columns holds all SQL columns for packageversion fields.
+var Columns = []string{
+	FieldID,
+	FieldNameID,
+	FieldVersion,
+	FieldSubpath,
+	FieldQualifiers,
+	FieldQualifiersKey,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion string
+	// DefaultSubpath holds the default value on creation for the "subpath" field.
+	DefaultSubpath string
+	// DefaultQualifiersKey holds the default value on creation for the "qualifiers_key" field.
+	DefaultQualifiersKey string
+)
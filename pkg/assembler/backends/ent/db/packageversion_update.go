@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// PackageVersionUpdate is the builder for updating PackageVersion entities.
+type PackageVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PackageVersionMutation
+}
+
+// Where appends a list predicates to the PackageVersionUpdate builder.
+func (pvu *PackageVersionUpdate) Where(ps ...predicate.PackageVersion) *PackageVersionUpdate {
+	pvu.mutation.Where(ps...)
+	return pvu
+}
+
+// SetNameID sets the "name_id" field.
+func (pvu *PackageVersionUpdate) SetNameID(i int) *PackageVersionUpdate {
+	pvu.mutation.SetNameID(i)
+	return pvu
+}
+
+// SetVersion sets the "version" field.
+func (pvu *PackageVersionUpdate) SetVersion(s string) *PackageVersionUpdate {
+	pvu.mutation.SetVersion(s)
+	return pvu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pvu *PackageVersionUpdate) SetNillableVersion(s *string) *PackageVersionUpdate {
+	if s != nil {
+		pvu.SetVersion(*s)
+	}
+	return pvu
+}
+
+// SetSubpath sets the "subpath" field.
+func (pvu *PackageVersionUpdate) SetSubpath(s string) *PackageVersionUpdate {
+	pvu.mutation.SetSubpath(s)
+	return pvu
+}
+
+// SetNillableSubpath sets the "subpath" field if the given value is not nil.
+func (pvu *PackageVersionUpdate) SetNillableSubpath(s *string) *PackageVersionUpdate {
+	if s != nil {
+		pvu.SetSubpath(*s)
+	}
+	return pvu
+}
+
+// SetQualifiers sets the "qualifiers" field.
+func (pvu *PackageVersionUpdate) SetQualifiers(mq []model.PackageQualifier) *PackageVersionUpdate {
+	pvu.mutation.SetQualifiers(mq)
+	return pvu
+}
+
+// AppendQualifiers appends mq to the "qualifiers" field.
+func (pvu *PackageVersionUpdate) AppendQualifiers(mq []model.PackageQualifier) *PackageVersionUpdate {
+	pvu.mutation.AppendQualifiers(mq)
+	return pvu
+}
+
+// SetQualifiersKey sets the "qualifiers_key" field.
+func (pvu *PackageVersionUpdate) SetQualifiersKey(s string) *PackageVersionUpdate {
+	pvu.mutation.SetQualifiersKey(s)
+	return pvu
+}
+
+// SetNillableQualifiersKey sets the "qualifiers_key" field if the given value is not nil.
+func (pvu *PackageVersionUpdate) SetNillableQualifiersKey(s *string) *PackageVersionUpdate {
+	if s != nil {
+		pvu.SetQualifiersKey(*s)
+	}
+	return pvu
+}
+
+// SetName sets the "name" edge to the PackageName entity.
+func (pvu *PackageVersionUpdate) SetName(p *PackageName) *PackageVersionUpdate {
+	return pvu.SetNameID(p.ID)
+}
+
+// Mutation returns the PackageVersionMutation object of the builder.
+func (pvu *PackageVersionUpdate) Mutation() *PackageVersionMutation {
+	return pvu.mutation
+}
+
+// ClearName clears the "name" edge to the PackageName entity.
+func (pvu *PackageVersionUpdate) ClearName() *PackageVersionUpdate {
+	pvu.mutation.ClearName()
+	return pvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pvu *PackageVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, PackageVersionMutation](ctx, pvu.sqlSave, pvu.mutation, pvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvu *PackageVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pvu *PackageVersionUpdate) Exec(ctx context.Context) error {
+	_, err := pvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvu *PackageVersionUpdate) ExecX(ctx context.Context) {
+	if err := pvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvu *PackageVersionUpdate) check() error {
+	if _, ok := pvu.mutation.NameID(); pvu.mutation.NameCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "PackageVersion.name"`)
+	}
+	return nil
+}
+
+func (pvu *PackageVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(packageversion.Table, packageversion.Columns, sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt))
+	if ps := pvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvu.mutation.Version(); ok {
+		_spec.SetField(packageversion.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Subpath(); ok {
+		_spec.SetField(packageversion.FieldSubpath, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Qualifiers(); ok {
+		_spec.SetField(packageversion.FieldQualifiers, field.TypeJSON, value)
+	}
+	if value, ok := pvu.mutation.AppendedQualifiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, packageversion.FieldQualifiers, value)
+		})
+	}
+	if value, ok := pvu.mutation.QualifiersKey(); ok {
+		_spec.SetField(packageversion.FieldQualifiersKey, field.TypeString, value)
+	}
+	if pvu.mutation.NameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   packageversion.NameTable,
+			Columns: []string{packageversion.NameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.NameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   packageversion.NameTable,
+			Columns: []string{packageversion.NameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{packageversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pvu.mutation.done = true
+	return n, nil
+}
+
+// PackageVersionUpdateOne is the builder for updating a single PackageVersion entity.
+type PackageVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PackageVersionMutation
+}
+
+// SetNameID sets the "name_id" field.
+func (pvuo *PackageVersionUpdateOne) SetNameID(i int) *PackageVersionUpdateOne {
+	pvuo.mutation.SetNameID(i)
+	return pvuo
+}
+
+// SetVersion sets the "version" field.
+func (pvuo *PackageVersionUpdateOne) SetVersion(s string) *PackageVersionUpdateOne {
+	pvuo.mutation.SetVersion(s)
+	return pvuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pvuo *PackageVersionUpdateOne) SetNillableVersion(s *string) *PackageVersionUpdateOne {
+	if s != nil {
+		pvuo.SetVersion(*s)
+	}
+	return pvuo
+}
+
+// SetSubpath sets the "subpath" field.
+func (pvuo *PackageVersionUpdateOne) SetSubpath(s string) *PackageVersionUpdateOne {
+	pvuo.mutation.SetSubpath(s)
+	return pvuo
+}
+
+// SetNillableSubpath sets the "subpath" field if the given value is not nil.
+func (pvuo *PackageVersionUpdateOne) SetNillableSubpath(s *string) *PackageVersionUpdateOne {
+	if s != nil {
+		pvuo.SetSubpath(*s)
+	}
+	return pvuo
+}
+
+// SetQualifiers sets the "qualifiers" field.
+func (pvuo *PackageVersionUpdateOne) SetQualifiers(mq []model.PackageQualifier) *PackageVersionUpdateOne {
+	pvuo.mutation.SetQualifiers(mq)
+	return pvuo
+}
+
+// AppendQualifiers appends mq to the "qualifiers" field.
+func (pvuo *PackageVersionUpdateOne) AppendQualifiers(mq []model.PackageQualifier) *PackageVersionUpdateOne {
+	pvuo.mutation.AppendQualifiers(mq)
+	return pvuo
+}
+
+// SetQualifiersKey sets the "qualifiers_key" field.
+func (pvuo *PackageVersionUpdateOne) SetQualifiersKey(s string) *PackageVersionUpdateOne {
+	pvuo.mutation.SetQualifiersKey(s)
+	return pvuo
+}
+
+// SetNillableQualifiersKey sets the "qualifiers_key" field if the given value is not nil.
+func (pvuo *PackageVersionUpdateOne) SetNillableQualifiersKey(s *string) *PackageVersionUpdateOne {
+	if s != nil {
+		pvuo.SetQualifiersKey(*s)
+	}
+	return pvuo
+}
+
+// SetName sets the "name" edge to the PackageName entity.
+func (pvuo *PackageVersionUpdateOne) SetName(p *PackageName) *PackageVersionUpdateOne {
+	return pvuo.SetNameID(p.ID)
+}
+
+// Mutation returns the PackageVersionMutation object of the builder.
+func (pvuo *PackageVersionUpdateOne) Mutation() *PackageVersionMutation {
+	return pvuo.mutation
+}
+
+// ClearName clears the "name" edge to the PackageName entity.
+func (pvuo *PackageVersionUpdateOne) ClearName() *PackageVersionUpdateOne {
+	pvuo.mutation.ClearName()
+	return pvuo
+}
+
+// Where appends a list predicates to the PackageVersionUpdate builder.
+func (pvuo *PackageVersionUpdateOne) Where(ps ...predicate.PackageVersion) *PackageVersionUpdateOne {
+	pvuo.mutation.Where(ps...)
+	return pvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pvuo *PackageVersionUpdateOne) Select(field string, fields ...string) *PackageVersionUpdateOne {
+	pvuo.fields = append([]string{field}, fields...)
+	return pvuo
+}
+
+// Save executes the query and returns the updated PackageVersion entity.
+func (pvuo *PackageVersionUpdateOne) Save(ctx context.Context) (*PackageVersion, error) {
+	return withHooks[*PackageVersion, PackageVersionMutation](ctx, pvuo.sqlSave, pvuo.mutation, pvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvuo *PackageVersionUpdateOne) SaveX(ctx context.Context) *PackageVersion {
+	node, err := pvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pvuo *PackageVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvuo *PackageVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := pvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvuo *PackageVersionUpdateOne) check() error {
+	if _, ok := pvuo.mutation.NameID(); pvuo.mutation.NameCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "PackageVersion.name"`)
+	}
+	return nil
+}
+
+func (pvuo *PackageVersionUpdateOne) sqlSave(ctx context.Context) (_node *PackageVersion, err error) {
+	if err := pvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(packageversion.Table, packageversion.Columns, sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeInt))
+	id, ok := pvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "PackageVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, packageversion.FieldID)
+		for _, f := range fields {
+			if !packageversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != packageversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvuo.mutation.Version(); ok {
+		_spec.SetField(packageversion.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Subpath(); ok {
+		_spec.SetField(packageversion.FieldSubpath, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Qualifiers(); ok {
+		_spec.SetField(packageversion.FieldQualifiers, field.TypeJSON, value)
+	}
+	if value, ok := pvuo.mutation.AppendedQualifiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, packageversion.FieldQualifiers, value)
+		})
+	}
+	if value, ok := pvuo.mutation.QualifiersKey(); ok {
+		_spec.SetField(packageversion.FieldQualifiersKey, field.TypeString, value)
+	}
+	if pvuo.mutation.NameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   packageversion.NameTable,
+			Columns: []string{packageversion.NameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.NameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   packageversion.NameTable,
+			Columns: []string{packageversion.NameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PackageVersion{config: pvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{packageversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pkgequal
+
+const (
+	// Label holds the string label denoting the pkgequal type in the database.
+	Label = "pkg_equal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageAID holds the string denoting the package_a_id field in the database.
+	FieldPackageAID = "package_a_id"
+	// FieldPackageBID holds the string denoting the package_b_id field in the database.
+	FieldPackageBID = "package_b_id"
+	// FieldJustification holds the string denoting the justification field in the database.
+	FieldJustification = "justification"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgePackageA holds the string denoting the package_a edge name in mutations.
+	EdgePackageA = "package_a"
+	// EdgePackageB holds the string denoting the package_b edge name in mutations.
+	EdgePackageB = "package_b"
+	// Table holds the table name of the pkgequal in the database.
+	Table = "pkg_equals"
+	// PackageATable is the table that holds the package_a relation/edge.
+	PackageATable = "pkg_equals"
+	// PackageAInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageAInverseTable = "package_versions"
+	// PackageAColumn is the table column denoting the package_a relation/edge.
+	PackageAColumn = "package_a_id"
+	// PackageBTable is the table that holds the package_b relation/edge.
+	PackageBTable = "pkg_equals"
+	// PackageBInverseTable is the table name for the PackageVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "packageversion" package.
+	PackageBInverseTable = "package_versions"
+	// PackageBColumn is the table column denoting the package_b relation/edge.
+	PackageBColumn = "package_b_id"
+)
+
+// Columns holds all SQL columns for pkgequal fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageAID,
+	FieldPackageBID,
+	FieldJustification,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
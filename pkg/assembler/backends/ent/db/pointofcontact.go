@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// PointOfContact is the model entity for the PointOfContact schema.
+type PointOfContact struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageVersionID holds the value of the "package_version_id" field.
+	PackageVersionID *int `json:"package_version_id,omitempty"`
+	// PackageNameID holds the value of the "package_name_id" field.
+	PackageNameID *int `json:"package_name_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID *int `json:"source_id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID *int `json:"artifact_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Info holds the value of the "info" field.
+	Info string `json:"info,omitempty"`
+	// Since holds the value of the "since" field.
+	Since time.Time `json:"since,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PointOfContactQuery when eager-loading is set.
+	Edges PointOfContactEdges `json:"edges"`
+}
+
+// PointOfContactEdges holds the relations/edges for other nodes in the graph.
+type PointOfContactEdges struct {
+	// PackageVersion holds the value of the package_version edge.
+	PackageVersion *PackageVersion `json:"package_version,omitempty"`
+	// PackageName holds the value of the package_name edge.
+	PackageName *PackageName `json:"package_name,omitempty"`
+	// Source holds the value of the source edge.
+	Source *SourceName `json:"source,omitempty"`
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PackageVersionOrErr returns the PackageVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PointOfContactEdges) PackageVersionOrErr() (*PackageVersion, error) {
+	if e.loadedTypes[0] {
+		if e.PackageVersion == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packageversion.Label}
+		}
+		return e.PackageVersion, nil
+	}
+	return nil, &NotLoadedError{edge: "package_version"}
+}
+
+// PackageNameOrErr returns the PackageName value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PointOfContactEdges) PackageNameOrErr() (*PackageName, error) {
+	if e.loadedTypes[1] {
+		if e.PackageName == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: packagename.Label}
+		}
+		return e.PackageName, nil
+	}
+	return nil, &NotLoadedError{edge: "package_name"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PointOfContactEdges) SourceOrErr() (*SourceName, error) {
+	if e.loadedTypes[2] {
+		if e.Source == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: sourcename.Label}
+		}
+		return e.Source, nil
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PointOfContactEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.loadedTypes[3] {
+		if e.Artifact == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: artifact.Label}
+		}
+		return e.Artifact, nil
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PointOfContact) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pointofcontact.FieldID, pointofcontact.FieldPackageVersionID, pointofcontact.FieldPackageNameID, pointofcontact.FieldSourceID, pointofcontact.FieldArtifactID:
+			values[i] = new(sql.NullInt64)
+		case pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector:
+			values[i] = new(sql.NullString)
+		case pointofcontact.FieldSince:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PointOfContact", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PointOfContact fields.
+func (poc *PointOfContact) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pointofcontact.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			poc.ID = int(value.Int64)
+		case pointofcontact.FieldPackageVersionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_version_id", values[i])
+			} else if value.Valid {
+				poc.PackageVersionID = new(int)
+				*poc.PackageVersionID = int(value.Int64)
+			}
+		case pointofcontact.FieldPackageNameID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name_id", values[i])
+			} else if value.Valid {
+				poc.PackageNameID = new(int)
+				*poc.PackageNameID = int(value.Int64)
+			}
+		case pointofcontact.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				poc.SourceID = new(int)
+				*poc.SourceID = int(value.Int64)
+			}
+		case pointofcontact.FieldArtifactID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value.Valid {
+				poc.ArtifactID = new(int)
+				*poc.ArtifactID = int(value.Int64)
+			}
+		case pointofcontact.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				poc.Email = value.String
+			}
+		case pointofcontact.FieldInfo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field info", values[i])
+			} else if value.Valid {
+				poc.Info = value.String
+			}
+		case pointofcontact.FieldSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field since", values[i])
+			} else if value.Valid {
+				poc.Since = value.Time
+			}
+		case pointofcontact.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				poc.Justification = value.String
+			}
+		case pointofcontact.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				poc.Origin = value.String
+			}
+		case pointofcontact.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				poc.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPackageVersion queries the "package_version" edge of the PointOfContact entity.
+func (poc *PointOfContact) QueryPackageVersion() *PackageVersionQuery {
+	return NewPointOfContactClient(poc.config).QueryPackageVersion(poc)
+}
+
+// QueryPackageName queries the "package_name" edge of the PointOfContact entity.
+func (poc *PointOfContact) QueryPackageName() *PackageNameQuery {
+	return NewPointOfContactClient(poc.config).QueryPackageName(poc)
+}
+
+// QuerySource queries the "source" edge of the PointOfContact entity.
+func (poc *PointOfContact) QuerySource() *SourceNameQuery {
+	return NewPointOfContactClient(poc.config).QuerySource(poc)
+}
+
+// QueryArtifact queries the "artifact" edge of the PointOfContact entity.
+func (poc *PointOfContact) QueryArtifact() *ArtifactQuery {
+	return NewPointOfContactClient(poc.config).QueryArtifact(poc)
+}
+
+// Update returns a builder for updating this PointOfContact.
+// Note that you need to call PointOfContact.Unwrap() before calling this method if this PointOfContact
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (poc *PointOfContact) Update() *PointOfContactUpdateOne {
+	return NewPointOfContactClient(poc.config).UpdateOne(poc)
+}
+
+// Unwrap unwraps the PointOfContact entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (poc *PointOfContact) Unwrap() *PointOfContact {
+	_tx, ok := poc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PointOfContact is not a transactional entity")
+	}
+	poc.config.driver = _tx.drv
+	return poc
+}
+
+// String implements the fmt.Stringer.
+func (poc *PointOfContact) String() string {
+	var builder strings.Builder
+	builder.WriteString("PointOfContact(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", poc.ID))
+	if v := poc.PackageVersionID; v != nil {
+		builder.WriteString("package_version_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := poc.PackageNameID; v != nil {
+		builder.WriteString("package_name_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := poc.SourceID; v != nil {
+		builder.WriteString("source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := poc.ArtifactID; v != nil {
+		builder.WriteString("artifact_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(poc.Email)
+	builder.WriteString(", ")
+	builder.WriteString("info=")
+	builder.WriteString(poc.Info)
+	builder.WriteString(", ")
+	builder.WriteString("since=")
+	builder.WriteString(poc.Since.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(poc.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(poc.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(poc.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PointOfContacts is a parsable slice of PointOfContact.
+type PointOfContacts []*PointOfContact
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// PointOfContactDelete is the builder for deleting a PointOfContact entity.
+type PointOfContactDelete struct {
+	config
+	hooks    []Hook
+	mutation *PointOfContactMutation
+}
+
+// Where appends a list predicates to the PointOfContactDelete builder.
+func (pocd *PointOfContactDelete) Where(ps ...predicate.PointOfContact) *PointOfContactDelete {
+	pocd.mutation.Where(ps...)
+	return pocd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pocd *PointOfContactDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, PointOfContactMutation](ctx, pocd.sqlExec, pocd.mutation, pocd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pocd *PointOfContactDelete) ExecX(ctx context.Context) int {
+	n, err := pocd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pocd *PointOfContactDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pointofcontact.Table, sqlgraph.NewFieldSpec(pointofcontact.FieldID, field.TypeInt))
+	if ps := pocd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pocd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pocd.mutation.done = true
+	return affected, err
+}
+
+// PointOfContactDeleteOne is the builder for deleting a single PointOfContact entity.
+type PointOfContactDeleteOne struct {
+	pocd *PointOfContactDelete
+}
+
+// Where appends a list predicates to the PointOfContactDelete builder.
+func (pocdo *PointOfContactDeleteOne) Where(ps ...predicate.PointOfContact) *PointOfContactDeleteOne {
+	pocdo.pocd.mutation.Where(ps...)
+	return pocdo
+}
+
+// Exec executes the deletion query.
+func (pocdo *PointOfContactDeleteOne) Exec(ctx context.Context) error {
+	n, err := pocdo.pocd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pointofcontact.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pocdo *PointOfContactDeleteOne) ExecX(ctx context.Context) {
+	if err := pocdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+)
+
+// PointOfContactQuery is the builder for querying PointOfContact entities.
+type PointOfContactQuery struct {
+	config
+	ctx                *QueryContext
+	order              []OrderFunc
+	inters             []Interceptor
+	predicates         []predicate.PointOfContact
+	withPackageVersion *PackageVersionQuery
+	withPackageName    *PackageNameQuery
+	withSource         *SourceNameQuery
+	withArtifact       *ArtifactQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PointOfContactQuery builder.
+func (pocq *PointOfContactQuery) Where(ps ...predicate.PointOfContact) *PointOfContactQuery {
+	pocq.predicates = append(pocq.predicates, ps...)
+	return pocq
+}
+
+// Limit the number of records to be returned by this query.
+func (pocq *PointOfContactQuery) Limit(limit int) *PointOfContactQuery {
+	pocq.ctx.Limit = &limit
+	return pocq
+}
+
+// Offset to start from.
+func (pocq *PointOfContactQuery) Offset(offset int) *PointOfContactQuery {
+	pocq.ctx.Offset = &offset
+	return pocq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pocq *PointOfContactQuery) Unique(unique bool) *PointOfContactQuery {
+	pocq.ctx.Unique = &unique
+	return pocq
+}
+
+// Order specifies how the records should be ordered.
+func (pocq *PointOfContactQuery) Order(o ...OrderFunc) *PointOfContactQuery {
+	pocq.order = append(pocq.order, o...)
+	return pocq
+}
+
+// QueryPackageVersion chains the current query on the "package_version" edge.
+func (pocq *PointOfContactQuery) QueryPackageVersion() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: pocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.PackageVersionTable, pointofcontact.PackageVersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackageName chains the current query on the "package_name" edge.
+func (pocq *PointOfContactQuery) QueryPackageName() *PackageNameQuery {
+	query := (&PackageNameClient{config: pocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, selector),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.PackageNameTable, pointofcontact.PackageNameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySource chains the current query on the "source" edge.
+func (pocq *PointOfContactQuery) QuerySource() *SourceNameQuery {
+	query := (&SourceNameClient{config: pocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, selector),
+			sqlgraph.To(sourcename.Table, sourcename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.SourceTable, pointofcontact.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (pocq *PointOfContactQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: pocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pointofcontact.Table, pointofcontact.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pointofcontact.ArtifactTable, pointofcontact.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PointOfContact entity from the query.
+// Returns a *NotFoundError when no PointOfContact was found.
+func (pocq *PointOfContactQuery) First(ctx context.Context) (*PointOfContact, error) {
+	nodes, err := pocq.Limit(1).All(setContextOp(ctx, pocq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pointofcontact.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pocq *PointOfContactQuery) FirstX(ctx context.Context) *PointOfContact {
+	node, err := pocq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PointOfContact ID from the query.
+// Returns a *NotFoundError when no PointOfContact ID was found.
+func (pocq *PointOfContactQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pocq.Limit(1).IDs(setContextOp(ctx, pocq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pointofcontact.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pocq *PointOfContactQuery) FirstIDX(ctx context.Context) int {
+	id, err := pocq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PointOfContact entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PointOfContact entity is found.
+// Returns a *NotFoundError when no PointOfContact entities are found.
+func (pocq *PointOfContactQuery) Only(ctx context.Context) (*PointOfContact, error) {
+	nodes, err := pocq.Limit(2).All(setContextOp(ctx, pocq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pointofcontact.Label}
+	default:
+		return nil, &NotSingularError{pointofcontact.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pocq *PointOfContactQuery) OnlyX(ctx context.Context) *PointOfContact {
+	node, err := pocq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PointOfContact ID in the query.
+// Returns a *NotSingularError when more than one PointOfContact ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pocq *PointOfContactQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pocq.Limit(2).IDs(setContextOp(ctx, pocq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pointofcontact.Label}
+	default:
+		err = &NotSingularError{pointofcontact.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pocq *PointOfContactQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pocq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PointOfContacts.
+func (pocq *PointOfContactQuery) All(ctx context.Context) ([]*PointOfContact, error) {
+	ctx = setContextOp(ctx, pocq.ctx, "All")
+	if err := pocq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PointOfContact, *PointOfContactQuery]()
+	return withInterceptors[[]*PointOfContact](ctx, pocq, qr, pocq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pocq *PointOfContactQuery) AllX(ctx context.Context) []*PointOfContact {
+	nodes, err := pocq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PointOfContact IDs.
+func (pocq *PointOfContactQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pocq.ctx.Unique == nil && pocq.path != nil {
+		pocq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pocq.ctx, "IDs")
+	if err = pocq.Select(pointofcontact.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pocq *PointOfContactQuery) IDsX(ctx context.Context) []int {
+	ids, err := pocq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pocq *PointOfContactQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pocq.ctx, "Count")
+	if err := pocq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pocq, querierCount[*PointOfContactQuery](), pocq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pocq *PointOfContactQuery) CountX(ctx context.Context) int {
+	count, err := pocq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pocq *PointOfContactQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pocq.ctx, "Exist")
+	switch _, err := pocq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pocq *PointOfContactQuery) ExistX(ctx context.Context) bool {
+	exist, err := pocq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PointOfContactQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pocq *PointOfContactQuery) Clone() *PointOfContactQuery {
+	if pocq == nil {
+		return nil
+	}
+	return &PointOfContactQuery{
+		config:             pocq.config,
+		ctx:                pocq.ctx.Clone(),
+		order:              append([]OrderFunc{}, pocq.order...),
+		inters:             append([]Interceptor{}, pocq.inters...),
+		predicates:         append([]predicate.PointOfContact{}, pocq.predicates...),
+		withPackageVersion: pocq.withPackageVersion.Clone(),
+		withPackageName:    pocq.withPackageName.Clone(),
+		withSource:         pocq.withSource.Clone(),
+		withArtifact:       pocq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  pocq.sql.Clone(),
+		path: pocq.path,
+	}
+}
+
+// WithPackageVersion tells the query-builder to eager-load the nodes that are connected to
+// the "package_version" edge. The optional arguments are used to configure the query builder of the edge.
+func (pocq *PointOfContactQuery) WithPackageVersion(opts ...func(*PackageVersionQuery)) *PointOfContactQuery {
+	query := (&PackageVersionClient{config: pocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pocq.withPackageVersion = query
+	return pocq
+}
+
+// WithPackageName tells the query-builder to eager-load the nodes that are connected to
+// the "package_name" edge. The optional arguments are used to configure the query builder of the edge.
+func (pocq *PointOfContactQuery) WithPackageName(opts ...func(*PackageNameQuery)) *PointOfContactQuery {
+	query := (&PackageNameClient{config: pocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pocq.withPackageName = query
+	return pocq
+}
+
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (pocq *PointOfContactQuery) WithSource(opts ...func(*SourceNameQuery)) *PointOfContactQuery {
+	query := (&SourceNameClient{config: pocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pocq.withSource = query
+	return pocq
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (pocq *PointOfContactQuery) WithArtifact(opts ...func(*ArtifactQuery)) *PointOfContactQuery {
+	query := (&ArtifactClient{config: pocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pocq.withArtifact = query
+	return pocq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PointOfContact.Query().
+//		GroupBy(pointofcontact.FieldPackageVersionID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (pocq *PointOfContactQuery) GroupBy(field string, fields ...string) *PointOfContactGroupBy {
+	pocq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PointOfContactGroupBy{build: pocq}
+	grbuild.flds = &pocq.ctx.Fields
+	grbuild.label = pointofcontact.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackageVersionID int `json:"package_version_id,omitempty"`
+//	}
+//
+//	client.PointOfContact.Query().
+//		Select(pointofcontact.FieldPackageVersionID).
+//		Scan(ctx, &v)
+func (pocq *PointOfContactQuery) Select(fields ...string) *PointOfContactSelect {
+	pocq.ctx.Fields = append(pocq.ctx.Fields, fields...)
+	sbuild := &PointOfContactSelect{PointOfContactQuery: pocq}
+	sbuild.label = pointofcontact.Label
+	sbuild.flds, sbuild.scan = &pocq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PointOfContactSelect configured with the given aggregations.
+func (pocq *PointOfContactQuery) Aggregate(fns ...AggregateFunc) *PointOfContactSelect {
+	return pocq.Select().Aggregate(fns...)
+}
+
+func (pocq *PointOfContactQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pocq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pocq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pocq.ctx.Fields {
+		if !pointofcontact.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if pocq.path != nil {
+		prev, err := pocq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pocq.sql = prev
+	}
+	return nil
+}
+
+func (pocq *PointOfContactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PointOfContact, error) {
+	var (
+		nodes       = []*PointOfContact{}
+		_spec       = pocq.querySpec()
+		loadedTypes = [4]bool{
+			pocq.withPackageVersion != nil,
+			pocq.withPackageName != nil,
+			pocq.withSource != nil,
+			pocq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PointOfContact).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PointOfContact{config: pocq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pocq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pocq.withPackageVersion; query != nil {
+		if err := pocq.loadPackageVersion(ctx, query, nodes, nil,
+			func(n *PointOfContact, e *PackageVersion) { n.Edges.PackageVersion = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pocq.withPackageName; query != nil {
+		if err := pocq.loadPackageName(ctx, query, nodes, nil,
+			func(n *PointOfContact, e *PackageName) { n.Edges.PackageName = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pocq.withSource; query != nil {
+		if err := pocq.loadSource(ctx, query, nodes, nil,
+			func(n *PointOfContact, e *SourceName) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pocq.withArtifact; query != nil {
+		if err := pocq.loadArtifact(ctx, query, nodes, nil,
+			func(n *PointOfContact, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pocq *PointOfContactQuery) loadPackageVersion(ctx context.Context, query *PackageVersionQuery, nodes []*PointOfContact, init func(*PointOfContact), assign func(*PointOfContact, *PackageVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PointOfContact)
+	for i := range nodes {
+		if nodes[i].PackageVersionID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageVersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pocq *PointOfContactQuery) loadPackageName(ctx context.Context, query *PackageNameQuery, nodes []*PointOfContact, init func(*PointOfContact), assign func(*PointOfContact, *PackageName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PointOfContact)
+	for i := range nodes {
+		if nodes[i].PackageNameID == nil {
+			continue
+		}
+		fk := *nodes[i].PackageNameID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_name_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pocq *PointOfContactQuery) loadSource(ctx context.Context, query *SourceNameQuery, nodes []*PointOfContact, init func(*PointOfContact), assign func(*PointOfContact, *SourceName)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PointOfContact)
+	for i := range nodes {
+		if nodes[i].SourceID == nil {
+			continue
+		}
+		fk := *nodes[i].SourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sourcename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pocq *PointOfContactQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*PointOfContact, init func(*PointOfContact), assign func(*PointOfContact, *Artifact)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PointOfContact)
+	for i := range nodes {
+		if nodes[i].ArtifactID == nil {
+			continue
+		}
+		fk := *nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pocq *PointOfContactQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pocq.querySpec()
+	_spec.Node.Columns = pocq.ctx.Fields
+	if len(pocq.ctx.Fields) > 0 {
+		_spec.Unique = pocq.ctx.Unique != nil && *pocq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pocq.driver, _spec)
+}
+
+func (pocq *PointOfContactQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pointofcontact.Table, pointofcontact.Columns, sqlgraph.NewFieldSpec(pointofcontact.FieldID, field.TypeInt))
+	_spec.From = pocq.sql
+	if unique := pocq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pocq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pocq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pointofcontact.FieldID)
+		for i := range fields {
+			if fields[i] != pointofcontact.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pocq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pocq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pocq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pocq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pocq *PointOfContactQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pocq.driver.Dialect())
+	t1 := builder.Table(pointofcontact.Table)
+	columns := pocq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pointofcontact.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pocq.sql != nil {
+		selector = pocq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pocq.ctx.Unique != nil && *pocq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pocq.predicates {
+		p(selector)
+	}
+	for _, p := range pocq.order {
+		p(selector)
+	}
+	if offset := pocq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pocq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PointOfContactGroupBy is the group-by builder for PointOfContact entities.
+type PointOfContactGroupBy struct {
+	selector
+	build *PointOfContactQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pocgb *PointOfContactGroupBy) Aggregate(fns ...AggregateFunc) *PointOfContactGroupBy {
+	pocgb.fns = append(pocgb.fns, fns...)
+	return pocgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pocgb *PointOfContactGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pocgb.build.ctx, "GroupBy")
+	if err := pocgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PointOfContactQuery, *PointOfContactGroupBy](ctx, pocgb.build, pocgb, pocgb.build.inters, v)
+}
+
+func (pocgb *PointOfContactGroupBy) sqlScan(ctx context.Context, root *PointOfContactQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pocgb.fns))
+	for _, fn := range pocgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pocgb.flds)+len(pocgb.fns))
+		for _, f := range *pocgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pocgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pocgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PointOfContactSelect is the builder for selecting fields of PointOfContact entities.
+type PointOfContactSelect struct {
+	*PointOfContactQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pocs *PointOfContactSelect) Aggregate(fns ...AggregateFunc) *PointOfContactSelect {
+	pocs.fns = append(pocs.fns, fns...)
+	return pocs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pocs *PointOfContactSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pocs.ctx, "Select")
+	if err := pocs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PointOfContactQuery, *PointOfContactSelect](ctx, pocs.PointOfContactQuery, pocs, pocs.inters, v)
+}
+
+func (pocs *PointOfContactSelect) sqlScan(ctx context.Context, root *PointOfContactQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pocs.fns))
+	for _, fn := range pocs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pocs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pocs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,85 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// Artifact is the predicate function for artifact builders.
+type Artifact func(*sql.Selector)
+
+// BuilderNode is the predicate function for buildernode builders.
+type BuilderNode func(*sql.Selector)
+
+// CertifyBad is the predicate function for certifybad builders.
+type CertifyBad func(*sql.Selector)
+
+// CertifyGood is the predicate function for certifygood builders.
+type CertifyGood func(*sql.Selector)
+
+// CertifyLegal is the predicate function for certifylegal builders.
+type CertifyLegal func(*sql.Selector)
+
+// CertifyVEXStatement is the predicate function for certifyvexstatement builders.
+type CertifyVEXStatement func(*sql.Selector)
+
+// CertifyVuln is the predicate function for certifyvuln builders.
+type CertifyVuln func(*sql.Selector)
+
+// HasMetadata is the predicate function for hasmetadata builders.
+type HasMetadata func(*sql.Selector)
+
+// HasSBOM is the predicate function for hassbom builders.
+type HasSBOM func(*sql.Selector)
+
+// HasSLSA is the predicate function for hasslsa builders.
+type HasSLSA func(*sql.Selector)
+
+// HashEqual is the predicate function for hashequal builders.
+type HashEqual func(*sql.Selector)
+
+// IsDependency is the predicate function for isdependency builders.
+type IsDependency func(*sql.Selector)
+
+// IsOccurrence is the predicate function for isoccurrence builders.
+type IsOccurrence func(*sql.Selector)
+
+// PackageName is the predicate function for packagename builders.
+type PackageName func(*sql.Selector)
+
+// PackageNamespace is the predicate function for packagenamespace builders.
+type PackageNamespace func(*sql.Selector)
+
+// PackageType is the predicate function for packagetype builders.
+type PackageType func(*sql.Selector)
+
+// PackageVersion is the predicate function for packageversion builders.
+type PackageVersion func(*sql.Selector)
+
+// PkgEqual is the predicate function for pkgequal builders.
+type PkgEqual func(*sql.Selector)
+
+// PointOfContact is the predicate function for pointofcontact builders.
+type PointOfContact func(*sql.Selector)
+
+// Scorecard is the predicate function for scorecard builders.
+type Scorecard func(*sql.Selector)
+
+// SourceName is the predicate function for sourcename builders.
+type SourceName func(*sql.Selector)
+
+// SourceNamespace is the predicate function for sourcenamespace builders.
+type SourceNamespace func(*sql.Selector)
+
+// SourceType is the predicate function for sourcetype builders.
+type SourceType func(*sql.Selector)
+
+// VulnEqual is the predicate function for vulnequal builders.
+type VulnEqual func(*sql.Selector)
+
+// VulnerabilityID is the predicate function for vulnerabilityid builders.
+type VulnerabilityID func(*sql.Selector)
+
+// VulnerabilityType is the predicate function for vulnerabilitytype builders.
+type VulnerabilityType func(*sql.Selector)
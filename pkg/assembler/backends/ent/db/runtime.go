@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	packageversionFields := schema.PackageVersion{}.Fields()
+	_ = packageversionFields
+	// packageversionDescVersion is the schema descriptor for version field.
+	packageversionDescVersion := packageversionFields[1].Descriptor()
+	// packageversion.DefaultVersion holds the default value on creation for the version field.
+	packageversion.DefaultVersion = packageversionDescVersion.Default.(string)
+	// packageversionDescSubpath is the schema descriptor for subpath field.
+	packageversionDescSubpath := packageversionFields[2].Descriptor()
+	// packageversion.DefaultSubpath holds the default value on creation for the subpath field.
+	packageversion.DefaultSubpath = packageversionDescSubpath.Default.(string)
+	// packageversionDescQualifiersKey is the schema descriptor for qualifiers_key field.
+	packageversionDescQualifiersKey := packageversionFields[4].Descriptor()
+	// packageversion.DefaultQualifiersKey holds the default value on creation for the qualifiers_key field.
+	packageversion.DefaultQualifiersKey = packageversionDescQualifiersKey.Default.(string)
+	sourcenameFields := schema.SourceName{}.Fields()
+	_ = sourcenameFields
+	// sourcenameDescTag is the schema descriptor for tag field.
+	sourcenameDescTag := sourcenameFields[2].Descriptor()
+	// sourcename.DefaultTag holds the default value on creation for the tag field.
+	sourcename.DefaultTag = sourcenameDescTag.Default.(string)
+	// sourcenameDescCommit is the schema descriptor for commit field.
+	sourcenameDescCommit := sourcenameFields[3].Descriptor()
+	// sourcename.DefaultCommit holds the default value on creation for the commit field.
+	sourcename.DefaultCommit = sourcenameDescCommit.Default.(string)
+}
@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scorecard
+
+const (
+	// Label holds the string label denoting the scorecard type in the database.
+	Label = "scorecard"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldChecks holds the string denoting the checks field in the database.
+	FieldChecks = "checks"
+	// FieldAggregateScore holds the string denoting the aggregate_score field in the database.
+	FieldAggregateScore = "aggregate_score"
+	// FieldTimeScanned holds the string denoting the time_scanned field in the database.
+	FieldTimeScanned = "time_scanned"
+	// FieldScorecardVersion holds the string denoting the scorecard_version field in the database.
+	FieldScorecardVersion = "scorecard_version"
+	// FieldCommit holds the string denoting the commit field in the database.
+	FieldCommit = "commit"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// Table holds the table name of the scorecard in the database.
+	Table = "scorecards"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "scorecards"
+	// SourceInverseTable is the table name for the SourceName entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcename" package.
+	SourceInverseTable = "source_names"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "source_id"
+)
+
+// Columns holds all SQL columns for scorecard fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceID,
+	FieldChecks,
+	FieldAggregateScore,
+	FieldTimeScanned,
+	FieldScorecardVersion,
+	FieldCommit,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcename
+
+const (
+	// Label holds the string label denoting the sourcename type in the database.
+	Label = "source_name"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespaceID holds the string denoting the namespace_id field in the database.
+	FieldNamespaceID = "namespace_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTag holds the string denoting the tag field in the database.
+	FieldTag = "tag"
+	// FieldCommit holds the string denoting the commit field in the database.
+	FieldCommit = "commit"
+	// EdgeNamespace holds the string denoting the namespace edge name in mutations.
+	EdgeNamespace = "namespace"
+	// Table holds the table name of the sourcename in the database.
+	Table = "source_names"
+	// NamespaceTable is the table that holds the namespace relation/edge.
+	NamespaceTable = "source_names"
+	// NamespaceInverseTable is the table name for the SourceNamespace entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcenamespace" package.
+	NamespaceInverseTable = "source_namespaces"
+	// NamespaceColumn is the table column denoting the namespace relation/edge.
+	NamespaceColumn = "namespace_id"
+)
+
+// Columns holds all SQL columns for sourcename fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespaceID,
+	FieldName,
+	FieldTag,
+	FieldCommit,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTag holds the default value on creation for the "tag" field.
+	DefaultTag string
+	// DefaultCommit holds the default value on creation for the "commit" field.
+	DefaultCommit string
+)
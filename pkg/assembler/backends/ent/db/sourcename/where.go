@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcename
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLTE(FieldID, id))
+}
+
+// NamespaceID applies equality check predicate on the "namespace_id" field. It's identical to NamespaceIDEQ.
+func NamespaceID(v int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldName, v))
+}
+
+// Tag applies equality check predicate on the "tag" field. It's identical to TagEQ.
+func Tag(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldTag, v))
+}
+
+// Commit applies equality check predicate on the "commit" field. It's identical to CommitEQ.
+func Commit(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldCommit, v))
+}
+
+// NamespaceIDEQ applies the EQ predicate on the "namespace_id" field.
+func NamespaceIDEQ(v int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDNEQ applies the NEQ predicate on the "namespace_id" field.
+func NamespaceIDNEQ(v int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDIn applies the In predicate on the "namespace_id" field.
+func NamespaceIDIn(vs ...int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDNotIn applies the NotIn predicate on the "namespace_id" field.
+func NamespaceIDNotIn(vs ...int) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNotIn(FieldNamespaceID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContainsFold(FieldName, v))
+}
+
+// TagEQ applies the EQ predicate on the "tag" field.
+func TagEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldTag, v))
+}
+
+// TagNEQ applies the NEQ predicate on the "tag" field.
+func TagNEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNEQ(FieldTag, v))
+}
+
+// TagIn applies the In predicate on the "tag" field.
+func TagIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldIn(FieldTag, vs...))
+}
+
+// TagNotIn applies the NotIn predicate on the "tag" field.
+func TagNotIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNotIn(FieldTag, vs...))
+}
+
+// TagGT applies the GT predicate on the "tag" field.
+func TagGT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGT(FieldTag, v))
+}
+
+// TagGTE applies the GTE predicate on the "tag" field.
+func TagGTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGTE(FieldTag, v))
+}
+
+// TagLT applies the LT predicate on the "tag" field.
+func TagLT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLT(FieldTag, v))
+}
+
+// TagLTE applies the LTE predicate on the "tag" field.
+func TagLTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLTE(FieldTag, v))
+}
+
+// TagContains applies the Contains predicate on the "tag" field.
+func TagContains(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContains(FieldTag, v))
+}
+
+// TagHasPrefix applies the HasPrefix predicate on the "tag" field.
+func TagHasPrefix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasPrefix(FieldTag, v))
+}
+
+// TagHasSuffix applies the HasSuffix predicate on the "tag" field.
+func TagHasSuffix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasSuffix(FieldTag, v))
+}
+
+// TagEqualFold applies the EqualFold predicate on the "tag" field.
+func TagEqualFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEqualFold(FieldTag, v))
+}
+
+// TagContainsFold applies the ContainsFold predicate on the "tag" field.
+func TagContainsFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContainsFold(FieldTag, v))
+}
+
+// CommitEQ applies the EQ predicate on the "commit" field.
+func CommitEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEQ(FieldCommit, v))
+}
+
+// CommitNEQ applies the NEQ predicate on the "commit" field.
+func CommitNEQ(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNEQ(FieldCommit, v))
+}
+
+// CommitIn applies the In predicate on the "commit" field.
+func CommitIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldIn(FieldCommit, vs...))
+}
+
+// CommitNotIn applies the NotIn predicate on the "commit" field.
+func CommitNotIn(vs ...string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldNotIn(FieldCommit, vs...))
+}
+
+// CommitGT applies the GT predicate on the "commit" field.
+func CommitGT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGT(FieldCommit, v))
+}
+
+// CommitGTE applies the GTE predicate on the "commit" field.
+func CommitGTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldGTE(FieldCommit, v))
+}
+
+// CommitLT applies the LT predicate on the "commit" field.
+func CommitLT(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLT(FieldCommit, v))
+}
+
+// CommitLTE applies the LTE predicate on the "commit" field.
+func CommitLTE(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldLTE(FieldCommit, v))
+}
+
+// CommitContains applies the Contains predicate on the "commit" field.
+func CommitContains(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContains(FieldCommit, v))
+}
+
+// CommitHasPrefix applies the HasPrefix predicate on the "commit" field.
+func CommitHasPrefix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasPrefix(FieldCommit, v))
+}
+
+// CommitHasSuffix applies the HasSuffix predicate on the "commit" field.
+func CommitHasSuffix(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldHasSuffix(FieldCommit, v))
+}
+
+// CommitEqualFold applies the EqualFold predicate on the "commit" field.
+func CommitEqualFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldEqualFold(FieldCommit, v))
+}
+
+// CommitContainsFold applies the ContainsFold predicate on the "commit" field.
+func CommitContainsFold(v string) predicate.SourceName {
+	return predicate.SourceName(sql.FieldContainsFold(FieldCommit, v))
+}
+
+// HasNamespace applies the HasEdge predicate on the "namespace" edge.
+func HasNamespace() predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NamespaceTable, NamespaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNamespaceWith applies the HasEdge predicate on the "namespace" edge with a given conditions (other predicates).
+func HasNamespaceWith(preds ...predicate.SourceNamespace) predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(NamespaceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NamespaceTable, NamespaceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SourceName) predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SourceName) predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SourceName) predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+)
+
+// SourceNameUpdate is the builder for updating SourceName entities.
+type SourceNameUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceNameMutation
+}
+
+// Where appends a list predicates to the SourceNameUpdate builder.
+func (snu *SourceNameUpdate) Where(ps ...predicate.SourceName) *SourceNameUpdate {
+	snu.mutation.Where(ps...)
+	return snu
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (snu *SourceNameUpdate) SetNamespaceID(i int) *SourceNameUpdate {
+	snu.mutation.SetNamespaceID(i)
+	return snu
+}
+
+// SetName sets the "name" field.
+func (snu *SourceNameUpdate) SetName(s string) *SourceNameUpdate {
+	snu.mutation.SetName(s)
+	return snu
+}
+
+// SetTag sets the "tag" field.
+func (snu *SourceNameUpdate) SetTag(s string) *SourceNameUpdate {
+	snu.mutation.SetTag(s)
+	return snu
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (snu *SourceNameUpdate) SetNillableTag(s *string) *SourceNameUpdate {
+	if s != nil {
+		snu.SetTag(*s)
+	}
+	return snu
+}
+
+// SetCommit sets the "commit" field.
+func (snu *SourceNameUpdate) SetCommit(s string) *SourceNameUpdate {
+	snu.mutation.SetCommit(s)
+	return snu
+}
+
+// SetNillableCommit sets the "commit" field if the given value is not nil.
+func (snu *SourceNameUpdate) SetNillableCommit(s *string) *SourceNameUpdate {
+	if s != nil {
+		snu.SetCommit(*s)
+	}
+	return snu
+}
+
+// SetNamespace sets the "namespace" edge to the SourceNamespace entity.
+func (snu *SourceNameUpdate) SetNamespace(s *SourceNamespace) *SourceNameUpdate {
+	return snu.SetNamespaceID(s.ID)
+}
+
+// Mutation returns the SourceNameMutation object of the builder.
+func (snu *SourceNameUpdate) Mutation() *SourceNameMutation {
+	return snu.mutation
+}
+
+// ClearNamespace clears the "namespace" edge to the SourceNamespace entity.
+func (snu *SourceNameUpdate) ClearNamespace() *SourceNameUpdate {
+	snu.mutation.ClearNamespace()
+	return snu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (snu *SourceNameUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, SourceNameMutation](ctx, snu.sqlSave, snu.mutation, snu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snu *SourceNameUpdate) SaveX(ctx context.Context) int {
+	affected, err := snu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (snu *SourceNameUpdate) Exec(ctx context.Context) error {
+	_, err := snu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snu *SourceNameUpdate) ExecX(ctx context.Context) {
+	if err := snu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snu *SourceNameUpdate) check() error {
+	if _, ok := snu.mutation.NamespaceID(); snu.mutation.NamespaceCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "SourceName.namespace"`)
+	}
+	return nil
+}
+
+func (snu *SourceNameUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := snu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sourcename.Table, sourcename.Columns, sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt))
+	if ps := snu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snu.mutation.Name(); ok {
+		_spec.SetField(sourcename.FieldName, field.TypeString, value)
+	}
+	if value, ok := snu.mutation.Tag(); ok {
+		_spec.SetField(sourcename.FieldTag, field.TypeString, value)
+	}
+	if value, ok := snu.mutation.Commit(); ok {
+		_spec.SetField(sourcename.FieldCommit, field.TypeString, value)
+	}
+	if snu.mutation.NamespaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcename.NamespaceTable,
+			Columns: []string{sourcename.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := snu.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcename.NamespaceTable,
+			Columns: []string{sourcename.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, snu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcename.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	snu.mutation.done = true
+	return n, nil
+}
+
+// SourceNameUpdateOne is the builder for updating a single SourceName entity.
+type SourceNameUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceNameMutation
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (snuo *SourceNameUpdateOne) SetNamespaceID(i int) *SourceNameUpdateOne {
+	snuo.mutation.SetNamespaceID(i)
+	return snuo
+}
+
+// SetName sets the "name" field.
+func (snuo *SourceNameUpdateOne) SetName(s string) *SourceNameUpdateOne {
+	snuo.mutation.SetName(s)
+	return snuo
+}
+
+// SetTag sets the "tag" field.
+func (snuo *SourceNameUpdateOne) SetTag(s string) *SourceNameUpdateOne {
+	snuo.mutation.SetTag(s)
+	return snuo
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (snuo *SourceNameUpdateOne) SetNillableTag(s *string) *SourceNameUpdateOne {
+	if s != nil {
+		snuo.SetTag(*s)
+	}
+	return snuo
+}
+
+// SetCommit sets the "commit" field.
+func (snuo *SourceNameUpdateOne) SetCommit(s string) *SourceNameUpdateOne {
+	snuo.mutation.SetCommit(s)
+	return snuo
+}
+
+// SetNillableCommit sets the "commit" field if the given value is not nil.
+func (snuo *SourceNameUpdateOne) SetNillableCommit(s *string) *SourceNameUpdateOne {
+	if s != nil {
+		snuo.SetCommit(*s)
+	}
+	return snuo
+}
+
+// SetNamespace sets the "namespace" edge to the SourceNamespace entity.
+func (snuo *SourceNameUpdateOne) SetNamespace(s *SourceNamespace) *SourceNameUpdateOne {
+	return snuo.SetNamespaceID(s.ID)
+}
+
+// Mutation returns the SourceNameMutation object of the builder.
+func (snuo *SourceNameUpdateOne) Mutation() *SourceNameMutation {
+	return snuo.mutation
+}
+
+// ClearNamespace clears the "namespace" edge to the SourceNamespace entity.
+func (snuo *SourceNameUpdateOne) ClearNamespace() *SourceNameUpdateOne {
+	snuo.mutation.ClearNamespace()
+	return snuo
+}
+
+// Where appends a list predicates to the SourceNameUpdate builder.
+func (snuo *SourceNameUpdateOne) Where(ps ...predicate.SourceName) *SourceNameUpdateOne {
+	snuo.mutation.Where(ps...)
+	return snuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (snuo *SourceNameUpdateOne) Select(field string, fields ...string) *SourceNameUpdateOne {
+	snuo.fields = append([]string{field}, fields...)
+	return snuo
+}
+
+// Save executes the query and returns the updated SourceName entity.
+func (snuo *SourceNameUpdateOne) Save(ctx context.Context) (*SourceName, error) {
+	return withHooks[*SourceName, SourceNameMutation](ctx, snuo.sqlSave, snuo.mutation, snuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snuo *SourceNameUpdateOne) SaveX(ctx context.Context) *SourceName {
+	node, err := snuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (snuo *SourceNameUpdateOne) Exec(ctx context.Context) error {
+	_, err := snuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snuo *SourceNameUpdateOne) ExecX(ctx context.Context) {
+	if err := snuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snuo *SourceNameUpdateOne) check() error {
+	if _, ok := snuo.mutation.NamespaceID(); snuo.mutation.NamespaceCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "SourceName.namespace"`)
+	}
+	return nil
+}
+
+func (snuo *SourceNameUpdateOne) sqlSave(ctx context.Context) (_node *SourceName, err error) {
+	if err := snuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sourcename.Table, sourcename.Columns, sqlgraph.NewFieldSpec(sourcename.FieldID, field.TypeInt))
+	id, ok := snuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SourceName.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := snuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcename.FieldID)
+		for _, f := range fields {
+			if !sourcename.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != sourcename.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := snuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snuo.mutation.Name(); ok {
+		_spec.SetField(sourcename.FieldName, field.TypeString, value)
+	}
+	if value, ok := snuo.mutation.Tag(); ok {
+		_spec.SetField(sourcename.FieldTag, field.TypeString, value)
+	}
+	if value, ok := snuo.mutation.Commit(); ok {
+		_spec.SetField(sourcename.FieldCommit, field.TypeString, value)
+	}
+	if snuo.mutation.NamespaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcename.NamespaceTable,
+			Columns: []string{sourcename.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := snuo.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcename.NamespaceTable,
+			Columns: []string{sourcename.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SourceName{config: snuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, snuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcename.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	snuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcenamespace
+
+const (
+	// Label holds the string label denoting the sourcenamespace type in the database.
+	Label = "source_namespace"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// EdgeNames holds the string denoting the names edge name in mutations.
+	EdgeNames = "names"
+	// Table holds the table name of the sourcenamespace in the database.
+	Table = "source_namespaces"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "source_namespaces"
+	// SourceInverseTable is the table name for the SourceType entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcetype" package.
+	SourceInverseTable = "source_types"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "source_id"
+	// NamesTable is the table that holds the names relation/edge.
+	NamesTable = "source_names"
+	// NamesInverseTable is the table name for the SourceName entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcename" package.
+	NamesInverseTable = "source_names"
+	// NamesColumn is the table column denoting the names relation/edge.
+	NamesColumn = "namespace_id"
+)
+
+// Columns holds all SQL columns for sourcenamespace fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceID,
+	FieldNamespace,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcetype
+
+const (
+	// Label holds the string label denoting the sourcetype type in the database.
+	Label = "source_type"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// EdgeNamespaces holds the string denoting the namespaces edge name in mutations.
+	EdgeNamespaces = "namespaces"
+	// Table holds the table name of the sourcetype in the database.
+	Table = "source_types"
+	// NamespacesTable is the table that holds the namespaces relation/edge.
+	NamespacesTable = "source_namespaces"
+	// NamespacesInverseTable is the table name for the SourceNamespace entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcenamespace" package.
+	NamespacesInverseTable = "source_namespaces"
+	// NamespacesColumn is the table column denoting the namespaces relation/edge.
+	NamespacesColumn = "source_id"
+)
+
+// Columns holds all SQL columns for sourcetype fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+)
+
+// SourceTypeQuery is the builder for querying SourceType entities.
+type SourceTypeQuery struct {
+	config
+	ctx            *QueryContext
+	order          []OrderFunc
+	inters         []Interceptor
+	predicates     []predicate.SourceType
+	withNamespaces *SourceNamespaceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SourceTypeQuery builder.
+func (stq *SourceTypeQuery) Where(ps ...predicate.SourceType) *SourceTypeQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *SourceTypeQuery) Limit(limit int) *SourceTypeQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *SourceTypeQuery) Offset(offset int) *SourceTypeQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *SourceTypeQuery) Unique(unique bool) *SourceTypeQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *SourceTypeQuery) Order(o ...OrderFunc) *SourceTypeQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// QueryNamespaces chains the current query on the "namespaces" edge.
+func (stq *SourceTypeQuery) QueryNamespaces() *SourceNamespaceQuery {
+	query := (&SourceNamespaceClient{config: stq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcetype.Table, sourcetype.FieldID, selector),
+			sqlgraph.To(sourcenamespace.Table, sourcenamespace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sourcetype.NamespacesTable, sourcetype.NamespacesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SourceType entity from the query.
+// Returns a *NotFoundError when no SourceType was found.
+func (stq *SourceTypeQuery) First(ctx context.Context) (*SourceType, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sourcetype.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *SourceTypeQuery) FirstX(ctx context.Context) *SourceType {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SourceType ID from the query.
+// Returns a *NotFoundError when no SourceType ID was found.
+func (stq *SourceTypeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sourcetype.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *SourceTypeQuery) FirstIDX(ctx context.Context) int {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SourceType entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SourceType entity is found.
+// Returns a *NotFoundError when no SourceType entities are found.
+func (stq *SourceTypeQuery) Only(ctx context.Context) (*SourceType, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sourcetype.Label}
+	default:
+		return nil, &NotSingularError{sourcetype.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *SourceTypeQuery) OnlyX(ctx context.Context) *SourceType {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SourceType ID in the query.
+// Returns a *NotSingularError when more than one SourceType ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *SourceTypeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sourcetype.Label}
+	default:
+		err = &NotSingularError{sourcetype.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *SourceTypeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SourceTypes.
+func (stq *SourceTypeQuery) All(ctx context.Context) ([]*SourceType, error) {
+	ctx = setContextOp(ctx, stq.ctx, "All")
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SourceType, *SourceTypeQuery]()
+	return withInterceptors[[]*SourceType](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *SourceTypeQuery) AllX(ctx context.Context) []*SourceType {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SourceType IDs.
+func (stq *SourceTypeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, "IDs")
+	if err = stq.Select(sourcetype.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *SourceTypeQuery) IDsX(ctx context.Context) []int {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *SourceTypeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, "Count")
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*SourceTypeQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *SourceTypeQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *SourceTypeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, "Exist")
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *SourceTypeQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SourceTypeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *SourceTypeQuery) Clone() *SourceTypeQuery {
+	if stq == nil {
+		return nil
+	}
+	return &SourceTypeQuery{
+		config:         stq.config,
+		ctx:            stq.ctx.Clone(),
+		order:          append([]OrderFunc{}, stq.order...),
+		inters:         append([]Interceptor{}, stq.inters...),
+		predicates:     append([]predicate.SourceType{}, stq.predicates...),
+		withNamespaces: stq.withNamespaces.Clone(),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// WithNamespaces tells the query-builder to eager-load the nodes that are connected to
+// the "namespaces" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *SourceTypeQuery) WithNamespaces(opts ...func(*SourceNamespaceQuery)) *SourceTypeQuery {
+	query := (&SourceNamespaceClient{config: stq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withNamespaces = query
+	return stq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SourceType.Query().
+//		GroupBy(sourcetype.FieldType).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (stq *SourceTypeQuery) GroupBy(field string, fields ...string) *SourceTypeGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SourceTypeGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = sourcetype.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//	}
+//
+//	client.SourceType.Query().
+//		Select(sourcetype.FieldType).
+//		Scan(ctx, &v)
+func (stq *SourceTypeQuery) Select(fields ...string) *SourceTypeSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &SourceTypeSelect{SourceTypeQuery: stq}
+	sbuild.label = sourcetype.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SourceTypeSelect configured with the given aggregations.
+func (stq *SourceTypeQuery) Aggregate(fns ...AggregateFunc) *SourceTypeSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *SourceTypeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !sourcetype.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *SourceTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SourceType, error) {
+	var (
+		nodes       = []*SourceType{}
+		_spec       = stq.querySpec()
+		loadedTypes = [1]bool{
+			stq.withNamespaces != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SourceType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SourceType{config: stq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := stq.withNamespaces; query != nil {
+		if err := stq.loadNamespaces(ctx, query, nodes,
+			func(n *SourceType) { n.Edges.Namespaces = []*SourceNamespace{} },
+			func(n *SourceType, e *SourceNamespace) { n.Edges.Namespaces = append(n.Edges.Namespaces, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (stq *SourceTypeQuery) loadNamespaces(ctx context.Context, query *SourceNamespaceQuery, nodes []*SourceType, init func(*SourceType), assign func(*SourceType, *SourceNamespace)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SourceType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.SourceNamespace(func(s *sql.Selector) {
+		s.Where(sql.InValues(sourcetype.NamespacesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SourceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (stq *SourceTypeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *SourceTypeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sourcetype.Table, sourcetype.Columns, sqlgraph.NewFieldSpec(sourcetype.FieldID, field.TypeInt))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcetype.FieldID)
+		for i := range fields {
+			if fields[i] != sourcetype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *SourceTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(sourcetype.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sourcetype.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SourceTypeGroupBy is the group-by builder for SourceType entities.
+type SourceTypeGroupBy struct {
+	selector
+	build *SourceTypeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *SourceTypeGroupBy) Aggregate(fns ...AggregateFunc) *SourceTypeGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *SourceTypeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, "GroupBy")
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SourceTypeQuery, *SourceTypeGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *SourceTypeGroupBy) sqlScan(ctx context.Context, root *SourceTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SourceTypeSelect is the builder for selecting fields of SourceType entities.
+type SourceTypeSelect struct {
+	*SourceTypeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *SourceTypeSelect) Aggregate(fns ...AggregateFunc) *SourceTypeSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *SourceTypeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, "Select")
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SourceTypeQuery, *SourceTypeSelect](ctx, sts.SourceTypeQuery, sts, sts.inters, v)
+}
+
+func (sts *SourceTypeSelect) sqlScan(ctx context.Context, root *SourceTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
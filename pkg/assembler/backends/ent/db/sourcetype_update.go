@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+)
+
+// SourceTypeUpdate is the builder for updating SourceType entities.
+type SourceTypeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceTypeMutation
+}
+
+// Where appends a list predicates to the SourceTypeUpdate builder.
+func (stu *SourceTypeUpdate) Where(ps ...predicate.SourceType) *SourceTypeUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetType sets the "type" field.
+func (stu *SourceTypeUpdate) SetType(s string) *SourceTypeUpdate {
+	stu.mutation.SetType(s)
+	return stu
+}
+
+// AddNamespaceIDs adds the "namespaces" edge to the SourceNamespace entity by IDs.
+func (stu *SourceTypeUpdate) AddNamespaceIDs(ids ...int) *SourceTypeUpdate {
+	stu.mutation.AddNamespaceIDs(ids...)
+	return stu
+}
+
+// AddNamespaces adds the "namespaces" edges to the SourceNamespace entity.
+func (stu *SourceTypeUpdate) AddNamespaces(s ...*SourceNamespace) *SourceTypeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return stu.AddNamespaceIDs(ids...)
+}
+
+// Mutation returns the SourceTypeMutation object of the builder.
+func (stu *SourceTypeUpdate) Mutation() *SourceTypeMutation {
+	return stu.mutation
+}
+
+// ClearNamespaces clears all "namespaces" edges to the SourceNamespace entity.
+func (stu *SourceTypeUpdate) ClearNamespaces() *SourceTypeUpdate {
+	stu.mutation.ClearNamespaces()
+	return stu
+}
+
+// RemoveNamespaceIDs removes the "namespaces" edge to SourceNamespace entities by IDs.
+func (stu *SourceTypeUpdate) RemoveNamespaceIDs(ids ...int) *SourceTypeUpdate {
+	stu.mutation.RemoveNamespaceIDs(ids...)
+	return stu
+}
+
+// RemoveNamespaces removes "namespaces" edges to SourceNamespace entities.
+func (stu *SourceTypeUpdate) RemoveNamespaces(s ...*SourceNamespace) *SourceTypeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return stu.RemoveNamespaceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SourceTypeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, SourceTypeMutation](ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SourceTypeUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SourceTypeUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SourceTypeUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (stu *SourceTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sourcetype.Table, sourcetype.Columns, sqlgraph.NewFieldSpec(sourcetype.FieldID, field.TypeInt))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.GetType(); ok {
+		_spec.SetField(sourcetype.FieldType, field.TypeString, value)
+	}
+	if stu.mutation.NamespacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.RemovedNamespacesIDs(); len(nodes) > 0 && !stu.mutation.NamespacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.NamespacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcetype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SourceTypeUpdateOne is the builder for updating a single SourceType entity.
+type SourceTypeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceTypeMutation
+}
+
+// SetType sets the "type" field.
+func (stuo *SourceTypeUpdateOne) SetType(s string) *SourceTypeUpdateOne {
+	stuo.mutation.SetType(s)
+	return stuo
+}
+
+// AddNamespaceIDs adds the "namespaces" edge to the SourceNamespace entity by IDs.
+func (stuo *SourceTypeUpdateOne) AddNamespaceIDs(ids ...int) *SourceTypeUpdateOne {
+	stuo.mutation.AddNamespaceIDs(ids...)
+	return stuo
+}
+
+// AddNamespaces adds the "namespaces" edges to the SourceNamespace entity.
+func (stuo *SourceTypeUpdateOne) AddNamespaces(s ...*SourceNamespace) *SourceTypeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return stuo.AddNamespaceIDs(ids...)
+}
+
+// Mutation returns the SourceTypeMutation object of the builder.
+func (stuo *SourceTypeUpdateOne) Mutation() *SourceTypeMutation {
+	return stuo.mutation
+}
+
+// ClearNamespaces clears all "namespaces" edges to the SourceNamespace entity.
+func (stuo *SourceTypeUpdateOne) ClearNamespaces() *SourceTypeUpdateOne {
+	stuo.mutation.ClearNamespaces()
+	return stuo
+}
+
+// RemoveNamespaceIDs removes the "namespaces" edge to SourceNamespace entities by IDs.
+func (stuo *SourceTypeUpdateOne) RemoveNamespaceIDs(ids ...int) *SourceTypeUpdateOne {
+	stuo.mutation.RemoveNamespaceIDs(ids...)
+	return stuo
+}
+
+// RemoveNamespaces removes "namespaces" edges to SourceNamespace entities.
+func (stuo *SourceTypeUpdateOne) RemoveNamespaces(s ...*SourceNamespace) *SourceTypeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return stuo.RemoveNamespaceIDs(ids...)
+}
+
+// Where appends a list predicates to the SourceTypeUpdate builder.
+func (stuo *SourceTypeUpdateOne) Where(ps ...predicate.SourceType) *SourceTypeUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SourceTypeUpdateOne) Select(field string, fields ...string) *SourceTypeUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SourceType entity.
+func (stuo *SourceTypeUpdateOne) Save(ctx context.Context) (*SourceType, error) {
+	return withHooks[*SourceType, SourceTypeMutation](ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SourceTypeUpdateOne) SaveX(ctx context.Context) *SourceType {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SourceTypeUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SourceTypeUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (stuo *SourceTypeUpdateOne) sqlSave(ctx context.Context) (_node *SourceType, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sourcetype.Table, sourcetype.Columns, sqlgraph.NewFieldSpec(sourcetype.FieldID, field.TypeInt))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SourceType.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcetype.FieldID)
+		for _, f := range fields {
+			if !sourcetype.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != sourcetype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.GetType(); ok {
+		_spec.SetField(sourcetype.FieldType, field.TypeString, value)
+	}
+	if stuo.mutation.NamespacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.RemovedNamespacesIDs(); len(nodes) > 0 && !stuo.mutation.NamespacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.NamespacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sourcetype.NamespacesTable,
+			Columns: []string{sourcetype.NamespacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sourcenamespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SourceType{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcetype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+)
+
+// VulnEqual is the model entity for the VulnEqual schema.
+type VulnEqual struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// VulnerabilityAID holds the value of the "vulnerability_a_id" field.
+	VulnerabilityAID int `json:"vulnerability_a_id,omitempty"`
+	// VulnerabilityBID holds the value of the "vulnerability_b_id" field.
+	VulnerabilityBID int `json:"vulnerability_b_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VulnEqualQuery when eager-loading is set.
+	Edges VulnEqualEdges `json:"edges"`
+}
+
+// VulnEqualEdges holds the relations/edges for other nodes in the graph.
+type VulnEqualEdges struct {
+	// VulnerabilityA holds the value of the vulnerability_a edge.
+	VulnerabilityA *VulnerabilityID `json:"vulnerability_a,omitempty"`
+	// VulnerabilityB holds the value of the vulnerability_b edge.
+	VulnerabilityB *VulnerabilityID `json:"vulnerability_b,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// VulnerabilityAOrErr returns the VulnerabilityA value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VulnEqualEdges) VulnerabilityAOrErr() (*VulnerabilityID, error) {
+	if e.loadedTypes[0] {
+		if e.VulnerabilityA == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: vulnerabilityid.Label}
+		}
+		return e.VulnerabilityA, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_a"}
+}
+
+// VulnerabilityBOrErr returns the VulnerabilityB value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VulnEqualEdges) VulnerabilityBOrErr() (*VulnerabilityID, error) {
+	if e.loadedTypes[1] {
+		if e.VulnerabilityB == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: vulnerabilityid.Label}
+		}
+		return e.VulnerabilityB, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_b"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VulnEqual) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vulnequal.FieldID, vulnequal.FieldVulnerabilityAID, vulnequal.FieldVulnerabilityBID:
+			values[i] = new(sql.NullInt64)
+		case vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type VulnEqual", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VulnEqual fields.
+func (ve *VulnEqual) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vulnequal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ve.ID = int(value.Int64)
+		case vulnequal.FieldVulnerabilityAID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_a_id", values[i])
+			} else if value.Valid {
+				ve.VulnerabilityAID = int(value.Int64)
+			}
+		case vulnequal.FieldVulnerabilityBID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_b_id", values[i])
+			} else if value.Valid {
+				ve.VulnerabilityBID = int(value.Int64)
+			}
+		case vulnequal.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				ve.Justification = value.String
+			}
+		case vulnequal.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				ve.Origin = value.String
+			}
+		case vulnequal.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				ve.Collector = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryVulnerabilityA queries the "vulnerability_a" edge of the VulnEqual entity.
+func (ve *VulnEqual) QueryVulnerabilityA() *VulnerabilityIDQuery {
+	return NewVulnEqualClient(ve.config).QueryVulnerabilityA(ve)
+}
+
+// QueryVulnerabilityB queries the "vulnerability_b" edge of the VulnEqual entity.
+func (ve *VulnEqual) QueryVulnerabilityB() *VulnerabilityIDQuery {
+	return NewVulnEqualClient(ve.config).QueryVulnerabilityB(ve)
+}
+
+// Update returns a builder for updating this VulnEqual.
+// Note that you need to call VulnEqual.Unwrap() before calling this method if this VulnEqual
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ve *VulnEqual) Update() *VulnEqualUpdateOne {
+	return NewVulnEqualClient(ve.config).UpdateOne(ve)
+}
+
+// Unwrap unwraps the VulnEqual entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ve *VulnEqual) Unwrap() *VulnEqual {
+	_tx, ok := ve.config.driver.(*txDriver)
+	if !ok {
+		panic("db: VulnEqual is not a transactional entity")
+	}
+	ve.config.driver = _tx.drv
+	return ve
+}
+
+// String implements the fmt.Stringer.
+func (ve *VulnEqual) String() string {
+	var builder strings.Builder
+	builder.WriteString("VulnEqual(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ve.ID))
+	builder.WriteString("vulnerability_a_id=")
+	builder.WriteString(fmt.Sprintf("%v", ve.VulnerabilityAID))
+	builder.WriteString(", ")
+	builder.WriteString("vulnerability_b_id=")
+	builder.WriteString(fmt.Sprintf("%v", ve.VulnerabilityBID))
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(ve.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(ve.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(ve.Collector)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VulnEquals is a parsable slice of VulnEqual.
+type VulnEquals []*VulnEqual
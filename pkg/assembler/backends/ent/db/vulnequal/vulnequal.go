@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnequal
+
+const (
+	// Label holds the string label denoting the vulnequal type in the database.
+	Label = "vuln_equal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVulnerabilityAID holds the string denoting the vulnerability_a_id field in the database.
+	FieldVulnerabilityAID = "vulnerability_a_id"
+	// FieldVulnerabilityBID holds the string denoting the vulnerability_b_id field in the database.
+	FieldVulnerabilityBID = "vulnerability_b_id"
+	// FieldJustification holds the string denoting the justification field in the database.
+	FieldJustification = "justification"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// EdgeVulnerabilityA holds the string denoting the vulnerability_a edge name in mutations.
+	EdgeVulnerabilityA = "vulnerability_a"
+	// EdgeVulnerabilityB holds the string denoting the vulnerability_b edge name in mutations.
+	EdgeVulnerabilityB = "vulnerability_b"
+	// Table holds the table name of the vulnequal in the database.
+	Table = "vuln_equals"
+	// VulnerabilityATable is the table that holds the vulnerability_a relation/edge.
+	VulnerabilityATable = "vuln_equals"
+	// VulnerabilityAInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityAInverseTable = "vulnerability_ids"
+	// VulnerabilityAColumn is the table column denoting the vulnerability_a relation/edge.
+	VulnerabilityAColumn = "vulnerability_a_id"
+	// VulnerabilityBTable is the table that holds the vulnerability_b relation/edge.
+	VulnerabilityBTable = "vuln_equals"
+	// VulnerabilityBInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityBInverseTable = "vulnerability_ids"
+	// VulnerabilityBColumn is the table column denoting the vulnerability_b relation/edge.
+	VulnerabilityBColumn = "vulnerability_b_id"
+)
+
+// Columns holds all SQL columns for vulnequal fields.
+var Columns = []string{
+	FieldID,
+	FieldVulnerabilityAID,
+	FieldVulnerabilityBID,
+	FieldJustification,
+	FieldOrigin,
+	FieldCollector,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+)
+
+// VulnEqualQuery is the builder for querying VulnEqual entities.
+type VulnEqualQuery struct {
+	config
+	ctx                *QueryContext
+	order              []OrderFunc
+	inters             []Interceptor
+	predicates         []predicate.VulnEqual
+	withVulnerabilityA *VulnerabilityIDQuery
+	withVulnerabilityB *VulnerabilityIDQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VulnEqualQuery builder.
+func (veq *VulnEqualQuery) Where(ps ...predicate.VulnEqual) *VulnEqualQuery {
+	veq.predicates = append(veq.predicates, ps...)
+	return veq
+}
+
+// Limit the number of records to be returned by this query.
+func (veq *VulnEqualQuery) Limit(limit int) *VulnEqualQuery {
+	veq.ctx.Limit = &limit
+	return veq
+}
+
+// Offset to start from.
+func (veq *VulnEqualQuery) Offset(offset int) *VulnEqualQuery {
+	veq.ctx.Offset = &offset
+	return veq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (veq *VulnEqualQuery) Unique(unique bool) *VulnEqualQuery {
+	veq.ctx.Unique = &unique
+	return veq
+}
+
+// Order specifies how the records should be ordered.
+func (veq *VulnEqualQuery) Order(o ...OrderFunc) *VulnEqualQuery {
+	veq.order = append(veq.order, o...)
+	return veq
+}
+
+// QueryVulnerabilityA chains the current query on the "vulnerability_a" edge.
+func (veq *VulnEqualQuery) QueryVulnerabilityA() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: veq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := veq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := veq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnequal.Table, vulnequal.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnequal.VulnerabilityATable, vulnequal.VulnerabilityAColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(veq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVulnerabilityB chains the current query on the "vulnerability_b" edge.
+func (veq *VulnEqualQuery) QueryVulnerabilityB() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: veq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := veq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := veq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnequal.Table, vulnequal.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnequal.VulnerabilityBTable, vulnequal.VulnerabilityBColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(veq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VulnEqual entity from the query.
+// Returns a *NotFoundError when no VulnEqual was found.
+func (veq *VulnEqualQuery) First(ctx context.Context) (*VulnEqual, error) {
+	nodes, err := veq.Limit(1).All(setContextOp(ctx, veq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vulnequal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (veq *VulnEqualQuery) FirstX(ctx context.Context) *VulnEqual {
+	node, err := veq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VulnEqual ID from the query.
+// Returns a *NotFoundError when no VulnEqual ID was found.
+func (veq *VulnEqualQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = veq.Limit(1).IDs(setContextOp(ctx, veq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vulnequal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (veq *VulnEqualQuery) FirstIDX(ctx context.Context) int {
+	id, err := veq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VulnEqual entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VulnEqual entity is found.
+// Returns a *NotFoundError when no VulnEqual entities are found.
+func (veq *VulnEqualQuery) Only(ctx context.Context) (*VulnEqual, error) {
+	nodes, err := veq.Limit(2).All(setContextOp(ctx, veq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vulnequal.Label}
+	default:
+		return nil, &NotSingularError{vulnequal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (veq *VulnEqualQuery) OnlyX(ctx context.Context) *VulnEqual {
+	node, err := veq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VulnEqual ID in the query.
+// Returns a *NotSingularError when more than one VulnEqual ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (veq *VulnEqualQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = veq.Limit(2).IDs(setContextOp(ctx, veq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vulnequal.Label}
+	default:
+		err = &NotSingularError{vulnequal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (veq *VulnEqualQuery) OnlyIDX(ctx context.Context) int {
+	id, err := veq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VulnEquals.
+func (veq *VulnEqualQuery) All(ctx context.Context) ([]*VulnEqual, error) {
+	ctx = setContextOp(ctx, veq.ctx, "All")
+	if err := veq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VulnEqual, *VulnEqualQuery]()
+	return withInterceptors[[]*VulnEqual](ctx, veq, qr, veq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (veq *VulnEqualQuery) AllX(ctx context.Context) []*VulnEqual {
+	nodes, err := veq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VulnEqual IDs.
+func (veq *VulnEqualQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if veq.ctx.Unique == nil && veq.path != nil {
+		veq.Unique(true)
+	}
+	ctx = setContextOp(ctx, veq.ctx, "IDs")
+	if err = veq.Select(vulnequal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (veq *VulnEqualQuery) IDsX(ctx context.Context) []int {
+	ids, err := veq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (veq *VulnEqualQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, veq.ctx, "Count")
+	if err := veq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, veq, querierCount[*VulnEqualQuery](), veq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (veq *VulnEqualQuery) CountX(ctx context.Context) int {
+	count, err := veq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (veq *VulnEqualQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, veq.ctx, "Exist")
+	switch _, err := veq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (veq *VulnEqualQuery) ExistX(ctx context.Context) bool {
+	exist, err := veq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VulnEqualQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (veq *VulnEqualQuery) Clone() *VulnEqualQuery {
+	if veq == nil {
+		return nil
+	}
+	return &VulnEqualQuery{
+		config:             veq.config,
+		ctx:                veq.ctx.Clone(),
+		order:              append([]OrderFunc{}, veq.order...),
+		inters:             append([]Interceptor{}, veq.inters...),
+		predicates:         append([]predicate.VulnEqual{}, veq.predicates...),
+		withVulnerabilityA: veq.withVulnerabilityA.Clone(),
+		withVulnerabilityB: veq.withVulnerabilityB.Clone(),
+		// clone intermediate query.
+		sql:  veq.sql.Clone(),
+		path: veq.path,
+	}
+}
+
+// WithVulnerabilityA tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_a" edge. The optional arguments are used to configure the query builder of the edge.
+func (veq *VulnEqualQuery) WithVulnerabilityA(opts ...func(*VulnerabilityIDQuery)) *VulnEqualQuery {
+	query := (&VulnerabilityIDClient{config: veq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	veq.withVulnerabilityA = query
+	return veq
+}
+
+// WithVulnerabilityB tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_b" edge. The optional arguments are used to configure the query builder of the edge.
+func (veq *VulnEqualQuery) WithVulnerabilityB(opts ...func(*VulnerabilityIDQuery)) *VulnEqualQuery {
+	query := (&VulnerabilityIDClient{config: veq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	veq.withVulnerabilityB = query
+	return veq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityAID int `json:"vulnerability_a_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VulnEqual.Query().
+//		GroupBy(vulnequal.FieldVulnerabilityAID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (veq *VulnEqualQuery) GroupBy(field string, fields ...string) *VulnEqualGroupBy {
+	veq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VulnEqualGroupBy{build: veq}
+	grbuild.flds = &veq.ctx.Fields
+	grbuild.label = vulnequal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityAID int `json:"vulnerability_a_id,omitempty"`
+//	}
+//
+//	client.VulnEqual.Query().
+//		Select(vulnequal.FieldVulnerabilityAID).
+//		Scan(ctx, &v)
+func (veq *VulnEqualQuery) Select(fields ...string) *VulnEqualSelect {
+	veq.ctx.Fields = append(veq.ctx.Fields, fields...)
+	sbuild := &VulnEqualSelect{VulnEqualQuery: veq}
+	sbuild.label = vulnequal.Label
+	sbuild.flds, sbuild.scan = &veq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VulnEqualSelect configured with the given aggregations.
+func (veq *VulnEqualQuery) Aggregate(fns ...AggregateFunc) *VulnEqualSelect {
+	return veq.Select().Aggregate(fns...)
+}
+
+func (veq *VulnEqualQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range veq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, veq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range veq.ctx.Fields {
+		if !vulnequal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if veq.path != nil {
+		prev, err := veq.path(ctx)
+		if err != nil {
+			return err
+		}
+		veq.sql = prev
+	}
+	return nil
+}
+
+func (veq *VulnEqualQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VulnEqual, error) {
+	var (
+		nodes       = []*VulnEqual{}
+		_spec       = veq.querySpec()
+		loadedTypes = [2]bool{
+			veq.withVulnerabilityA != nil,
+			veq.withVulnerabilityB != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VulnEqual).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VulnEqual{config: veq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, veq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := veq.withVulnerabilityA; query != nil {
+		if err := veq.loadVulnerabilityA(ctx, query, nodes, nil,
+			func(n *VulnEqual, e *VulnerabilityID) { n.Edges.VulnerabilityA = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := veq.withVulnerabilityB; query != nil {
+		if err := veq.loadVulnerabilityB(ctx, query, nodes, nil,
+			func(n *VulnEqual, e *VulnerabilityID) { n.Edges.VulnerabilityB = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (veq *VulnEqualQuery) loadVulnerabilityA(ctx context.Context, query *VulnerabilityIDQuery, nodes []*VulnEqual, init func(*VulnEqual), assign func(*VulnEqual, *VulnerabilityID)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*VulnEqual)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityAID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_a_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (veq *VulnEqualQuery) loadVulnerabilityB(ctx context.Context, query *VulnerabilityIDQuery, nodes []*VulnEqual, init func(*VulnEqual), assign func(*VulnEqual, *VulnerabilityID)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*VulnEqual)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityBID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_b_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (veq *VulnEqualQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := veq.querySpec()
+	_spec.Node.Columns = veq.ctx.Fields
+	if len(veq.ctx.Fields) > 0 {
+		_spec.Unique = veq.ctx.Unique != nil && *veq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, veq.driver, _spec)
+}
+
+func (veq *VulnEqualQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(vulnequal.Table, vulnequal.Columns, sqlgraph.NewFieldSpec(vulnequal.FieldID, field.TypeInt))
+	_spec.From = veq.sql
+	if unique := veq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if veq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := veq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnequal.FieldID)
+		for i := range fields {
+			if fields[i] != vulnequal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := veq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := veq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := veq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := veq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (veq *VulnEqualQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(veq.driver.Dialect())
+	t1 := builder.Table(vulnequal.Table)
+	columns := veq.ctx.Fields
+	if len(columns) == 0 {
+		columns = vulnequal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if veq.sql != nil {
+		selector = veq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if veq.ctx.Unique != nil && *veq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range veq.predicates {
+		p(selector)
+	}
+	for _, p := range veq.order {
+		p(selector)
+	}
+	if offset := veq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := veq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VulnEqualGroupBy is the group-by builder for VulnEqual entities.
+type VulnEqualGroupBy struct {
+	selector
+	build *VulnEqualQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vegb *VulnEqualGroupBy) Aggregate(fns ...AggregateFunc) *VulnEqualGroupBy {
+	vegb.fns = append(vegb.fns, fns...)
+	return vegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vegb *VulnEqualGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vegb.build.ctx, "GroupBy")
+	if err := vegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnEqualQuery, *VulnEqualGroupBy](ctx, vegb.build, vegb, vegb.build.inters, v)
+}
+
+func (vegb *VulnEqualGroupBy) sqlScan(ctx context.Context, root *VulnEqualQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vegb.fns))
+	for _, fn := range vegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vegb.flds)+len(vegb.fns))
+		for _, f := range *vegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VulnEqualSelect is the builder for selecting fields of VulnEqual entities.
+type VulnEqualSelect struct {
+	*VulnEqualQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ves *VulnEqualSelect) Aggregate(fns ...AggregateFunc) *VulnEqualSelect {
+	ves.fns = append(ves.fns, fns...)
+	return ves
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ves *VulnEqualSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ves.ctx, "Select")
+	if err := ves.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnEqualQuery, *VulnEqualSelect](ctx, ves.VulnEqualQuery, ves, ves.inters, v)
+}
+
+func (ves *VulnEqualSelect) sqlScan(ctx context.Context, root *VulnEqualQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ves.fns))
+	for _, fn := range ves.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ves.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ves.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
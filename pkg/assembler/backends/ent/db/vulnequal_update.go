@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+)
+
+// VulnEqualUpdate is the builder for updating VulnEqual entities.
+type VulnEqualUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VulnEqualMutation
+}
+
+// Where appends a list predicates to the VulnEqualUpdate builder.
+func (veu *VulnEqualUpdate) Where(ps ...predicate.VulnEqual) *VulnEqualUpdate {
+	veu.mutation.Where(ps...)
+	return veu
+}
+
+// SetVulnerabilityAID sets the "vulnerability_a_id" field.
+func (veu *VulnEqualUpdate) SetVulnerabilityAID(i int) *VulnEqualUpdate {
+	veu.mutation.SetVulnerabilityAID(i)
+	return veu
+}
+
+// SetVulnerabilityBID sets the "vulnerability_b_id" field.
+func (veu *VulnEqualUpdate) SetVulnerabilityBID(i int) *VulnEqualUpdate {
+	veu.mutation.SetVulnerabilityBID(i)
+	return veu
+}
+
+// SetJustification sets the "justification" field.
+func (veu *VulnEqualUpdate) SetJustification(s string) *VulnEqualUpdate {
+	veu.mutation.SetJustification(s)
+	return veu
+}
+
+// SetOrigin sets the "origin" field.
+func (veu *VulnEqualUpdate) SetOrigin(s string) *VulnEqualUpdate {
+	veu.mutation.SetOrigin(s)
+	return veu
+}
+
+// SetCollector sets the "collector" field.
+func (veu *VulnEqualUpdate) SetCollector(s string) *VulnEqualUpdate {
+	veu.mutation.SetCollector(s)
+	return veu
+}
+
+// SetVulnerabilityA sets the "vulnerability_a" edge to the VulnerabilityID entity.
+func (veu *VulnEqualUpdate) SetVulnerabilityA(v *VulnerabilityID) *VulnEqualUpdate {
+	return veu.SetVulnerabilityAID(v.ID)
+}
+
+// SetVulnerabilityB sets the "vulnerability_b" edge to the VulnerabilityID entity.
+func (veu *VulnEqualUpdate) SetVulnerabilityB(v *VulnerabilityID) *VulnEqualUpdate {
+	return veu.SetVulnerabilityBID(v.ID)
+}
+
+// Mutation returns the VulnEqualMutation object of the builder.
+func (veu *VulnEqualUpdate) Mutation() *VulnEqualMutation {
+	return veu.mutation
+}
+
+// ClearVulnerabilityA clears the "vulnerability_a" edge to the VulnerabilityID entity.
+func (veu *VulnEqualUpdate) ClearVulnerabilityA() *VulnEqualUpdate {
+	veu.mutation.ClearVulnerabilityA()
+	return veu
+}
+
+// ClearVulnerabilityB clears the "vulnerability_b" edge to the VulnerabilityID entity.
+func (veu *VulnEqualUpdate) ClearVulnerabilityB() *VulnEqualUpdate {
+	veu.mutation.ClearVulnerabilityB()
+	return veu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (veu *VulnEqualUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, VulnEqualMutation](ctx, veu.sqlSave, veu.mutation, veu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (veu *VulnEqualUpdate) SaveX(ctx context.Context) int {
+	affected, err := veu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (veu *VulnEqualUpdate) Exec(ctx context.Context) error {
+	_, err := veu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (veu *VulnEqualUpdate) ExecX(ctx context.Context) {
+	if err := veu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (veu *VulnEqualUpdate) check() error {
+	if _, ok := veu.mutation.VulnerabilityAID(); veu.mutation.VulnerabilityACleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnEqual.vulnerability_a"`)
+	}
+	if _, ok := veu.mutation.VulnerabilityBID(); veu.mutation.VulnerabilityBCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnEqual.vulnerability_b"`)
+	}
+	return nil
+}
+
+func (veu *VulnEqualUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := veu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnequal.Table, vulnequal.Columns, sqlgraph.NewFieldSpec(vulnequal.FieldID, field.TypeInt))
+	if ps := veu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := veu.mutation.Justification(); ok {
+		_spec.SetField(vulnequal.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := veu.mutation.Origin(); ok {
+		_spec.SetField(vulnequal.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := veu.mutation.Collector(); ok {
+		_spec.SetField(vulnequal.FieldCollector, field.TypeString, value)
+	}
+	if veu.mutation.VulnerabilityACleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityATable,
+			Columns: []string{vulnequal.VulnerabilityAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := veu.mutation.VulnerabilityAIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityATable,
+			Columns: []string{vulnequal.VulnerabilityAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if veu.mutation.VulnerabilityBCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityBTable,
+			Columns: []string{vulnequal.VulnerabilityBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := veu.mutation.VulnerabilityBIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityBTable,
+			Columns: []string{vulnequal.VulnerabilityBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, veu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnequal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	veu.mutation.done = true
+	return n, nil
+}
+
+// VulnEqualUpdateOne is the builder for updating a single VulnEqual entity.
+type VulnEqualUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VulnEqualMutation
+}
+
+// SetVulnerabilityAID sets the "vulnerability_a_id" field.
+func (veuo *VulnEqualUpdateOne) SetVulnerabilityAID(i int) *VulnEqualUpdateOne {
+	veuo.mutation.SetVulnerabilityAID(i)
+	return veuo
+}
+
+// SetVulnerabilityBID sets the "vulnerability_b_id" field.
+func (veuo *VulnEqualUpdateOne) SetVulnerabilityBID(i int) *VulnEqualUpdateOne {
+	veuo.mutation.SetVulnerabilityBID(i)
+	return veuo
+}
+
+// SetJustification sets the "justification" field.
+func (veuo *VulnEqualUpdateOne) SetJustification(s string) *VulnEqualUpdateOne {
+	veuo.mutation.SetJustification(s)
+	return veuo
+}
+
+// SetOrigin sets the "origin" field.
+func (veuo *VulnEqualUpdateOne) SetOrigin(s string) *VulnEqualUpdateOne {
+	veuo.mutation.SetOrigin(s)
+	return veuo
+}
+
+// SetCollector sets the "collector" field.
+func (veuo *VulnEqualUpdateOne) SetCollector(s string) *VulnEqualUpdateOne {
+	veuo.mutation.SetCollector(s)
+	return veuo
+}
+
+// SetVulnerabilityA sets the "vulnerability_a" edge to the VulnerabilityID entity.
+func (veuo *VulnEqualUpdateOne) SetVulnerabilityA(v *VulnerabilityID) *VulnEqualUpdateOne {
+	return veuo.SetVulnerabilityAID(v.ID)
+}
+
+// SetVulnerabilityB sets the "vulnerability_b" edge to the VulnerabilityID entity.
+func (veuo *VulnEqualUpdateOne) SetVulnerabilityB(v *VulnerabilityID) *VulnEqualUpdateOne {
+	return veuo.SetVulnerabilityBID(v.ID)
+}
+
+// Mutation returns the VulnEqualMutation object of the builder.
+func (veuo *VulnEqualUpdateOne) Mutation() *VulnEqualMutation {
+	return veuo.mutation
+}
+
+// ClearVulnerabilityA clears the "vulnerability_a" edge to the VulnerabilityID entity.
+func (veuo *VulnEqualUpdateOne) ClearVulnerabilityA() *VulnEqualUpdateOne {
+	veuo.mutation.ClearVulnerabilityA()
+	return veuo
+}
+
+// ClearVulnerabilityB clears the "vulnerability_b" edge to the VulnerabilityID entity.
+func (veuo *VulnEqualUpdateOne) ClearVulnerabilityB() *VulnEqualUpdateOne {
+	veuo.mutation.ClearVulnerabilityB()
+	return veuo
+}
+
+// Where appends a list predicates to the VulnEqualUpdate builder.
+func (veuo *VulnEqualUpdateOne) Where(ps ...predicate.VulnEqual) *VulnEqualUpdateOne {
+	veuo.mutation.Where(ps...)
+	return veuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (veuo *VulnEqualUpdateOne) Select(field string, fields ...string) *VulnEqualUpdateOne {
+	veuo.fields = append([]string{field}, fields...)
+	return veuo
+}
+
+// Save executes the query and returns the updated VulnEqual entity.
+func (veuo *VulnEqualUpdateOne) Save(ctx context.Context) (*VulnEqual, error) {
+	return withHooks[*VulnEqual, VulnEqualMutation](ctx, veuo.sqlSave, veuo.mutation, veuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (veuo *VulnEqualUpdateOne) SaveX(ctx context.Context) *VulnEqual {
+	node, err := veuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (veuo *VulnEqualUpdateOne) Exec(ctx context.Context) error {
+	_, err := veuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (veuo *VulnEqualUpdateOne) ExecX(ctx context.Context) {
+	if err := veuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (veuo *VulnEqualUpdateOne) check() error {
+	if _, ok := veuo.mutation.VulnerabilityAID(); veuo.mutation.VulnerabilityACleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnEqual.vulnerability_a"`)
+	}
+	if _, ok := veuo.mutation.VulnerabilityBID(); veuo.mutation.VulnerabilityBCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnEqual.vulnerability_b"`)
+	}
+	return nil
+}
+
+func (veuo *VulnEqualUpdateOne) sqlSave(ctx context.Context) (_node *VulnEqual, err error) {
+	if err := veuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnequal.Table, vulnequal.Columns, sqlgraph.NewFieldSpec(vulnequal.FieldID, field.TypeInt))
+	id, ok := veuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "VulnEqual.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := veuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnequal.FieldID)
+		for _, f := range fields {
+			if !vulnequal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != vulnequal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := veuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := veuo.mutation.Justification(); ok {
+		_spec.SetField(vulnequal.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := veuo.mutation.Origin(); ok {
+		_spec.SetField(vulnequal.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := veuo.mutation.Collector(); ok {
+		_spec.SetField(vulnequal.FieldCollector, field.TypeString, value)
+	}
+	if veuo.mutation.VulnerabilityACleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityATable,
+			Columns: []string{vulnequal.VulnerabilityAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := veuo.mutation.VulnerabilityAIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityATable,
+			Columns: []string{vulnequal.VulnerabilityAColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if veuo.mutation.VulnerabilityBCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityBTable,
+			Columns: []string{vulnequal.VulnerabilityBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := veuo.mutation.VulnerabilityBIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnequal.VulnerabilityBTable,
+			Columns: []string{vulnequal.VulnerabilityBColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VulnEqual{config: veuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, veuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnequal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	veuo.mutation.done = true
+	return _node, nil
+}
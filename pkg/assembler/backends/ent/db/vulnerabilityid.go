@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityID is the model entity for the VulnerabilityID schema.
+type VulnerabilityID struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TypeID holds the value of the "type_id" field.
+	TypeID int `json:"type_id,omitempty"`
+	// VulnerabilityID holds the value of the "vulnerability_id" field.
+	VulnerabilityID string `json:"vulnerability_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VulnerabilityIDQuery when eager-loading is set.
+	Edges VulnerabilityIDEdges `json:"edges"`
+}
+
+// VulnerabilityIDEdges holds the relations/edges for other nodes in the graph.
+type VulnerabilityIDEdges struct {
+	// Type holds the value of the type edge.
+	Type *VulnerabilityType `json:"type,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TypeOrErr returns the Type value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VulnerabilityIDEdges) TypeOrErr() (*VulnerabilityType, error) {
+	if e.loadedTypes[0] {
+		if e.Type == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: vulnerabilitytype.Label}
+		}
+		return e.Type, nil
+	}
+	return nil, &NotLoadedError{edge: "type"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VulnerabilityID) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilityid.FieldID, vulnerabilityid.FieldTypeID:
+			values[i] = new(sql.NullInt64)
+		case vulnerabilityid.FieldVulnerabilityID:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type VulnerabilityID", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VulnerabilityID fields.
+func (vi *VulnerabilityID) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilityid.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vi.ID = int(value.Int64)
+		case vulnerabilityid.FieldTypeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field type_id", values[i])
+			} else if value.Valid {
+				vi.TypeID = int(value.Int64)
+			}
+		case vulnerabilityid.FieldVulnerabilityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_id", values[i])
+			} else if value.Valid {
+				vi.VulnerabilityID = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryType queries the "type" edge of the VulnerabilityID entity.
+func (vi *VulnerabilityID) QueryType() *VulnerabilityTypeQuery {
+	return NewVulnerabilityIDClient(vi.config).QueryType(vi)
+}
+
+// Update returns a builder for updating this VulnerabilityID.
+// Note that you need to call VulnerabilityID.Unwrap() before calling this method if this VulnerabilityID
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vi *VulnerabilityID) Update() *VulnerabilityIDUpdateOne {
+	return NewVulnerabilityIDClient(vi.config).UpdateOne(vi)
+}
+
+// Unwrap unwraps the VulnerabilityID entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vi *VulnerabilityID) Unwrap() *VulnerabilityID {
+	_tx, ok := vi.config.driver.(*txDriver)
+	if !ok {
+		panic("db: VulnerabilityID is not a transactional entity")
+	}
+	vi.config.driver = _tx.drv
+	return vi
+}
+
+// String implements the fmt.Stringer.
+func (vi *VulnerabilityID) String() string {
+	var builder strings.Builder
+	builder.WriteString("VulnerabilityID(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vi.ID))
+	builder.WriteString("type_id=")
+	builder.WriteString(fmt.Sprintf("%v", vi.TypeID))
+	builder.WriteString(", ")
+	builder.WriteString("vulnerability_id=")
+	builder.WriteString(vi.VulnerabilityID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VulnerabilityIDs is a parsable slice of VulnerabilityID.
+type VulnerabilityIDs []*VulnerabilityID
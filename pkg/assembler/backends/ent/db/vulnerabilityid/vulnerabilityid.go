@@ -0,0 +1,42 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerabilityid
+
+const (
+	// Label holds the string label denoting the vulnerabilityid type in the database.
+	Label = "vulnerability_id"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTypeID holds the string denoting the type_id field in the database.
+	FieldTypeID = "type_id"
+	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
+	FieldVulnerabilityID = "vulnerability_id"
+	// EdgeType holds the string denoting the type edge name in mutations.
+	EdgeType = "type"
+	// Table holds the table name of the vulnerabilityid in the database.
+	Table = "vulnerability_ids"
+	// TypeTable is the table that holds the type relation/edge.
+	TypeTable = "vulnerability_ids"
+	// TypeInverseTable is the table name for the VulnerabilityType entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilitytype" package.
+	TypeInverseTable = "vulnerability_types"
+	// TypeColumn is the table column denoting the type relation/edge.
+	TypeColumn = "type_id"
+)
+
+// Columns holds all SQL columns for vulnerabilityid fields.
+var Columns = []string{
+	FieldID,
+	FieldTypeID,
+	FieldVulnerabilityID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
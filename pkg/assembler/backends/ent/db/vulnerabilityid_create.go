@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityIDCreate is the builder for creating a VulnerabilityID entity.
+type VulnerabilityIDCreate struct {
+	config
+	mutation *VulnerabilityIDMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTypeID sets the "type_id" field.
+func (vic *VulnerabilityIDCreate) SetTypeID(i int) *VulnerabilityIDCreate {
+	vic.mutation.SetTypeID(i)
+	return vic
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (vic *VulnerabilityIDCreate) SetVulnerabilityID(s string) *VulnerabilityIDCreate {
+	vic.mutation.SetVulnerabilityID(s)
+	return vic
+}
+
+// SetType sets the "type" edge to the VulnerabilityType entity.
+func (vic *VulnerabilityIDCreate) SetType(v *VulnerabilityType) *VulnerabilityIDCreate {
+	return vic.SetTypeID(v.ID)
+}
+
+// Mutation returns the VulnerabilityIDMutation object of the builder.
+func (vic *VulnerabilityIDCreate) Mutation() *VulnerabilityIDMutation {
+	return vic.mutation
+}
+
+// Save creates the VulnerabilityID in the database.
+func (vic *VulnerabilityIDCreate) Save(ctx context.Context) (*VulnerabilityID, error) {
+	return withHooks[*VulnerabilityID, VulnerabilityIDMutation](ctx, vic.sqlSave, vic.mutation, vic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vic *VulnerabilityIDCreate) SaveX(ctx context.Context) *VulnerabilityID {
+	v, err := vic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vic *VulnerabilityIDCreate) Exec(ctx context.Context) error {
+	_, err := vic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vic *VulnerabilityIDCreate) ExecX(ctx context.Context) {
+	if err := vic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vic *VulnerabilityIDCreate) check() error {
+	if _, ok := vic.mutation.TypeID(); !ok {
+		return &ValidationError{Name: "type_id", err: errors.New(`db: missing required field "VulnerabilityID.type_id"`)}
+	}
+	if _, ok := vic.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability_id", err: errors.New(`db: missing required field "VulnerabilityID.vulnerability_id"`)}
+	}
+	if _, ok := vic.mutation.TypeID(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`db: missing required edge "VulnerabilityID.type"`)}
+	}
+	return nil
+}
+
+func (vic *VulnerabilityIDCreate) sqlSave(ctx context.Context) (*VulnerabilityID, error) {
+	if err := vic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vic.mutation.id = &_node.ID
+	vic.mutation.done = true
+	return _node, nil
+}
+
+func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VulnerabilityID{config: vic.config}
+		_spec = sqlgraph.NewCreateSpec(vulnerabilityid.Table, sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = vic.conflict
+	if value, ok := vic.mutation.VulnerabilityID(); ok {
+		_spec.SetField(vulnerabilityid.FieldVulnerabilityID, field.TypeString, value)
+		_node.VulnerabilityID = value
+	}
+	if nodes := vic.mutation.TypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vulnerabilityid.TypeTable,
+			Columns: []string{vulnerabilityid.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TypeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VulnerabilityID.Create().
+//		SetTypeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VulnerabilityIDUpsert) {
+//			SetTypeID(v+v).
+//		}).
+//		Exec(ctx)
+func (vic *VulnerabilityIDCreate) OnConflict(opts ...sql.ConflictOption) *VulnerabilityIDUpsertOne {
+	vic.conflict = opts
+	return &VulnerabilityIDUpsertOne{
+		create: vic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vic *VulnerabilityIDCreate) OnConflictColumns(columns ...string) *VulnerabilityIDUpsertOne {
+	vic.conflict = append(vic.conflict, sql.ConflictColumns(columns...))
+	return &VulnerabilityIDUpsertOne{
+		create: vic,
+	}
+}
+
+type (
+	// VulnerabilityIDUpsertOne is the builder for "upsert"-ing
+	//  one VulnerabilityID node.
+	VulnerabilityIDUpsertOne struct {
+		create *VulnerabilityIDCreate
+	}
+
+	// VulnerabilityIDUpsert is the "OnConflict" setter.
+	VulnerabilityIDUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTypeID sets the "type_id" field.
+func (u *VulnerabilityIDUpsert) SetTypeID(v int) *VulnerabilityIDUpsert {
+	u.Set(vulnerabilityid.FieldTypeID, v)
+	return u
+}
+
+// UpdateTypeID sets the "type_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsert) UpdateTypeID() *VulnerabilityIDUpsert {
+	u.SetExcluded(vulnerabilityid.FieldTypeID)
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityIDUpsert) SetVulnerabilityID(v string) *VulnerabilityIDUpsert {
+	u.Set(vulnerabilityid.FieldVulnerabilityID, v)
+	return u
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsert) UpdateVulnerabilityID() *VulnerabilityIDUpsert {
+	u.SetExcluded(vulnerabilityid.FieldVulnerabilityID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VulnerabilityIDUpsertOne) UpdateNewValues() *VulnerabilityIDUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VulnerabilityIDUpsertOne) Ignore() *VulnerabilityIDUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VulnerabilityIDUpsertOne) DoNothing() *VulnerabilityIDUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VulnerabilityIDCreate.OnConflict
+// documentation for more info.
+func (u *VulnerabilityIDUpsertOne) Update(set func(*VulnerabilityIDUpsert)) *VulnerabilityIDUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VulnerabilityIDUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTypeID sets the "type_id" field.
+func (u *VulnerabilityIDUpsertOne) SetTypeID(v int) *VulnerabilityIDUpsertOne {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.SetTypeID(v)
+	})
+}
+
+// UpdateTypeID sets the "type_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsertOne) UpdateTypeID() *VulnerabilityIDUpsertOne {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.UpdateTypeID()
+	})
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityIDUpsertOne) SetVulnerabilityID(v string) *VulnerabilityIDUpsertOne {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsertOne) UpdateVulnerabilityID() *VulnerabilityIDUpsertOne {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// Exec executes the query.
+func (u *VulnerabilityIDUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for VulnerabilityIDCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VulnerabilityIDUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VulnerabilityIDUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VulnerabilityIDUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VulnerabilityIDCreateBulk is the builder for creating many VulnerabilityID entities in bulk.
+type VulnerabilityIDCreateBulk struct {
+	config
+	builders []*VulnerabilityIDCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VulnerabilityID entities in the database.
+func (vicb *VulnerabilityIDCreateBulk) Save(ctx context.Context) ([]*VulnerabilityID, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(vicb.builders))
+	nodes := make([]*VulnerabilityID, len(vicb.builders))
+	mutators := make([]Mutator, len(vicb.builders))
+	for i := range vicb.builders {
+		func(i int, root context.Context) {
+			builder := vicb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VulnerabilityIDMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vicb *VulnerabilityIDCreateBulk) SaveX(ctx context.Context) []*VulnerabilityID {
+	v, err := vicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vicb *VulnerabilityIDCreateBulk) Exec(ctx context.Context) error {
+	_, err := vicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vicb *VulnerabilityIDCreateBulk) ExecX(ctx context.Context) {
+	if err := vicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VulnerabilityID.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VulnerabilityIDUpsert) {
+//			SetTypeID(v+v).
+//		}).
+//		Exec(ctx)
+func (vicb *VulnerabilityIDCreateBulk) OnConflict(opts ...sql.ConflictOption) *VulnerabilityIDUpsertBulk {
+	vicb.conflict = opts
+	return &VulnerabilityIDUpsertBulk{
+		create: vicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vicb *VulnerabilityIDCreateBulk) OnConflictColumns(columns ...string) *VulnerabilityIDUpsertBulk {
+	vicb.conflict = append(vicb.conflict, sql.ConflictColumns(columns...))
+	return &VulnerabilityIDUpsertBulk{
+		create: vicb,
+	}
+}
+
+// VulnerabilityIDUpsertBulk is the builder for "upsert"-ing
+// a bulk of VulnerabilityID nodes.
+type VulnerabilityIDUpsertBulk struct {
+	create *VulnerabilityIDCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VulnerabilityIDUpsertBulk) UpdateNewValues() *VulnerabilityIDUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityID.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VulnerabilityIDUpsertBulk) Ignore() *VulnerabilityIDUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VulnerabilityIDUpsertBulk) DoNothing() *VulnerabilityIDUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VulnerabilityIDCreateBulk.OnConflict
+// documentation for more info.
+func (u *VulnerabilityIDUpsertBulk) Update(set func(*VulnerabilityIDUpsert)) *VulnerabilityIDUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VulnerabilityIDUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTypeID sets the "type_id" field.
+func (u *VulnerabilityIDUpsertBulk) SetTypeID(v int) *VulnerabilityIDUpsertBulk {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.SetTypeID(v)
+	})
+}
+
+// UpdateTypeID sets the "type_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsertBulk) UpdateTypeID() *VulnerabilityIDUpsertBulk {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.UpdateTypeID()
+	})
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityIDUpsertBulk) SetVulnerabilityID(v string) *VulnerabilityIDUpsertBulk {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityIDUpsertBulk) UpdateVulnerabilityID() *VulnerabilityIDUpsertBulk {
+	return u.Update(func(s *VulnerabilityIDUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// Exec executes the query.
+func (u *VulnerabilityIDUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the VulnerabilityIDCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for VulnerabilityIDCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VulnerabilityIDUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+)
+
+// VulnerabilityIDDelete is the builder for deleting a VulnerabilityID entity.
+type VulnerabilityIDDelete struct {
+	config
+	hooks    []Hook
+	mutation *VulnerabilityIDMutation
+}
+
+// Where appends a list predicates to the VulnerabilityIDDelete builder.
+func (vid *VulnerabilityIDDelete) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDDelete {
+	vid.mutation.Where(ps...)
+	return vid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vid *VulnerabilityIDDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, VulnerabilityIDMutation](ctx, vid.sqlExec, vid.mutation, vid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vid *VulnerabilityIDDelete) ExecX(ctx context.Context) int {
+	n, err := vid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vid *VulnerabilityIDDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vulnerabilityid.Table, sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt))
+	if ps := vid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vid.mutation.done = true
+	return affected, err
+}
+
+// VulnerabilityIDDeleteOne is the builder for deleting a single VulnerabilityID entity.
+type VulnerabilityIDDeleteOne struct {
+	vid *VulnerabilityIDDelete
+}
+
+// Where appends a list predicates to the VulnerabilityIDDelete builder.
+func (vido *VulnerabilityIDDeleteOne) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDDeleteOne {
+	vido.vid.mutation.Where(ps...)
+	return vido
+}
+
+// Exec executes the deletion query.
+func (vido *VulnerabilityIDDeleteOne) Exec(ctx context.Context) error {
+	n, err := vido.vid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vulnerabilityid.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vido *VulnerabilityIDDeleteOne) ExecX(ctx context.Context) {
+	if err := vido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityIDUpdate is the builder for updating VulnerabilityID entities.
+type VulnerabilityIDUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VulnerabilityIDMutation
+}
+
+// Where appends a list predicates to the VulnerabilityIDUpdate builder.
+func (viu *VulnerabilityIDUpdate) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDUpdate {
+	viu.mutation.Where(ps...)
+	return viu
+}
+
+// SetTypeID sets the "type_id" field.
+func (viu *VulnerabilityIDUpdate) SetTypeID(i int) *VulnerabilityIDUpdate {
+	viu.mutation.SetTypeID(i)
+	return viu
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (viu *VulnerabilityIDUpdate) SetVulnerabilityID(s string) *VulnerabilityIDUpdate {
+	viu.mutation.SetVulnerabilityID(s)
+	return viu
+}
+
+// SetType sets the "type" edge to the VulnerabilityType entity.
+func (viu *VulnerabilityIDUpdate) SetType(v *VulnerabilityType) *VulnerabilityIDUpdate {
+	return viu.SetTypeID(v.ID)
+}
+
+// Mutation returns the VulnerabilityIDMutation object of the builder.
+func (viu *VulnerabilityIDUpdate) Mutation() *VulnerabilityIDMutation {
+	return viu.mutation
+}
+
+// ClearType clears the "type" edge to the VulnerabilityType entity.
+func (viu *VulnerabilityIDUpdate) ClearType() *VulnerabilityIDUpdate {
+	viu.mutation.ClearType()
+	return viu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (viu *VulnerabilityIDUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, VulnerabilityIDMutation](ctx, viu.sqlSave, viu.mutation, viu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (viu *VulnerabilityIDUpdate) SaveX(ctx context.Context) int {
+	affected, err := viu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (viu *VulnerabilityIDUpdate) Exec(ctx context.Context) error {
+	_, err := viu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (viu *VulnerabilityIDUpdate) ExecX(ctx context.Context) {
+	if err := viu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (viu *VulnerabilityIDUpdate) check() error {
+	if _, ok := viu.mutation.TypeID(); viu.mutation.TypeCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnerabilityID.type"`)
+	}
+	return nil
+}
+
+func (viu *VulnerabilityIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := viu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnerabilityid.Table, vulnerabilityid.Columns, sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt))
+	if ps := viu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := viu.mutation.VulnerabilityID(); ok {
+		_spec.SetField(vulnerabilityid.FieldVulnerabilityID, field.TypeString, value)
+	}
+	if viu.mutation.TypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vulnerabilityid.TypeTable,
+			Columns: []string{vulnerabilityid.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viu.mutation.TypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vulnerabilityid.TypeTable,
+			Columns: []string{vulnerabilityid.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, viu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnerabilityid.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	viu.mutation.done = true
+	return n, nil
+}
+
+// VulnerabilityIDUpdateOne is the builder for updating a single VulnerabilityID entity.
+type VulnerabilityIDUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VulnerabilityIDMutation
+}
+
+// SetTypeID sets the "type_id" field.
+func (viuo *VulnerabilityIDUpdateOne) SetTypeID(i int) *VulnerabilityIDUpdateOne {
+	viuo.mutation.SetTypeID(i)
+	return viuo
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (viuo *VulnerabilityIDUpdateOne) SetVulnerabilityID(s string) *VulnerabilityIDUpdateOne {
+	viuo.mutation.SetVulnerabilityID(s)
+	return viuo
+}
+
+// SetType sets the "type" edge to the VulnerabilityType entity.
+func (viuo *VulnerabilityIDUpdateOne) SetType(v *VulnerabilityType) *VulnerabilityIDUpdateOne {
+	return viuo.SetTypeID(v.ID)
+}
+
+// Mutation returns the VulnerabilityIDMutation object of the builder.
+func (viuo *VulnerabilityIDUpdateOne) Mutation() *VulnerabilityIDMutation {
+	return viuo.mutation
+}
+
+// ClearType clears the "type" edge to the VulnerabilityType entity.
+func (viuo *VulnerabilityIDUpdateOne) ClearType() *VulnerabilityIDUpdateOne {
+	viuo.mutation.ClearType()
+	return viuo
+}
+
+// Where appends a list predicates to the VulnerabilityIDUpdate builder.
+func (viuo *VulnerabilityIDUpdateOne) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDUpdateOne {
+	viuo.mutation.Where(ps...)
+	return viuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (viuo *VulnerabilityIDUpdateOne) Select(field string, fields ...string) *VulnerabilityIDUpdateOne {
+	viuo.fields = append([]string{field}, fields...)
+	return viuo
+}
+
+// Save executes the query and returns the updated VulnerabilityID entity.
+func (viuo *VulnerabilityIDUpdateOne) Save(ctx context.Context) (*VulnerabilityID, error) {
+	return withHooks[*VulnerabilityID, VulnerabilityIDMutation](ctx, viuo.sqlSave, viuo.mutation, viuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (viuo *VulnerabilityIDUpdateOne) SaveX(ctx context.Context) *VulnerabilityID {
+	node, err := viuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (viuo *VulnerabilityIDUpdateOne) Exec(ctx context.Context) error {
+	_, err := viuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (viuo *VulnerabilityIDUpdateOne) ExecX(ctx context.Context) {
+	if err := viuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (viuo *VulnerabilityIDUpdateOne) check() error {
+	if _, ok := viuo.mutation.TypeID(); viuo.mutation.TypeCleared() && !ok {
+		return errors.New(`db: clearing a required unique edge "VulnerabilityID.type"`)
+	}
+	return nil
+}
+
+func (viuo *VulnerabilityIDUpdateOne) sqlSave(ctx context.Context) (_node *VulnerabilityID, err error) {
+	if err := viuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnerabilityid.Table, vulnerabilityid.Columns, sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeInt))
+	id, ok := viuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "VulnerabilityID.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := viuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnerabilityid.FieldID)
+		for _, f := range fields {
+			if !vulnerabilityid.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != vulnerabilityid.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := viuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := viuo.mutation.VulnerabilityID(); ok {
+		_spec.SetField(vulnerabilityid.FieldVulnerabilityID, field.TypeString, value)
+	}
+	if viuo.mutation.TypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vulnerabilityid.TypeTable,
+			Columns: []string{vulnerabilityid.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viuo.mutation.TypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vulnerabilityid.TypeTable,
+			Columns: []string{vulnerabilityid.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VulnerabilityID{config: viuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, viuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnerabilityid.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	viuo.mutation.done = true
+	return _node, nil
+}
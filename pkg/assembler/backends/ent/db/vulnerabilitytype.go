@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityType is the model entity for the VulnerabilityType schema.
+type VulnerabilityType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VulnerabilityTypeQuery when eager-loading is set.
+	Edges VulnerabilityTypeEdges `json:"edges"`
+}
+
+// VulnerabilityTypeEdges holds the relations/edges for other nodes in the graph.
+type VulnerabilityTypeEdges struct {
+	// VulnerabilityIds holds the value of the vulnerability_ids edge.
+	VulnerabilityIds []*VulnerabilityID `json:"vulnerability_ids,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VulnerabilityIdsOrErr returns the VulnerabilityIds value or an error if the edge
+// was not loaded in eager-loading.
+func (e VulnerabilityTypeEdges) VulnerabilityIdsOrErr() ([]*VulnerabilityID, error) {
+	if e.loadedTypes[0] {
+		return e.VulnerabilityIds, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_ids"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VulnerabilityType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilitytype.FieldID:
+			values[i] = new(sql.NullInt64)
+		case vulnerabilitytype.FieldType:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type VulnerabilityType", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VulnerabilityType fields.
+func (vt *VulnerabilityType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilitytype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vt.ID = int(value.Int64)
+		case vulnerabilitytype.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				vt.Type = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryVulnerabilityIds queries the "vulnerability_ids" edge of the VulnerabilityType entity.
+func (vt *VulnerabilityType) QueryVulnerabilityIds() *VulnerabilityIDQuery {
+	return NewVulnerabilityTypeClient(vt.config).QueryVulnerabilityIds(vt)
+}
+
+// Update returns a builder for updating this VulnerabilityType.
+// Note that you need to call VulnerabilityType.Unwrap() before calling this method if this VulnerabilityType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vt *VulnerabilityType) Update() *VulnerabilityTypeUpdateOne {
+	return NewVulnerabilityTypeClient(vt.config).UpdateOne(vt)
+}
+
+// Unwrap unwraps the VulnerabilityType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vt *VulnerabilityType) Unwrap() *VulnerabilityType {
+	_tx, ok := vt.config.driver.(*txDriver)
+	if !ok {
+		panic("db: VulnerabilityType is not a transactional entity")
+	}
+	vt.config.driver = _tx.drv
+	return vt
+}
+
+// String implements the fmt.Stringer.
+func (vt *VulnerabilityType) String() string {
+	var builder strings.Builder
+	builder.WriteString("VulnerabilityType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vt.ID))
+	builder.WriteString("type=")
+	builder.WriteString(vt.Type)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VulnerabilityTypes is a parsable slice of VulnerabilityType.
+type VulnerabilityTypes []*VulnerabilityType
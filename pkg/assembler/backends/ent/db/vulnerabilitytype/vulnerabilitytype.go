@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerabilitytype
+
+const (
+	// Label holds the string label denoting the vulnerabilitytype type in the database.
+	Label = "vulnerability_type"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// EdgeVulnerabilityIds holds the string denoting the vulnerability_ids edge name in mutations.
+	EdgeVulnerabilityIds = "vulnerability_ids"
+	// Table holds the table name of the vulnerabilitytype in the database.
+	Table = "vulnerability_types"
+	// VulnerabilityIdsTable is the table that holds the vulnerability_ids relation/edge.
+	VulnerabilityIdsTable = "vulnerability_ids"
+	// VulnerabilityIdsInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityIdsInverseTable = "vulnerability_ids"
+	// VulnerabilityIdsColumn is the table column denoting the vulnerability_ids relation/edge.
+	VulnerabilityIdsColumn = "type_id"
+)
+
+// Columns holds all SQL columns for vulnerabilitytype fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
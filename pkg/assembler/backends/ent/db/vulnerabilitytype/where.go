@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerabilitytype
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldLTE(FieldID, id))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldEQ(FieldType, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(sql.FieldContainsFold(FieldType, v))
+}
+
+// HasVulnerabilityIds applies the HasEdge predicate on the "vulnerability_ids" edge.
+func HasVulnerabilityIds() predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VulnerabilityIdsTable, VulnerabilityIdsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityIdsWith applies the HasEdge predicate on the "vulnerability_ids" edge with a given conditions (other predicates).
+func HasVulnerabilityIdsWith(preds ...predicate.VulnerabilityID) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(VulnerabilityIdsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VulnerabilityIdsTable, VulnerabilityIdsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VulnerabilityType) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VulnerabilityType) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VulnerabilityType) predicate.VulnerabilityType {
+	return predicate.VulnerabilityType(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
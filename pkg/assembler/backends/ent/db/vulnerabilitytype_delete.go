@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityTypeDelete is the builder for deleting a VulnerabilityType entity.
+type VulnerabilityTypeDelete struct {
+	config
+	hooks    []Hook
+	mutation *VulnerabilityTypeMutation
+}
+
+// Where appends a list predicates to the VulnerabilityTypeDelete builder.
+func (vtd *VulnerabilityTypeDelete) Where(ps ...predicate.VulnerabilityType) *VulnerabilityTypeDelete {
+	vtd.mutation.Where(ps...)
+	return vtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vtd *VulnerabilityTypeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, VulnerabilityTypeMutation](ctx, vtd.sqlExec, vtd.mutation, vtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtd *VulnerabilityTypeDelete) ExecX(ctx context.Context) int {
+	n, err := vtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vtd *VulnerabilityTypeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vulnerabilitytype.Table, sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt))
+	if ps := vtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vtd.mutation.done = true
+	return affected, err
+}
+
+// VulnerabilityTypeDeleteOne is the builder for deleting a single VulnerabilityType entity.
+type VulnerabilityTypeDeleteOne struct {
+	vtd *VulnerabilityTypeDelete
+}
+
+// Where appends a list predicates to the VulnerabilityTypeDelete builder.
+func (vtdo *VulnerabilityTypeDeleteOne) Where(ps ...predicate.VulnerabilityType) *VulnerabilityTypeDeleteOne {
+	vtdo.vtd.mutation.Where(ps...)
+	return vtdo
+}
+
+// Exec executes the deletion query.
+func (vtdo *VulnerabilityTypeDeleteOne) Exec(ctx context.Context) error {
+	n, err := vtdo.vtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vulnerabilitytype.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtdo *VulnerabilityTypeDeleteOne) ExecX(ctx context.Context) {
+	if err := vtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
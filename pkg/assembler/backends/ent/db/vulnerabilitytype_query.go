@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+)
+
+// VulnerabilityTypeQuery is the builder for querying VulnerabilityType entities.
+type VulnerabilityTypeQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []OrderFunc
+	inters               []Interceptor
+	predicates           []predicate.VulnerabilityType
+	withVulnerabilityIds *VulnerabilityIDQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VulnerabilityTypeQuery builder.
+func (vtq *VulnerabilityTypeQuery) Where(ps ...predicate.VulnerabilityType) *VulnerabilityTypeQuery {
+	vtq.predicates = append(vtq.predicates, ps...)
+	return vtq
+}
+
+// Limit the number of records to be returned by this query.
+func (vtq *VulnerabilityTypeQuery) Limit(limit int) *VulnerabilityTypeQuery {
+	vtq.ctx.Limit = &limit
+	return vtq
+}
+
+// Offset to start from.
+func (vtq *VulnerabilityTypeQuery) Offset(offset int) *VulnerabilityTypeQuery {
+	vtq.ctx.Offset = &offset
+	return vtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vtq *VulnerabilityTypeQuery) Unique(unique bool) *VulnerabilityTypeQuery {
+	vtq.ctx.Unique = &unique
+	return vtq
+}
+
+// Order specifies how the records should be ordered.
+func (vtq *VulnerabilityTypeQuery) Order(o ...OrderFunc) *VulnerabilityTypeQuery {
+	vtq.order = append(vtq.order, o...)
+	return vtq
+}
+
+// QueryVulnerabilityIds chains the current query on the "vulnerability_ids" edge.
+func (vtq *VulnerabilityTypeQuery) QueryVulnerabilityIds() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: vtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilitytype.Table, vulnerabilitytype.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vulnerabilitytype.VulnerabilityIdsTable, vulnerabilitytype.VulnerabilityIdsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VulnerabilityType entity from the query.
+// Returns a *NotFoundError when no VulnerabilityType was found.
+func (vtq *VulnerabilityTypeQuery) First(ctx context.Context) (*VulnerabilityType, error) {
+	nodes, err := vtq.Limit(1).All(setContextOp(ctx, vtq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vulnerabilitytype.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) FirstX(ctx context.Context) *VulnerabilityType {
+	node, err := vtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VulnerabilityType ID from the query.
+// Returns a *NotFoundError when no VulnerabilityType ID was found.
+func (vtq *VulnerabilityTypeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vtq.Limit(1).IDs(setContextOp(ctx, vtq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vulnerabilitytype.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) FirstIDX(ctx context.Context) int {
+	id, err := vtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VulnerabilityType entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VulnerabilityType entity is found.
+// Returns a *NotFoundError when no VulnerabilityType entities are found.
+func (vtq *VulnerabilityTypeQuery) Only(ctx context.Context) (*VulnerabilityType, error) {
+	nodes, err := vtq.Limit(2).All(setContextOp(ctx, vtq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vulnerabilitytype.Label}
+	default:
+		return nil, &NotSingularError{vulnerabilitytype.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) OnlyX(ctx context.Context) *VulnerabilityType {
+	node, err := vtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VulnerabilityType ID in the query.
+// Returns a *NotSingularError when more than one VulnerabilityType ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vtq *VulnerabilityTypeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vtq.Limit(2).IDs(setContextOp(ctx, vtq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vulnerabilitytype.Label}
+	default:
+		err = &NotSingularError{vulnerabilitytype.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VulnerabilityTypes.
+func (vtq *VulnerabilityTypeQuery) All(ctx context.Context) ([]*VulnerabilityType, error) {
+	ctx = setContextOp(ctx, vtq.ctx, "All")
+	if err := vtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VulnerabilityType, *VulnerabilityTypeQuery]()
+	return withInterceptors[[]*VulnerabilityType](ctx, vtq, qr, vtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) AllX(ctx context.Context) []*VulnerabilityType {
+	nodes, err := vtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VulnerabilityType IDs.
+func (vtq *VulnerabilityTypeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vtq.ctx.Unique == nil && vtq.path != nil {
+		vtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vtq.ctx, "IDs")
+	if err = vtq.Select(vulnerabilitytype.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) IDsX(ctx context.Context) []int {
+	ids, err := vtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vtq *VulnerabilityTypeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vtq.ctx, "Count")
+	if err := vtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vtq, querierCount[*VulnerabilityTypeQuery](), vtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) CountX(ctx context.Context) int {
+	count, err := vtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vtq *VulnerabilityTypeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vtq.ctx, "Exist")
+	switch _, err := vtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vtq *VulnerabilityTypeQuery) ExistX(ctx context.Context) bool {
+	exist, err := vtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VulnerabilityTypeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vtq *VulnerabilityTypeQuery) Clone() *VulnerabilityTypeQuery {
+	if vtq == nil {
+		return nil
+	}
+	return &VulnerabilityTypeQuery{
+		config:               vtq.config,
+		ctx:                  vtq.ctx.Clone(),
+		order:                append([]OrderFunc{}, vtq.order...),
+		inters:               append([]Interceptor{}, vtq.inters...),
+		predicates:           append([]predicate.VulnerabilityType{}, vtq.predicates...),
+		withVulnerabilityIds: vtq.withVulnerabilityIds.Clone(),
+		// clone intermediate query.
+		sql:  vtq.sql.Clone(),
+		path: vtq.path,
+	}
+}
+
+// WithVulnerabilityIds tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_ids" edge. The optional arguments are used to configure the query builder of the edge.
+func (vtq *VulnerabilityTypeQuery) WithVulnerabilityIds(opts ...func(*VulnerabilityIDQuery)) *VulnerabilityTypeQuery {
+	query := (&VulnerabilityIDClient{config: vtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vtq.withVulnerabilityIds = query
+	return vtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VulnerabilityType.Query().
+//		GroupBy(vulnerabilitytype.FieldType).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (vtq *VulnerabilityTypeQuery) GroupBy(field string, fields ...string) *VulnerabilityTypeGroupBy {
+	vtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VulnerabilityTypeGroupBy{build: vtq}
+	grbuild.flds = &vtq.ctx.Fields
+	grbuild.label = vulnerabilitytype.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//	}
+//
+//	client.VulnerabilityType.Query().
+//		Select(vulnerabilitytype.FieldType).
+//		Scan(ctx, &v)
+func (vtq *VulnerabilityTypeQuery) Select(fields ...string) *VulnerabilityTypeSelect {
+	vtq.ctx.Fields = append(vtq.ctx.Fields, fields...)
+	sbuild := &VulnerabilityTypeSelect{VulnerabilityTypeQuery: vtq}
+	sbuild.label = vulnerabilitytype.Label
+	sbuild.flds, sbuild.scan = &vtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VulnerabilityTypeSelect configured with the given aggregations.
+func (vtq *VulnerabilityTypeQuery) Aggregate(fns ...AggregateFunc) *VulnerabilityTypeSelect {
+	return vtq.Select().Aggregate(fns...)
+}
+
+func (vtq *VulnerabilityTypeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vtq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vtq.ctx.Fields {
+		if !vulnerabilitytype.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if vtq.path != nil {
+		prev, err := vtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vtq.sql = prev
+	}
+	return nil
+}
+
+func (vtq *VulnerabilityTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VulnerabilityType, error) {
+	var (
+		nodes       = []*VulnerabilityType{}
+		_spec       = vtq.querySpec()
+		loadedTypes = [1]bool{
+			vtq.withVulnerabilityIds != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VulnerabilityType).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VulnerabilityType{config: vtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := vtq.withVulnerabilityIds; query != nil {
+		if err := vtq.loadVulnerabilityIds(ctx, query, nodes,
+			func(n *VulnerabilityType) { n.Edges.VulnerabilityIds = []*VulnerabilityID{} },
+			func(n *VulnerabilityType, e *VulnerabilityID) {
+				n.Edges.VulnerabilityIds = append(n.Edges.VulnerabilityIds, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vtq *VulnerabilityTypeQuery) loadVulnerabilityIds(ctx context.Context, query *VulnerabilityIDQuery, nodes []*VulnerabilityType, init func(*VulnerabilityType), assign func(*VulnerabilityType, *VulnerabilityID)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*VulnerabilityType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.VulnerabilityID(func(s *sql.Selector) {
+		s.Where(sql.InValues(vulnerabilitytype.VulnerabilityIdsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (vtq *VulnerabilityTypeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vtq.querySpec()
+	_spec.Node.Columns = vtq.ctx.Fields
+	if len(vtq.ctx.Fields) > 0 {
+		_spec.Unique = vtq.ctx.Unique != nil && *vtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vtq.driver, _spec)
+}
+
+func (vtq *VulnerabilityTypeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(vulnerabilitytype.Table, vulnerabilitytype.Columns, sqlgraph.NewFieldSpec(vulnerabilitytype.FieldID, field.TypeInt))
+	_spec.From = vtq.sql
+	if unique := vtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnerabilitytype.FieldID)
+		for i := range fields {
+			if fields[i] != vulnerabilitytype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vtq *VulnerabilityTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vtq.driver.Dialect())
+	t1 := builder.Table(vulnerabilitytype.Table)
+	columns := vtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = vulnerabilitytype.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vtq.sql != nil {
+		selector = vtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vtq.ctx.Unique != nil && *vtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vtq.predicates {
+		p(selector)
+	}
+	for _, p := range vtq.order {
+		p(selector)
+	}
+	if offset := vtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VulnerabilityTypeGroupBy is the group-by builder for VulnerabilityType entities.
+type VulnerabilityTypeGroupBy struct {
+	selector
+	build *VulnerabilityTypeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vtgb *VulnerabilityTypeGroupBy) Aggregate(fns ...AggregateFunc) *VulnerabilityTypeGroupBy {
+	vtgb.fns = append(vtgb.fns, fns...)
+	return vtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vtgb *VulnerabilityTypeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vtgb.build.ctx, "GroupBy")
+	if err := vtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnerabilityTypeQuery, *VulnerabilityTypeGroupBy](ctx, vtgb.build, vtgb, vtgb.build.inters, v)
+}
+
+func (vtgb *VulnerabilityTypeGroupBy) sqlScan(ctx context.Context, root *VulnerabilityTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vtgb.fns))
+	for _, fn := range vtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vtgb.flds)+len(vtgb.fns))
+		for _, f := range *vtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VulnerabilityTypeSelect is the builder for selecting fields of VulnerabilityType entities.
+type VulnerabilityTypeSelect struct {
+	*VulnerabilityTypeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vts *VulnerabilityTypeSelect) Aggregate(fns ...AggregateFunc) *VulnerabilityTypeSelect {
+	vts.fns = append(vts.fns, fns...)
+	return vts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vts *VulnerabilityTypeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vts.ctx, "Select")
+	if err := vts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnerabilityTypeQuery, *VulnerabilityTypeSelect](ctx, vts.VulnerabilityTypeQuery, vts, vts.inters, v)
+}
+
+func (vts *VulnerabilityTypeSelect) sqlScan(ctx context.Context, root *VulnerabilityTypeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vts.fns))
+	for _, fn := range vts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
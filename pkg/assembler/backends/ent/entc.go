@@ -0,0 +1,38 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+// This program generates the ent client in db/ from the schema in schema/. It
+// is run by go generate.
+func main() {
+	err := entc.Generate("./schema", &gen.Config{
+		Target:   "./db",
+		Package:  "github.com/guacsec/guac/pkg/assembler/backends/ent/db",
+		Features: []gen.Feature{gen.FeatureUpsert},
+	})
+	if err != nil {
+		log.Fatalf("running ent codegen: %v", err)
+	}
+}
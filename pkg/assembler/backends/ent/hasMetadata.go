@@ -0,0 +1,168 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest HasMetadata
+
+func (c *entClient) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasMetadata == nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", backends.Missing("hasMetadata"))
+	}
+	if err := validateSubject(subject); err != nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", err)
+	}
+
+	n, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.HasMetadata, error) {
+		s, err := ingestSubject(ctx, tx.Client(), subject, pkgMatchType)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.HasMetadata.Create().
+			SetNillablePackageVersionID(s.pkgVersion).
+			SetNillablePackageNameID(s.pkgName).
+			SetNillableSourceID(s.src).
+			SetNillableArtifactID(s.artifact).
+			SetKey(hasMetadata.Key).
+			SetValue(hasMetadata.Value).
+			SetSince(hasMetadata.Since.UTC()).
+			SetJustification(hasMetadata.Justification).
+			SetOrigin(hasMetadata.Origin).
+			SetCollector(hasMetadata.Collector).
+			OnConflict(
+				sql.ConflictColumns(s.column(), hasmetadata.FieldKey, hasmetadata.FieldValue, hasmetadata.FieldJustification),
+				sql.ConflictWhere(sql.NotNull(s.column())),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withHasMetadataSubject(tx.HasMetadata.Query().Where(hasmetadata.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestHasMetadata", err)
+	}
+	return toModelHasMetadata(n), nil
+}
+
+// Query HasMetadata
+
+func (c *entClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasMetadataSpec == nil {
+		hasMetadataSpec = &model.HasMetadataSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(hasMetadataSpec.Subject); err != nil {
+		return nil, backends.Errorf("HasMetadata :: %w", err)
+	}
+
+	var filters []predicate.HasMetadata
+	if hasMetadataSpec.ID != nil {
+		id, err := parseID(*hasMetadataSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("HasMetadata :: %w", err)
+		}
+		filters = append(filters, hasmetadata.ID(id))
+	}
+	if hasMetadataSpec.Key != nil {
+		filters = append(filters, hasmetadata.Key(*hasMetadataSpec.Key))
+	}
+	if hasMetadataSpec.Value != nil {
+		filters = append(filters, hasmetadata.Value(*hasMetadataSpec.Value))
+	}
+	if hasMetadataSpec.Since != nil {
+		filters = append(filters, hasmetadata.Since(hasMetadataSpec.Since.UTC()))
+	}
+	if hasMetadataSpec.Justification != nil {
+		filters = append(filters, hasmetadata.Justification(*hasMetadataSpec.Justification))
+	}
+	if hasMetadataSpec.Origin != nil {
+		filters = append(filters, hasmetadata.Origin(*hasMetadataSpec.Origin))
+	}
+	if hasMetadataSpec.Collector != nil {
+		filters = append(filters, hasmetadata.Collector(*hasMetadataSpec.Collector))
+	}
+	if s := hasMetadataSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			// Metadata of a package name match the package filters regardless
+			// of the version filters.
+			filters = append(filters, hasmetadata.Or(
+				hasmetadata.HasPackageVersionWith(packageVersionMatches(s.Package)...),
+				hasmetadata.HasPackageNameWith(packageNameMatches(s.Package)...),
+			))
+		case s.Source != nil:
+			filters = append(filters, hasmetadata.HasSourceWith(sourceNameMatches(s.Source)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("HasMetadata :: %w", err)
+			}
+			filters = append(filters, hasmetadata.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	metadata, err := withHasMetadataSubject(c.client.HasMetadata.Query().Where(filters...)).
+		Order(db.Asc(hasmetadata.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "HasMetadata", err)
+	}
+
+	out := make([]*model.HasMetadata, 0, len(metadata))
+	for _, n := range metadata {
+		out = append(out, toModelHasMetadata(n))
+	}
+	return out, nil
+}
+
+// withHasMetadataSubject loads the subject of the metadata returned by the
+// query.
+func withHasMetadataSubject(q *db.HasMetadataQuery) *db.HasMetadataQuery {
+	return q.WithPackageVersion(withPackageVersionPath).
+		WithPackageName(withPackageNamePath).
+		WithSource(withSourceNamePath).
+		WithArtifact()
+}
+
+func toModelHasMetadata(n *db.HasMetadata) *model.HasMetadata {
+	return &model.HasMetadata{
+		ID:            nodeID(n.ID),
+		Subject:       subjectToModel(n.Edges.PackageVersion, n.Edges.PackageName, n.Edges.Source, n.Edges.Artifact),
+		Key:           n.Key,
+		Value:         n.Value,
+		Since:         n.Since.UTC(),
+		Justification: n.Justification,
+		Origin:        n.Origin,
+		Collector:     n.Collector,
+	}
+}
@@ -0,0 +1,190 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest HasSBOM
+
+func (c *entClient) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("subject"))
+	}
+	if hasSbom == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("hasSBOM"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", err)
+	}
+	if subject.Artifact != nil {
+		if _, _, err := backends.CanonicalArtifact(subject.Artifact); err != nil {
+			return nil, backends.Errorf("IngestHasSbom :: %w", err)
+		}
+	}
+
+	h, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.HasSBOM, error) {
+		create := tx.HasSBOM.Create().
+			SetURI(hasSbom.URI).
+			SetAlgorithm(strings.ToLower(hasSbom.Algorithm)).
+			SetDigest(strings.ToLower(hasSbom.Digest)).
+			SetDownloadLocation(hasSbom.DownloadLocation).
+			SetOrigin(hasSbom.Origin).
+			SetCollector(hasSbom.Collector)
+		subjectColumn := hassbom.FieldPackageID
+		if subject.Package != nil {
+			pkgID, err := ingestPackage(ctx, tx.Client(), subject.Package)
+			if err != nil {
+				return nil, err
+			}
+			create.SetPackageID(pkgID)
+		} else {
+			artifactID, err := ingestArtifactInput(ctx, tx.Client(), subject.Artifact)
+			if err != nil {
+				return nil, err
+			}
+			create.SetArtifactID(artifactID)
+			subjectColumn = hassbom.FieldArtifactID
+		}
+		id, err := create.
+			OnConflict(
+				sql.ConflictColumns(subjectColumn, hassbom.FieldURI, hassbom.FieldAlgorithm, hassbom.FieldDigest,
+					hassbom.FieldDownloadLocation, hassbom.FieldOrigin, hassbom.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(subjectColumn)),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withHasSBOMSubject(tx.HasSBOM.Query().Where(hassbom.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestHasSbom", err)
+	}
+	return toModelHasSBOM(h), nil
+}
+
+// Query HasSBOM
+
+func (c *entClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasSBOMSpec == nil {
+		hasSBOMSpec = &model.HasSBOMSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(hasSBOMSpec.Subject); err != nil {
+		return nil, backends.Errorf("HasSBOM :: %w", err)
+	}
+
+	var filters []predicate.HasSBOM
+	if hasSBOMSpec.ID != nil {
+		id, err := parseID(*hasSBOMSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("HasSBOM :: %w", err)
+		}
+		filters = append(filters, hassbom.ID(id))
+	}
+	if hasSBOMSpec.URI != nil {
+		filters = append(filters, hassbom.URI(*hasSBOMSpec.URI))
+	}
+	if hasSBOMSpec.Algorithm != nil {
+		filters = append(filters, hassbom.Algorithm(*backends.LowerIfSet(hasSBOMSpec.Algorithm)))
+	}
+	if hasSBOMSpec.Digest != nil {
+		filters = append(filters, hassbom.Digest(*backends.LowerIfSet(hasSBOMSpec.Digest)))
+	}
+	if hasSBOMSpec.DownloadLocation != nil {
+		filters = append(filters, hassbom.DownloadLocation(*hasSBOMSpec.DownloadLocation))
+	}
+	if hasSBOMSpec.Origin != nil {
+		filters = append(filters, hassbom.Origin(*hasSBOMSpec.Origin))
+	}
+	if hasSBOMSpec.Collector != nil {
+		filters = append(filters, hassbom.Collector(*hasSBOMSpec.Collector))
+	}
+	if s := hasSBOMSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			filters = append(filters, hassbom.HasPackageWith(packageVersionMatches(s.Package)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("HasSBOM :: %w", err)
+			}
+			filters = append(filters, hassbom.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	sboms, err := withHasSBOMSubject(c.client.HasSBOM.Query().Where(filters...)).
+		Order(db.Asc(hassbom.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "HasSBOM", err)
+	}
+
+	out := make([]*model.HasSbom, 0, len(sboms))
+	for _, h := range sboms {
+		out = append(out, toModelHasSBOM(h))
+	}
+	return out, nil
+}
+
+// withHasSBOMSubject loads the subject of the rows returned by the query.
+func withHasSBOMSubject(q *db.HasSBOMQuery) *db.HasSBOMQuery {
+	return q.WithPackage(withPackageVersionPath).WithArtifact()
+}
+
+func toModelHasSBOM(h *db.HasSBOM) *model.HasSbom {
+	var subject model.PackageOrArtifact
+	if h.Edges.Package != nil {
+		subject = versionToPackage(h.Edges.Package)
+	} else {
+		subject = toModelArtifact(h.Edges.Artifact)
+	}
+	return &model.HasSbom{
+		ID:               nodeID(h.ID),
+		Subject:          subject,
+		URI:              h.URI,
+		Algorithm:        h.Algorithm,
+		Digest:           h.Digest,
+		DownloadLocation: h.DownloadLocation,
+		Origin:           h.Origin,
+		Collector:        h.Collector,
+	}
+}
+
+// FindSBOMsByArtifact walks the evidence trees with the search shared by the
+// backends.
+func (c *entClient) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.FindSBOMsByArtifact(ctx, c, algorithm, digest)
+}
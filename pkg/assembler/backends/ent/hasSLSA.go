@@ -0,0 +1,260 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest HasSLSA
+
+func (c *entClient) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("subject"))
+	}
+	if builtBy == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("builtBy"))
+	}
+	if slsa == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("slsa"))
+	}
+	if builtBy.URI == "" {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+	if _, _, err := backends.CanonicalArtifact(subject); err != nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", err)
+	}
+	// Materials are deduplicated and sorted by key, so that the same
+	// provenance always results in the same row.
+	materials := map[string]*model.ArtifactInputSpec{}
+	for i, m := range builtFrom {
+		if m == nil {
+			return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("builtFrom", "missing material at index %d", i))
+		}
+		algorithm, digest, err := backends.CanonicalArtifact(m)
+		if err != nil {
+			return nil, backends.Errorf("IngestSLSA :: material at index %d: %w", i, err)
+		}
+		materials[algorithm+":"+digest] = &model.ArtifactInputSpec{Algorithm: algorithm, Digest: digest}
+	}
+	materialKeys := make([]string, 0, len(materials))
+	for key := range materials {
+		materialKeys = append(materialKeys, key)
+	}
+	sort.Strings(materialKeys)
+	predicates := make([]model.SLSAPredicate, 0, len(slsa.SlsaPredicate))
+	for _, p := range slsa.SlsaPredicate {
+		predicates = append(predicates, model.SLSAPredicate{Key: p.Key, Value: p.Value})
+	}
+	sort.Slice(predicates, func(i, j int) bool {
+		if predicates[i].Key != predicates[j].Key {
+			return predicates[i].Key < predicates[j].Key
+		}
+		return predicates[i].Value < predicates[j].Value
+	})
+	predicateValues := make([]string, 0, len(predicates))
+	for _, p := range predicates {
+		predicateValues = append(predicateValues, p.Key+"="+p.Value)
+	}
+
+	h, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.HasSLSA, error) {
+		subjectID, err := ingestArtifactInput(ctx, tx.Client(), subject)
+		if err != nil {
+			return nil, err
+		}
+		materialIDs := make([]int, 0, len(materialKeys))
+		for _, key := range materialKeys {
+			id, err := ingestArtifact(ctx, tx.Client(), materials[key].Algorithm, materials[key].Digest)
+			if err != nil {
+				return nil, err
+			}
+			materialIDs = append(materialIDs, id)
+		}
+		builderID, err := ingestBuilder(ctx, tx.Client(), builtBy)
+		if err != nil {
+			return nil, err
+		}
+		create := tx.HasSLSA.Create().
+			SetSubjectID(subjectID).
+			SetBuiltByID(builderID).
+			SetBuildType(slsa.BuildType).
+			SetSlsaPredicates(predicates).
+			SetSlsaVersion(slsa.SlsaVersion).
+			SetNillableStartedOn(optionalUTC(slsa.StartedOn)).
+			SetNillableFinishedOn(optionalUTC(slsa.FinishedOn)).
+			SetOrigin(slsa.Origin).
+			SetCollector(slsa.Collector).
+			SetBuiltFromHash(listHash(materialKeys)).
+			SetPredicatesHash(listHash(predicateValues))
+		id, err := create.
+			OnConflict(sql.ConflictColumns(hasslsa.FieldSubjectID, hasslsa.FieldBuiltByID, hasslsa.FieldBuildType,
+				hasslsa.FieldSlsaVersion, hasslsa.FieldOrigin, hasslsa.FieldCollector,
+				hasslsa.FieldBuiltFromHash, hasslsa.FieldPredicatesHash)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// The materials of an existing row are already linked.
+		linked, err := tx.HasSLSA.Query().Where(hasslsa.ID(id)).QueryBuiltFrom().Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !linked && len(materialIDs) > 0 {
+			if err := tx.HasSLSA.UpdateOneID(id).AddBuiltFromIDs(materialIDs...).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return withHasSLSAPaths(tx.HasSLSA.Query().Where(hasslsa.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestSLSA", err)
+	}
+	return toModelHasSLSA(h), nil
+}
+
+// listHash returns a digest of the sorted values, which identifies them in
+// the unique index of the provenance.
+func listHash(values []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(values, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+func optionalUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC()
+	return &v
+}
+
+// Query HasSLSA
+
+func (c *entClient) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasSLSASpec == nil {
+		hasSLSASpec = &model.HasSLSASpec{}
+	}
+
+	var filters []predicate.HasSLSA
+	if hasSLSASpec.ID != nil {
+		id, err := parseID(*hasSLSASpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("HasSLSA :: %w", err)
+		}
+		filters = append(filters, hasslsa.ID(id))
+	}
+	if hasSLSASpec.BuildType != nil {
+		filters = append(filters, hasslsa.BuildType(*hasSLSASpec.BuildType))
+	}
+	if hasSLSASpec.SlsaVersion != nil {
+		filters = append(filters, hasslsa.SlsaVersion(*hasSLSASpec.SlsaVersion))
+	}
+	if hasSLSASpec.Origin != nil {
+		filters = append(filters, hasslsa.Origin(*hasSLSASpec.Origin))
+	}
+	if hasSLSASpec.Collector != nil {
+		filters = append(filters, hasslsa.Collector(*hasSLSASpec.Collector))
+	}
+	if hasSLSASpec.Subject != nil {
+		subjectFilters, err := artifactMatches(hasSLSASpec.Subject)
+		if err != nil {
+			return nil, backends.Errorf("HasSLSA :: %w", err)
+		}
+		filters = append(filters, hasslsa.HasSubjectWith(subjectFilters...))
+	}
+	if hasSLSASpec.BuiltBy != nil {
+		builderFilters, err := builderMatches(hasSLSASpec.BuiltBy)
+		if err != nil {
+			return nil, backends.Errorf("HasSLSA :: %w", err)
+		}
+		filters = append(filters, hasslsa.HasBuiltByWith(builderFilters...))
+	}
+	// Every material filter must match one of the materials.
+	for _, spec := range hasSLSASpec.BuiltFrom {
+		materialFilters, err := artifactMatches(spec)
+		if err != nil {
+			return nil, backends.Errorf("HasSLSA :: %w", err)
+		}
+		filters = append(filters, hasslsa.HasBuiltFromWith(materialFilters...))
+	}
+
+	provenances, err := withHasSLSAPaths(c.client.HasSLSA.Query().Where(filters...)).
+		Order(db.Asc(hasslsa.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "HasSLSA", err)
+	}
+
+	out := make([]*model.HasSlsa, 0, len(provenances))
+	for _, h := range provenances {
+		out = append(out, toModelHasSLSA(h))
+	}
+	return out, nil
+}
+
+// withHasSLSAPaths loads the subject, builder and materials of the rows
+// returned by the query.
+func withHasSLSAPaths(q *db.HasSLSAQuery) *db.HasSLSAQuery {
+	return q.WithSubject().WithBuiltBy().WithBuiltFrom()
+}
+
+func toModelHasSLSA(h *db.HasSLSA) *model.HasSlsa {
+	materials := h.Edges.BuiltFrom
+	sort.Slice(materials, func(i, j int) bool {
+		return artifactKey(materials[i]) < artifactKey(materials[j])
+	})
+	builtFrom := make([]*model.Artifact, 0, len(materials))
+	for _, m := range materials {
+		builtFrom = append(builtFrom, toModelArtifact(m))
+	}
+	predicates := make([]*model.SLSAPredicate, 0, len(h.SlsaPredicates))
+	for _, p := range h.SlsaPredicates {
+		predicates = append(predicates, &model.SLSAPredicate{Key: p.Key, Value: p.Value})
+	}
+	return &model.HasSlsa{
+		ID:      nodeID(h.ID),
+		Subject: toModelArtifact(h.Edges.Subject),
+		Slsa: &model.Slsa{
+			BuiltFrom:     builtFrom,
+			BuiltBy:       toModelBuilder(h.Edges.BuiltBy),
+			BuildType:     h.BuildType,
+			SlsaPredicate: predicates,
+			SlsaVersion:   h.SlsaVersion,
+			StartedOn:     optionalUTC(h.StartedOn),
+			FinishedOn:    optionalUTC(h.FinishedOn),
+			Origin:        h.Origin,
+			Collector:     h.Collector,
+		},
+	}
+}
@@ -0,0 +1,181 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest HashEqual
+
+// IngestHashEqual returns the artifacts in the order of the arguments, even
+// if the equality was first ingested with them swapped.
+func (c *entClient) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("artifact"))
+	}
+	if equalArtifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("equalArtifact"))
+	}
+	if hashEqual == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("hashEqual"))
+	}
+	for _, a := range []*model.ArtifactInputSpec{artifact, equalArtifact} {
+		if _, _, err := backends.CanonicalArtifact(a); err != nil {
+			return nil, backends.Errorf("IngestHashEqual :: %w", err)
+		}
+	}
+
+	h, err := withTx(ctx, c.client, func(tx *db.Tx) (*model.HashEqual, error) {
+		a, err := ingestArtifactInput(ctx, tx.Client(), artifact)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ingestArtifactInput(ctx, tx.Client(), equalArtifact)
+		if err != nil {
+			return nil, err
+		}
+		if a == b {
+			return nil, backends.Errorf("IngestHashEqual :: %w", backends.NewValidationError("equalArtifact", "an artifact cannot be certified equal to itself"))
+		}
+		first, second := a, b
+		if second < first {
+			first, second = second, first
+		}
+		id, err := tx.HashEqual.Create().
+			SetArtifactAID(first).
+			SetArtifactBID(second).
+			SetJustification(hashEqual.Justification).
+			SetOrigin(hashEqual.Origin).
+			SetCollector(hashEqual.Collector).
+			OnConflict(sql.ConflictColumns(hashequal.FieldArtifactAID, hashequal.FieldArtifactBID,
+				hashequal.FieldJustification, hashequal.FieldOrigin, hashequal.FieldCollector)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		h, err := tx.HashEqual.Query().
+			Where(hashequal.ID(id)).
+			WithArtifactA().
+			WithArtifactB().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := toModelHashEqual(h)
+		if a != first {
+			out.Artifacts[0], out.Artifacts[1] = out.Artifacts[1], out.Artifacts[0]
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestHashEqual", err)
+	}
+	return h, nil
+}
+
+// Query HashEqual
+
+func (c *entClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hashEqualSpec == nil {
+		hashEqualSpec = &model.HashEqualSpec{}
+	}
+	if len(hashEqualSpec.Artifacts) > 2 {
+		return nil, backends.Errorf("HashEqual :: %w", backends.NewValidationError("artifacts", "cannot filter on more than 2 artifacts"))
+	}
+	if hashEqualSpec.ExpandTransitive != nil && *hashEqualSpec.ExpandTransitive {
+		return backends.ExpandHashEqual(ctx, c, hashEqualSpec)
+	}
+
+	var filters []predicate.HashEqual
+	if hashEqualSpec.ID != nil {
+		id, err := parseID(*hashEqualSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("HashEqual :: %w", err)
+		}
+		filters = append(filters, hashequal.ID(id))
+	}
+	if hashEqualSpec.Justification != nil {
+		filters = append(filters, hashequal.Justification(*hashEqualSpec.Justification))
+	}
+	if hashEqualSpec.Origin != nil {
+		filters = append(filters, hashequal.Origin(*hashEqualSpec.Origin))
+	}
+	if hashEqualSpec.Collector != nil {
+		filters = append(filters, hashequal.Collector(*hashEqualSpec.Collector))
+	}
+	// Every artifact filter must match a different artifact, in any order.
+	var artifactFilters [][]predicate.Artifact
+	for _, spec := range hashEqualSpec.Artifacts {
+		f, err := artifactMatches(spec)
+		if err != nil {
+			return nil, backends.Errorf("HashEqual :: %w", err)
+		}
+		artifactFilters = append(artifactFilters, f)
+	}
+	switch len(artifactFilters) {
+	case 1:
+		filters = append(filters, hashequal.Or(
+			hashequal.HasArtifactAWith(artifactFilters[0]...),
+			hashequal.HasArtifactBWith(artifactFilters[0]...),
+		))
+	case 2:
+		filters = append(filters, hashequal.Or(
+			hashequal.And(hashequal.HasArtifactAWith(artifactFilters[0]...), hashequal.HasArtifactBWith(artifactFilters[1]...)),
+			hashequal.And(hashequal.HasArtifactAWith(artifactFilters[1]...), hashequal.HasArtifactBWith(artifactFilters[0]...)),
+		))
+	}
+
+	equalities, err := c.client.HashEqual.Query().
+		Where(filters...).
+		WithArtifactA().
+		WithArtifactB().
+		Order(db.Asc(hashequal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "HashEqual", err)
+	}
+
+	out := make([]*model.HashEqual, 0, len(equalities))
+	for _, h := range equalities {
+		out = append(out, toModelHashEqual(h))
+	}
+	return out, nil
+}
+
+func toModelHashEqual(h *db.HashEqual) *model.HashEqual {
+	return &model.HashEqual{
+		ID:            nodeID(h.ID),
+		Artifacts:     []*model.Artifact{toModelArtifact(h.Edges.ArtifactA), toModelArtifact(h.Edges.ArtifactB)},
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+	}
+}
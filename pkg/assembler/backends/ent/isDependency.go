@@ -0,0 +1,161 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isdependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest IsDependency
+
+func (c *entClient) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("pkg"))
+	}
+	if depPkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("depPkg"))
+	}
+	if dependency == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("dependency"))
+	}
+	if !dependency.DependencyType.IsValid() {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.NewValidationError("dependencyType", "invalid dependency type %q", dependency.DependencyType))
+	}
+
+	d, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.IsDependency, error) {
+		pkgID, err := ingestPackage(ctx, tx.Client(), pkg)
+		if err != nil {
+			return nil, err
+		}
+		depPkgID, err := ingestPackageName(ctx, tx.Client(), depPkg)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.IsDependency.Create().
+			SetPackageID(pkgID).
+			SetDependentPackageID(depPkgID).
+			SetVersionRange(dependency.VersionRange).
+			SetDependencyType(dependency.DependencyType).
+			SetJustification(dependency.Justification).
+			SetOrigin(dependency.Origin).
+			SetCollector(dependency.Collector).
+			OnConflict(sql.ConflictColumns(isdependency.FieldPackageID, isdependency.FieldDependentPackageID,
+				isdependency.FieldVersionRange, isdependency.FieldDependencyType,
+				isdependency.FieldJustification, isdependency.FieldOrigin, isdependency.FieldCollector)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withIsDependencyPaths(tx.IsDependency.Query().Where(isdependency.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestIsDependency", err)
+	}
+	return toModelIsDependency(d), nil
+}
+
+// Query IsDependency
+
+func (c *entClient) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if isDependencySpec == nil {
+		isDependencySpec = &model.IsDependencySpec{}
+	}
+
+	var filters []predicate.IsDependency
+	if isDependencySpec.ID != nil {
+		id, err := parseID(*isDependencySpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("IsDependency :: %w", err)
+		}
+		filters = append(filters, isdependency.ID(id))
+	}
+	if isDependencySpec.VersionRange != nil {
+		filters = append(filters, isdependency.VersionRange(*isDependencySpec.VersionRange))
+	}
+	if isDependencySpec.DependencyType != nil {
+		filters = append(filters, isdependency.DependencyType(*isDependencySpec.DependencyType))
+	}
+	if isDependencySpec.Justification != nil {
+		filters = append(filters, isdependency.Justification(*isDependencySpec.Justification))
+	}
+	if isDependencySpec.Origin != nil {
+		filters = append(filters, isdependency.Origin(*isDependencySpec.Origin))
+	}
+	if isDependencySpec.Collector != nil {
+		filters = append(filters, isdependency.Collector(*isDependencySpec.Collector))
+	}
+	if isDependencySpec.Package != nil {
+		filters = append(filters, isdependency.HasPackageWith(packageVersionMatches(isDependencySpec.Package)...))
+	}
+	if isDependencySpec.DependentPackage != nil {
+		filters = append(filters, isdependency.HasDependentPackageWith(packageNameMatches(isDependencySpec.DependentPackage)...))
+	}
+
+	dependencies, err := withIsDependencyPaths(c.client.IsDependency.Query().Where(filters...)).
+		Order(db.Asc(isdependency.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "IsDependency", err)
+	}
+
+	out := make([]*model.IsDependency, 0, len(dependencies))
+	for _, d := range dependencies {
+		out = append(out, toModelIsDependency(d))
+	}
+	return out, nil
+}
+
+// withIsDependencyPaths loads both packages of the rows returned by the
+// query.
+func withIsDependencyPaths(q *db.IsDependencyQuery) *db.IsDependencyQuery {
+	return q.WithPackage(withPackageVersionPath).WithDependentPackage(withPackageNamePath)
+}
+
+func toModelIsDependency(d *db.IsDependency) *model.IsDependency {
+	return &model.IsDependency{
+		ID:               nodeID(d.ID),
+		Package:          versionToPackage(d.Edges.Package),
+		DependentPackage: nameToPackage(d.Edges.DependentPackage),
+		VersionRange:     d.VersionRange,
+		DependencyType:   d.DependencyType,
+		Justification:    d.Justification,
+		Origin:           d.Origin,
+		Collector:        d.Collector,
+	}
+}
+
+// TransitiveDependencies walks the IsDependency edges with the search shared
+// by the backends.
+func (c *entClient) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.TransitiveDependencies(ctx, c, pkgSpec, maxDepth)
+}
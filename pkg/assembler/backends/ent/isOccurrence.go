@@ -0,0 +1,185 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isoccurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest IsOccurrence
+
+// IngestIsOccurrence stores the occurrence once per subject, artifact and
+// justification: when the same occurrence is reported again, the existing
+// row is returned with its original origin and collector.
+func (c *entClient) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("subject"))
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("artifact"))
+	}
+	if occurrence == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("occurrence"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+	if _, _, err := backends.CanonicalArtifact(artifact); err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+	if subject.Source != nil {
+		if err := backends.ValidateSourceInput(subject.Source); err != nil {
+			return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+		}
+	}
+
+	o, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.IsOccurrence, error) {
+		create := tx.IsOccurrence.Create().
+			SetJustification(occurrence.Justification).
+			SetOrigin(occurrence.Origin).
+			SetCollector(occurrence.Collector)
+		subjectColumn := isoccurrence.FieldPackageID
+		if subject.Package != nil {
+			pkgID, err := ingestPackage(ctx, tx.Client(), subject.Package)
+			if err != nil {
+				return nil, err
+			}
+			create.SetPackageID(pkgID)
+		} else {
+			srcID, err := ingestSource(ctx, tx.Client(), subject.Source)
+			if err != nil {
+				return nil, err
+			}
+			create.SetSourceID(srcID)
+			subjectColumn = isoccurrence.FieldSourceID
+		}
+		artifactID, err := ingestArtifactInput(ctx, tx.Client(), artifact)
+		if err != nil {
+			return nil, err
+		}
+		id, err := create.
+			SetArtifactID(artifactID).
+			OnConflict(
+				sql.ConflictColumns(subjectColumn, isoccurrence.FieldArtifactID, isoccurrence.FieldJustification),
+				sql.ConflictWhere(sql.NotNull(subjectColumn)),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withIsOccurrencePaths(tx.IsOccurrence.Query().Where(isoccurrence.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestIsOccurrence", err)
+	}
+	return toModelIsOccurrence(o), nil
+}
+
+// Query IsOccurrence
+
+func (c *entClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if isOccurrenceSpec == nil {
+		isOccurrenceSpec = &model.IsOccurrenceSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(isOccurrenceSpec.Subject); err != nil {
+		return nil, backends.Errorf("IsOccurrence :: %w", err)
+	}
+
+	var filters []predicate.IsOccurrence
+	if isOccurrenceSpec.ID != nil {
+		id, err := parseID(*isOccurrenceSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("IsOccurrence :: %w", err)
+		}
+		filters = append(filters, isoccurrence.ID(id))
+	}
+	if isOccurrenceSpec.Justification != nil {
+		filters = append(filters, isoccurrence.Justification(*isOccurrenceSpec.Justification))
+	}
+	if isOccurrenceSpec.Origin != nil {
+		filters = append(filters, isoccurrence.Origin(*isOccurrenceSpec.Origin))
+	}
+	if isOccurrenceSpec.Collector != nil {
+		filters = append(filters, isoccurrence.Collector(*isOccurrenceSpec.Collector))
+	}
+	if isOccurrenceSpec.Artifact != nil {
+		artifactFilters, err := artifactMatches(isOccurrenceSpec.Artifact)
+		if err != nil {
+			return nil, backends.Errorf("IsOccurrence :: %w", err)
+		}
+		filters = append(filters, isoccurrence.HasArtifactWith(artifactFilters...))
+	}
+	if s := isOccurrenceSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			filters = append(filters, isoccurrence.HasPackageWith(packageVersionMatches(s.Package)...))
+		case s.Source != nil:
+			filters = append(filters, isoccurrence.HasSourceWith(sourceNameMatches(s.Source)...))
+		}
+	}
+
+	occurrences, err := withIsOccurrencePaths(c.client.IsOccurrence.Query().Where(filters...)).
+		Order(db.Asc(isoccurrence.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "IsOccurrence", err)
+	}
+
+	out := make([]*model.IsOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		out = append(out, toModelIsOccurrence(o))
+	}
+	return out, nil
+}
+
+// withIsOccurrencePaths loads the subject and artifact of the rows returned
+// by the query.
+func withIsOccurrencePaths(q *db.IsOccurrenceQuery) *db.IsOccurrenceQuery {
+	return q.WithPackage(withPackageVersionPath).
+		WithSource(withSourceNamePath).
+		WithArtifact()
+}
+
+func toModelIsOccurrence(o *db.IsOccurrence) *model.IsOccurrence {
+	var subject model.PackageOrSource
+	if o.Edges.Package != nil {
+		subject = versionToPackage(o.Edges.Package)
+	} else {
+		subject = nameToSource(o.Edges.Source)
+	}
+	return &model.IsOccurrence{
+		ID:            nodeID(o.ID),
+		Subject:       subject,
+		Artifact:      toModelArtifact(o.Edges.Artifact),
+		Justification: o.Justification,
+		Origin:        o.Origin,
+		Collector:     o.Collector,
+	}
+}
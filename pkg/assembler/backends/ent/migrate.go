@@ -0,0 +1,39 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/migrate"
+)
+
+// migrateSchema creates the tables and indexes of the schema, or adds the missing
+// ones to an existing database. It never drops columns nor indexes, so a
+// database can be shared by backends built from different versions of the
+// schema as long as it only grows.
+//
+// IDs are allocated in a separate range for every table, so that they are
+// unique across all the nodes like in the other backends. The ranges are
+// recorded in the ent_types table.
+func migrateSchema(ctx context.Context, client *db.Client) error {
+	if err := client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)); err != nil {
+		return fmt.Errorf("migrating the database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,755 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/buildernode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifybad"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifygood"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvexstatement"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hassbom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/hasslsa"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isdependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/isoccurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/scorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Path and Neighbors follow the foreign keys between the rows in both
+// directions. Every table is a kind of node, which knows how to find the
+// rows connected to its own rows.
+
+// neighbor is a row connected to another one through an edge with the label.
+type neighbor struct {
+	id   int
+	edge model.Edge
+}
+
+// neighbors collects the neighbors of a row, keeping the first error.
+type neighbors struct {
+	out []neighbor
+	err error
+}
+
+// add adds the rows referenced by the foreign keys of a row, skipping the
+// ones which are not set.
+func (n *neighbors) add(edge model.Edge, ids ...*int) {
+	for _, id := range ids {
+		if id != nil {
+			n.out = append(n.out, neighbor{id: *id, edge: edge})
+		}
+	}
+}
+
+// through returns a function adding the rows returned by a query, usually
+// the rows referencing the row through a foreign key.
+func (n *neighbors) through(edge model.Edge) func([]int, error) {
+	return func(ids []int, err error) {
+		if n.err != nil {
+			return
+		}
+		if err != nil {
+			n.err = err
+			return
+		}
+		for _, id := range ids {
+			n.out = append(n.out, neighbor{id: id, edge: edge})
+		}
+	}
+}
+
+func (n *neighbors) result() ([]neighbor, error) {
+	return n.out, n.err
+}
+
+// nodeKind is a table seen as a kind of node.
+type nodeKind struct {
+	exists    func(ctx context.Context, client *db.Client, id int) (bool, error)
+	neighbors func(ctx context.Context, client *db.Client, id int) ([]neighbor, error)
+	toModel   func(ctx context.Context, c *entClient, id int) (model.Node, error)
+}
+
+var nodeKinds = []*nodeKind{{
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PackageType.Query().Where(packagetype.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		var n neighbors
+		n.through(model.EdgePackageTrie)(client.PackageNamespace.Query().Where(packagenamespace.PackageID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		t, err := c.client.PackageType.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &model.Package{ID: nodeID(t.ID), Type: t.Type, Namespaces: []*model.PackageNamespace{}}, nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PackageNamespace.Query().Where(packagenamespace.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		ns, err := client.PackageNamespace.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgePackageTrie, &ns.PackageID)
+		n.through(model.EdgePackageTrie)(client.PackageName.Query().Where(packagename.NamespaceID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		ns, err := c.client.PackageNamespace.Query().Where(packagenamespace.ID(id)).WithPackage().Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t := ns.Edges.Package
+		return &model.Package{
+			ID:   nodeID(t.ID),
+			Type: t.Type,
+			Namespaces: []*model.PackageNamespace{{
+				ID:        nodeID(ns.ID),
+				Namespace: ns.Namespace,
+				Names:     []*model.PackageName{},
+			}},
+		}, nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PackageName.Query().Where(packagename.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		name, err := client.PackageName.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgePackageTrie, &name.NamespaceID)
+		n.through(model.EdgePackageTrie)(client.PackageVersion.Query().Where(packageversion.NameID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyBad)(client.CertifyBad.Query().Where(certifybad.PackageNameID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyGood)(client.CertifyGood.Query().Where(certifygood.PackageNameID(id)).IDs(ctx))
+		n.through(model.EdgeHasMetadata)(client.HasMetadata.Query().Where(hasmetadata.PackageNameID(id)).IDs(ctx))
+		n.through(model.EdgeIsDependency)(client.IsDependency.Query().Where(isdependency.DependentPackageID(id)).IDs(ctx))
+		n.through(model.EdgePointOfContact)(client.PointOfContact.Query().Where(pointofcontact.PackageNameID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		q := c.client.PackageName.Query().Where(packagename.ID(id))
+		withPackageNamePath(q)
+		name, err := q.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return nameToPackage(name), nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PackageVersion.Query().Where(packageversion.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		v, err := client.PackageVersion.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgePackageTrie, &v.NameID)
+		n.through(model.EdgeCertifyBad)(client.CertifyBad.Query().Where(certifybad.PackageVersionID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyGood)(client.CertifyGood.Query().Where(certifygood.PackageVersionID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyLegal)(client.CertifyLegal.Query().Where(certifylegal.PackageID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyVexStatement)(client.CertifyVEXStatement.Query().Where(certifyvexstatement.PackageID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyVuln)(client.CertifyVuln.Query().Where(certifyvuln.PackageID(id)).IDs(ctx))
+		n.through(model.EdgeHasMetadata)(client.HasMetadata.Query().Where(hasmetadata.PackageVersionID(id)).IDs(ctx))
+		n.through(model.EdgeHasSbom)(client.HasSBOM.Query().Where(hassbom.PackageID(id)).IDs(ctx))
+		n.through(model.EdgeIsDependency)(client.IsDependency.Query().Where(isdependency.PackageID(id)).IDs(ctx))
+		n.through(model.EdgeIsOccurrence)(client.IsOccurrence.Query().Where(isoccurrence.PackageID(id)).IDs(ctx))
+		n.through(model.EdgePkgEqual)(client.PkgEqual.Query().Where(pkgequal.Or(pkgequal.PackageAID(id), pkgequal.PackageBID(id))).IDs(ctx))
+		n.through(model.EdgePointOfContact)(client.PointOfContact.Query().Where(pointofcontact.PackageVersionID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		q := c.client.PackageVersion.Query().Where(packageversion.ID(id))
+		withPackageVersionPath(q)
+		v, err := q.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return versionToPackage(v), nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.SourceType.Query().Where(sourcetype.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		var n neighbors
+		n.through(model.EdgeSourceTrie)(client.SourceNamespace.Query().Where(sourcenamespace.SourceID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		t, err := c.client.SourceType.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &model.Source{ID: nodeID(t.ID), Type: t.Type, Namespaces: []*model.SourceNamespace{}}, nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.SourceNamespace.Query().Where(sourcenamespace.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		ns, err := client.SourceNamespace.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeSourceTrie, &ns.SourceID)
+		n.through(model.EdgeSourceTrie)(client.SourceName.Query().Where(sourcename.NamespaceID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		q := c.client.SourceNamespace.Query().Where(sourcenamespace.ID(id))
+		withSourceNamespacePath(q)
+		ns, err := q.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t := ns.Edges.Source
+		return &model.Source{
+			ID:   nodeID(t.ID),
+			Type: t.Type,
+			Namespaces: []*model.SourceNamespace{{
+				ID:        nodeID(ns.ID),
+				Namespace: ns.Namespace,
+				Names:     []*model.SourceName{},
+			}},
+		}, nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.SourceName.Query().Where(sourcename.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		name, err := client.SourceName.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeSourceTrie, &name.NamespaceID)
+		n.through(model.EdgeCertifyBad)(client.CertifyBad.Query().Where(certifybad.SourceID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyGood)(client.CertifyGood.Query().Where(certifygood.SourceID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyLegal)(client.CertifyLegal.Query().Where(certifylegal.SourceID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyScorecard)(client.Scorecard.Query().Where(scorecard.SourceID(id)).IDs(ctx))
+		n.through(model.EdgeHasMetadata)(client.HasMetadata.Query().Where(hasmetadata.SourceID(id)).IDs(ctx))
+		n.through(model.EdgeIsOccurrence)(client.IsOccurrence.Query().Where(isoccurrence.SourceID(id)).IDs(ctx))
+		n.through(model.EdgePointOfContact)(client.PointOfContact.Query().Where(pointofcontact.SourceID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		q := c.client.SourceName.Query().Where(sourcename.ID(id))
+		withSourceNamePath(q)
+		name, err := q.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return nameToSource(name), nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.VulnerabilityType.Query().Where(vulnerabilitytype.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		var n neighbors
+		n.through(model.EdgeVulnerabilityTrie)(client.VulnerabilityID.Query().Where(vulnerabilityid.TypeID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		t, err := c.client.VulnerabilityType.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &model.Vulnerability{ID: nodeID(t.ID), Type: t.Type, VulnerabilityIDs: []*model.VulnerabilityID{}}, nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.VulnerabilityID.Query().Where(vulnerabilityid.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		v, err := client.VulnerabilityID.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeVulnerabilityTrie, &v.TypeID)
+		n.through(model.EdgeCertifyVexStatement)(client.CertifyVEXStatement.Query().Where(certifyvexstatement.VulnerabilityID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyVuln)(client.CertifyVuln.Query().Where(certifyvuln.VulnerabilityID(id)).IDs(ctx))
+		n.through(model.EdgeVulnEqual)(client.VulnEqual.Query().Where(vulnequal.Or(vulnequal.VulnerabilityAID(id), vulnequal.VulnerabilityBID(id))).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		v, err := c.client.VulnerabilityID.Query().Where(vulnerabilityid.ID(id)).WithType().Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return idToVulnerability(v), nil
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.Artifact.Query().Where(artifact.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		var n neighbors
+		n.through(model.EdgeCertifyBad)(client.CertifyBad.Query().Where(certifybad.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyGood)(client.CertifyGood.Query().Where(certifygood.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgeCertifyVexStatement)(client.CertifyVEXStatement.Query().Where(certifyvexstatement.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgeHashEqual)(client.HashEqual.Query().Where(hashequal.Or(hashequal.ArtifactAID(id), hashequal.ArtifactBID(id))).IDs(ctx))
+		n.through(model.EdgeHasMetadata)(client.HasMetadata.Query().Where(hasmetadata.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgeHasSbom)(client.HasSBOM.Query().Where(hassbom.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgeHasSlsa)(client.HasSLSA.Query().Where(hasslsa.SubjectID(id)).IDs(ctx))
+		n.through(model.EdgeHasSlsa)(client.Artifact.Query().Where(artifact.ID(id)).QueryMaterialOf().IDs(ctx))
+		n.through(model.EdgeIsOccurrence)(client.IsOccurrence.Query().Where(isoccurrence.ArtifactID(id)).IDs(ctx))
+		n.through(model.EdgePointOfContact)(client.PointOfContact.Query().Where(pointofcontact.ArtifactID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.Artifacts(ctx, &model.ArtifactSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.BuilderNode.Query().Where(buildernode.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		var n neighbors
+		n.through(model.EdgeHasSlsa)(client.HasSLSA.Query().Where(hasslsa.BuiltByID(id)).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.Builders(ctx, &model.BuilderSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.CertifyBad.Query().Where(certifybad.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		b, err := client.CertifyBad.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyBad, b.PackageVersionID, b.PackageNameID, b.SourceID, b.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.CertifyBad(ctx, &model.CertifyBadSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.CertifyGood.Query().Where(certifygood.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		g, err := client.CertifyGood.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyGood, g.PackageVersionID, g.PackageNameID, g.SourceID, g.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.CertifyGood(ctx, &model.CertifyGoodSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.CertifyLegal.Query().Where(certifylegal.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		l, err := client.CertifyLegal.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyLegal, l.PackageID, l.SourceID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.Scorecard.Query().Where(scorecard.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		s, err := client.Scorecard.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyScorecard, &s.SourceID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.Scorecards(ctx, &model.CertifyScorecardSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.CertifyVEXStatement.Query().Where(certifyvexstatement.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		v, err := client.CertifyVEXStatement.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyVexStatement, v.PackageID, v.ArtifactID, &v.VulnerabilityID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.CertifyVuln.Query().Where(certifyvuln.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		v, err := client.CertifyVuln.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeCertifyVuln, &v.PackageID, &v.VulnerabilityID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.HashEqual.Query().Where(hashequal.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		h, err := client.HashEqual.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeHashEqual, &h.ArtifactAID, &h.ArtifactBID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.HashEqual(ctx, &model.HashEqualSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.HasMetadata.Query().Where(hasmetadata.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		m, err := client.HasMetadata.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeHasMetadata, m.PackageVersionID, m.PackageNameID, m.SourceID, m.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.HasMetadata(ctx, &model.HasMetadataSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.HasSBOM.Query().Where(hassbom.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		h, err := client.HasSBOM.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeHasSbom, h.PackageID, h.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.HasSBOM(ctx, &model.HasSBOMSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.HasSLSA.Query().Where(hasslsa.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		h, err := client.HasSLSA.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeHasSlsa, &h.SubjectID, &h.BuiltByID)
+		n.through(model.EdgeHasSlsa)(client.HasSLSA.QueryBuiltFrom(h).IDs(ctx))
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.HasSLSA(ctx, &model.HasSLSASpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.IsDependency.Query().Where(isdependency.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		d, err := client.IsDependency.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeIsDependency, &d.PackageID, &d.DependentPackageID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.IsDependency(ctx, &model.IsDependencySpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.IsOccurrence.Query().Where(isoccurrence.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		o, err := client.IsOccurrence.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeIsOccurrence, o.PackageID, o.SourceID, &o.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PkgEqual.Query().Where(pkgequal.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		p, err := client.PkgEqual.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgePkgEqual, &p.PackageAID, &p.PackageBID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.PkgEqual(ctx, &model.PkgEqualSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.PointOfContact.Query().Where(pointofcontact.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		p, err := client.PointOfContact.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgePointOfContact, p.PackageVersionID, p.PackageNameID, p.SourceID, p.ArtifactID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.PointOfContact(ctx, &model.PointOfContactSpec{ID: ptrTo(nodeID(id))}))
+	},
+}, {
+	exists: func(ctx context.Context, client *db.Client, id int) (bool, error) {
+		return client.VulnEqual.Query().Where(vulnequal.ID(id)).Exist(ctx)
+	},
+	neighbors: func(ctx context.Context, client *db.Client, id int) ([]neighbor, error) {
+		e, err := client.VulnEqual.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var n neighbors
+		n.add(model.EdgeVulnEqual, &e.VulnerabilityAID, &e.VulnerabilityBID)
+		return n.result()
+	},
+	toModel: func(ctx context.Context, c *entClient, id int) (model.Node, error) {
+		return firstNode(c.VulnEqual(ctx, &model.VulnEqualSpec{ID: ptrTo(nodeID(id))}))
+	},
+}}
+
+// nodeResolver resolves the kind of the nodes seen by a query. IDs are allocated
+// in a separate range of 1<<32 IDs for every table (see migrateSchema), so
+// the kind of a range is only looked up once.
+type nodeResolver struct {
+	c     *entClient
+	kinds map[int]*nodeKind
+}
+
+func newNodeResolver(c *entClient) *nodeResolver {
+	return &nodeResolver{c: c, kinds: map[int]*nodeKind{}}
+}
+
+// kind returns the kind of the node, failing if there is no such node.
+func (r *nodeResolver) kind(ctx context.Context, method string, id int) (*nodeKind, error) {
+	if k, ok := r.kinds[id>>32]; ok {
+		exists, err := k.exists(ctx, r.c.client, id)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return k, nil
+		}
+		return nil, backends.Errorf("%s :: node %q %w", method, nodeID(id), backends.ErrNotFound)
+	}
+	for _, k := range nodeKinds {
+		exists, err := k.exists(ctx, r.c.client, id)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			r.kinds[id>>32] = k
+			return k, nil
+		}
+	}
+	return nil, backends.Errorf("%s :: node %q %w", method, nodeID(id), backends.ErrNotFound)
+}
+
+// neighbors returns the IDs of the nodes connected to the node through an
+// allowed edge.
+func (r *nodeResolver) neighbors(ctx context.Context, method string, id int, usingOnly []model.Edge) ([]string, error) {
+	k, err := r.kind(ctx, method, id)
+	if err != nil {
+		return nil, err
+	}
+	all, err := k.neighbors(ctx, r.c.client, id)
+	if err != nil {
+		return nil, err
+	}
+	out := []string{}
+	seen := map[int]bool{}
+	for _, n := range all {
+		if seen[n.id] || !backends.AllowsEdge(usingOnly, n.edge) {
+			continue
+		}
+		seen[n.id] = true
+		out = append(out, nodeID(n.id))
+	}
+	return out, nil
+}
+
+// nodes returns the model of the nodes with the given IDs, in order.
+func (r *nodeResolver) nodes(ctx context.Context, method string, ids []string) ([]model.Node, error) {
+	out := make([]model.Node, 0, len(ids))
+	for _, s := range ids {
+		id, err := parseID(s)
+		if err != nil {
+			return nil, err
+		}
+		k, err := r.kind(ctx, method, id)
+		if err != nil {
+			return nil, err
+		}
+		n, err := k.toModel(ctx, r.c, id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func (c *entClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	id, err := parseID(node)
+	if err != nil {
+		return nil, queryError(ctx, "Neighbors", err)
+	}
+	r := newNodeResolver(c)
+	ids, err := r.neighbors(ctx, "Neighbors", id, usingOnly)
+	if err != nil {
+		return nil, queryError(ctx, "Neighbors", err)
+	}
+	nodes, err := r.nodes(ctx, "Neighbors", ids)
+	if err != nil {
+		return nil, queryError(ctx, "Neighbors", err)
+	}
+	return nodes, nil
+}
+
+func (c *entClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if maxPathLength <= 0 {
+		return nil, backends.Errorf("Path :: %w", backends.NewValidationError("maxPathLength", "maxPathLength must be positive"))
+	}
+	r := newNodeResolver(c)
+	for _, s := range []string{subject, target} {
+		id, err := parseID(s)
+		if err != nil {
+			return nil, queryError(ctx, "Path", err)
+		}
+		if _, err := r.kind(ctx, "Path", id); err != nil {
+			return nil, queryError(ctx, "Path", err)
+		}
+	}
+	ids, err := backends.ShortestPath(ctx, subject, target, maxPathLength,
+		func(ctx context.Context, s string) ([]string, error) {
+			id, err := parseID(s)
+			if err != nil {
+				return nil, err
+			}
+			return r.neighbors(ctx, "Path", id, usingOnly)
+		})
+	if err != nil {
+		return nil, queryError(ctx, "Path", err)
+	}
+	nodes, err := r.nodes(ctx, "Path", ids)
+	if err != nil {
+		return nil, queryError(ctx, "Path", err)
+	}
+	return nodes, nil
+}
+
+// firstNode returns the single node returned by a query on its ID.
+func firstNode[T model.Node](nodes []T, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("node %w", backends.ErrNotFound)
+	}
+	return nodes[0], nil
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,546 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packagetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest Package
+
+func (c *entClient) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPackage :: %w", backends.Missing("pkg"))
+	}
+
+	v, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.PackageVersion, error) {
+		id, err := ingestPackage(ctx, tx.Client(), pkg)
+		if err != nil {
+			return nil, err
+		}
+		return tx.PackageVersion.Query().
+			Where(packageversion.ID(id)).
+			WithName(withPackageNamePath).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestPackage", err)
+	}
+	return versionToPackage(v), nil
+}
+
+// IngestPackages ingests all the packages in a single transaction, like
+// IngestArtifacts. The rows shared by the packages are only inserted once,
+// and the packages are read back with a single query.
+func (c *entClient) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for i, pkg := range pkgs {
+		if pkg == nil {
+			return nil, backends.Errorf("IngestPackages :: %w", backends.NewValidationError("pkgs", "missing package at index %d", i))
+		}
+	}
+
+	out, err := withTx(ctx, c.client, func(tx *db.Tx) ([]*model.Package, error) {
+		trie := trieIDs{}
+		ids := make([]int, 0, len(pkgs))
+		for _, pkg := range pkgs {
+			id, err := trie.ingestPackage(ctx, tx.Client(), pkg)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		versions, err := tx.PackageVersion.Query().
+			Where(packageversion.IDIn(ids...)).
+			WithName(withPackageNamePath).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[int]*db.PackageVersion, len(versions))
+		for _, v := range versions {
+			byID[v.ID] = v
+		}
+		out := make([]*model.Package, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, versionToPackage(byID[id]))
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestPackages", err)
+	}
+	return out, nil
+}
+
+// trieIDs remembers the IDs of the trie rows ingested in a transaction, so
+// that bulk ingestion inserts the rows shared by the inputs only once. The
+// rows are keyed on their level in the trie, their parent and their value.
+type trieIDs map[trieKey]int
+
+type trieKey struct {
+	level  string
+	parent int
+	value  string
+}
+
+// id returns the remembered ID of the row, inserting it with insert if
+// needed. A nil trieIDs remembers nothing.
+func (t trieIDs) id(level string, parent int, value string, insert func() (int, error)) (int, error) {
+	key := trieKey{level: level, parent: parent, value: value}
+	if id, ok := t[key]; ok {
+		return id, nil
+	}
+	id, err := insert()
+	if err != nil {
+		return 0, err
+	}
+	if t != nil {
+		t[key] = id
+	}
+	return id, nil
+}
+
+// ingestPackage adds the package to the trie, creating only the missing
+// rows, and returns the ID of the version.
+func ingestPackage(ctx context.Context, client *db.Client, pkg *model.PkgInputSpec) (int, error) {
+	return trieIDs(nil).ingestPackage(ctx, client, pkg)
+}
+
+// ingestPackageName is like ingestPackage but stops at the name level of the
+// trie, ignoring the version, qualifiers and subpath of the input.
+func ingestPackageName(ctx context.Context, client *db.Client, pkg *model.PkgInputSpec) (int, error) {
+	return trieIDs(nil).ingestPackageName(ctx, client, pkg)
+}
+
+// ingestPackage is like the ingestPackage function, skipping the rows
+// already ingested through t.
+func (t trieIDs) ingestPackage(ctx context.Context, client *db.Client, pkg *model.PkgInputSpec) (int, error) {
+	pkg = backends.CanonicalPkgInputSpec(pkg)
+	nameID, err := t.ingestPackageName(ctx, client, pkg)
+	if err != nil {
+		return 0, err
+	}
+
+	qualifiers := make([]model.PackageQualifier, 0, len(pkg.Qualifiers))
+	for _, q := range pkg.Qualifiers {
+		qualifiers = append(qualifiers, model.PackageQualifier{Key: q.Key, Value: q.Value})
+	}
+	version := derefOrEmpty(pkg.Version)
+	subpath := derefOrEmpty(pkg.Subpath)
+	key := qualifiersKey(qualifiers)
+	return t.id("version", nameID, version+"\x00"+subpath+"\x00"+key, func() (int, error) {
+		return client.PackageVersion.Create().
+			SetNameID(nameID).
+			SetVersion(version).
+			SetSubpath(subpath).
+			SetQualifiers(qualifiers).
+			SetQualifiersKey(key).
+			OnConflict(sql.ConflictColumns(packageversion.FieldNameID, packageversion.FieldVersion,
+				packageversion.FieldSubpath, packageversion.FieldQualifiersKey)).
+			Ignore().
+			ID(ctx)
+	})
+}
+
+// ingestPackageName is like the ingestPackageName function, skipping the
+// rows already ingested through t.
+func (t trieIDs) ingestPackageName(ctx context.Context, client *db.Client, pkg *model.PkgInputSpec) (int, error) {
+	pkg = backends.CanonicalPkgInputSpec(pkg)
+	typeID, err := t.id("pkgType", 0, pkg.Type, func() (int, error) {
+		return client.PackageType.Create().
+			SetType(pkg.Type).
+			OnConflict(sql.ConflictColumns(packagetype.FieldType)).
+			Ignore().
+			ID(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	namespace := derefOrEmpty(pkg.Namespace)
+	namespaceID, err := t.id("pkgNamespace", typeID, namespace, func() (int, error) {
+		return client.PackageNamespace.Create().
+			SetPackageID(typeID).
+			SetNamespace(namespace).
+			OnConflict(sql.ConflictColumns(packagenamespace.FieldPackageID, packagenamespace.FieldNamespace)).
+			Ignore().
+			ID(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return t.id("pkgName", namespaceID, pkg.Name, func() (int, error) {
+		return client.PackageName.Create().
+			SetNamespaceID(namespaceID).
+			SetName(pkg.Name).
+			OnConflict(sql.ConflictColumns(packagename.FieldNamespaceID, packagename.FieldName)).
+			Ignore().
+			ID(ctx)
+	})
+}
+
+// qualifiersKey returns the value identifying the qualifiers of a version
+// regardless of their order. The qualifiers are encoded like in the query
+// string of a URL, so that qualifierMatches can look for a single pair.
+func qualifiersKey(qualifiers []model.PackageQualifier) string {
+	pairs := make([]string, 0, len(qualifiers))
+	for _, q := range qualifiers {
+		pairs = append(pairs, url.QueryEscape(q.Key)+"="+url.QueryEscape(q.Value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// qualifierMatches returns the predicate matching the versions having the
+// qualifier of the spec, by looking for it in their qualifiers_key.
+func qualifierMatches(q *model.PackageQualifierSpec) predicate.PackageVersion {
+	if q.Value == nil {
+		prefix := url.QueryEscape(q.Key) + "="
+		return packageversion.Or(
+			packageversion.QualifiersKeyHasPrefix(prefix),
+			packageversion.QualifiersKeyContains("&"+prefix))
+	}
+	pair := url.QueryEscape(q.Key) + "=" + url.QueryEscape(*q.Value)
+	return packageversion.Or(
+		packageversion.QualifiersKeyEQ(pair),
+		packageversion.QualifiersKeyHasPrefix(pair+"&"),
+		packageversion.QualifiersKeyHasSuffix("&"+pair),
+		packageversion.QualifiersKeyContains("&"+pair+"&"))
+}
+
+// qualifiersMatch returns the predicates matching the versions with the
+// qualifiers of the canonicalized spec.
+func qualifiersMatch(pkgSpec *model.PkgSpec) []predicate.PackageVersion {
+	if pkgSpec.MatchOnlyEmptyQualifiers != nil {
+		return []predicate.PackageVersion{packageversion.QualifiersKeyEQ("")}
+	}
+	var filters []predicate.PackageVersion
+	for _, q := range pkgSpec.Qualifiers {
+		filters = append(filters, qualifierMatches(q))
+	}
+	return filters
+}
+
+// Query Packages
+
+// Packages returns the package trie pruned to the spec. Like in the other
+// backends, a level is only left out when it has no matching children if the
+// spec filters on that level or below it.
+func (c *entClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkgSpec == nil {
+		pkgSpec = &model.PkgSpec{}
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+
+	versionFilters := versionMatches(pkgSpec)
+	var nameFilters []predicate.PackageName
+	if pkgSpec.Name != nil {
+		nameFilters = append(nameFilters, packagename.Name(*pkgSpec.Name))
+	}
+	if filtersPackageVersion(pkgSpec) {
+		nameFilters = append(nameFilters, packagename.HasVersionsWith(versionFilters...))
+	}
+	var namespaceFilters []predicate.PackageNamespace
+	if pkgSpec.Namespace != nil {
+		namespaceFilters = append(namespaceFilters, packagenamespace.Namespace(*pkgSpec.Namespace))
+	}
+	if filtersPackageName(pkgSpec) {
+		namespaceFilters = append(namespaceFilters, packagenamespace.HasNamesWith(nameFilters...))
+	}
+	var typeFilters []predicate.PackageType
+	if pkgSpec.Type != nil {
+		typeFilters = append(typeFilters, packagetype.Type(*pkgSpec.Type))
+	}
+	if pkgSpec.Namespace != nil || filtersPackageName(pkgSpec) {
+		typeFilters = append(typeFilters, packagetype.HasNamespacesWith(namespaceFilters...))
+	}
+
+	types, err := c.client.PackageType.Query().
+		Where(typeFilters...).
+		Order(db.Asc(packagetype.FieldID)).
+		WithNamespaces(func(q *db.PackageNamespaceQuery) {
+			q.Where(namespaceFilters...).
+				Order(db.Asc(packagenamespace.FieldID)).
+				WithNames(func(q *db.PackageNameQuery) {
+					q.Where(nameFilters...).
+						Order(db.Asc(packagename.FieldID)).
+						WithVersions(func(q *db.PackageVersionQuery) {
+							q.Where(versionFilters...).Order(db.Asc(packageversion.FieldID))
+						})
+				})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "Packages", err)
+	}
+
+	out := make([]*model.Package, 0, len(types))
+	for _, t := range types {
+		p := &model.Package{ID: nodeID(t.ID), Type: t.Type}
+		for _, ns := range t.Edges.Namespaces {
+			namespace := &model.PackageNamespace{ID: nodeID(ns.ID), Namespace: ns.Namespace}
+			for _, n := range ns.Edges.Names {
+				name := &model.PackageName{ID: nodeID(n.ID), Name: n.Name}
+				for _, v := range n.Edges.Versions {
+					name.Versions = append(name.Versions, toModelPackageVersion(v))
+				}
+				namespace.Names = append(namespace.Names, name)
+			}
+			p.Namespaces = append(p.Namespaces, namespace)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// PackagesByPurls loads the names of all the purls, with their path and
+// versions, in a single query matching any of them. The versions loaded are
+// the ones matching any of the purls, which are then assigned to each purl.
+func (c *entClient) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	specs, specsErr := backends.PurlPkgSpecs(purls)
+	out := make(map[string]*model.Package, len(specs))
+	if len(specs) == 0 {
+		return out, specsErr
+	}
+
+	nameFilters := make([]predicate.PackageName, 0, len(specs))
+	var versionFilters []predicate.PackageVersion
+	allVersions := false
+	for _, pkgSpec := range specs {
+		nameFilters = append(nameFilters, packagename.And(packageNameMatches(pkgSpec)...))
+		if filtersPackageVersion(pkgSpec) {
+			versionFilters = append(versionFilters, packageversion.And(versionMatches(pkgSpec)...))
+		} else {
+			allVersions = true
+		}
+	}
+
+	names, err := c.client.PackageName.Query().
+		Where(packagename.Or(nameFilters...)).
+		WithNamespace(func(q *db.PackageNamespaceQuery) {
+			q.WithPackage()
+		}).
+		WithVersions(func(q *db.PackageVersionQuery) {
+			if !allVersions {
+				q.Where(packageversion.Or(versionFilters...))
+			}
+			q.Order(db.Asc(packageversion.FieldID))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "PackagesByPurls", err)
+	}
+
+	byPath := make(map[string]*db.PackageName, len(names))
+	for _, n := range names {
+		ns := n.Edges.Namespace
+		byPath[ns.Edges.Package.Type+"\x00"+ns.Namespace+"\x00"+n.Name] = n
+	}
+	for purl, pkgSpec := range specs {
+		n, ok := byPath[*pkgSpec.Type+"\x00"+*pkgSpec.Namespace+"\x00"+*pkgSpec.Name]
+		if !ok {
+			continue
+		}
+		var versions []*model.PackageVersion
+		for _, v := range n.Edges.Versions {
+			if versionMatchesSpec(v, pkgSpec) {
+				versions = append(versions, toModelPackageVersion(v))
+			}
+		}
+		if len(versions) == 0 && filtersPackageVersion(pkgSpec) {
+			continue
+		}
+		p := nameToPackage(n)
+		p.Namespaces[0].Names[0].Versions = versions
+		out[purl] = p
+	}
+	return out, specsErr
+}
+
+// versionMatchesSpec returns true if the version matches the version filters
+// of the canonicalized spec, as the versionMatches predicates do.
+func versionMatchesSpec(v *db.PackageVersion, pkgSpec *model.PkgSpec) bool {
+	if pkgSpec.Version != nil && v.Version != *pkgSpec.Version {
+		return false
+	}
+	if pkgSpec.Subpath != nil && v.Subpath != *pkgSpec.Subpath {
+		return false
+	}
+	if pkgSpec.MatchOnlyEmptyQualifiers != nil {
+		return len(v.Qualifiers) == 0
+	}
+	for _, want := range pkgSpec.Qualifiers {
+		found := false
+		for _, q := range v.Qualifiers {
+			if q.Key == want.Key && (want.Value == nil || q.Value == *want.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filtersPackageName returns true if the spec filters on the name level of
+// the trie or below it.
+func filtersPackageName(pkgSpec *model.PkgSpec) bool {
+	return pkgSpec.Name != nil || filtersPackageVersion(pkgSpec)
+}
+
+// filtersPackageVersion returns true if the spec filters on the version level
+// of the trie.
+func filtersPackageVersion(pkgSpec *model.PkgSpec) bool {
+	return pkgSpec.Version != nil || pkgSpec.Subpath != nil ||
+		len(pkgSpec.Qualifiers) > 0 || pkgSpec.MatchOnlyEmptyQualifiers != nil
+}
+
+// The helpers below are used by the evidence trees pointing to the package
+// trie, to filter and load the path from the root of the trie down to the
+// version or name they point to.
+
+// packageVersionMatches returns the predicates matching the versions whose
+// path matches the canonicalized spec.
+func packageVersionMatches(pkgSpec *model.PkgSpec) []predicate.PackageVersion {
+	if pkgSpec == nil {
+		return nil
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	filters := versionMatches(pkgSpec)
+	if names := packageNameMatches(pkgSpec); len(names) > 0 {
+		filters = append(filters, packageversion.HasNameWith(names...))
+	}
+	return filters
+}
+
+// versionMatches returns the predicates matching the versions against the
+// version filters of the canonicalized spec, ignoring their path.
+func versionMatches(pkgSpec *model.PkgSpec) []predicate.PackageVersion {
+	var filters []predicate.PackageVersion
+	if pkgSpec.Version != nil {
+		filters = append(filters, packageversion.Version(*pkgSpec.Version))
+	}
+	if pkgSpec.Subpath != nil {
+		filters = append(filters, packageversion.Subpath(*pkgSpec.Subpath))
+	}
+	return append(filters, qualifiersMatch(pkgSpec)...)
+}
+
+// packageNameMatches returns the predicates matching the names whose path
+// matches the type, namespace and name filters of the canonicalized spec. The
+// version filters are ignored.
+func packageNameMatches(pkgSpec *model.PkgSpec) []predicate.PackageName {
+	if pkgSpec == nil {
+		return nil
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	var filters []predicate.PackageName
+	if pkgSpec.Name != nil {
+		filters = append(filters, packagename.Name(*pkgSpec.Name))
+	}
+	var namespaceFilters []predicate.PackageNamespace
+	if pkgSpec.Namespace != nil {
+		namespaceFilters = append(namespaceFilters, packagenamespace.Namespace(*pkgSpec.Namespace))
+	}
+	if pkgSpec.Type != nil {
+		namespaceFilters = append(namespaceFilters, packagenamespace.HasPackageWith(packagetype.Type(*pkgSpec.Type)))
+	}
+	if len(namespaceFilters) > 0 {
+		filters = append(filters, packagename.HasNamespaceWith(namespaceFilters...))
+	}
+	return filters
+}
+
+// withPackageNamePath loads the namespace and type of the names returned by
+// the query.
+func withPackageNamePath(q *db.PackageNameQuery) {
+	q.WithNamespace(func(q *db.PackageNamespaceQuery) {
+		q.WithPackage()
+	})
+}
+
+// withPackageVersionPath loads the path from the root of the trie of the
+// versions returned by the query.
+func withPackageVersionPath(q *db.PackageVersionQuery) {
+	q.WithName(withPackageNamePath)
+}
+
+func toModelPackageVersion(v *db.PackageVersion) *model.PackageVersion {
+	qualifiers := make([]*model.PackageQualifier, 0, len(v.Qualifiers))
+	for _, q := range v.Qualifiers {
+		qualifiers = append(qualifiers, &model.PackageQualifier{Key: q.Key, Value: q.Value})
+	}
+	return &model.PackageVersion{
+		ID:         nodeID(v.ID),
+		Version:    v.Version,
+		Subpath:    v.Subpath,
+		Qualifiers: qualifiers,
+	}
+}
+
+// nameToPackage returns the path from the root of the trie down to a name
+// loaded with withPackageNamePath, without any version.
+func nameToPackage(n *db.PackageName) *model.Package {
+	ns := n.Edges.Namespace
+	t := ns.Edges.Package
+	return &model.Package{
+		ID:   nodeID(t.ID),
+		Type: t.Type,
+		Namespaces: []*model.PackageNamespace{{
+			ID:        nodeID(ns.ID),
+			Namespace: ns.Namespace,
+			Names: []*model.PackageName{{
+				ID:       nodeID(n.ID),
+				Name:     n.Name,
+				Versions: []*model.PackageVersion{},
+			}},
+		}},
+	}
+}
+
+// versionToPackage returns the path from the root of the trie down to a
+// version loaded with withPackageVersionPath.
+func versionToPackage(v *db.PackageVersion) *model.Package {
+	p := nameToPackage(v.Edges.Name)
+	p.Namespaces[0].Names[0].Versions = []*model.PackageVersion{toModelPackageVersion(v)}
+	return p
+}
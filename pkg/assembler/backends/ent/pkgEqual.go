@@ -0,0 +1,205 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest PkgEqual
+
+// IngestPkgEqual returns the packages in the order of the arguments, like
+// IngestHashEqual.
+func (c *entClient) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkg"))
+	}
+	if otherPackage == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("otherPackage"))
+	}
+	if pkgEqual == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkgEqual"))
+	}
+
+	p, err := withTx(ctx, c.client, func(tx *db.Tx) (*model.PkgEqual, error) {
+		a, err := ingestPackage(ctx, tx.Client(), pkg)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ingestPackage(ctx, tx.Client(), otherPackage)
+		if err != nil {
+			return nil, err
+		}
+		if a == b {
+			return nil, backends.Errorf("IngestPkgEqual :: %w", backends.NewValidationError("otherPackage", "a package cannot be certified equal to itself"))
+		}
+		first, second := a, b
+		if second < first {
+			first, second = second, first
+		}
+		id, err := tx.PkgEqual.Create().
+			SetPackageAID(first).
+			SetPackageBID(second).
+			SetJustification(pkgEqual.Justification).
+			SetOrigin(pkgEqual.Origin).
+			SetCollector(pkgEqual.Collector).
+			OnConflict(sql.ConflictColumns(pkgequal.FieldPackageAID, pkgequal.FieldPackageBID,
+				pkgequal.FieldJustification, pkgequal.FieldOrigin, pkgequal.FieldCollector)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p, err := withPkgEqualPaths(tx.PkgEqual.Query().Where(pkgequal.ID(id))).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toModelPkgEqual(p, a != first), nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestPkgEqual", err)
+	}
+	return p, nil
+}
+
+// Query PkgEqual
+
+// PkgEqual returns the packages of every equality ordered so that the first
+// one matches the first package filter, as in the other backends.
+func (c *entClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkgEqualSpec == nil {
+		pkgEqualSpec = &model.PkgEqualSpec{}
+	}
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, backends.Errorf("PkgEqual :: %w", backends.NewValidationError("packages", "cannot filter on more than 2 packages"))
+	}
+	if pkgEqualSpec.ExpandTransitive != nil && *pkgEqualSpec.ExpandTransitive {
+		return backends.ExpandPkgEqual(ctx, c, pkgEqualSpec)
+	}
+
+	var filters []predicate.PkgEqual
+	if pkgEqualSpec.ID != nil {
+		id, err := parseID(*pkgEqualSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("PkgEqual :: %w", err)
+		}
+		filters = append(filters, pkgequal.ID(id))
+	}
+	if pkgEqualSpec.Justification != nil {
+		filters = append(filters, pkgequal.Justification(*pkgEqualSpec.Justification))
+	}
+	if pkgEqualSpec.Origin != nil {
+		filters = append(filters, pkgequal.Origin(*pkgEqualSpec.Origin))
+	}
+	if pkgEqualSpec.Collector != nil {
+		filters = append(filters, pkgequal.Collector(*pkgEqualSpec.Collector))
+	}
+	var pkgFilters [][]predicate.PackageVersion
+	for _, spec := range pkgEqualSpec.Packages {
+		pkgFilters = append(pkgFilters, packageVersionMatches(spec))
+	}
+	switch len(pkgFilters) {
+	case 1:
+		filters = append(filters, pkgequal.Or(
+			pkgequal.HasPackageAWith(pkgFilters[0]...),
+			pkgequal.HasPackageBWith(pkgFilters[0]...),
+		))
+	case 2:
+		filters = append(filters, pkgequal.Or(
+			pkgequal.And(pkgequal.HasPackageAWith(pkgFilters[0]...), pkgequal.HasPackageBWith(pkgFilters[1]...)),
+			pkgequal.And(pkgequal.HasPackageAWith(pkgFilters[1]...), pkgequal.HasPackageBWith(pkgFilters[0]...)),
+		))
+	}
+
+	equalities, err := withPkgEqualPaths(c.client.PkgEqual.Query().Where(filters...)).
+		Order(db.Asc(pkgequal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "PkgEqual", err)
+	}
+	if len(equalities) == 0 {
+		return nil, nil
+	}
+
+	// The packages are swapped unless the first one matches the first
+	// filter (and the second one the second filter), so the packages of the
+	// results matching each filter are needed.
+	var ids []int
+	for _, p := range equalities {
+		ids = append(ids, p.PackageAID, p.PackageBID)
+	}
+	matching := make([]map[int]bool, len(pkgFilters))
+	for i, f := range pkgFilters {
+		matchingIDs, err := c.client.PackageVersion.Query().
+			Where(append([]predicate.PackageVersion{packageversion.IDIn(ids...)}, f...)...).
+			IDs(ctx)
+		if err != nil {
+			return nil, queryError(ctx, "PkgEqual", err)
+		}
+		matching[i] = map[int]bool{}
+		for _, id := range matchingIDs {
+			matching[i][id] = true
+		}
+	}
+
+	out := make([]*model.PkgEqual, 0, len(equalities))
+	for _, p := range equalities {
+		swap := false
+		switch len(matching) {
+		case 1:
+			swap = !matching[0][p.PackageAID]
+		case 2:
+			swap = !matching[0][p.PackageAID] || !matching[1][p.PackageBID]
+		}
+		out = append(out, toModelPkgEqual(p, swap))
+	}
+	return out, nil
+}
+
+// withPkgEqualPaths loads both packages of the rows returned by the query.
+func withPkgEqualPaths(q *db.PkgEqualQuery) *db.PkgEqualQuery {
+	return q.WithPackageA(withPackageVersionPath).WithPackageB(withPackageVersionPath)
+}
+
+// toModelPkgEqual converts the row to the model, swapping its packages if
+// requested.
+func toModelPkgEqual(p *db.PkgEqual, swap bool) *model.PkgEqual {
+	first, second := p.Edges.PackageA, p.Edges.PackageB
+	if swap {
+		first, second = second, first
+	}
+	return &model.PkgEqual{
+		ID:            nodeID(p.ID),
+		Packages:      []*model.Package{versionToPackage(first), versionToPackage(second)},
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+	}
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest PointOfContact
+
+func (c *entClient) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pointOfContact == nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", backends.Missing("pointOfContact"))
+	}
+	if err := validateSubject(subject); err != nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", err)
+	}
+
+	n, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.PointOfContact, error) {
+		s, err := ingestSubject(ctx, tx.Client(), subject, pkgMatchType)
+		if err != nil {
+			return nil, err
+		}
+		id, err := tx.PointOfContact.Create().
+			SetNillablePackageVersionID(s.pkgVersion).
+			SetNillablePackageNameID(s.pkgName).
+			SetNillableSourceID(s.src).
+			SetNillableArtifactID(s.artifact).
+			SetEmail(pointOfContact.Email).
+			SetInfo(pointOfContact.Info).
+			SetSince(pointOfContact.Since.UTC()).
+			SetJustification(pointOfContact.Justification).
+			SetOrigin(pointOfContact.Origin).
+			SetCollector(pointOfContact.Collector).
+			OnConflict(
+				sql.ConflictColumns(s.column(), pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldSince,
+					pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector),
+				sql.ConflictWhere(sql.NotNull(s.column())),
+			).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withPointOfContactSubject(tx.PointOfContact.Query().Where(pointofcontact.ID(id))).Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestPointOfContact", err)
+	}
+	return toModelPointOfContact(n), nil
+}
+
+// Query PointOfContact
+
+func (c *entClient) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pointOfContactSpec == nil {
+		pointOfContactSpec = &model.PointOfContactSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(pointOfContactSpec.Subject); err != nil {
+		return nil, backends.Errorf("PointOfContact :: %w", err)
+	}
+
+	var filters []predicate.PointOfContact
+	if pointOfContactSpec.ID != nil {
+		id, err := parseID(*pointOfContactSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("PointOfContact :: %w", err)
+		}
+		filters = append(filters, pointofcontact.ID(id))
+	}
+	if pointOfContactSpec.Email != nil {
+		filters = append(filters, pointofcontact.Email(*pointOfContactSpec.Email))
+	}
+	if pointOfContactSpec.Info != nil {
+		filters = append(filters, pointofcontact.Info(*pointOfContactSpec.Info))
+	}
+	if pointOfContactSpec.Since != nil {
+		filters = append(filters, pointofcontact.Since(pointOfContactSpec.Since.UTC()))
+	}
+	if pointOfContactSpec.Justification != nil {
+		filters = append(filters, pointofcontact.Justification(*pointOfContactSpec.Justification))
+	}
+	if pointOfContactSpec.Origin != nil {
+		filters = append(filters, pointofcontact.Origin(*pointOfContactSpec.Origin))
+	}
+	if pointOfContactSpec.Collector != nil {
+		filters = append(filters, pointofcontact.Collector(*pointOfContactSpec.Collector))
+	}
+	if s := pointOfContactSpec.Subject; s != nil {
+		switch {
+		case s.Package != nil:
+			// Contacts on a package name match the package filters regardless
+			// of the version filters.
+			filters = append(filters, pointofcontact.Or(
+				pointofcontact.HasPackageVersionWith(packageVersionMatches(s.Package)...),
+				pointofcontact.HasPackageNameWith(packageNameMatches(s.Package)...),
+			))
+		case s.Source != nil:
+			filters = append(filters, pointofcontact.HasSourceWith(sourceNameMatches(s.Source)...))
+		case s.Artifact != nil:
+			artifactFilters, err := artifactMatches(s.Artifact)
+			if err != nil {
+				return nil, backends.Errorf("PointOfContact :: %w", err)
+			}
+			filters = append(filters, pointofcontact.HasArtifactWith(artifactFilters...))
+		}
+	}
+
+	contacts, err := withPointOfContactSubject(c.client.PointOfContact.Query().Where(filters...)).
+		Order(db.Asc(pointofcontact.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "PointOfContact", err)
+	}
+
+	out := make([]*model.PointOfContact, 0, len(contacts))
+	for _, n := range contacts {
+		out = append(out, toModelPointOfContact(n))
+	}
+	return out, nil
+}
+
+// withPointOfContactSubject loads the subject of the points of contact
+// returned by the query.
+func withPointOfContactSubject(q *db.PointOfContactQuery) *db.PointOfContactQuery {
+	return q.WithPackageVersion(withPackageVersionPath).
+		WithPackageName(withPackageNamePath).
+		WithSource(withSourceNamePath).
+		WithArtifact()
+}
+
+func toModelPointOfContact(n *db.PointOfContact) *model.PointOfContact {
+	return &model.PointOfContact{
+		ID:            nodeID(n.ID),
+		Subject:       subjectToModel(n.Edges.PackageVersion, n.Edges.PackageName, n.Edges.Source, n.Edges.Artifact),
+		Email:         n.Email,
+		Info:          n.Info,
+		Since:         n.Since.UTC(),
+		Justification: n.Justification,
+		Origin:        n.Origin,
+		Collector:     n.Collector,
+	}
+}
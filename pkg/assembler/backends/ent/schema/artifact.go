@@ -0,0 +1,48 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Artifact holds the schema definition for the artifacts, deduplicated on
+// their lowercase algorithm and digest.
+type Artifact struct {
+	ent.Schema
+}
+
+func (Artifact) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("algorithm"),
+		field.String("digest"),
+	}
+}
+
+func (Artifact) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("material_of", HasSLSA.Type).Ref("built_from"),
+	}
+}
+
+func (Artifact) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("algorithm", "digest").Unique().StorageKey("artifacts_unique"),
+	}
+}
@@ -0,0 +1,41 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// BuilderNode holds the schema definition for the builders, deduplicated on
+// their URI. It is not named Builder as that clashes with the generated code.
+type BuilderNode struct {
+	ent.Schema
+}
+
+func (BuilderNode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "builders"},
+	}
+}
+
+func (BuilderNode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uri").Unique(),
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// certificationMixin holds the fields shared by CertifyBad and CertifyGood.
+// Their subject is exactly one of a package version, a package name (for
+// certifications applying to all the versions), a source or an artifact, so
+// every subject has its own unique index restricted to the rows using it.
+type certificationMixin struct {
+	mixin.Schema
+	// table is the name of the table of the certifications, prefixing the
+	// names of the indexes.
+	table string
+}
+
+func (certificationMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_version_id").Optional().Nillable(),
+		field.Int("package_name_id").Optional().Nillable(),
+		field.Int("source_id").Optional().Nillable(),
+		field.Int("artifact_id").Optional().Nillable(),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (certificationMixin) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package_version", PackageVersion.Type).Field("package_version_id").Unique(),
+		edge.To("package_name", PackageName.Type).Field("package_name_id").Unique(),
+		edge.To("source", SourceName.Type).Field("source_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique(),
+	}
+}
+
+func (m certificationMixin) Indexes() []ent.Index {
+	return subjectIndexes(m.table, []string{"package_version_id", "package_name_id", "source_id", "artifact_id"},
+		"justification", "origin", "collector")
+}
+
+// subjectIndexes returns a unique index for each subject column, covering the
+// rows where it is set. The other fields are part of all the indexes.
+func subjectIndexes(table string, subjects []string, fields ...string) []ent.Index {
+	indexes := make([]ent.Index, 0, len(subjects))
+	for _, subject := range subjects {
+		indexes = append(indexes, index.Fields(append([]string{subject}, fields...)...).
+			Unique().
+			StorageKey(table+"_"+subject+"_unique").
+			Annotations(entsql.IndexWhere(subject+" IS NOT NULL")))
+	}
+	return indexes
+}
+
+// CertifyBad holds the schema definition for the CertifyBad certifications.
+type CertifyBad struct {
+	ent.Schema
+}
+
+func (CertifyBad) Mixin() []ent.Mixin {
+	return []ent.Mixin{certificationMixin{table: "certify_bads"}}
+}
+
+// CertifyGood holds the schema definition for the CertifyGood certifications.
+type CertifyGood struct {
+	ent.Schema
+}
+
+func (CertifyGood) Mixin() []ent.Mixin {
+	return []ent.Mixin{certificationMixin{table: "certify_goods"}}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CertifyLegal holds the schema definition for the license information of
+// package versions and sources. Exactly one of package_id and source_id is
+// set. Every distinct scan is a separate row.
+//
+// The licenses and the attribution can be long, so the unique indexes use
+// their digest stored in legal_hash.
+type CertifyLegal struct {
+	ent.Schema
+}
+
+func (CertifyLegal) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "certify_legals"},
+	}
+}
+
+func (CertifyLegal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id").Optional().Nillable(),
+		field.Int("source_id").Optional().Nillable(),
+		field.Text("declared_license"),
+		field.Text("discovered_license"),
+		field.Text("attribution"),
+		field.String("legal_hash"),
+		field.String("justification"),
+		field.Time("time_scanned"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (CertifyLegal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique(),
+		edge.To("source", SourceName.Type).Field("source_id").Unique(),
+	}
+}
+
+func (CertifyLegal) Indexes() []ent.Index {
+	return subjectIndexes("certify_legals", []string{"package_id", "source_id"},
+		"legal_hash", "justification", "time_scanned", "origin", "collector")
+}
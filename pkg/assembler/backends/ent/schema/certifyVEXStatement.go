@@ -0,0 +1,70 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// CertifyVEXStatement holds the schema definition for the VEX statements
+// about the vulnerabilities of package versions and artifacts. Exactly one of
+// package_id and artifact_id is set. Every distinct statement is a separate
+// row, so the history of the statuses of a subject is kept.
+//
+// The statement can be long, so the unique indexes use its digest stored in
+// statement_hash.
+type CertifyVEXStatement struct {
+	ent.Schema
+}
+
+func (CertifyVEXStatement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "certify_vex_statements"},
+	}
+}
+
+func (CertifyVEXStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id").Optional().Nillable(),
+		field.Int("artifact_id").Optional().Nillable(),
+		field.Int("vulnerability_id"),
+		field.String("status").GoType(model.VexStatus("")),
+		field.String("vex_justification").GoType(model.VexJustification("")),
+		field.Text("statement"),
+		field.String("statement_hash"),
+		field.Time("known_since"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (CertifyVEXStatement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique(),
+		edge.To("vulnerability", VulnerabilityID.Type).Field("vulnerability_id").Unique().Required(),
+	}
+}
+
+func (CertifyVEXStatement) Indexes() []ent.Index {
+	return subjectIndexes("certify_vex_statements", []string{"package_id", "artifact_id"},
+		"vulnerability_id", "status", "vex_justification", "statement_hash", "known_since", "origin", "collector")
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CertifyVuln holds the schema definition for the vulnerabilities found by
+// scans of package versions. Every distinct scan is a separate row.
+type CertifyVuln struct {
+	ent.Schema
+}
+
+func (CertifyVuln) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id"),
+		field.Int("vulnerability_id"),
+		field.Time("time_scanned"),
+		field.String("db_uri"),
+		field.String("db_version"),
+		field.String("scanner_uri"),
+		field.String("scanner_version"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (CertifyVuln) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique().Required(),
+		edge.To("vulnerability", VulnerabilityID.Type).Field("vulnerability_id").Unique().Required(),
+	}
+}
+
+func (CertifyVuln) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package_id", "vulnerability_id", "time_scanned", "db_uri", "db_version",
+			"scanner_uri", "scanner_version", "origin", "collector").Unique().StorageKey("certify_vulns_unique"),
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// HasMetadata holds the schema definition for the key/value metadata. Like
+// for the certifications, the subject is exactly one of a package version, a
+// package name (for metadata applying to all the versions), a source or an
+// artifact. The rows are unique on the subject, key, value and justification.
+type HasMetadata struct {
+	ent.Schema
+}
+
+func (HasMetadata) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "has_metadata"},
+	}
+}
+
+func (HasMetadata) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_version_id").Optional().Nillable(),
+		field.Int("package_name_id").Optional().Nillable(),
+		field.Int("source_id").Optional().Nillable(),
+		field.Int("artifact_id").Optional().Nillable(),
+		field.String("key"),
+		field.String("value"),
+		field.Time("since"),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (HasMetadata) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package_version", PackageVersion.Type).Field("package_version_id").Unique(),
+		edge.To("package_name", PackageName.Type).Field("package_name_id").Unique(),
+		edge.To("source", SourceName.Type).Field("source_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique(),
+	}
+}
+
+func (HasMetadata) Indexes() []ent.Index {
+	return subjectIndexes("has_metadata", []string{"package_version_id", "package_name_id", "source_id", "artifact_id"},
+		"key", "value", "justification")
+}
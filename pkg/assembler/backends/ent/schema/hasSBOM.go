@@ -0,0 +1,61 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// HasSBOM holds the schema definition for the SBOMs of package versions and
+// artifacts. Exactly one of package_id and artifact_id is set.
+type HasSBOM struct {
+	ent.Schema
+}
+
+func (HasSBOM) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "has_sboms"},
+	}
+}
+
+func (HasSBOM) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id").Optional().Nillable(),
+		field.Int("artifact_id").Optional().Nillable(),
+		field.String("uri"),
+		field.String("algorithm"),
+		field.String("digest"),
+		field.String("download_location"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (HasSBOM) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique(),
+	}
+}
+
+func (HasSBOM) Indexes() []ent.Index {
+	return subjectIndexes("has_sboms", []string{"package_id", "artifact_id"},
+		"uri", "algorithm", "digest", "download_location", "origin", "collector")
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// HasSLSA holds the schema definition for the SLSA provenance of artifacts.
+// The materials are a many-to-many edge to the artifacts, and predicates are
+// stored sorted by key and value. As neither can be part of a unique index,
+// built_from_hash and predicates_hash hold digests of their sorted values.
+// The build times are not part of the unique index.
+type HasSLSA struct {
+	ent.Schema
+}
+
+func (HasSLSA) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "has_slsas"},
+	}
+}
+
+func (HasSLSA) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("subject_id"),
+		field.Int("built_by_id"),
+		field.String("build_type"),
+		field.JSON("slsa_predicates", []model.SLSAPredicate{}),
+		field.String("slsa_version"),
+		field.Time("started_on").Optional().Nillable(),
+		field.Time("finished_on").Optional().Nillable(),
+		field.String("origin"),
+		field.String("collector"),
+		field.String("built_from_hash"),
+		field.String("predicates_hash"),
+	}
+}
+
+func (HasSLSA) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subject", Artifact.Type).Field("subject_id").Unique().Required(),
+		edge.To("built_by", BuilderNode.Type).Field("built_by_id").Unique().Required(),
+		edge.To("built_from", Artifact.Type),
+	}
+}
+
+func (HasSLSA) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_id", "built_by_id", "build_type", "slsa_version", "origin", "collector",
+			"built_from_hash", "predicates_hash").Unique().StorageKey("has_slsas_unique"),
+	}
+}
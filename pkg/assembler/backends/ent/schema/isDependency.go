@@ -0,0 +1,56 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IsDependency holds the schema definition for the dependencies of package
+// versions on package names.
+type IsDependency struct {
+	ent.Schema
+}
+
+func (IsDependency) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id"),
+		field.Int("dependent_package_id"),
+		field.String("version_range"),
+		field.String("dependency_type").GoType(model.DependencyType("")),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (IsDependency) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique().Required(),
+		edge.To("dependent_package", PackageName.Type).Field("dependent_package_id").Unique().Required(),
+	}
+}
+
+func (IsDependency) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package_id", "dependent_package_id", "version_range", "dependency_type",
+			"justification", "origin", "collector").Unique().StorageKey("is_dependencies_unique"),
+	}
+}
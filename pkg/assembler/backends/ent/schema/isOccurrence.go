@@ -0,0 +1,53 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// IsOccurrence holds the schema definition for the occurrences of package
+// versions and sources as artifacts. Exactly one of package_id and source_id
+// is set. Origin and collector are not part of the unique indexes, so the
+// same occurrence reported by different documents is stored once.
+type IsOccurrence struct {
+	ent.Schema
+}
+
+func (IsOccurrence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id").Optional().Nillable(),
+		field.Int("source_id").Optional().Nillable(),
+		field.Int("artifact_id"),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (IsOccurrence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package", PackageVersion.Type).Field("package_id").Unique(),
+		edge.To("source", SourceName.Type).Field("source_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique().Required(),
+	}
+}
+
+func (IsOccurrence) Indexes() []ent.Index {
+	return subjectIndexes("is_occurrences", []string{"package_id", "source_id"}, "artifact_id", "justification")
+}
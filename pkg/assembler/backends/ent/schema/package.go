@@ -0,0 +1,127 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// The package trie is stored as one table per level, every node pointing to
+// its parent. The unique indexes identify a node among its siblings, like the
+// keys of the in-memory trie.
+
+// PackageType holds the schema definition for the root level of the package
+// trie.
+type PackageType struct {
+	ent.Schema
+}
+
+func (PackageType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").Unique(),
+	}
+}
+
+func (PackageType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("namespaces", PackageNamespace.Type),
+	}
+}
+
+// PackageNamespace holds the schema definition for the namespace level of the
+// package trie. Packages without a namespace have an empty one.
+type PackageNamespace struct {
+	ent.Schema
+}
+
+func (PackageNamespace) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id"),
+		field.String("namespace"),
+	}
+}
+
+func (PackageNamespace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("package", PackageType.Type).Ref("namespaces").Field("package_id").Unique().Required(),
+		edge.To("names", PackageName.Type),
+	}
+}
+
+func (PackageNamespace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package_id", "namespace").Unique().StorageKey("package_namespaces_unique"),
+	}
+}
+
+// PackageName holds the schema definition for the name level of the package
+// trie.
+type PackageName struct {
+	ent.Schema
+}
+
+func (PackageName) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("namespace_id"),
+		field.String("name"),
+	}
+}
+
+func (PackageName) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("namespace", PackageNamespace.Type).Ref("names").Field("namespace_id").Unique().Required(),
+		edge.To("versions", PackageVersion.Type),
+	}
+}
+
+func (PackageName) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace_id", "name").Unique().StorageKey("package_names_unique"),
+	}
+}
+
+// PackageVersion holds the schema definition for the version level of the
+// package trie. Qualifiers are compared regardless of their order, through
+// qualifiers_key which holds them sorted.
+type PackageVersion struct {
+	ent.Schema
+}
+
+func (PackageVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("name_id"),
+		field.String("version").Default(""),
+		field.String("subpath").Default(""),
+		field.JSON("qualifiers", []model.PackageQualifier{}),
+		field.String("qualifiers_key").Default(""),
+	}
+}
+
+func (PackageVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("name", PackageName.Type).Ref("versions").Field("name_id").Unique().Required(),
+	}
+}
+
+func (PackageVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name_id", "version", "subpath", "qualifiers_key").Unique().StorageKey("package_versions_unique"),
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PkgEqual holds the schema definition for the equalities between two package
+// versions. Like for HashEqual, the packages are stored ordered by ID.
+type PkgEqual struct {
+	ent.Schema
+}
+
+func (PkgEqual) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_a_id"),
+		field.Int("package_b_id"),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (PkgEqual) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package_a", PackageVersion.Type).Field("package_a_id").Unique().Required(),
+		edge.To("package_b", PackageVersion.Type).Field("package_b_id").Unique().Required(),
+	}
+}
+
+func (PkgEqual) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package_a_id", "package_b_id", "justification", "origin", "collector").Unique().StorageKey("pkg_equals_unique"),
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PointOfContact holds the schema definition for the points of contact. Like
+// for the certifications, the subject is exactly one of a package version, a
+// package name (for contacts applying to all the versions), a source or an
+// artifact.
+type PointOfContact struct {
+	ent.Schema
+}
+
+func (PointOfContact) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "point_of_contacts"},
+	}
+}
+
+func (PointOfContact) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_version_id").Optional().Nillable(),
+		field.Int("package_name_id").Optional().Nillable(),
+		field.Int("source_id").Optional().Nillable(),
+		field.Int("artifact_id").Optional().Nillable(),
+		field.String("email"),
+		field.String("info"),
+		field.Time("since"),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (PointOfContact) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("package_version", PackageVersion.Type).Field("package_version_id").Unique(),
+		edge.To("package_name", PackageName.Type).Field("package_name_id").Unique(),
+		edge.To("source", SourceName.Type).Field("source_id").Unique(),
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique(),
+	}
+}
+
+func (PointOfContact) Indexes() []ent.Index {
+	return subjectIndexes("point_of_contacts", []string{"package_version_id", "package_name_id", "source_id", "artifact_id"},
+		"email", "info", "since", "justification", "origin", "collector")
+}
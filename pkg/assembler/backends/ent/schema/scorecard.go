@@ -0,0 +1,56 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Scorecard holds the schema definition for the results of Scorecard runs.
+// Runs are identified by the source, the commit scanned and the time of the
+// scan. Checks are stored sorted by name.
+type Scorecard struct {
+	ent.Schema
+}
+
+func (Scorecard) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("source_id"),
+		field.JSON("checks", []model.ScorecardCheck{}),
+		field.Float("aggregate_score"),
+		field.Time("time_scanned"),
+		field.String("scorecard_version"),
+		field.String("commit"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (Scorecard) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("source", SourceName.Type).Field("source_id").Unique().Required(),
+	}
+}
+
+func (Scorecard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_id", "commit", "time_scanned").Unique().StorageKey("scorecards_unique"),
+	}
+}
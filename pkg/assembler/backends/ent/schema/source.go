@@ -0,0 +1,98 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// The source trie is stored like the package trie. Tag and commit are empty
+// if the source is not pinned to them, so that they take part in the unique
+// index of the names.
+
+// SourceType holds the schema definition for the root level of the source
+// trie.
+type SourceType struct {
+	ent.Schema
+}
+
+func (SourceType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").Unique(),
+	}
+}
+
+func (SourceType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("namespaces", SourceNamespace.Type),
+	}
+}
+
+// SourceNamespace holds the schema definition for the namespace level of the
+// source trie.
+type SourceNamespace struct {
+	ent.Schema
+}
+
+func (SourceNamespace) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("source_id"),
+		field.String("namespace"),
+	}
+}
+
+func (SourceNamespace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("source", SourceType.Type).Ref("namespaces").Field("source_id").Unique().Required(),
+		edge.To("names", SourceName.Type),
+	}
+}
+
+func (SourceNamespace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_id", "namespace").Unique().StorageKey("source_namespaces_unique"),
+	}
+}
+
+// SourceName holds the schema definition for the name level of the source
+// trie.
+type SourceName struct {
+	ent.Schema
+}
+
+func (SourceName) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("namespace_id"),
+		field.String("name"),
+		field.String("tag").Default(""),
+		field.String("commit").Default(""),
+	}
+}
+
+func (SourceName) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("namespace", SourceNamespace.Type).Ref("names").Field("namespace_id").Unique().Required(),
+	}
+}
+
+func (SourceName) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace_id", "name", "tag", "commit").Unique().StorageKey("source_names_unique"),
+	}
+}
@@ -0,0 +1,53 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VulnEqual holds the schema definition for the equalities between two
+// vulnerability IDs. Like HashEqual, the vulnerabilities are stored ordered by
+// ID, vulnerability_a_id being the lowest one.
+type VulnEqual struct {
+	ent.Schema
+}
+
+func (VulnEqual) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vulnerability_a_id"),
+		field.Int("vulnerability_b_id"),
+		field.String("justification"),
+		field.String("origin"),
+		field.String("collector"),
+	}
+}
+
+func (VulnEqual) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vulnerability_a", VulnerabilityID.Type).Field("vulnerability_a_id").Unique().Required(),
+		edge.To("vulnerability_b", VulnerabilityID.Type).Field("vulnerability_b_id").Unique().Required(),
+	}
+}
+
+func (VulnEqual) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vulnerability_a_id", "vulnerability_b_id", "justification", "origin", "collector").Unique().StorageKey("vuln_equals_unique"),
+	}
+}
@@ -0,0 +1,66 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VulnerabilityType holds the schema definition for the root level of the
+// vulnerability trie. Types are stored in lowercase.
+type VulnerabilityType struct {
+	ent.Schema
+}
+
+func (VulnerabilityType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").Unique(),
+	}
+}
+
+func (VulnerabilityType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vulnerability_ids", VulnerabilityID.Type),
+	}
+}
+
+// VulnerabilityID holds the schema definition for the vulnerability IDs of a
+// type, stored in lowercase.
+type VulnerabilityID struct {
+	ent.Schema
+}
+
+func (VulnerabilityID) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("type_id"),
+		field.String("vulnerability_id"),
+	}
+}
+
+func (VulnerabilityID) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("type", VulnerabilityType.Type).Ref("vulnerability_ids").Field("type_id").Unique().Required(),
+	}
+}
+
+func (VulnerabilityID) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type_id", "vulnerability_id").Unique().StorageKey("vulnerability_ids_unique"),
+	}
+}
@@ -0,0 +1,296 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcenamespace"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/sourcetype"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest Source
+
+func (c *entClient) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, backends.Errorf("IngestSource :: %w", backends.Missing("source"))
+	}
+	if err := backends.ValidateSourceInput(source); err != nil {
+		return nil, backends.Errorf("IngestSource :: %w", err)
+	}
+
+	n, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.SourceName, error) {
+		id, err := ingestSource(ctx, tx.Client(), source)
+		if err != nil {
+			return nil, err
+		}
+		return tx.SourceName.Query().
+			Where(sourcename.ID(id)).
+			WithNamespace(withSourceNamespacePath).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestSource", err)
+	}
+	return nameToSource(n), nil
+}
+
+// IngestSources ingests all the sources in a single transaction, like
+// IngestPackages.
+func (c *entClient) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for i, source := range sources {
+		if source == nil {
+			return nil, backends.Errorf("IngestSources :: %w", backends.NewValidationError("sources", "missing source at index %d", i))
+		}
+		if err := backends.ValidateSourceInput(source); err != nil {
+			return nil, backends.Errorf("IngestSources :: source at index %d: %w", i, err)
+		}
+	}
+
+	out, err := withTx(ctx, c.client, func(tx *db.Tx) ([]*model.Source, error) {
+		trie := trieIDs{}
+		ids := make([]int, 0, len(sources))
+		for _, source := range sources {
+			id, err := trie.ingestSource(ctx, tx.Client(), source)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		names, err := tx.SourceName.Query().
+			Where(sourcename.IDIn(ids...)).
+			WithNamespace(withSourceNamespacePath).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[int]*db.SourceName, len(names))
+		for _, n := range names {
+			byID[n.ID] = n
+		}
+		out := make([]*model.Source, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, nameToSource(byID[id]))
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestSources", err)
+	}
+	return out, nil
+}
+
+// ingestSource adds the source to the trie, creating only the missing rows,
+// and returns the ID of the name. The source must have been validated by
+// validateSourceInput.
+func ingestSource(ctx context.Context, client *db.Client, source *model.SourceInputSpec) (int, error) {
+	return trieIDs(nil).ingestSource(ctx, client, source)
+}
+
+// ingestSource is like the ingestSource function, skipping the rows already
+// ingested through t.
+func (t trieIDs) ingestSource(ctx context.Context, client *db.Client, source *model.SourceInputSpec) (int, error) {
+	source = backends.CanonicalSourceInputSpec(source)
+	typeID, err := t.id("srcType", 0, source.Type, func() (int, error) {
+		return client.SourceType.Create().
+			SetType(source.Type).
+			OnConflict(sql.ConflictColumns(sourcetype.FieldType)).
+			Ignore().
+			ID(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	namespaceID, err := t.id("srcNamespace", typeID, source.Namespace, func() (int, error) {
+		return client.SourceNamespace.Create().
+			SetSourceID(typeID).
+			SetNamespace(source.Namespace).
+			OnConflict(sql.ConflictColumns(sourcenamespace.FieldSourceID, sourcenamespace.FieldNamespace)).
+			Ignore().
+			ID(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	tag := derefOrEmpty(source.Tag)
+	commit := derefOrEmpty(source.Commit)
+	return t.id("srcName", namespaceID, source.Name+"\x00"+tag+"\x00"+commit, func() (int, error) {
+		return client.SourceName.Create().
+			SetNamespaceID(namespaceID).
+			SetName(source.Name).
+			SetTag(tag).
+			SetCommit(commit).
+			OnConflict(sql.ConflictColumns(sourcename.FieldNamespaceID, sourcename.FieldName,
+				sourcename.FieldTag, sourcename.FieldCommit)).
+			Ignore().
+			ID(ctx)
+	})
+}
+
+// Query Sources
+
+// Sources returns the source trie pruned to the spec, like Packages.
+func (c *entClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if sourceSpec == nil {
+		sourceSpec = &model.SourceSpec{}
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" || *sourceSpec.Tag != "" {
+			return nil, backends.Errorf("%w", backends.NewValidationError("commit", "Passing both commit and tag selectors is an error"))
+		}
+	}
+
+	nameFilters := sourceNameFilters(sourceSpec)
+	var namespaceFilters []predicate.SourceNamespace
+	if sourceSpec.Namespace != nil {
+		namespaceFilters = append(namespaceFilters, sourcenamespace.Namespace(*sourceSpec.Namespace))
+	}
+	if filtersSourceName(sourceSpec) {
+		namespaceFilters = append(namespaceFilters, sourcenamespace.HasNamesWith(nameFilters...))
+	}
+	var typeFilters []predicate.SourceType
+	if sourceSpec.Type != nil {
+		typeFilters = append(typeFilters, sourcetype.Type(*sourceSpec.Type))
+	}
+	if sourceSpec.Namespace != nil || filtersSourceName(sourceSpec) {
+		typeFilters = append(typeFilters, sourcetype.HasNamespacesWith(namespaceFilters...))
+	}
+
+	types, err := c.client.SourceType.Query().
+		Where(typeFilters...).
+		Order(db.Asc(sourcetype.FieldID)).
+		WithNamespaces(func(q *db.SourceNamespaceQuery) {
+			q.Where(namespaceFilters...).
+				Order(db.Asc(sourcenamespace.FieldID)).
+				WithNames(func(q *db.SourceNameQuery) {
+					q.Where(nameFilters...).Order(db.Asc(sourcename.FieldID))
+				})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "Sources", err)
+	}
+
+	out := make([]*model.Source, 0, len(types))
+	for _, t := range types {
+		src := &model.Source{ID: nodeID(t.ID), Type: t.Type}
+		for _, ns := range t.Edges.Namespaces {
+			namespace := &model.SourceNamespace{ID: nodeID(ns.ID), Namespace: ns.Namespace}
+			for _, n := range ns.Edges.Names {
+				namespace.Names = append(namespace.Names, toModelSourceName(n))
+			}
+			src.Namespaces = append(src.Namespaces, namespace)
+		}
+		out = append(out, src)
+	}
+	return out, nil
+}
+
+// sourceNameFilters returns the predicates matching the name level of the
+// trie against the spec.
+func sourceNameFilters(sourceSpec *model.SourceSpec) []predicate.SourceName {
+	var filters []predicate.SourceName
+	if sourceSpec.Name != nil {
+		filters = append(filters, sourcename.Name(*sourceSpec.Name))
+	}
+	if sourceSpec.Tag != nil {
+		filters = append(filters, sourcename.Tag(*sourceSpec.Tag))
+	}
+	if sourceSpec.Commit != nil {
+		filters = append(filters, sourcename.Commit(*sourceSpec.Commit))
+	}
+	return filters
+}
+
+// filtersSourceName returns true if the spec filters on the name level of the
+// trie.
+func filtersSourceName(sourceSpec *model.SourceSpec) bool {
+	return sourceSpec.Name != nil || sourceSpec.Tag != nil || sourceSpec.Commit != nil
+}
+
+// sourceNameMatches returns the predicates matching the names whose path
+// matches the canonicalized spec, for the evidence trees pointing to sources.
+func sourceNameMatches(sourceSpec *model.SourceSpec) []predicate.SourceName {
+	if sourceSpec == nil {
+		return nil
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	filters := sourceNameFilters(sourceSpec)
+	var namespaceFilters []predicate.SourceNamespace
+	if sourceSpec.Namespace != nil {
+		namespaceFilters = append(namespaceFilters, sourcenamespace.Namespace(*sourceSpec.Namespace))
+	}
+	if sourceSpec.Type != nil {
+		namespaceFilters = append(namespaceFilters, sourcenamespace.HasSourceWith(sourcetype.Type(*sourceSpec.Type)))
+	}
+	if len(namespaceFilters) > 0 {
+		filters = append(filters, sourcename.HasNamespaceWith(namespaceFilters...))
+	}
+	return filters
+}
+
+// withSourceNamespacePath loads the type of the namespaces returned by the
+// query.
+func withSourceNamespacePath(q *db.SourceNamespaceQuery) {
+	q.WithSource()
+}
+
+// withSourceNamePath loads the path from the root of the trie of the names
+// returned by the query.
+func withSourceNamePath(q *db.SourceNameQuery) {
+	q.WithNamespace(withSourceNamespacePath)
+}
+
+func toModelSourceName(n *db.SourceName) *model.SourceName {
+	return &model.SourceName{
+		ID:     nodeID(n.ID),
+		Name:   n.Name,
+		Tag:    nilIfEmpty(n.Tag),
+		Commit: nilIfEmpty(n.Commit),
+	}
+}
+
+// nameToSource returns the path from the root of the trie down to a name
+// loaded with withSourceNamePath.
+func nameToSource(n *db.SourceName) *model.Source {
+	ns := n.Edges.Namespace
+	t := ns.Edges.Source
+	return &model.Source{
+		ID:   nodeID(t.ID),
+		Type: t.Type,
+		Namespaces: []*model.SourceNamespace{{
+			ID:        nodeID(ns.ID),
+			Namespace: ns.Namespace,
+			Names:     []*model.SourceName{toModelSourceName(n)},
+		}},
+	}
+}
@@ -0,0 +1,105 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// subjectIDs holds the subject of a certification which can apply to a
+// package, a source or an artifact. Exactly one of the fields is set: a
+// package subject is either a version or, for certifications applying to all
+// the versions of the package, a name.
+type subjectIDs struct {
+	pkgVersion *int
+	pkgName    *int
+	src        *int
+	artifact   *int
+}
+
+// column returns the column of the certification tables holding the subject,
+// which is also the column of the unique index applying to it.
+func (s subjectIDs) column() string {
+	switch {
+	case s.pkgVersion != nil:
+		return "package_version_id"
+	case s.pkgName != nil:
+		return "package_name_id"
+	case s.src != nil:
+		return "source_id"
+	default:
+		return "artifact_id"
+	}
+}
+
+// ingestSubject adds the subject of a certification, creating only the
+// missing rows. For package subjects, pkgMatchType selects the level of the
+// package trie, defaulting to the version. The subject must have been
+// validated by validateSubject.
+func ingestSubject(ctx context.Context, client *db.Client, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType) (subjectIDs, error) {
+	var id int
+	var err error
+	switch {
+	case subject.Package != nil:
+		if pkgMatchType != nil && *pkgMatchType == model.PkgMatchTypeAllVersions {
+			id, err = ingestPackageName(ctx, client, subject.Package)
+			return subjectIDs{pkgName: &id}, err
+		}
+		id, err = ingestPackage(ctx, client, subject.Package)
+		return subjectIDs{pkgVersion: &id}, err
+	case subject.Source != nil:
+		id, err = ingestSource(ctx, client, subject.Source)
+		return subjectIDs{src: &id}, err
+	default:
+		id, err = ingestArtifactInput(ctx, client, subject.Artifact)
+		return subjectIDs{artifact: &id}, err
+	}
+}
+
+// validateSubject checks that exactly one subject is set in the input, as
+// backends.ValidateSubjectSpec does, and that it is valid.
+func validateSubject(subject *model.PackageSourceOrArtifactInput) error {
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return err
+	}
+	if subject.Source != nil {
+		return backends.ValidateSourceInput(subject.Source)
+	}
+	if subject.Artifact != nil {
+		_, _, err := backends.CanonicalArtifact(subject.Artifact)
+		return err
+	}
+	return nil
+}
+
+// subjectToModel converts the loaded subject edge of a certification, only
+// one of which is set, to the model.
+func subjectToModel(pkgVersion *db.PackageVersion, pkgName *db.PackageName, src *db.SourceName, artifact *db.Artifact) model.PackageSourceOrArtifact {
+	switch {
+	case pkgVersion != nil:
+		return versionToPackage(pkgVersion)
+	case pkgName != nil:
+		return nameToPackage(pkgName)
+	case src != nil:
+		return nameToSource(src)
+	default:
+		return toModelArtifact(artifact)
+	}
+}
@@ -0,0 +1,182 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest VulnEqual
+
+// IngestVulnEqual returns the vulnerabilities in the order of the arguments,
+// even if the equality was first ingested with them swapped.
+func (c *entClient) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnerability"))
+	}
+	if otherVulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("otherVulnerability"))
+	}
+	if vulnEqual == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnEqual"))
+	}
+	vulnType, vulnID, err := canonicalVulnerability(vulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", err)
+	}
+	otherType, otherID, err := canonicalVulnerability(otherVulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", err)
+	}
+	if vulnType == otherType && vulnID == otherID {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.NewValidationError("otherVulnerability", "a vulnerability cannot be certified equal to itself"))
+	}
+
+	e, err := withTx(ctx, c.client, func(tx *db.Tx) (*model.VulnEqual, error) {
+		a, err := ingestVulnerability(ctx, tx.Client(), vulnType, vulnID)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ingestVulnerability(ctx, tx.Client(), otherType, otherID)
+		if err != nil {
+			return nil, err
+		}
+		first, second := a, b
+		if second < first {
+			first, second = second, first
+		}
+		id, err := tx.VulnEqual.Create().
+			SetVulnerabilityAID(first).
+			SetVulnerabilityBID(second).
+			SetJustification(vulnEqual.Justification).
+			SetOrigin(vulnEqual.Origin).
+			SetCollector(vulnEqual.Collector).
+			OnConflict(sql.ConflictColumns(vulnequal.FieldVulnerabilityAID, vulnequal.FieldVulnerabilityBID,
+				vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector)).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		e, err := tx.VulnEqual.Query().
+			Where(vulnequal.ID(id)).
+			WithVulnerabilityA(func(q *db.VulnerabilityIDQuery) { q.WithType() }).
+			WithVulnerabilityB(func(q *db.VulnerabilityIDQuery) { q.WithType() }).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := toModelVulnEqual(e)
+		if a != first {
+			out.Vulnerabilities[0], out.Vulnerabilities[1] = out.Vulnerabilities[1], out.Vulnerabilities[0]
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestVulnEqual", err)
+	}
+	return e, nil
+}
+
+// Query VulnEqual
+
+func (c *entClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vulnEqualSpec == nil {
+		vulnEqualSpec = &model.VulnEqualSpec{}
+	}
+	if len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, backends.Errorf("VulnEqual :: %w", backends.NewValidationError("vulnerabilities", "cannot filter on more than 2 vulnerabilities"))
+	}
+
+	var filters []predicate.VulnEqual
+	if vulnEqualSpec.ID != nil {
+		id, err := parseID(*vulnEqualSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("VulnEqual :: %w", err)
+		}
+		filters = append(filters, vulnequal.ID(id))
+	}
+	if vulnEqualSpec.Justification != nil {
+		filters = append(filters, vulnequal.Justification(*vulnEqualSpec.Justification))
+	}
+	if vulnEqualSpec.Origin != nil {
+		filters = append(filters, vulnequal.Origin(*vulnEqualSpec.Origin))
+	}
+	if vulnEqualSpec.Collector != nil {
+		filters = append(filters, vulnequal.Collector(*vulnEqualSpec.Collector))
+	}
+	// Every vulnerability filter must match a different vulnerability, in
+	// any order.
+	var vulnFilters [][]predicate.VulnerabilityID
+	for _, spec := range vulnEqualSpec.Vulnerabilities {
+		f, err := vulnerabilityIDMatches(spec)
+		if err != nil {
+			return nil, backends.Errorf("VulnEqual :: %w", err)
+		}
+		vulnFilters = append(vulnFilters, f)
+	}
+	switch len(vulnFilters) {
+	case 1:
+		filters = append(filters, vulnequal.Or(
+			vulnequal.HasVulnerabilityAWith(vulnFilters[0]...),
+			vulnequal.HasVulnerabilityBWith(vulnFilters[0]...),
+		))
+	case 2:
+		filters = append(filters, vulnequal.Or(
+			vulnequal.And(vulnequal.HasVulnerabilityAWith(vulnFilters[0]...), vulnequal.HasVulnerabilityBWith(vulnFilters[1]...)),
+			vulnequal.And(vulnequal.HasVulnerabilityAWith(vulnFilters[1]...), vulnequal.HasVulnerabilityBWith(vulnFilters[0]...)),
+		))
+	}
+
+	equalities, err := c.client.VulnEqual.Query().
+		Where(filters...).
+		WithVulnerabilityA(func(q *db.VulnerabilityIDQuery) { q.WithType() }).
+		WithVulnerabilityB(func(q *db.VulnerabilityIDQuery) { q.WithType() }).
+		Order(db.Asc(vulnequal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "VulnEqual", err)
+	}
+
+	out := make([]*model.VulnEqual, 0, len(equalities))
+	for _, e := range equalities {
+		out = append(out, toModelVulnEqual(e))
+	}
+	return out, nil
+}
+
+func toModelVulnEqual(e *db.VulnEqual) *model.VulnEqual {
+	return &model.VulnEqual{
+		ID:              nodeID(e.ID),
+		Vulnerabilities: []*model.Vulnerability{idToVulnerability(e.Edges.VulnerabilityA), idToVulnerability(e.Edges.VulnerabilityB)},
+		Justification:   e.Justification,
+		Origin:          e.Origin,
+		Collector:       e.Collector,
+	}
+}
@@ -0,0 +1,180 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/db/vulnerabilitytype"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest Vulnerability
+
+func (c *entClient) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vuln == nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", backends.Missing("vuln"))
+	}
+	vulnType, vulnID, err := canonicalVulnerability(vuln)
+	if err != nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", err)
+	}
+
+	v, err := withTx(ctx, c.client, func(tx *db.Tx) (*db.VulnerabilityID, error) {
+		id, err := ingestVulnerability(ctx, tx.Client(), vulnType, vulnID)
+		if err != nil {
+			return nil, err
+		}
+		return tx.VulnerabilityID.Query().
+			Where(vulnerabilityid.ID(id)).
+			WithType().
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, queryError(ctx, "IngestVulnerability", err)
+	}
+	return idToVulnerability(v), nil
+}
+
+// canonicalVulnerability returns the lowercase type and vulnerability ID of
+// the input, validating that they are not empty.
+func canonicalVulnerability(vuln *model.VulnerabilityInputSpec) (string, string, error) {
+	vulnType := strings.ToLower(strings.TrimSpace(vuln.Type))
+	vulnID := strings.ToLower(strings.TrimSpace(vuln.VulnerabilityID))
+	if vulnType == "" {
+		return "", "", backends.NewValidationError("type", "type must not be empty")
+	}
+	if vulnID == "" {
+		return "", "", backends.NewValidationError("vulnerabilityID", "vulnerability ID must not be empty")
+	}
+	return vulnType, vulnID, nil
+}
+
+// ingestVulnerability adds the canonical vulnerability to the trie, creating
+// only the missing rows, and returns the ID of the vulnerability ID row.
+func ingestVulnerability(ctx context.Context, client *db.Client, vulnType, vulnID string) (int, error) {
+	typeID, err := client.VulnerabilityType.Create().
+		SetType(vulnType).
+		OnConflict(sql.ConflictColumns(vulnerabilitytype.FieldType)).
+		Ignore().
+		ID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return client.VulnerabilityID.Create().
+		SetTypeID(typeID).
+		SetVulnerabilityID(vulnID).
+		OnConflict(sql.ConflictColumns(vulnerabilityid.FieldTypeID, vulnerabilityid.FieldVulnerabilityID)).
+		Ignore().
+		ID(ctx)
+}
+
+// Query Vulnerabilities
+
+func (c *entClient) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vulnSpec == nil {
+		vulnSpec = &model.VulnerabilitySpec{}
+	}
+
+	var idFilters []predicate.VulnerabilityID
+	if vulnSpec.ID != nil {
+		id, err := parseID(*vulnSpec.ID)
+		if err != nil {
+			return nil, backends.Errorf("Vulnerabilities :: %w", err)
+		}
+		idFilters = append(idFilters, vulnerabilityid.ID(id))
+	}
+	if vulnSpec.VulnerabilityID != nil {
+		idFilters = append(idFilters, vulnerabilityid.VulnerabilityID(*backends.LowerIfSet(vulnSpec.VulnerabilityID)))
+	}
+	// Types without any matching vulnerability ID are left out.
+	typeFilters := []predicate.VulnerabilityType{vulnerabilitytype.HasVulnerabilityIdsWith(idFilters...)}
+	if vulnSpec.Type != nil {
+		typeFilters = append(typeFilters, vulnerabilitytype.Type(*backends.LowerIfSet(vulnSpec.Type)))
+	}
+
+	types, err := c.client.VulnerabilityType.Query().
+		Where(typeFilters...).
+		Order(db.Asc(vulnerabilitytype.FieldID)).
+		WithVulnerabilityIds(func(q *db.VulnerabilityIDQuery) {
+			q.Where(idFilters...).Order(db.Asc(vulnerabilityid.FieldID))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, queryError(ctx, "Vulnerabilities", err)
+	}
+
+	out := make([]*model.Vulnerability, 0, len(types))
+	for _, t := range types {
+		v := &model.Vulnerability{ID: nodeID(t.ID), Type: t.Type}
+		for _, id := range t.Edges.VulnerabilityIds {
+			v.VulnerabilityIDs = append(v.VulnerabilityIDs, toModelVulnerabilityID(id))
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// vulnerabilityIDMatches returns the predicates matching the vulnerability
+// IDs whose path matches the spec, for the evidence trees pointing to
+// vulnerabilities.
+func vulnerabilityIDMatches(vulnSpec *model.VulnerabilitySpec) ([]predicate.VulnerabilityID, error) {
+	if vulnSpec == nil {
+		return nil, nil
+	}
+	var filters []predicate.VulnerabilityID
+	if vulnSpec.ID != nil {
+		id, err := parseID(*vulnSpec.ID)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, vulnerabilityid.ID(id))
+	}
+	if vulnSpec.VulnerabilityID != nil {
+		filters = append(filters, vulnerabilityid.VulnerabilityID(*backends.LowerIfSet(vulnSpec.VulnerabilityID)))
+	}
+	if vulnSpec.Type != nil {
+		filters = append(filters, vulnerabilityid.HasTypeWith(vulnerabilitytype.Type(*backends.LowerIfSet(vulnSpec.Type))))
+	}
+	return filters, nil
+}
+
+func toModelVulnerabilityID(v *db.VulnerabilityID) *model.VulnerabilityID {
+	return &model.VulnerabilityID{ID: nodeID(v.ID), VulnerabilityID: v.VulnerabilityID}
+}
+
+// idToVulnerability returns the path from the root of the trie down to a
+// vulnerability ID loaded with its type.
+func idToVulnerability(v *db.VulnerabilityID) *model.Vulnerability {
+	t := v.Edges.Type
+	return &model.Vulnerability{
+		ID:               nodeID(t.ID),
+		Type:             t.Type,
+		VulnerabilityIDs: []*model.VulnerabilityID{toModelVulnerabilityID(v)},
+	}
+}
@@ -0,0 +1,95 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The errors returned by the backends wrap the values below, so that callers
+// can tell invalid arguments, conflicts and transient database errors apart
+// with errors.Is and errors.As.
+var (
+	// ErrNotFound is wrapped by the errors about nodes which do not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrDuplicate is wrapped by the errors about nodes which conflict with
+	// existing ones.
+	ErrDuplicate = errors.New("duplicate")
+	// ErrTransient is wrapped by the errors which may not happen again if
+	// the call is retried, such as deadlocks or lost connections.
+	ErrTransient = errors.New("transient error")
+)
+
+// ValidationError is returned when an argument of a query or an ingestion is
+// invalid or missing.
+type ValidationError struct {
+	// Field is the name of the offending field, as named in the GraphQL
+	// schema.
+	Field string
+	Err   error
+}
+
+// NewValidationError returns the ValidationError of field, formatting the
+// error as fmt.Errorf does.
+func NewValidationError(field, format string, a ...interface{}) *ValidationError {
+	return &ValidationError{Field: field, Err: fmt.Errorf(format, a...)}
+}
+
+// Missing returns the ValidationError reporting that field is not set.
+func Missing(field string) *ValidationError {
+	return NewValidationError(field, "missing %s", field)
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Classify returns err classified as kind, one of the errors above: the
+// returned error matches kind with errors.Is, and wraps err, so that the
+// errors of the database are still found by errors.As.
+func Classify(kind, err error) error {
+	return &classifiedError{kind: kind, err: err}
+}
+
+type classifiedError struct {
+	kind error
+	err  error
+}
+
+func (e *classifiedError) Error() string {
+	return e.kind.Error() + ": " + e.err.Error()
+}
+
+func (e *classifiedError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *classifiedError) Unwrap() error {
+	return e.err
+}
+
+// Errorf is like gqlerror.Errorf, but the returned error wraps the operands
+// of the %w verbs, as for fmt.Errorf.
+func Errorf(format string, a ...interface{}) *gqlerror.Error {
+	return gqlerror.WrapPath(nil, fmt.Errorf(format, a...))
+}
@@ -0,0 +1,48 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type dbError struct {
+	code string
+}
+
+func (e *dbError) Error() string {
+	return "database error " + e.code
+}
+
+func TestClassify(t *testing.T) {
+	dbErr := &dbError{code: "40001"}
+	err := Classify(ErrTransient, fmt.Errorf("IngestPackage :: %w", dbErr))
+	if !errors.Is(err, ErrTransient) {
+		t.Errorf("errors.Is(%v, ErrTransient) = false, want true", err)
+	}
+	if errors.Is(err, ErrDuplicate) {
+		t.Errorf("errors.Is(%v, ErrDuplicate) = true, want false", err)
+	}
+	var got *dbError
+	if !errors.As(err, &got) || got != dbErr {
+		t.Errorf("errors.As(%v) = %v, want the database error", err, got)
+	}
+	if want := "transient error: IngestPackage :: database error 40001"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
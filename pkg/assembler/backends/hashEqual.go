@@ -0,0 +1,87 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ExpandHashEqual returns the HashEqual matching hashEqualSpec, followed by
+// every HashEqual transitively linked to their artifacts which matches the
+// justification, origin and collector filters of the spec, each one once.
+// Backends implement the expandTransitive filter of HashEqualSpec with it.
+func ExpandHashEqual(ctx context.Context, b Backend, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	direct := model.HashEqualSpec{}
+	if hashEqualSpec != nil {
+		direct = *hashEqualSpec
+	}
+	direct.ExpandTransitive = nil
+	equalities, err := b.HashEqual(ctx, &direct)
+	if err != nil {
+		return nil, err
+	}
+	return expandEqualities(equalities,
+		func(e *model.HashEqual) string { return e.ID },
+		func(e *model.HashEqual) []*model.Artifact { return e.Artifacts },
+		func(a *model.Artifact) string { return a.ID },
+		func(a *model.Artifact) ([]*model.HashEqual, error) {
+			return b.HashEqual(ctx, &model.HashEqualSpec{
+				Artifacts:     []*model.ArtifactSpec{{ID: &a.ID}},
+				Justification: direct.Justification,
+				Origin:        direct.Origin,
+				Collector:     direct.Collector,
+			})
+		})
+}
+
+// expandEqualities walks breadth first from the equalities to the nodes they
+// link, and from the nodes to the equalities returned by adjacent, visiting
+// every equality and node once so that cycles end the walk. The equalities
+// are returned in the order in which they are found.
+func expandEqualities[E any, N any](equalities []E, equalityID func(E) string, nodes func(E) []N, nodeID func(N) string, adjacent func(N) ([]E, error)) ([]E, error) {
+	var out []E
+	seen := map[string]bool{}
+	visited := map[string]bool{}
+	var frontier []N
+	visit := func(equalities []E) {
+		for _, e := range equalities {
+			if seen[equalityID(e)] {
+				continue
+			}
+			seen[equalityID(e)] = true
+			out = append(out, e)
+			for _, n := range nodes(e) {
+				if !visited[nodeID(n)] {
+					visited[nodeID(n)] = true
+					frontier = append(frontier, n)
+				}
+			}
+		}
+	}
+	visit(equalities)
+	for len(frontier) > 0 {
+		n := frontier[0]
+		frontier = frontier[1:]
+		found, err := adjacent(n)
+		if err != nil {
+			return nil, err
+		}
+		visit(found)
+	}
+	return out, nil
+}
@@ -0,0 +1,187 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// artifactNode is an artifact, deduplicated on algorithm and digest.
+type artifactNode struct {
+	id        string
+	algorithm string
+	digest    string
+}
+
+func (a *artifactNode) toModel() *model.Artifact {
+	return &model.Artifact{
+		ID:        a.id,
+		Algorithm: a.algorithm,
+		Digest:    a.digest,
+	}
+}
+
+// Ingest Artifact
+
+func (c *inmemClient) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", backends.Missing("artifact"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a, err := c.ingestArtifact(artifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", err)
+	}
+	return a.toModel(), nil
+}
+
+func (c *inmemClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Validate the whole batch first, so that nothing is ingested if any
+	// artifact is invalid.
+	canonical := make([]artifactNode, 0, len(artifacts))
+	for i, artifact := range artifacts {
+		if artifact == nil {
+			return nil, backends.Errorf("IngestArtifacts :: %w", backends.NewValidationError("artifacts", "missing artifact at index %d", i))
+		}
+		algorithm, digest, err := backends.CanonicalArtifact(artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestArtifacts :: artifact at index %d: %w", i, err)
+		}
+		canonical = append(canonical, artifactNode{algorithm: algorithm, digest: digest})
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	out := make([]*model.Artifact, 0, len(canonical))
+	for _, a := range canonical {
+		out = append(out, c.addArtifact(a.algorithm, a.digest).toModel())
+	}
+	return out, nil
+}
+
+// ingestArtifact returns the artifact node matching the input, creating it if
+// needed. Must be called with the write lock held.
+func (c *inmemClient) ingestArtifact(artifact *model.ArtifactInputSpec) (*artifactNode, error) {
+	algorithm, digest, err := backends.CanonicalArtifact(artifact)
+	if err != nil {
+		return nil, err
+	}
+	return c.addArtifact(algorithm, digest), nil
+}
+
+// addArtifact is like ingestArtifact for an already canonical algorithm and
+// digest. New artifacts are sent to the subscribers.
+func (c *inmemClient) addArtifact(algorithm, digest string) *artifactNode {
+	a := &artifactNode{algorithm: algorithm, digest: digest}
+	if existing, ok := c.artifacts.get(a.key()); ok {
+		return existing
+	}
+	a.id = c.nextID()
+	c.artifacts.add(a.key(), a)
+	c.artifactSubscribers.publish(a)
+	return a
+}
+
+// matches returns true if the artifact matches the canonicalized spec.
+func (a *artifactNode) matches(artifactSpec *model.ArtifactSpec) bool {
+	if artifactSpec == nil {
+		return true
+	}
+	artifactSpec = backends.CanonicalArtifactSpec(artifactSpec)
+	return matchString(artifactSpec.ID, a.id) &&
+		matchString(artifactSpec.Algorithm, a.algorithm) &&
+		matchString(artifactSpec.Digest, a.digest)
+}
+
+func (a *artifactNode) key() string {
+	return a.algorithm + ":" + a.digest
+}
+
+// Query Artifacts
+
+func (c *inmemClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	connection, err := c.ArtifactsList(ctx, artifactSpec, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Artifact, 0, len(connection.Edges))
+	for _, e := range connection.Edges {
+		out = append(out, e.Node)
+	}
+	return out, nil
+}
+
+// ArtifactsList returns the artifacts in the order in which they have been
+// ingested, so new artifacts always come after the existing cursors.
+func (c *inmemClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if first != nil && *first < 0 {
+		return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("first", "first must not be negative"))
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	start := 0
+	if after != nil {
+		key, err := backends.DecodeCursor(*after)
+		if err != nil {
+			return nil, backends.Errorf("ArtifactsList :: %w", err)
+		}
+		i, ok := c.artifacts.indexOf(key)
+		if !ok {
+			return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("after", "invalid cursor %q", *after))
+		}
+		start = i + 1
+	}
+
+	connection := &model.ArtifactConnection{
+		Edges:    []*model.ArtifactEdge{},
+		PageInfo: &model.PageInfo{},
+	}
+	for _, a := range c.artifacts.order[start:] {
+		if !a.matches(artifactSpec) {
+			continue
+		}
+		if first != nil && len(connection.Edges) == *first {
+			connection.PageInfo.HasNextPage = true
+			break
+		}
+		cursor := backends.EncodeCursor(a.key())
+		connection.Edges = append(connection.Edges, &model.ArtifactEdge{Cursor: cursor, Node: a.toModel()})
+		connection.PageInfo.EndCursor = &cursor
+	}
+	return connection, nil
+}
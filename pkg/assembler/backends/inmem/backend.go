@@ -0,0 +1,144 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inmem implements a backend which keeps all the nodes in memory. It
+// is meant for local development and testing, every node is lost when the
+// process exits.
+package inmem
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/contentid"
+)
+
+type inmemClient struct {
+	// lock guards all the fields below. Queries take a read lock, ingestion
+	// takes a write lock. As they hold the lock until done, the methods check
+	// that their context is not done before starting.
+	lock sync.RWMutex
+	// index is used to assign unique IDs to every node
+	index uint64
+
+	artifacts children[*artifactNode]
+	builders  children[*builderNode]
+	packages  children[*pkgTypeNode]
+	sources   children[*srcTypeNode]
+	vulns     children[*vulnTypeNode]
+
+	certifyBads   children[*certifyNode]
+	certifyGoods  children[*certifyNode]
+	certifyLegals children[*certifyLegalNode]
+	certifyVulns  children[*certifyVulnNode]
+	hashEquals    children[*hashEqualNode]
+	hasMetadata   children[*hasMetadataNode]
+	hasSBOMs      children[*hasSBOMNode]
+	hasSLSAs      children[*hasSLSANode]
+	dependencies  children[*isDependencyNode]
+	occurrences   children[*isOccurrenceNode]
+	pkgEquals     children[*pkgEqualNode]
+	contacts      children[*pointOfContactNode]
+	scorecards    children[*scorecardNode]
+	vexStatements children[*vexStatementNode]
+	vulnEquals    children[*vulnEqualNode]
+
+	// artifactSubscribers has its own lock: new artifacts are published with
+	// the write lock held, but subscribing does not take it.
+	artifactSubscribers subscribers[*artifactNode]
+}
+
+// Config holds the optional arguments of the in-memory backend.
+type Config struct {
+	// IDStrategy selects the IDs of the nodes. The native IDs are counters
+	// incremented by the ingestions.
+	IDStrategy backends.IDStrategy
+}
+
+// New returns a new empty in-memory backend. args may be nil, or a *Config.
+func New(ctx context.Context, args backends.BackendArgs) (backends.Backend, error) {
+	client := &inmemClient{}
+	if config, ok := args.(*Config); ok && config != nil && config.IDStrategy == backends.ContentIDs {
+		return contentid.Wrap(client, contentid.NewMemoryStore()), nil
+	}
+	return client, nil
+}
+
+// Ping always succeeds as the nodes are in memory, unless ctx is done.
+func (c *inmemClient) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// nextID returns a fresh ID for a node. Must be called with the write lock
+// held.
+func (c *inmemClient) nextID() string {
+	c.index++
+	return strconv.FormatUint(c.index, 10)
+}
+
+// children is a set of nodes indexed by a key which also remembers the order
+// in which the nodes have been added, to return stable query results.
+type children[T any] struct {
+	order []T
+	byKey map[string]T
+	// position is the index in order of the node with a given key.
+	position map[string]int
+}
+
+func (c *children[T]) get(key string) (T, bool) {
+	v, ok := c.byKey[key]
+	return v, ok
+}
+
+func (c *children[T]) add(key string, v T) {
+	if c.byKey == nil {
+		c.byKey = map[string]T{}
+		c.position = map[string]int{}
+	}
+	c.byKey[key] = v
+	c.position[key] = len(c.order)
+	c.order = append(c.order, v)
+}
+
+// indexOf returns the index in order of the node with the given key.
+func (c *children[T]) indexOf(key string) (int, bool) {
+	i, ok := c.position[key]
+	return i, ok
+}
+
+// matchString returns true if the filter is not set or if it is equal to the
+// value.
+func matchString(filter *string, value string) bool {
+	return filter == nil || *filter == value
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// nilIfEmpty converts optional input values to the representation used in
+// the model, where missing values are nil.
+func nilIfEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	v := *s
+	return &v
+}
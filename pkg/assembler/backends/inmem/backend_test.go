@@ -0,0 +1,64 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestConcurrentIngest(t *testing.T) {
+	ctx := context.Background()
+	b, err := New(ctx, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	const workers = 8
+	const versions = 50
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < versions; i++ {
+				version := fmt.Sprintf("1.0.%d", i)
+				if _, err := b.IngestPackage(ctx, &model.PkgInputSpec{Type: "npm", Name: "foobar", Version: &version}); err != nil {
+					t.Errorf("IngestPackage() error = %v", err)
+				}
+				if _, err := b.Packages(ctx, &model.PkgSpec{Version: &version}); err != nil {
+					t.Errorf("Packages() error = %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := b.Packages(ctx, nil)
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(got) != 1 || len(got[0].Namespaces) != 1 || len(got[0].Namespaces[0].Names) != 1 {
+		t.Fatalf("Packages() returned %v, want a single package name", got)
+	}
+	if n := len(got[0].Namespaces[0].Names[0].Versions); n != versions {
+		t.Errorf("Packages() returned %d versions, want %d", n, versions)
+	}
+}
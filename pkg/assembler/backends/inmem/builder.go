@@ -0,0 +1,91 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// builderNode is a builder, deduplicated on its URI.
+type builderNode struct {
+	id  string
+	uri string
+}
+
+func (b *builderNode) toModel() *model.Builder {
+	return &model.Builder{
+		ID:  b.id,
+		URI: b.uri,
+	}
+}
+
+// Ingest Builder
+
+func (c *inmemClient) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if builder == nil {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.Missing("builder"))
+	}
+	if builder.URI == "" {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.ingestBuilder(builder).toModel(), nil
+}
+
+// ingestBuilder returns the builder node matching the input, creating it if
+// needed. Must be called with the write lock held.
+func (c *inmemClient) ingestBuilder(builder *model.BuilderInputSpec) *builderNode {
+	if existing, ok := c.builders.get(builder.URI); ok {
+		return existing
+	}
+	b := &builderNode{id: c.nextID(), uri: builder.URI}
+	c.builders.add(builder.URI, b)
+	return b
+}
+
+// Query Builders
+
+func (c *inmemClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.Builder
+	for _, b := range c.builders.order {
+		if b.matches(builderSpec) {
+			out = append(out, b.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (b *builderNode) matches(builderSpec *model.BuilderSpec) bool {
+	if builderSpec == nil {
+		return true
+	}
+	return matchString(builderSpec.ID, b.id) && matchString(builderSpec.URI, b.uri)
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// certifyNode is a CertifyBad or CertifyGood certification. Both have the
+// same shape and are stored in separate collections.
+type certifyNode struct {
+	id            string
+	subject       subjectNode
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *certifyNode) key() string {
+	return strings.Join([]string{n.subject.id(), n.justification, n.origin, n.collector}, "\x00")
+}
+
+func (n *certifyNode) matches(id *string, subject *model.PackageSourceOrArtifactSpec, justification, origin, collector *string) bool {
+	return matchString(id, n.id) &&
+		matchString(justification, n.justification) &&
+		matchString(origin, n.origin) &&
+		matchString(collector, n.collector) &&
+		n.subject.matches(subject)
+}
+
+// ingestCertification adds a certification to certifications, unless it
+// already exists, and returns it. Must be called with the write lock held.
+func (c *inmemClient) ingestCertification(certifications *children[*certifyNode], subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, justification, origin, collector string) (*certifyNode, error) {
+	s, err := c.ingestSubject(subject, pkgMatchType)
+	if err != nil {
+		return nil, err
+	}
+	n := &certifyNode{
+		subject:       s,
+		justification: justification,
+		origin:        origin,
+		collector:     collector,
+	}
+	key := n.key()
+	if existing, ok := certifications.get(key); ok {
+		return existing, nil
+	}
+	n.id = c.nextID()
+	certifications.add(key, n)
+	return n, nil
+}
+
+// Ingest CertifyBad
+
+func (c *inmemClient) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyBad == nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", backends.Missing("certifyBad"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n, err := c.ingestCertification(&c.certifyBads, subject, pkgMatchType,
+		certifyBad.Justification, certifyBad.Origin, certifyBad.Collector)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", err)
+	}
+	return n.toCertifyBad(), nil
+}
+
+// Query CertifyBad
+
+func (c *inmemClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyBadSpec == nil {
+		certifyBadSpec = &model.CertifyBadSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyBadSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyBad :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.CertifyBad
+	for _, n := range c.certifyBads.order {
+		if n.matches(certifyBadSpec.ID, certifyBadSpec.Subject, certifyBadSpec.Justification, certifyBadSpec.Origin, certifyBadSpec.Collector) {
+			out = append(out, n.toCertifyBad())
+		}
+	}
+	return out, nil
+}
+
+func (n *certifyNode) toCertifyBad() *model.CertifyBad {
+	return &model.CertifyBad{
+		ID:            n.id,
+		Subject:       n.subject.toModel(),
+		Justification: n.justification,
+		Origin:        n.origin,
+		Collector:     n.collector,
+	}
+}
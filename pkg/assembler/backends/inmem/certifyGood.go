@@ -0,0 +1,79 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyGood
+
+func (c *inmemClient) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyGood == nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", backends.Missing("certifyGood"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n, err := c.ingestCertification(&c.certifyGoods, subject, pkgMatchType,
+		certifyGood.Justification, certifyGood.Origin, certifyGood.Collector)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", err)
+	}
+	return n.toCertifyGood(), nil
+}
+
+// Query CertifyGood
+
+func (c *inmemClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyGoodSpec == nil {
+		certifyGoodSpec = &model.CertifyGoodSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyGoodSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyGood :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.CertifyGood
+	for _, n := range c.certifyGoods.order {
+		if n.matches(certifyGoodSpec.ID, certifyGoodSpec.Subject, certifyGoodSpec.Justification, certifyGoodSpec.Origin, certifyGoodSpec.Collector) {
+			out = append(out, n.toCertifyGood())
+		}
+	}
+	return out, nil
+}
+
+func (n *certifyNode) toCertifyGood() *model.CertifyGood {
+	return &model.CertifyGood{
+		ID:            n.id,
+		Subject:       n.subject.toModel(),
+		Justification: n.justification,
+		Origin:        n.origin,
+		Collector:     n.collector,
+	}
+}
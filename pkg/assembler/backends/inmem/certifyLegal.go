@@ -0,0 +1,169 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// certifyLegalNode links a package version or a source (exactly one of pkg
+// and src is set) to its license information. Every distinct scan gets its
+// own node.
+type certifyLegalNode struct {
+	id                string
+	pkg               *pkgVersionNode
+	src               *srcNameNode
+	declaredLicense   string
+	discoveredLicense string
+	attribution       string
+	justification     string
+	timeScanned       time.Time
+	origin            string
+	collector         string
+}
+
+func (l *certifyLegalNode) key() string {
+	var subjectID string
+	if l.pkg != nil {
+		subjectID = l.pkg.id
+	} else {
+		subjectID = l.src.id
+	}
+	return strings.Join([]string{subjectID, l.declaredLicense, l.discoveredLicense, l.attribution, l.justification,
+		l.timeScanned.Format(time.RFC3339Nano), l.origin, l.collector}, "\x00")
+}
+
+func (l *certifyLegalNode) toModel() *model.CertifyLegal {
+	var subject model.PackageOrSource
+	if l.pkg != nil {
+		subject = l.pkg.toPackage()
+	} else {
+		subject = l.src.toSource()
+	}
+	return &model.CertifyLegal{
+		ID:                l.id,
+		Subject:           subject,
+		DeclaredLicense:   l.declaredLicense,
+		DiscoveredLicense: l.discoveredLicense,
+		Attribution:       l.attribution,
+		Justification:     l.justification,
+		TimeScanned:       l.timeScanned,
+		Origin:            l.origin,
+		Collector:         l.collector,
+	}
+}
+
+// Ingest CertifyLegal
+
+func (c *inmemClient) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("subject"))
+	}
+	if certifyLegal == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("certifyLegal"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	l := &certifyLegalNode{
+		declaredLicense:   certifyLegal.DeclaredLicense,
+		discoveredLicense: certifyLegal.DiscoveredLicense,
+		attribution:       certifyLegal.Attribution,
+		justification:     certifyLegal.Justification,
+		timeScanned:       certifyLegal.TimeScanned.UTC(),
+		origin:            certifyLegal.Origin,
+		collector:         certifyLegal.Collector,
+	}
+	if subject.Package != nil {
+		l.pkg = c.ingestPackage(subject.Package)
+	} else {
+		src, err := c.ingestSource(subject.Source)
+		if err != nil {
+			return nil, err
+		}
+		l.src = src
+	}
+
+	key := l.key()
+	if existing, ok := c.certifyLegals.get(key); ok {
+		return existing.toModel(), nil
+	}
+	l.id = c.nextID()
+	c.certifyLegals.add(key, l)
+	return l.toModel(), nil
+}
+
+// Query CertifyLegal
+
+func (c *inmemClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyLegalSpec == nil {
+		certifyLegalSpec = &model.CertifyLegalSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyLegalSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyLegal :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.CertifyLegal
+	for _, l := range c.certifyLegals.order {
+		if l.matches(certifyLegalSpec) {
+			out = append(out, l.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (l *certifyLegalNode) matches(spec *model.CertifyLegalSpec) bool {
+	if spec.TimeScanned != nil && !l.timeScanned.Equal(*spec.TimeScanned) {
+		return false
+	}
+	if !matchString(spec.ID, l.id) ||
+		!matchString(spec.DeclaredLicense, l.declaredLicense) ||
+		!matchString(spec.DiscoveredLicense, l.discoveredLicense) ||
+		!matchString(spec.Attribution, l.attribution) ||
+		!matchString(spec.Justification, l.justification) ||
+		!matchString(spec.Origin, l.origin) ||
+		!matchString(spec.Collector, l.collector) {
+		return false
+	}
+	if spec.Subject == nil {
+		return true
+	}
+	if spec.Subject.Package != nil {
+		return l.pkg != nil && l.pkg.matches(spec.Subject.Package)
+	}
+	if spec.Subject.Source != nil {
+		return l.src != nil && l.src.matches(spec.Subject.Source)
+	}
+	return true
+}
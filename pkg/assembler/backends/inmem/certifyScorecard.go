@@ -0,0 +1,159 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// scorecardNode links a source to the results of a Scorecard run. Runs are
+// identified by the source, the commit scanned and the time of the scan.
+type scorecardNode struct {
+	id               string
+	src              *srcNameNode
+	checks           []*model.ScorecardCheck
+	aggregateScore   float64
+	timeScanned      time.Time
+	scorecardVersion string
+	commit           string
+	origin           string
+	collector        string
+}
+
+func (s *scorecardNode) key() string {
+	return strings.Join([]string{s.src.id, s.commit, s.timeScanned.UTC().Format(time.RFC3339Nano)}, "\x00")
+}
+
+func (s *scorecardNode) toModel() *model.CertifyScorecard {
+	checks := make([]*model.ScorecardCheck, 0, len(s.checks))
+	for _, check := range s.checks {
+		checks = append(checks, &model.ScorecardCheck{Check: check.Check, Score: check.Score})
+	}
+	return &model.CertifyScorecard{
+		ID:     s.id,
+		Source: s.src.toSource(),
+		Scorecard: &model.Scorecard{
+			Checks:           checks,
+			AggregateScore:   s.aggregateScore,
+			TimeScanned:      s.timeScanned,
+			ScorecardVersion: s.scorecardVersion,
+			Commit:           s.commit,
+			Origin:           s.origin,
+			Collector:        s.collector,
+		},
+	}
+}
+
+// Ingest Scorecard
+
+func (c *inmemClient) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("source"))
+	}
+	if scorecard == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("scorecard"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	src, err := c.ingestSource(source)
+	if err != nil {
+		return nil, err
+	}
+	// Checks are sorted by name, to return them in a stable order.
+	checks := make([]*model.ScorecardCheck, 0, len(scorecard.Checks))
+	for _, check := range scorecard.Checks {
+		checks = append(checks, &model.ScorecardCheck{Check: check.Check, Score: check.Score})
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Check < checks[j].Check
+	})
+	s := &scorecardNode{
+		src:              src,
+		checks:           checks,
+		aggregateScore:   scorecard.AggregateScore,
+		timeScanned:      scorecard.TimeScanned.UTC(),
+		scorecardVersion: scorecard.ScorecardVersion,
+		commit:           scorecard.Commit,
+		origin:           scorecard.Origin,
+		collector:        scorecard.Collector,
+	}
+	key := s.key()
+	if existing, ok := c.scorecards.get(key); ok {
+		return existing.toModel(), nil
+	}
+	s.id = c.nextID()
+	c.scorecards.add(key, s)
+	return s.toModel(), nil
+}
+
+// Query Scorecards
+
+func (c *inmemClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyScorecardSpec == nil {
+		certifyScorecardSpec = &model.CertifyScorecardSpec{}
+	}
+	latestOnly := certifyScorecardSpec.LatestOnly != nil && *certifyScorecardSpec.LatestOnly
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var matching []*scorecardNode
+	latest := map[*srcNameNode]*scorecardNode{}
+	for _, s := range c.scorecards.order {
+		if !s.matches(certifyScorecardSpec) {
+			continue
+		}
+		matching = append(matching, s)
+		if l, ok := latest[s.src]; !ok || s.timeScanned.After(l.timeScanned) {
+			latest[s.src] = s
+		}
+	}
+
+	var out []*model.CertifyScorecard
+	for _, s := range matching {
+		if latestOnly && latest[s.src] != s {
+			continue
+		}
+		out = append(out, s.toModel())
+	}
+	return out, nil
+}
+
+func (s *scorecardNode) matches(spec *model.CertifyScorecardSpec) bool {
+	if spec.MinAggregateScore != nil && s.aggregateScore < *spec.MinAggregateScore {
+		return false
+	}
+	return matchString(spec.ID, s.id) &&
+		matchString(spec.ScorecardVersion, s.scorecardVersion) &&
+		matchString(spec.Commit, s.commit) &&
+		matchString(spec.Origin, s.origin) &&
+		matchString(spec.Collector, s.collector) &&
+		s.src.matches(spec.Source)
+}
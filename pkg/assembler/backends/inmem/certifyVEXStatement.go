@@ -0,0 +1,194 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// vexStatementNode links a package version or an artifact (exactly one of pkg
+// and artifact is set) to a VEX statement about a vulnerability. Every
+// distinct statement gets its own node, so the history of the statuses of a
+// subject is preserved.
+type vexStatementNode struct {
+	id            string
+	pkg           *pkgVersionNode
+	artifact      *artifactNode
+	vuln          *vulnIDNode
+	status        model.VexStatus
+	justification model.VexJustification
+	statement     string
+	knownSince    time.Time
+	origin        string
+	collector     string
+}
+
+func (v *vexStatementNode) subjectID() string {
+	if v.pkg != nil {
+		return v.pkg.id
+	}
+	return v.artifact.id
+}
+
+func (v *vexStatementNode) key() string {
+	return strings.Join([]string{v.subjectID(), v.vuln.id, string(v.status), string(v.justification), v.statement,
+		v.knownSince.Format(time.RFC3339Nano), v.origin, v.collector}, "\x00")
+}
+
+func (v *vexStatementNode) toModel() *model.CertifyVEXStatement {
+	var subject model.PackageOrArtifact
+	if v.pkg != nil {
+		subject = v.pkg.toPackage()
+	} else {
+		subject = v.artifact.toModel()
+	}
+	return &model.CertifyVEXStatement{
+		ID:               v.id,
+		Subject:          subject,
+		Vulnerability:    v.vuln.toVulnerability(),
+		Status:           v.status,
+		VexJustification: v.justification,
+		Statement:        v.statement,
+		KnownSince:       v.knownSince,
+		Origin:           v.origin,
+		Collector:        v.collector,
+	}
+}
+
+// Ingest CertifyVEXStatement
+
+func (c *inmemClient) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("subject"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vulnerability"))
+	}
+	if vexStatement == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vexStatement"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if err := backends.ValidateVEXStatementInput(vexStatement); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if subject.Artifact != nil {
+		// Checked before ingesting the vulnerability, so that nothing is
+		// ingested if the artifact is invalid.
+		if _, _, err := backends.CanonicalArtifact(subject.Artifact); err != nil {
+			return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	vuln, err := c.ingestVulnerability(vulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	v := &vexStatementNode{
+		vuln:          vuln,
+		status:        vexStatement.Status,
+		justification: vexStatement.VexJustification,
+		statement:     vexStatement.Statement,
+		knownSince:    vexStatement.KnownSince.UTC(),
+		origin:        vexStatement.Origin,
+		collector:     vexStatement.Collector,
+	}
+	if subject.Package != nil {
+		v.pkg = c.ingestPackage(subject.Package)
+	} else {
+		v.artifact, err = c.ingestArtifact(subject.Artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+		}
+	}
+
+	key := v.key()
+	if existing, ok := c.vexStatements.get(key); ok {
+		return existing.toModel(), nil
+	}
+	v.id = c.nextID()
+	c.vexStatements.add(key, v)
+	return v.toModel(), nil
+}
+
+// Query CertifyVEXStatement
+
+func (c *inmemClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyVEXStatementSpec == nil {
+		certifyVEXStatementSpec = &model.CertifyVEXStatementSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(certifyVEXStatementSpec.Subject); err != nil {
+		return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.CertifyVEXStatement
+	for _, v := range c.vexStatements.order {
+		if v.matches(certifyVEXStatementSpec) {
+			out = append(out, v.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (v *vexStatementNode) matches(spec *model.CertifyVEXStatementSpec) bool {
+	if spec.KnownSinceFrom != nil && v.knownSince.Before(*spec.KnownSinceFrom) {
+		return false
+	}
+	if spec.KnownSinceUntil != nil && v.knownSince.After(*spec.KnownSinceUntil) {
+		return false
+	}
+	if spec.Status != nil && *spec.Status != v.status {
+		return false
+	}
+	if spec.VexJustification != nil && *spec.VexJustification != v.justification {
+		return false
+	}
+	if !matchString(spec.ID, v.id) ||
+		!matchString(spec.Statement, v.statement) ||
+		!matchString(spec.Origin, v.origin) ||
+		!matchString(spec.Collector, v.collector) ||
+		!v.vuln.matches(spec.Vulnerability) {
+		return false
+	}
+	if spec.Subject == nil {
+		return true
+	}
+	if spec.Subject.Package != nil {
+		return v.pkg != nil && v.pkg.matches(spec.Subject.Package)
+	}
+	if spec.Subject.Artifact != nil {
+		return v.artifact != nil && v.artifact.matches(spec.Subject.Artifact)
+	}
+	return true
+}
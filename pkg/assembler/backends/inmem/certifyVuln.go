@@ -0,0 +1,161 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// certifyVulnNode links a package version to a vulnerability found by a
+// scan. Every distinct scan gets its own node.
+type certifyVulnNode struct {
+	id             string
+	pkg            *pkgVersionNode
+	vuln           *vulnIDNode
+	timeScanned    time.Time
+	dbURI          string
+	dbVersion      string
+	scannerURI     string
+	scannerVersion string
+	origin         string
+	collector      string
+}
+
+func (cv *certifyVulnNode) key() string {
+	return strings.Join([]string{cv.pkg.id, cv.vuln.id, cv.timeScanned.UTC().Format(time.RFC3339Nano),
+		cv.dbURI, cv.dbVersion, cv.scannerURI, cv.scannerVersion, cv.origin, cv.collector}, "\x00")
+}
+
+func (cv *certifyVulnNode) toModel() *model.CertifyVuln {
+	return &model.CertifyVuln{
+		ID:            cv.id,
+		Package:       cv.pkg.toPackage(),
+		Vulnerability: cv.vuln.toVulnerability(),
+		Metadata: &model.ScanMetadata{
+			TimeScanned:    cv.timeScanned,
+			DbURI:          cv.dbURI,
+			DbVersion:      cv.dbVersion,
+			ScannerURI:     cv.scannerURI,
+			ScannerVersion: cv.scannerVersion,
+			Origin:         cv.origin,
+			Collector:      cv.collector,
+		},
+	}
+}
+
+// Ingest CertifyVuln
+
+func (c *inmemClient) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("pkg"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("vulnerability"))
+	}
+	if certifyVuln == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("certifyVuln"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	vuln, err := c.ingestVulnerability(vulnerability)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", err)
+	}
+	cv := &certifyVulnNode{
+		pkg:            c.ingestPackage(pkg),
+		vuln:           vuln,
+		timeScanned:    certifyVuln.TimeScanned.UTC(),
+		dbURI:          certifyVuln.DbURI,
+		dbVersion:      certifyVuln.DbVersion,
+		scannerURI:     certifyVuln.ScannerURI,
+		scannerVersion: certifyVuln.ScannerVersion,
+		origin:         certifyVuln.Origin,
+		collector:      certifyVuln.Collector,
+	}
+	key := cv.key()
+	if existing, ok := c.certifyVulns.get(key); ok {
+		return existing.toModel(), nil
+	}
+	cv.id = c.nextID()
+	c.certifyVulns.add(key, cv)
+	return cv.toModel(), nil
+}
+
+// Query CertifyVuln
+
+func (c *inmemClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if certifyVulnSpec == nil {
+		certifyVulnSpec = &model.CertifyVulnSpec{}
+	}
+	latestOnly := certifyVulnSpec.LatestOnly != nil && *certifyVulnSpec.LatestOnly
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var matching []*certifyVulnNode
+	// latest holds the time of the latest scan of each package version, all
+	// of whose certifications are kept.
+	latest := map[*pkgVersionNode]time.Time{}
+	for _, cv := range c.certifyVulns.order {
+		if !cv.matches(certifyVulnSpec) {
+			continue
+		}
+		matching = append(matching, cv)
+		if l, ok := latest[cv.pkg]; !ok || cv.timeScanned.After(l) {
+			latest[cv.pkg] = cv.timeScanned
+		}
+	}
+
+	var out []*model.CertifyVuln
+	for _, cv := range matching {
+		if latestOnly && !cv.timeScanned.Equal(latest[cv.pkg]) {
+			continue
+		}
+		out = append(out, cv.toModel())
+	}
+	return out, nil
+}
+
+func (cv *certifyVulnNode) matches(spec *model.CertifyVulnSpec) bool {
+	if spec.TimeScannedSince != nil && cv.timeScanned.Before(*spec.TimeScannedSince) {
+		return false
+	}
+	if spec.TimeScannedUntil != nil && cv.timeScanned.After(*spec.TimeScannedUntil) {
+		return false
+	}
+	return matchString(spec.ID, cv.id) &&
+		matchString(spec.DbURI, cv.dbURI) &&
+		matchString(spec.DbVersion, cv.dbVersion) &&
+		matchString(spec.ScannerURI, cv.scannerURI) &&
+		matchString(spec.ScannerVersion, cv.scannerVersion) &&
+		matchString(spec.Origin, cv.origin) &&
+		matchString(spec.Collector, cv.collector) &&
+		cv.pkg.matches(spec.Package) &&
+		cv.vuln.matches(spec.Vulnerability)
+}
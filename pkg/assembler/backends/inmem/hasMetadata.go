@@ -0,0 +1,129 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// hasMetadataNode attaches a key/value pair to a subject. Like for CertifyBad,
+// a package subject is either a version or a name.
+type hasMetadataNode struct {
+	id            string
+	subject       subjectNode
+	key           string
+	value         string
+	since         time.Time
+	justification string
+	origin        string
+	collector     string
+}
+
+// nodeKey deduplicates the nodes on the subject, key, value and
+// justification: ingesting them again with another since, origin or collector
+// returns the existing node.
+func (n *hasMetadataNode) nodeKey() string {
+	return strings.Join([]string{n.subject.id(), n.key, n.value, n.justification}, "\x00")
+}
+
+func (n *hasMetadataNode) toModel() *model.HasMetadata {
+	return &model.HasMetadata{
+		ID:            n.id,
+		Subject:       n.subject.toModel(),
+		Key:           n.key,
+		Value:         n.value,
+		Since:         n.since,
+		Justification: n.justification,
+		Origin:        n.origin,
+		Collector:     n.collector,
+	}
+}
+
+func (n *hasMetadataNode) matches(spec *model.HasMetadataSpec) bool {
+	return matchString(spec.ID, n.id) &&
+		matchString(spec.Key, n.key) &&
+		matchString(spec.Value, n.value) &&
+		(spec.Since == nil || spec.Since.Equal(n.since)) &&
+		matchString(spec.Justification, n.justification) &&
+		matchString(spec.Origin, n.origin) &&
+		matchString(spec.Collector, n.collector) &&
+		n.subject.matches(spec.Subject)
+}
+
+// Ingest HasMetadata
+
+func (c *inmemClient) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasMetadata == nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", backends.Missing("hasMetadata"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, err := c.ingestSubject(subject, pkgMatchType)
+	if err != nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", err)
+	}
+	n := &hasMetadataNode{
+		subject:       s,
+		key:           hasMetadata.Key,
+		value:         hasMetadata.Value,
+		since:         hasMetadata.Since.UTC(),
+		justification: hasMetadata.Justification,
+		origin:        hasMetadata.Origin,
+		collector:     hasMetadata.Collector,
+	}
+	key := n.nodeKey()
+	if existing, ok := c.hasMetadata.get(key); ok {
+		return existing.toModel(), nil
+	}
+	n.id = c.nextID()
+	c.hasMetadata.add(key, n)
+	return n.toModel(), nil
+}
+
+// Query HasMetadata
+
+func (c *inmemClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasMetadataSpec == nil {
+		hasMetadataSpec = &model.HasMetadataSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(hasMetadataSpec.Subject); err != nil {
+		return nil, backends.Errorf("HasMetadata :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.HasMetadata
+	for _, n := range c.hasMetadata.order {
+		if n.matches(hasMetadataSpec) {
+			out = append(out, n.toModel())
+		}
+	}
+	return out, nil
+}
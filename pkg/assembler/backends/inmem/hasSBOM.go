@@ -0,0 +1,170 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// hasSBOMNode links a package version or an artifact (exactly one of pkg and
+// artifact is set) to an SBOM document.
+type hasSBOMNode struct {
+	id               string
+	pkg              *pkgVersionNode
+	artifact         *artifactNode
+	uri              string
+	algorithm        string
+	digest           string
+	downloadLocation string
+	origin           string
+	collector        string
+}
+
+func (h *hasSBOMNode) subjectID() string {
+	if h.pkg != nil {
+		return h.pkg.id
+	}
+	return h.artifact.id
+}
+
+func (h *hasSBOMNode) key() string {
+	return strings.Join([]string{h.subjectID(), h.uri, h.algorithm, h.digest, h.downloadLocation, h.origin, h.collector}, "\x00")
+}
+
+func (h *hasSBOMNode) toModel() *model.HasSbom {
+	var subject model.PackageOrArtifact
+	if h.pkg != nil {
+		subject = h.pkg.toPackage()
+	} else {
+		subject = h.artifact.toModel()
+	}
+	return &model.HasSbom{
+		ID:               h.id,
+		Subject:          subject,
+		URI:              h.uri,
+		Algorithm:        h.algorithm,
+		Digest:           h.digest,
+		DownloadLocation: h.downloadLocation,
+		Origin:           h.origin,
+		Collector:        h.collector,
+	}
+}
+
+// Ingest HasSBOM
+
+func (c *inmemClient) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("subject"))
+	}
+	if hasSbom == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("hasSBOM"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	h := &hasSBOMNode{
+		uri:              hasSbom.URI,
+		algorithm:        strings.ToLower(hasSbom.Algorithm),
+		digest:           strings.ToLower(hasSbom.Digest),
+		downloadLocation: hasSbom.DownloadLocation,
+		origin:           hasSbom.Origin,
+		collector:        hasSbom.Collector,
+	}
+	if subject.Package != nil {
+		h.pkg = c.ingestPackage(subject.Package)
+	} else {
+		a, err := c.ingestArtifact(subject.Artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestHasSbom :: %w", err)
+		}
+		h.artifact = a
+	}
+
+	key := h.key()
+	if existing, ok := c.hasSBOMs.get(key); ok {
+		return existing.toModel(), nil
+	}
+	h.id = c.nextID()
+	c.hasSBOMs.add(key, h)
+	return h.toModel(), nil
+}
+
+// Query HasSBOM
+
+func (c *inmemClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasSBOMSpec == nil {
+		hasSBOMSpec = &model.HasSBOMSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(hasSBOMSpec.Subject); err != nil {
+		return nil, backends.Errorf("HasSBOM :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.HasSbom
+	for _, h := range c.hasSBOMs.order {
+		if h.matches(hasSBOMSpec) {
+			out = append(out, h.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (h *hasSBOMNode) matches(spec *model.HasSBOMSpec) bool {
+	if !matchString(spec.ID, h.id) ||
+		!matchString(spec.URI, h.uri) ||
+		!matchString(backends.LowerIfSet(spec.Algorithm), h.algorithm) ||
+		!matchString(backends.LowerIfSet(spec.Digest), h.digest) ||
+		!matchString(spec.DownloadLocation, h.downloadLocation) ||
+		!matchString(spec.Origin, h.origin) ||
+		!matchString(spec.Collector, h.collector) {
+		return false
+	}
+	if spec.Subject == nil {
+		return true
+	}
+	if spec.Subject.Package != nil {
+		return h.pkg != nil && h.pkg.matches(spec.Subject.Package)
+	}
+	if spec.Subject.Artifact != nil {
+		return h.artifact != nil && h.artifact.matches(spec.Subject.Artifact)
+	}
+	return true
+}
+
+// FindSBOMsByArtifact walks the evidence trees with the search shared by the
+// backends.
+func (c *inmemClient) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.FindSBOMsByArtifact(ctx, c, algorithm, digest)
+}
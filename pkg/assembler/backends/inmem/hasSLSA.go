@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// hasSLSANode links a subject artifact to the builder and materials of its
+// build. Materials are sorted by key and predicates by key and value, so that
+// the same provenance always results in the same node.
+type hasSLSANode struct {
+	id          string
+	subject     *artifactNode
+	builtFrom   []*artifactNode
+	builtBy     *builderNode
+	buildType   string
+	predicates  []*model.SLSAPredicate
+	slsaVersion string
+	startedOn   *time.Time
+	finishedOn  *time.Time
+	origin      string
+	collector   string
+}
+
+// key identifies the node. The build times are not part of it.
+func (h *hasSLSANode) key() string {
+	parts := []string{h.subject.id, h.builtBy.id, h.buildType, h.slsaVersion, h.origin, h.collector}
+	for _, m := range h.builtFrom {
+		parts = append(parts, m.id)
+	}
+	for _, p := range h.predicates {
+		parts = append(parts, p.Key+"="+p.Value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+func (h *hasSLSANode) toModel() *model.HasSlsa {
+	builtFrom := make([]*model.Artifact, 0, len(h.builtFrom))
+	for _, m := range h.builtFrom {
+		builtFrom = append(builtFrom, m.toModel())
+	}
+	predicates := make([]*model.SLSAPredicate, 0, len(h.predicates))
+	for _, p := range h.predicates {
+		predicates = append(predicates, &model.SLSAPredicate{Key: p.Key, Value: p.Value})
+	}
+	return &model.HasSlsa{
+		ID:      h.id,
+		Subject: h.subject.toModel(),
+		Slsa: &model.Slsa{
+			BuiltFrom:     builtFrom,
+			BuiltBy:       h.builtBy.toModel(),
+			BuildType:     h.buildType,
+			SlsaPredicate: predicates,
+			SlsaVersion:   h.slsaVersion,
+			StartedOn:     optionalUTC(h.startedOn),
+			FinishedOn:    optionalUTC(h.finishedOn),
+			Origin:        h.origin,
+			Collector:     h.collector,
+		},
+	}
+}
+
+func optionalUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC()
+	return &v
+}
+
+// Ingest HasSLSA
+
+func (c *inmemClient) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("subject"))
+	}
+	if builtBy == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("builtBy"))
+	}
+	if slsa == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("slsa"))
+	}
+	if builtBy.URI == "" {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+	// Validate all the artifacts first, so that nothing is ingested on errors.
+	if _, _, err := backends.CanonicalArtifact(subject); err != nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", err)
+	}
+	for i, m := range builtFrom {
+		if m == nil {
+			return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("builtFrom", "missing material at index %d", i))
+		}
+		if _, _, err := backends.CanonicalArtifact(m); err != nil {
+			return nil, backends.Errorf("IngestSLSA :: material at index %d: %w", i, err)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, _ := c.ingestArtifact(subject)
+	materials := map[string]*artifactNode{}
+	for _, m := range builtFrom {
+		a, _ := c.ingestArtifact(m)
+		materials[a.key()] = a
+	}
+	h := &hasSLSANode{
+		subject:     s,
+		builtFrom:   make([]*artifactNode, 0, len(materials)),
+		builtBy:     c.ingestBuilder(builtBy),
+		buildType:   slsa.BuildType,
+		predicates:  make([]*model.SLSAPredicate, 0, len(slsa.SlsaPredicate)),
+		slsaVersion: slsa.SlsaVersion,
+		startedOn:   optionalUTC(slsa.StartedOn),
+		finishedOn:  optionalUTC(slsa.FinishedOn),
+		origin:      slsa.Origin,
+		collector:   slsa.Collector,
+	}
+	for _, a := range materials {
+		h.builtFrom = append(h.builtFrom, a)
+	}
+	sort.Slice(h.builtFrom, func(i, j int) bool {
+		return h.builtFrom[i].key() < h.builtFrom[j].key()
+	})
+	for _, p := range slsa.SlsaPredicate {
+		h.predicates = append(h.predicates, &model.SLSAPredicate{Key: p.Key, Value: p.Value})
+	}
+	sort.Slice(h.predicates, func(i, j int) bool {
+		if h.predicates[i].Key != h.predicates[j].Key {
+			return h.predicates[i].Key < h.predicates[j].Key
+		}
+		return h.predicates[i].Value < h.predicates[j].Value
+	})
+
+	key := h.key()
+	if existing, ok := c.hasSLSAs.get(key); ok {
+		return existing.toModel(), nil
+	}
+	h.id = c.nextID()
+	c.hasSLSAs.add(key, h)
+	return h.toModel(), nil
+}
+
+// Query HasSLSA
+
+func (c *inmemClient) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hasSLSASpec == nil {
+		hasSLSASpec = &model.HasSLSASpec{}
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.HasSlsa
+	for _, h := range c.hasSLSAs.order {
+		if h.matches(hasSLSASpec) {
+			out = append(out, h.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (h *hasSLSANode) matches(spec *model.HasSLSASpec) bool {
+	if !matchString(spec.ID, h.id) ||
+		!matchString(spec.BuildType, h.buildType) ||
+		!matchString(spec.SlsaVersion, h.slsaVersion) ||
+		!matchString(spec.Origin, h.origin) ||
+		!matchString(spec.Collector, h.collector) ||
+		!h.subject.matches(spec.Subject) ||
+		!h.builtBy.matches(spec.BuiltBy) {
+		return false
+	}
+	for _, artifactSpec := range spec.BuiltFrom {
+		found := false
+		for _, m := range h.builtFrom {
+			if m.matches(artifactSpec) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// hashEqualNode links two artifacts with the same contents. The relation is
+// symmetric, so the key of the node does not depend on the order of the
+// artifacts.
+type hashEqualNode struct {
+	id            string
+	artifacts     [2]*artifactNode
+	justification string
+	origin        string
+	collector     string
+}
+
+func (h *hashEqualNode) key() string {
+	first, second := h.artifacts[0].id, h.artifacts[1].id
+	if second < first {
+		first, second = second, first
+	}
+	return strings.Join([]string{first, second, h.justification, h.origin, h.collector}, "\x00")
+}
+
+func (h *hashEqualNode) toModel() *model.HashEqual {
+	return &model.HashEqual{
+		ID:            h.id,
+		Artifacts:     []*model.Artifact{h.artifacts[0].toModel(), h.artifacts[1].toModel()},
+		Justification: h.justification,
+		Origin:        h.origin,
+		Collector:     h.collector,
+	}
+}
+
+// Ingest HashEqual
+
+func (c *inmemClient) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("artifact"))
+	}
+	if equalArtifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("equalArtifact"))
+	}
+	if hashEqual == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("hashEqual"))
+	}
+	// Validate both artifacts first, so that nothing is ingested on errors.
+	for _, a := range []*model.ArtifactInputSpec{artifact, equalArtifact} {
+		if _, _, err := backends.CanonicalArtifact(a); err != nil {
+			return nil, backends.Errorf("IngestHashEqual :: %w", err)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a, _ := c.ingestArtifact(artifact)
+	b, _ := c.ingestArtifact(equalArtifact)
+	if a == b {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.NewValidationError("equalArtifact", "an artifact cannot be certified equal to itself"))
+	}
+	h := &hashEqualNode{
+		artifacts:     [2]*artifactNode{a, b},
+		justification: hashEqual.Justification,
+		origin:        hashEqual.Origin,
+		collector:     hashEqual.Collector,
+	}
+	key := h.key()
+	if existing, ok := c.hashEquals.get(key); ok {
+		return existing.toModel(), nil
+	}
+	h.id = c.nextID()
+	c.hashEquals.add(key, h)
+	return h.toModel(), nil
+}
+
+// Query HashEqual
+
+func (c *inmemClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hashEqualSpec == nil {
+		hashEqualSpec = &model.HashEqualSpec{}
+	}
+	if len(hashEqualSpec.Artifacts) > 2 {
+		return nil, backends.Errorf("HashEqual :: %w", backends.NewValidationError("artifacts", "cannot filter on more than 2 artifacts"))
+	}
+	if hashEqualSpec.ExpandTransitive != nil && *hashEqualSpec.ExpandTransitive {
+		return backends.ExpandHashEqual(ctx, c, hashEqualSpec)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.HashEqual
+	for _, h := range c.hashEquals.order {
+		if h.matches(hashEqualSpec) {
+			out = append(out, h.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (h *hashEqualNode) matches(spec *model.HashEqualSpec) bool {
+	if !matchString(spec.ID, h.id) ||
+		!matchString(spec.Justification, h.justification) ||
+		!matchString(spec.Origin, h.origin) ||
+		!matchString(spec.Collector, h.collector) {
+		return false
+	}
+	a, b := h.artifacts[0], h.artifacts[1]
+	switch len(spec.Artifacts) {
+	case 0:
+		return true
+	case 1:
+		return a.matches(spec.Artifacts[0]) || b.matches(spec.Artifacts[0])
+	default:
+		first, second := spec.Artifacts[0], spec.Artifacts[1]
+		return (a.matches(first) && b.matches(second)) || (a.matches(second) && b.matches(first))
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// isDependencyNode links a package version to the name of the package it
+// depends on.
+type isDependencyNode struct {
+	id             string
+	pkg            *pkgVersionNode
+	depPkg         *pkgNameNode
+	versionRange   string
+	dependencyType model.DependencyType
+	justification  string
+	origin         string
+	collector      string
+}
+
+func (d *isDependencyNode) key() string {
+	return strings.Join([]string{d.pkg.id, d.depPkg.id, d.versionRange, string(d.dependencyType), d.justification, d.origin, d.collector}, "\x00")
+}
+
+func (d *isDependencyNode) toModel() *model.IsDependency {
+	return &model.IsDependency{
+		ID:               d.id,
+		Package:          d.pkg.toPackage(),
+		DependentPackage: d.depPkg.toPackage(),
+		VersionRange:     d.versionRange,
+		DependencyType:   d.dependencyType,
+		Justification:    d.justification,
+		Origin:           d.origin,
+		Collector:        d.collector,
+	}
+}
+
+// Ingest IsDependency
+
+func (c *inmemClient) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("pkg"))
+	}
+	if depPkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("depPkg"))
+	}
+	if dependency == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("dependency"))
+	}
+	if !dependency.DependencyType.IsValid() {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.NewValidationError("dependencyType", "invalid dependency type %q", dependency.DependencyType))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	d := &isDependencyNode{
+		pkg:            c.ingestPackage(pkg),
+		depPkg:         c.ingestPackageName(depPkg),
+		versionRange:   dependency.VersionRange,
+		dependencyType: dependency.DependencyType,
+		justification:  dependency.Justification,
+		origin:         dependency.Origin,
+		collector:      dependency.Collector,
+	}
+	key := d.key()
+	if existing, ok := c.dependencies.get(key); ok {
+		return existing.toModel(), nil
+	}
+	d.id = c.nextID()
+	c.dependencies.add(key, d)
+	return d.toModel(), nil
+}
+
+// Query IsDependency
+
+func (c *inmemClient) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if isDependencySpec == nil {
+		isDependencySpec = &model.IsDependencySpec{}
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.IsDependency
+	for _, d := range c.dependencies.order {
+		if d.matches(isDependencySpec) {
+			out = append(out, d.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (d *isDependencyNode) matches(spec *model.IsDependencySpec) bool {
+	if spec.DependencyType != nil && *spec.DependencyType != d.dependencyType {
+		return false
+	}
+	return matchString(spec.ID, d.id) &&
+		matchString(spec.VersionRange, d.versionRange) &&
+		matchString(spec.Justification, d.justification) &&
+		matchString(spec.Origin, d.origin) &&
+		matchString(spec.Collector, d.collector) &&
+		d.pkg.matches(spec.Package) &&
+		d.depPkg.matches(spec.DependentPackage)
+}
+
+// TransitiveDependencies walks the IsDependency edges with the search shared
+// by the backends.
+func (c *inmemClient) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.TransitiveDependencies(ctx, c, pkgSpec, maxDepth)
+}
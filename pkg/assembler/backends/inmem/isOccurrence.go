@@ -0,0 +1,166 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// isOccurrenceNode links a package version or a source (exactly one of pkg
+// and src is set) to an artifact.
+type isOccurrenceNode struct {
+	id            string
+	pkg           *pkgVersionNode
+	src           *srcNameNode
+	artifact      *artifactNode
+	justification string
+	origin        string
+	collector     string
+}
+
+// key identifies an occurrence. Origin and collector are not part of it, so
+// the same occurrence reported by different documents is stored once.
+func (o *isOccurrenceNode) key() string {
+	var subjectID string
+	if o.pkg != nil {
+		subjectID = o.pkg.id
+	} else {
+		subjectID = o.src.id
+	}
+	return strings.Join([]string{subjectID, o.artifact.id, o.justification}, "\x00")
+}
+
+func (o *isOccurrenceNode) toModel() *model.IsOccurrence {
+	var subject model.PackageOrSource
+	if o.pkg != nil {
+		subject = o.pkg.toPackage()
+	} else {
+		subject = o.src.toSource()
+	}
+	return &model.IsOccurrence{
+		ID:            o.id,
+		Subject:       subject,
+		Artifact:      o.artifact.toModel(),
+		Justification: o.justification,
+		Origin:        o.origin,
+		Collector:     o.collector,
+	}
+}
+
+// Ingest IsOccurrence
+
+func (c *inmemClient) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("subject"))
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("artifact"))
+	}
+	if occurrence == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("occurrence"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+
+	// Validate the artifact first, so that nothing is ingested on errors.
+	if _, _, err := backends.CanonicalArtifact(artifact); err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	o := &isOccurrenceNode{
+		justification: occurrence.Justification,
+		origin:        occurrence.Origin,
+		collector:     occurrence.Collector,
+	}
+	if subject.Package != nil {
+		o.pkg = c.ingestPackage(subject.Package)
+	} else {
+		src, err := c.ingestSource(subject.Source)
+		if err != nil {
+			return nil, err
+		}
+		o.src = src
+	}
+	a, err := c.ingestArtifact(artifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+	o.artifact = a
+
+	key := o.key()
+	if existing, ok := c.occurrences.get(key); ok {
+		return existing.toModel(), nil
+	}
+	o.id = c.nextID()
+	c.occurrences.add(key, o)
+	return o.toModel(), nil
+}
+
+// Query IsOccurrence
+
+func (c *inmemClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if isOccurrenceSpec == nil {
+		isOccurrenceSpec = &model.IsOccurrenceSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(isOccurrenceSpec.Subject); err != nil {
+		return nil, backends.Errorf("IsOccurrence :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.IsOccurrence
+	for _, o := range c.occurrences.order {
+		if o.matches(isOccurrenceSpec) {
+			out = append(out, o.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (o *isOccurrenceNode) matches(spec *model.IsOccurrenceSpec) bool {
+	if !matchString(spec.ID, o.id) ||
+		!matchString(spec.Justification, o.justification) ||
+		!matchString(spec.Origin, o.origin) ||
+		!matchString(spec.Collector, o.collector) ||
+		!o.artifact.matches(spec.Artifact) {
+		return false
+	}
+	if spec.Subject == nil {
+		return true
+	}
+	if spec.Subject.Package != nil {
+		return o.pkg != nil && o.pkg.matches(spec.Subject.Package)
+	}
+	if spec.Subject.Source != nil {
+		return o.src != nil && o.src.matches(spec.Subject.Source)
+	}
+	return true
+}
@@ -0,0 +1,287 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// graph is the view of all the nodes walked by Path and Neighbors, indexed by
+// ID. The nodes do not keep track of the evidence referencing them, so the
+// graph is built from scratch by every query.
+type graph map[string]*graphNode
+
+type graphNode struct {
+	toModel func() model.Node
+	edges   []graphEdge
+}
+
+type graphEdge struct {
+	to    string
+	label model.Edge
+}
+
+func (g graph) add(id string, toModel func() model.Node) {
+	g[id] = &graphNode{toModel: toModel}
+}
+
+// connect adds an edge between two nodes already in the graph.
+func (g graph) connect(a, b string, label model.Edge) {
+	g[a].edges = append(g[a].edges, graphEdge{to: b, label: label})
+	g[b].edges = append(g[b].edges, graphEdge{to: a, label: label})
+}
+
+// neighbors returns the IDs of the nodes connected to id through an allowed
+// edge, once even if they are connected through several edges.
+func (g graph) neighbors(id string, usingOnly []model.Edge) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, e := range g[id].edges {
+		if seen[e.to] || !backends.AllowsEdge(usingOnly, e.label) {
+			continue
+		}
+		seen[e.to] = true
+		out = append(out, e.to)
+	}
+	return out
+}
+
+func (g graph) toModel(ids []string) []model.Node {
+	out := make([]model.Node, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, g[id].toModel())
+	}
+	return out
+}
+
+// graph returns the graph of all the nodes. Must be called with the read lock
+// held.
+func (c *inmemClient) graph() graph {
+	g := graph{}
+	c.addTries(g)
+
+	for _, a := range c.artifacts.order {
+		a := a
+		g.add(a.id, func() model.Node { return a.toModel() })
+	}
+	for _, b := range c.builders.order {
+		b := b
+		g.add(b.id, func() model.Node { return b.toModel() })
+	}
+
+	for _, n := range c.certifyBads.order {
+		n := n
+		g.add(n.id, func() model.Node { return n.toCertifyBad() })
+		g.connect(n.id, n.subject.id(), model.EdgeCertifyBad)
+	}
+	for _, n := range c.certifyGoods.order {
+		n := n
+		g.add(n.id, func() model.Node { return n.toCertifyGood() })
+		g.connect(n.id, n.subject.id(), model.EdgeCertifyGood)
+	}
+	for _, l := range c.certifyLegals.order {
+		l := l
+		g.add(l.id, func() model.Node { return l.toModel() })
+		if l.pkg != nil {
+			g.connect(l.id, l.pkg.id, model.EdgeCertifyLegal)
+		} else {
+			g.connect(l.id, l.src.id, model.EdgeCertifyLegal)
+		}
+	}
+	for _, s := range c.scorecards.order {
+		s := s
+		g.add(s.id, func() model.Node { return s.toModel() })
+		g.connect(s.id, s.src.id, model.EdgeCertifyScorecard)
+	}
+	for _, v := range c.vexStatements.order {
+		v := v
+		g.add(v.id, func() model.Node { return v.toModel() })
+		if v.pkg != nil {
+			g.connect(v.id, v.pkg.id, model.EdgeCertifyVexStatement)
+		} else {
+			g.connect(v.id, v.artifact.id, model.EdgeCertifyVexStatement)
+		}
+		g.connect(v.id, v.vuln.id, model.EdgeCertifyVexStatement)
+	}
+	for _, cv := range c.certifyVulns.order {
+		cv := cv
+		g.add(cv.id, func() model.Node { return cv.toModel() })
+		g.connect(cv.id, cv.pkg.id, model.EdgeCertifyVuln)
+		g.connect(cv.id, cv.vuln.id, model.EdgeCertifyVuln)
+	}
+	for _, h := range c.hashEquals.order {
+		h := h
+		g.add(h.id, func() model.Node { return h.toModel() })
+		for _, a := range h.artifacts {
+			g.connect(h.id, a.id, model.EdgeHashEqual)
+		}
+	}
+	for _, n := range c.hasMetadata.order {
+		n := n
+		g.add(n.id, func() model.Node { return n.toModel() })
+		g.connect(n.id, n.subject.id(), model.EdgeHasMetadata)
+	}
+	for _, h := range c.hasSBOMs.order {
+		h := h
+		g.add(h.id, func() model.Node { return h.toModel() })
+		if h.pkg != nil {
+			g.connect(h.id, h.pkg.id, model.EdgeHasSbom)
+		} else {
+			g.connect(h.id, h.artifact.id, model.EdgeHasSbom)
+		}
+	}
+	for _, h := range c.hasSLSAs.order {
+		h := h
+		g.add(h.id, func() model.Node { return h.toModel() })
+		g.connect(h.id, h.subject.id, model.EdgeHasSlsa)
+		for _, a := range h.builtFrom {
+			g.connect(h.id, a.id, model.EdgeHasSlsa)
+		}
+		g.connect(h.id, h.builtBy.id, model.EdgeHasSlsa)
+	}
+	for _, d := range c.dependencies.order {
+		d := d
+		g.add(d.id, func() model.Node { return d.toModel() })
+		g.connect(d.id, d.pkg.id, model.EdgeIsDependency)
+		g.connect(d.id, d.depPkg.id, model.EdgeIsDependency)
+	}
+	for _, o := range c.occurrences.order {
+		o := o
+		g.add(o.id, func() model.Node { return o.toModel() })
+		if o.pkg != nil {
+			g.connect(o.id, o.pkg.id, model.EdgeIsOccurrence)
+		} else {
+			g.connect(o.id, o.src.id, model.EdgeIsOccurrence)
+		}
+		g.connect(o.id, o.artifact.id, model.EdgeIsOccurrence)
+	}
+	for _, p := range c.pkgEquals.order {
+		p := p
+		g.add(p.id, func() model.Node { return p.toModel(p.packages[0], p.packages[1]) })
+		for _, v := range p.packages {
+			g.connect(p.id, v.id, model.EdgePkgEqual)
+		}
+	}
+	for _, n := range c.contacts.order {
+		n := n
+		g.add(n.id, func() model.Node { return n.toModel() })
+		g.connect(n.id, n.subject.id(), model.EdgePointOfContact)
+	}
+	for _, v := range c.vulnEquals.order {
+		v := v
+		g.add(v.id, func() model.Node { return v.toModel() })
+		for _, id := range v.vulns {
+			g.connect(v.id, id.id, model.EdgeVulnEqual)
+		}
+	}
+	return g
+}
+
+// addTries adds the nodes of the package, source and vulnerability tries,
+// connected to their parent.
+func (c *inmemClient) addTries(g graph) {
+	for _, t := range c.packages.order {
+		t := t
+		g.add(t.id, func() model.Node { return t.toPackage() })
+		for _, ns := range t.namespaces.order {
+			ns := ns
+			g.add(ns.id, func() model.Node { return ns.toPackage() })
+			g.connect(t.id, ns.id, model.EdgePackageTrie)
+			for _, n := range ns.names.order {
+				n := n
+				g.add(n.id, func() model.Node { return n.toPackage() })
+				g.connect(ns.id, n.id, model.EdgePackageTrie)
+				for _, v := range n.versions.order {
+					v := v
+					g.add(v.id, func() model.Node { return v.toPackage() })
+					g.connect(n.id, v.id, model.EdgePackageTrie)
+				}
+			}
+		}
+	}
+	for _, t := range c.sources.order {
+		t := t
+		g.add(t.id, func() model.Node { return t.toSource() })
+		for _, ns := range t.namespaces.order {
+			ns := ns
+			g.add(ns.id, func() model.Node { return ns.toSource() })
+			g.connect(t.id, ns.id, model.EdgeSourceTrie)
+			for _, n := range ns.names.order {
+				n := n
+				g.add(n.id, func() model.Node { return n.toSource() })
+				g.connect(ns.id, n.id, model.EdgeSourceTrie)
+			}
+		}
+	}
+	for _, t := range c.vulns.order {
+		t := t
+		g.add(t.id, func() model.Node { return t.toVulnerability() })
+		for _, v := range t.vulnIDs.order {
+			v := v
+			g.add(v.id, func() model.Node { return v.toVulnerability() })
+			g.connect(t.id, v.id, model.EdgeVulnerabilityTrie)
+		}
+	}
+}
+
+// Query Neighbors
+
+func (c *inmemClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	g := c.graph()
+	if _, ok := g[node]; !ok {
+		return nil, backends.Errorf("Neighbors :: node %q %w", node, backends.ErrNotFound)
+	}
+	return g.toModel(g.neighbors(node, usingOnly)), nil
+}
+
+// Query Path
+
+func (c *inmemClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if maxPathLength <= 0 {
+		return nil, backends.Errorf("Path :: %w", backends.NewValidationError("maxPathLength", "maxPathLength must be positive"))
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	g := c.graph()
+	for _, id := range []string{subject, target} {
+		if _, ok := g[id]; !ok {
+			return nil, backends.Errorf("Path :: node %q %w", id, backends.ErrNotFound)
+		}
+	}
+	ids, err := backends.ShortestPath(ctx, subject, target, maxPathLength,
+		func(ctx context.Context, id string) ([]string, error) {
+			return g.neighbors(id, usingOnly), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return g.toModel(ids), nil
+}
@@ -0,0 +1,396 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: the package trie. Every level is indexed by the value (or
+// values) which identify a node among its siblings.
+
+type pkgTypeNode struct {
+	id         string
+	typeKey    string
+	namespaces children[*pkgNamespaceNode]
+}
+
+type pkgNamespaceNode struct {
+	id        string
+	parent    *pkgTypeNode
+	namespace string
+	names     children[*pkgNameNode]
+}
+
+type pkgNameNode struct {
+	id       string
+	parent   *pkgNamespaceNode
+	name     string
+	versions children[*pkgVersionNode]
+}
+
+type pkgVersionNode struct {
+	id         string
+	parent     *pkgNameNode
+	version    string
+	subpath    string
+	qualifiers []*model.PackageQualifier
+}
+
+// versionKey identifies a version node among the versions of the same
+// package name. Qualifiers are compared regardless of their order.
+func versionKey(version, subpath string, qualifiers []*model.PackageQualifier) string {
+	pairs := make([]string, 0, len(qualifiers))
+	for _, q := range qualifiers {
+		pairs = append(pairs, q.Key+"="+q.Value)
+	}
+	sort.Strings(pairs)
+	return version + "@" + subpath + "?" + strings.Join(pairs, "&")
+}
+
+// Ingest Package
+
+func (c *inmemClient) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPackage :: %w", backends.Missing("pkg"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.ingestPackage(pkg).toPackage(), nil
+}
+
+func (c *inmemClient) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for i, pkg := range pkgs {
+		if pkg == nil {
+			return nil, backends.Errorf("IngestPackages :: %w", backends.NewValidationError("pkgs", "missing package at index %d", i))
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	out := make([]*model.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		out = append(out, c.ingestPackage(pkg).toPackage())
+	}
+	return out, nil
+}
+
+// ingestPackage adds the package to the trie, creating only the missing
+// nodes, and returns the version node. Must be called with the write lock
+// held.
+func (c *inmemClient) ingestPackage(pkg *model.PkgInputSpec) *pkgVersionNode {
+	pkg = backends.CanonicalPkgInputSpec(pkg)
+	n := c.ingestPackageName(pkg)
+
+	qualifiers := make([]*model.PackageQualifier, 0, len(pkg.Qualifiers))
+	for _, q := range pkg.Qualifiers {
+		qualifiers = append(qualifiers, &model.PackageQualifier{Key: q.Key, Value: q.Value})
+	}
+	version := derefOrEmpty(pkg.Version)
+	subpath := derefOrEmpty(pkg.Subpath)
+	key := versionKey(version, subpath, qualifiers)
+	v, ok := n.versions.get(key)
+	if !ok {
+		v = &pkgVersionNode{
+			id:         c.nextID(),
+			parent:     n,
+			version:    version,
+			subpath:    subpath,
+			qualifiers: qualifiers,
+		}
+		n.versions.add(key, v)
+	}
+	return v
+}
+
+// ingestPackageName is like ingestPackage but stops at the name level of the
+// trie, ignoring the version, qualifiers and subpath of the input.
+func (c *inmemClient) ingestPackageName(pkg *model.PkgInputSpec) *pkgNameNode {
+	pkg = backends.CanonicalPkgInputSpec(pkg)
+	t, ok := c.packages.get(pkg.Type)
+	if !ok {
+		t = &pkgTypeNode{id: c.nextID(), typeKey: pkg.Type}
+		c.packages.add(pkg.Type, t)
+	}
+
+	namespace := derefOrEmpty(pkg.Namespace)
+	ns, ok := t.namespaces.get(namespace)
+	if !ok {
+		ns = &pkgNamespaceNode{id: c.nextID(), parent: t, namespace: namespace}
+		t.namespaces.add(namespace, ns)
+	}
+
+	n, ok := ns.names.get(pkg.Name)
+	if !ok {
+		n = &pkgNameNode{id: c.nextID(), parent: ns, name: pkg.Name}
+		ns.names.add(pkg.Name, n)
+	}
+	return n
+}
+
+// Query Packages
+
+func (c *inmemClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkgSpec == nil {
+		pkgSpec = &model.PkgSpec{}
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.Package
+	for _, t := range c.packages.order {
+		if !matchString(pkgSpec.Type, t.typeKey) {
+			continue
+		}
+		namespaces := t.filterNamespaces(pkgSpec)
+		if len(namespaces) == 0 && (pkgSpec.Namespace != nil || filtersPackageName(pkgSpec)) {
+			continue
+		}
+		out = append(out, &model.Package{
+			ID:         t.id,
+			Type:       t.typeKey,
+			Namespaces: namespaces,
+		})
+	}
+	return out, nil
+}
+
+// PackagesByPurls looks the packages of all the purls up in the trie under a
+// single read lock. A purl matches at most one name, below which the versions
+// are filtered as in Packages.
+func (c *inmemClient) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	specs, err := backends.PurlPkgSpecs(purls)
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	out := make(map[string]*model.Package, len(specs))
+	for purl, pkgSpec := range specs {
+		t, ok := c.packages.get(*pkgSpec.Type)
+		if !ok {
+			continue
+		}
+		ns, ok := t.namespaces.get(*pkgSpec.Namespace)
+		if !ok {
+			continue
+		}
+		n, ok := ns.names.get(*pkgSpec.Name)
+		if !ok {
+			continue
+		}
+		versions := n.filterVersions(pkgSpec)
+		if len(versions) == 0 && filtersPackageVersion(pkgSpec) {
+			continue
+		}
+		p := n.toPackage()
+		p.Namespaces[0].Names[0].Versions = versions
+		out[purl] = p
+	}
+	return out, err
+}
+
+func (t *pkgTypeNode) filterNamespaces(pkgSpec *model.PkgSpec) []*model.PackageNamespace {
+	var out []*model.PackageNamespace
+	for _, ns := range t.namespaces.order {
+		if !matchString(pkgSpec.Namespace, ns.namespace) {
+			continue
+		}
+		names := ns.filterNames(pkgSpec)
+		if len(names) == 0 && filtersPackageName(pkgSpec) {
+			continue
+		}
+		out = append(out, &model.PackageNamespace{
+			ID:        ns.id,
+			Namespace: ns.namespace,
+			Names:     names,
+		})
+	}
+	return out
+}
+
+func (ns *pkgNamespaceNode) filterNames(pkgSpec *model.PkgSpec) []*model.PackageName {
+	var out []*model.PackageName
+	for _, n := range ns.names.order {
+		if !matchString(pkgSpec.Name, n.name) {
+			continue
+		}
+		versions := n.filterVersions(pkgSpec)
+		if len(versions) == 0 && filtersPackageVersion(pkgSpec) {
+			continue
+		}
+		out = append(out, &model.PackageName{
+			ID:       n.id,
+			Name:     n.name,
+			Versions: versions,
+		})
+	}
+	return out
+}
+
+func (n *pkgNameNode) filterVersions(pkgSpec *model.PkgSpec) []*model.PackageVersion {
+	var out []*model.PackageVersion
+	for _, v := range n.versions.order {
+		if !matchString(pkgSpec.Version, v.version) || !matchString(pkgSpec.Subpath, v.subpath) || !v.matchQualifiers(pkgSpec) {
+			continue
+		}
+		out = append(out, v.toModel())
+	}
+	return out
+}
+
+func (v *pkgVersionNode) toModel() *model.PackageVersion {
+	qualifiers := make([]*model.PackageQualifier, 0, len(v.qualifiers))
+	for _, q := range v.qualifiers {
+		qualifiers = append(qualifiers, &model.PackageQualifier{Key: q.Key, Value: q.Value})
+	}
+	return &model.PackageVersion{
+		ID:         v.id,
+		Version:    v.version,
+		Subpath:    v.subpath,
+		Qualifiers: qualifiers,
+	}
+}
+
+// toPackage returns this type node, without any namespace.
+func (t *pkgTypeNode) toPackage() *model.Package {
+	return &model.Package{
+		ID:         t.id,
+		Type:       t.typeKey,
+		Namespaces: []*model.PackageNamespace{},
+	}
+}
+
+// toPackage returns the path from the root of the trie down to this
+// namespace node, without any name.
+func (ns *pkgNamespaceNode) toPackage() *model.Package {
+	p := ns.parent.toPackage()
+	p.Namespaces = []*model.PackageNamespace{{
+		ID:        ns.id,
+		Namespace: ns.namespace,
+		Names:     []*model.PackageName{},
+	}}
+	return p
+}
+
+// toPackage returns the path from the root of the trie down to this name
+// node, without any version.
+func (n *pkgNameNode) toPackage() *model.Package {
+	ns := n.parent
+	t := ns.parent
+	return &model.Package{
+		ID:   t.id,
+		Type: t.typeKey,
+		Namespaces: []*model.PackageNamespace{{
+			ID:        ns.id,
+			Namespace: ns.namespace,
+			Names: []*model.PackageName{{
+				ID:       n.id,
+				Name:     n.name,
+				Versions: []*model.PackageVersion{},
+			}},
+		}},
+	}
+}
+
+// toPackage returns the path from the root of the trie down to this version
+// node.
+func (v *pkgVersionNode) toPackage() *model.Package {
+	p := v.parent.toPackage()
+	p.Namespaces[0].Names[0].Versions = []*model.PackageVersion{v.toModel()}
+	return p
+}
+
+// matches returns true if the name node and its ancestors match the type,
+// namespace and name filters of the canonicalized spec. The version filters
+// are ignored.
+func (n *pkgNameNode) matches(pkgSpec *model.PkgSpec) bool {
+	if pkgSpec == nil {
+		return true
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	return matchString(pkgSpec.Name, n.name) &&
+		matchString(pkgSpec.Namespace, n.parent.namespace) &&
+		matchString(pkgSpec.Type, n.parent.parent.typeKey)
+}
+
+// matches returns true if the version node and its ancestors match the
+// canonicalized spec.
+func (v *pkgVersionNode) matches(pkgSpec *model.PkgSpec) bool {
+	if pkgSpec == nil {
+		return true
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	return matchString(pkgSpec.Version, v.version) &&
+		matchString(pkgSpec.Subpath, v.subpath) &&
+		v.matchQualifiers(pkgSpec) &&
+		v.parent.matches(pkgSpec)
+}
+
+// matchQualifiers returns true if the version has all the qualifiers of the
+// canonicalized spec, or none if it matches only empty qualifiers.
+func (v *pkgVersionNode) matchQualifiers(pkgSpec *model.PkgSpec) bool {
+	if pkgSpec.MatchOnlyEmptyQualifiers != nil {
+		return len(v.qualifiers) == 0
+	}
+	for _, want := range pkgSpec.Qualifiers {
+		found := false
+		for _, q := range v.qualifiers {
+			if q.Key == want.Key && matchString(want.Value, q.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filtersPackageName returns true if the spec filters on the name level of
+// the trie or below it.
+func filtersPackageName(pkgSpec *model.PkgSpec) bool {
+	return pkgSpec.Name != nil || filtersPackageVersion(pkgSpec)
+}
+
+// filtersPackageVersion returns true if the spec filters on the version level
+// of the trie.
+func filtersPackageVersion(pkgSpec *model.PkgSpec) bool {
+	return pkgSpec.Version != nil || pkgSpec.Subpath != nil ||
+		len(pkgSpec.Qualifiers) > 0 || pkgSpec.MatchOnlyEmptyQualifiers != nil
+}
@@ -0,0 +1,149 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// pkgEqualNode links two package versions which are the same package. Like
+// hashEqualNode, the key of the node does not depend on the order of the
+// packages.
+type pkgEqualNode struct {
+	id            string
+	packages      [2]*pkgVersionNode
+	justification string
+	origin        string
+	collector     string
+}
+
+func (p *pkgEqualNode) key() string {
+	first, second := p.packages[0].id, p.packages[1].id
+	if second < first {
+		first, second = second, first
+	}
+	return strings.Join([]string{first, second, p.justification, p.origin, p.collector}, "\x00")
+}
+
+// toModel returns the node with its packages in the given order.
+func (p *pkgEqualNode) toModel(first, second *pkgVersionNode) *model.PkgEqual {
+	return &model.PkgEqual{
+		ID:            p.id,
+		Packages:      []*model.Package{first.toPackage(), second.toPackage()},
+		Justification: p.justification,
+		Origin:        p.origin,
+		Collector:     p.collector,
+	}
+}
+
+// Ingest PkgEqual
+
+func (c *inmemClient) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkg"))
+	}
+	if otherPackage == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("otherPackage"))
+	}
+	if pkgEqual == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkgEqual"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a := c.ingestPackage(pkg)
+	b := c.ingestPackage(otherPackage)
+	if a == b {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.NewValidationError("otherPackage", "a package cannot be certified equal to itself"))
+	}
+	p := &pkgEqualNode{
+		packages:      [2]*pkgVersionNode{a, b},
+		justification: pkgEqual.Justification,
+		origin:        pkgEqual.Origin,
+		collector:     pkgEqual.Collector,
+	}
+	key := p.key()
+	if existing, ok := c.pkgEquals.get(key); ok {
+		p = existing
+	} else {
+		p.id = c.nextID()
+		c.pkgEquals.add(key, p)
+	}
+	return p.toModel(a, b), nil
+}
+
+// Query PkgEqual
+
+func (c *inmemClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pkgEqualSpec == nil {
+		pkgEqualSpec = &model.PkgEqualSpec{}
+	}
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, backends.Errorf("PkgEqual :: %w", backends.NewValidationError("packages", "cannot filter on more than 2 packages"))
+	}
+	if pkgEqualSpec.ExpandTransitive != nil && *pkgEqualSpec.ExpandTransitive {
+		return backends.ExpandPkgEqual(ctx, c, pkgEqualSpec)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.PkgEqual
+	for _, p := range c.pkgEquals.order {
+		if first, second, ok := p.matches(pkgEqualSpec); ok {
+			out = append(out, p.toModel(first, second))
+		}
+	}
+	return out, nil
+}
+
+// matches returns true if the node matches the spec, along with its packages
+// ordered so that the first one matches the first package filter.
+func (p *pkgEqualNode) matches(spec *model.PkgEqualSpec) (*pkgVersionNode, *pkgVersionNode, bool) {
+	a, b := p.packages[0], p.packages[1]
+	if !matchString(spec.ID, p.id) ||
+		!matchString(spec.Justification, p.justification) ||
+		!matchString(spec.Origin, p.origin) ||
+		!matchString(spec.Collector, p.collector) {
+		return a, b, false
+	}
+	switch len(spec.Packages) {
+	case 0:
+		return a, b, true
+	case 1:
+		if a.matches(spec.Packages[0]) {
+			return a, b, true
+		}
+		return b, a, b.matches(spec.Packages[0])
+	default:
+		first, second := spec.Packages[0], spec.Packages[1]
+		if a.matches(first) && b.matches(second) {
+			return a, b, true
+		}
+		return b, a, b.matches(first) && a.matches(second)
+	}
+}
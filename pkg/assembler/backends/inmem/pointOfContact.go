@@ -0,0 +1,128 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// pointOfContactNode links a subject to the contact information of its
+// owners. Like for CertifyBad, a package subject is either a version or a
+// name.
+type pointOfContactNode struct {
+	id            string
+	subject       subjectNode
+	email         string
+	info          string
+	since         time.Time
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *pointOfContactNode) key() string {
+	return strings.Join([]string{n.subject.id(), n.email, n.info, n.since.Format(time.RFC3339Nano),
+		n.justification, n.origin, n.collector}, "\x00")
+}
+
+func (n *pointOfContactNode) toModel() *model.PointOfContact {
+	return &model.PointOfContact{
+		ID:            n.id,
+		Subject:       n.subject.toModel(),
+		Email:         n.email,
+		Info:          n.info,
+		Since:         n.since,
+		Justification: n.justification,
+		Origin:        n.origin,
+		Collector:     n.collector,
+	}
+}
+
+func (n *pointOfContactNode) matches(spec *model.PointOfContactSpec) bool {
+	return matchString(spec.ID, n.id) &&
+		matchString(spec.Email, n.email) &&
+		matchString(spec.Info, n.info) &&
+		(spec.Since == nil || spec.Since.Equal(n.since)) &&
+		matchString(spec.Justification, n.justification) &&
+		matchString(spec.Origin, n.origin) &&
+		matchString(spec.Collector, n.collector) &&
+		n.subject.matches(spec.Subject)
+}
+
+// Ingest PointOfContact
+
+func (c *inmemClient) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pointOfContact == nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", backends.Missing("pointOfContact"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, err := c.ingestSubject(subject, pkgMatchType)
+	if err != nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", err)
+	}
+	n := &pointOfContactNode{
+		subject:       s,
+		email:         pointOfContact.Email,
+		info:          pointOfContact.Info,
+		since:         pointOfContact.Since.UTC(),
+		justification: pointOfContact.Justification,
+		origin:        pointOfContact.Origin,
+		collector:     pointOfContact.Collector,
+	}
+	key := n.key()
+	if existing, ok := c.contacts.get(key); ok {
+		return existing.toModel(), nil
+	}
+	n.id = c.nextID()
+	c.contacts.add(key, n)
+	return n.toModel(), nil
+}
+
+// Query PointOfContact
+
+func (c *inmemClient) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pointOfContactSpec == nil {
+		pointOfContactSpec = &model.PointOfContactSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(pointOfContactSpec.Subject); err != nil {
+		return nil, backends.Errorf("PointOfContact :: %w", err)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.PointOfContact
+	for _, n := range c.contacts.order {
+		if n.matches(pointOfContactSpec) {
+			out = append(out, n.toModel())
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,264 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: the source trie. Every level is indexed by the value (or
+// values) which identify a node among its siblings.
+
+type srcTypeNode struct {
+	id         string
+	typeKey    string
+	namespaces children[*srcNamespaceNode]
+}
+
+type srcNamespaceNode struct {
+	id        string
+	parent    *srcTypeNode
+	namespace string
+	names     children[*srcNameNode]
+}
+
+type srcNameNode struct {
+	id     string
+	parent *srcNamespaceNode
+	name   string
+	tag    *string
+	commit *string
+}
+
+// nameKey identifies a name node among the names in the same namespace.
+func nameKey(name string, tag, commit *string) string {
+	return name + "@tag:" + derefOrEmpty(tag) + "@commit:" + derefOrEmpty(commit)
+}
+
+// Ingest Source
+
+func (c *inmemClient) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, backends.Errorf("IngestSource :: %w", backends.Missing("source"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n, err := c.ingestSource(source)
+	if err != nil {
+		return nil, err
+	}
+	return n.toSource(), nil
+}
+
+func (c *inmemClient) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Validate the whole batch first, so that nothing is ingested if any
+	// source is invalid.
+	for i, source := range sources {
+		if source == nil {
+			return nil, backends.Errorf("IngestSources :: %w", backends.NewValidationError("sources", "missing source at index %d", i))
+		}
+		if err := backends.ValidateSourceInput(source); err != nil {
+			return nil, backends.Errorf("IngestSources :: source at index %d: %w", i, err)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	out := make([]*model.Source, 0, len(sources))
+	for _, source := range sources {
+		n, err := c.ingestSource(source)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n.toSource())
+	}
+	return out, nil
+}
+
+// ingestSource adds the source to the trie, creating only the missing nodes,
+// and returns the name node. Must be called with the write lock held.
+func (c *inmemClient) ingestSource(source *model.SourceInputSpec) (*srcNameNode, error) {
+	if err := backends.ValidateSourceInput(source); err != nil {
+		return nil, backends.Errorf("%w", err)
+	}
+	source = backends.CanonicalSourceInputSpec(source)
+	tag := nilIfEmpty(source.Tag)
+	commit := nilIfEmpty(source.Commit)
+
+	t, ok := c.sources.get(source.Type)
+	if !ok {
+		t = &srcTypeNode{id: c.nextID(), typeKey: source.Type}
+		c.sources.add(source.Type, t)
+	}
+
+	ns, ok := t.namespaces.get(source.Namespace)
+	if !ok {
+		ns = &srcNamespaceNode{id: c.nextID(), parent: t, namespace: source.Namespace}
+		t.namespaces.add(source.Namespace, ns)
+	}
+
+	key := nameKey(source.Name, tag, commit)
+	n, ok := ns.names.get(key)
+	if !ok {
+		n = &srcNameNode{
+			id:     c.nextID(),
+			parent: ns,
+			name:   source.Name,
+			tag:    tag,
+			commit: commit,
+		}
+		ns.names.add(key, n)
+	}
+	return n, nil
+}
+
+// Query Sources
+
+func (c *inmemClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if sourceSpec == nil {
+		sourceSpec = &model.SourceSpec{}
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" || *sourceSpec.Tag != "" {
+			return nil, backends.Errorf("%w", backends.NewValidationError("commit", "Passing both commit and tag selectors is an error"))
+		}
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.Source
+	for _, t := range c.sources.order {
+		if !matchString(sourceSpec.Type, t.typeKey) {
+			continue
+		}
+		namespaces := t.filterNamespaces(sourceSpec)
+		if len(namespaces) == 0 && (sourceSpec.Namespace != nil || filtersSourceName(sourceSpec)) {
+			continue
+		}
+		out = append(out, &model.Source{
+			ID:         t.id,
+			Type:       t.typeKey,
+			Namespaces: namespaces,
+		})
+	}
+	return out, nil
+}
+
+func (t *srcTypeNode) filterNamespaces(sourceSpec *model.SourceSpec) []*model.SourceNamespace {
+	var out []*model.SourceNamespace
+	for _, ns := range t.namespaces.order {
+		if !matchString(sourceSpec.Namespace, ns.namespace) {
+			continue
+		}
+		var names []*model.SourceName
+		for _, n := range ns.names.order {
+			if n.matches(sourceSpec) {
+				names = append(names, n.toModel())
+			}
+		}
+		if len(names) == 0 && filtersSourceName(sourceSpec) {
+			continue
+		}
+		out = append(out, &model.SourceNamespace{
+			ID:        ns.id,
+			Namespace: ns.namespace,
+			Names:     names,
+		})
+	}
+	return out
+}
+
+// matches returns true if the name node and its ancestors match the
+// canonicalized spec.
+func (n *srcNameNode) matches(sourceSpec *model.SourceSpec) bool {
+	if sourceSpec == nil {
+		return true
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	return matchString(sourceSpec.Name, n.name) &&
+		matchString(sourceSpec.Tag, derefOrEmpty(n.tag)) &&
+		matchString(sourceSpec.Commit, derefOrEmpty(n.commit)) &&
+		matchString(sourceSpec.Namespace, n.parent.namespace) &&
+		matchString(sourceSpec.Type, n.parent.parent.typeKey)
+}
+
+func (n *srcNameNode) toModel() *model.SourceName {
+	return &model.SourceName{
+		ID:     n.id,
+		Name:   n.name,
+		Tag:    nilIfEmpty(n.tag),
+		Commit: nilIfEmpty(n.commit),
+	}
+}
+
+// toSource returns this type node, without any namespace.
+func (t *srcTypeNode) toSource() *model.Source {
+	return &model.Source{
+		ID:         t.id,
+		Type:       t.typeKey,
+		Namespaces: []*model.SourceNamespace{},
+	}
+}
+
+// toSource returns the path from the root of the trie down to this namespace
+// node, without any name.
+func (ns *srcNamespaceNode) toSource() *model.Source {
+	s := ns.parent.toSource()
+	s.Namespaces = []*model.SourceNamespace{{
+		ID:        ns.id,
+		Namespace: ns.namespace,
+		Names:     []*model.SourceName{},
+	}}
+	return s
+}
+
+// toSource returns the path from the root of the trie down to this name node.
+func (n *srcNameNode) toSource() *model.Source {
+	ns := n.parent
+	t := ns.parent
+	return &model.Source{
+		ID:   t.id,
+		Type: t.typeKey,
+		Namespaces: []*model.SourceNamespace{{
+			ID:        ns.id,
+			Namespace: ns.namespace,
+			Names:     []*model.SourceName{n.toModel()},
+		}},
+	}
+}
+
+// filtersSourceName returns true if the spec filters on the name level of the
+// trie.
+func filtersSourceName(sourceSpec *model.SourceSpec) bool {
+	return sourceSpec.Name != nil || sourceSpec.Tag != nil || sourceSpec.Commit != nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// subjectNode is the subject of a certification which can apply to a
+// package, a source or an artifact. Exactly one of the fields is set: a
+// package subject is either a version or, for certifications applying to all
+// the versions of the package, a name.
+type subjectNode struct {
+	pkgVersion *pkgVersionNode
+	pkgName    *pkgNameNode
+	src        *srcNameNode
+	artifact   *artifactNode
+}
+
+// ingestSubject returns the subject node matching the input, creating it if
+// needed. For package subjects, pkgMatchType selects the level of the
+// package trie, defaulting to the version. Must be called with the write lock
+// held.
+func (c *inmemClient) ingestSubject(subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType) (subjectNode, error) {
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return subjectNode{}, err
+	}
+	switch {
+	case subject.Package != nil:
+		if pkgMatchType != nil && *pkgMatchType == model.PkgMatchTypeAllVersions {
+			return subjectNode{pkgName: c.ingestPackageName(subject.Package)}, nil
+		}
+		return subjectNode{pkgVersion: c.ingestPackage(subject.Package)}, nil
+	case subject.Source != nil:
+		src, err := c.ingestSource(subject.Source)
+		return subjectNode{src: src}, err
+	default:
+		a, err := c.ingestArtifact(subject.Artifact)
+		return subjectNode{artifact: a}, err
+	}
+}
+
+func (s subjectNode) id() string {
+	switch {
+	case s.pkgVersion != nil:
+		return s.pkgVersion.id
+	case s.pkgName != nil:
+		return s.pkgName.id
+	case s.src != nil:
+		return s.src.id
+	default:
+		return s.artifact.id
+	}
+}
+
+func (s subjectNode) toModel() model.PackageSourceOrArtifact {
+	switch {
+	case s.pkgVersion != nil:
+		return s.pkgVersion.toPackage()
+	case s.pkgName != nil:
+		return s.pkgName.toPackage()
+	case s.src != nil:
+		return s.src.toSource()
+	default:
+		return s.artifact.toModel()
+	}
+}
+
+// matches returns true if the subject matches the spec. Package names match
+// the package filters regardless of the version filters, as they stand for
+// all the versions of the package.
+func (s subjectNode) matches(spec *model.PackageSourceOrArtifactSpec) bool {
+	switch {
+	case spec == nil:
+		return true
+	case spec.Package != nil:
+		return (s.pkgVersion != nil && s.pkgVersion.matches(spec.Package)) ||
+			(s.pkgName != nil && s.pkgName.matches(spec.Package))
+	case spec.Source != nil:
+		return s.src != nil && s.src.matches(spec.Source)
+	case spec.Artifact != nil:
+		return s.artifact != nil && s.artifact.matches(spec.Artifact)
+	}
+	return true
+}
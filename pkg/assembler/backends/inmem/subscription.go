@@ -0,0 +1,108 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// subscribers is the registry of the subscriptions to the ingestion of some
+// nodes. Every event is queued for every subscriber, so a slow subscriber
+// neither misses events nor blocks the ingestion or the other subscribers.
+// The zero value is an empty registry.
+type subscribers[T any] struct {
+	lock sync.Mutex
+	set  map[*subscriber[T]]struct{}
+}
+
+// subscriber is the queue of the events not yet received by a subscriber.
+type subscriber[T any] struct {
+	lock    sync.Mutex
+	pending []T
+	// notify has a buffer of one, so that publishing never blocks and a
+	// notification sent while the events are forwarded is not lost.
+	notify chan struct{}
+}
+
+// subscribe registers a new subscriber and returns the channel receiving its
+// events, converted by toModel. The subscriber is removed and the channel is
+// closed once ctx is done.
+func subscribe[T, M any](ctx context.Context, s *subscribers[T], toModel func(T) M) <-chan M {
+	sub := &subscriber[T]{notify: make(chan struct{}, 1)}
+	s.lock.Lock()
+	if s.set == nil {
+		s.set = map[*subscriber[T]]struct{}{}
+	}
+	s.set[sub] = struct{}{}
+	s.lock.Unlock()
+
+	out := make(chan M)
+	go func() {
+		defer close(out)
+		defer func() {
+			s.lock.Lock()
+			delete(s.set, sub)
+			s.lock.Unlock()
+		}()
+		for {
+			sub.lock.Lock()
+			events := sub.pending
+			sub.pending = nil
+			sub.lock.Unlock()
+
+			for _, e := range events {
+				select {
+				case out <- toModel(e):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-sub.notify:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// publish queues the event for all the current subscribers. It never blocks,
+// so it can be called with the write lock of the backend held.
+func (s *subscribers[T]) publish(e T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for sub := range s.set {
+		sub.lock.Lock()
+		sub.pending = append(sub.pending, e)
+		sub.lock.Unlock()
+		select {
+		case sub.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (c *inmemClient) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return subscribe(ctx, &c.artifactSubscribers, (*artifactNode).toModel), nil
+}
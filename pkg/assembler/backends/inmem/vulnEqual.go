@@ -0,0 +1,143 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// vulnEqualNode links two vulnerability IDs describing the same
+// vulnerability, usually of different types. Like hashEqualNode, the key of
+// the node does not depend on the order of the vulnerabilities.
+type vulnEqualNode struct {
+	id            string
+	vulns         [2]*vulnIDNode
+	justification string
+	origin        string
+	collector     string
+}
+
+func (v *vulnEqualNode) key() string {
+	first, second := v.vulns[0].id, v.vulns[1].id
+	if second < first {
+		first, second = second, first
+	}
+	return strings.Join([]string{first, second, v.justification, v.origin, v.collector}, "\x00")
+}
+
+func (v *vulnEqualNode) toModel() *model.VulnEqual {
+	return &model.VulnEqual{
+		ID:              v.id,
+		Vulnerabilities: []*model.Vulnerability{v.vulns[0].toVulnerability(), v.vulns[1].toVulnerability()},
+		Justification:   v.justification,
+		Origin:          v.origin,
+		Collector:       v.collector,
+	}
+}
+
+// Ingest VulnEqual
+
+func (c *inmemClient) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnerability"))
+	}
+	if otherVulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("otherVulnerability"))
+	}
+	if vulnEqual == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnEqual"))
+	}
+	// Validate both vulnerabilities first, so that nothing is ingested on
+	// errors.
+	for _, v := range []*model.VulnerabilityInputSpec{vulnerability, otherVulnerability} {
+		if _, _, err := canonicalVulnerability(v); err != nil {
+			return nil, backends.Errorf("IngestVulnEqual :: %w", err)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a, _ := c.ingestVulnerability(vulnerability)
+	b, _ := c.ingestVulnerability(otherVulnerability)
+	if a == b {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.NewValidationError("otherVulnerability", "a vulnerability cannot be certified equal to itself"))
+	}
+	v := &vulnEqualNode{
+		vulns:         [2]*vulnIDNode{a, b},
+		justification: vulnEqual.Justification,
+		origin:        vulnEqual.Origin,
+		collector:     vulnEqual.Collector,
+	}
+	key := v.key()
+	if existing, ok := c.vulnEquals.get(key); ok {
+		return existing.toModel(), nil
+	}
+	v.id = c.nextID()
+	c.vulnEquals.add(key, v)
+	return v.toModel(), nil
+}
+
+// Query VulnEqual
+
+func (c *inmemClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vulnEqualSpec == nil {
+		vulnEqualSpec = &model.VulnEqualSpec{}
+	}
+	if len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, backends.Errorf("VulnEqual :: %w", backends.NewValidationError("vulnerabilities", "cannot filter on more than 2 vulnerabilities"))
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.VulnEqual
+	for _, v := range c.vulnEquals.order {
+		if v.matches(vulnEqualSpec) {
+			out = append(out, v.toModel())
+		}
+	}
+	return out, nil
+}
+
+func (v *vulnEqualNode) matches(spec *model.VulnEqualSpec) bool {
+	if !matchString(spec.ID, v.id) ||
+		!matchString(spec.Justification, v.justification) ||
+		!matchString(spec.Origin, v.origin) ||
+		!matchString(spec.Collector, v.collector) {
+		return false
+	}
+	a, b := v.vulns[0], v.vulns[1]
+	switch len(spec.Vulnerabilities) {
+	case 0:
+		return true
+	case 1:
+		return a.matches(spec.Vulnerabilities[0]) || b.matches(spec.Vulnerabilities[0])
+	default:
+		first, second := spec.Vulnerabilities[0], spec.Vulnerabilities[1]
+		return (a.matches(first) && b.matches(second)) || (a.matches(second) && b.matches(first))
+	}
+}
@@ -0,0 +1,159 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inmem
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: the vulnerability trie, indexed by the lowercase type and
+// vulnerability ID.
+
+type vulnTypeNode struct {
+	id      string
+	typeKey string
+	vulnIDs children[*vulnIDNode]
+}
+
+type vulnIDNode struct {
+	id              string
+	parent          *vulnTypeNode
+	vulnerabilityID string
+}
+
+// Ingest Vulnerability
+
+func (c *inmemClient) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if vuln == nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", backends.Missing("vuln"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	v, err := c.ingestVulnerability(vuln)
+	if err != nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", err)
+	}
+	return v.toVulnerability(), nil
+}
+
+// canonicalVulnerability returns the lowercase type and vulnerability ID of
+// the input, validating that they are not empty.
+func canonicalVulnerability(vuln *model.VulnerabilityInputSpec) (string, string, error) {
+	vulnType := strings.ToLower(strings.TrimSpace(vuln.Type))
+	vulnID := strings.ToLower(strings.TrimSpace(vuln.VulnerabilityID))
+	if vulnType == "" {
+		return "", "", backends.NewValidationError("type", "type must not be empty")
+	}
+	if vulnID == "" {
+		return "", "", backends.NewValidationError("vulnerabilityID", "vulnerability ID must not be empty")
+	}
+	return vulnType, vulnID, nil
+}
+
+// ingestVulnerability adds the vulnerability to the trie, creating only the
+// missing nodes, and returns the vulnerability ID node. Must be called with
+// the write lock held.
+func (c *inmemClient) ingestVulnerability(vuln *model.VulnerabilityInputSpec) (*vulnIDNode, error) {
+	vulnType, vulnID, err := canonicalVulnerability(vuln)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := c.vulns.get(vulnType)
+	if !ok {
+		t = &vulnTypeNode{id: c.nextID(), typeKey: vulnType}
+		c.vulns.add(vulnType, t)
+	}
+
+	v, ok := t.vulnIDs.get(vulnID)
+	if !ok {
+		v = &vulnIDNode{id: c.nextID(), parent: t, vulnerabilityID: vulnID}
+		t.vulnIDs.add(vulnID, v)
+	}
+	return v, nil
+}
+
+// Query Vulnerabilities
+
+func (c *inmemClient) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var out []*model.Vulnerability
+	for _, t := range c.vulns.order {
+		var ids []*model.VulnerabilityID
+		for _, v := range t.vulnIDs.order {
+			if v.matches(vulnSpec) {
+				ids = append(ids, v.toModel())
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		out = append(out, &model.Vulnerability{
+			ID:               t.id,
+			Type:             t.typeKey,
+			VulnerabilityIDs: ids,
+		})
+	}
+	return out, nil
+}
+
+// matches returns true if the vulnerability ID node and its parent match the
+// spec.
+func (v *vulnIDNode) matches(vulnSpec *model.VulnerabilitySpec) bool {
+	if vulnSpec == nil {
+		return true
+	}
+	return matchString(vulnSpec.ID, v.id) &&
+		matchString(backends.LowerIfSet(vulnSpec.Type), v.parent.typeKey) &&
+		matchString(backends.LowerIfSet(vulnSpec.VulnerabilityID), v.vulnerabilityID)
+}
+
+func (v *vulnIDNode) toModel() *model.VulnerabilityID {
+	return &model.VulnerabilityID{ID: v.id, VulnerabilityID: v.vulnerabilityID}
+}
+
+// toVulnerability returns this type node, without any vulnerability ID.
+func (t *vulnTypeNode) toVulnerability() *model.Vulnerability {
+	return &model.Vulnerability{
+		ID:               t.id,
+		Type:             t.typeKey,
+		VulnerabilityIDs: []*model.VulnerabilityID{},
+	}
+}
+
+// toVulnerability returns the path from the root of the trie down to this
+// vulnerability ID node.
+func (v *vulnIDNode) toVulnerability() *model.Vulnerability {
+	return &model.Vulnerability{
+		ID:               v.parent.id,
+		Type:             v.parent.typeKey,
+		VulnerabilityIDs: []*model.VulnerabilityID{v.toModel()},
+	}
+}
@@ -0,0 +1,329 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (b *metricsBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return call(b, "Artifacts", func() ([]*model.Artifact, error) {
+		return b.inner.Artifacts(ctx, artifactSpec)
+	})
+}
+
+func (b *metricsBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	return call(b, "ArtifactsList", func() (*model.ArtifactConnection, error) {
+		return b.inner.ArtifactsList(ctx, artifactSpec, after, first)
+	})
+}
+
+func (b *metricsBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	return call(b, "Builders", func() ([]*model.Builder, error) {
+		return b.inner.Builders(ctx, builderSpec)
+	})
+}
+
+func (b *metricsBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return call(b, "Packages", func() ([]*model.Package, error) {
+		return b.inner.Packages(ctx, pkgSpec)
+	})
+}
+
+func (b *metricsBackend) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	return call(b, "PackagesByPurls", func() (map[string]*model.Package, error) {
+		return b.inner.PackagesByPurls(ctx, purls)
+	})
+}
+
+func (b *metricsBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return call(b, "Sources", func() ([]*model.Source, error) {
+		return b.inner.Sources(ctx, sourceSpec)
+	})
+}
+
+func (b *metricsBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return call(b, "Vulnerabilities", func() ([]*model.Vulnerability, error) {
+		return b.inner.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (b *metricsBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return call(b, "CertifyBad", func() ([]*model.CertifyBad, error) {
+		return b.inner.CertifyBad(ctx, certifyBadSpec)
+	})
+}
+
+func (b *metricsBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	return call(b, "CertifyGood", func() ([]*model.CertifyGood, error) {
+		return b.inner.CertifyGood(ctx, certifyGoodSpec)
+	})
+}
+
+func (b *metricsBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return call(b, "CertifyLegal", func() ([]*model.CertifyLegal, error) {
+		return b.inner.CertifyLegal(ctx, certifyLegalSpec)
+	})
+}
+
+func (b *metricsBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return call(b, "CertifyVuln", func() ([]*model.CertifyVuln, error) {
+		return b.inner.CertifyVuln(ctx, certifyVulnSpec)
+	})
+}
+
+func (b *metricsBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	return call(b, "CertifyVEXStatement", func() ([]*model.CertifyVEXStatement, error) {
+		return b.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+}
+
+func (b *metricsBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	return call(b, "HashEqual", func() ([]*model.HashEqual, error) {
+		return b.inner.HashEqual(ctx, hashEqualSpec)
+	})
+}
+
+func (b *metricsBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return call(b, "HasSBOM", func() ([]*model.HasSbom, error) {
+		return b.inner.HasSBOM(ctx, hasSBOMSpec)
+	})
+}
+
+func (b *metricsBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	return call(b, "HasMetadata", func() ([]*model.HasMetadata, error) {
+		return b.inner.HasMetadata(ctx, hasMetadataSpec)
+	})
+}
+
+func (b *metricsBackend) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	return call(b, "HasSLSA", func() ([]*model.HasSlsa, error) {
+		return b.inner.HasSLSA(ctx, hasSLSASpec)
+	})
+}
+
+func (b *metricsBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return call(b, "IsDependency", func() ([]*model.IsDependency, error) {
+		return b.inner.IsDependency(ctx, isDependencySpec)
+	})
+}
+
+func (b *metricsBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	return call(b, "IsOccurrence", func() ([]*model.IsOccurrence, error) {
+		return b.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+}
+
+func (b *metricsBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return call(b, "PkgEqual", func() ([]*model.PkgEqual, error) {
+		return b.inner.PkgEqual(ctx, pkgEqualSpec)
+	})
+}
+
+func (b *metricsBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return call(b, "PointOfContact", func() ([]*model.PointOfContact, error) {
+		return b.inner.PointOfContact(ctx, pointOfContactSpec)
+	})
+}
+
+func (b *metricsBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	return call(b, "Scorecards", func() ([]*model.CertifyScorecard, error) {
+		return b.inner.Scorecards(ctx, certifyScorecardSpec)
+	})
+}
+
+func (b *metricsBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return call(b, "VulnEqual", func() ([]*model.VulnEqual, error) {
+		return b.inner.VulnEqual(ctx, vulnEqualSpec)
+	})
+}
+
+func (b *metricsBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return call(b, "Neighbors", func() ([]model.Node, error) {
+		return b.inner.Neighbors(ctx, node, usingOnly)
+	})
+}
+
+func (b *metricsBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	return call(b, "Path", func() ([]model.Node, error) {
+		return b.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	})
+}
+
+func (b *metricsBackend) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	return call(b, "FindSBOMsByArtifact", func() ([]*model.HasSbom, error) {
+		return b.inner.FindSBOMsByArtifact(ctx, algorithm, digest)
+	})
+}
+
+func (b *metricsBackend) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	return call(b, "TransitiveDependencies", func() ([]*model.Package, error) {
+		return b.inner.TransitiveDependencies(ctx, pkgSpec, maxDepth)
+	})
+}
+
+func (b *metricsBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return call(b, "IngestArtifact", func() (*model.Artifact, error) {
+		return b.inner.IngestArtifact(ctx, artifact)
+	})
+}
+
+func (b *metricsBackend) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return call(b, "IngestArtifacts", func() ([]*model.Artifact, error) {
+		return b.inner.IngestArtifacts(ctx, artifacts)
+	})
+}
+
+func (b *metricsBackend) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	return call(b, "IngestBuilder", func() (*model.Builder, error) {
+		return b.inner.IngestBuilder(ctx, builder)
+	})
+}
+
+func (b *metricsBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return call(b, "IngestPackage", func() (*model.Package, error) {
+		return b.inner.IngestPackage(ctx, pkg)
+	})
+}
+
+func (b *metricsBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return call(b, "IngestPackages", func() ([]*model.Package, error) {
+		return b.inner.IngestPackages(ctx, pkgs)
+	})
+}
+
+func (b *metricsBackend) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	return call(b, "IngestSource", func() (*model.Source, error) {
+		return b.inner.IngestSource(ctx, source)
+	})
+}
+
+func (b *metricsBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	return call(b, "IngestSources", func() ([]*model.Source, error) {
+		return b.inner.IngestSources(ctx, sources)
+	})
+}
+
+func (b *metricsBackend) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	return call(b, "IngestVulnerability", func() (*model.Vulnerability, error) {
+		return b.inner.IngestVulnerability(ctx, vuln)
+	})
+}
+
+func (b *metricsBackend) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return call(b, "IngestCertifyBad", func() (*model.CertifyBad, error) {
+		return b.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *metricsBackend) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	return call(b, "IngestCertifyGood", func() (*model.CertifyGood, error) {
+		return b.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	})
+}
+
+func (b *metricsBackend) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return call(b, "IngestCertifyLegal", func() (*model.CertifyLegal, error) {
+		return b.inner.IngestCertifyLegal(ctx, subject, certifyLegal)
+	})
+}
+
+func (b *metricsBackend) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	return call(b, "IngestCertifyVuln", func() (*model.CertifyVuln, error) {
+		return b.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *metricsBackend) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	return call(b, "IngestHashEqual", func() (*model.HashEqual, error) {
+		return b.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	})
+}
+
+func (b *metricsBackend) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	return call(b, "IngestHasMetadata", func() (*model.HasMetadata, error) {
+		return b.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	})
+}
+
+func (b *metricsBackend) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	return call(b, "IngestHasSbom", func() (*model.HasSbom, error) {
+		return b.inner.IngestHasSbom(ctx, subject, hasSbom)
+	})
+}
+
+func (b *metricsBackend) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	return call(b, "IngestSLSA", func() (*model.HasSlsa, error) {
+		return b.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	})
+}
+
+func (b *metricsBackend) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return call(b, "IngestIsDependency", func() (*model.IsDependency, error) {
+		return b.inner.IngestIsDependency(ctx, pkg, depPkg, dependency)
+	})
+}
+
+func (b *metricsBackend) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	return call(b, "IngestIsOccurrence", func() (*model.IsOccurrence, error) {
+		return b.inner.IngestIsOccurrence(ctx, subject, artifact, occurrence)
+	})
+}
+
+func (b *metricsBackend) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return call(b, "IngestPkgEqual", func() (*model.PkgEqual, error) {
+		return b.inner.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+	})
+}
+
+func (b *metricsBackend) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return call(b, "IngestPointOfContact", func() (*model.PointOfContact, error) {
+		return b.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	})
+}
+
+func (b *metricsBackend) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return call(b, "IngestScorecard", func() (*model.CertifyScorecard, error) {
+		return b.inner.IngestScorecard(ctx, source, scorecard)
+	})
+}
+
+func (b *metricsBackend) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	return call(b, "IngestVEXStatement", func() (*model.CertifyVEXStatement, error) {
+		return b.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *metricsBackend) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return call(b, "IngestVulnEqual", func() (*model.VulnEqual, error) {
+		return b.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	})
+}
+
+func (b *metricsBackend) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	return call(b, "SubscribeArtifacts", func() (<-chan *model.Artifact, error) {
+		return b.inner.SubscribeArtifacts(ctx)
+	})
+}
+
+func (b *metricsBackend) Ping(ctx context.Context) error {
+	_, err := call(b, "Ping", func() (struct{}, error) {
+		return struct{}{}, b.inner.Ping(ctx)
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics provides a backend recording Prometheus metrics of the
+// calls of another backend.
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MethodLabel is the label of the metrics holding the name of the Backend
+// method called.
+const MethodLabel = "method"
+
+type metricsBackend struct {
+	inner    backends.Backend
+	calls    *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// Wrap returns a backend delegating to inner, and recording for each method
+// the number of calls and errors, and the duration of the calls, in the
+// guac_backend_calls_total, guac_backend_errors_total and
+// guac_backend_call_duration_seconds metrics of reg.
+//
+// The collectors are registered once: the backends wrapped with the same
+// registry share them, so that their calls add up. Wrap panics if reg has
+// other collectors conflicting with them, as prometheus.MustRegister does.
+func Wrap(inner backends.Backend, reg prometheus.Registerer) backends.Backend {
+	return &metricsBackend{
+		inner: inner,
+		calls: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guac",
+			Subsystem: "backend",
+			Name:      "calls_total",
+			Help:      "Number of calls of the backend methods.",
+		}, []string{MethodLabel})),
+		errors: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "guac",
+			Subsystem: "backend",
+			Name:      "errors_total",
+			Help:      "Number of calls of the backend methods which returned an error.",
+		}, []string{MethodLabel})),
+		duration: register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "guac",
+			Subsystem: "backend",
+			Name:      "call_duration_seconds",
+			Help:      "Duration of the calls of the backend methods.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{MethodLabel})),
+	}
+}
+
+// IdempotentIngestion implements retry.IdempotentBackend, so that the
+// ingestions are still retried when inner can run them again: each attempt is
+// counted as a call.
+func (b *metricsBackend) IdempotentIngestion(method string) bool {
+	i, ok := b.inner.(retry.IdempotentBackend)
+	return ok && i.IdempotentIngestion(method)
+}
+
+// register registers c with reg, returning the collector registered before
+// if there is already one identical to c.
+func register[C prometheus.Collector](reg prometheus.Registerer, c C) C {
+	if err := reg.Register(c); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+		panic(err)
+	}
+	return c
+}
+
+// Handler returns the handler serving the metrics of g, such as the registry
+// passed to Wrap, to the Prometheus scrapers.
+func Handler(g prometheus.Gatherer) http.Handler {
+	return promhttp.HandlerFor(g, promhttp.HandlerOpts{})
+}
+
+// call runs f, calling method, and records it in the metrics.
+func call[T any](b *metricsBackend, method string, f func() (T, error)) (T, error) {
+	start := time.Now()
+	result, err := f()
+	b.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	b.calls.WithLabelValues(method).Inc()
+	if err != nil {
+		b.errors.WithLabelValues(method).Inc()
+	}
+	return result, err
+}
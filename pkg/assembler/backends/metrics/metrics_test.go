@@ -0,0 +1,227 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+func newInmem(t *testing.T) backends.Backend {
+	t.Helper()
+	b, err := inmem.New(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("inmem.New() error = %v", err)
+	}
+	return b
+}
+
+// scrape returns the metric families served by the Handler of reg.
+func scrape(t *testing.T, reg prometheus.Gatherer) map[string]*dto.MetricFamily {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler(reg).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("scraping the metrics returned status %d", rec.Code)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(rec.Body)
+	if err != nil {
+		t.Fatalf("TextToMetricFamilies() error = %v", err)
+	}
+	return families
+}
+
+// byMethod returns the value of the counters, or the sample count of the
+// histograms, of a metric family for each method.
+func byMethod(family *dto.MetricFamily) map[string]uint64 {
+	out := map[string]uint64{}
+	if family == nil {
+		return out
+	}
+	for _, m := range family.GetMetric() {
+		for _, label := range m.GetLabel() {
+			if label.GetName() != MethodLabel {
+				continue
+			}
+			switch {
+			case m.GetCounter() != nil:
+				out[label.GetValue()] = uint64(m.GetCounter().GetValue())
+			case m.GetHistogram() != nil:
+				out[label.GetValue()] = m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return out
+}
+
+func TestWrap(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	b := Wrap(newInmem(t), reg)
+
+	artifacts := []*model.ArtifactInputSpec{
+		{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"},
+		{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+	}
+	if _, err := b.IngestArtifacts(ctx, artifacts); err != nil {
+		t.Fatalf("IngestArtifacts() error = %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := b.Artifacts(ctx, nil); err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "not hex"}); err == nil {
+		t.Fatalf("IngestArtifact() of an invalid artifact succeeded")
+	}
+	if err := b.Ping(ctx); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	families := scrape(t, reg)
+	tests := []struct {
+		family string
+		want   map[string]uint64
+	}{{
+		family: "guac_backend_calls_total",
+		want:   map[string]uint64{"IngestArtifacts": 1, "Artifacts": 3, "IngestArtifact": 1, "Ping": 1},
+	}, {
+		family: "guac_backend_errors_total",
+		want:   map[string]uint64{"IngestArtifact": 1},
+	}, {
+		family: "guac_backend_call_duration_seconds",
+		want:   map[string]uint64{"IngestArtifacts": 1, "Artifacts": 3, "IngestArtifact": 1, "Ping": 1},
+	}}
+	for _, tt := range tests {
+		got := byMethod(families[tt.family])
+		if len(got) != len(tt.want) {
+			t.Errorf("%s = %v, want %v", tt.family, got, tt.want)
+			continue
+		}
+		for method, want := range tt.want {
+			if got[method] != want {
+				t.Errorf("%s = %v, want %v", tt.family, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestWrapSharesCollectors(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	inner := newInmem(t)
+	wrapped := []backends.Backend{Wrap(inner, reg), Wrap(inner, reg)}
+
+	const calls = 50
+	var wg sync.WaitGroup
+	for _, b := range wrapped {
+		for i := 0; i < calls; i++ {
+			wg.Add(1)
+			go func(b backends.Backend) {
+				defer wg.Done()
+				if _, err := b.Builders(ctx, nil); err != nil {
+					t.Errorf("Builders() error = %v", err)
+				}
+			}(b)
+		}
+	}
+	wg.Wait()
+
+	families := scrape(t, reg)
+	if got := byMethod(families["guac_backend_calls_total"])["Builders"]; got != 2*calls {
+		t.Errorf("guac_backend_calls_total of Builders = %d, want %d", got, 2*calls)
+	}
+	if got := byMethod(families["guac_backend_call_duration_seconds"])["Builders"]; got != 2*calls {
+		t.Errorf("guac_backend_call_duration_seconds samples of Builders = %d, want %d", got, 2*calls)
+	}
+}
+
+func TestWrapConflictingCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(prometheus.NewCounter(prometheus.CounterOpts{Name: "guac_backend_calls_total", Help: "Conflicting counter."}))
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Wrap() with a conflicting collector did not panic")
+		}
+	}()
+	Wrap(newInmem(t), reg)
+}
+
+// flakyBackend fails the first ingestion of a package with a transient error.
+type flakyBackend struct {
+	backends.Backend
+	idempotent bool
+	calls      int
+}
+
+func (b *flakyBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	b.calls++
+	if b.calls == 1 {
+		return nil, backends.ErrTransient
+	}
+	return b.Backend.IngestPackage(ctx, pkg)
+}
+
+func (b *flakyBackend) IdempotentIngestion(method string) bool {
+	return b.idempotent
+}
+
+func TestWrapRetried(t *testing.T) {
+	ctx := context.Background()
+	pkg := &model.PkgInputSpec{Type: "pypi", Name: "django"}
+	for _, idempotent := range []bool{true, false} {
+		reg := prometheus.NewRegistry()
+		flaky := &flakyBackend{Backend: newInmem(t), idempotent: idempotent}
+		b := retry.Wrap(Wrap(flaky, reg), retry.RetryOptions{InitialBackoff: time.Millisecond})
+
+		_, err := b.IngestPackage(ctx, pkg)
+		if !idempotent {
+			if !errors.Is(err, backends.ErrTransient) || flaky.calls != 1 {
+				t.Errorf("IngestPackage() of a backend without idempotent ingestions error = %v after %d calls, want %v after 1", err, flaky.calls, backends.ErrTransient)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if flaky.calls != 2 {
+			t.Errorf("IngestPackage() called the backend %d times, want 2", flaky.calls)
+		}
+		// Each attempt is counted.
+		families := scrape(t, reg)
+		if calls := byMethod(families["guac_backend_calls_total"])["IngestPackage"]; calls != 2 {
+			t.Errorf("guac_backend_calls_total of IngestPackage = %d, want 2", calls)
+		}
+		if errs := byMethod(families["guac_backend_errors_total"])["IngestPackage"]; errs != 1 {
+			t.Errorf("guac_backend_errors_total of IngestPackage = %d, want 1", errs)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Artifacts are stored as (:Artifact {algorithm, digest}) nodes.
+
+func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	connection, err := c.ArtifactsList(ctx, artifactSpec, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Artifact, 0, len(connection.Edges))
+	for _, e := range connection.Edges {
+		out = append(out, e.Node)
+	}
+	return out, nil
+}
+
+// ArtifactsList returns the artifacts ordered by algorithm and digest. The
+// cursors encode both, so a page resumes after the last artifact of the
+// previous one even if new artifacts have been ingested meanwhile.
+func (c *neo4jClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	if first != nil && *first < 0 {
+		return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("first", "first must not be negative"))
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH (a:Artifact)")
+	firstMatch, err := matchArtifactSpec(&sb, queryValues, true, "a", artifactSpec)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		key, err := backends.DecodeCursor(*after)
+		if err != nil {
+			return nil, backends.Errorf("ArtifactsList :: %w", err)
+		}
+		algorithm, digest, ok := strings.Cut(key, ":")
+		if !ok {
+			return nil, backends.Errorf("ArtifactsList :: %w", backends.NewValidationError("after", "invalid cursor %q", *after))
+		}
+		if firstMatch {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString("(a.algorithm > $afterAlgorithm OR (a.algorithm = $afterAlgorithm AND a.digest > $afterDigest))")
+		queryValues["afterAlgorithm"] = algorithm
+		queryValues["afterDigest"] = digest
+	}
+	sb.WriteString(" RETURN id(a), a.algorithm, a.digest ORDER BY a.algorithm, a.digest")
+	if first != nil {
+		// One more artifact tells whether there is a next page.
+		sb.WriteString(" LIMIT $limit")
+		queryValues["limit"] = *first + 1
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			connection := &model.ArtifactConnection{
+				Edges:    []*model.ArtifactEdge{},
+				PageInfo: &model.PageInfo{},
+			}
+			for result.Next() {
+				if first != nil && len(connection.Edges) == *first {
+					connection.PageInfo.HasNextPage = true
+					break
+				}
+				a := artifactFromRecord(result.Record())
+				cursor := backends.EncodeCursor(a.Algorithm + ":" + a.Digest)
+				connection.Edges = append(connection.Edges, &model.ArtifactEdge{Cursor: cursor, Node: a})
+				connection.PageInfo.EndCursor = &cursor
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return connection, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.ArtifactConnection), nil
+}
+
+func (c *neo4jClient) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	if artifact == nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", backends.Missing("artifact"))
+	}
+	algorithm, digest, err := backends.CanonicalArtifact(artifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestArtifact :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			query := "MERGE (a:Artifact {algorithm: $algorithm, digest: $digest}) RETURN id(a), a.algorithm, a.digest"
+			result, err := tx.Run(query, map[string]interface{}{
+				"algorithm": algorithm,
+				"digest":    digest,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return artifactFromRecord(record), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Artifact), nil
+}
+
+// IngestArtifacts merges all the artifacts in a single transaction, which is
+// rolled back if any of them fails. UNWIND preserves the order of the list,
+// so the artifacts are returned in the order of the input.
+func (c *neo4jClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	batch := make([]interface{}, 0, len(artifacts))
+	for i, artifact := range artifacts {
+		if artifact == nil {
+			return nil, backends.Errorf("IngestArtifacts :: %w", backends.NewValidationError("artifacts", "missing artifact at index %d", i))
+		}
+		algorithm, digest, err := backends.CanonicalArtifact(artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestArtifacts :: artifact at index %d: %w", i, err)
+		}
+		batch = append(batch, map[string]interface{}{
+			"algorithm": algorithm,
+			"digest":    digest,
+		})
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			query := `UNWIND $artifacts AS artifact
+MERGE (a:Artifact {algorithm: artifact.algorithm, digest: artifact.digest})
+RETURN id(a), a.algorithm, a.digest`
+			result, err := tx.Run(query, map[string]interface{}{"artifacts": batch})
+			if err != nil {
+				return nil, err
+			}
+
+			out := make([]*model.Artifact, 0, len(batch))
+			for result.Next() {
+				out = append(out, artifactFromRecord(result.Record()))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Artifact), nil
+}
+
+// matchArtifactSpec adds the clauses matching the artifact node bound to
+// label against the canonicalized spec, as matchProperty does.
+func matchArtifactSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, artifactSpec *model.ArtifactSpec) (bool, error) {
+	if artifactSpec == nil {
+		return firstMatch, nil
+	}
+	artifactSpec = backends.CanonicalArtifactSpec(artifactSpec)
+	firstMatch, err := matchID(sb, queryValues, firstMatch, label, artifactSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, label, "algorithm", artifactSpec.Algorithm)
+	return matchProperty(sb, queryValues, firstMatch, label, "digest", artifactSpec.Digest), nil
+}
+
+// artifactFromRecord converts a record containing the id, algorithm and
+// digest of an artifact (in this order) to the GraphQL model.
+func artifactFromRecord(record *neo4j.Record) *model.Artifact {
+	return artifactFromValues(record.Values)
+}
+
+// artifactFromValues is like artifactFromRecord but takes the values directly.
+func artifactFromValues(values []interface{}) *model.Artifact {
+	return &model.Artifact{
+		ID:        nodeID(values[0].(int64)),
+		Algorithm: values[1].(string),
+		Digest:    values[2].(string),
+	}
+}
+
+// SubscribeArtifacts is not supported: the database is shared by all the
+// ingestion processes, and Neo4j has no notifications for the nodes created
+// by the other ones.
+func (c *neo4jClient) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, gqlerror.Errorf("SubscribeArtifacts :: subscriptions are not supported by the Neo4j backend")
+}
@@ -17,27 +17,47 @@ package backend
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
-	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/backends/contentid"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
-type Neo4jCredentials struct {
+// Neo4jConfig holds the arguments needed to connect to a Neo4j database.
+type Neo4jConfig struct {
+	// DBAddr is the bolt (or neo4j) URL of the database, for example
+	// neo4j://localhost:7687.
+	DBAddr string
 	User   string
 	Pass   string
 	Realm  string
-	DBAddr string
+	// DBName is the database to use. If empty, the default database of the
+	// server is used.
+	DBName string
+	// IDStrategy selects the IDs of the nodes. The native IDs are the
+	// internal ids of the nodes, and the content IDs are stored in their
+	// content_id property.
+	IDStrategy backends.IDStrategy
 }
 
 type neo4jClient struct {
 	driver neo4j.Driver
+	dbName string
 }
 
-func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
-	creds := args.(*Neo4jCredentials)
-	token := neo4j.BasicAuth(creds.User, creds.Pass, creds.Realm)
-	driver, err := neo4j.NewDriver(creds.DBAddr, token)
+// New returns a backend storing the GUAC trees in the Neo4j database described
+// by args. It fails if the database cannot be reached.
+func New(ctx context.Context, args *Neo4jConfig) (backends.Backend, error) {
+	if args == nil {
+		return nil, fmt.Errorf("missing Neo4j configuration")
+	}
+	token := neo4j.BasicAuth(args.User, args.Pass, args.Realm)
+	driver, err := neo4j.NewDriver(args.DBAddr, token)
 	if err != nil {
 		return nil, err
 	}
@@ -47,41 +67,289 @@ func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
 		return nil, err
 	}
 
-	return &neo4jClient{driver}, nil
+	client := &neo4jClient{driver: driver, dbName: args.DBName}
+	if args.IDStrategy == backends.ContentIDs {
+		return contentid.Wrap(client, &contentIDStore{client: client}), nil
+	}
+	return client, nil
+}
+
+// contentIDStore is the contentid.Store keeping the content IDs in the
+// content_id property of the nodes.
+type contentIDStore struct {
+	client *neo4jClient
 }
 
-func (c *neo4jClient) Artifacts(ctx context.Context) ([]*model.Artifact, error) {
-	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+func (s *contentIDStore) Record(ctx context.Context, ids map[string]string) error {
+	batch := make([]interface{}, 0, len(ids))
+	for native, content := range ids {
+		id, err := parseNodeID(native)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, map[string]interface{}{"native": id, "content": content})
+	}
+
+	session := s.client.newSession(neo4j.AccessModeWrite)
 	defer session.Close()
 
-	result, err := session.ReadTransaction(
+	_, err := writeTransaction(ctx, session,
 		func(tx neo4j.Transaction) (interface{}, error) {
-			query := "MATCH (n:Artifact) return n.digest, n.name"
-			result, err := tx.Run(query, nil)
+			result, err := tx.Run("UNWIND $ids AS n MATCH (node) WHERE id(node) = n.native SET node.content_id = n.content",
+				map[string]interface{}{"ids": batch})
 			if err != nil {
 				return nil, err
 			}
+			return result.Consume()
+		})
+	return err
+}
 
-			var artifacts []*model.Artifact
-			for result.Next() {
-				artifact := model.Artifact{
-					Digest: result.Record().Values[0].(string),
-				}
-				if result.Record().Values[1] != nil {
-					packageName := result.Record().Values[1].(string)
-					artifact.Name = &packageName
-				}
-				artifacts = append(artifacts, &artifact)
-			}
-			if err = result.Err(); err != nil {
+// NativeID maps the unknown IDs to -1, which is not the internal id of any
+// node. The nodes of all the labels are looked at, as Neo4j only indexes the
+// properties of the nodes of a given label.
+func (s *contentIDStore) NativeID(ctx context.Context, id string) (string, error) {
+	session := s.client.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run("MATCH (node) WHERE node.content_id = $id RETURN id(node) LIMIT 1",
+				map[string]interface{}{"id": id})
+			if err != nil {
 				return nil, err
 			}
-
-			return artifacts, nil
+			if !result.Next() {
+				return nodeID(-1), result.Err()
+			}
+			return nodeID(result.Record().Values[0].(int64)), nil
 		})
 	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// newSession opens a session on the configured database. Callers must close
+// it.
+func (c *neo4jClient) newSession(accessMode neo4j.AccessMode) neo4j.Session {
+	return c.driver.NewSession(neo4j.SessionConfig{AccessMode: accessMode, DatabaseName: c.dbName})
+}
+
+// readTransaction runs work in a read transaction of the session, bounded by
+// the context. See runTransaction.
+func readTransaction(ctx context.Context, session neo4j.Session, work neo4j.TransactionWork) (interface{}, error) {
+	return runTransaction(ctx, session.ReadTransaction, work)
+}
+
+// writeTransaction is like readTransaction for a write transaction.
+func writeTransaction(ctx context.Context, session neo4j.Session, work neo4j.TransactionWork) (interface{}, error) {
+	return runTransaction(ctx, session.WriteTransaction, work)
+}
+
+// constraintValidationFailed is the code of the errors violating a uniqueness
+// constraint of the database.
+const constraintValidationFailed = "Neo.ClientError.Schema.ConstraintValidationFailed"
+
+// runTransaction runs work in a transaction started by run. The driver does
+// not take a context, so the deadline of the context is passed as the
+// transaction timeout instead, and the server aborts the in-flight query when
+// it expires. Nothing is sent if the context is already done, nor retried once
+// it is done. In all these cases the context error is returned.
+func runTransaction(ctx context.Context, run func(neo4j.TransactionWork, ...func(*neo4j.TransactionConfig)) (interface{}, error), work neo4j.TransactionWork) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
+	var configurers []func(*neo4j.TransactionConfig)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return nil, context.DeadlineExceeded
+		}
+		configurers = append(configurers, neo4j.WithTxTimeout(timeout))
+	}
+
+	result, err := run(func(tx neo4j.Transaction) (interface{}, error) {
+		// The context error is not retryable, so this also stops the
+		// retries of the driver.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return work(tx)
+	}, configurers...)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) && neo4jErr.Code == constraintValidationFailed {
+		return nil, backends.Classify(backends.ErrDuplicate, err)
+	}
+	if isTransient(err) {
+		return nil, backends.Classify(backends.ErrTransient, err)
+	}
+	return result, err
+}
+
+// isTransient returns true for the errors which the driver would retry, or has
+// given up retrying: deadlocks, leader switches and lost connections.
+func isTransient(err error) bool {
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) {
+		return neo4jErr.IsRetriableTransient() || neo4jErr.IsRetriableCluster()
+	}
+	return neo4j.IsTransactionExecutionLimit(err) || neo4j.IsConnectivityError(err)
+}
+
+// IdempotentIngestion implements retry.IdempotentBackend. All the ingestions
+// MERGE their nodes and relationships, so running one again after a failure
+// never creates duplicates.
+func (c *neo4jClient) IdempotentIngestion(method string) bool {
+	return true
+}
+
+// Ping runs a trivial query. The transaction timeout only bounds the query
+// once sent, while a hung server can block acquiring a connection for much
+// longer. So the query runs in the background, and Ping returns as soon as ctx
+// is done.
+func (c *neo4jClient) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		session := c.newSession(neo4j.AccessModeRead)
+		defer session.Close()
+		_, err := readTransaction(ctx, session, func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run("RETURN 1", nil)
+			if err != nil {
+				return nil, err
+			}
+			_, err = result.Consume()
+			return nil, err
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// matchProperty appends a `WHERE`/`AND` clause to the query matching the
+// property of the node bound to label against the filter value. If the filter
+// is not set, nothing is added. Returns whether the next clause is still the
+// first one.
+func matchProperty(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, property string, filter *string) bool {
+	if filter == nil {
+		return firstMatch
+	}
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	param := label + "_" + property
+	sb.WriteString(label + "." + property + " = $" + param)
+	queryValues[param] = *filter
+	return false
+}
+
+// matchID is like matchProperty but matches the internal id of the node
+// bound to label. Returns an error if the filter is not a valid node id.
+func matchID(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, filter *string) (bool, error) {
+	if filter == nil {
+		return firstMatch, nil
+	}
+	id, err := strconv.ParseInt(*filter, 10, 64)
+	if err != nil {
+		return firstMatch, backends.Errorf("%w", backends.NewValidationError("id", "invalid id %q", *filter))
+	}
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	param := label + "_id"
+	sb.WriteString("id(" + label + ") = $" + param)
+	queryValues[param] = id
+	return false, nil
+}
 
-	return result.([]*model.Artifact), nil
+// matchTime appends a clause comparing a time property of the node bound to
+// label against the filter value using op (e.g. ">="). The filter is passed
+// as the param query parameter. If the filter is not set, nothing is added.
+func matchTime(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, property string, op string, param string, filter *time.Time) bool {
+	if filter == nil {
+		return firstMatch
+	}
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	param = label + "_" + param
+	sb.WriteString(label + "." + property + " " + op + " $" + param)
+	queryValues[param] = filter.UTC()
+	return false
+}
+
+// keepLatest appends the clauses keeping, among the matched rows with the
+// same nodes bound to variables, only the one with the most recent property
+// of the node bound to label. Among the ones with the same time, the node with
+// the lowest id, the first one ingested, is kept. The variables must include
+// all the ones used by the returned columns, but label.
+func keepLatest(sb *strings.Builder, label string, property string, variables ...string) {
+	grouping := strings.Join(variables, ", ")
+	sb.WriteString(" WITH " + grouping + ", " + label + " ORDER BY " + label + "." + property + " DESC, id(" + label + ")")
+	sb.WriteString(" WITH " + grouping + ", collect(" + label + ")[0] AS " + label)
+}
+
+// keepAllLatest is like keepLatest, but keeps all the rows with the most
+// recent property.
+func keepAllLatest(sb *strings.Builder, label string, property string, variables ...string) {
+	grouping := strings.Join(variables, ", ")
+	sb.WriteString(" WITH " + grouping + ", max(" + label + "." + property + ") AS latest, collect(" + label + ") AS " + label + "s")
+	sb.WriteString(" UNWIND " + label + "s AS " + label)
+	sb.WriteString(" WITH " + grouping + ", " + label + ", latest WHERE " + label + "." + property + " = latest")
+}
+
+// nodeID converts a Neo4j internal node id to a GraphQL ID.
+func nodeID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// matchOptionalProperty is like matchProperty but for properties that might
+// be missing from the node, in which case they match the empty string.
+func matchOptionalProperty(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, property string, filter *string) bool {
+	if filter == nil {
+		return firstMatch
+	}
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	param := label + "_" + property
+	sb.WriteString("coalesce(" + label + "." + property + ", '') = $" + param)
+	queryValues[param] = *filter
+	return false
+}
+
+// optionalString converts an optional string property to the model, where
+// missing (and empty) values are nil.
+func optionalString(value interface{}) *string {
+	s, ok := value.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
@@ -0,0 +1,326 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// The tests in this file need a running Neo4j database. Its URL is read from
+// NEO4J_ADDR, the credentials from NEO4J_USER and NEO4J_PASS and the database
+// name from NEO4J_DB. The database is wiped by every test.
+
+func testConfig(t *testing.T) *Neo4jConfig {
+	t.Helper()
+	addr := os.Getenv("NEO4J_ADDR")
+	if addr == "" {
+		t.Skip("NEO4J_ADDR is not set")
+	}
+	return &Neo4jConfig{
+		DBAddr: addr,
+		User:   os.Getenv("NEO4J_USER"),
+		Pass:   os.Getenv("NEO4J_PASS"),
+		Realm:  "neo4j",
+		DBName: os.Getenv("NEO4J_DB"),
+	}
+}
+
+func newTestClient(t *testing.T) *neo4jClient {
+	t.Helper()
+	b, err := New(context.Background(), testConfig(t))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c := b.(*neo4jClient)
+	t.Cleanup(func() { c.driver.Close() })
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+	if _, err := session.Run("MATCH (n) DETACH DELETE n", nil); err != nil {
+		t.Fatalf("clearing the database: %v", err)
+	}
+	return c
+}
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+var ignoreIDs = cmp.Options{
+	cmpopts.IgnoreFields(model.Package{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageNamespace{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageName{}, "ID"),
+	cmpopts.IgnoreFields(model.PackageVersion{}, "ID"),
+	cmpopts.IgnoreFields(model.Source{}, "ID"),
+	cmpopts.IgnoreFields(model.SourceNamespace{}, "ID"),
+	cmpopts.IgnoreFields(model.SourceName{}, "ID"),
+	cmpopts.IgnoreFields(model.Artifact{}, "ID"),
+	cmpopts.EquateEmpty(),
+}
+
+func TestNewInvalidAddress(t *testing.T) {
+	if _, err := New(context.Background(), &Neo4jConfig{DBAddr: "neo4j://localhost:1"}); err == nil {
+		t.Errorf("New() with an unreachable database did not return an error")
+	}
+}
+
+func TestIngestPackageIdempotent(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	pkg := &model.PkgInputSpec{
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "curl",
+		Version:    ptrfrom("7.50.3-1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "i386"}},
+	}
+	first, err := c.IngestPackage(ctx, pkg)
+	if err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	second, err := c.IngestPackage(ctx, pkg)
+	if err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("IngestPackage() of the same package created new nodes (-first +second):\n%s", diff)
+	}
+
+	got, err := c.Packages(ctx, &model.PkgSpec{Name: ptrfrom("curl")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	want := []*model.Package{{
+		Type: "deb",
+		Namespaces: []*model.PackageNamespace{{
+			Namespace: "debian",
+			Names: []*model.PackageName{{
+				Name: "curl",
+				Versions: []*model.PackageVersion{{
+					Version:    "7.50.3-1",
+					Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "i386"}},
+				}},
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(want, got, ignoreIDs); diff != "" {
+		t.Errorf("Packages() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIngestSourceIdempotent(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	src := &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+		Tag:       ptrfrom("v0.0.1"),
+	}
+	first, err := c.IngestSource(ctx, src)
+	if err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	second, err := c.IngestSource(ctx, src)
+	if err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("IngestSource() of the same source created new nodes (-first +second):\n%s", diff)
+	}
+
+	got, err := c.Sources(ctx, nil)
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.Source{first}, got); diff != "" {
+		t.Errorf("Sources() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestArtifacts(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	ingested, err := c.IngestArtifacts(ctx, []*model.ArtifactInputSpec{
+		{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"},
+		{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+		{Algorithm: "SHA256", Digest: "CCC8B0BC3E9E1D7D1E1E4FA90A5B7E6F05FA42A6D423D2D0DC0CD7FB856DE0D0"},
+	})
+	if err != nil {
+		t.Fatalf("IngestArtifacts() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		spec *model.ArtifactSpec
+		want []*model.Artifact
+	}{{
+		name: "nil spec",
+		want: []*model.Artifact{ingested[1], ingested[0], ingested[2]},
+	}, {
+		name: "algorithm ignoring case",
+		spec: &model.ArtifactSpec{Algorithm: ptrfrom("SHA256")},
+		want: []*model.Artifact{ingested[0], ingested[2]},
+	}, {
+		name: "digest",
+		spec: &model.ArtifactSpec{Digest: ptrfrom("7a8f47318e4676dacb0142afa0b83029cd7befd9")},
+		want: []*model.Artifact{ingested[1]},
+	}, {
+		name: "id",
+		spec: &model.ArtifactSpec{ID: &ingested[2].ID},
+		want: []*model.Artifact{ingested[2]},
+	}, {
+		name: "no match",
+		spec: &model.ArtifactSpec{Algorithm: ptrfrom("md5")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Artifacts(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("Artifacts() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Artifacts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	page, err := c.ArtifactsList(ctx, nil, nil, ptrfrom(2))
+	if err != nil {
+		t.Fatalf("ArtifactsList() error = %v", err)
+	}
+	if len(page.Edges) != 2 || !page.PageInfo.HasNextPage {
+		t.Fatalf("ArtifactsList() returned %d artifacts, hasNextPage = %v, want 2 and true", len(page.Edges), page.PageInfo.HasNextPage)
+	}
+	page, err = c.ArtifactsList(ctx, nil, page.PageInfo.EndCursor, ptrfrom(2))
+	if err != nil {
+		t.Fatalf("ArtifactsList() error = %v", err)
+	}
+	if len(page.Edges) != 1 || page.PageInfo.HasNextPage || page.Edges[0].Node.ID != ingested[2].ID {
+		t.Errorf("ArtifactsList() second page = %+v, want only artifact %s", page, ingested[2].ID)
+	}
+}
+
+func TestContextDone(t *testing.T) {
+	c := newTestClient(t)
+	pkg := &model.PkgInputSpec{Type: "npm", Name: "foobar", Version: ptrfrom("12.3.1")}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.IngestPackage(cancelled, pkg); !errors.Is(err, context.Canceled) {
+		t.Errorf("IngestPackage() error = %v, want %v", err, context.Canceled)
+	}
+	if _, err := c.Packages(cancelled, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Packages() error = %v, want %v", err, context.Canceled)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err := c.IngestPackage(expired, pkg); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("IngestPackage() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// A slow query is aborted by the server once the deadline expires.
+	short, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+	_, err := readTransaction(short, session, func(tx neo4j.Transaction) (interface{}, error) {
+		result, err := tx.Run("UNWIND range(1, 1000000000) AS i WITH i WHERE i < 0 RETURN count(i)", nil)
+		if err != nil {
+			return nil, err
+		}
+		return result.Consume()
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("readTransaction() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("readTransaction() took %v to return", elapsed)
+	}
+
+	got, err := c.Packages(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Packages() = %v, want none ingested with a done context", got)
+	}
+}
+
+func TestContentIDs(t *testing.T) {
+	ctx := context.Background()
+	newTestClient(t)
+	config := testConfig(t)
+	config.IDStrategy = backends.ContentIDs
+	neo4jBackend, err := New(ctx, config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	inmemBackend, err := inmem.New(ctx, &inmem.Config{IDStrategy: backends.ContentIDs})
+	if err != nil {
+		t.Fatalf("inmem.New() error = %v", err)
+	}
+
+	pkg := &model.PkgInputSpec{Type: "pypi", Name: "django", Version: ptrfrom("1.11.1")}
+	artifact := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	var occurrences []*model.IsOccurrence
+	for _, b := range []backends.Backend{inmemBackend, neo4jBackend} {
+		// The in-memory backend gets an extra package first, so that its
+		// native IDs differ.
+		if b == inmemBackend {
+			if _, err := b.IngestPackage(ctx, &model.PkgInputSpec{Type: "npm", Name: "foobar"}); err != nil {
+				t.Fatalf("IngestPackage() error = %v", err)
+			}
+		}
+		o, err := b.IngestIsOccurrence(ctx, &model.PackageOrSourceInput{Package: pkg}, artifact,
+			&model.IsOccurrenceInputSpec{Justification: "package of the artifact"})
+		if err != nil {
+			t.Fatalf("IngestIsOccurrence() error = %v", err)
+		}
+		occurrences = append(occurrences, o)
+	}
+	if diff := cmp.Diff(occurrences[0], occurrences[1], cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("IngestIsOccurrence() mismatch between the backends (-inmem +neo4j):\n%s", diff)
+	}
+
+	// The content IDs are looked up.
+	id := occurrences[0].Artifact.ID
+	found, err := neo4jBackend.IsOccurrence(ctx, &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{ID: &id}})
+	if err != nil {
+		t.Fatalf("IsOccurrence() error = %v", err)
+	}
+	if diff := cmp.Diff(occurrences[:1], found, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("IsOccurrence() of the artifact mismatch (-want +got):\n%s", diff)
+	}
+}
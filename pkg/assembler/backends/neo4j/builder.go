@@ -0,0 +1,123 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// Builders are stored as (:Builder {uri}) and merged on the uri.
+
+func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	if builderSpec == nil {
+		builderSpec = &model.BuilderSpec{}
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH (b:Builder)")
+	if _, err := matchBuilderSpec(&sb, queryValues, true, "b", builderSpec); err != nil {
+		return nil, err
+	}
+	sb.WriteString(" RETURN id(b), b.uri")
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var builders []*model.Builder
+			for result.Next() {
+				builders = append(builders, builderFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return builders, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Builder), nil
+}
+
+func (c *neo4jClient) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	if builder == nil {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.Missing("builder"))
+	}
+	if builder.URI == "" {
+		return nil, backends.Errorf("IngestBuilder :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			query := "MERGE (b:Builder {uri: $uri}) RETURN id(b), b.uri"
+			result, err := tx.Run(query, map[string]interface{}{"uri": builder.URI})
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return builderFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Builder), nil
+}
+
+// matchBuilderSpec adds the clauses matching the builder node bound to label
+// against the spec, as matchProperty does.
+func matchBuilderSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, builderSpec *model.BuilderSpec) (bool, error) {
+	if builderSpec == nil {
+		return firstMatch, nil
+	}
+	firstMatch, err := matchID(sb, queryValues, firstMatch, label, builderSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	return matchProperty(sb, queryValues, firstMatch, label, "uri", builderSpec.URI), nil
+}
+
+// builderFromValues converts the id and uri of a builder (in this order) to
+// the model.
+func builderFromValues(values []interface{}) *model.Builder {
+	return &model.Builder{
+		ID:  nodeID(values[0].(int64)),
+		URI: values[1].(string),
+	}
+}
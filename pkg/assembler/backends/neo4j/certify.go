@@ -0,0 +1,284 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// CertifyBad and CertifyGood nodes are stored as
+//
+//	(subject)<-[:subject]-(:CertifyBad {justification, origin, collector})
+//	(subject)<-[:subject]-(:CertifyGood {justification, origin, collector})
+//
+// where subject is either a PkgVersion, a PkgName (for certifications which
+// apply to all the versions of a package), a SrcName or an Artifact node.
+// Both labels share the helpers below, using label as the node label.
+
+// certification holds the common fields of CertifyBad and CertifyGood.
+type certification struct {
+	id            string
+	subject       model.PackageSourceOrArtifact
+	justification string
+	origin        string
+	collector     string
+}
+
+// certificationColumns are the columns returning the certification node bound
+// to cert, as expected by certificationFromValues.
+const certificationColumns = "id(cert), cert.justification, cert.origin, cert.collector"
+
+// queryCertifications returns the certifications with the given label which
+// match the filters. A package filter matches the certifications on package
+// names regardless of its version filters, as these apply to all the
+// versions.
+func (c *neo4jClient) queryCertifications(ctx context.Context, label string, id *string, subject *model.PackageSourceOrArtifactSpec, justification, origin, collector *string) ([]*certification, error) {
+	queries, err := subjectQueries(subject, "cert", label, func(sb *strings.Builder, queryValues map[string]interface{}) (bool, error) {
+		firstMatch, err := matchID(sb, queryValues, true, "cert", id)
+		if err != nil {
+			return firstMatch, err
+		}
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "cert", "justification", justification)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "cert", "origin", origin)
+		return matchProperty(sb, queryValues, firstMatch, "cert", "collector", collector), nil
+	}, certificationColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*certification
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					cert := certificationFromValues(values)
+					cert.subject = q.toSubject(values[4:])
+					out = append(out, cert)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*certification), nil
+}
+
+// ingestCertification merges a certification with the given label on the
+// subject, creating the subject if needed. For package subjects, pkgMatchType
+// selects the level of the package trie, defaulting to the version.
+func (c *neo4jClient) ingestCertification(ctx context.Context, label string, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, justification, origin, collector string) (*certification, error) {
+	queryValues := map[string]interface{}{
+		"justification": justification,
+		"origin":        origin,
+		"collector":     collector,
+	}
+	mergeCertification := "MERGE (subject)<-[:subject]-(cert:" + label + " {justification: $justification, origin: $origin, collector: $collector})"
+	query, toSubject, err := mergeOnSubject(subject, pkgMatchType, queryValues, mergeCertification, certificationColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			cert := certificationFromValues(record.Values)
+			cert.subject = toSubject(record.Values[4:])
+			return cert, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*certification), nil
+}
+
+// The helpers below build the queries for the nodes attached to a
+// PackageSourceOrArtifact subject, such as the certifications and the points
+// of contact.
+
+// subjectQuery is a query for the nodes attached to one kind of subject. The
+// values returned by the query are the columns of the node followed by the
+// ones of the subject, which toSubject converts.
+type subjectQuery struct {
+	cypher      string
+	queryValues map[string]interface{}
+	toSubject   func([]interface{}) model.PackageSourceOrArtifact
+}
+
+// subjectQueries returns the queries for the nodes with the label, bound to
+// node, which are attached to the subjects matching the spec, one per kind of
+// subject. matchNode adds the clauses matching the properties of the node and
+// columns are the columns returning it.
+//
+// A package filter matches the nodes attached to package names regardless of
+// its version filters, as these apply to all the versions.
+func subjectQueries(subject *model.PackageSourceOrArtifactSpec, node, label string, matchNode func(*strings.Builder, map[string]interface{}) (bool, error), columns string) ([]subjectQuery, error) {
+	if subject == nil {
+		subject = &model.PackageSourceOrArtifactSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, err
+	}
+
+	var queries []subjectQuery
+	// addQuery adds a query for the subjects bound by path, skipping it if the
+	// spec filters on another subject kind.
+	addQuery := func(kind bool, path string, matchSubject func(*strings.Builder, map[string]interface{}, bool) (bool, error), subjectColumns string, toSubject func([]interface{}) model.PackageSourceOrArtifact) error {
+		if !kind {
+			return nil
+		}
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + path + "<-[:subject]-(" + node + ":" + label + ")")
+		firstMatch, err := matchNode(&sb, queryValues)
+		if err != nil {
+			return err
+		}
+		if _, err := matchSubject(&sb, queryValues, firstMatch); err != nil {
+			return err
+		}
+		sb.WriteString(" RETURN " + columns + ", " + subjectColumns)
+		queries = append(queries, subjectQuery{sb.String(), queryValues, toSubject})
+		return nil
+	}
+
+	noSource := subject.Source == nil && subject.Artifact == nil
+	err := addQuery(noSource, pkgVersionPath(""),
+		func(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool) (bool, error) {
+			return matchPkgSpec(sb, queryValues, firstMatch, "", subject.Package), nil
+		}, pkgVersionColumns(""),
+		func(values []interface{}) model.PackageSourceOrArtifact { return packageFromValues(values) })
+	if err != nil {
+		return nil, err
+	}
+	err = addQuery(noSource, pkgNamePath(""),
+		func(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool) (bool, error) {
+			return matchPkgNameSpec(sb, queryValues, firstMatch, "", subject.Package), nil
+		}, pkgNameColumns(""),
+		func(values []interface{}) model.PackageSourceOrArtifact { return packageNameFromValues(values) })
+	if err != nil {
+		return nil, err
+	}
+	err = addQuery(subject.Package == nil && subject.Artifact == nil, srcNamePath(""),
+		func(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool) (bool, error) {
+			return matchSrcSpec(sb, queryValues, firstMatch, "", subject.Source), nil
+		}, srcNameColumns(""),
+		func(values []interface{}) model.PackageSourceOrArtifact { return sourceFromValues(values) })
+	if err != nil {
+		return nil, err
+	}
+	err = addQuery(subject.Package == nil && subject.Source == nil, "(a:Artifact)",
+		func(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool) (bool, error) {
+			return matchArtifactSpec(sb, queryValues, firstMatch, "a", subject.Artifact)
+		}, "id(a), a.algorithm, a.digest",
+		func(values []interface{}) model.PackageSourceOrArtifact { return artifactFromValues(values) })
+	if err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
+
+// mergeOnSubject returns the query creating the subject if needed and then
+// running merge, which can refer to it as subject. For package subjects,
+// pkgMatchType selects the level of the package trie, defaulting to the
+// version. The subject parameters are added to queryValues. The query
+// returns the columns followed by the ones of the subject, which toSubject
+// converts.
+func mergeOnSubject(subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, queryValues map[string]interface{}, merge string, columns string) (string, func([]interface{}) model.PackageSourceOrArtifact, error) {
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return "", nil, err
+	}
+
+	switch {
+	case subject.Package != nil && pkgMatchType != nil && *pkgMatchType == model.PkgMatchTypeAllVersions:
+		addPkgInputValues(queryValues, "", subject.Package)
+		query := mergePkgName("") + "\nWITH name AS subject, type, namespace, name\n" + merge +
+			"\nRETURN " + columns + ", " + pkgNameColumns("")
+		return query, func(values []interface{}) model.PackageSourceOrArtifact {
+			return packageNameFromValues(values)
+		}, nil
+	case subject.Package != nil:
+		addPkgInputValues(queryValues, "", subject.Package)
+		query := mergePkgVersion("") + "\nWITH version AS subject, type, namespace, name, version\n" + merge +
+			"\nRETURN " + columns + ", " + pkgVersionColumns("")
+		return query, func(values []interface{}) model.PackageSourceOrArtifact {
+			return packageFromValues(values)
+		}, nil
+	case subject.Source != nil:
+		if err := backends.ValidateSourceInput(subject.Source); err != nil {
+			return "", nil, err
+		}
+		addSrcInputValues(queryValues, "", subject.Source)
+		query := mergeSrcName("") + "\nWITH name AS subject, type, namespace, name\n" + merge +
+			"\nRETURN " + columns + ", " + srcNameColumns("")
+		return query, func(values []interface{}) model.PackageSourceOrArtifact {
+			return sourceFromValues(values)
+		}, nil
+	default:
+		algorithm, digest, err := backends.CanonicalArtifact(subject.Artifact)
+		if err != nil {
+			return "", nil, err
+		}
+		queryValues["algorithm"] = algorithm
+		queryValues["digest"] = digest
+		query := "MERGE (subject:Artifact {algorithm: $algorithm, digest: $digest})\n" + merge +
+			"\nRETURN " + columns + ", id(subject), subject.algorithm, subject.digest"
+		return query, func(values []interface{}) model.PackageSourceOrArtifact {
+			return artifactFromValues(values)
+		}, nil
+	}
+}
+
+// certificationFromValues converts the values of the certificationColumns,
+// without the subject.
+func certificationFromValues(values []interface{}) *certification {
+	return &certification{
+		id:            nodeID(values[0].(int64)),
+		justification: values[1].(string),
+		origin:        values[2].(string),
+		collector:     values[3].(string),
+	}
+}
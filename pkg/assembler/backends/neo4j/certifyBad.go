@@ -0,0 +1,64 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if certifyBadSpec == nil {
+		certifyBadSpec = &model.CertifyBadSpec{}
+	}
+
+	certifications, err := c.queryCertifications(ctx, "CertifyBad", certifyBadSpec.ID, certifyBadSpec.Subject,
+		certifyBadSpec.Justification, certifyBadSpec.Origin, certifyBadSpec.Collector)
+	if err != nil {
+		return nil, backends.Errorf("CertifyBad :: %w", err)
+	}
+
+	out := make([]*model.CertifyBad, 0, len(certifications))
+	for _, cert := range certifications {
+		out = append(out, cert.toCertifyBad())
+	}
+	return out, nil
+}
+
+func (c *neo4jClient) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	if certifyBad == nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", backends.Missing("certifyBad"))
+	}
+
+	cert, err := c.ingestCertification(ctx, "CertifyBad", subject, pkgMatchType,
+		certifyBad.Justification, certifyBad.Origin, certifyBad.Collector)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyBad :: %w", err)
+	}
+	return cert.toCertifyBad(), nil
+}
+
+func (cert *certification) toCertifyBad() *model.CertifyBad {
+	return &model.CertifyBad{
+		ID:            cert.id,
+		Subject:       cert.subject,
+		Justification: cert.justification,
+		Origin:        cert.origin,
+		Collector:     cert.collector,
+	}
+}
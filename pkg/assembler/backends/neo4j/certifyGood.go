@@ -0,0 +1,64 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if certifyGoodSpec == nil {
+		certifyGoodSpec = &model.CertifyGoodSpec{}
+	}
+
+	certifications, err := c.queryCertifications(ctx, "CertifyGood", certifyGoodSpec.ID, certifyGoodSpec.Subject,
+		certifyGoodSpec.Justification, certifyGoodSpec.Origin, certifyGoodSpec.Collector)
+	if err != nil {
+		return nil, backends.Errorf("CertifyGood :: %w", err)
+	}
+
+	out := make([]*model.CertifyGood, 0, len(certifications))
+	for _, cert := range certifications {
+		out = append(out, cert.toCertifyGood())
+	}
+	return out, nil
+}
+
+func (c *neo4jClient) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	if certifyGood == nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", backends.Missing("certifyGood"))
+	}
+
+	cert, err := c.ingestCertification(ctx, "CertifyGood", subject, pkgMatchType,
+		certifyGood.Justification, certifyGood.Origin, certifyGood.Collector)
+	if err != nil {
+		return nil, backends.Errorf("IngestCertifyGood :: %w", err)
+	}
+	return cert.toCertifyGood(), nil
+}
+
+func (cert *certification) toCertifyGood() *model.CertifyGood {
+	return &model.CertifyGood{
+		ID:            cert.id,
+		Subject:       cert.subject,
+		Justification: cert.justification,
+		Origin:        cert.origin,
+		Collector:     cert.collector,
+	}
+}
@@ -0,0 +1,218 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// CertifyLegal nodes are stored as
+//
+//	(subject)<-[:subject]-(:CertifyLegal {declaredLicense, discoveredLicense, attribution, justification, timeScanned, origin, collector})
+//
+// where subject is either a PkgVersion or a SrcName node. Every property is
+// part of the merge pattern, so every distinct scan is recorded as a separate
+// node.
+
+// certifyLegalColumns are the columns returning the CertifyLegal node bound
+// to l, as expected by certifyLegalFromValues.
+const certifyLegalColumns = "id(l), l.declaredLicense, l.discoveredLicense, l.attribution, l.justification, l.timeScanned, l.origin, l.collector"
+
+func (c *neo4jClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	if certifyLegalSpec == nil {
+		certifyLegalSpec = &model.CertifyLegalSpec{}
+	}
+	subject := certifyLegalSpec.Subject
+	if subject == nil {
+		subject = &model.PackageOrSourceSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("CertifyLegal :: %w", err)
+	}
+
+	// Packages and sources are queried separately, as for IsOccurrence.
+	type query struct {
+		cypher      string
+		queryValues map[string]interface{}
+		toSubject   func([]interface{}) model.PackageOrSource
+	}
+	var queries []query
+
+	if subject.Source == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(l:CertifyLegal)")
+		firstMatch, err := matchCertifyLegalSpec(&sb, queryValues, certifyLegalSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchPkgSpec(&sb, queryValues, firstMatch, "", subject.Package)
+		sb.WriteString(" RETURN " + certifyLegalColumns + ", " + pkgVersionColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrSource {
+			return packageFromValues(values)
+		}})
+	}
+
+	if subject.Package == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + srcNamePath("") + "<-[:subject]-(l:CertifyLegal)")
+		firstMatch, err := matchCertifyLegalSpec(&sb, queryValues, certifyLegalSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchSrcSpec(&sb, queryValues, firstMatch, "", subject.Source)
+		sb.WriteString(" RETURN " + certifyLegalColumns + ", " + srcNameColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrSource {
+			return sourceFromValues(values)
+		}})
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.CertifyLegal
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					l := certifyLegalFromValues(values)
+					l.Subject = q.toSubject(values[8:])
+					out = append(out, l)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.CertifyLegal), nil
+}
+
+// matchCertifyLegalSpec adds the clauses matching the CertifyLegal node bound
+// to l against the spec. The subject is not matched.
+func matchCertifyLegalSpec(sb *strings.Builder, queryValues map[string]interface{}, certifyLegalSpec *model.CertifyLegalSpec) (bool, error) {
+	firstMatch, err := matchID(sb, queryValues, true, "l", certifyLegalSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "l", "declaredLicense", certifyLegalSpec.DeclaredLicense)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "l", "discoveredLicense", certifyLegalSpec.DiscoveredLicense)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "l", "attribution", certifyLegalSpec.Attribution)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "l", "justification", certifyLegalSpec.Justification)
+	firstMatch = matchTime(sb, queryValues, firstMatch, "l", "timeScanned", "=", "timeScanned", certifyLegalSpec.TimeScanned)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "l", "origin", certifyLegalSpec.Origin)
+	return matchProperty(sb, queryValues, firstMatch, "l", "collector", certifyLegalSpec.Collector), nil
+}
+
+func (c *neo4jClient) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	if subject == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("subject"))
+	}
+	if certifyLegal == nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", backends.Missing("certifyLegal"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestCertifyLegal :: %w", err)
+	}
+
+	queryValues := map[string]interface{}{
+		"declaredLicense":   certifyLegal.DeclaredLicense,
+		"discoveredLicense": certifyLegal.DiscoveredLicense,
+		"attribution":       certifyLegal.Attribution,
+		"justification":     certifyLegal.Justification,
+		"timeScanned":       certifyLegal.TimeScanned.UTC(),
+		"origin":            certifyLegal.Origin,
+		"collector":         certifyLegal.Collector,
+	}
+	mergeCertifyLegal := "MERGE (subject)<-[:subject]-(l:CertifyLegal {declaredLicense: $declaredLicense, discoveredLicense: $discoveredLicense, attribution: $attribution, justification: $justification, timeScanned: $timeScanned, origin: $origin, collector: $collector})"
+
+	var query string
+	var toSubject func([]interface{}) model.PackageOrSource
+	if subject.Package != nil {
+		addPkgInputValues(queryValues, "", subject.Package)
+		query = mergePkgVersion("") + "\nWITH version AS subject, type, namespace, name, version\n" + mergeCertifyLegal +
+			"\nRETURN " + certifyLegalColumns + ", " + pkgVersionColumns("")
+		toSubject = func(values []interface{}) model.PackageOrSource {
+			return packageFromValues(values)
+		}
+	} else {
+		if err := backends.ValidateSourceInput(subject.Source); err != nil {
+			return nil, backends.Errorf("IngestCertifyLegal :: %w", err)
+		}
+		addSrcInputValues(queryValues, "", subject.Source)
+		query = mergeSrcName("") + "\nWITH name AS subject, type, namespace, name\n" + mergeCertifyLegal +
+			"\nRETURN " + certifyLegalColumns + ", " + srcNameColumns("")
+		toSubject = func(values []interface{}) model.PackageOrSource {
+			return sourceFromValues(values)
+		}
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			l := certifyLegalFromValues(record.Values)
+			l.Subject = toSubject(record.Values[8:])
+			return l, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyLegal), nil
+}
+
+// certifyLegalFromValues converts the values of the certifyLegalColumns to
+// the model, without the subject.
+func certifyLegalFromValues(values []interface{}) *model.CertifyLegal {
+	return &model.CertifyLegal{
+		ID:                nodeID(values[0].(int64)),
+		DeclaredLicense:   values[1].(string),
+		DiscoveredLicense: values[2].(string),
+		Attribution:       values[3].(string),
+		Justification:     values[4].(string),
+		TimeScanned:       values[5].(time.Time).UTC(),
+		Origin:            values[6].(string),
+		Collector:         values[7].(string),
+	}
+}
@@ -0,0 +1,193 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// CertifyScorecard nodes are stored as
+//
+//	(:SrcName)<-[:subject]-(:CertifyScorecard {commit, timeScanned, checkNames, checkScores, aggregateScore, scorecardVersion, origin, collector})
+//
+// where checkNames and checkScores are parallel lists, sorted by check name.
+// The node is merged on the commit and timeScanned only, the other properties
+// are set when it is created.
+
+// scorecardColumns are the columns returning the CertifyScorecard node bound
+// to s, followed by the source path, as expected by scorecardFromValues.
+var scorecardColumns = "id(s), s.checkNames, s.checkScores, s.aggregateScore, s.timeScanned, s.scorecardVersion, s.commit, s.origin, s.collector, " +
+	srcNameColumns("")
+
+func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if certifyScorecardSpec == nil {
+		certifyScorecardSpec = &model.CertifyScorecardSpec{}
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + srcNamePath("") + "<-[:subject]-(s:CertifyScorecard)")
+
+	firstMatch, err := matchID(&sb, queryValues, true, "s", certifyScorecardSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	if certifyScorecardSpec.MinAggregateScore != nil {
+		if firstMatch {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString("s.aggregateScore >= $s_minAggregateScore")
+		queryValues["s_minAggregateScore"] = *certifyScorecardSpec.MinAggregateScore
+		firstMatch = false
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "s", "scorecardVersion", certifyScorecardSpec.ScorecardVersion)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "s", "commit", certifyScorecardSpec.Commit)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "s", "origin", certifyScorecardSpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "s", "collector", certifyScorecardSpec.Collector)
+	matchSrcSpec(&sb, queryValues, firstMatch, "", certifyScorecardSpec.Source)
+	if certifyScorecardSpec.LatestOnly != nil && *certifyScorecardSpec.LatestOnly {
+		keepLatest(&sb, "s", "timeScanned", "type", "namespace", "name")
+	}
+
+	sb.WriteString(" RETURN " + scorecardColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var scorecards []*model.CertifyScorecard
+			for result.Next() {
+				scorecards = append(scorecards, scorecardFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return scorecards, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.CertifyScorecard), nil
+}
+
+func (c *neo4jClient) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if source == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("source"))
+	}
+	if scorecard == nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", backends.Missing("scorecard"))
+	}
+	if err := backends.ValidateSourceInput(source); err != nil {
+		return nil, backends.Errorf("IngestScorecard :: %w", err)
+	}
+
+	checks := make([]*model.ScorecardCheckInputSpec, len(scorecard.Checks))
+	copy(checks, scorecard.Checks)
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Check < checks[j].Check
+	})
+	checkNames := make([]string, 0, len(checks))
+	checkScores := make([]int64, 0, len(checks))
+	for _, check := range checks {
+		checkNames = append(checkNames, check.Check)
+		checkScores = append(checkScores, int64(check.Score))
+	}
+
+	queryValues := map[string]interface{}{
+		"commit":           scorecard.Commit,
+		"timeScanned":      scorecard.TimeScanned.UTC(),
+		"checkNames":       checkNames,
+		"checkScores":      checkScores,
+		"aggregateScore":   scorecard.AggregateScore,
+		"scorecardVersion": scorecard.ScorecardVersion,
+		"origin":           scorecard.Origin,
+		"collector":        scorecard.Collector,
+	}
+	addSrcInputValues(queryValues, "", source)
+
+	query := mergeSrcName("") + `
+MERGE (name)<-[:subject]-(s:CertifyScorecard {commit: $commit, timeScanned: $timeScanned})
+ON CREATE SET s.checkNames = $checkNames, s.checkScores = $checkScores, s.aggregateScore = $aggregateScore, s.scorecardVersion = $scorecardVersion, s.origin = $origin, s.collector = $collector
+RETURN ` + scorecardColumns
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return scorecardFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyScorecard), nil
+}
+
+// scorecardFromValues converts the values of the scorecardColumns to the
+// model.
+func scorecardFromValues(values []interface{}) *model.CertifyScorecard {
+	names, _ := values[1].([]interface{})
+	scores, _ := values[2].([]interface{})
+	checks := make([]*model.ScorecardCheck, 0, len(names))
+	for i := range names {
+		checks = append(checks, &model.ScorecardCheck{
+			Check: names[i].(string),
+			Score: int(scores[i].(int64)),
+		})
+	}
+	return &model.CertifyScorecard{
+		ID:     nodeID(values[0].(int64)),
+		Source: sourceFromValues(values[9:]),
+		Scorecard: &model.Scorecard{
+			Checks:           checks,
+			AggregateScore:   values[3].(float64),
+			TimeScanned:      values[4].(time.Time).UTC(),
+			ScorecardVersion: values[5].(string),
+			Commit:           values[6].(string),
+			Origin:           values[7].(string),
+			Collector:        values[8].(string),
+		},
+	}
+}
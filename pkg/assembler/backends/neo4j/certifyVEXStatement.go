@@ -0,0 +1,240 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// CertifyVEXStatement nodes are stored as
+//
+//	(subject)<-[:subject]-(:CertifyVEXStatement {status, vexJustification, statement, knownSince, origin, collector})-[:is_vuln]->(:VulnID)
+//
+// where subject is either a PkgVersion or an Artifact node. Every property is
+// part of the merge pattern, so a new statement about the same subject and
+// vulnerability is recorded as a separate node.
+
+// vexStatementColumns are the columns returning the CertifyVEXStatement node
+// bound to v and its vulnerability, as expected by vexStatementFromValues.
+var vexStatementColumns = "id(v), v.status, v.vexJustification, v.statement, v.knownSince, v.origin, v.collector, " + vulnIDColumns("")
+
+func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if certifyVEXStatementSpec == nil {
+		certifyVEXStatementSpec = &model.CertifyVEXStatementSpec{}
+	}
+	subject := certifyVEXStatementSpec.Subject
+	if subject == nil {
+		subject = &model.PackageOrArtifactSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("CertifyVEXStatement :: %w", err)
+	}
+
+	// Packages and artifacts are queried separately, as for HasSBOM.
+	type query struct {
+		cypher      string
+		queryValues map[string]interface{}
+		toSubject   func([]interface{}) model.PackageOrArtifact
+	}
+	var queries []query
+
+	if subject.Artifact == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(v:CertifyVEXStatement)-[:is_vuln]->(vulnID:VulnID), " + vulnIDPath(""))
+		firstMatch, err := matchVEXStatementSpec(&sb, queryValues, certifyVEXStatementSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchPkgSpec(&sb, queryValues, firstMatch, "", subject.Package)
+		sb.WriteString(" RETURN " + vexStatementColumns + ", " + pkgVersionColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrArtifact {
+			return packageFromValues(values)
+		}})
+	}
+
+	if subject.Package == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH (a:Artifact)<-[:subject]-(v:CertifyVEXStatement)-[:is_vuln]->(vulnID:VulnID), " + vulnIDPath(""))
+		firstMatch, err := matchVEXStatementSpec(&sb, queryValues, certifyVEXStatementSpec)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := matchArtifactSpec(&sb, queryValues, firstMatch, "a", subject.Artifact); err != nil {
+			return nil, err
+		}
+		sb.WriteString(" RETURN " + vexStatementColumns + ", id(a), a.algorithm, a.digest")
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrArtifact {
+			return artifactFromValues(values)
+		}})
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.CertifyVEXStatement
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					v := vexStatementFromValues(values)
+					v.Subject = q.toSubject(values[11:])
+					out = append(out, v)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.CertifyVEXStatement), nil
+}
+
+// matchVEXStatementSpec adds the clauses matching the CertifyVEXStatement
+// node bound to v and its vulnerability against the spec. The subject is not
+// matched.
+func matchVEXStatementSpec(sb *strings.Builder, queryValues map[string]interface{}, spec *model.CertifyVEXStatementSpec) (bool, error) {
+	firstMatch, err := matchID(sb, queryValues, true, "v", spec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	var status, justification *string
+	if spec.Status != nil {
+		status = (*string)(spec.Status)
+	}
+	if spec.VexJustification != nil {
+		justification = (*string)(spec.VexJustification)
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "v", "status", status)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "v", "vexJustification", justification)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "v", "statement", spec.Statement)
+	firstMatch = matchTime(sb, queryValues, firstMatch, "v", "knownSince", ">=", "knownSinceFrom", spec.KnownSinceFrom)
+	firstMatch = matchTime(sb, queryValues, firstMatch, "v", "knownSince", "<=", "knownSinceUntil", spec.KnownSinceUntil)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "v", "origin", spec.Origin)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "v", "collector", spec.Collector)
+	return matchVulnSpec(sb, queryValues, firstMatch, "", spec.Vulnerability)
+}
+
+func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	if subject == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("subject"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vulnerability"))
+	}
+	if vexStatement == nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", backends.Missing("vexStatement"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if err := backends.ValidateVEXStatementInput(vexStatement); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+
+	queryValues := map[string]interface{}{
+		"status":           string(vexStatement.Status),
+		"vexJustification": string(vexStatement.VexJustification),
+		"statement":        vexStatement.Statement,
+		"knownSince":       vexStatement.KnownSince.UTC(),
+		"origin":           vexStatement.Origin,
+		"collector":        vexStatement.Collector,
+	}
+	if err := addVulnInputValues(queryValues, "", vulnerability); err != nil {
+		return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+	}
+	mergeVEXStatement := mergeVulnID("") + `
+MERGE (subject)<-[:subject]-(v:CertifyVEXStatement {status: $status, vexJustification: $vexJustification, statement: $statement, knownSince: $knownSince, origin: $origin, collector: $collector})-[:is_vuln]->(vulnID)`
+
+	var query string
+	var toSubject func([]interface{}) model.PackageOrArtifact
+	if subject.Package != nil {
+		addPkgInputValues(queryValues, "", subject.Package)
+		query = mergePkgVersion("") + "\nWITH version AS subject, type, namespace, name, version\n" + mergeVEXStatement +
+			"\nRETURN " + vexStatementColumns + ", " + pkgVersionColumns("")
+		toSubject = func(values []interface{}) model.PackageOrArtifact {
+			return packageFromValues(values)
+		}
+	} else {
+		algorithm, digest, err := backends.CanonicalArtifact(subject.Artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestVEXStatement :: %w", err)
+		}
+		queryValues["artifactAlgorithm"] = algorithm
+		queryValues["artifactDigest"] = digest
+		query = "MERGE (subject:Artifact {algorithm: $artifactAlgorithm, digest: $artifactDigest})\n" + mergeVEXStatement +
+			"\nRETURN " + vexStatementColumns + ", id(subject), subject.algorithm, subject.digest"
+		toSubject = func(values []interface{}) model.PackageOrArtifact {
+			return artifactFromValues(values)
+		}
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			v := vexStatementFromValues(record.Values)
+			v.Subject = toSubject(record.Values[11:])
+			return v, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyVEXStatement), nil
+}
+
+// vexStatementFromValues converts the values of the vexStatementColumns to
+// the model, without the subject.
+func vexStatementFromValues(values []interface{}) *model.CertifyVEXStatement {
+	return &model.CertifyVEXStatement{
+		ID:               nodeID(values[0].(int64)),
+		Status:           model.VexStatus(values[1].(string)),
+		VexJustification: model.VexJustification(values[2].(string)),
+		Statement:        values[3].(string),
+		KnownSince:       values[4].(time.Time).UTC(),
+		Origin:           values[5].(string),
+		Collector:        values[6].(string),
+		Vulnerability:    vulnerabilityFromValues(values[7:11]),
+	}
+}
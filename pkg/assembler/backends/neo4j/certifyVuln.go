@@ -0,0 +1,173 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// CertifyVuln nodes are stored as
+//
+//	(:PkgVersion)<-[:subject]-(:CertifyVuln {timeScanned, dbUri, dbVersion, scannerUri, scannerVersion, origin, collector})-[:is_vuln]->(:VulnID)
+//
+// Every property is part of the merge pattern, so every distinct scan is
+// recorded as a separate node.
+
+// certifyVulnColumns are the columns returning the CertifyVuln node bound to
+// cv, followed by the package and vulnerability paths, as expected by
+// certifyVulnFromValues.
+var certifyVulnColumns = "id(cv), cv.timeScanned, cv.dbUri, cv.dbVersion, cv.scannerUri, cv.scannerVersion, cv.origin, cv.collector, " +
+	pkgVersionColumns("") + ", " + vulnIDColumns("")
+
+func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if certifyVulnSpec == nil {
+		certifyVulnSpec = &model.CertifyVulnSpec{}
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(cv:CertifyVuln)-[:is_vuln]->(vulnID:VulnID), " + vulnIDPath(""))
+
+	firstMatch, err := matchID(&sb, queryValues, true, "cv", certifyVulnSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchTime(&sb, queryValues, firstMatch, "cv", "timeScanned", ">=", "timeScannedSince", certifyVulnSpec.TimeScannedSince)
+	firstMatch = matchTime(&sb, queryValues, firstMatch, "cv", "timeScanned", "<=", "timeScannedUntil", certifyVulnSpec.TimeScannedUntil)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "dbUri", certifyVulnSpec.DbURI)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "dbVersion", certifyVulnSpec.DbVersion)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "scannerUri", certifyVulnSpec.ScannerURI)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "scannerVersion", certifyVulnSpec.ScannerVersion)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "origin", certifyVulnSpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "cv", "collector", certifyVulnSpec.Collector)
+	firstMatch = matchPkgSpec(&sb, queryValues, firstMatch, "", certifyVulnSpec.Package)
+	if _, err := matchVulnSpec(&sb, queryValues, firstMatch, "", certifyVulnSpec.Vulnerability); err != nil {
+		return nil, err
+	}
+	if certifyVulnSpec.LatestOnly != nil && *certifyVulnSpec.LatestOnly {
+		keepAllLatest(&sb, "cv", "timeScanned", "type", "namespace", "name", "version")
+		// The vulnerabilities of the latest scan are matched again, as they
+		// are not part of the grouping.
+		sb.WriteString(" MATCH (cv)-[:is_vuln]->(vulnID:VulnID), " + vulnIDPath(""))
+	}
+
+	sb.WriteString(" RETURN " + certifyVulnColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var certifications []*model.CertifyVuln
+			for result.Next() {
+				certifications = append(certifications, certifyVulnFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return certifications, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.CertifyVuln), nil
+}
+
+func (c *neo4jClient) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	if pkg == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("pkg"))
+	}
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("vulnerability"))
+	}
+	if certifyVuln == nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", backends.Missing("certifyVuln"))
+	}
+
+	queryValues := map[string]interface{}{
+		"timeScanned":    certifyVuln.TimeScanned.UTC(),
+		"dbUri":          certifyVuln.DbURI,
+		"dbVersion":      certifyVuln.DbVersion,
+		"scannerUri":     certifyVuln.ScannerURI,
+		"scannerVersion": certifyVuln.ScannerVersion,
+		"origin":         certifyVuln.Origin,
+		"collector":      certifyVuln.Collector,
+	}
+	if err := addVulnInputValues(queryValues, "", vulnerability); err != nil {
+		return nil, backends.Errorf("IngestCertifyVuln :: %w", err)
+	}
+	addPkgInputValues(queryValues, "", pkg)
+
+	query := mergePkgVersion("") + "\n" + mergeVulnID("") + `
+MERGE (version)<-[:subject]-(cv:CertifyVuln {timeScanned: $timeScanned, dbUri: $dbUri, dbVersion: $dbVersion, scannerUri: $scannerUri, scannerVersion: $scannerVersion, origin: $origin, collector: $collector})-[:is_vuln]->(vulnID)
+RETURN ` + certifyVulnColumns
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return certifyVulnFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyVuln), nil
+}
+
+// certifyVulnFromValues converts the values of the certifyVulnColumns to the
+// model.
+func certifyVulnFromValues(values []interface{}) *model.CertifyVuln {
+	return &model.CertifyVuln{
+		ID:            nodeID(values[0].(int64)),
+		Package:       packageFromValues(values[8:18]),
+		Vulnerability: vulnerabilityFromValues(values[18:22]),
+		Metadata: &model.ScanMetadata{
+			TimeScanned:    values[1].(time.Time).UTC(),
+			DbURI:          values[2].(string),
+			DbVersion:      values[3].(string),
+			ScannerURI:     values[4].(string),
+			ScannerVersion: values[5].(string),
+			Origin:         values[6].(string),
+			Collector:      values[7].(string),
+		},
+	}
+}
@@ -0,0 +1,150 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// HasMetadata nodes are stored as
+//
+//	(subject)<-[:subject]-(:HasMetadata {key, value, justification, since, origin, collector})
+//
+// where subject is either a PkgVersion, a PkgName (for metadata which apply
+// to all the versions of a package), a SrcName or an Artifact node. The nodes
+// are merged on the subject, key, value and justification only, so since,
+// origin and collector are the ones of the first ingestion.
+
+// hasMetadataColumns are the columns returning the HasMetadata node bound to
+// meta, as expected by hasMetadataFromValues.
+const hasMetadataColumns = "id(meta), meta.key, meta.value, meta.since, meta.justification, meta.origin, meta.collector"
+
+func (c *neo4jClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	if hasMetadataSpec == nil {
+		hasMetadataSpec = &model.HasMetadataSpec{}
+	}
+
+	queries, err := subjectQueries(hasMetadataSpec.Subject, "meta", "HasMetadata", func(sb *strings.Builder, queryValues map[string]interface{}) (bool, error) {
+		firstMatch, err := matchID(sb, queryValues, true, "meta", hasMetadataSpec.ID)
+		if err != nil {
+			return firstMatch, err
+		}
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "meta", "key", hasMetadataSpec.Key)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "meta", "value", hasMetadataSpec.Value)
+		firstMatch = matchTime(sb, queryValues, firstMatch, "meta", "since", "=", "since", hasMetadataSpec.Since)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "meta", "justification", hasMetadataSpec.Justification)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "meta", "origin", hasMetadataSpec.Origin)
+		return matchProperty(sb, queryValues, firstMatch, "meta", "collector", hasMetadataSpec.Collector), nil
+	}, hasMetadataColumns)
+	if err != nil {
+		return nil, backends.Errorf("HasMetadata :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.HasMetadata
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					meta := hasMetadataFromValues(values)
+					meta.Subject = q.toSubject(values[7:])
+					out = append(out, meta)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.HasMetadata), nil
+}
+
+func (c *neo4jClient) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	if hasMetadata == nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", backends.Missing("hasMetadata"))
+	}
+
+	queryValues := map[string]interface{}{
+		"key":           hasMetadata.Key,
+		"value":         hasMetadata.Value,
+		"since":         hasMetadata.Since.UTC(),
+		"justification": hasMetadata.Justification,
+		"origin":        hasMetadata.Origin,
+		"collector":     hasMetadata.Collector,
+	}
+	mergeHasMetadata := "MERGE (subject)<-[:subject]-(meta:HasMetadata {key: $key, value: $value, justification: $justification})\n" +
+		"ON CREATE SET meta.since = $since, meta.origin = $origin, meta.collector = $collector"
+	query, toSubject, err := mergeOnSubject(subject, pkgMatchType, queryValues, mergeHasMetadata, hasMetadataColumns)
+	if err != nil {
+		return nil, backends.Errorf("IngestHasMetadata :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			meta := hasMetadataFromValues(record.Values)
+			meta.Subject = toSubject(record.Values[7:])
+			return meta, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.HasMetadata), nil
+}
+
+// hasMetadataFromValues converts the values of the hasMetadataColumns to the
+// model, without the subject.
+func hasMetadataFromValues(values []interface{}) *model.HasMetadata {
+	return &model.HasMetadata{
+		ID:            nodeID(values[0].(int64)),
+		Key:           values[1].(string),
+		Value:         values[2].(string),
+		Since:         values[3].(time.Time).UTC(),
+		Justification: values[4].(string),
+		Origin:        values[5].(string),
+		Collector:     values[6].(string),
+	}
+}
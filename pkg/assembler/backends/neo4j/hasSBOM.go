@@ -0,0 +1,226 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// HasSBOM nodes are stored as
+//
+//	(:HasSBOM {uri, algorithm, digest, downloadLocation, origin, collector})-[:subject]->(subject)
+//
+// where subject is either a PkgVersion or an Artifact node.
+
+// hasSBOMColumns are the columns returning the HasSBOM node bound to h, as
+// expected by hasSBOMFromValues.
+const hasSBOMColumns = "id(h), h.uri, h.algorithm, h.digest, h.downloadLocation, h.origin, h.collector"
+
+func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if hasSBOMSpec == nil {
+		hasSBOMSpec = &model.HasSBOMSpec{}
+	}
+	subject := hasSBOMSpec.Subject
+	if subject == nil {
+		subject = &model.PackageOrArtifactSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("HasSBOM :: %w", err)
+	}
+
+	// Packages and artifacts are queried separately, skipping the subject
+	// kind which is excluded by the spec.
+	type query struct {
+		cypher      string
+		queryValues map[string]interface{}
+		toSubject   func([]interface{}) model.PackageOrArtifact
+	}
+	var queries []query
+
+	if subject.Artifact == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(h:HasSBOM)")
+		firstMatch, err := matchHasSBOMSpec(&sb, queryValues, hasSBOMSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchPkgSpec(&sb, queryValues, firstMatch, "", subject.Package)
+		sb.WriteString(" RETURN " + hasSBOMColumns + ", " + pkgVersionColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrArtifact {
+			return packageFromValues(values)
+		}})
+	}
+
+	if subject.Package == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH (a:Artifact)<-[:subject]-(h:HasSBOM)")
+		firstMatch, err := matchHasSBOMSpec(&sb, queryValues, hasSBOMSpec)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := matchArtifactSpec(&sb, queryValues, firstMatch, "a", subject.Artifact); err != nil {
+			return nil, err
+		}
+		sb.WriteString(" RETURN " + hasSBOMColumns + ", id(a), a.algorithm, a.digest")
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrArtifact {
+			return artifactFromValues(values)
+		}})
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.HasSbom
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					h := hasSBOMFromValues(values)
+					h.Subject = q.toSubject(values[7:])
+					out = append(out, h)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.HasSbom), nil
+}
+
+// matchHasSBOMSpec adds the clauses matching the HasSBOM node bound to h
+// against the spec. The subject is not matched.
+func matchHasSBOMSpec(sb *strings.Builder, queryValues map[string]interface{}, hasSBOMSpec *model.HasSBOMSpec) (bool, error) {
+	firstMatch, err := matchID(sb, queryValues, true, "h", hasSBOMSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "h", "uri", hasSBOMSpec.URI)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "h", "algorithm", backends.LowerIfSet(hasSBOMSpec.Algorithm))
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "h", "digest", backends.LowerIfSet(hasSBOMSpec.Digest))
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "h", "downloadLocation", hasSBOMSpec.DownloadLocation)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "h", "origin", hasSBOMSpec.Origin)
+	return matchProperty(sb, queryValues, firstMatch, "h", "collector", hasSBOMSpec.Collector), nil
+}
+
+func (c *neo4jClient) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	if subject == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("subject"))
+	}
+	if hasSbom == nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", backends.Missing("hasSBOM"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestHasSbom :: %w", err)
+	}
+
+	queryValues := map[string]interface{}{
+		"uri":              hasSbom.URI,
+		"algorithm":        strings.ToLower(hasSbom.Algorithm),
+		"digest":           strings.ToLower(hasSbom.Digest),
+		"downloadLocation": hasSbom.DownloadLocation,
+		"origin":           hasSbom.Origin,
+		"collector":        hasSbom.Collector,
+	}
+	mergeHasSBOM := "MERGE (subject)<-[:subject]-(h:HasSBOM {uri: $uri, algorithm: $algorithm, digest: $digest, downloadLocation: $downloadLocation, origin: $origin, collector: $collector})"
+
+	var query string
+	var toSubject func([]interface{}) model.PackageOrArtifact
+	if subject.Package != nil {
+		addPkgInputValues(queryValues, "", subject.Package)
+		query = mergePkgVersion("") + "\nWITH version AS subject, type, namespace, name, version\n" + mergeHasSBOM +
+			"\nRETURN " + hasSBOMColumns + ", " + pkgVersionColumns("")
+		toSubject = func(values []interface{}) model.PackageOrArtifact {
+			return packageFromValues(values)
+		}
+	} else {
+		algorithm, digest, err := backends.CanonicalArtifact(subject.Artifact)
+		if err != nil {
+			return nil, backends.Errorf("IngestHasSbom :: %w", err)
+		}
+		queryValues["artifactAlgorithm"] = algorithm
+		queryValues["artifactDigest"] = digest
+		query = "MERGE (subject:Artifact {algorithm: $artifactAlgorithm, digest: $artifactDigest})\n" + mergeHasSBOM +
+			"\nRETURN " + hasSBOMColumns + ", id(subject), subject.algorithm, subject.digest"
+		toSubject = func(values []interface{}) model.PackageOrArtifact {
+			return artifactFromValues(values)
+		}
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			h := hasSBOMFromValues(record.Values)
+			h.Subject = toSubject(record.Values[7:])
+			return h, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.HasSbom), nil
+}
+
+// hasSBOMFromValues converts the values of the hasSBOMColumns to the model,
+// without the subject.
+func hasSBOMFromValues(values []interface{}) *model.HasSbom {
+	return &model.HasSbom{
+		ID:               nodeID(values[0].(int64)),
+		URI:              values[1].(string),
+		Algorithm:        values[2].(string),
+		Digest:           values[3].(string),
+		DownloadLocation: values[4].(string),
+		Origin:           values[5].(string),
+		Collector:        values[6].(string),
+	}
+}
+
+// FindSBOMsByArtifact walks the evidence trees with the search shared by the
+// backends.
+func (c *neo4jClient) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.FindSBOMsByArtifact(ctx, c, algorithm, digest)
+}
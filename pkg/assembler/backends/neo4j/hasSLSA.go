@@ -0,0 +1,276 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// HasSLSA nodes are stored as
+//
+//	(:Artifact)<-[:subject]-(:HasSLSA {buildType, predicateKeys, predicateValues, slsaVersion, builtFromKey, startedOn, finishedOn, origin, collector})-[:built_by]->(:Builder)
+//	(:HasSLSA)-[:built_from]->(:Artifact)
+//
+// where predicateKeys and predicateValues are parallel lists, sorted by key
+// and value, and builtFromKey is the sorted list of the algorithm:digest of
+// the materials. The node is merged on all the properties except startedOn
+// and finishedOn, which are set when it is created.
+
+// hasSLSAColumns are the columns returning the HasSLSA node bound to h, with
+// its subject, builder and materials, as expected by hasSLSAFromValues.
+const hasSLSAColumns = "id(h), h.buildType, h.predicateKeys, h.predicateValues, h.slsaVersion, h.startedOn, h.finishedOn, h.origin, h.collector, " +
+	"id(subject), subject.algorithm, subject.digest, id(builder), builder.uri, " +
+	"[(h)-[:built_from]->(m:Artifact) | [id(m), m.algorithm, m.digest]]"
+
+func (c *neo4jClient) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if hasSLSASpec == nil {
+		hasSLSASpec = &model.HasSLSASpec{}
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH (subject:Artifact)<-[:subject]-(h:HasSLSA)-[:built_by]->(builder:Builder)")
+	firstMatch, err := matchID(&sb, queryValues, true, "h", hasSLSASpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "buildType", hasSLSASpec.BuildType)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "slsaVersion", hasSLSASpec.SlsaVersion)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "origin", hasSLSASpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "collector", hasSLSASpec.Collector)
+	firstMatch, err = matchArtifactSpec(&sb, queryValues, firstMatch, "subject", hasSLSASpec.Subject)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch, err = matchBuilderSpec(&sb, queryValues, firstMatch, "builder", hasSLSASpec.BuiltBy)
+	if err != nil {
+		return nil, err
+	}
+	// Every material filter must match one of the materials, which is
+	// checked by a separate subquery for each of them.
+	for i, artifactSpec := range hasSLSASpec.BuiltFrom {
+		if firstMatch {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		firstMatch = false
+		label := "m" + strconv.Itoa(i)
+		sb.WriteString("EXISTS { MATCH (h)-[:built_from]->(" + label + ":Artifact)")
+		if _, err := matchArtifactSpec(&sb, queryValues, true, label, artifactSpec); err != nil {
+			return nil, err
+		}
+		sb.WriteString(" }")
+	}
+	sb.WriteString(" RETURN " + hasSLSAColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var hasSLSAs []*model.HasSlsa
+			for result.Next() {
+				hasSLSAs = append(hasSLSAs, hasSLSAFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return hasSLSAs, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.HasSlsa), nil
+}
+
+func (c *neo4jClient) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	if subject == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("subject"))
+	}
+	if builtBy == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("builtBy"))
+	}
+	if slsa == nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.Missing("slsa"))
+	}
+	if builtBy.URI == "" {
+		return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("uri", "builder URI must not be empty"))
+	}
+	algorithm, digest, err := backends.CanonicalArtifact(subject)
+	if err != nil {
+		return nil, backends.Errorf("IngestSLSA :: %w", err)
+	}
+	materials := map[string]map[string]interface{}{}
+	for i, m := range builtFrom {
+		if m == nil {
+			return nil, backends.Errorf("IngestSLSA :: %w", backends.NewValidationError("builtFrom", "missing material at index %d", i))
+		}
+		materialAlgorithm, materialDigest, err := backends.CanonicalArtifact(m)
+		if err != nil {
+			return nil, backends.Errorf("IngestSLSA :: material at index %d: %w", i, err)
+		}
+		materials[materialAlgorithm+":"+materialDigest] = map[string]interface{}{
+			"algorithm": materialAlgorithm,
+			"digest":    materialDigest,
+		}
+	}
+	builtFromKey := make([]string, 0, len(materials))
+	for key := range materials {
+		builtFromKey = append(builtFromKey, key)
+	}
+	sort.Strings(builtFromKey)
+	batch := make([]interface{}, 0, len(builtFromKey))
+	for _, key := range builtFromKey {
+		batch = append(batch, materials[key])
+	}
+
+	predicates := make([]*model.SLSAPredicateInputSpec, len(slsa.SlsaPredicate))
+	copy(predicates, slsa.SlsaPredicate)
+	sort.Slice(predicates, func(i, j int) bool {
+		if predicates[i].Key != predicates[j].Key {
+			return predicates[i].Key < predicates[j].Key
+		}
+		return predicates[i].Value < predicates[j].Value
+	})
+	predicateKeys := make([]string, 0, len(predicates))
+	predicateValues := make([]string, 0, len(predicates))
+	for _, p := range predicates {
+		predicateKeys = append(predicateKeys, p.Key)
+		predicateValues = append(predicateValues, p.Value)
+	}
+
+	queryValues := map[string]interface{}{
+		"algorithm":       algorithm,
+		"digest":          digest,
+		"uri":             builtBy.URI,
+		"buildType":       slsa.BuildType,
+		"predicateKeys":   predicateKeys,
+		"predicateValues": predicateValues,
+		"slsaVersion":     slsa.SlsaVersion,
+		"builtFromKey":    builtFromKey,
+		"builtFrom":       batch,
+		"startedOn":       optionalTime(slsa.StartedOn),
+		"finishedOn":      optionalTime(slsa.FinishedOn),
+		"origin":          slsa.Origin,
+		"collector":       slsa.Collector,
+	}
+
+	query := `MERGE (subject:Artifact {algorithm: $algorithm, digest: $digest})
+MERGE (builder:Builder {uri: $uri})
+MERGE (subject)<-[:subject]-(h:HasSLSA {buildType: $buildType, predicateKeys: $predicateKeys, predicateValues: $predicateValues, slsaVersion: $slsaVersion, builtFromKey: $builtFromKey, origin: $origin, collector: $collector})-[:built_by]->(builder)
+ON CREATE SET h.startedOn = $startedOn, h.finishedOn = $finishedOn
+FOREACH (material IN $builtFrom |
+  MERGE (m:Artifact {algorithm: material.algorithm, digest: material.digest})
+  MERGE (h)-[:built_from]->(m))
+RETURN ` + hasSLSAColumns
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return hasSLSAFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.HasSlsa), nil
+}
+
+// optionalTime converts an optional time to a query parameter, where missing
+// values are null.
+func optionalTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// timeFromValue converts an optional time property to the model.
+func timeFromValue(value interface{}) *time.Time {
+	t, ok := value.(time.Time)
+	if !ok {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+// hasSLSAFromValues converts the values of the hasSLSAColumns to the model.
+// Materials are sorted by algorithm and digest, to return them in a stable
+// order.
+func hasSLSAFromValues(values []interface{}) *model.HasSlsa {
+	keys, _ := values[2].([]interface{})
+	predicateValues, _ := values[3].([]interface{})
+	predicates := make([]*model.SLSAPredicate, 0, len(keys))
+	for i := range keys {
+		predicates = append(predicates, &model.SLSAPredicate{
+			Key:   keys[i].(string),
+			Value: predicateValues[i].(string),
+		})
+	}
+	materials, _ := values[14].([]interface{})
+	builtFrom := make([]*model.Artifact, 0, len(materials))
+	for _, m := range materials {
+		builtFrom = append(builtFrom, artifactFromValues(m.([]interface{})))
+	}
+	sort.Slice(builtFrom, func(i, j int) bool {
+		return builtFrom[i].Algorithm+":"+builtFrom[i].Digest < builtFrom[j].Algorithm+":"+builtFrom[j].Digest
+	})
+	return &model.HasSlsa{
+		ID:      nodeID(values[0].(int64)),
+		Subject: artifactFromValues(values[9:12]),
+		Slsa: &model.Slsa{
+			BuiltFrom:     builtFrom,
+			BuiltBy:       builderFromValues(values[12:14]),
+			BuildType:     values[1].(string),
+			SlsaPredicate: predicates,
+			SlsaVersion:   values[4].(string),
+			StartedOn:     timeFromValue(values[5]),
+			FinishedOn:    timeFromValue(values[6]),
+			Origin:        values[7].(string),
+			Collector:     values[8].(string),
+		},
+	}
+}
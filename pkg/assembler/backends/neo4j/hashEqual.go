@@ -0,0 +1,176 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// HashEqual nodes are stored as
+//
+//	(:Artifact)<-[:has_equal]-(:HashEqual {justification, origin, collector})-[:has_equal]->(:Artifact)
+//
+// The pattern is symmetric, so merging it with the artifacts in either order
+// finds the same node.
+
+// hashEqualColumns are the columns returning the HashEqual node bound to h
+// and its artifacts, as expected by hashEqualFromValues.
+const hashEqualColumns = "id(h), h.justification, h.origin, h.collector, collect([id(a), a.algorithm, a.digest])"
+
+func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if hashEqualSpec == nil {
+		hashEqualSpec = &model.HashEqualSpec{}
+	}
+	if len(hashEqualSpec.Artifacts) > 2 {
+		return nil, backends.Errorf("HashEqual :: %w", backends.NewValidationError("artifacts", "cannot filter on more than 2 artifacts"))
+	}
+	if hashEqualSpec.ExpandTransitive != nil && *hashEqualSpec.ExpandTransitive {
+		return backends.ExpandHashEqual(ctx, c, hashEqualSpec)
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH (a1:Artifact)<-[:has_equal]-(h:HashEqual)-[:has_equal]->(a2:Artifact)")
+	firstMatch, err := matchID(&sb, queryValues, true, "h", hashEqualSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "justification", hashEqualSpec.Justification)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "origin", hashEqualSpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "h", "collector", hashEqualSpec.Collector)
+	for i, artifactSpec := range hashEqualSpec.Artifacts {
+		label := "a1"
+		if i == 1 {
+			label = "a2"
+		}
+		firstMatch, err = matchArtifactSpec(&sb, queryValues, firstMatch, label, artifactSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Both orders of the artifacts match the pattern, so the same node can be
+	// found twice.
+	sb.WriteString(" WITH DISTINCT h MATCH (h)-[:has_equal]->(a:Artifact) RETURN " + hashEqualColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var hashEquals []*model.HashEqual
+			for result.Next() {
+				hashEquals = append(hashEquals, hashEqualFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return hashEquals, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.HashEqual), nil
+}
+
+func (c *neo4jClient) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	if artifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("artifact"))
+	}
+	if equalArtifact == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("equalArtifact"))
+	}
+	if hashEqual == nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.Missing("hashEqual"))
+	}
+	algorithm, digest, err := backends.CanonicalArtifact(artifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", err)
+	}
+	equalAlgorithm, equalDigest, err := backends.CanonicalArtifact(equalArtifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestHashEqual :: %w", err)
+	}
+	if algorithm == equalAlgorithm && digest == equalDigest {
+		return nil, backends.Errorf("IngestHashEqual :: %w", backends.NewValidationError("equalArtifact", "an artifact cannot be certified equal to itself"))
+	}
+
+	query := `MERGE (a1:Artifact {algorithm: $algorithm, digest: $digest})
+MERGE (a2:Artifact {algorithm: $equalAlgorithm, digest: $equalDigest})
+MERGE (a1)<-[:has_equal]-(h:HashEqual {justification: $justification, origin: $origin, collector: $collector})-[:has_equal]->(a2)
+WITH h MATCH (h)-[:has_equal]->(a:Artifact)
+RETURN ` + hashEqualColumns
+	queryValues := map[string]interface{}{
+		"algorithm":      algorithm,
+		"digest":         digest,
+		"equalAlgorithm": equalAlgorithm,
+		"equalDigest":    equalDigest,
+		"justification":  hashEqual.Justification,
+		"origin":         hashEqual.Origin,
+		"collector":      hashEqual.Collector,
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return hashEqualFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.HashEqual), nil
+}
+
+// hashEqualFromValues converts the values of the hashEqualColumns to the
+// model.
+func hashEqualFromValues(values []interface{}) *model.HashEqual {
+	h := &model.HashEqual{
+		ID:            nodeID(values[0].(int64)),
+		Justification: values[1].(string),
+		Origin:        values[2].(string),
+		Collector:     values[3].(string),
+	}
+	artifacts, _ := values[4].([]interface{})
+	for _, a := range artifacts {
+		h.Artifacts = append(h.Artifacts, artifactFromValues(a.([]interface{})))
+	}
+	return h
+}
@@ -0,0 +1,165 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// IsDependency nodes are stored as
+//
+//	(:PkgVersion)<-[:subject]-(:IsDependency {versionRange, dependencyType, justification, origin, collector})-[:dependency]->(:PkgName)
+//
+// The dependency package path is bound to variables prefixed with dep_.
+
+// isDependencyColumns are the columns returning the IsDependency node bound
+// to d, followed by both package paths, as expected by isDependencyFromValues.
+var isDependencyColumns = "id(d), d.versionRange, d.dependencyType, d.justification, d.origin, d.collector, " +
+	pkgVersionColumns("") + ", " + pkgNameColumns("dep_")
+
+func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	if isDependencySpec == nil {
+		isDependencySpec = &model.IsDependencySpec{}
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(d:IsDependency)-[:dependency]->(dep_name:PkgName), " + pkgNamePath("dep_"))
+
+	firstMatch, err := matchID(&sb, queryValues, true, "d", isDependencySpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "d", "versionRange", isDependencySpec.VersionRange)
+	if isDependencySpec.DependencyType != nil {
+		dependencyType := string(*isDependencySpec.DependencyType)
+		firstMatch = matchProperty(&sb, queryValues, firstMatch, "d", "dependencyType", &dependencyType)
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "d", "justification", isDependencySpec.Justification)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "d", "origin", isDependencySpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "d", "collector", isDependencySpec.Collector)
+	firstMatch = matchPkgSpec(&sb, queryValues, firstMatch, "", isDependencySpec.Package)
+	matchPkgNameSpec(&sb, queryValues, firstMatch, "dep_", isDependencySpec.DependentPackage)
+
+	sb.WriteString(" RETURN " + isDependencyColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var dependencies []*model.IsDependency
+			for result.Next() {
+				dependencies = append(dependencies, isDependencyFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return dependencies, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.IsDependency), nil
+}
+
+func (c *neo4jClient) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	if pkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("pkg"))
+	}
+	if depPkg == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("depPkg"))
+	}
+	if dependency == nil {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.Missing("dependency"))
+	}
+	if !dependency.DependencyType.IsValid() {
+		return nil, backends.Errorf("IngestIsDependency :: %w", backends.NewValidationError("dependencyType", "invalid dependency type %q", dependency.DependencyType))
+	}
+
+	query := mergePkgVersion("") + "\n" + mergePkgName("dep_") + `
+MERGE (version)<-[:subject]-(d:IsDependency {versionRange: $versionRange, dependencyType: $dependencyType, justification: $justification, origin: $origin, collector: $collector})-[:dependency]->(dep_name)
+RETURN ` + isDependencyColumns
+	queryValues := map[string]interface{}{
+		"versionRange":   dependency.VersionRange,
+		"dependencyType": string(dependency.DependencyType),
+		"justification":  dependency.Justification,
+		"origin":         dependency.Origin,
+		"collector":      dependency.Collector,
+	}
+	addPkgInputValues(queryValues, "", pkg)
+	addPkgInputValues(queryValues, "dep_", depPkg)
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return isDependencyFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.IsDependency), nil
+}
+
+// isDependencyFromValues converts the values of the isDependencyColumns to
+// the model.
+func isDependencyFromValues(values []interface{}) *model.IsDependency {
+	return &model.IsDependency{
+		ID:               nodeID(values[0].(int64)),
+		VersionRange:     values[1].(string),
+		DependencyType:   model.DependencyType(values[2].(string)),
+		Justification:    values[3].(string),
+		Origin:           values[4].(string),
+		Collector:        values[5].(string),
+		Package:          packageFromValues(values[6:16]),
+		DependentPackage: packageNameFromValues(values[16:22]),
+	}
+}
+
+// TransitiveDependencies walks the IsDependency edges with the search shared
+// by the backends.
+func (c *neo4jClient) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return backends.TransitiveDependencies(ctx, c, pkgSpec, maxDepth)
+}
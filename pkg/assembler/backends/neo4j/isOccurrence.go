@@ -0,0 +1,218 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// IsOccurrence nodes are stored as
+//
+//	(subject)<-[:subject]-(:IsOccurrence {justification, origin, collector})-[:has_occurrence]->(:Artifact)
+//
+// where subject is either a PkgVersion or a SrcName node. The node is merged
+// on the justification only, origin and collector are set when it is created.
+
+// isOccurrenceColumns are the columns returning the IsOccurrence node bound to
+// o and its artifact bound to a, as expected by isOccurrenceFromValues.
+const isOccurrenceColumns = "id(o), o.justification, o.origin, o.collector, id(a), a.algorithm, a.digest"
+
+func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if isOccurrenceSpec == nil {
+		isOccurrenceSpec = &model.IsOccurrenceSpec{}
+	}
+	subject := isOccurrenceSpec.Subject
+	if subject == nil {
+		subject = &model.PackageOrSourceSpec{}
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IsOccurrence :: %w", err)
+	}
+
+	// Packages and sources are queried separately, skipping the subject kind
+	// which is excluded by the spec.
+	type query struct {
+		cypher      string
+		queryValues map[string]interface{}
+		toSubject   func([]interface{}) model.PackageOrSource
+	}
+	var queries []query
+
+	if subject.Source == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:subject]-(o:IsOccurrence)-[:has_occurrence]->(a:Artifact)")
+		firstMatch, err := matchIsOccurrenceSpec(&sb, queryValues, isOccurrenceSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchPkgSpec(&sb, queryValues, firstMatch, "", subject.Package)
+		sb.WriteString(" RETURN " + isOccurrenceColumns + ", " + pkgVersionColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrSource {
+			return packageFromValues(values)
+		}})
+	}
+
+	if subject.Package == nil {
+		var sb strings.Builder
+		queryValues := map[string]interface{}{}
+		sb.WriteString("MATCH " + srcNamePath("") + "<-[:subject]-(o:IsOccurrence)-[:has_occurrence]->(a:Artifact)")
+		firstMatch, err := matchIsOccurrenceSpec(&sb, queryValues, isOccurrenceSpec)
+		if err != nil {
+			return nil, err
+		}
+		matchSrcSpec(&sb, queryValues, firstMatch, "", subject.Source)
+		sb.WriteString(" RETURN " + isOccurrenceColumns + ", " + srcNameColumns(""))
+		queries = append(queries, query{sb.String(), queryValues, func(values []interface{}) model.PackageOrSource {
+			return sourceFromValues(values)
+		}})
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.IsOccurrence
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					o := isOccurrenceFromValues(values)
+					o.Subject = q.toSubject(values[7:])
+					out = append(out, o)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.IsOccurrence), nil
+}
+
+// matchIsOccurrenceSpec adds the clauses matching the IsOccurrence node bound
+// to o and its artifact against the spec. The subject is not matched.
+func matchIsOccurrenceSpec(sb *strings.Builder, queryValues map[string]interface{}, isOccurrenceSpec *model.IsOccurrenceSpec) (bool, error) {
+	firstMatch, err := matchID(sb, queryValues, true, "o", isOccurrenceSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "o", "justification", isOccurrenceSpec.Justification)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "o", "origin", isOccurrenceSpec.Origin)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, "o", "collector", isOccurrenceSpec.Collector)
+	return matchArtifactSpec(sb, queryValues, firstMatch, "a", isOccurrenceSpec.Artifact)
+}
+
+func (c *neo4jClient) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	if subject == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("subject"))
+	}
+	if artifact == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("artifact"))
+	}
+	if occurrence == nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", backends.Missing("occurrence"))
+	}
+	if err := backends.ValidateSubjectSpec(subject); err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+	algorithm, digest, err := backends.CanonicalArtifact(artifact)
+	if err != nil {
+		return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+	}
+
+	queryValues := map[string]interface{}{
+		"artifactAlgorithm": algorithm,
+		"artifactDigest":    digest,
+		"justification":     occurrence.Justification,
+		"origin":            occurrence.Origin,
+		"collector":         occurrence.Collector,
+	}
+	mergeOccurrence := `MERGE (a:Artifact {algorithm: $artifactAlgorithm, digest: $artifactDigest})
+MERGE (subject)<-[:subject]-(o:IsOccurrence {justification: $justification})-[:has_occurrence]->(a)
+ON CREATE SET o.origin = $origin, o.collector = $collector`
+
+	var query string
+	var toSubject func([]interface{}) model.PackageOrSource
+	if subject.Package != nil {
+		addPkgInputValues(queryValues, "", subject.Package)
+		query = mergePkgVersion("") + "\nWITH version AS subject, type, namespace, name, version\n" + mergeOccurrence +
+			"\nRETURN " + isOccurrenceColumns + ", " + pkgVersionColumns("")
+		toSubject = func(values []interface{}) model.PackageOrSource {
+			return packageFromValues(values)
+		}
+	} else {
+		if err := backends.ValidateSourceInput(subject.Source); err != nil {
+			return nil, backends.Errorf("IngestIsOccurrence :: %w", err)
+		}
+		addSrcInputValues(queryValues, "", subject.Source)
+		query = mergeSrcName("") + "\nWITH name AS subject, type, namespace, name\n" + mergeOccurrence +
+			"\nRETURN " + isOccurrenceColumns + ", " + srcNameColumns("")
+		toSubject = func(values []interface{}) model.PackageOrSource {
+			return sourceFromValues(values)
+		}
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			o := isOccurrenceFromValues(record.Values)
+			o.Subject = toSubject(record.Values[7:])
+			return o, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.IsOccurrence), nil
+}
+
+// isOccurrenceFromValues converts the values of the isOccurrenceColumns to
+// the model, without the subject.
+func isOccurrenceFromValues(values []interface{}) *model.IsOccurrence {
+	return &model.IsOccurrence{
+		ID:            nodeID(values[0].(int64)),
+		Justification: values[1].(string),
+		Origin:        values[2].(string),
+		Collector:     values[3].(string),
+		Artifact:      artifactFromValues(values[4:7]),
+	}
+}
@@ -0,0 +1,403 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// Path and Neighbors walk the relationships of the nodes regardless of their
+// direction, except the ones to the roots of the tries, which are not nodes
+// of the model. The label of an edge is derived from the labels of its nodes:
+// evidence nodes give their label to all their relationships, the others are
+// trie relationships.
+
+// evidenceEdges maps the label of the evidence nodes to the label of their
+// edges.
+var evidenceEdges = map[string]model.Edge{
+	"CertifyBad":          model.EdgeCertifyBad,
+	"CertifyGood":         model.EdgeCertifyGood,
+	"CertifyLegal":        model.EdgeCertifyLegal,
+	"CertifyScorecard":    model.EdgeCertifyScorecard,
+	"CertifyVEXStatement": model.EdgeCertifyVexStatement,
+	"CertifyVuln":         model.EdgeCertifyVuln,
+	"HashEqual":           model.EdgeHashEqual,
+	"HasMetadata":         model.EdgeHasMetadata,
+	"HasSBOM":             model.EdgeHasSbom,
+	"HasSLSA":             model.EdgeHasSlsa,
+	"IsDependency":        model.EdgeIsDependency,
+	"IsOccurrence":        model.EdgeIsOccurrence,
+	"PkgEqual":            model.EdgePkgEqual,
+	"PointOfContact":      model.EdgePointOfContact,
+	"VulnEqual":           model.EdgeVulnEqual,
+}
+
+// trieNodes maps the label of the trie nodes to the label of their edges and
+// to the query reading back the path from the root of the trie to them. The
+// node is bound to alias in match.
+var trieNodes = map[string]struct {
+	edge    model.Edge
+	match   string
+	alias   string
+	columns string
+	toModel func([]interface{}) model.Node
+}{
+	"PkgType": {
+		edge:    model.EdgePackageTrie,
+		match:   "(type:PkgType)",
+		alias:   "type",
+		columns: "id(type), type.type",
+		toModel: func(values []interface{}) model.Node {
+			return &model.Package{ID: nodeID(values[0].(int64)), Type: values[1].(string), Namespaces: []*model.PackageNamespace{}}
+		},
+	},
+	"PkgNamespace": {
+		edge:    model.EdgePackageTrie,
+		match:   "(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)",
+		alias:   "namespace",
+		columns: "id(type), type.type, id(namespace), namespace.namespace",
+		toModel: func(values []interface{}) model.Node {
+			return &model.Package{
+				ID:   nodeID(values[0].(int64)),
+				Type: values[1].(string),
+				Namespaces: []*model.PackageNamespace{{
+					ID:        nodeID(values[2].(int64)),
+					Namespace: values[3].(string),
+					Names:     []*model.PackageName{},
+				}},
+			}
+		},
+	},
+	"PkgName": {
+		edge:    model.EdgePackageTrie,
+		match:   pkgNamePath(""),
+		alias:   "name",
+		columns: pkgNameColumns(""),
+		toModel: func(values []interface{}) model.Node { return packageNameFromValues(values) },
+	},
+	"PkgVersion": {
+		edge:    model.EdgePackageTrie,
+		match:   pkgVersionPath(""),
+		alias:   "version",
+		columns: pkgVersionColumns(""),
+		toModel: func(values []interface{}) model.Node { return packageFromValues(values) },
+	},
+	"SrcType": {
+		edge:    model.EdgeSourceTrie,
+		match:   "(type:SrcType)",
+		alias:   "type",
+		columns: "id(type), type.type",
+		toModel: func(values []interface{}) model.Node {
+			return &model.Source{ID: nodeID(values[0].(int64)), Type: values[1].(string), Namespaces: []*model.SourceNamespace{}}
+		},
+	},
+	"SrcNamespace": {
+		edge:    model.EdgeSourceTrie,
+		match:   "(type:SrcType)-[:SrcHasNamespace]->(namespace:SrcNamespace)",
+		alias:   "namespace",
+		columns: "id(type), type.type, id(namespace), namespace.namespace",
+		toModel: func(values []interface{}) model.Node {
+			return &model.Source{
+				ID:   nodeID(values[0].(int64)),
+				Type: values[1].(string),
+				Namespaces: []*model.SourceNamespace{{
+					ID:        nodeID(values[2].(int64)),
+					Namespace: values[3].(string),
+					Names:     []*model.SourceName{},
+				}},
+			}
+		},
+	},
+	"SrcName": {
+		edge:    model.EdgeSourceTrie,
+		match:   srcNamePath(""),
+		alias:   "name",
+		columns: srcNameColumns(""),
+		toModel: func(values []interface{}) model.Node { return sourceFromValues(values) },
+	},
+	"VulnType": {
+		edge:    model.EdgeVulnerabilityTrie,
+		match:   "(vulnType:VulnType)",
+		alias:   "vulnType",
+		columns: "id(vulnType), vulnType.type",
+		toModel: func(values []interface{}) model.Node {
+			return &model.Vulnerability{ID: nodeID(values[0].(int64)), Type: values[1].(string), VulnerabilityIDs: []*model.VulnerabilityID{}}
+		},
+	},
+	"VulnID": {
+		edge:    model.EdgeVulnerabilityTrie,
+		match:   vulnIDPath(""),
+		alias:   "vulnID",
+		columns: vulnIDColumns(""),
+		toModel: func(values []interface{}) model.Node { return vulnerabilityFromValues(values) },
+	},
+}
+
+// edgeLabel returns the label of the edge between nodes with the given
+// labels, and false if it is not an edge of the model.
+func edgeLabel(nodeLabels ...[]interface{}) (model.Edge, bool) {
+	for _, labels := range nodeLabels {
+		for _, l := range labels {
+			if edge, ok := evidenceEdges[l.(string)]; ok {
+				return edge, true
+			}
+		}
+	}
+	for _, labels := range nodeLabels {
+		for _, l := range labels {
+			if trie, ok := trieNodes[l.(string)]; ok {
+				return trie.edge, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (c *neo4jClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	if _, err := c.nodeLabel(ctx, node); err != nil {
+		return nil, err
+	}
+	ids, err := c.neighbors(ctx, node, usingOnly)
+	if err != nil {
+		return nil, err
+	}
+	return c.nodes(ctx, ids)
+}
+
+func (c *neo4jClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if maxPathLength <= 0 {
+		return nil, backends.Errorf("Path :: %w", backends.NewValidationError("maxPathLength", "maxPathLength must be positive"))
+	}
+	for _, id := range []string{subject, target} {
+		if _, err := c.nodeLabel(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	ids, err := backends.ShortestPath(ctx, subject, target, maxPathLength,
+		func(ctx context.Context, id string) ([]string, error) {
+			return c.neighbors(ctx, id, usingOnly)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return c.nodes(ctx, ids)
+}
+
+// neighbors returns the IDs of the nodes connected to the node through an
+// allowed edge.
+func (c *neo4jClient) neighbors(ctx context.Context, id string, usingOnly []model.Edge) ([]string, error) {
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	query := "MATCH (n)--(m) WHERE id(n) = $id AND NOT m:Pkg AND NOT m:Src AND NOT m:Vuln" +
+		" RETURN DISTINCT id(m), labels(n), labels(m)"
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, map[string]interface{}{"id": nodeID})
+			if err != nil {
+				return nil, err
+			}
+
+			ids := []string{}
+			seen := map[int64]bool{}
+			for result.Next() {
+				values := result.Record().Values
+				other := values[0].(int64)
+				edge, ok := edgeLabel(values[1].([]interface{}), values[2].([]interface{}))
+				if seen[other] || !ok || !backends.AllowsEdge(usingOnly, edge) {
+					continue
+				}
+				seen[other] = true
+				ids = append(ids, strconv.FormatInt(other, 10))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return ids, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]string), nil
+}
+
+// nodeLabel returns the label identifying the kind of the node, failing if
+// there is no such node.
+func (c *neo4jClient) nodeLabel(ctx context.Context, id string) (string, error) {
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		return "", err
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run("MATCH (n) WHERE id(n) = $id RETURN labels(n)", map[string]interface{}{"id": nodeID})
+			if err != nil {
+				return nil, err
+			}
+
+			var label string
+			for result.Next() {
+				for _, l := range result.Record().Values[0].([]interface{}) {
+					l := l.(string)
+					if _, ok := evidenceEdges[l]; ok {
+						label = l
+					} else if _, ok := trieNodes[l]; ok || l == "Artifact" || l == "Builder" {
+						label = l
+					}
+				}
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return label, nil
+		})
+	if err != nil {
+		return "", err
+	}
+	if result.(string) == "" {
+		return "", backends.Errorf("node %q %w", id, backends.ErrNotFound)
+	}
+	return result.(string), nil
+}
+
+// nodes returns the model of the nodes with the given IDs, in order.
+func (c *neo4jClient) nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	out := make([]model.Node, 0, len(ids))
+	for _, id := range ids {
+		label, err := c.nodeLabel(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		n, err := c.node(ctx, label, id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// node returns the model of the node with the given label and ID.
+func (c *neo4jClient) node(ctx context.Context, label, id string) (model.Node, error) {
+	if trie, ok := trieNodes[label]; ok {
+		return c.trieNode(ctx, id, trie.match, trie.alias, trie.columns, trie.toModel)
+	}
+	switch label {
+	case "Artifact":
+		return firstNode(c.Artifacts(ctx, &model.ArtifactSpec{ID: &id}))
+	case "Builder":
+		return firstNode(c.Builders(ctx, &model.BuilderSpec{ID: &id}))
+	case "CertifyBad":
+		return firstNode(c.CertifyBad(ctx, &model.CertifyBadSpec{ID: &id}))
+	case "CertifyGood":
+		return firstNode(c.CertifyGood(ctx, &model.CertifyGoodSpec{ID: &id}))
+	case "CertifyLegal":
+		return firstNode(c.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: &id}))
+	case "CertifyScorecard":
+		return firstNode(c.Scorecards(ctx, &model.CertifyScorecardSpec{ID: &id}))
+	case "CertifyVEXStatement":
+		return firstNode(c.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &id}))
+	case "CertifyVuln":
+		return firstNode(c.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &id}))
+	case "HashEqual":
+		return firstNode(c.HashEqual(ctx, &model.HashEqualSpec{ID: &id}))
+	case "HasMetadata":
+		return firstNode(c.HasMetadata(ctx, &model.HasMetadataSpec{ID: &id}))
+	case "HasSBOM":
+		return firstNode(c.HasSBOM(ctx, &model.HasSBOMSpec{ID: &id}))
+	case "HasSLSA":
+		return firstNode(c.HasSLSA(ctx, &model.HasSLSASpec{ID: &id}))
+	case "IsDependency":
+		return firstNode(c.IsDependency(ctx, &model.IsDependencySpec{ID: &id}))
+	case "IsOccurrence":
+		return firstNode(c.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: &id}))
+	case "PkgEqual":
+		return firstNode(c.PkgEqual(ctx, &model.PkgEqualSpec{ID: &id}))
+	case "PointOfContact":
+		return firstNode(c.PointOfContact(ctx, &model.PointOfContactSpec{ID: &id}))
+	default:
+		return firstNode(c.VulnEqual(ctx, &model.VulnEqualSpec{ID: &id}))
+	}
+}
+
+// trieNode returns the path from the root of a trie to the node bound to
+// alias in match.
+func (c *neo4jClient) trieNode(ctx context.Context, id, match, alias, columns string, toModel func([]interface{}) model.Node) (model.Node, error) {
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	query := "MATCH " + match + " WHERE id(" + alias + ") = $id RETURN " + columns
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, map[string]interface{}{"id": nodeID})
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return toModel(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(model.Node), nil
+}
+
+// firstNode returns the single node returned by a query on its ID.
+func firstNode[T model.Node](nodes []T, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, backends.Errorf("node %w", backends.ErrNotFound)
+	}
+	return nodes[0], nil
+}
+
+func parseNodeID(id string) (int64, error) {
+	v, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, backends.Errorf("%w", backends.NewValidationError("id", "invalid id %q", id))
+	}
+	return v, nil
+}
@@ -0,0 +1,493 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// The package trie is stored as
+//
+//	(:Pkg)-[:PkgHasType]->(:PkgType {type})
+//	      -[:PkgHasNamespace]->(:PkgNamespace {namespace})
+//	      -[:PkgHasName]->(:PkgName {name})
+//	      -[:PkgHasVersion]->(:PkgVersion {version, subpath, qualifier_list})
+//
+// where qualifier_list is a flattened list of qualifier key, value pairs,
+// sorted by key.
+
+func (c *neo4jClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	if pkgSpec == nil {
+		pkgSpec = &model.PkgSpec{}
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	filterVersion := filtersPackageVersion(pkgSpec)
+	if filterVersion {
+		sb.WriteString("MATCH " + pkgVersionPath(""))
+	} else {
+		sb.WriteString("MATCH " + pkgNamePath(""))
+	}
+
+	matchPkgSpec(&sb, queryValues, true, "", pkgSpec)
+
+	if !filterVersion {
+		sb.WriteString(" OPTIONAL MATCH (name)-[:PkgHasVersion]->(version:PkgVersion)")
+	}
+	sb.WriteString(" RETURN " + pkgVersionColumns(""))
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			trie := newPkgTrieBuilder()
+			for result.Next() {
+				values := result.Record().Values
+				n := trie.addName(values[0].(int64), values[1].(string),
+					values[2].(int64), values[3].(string),
+					values[4].(int64), values[5].(string))
+				if values[6] == nil {
+					continue
+				}
+				n.Versions = append(n.Versions, &model.PackageVersion{
+					ID:         nodeID(values[6].(int64)),
+					Version:    values[7].(string),
+					Subpath:    values[8].(string),
+					Qualifiers: qualifiersFromList(values[9]),
+				})
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return trie.packages, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Package), nil
+}
+
+// PackagesByPurls matches the packages of all the purls in a single query,
+// unwinding the list of their specs. The rows are returned with the index of
+// the purl they match, to reassemble the trie of each purl.
+func (c *neo4jClient) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	specs, specsErr := backends.PurlPkgSpecs(purls)
+	index := make([]string, 0, len(specs))
+	batch := make([]interface{}, 0, len(specs))
+	for purl, pkgSpec := range specs {
+		qualifiers := make([]interface{}, 0, len(pkgSpec.Qualifiers))
+		for _, q := range pkgSpec.Qualifiers {
+			qualifiers = append(qualifiers, map[string]interface{}{"key": q.Key, "value": *q.Value})
+		}
+		var version, subpath interface{}
+		if pkgSpec.Version != nil {
+			version = *pkgSpec.Version
+		}
+		if pkgSpec.Subpath != nil {
+			subpath = *pkgSpec.Subpath
+		}
+		batch = append(batch, map[string]interface{}{
+			"index":      int64(len(index)),
+			"pkgType":    *pkgSpec.Type,
+			"namespace":  *pkgSpec.Namespace,
+			"name":       *pkgSpec.Name,
+			"version":    version,
+			"subpath":    subpath,
+			"qualifiers": qualifiers,
+		})
+		index = append(index, purl)
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	query := "UNWIND $purls AS purl\n" +
+		"MATCH " + pkgNamePath("") + "\n" +
+		"WHERE type.type = purl.pkgType AND namespace.namespace = purl.namespace AND name.name = purl.name\n" +
+		"OPTIONAL MATCH (name)-[:PkgHasVersion]->(version:PkgVersion)\n" +
+		"WHERE (purl.version IS NULL OR version.version = purl.version)" +
+		" AND (purl.subpath IS NULL OR version.subpath = purl.subpath)" +
+		" AND all(q IN purl.qualifiers WHERE any(i IN range(0, size(version.qualifier_list) - 2, 2)" +
+		" WHERE version.qualifier_list[i] = q.key AND version.qualifier_list[i + 1] = q.value))\n" +
+		"RETURN purl.index, " + pkgVersionColumns("")
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, map[string]interface{}{"purls": batch})
+			if err != nil {
+				return nil, err
+			}
+
+			tries := map[string]*pkgTrieBuilder{}
+			for result.Next() {
+				values := result.Record().Values
+				purl := index[values[0].(int64)]
+				values = values[1:]
+				if values[6] == nil && filtersPackageVersion(specs[purl]) {
+					continue
+				}
+				trie, ok := tries[purl]
+				if !ok {
+					trie = newPkgTrieBuilder()
+					tries[purl] = trie
+				}
+				n := trie.addName(values[0].(int64), values[1].(string),
+					values[2].(int64), values[3].(string),
+					values[4].(int64), values[5].(string))
+				if values[6] == nil {
+					continue
+				}
+				n.Versions = append(n.Versions, &model.PackageVersion{
+					ID:         nodeID(values[6].(int64)),
+					Version:    values[7].(string),
+					Subpath:    values[8].(string),
+					Qualifiers: qualifiersFromList(values[9]),
+				})
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			out := make(map[string]*model.Package, len(tries))
+			for purl, trie := range tries {
+				out[purl] = trie.packages[0]
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(map[string]*model.Package), specsErr
+}
+
+func (c *neo4jClient) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPackage :: %w", backends.Missing("pkg"))
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	query := mergePkgVersion("") + "\nRETURN " + pkgVersionColumns("")
+	queryValues := map[string]interface{}{}
+	addPkgInputValues(queryValues, "", pkg)
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return packageFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Package), nil
+}
+
+// IngestPackages merges all the packages in a single transaction, like
+// IngestArtifacts. MERGE finds the nodes created by the previous rows, so
+// the nodes shared by the packages are only created once.
+func (c *neo4jClient) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	batch := make([]interface{}, 0, len(pkgs))
+	for i, pkg := range pkgs {
+		if pkg == nil {
+			return nil, backends.Errorf("IngestPackages :: %w", backends.NewValidationError("pkgs", "missing package at index %d", i))
+		}
+		values := map[string]interface{}{}
+		addPkgInputValues(values, "", pkg)
+		batch = append(batch, values)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	query := "UNWIND $pkgs AS pkg\n" + mergePkgVersionFrom("", "pkg.") + "\nRETURN " + pkgVersionColumns("")
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, map[string]interface{}{"pkgs": batch})
+			if err != nil {
+				return nil, err
+			}
+
+			out := make([]*model.Package, 0, len(batch))
+			for result.Next() {
+				out = append(out, packageFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Package), nil
+}
+
+// The helpers below build the Cypher clauses for queries which match or
+// create paths in the package trie. The trie nodes are bound to the type,
+// namespace, name and version variables, which are prefixed with prefix (and
+// so are the query parameters) to allow multiple paths in the same query.
+
+// matchPkgSpec adds the clauses matching the type, namespace, name and
+// version nodes of the package trie against the canonicalized spec, as
+// matchProperty does.
+func matchPkgSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, prefix string, pkgSpec *model.PkgSpec) bool {
+	if pkgSpec == nil {
+		return firstMatch
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	firstMatch = matchPkgNameSpec(sb, queryValues, firstMatch, prefix, pkgSpec)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"version", "version", pkgSpec.Version)
+	firstMatch = matchQualifiers(sb, queryValues, firstMatch, prefix+"version", pkgSpec)
+	return matchProperty(sb, queryValues, firstMatch, prefix+"version", "subpath", pkgSpec.Subpath)
+}
+
+// matchQualifiers is like matchProperty for the qualifiers of the
+// canonicalized spec, looking for each of them among the key, value pairs of
+// the qualifier_list of the version bound to label.
+func matchQualifiers(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, label string, pkgSpec *model.PkgSpec) bool {
+	list := label + ".qualifier_list"
+	var conditions []string
+	if pkgSpec.MatchOnlyEmptyQualifiers != nil {
+		conditions = append(conditions, "size("+list+") = 0")
+	}
+	for i, q := range pkgSpec.Qualifiers {
+		param := fmt.Sprintf("%s_qualifier_%d", label, i)
+		condition := list + "[i] = $" + param + "_key"
+		queryValues[param+"_key"] = q.Key
+		if q.Value != nil {
+			condition += " AND " + list + "[i + 1] = $" + param + "_value"
+			queryValues[param+"_value"] = *q.Value
+		}
+		conditions = append(conditions, "any(i IN range(0, size("+list+") - 2, 2) WHERE "+condition+")")
+	}
+	for _, condition := range conditions {
+		if firstMatch {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString(condition)
+		firstMatch = false
+	}
+	return firstMatch
+}
+
+// filtersPackageVersion returns true if the canonicalized spec filters on the
+// version level of the trie.
+func filtersPackageVersion(pkgSpec *model.PkgSpec) bool {
+	return pkgSpec.Version != nil || pkgSpec.Subpath != nil ||
+		len(pkgSpec.Qualifiers) > 0 || pkgSpec.MatchOnlyEmptyQualifiers != nil
+}
+
+// matchPkgNameSpec is like matchPkgSpec but ignores the version filters, for
+// paths which stop at the name level of the trie.
+func matchPkgNameSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, prefix string, pkgSpec *model.PkgSpec) bool {
+	if pkgSpec == nil {
+		return firstMatch
+	}
+	pkgSpec = backends.CanonicalPkgSpec(pkgSpec)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"type", "type", pkgSpec.Type)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"namespace", "namespace", pkgSpec.Namespace)
+	return matchProperty(sb, queryValues, firstMatch, prefix+"name", "name", pkgSpec.Name)
+}
+
+// pkgNamePath returns the pattern of the path from the root of the package
+// trie to a name.
+func pkgNamePath(prefix string) string {
+	return "(" + prefix + "root:Pkg)-[:PkgHasType]->(" + prefix + "type:PkgType)-[:PkgHasNamespace]->(" + prefix + "namespace:PkgNamespace)-[:PkgHasName]->(" + prefix + "name:PkgName)"
+}
+
+// pkgVersionPath returns the pattern of the path from the root of the
+// package trie to a version.
+func pkgVersionPath(prefix string) string {
+	return pkgNamePath(prefix) + "-[:PkgHasVersion]->(" + prefix + "version:PkgVersion)"
+}
+
+// mergePkgName returns the clauses creating the missing nodes of the path
+// from the root of the package trie to a name. The query parameters are set
+// by addPkgInputValues.
+func mergePkgName(prefix string) string {
+	return mergePkgNameFrom(prefix, "$"+prefix)
+}
+
+// mergePkgVersion is like mergePkgName but goes down to the version.
+func mergePkgVersion(prefix string) string {
+	return mergePkgVersionFrom(prefix, "$"+prefix)
+}
+
+// mergePkgNameFrom is like mergePkgName, reading the values set by
+// addPkgInputValues from the expressions prefixed with values instead of the
+// query parameters, e.g. from the fields of a map with "pkg.".
+func mergePkgNameFrom(prefix string, values string) string {
+	return "MERGE (" + prefix + "root:Pkg)\n" +
+		"MERGE (" + prefix + "root)-[:PkgHasType]->(" + prefix + "type:PkgType {type: " + values + "pkgType})\n" +
+		"MERGE (" + prefix + "type)-[:PkgHasNamespace]->(" + prefix + "namespace:PkgNamespace {namespace: " + values + "namespace})\n" +
+		"MERGE (" + prefix + "namespace)-[:PkgHasName]->(" + prefix + "name:PkgName {name: " + values + "name})"
+}
+
+// mergePkgVersionFrom is like mergePkgNameFrom but goes down to the version.
+func mergePkgVersionFrom(prefix string, values string) string {
+	return mergePkgNameFrom(prefix, values) + "\n" +
+		"MERGE (" + prefix + "name)-[:PkgHasVersion]->(" + prefix + "version:PkgVersion {version: " + values + "version, subpath: " + values + "subpath, qualifier_list: " + values + "qualifiers})"
+}
+
+// addPkgInputValues sets the query parameters used by mergePkgName and
+// mergePkgVersion to the values of the canonicalized package.
+func addPkgInputValues(queryValues map[string]interface{}, prefix string, pkg *model.PkgInputSpec) {
+	pkg = backends.CanonicalPkgInputSpec(pkg)
+	queryValues[prefix+"pkgType"] = pkg.Type
+	queryValues[prefix+"namespace"] = derefOrEmpty(pkg.Namespace)
+	queryValues[prefix+"name"] = pkg.Name
+	queryValues[prefix+"version"] = derefOrEmpty(pkg.Version)
+	queryValues[prefix+"subpath"] = derefOrEmpty(pkg.Subpath)
+	queryValues[prefix+"qualifiers"] = qualifiersToList(pkg.Qualifiers)
+}
+
+// pkgNameColumns returns the columns of a RETURN clause reading back the path
+// from the root of the package trie to the name node, as expected by
+// packageNameFromValues.
+func pkgNameColumns(prefix string) string {
+	return "id(" + prefix + "type), " + prefix + "type.type, id(" + prefix + "namespace), " + prefix + "namespace.namespace, id(" + prefix + "name), " + prefix + "name.name"
+}
+
+// pkgVersionColumns is like pkgNameColumns but goes down to the version, as
+// expected by packageFromValues.
+func pkgVersionColumns(prefix string) string {
+	return pkgNameColumns(prefix) + ", id(" + prefix + "version), " + prefix + "version.version, " + prefix + "version.subpath, " + prefix + "version.qualifier_list"
+}
+
+// packageNameFromValues converts the values of the pkgNameColumns to the path
+// from the root of the package trie to a name.
+func packageNameFromValues(values []interface{}) *model.Package {
+	trie := newPkgTrieBuilder()
+	n := trie.addName(values[0].(int64), values[1].(string),
+		values[2].(int64), values[3].(string),
+		values[4].(int64), values[5].(string))
+	n.Versions = []*model.PackageVersion{}
+	return trie.packages[0]
+}
+
+// packageFromValues converts the values of the pkgVersionColumns to the path
+// from the root of the package trie to a single version.
+func packageFromValues(values []interface{}) *model.Package {
+	p := packageNameFromValues(values)
+	n := p.Namespaces[0].Names[0]
+	n.Versions = append(n.Versions, &model.PackageVersion{
+		ID:         nodeID(values[6].(int64)),
+		Version:    values[7].(string),
+		Subpath:    values[8].(string),
+		Qualifiers: qualifiersFromList(values[9]),
+	})
+	return p
+}
+
+// pkgTrieBuilder reassembles the package trie from the flat rows returned by
+// a Cypher query.
+type pkgTrieBuilder struct {
+	packages   []*model.Package
+	types      map[int64]*model.Package
+	namespaces map[int64]*model.PackageNamespace
+	names      map[int64]*model.PackageName
+}
+
+func newPkgTrieBuilder() *pkgTrieBuilder {
+	return &pkgTrieBuilder{
+		types:      map[int64]*model.Package{},
+		namespaces: map[int64]*model.PackageNamespace{},
+		names:      map[int64]*model.PackageName{},
+	}
+}
+
+// addName inserts the path from the root of the trie to a package name,
+// reusing the nodes already seen, and returns the name node.
+func (b *pkgTrieBuilder) addName(typeID int64, pkgType string, namespaceID int64, namespace string, nameID int64, name string) *model.PackageName {
+	p, ok := b.types[typeID]
+	if !ok {
+		p = &model.Package{ID: nodeID(typeID), Type: pkgType}
+		b.types[typeID] = p
+		b.packages = append(b.packages, p)
+	}
+	ns, ok := b.namespaces[namespaceID]
+	if !ok {
+		ns = &model.PackageNamespace{ID: nodeID(namespaceID), Namespace: namespace}
+		b.namespaces[namespaceID] = ns
+		p.Namespaces = append(p.Namespaces, ns)
+	}
+	n, ok := b.names[nameID]
+	if !ok {
+		n = &model.PackageName{ID: nodeID(nameID), Name: name}
+		b.names[nameID] = n
+		ns.Names = append(ns.Names, n)
+	}
+	return n
+}
+
+// qualifiersToList flattens the qualifiers into the qualifier_list property.
+// The qualifiers of canonical packages are sorted, so that the same set of
+// qualifiers always results in the same list.
+func qualifiersToList(qualifiers []*model.PackageQualifierInputSpec) []string {
+	list := make([]string, 0, 2*len(qualifiers))
+	for _, q := range qualifiers {
+		list = append(list, q.Key, q.Value)
+	}
+	return list
+}
+
+// qualifiersFromList converts the flattened qualifier_list property back into
+// qualifiers.
+func qualifiersFromList(list interface{}) []*model.PackageQualifier {
+	values, _ := list.([]interface{})
+	qualifiers := make([]*model.PackageQualifier, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		qualifiers = append(qualifiers, &model.PackageQualifier{
+			Key:   values[i].(string),
+			Value: values[i+1].(string),
+		})
+	}
+	return qualifiers
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// PkgEqual nodes are stored as
+//
+//	(:PkgVersion)<-[:pkg_equal]-(:PkgEqual {justification, origin, collector})-[:pkg_equal]->(:PkgVersion)
+//
+// Like for HashEqual, the pattern is symmetric, so merging it with the
+// packages in either order finds the same node. The second package path is
+// bound to variables prefixed with other_.
+
+// pkgEqualColumns are the columns returning the PkgEqual node bound to e,
+// followed by both package paths, as expected by pkgEqualFromValues.
+var pkgEqualColumns = "id(e), e.justification, e.origin, e.collector, " +
+	pkgVersionColumns("") + ", " + pkgVersionColumns("other_")
+
+func (c *neo4jClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	if pkgEqualSpec == nil {
+		pkgEqualSpec = &model.PkgEqualSpec{}
+	}
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, backends.Errorf("PkgEqual :: %w", backends.NewValidationError("packages", "cannot filter on more than 2 packages"))
+	}
+	if pkgEqualSpec.ExpandTransitive != nil && *pkgEqualSpec.ExpandTransitive {
+		return backends.ExpandPkgEqual(ctx, c, pkgEqualSpec)
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + pkgVersionPath("") + "<-[:pkg_equal]-(e:PkgEqual)-[:pkg_equal]->(other_version:PkgVersion), " + pkgVersionPath("other_"))
+	firstMatch, err := matchID(&sb, queryValues, true, "e", pkgEqualSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "justification", pkgEqualSpec.Justification)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "origin", pkgEqualSpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "collector", pkgEqualSpec.Collector)
+	for i, pkgSpec := range pkgEqualSpec.Packages {
+		prefix := ""
+		if i == 1 {
+			prefix = "other_"
+		}
+		firstMatch = matchPkgSpec(&sb, queryValues, firstMatch, prefix, pkgSpec)
+	}
+	sb.WriteString(" RETURN " + pkgEqualColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// Both orders of the packages match the pattern, so the same
+			// node can be found twice. The first row is kept, which has the
+			// packages in the order of the filters.
+			var pkgEquals []*model.PkgEqual
+			seen := map[string]bool{}
+			for result.Next() {
+				p := pkgEqualFromValues(result.Record().Values)
+				if seen[p.ID] {
+					continue
+				}
+				seen[p.ID] = true
+				pkgEquals = append(pkgEquals, p)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return pkgEquals, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.PkgEqual), nil
+}
+
+func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	if pkg == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkg"))
+	}
+	if otherPackage == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("otherPackage"))
+	}
+	if pkgEqual == nil {
+		return nil, backends.Errorf("IngestPkgEqual :: %w", backends.Missing("pkgEqual"))
+	}
+
+	query := mergePkgVersion("") + "\n" + mergePkgVersion("other_") + `
+WITH *
+WHERE version <> other_version
+MERGE (version)<-[:pkg_equal]-(e:PkgEqual {justification: $justification, origin: $origin, collector: $collector})-[:pkg_equal]->(other_version)
+RETURN ` + pkgEqualColumns
+	queryValues := map[string]interface{}{
+		"justification": pkgEqual.Justification,
+		"origin":        pkgEqual.Origin,
+		"collector":     pkgEqual.Collector,
+	}
+	addPkgInputValues(queryValues, "", pkg)
+	addPkgInputValues(queryValues, "other_", otherPackage)
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// No record is returned if both packages are the same version.
+			if !result.Next() {
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+				return nil, backends.Errorf("IngestPkgEqual :: %w", backends.NewValidationError("otherPackage", "a package cannot be certified equal to itself"))
+			}
+
+			return pkgEqualFromValues(result.Record().Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.PkgEqual), nil
+}
+
+// pkgEqualFromValues converts the values of the pkgEqualColumns to the model.
+func pkgEqualFromValues(values []interface{}) *model.PkgEqual {
+	return &model.PkgEqual{
+		ID:            nodeID(values[0].(int64)),
+		Justification: values[1].(string),
+		Origin:        values[2].(string),
+		Collector:     values[3].(string),
+		Packages:      []*model.Package{packageFromValues(values[4:14]), packageFromValues(values[14:24])},
+	}
+}
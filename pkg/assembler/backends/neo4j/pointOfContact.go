@@ -0,0 +1,148 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// PointOfContact nodes are stored as
+//
+//	(subject)<-[:subject]-(:PointOfContact {email, info, since, justification, origin, collector})
+//
+// where subject is either a PkgVersion, a PkgName (for contacts which apply
+// to all the versions of a package), a SrcName or an Artifact node, like for
+// the certifications.
+
+// pointOfContactColumns are the columns returning the PointOfContact node
+// bound to poc, as expected by pointOfContactFromValues.
+const pointOfContactColumns = "id(poc), poc.email, poc.info, poc.since, poc.justification, poc.origin, poc.collector"
+
+func (c *neo4jClient) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	if pointOfContactSpec == nil {
+		pointOfContactSpec = &model.PointOfContactSpec{}
+	}
+
+	queries, err := subjectQueries(pointOfContactSpec.Subject, "poc", "PointOfContact", func(sb *strings.Builder, queryValues map[string]interface{}) (bool, error) {
+		firstMatch, err := matchID(sb, queryValues, true, "poc", pointOfContactSpec.ID)
+		if err != nil {
+			return firstMatch, err
+		}
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "poc", "email", pointOfContactSpec.Email)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "poc", "info", pointOfContactSpec.Info)
+		firstMatch = matchTime(sb, queryValues, firstMatch, "poc", "since", "=", "since", pointOfContactSpec.Since)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "poc", "justification", pointOfContactSpec.Justification)
+		firstMatch = matchProperty(sb, queryValues, firstMatch, "poc", "origin", pointOfContactSpec.Origin)
+		return matchProperty(sb, queryValues, firstMatch, "poc", "collector", pointOfContactSpec.Collector), nil
+	}, pointOfContactColumns)
+	if err != nil {
+		return nil, backends.Errorf("PointOfContact :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			var out []*model.PointOfContact
+			for _, q := range queries {
+				result, err := tx.Run(q.cypher, q.queryValues)
+				if err != nil {
+					return nil, err
+				}
+				for result.Next() {
+					values := result.Record().Values
+					poc := pointOfContactFromValues(values)
+					poc.Subject = q.toSubject(values[7:])
+					out = append(out, poc)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.PointOfContact), nil
+}
+
+func (c *neo4jClient) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	if pointOfContact == nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", backends.Missing("pointOfContact"))
+	}
+
+	queryValues := map[string]interface{}{
+		"email":         pointOfContact.Email,
+		"info":          pointOfContact.Info,
+		"since":         pointOfContact.Since.UTC(),
+		"justification": pointOfContact.Justification,
+		"origin":        pointOfContact.Origin,
+		"collector":     pointOfContact.Collector,
+	}
+	mergePointOfContact := "MERGE (subject)<-[:subject]-(poc:PointOfContact {email: $email, info: $info, since: $since, justification: $justification, origin: $origin, collector: $collector})"
+	query, toSubject, err := mergeOnSubject(subject, pkgMatchType, queryValues, mergePointOfContact, pointOfContactColumns)
+	if err != nil {
+		return nil, backends.Errorf("IngestPointOfContact :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			poc := pointOfContactFromValues(record.Values)
+			poc.Subject = toSubject(record.Values[7:])
+			return poc, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.PointOfContact), nil
+}
+
+// pointOfContactFromValues converts the values of the pointOfContactColumns
+// to the model, without the subject.
+func pointOfContactFromValues(values []interface{}) *model.PointOfContact {
+	return &model.PointOfContact{
+		ID:            nodeID(values[0].(int64)),
+		Email:         values[1].(string),
+		Info:          values[2].(string),
+		Since:         values[3].(time.Time).UTC(),
+		Justification: values[4].(string),
+		Origin:        values[5].(string),
+		Collector:     values[6].(string),
+	}
+}
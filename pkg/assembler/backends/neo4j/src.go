@@ -0,0 +1,261 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// The source trie is stored as
+//
+//	(:Src)-[:SrcHasType]->(:SrcType {type})
+//	      -[:SrcHasNamespace]->(:SrcNamespace {namespace})
+//	      -[:SrcHasName]->(:SrcName {name, tag, commit})
+//
+// where tag and commit are empty (or missing) if the source is not pinned to
+// them.
+
+func (c *neo4jClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	if sourceSpec == nil {
+		sourceSpec = &model.SourceSpec{}
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" || *sourceSpec.Tag != "" {
+			return nil, backends.Errorf("%w", backends.NewValidationError("commit", "Passing both commit and tag selectors is an error"))
+		}
+	}
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + srcNamePath(""))
+	matchSrcSpec(&sb, queryValues, true, "", sourceSpec)
+	sb.WriteString(" RETURN " + srcNameColumns(""))
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var sources []*model.Source
+			types := map[int64]*model.Source{}
+			namespaces := map[int64]*model.SourceNamespace{}
+			for result.Next() {
+				values := result.Record().Values
+
+				typeID := values[0].(int64)
+				src, ok := types[typeID]
+				if !ok {
+					src = &model.Source{ID: nodeID(typeID), Type: values[1].(string)}
+					types[typeID] = src
+					sources = append(sources, src)
+				}
+
+				namespaceID := values[2].(int64)
+				ns, ok := namespaces[namespaceID]
+				if !ok {
+					ns = &model.SourceNamespace{ID: nodeID(namespaceID), Namespace: values[3].(string)}
+					namespaces[namespaceID] = ns
+					src.Namespaces = append(src.Namespaces, ns)
+				}
+
+				ns.Names = append(ns.Names, &model.SourceName{
+					ID:     nodeID(values[4].(int64)),
+					Name:   values[5].(string),
+					Tag:    optionalString(values[6]),
+					Commit: optionalString(values[7]),
+				})
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return sources, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Source), nil
+}
+
+func (c *neo4jClient) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	if source == nil {
+		return nil, backends.Errorf("IngestSource :: %w", backends.Missing("source"))
+	}
+	if err := backends.ValidateSourceInput(source); err != nil {
+		return nil, backends.Errorf("IngestSource :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	query := mergeSrcName("") + "\nRETURN " + srcNameColumns("")
+	queryValues := map[string]interface{}{}
+	addSrcInputValues(queryValues, "", source)
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return sourceFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Source), nil
+}
+
+// IngestSources merges all the sources in a single transaction, like
+// IngestPackages.
+func (c *neo4jClient) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	batch := make([]interface{}, 0, len(sources))
+	for i, source := range sources {
+		if source == nil {
+			return nil, backends.Errorf("IngestSources :: %w", backends.NewValidationError("sources", "missing source at index %d", i))
+		}
+		if err := backends.ValidateSourceInput(source); err != nil {
+			return nil, backends.Errorf("IngestSources :: source at index %d: %w", i, err)
+		}
+		values := map[string]interface{}{}
+		addSrcInputValues(values, "", source)
+		batch = append(batch, values)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	query := "UNWIND $sources AS source\n" + mergeSrcNameFrom("", "source.") + "\nRETURN " + srcNameColumns("")
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, map[string]interface{}{"sources": batch})
+			if err != nil {
+				return nil, err
+			}
+
+			out := make([]*model.Source, 0, len(batch))
+			for result.Next() {
+				out = append(out, sourceFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Source), nil
+}
+
+// The helpers below build the Cypher clauses for queries which match or
+// create paths in the source trie, like the ones for the package trie.
+
+// matchSrcSpec adds the clauses matching the type, namespace and name nodes
+// of the source trie against the canonicalized spec, as matchProperty does.
+func matchSrcSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, prefix string, sourceSpec *model.SourceSpec) bool {
+	if sourceSpec == nil {
+		return firstMatch
+	}
+	sourceSpec = backends.CanonicalSourceSpec(sourceSpec)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"type", "type", sourceSpec.Type)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"namespace", "namespace", sourceSpec.Namespace)
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"name", "name", sourceSpec.Name)
+	firstMatch = matchOptionalProperty(sb, queryValues, firstMatch, prefix+"name", "tag", sourceSpec.Tag)
+	return matchOptionalProperty(sb, queryValues, firstMatch, prefix+"name", "commit", sourceSpec.Commit)
+}
+
+// srcNamePath returns the pattern of the path from the root of the source
+// trie to a name.
+func srcNamePath(prefix string) string {
+	return "(" + prefix + "root:Src)-[:SrcHasType]->(" + prefix + "type:SrcType)-[:SrcHasNamespace]->(" + prefix + "namespace:SrcNamespace)-[:SrcHasName]->(" + prefix + "name:SrcName)"
+}
+
+// mergeSrcName returns the clauses creating the missing nodes of the path
+// from the root of the source trie to a name. The query parameters are set by
+// addSrcInputValues.
+func mergeSrcName(prefix string) string {
+	return mergeSrcNameFrom(prefix, "$"+prefix)
+}
+
+// mergeSrcNameFrom is like mergeSrcName, reading the values from the
+// expressions prefixed with values, as mergePkgNameFrom does.
+func mergeSrcNameFrom(prefix string, values string) string {
+	return "MERGE (" + prefix + "root:Src)\n" +
+		"MERGE (" + prefix + "root)-[:SrcHasType]->(" + prefix + "type:SrcType {type: " + values + "srcType})\n" +
+		"MERGE (" + prefix + "type)-[:SrcHasNamespace]->(" + prefix + "namespace:SrcNamespace {namespace: " + values + "namespace})\n" +
+		"MERGE (" + prefix + "namespace)-[:SrcHasName]->(" + prefix + "name:SrcName {name: " + values + "name, tag: " + values + "tag, commit: " + values + "commit})"
+}
+
+// addSrcInputValues sets the query parameters used by mergeSrcName, from the
+// canonicalized source.
+func addSrcInputValues(queryValues map[string]interface{}, prefix string, source *model.SourceInputSpec) {
+	source = backends.CanonicalSourceInputSpec(source)
+	queryValues[prefix+"srcType"] = source.Type
+	queryValues[prefix+"namespace"] = source.Namespace
+	queryValues[prefix+"name"] = source.Name
+	queryValues[prefix+"tag"] = derefOrEmpty(source.Tag)
+	queryValues[prefix+"commit"] = derefOrEmpty(source.Commit)
+}
+
+// srcNameColumns returns the columns of a RETURN clause reading back the path
+// from the root of the source trie to the name node, as expected by
+// sourceFromValues.
+func srcNameColumns(prefix string) string {
+	return "id(" + prefix + "type), " + prefix + "type.type, id(" + prefix + "namespace), " + prefix + "namespace.namespace, id(" + prefix + "name), " + prefix + "name.name, " + prefix + "name.tag, " + prefix + "name.commit"
+}
+
+// sourceFromValues converts the values of the srcNameColumns to the path from
+// the root of the source trie to a name.
+func sourceFromValues(values []interface{}) *model.Source {
+	return &model.Source{
+		ID:   nodeID(values[0].(int64)),
+		Type: values[1].(string),
+		Namespaces: []*model.SourceNamespace{{
+			ID:        nodeID(values[2].(int64)),
+			Namespace: values[3].(string),
+			Names: []*model.SourceName{{
+				ID:     nodeID(values[4].(int64)),
+				Name:   values[5].(string),
+				Tag:    optionalString(values[6]),
+				Commit: optionalString(values[7]),
+			}},
+		}},
+	}
+}
@@ -0,0 +1,166 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// VulnEqual nodes are stored as
+//
+//	(:VulnID)<-[:vuln_equal]-(:VulnEqual {justification, origin, collector})-[:vuln_equal]->(:VulnID)
+//
+// Like for HashEqual, the pattern is symmetric, so merging it with the
+// vulnerabilities in either order finds the same node.
+
+// vulnEqualColumns are the columns returning the VulnEqual node bound to e
+// and its vulnerabilities, as expected by vulnEqualFromValues.
+var vulnEqualColumns = "id(e), e.justification, e.origin, e.collector, collect([" + vulnIDColumns("") + "])"
+
+func (c *neo4jClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	if vulnEqualSpec == nil {
+		vulnEqualSpec = &model.VulnEqualSpec{}
+	}
+	if len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, backends.Errorf("VulnEqual :: %w", backends.NewValidationError("vulnerabilities", "cannot filter on more than 2 vulnerabilities"))
+	}
+
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + vulnIDPath("v1") + ", " + vulnIDPath("v2") + ", (v1vulnID)<-[:vuln_equal]-(e:VulnEqual)-[:vuln_equal]->(v2vulnID)")
+	firstMatch, err := matchID(&sb, queryValues, true, "e", vulnEqualSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "justification", vulnEqualSpec.Justification)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "origin", vulnEqualSpec.Origin)
+	firstMatch = matchProperty(&sb, queryValues, firstMatch, "e", "collector", vulnEqualSpec.Collector)
+	for i, vulnSpec := range vulnEqualSpec.Vulnerabilities {
+		prefix := "v1"
+		if i == 1 {
+			prefix = "v2"
+		}
+		firstMatch, err = matchVulnSpec(&sb, queryValues, firstMatch, prefix, vulnSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Both orders of the vulnerabilities match the pattern, so the same node
+	// can be found twice.
+	sb.WriteString(" WITH DISTINCT e MATCH (e)-[:vuln_equal]->(vulnID:VulnID)<-[:VulnHasID]-(vulnType:VulnType) RETURN " + vulnEqualColumns)
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var vulnEquals []*model.VulnEqual
+			for result.Next() {
+				vulnEquals = append(vulnEquals, vulnEqualFromValues(result.Record().Values))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return vulnEquals, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.VulnEqual), nil
+}
+
+func (c *neo4jClient) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	if vulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnerability"))
+	}
+	if otherVulnerability == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("otherVulnerability"))
+	}
+	if vulnEqual == nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.Missing("vulnEqual"))
+	}
+	queryValues := map[string]interface{}{
+		"justification": vulnEqual.Justification,
+		"origin":        vulnEqual.Origin,
+		"collector":     vulnEqual.Collector,
+	}
+	if err := addVulnInputValues(queryValues, "v1", vulnerability); err != nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", err)
+	}
+	if err := addVulnInputValues(queryValues, "v2", otherVulnerability); err != nil {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", err)
+	}
+	if queryValues["v1vulnType"] == queryValues["v2vulnType"] && queryValues["v1vulnID"] == queryValues["v2vulnID"] {
+		return nil, backends.Errorf("IngestVulnEqual :: %w", backends.NewValidationError("otherVulnerability", "a vulnerability cannot be certified equal to itself"))
+	}
+
+	query := mergeVulnID("v1") + "\n" + mergeVulnID("v2") + `
+MERGE (v1vulnID)<-[:vuln_equal]-(e:VulnEqual {justification: $justification, origin: $origin, collector: $collector})-[:vuln_equal]->(v2vulnID)
+WITH e MATCH (e)-[:vuln_equal]->(vulnID:VulnID)<-[:VulnHasID]-(vulnType:VulnType)
+RETURN ` + vulnEqualColumns
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return vulnEqualFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.VulnEqual), nil
+}
+
+// vulnEqualFromValues converts the values of the vulnEqualColumns to the
+// model.
+func vulnEqualFromValues(values []interface{}) *model.VulnEqual {
+	e := &model.VulnEqual{
+		ID:            nodeID(values[0].(int64)),
+		Justification: values[1].(string),
+		Origin:        values[2].(string),
+		Collector:     values[3].(string),
+	}
+	vulns, _ := values[4].([]interface{})
+	for _, v := range vulns {
+		e.Vulnerabilities = append(e.Vulnerabilities, vulnerabilityFromValues(v.([]interface{})))
+	}
+	return e
+}
@@ -0,0 +1,183 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// The vulnerability trie is stored as
+//
+//	(:Vuln)-[:VulnHasType]->(:VulnType {type})
+//	       -[:VulnHasID]->(:VulnID {vulnerabilityID})
+//
+// with both properties in lowercase.
+
+func (c *neo4jClient) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	var sb strings.Builder
+	queryValues := map[string]interface{}{}
+
+	sb.WriteString("MATCH " + vulnIDPath(""))
+	if _, err := matchVulnSpec(&sb, queryValues, true, "", vulnSpec); err != nil {
+		return nil, err
+	}
+	sb.WriteString(" RETURN " + vulnIDColumns(""))
+
+	session := c.newSession(neo4j.AccessModeRead)
+	defer session.Close()
+
+	result, err := readTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			var vulns []*model.Vulnerability
+			types := map[int64]*model.Vulnerability{}
+			for result.Next() {
+				values := result.Record().Values
+				typeID := values[0].(int64)
+				v, ok := types[typeID]
+				if !ok {
+					v = &model.Vulnerability{ID: nodeID(typeID), Type: values[1].(string)}
+					types[typeID] = v
+					vulns = append(vulns, v)
+				}
+				v.VulnerabilityIDs = append(v.VulnerabilityIDs, &model.VulnerabilityID{
+					ID:              nodeID(values[2].(int64)),
+					VulnerabilityID: values[3].(string),
+				})
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return vulns, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Vulnerability), nil
+}
+
+func (c *neo4jClient) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	if vuln == nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", backends.Missing("vuln"))
+	}
+	queryValues := map[string]interface{}{}
+	if err := addVulnInputValues(queryValues, "", vuln); err != nil {
+		return nil, backends.Errorf("IngestVulnerability :: %w", err)
+	}
+
+	session := c.newSession(neo4j.AccessModeWrite)
+	defer session.Close()
+
+	query := mergeVulnID("") + "\nRETURN " + vulnIDColumns("")
+	result, err := writeTransaction(ctx, session,
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return vulnerabilityFromValues(record.Values), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Vulnerability), nil
+}
+
+// The helpers below build the Cypher clauses for queries which match or
+// create paths in the vulnerability trie, like the ones for the package trie.
+
+// matchVulnSpec adds the clauses matching the type and vulnerability ID nodes
+// of the vulnerability trie against the spec, as matchProperty does.
+func matchVulnSpec(sb *strings.Builder, queryValues map[string]interface{}, firstMatch bool, prefix string, vulnSpec *model.VulnerabilitySpec) (bool, error) {
+	if vulnSpec == nil {
+		return firstMatch, nil
+	}
+	firstMatch, err := matchID(sb, queryValues, firstMatch, prefix+"vulnID", vulnSpec.ID)
+	if err != nil {
+		return firstMatch, err
+	}
+	firstMatch = matchProperty(sb, queryValues, firstMatch, prefix+"vulnType", "type", backends.LowerIfSet(vulnSpec.Type))
+	return matchProperty(sb, queryValues, firstMatch, prefix+"vulnID", "vulnerabilityID", backends.LowerIfSet(vulnSpec.VulnerabilityID)), nil
+}
+
+// vulnIDPath returns the pattern of the path from the root of the
+// vulnerability trie to a vulnerability ID.
+func vulnIDPath(prefix string) string {
+	return "(" + prefix + "vulnRoot:Vuln)-[:VulnHasType]->(" + prefix + "vulnType:VulnType)-[:VulnHasID]->(" + prefix + "vulnID:VulnID)"
+}
+
+// mergeVulnID returns the clauses creating the missing nodes of the path from
+// the root of the vulnerability trie to a vulnerability ID. The query
+// parameters are set by addVulnInputValues.
+func mergeVulnID(prefix string) string {
+	return "MERGE (" + prefix + "vulnRoot:Vuln)\n" +
+		"MERGE (" + prefix + "vulnRoot)-[:VulnHasType]->(" + prefix + "vulnType:VulnType {type: $" + prefix + "vulnType})\n" +
+		"MERGE (" + prefix + "vulnType)-[:VulnHasID]->(" + prefix + "vulnID:VulnID {vulnerabilityID: $" + prefix + "vulnID})"
+}
+
+// addVulnInputValues sets the query parameters used by mergeVulnID, after
+// canonicalizing them to lowercase.
+func addVulnInputValues(queryValues map[string]interface{}, prefix string, vuln *model.VulnerabilityInputSpec) error {
+	vulnType := strings.ToLower(strings.TrimSpace(vuln.Type))
+	vulnID := strings.ToLower(strings.TrimSpace(vuln.VulnerabilityID))
+	if vulnType == "" {
+		return backends.NewValidationError("type", "type must not be empty")
+	}
+	if vulnID == "" {
+		return backends.NewValidationError("vulnerabilityID", "vulnerability ID must not be empty")
+	}
+	queryValues[prefix+"vulnType"] = vulnType
+	queryValues[prefix+"vulnID"] = vulnID
+	return nil
+}
+
+// vulnIDColumns returns the columns of a RETURN clause reading back the path
+// from the root of the vulnerability trie to the vulnerability ID node, as
+// expected by vulnerabilityFromValues.
+func vulnIDColumns(prefix string) string {
+	return "id(" + prefix + "vulnType), " + prefix + "vulnType.type, id(" + prefix + "vulnID), " + prefix + "vulnID.vulnerabilityID"
+}
+
+// vulnerabilityFromValues converts the values of the vulnIDColumns to the
+// path from the root of the vulnerability trie to a vulnerability ID.
+func vulnerabilityFromValues(values []interface{}) *model.Vulnerability {
+	return &model.Vulnerability{
+		ID:   nodeID(values[0].(int64)),
+		Type: values[1].(string),
+		VulnerabilityIDs: []*model.VulnerabilityID{{
+			ID:              nodeID(values[2].(int64)),
+			VulnerabilityID: values[3].(string),
+		}},
+	}
+}
@@ -0,0 +1,329 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (b *otelBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return call(ctx, b, "Artifacts", []interface{}{artifactSpec}, func(ctx context.Context) ([]*model.Artifact, error) {
+		return b.inner.Artifacts(ctx, artifactSpec)
+	})
+}
+
+func (b *otelBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	return call(ctx, b, "ArtifactsList", []interface{}{artifactSpec, after, first}, func(ctx context.Context) (*model.ArtifactConnection, error) {
+		return b.inner.ArtifactsList(ctx, artifactSpec, after, first)
+	})
+}
+
+func (b *otelBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	return call(ctx, b, "Builders", []interface{}{builderSpec}, func(ctx context.Context) ([]*model.Builder, error) {
+		return b.inner.Builders(ctx, builderSpec)
+	})
+}
+
+func (b *otelBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return call(ctx, b, "Packages", []interface{}{pkgSpec}, func(ctx context.Context) ([]*model.Package, error) {
+		return b.inner.Packages(ctx, pkgSpec)
+	})
+}
+
+func (b *otelBackend) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	return call(ctx, b, "PackagesByPurls", []interface{}{purls}, func(ctx context.Context) (map[string]*model.Package, error) {
+		return b.inner.PackagesByPurls(ctx, purls)
+	})
+}
+
+func (b *otelBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return call(ctx, b, "Sources", []interface{}{sourceSpec}, func(ctx context.Context) ([]*model.Source, error) {
+		return b.inner.Sources(ctx, sourceSpec)
+	})
+}
+
+func (b *otelBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return call(ctx, b, "Vulnerabilities", []interface{}{vulnSpec}, func(ctx context.Context) ([]*model.Vulnerability, error) {
+		return b.inner.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (b *otelBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return call(ctx, b, "CertifyBad", []interface{}{certifyBadSpec}, func(ctx context.Context) ([]*model.CertifyBad, error) {
+		return b.inner.CertifyBad(ctx, certifyBadSpec)
+	})
+}
+
+func (b *otelBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	return call(ctx, b, "CertifyGood", []interface{}{certifyGoodSpec}, func(ctx context.Context) ([]*model.CertifyGood, error) {
+		return b.inner.CertifyGood(ctx, certifyGoodSpec)
+	})
+}
+
+func (b *otelBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return call(ctx, b, "CertifyLegal", []interface{}{certifyLegalSpec}, func(ctx context.Context) ([]*model.CertifyLegal, error) {
+		return b.inner.CertifyLegal(ctx, certifyLegalSpec)
+	})
+}
+
+func (b *otelBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return call(ctx, b, "CertifyVuln", []interface{}{certifyVulnSpec}, func(ctx context.Context) ([]*model.CertifyVuln, error) {
+		return b.inner.CertifyVuln(ctx, certifyVulnSpec)
+	})
+}
+
+func (b *otelBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	return call(ctx, b, "CertifyVEXStatement", []interface{}{certifyVEXStatementSpec}, func(ctx context.Context) ([]*model.CertifyVEXStatement, error) {
+		return b.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+}
+
+func (b *otelBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	return call(ctx, b, "HashEqual", []interface{}{hashEqualSpec}, func(ctx context.Context) ([]*model.HashEqual, error) {
+		return b.inner.HashEqual(ctx, hashEqualSpec)
+	})
+}
+
+func (b *otelBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return call(ctx, b, "HasSBOM", []interface{}{hasSBOMSpec}, func(ctx context.Context) ([]*model.HasSbom, error) {
+		return b.inner.HasSBOM(ctx, hasSBOMSpec)
+	})
+}
+
+func (b *otelBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	return call(ctx, b, "HasMetadata", []interface{}{hasMetadataSpec}, func(ctx context.Context) ([]*model.HasMetadata, error) {
+		return b.inner.HasMetadata(ctx, hasMetadataSpec)
+	})
+}
+
+func (b *otelBackend) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	return call(ctx, b, "HasSLSA", []interface{}{hasSLSASpec}, func(ctx context.Context) ([]*model.HasSlsa, error) {
+		return b.inner.HasSLSA(ctx, hasSLSASpec)
+	})
+}
+
+func (b *otelBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return call(ctx, b, "IsDependency", []interface{}{isDependencySpec}, func(ctx context.Context) ([]*model.IsDependency, error) {
+		return b.inner.IsDependency(ctx, isDependencySpec)
+	})
+}
+
+func (b *otelBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	return call(ctx, b, "IsOccurrence", []interface{}{isOccurrenceSpec}, func(ctx context.Context) ([]*model.IsOccurrence, error) {
+		return b.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+}
+
+func (b *otelBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return call(ctx, b, "PkgEqual", []interface{}{pkgEqualSpec}, func(ctx context.Context) ([]*model.PkgEqual, error) {
+		return b.inner.PkgEqual(ctx, pkgEqualSpec)
+	})
+}
+
+func (b *otelBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return call(ctx, b, "PointOfContact", []interface{}{pointOfContactSpec}, func(ctx context.Context) ([]*model.PointOfContact, error) {
+		return b.inner.PointOfContact(ctx, pointOfContactSpec)
+	})
+}
+
+func (b *otelBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	return call(ctx, b, "Scorecards", []interface{}{certifyScorecardSpec}, func(ctx context.Context) ([]*model.CertifyScorecard, error) {
+		return b.inner.Scorecards(ctx, certifyScorecardSpec)
+	})
+}
+
+func (b *otelBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return call(ctx, b, "VulnEqual", []interface{}{vulnEqualSpec}, func(ctx context.Context) ([]*model.VulnEqual, error) {
+		return b.inner.VulnEqual(ctx, vulnEqualSpec)
+	})
+}
+
+func (b *otelBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return call(ctx, b, "Neighbors", []interface{}{node, usingOnly}, func(ctx context.Context) ([]model.Node, error) {
+		return b.inner.Neighbors(ctx, node, usingOnly)
+	})
+}
+
+func (b *otelBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	return call(ctx, b, "Path", []interface{}{subject, target, maxPathLength, usingOnly}, func(ctx context.Context) ([]model.Node, error) {
+		return b.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	})
+}
+
+func (b *otelBackend) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	return call(ctx, b, "FindSBOMsByArtifact", []interface{}{algorithm, digest}, func(ctx context.Context) ([]*model.HasSbom, error) {
+		return b.inner.FindSBOMsByArtifact(ctx, algorithm, digest)
+	})
+}
+
+func (b *otelBackend) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	return call(ctx, b, "TransitiveDependencies", []interface{}{pkgSpec, maxDepth}, func(ctx context.Context) ([]*model.Package, error) {
+		return b.inner.TransitiveDependencies(ctx, pkgSpec, maxDepth)
+	})
+}
+
+func (b *otelBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return call(ctx, b, "IngestArtifact", []interface{}{artifact}, func(ctx context.Context) (*model.Artifact, error) {
+		return b.inner.IngestArtifact(ctx, artifact)
+	})
+}
+
+func (b *otelBackend) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return call(ctx, b, "IngestArtifacts", []interface{}{artifacts}, func(ctx context.Context) ([]*model.Artifact, error) {
+		return b.inner.IngestArtifacts(ctx, artifacts)
+	})
+}
+
+func (b *otelBackend) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	return call(ctx, b, "IngestBuilder", []interface{}{builder}, func(ctx context.Context) (*model.Builder, error) {
+		return b.inner.IngestBuilder(ctx, builder)
+	})
+}
+
+func (b *otelBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return call(ctx, b, "IngestPackage", []interface{}{pkg}, func(ctx context.Context) (*model.Package, error) {
+		return b.inner.IngestPackage(ctx, pkg)
+	})
+}
+
+func (b *otelBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return call(ctx, b, "IngestPackages", []interface{}{pkgs}, func(ctx context.Context) ([]*model.Package, error) {
+		return b.inner.IngestPackages(ctx, pkgs)
+	})
+}
+
+func (b *otelBackend) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	return call(ctx, b, "IngestSource", []interface{}{source}, func(ctx context.Context) (*model.Source, error) {
+		return b.inner.IngestSource(ctx, source)
+	})
+}
+
+func (b *otelBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	return call(ctx, b, "IngestSources", []interface{}{sources}, func(ctx context.Context) ([]*model.Source, error) {
+		return b.inner.IngestSources(ctx, sources)
+	})
+}
+
+func (b *otelBackend) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	return call(ctx, b, "IngestVulnerability", []interface{}{vuln}, func(ctx context.Context) (*model.Vulnerability, error) {
+		return b.inner.IngestVulnerability(ctx, vuln)
+	})
+}
+
+func (b *otelBackend) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return call(ctx, b, "IngestCertifyBad", []interface{}{subject, pkgMatchType, certifyBad}, func(ctx context.Context) (*model.CertifyBad, error) {
+		return b.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *otelBackend) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	return call(ctx, b, "IngestCertifyGood", []interface{}{subject, pkgMatchType, certifyGood}, func(ctx context.Context) (*model.CertifyGood, error) {
+		return b.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	})
+}
+
+func (b *otelBackend) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return call(ctx, b, "IngestCertifyLegal", []interface{}{subject, certifyLegal}, func(ctx context.Context) (*model.CertifyLegal, error) {
+		return b.inner.IngestCertifyLegal(ctx, subject, certifyLegal)
+	})
+}
+
+func (b *otelBackend) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	return call(ctx, b, "IngestCertifyVuln", []interface{}{pkg, vulnerability, certifyVuln}, func(ctx context.Context) (*model.CertifyVuln, error) {
+		return b.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *otelBackend) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	return call(ctx, b, "IngestHashEqual", []interface{}{artifact, equalArtifact, hashEqual}, func(ctx context.Context) (*model.HashEqual, error) {
+		return b.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	})
+}
+
+func (b *otelBackend) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	return call(ctx, b, "IngestHasMetadata", []interface{}{subject, pkgMatchType, hasMetadata}, func(ctx context.Context) (*model.HasMetadata, error) {
+		return b.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	})
+}
+
+func (b *otelBackend) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	return call(ctx, b, "IngestHasSbom", []interface{}{subject, hasSbom}, func(ctx context.Context) (*model.HasSbom, error) {
+		return b.inner.IngestHasSbom(ctx, subject, hasSbom)
+	})
+}
+
+func (b *otelBackend) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	return call(ctx, b, "IngestSLSA", []interface{}{subject, builtFrom, builtBy, slsa}, func(ctx context.Context) (*model.HasSlsa, error) {
+		return b.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	})
+}
+
+func (b *otelBackend) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return call(ctx, b, "IngestIsDependency", []interface{}{pkg, depPkg, dependency}, func(ctx context.Context) (*model.IsDependency, error) {
+		return b.inner.IngestIsDependency(ctx, pkg, depPkg, dependency)
+	})
+}
+
+func (b *otelBackend) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	return call(ctx, b, "IngestIsOccurrence", []interface{}{subject, artifact, occurrence}, func(ctx context.Context) (*model.IsOccurrence, error) {
+		return b.inner.IngestIsOccurrence(ctx, subject, artifact, occurrence)
+	})
+}
+
+func (b *otelBackend) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return call(ctx, b, "IngestPkgEqual", []interface{}{pkg, otherPackage, pkgEqual}, func(ctx context.Context) (*model.PkgEqual, error) {
+		return b.inner.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+	})
+}
+
+func (b *otelBackend) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return call(ctx, b, "IngestPointOfContact", []interface{}{subject, pkgMatchType, pointOfContact}, func(ctx context.Context) (*model.PointOfContact, error) {
+		return b.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	})
+}
+
+func (b *otelBackend) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return call(ctx, b, "IngestScorecard", []interface{}{source, scorecard}, func(ctx context.Context) (*model.CertifyScorecard, error) {
+		return b.inner.IngestScorecard(ctx, source, scorecard)
+	})
+}
+
+func (b *otelBackend) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	return call(ctx, b, "IngestVEXStatement", []interface{}{subject, vulnerability, vexStatement}, func(ctx context.Context) (*model.CertifyVEXStatement, error) {
+		return b.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *otelBackend) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return call(ctx, b, "IngestVulnEqual", []interface{}{vulnerability, otherVulnerability, vulnEqual}, func(ctx context.Context) (*model.VulnEqual, error) {
+		return b.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	})
+}
+
+func (b *otelBackend) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	return call(ctx, b, "SubscribeArtifacts", []interface{}{}, func(ctx context.Context) (<-chan *model.Artifact, error) {
+		return b.inner.SubscribeArtifacts(ctx)
+	})
+}
+
+func (b *otelBackend) Ping(ctx context.Context) error {
+	_, err := call(ctx, b, "Ping", []interface{}{}, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, b.inner.Ping(ctx)
+	})
+	return err
+}
@@ -0,0 +1,58 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+)
+
+type logExporter struct {
+	logger *zap.SugaredLogger
+}
+
+// NewLogExporter returns a span exporter writing the spans to logger, such as
+// the one of logging.FromContext, with their duration and attributes. Spans
+// with an error status are logged as warnings.
+func NewLogExporter(logger *zap.SugaredLogger) sdktrace.SpanExporter {
+	return &logExporter{logger: logger}
+}
+
+func (e *logExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, span := range spans {
+		keysAndValues := []interface{}{
+			"span", span.Name(),
+			"trace_id", span.SpanContext().TraceID().String(),
+			"duration", span.EndTime().Sub(span.StartTime()),
+		}
+		for _, attr := range span.Attributes() {
+			keysAndValues = append(keysAndValues, string(attr.Key), attr.Value.AsInterface())
+		}
+		if status := span.Status(); status.Code == codes.Error {
+			e.logger.Warnw("backend call failed", append(keysAndValues, "error", status.Description)...)
+			continue
+		}
+		e.logger.Infow("backend call", keysAndValues...)
+	}
+	return nil
+}
+
+func (e *logExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
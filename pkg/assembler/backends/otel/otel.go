@@ -0,0 +1,99 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otel provides a backend tracing the calls of another backend with
+// OpenTelemetry spans.
+package otel
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The attributes of the spans of the backend calls.
+const (
+	// MethodKey is the name of the Backend method called.
+	MethodKey = attribute.Key("guac.backend.method")
+	// SpecCardinalityKey is the number of specs and inputs passed to the
+	// method: the length of the lists, plus one for each spec or input not
+	// set to nil.
+	SpecCardinalityKey = attribute.Key("guac.backend.spec_cardinality")
+	// ResultsKey is the number of results returned by the methods returning
+	// a list or a map, if they succeed.
+	ResultsKey = attribute.Key("guac.backend.results")
+)
+
+type otelBackend struct {
+	inner  backends.Backend
+	tracer trace.Tracer
+}
+
+// Wrap returns a backend delegating to inner, running each call in a span of
+// tracer named after the method. The duration of the call is the one of the
+// span, and errors set the status of the span to codes.Error. The span is in
+// the context passed to inner, so that the spans it starts are its children.
+func Wrap(inner backends.Backend, tracer trace.Tracer) backends.Backend {
+	return &otelBackend{inner: inner, tracer: tracer}
+}
+
+// IdempotentIngestion implements retry.IdempotentBackend, so that the
+// ingestions are still retried when inner can run them again: each attempt is
+// traced in its own span.
+func (b *otelBackend) IdempotentIngestion(method string) bool {
+	i, ok := b.inner.(retry.IdempotentBackend)
+	return ok && i.IdempotentIngestion(method)
+}
+
+// call runs f, calling method with args, in a span.
+func call[T any](ctx context.Context, b *otelBackend, method string, args []interface{}, f func(ctx context.Context) (T, error)) (T, error) {
+	ctx, span := b.tracer.Start(ctx, "Backend."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(MethodKey.String(method), SpecCardinalityKey.Int(specCardinality(args))))
+	defer span.End()
+
+	result, err := f(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return result, err
+	}
+	if v := reflect.ValueOf(result); v.Kind() == reflect.Slice || v.Kind() == reflect.Map {
+		span.SetAttributes(ResultsKey.Int(v.Len()))
+	}
+	return result, nil
+}
+
+// specCardinality returns the SpecCardinalityKey attribute of the arguments.
+// Arguments which are neither lists nor pointers to structs, such as
+// pagination cursors, are not counted.
+func specCardinality(args []interface{}) int {
+	n := 0
+	for _, arg := range args {
+		v := reflect.ValueOf(arg)
+		switch {
+		case v.Kind() == reflect.Slice:
+			n += v.Len()
+		case v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct && !v.IsNil():
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,222 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newTracedBackend(t *testing.T) (backends.Backend, *tracetest.SpanRecorder) {
+	t.Helper()
+	inner, err := inmem.New(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("inmem.New() error = %v", err)
+	}
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return Wrap(inner, provider.Tracer("test")), recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	out := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		out[attr.Key] = attr.Value
+	}
+	return out
+}
+
+func TestWrap(t *testing.T) {
+	ctx := context.Background()
+	b, recorder := newTracedBackend(t)
+
+	artifacts := []*model.ArtifactInputSpec{
+		{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"},
+		{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+	}
+	if _, err := b.IngestArtifacts(ctx, artifacts); err != nil {
+		t.Fatalf("IngestArtifacts() error = %v", err)
+	}
+	if _, err := b.Artifacts(ctx, nil); err != nil {
+		t.Fatalf("Artifacts() error = %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "not hex"}); err == nil {
+		t.Fatalf("IngestArtifact() of an invalid artifact succeeded")
+	}
+	if err := b.Ping(ctx); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	tests := []struct {
+		method          string
+		specCardinality int64
+		results         int64
+		wantErr         bool
+	}{
+		{method: "IngestArtifacts", specCardinality: 2, results: 2},
+		{method: "Artifacts", specCardinality: 0, results: 2},
+		{method: "IngestArtifact", specCardinality: 1, wantErr: true},
+		{method: "Ping", specCardinality: 0, results: -1},
+	}
+	spans := recorder.Ended()
+	if len(spans) != len(tests) {
+		t.Fatalf("recorded %d spans, want %d", len(spans), len(tests))
+	}
+	for i, tt := range tests {
+		span := spans[i]
+		if want := "Backend." + tt.method; span.Name() != want {
+			t.Errorf("span %d is named %q, want %q", i, span.Name(), want)
+			continue
+		}
+		attrs := attributes(span)
+		if got := attrs[MethodKey].AsString(); got != tt.method {
+			t.Errorf("%s: method attribute = %q, want %q", tt.method, got, tt.method)
+		}
+		if got := attrs[SpecCardinalityKey].AsInt64(); got != tt.specCardinality {
+			t.Errorf("%s: spec cardinality = %d, want %d", tt.method, got, tt.specCardinality)
+		}
+		results, ok := attrs[ResultsKey]
+		switch {
+		case tt.results < 0 || tt.wantErr:
+			if ok {
+				t.Errorf("%s: unexpected results attribute %v", tt.method, results.AsInt64())
+			}
+		case results.AsInt64() != tt.results:
+			t.Errorf("%s: results = %d, want %d", tt.method, results.AsInt64(), tt.results)
+		}
+		if span.EndTime().IsZero() || span.EndTime().Before(span.StartTime()) {
+			t.Errorf("%s: span ended at %v, before its start %v", tt.method, span.EndTime(), span.StartTime())
+		}
+		if got := span.Status().Code; (got == codes.Error) != tt.wantErr {
+			t.Errorf("%s: status = %v, wantErr %v", tt.method, got, tt.wantErr)
+		}
+		if tt.wantErr && len(span.Events()) == 0 {
+			t.Errorf("%s: the error is not recorded as an event", tt.method)
+		}
+	}
+}
+
+func TestWrapPropagatesSpan(t *testing.T) {
+	b, recorder := newTracedBackend(t)
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	if _, err := b.Builders(ctx, nil); err != nil {
+		t.Fatalf("Builders() error = %v", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(spans))
+	}
+	if got, want := spans[0].Parent().SpanID(), spans[1].SpanContext().SpanID(); got != want {
+		t.Errorf("parent of the backend span = %v, want %v", got, want)
+	}
+}
+
+func TestLogExporter(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	exporter := NewLogExporter(zap.New(core).Sugar())
+	ctx := context.Background()
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(ctx, "Backend.Packages")
+	span.SetAttributes(MethodKey.String("Packages"))
+	span.End()
+	_, span = tracer.Start(ctx, "Backend.Ping")
+	span.SetStatus(codes.Error, "unreachable")
+	span.End()
+
+	if err := exporter.ExportSpans(ctx, recorder.Ended()); err != nil {
+		t.Fatalf("ExportSpans() error = %v", err)
+	}
+	entries := logs.AllUntimed()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	if fields := entries[0].ContextMap(); fields["span"] != "Backend.Packages" || fields[string(MethodKey)] != "Packages" {
+		t.Errorf("first entry has fields %v", fields)
+	}
+	if entries[1].Level != zap.WarnLevel || entries[1].ContextMap()["error"] != "unreachable" {
+		t.Errorf("second entry is %v %v, want a warning with the error", entries[1].Level, entries[1].ContextMap())
+	}
+}
+
+// flakyBackend fails the first ingestion of a package with a transient error.
+type flakyBackend struct {
+	backends.Backend
+	idempotent bool
+	calls      int
+}
+
+func (b *flakyBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	b.calls++
+	if b.calls == 1 {
+		return nil, backends.ErrTransient
+	}
+	return b.Backend.IngestPackage(ctx, pkg)
+}
+
+func (b *flakyBackend) IdempotentIngestion(method string) bool {
+	return b.idempotent
+}
+
+func TestWrapRetried(t *testing.T) {
+	ctx := context.Background()
+	pkg := &model.PkgInputSpec{Type: "pypi", Name: "django"}
+	for _, idempotent := range []bool{true, false} {
+		inner, err := inmem.New(ctx, nil)
+		if err != nil {
+			t.Fatalf("inmem.New() error = %v", err)
+		}
+		flaky := &flakyBackend{Backend: inner, idempotent: idempotent}
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		b := retry.Wrap(Wrap(flaky, provider.Tracer("test")), retry.RetryOptions{InitialBackoff: time.Millisecond})
+
+		_, err = b.IngestPackage(ctx, pkg)
+		if !idempotent {
+			if !errors.Is(err, backends.ErrTransient) || flaky.calls != 1 {
+				t.Errorf("IngestPackage() of a backend without idempotent ingestions error = %v after %d calls, want %v after 1", err, flaky.calls, backends.ErrTransient)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if flaky.calls != 2 {
+			t.Errorf("IngestPackage() called the backend %d times, want 2", flaky.calls)
+		}
+		// Each attempt has its span.
+		if spans := recorder.Ended(); len(spans) != 2 || spans[0].Status().Code != codes.Error || spans[1].Status().Code == codes.Error {
+			t.Errorf("IngestPackage() recorded %d spans, want a failed one and a successful one", len(spans))
+		}
+	}
+}
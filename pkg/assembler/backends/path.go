@@ -0,0 +1,97 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// The path and neighbors queries see the trees as an undirected graph. The
+// nodes of the package, source and vulnerability tries are connected to their
+// parent, and every evidence node is connected to each of the nodes it
+// references. Backends only have to return the neighbors of a node, the
+// search itself is shared.
+
+// NeighborsFunc returns the IDs of the nodes directly connected to the node
+// with the given ID, through the edges allowed by the query.
+type NeighborsFunc func(ctx context.Context, id string) ([]string, error)
+
+// ShortestPath returns the IDs of the nodes along a shortest path from
+// subject to target, both included, traversing at most maxPathLength edges.
+// It returns an empty slice if target cannot be reached. The search is
+// breadth first, so neighbors is called at most once per node, in the order
+// in which the nodes are reached. maxPathLength must be positive.
+func ShortestPath(ctx context.Context, subject, target string, maxPathLength int, neighbors NeighborsFunc) ([]string, error) {
+	if subject == target {
+		return []string{subject}, nil
+	}
+
+	// parents records the node from which every visited node was reached.
+	parents := map[string]string{subject: ""}
+	frontier := []string{subject}
+	for depth := 0; depth < maxPathLength && len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			ids, err := neighbors(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range ids {
+				if _, ok := parents[n]; ok {
+					continue
+				}
+				parents[n] = id
+				if n == target {
+					return pathTo(parents, target), nil
+				}
+				next = append(next, n)
+			}
+		}
+		frontier = next
+	}
+	return []string{}, nil
+}
+
+// pathTo walks back the parents from target to the subject of the search,
+// the only node without a parent, and returns the path in order.
+func pathTo(parents map[string]string, target string) []string {
+	var path []string
+	for id := target; id != ""; id = parents[id] {
+		path = append(path, id)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// AllowsEdge returns true if usingOnly is empty or contains the label.
+func AllowsEdge(usingOnly []model.Edge, label model.Edge) bool {
+	if len(usingOnly) == 0 {
+		return true
+	}
+	for _, e := range usingOnly {
+		if e == label {
+			return true
+		}
+	}
+	return false
+}
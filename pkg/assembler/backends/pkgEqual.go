@@ -0,0 +1,64 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ExpandPkgEqual is the same as ExpandHashEqual for the PkgEqual linking
+// package versions. Backends implement the expandTransitive filter of
+// PkgEqualSpec with it.
+func ExpandPkgEqual(ctx context.Context, b Backend, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	direct := model.PkgEqualSpec{}
+	if pkgEqualSpec != nil {
+		direct = *pkgEqualSpec
+	}
+	direct.ExpandTransitive = nil
+	equalities, err := b.PkgEqual(ctx, &direct)
+	if err != nil {
+		return nil, err
+	}
+	return expandEqualities(equalities,
+		func(e *model.PkgEqual) string { return e.ID },
+		func(e *model.PkgEqual) []*model.Package { return e.Packages },
+		func(p *model.Package) string { return pkgVersionID(p) },
+		func(p *model.Package) ([]*model.PkgEqual, error) {
+			found, err := b.PkgEqual(ctx, &model.PkgEqualSpec{
+				Packages:      []*model.PkgSpec{pkgVersionSpec(p)},
+				Justification: direct.Justification,
+				Origin:        direct.Origin,
+				Collector:     direct.Collector,
+			})
+			if err != nil {
+				return nil, err
+			}
+			// The version specs ignore the qualifiers, so the
+			// equalities of the other versions are skipped here.
+			var out []*model.PkgEqual
+			for _, e := range found {
+				for _, other := range e.Packages {
+					if pkgVersionID(other) == pkgVersionID(p) {
+						out = append(out, e)
+						break
+					}
+				}
+			}
+			return out, nil
+		})
+}
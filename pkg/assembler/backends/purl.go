@@ -0,0 +1,51 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// PurlPkgSpecs converts the purls of a PackagesByPurls query to the
+// canonicalized specs matching their packages, keyed by purl, as
+// helpers.PurlToPkgSpec does. The purls which cannot be parsed are left out
+// and listed in the returned ValidationError, so that the backends can still
+// resolve the others and return them along with the error.
+func PurlPkgSpecs(purls []string) (map[string]*model.PkgSpec, error) {
+	specs := make(map[string]*model.PkgSpec, len(purls))
+	seen := map[string]bool{}
+	var invalid []string
+	for _, purl := range purls {
+		if seen[purl] {
+			continue
+		}
+		seen[purl] = true
+		spec, err := helpers.PurlToPkgSpec(purl)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%q: %v", purl, err))
+			continue
+		}
+		specs[purl] = CanonicalPkgSpec(spec)
+	}
+	if len(invalid) > 0 {
+		return specs, Errorf("PackagesByPurls :: %w", NewValidationError("purls", "invalid purls: %s", strings.Join(invalid, "; ")))
+	}
+	return specs, nil
+}
@@ -0,0 +1,328 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (b *retryBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return read(ctx, b, func() ([]*model.Artifact, error) {
+		return b.inner.Artifacts(ctx, artifactSpec)
+	})
+}
+
+func (b *retryBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	return read(ctx, b, func() (*model.ArtifactConnection, error) {
+		return b.inner.ArtifactsList(ctx, artifactSpec, after, first)
+	})
+}
+
+func (b *retryBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	return read(ctx, b, func() ([]*model.Builder, error) {
+		return b.inner.Builders(ctx, builderSpec)
+	})
+}
+
+func (b *retryBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return read(ctx, b, func() ([]*model.Package, error) {
+		return b.inner.Packages(ctx, pkgSpec)
+	})
+}
+
+func (b *retryBackend) PackagesByPurls(ctx context.Context, purls []string) (map[string]*model.Package, error) {
+	return read(ctx, b, func() (map[string]*model.Package, error) {
+		return b.inner.PackagesByPurls(ctx, purls)
+	})
+}
+
+func (b *retryBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return read(ctx, b, func() ([]*model.Source, error) {
+		return b.inner.Sources(ctx, sourceSpec)
+	})
+}
+
+func (b *retryBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return read(ctx, b, func() ([]*model.Vulnerability, error) {
+		return b.inner.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (b *retryBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return read(ctx, b, func() ([]*model.CertifyBad, error) {
+		return b.inner.CertifyBad(ctx, certifyBadSpec)
+	})
+}
+
+func (b *retryBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	return read(ctx, b, func() ([]*model.CertifyGood, error) {
+		return b.inner.CertifyGood(ctx, certifyGoodSpec)
+	})
+}
+
+func (b *retryBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return read(ctx, b, func() ([]*model.CertifyLegal, error) {
+		return b.inner.CertifyLegal(ctx, certifyLegalSpec)
+	})
+}
+
+func (b *retryBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return read(ctx, b, func() ([]*model.CertifyVuln, error) {
+		return b.inner.CertifyVuln(ctx, certifyVulnSpec)
+	})
+}
+
+func (b *retryBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	return read(ctx, b, func() ([]*model.CertifyVEXStatement, error) {
+		return b.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+}
+
+func (b *retryBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	return read(ctx, b, func() ([]*model.HashEqual, error) {
+		return b.inner.HashEqual(ctx, hashEqualSpec)
+	})
+}
+
+func (b *retryBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return read(ctx, b, func() ([]*model.HasSbom, error) {
+		return b.inner.HasSBOM(ctx, hasSBOMSpec)
+	})
+}
+
+func (b *retryBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	return read(ctx, b, func() ([]*model.HasMetadata, error) {
+		return b.inner.HasMetadata(ctx, hasMetadataSpec)
+	})
+}
+
+func (b *retryBackend) HasSLSA(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	return read(ctx, b, func() ([]*model.HasSlsa, error) {
+		return b.inner.HasSLSA(ctx, hasSLSASpec)
+	})
+}
+
+func (b *retryBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return read(ctx, b, func() ([]*model.IsDependency, error) {
+		return b.inner.IsDependency(ctx, isDependencySpec)
+	})
+}
+
+func (b *retryBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	return read(ctx, b, func() ([]*model.IsOccurrence, error) {
+		return b.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+}
+
+func (b *retryBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return read(ctx, b, func() ([]*model.PkgEqual, error) {
+		return b.inner.PkgEqual(ctx, pkgEqualSpec)
+	})
+}
+
+func (b *retryBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return read(ctx, b, func() ([]*model.PointOfContact, error) {
+		return b.inner.PointOfContact(ctx, pointOfContactSpec)
+	})
+}
+
+func (b *retryBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	return read(ctx, b, func() ([]*model.CertifyScorecard, error) {
+		return b.inner.Scorecards(ctx, certifyScorecardSpec)
+	})
+}
+
+func (b *retryBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return read(ctx, b, func() ([]*model.VulnEqual, error) {
+		return b.inner.VulnEqual(ctx, vulnEqualSpec)
+	})
+}
+
+func (b *retryBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return read(ctx, b, func() ([]model.Node, error) {
+		return b.inner.Neighbors(ctx, node, usingOnly)
+	})
+}
+
+func (b *retryBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	return read(ctx, b, func() ([]model.Node, error) {
+		return b.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	})
+}
+
+func (b *retryBackend) FindSBOMsByArtifact(ctx context.Context, algorithm string, digest string) ([]*model.HasSbom, error) {
+	return read(ctx, b, func() ([]*model.HasSbom, error) {
+		return b.inner.FindSBOMsByArtifact(ctx, algorithm, digest)
+	})
+}
+
+func (b *retryBackend) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	return read(ctx, b, func() ([]*model.Package, error) {
+		return b.inner.TransitiveDependencies(ctx, pkgSpec, maxDepth)
+	})
+}
+
+func (b *retryBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return ingest(ctx, b, "IngestArtifact", func() (*model.Artifact, error) {
+		return b.inner.IngestArtifact(ctx, artifact)
+	})
+}
+
+func (b *retryBackend) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return ingest(ctx, b, "IngestArtifacts", func() ([]*model.Artifact, error) {
+		return b.inner.IngestArtifacts(ctx, artifacts)
+	})
+}
+
+func (b *retryBackend) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	return ingest(ctx, b, "IngestBuilder", func() (*model.Builder, error) {
+		return b.inner.IngestBuilder(ctx, builder)
+	})
+}
+
+func (b *retryBackend) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return ingest(ctx, b, "IngestPackage", func() (*model.Package, error) {
+		return b.inner.IngestPackage(ctx, pkg)
+	})
+}
+
+func (b *retryBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return ingest(ctx, b, "IngestPackages", func() ([]*model.Package, error) {
+		return b.inner.IngestPackages(ctx, pkgs)
+	})
+}
+
+func (b *retryBackend) IngestSource(ctx context.Context, source *model.SourceInputSpec) (*model.Source, error) {
+	return ingest(ctx, b, "IngestSource", func() (*model.Source, error) {
+		return b.inner.IngestSource(ctx, source)
+	})
+}
+
+func (b *retryBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	return ingest(ctx, b, "IngestSources", func() ([]*model.Source, error) {
+		return b.inner.IngestSources(ctx, sources)
+	})
+}
+
+func (b *retryBackend) IngestVulnerability(ctx context.Context, vuln *model.VulnerabilityInputSpec) (*model.Vulnerability, error) {
+	return ingest(ctx, b, "IngestVulnerability", func() (*model.Vulnerability, error) {
+		return b.inner.IngestVulnerability(ctx, vuln)
+	})
+}
+
+func (b *retryBackend) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return ingest(ctx, b, "IngestCertifyBad", func() (*model.CertifyBad, error) {
+		return b.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *retryBackend) IngestCertifyGood(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	return ingest(ctx, b, "IngestCertifyGood", func() (*model.CertifyGood, error) {
+		return b.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	})
+}
+
+func (b *retryBackend) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return ingest(ctx, b, "IngestCertifyLegal", func() (*model.CertifyLegal, error) {
+		return b.inner.IngestCertifyLegal(ctx, subject, certifyLegal)
+	})
+}
+
+func (b *retryBackend) IngestCertifyVuln(ctx context.Context, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) (*model.CertifyVuln, error) {
+	return ingest(ctx, b, "IngestCertifyVuln", func() (*model.CertifyVuln, error) {
+		return b.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *retryBackend) IngestHashEqual(ctx context.Context, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) (*model.HashEqual, error) {
+	return ingest(ctx, b, "IngestHashEqual", func() (*model.HashEqual, error) {
+		return b.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	})
+}
+
+func (b *retryBackend) IngestHasMetadata(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) (*model.HasMetadata, error) {
+	return ingest(ctx, b, "IngestHasMetadata", func() (*model.HasMetadata, error) {
+		return b.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	})
+}
+
+func (b *retryBackend) IngestHasSbom(ctx context.Context, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	return ingest(ctx, b, "IngestHasSbom", func() (*model.HasSbom, error) {
+		return b.inner.IngestHasSbom(ctx, subject, hasSbom)
+	})
+}
+
+func (b *retryBackend) IngestSLSA(ctx context.Context, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) (*model.HasSlsa, error) {
+	return ingest(ctx, b, "IngestSLSA", func() (*model.HasSlsa, error) {
+		return b.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	})
+}
+
+func (b *retryBackend) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return ingest(ctx, b, "IngestIsDependency", func() (*model.IsDependency, error) {
+		return b.inner.IngestIsDependency(ctx, pkg, depPkg, dependency)
+	})
+}
+
+func (b *retryBackend) IngestIsOccurrence(ctx context.Context, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	return ingest(ctx, b, "IngestIsOccurrence", func() (*model.IsOccurrence, error) {
+		return b.inner.IngestIsOccurrence(ctx, subject, artifact, occurrence)
+	})
+}
+
+func (b *retryBackend) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return ingest(ctx, b, "IngestPkgEqual", func() (*model.PkgEqual, error) {
+		return b.inner.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+	})
+}
+
+func (b *retryBackend) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return ingest(ctx, b, "IngestPointOfContact", func() (*model.PointOfContact, error) {
+		return b.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	})
+}
+
+func (b *retryBackend) IngestScorecard(ctx context.Context, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return ingest(ctx, b, "IngestScorecard", func() (*model.CertifyScorecard, error) {
+		return b.inner.IngestScorecard(ctx, source, scorecard)
+	})
+}
+
+func (b *retryBackend) IngestVEXStatement(ctx context.Context, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	return ingest(ctx, b, "IngestVEXStatement", func() (*model.CertifyVEXStatement, error) {
+		return b.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *retryBackend) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return ingest(ctx, b, "IngestVulnEqual", func() (*model.VulnEqual, error) {
+		return b.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	})
+}
+
+func (b *retryBackend) SubscribeArtifacts(ctx context.Context) (<-chan *model.Artifact, error) {
+	return read(ctx, b, func() (<-chan *model.Artifact, error) {
+		return b.inner.SubscribeArtifacts(ctx)
+	})
+}
+
+// Ping is not retried, so that a probe reports the current state of the
+// datastore.
+func (b *retryBackend) Ping(ctx context.Context) error {
+	return b.inner.Ping(ctx)
+}
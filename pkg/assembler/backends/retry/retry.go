@@ -0,0 +1,164 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry provides a backend retrying the calls of another backend
+// which fail with transient errors.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+const (
+	defaultMaxAttempts    int           = 5
+	defaultInitialBackoff time.Duration = 50 * time.Millisecond
+	defaultMaxBackoff     time.Duration = 5 * time.Second
+)
+
+// RetryOptions configures the retries of the backend returned by Wrap. The
+// zero value retries on backends.ErrTransient with the default limits.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of calls of the inner backend for
+	// each call, including the first one. Defaults to 5.
+	MaxAttempts int
+	// InitialBackoff is the longest wait before the first retry. It is
+	// doubled at each retry, up to MaxBackoff. The actual wait is picked at
+	// random between half of it and all of it. Defaults to 50ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts. Defaults to 5s.
+	MaxBackoff time.Duration
+	// RetryableErrors are matched with errors.Is against the errors of the
+	// inner backend to decide whether to retry. Defaults to
+	// backends.ErrTransient.
+	RetryableErrors []error
+}
+
+// IdempotentBackend is implemented by the backends whose ingestions can be
+// run again after a failure without changing the result, e.g. because the
+// first run may have been committed before the error. Ingestions are only
+// retried when IdempotentIngestion returns true for the name of the Backend
+// method.
+type IdempotentBackend interface {
+	IdempotentIngestion(method string) bool
+}
+
+type retryBackend struct {
+	inner      backends.Backend
+	opts       RetryOptions
+	idempotent func(method string) bool
+
+	randLock sync.Mutex
+	rand     *rand.Rand
+}
+
+// Wrap returns a backend delegating to inner, and calling it again with an
+// exponential backoff when it fails with one of the retryable errors. The
+// queries are always retried, the ingestions only if inner implements
+// IdempotentBackend. No retry is attempted once the context is done, or if the
+// wait would end after the deadline of the context.
+func Wrap(inner backends.Backend, opts RetryOptions) backends.Backend {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = opts.InitialBackoff
+	}
+	if opts.RetryableErrors == nil {
+		opts.RetryableErrors = []error{backends.ErrTransient}
+	}
+	b := &retryBackend{
+		inner: inner,
+		opts:  opts,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	if i, ok := inner.(IdempotentBackend); ok {
+		b.idempotent = i.IdempotentIngestion
+	}
+	return b
+}
+
+// read runs the query f, retrying it on retryable errors.
+func read[T any](ctx context.Context, b *retryBackend, f func() (T, error)) (T, error) {
+	return call(ctx, b, true, f)
+}
+
+// ingest runs the ingestion f done by method, retrying it on retryable errors
+// if the inner backend signals that method is idempotent.
+func ingest[T any](ctx context.Context, b *retryBackend, method string, f func() (T, error)) (T, error) {
+	return call(ctx, b, b.idempotent != nil && b.idempotent(method), f)
+}
+
+func call[T any](ctx context.Context, b *retryBackend, retry bool, f func() (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := f()
+		if err == nil || !retry || attempt == b.opts.MaxAttempts || !b.retryable(err) {
+			return result, err
+		}
+		wait := b.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return result, err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			var zero T
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable returns true if err matches one of the retryable errors. Context
+// errors are never retried.
+func (b *retryBackend) retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	for _, target := range b.opts.RetryableErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before the retry following the attempt-th call,
+// between half and all of the exponential backoff.
+func (b *retryBackend) backoff(attempt int) time.Duration {
+	backoff := b.opts.InitialBackoff
+	for i := 1; i < attempt && backoff < b.opts.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > b.opts.MaxBackoff {
+		backoff = b.opts.MaxBackoff
+	}
+	b.randLock.Lock()
+	defer b.randLock.Unlock()
+	half := backoff / 2
+	return half + time.Duration(b.rand.Int63n(int64(backoff-half)+1))
+}
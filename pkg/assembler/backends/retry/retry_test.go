@@ -0,0 +1,229 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// fakeBackend fails the first failures calls of Artifacts and IngestArtifact
+// with err, then succeeds. The other methods are not implemented.
+type fakeBackend struct {
+	backends.Backend
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *fakeBackend) call() error {
+	f.calls++
+	if f.calls <= f.failures {
+		return f.err
+	}
+	return nil
+}
+
+func (f *fakeBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+	return []*model.Artifact{{ID: "1", Algorithm: "sha256", Digest: "abcd"}}, nil
+}
+
+func (f *fakeBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+	return &model.Artifact{ID: "1", Algorithm: artifact.Algorithm, Digest: artifact.Digest}, nil
+}
+
+// idempotentBackend is a fakeBackend signalling that IngestArtifact is
+// idempotent.
+type idempotentBackend struct {
+	*fakeBackend
+}
+
+func (idempotentBackend) IdempotentIngestion(method string) bool {
+	return method == "IngestArtifact"
+}
+
+var errCustom = errors.New("custom error")
+
+var fastRetries = RetryOptions{InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func TestRetry(t *testing.T) {
+	transient := fmt.Errorf("deadlock: %w", backends.ErrTransient)
+	tests := []struct {
+		name       string
+		opts       RetryOptions
+		idempotent bool
+		ingest     bool
+		failures   int
+		err        error
+		wantCalls  int
+		wantErr    error
+	}{{
+		name:      "read succeeding after transient errors",
+		opts:      fastRetries,
+		failures:  2,
+		err:       transient,
+		wantCalls: 3,
+	}, {
+		name:      "read failing more than the max attempts",
+		opts:      RetryOptions{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		failures:  10,
+		err:       transient,
+		wantCalls: 3,
+		wantErr:   backends.ErrTransient,
+	}, {
+		name:      "read not retried on validation error",
+		opts:      fastRetries,
+		failures:  1,
+		err:       backends.Errorf("Artifacts :: %w", backends.Missing("artifactSpec")),
+		wantCalls: 1,
+		wantErr:   &backends.ValidationError{},
+	}, {
+		name:      "read not retried on other errors by default",
+		opts:      fastRetries,
+		failures:  1,
+		err:       errCustom,
+		wantCalls: 1,
+		wantErr:   errCustom,
+	}, {
+		name:      "read retried on configured errors",
+		opts:      RetryOptions{InitialBackoff: time.Millisecond, RetryableErrors: []error{errCustom}},
+		failures:  2,
+		err:       errCustom,
+		wantCalls: 3,
+	}, {
+		name:      "ingestion not retried without idempotency",
+		opts:      fastRetries,
+		ingest:    true,
+		failures:  2,
+		err:       transient,
+		wantCalls: 1,
+		wantErr:   backends.ErrTransient,
+	}, {
+		name:       "idempotent ingestion retried",
+		opts:       fastRetries,
+		idempotent: true,
+		ingest:     true,
+		failures:   2,
+		err:        transient,
+		wantCalls:  3,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeBackend{failures: tt.failures, err: tt.err}
+			var inner backends.Backend = fake
+			if tt.idempotent {
+				inner = idempotentBackend{fake}
+			}
+			b := Wrap(inner, tt.opts)
+
+			ctx := context.Background()
+			var got interface{}
+			var err error
+			if tt.ingest {
+				got, err = b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcd"})
+			} else {
+				got, err = b.Artifacts(ctx, nil)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("error = %v, want none", err)
+			}
+			var validationErr *backends.ValidationError
+			if _, ok := tt.wantErr.(*backends.ValidationError); ok {
+				if !errors.As(err, &validationErr) {
+					t.Errorf("error = %v, want a ValidationError", err)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got == nil {
+				t.Errorf("no result returned")
+			}
+			if fake.calls != tt.wantCalls {
+				t.Errorf("inner backend called %d times, want %d", fake.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryContext(t *testing.T) {
+	opts := RetryOptions{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	// The wait would end after the deadline, so the error is returned
+	// without waiting.
+	fake := &fakeBackend{failures: 1, err: backends.ErrTransient}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err := Wrap(fake, opts).Artifacts(ctx, nil); !errors.Is(err, backends.ErrTransient) {
+		t.Errorf("Artifacts() error = %v, want %v", err, backends.ErrTransient)
+	}
+	if fake.calls != 1 {
+		t.Errorf("inner backend called %d times, want 1", fake.calls)
+	}
+
+	// The context is cancelled while waiting.
+	fake = &fakeBackend{failures: 1, err: backends.ErrTransient}
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	got, err := Wrap(fake, opts).Artifacts(ctx, nil)
+	if err != context.Canceled {
+		t.Errorf("Artifacts() error = %v, want %v", err, context.Canceled)
+	}
+	if got != nil {
+		t.Errorf("Artifacts() = %v, want no result", got)
+	}
+
+	// Context errors are never retried.
+	fake = &fakeBackend{failures: 1, err: context.DeadlineExceeded}
+	opts.RetryableErrors = []error{context.DeadlineExceeded}
+	if _, err := Wrap(fake, opts).Artifacts(context.Background(), nil); err != context.DeadlineExceeded {
+		t.Errorf("Artifacts() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if fake.calls != 1 {
+		t.Errorf("inner backend called %d times, want 1", fake.calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	b := Wrap(&fakeBackend{}, RetryOptions{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}).(*retryBackend)
+	for _, tt := range []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{attempt: 1, min: 5 * time.Millisecond, max: 10 * time.Millisecond},
+		{attempt: 2, min: 10 * time.Millisecond, max: 20 * time.Millisecond},
+		{attempt: 3, min: 20 * time.Millisecond, max: 40 * time.Millisecond},
+		{attempt: 4, min: 25 * time.Millisecond, max: 50 * time.Millisecond},
+		{attempt: 100, min: 25 * time.Millisecond, max: 50 * time.Millisecond},
+	} {
+		for i := 0; i < 100; i++ {
+			if got := b.backoff(tt.attempt); got < tt.min || got > tt.max {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// MaxSBOMSearchDepth is the number of IsOccurrence and IsDependency edges
+// FindSBOMsByArtifact follows from the artifact at most.
+const MaxSBOMSearchDepth = 16
+
+// sbomSearchNode is a node reached by FindSBOMsByArtifact: either an artifact
+// or the path from the root of the package trie to a version.
+type sbomSearchNode struct {
+	artifact *model.Artifact
+	pkg      *model.Package
+}
+
+// FindSBOMsByArtifact returns the HasSBOM nodes of every SBOM which may ship
+// the artifact with the given algorithm and digest, in the order in which
+// they are found, each SBOM being returned once. Backends implement the query
+// of the same name with it.
+//
+// Starting from the artifact, the search walks breadth first to:
+//   - the package versions the artifacts are occurrences of, and the
+//     artifacts which are occurrences of the package versions, as both are
+//     the same software;
+//   - the package versions depending on the package versions, regardless of
+//     the version range of the dependency.
+//
+// The SBOMs are the ones whose subject is any of the artifacts or package
+// versions reached, including the artifact itself, through at most
+// MaxSBOMSearchDepth edges. An unknown artifact has no SBOM.
+func FindSBOMsByArtifact(ctx context.Context, b Backend, algorithm, digest string) ([]*model.HasSbom, error) {
+	if algorithm == "" {
+		return nil, Errorf("FindSBOMsByArtifact :: %w", Missing("algorithm"))
+	}
+	if digest == "" {
+		return nil, Errorf("FindSBOMsByArtifact :: %w", Missing("digest"))
+	}
+	artifacts, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &algorithm, Digest: &digest})
+	if err != nil {
+		return nil, err
+	}
+
+	out := []*model.HasSbom{}
+	sboms := map[string]bool{}
+	addSBOMs := func(found []*model.HasSbom, versionID string) {
+		for _, sbom := range found {
+			// The version specs ignore the qualifiers, so the SBOMs
+			// of the other versions are skipped here.
+			if versionID != "" && pkgVersionID(sbom.Subject) != versionID {
+				continue
+			}
+			if !sboms[sbom.ID] {
+				sboms[sbom.ID] = true
+				out = append(out, sbom)
+			}
+		}
+	}
+
+	visited := map[string]bool{}
+	var frontier []sbomSearchNode
+	visit := func(n sbomSearchNode) {
+		// The IDs of the artifacts and versions may overlap in some
+		// backends.
+		id := "pkg:" + pkgVersionID(n.pkg)
+		if n.artifact != nil {
+			id = "artifact:" + n.artifact.ID
+		}
+		if !visited[id] {
+			visited[id] = true
+			frontier = append(frontier, n)
+		}
+	}
+	for _, a := range artifacts {
+		visit(sbomSearchNode{artifact: a})
+	}
+
+	for depth := 0; len(frontier) > 0; depth++ {
+		current := frontier
+		frontier = nil
+		for _, n := range current {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if n.artifact != nil {
+				found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{ID: &n.artifact.ID}}})
+				if err != nil {
+					return nil, err
+				}
+				addSBOMs(found, "")
+				if depth == MaxSBOMSearchDepth {
+					continue
+				}
+				occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{ID: &n.artifact.ID}})
+				if err != nil {
+					return nil, err
+				}
+				for _, o := range occurrences {
+					if p, ok := o.Subject.(*model.Package); ok && pkgVersionID(p) != "" {
+						visit(sbomSearchNode{pkg: p})
+					}
+				}
+				continue
+			}
+
+			versionID := pkgVersionID(n.pkg)
+			found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{Package: pkgVersionSpec(n.pkg)}})
+			if err != nil {
+				return nil, err
+			}
+			addSBOMs(found, versionID)
+			if depth == MaxSBOMSearchDepth {
+				continue
+			}
+			occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: pkgVersionSpec(n.pkg)}})
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range occurrences {
+				if p, ok := o.Subject.(*model.Package); ok && pkgVersionID(p) == versionID {
+					visit(sbomSearchNode{artifact: o.Artifact})
+				}
+			}
+			nameSpec := pkgVersionSpec(n.pkg)
+			nameSpec.Version, nameSpec.Subpath = nil, nil
+			dependents, err := b.IsDependency(ctx, &model.IsDependencySpec{DependentPackage: nameSpec})
+			if err != nil {
+				return nil, err
+			}
+			for _, d := range dependents {
+				if pkgVersionID(d.Package) != "" {
+					visit(sbomSearchNode{pkg: d.Package})
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// pkgVersionID returns the ID of the version of a package or artifact
+// returned by the evidence queries, or "" if it is not a package version.
+func pkgVersionID(node interface{}) string {
+	p, ok := node.(*model.Package)
+	if !ok || p == nil || len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 || len(p.Namespaces[0].Names[0].Versions) == 0 {
+		return ""
+	}
+	return p.Namespaces[0].Names[0].Versions[0].ID
+}
+
+// pkgVersionSpec returns the spec matching the version of the package,
+// regardless of its qualifiers.
+func pkgVersionSpec(p *model.Package) *model.PkgSpec {
+	ns := p.Namespaces[0]
+	n := ns.Names[0]
+	v := n.Versions[0]
+	return &model.PkgSpec{
+		Type:      &p.Type,
+		Namespace: &ns.Namespace,
+		Name:      &n.Name,
+		Version:   &v.Version,
+		Subpath:   &v.Subpath,
+	}
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// subjectField is a field of a subject union, which is set if the subject is
+// of its kind.
+type subjectField struct {
+	name string
+	set  bool
+}
+
+// ValidateSubjectSpec checks the subject union of an evidence tree, returning
+// a ValidationError of the subject field naming the conflicting fields if it
+// is ambiguous. Exactly one field must be set in the ingestion inputs
+// (PackageSourceOrArtifactInput, PackageOrSourceInput and
+// PackageOrArtifactInput), while at most one may be set in the query specs
+// (PackageSourceOrArtifactSpec, PackageOrSourceSpec and
+// PackageOrArtifactSpec), a nil spec matching all the subjects.
+func ValidateSubjectSpec(subject interface{}) error {
+	var fields []subjectField
+	input := true
+	switch s := subject.(type) {
+	case *model.PackageSourceOrArtifactInput:
+		if s == nil {
+			s = &model.PackageSourceOrArtifactInput{}
+		}
+		fields = []subjectField{{"package", s.Package != nil}, {"source", s.Source != nil}, {"artifact", s.Artifact != nil}}
+	case *model.PackageOrSourceInput:
+		if s == nil {
+			s = &model.PackageOrSourceInput{}
+		}
+		fields = []subjectField{{"package", s.Package != nil}, {"source", s.Source != nil}}
+	case *model.PackageOrArtifactInput:
+		if s == nil {
+			s = &model.PackageOrArtifactInput{}
+		}
+		fields = []subjectField{{"package", s.Package != nil}, {"artifact", s.Artifact != nil}}
+	case *model.PackageSourceOrArtifactSpec:
+		if s == nil {
+			return nil
+		}
+		input = false
+		fields = []subjectField{{"package", s.Package != nil}, {"source", s.Source != nil}, {"artifact", s.Artifact != nil}}
+	case *model.PackageOrSourceSpec:
+		if s == nil {
+			return nil
+		}
+		input = false
+		fields = []subjectField{{"package", s.Package != nil}, {"source", s.Source != nil}}
+	case *model.PackageOrArtifactSpec:
+		if s == nil {
+			return nil
+		}
+		input = false
+		fields = []subjectField{{"package", s.Package != nil}, {"artifact", s.Artifact != nil}}
+	default:
+		return fmt.Errorf("unsupported subject type %T", subject)
+	}
+
+	var all, set []string
+	for _, f := range fields {
+		all = append(all, f.name)
+		if f.set {
+			set = append(set, f.name)
+		}
+	}
+	switch {
+	case input && len(set) == 0:
+		return NewValidationError("subject", "exactly one of %s must be specified as subject", joinNames(all))
+	case input && len(set) > 1:
+		return NewValidationError("subject", "conflicting %s subjects, exactly one of %s must be specified as subject", joinNames(set), joinNames(all))
+	case len(set) > 1:
+		return NewValidationError("subject", "conflicting %s subjects, cannot filter on more than one of %s", joinNames(set), joinNames(all))
+	}
+	return nil
+}
+
+// joinNames joins the names as in "package, source and artifact".
+func joinNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
@@ -0,0 +1,143 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestValidateSubjectSpec(t *testing.T) {
+	pkgInput := &model.PkgInputSpec{Type: "npm", Name: "debug"}
+	srcInput := &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac"}
+	artifactInput := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcd"}
+	pkg := &model.PkgSpec{}
+	src := &model.SourceSpec{}
+	artifact := &model.ArtifactSpec{}
+
+	tests := []struct {
+		name    string
+		subject interface{}
+		// wantConflict are the conflicting fields named by the error, if
+		// some are expected.
+		wantConflict []string
+		wantErr      bool
+	}{{
+		name:    "no PackageSourceOrArtifactInput subject",
+		subject: &model.PackageSourceOrArtifactInput{},
+		wantErr: true,
+	}, {
+		name:    "nil PackageSourceOrArtifactInput",
+		subject: (*model.PackageSourceOrArtifactInput)(nil),
+		wantErr: true,
+	}, {
+		name:    "PackageSourceOrArtifactInput source",
+		subject: &model.PackageSourceOrArtifactInput{Source: srcInput},
+	}, {
+		name:         "PackageSourceOrArtifactInput package and artifact",
+		subject:      &model.PackageSourceOrArtifactInput{Package: pkgInput, Artifact: artifactInput},
+		wantConflict: []string{"package", "artifact"},
+	}, {
+		name:         "PackageSourceOrArtifactInput all subjects",
+		subject:      &model.PackageSourceOrArtifactInput{Package: pkgInput, Source: srcInput, Artifact: artifactInput},
+		wantConflict: []string{"package", "source", "artifact"},
+	}, {
+		name:    "no PackageOrSourceInput subject",
+		subject: &model.PackageOrSourceInput{},
+		wantErr: true,
+	}, {
+		name:    "PackageOrSourceInput package",
+		subject: &model.PackageOrSourceInput{Package: pkgInput},
+	}, {
+		name:         "PackageOrSourceInput package and source",
+		subject:      &model.PackageOrSourceInput{Package: pkgInput, Source: srcInput},
+		wantConflict: []string{"package", "source"},
+	}, {
+		name:    "no PackageOrArtifactInput subject",
+		subject: &model.PackageOrArtifactInput{},
+		wantErr: true,
+	}, {
+		name:    "PackageOrArtifactInput artifact",
+		subject: &model.PackageOrArtifactInput{Artifact: artifactInput},
+	}, {
+		name:         "PackageOrArtifactInput package and artifact",
+		subject:      &model.PackageOrArtifactInput{Package: pkgInput, Artifact: artifactInput},
+		wantConflict: []string{"package", "artifact"},
+	}, {
+		name:    "no PackageSourceOrArtifactSpec subject",
+		subject: &model.PackageSourceOrArtifactSpec{},
+	}, {
+		name:    "nil PackageSourceOrArtifactSpec",
+		subject: (*model.PackageSourceOrArtifactSpec)(nil),
+	}, {
+		name:    "PackageSourceOrArtifactSpec artifact",
+		subject: &model.PackageSourceOrArtifactSpec{Artifact: artifact},
+	}, {
+		name:         "PackageSourceOrArtifactSpec source and artifact",
+		subject:      &model.PackageSourceOrArtifactSpec{Source: src, Artifact: artifact},
+		wantConflict: []string{"source", "artifact"},
+	}, {
+		name:    "no PackageOrSourceSpec subject",
+		subject: &model.PackageOrSourceSpec{},
+	}, {
+		name:    "PackageOrSourceSpec source",
+		subject: &model.PackageOrSourceSpec{Source: src},
+	}, {
+		name:         "PackageOrSourceSpec package and source",
+		subject:      &model.PackageOrSourceSpec{Package: pkg, Source: src},
+		wantConflict: []string{"package", "source"},
+	}, {
+		name:    "no PackageOrArtifactSpec subject",
+		subject: &model.PackageOrArtifactSpec{},
+	}, {
+		name:    "PackageOrArtifactSpec package",
+		subject: &model.PackageOrArtifactSpec{Package: pkg},
+	}, {
+		name:         "PackageOrArtifactSpec package and artifact",
+		subject:      &model.PackageOrArtifactSpec{Package: pkg, Artifact: artifact},
+		wantConflict: []string{"package", "artifact"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSubjectSpec(tt.subject)
+			wantErr := tt.wantErr || tt.wantConflict != nil
+			if (err != nil) != wantErr {
+				t.Fatalf("ValidateSubjectSpec() error = %v, wantErr %v", err, wantErr)
+			}
+			if err == nil {
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "subject" {
+				t.Fatalf("ValidateSubjectSpec() error = %v, want a ValidationError of subject", err)
+			}
+			if tt.wantConflict != nil {
+				if want := "conflicting " + joinNames(tt.wantConflict) + " subjects"; !strings.HasPrefix(err.Error(), want) {
+					t.Errorf("ValidateSubjectSpec() error = %v, want it to start with %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSubjectSpec_UnsupportedType(t *testing.T) {
+	if err := ValidateSubjectSpec(&model.PkgSpec{}); err == nil {
+		t.Error("ValidateSubjectSpec() of a package spec succeeded, want an error")
+	}
+}
@@ -17,20 +17,32 @@ package backend
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
-	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
 )
 
 type DemoCredentials struct{}
 
-type demoClient struct{}
-
+// GetBackend returns an in-memory backend populated with demo data.
 func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
-	return &demoClient{}, nil
+	ctx := context.Background()
+	client, err := inmem.New(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := registerAllArtifacts(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := registerAllPackages(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := registerAllSources(ctx, client); err != nil {
+		return nil, err
+	}
+	return client, nil
 }
 
-func (c *demoClient) Artifacts(ctx context.Context) ([]*model.Artifact, error) {
-	panic(fmt.Errorf("not implemented: Artifacts - artifacts in testing backend"))
+func ptrfrom[T any](t T) *T {
+	return &t
 }
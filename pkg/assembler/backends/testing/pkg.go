@@ -0,0 +1,148 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func registerAllPackages(ctx context.Context, client backends.Backend) error {
+	packages := []*model.PkgInputSpec{{
+		// pkg:apk/alpine/apk@2.12.9-r3?arch=x86
+		Type:       "apk",
+		Namespace:  ptrfrom("alpine"),
+		Name:       "apk",
+		Version:    ptrfrom("2.12.9-r3"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "x86"}},
+	}, {
+		// pkg:apk/alpine/curl@7.83.0-r0?arch=x86
+		Type:       "apk",
+		Namespace:  ptrfrom("alpine"),
+		Name:       "curl",
+		Version:    ptrfrom("7.83.0-r0"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "x86"}},
+	}, {
+		// pkg:conan/openssl.org/openssl@3.0.3?user=bincrafters&channel=stable
+		Type:       "conan",
+		Namespace:  ptrfrom("openssl.org"),
+		Name:       "openssl",
+		Version:    ptrfrom("3.0.3"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "user", Value: "bincrafters"}, {Key: "channel", Value: "stable"}},
+	}, {
+		// pkg:conan/openssl@3.0.3
+		Type:    "conan",
+		Name:    "openssl",
+		Version: ptrfrom("3.0.3"),
+	}, {
+		// pkg:deb/debian/attr@1:2.4.47-2?arch=source
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "attr",
+		Version:    ptrfrom("1:2.4.47-2"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "source"}},
+	}, {
+		// pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "curl",
+		Version:    ptrfrom("7.50.3-1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "i386"}, {Key: "distro", Value: "jessie"}},
+	}, {
+		// pkg:deb/debian/dpkg@1.19.0.4?arch=amd64&distro=stretch
+		Type:       "deb",
+		Namespace:  ptrfrom("debian"),
+		Name:       "dpkg",
+		Version:    ptrfrom("1.19.0.4"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "amd64"}, {Key: "distro", Value: "stretch"}},
+	}, {
+		// pkg:deb/ubuntu/dpkg@1.19.0.4?arch=amd64
+		Type:       "deb",
+		Namespace:  ptrfrom("ubuntu"),
+		Name:       "dpkg",
+		Version:    ptrfrom("1.19.0.4"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "amd64"}},
+	}, {
+		// pkg:docker/customer/dockerimage@sha256:244fd47e07d1004f0aed9c?repository_url=gcr.io
+		Type:       "docker",
+		Namespace:  ptrfrom("customer"),
+		Name:       "dockerimage",
+		Version:    ptrfrom("sha256:244fd47e07d1004f0aed9c"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "repository_url", Value: "gcr.io"}},
+	}, {
+		// pkg:docker/smartentry/debian@dc437cc87d10
+		Type:      "docker",
+		Namespace: ptrfrom("smartentry"),
+		Name:      "debian",
+		Version:   ptrfrom("dc437cc87d10"),
+	}, {
+		// pkg:github/package-url/purl-spec@244fd47e07d1004#everybody/loves/dogs
+		Type:      "github",
+		Namespace: ptrfrom("package-url"),
+		Name:      "purl-spec",
+		Version:   ptrfrom("244fd47e07d1004"),
+		Subpath:   ptrfrom("everybody/loves/dogs"),
+	}, {
+		// pkg:golang/github.com/ethereum/go-ethereum@v1.10.11
+		Type:      "golang",
+		Namespace: ptrfrom("github.com/ethereum"),
+		Name:      "go-ethereum",
+		Version:   ptrfrom("v1.10.11"),
+	}, {
+		// pkg:golang/google.golang.org/genproto#googleapis/api/annotations
+		Type:      "golang",
+		Namespace: ptrfrom("google.golang.org"),
+		Name:      "genproto",
+		Subpath:   ptrfrom("googleapis/api/annotations"),
+	}, {
+		// pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?packaging=sources
+		Type:       "maven",
+		Namespace:  ptrfrom("org.apache.xmlgraphics"),
+		Name:       "batik-anim",
+		Version:    ptrfrom("1.9.1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "packaging", Value: "sources"}},
+	}, {
+		// pkg:npm/foobar@12.3.1
+		Type:    "npm",
+		Name:    "foobar",
+		Version: ptrfrom("12.3.1"),
+	}, {
+		// pkg:oci/debian@sha256:244fd47e07d10?repository_url=docker.io/library/debian&arch=amd64&tag=latest
+		Type:       "oci",
+		Name:       "debian",
+		Version:    ptrfrom("sha256:244fd47e07d10"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "repository_url", Value: "docker.io/library/debian"}, {Key: "arch", Value: "amd64"}, {Key: "tag", Value: "latest"}},
+	}, {
+		// pkg:pypi/django@1.11.1
+		Type:    "pypi",
+		Name:    "django",
+		Version: ptrfrom("1.11.1"),
+	}, {
+		// pkg:pypi/django@1.11.1#subpath
+		Type:    "pypi",
+		Name:    "django",
+		Version: ptrfrom("1.11.1"),
+		Subpath: ptrfrom("subpath"),
+	}}
+	for _, p := range packages {
+		if _, err := client.IngestPackage(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
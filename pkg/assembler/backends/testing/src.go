@@ -0,0 +1,56 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func registerAllSources(ctx context.Context, client backends.Backend) error {
+	sources := []*model.SourceInputSpec{{
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+		Tag:       ptrfrom("v0.0.1"),
+	}, {
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+		Commit:    ptrfrom("fcba958b73e27cad8b5c8655d46439984d27853b"),
+	}, {
+		Type:      "git",
+		Namespace: "github.com/guacsec",
+		Name:      "guac",
+	}, {
+		Type:      "git",
+		Namespace: "gitlab.com/guacsec",
+		Name:      "guacdata",
+		Tag:       ptrfrom("v0.0.1"),
+	}, {
+		Type:      "svn",
+		Namespace: "svn.apache.org/repos/asf",
+		Name:      "commons-lang",
+	}}
+	for _, s := range sources {
+		if _, err := client.IngestSource(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import "github.com/guacsec/guac/pkg/assembler/graphql/model"
+
+// ValidateSourceInput checks that the source is not pinned to both a tag and
+// a commit, returning a ValidationError of the commit field if it is.
+func ValidateSourceInput(source *model.SourceInputSpec) error {
+	source = CanonicalSourceInputSpec(source)
+	if source.Tag != nil && *source.Tag != "" && source.Commit != nil && *source.Commit != "" {
+		return NewValidationError("commit", "Passing both commit and tag selectors is an error")
+	}
+	return nil
+}
+
+// ValidateVEXStatementInput checks the status and justification of the
+// statement, returning a ValidationError of the offending field. As required
+// by the VEX specification, a not affected status must be justified.
+func ValidateVEXStatementInput(vexStatement *model.VexStatementInputSpec) error {
+	if !vexStatement.Status.IsValid() {
+		return NewValidationError("status", "invalid status %q", vexStatement.Status)
+	}
+	if !vexStatement.VexJustification.IsValid() {
+		return NewValidationError("vexJustification", "invalid justification %q", vexStatement.VexJustification)
+	}
+	if vexStatement.Status == model.VexStatusNotAffected &&
+		vexStatement.VexJustification == model.VexJustificationNotProvided && vexStatement.Statement == "" {
+		return NewValidationError("vexJustification", "a NOT_AFFECTED statement must have a justification or a statement")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestValidateSourceInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    *model.SourceInputSpec
+		wantField string
+	}{
+		{name: "tag", source: &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.1.0")}},
+		{name: "empty tag and commit", source: &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom(""), Commit: ptrfrom("  ")}},
+		{name: "tag and commit", source: &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom("v0.1.0"), Commit: ptrfrom("abcd")}, wantField: "commit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidationError(t, ValidateSourceInput(tt.source), tt.wantField)
+		})
+	}
+}
+
+func TestValidateVEXStatementInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement *model.VexStatementInputSpec
+		wantField string
+	}{
+		{name: "affected", statement: &model.VexStatementInputSpec{Status: model.VexStatusAffected, VexJustification: model.VexJustificationNotProvided}},
+		{name: "justified not affected", statement: &model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationComponentNotPresent}},
+		{name: "not affected with a statement", statement: &model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationNotProvided, Statement: "not reachable"}},
+		{name: "unjustified not affected", statement: &model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationNotProvided}, wantField: "vexJustification"},
+		{name: "invalid status", statement: &model.VexStatementInputSpec{Status: "FIXED_MAYBE", VexJustification: model.VexJustificationNotProvided}, wantField: "status"},
+		{name: "invalid justification", statement: &model.VexStatementInputSpec{Status: model.VexStatusAffected, VexJustification: "BECAUSE"}, wantField: "vexJustification"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidationError(t, ValidateVEXStatementInput(tt.statement), tt.wantField)
+		})
+	}
+}
+
+// checkValidationError checks that err is a ValidationError of wantField, or
+// nil if wantField is empty.
+func checkValidationError(t *testing.T, err error, wantField string) {
+	t.Helper()
+	if wantField == "" {
+		if err != nil {
+			t.Errorf("error = %v, want nil", err)
+		}
+		return
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != wantField {
+		t.Errorf("error = %v, want a ValidationError of %s", err, wantField)
+	}
+}
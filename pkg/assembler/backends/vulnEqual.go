@@ -0,0 +1,71 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ExpandVulnEqual returns a spec for every vulnerability ID matching
+// vulnSpec, plus every vulnerability ID transitively linked to them by
+// VulnEqual nodes. Each spec matches exactly one vulnerability ID, so
+// evidence ingested for any of the equivalent identifiers can be found by
+// querying, e.g., CertifyVuln once per spec.
+func ExpandVulnEqual(ctx context.Context, b Backend, vulnSpec *model.VulnerabilitySpec) ([]*model.VulnerabilitySpec, error) {
+	vulns, err := b.Vulnerabilities(ctx, vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*model.VulnerabilitySpec
+	seen := map[string]bool{}
+	var frontier []string
+	visit := func(v *model.Vulnerability) {
+		for _, id := range v.VulnerabilityIDs {
+			if seen[id.ID] {
+				continue
+			}
+			seen[id.ID] = true
+			frontier = append(frontier, id.ID)
+			out = append(out, &model.VulnerabilitySpec{
+				ID:              &id.ID,
+				Type:            &v.Type,
+				VulnerabilityID: &id.VulnerabilityID,
+			})
+		}
+	}
+	for _, v := range vulns {
+		visit(v)
+	}
+	for len(frontier) > 0 {
+		id := frontier[0]
+		frontier = frontier[1:]
+		equalities, err := b.VulnEqual(ctx, &model.VulnEqualSpec{
+			Vulnerabilities: []*model.VulnerabilitySpec{{ID: &id}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range equalities {
+			for _, v := range e.Vulnerabilities {
+				visit(v)
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,236 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package documents defines the GUAC ingest documents, which serialize the
+// trees stored by a backend so that they can be ingested into another one.
+package documents
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Document is a GUAC ingest document: the arguments of one ingestion of a
+// backend, of which exactly one field is set. It is serialized as JSON, as
+// one line of an NDJSON stream.
+type Document struct {
+	Artifact      *model.ArtifactInputSpec      `json:"artifact,omitempty"`
+	Builder       *model.BuilderInputSpec       `json:"builder,omitempty"`
+	Package       *model.PkgInputSpec           `json:"package,omitempty"`
+	Source        *model.SourceInputSpec        `json:"source,omitempty"`
+	Vulnerability *model.VulnerabilityInputSpec `json:"vulnerability,omitempty"`
+
+	CertifyBad     *CertifyBad     `json:"certifyBad,omitempty"`
+	CertifyGood    *CertifyGood    `json:"certifyGood,omitempty"`
+	CertifyLegal   *CertifyLegal   `json:"certifyLegal,omitempty"`
+	CertifyVuln    *CertifyVuln    `json:"certifyVuln,omitempty"`
+	HashEqual      *HashEqual      `json:"hashEqual,omitempty"`
+	HasMetadata    *HasMetadata    `json:"hasMetadata,omitempty"`
+	HasSBOM        *HasSBOM        `json:"hasSBOM,omitempty"`
+	HasSLSA        *HasSLSA        `json:"hasSLSA,omitempty"`
+	IsDependency   *IsDependency   `json:"isDependency,omitempty"`
+	IsOccurrence   *IsOccurrence   `json:"isOccurrence,omitempty"`
+	PkgEqual       *PkgEqual       `json:"pkgEqual,omitempty"`
+	PointOfContact *PointOfContact `json:"pointOfContact,omitempty"`
+	Scorecard      *Scorecard      `json:"scorecard,omitempty"`
+	VEXStatement   *VEXStatement   `json:"vexStatement,omitempty"`
+	VulnEqual      *VulnEqual      `json:"vulnEqual,omitempty"`
+}
+
+// The evidence documents hold the arguments of the matching ingestion of the
+// backends, named after its parameters.
+
+type CertifyBad struct {
+	Subject      *model.PackageSourceOrArtifactInput `json:"subject"`
+	PkgMatchType *model.PkgMatchType                 `json:"pkgMatchType,omitempty"`
+	CertifyBad   *model.CertifyBadInputSpec          `json:"certifyBad"`
+}
+
+type CertifyGood struct {
+	Subject      *model.PackageSourceOrArtifactInput `json:"subject"`
+	PkgMatchType *model.PkgMatchType                 `json:"pkgMatchType,omitempty"`
+	CertifyGood  *model.CertifyGoodInputSpec         `json:"certifyGood"`
+}
+
+type CertifyLegal struct {
+	Subject      *model.PackageOrSourceInput  `json:"subject"`
+	CertifyLegal *model.CertifyLegalInputSpec `json:"certifyLegal"`
+}
+
+type CertifyVuln struct {
+	Pkg           *model.PkgInputSpec           `json:"pkg"`
+	Vulnerability *model.VulnerabilityInputSpec `json:"vulnerability"`
+	CertifyVuln   *model.ScanMetadataInput      `json:"certifyVuln"`
+}
+
+type HashEqual struct {
+	Artifact      *model.ArtifactInputSpec  `json:"artifact"`
+	EqualArtifact *model.ArtifactInputSpec  `json:"equalArtifact"`
+	HashEqual     *model.HashEqualInputSpec `json:"hashEqual"`
+}
+
+type HasMetadata struct {
+	Subject      *model.PackageSourceOrArtifactInput `json:"subject"`
+	PkgMatchType *model.PkgMatchType                 `json:"pkgMatchType,omitempty"`
+	HasMetadata  *model.HasMetadataInputSpec         `json:"hasMetadata"`
+}
+
+type HasSBOM struct {
+	Subject *model.PackageOrArtifactInput `json:"subject"`
+	HasSbom *model.HasSBOMInputSpec       `json:"hasSbom"`
+}
+
+type HasSLSA struct {
+	Subject   *model.ArtifactInputSpec   `json:"subject"`
+	BuiltFrom []*model.ArtifactInputSpec `json:"builtFrom"`
+	BuiltBy   *model.BuilderInputSpec    `json:"builtBy"`
+	SLSA      *model.SLSAInputSpec       `json:"slsa"`
+}
+
+type IsDependency struct {
+	Pkg        *model.PkgInputSpec          `json:"pkg"`
+	DepPkg     *model.PkgInputSpec          `json:"depPkg"`
+	Dependency *model.IsDependencyInputSpec `json:"dependency"`
+}
+
+type IsOccurrence struct {
+	Subject    *model.PackageOrSourceInput  `json:"subject"`
+	Artifact   *model.ArtifactInputSpec     `json:"artifact"`
+	Occurrence *model.IsOccurrenceInputSpec `json:"occurrence"`
+}
+
+type PkgEqual struct {
+	Pkg          *model.PkgInputSpec      `json:"pkg"`
+	OtherPackage *model.PkgInputSpec      `json:"otherPackage"`
+	PkgEqual     *model.PkgEqualInputSpec `json:"pkgEqual"`
+}
+
+type PointOfContact struct {
+	Subject        *model.PackageSourceOrArtifactInput `json:"subject"`
+	PkgMatchType   *model.PkgMatchType                 `json:"pkgMatchType,omitempty"`
+	PointOfContact *model.PointOfContactInputSpec      `json:"pointOfContact"`
+}
+
+type Scorecard struct {
+	Source    *model.SourceInputSpec    `json:"source"`
+	Scorecard *model.ScorecardInputSpec `json:"scorecard"`
+}
+
+type VEXStatement struct {
+	Subject       *model.PackageOrArtifactInput `json:"subject"`
+	Vulnerability *model.VulnerabilityInputSpec `json:"vulnerability"`
+	VEXStatement  *model.VexStatementInputSpec  `json:"vexStatement"`
+}
+
+type VulnEqual struct {
+	Vulnerability      *model.VulnerabilityInputSpec `json:"vulnerability"`
+	OtherVulnerability *model.VulnerabilityInputSpec `json:"otherVulnerability"`
+	VulnEqual          *model.VulnEqualInputSpec     `json:"vulnEqual"`
+}
+
+// Kind returns the JSON name of the field set in the document, or an error if
+// not exactly one is set.
+func (d *Document) Kind() (string, error) {
+	var kinds []string
+	v := reflect.ValueOf(d).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			kinds = append(kinds, jsonName(v.Type().Field(i)))
+		}
+	}
+	if len(kinds) != 1 {
+		return "", backends.NewValidationError("document", "exactly one kind must be set in a document, got %d", len(kinds))
+	}
+	return kinds[0], nil
+}
+
+// Ingest ingests the document into the backend.
+func (d *Document) Ingest(ctx context.Context, b backends.Backend) error {
+	kind, err := d.Kind()
+	if err != nil {
+		return err
+	}
+	switch {
+	case d.Artifact != nil:
+		_, err = b.IngestArtifact(ctx, d.Artifact)
+	case d.Builder != nil:
+		_, err = b.IngestBuilder(ctx, d.Builder)
+	case d.Package != nil:
+		_, err = b.IngestPackage(ctx, d.Package)
+	case d.Source != nil:
+		_, err = b.IngestSource(ctx, d.Source)
+	case d.Vulnerability != nil:
+		_, err = b.IngestVulnerability(ctx, d.Vulnerability)
+	case d.CertifyBad != nil:
+		e := d.CertifyBad
+		_, err = b.IngestCertifyBad(ctx, e.Subject, e.PkgMatchType, e.CertifyBad)
+	case d.CertifyGood != nil:
+		e := d.CertifyGood
+		_, err = b.IngestCertifyGood(ctx, e.Subject, e.PkgMatchType, e.CertifyGood)
+	case d.CertifyLegal != nil:
+		e := d.CertifyLegal
+		_, err = b.IngestCertifyLegal(ctx, e.Subject, e.CertifyLegal)
+	case d.CertifyVuln != nil:
+		e := d.CertifyVuln
+		_, err = b.IngestCertifyVuln(ctx, e.Pkg, e.Vulnerability, e.CertifyVuln)
+	case d.HashEqual != nil:
+		e := d.HashEqual
+		_, err = b.IngestHashEqual(ctx, e.Artifact, e.EqualArtifact, e.HashEqual)
+	case d.HasMetadata != nil:
+		e := d.HasMetadata
+		_, err = b.IngestHasMetadata(ctx, e.Subject, e.PkgMatchType, e.HasMetadata)
+	case d.HasSBOM != nil:
+		e := d.HasSBOM
+		_, err = b.IngestHasSbom(ctx, e.Subject, e.HasSbom)
+	case d.HasSLSA != nil:
+		e := d.HasSLSA
+		_, err = b.IngestSLSA(ctx, e.Subject, e.BuiltFrom, e.BuiltBy, e.SLSA)
+	case d.IsDependency != nil:
+		e := d.IsDependency
+		_, err = b.IngestIsDependency(ctx, e.Pkg, e.DepPkg, e.Dependency)
+	case d.IsOccurrence != nil:
+		e := d.IsOccurrence
+		_, err = b.IngestIsOccurrence(ctx, e.Subject, e.Artifact, e.Occurrence)
+	case d.PkgEqual != nil:
+		e := d.PkgEqual
+		_, err = b.IngestPkgEqual(ctx, e.Pkg, e.OtherPackage, e.PkgEqual)
+	case d.PointOfContact != nil:
+		e := d.PointOfContact
+		_, err = b.IngestPointOfContact(ctx, e.Subject, e.PkgMatchType, e.PointOfContact)
+	case d.Scorecard != nil:
+		e := d.Scorecard
+		_, err = b.IngestScorecard(ctx, e.Source, e.Scorecard)
+	case d.VEXStatement != nil:
+		e := d.VEXStatement
+		_, err = b.IngestVEXStatement(ctx, e.Subject, e.Vulnerability, e.VEXStatement)
+	case d.VulnEqual != nil:
+		e := d.VulnEqual
+		_, err = b.IngestVulnEqual(ctx, e.Vulnerability, e.OtherVulnerability, e.VulnEqual)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to ingest %s document: %w", kind, err)
+	}
+	return nil
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
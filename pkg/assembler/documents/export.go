@@ -0,0 +1,678 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// evidenceEdges are the edges followed by ExportSubgraph: all of them but the
+// ones of the tries, so that the parents of the nodes, which are shared by all
+// the packages of a type or namespace, are not walked.
+var evidenceEdges = func() []model.Edge {
+	var edges []model.Edge
+	for _, e := range model.AllEdge {
+		switch e {
+		case model.EdgePackageTrie, model.EdgeSourceTrie, model.EdgeVulnerabilityTrie:
+		default:
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}()
+
+// ExportSubgraph returns the NDJSON stream of the documents ingesting the
+// subgraph reachable from the subjects matching rootSpec, starting from the
+// versions and names of the matching packages, the matching sources and the
+// matching artifacts. A nil rootSpec, or one with no subject set, exports all
+// the trees of the backend.
+//
+// The subgraph is walked through the evidence nodes, in both directions, so
+// it holds every evidence node connected to the subjects and the nodes those
+// reference, and so on. The package versions are connected to their names,
+// for the evidence on all the versions, but the tries are not walked further
+// up.
+//
+// The subgraph is walked as the stream is read, so that it is never held in
+// memory: the documents of each node are written to the stream as soon as the
+// walk reaches it, and each document is written once. An invalid rootSpec is
+// reported by the returned error, and the errors of the walk by the reads of
+// the stream, which must be read until it fails or ends, or until ctx is done.
+// The documents reference the nodes they need, so they can be ingested in any
+// order. The export is deterministic: the roots, then the nodes
+// reached from each node, are visited in the order of their documents, and
+// the lists in them are sorted, so that exporting the same subgraph from the
+// same roots of any backend gives the same bytes. Starting from other roots
+// gives the same documents in another order.
+func ExportSubgraph(ctx context.Context, b backends.Backend, rootSpec *model.PackageSourceOrArtifactSpec) (io.Reader, error) {
+	if err := backends.ValidateSubjectSpec(rootSpec); err != nil {
+		return nil, backends.Errorf("ExportSubgraph :: %w", err)
+	}
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pw.CloseWithError(writeSubgraph(ctx, b, pw, rootSpec))
+	}()
+	// The walk stops once ctx is done, even if it is blocked writing to a
+	// stream which is no longer read.
+	go func() {
+		select {
+		case <-ctx.Done():
+			pw.CloseWithError(ctx.Err())
+		case <-done:
+		}
+	}()
+	return pr, nil
+}
+
+// writeSubgraph writes the stream of ExportSubgraph to w.
+func writeSubgraph(ctx context.Context, b backends.Backend, w io.Writer, rootSpec *model.PackageSourceOrArtifactSpec) error {
+	if rootSpec == nil {
+		rootSpec = &model.PackageSourceOrArtifactSpec{}
+	}
+	e := &exporter{w: w, visited: map[string]bool{}, written: map[[sha256.Size]byte]bool{}}
+	allKinds := rootSpec.Package == nil && rootSpec.Source == nil && rootSpec.Artifact == nil
+
+	var roots []exportNode
+	if allKinds || rootSpec.Package != nil {
+		pkgs, err := b.Packages(ctx, rootSpec.Package)
+		if err != nil {
+			return err
+		}
+		for _, p := range pkgs {
+			nodes, err := packageExportNodes(p)
+			if err != nil {
+				return err
+			}
+			roots = append(roots, nodes...)
+		}
+	}
+	if allKinds || rootSpec.Source != nil {
+		srcs, err := b.Sources(ctx, rootSpec.Source)
+		if err != nil {
+			return err
+		}
+		for _, s := range srcs {
+			for _, n := range sourceNodes(s) {
+				node, err := newExportNode(n.id, []*Document{{Source: n.input}})
+				if err != nil {
+					return err
+				}
+				roots = append(roots, node)
+			}
+		}
+	}
+	if allKinds || rootSpec.Artifact != nil {
+		artifacts, err := b.Artifacts(ctx, rootSpec.Artifact)
+		if err != nil {
+			return err
+		}
+		for _, a := range artifacts {
+			node, err := newExportNode(a.ID, []*Document{{Artifact: artifactInput(a)}})
+			if err != nil {
+				return err
+			}
+			roots = append(roots, node)
+		}
+	}
+	if err := e.visit(roots); err != nil {
+		return err
+	}
+
+	for len(e.queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		id := e.queue[0]
+		e.queue = e.queue[1:]
+		neighbors, err := b.Neighbors(ctx, id, evidenceEdges)
+		if err != nil {
+			return err
+		}
+		var nodes []exportNode
+		for _, n := range neighbors {
+			if p, ok := n.(*model.Package); ok {
+				// Visit the name of a version too, for the evidence
+				// on all the versions.
+				pkgNodes, err := packageExportNodes(p)
+				if err != nil {
+					return err
+				}
+				nodes = append(nodes, pkgNodes...)
+				continue
+			}
+			nodeID, docs, err := nodeDocuments(n)
+			if err != nil {
+				return err
+			}
+			node, err := newExportNode(nodeID, docs)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+		}
+		if err := e.visit(nodes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportNode is a node of the subgraph with the encoded documents ingesting
+// it.
+type exportNode struct {
+	id    string
+	lines [][]byte
+	// key orders the nodes reached at the same step of the walk.
+	key string
+}
+
+func newExportNode(id string, docs []*Document) (exportNode, error) {
+	n := exportNode{id: id}
+	for _, d := range docs {
+		kind, err := d.Kind()
+		if err != nil {
+			return exportNode{}, err
+		}
+		line, err := json.Marshal(d)
+		if err != nil {
+			return exportNode{}, fmt.Errorf("failed to encode %s document: %w", kind, err)
+		}
+		n.lines = append(n.lines, line)
+		n.key += string(line)
+	}
+	return n, nil
+}
+
+// packageExportNodes returns the export nodes of the names and versions of
+// the package trie. The names, which have no document, are ordered by their
+// input.
+func packageExportNodes(p *model.Package) ([]exportNode, error) {
+	var out []exportNode
+	for _, pn := range packageNodes(p) {
+		node, err := newExportNode(pn.id, pn.documents())
+		if err != nil {
+			return nil, err
+		}
+		if !pn.version {
+			name, err := json.Marshal(pn.input)
+			if err != nil {
+				return nil, err
+			}
+			node.key = string(name)
+		}
+		out = append(out, node)
+	}
+	return out, nil
+}
+
+// exporter writes the documents of the visited nodes.
+type exporter struct {
+	w       io.Writer
+	visited map[string]bool
+	queue   []string
+	// written holds the hashes of the lines already written, as the
+	// documents of distinct nodes, such as the PkgEqual of the same packages
+	// in both orders, may be the same.
+	written map[[sha256.Size]byte]bool
+}
+
+// visit writes the documents of the nodes which have not been visited yet, in
+// the order of their keys, and queues them.
+func (e *exporter) visit(nodes []exportNode) error {
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].key < nodes[j].key })
+	for _, n := range nodes {
+		if e.visited[n.id] {
+			continue
+		}
+		e.visited[n.id] = true
+		e.queue = append(e.queue, n.id)
+		for _, line := range n.lines {
+			hash := sha256.Sum256(line)
+			if e.written[hash] {
+				continue
+			}
+			e.written[hash] = true
+			if _, err := e.w.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nodeDocuments returns the ID of a node returned by Neighbors, which is the
+// deepest one of the tries of sources and vulnerabilities, and the documents
+// ingesting it. The packages are handled by packageNodes.
+func nodeDocuments(node model.Node) (string, []*Document, error) {
+	switch n := node.(type) {
+	case *model.Source:
+		nodes := sourceNodes(n)
+		if len(nodes) == 0 {
+			return "", nil, fmt.Errorf("cannot export source %s without name", n.ID)
+		}
+		return nodes[len(nodes)-1].id, []*Document{{Source: nodes[len(nodes)-1].input}}, nil
+	case *model.Vulnerability:
+		id := n.ID
+		if len(n.VulnerabilityIDs) > 0 {
+			id = n.VulnerabilityIDs[0].ID
+		}
+		return id, []*Document{{Vulnerability: vulnInput(n)}}, nil
+	case *model.Artifact:
+		return n.ID, []*Document{{Artifact: artifactInput(n)}}, nil
+	case *model.Builder:
+		return n.ID, []*Document{{Builder: &model.BuilderInputSpec{URI: n.URI}}}, nil
+	case *model.CertifyBad:
+		subject, matchType := psaInput(n.Subject)
+		return n.ID, []*Document{{CertifyBad: &CertifyBad{
+			Subject:      subject,
+			PkgMatchType: matchType,
+			CertifyBad: &model.CertifyBadInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.CertifyGood:
+		subject, matchType := psaInput(n.Subject)
+		return n.ID, []*Document{{CertifyGood: &CertifyGood{
+			Subject:      subject,
+			PkgMatchType: matchType,
+			CertifyGood: &model.CertifyGoodInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.CertifyLegal:
+		return n.ID, []*Document{{CertifyLegal: &CertifyLegal{
+			Subject: psInput(n.Subject),
+			CertifyLegal: &model.CertifyLegalInputSpec{
+				DeclaredLicense:   n.DeclaredLicense,
+				DiscoveredLicense: n.DiscoveredLicense,
+				Attribution:       n.Attribution,
+				Justification:     n.Justification,
+				TimeScanned:       n.TimeScanned.UTC(),
+				Origin:            n.Origin,
+				Collector:         n.Collector,
+			},
+		}}}, nil
+	case *model.CertifyVuln:
+		m := n.Metadata
+		return n.ID, []*Document{{CertifyVuln: &CertifyVuln{
+			Pkg:           pkgInput(n.Package),
+			Vulnerability: vulnInput(n.Vulnerability),
+			CertifyVuln: &model.ScanMetadataInput{
+				TimeScanned:    m.TimeScanned.UTC(),
+				DbURI:          m.DbURI,
+				DbVersion:      m.DbVersion,
+				ScannerURI:     m.ScannerURI,
+				ScannerVersion: m.ScannerVersion,
+				Origin:         m.Origin,
+				Collector:      m.Collector,
+			},
+		}}}, nil
+	case *model.HashEqual:
+		if len(n.Artifacts) != 2 {
+			return "", nil, fmt.Errorf("HashEqual %s has %d artifacts, want 2", n.ID, len(n.Artifacts))
+		}
+		artifacts := []*model.ArtifactInputSpec{artifactInput(n.Artifacts[0]), artifactInput(n.Artifacts[1])}
+		sortByJSON(artifacts)
+		return n.ID, []*Document{{HashEqual: &HashEqual{
+			Artifact:      artifacts[0],
+			EqualArtifact: artifacts[1],
+			HashEqual: &model.HashEqualInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.HasMetadata:
+		subject, matchType := psaInput(n.Subject)
+		return n.ID, []*Document{{HasMetadata: &HasMetadata{
+			Subject:      subject,
+			PkgMatchType: matchType,
+			HasMetadata: &model.HasMetadataInputSpec{
+				Key:           n.Key,
+				Value:         n.Value,
+				Since:         n.Since.UTC(),
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.HasSbom:
+		return n.ID, []*Document{{HasSBOM: &HasSBOM{
+			Subject: paInput(n.Subject),
+			HasSbom: &model.HasSBOMInputSpec{
+				URI:              n.URI,
+				Algorithm:        n.Algorithm,
+				Digest:           n.Digest,
+				DownloadLocation: n.DownloadLocation,
+				Origin:           n.Origin,
+				Collector:        n.Collector,
+			},
+		}}}, nil
+	case *model.HasSlsa:
+		s := n.Slsa
+		builtFrom := make([]*model.ArtifactInputSpec, 0, len(s.BuiltFrom))
+		for _, a := range s.BuiltFrom {
+			builtFrom = append(builtFrom, artifactInput(a))
+		}
+		sortByJSON(builtFrom)
+		predicates := make([]*model.SLSAPredicateInputSpec, 0, len(s.SlsaPredicate))
+		for _, p := range s.SlsaPredicate {
+			predicates = append(predicates, &model.SLSAPredicateInputSpec{Key: p.Key, Value: p.Value})
+		}
+		sortByJSON(predicates)
+		return n.ID, []*Document{{HasSLSA: &HasSLSA{
+			Subject:   artifactInput(n.Subject),
+			BuiltFrom: builtFrom,
+			BuiltBy:   &model.BuilderInputSpec{URI: s.BuiltBy.URI},
+			SLSA: &model.SLSAInputSpec{
+				BuildType:     s.BuildType,
+				SlsaPredicate: predicates,
+				SlsaVersion:   s.SlsaVersion,
+				StartedOn:     utc(s.StartedOn),
+				FinishedOn:    utc(s.FinishedOn),
+				Origin:        s.Origin,
+				Collector:     s.Collector,
+			},
+		}}}, nil
+	case *model.IsDependency:
+		return n.ID, []*Document{{IsDependency: &IsDependency{
+			Pkg:    pkgInput(n.Package),
+			DepPkg: pkgInput(n.DependentPackage),
+			Dependency: &model.IsDependencyInputSpec{
+				VersionRange:   n.VersionRange,
+				DependencyType: n.DependencyType,
+				Justification:  n.Justification,
+				Origin:         n.Origin,
+				Collector:      n.Collector,
+			},
+		}}}, nil
+	case *model.IsOccurrence:
+		return n.ID, []*Document{{IsOccurrence: &IsOccurrence{
+			Subject:  psInput(n.Subject),
+			Artifact: artifactInput(n.Artifact),
+			Occurrence: &model.IsOccurrenceInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.PkgEqual:
+		if len(n.Packages) != 2 {
+			return "", nil, fmt.Errorf("PkgEqual %s has %d packages, want 2", n.ID, len(n.Packages))
+		}
+		pkgs := []*model.PkgInputSpec{pkgInput(n.Packages[0]), pkgInput(n.Packages[1])}
+		sortByJSON(pkgs)
+		return n.ID, []*Document{{PkgEqual: &PkgEqual{
+			Pkg:          pkgs[0],
+			OtherPackage: pkgs[1],
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.PointOfContact:
+		subject, matchType := psaInput(n.Subject)
+		return n.ID, []*Document{{PointOfContact: &PointOfContact{
+			Subject:      subject,
+			PkgMatchType: matchType,
+			PointOfContact: &model.PointOfContactInputSpec{
+				Email:         n.Email,
+				Info:          n.Info,
+				Since:         n.Since.UTC(),
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	case *model.CertifyScorecard:
+		s := n.Scorecard
+		checks := make([]*model.ScorecardCheckInputSpec, 0, len(s.Checks))
+		for _, c := range s.Checks {
+			checks = append(checks, &model.ScorecardCheckInputSpec{Check: c.Check, Score: c.Score})
+		}
+		sortByJSON(checks)
+		return n.ID, []*Document{{Scorecard: &Scorecard{
+			Source: srcInput(n.Source),
+			Scorecard: &model.ScorecardInputSpec{
+				Checks:           checks,
+				AggregateScore:   s.AggregateScore,
+				TimeScanned:      s.TimeScanned.UTC(),
+				ScorecardVersion: s.ScorecardVersion,
+				Commit:           s.Commit,
+				Origin:           s.Origin,
+				Collector:        s.Collector,
+			},
+		}}}, nil
+	case *model.CertifyVEXStatement:
+		return n.ID, []*Document{{VEXStatement: &VEXStatement{
+			Subject:       paInput(n.Subject),
+			Vulnerability: vulnInput(n.Vulnerability),
+			VEXStatement: &model.VexStatementInputSpec{
+				Status:           n.Status,
+				VexJustification: n.VexJustification,
+				Statement:        n.Statement,
+				KnownSince:       n.KnownSince.UTC(),
+				Origin:           n.Origin,
+				Collector:        n.Collector,
+			},
+		}}}, nil
+	case *model.VulnEqual:
+		if len(n.Vulnerabilities) != 2 {
+			return "", nil, fmt.Errorf("VulnEqual %s has %d vulnerabilities, want 2", n.ID, len(n.Vulnerabilities))
+		}
+		vulns := []*model.VulnerabilityInputSpec{vulnInput(n.Vulnerabilities[0]), vulnInput(n.Vulnerabilities[1])}
+		sortByJSON(vulns)
+		return n.ID, []*Document{{VulnEqual: &VulnEqual{
+			Vulnerability:      vulns[0],
+			OtherVulnerability: vulns[1],
+			VulnEqual: &model.VulnEqualInputSpec{
+				Justification: n.Justification,
+				Origin:        n.Origin,
+				Collector:     n.Collector,
+			},
+		}}}, nil
+	default:
+		return "", nil, fmt.Errorf("cannot export node of type %T", node)
+	}
+}
+
+// pkgNode is a package version or name of a package trie.
+type pkgNode struct {
+	id    string
+	input *model.PkgInputSpec
+	// version is true for the package versions.
+	version bool
+}
+
+// documents returns the document ingesting the node. Package names have none,
+// as they are created by the evidence referencing them.
+func (n pkgNode) documents() []*Document {
+	if !n.version {
+		return nil
+	}
+	return []*Document{{Package: n.input}}
+}
+
+// packageNodes returns the names and versions of the package trie, each name
+// being followed by its versions.
+func packageNodes(p *model.Package) []pkgNode {
+	var out []pkgNode
+	for _, ns := range p.Namespaces {
+		for _, name := range ns.Names {
+			namespace, pkgName := ns.Namespace, name.Name
+			out = append(out, pkgNode{
+				id:    name.ID,
+				input: &model.PkgInputSpec{Type: p.Type, Namespace: &namespace, Name: pkgName},
+			})
+			for _, v := range name.Versions {
+				version, subpath := v.Version, v.Subpath
+				qualifiers := make([]*model.PackageQualifierInputSpec, 0, len(v.Qualifiers))
+				for _, q := range v.Qualifiers {
+					qualifiers = append(qualifiers, &model.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+				}
+				sortByJSON(qualifiers)
+				out = append(out, pkgNode{
+					id: v.ID,
+					input: &model.PkgInputSpec{
+						Type:       p.Type,
+						Namespace:  &namespace,
+						Name:       pkgName,
+						Version:    &version,
+						Qualifiers: qualifiers,
+						Subpath:    &subpath,
+					},
+					version: true,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// pkgInput returns the input of the deepest node of the package trie.
+func pkgInput(p *model.Package) *model.PkgInputSpec {
+	nodes := packageNodes(p)
+	return nodes[len(nodes)-1].input
+}
+
+type srcNode struct {
+	id    string
+	input *model.SourceInputSpec
+}
+
+// sourceNodes returns the names of the source trie.
+func sourceNodes(s *model.Source) []srcNode {
+	var out []srcNode
+	for _, ns := range s.Namespaces {
+		for _, name := range ns.Names {
+			out = append(out, srcNode{
+				id: name.ID,
+				input: &model.SourceInputSpec{
+					Type:      s.Type,
+					Namespace: ns.Namespace,
+					Name:      name.Name,
+					Tag:       name.Tag,
+					Commit:    name.Commit,
+				},
+			})
+		}
+	}
+	return out
+}
+
+func srcInput(s *model.Source) *model.SourceInputSpec {
+	nodes := sourceNodes(s)
+	return nodes[len(nodes)-1].input
+}
+
+func artifactInput(a *model.Artifact) *model.ArtifactInputSpec {
+	return &model.ArtifactInputSpec{Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func vulnInput(v *model.Vulnerability) *model.VulnerabilityInputSpec {
+	input := &model.VulnerabilityInputSpec{Type: v.Type}
+	if len(v.VulnerabilityIDs) > 0 {
+		input.VulnerabilityID = v.VulnerabilityIDs[0].VulnerabilityID
+	}
+	return input
+}
+
+// psaInput returns the input of the subject, with the ALL_VERSIONS match type
+// for the package names.
+func psaInput(s model.PackageSourceOrArtifact) (*model.PackageSourceOrArtifactInput, *model.PkgMatchType) {
+	switch s := s.(type) {
+	case *model.Package:
+		nodes := packageNodes(s)
+		leaf := nodes[len(nodes)-1]
+		if leaf.version {
+			return &model.PackageSourceOrArtifactInput{Package: leaf.input}, nil
+		}
+		matchType := model.PkgMatchTypeAllVersions
+		return &model.PackageSourceOrArtifactInput{Package: leaf.input}, &matchType
+	case *model.Source:
+		return &model.PackageSourceOrArtifactInput{Source: srcInput(s)}, nil
+	case *model.Artifact:
+		return &model.PackageSourceOrArtifactInput{Artifact: artifactInput(s)}, nil
+	}
+	return &model.PackageSourceOrArtifactInput{}, nil
+}
+
+func psInput(s model.PackageOrSource) *model.PackageOrSourceInput {
+	switch s := s.(type) {
+	case *model.Package:
+		return &model.PackageOrSourceInput{Package: pkgInput(s)}
+	case *model.Source:
+		return &model.PackageOrSourceInput{Source: srcInput(s)}
+	}
+	return &model.PackageOrSourceInput{}
+}
+
+func paInput(s model.PackageOrArtifact) *model.PackageOrArtifactInput {
+	switch s := s.(type) {
+	case *model.Package:
+		return &model.PackageOrArtifactInput{Package: pkgInput(s)}
+	case *model.Artifact:
+		return &model.PackageOrArtifactInput{Artifact: artifactInput(s)}
+	}
+	return &model.PackageOrArtifactInput{}
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
+// sortByJSON sorts the elements by their JSON encoding, which cannot fail for
+// the input specs.
+func sortByJSON[T any](elements []T) {
+	keys := make([]string, len(elements))
+	for i, e := range elements {
+		b, _ := json.Marshal(e)
+		keys[i] = string(b)
+	}
+	sort.Sort(byKey[T]{elements, keys})
+}
+
+type byKey[T any] struct {
+	elements []T
+	keys     []string
+}
+
+func (s byKey[T]) Len() int           { return len(s.elements) }
+func (s byKey[T]) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s byKey[T]) Swap(i, j int) {
+	s.elements[i], s.elements[j] = s.elements[j], s.elements[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
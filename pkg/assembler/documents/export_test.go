@@ -0,0 +1,306 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package documents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](v T) *T {
+	return &v
+}
+
+var (
+	debug = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom(""), Name: "debug", Version: ptrfrom("4.3.4"), Subpath: ptrfrom("")}
+	ms    = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom(""), Name: "ms"}
+	// debugGitHub is the package of the GitHub repository of debug.
+	debugGitHub = &model.PkgInputSpec{Type: "github", Namespace: ptrfrom("debug-js"), Name: "debug", Version: ptrfrom("4.3.4"), Subpath: ptrfrom("")}
+	requests    = &model.PkgInputSpec{Type: "pypi", Namespace: ptrfrom(""), Name: "requests", Version: ptrfrom("2.28.2"), Subpath: ptrfrom("")}
+
+	debugSrc = &model.SourceInputSpec{Type: "git", Namespace: "github.com/debug-js", Name: "debug", Tag: ptrfrom("4.3.4")}
+
+	tarball  = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aef3c5bd0ddbd5e4d2e9f7f3cb98b6c3f1ca9a2b3e7e1c6f8d4a5b0c9e8f7a6b"}
+	mirror   = &model.ArtifactInputSpec{Algorithm: "sha512", Digest: "0123456789abcdef"}
+	checkout = &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "5835544ca568b757a8ecae5c153f317e5736700e"}
+
+	cve  = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2017-20165"}
+	ghsa = &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-9vvw-cc9w-f27h"}
+
+	since = time.Date(2023, time.March, 6, 12, 0, 0, 0, time.UTC)
+)
+
+// testDocuments are the trees of the tests. All of them are connected to
+// debug, but the ones of requests.
+var testDocuments = []*Document{
+	{Package: debug},
+	{IsDependency: &IsDependency{Pkg: debug, DepPkg: ms, Dependency: &model.IsDependencyInputSpec{VersionRange: "2.1.2", DependencyType: model.DependencyTypeDirect, Origin: "sbom", Collector: "test"}}},
+	{IsOccurrence: &IsOccurrence{Subject: &model.PackageOrSourceInput{Package: debug}, Artifact: tarball, Occurrence: &model.IsOccurrenceInputSpec{Justification: "npm tarball"}}},
+	{IsOccurrence: &IsOccurrence{Subject: &model.PackageOrSourceInput{Source: debugSrc}, Artifact: checkout, Occurrence: &model.IsOccurrenceInputSpec{Justification: "checkout"}}},
+	{HashEqual: &HashEqual{Artifact: tarball, EqualArtifact: mirror, HashEqual: &model.HashEqualInputSpec{Justification: "same tarball"}}},
+	{HasSBOM: &HasSBOM{Subject: &model.PackageOrArtifactInput{Artifact: tarball}, HasSbom: &model.HasSBOMInputSpec{URI: "https://example.com/debug.spdx.json", Algorithm: "sha256", Digest: "abcd", Origin: "sbom"}}},
+	{HasSLSA: &HasSLSA{
+		Subject:   tarball,
+		BuiltFrom: []*model.ArtifactInputSpec{mirror, checkout},
+		BuiltBy:   &model.BuilderInputSpec{URI: "https://github.com/actions/runner"},
+		SLSA: &model.SLSAInputSpec{
+			BuildType:     "npm",
+			SlsaPredicate: []*model.SLSAPredicateInputSpec{{Key: "slsa.buildDefinition.buildType", Value: "npm"}, {Key: "slsa.builder.id", Value: "github"}},
+			SlsaVersion:   "v1",
+			StartedOn:     &since,
+		},
+	}},
+	{CertifyVuln: &CertifyVuln{Pkg: debug, Vulnerability: cve, CertifyVuln: &model.ScanMetadataInput{TimeScanned: since, DbURI: "osv.dev", ScannerURI: "osv-scanner"}}},
+	{VulnEqual: &VulnEqual{Vulnerability: cve, OtherVulnerability: ghsa, VulnEqual: &model.VulnEqualInputSpec{Justification: "alias"}}},
+	{VEXStatement: &VEXStatement{Subject: &model.PackageOrArtifactInput{Artifact: tarball}, Vulnerability: cve, VEXStatement: &model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath, KnownSince: since}}},
+	{CertifyBad: &CertifyBad{Subject: &model.PackageSourceOrArtifactInput{Package: debug}, PkgMatchType: ptrfrom(model.PkgMatchTypeAllVersions), CertifyBad: &model.CertifyBadInputSpec{Justification: "hijacked"}}},
+	{CertifyGood: &CertifyGood{Subject: &model.PackageSourceOrArtifactInput{Package: debug}, CertifyGood: &model.CertifyGoodInputSpec{Justification: "reviewed"}}},
+	{PkgEqual: &PkgEqual{Pkg: debug, OtherPackage: debugGitHub, PkgEqual: &model.PkgEqualInputSpec{Justification: "same code"}}},
+	{PkgEqual: &PkgEqual{Pkg: debugGitHub, OtherPackage: debug, PkgEqual: &model.PkgEqualInputSpec{Justification: "same code"}}},
+	{HasMetadata: &HasMetadata{Subject: &model.PackageSourceOrArtifactInput{Artifact: checkout}, HasMetadata: &model.HasMetadataInputSpec{Key: "signed", Value: "true", Since: since}}},
+	{CertifyLegal: &CertifyLegal{Subject: &model.PackageOrSourceInput{Source: debugSrc}, CertifyLegal: &model.CertifyLegalInputSpec{DeclaredLicense: "MIT", TimeScanned: since}}},
+	{Scorecard: &Scorecard{Source: debugSrc, Scorecard: &model.ScorecardInputSpec{
+		Checks:         []*model.ScorecardCheckInputSpec{{Check: "Maintained", Score: 8}, {Check: "Binary-Artifacts", Score: 10}},
+		AggregateScore: 7.5,
+		TimeScanned:    since,
+	}}},
+	{PointOfContact: &PointOfContact{Subject: &model.PackageSourceOrArtifactInput{Source: debugSrc}, PointOfContact: &model.PointOfContactInputSpec{Email: "maintainers@example.com", Since: since}}},
+	{CertifyGood: &CertifyGood{Subject: &model.PackageSourceOrArtifactInput{Package: requests}, CertifyGood: &model.CertifyGoodInputSpec{Justification: "reviewed"}}},
+}
+
+func newBackend(t *testing.T, docs []*Document) backends.Backend {
+	t.Helper()
+	ctx := context.Background()
+	b, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range docs {
+		if err := d.Ingest(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func export(t *testing.T, b backends.Backend, rootSpec *model.PackageSourceOrArtifactSpec) []byte {
+	t.Helper()
+	r, err := ExportSubgraph(context.Background(), b, rootSpec)
+	if err != nil {
+		t.Fatalf("ExportSubgraph() error = %v", err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading the export error = %v", err)
+	}
+	return out
+}
+
+// sortedLines returns the lines of the export in order, for the exports of
+// the same subgraph from other roots.
+func sortedLines(exported []byte) []string {
+	lines := strings.Split(strings.TrimSuffix(string(exported), "\n"), "\n")
+	sort.Strings(lines)
+	return lines
+}
+
+func decode(t *testing.T, exported []byte) []*Document {
+	t.Helper()
+	var docs []*Document
+	scanner := bufio.NewScanner(bytes.NewReader(exported))
+	for scanner.Scan() {
+		var d Document
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			t.Fatalf("failed to decode %s: %v", scanner.Text(), err)
+		}
+		docs = append(docs, &d)
+	}
+	return docs
+}
+
+var debugSpec = &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{Type: ptrfrom("npm"), Name: ptrfrom("debug")}}
+
+func TestExportSubgraph_RoundTrip(t *testing.T) {
+	exported := export(t, newBackend(t, testDocuments), debugSpec)
+
+	kinds := map[string]int{}
+	for _, d := range decode(t, exported) {
+		kind, err := d.Kind()
+		if err != nil {
+			t.Fatal(err)
+		}
+		kinds[kind]++
+	}
+	// All the kinds of documents are exported, but the CertifyGood of
+	// requests and the duplicate PkgEqual.
+	want := map[string]int{
+		"artifact": 3, "builder": 1, "package": 2, "source": 1, "vulnerability": 2,
+		"certifyBad": 1, "certifyGood": 1, "certifyLegal": 1, "certifyVuln": 1,
+		"hashEqual": 1, "hasMetadata": 1, "hasSBOM": 1, "hasSLSA": 1,
+		"isDependency": 1, "isOccurrence": 2, "pkgEqual": 1, "pointOfContact": 1,
+		"scorecard": 1, "vexStatement": 1, "vulnEqual": 1,
+	}
+	if len(kinds) != len(want) {
+		t.Errorf("exported kinds %v, want %v", kinds, want)
+	}
+	for kind, n := range want {
+		if kinds[kind] != n {
+			t.Errorf("exported %d %s documents, want %d", kinds[kind], kind, n)
+		}
+	}
+	if bytes.Contains(exported, []byte("requests")) {
+		t.Errorf("exported the unconnected package requests:\n%s", exported)
+	}
+
+	// Ingesting the export into a fresh backend gives the same graph, which
+	// is all of its trees.
+	imported := newBackend(t, decode(t, exported))
+	if reexported := export(t, imported, debugSpec); !bytes.Equal(reexported, exported) {
+		t.Errorf("export after round trip =\n%s\nwant\n%s", reexported, exported)
+	}
+	if all := export(t, imported, nil); !reflect.DeepEqual(sortedLines(all), sortedLines(exported)) {
+		t.Errorf("export of all the trees after round trip =\n%s\nwant the documents of\n%s", all, exported)
+	}
+}
+
+func TestExportSubgraph_Deterministic(t *testing.T) {
+	reversed := make([]*Document, 0, len(testDocuments))
+	for i := len(testDocuments) - 1; i >= 0; i-- {
+		reversed = append(reversed, testDocuments[i])
+	}
+	exported := export(t, newBackend(t, testDocuments), debugSpec)
+	if again := export(t, newBackend(t, testDocuments), debugSpec); !bytes.Equal(again, exported) {
+		t.Errorf("second export =\n%s\nwant\n%s", again, exported)
+	}
+	// The IDs, and the order in which the backend returns the nodes, do not
+	// change the export.
+	if other := export(t, newBackend(t, reversed), debugSpec); !bytes.Equal(other, exported) {
+		t.Errorf("export of the documents ingested in reverse =\n%s\nwant\n%s", other, exported)
+	}
+	// Starting from another node of the subgraph exports the same graph.
+	fromSource := export(t, newBackend(t, testDocuments), &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{Name: ptrfrom("debug")}})
+	if !reflect.DeepEqual(sortedLines(fromSource), sortedLines(exported)) {
+		t.Errorf("export from the source =\n%s\nwant the documents of\n%s", fromSource, exported)
+	}
+}
+
+func TestExportSubgraph_Artifact(t *testing.T) {
+	b := newBackend(t, []*Document{{Artifact: tarball}, {Package: requests}})
+	exported := export(t, b, &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom(tarball.Digest)}})
+	want := `{"artifact":{"algorithm":"sha256","digest":"` + tarball.Digest + `"}}` + "\n"
+	if string(exported) != want {
+		t.Errorf("ExportSubgraph() = %s, want %s", exported, want)
+	}
+}
+
+func TestExportSubgraph_Errors(t *testing.T) {
+	b := newBackend(t, nil)
+	_, err := ExportSubgraph(context.Background(), b, &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{}, Artifact: &model.ArtifactSpec{}})
+	var validationErr *backends.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "subject" {
+		t.Errorf("ExportSubgraph() with conflicting roots error = %v, want a ValidationError of subject", err)
+	}
+
+	// The errors of the walk are returned by the reads, once the documents
+	// exported before are read.
+	neighborsErr := errors.New("neighbors failed")
+	r, err := ExportSubgraph(context.Background(), &neighborsFailingBackend{Backend: newBackend(t, testDocuments), err: neighborsErr}, debugSpec)
+	if err != nil {
+		t.Fatalf("ExportSubgraph() error = %v", err)
+	}
+	if out, err := io.ReadAll(r); !errors.Is(err, neighborsErr) || len(out) == 0 {
+		t.Errorf("reading the export of a failing backend returned %q, %v, want the roots and %v", out, err, neighborsErr)
+	}
+
+	// The walk stops once the context is done, even if the stream is not
+	// read.
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err = ExportSubgraph(ctx, newBackend(t, testDocuments), debugSpec)
+	if err != nil {
+		t.Fatalf("ExportSubgraph() error = %v", err)
+	}
+	cancel()
+	if _, err := io.ReadAll(r); !errors.Is(err, context.Canceled) {
+		t.Errorf("reading the export with cancelled context error = %v, want %v", err, context.Canceled)
+	}
+}
+
+// neighborsFailingBackend fails the Neighbors queries, after the roots of the
+// export are found.
+type neighborsFailingBackend struct {
+	backends.Backend
+	err error
+}
+
+func (b *neighborsFailingBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return nil, b.err
+}
+
+func TestDocument_Ingest(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t, nil)
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr string
+	}{{
+		name: "package",
+		doc:  `{"package":{"type":"npm","name":"debug","version":"4.3.4"}}`,
+	}, {
+		name:    "no kind",
+		doc:     `{}`,
+		wantErr: "exactly one kind",
+	}, {
+		name:    "two kinds",
+		doc:     `{"artifact":{"algorithm":"sha256","digest":"abcd"},"builder":{"uri":"https://example.com"}}`,
+		wantErr: "exactly one kind",
+	}, {
+		name:    "invalid ingestion",
+		doc:     `{"artifact":{"algorithm":"sha256","digest":"not hex"}}`,
+		wantErr: "failed to ingest artifact document",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Document
+			if err := json.Unmarshal([]byte(tt.doc), &d); err != nil {
+				t.Fatal(err)
+			}
+			err := d.Ingest(ctx, b)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Ingest() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Ingest() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
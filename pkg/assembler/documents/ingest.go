@@ -0,0 +1,135 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package documents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// DefaultWorkers is the number of documents ingested concurrently by
+// IngestStream when no worker count is given.
+const DefaultWorkers = 4
+
+// LineError is the error of the document on a line of an NDJSON stream. The
+// lines are numbered from 1.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// IngestStream ingests the documents of the NDJSON stream read from r, such
+// as the ones of ExportSubgraph, with up to workers concurrent ingestions, or
+// DefaultWorkers if workers is not positive. Blank lines are skipped.
+//
+// A line which cannot be decoded, or whose document fails to be ingested,
+// does not stop the stream: it is reported in the returned errors, which are
+// sorted by line. The stream is only read as fast as the backend ingests the
+// documents, as at most workers decoded documents wait for a worker.
+//
+// The returned error is set if reading r fails or ctx is done before the end
+// of the stream, once the documents already read are done.
+func IngestStream(ctx context.Context, b backends.Backend, r io.Reader, workers int) ([]*LineError, error) {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	s := &stream{jobs: make(chan streamJob, workers)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range s.jobs {
+				if err := job.doc.Ingest(ctx, b); err != nil {
+					s.report(job.line, err)
+				}
+			}
+		}()
+	}
+	err := s.read(ctx, r)
+	close(s.jobs)
+	wg.Wait()
+
+	sort.Slice(s.errs, func(i, j int) bool { return s.errs[i].Line < s.errs[j].Line })
+	return s.errs, err
+}
+
+type streamJob struct {
+	line int
+	doc  *Document
+}
+
+// stream holds the state shared by the reader and the workers of
+// IngestStream.
+type stream struct {
+	jobs chan streamJob
+
+	lock sync.Mutex
+	errs []*LineError
+}
+
+func (s *stream) report(line int, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.errs = append(s.errs, &LineError{Line: line, Err: err})
+}
+
+// read decodes the lines of r and sends their documents to the workers,
+// blocking while all of them are busy.
+func (s *stream) read(ctx context.Context, r io.Reader) error {
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := reader.ReadBytes('\n')
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			var doc Document
+			if decodeErr := json.Unmarshal(data, &doc); decodeErr != nil {
+				s.report(line, fmt.Errorf("malformed document: %w", decodeErr))
+			} else {
+				select {
+				case s.jobs <- streamJob{line: line, doc: &doc}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read line %d: %w", line, err)
+		}
+	}
+}
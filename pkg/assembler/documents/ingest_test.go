@@ -0,0 +1,208 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestStream(t *testing.T) {
+	// The documents are interleaved with lines which cannot be decoded or
+	// ingested, and the last line has no newline.
+	var lines []string
+	for i, d := range testDocuments {
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(data))
+		switch i {
+		case 2:
+			lines = append(lines, `{"package": {"type": "npm"`)
+		case 5:
+			lines = append(lines, "", "  ")
+		case 8:
+			lines = append(lines, `{}`)
+		case 11:
+			lines = append(lines, `["artifact"]`)
+		}
+	}
+	lines = append(lines, `{"artifact": {"algorithm": "sha256", "digest": "abcd"}, "builder": {"uri": "https://example.com"}}`)
+	stream := strings.Join(lines, "\n")
+
+	b := newBackend(t, nil)
+	errs, err := IngestStream(context.Background(), b, strings.NewReader(stream), 3)
+	if err != nil {
+		t.Fatalf("IngestStream() error = %v", err)
+	}
+	var gotLines []int
+	for _, e := range errs {
+		gotLines = append(gotLines, e.Line)
+	}
+	if diff := cmp.Diff([]int{4, 13, 17, len(lines)}, gotLines); diff != "" {
+		t.Errorf("IngestStream() error lines mismatch (-want +got):\n%s\nerrors: %v", diff, errs)
+	}
+	var syntaxErr *json.SyntaxError
+	if len(errs) > 0 && !errors.As(errs[0], &syntaxErr) {
+		t.Errorf("IngestStream() error of a truncated line = %v, want a syntax error", errs[0])
+	}
+	var validationErr *backends.ValidationError
+	if len(errs) > 1 && !errors.As(errs[1], &validationErr) {
+		t.Errorf("IngestStream() error of an empty document = %v, want a validation error", errs[1])
+	}
+
+	if got, want := export(t, b, nil), export(t, newBackend(t, testDocuments), nil); !bytes.Equal(got, want) {
+		t.Errorf("IngestStream() ingested\n%s\nwant\n%s", got, want)
+	}
+}
+
+// blockingBackend blocks the ingestion of artifacts until release is closed.
+type blockingBackend struct {
+	backends.Backend
+	release chan struct{}
+
+	active, maxActive int32
+}
+
+func (b *blockingBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	active := atomic.AddInt32(&b.active, 1)
+	defer atomic.AddInt32(&b.active, -1)
+	for {
+		max := atomic.LoadInt32(&b.maxActive)
+		if active <= max || atomic.CompareAndSwapInt32(&b.maxActive, max, active) {
+			break
+		}
+	}
+	<-b.release
+	return b.Backend.IngestArtifact(ctx, artifact)
+}
+
+func TestIngestStream_BackPressure(t *testing.T) {
+	const workers, documents = 2, 50
+	ctx := context.Background()
+	b := &blockingBackend{Backend: newBackend(t, nil), release: make(chan struct{})}
+
+	r, w := io.Pipe()
+	var written int32
+	go func() {
+		for i := 0; i < documents; i++ {
+			if _, err := fmt.Fprintf(w, `{"artifact": {"algorithm": "sha256", "digest": "%064x"}}`+"\n", i); err != nil {
+				return
+			}
+			atomic.AddInt32(&written, 1)
+		}
+		w.Close()
+	}()
+	type result struct {
+		errs []*LineError
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		errs, err := IngestStream(ctx, b, r, workers)
+		done <- result{errs, err}
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&b.active) < workers {
+		if time.Now().After(deadline) {
+			t.Fatalf("IngestStream() did not start %d workers", workers)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	// The documents being ingested, the ones waiting for a worker, and the
+	// one waiting to be sent to them.
+	if got, max := atomic.LoadInt32(&written), int32(2*workers+1); got > max {
+		t.Errorf("IngestStream() read %d documents while the backend was blocked, want at most %d", got, max)
+	}
+
+	close(b.release)
+	res := <-done
+	if res.err != nil || len(res.errs) > 0 {
+		t.Fatalf("IngestStream() = %v, %v", res.errs, res.err)
+	}
+	if b.maxActive > workers {
+		t.Errorf("IngestStream() ingested %d documents concurrently, want at most %d", b.maxActive, workers)
+	}
+	artifacts, err := b.Artifacts(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(artifacts) != documents {
+		t.Errorf("IngestStream() ingested %d artifacts, want %d", len(artifacts), documents)
+	}
+}
+
+// failingReader returns the data, then err.
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestIngestStream_Errors(t *testing.T) {
+	line := `{"artifact": {"algorithm": "sha256", "digest": "abcd"}}` + "\n"
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	readErr := errors.New("connection reset")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		r       io.Reader
+		wantErr error
+	}{{
+		name:    "context done",
+		ctx:     cancelled,
+		r:       strings.NewReader(line),
+		wantErr: context.Canceled,
+	}, {
+		name:    "read error",
+		ctx:     context.Background(),
+		r:       &failingReader{data: line + line, err: readErr},
+		wantErr: readErr,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := IngestStream(tt.ctx, newBackend(t, nil), tt.r, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("IngestStream() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -29,7 +30,9 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Mutation() MutationResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -37,13 +40,19 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	Artifact struct {
-		BuiltBy       func(childComplexity int) int
-		CollectorInfo func(childComplexity int) int
-		DependsOn     func(childComplexity int) int
-		Digest        func(childComplexity int) int
-		Name          func(childComplexity int) int
-		SourceInfo    func(childComplexity int) int
-		Tags          func(childComplexity int) int
+		Algorithm func(childComplexity int) int
+		Digest    func(childComplexity int) int
+		ID        func(childComplexity int) int
+	}
+
+	ArtifactConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ArtifactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	Attestation struct {
@@ -58,10 +67,97 @@ type ComplexityRoot struct {
 	}
 
 	Builder struct {
-		CollectorInfo func(childComplexity int) int
+		ID  func(childComplexity int) int
+		URI func(childComplexity int) int
+	}
+
+	CertifyBad struct {
+		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
-		SourceInfo    func(childComplexity int) int
-		Type          func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Subject       func(childComplexity int) int
+	}
+
+	CertifyGood struct {
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Subject       func(childComplexity int) int
+	}
+
+	CertifyLegal struct {
+		Attribution       func(childComplexity int) int
+		Collector         func(childComplexity int) int
+		DeclaredLicense   func(childComplexity int) int
+		DiscoveredLicense func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Justification     func(childComplexity int) int
+		Origin            func(childComplexity int) int
+		Subject           func(childComplexity int) int
+		TimeScanned       func(childComplexity int) int
+	}
+
+	CertifyScorecard struct {
+		ID        func(childComplexity int) int
+		Scorecard func(childComplexity int) int
+		Source    func(childComplexity int) int
+	}
+
+	CertifyVEXStatement struct {
+		Collector        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		KnownSince       func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		Statement        func(childComplexity int) int
+		Status           func(childComplexity int) int
+		Subject          func(childComplexity int) int
+		VexJustification func(childComplexity int) int
+		Vulnerability    func(childComplexity int) int
+	}
+
+	CertifyVuln struct {
+		ID            func(childComplexity int) int
+		Metadata      func(childComplexity int) int
+		Package       func(childComplexity int) int
+		Vulnerability func(childComplexity int) int
+	}
+
+	HasMetadata struct {
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Key           func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Since         func(childComplexity int) int
+		Subject       func(childComplexity int) int
+		Value         func(childComplexity int) int
+	}
+
+	HasSBOM struct {
+		Algorithm        func(childComplexity int) int
+		Collector        func(childComplexity int) int
+		Digest           func(childComplexity int) int
+		DownloadLocation func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		Subject          func(childComplexity int) int
+		URI              func(childComplexity int) int
+	}
+
+	HasSLSA struct {
+		ID      func(childComplexity int) int
+		Slsa    func(childComplexity int) int
+		Subject func(childComplexity int) int
+	}
+
+	HashEqual struct {
+		Artifacts     func(childComplexity int) int
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
 	}
 
 	Identity struct {
@@ -75,6 +171,26 @@ type ComplexityRoot struct {
 		SourceInfo    func(childComplexity int) int
 	}
 
+	IsDependency struct {
+		Collector        func(childComplexity int) int
+		DependencyType   func(childComplexity int) int
+		DependentPackage func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Justification    func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		Package          func(childComplexity int) int
+		VersionRange     func(childComplexity int) int
+	}
+
+	IsOccurrence struct {
+		Artifact      func(childComplexity int) int
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Subject       func(childComplexity int) int
+	}
+
 	Metadata struct {
 		AttachedTo    func(childComplexity int) int
 		CollectorInfo func(childComplexity int) int
@@ -84,21 +200,160 @@ type ComplexityRoot struct {
 		Type          func(childComplexity int) int
 	}
 
+	Mutation struct {
+		IngestArtifact       func(childComplexity int, artifact *model.ArtifactInputSpec) int
+		IngestArtifacts      func(childComplexity int, artifacts []*model.ArtifactInputSpec) int
+		IngestBuilder        func(childComplexity int, builder *model.BuilderInputSpec) int
+		IngestCertifyBad     func(childComplexity int, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) int
+		IngestCertifyGood    func(childComplexity int, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyGood *model.CertifyGoodInputSpec) int
+		IngestCertifyLegal   func(childComplexity int, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) int
+		IngestCertifyVuln    func(childComplexity int, pkg *model.PkgInputSpec, vulnerability *model.VulnerabilityInputSpec, certifyVuln *model.ScanMetadataInput) int
+		IngestHasMetadata    func(childComplexity int, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, hasMetadata *model.HasMetadataInputSpec) int
+		IngestHasSbom        func(childComplexity int, subject *model.PackageOrArtifactInput, hasSbom *model.HasSBOMInputSpec) int
+		IngestHashEqual      func(childComplexity int, artifact *model.ArtifactInputSpec, equalArtifact *model.ArtifactInputSpec, hashEqual *model.HashEqualInputSpec) int
+		IngestIsDependency   func(childComplexity int, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) int
+		IngestIsOccurrence   func(childComplexity int, subject *model.PackageOrSourceInput, artifact *model.ArtifactInputSpec, occurrence *model.IsOccurrenceInputSpec) int
+		IngestPackage        func(childComplexity int, pkg *model.PkgInputSpec) int
+		IngestPackages       func(childComplexity int, pkgs []*model.PkgInputSpec) int
+		IngestPkgEqual       func(childComplexity int, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) int
+		IngestPointOfContact func(childComplexity int, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) int
+		IngestScorecard      func(childComplexity int, source *model.SourceInputSpec, scorecard *model.ScorecardInputSpec) int
+		IngestSlsa           func(childComplexity int, subject *model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy *model.BuilderInputSpec, slsa *model.SLSAInputSpec) int
+		IngestSource         func(childComplexity int, source *model.SourceInputSpec) int
+		IngestSources        func(childComplexity int, sources []*model.SourceInputSpec) int
+		IngestVEXStatement   func(childComplexity int, subject *model.PackageOrArtifactInput, vulnerability *model.VulnerabilityInputSpec, vexStatement *model.VexStatementInputSpec) int
+		IngestVulnEqual      func(childComplexity int, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) int
+		IngestVulnerability  func(childComplexity int, vuln *model.VulnerabilityInputSpec) int
+	}
+
 	Package struct {
-		CPEs          func(childComplexity int) int
-		CollectorInfo func(childComplexity int) int
-		Contains      func(childComplexity int) int
-		DependsOn     func(childComplexity int) int
-		Digest        func(childComplexity int) int
-		Name          func(childComplexity int) int
-		Purl          func(childComplexity int) int
-		SourceInfo    func(childComplexity int) int
-		Tags          func(childComplexity int) int
-		Version       func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Namespaces func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
+	PackageName struct {
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Versions func(childComplexity int) int
+	}
+
+	PackageNamespace struct {
+		ID        func(childComplexity int) int
+		Names     func(childComplexity int) int
+		Namespace func(childComplexity int) int
+	}
+
+	PackageQualifier struct {
+		Key   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	PackageVersion struct {
+		ID         func(childComplexity int) int
+		Qualifiers func(childComplexity int) int
+		Subpath    func(childComplexity int) int
+		Version    func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
+	PkgEqual struct {
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Packages      func(childComplexity int) int
+	}
+
+	PointOfContact struct {
+		Collector     func(childComplexity int) int
+		Email         func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Info          func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Since         func(childComplexity int) int
+		Subject       func(childComplexity int) int
+	}
+
+	PurlPackage struct {
+		Package func(childComplexity int) int
+		Purl    func(childComplexity int) int
 	}
 
 	Query struct {
-		Artifacts func(childComplexity int) int
+		Artifacts              func(childComplexity int, artifactSpec *model.ArtifactSpec) int
+		ArtifactsList          func(childComplexity int, artifactSpec *model.ArtifactSpec, after *string, first *int) int
+		Builders               func(childComplexity int, builderSpec *model.BuilderSpec) int
+		CertifyBad             func(childComplexity int, certifyBadSpec *model.CertifyBadSpec) int
+		CertifyGood            func(childComplexity int, certifyGoodSpec *model.CertifyGoodSpec) int
+		CertifyLegal           func(childComplexity int, certifyLegalSpec *model.CertifyLegalSpec) int
+		CertifyVEXStatement    func(childComplexity int, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) int
+		CertifyVuln            func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
+		FindSBOMsByArtifact    func(childComplexity int, algorithm string, digest string) int
+		HasMetadata            func(childComplexity int, hasMetadataSpec *model.HasMetadataSpec) int
+		HasSbom                func(childComplexity int, hasSBOMSpec *model.HasSBOMSpec) int
+		HasSlsa                func(childComplexity int, hasSLSASpec *model.HasSLSASpec) int
+		HashEqual              func(childComplexity int, hashEqualSpec *model.HashEqualSpec) int
+		IsDependency           func(childComplexity int, isDependencySpec *model.IsDependencySpec) int
+		IsOccurrence           func(childComplexity int, isOccurrenceSpec *model.IsOccurrenceSpec) int
+		Neighbors              func(childComplexity int, node string, usingOnly []model.Edge) int
+		Packages               func(childComplexity int, pkgSpec *model.PkgSpec) int
+		PackagesByPurls        func(childComplexity int, purls []string) int
+		Path                   func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
+		PkgEqual               func(childComplexity int, pkgEqualSpec *model.PkgEqualSpec) int
+		PointOfContact         func(childComplexity int, pointOfContactSpec *model.PointOfContactSpec) int
+		Scorecards             func(childComplexity int, scorecardSpec *model.CertifyScorecardSpec) int
+		Sources                func(childComplexity int, sourceSpec *model.SourceSpec) int
+		TransitiveDependencies func(childComplexity int, pkgSpec model.PkgSpec, maxDepth int) int
+		VulnEqual              func(childComplexity int, vulnEqualSpec *model.VulnEqualSpec) int
+		Vulnerabilities        func(childComplexity int, vulnSpec *model.VulnerabilitySpec) int
+	}
+
+	SLSA struct {
+		BuildType     func(childComplexity int) int
+		BuiltBy       func(childComplexity int) int
+		BuiltFrom     func(childComplexity int) int
+		Collector     func(childComplexity int) int
+		FinishedOn    func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		SlsaPredicate func(childComplexity int) int
+		SlsaVersion   func(childComplexity int) int
+		StartedOn     func(childComplexity int) int
+	}
+
+	SLSAPredicate struct {
+		Key   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	ScanMetadata struct {
+		Collector      func(childComplexity int) int
+		DbURI          func(childComplexity int) int
+		DbVersion      func(childComplexity int) int
+		Origin         func(childComplexity int) int
+		ScannerURI     func(childComplexity int) int
+		ScannerVersion func(childComplexity int) int
+		TimeScanned    func(childComplexity int) int
+	}
+
+	Scorecard struct {
+		AggregateScore   func(childComplexity int) int
+		Checks           func(childComplexity int) int
+		Collector        func(childComplexity int) int
+		Commit           func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		ScorecardVersion func(childComplexity int) int
+		TimeScanned      func(childComplexity int) int
+	}
+
+	ScorecardCheck struct {
+		Check func(childComplexity int) int
+		Score func(childComplexity int) int
 	}
 
 	ScorecardPayload struct {
@@ -109,6 +364,29 @@ type ComplexityRoot struct {
 		ScorecardVersion func(childComplexity int) int
 	}
 
+	Source struct {
+		ID         func(childComplexity int) int
+		Namespaces func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
+	SourceName struct {
+		Commit func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Name   func(childComplexity int) int
+		Tag    func(childComplexity int) int
+	}
+
+	SourceNamespace struct {
+		ID        func(childComplexity int) int
+		Names     func(childComplexity int) int
+		Namespace func(childComplexity int) int
+	}
+
+	Subscription struct {
+		ArtifactIngested func(childComplexity int) int
+	}
+
 	VEXInvocation struct {
 		EventID    func(childComplexity int) int
 		Parameters func(childComplexity int) int
@@ -135,10 +413,23 @@ type ComplexityRoot struct {
 		ID      func(childComplexity int) int
 	}
 
+	VulnEqual struct {
+		Collector       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Justification   func(childComplexity int) int
+		Origin          func(childComplexity int) int
+		Vulnerabilities func(childComplexity int) int
+	}
+
 	Vulnerability struct {
-		CollectorInfo func(childComplexity int) int
-		ID            func(childComplexity int) int
-		SourceInfo    func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Type             func(childComplexity int) int
+		VulnerabilityIDs func(childComplexity int) int
+	}
+
+	VulnerabilityID struct {
+		ID              func(childComplexity int) int
+		VulnerabilityID func(childComplexity int) int
 	}
 }
 
@@ -157,54 +448,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Artifact.builtBy":
-		if e.complexity.Artifact.BuiltBy == nil {
+	case "Artifact.algorithm":
+		if e.complexity.Artifact.Algorithm == nil {
 			break
 		}
 
-		return e.complexity.Artifact.BuiltBy(childComplexity), true
+		return e.complexity.Artifact.Algorithm(childComplexity), true
 
-	case "Artifact.collectorInfo":
-		if e.complexity.Artifact.CollectorInfo == nil {
+	case "Artifact.digest":
+		if e.complexity.Artifact.Digest == nil {
 			break
 		}
 
-		return e.complexity.Artifact.CollectorInfo(childComplexity), true
+		return e.complexity.Artifact.Digest(childComplexity), true
 
-	case "Artifact.dependsOn":
-		if e.complexity.Artifact.DependsOn == nil {
+	case "Artifact.id":
+		if e.complexity.Artifact.ID == nil {
 			break
 		}
 
-		return e.complexity.Artifact.DependsOn(childComplexity), true
+		return e.complexity.Artifact.ID(childComplexity), true
 
-	case "Artifact.digest":
-		if e.complexity.Artifact.Digest == nil {
+	case "ArtifactConnection.edges":
+		if e.complexity.ArtifactConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.Artifact.Digest(childComplexity), true
+		return e.complexity.ArtifactConnection.Edges(childComplexity), true
 
-	case "Artifact.name":
-		if e.complexity.Artifact.Name == nil {
+	case "ArtifactConnection.pageInfo":
+		if e.complexity.ArtifactConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Artifact.Name(childComplexity), true
+		return e.complexity.ArtifactConnection.PageInfo(childComplexity), true
 
-	case "Artifact.sourceInfo":
-		if e.complexity.Artifact.SourceInfo == nil {
+	case "ArtifactEdge.cursor":
+		if e.complexity.ArtifactEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.Artifact.SourceInfo(childComplexity), true
+		return e.complexity.ArtifactEdge.Cursor(childComplexity), true
 
-	case "Artifact.tags":
-		if e.complexity.Artifact.Tags == nil {
+	case "ArtifactEdge.node":
+		if e.complexity.ArtifactEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.Artifact.Tags(childComplexity), true
+		return e.complexity.ArtifactEdge.Node(childComplexity), true
 
 	case "Attestation.attestedObjects":
 		if e.complexity.Attestation.AttestedObjects == nil {
@@ -262,415 +553,3935 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Attestation.Vulnerabilities(childComplexity), true
 
-	case "Builder.collectorInfo":
-		if e.complexity.Builder.CollectorInfo == nil {
+	case "Builder.id":
+		if e.complexity.Builder.ID == nil {
 			break
 		}
 
-		return e.complexity.Builder.CollectorInfo(childComplexity), true
+		return e.complexity.Builder.ID(childComplexity), true
 
-	case "Builder.id":
-		if e.complexity.Builder.ID == nil {
+	case "Builder.uri":
+		if e.complexity.Builder.URI == nil {
 			break
 		}
 
-		return e.complexity.Builder.ID(childComplexity), true
+		return e.complexity.Builder.URI(childComplexity), true
 
-	case "Builder.sourceInfo":
-		if e.complexity.Builder.SourceInfo == nil {
+	case "CertifyBad.collector":
+		if e.complexity.CertifyBad.Collector == nil {
 			break
 		}
 
-		return e.complexity.Builder.SourceInfo(childComplexity), true
+		return e.complexity.CertifyBad.Collector(childComplexity), true
 
-	case "Builder.type":
-		if e.complexity.Builder.Type == nil {
+	case "CertifyBad.id":
+		if e.complexity.CertifyBad.ID == nil {
 			break
 		}
 
-		return e.complexity.Builder.Type(childComplexity), true
+		return e.complexity.CertifyBad.ID(childComplexity), true
 
-	case "Identity.attestations":
-		if e.complexity.Identity.Attestations == nil {
+	case "CertifyBad.justification":
+		if e.complexity.CertifyBad.Justification == nil {
 			break
 		}
 
-		return e.complexity.Identity.Attestations(childComplexity), true
+		return e.complexity.CertifyBad.Justification(childComplexity), true
 
-	case "Identity.collectorInfo":
-		if e.complexity.Identity.CollectorInfo == nil {
+	case "CertifyBad.origin":
+		if e.complexity.CertifyBad.Origin == nil {
 			break
 		}
 
-		return e.complexity.Identity.CollectorInfo(childComplexity), true
+		return e.complexity.CertifyBad.Origin(childComplexity), true
 
-	case "Identity.digest":
-		if e.complexity.Identity.Digest == nil {
+	case "CertifyBad.subject":
+		if e.complexity.CertifyBad.Subject == nil {
 			break
 		}
 
-		return e.complexity.Identity.Digest(childComplexity), true
+		return e.complexity.CertifyBad.Subject(childComplexity), true
 
-	case "Identity.id":
-		if e.complexity.Identity.ID == nil {
+	case "CertifyGood.collector":
+		if e.complexity.CertifyGood.Collector == nil {
 			break
 		}
 
-		return e.complexity.Identity.ID(childComplexity), true
+		return e.complexity.CertifyGood.Collector(childComplexity), true
 
-	case "Identity.key":
-		if e.complexity.Identity.Key == nil {
+	case "CertifyGood.id":
+		if e.complexity.CertifyGood.ID == nil {
 			break
 		}
 
-		return e.complexity.Identity.Key(childComplexity), true
+		return e.complexity.CertifyGood.ID(childComplexity), true
 
-	case "Identity.keyScheme":
-		if e.complexity.Identity.KeyScheme == nil {
+	case "CertifyGood.justification":
+		if e.complexity.CertifyGood.Justification == nil {
 			break
 		}
 
-		return e.complexity.Identity.KeyScheme(childComplexity), true
+		return e.complexity.CertifyGood.Justification(childComplexity), true
 
-	case "Identity.keyType":
-		if e.complexity.Identity.KeyType == nil {
+	case "CertifyGood.origin":
+		if e.complexity.CertifyGood.Origin == nil {
 			break
 		}
 
-		return e.complexity.Identity.KeyType(childComplexity), true
+		return e.complexity.CertifyGood.Origin(childComplexity), true
 
-	case "Identity.sourceInfo":
-		if e.complexity.Identity.SourceInfo == nil {
+	case "CertifyGood.subject":
+		if e.complexity.CertifyGood.Subject == nil {
 			break
 		}
 
-		return e.complexity.Identity.SourceInfo(childComplexity), true
+		return e.complexity.CertifyGood.Subject(childComplexity), true
 
-	case "Metadata.attachedTo":
-		if e.complexity.Metadata.AttachedTo == nil {
+	case "CertifyLegal.attribution":
+		if e.complexity.CertifyLegal.Attribution == nil {
 			break
 		}
 
-		return e.complexity.Metadata.AttachedTo(childComplexity), true
+		return e.complexity.CertifyLegal.Attribution(childComplexity), true
 
-	case "Metadata.collectorInfo":
-		if e.complexity.Metadata.CollectorInfo == nil {
+	case "CertifyLegal.collector":
+		if e.complexity.CertifyLegal.Collector == nil {
 			break
 		}
 
-		return e.complexity.Metadata.CollectorInfo(childComplexity), true
+		return e.complexity.CertifyLegal.Collector(childComplexity), true
 
-	case "Metadata.id":
-		if e.complexity.Metadata.ID == nil {
+	case "CertifyLegal.declaredLicense":
+		if e.complexity.CertifyLegal.DeclaredLicense == nil {
 			break
 		}
 
-		return e.complexity.Metadata.ID(childComplexity), true
+		return e.complexity.CertifyLegal.DeclaredLicense(childComplexity), true
 
-	case "Metadata.payload":
-		if e.complexity.Metadata.Payload == nil {
+	case "CertifyLegal.discoveredLicense":
+		if e.complexity.CertifyLegal.DiscoveredLicense == nil {
 			break
 		}
 
-		return e.complexity.Metadata.Payload(childComplexity), true
+		return e.complexity.CertifyLegal.DiscoveredLicense(childComplexity), true
 
-	case "Metadata.sourceInfo":
-		if e.complexity.Metadata.SourceInfo == nil {
+	case "CertifyLegal.id":
+		if e.complexity.CertifyLegal.ID == nil {
 			break
 		}
 
-		return e.complexity.Metadata.SourceInfo(childComplexity), true
+		return e.complexity.CertifyLegal.ID(childComplexity), true
 
-	case "Metadata.type":
-		if e.complexity.Metadata.Type == nil {
+	case "CertifyLegal.justification":
+		if e.complexity.CertifyLegal.Justification == nil {
 			break
 		}
 
-		return e.complexity.Metadata.Type(childComplexity), true
+		return e.complexity.CertifyLegal.Justification(childComplexity), true
 
-	case "Package.CPEs":
-		if e.complexity.Package.CPEs == nil {
+	case "CertifyLegal.origin":
+		if e.complexity.CertifyLegal.Origin == nil {
 			break
 		}
 
-		return e.complexity.Package.CPEs(childComplexity), true
+		return e.complexity.CertifyLegal.Origin(childComplexity), true
 
-	case "Package.collectorInfo":
-		if e.complexity.Package.CollectorInfo == nil {
+	case "CertifyLegal.subject":
+		if e.complexity.CertifyLegal.Subject == nil {
 			break
 		}
 
-		return e.complexity.Package.CollectorInfo(childComplexity), true
+		return e.complexity.CertifyLegal.Subject(childComplexity), true
 
-	case "Package.contains":
-		if e.complexity.Package.Contains == nil {
+	case "CertifyLegal.timeScanned":
+		if e.complexity.CertifyLegal.TimeScanned == nil {
 			break
 		}
 
-		return e.complexity.Package.Contains(childComplexity), true
+		return e.complexity.CertifyLegal.TimeScanned(childComplexity), true
 
-	case "Package.dependsOn":
-		if e.complexity.Package.DependsOn == nil {
+	case "CertifyScorecard.id":
+		if e.complexity.CertifyScorecard.ID == nil {
 			break
 		}
 
-		return e.complexity.Package.DependsOn(childComplexity), true
+		return e.complexity.CertifyScorecard.ID(childComplexity), true
 
-	case "Package.digest":
-		if e.complexity.Package.Digest == nil {
+	case "CertifyScorecard.scorecard":
+		if e.complexity.CertifyScorecard.Scorecard == nil {
 			break
 		}
 
-		return e.complexity.Package.Digest(childComplexity), true
+		return e.complexity.CertifyScorecard.Scorecard(childComplexity), true
 
-	case "Package.name":
-		if e.complexity.Package.Name == nil {
+	case "CertifyScorecard.source":
+		if e.complexity.CertifyScorecard.Source == nil {
 			break
 		}
 
-		return e.complexity.Package.Name(childComplexity), true
+		return e.complexity.CertifyScorecard.Source(childComplexity), true
 
-	case "Package.purl":
-		if e.complexity.Package.Purl == nil {
+	case "CertifyVEXStatement.collector":
+		if e.complexity.CertifyVEXStatement.Collector == nil {
 			break
 		}
 
-		return e.complexity.Package.Purl(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Collector(childComplexity), true
 
-	case "Package.sourceInfo":
-		if e.complexity.Package.SourceInfo == nil {
+	case "CertifyVEXStatement.id":
+		if e.complexity.CertifyVEXStatement.ID == nil {
 			break
 		}
 
-		return e.complexity.Package.SourceInfo(childComplexity), true
+		return e.complexity.CertifyVEXStatement.ID(childComplexity), true
 
-	case "Package.tags":
-		if e.complexity.Package.Tags == nil {
+	case "CertifyVEXStatement.knownSince":
+		if e.complexity.CertifyVEXStatement.KnownSince == nil {
 			break
 		}
 
-		return e.complexity.Package.Tags(childComplexity), true
+		return e.complexity.CertifyVEXStatement.KnownSince(childComplexity), true
 
-	case "Package.version":
-		if e.complexity.Package.Version == nil {
+	case "CertifyVEXStatement.origin":
+		if e.complexity.CertifyVEXStatement.Origin == nil {
 			break
 		}
 
-		return e.complexity.Package.Version(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Origin(childComplexity), true
 
-	case "Query.artifacts":
-		if e.complexity.Query.Artifacts == nil {
+	case "CertifyVEXStatement.statement":
+		if e.complexity.CertifyVEXStatement.Statement == nil {
 			break
 		}
 
-		return e.complexity.Query.Artifacts(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Statement(childComplexity), true
 
-	case "ScorecardPayload.aggregate_score":
-		if e.complexity.ScorecardPayload.AggregateScore == nil {
+	case "CertifyVEXStatement.status":
+		if e.complexity.CertifyVEXStatement.Status == nil {
 			break
 		}
 
-		return e.complexity.ScorecardPayload.AggregateScore(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Status(childComplexity), true
 
-	case "ScorecardPayload.commit":
-		if e.complexity.ScorecardPayload.Commit == nil {
+	case "CertifyVEXStatement.subject":
+		if e.complexity.CertifyVEXStatement.Subject == nil {
 			break
 		}
 
-		return e.complexity.ScorecardPayload.Commit(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Subject(childComplexity), true
 
-	case "ScorecardPayload.repo":
-		if e.complexity.ScorecardPayload.Repo == nil {
+	case "CertifyVEXStatement.vexJustification":
+		if e.complexity.CertifyVEXStatement.VexJustification == nil {
 			break
 		}
 
-		return e.complexity.ScorecardPayload.Repo(childComplexity), true
+		return e.complexity.CertifyVEXStatement.VexJustification(childComplexity), true
 
-	case "ScorecardPayload.scorecard_commit":
-		if e.complexity.ScorecardPayload.ScorecardCommit == nil {
+	case "CertifyVEXStatement.vulnerability":
+		if e.complexity.CertifyVEXStatement.Vulnerability == nil {
 			break
 		}
 
-		return e.complexity.ScorecardPayload.ScorecardCommit(childComplexity), true
+		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
 
-	case "ScorecardPayload.scorecard_version":
-		if e.complexity.ScorecardPayload.ScorecardVersion == nil {
+	case "CertifyVuln.id":
+		if e.complexity.CertifyVuln.ID == nil {
 			break
 		}
 
-		return e.complexity.ScorecardPayload.ScorecardVersion(childComplexity), true
+		return e.complexity.CertifyVuln.ID(childComplexity), true
 
-	case "VEXInvocation.eventID":
-		if e.complexity.VEXInvocation.EventID == nil {
+	case "CertifyVuln.metadata":
+		if e.complexity.CertifyVuln.Metadata == nil {
 			break
 		}
 
-		return e.complexity.VEXInvocation.EventID(childComplexity), true
+		return e.complexity.CertifyVuln.Metadata(childComplexity), true
 
-	case "VEXInvocation.parameters":
-		if e.complexity.VEXInvocation.Parameters == nil {
+	case "CertifyVuln.package":
+		if e.complexity.CertifyVuln.Package == nil {
 			break
 		}
 
-		return e.complexity.VEXInvocation.Parameters(childComplexity), true
+		return e.complexity.CertifyVuln.Package(childComplexity), true
 
-	case "VEXInvocation.producerID":
-		if e.complexity.VEXInvocation.ProducerID == nil {
+	case "CertifyVuln.vulnerability":
+		if e.complexity.CertifyVuln.Vulnerability == nil {
 			break
 		}
 
-		return e.complexity.VEXInvocation.ProducerID(childComplexity), true
+		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
 
-	case "VEXInvocation.scannedOn":
-		if e.complexity.VEXInvocation.ScannedOn == nil {
+	case "HasMetadata.collector":
+		if e.complexity.HasMetadata.Collector == nil {
 			break
 		}
 
-		return e.complexity.VEXInvocation.ScannedOn(childComplexity), true
+		return e.complexity.HasMetadata.Collector(childComplexity), true
 
-	case "VEXInvocation.uri":
-		if e.complexity.VEXInvocation.URI == nil {
+	case "HasMetadata.id":
+		if e.complexity.HasMetadata.ID == nil {
 			break
 		}
 
-		return e.complexity.VEXInvocation.URI(childComplexity), true
+		return e.complexity.HasMetadata.ID(childComplexity), true
 
-	case "VEXPayload.invocation":
-		if e.complexity.VEXPayload.Invocation == nil {
+	case "HasMetadata.justification":
+		if e.complexity.HasMetadata.Justification == nil {
 			break
 		}
 
-		return e.complexity.VEXPayload.Invocation(childComplexity), true
+		return e.complexity.HasMetadata.Justification(childComplexity), true
 
-	case "VEXPayload.scanner":
-		if e.complexity.VEXPayload.Scanner == nil {
+	case "HasMetadata.key":
+		if e.complexity.HasMetadata.Key == nil {
 			break
 		}
 
-		return e.complexity.VEXPayload.Scanner(childComplexity), true
+		return e.complexity.HasMetadata.Key(childComplexity), true
 
-	case "VEXPayload.vulnerabilities":
-		if e.complexity.VEXPayload.Vulnerabilities == nil {
+	case "HasMetadata.origin":
+		if e.complexity.HasMetadata.Origin == nil {
 			break
 		}
 
-		return e.complexity.VEXPayload.Vulnerabilities(childComplexity), true
+		return e.complexity.HasMetadata.Origin(childComplexity), true
 
-	case "VEXScanner.db_uri":
-		if e.complexity.VEXScanner.DbURI == nil {
+	case "HasMetadata.since":
+		if e.complexity.HasMetadata.Since == nil {
 			break
 		}
 
-		return e.complexity.VEXScanner.DbURI(childComplexity), true
+		return e.complexity.HasMetadata.Since(childComplexity), true
 
-	case "VEXScanner.db_version":
-		if e.complexity.VEXScanner.DbVersion == nil {
+	case "HasMetadata.subject":
+		if e.complexity.HasMetadata.Subject == nil {
 			break
 		}
 
-		return e.complexity.VEXScanner.DbVersion(childComplexity), true
+		return e.complexity.HasMetadata.Subject(childComplexity), true
 
-	case "VEXScanner.uri":
-		if e.complexity.VEXScanner.URI == nil {
+	case "HasMetadata.value":
+		if e.complexity.HasMetadata.Value == nil {
 			break
 		}
 
-		return e.complexity.VEXScanner.URI(childComplexity), true
+		return e.complexity.HasMetadata.Value(childComplexity), true
 
-	case "VEXScanner.version":
-		if e.complexity.VEXScanner.Version == nil {
+	case "HasSBOM.algorithm":
+		if e.complexity.HasSBOM.Algorithm == nil {
 			break
 		}
 
-		return e.complexity.VEXScanner.Version(childComplexity), true
+		return e.complexity.HasSBOM.Algorithm(childComplexity), true
 
-	case "VEXVulnerability.aliases":
-		if e.complexity.VEXVulnerability.Aliases == nil {
+	case "HasSBOM.collector":
+		if e.complexity.HasSBOM.Collector == nil {
 			break
 		}
 
-		return e.complexity.VEXVulnerability.Aliases(childComplexity), true
+		return e.complexity.HasSBOM.Collector(childComplexity), true
 
-	case "VEXVulnerability.id":
-		if e.complexity.VEXVulnerability.ID == nil {
+	case "HasSBOM.digest":
+		if e.complexity.HasSBOM.Digest == nil {
 			break
 		}
 
-		return e.complexity.VEXVulnerability.ID(childComplexity), true
+		return e.complexity.HasSBOM.Digest(childComplexity), true
 
-	case "Vulnerability.collectorInfo":
-		if e.complexity.Vulnerability.CollectorInfo == nil {
+	case "HasSBOM.downloadLocation":
+		if e.complexity.HasSBOM.DownloadLocation == nil {
 			break
 		}
 
-		return e.complexity.Vulnerability.CollectorInfo(childComplexity), true
+		return e.complexity.HasSBOM.DownloadLocation(childComplexity), true
 
-	case "Vulnerability.id":
-		if e.complexity.Vulnerability.ID == nil {
+	case "HasSBOM.id":
+		if e.complexity.HasSBOM.ID == nil {
 			break
 		}
 
-		return e.complexity.Vulnerability.ID(childComplexity), true
+		return e.complexity.HasSBOM.ID(childComplexity), true
 
-	case "Vulnerability.sourceInfo":
-		if e.complexity.Vulnerability.SourceInfo == nil {
+	case "HasSBOM.origin":
+		if e.complexity.HasSBOM.Origin == nil {
 			break
 		}
 
-		return e.complexity.Vulnerability.SourceInfo(childComplexity), true
+		return e.complexity.HasSBOM.Origin(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "HasSBOM.subject":
+		if e.complexity.HasSBOM.Subject == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
-	first := true
+		return e.complexity.HasSBOM.Subject(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	case "HasSBOM.uri":
+		if e.complexity.HasSBOM.URI == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.HasSBOM.URI(childComplexity), true
+
+	case "HasSLSA.id":
+		if e.complexity.HasSLSA.ID == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.HasSLSA.ID(childComplexity), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-}
+	case "HasSLSA.slsa":
+		if e.complexity.HasSLSA.Slsa == nil {
+			break
+		}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
+		return e.complexity.HasSLSA.Slsa(childComplexity), true
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
+	case "HasSLSA.subject":
+		if e.complexity.HasSLSA.Subject == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.Subject(childComplexity), true
+
+	case "HashEqual.artifacts":
+		if e.complexity.HashEqual.Artifacts == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Artifacts(childComplexity), true
+
+	case "HashEqual.collector":
+		if e.complexity.HashEqual.Collector == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Collector(childComplexity), true
+
+	case "HashEqual.id":
+		if e.complexity.HashEqual.ID == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.ID(childComplexity), true
+
+	case "HashEqual.justification":
+		if e.complexity.HashEqual.Justification == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Justification(childComplexity), true
+
+	case "HashEqual.origin":
+		if e.complexity.HashEqual.Origin == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Origin(childComplexity), true
+
+	case "Identity.attestations":
+		if e.complexity.Identity.Attestations == nil {
+			break
+		}
+
+		return e.complexity.Identity.Attestations(childComplexity), true
+
+	case "Identity.collectorInfo":
+		if e.complexity.Identity.CollectorInfo == nil {
+			break
+		}
+
+		return e.complexity.Identity.CollectorInfo(childComplexity), true
+
+	case "Identity.digest":
+		if e.complexity.Identity.Digest == nil {
+			break
+		}
+
+		return e.complexity.Identity.Digest(childComplexity), true
+
+	case "Identity.id":
+		if e.complexity.Identity.ID == nil {
+			break
+		}
+
+		return e.complexity.Identity.ID(childComplexity), true
+
+	case "Identity.key":
+		if e.complexity.Identity.Key == nil {
+			break
+		}
+
+		return e.complexity.Identity.Key(childComplexity), true
+
+	case "Identity.keyScheme":
+		if e.complexity.Identity.KeyScheme == nil {
+			break
+		}
+
+		return e.complexity.Identity.KeyScheme(childComplexity), true
+
+	case "Identity.keyType":
+		if e.complexity.Identity.KeyType == nil {
+			break
+		}
+
+		return e.complexity.Identity.KeyType(childComplexity), true
+
+	case "Identity.sourceInfo":
+		if e.complexity.Identity.SourceInfo == nil {
+			break
+		}
+
+		return e.complexity.Identity.SourceInfo(childComplexity), true
+
+	case "IsDependency.collector":
+		if e.complexity.IsDependency.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Collector(childComplexity), true
+
+	case "IsDependency.dependencyType":
+		if e.complexity.IsDependency.DependencyType == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.DependencyType(childComplexity), true
+
+	case "IsDependency.dependentPackage":
+		if e.complexity.IsDependency.DependentPackage == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.DependentPackage(childComplexity), true
+
+	case "IsDependency.id":
+		if e.complexity.IsDependency.ID == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.ID(childComplexity), true
+
+	case "IsDependency.justification":
+		if e.complexity.IsDependency.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Justification(childComplexity), true
+
+	case "IsDependency.origin":
+		if e.complexity.IsDependency.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Origin(childComplexity), true
+
+	case "IsDependency.package":
+		if e.complexity.IsDependency.Package == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Package(childComplexity), true
+
+	case "IsDependency.versionRange":
+		if e.complexity.IsDependency.VersionRange == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.VersionRange(childComplexity), true
+
+	case "IsOccurrence.artifact":
+		if e.complexity.IsOccurrence.Artifact == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+
+	case "IsOccurrence.collector":
+		if e.complexity.IsOccurrence.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Collector(childComplexity), true
+
+	case "IsOccurrence.id":
+		if e.complexity.IsOccurrence.ID == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.ID(childComplexity), true
+
+	case "IsOccurrence.justification":
+		if e.complexity.IsOccurrence.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Justification(childComplexity), true
+
+	case "IsOccurrence.origin":
+		if e.complexity.IsOccurrence.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Origin(childComplexity), true
+
+	case "IsOccurrence.subject":
+		if e.complexity.IsOccurrence.Subject == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Subject(childComplexity), true
+
+	case "Metadata.attachedTo":
+		if e.complexity.Metadata.AttachedTo == nil {
+			break
+		}
+
+		return e.complexity.Metadata.AttachedTo(childComplexity), true
+
+	case "Metadata.collectorInfo":
+		if e.complexity.Metadata.CollectorInfo == nil {
+			break
+		}
+
+		return e.complexity.Metadata.CollectorInfo(childComplexity), true
+
+	case "Metadata.id":
+		if e.complexity.Metadata.ID == nil {
+			break
+		}
+
+		return e.complexity.Metadata.ID(childComplexity), true
+
+	case "Metadata.payload":
+		if e.complexity.Metadata.Payload == nil {
+			break
+		}
+
+		return e.complexity.Metadata.Payload(childComplexity), true
+
+	case "Metadata.sourceInfo":
+		if e.complexity.Metadata.SourceInfo == nil {
+			break
+		}
+
+		return e.complexity.Metadata.SourceInfo(childComplexity), true
+
+	case "Metadata.type":
+		if e.complexity.Metadata.Type == nil {
+			break
+		}
+
+		return e.complexity.Metadata.Type(childComplexity), true
+
+	case "Mutation.ingestArtifact":
+		if e.complexity.Mutation.IngestArtifact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestArtifact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestArtifact(childComplexity, args["artifact"].(*model.ArtifactInputSpec)), true
+
+	case "Mutation.ingestArtifacts":
+		if e.complexity.Mutation.IngestArtifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestArtifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestArtifacts(childComplexity, args["artifacts"].([]*model.ArtifactInputSpec)), true
+
+	case "Mutation.ingestBuilder":
+		if e.complexity.Mutation.IngestBuilder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestBuilder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestBuilder(childComplexity, args["builder"].(*model.BuilderInputSpec)), true
+
+	case "Mutation.ingestCertifyBad":
+		if e.complexity.Mutation.IngestCertifyBad == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyBad(childComplexity, args["subject"].(*model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.PkgMatchType), args["certifyBad"].(*model.CertifyBadInputSpec)), true
+
+	case "Mutation.ingestCertifyGood":
+		if e.complexity.Mutation.IngestCertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyGood(childComplexity, args["subject"].(*model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.PkgMatchType), args["certifyGood"].(*model.CertifyGoodInputSpec)), true
+
+	case "Mutation.ingestCertifyLegal":
+		if e.complexity.Mutation.IngestCertifyLegal == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyLegal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyLegal(childComplexity, args["subject"].(*model.PackageOrSourceInput), args["certifyLegal"].(*model.CertifyLegalInputSpec)), true
+
+	case "Mutation.ingestCertifyVuln":
+		if e.complexity.Mutation.IngestCertifyVuln == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyVuln_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyVuln(childComplexity, args["pkg"].(*model.PkgInputSpec), args["vulnerability"].(*model.VulnerabilityInputSpec), args["certifyVuln"].(*model.ScanMetadataInput)), true
+
+	case "Mutation.ingestHasMetadata":
+		if e.complexity.Mutation.IngestHasMetadata == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasMetadata(childComplexity, args["subject"].(*model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.PkgMatchType), args["hasMetadata"].(*model.HasMetadataInputSpec)), true
+
+	case "Mutation.ingestHasSBOM":
+		if e.complexity.Mutation.IngestHasSbom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasSBOM_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasSbom(childComplexity, args["subject"].(*model.PackageOrArtifactInput), args["hasSBOM"].(*model.HasSBOMInputSpec)), true
+
+	case "Mutation.ingestHashEqual":
+		if e.complexity.Mutation.IngestHashEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHashEqual(childComplexity, args["artifact"].(*model.ArtifactInputSpec), args["equalArtifact"].(*model.ArtifactInputSpec), args["hashEqual"].(*model.HashEqualInputSpec)), true
+
+	case "Mutation.ingestIsDependency":
+		if e.complexity.Mutation.IngestIsDependency == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestIsDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestIsDependency(childComplexity, args["pkg"].(*model.PkgInputSpec), args["depPkg"].(*model.PkgInputSpec), args["dependency"].(*model.IsDependencyInputSpec)), true
+
+	case "Mutation.ingestIsOccurrence":
+		if e.complexity.Mutation.IngestIsOccurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestIsOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestIsOccurrence(childComplexity, args["subject"].(*model.PackageOrSourceInput), args["artifact"].(*model.ArtifactInputSpec), args["occurrence"].(*model.IsOccurrenceInputSpec)), true
+
+	case "Mutation.ingestPackage":
+		if e.complexity.Mutation.IngestPackage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPackage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPackage(childComplexity, args["pkg"].(*model.PkgInputSpec)), true
+
+	case "Mutation.ingestPackages":
+		if e.complexity.Mutation.IngestPackages == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPackages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPackages(childComplexity, args["pkgs"].([]*model.PkgInputSpec)), true
+
+	case "Mutation.ingestPkgEqual":
+		if e.complexity.Mutation.IngestPkgEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPkgEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPkgEqual(childComplexity, args["pkg"].(*model.PkgInputSpec), args["otherPackage"].(*model.PkgInputSpec), args["pkgEqual"].(*model.PkgEqualInputSpec)), true
+
+	case "Mutation.ingestPointOfContact":
+		if e.complexity.Mutation.IngestPointOfContact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPointOfContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPointOfContact(childComplexity, args["subject"].(*model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.PkgMatchType), args["pointOfContact"].(*model.PointOfContactInputSpec)), true
+
+	case "Mutation.ingestScorecard":
+		if e.complexity.Mutation.IngestScorecard == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestScorecard_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestScorecard(childComplexity, args["source"].(*model.SourceInputSpec), args["scorecard"].(*model.ScorecardInputSpec)), true
+
+	case "Mutation.ingestSLSA":
+		if e.complexity.Mutation.IngestSlsa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSLSA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSlsa(childComplexity, args["subject"].(*model.ArtifactInputSpec), args["builtFrom"].([]*model.ArtifactInputSpec), args["builtBy"].(*model.BuilderInputSpec), args["slsa"].(*model.SLSAInputSpec)), true
+
+	case "Mutation.ingestSource":
+		if e.complexity.Mutation.IngestSource == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSource_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSource(childComplexity, args["source"].(*model.SourceInputSpec)), true
+
+	case "Mutation.ingestSources":
+		if e.complexity.Mutation.IngestSources == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSources_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSources(childComplexity, args["sources"].([]*model.SourceInputSpec)), true
+
+	case "Mutation.ingestVEXStatement":
+		if e.complexity.Mutation.IngestVEXStatement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVEXStatement_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVEXStatement(childComplexity, args["subject"].(*model.PackageOrArtifactInput), args["vulnerability"].(*model.VulnerabilityInputSpec), args["vexStatement"].(*model.VexStatementInputSpec)), true
+
+	case "Mutation.ingestVulnEqual":
+		if e.complexity.Mutation.IngestVulnEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVulnEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVulnEqual(childComplexity, args["vulnerability"].(*model.VulnerabilityInputSpec), args["otherVulnerability"].(*model.VulnerabilityInputSpec), args["vulnEqual"].(*model.VulnEqualInputSpec)), true
+
+	case "Mutation.ingestVulnerability":
+		if e.complexity.Mutation.IngestVulnerability == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVulnerability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVulnerability(childComplexity, args["vuln"].(*model.VulnerabilityInputSpec)), true
+
+	case "Package.id":
+		if e.complexity.Package.ID == nil {
+			break
+		}
+
+		return e.complexity.Package.ID(childComplexity), true
+
+	case "Package.namespaces":
+		if e.complexity.Package.Namespaces == nil {
+			break
+		}
+
+		return e.complexity.Package.Namespaces(childComplexity), true
+
+	case "Package.type":
+		if e.complexity.Package.Type == nil {
+			break
+		}
+
+		return e.complexity.Package.Type(childComplexity), true
+
+	case "PackageName.id":
+		if e.complexity.PackageName.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageName.ID(childComplexity), true
+
+	case "PackageName.name":
+		if e.complexity.PackageName.Name == nil {
+			break
+		}
+
+		return e.complexity.PackageName.Name(childComplexity), true
+
+	case "PackageName.versions":
+		if e.complexity.PackageName.Versions == nil {
+			break
+		}
+
+		return e.complexity.PackageName.Versions(childComplexity), true
+
+	case "PackageNamespace.id":
+		if e.complexity.PackageNamespace.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.ID(childComplexity), true
+
+	case "PackageNamespace.names":
+		if e.complexity.PackageNamespace.Names == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.Names(childComplexity), true
+
+	case "PackageNamespace.namespace":
+		if e.complexity.PackageNamespace.Namespace == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.Namespace(childComplexity), true
+
+	case "PackageQualifier.key":
+		if e.complexity.PackageQualifier.Key == nil {
+			break
+		}
+
+		return e.complexity.PackageQualifier.Key(childComplexity), true
+
+	case "PackageQualifier.value":
+		if e.complexity.PackageQualifier.Value == nil {
+			break
+		}
+
+		return e.complexity.PackageQualifier.Value(childComplexity), true
+
+	case "PackageVersion.id":
+		if e.complexity.PackageVersion.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.ID(childComplexity), true
+
+	case "PackageVersion.qualifiers":
+		if e.complexity.PackageVersion.Qualifiers == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Qualifiers(childComplexity), true
+
+	case "PackageVersion.subpath":
+		if e.complexity.PackageVersion.Subpath == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Subpath(childComplexity), true
+
+	case "PackageVersion.version":
+		if e.complexity.PackageVersion.Version == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Version(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PkgEqual.collector":
+		if e.complexity.PkgEqual.Collector == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Collector(childComplexity), true
+
+	case "PkgEqual.id":
+		if e.complexity.PkgEqual.ID == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.ID(childComplexity), true
+
+	case "PkgEqual.justification":
+		if e.complexity.PkgEqual.Justification == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Justification(childComplexity), true
+
+	case "PkgEqual.origin":
+		if e.complexity.PkgEqual.Origin == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Origin(childComplexity), true
+
+	case "PkgEqual.packages":
+		if e.complexity.PkgEqual.Packages == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Packages(childComplexity), true
+
+	case "PointOfContact.collector":
+		if e.complexity.PointOfContact.Collector == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Collector(childComplexity), true
+
+	case "PointOfContact.email":
+		if e.complexity.PointOfContact.Email == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Email(childComplexity), true
+
+	case "PointOfContact.id":
+		if e.complexity.PointOfContact.ID == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.ID(childComplexity), true
+
+	case "PointOfContact.info":
+		if e.complexity.PointOfContact.Info == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Info(childComplexity), true
+
+	case "PointOfContact.justification":
+		if e.complexity.PointOfContact.Justification == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Justification(childComplexity), true
+
+	case "PointOfContact.origin":
+		if e.complexity.PointOfContact.Origin == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Origin(childComplexity), true
+
+	case "PointOfContact.since":
+		if e.complexity.PointOfContact.Since == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Since(childComplexity), true
+
+	case "PointOfContact.subject":
+		if e.complexity.PointOfContact.Subject == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Subject(childComplexity), true
+
+	case "PurlPackage.package":
+		if e.complexity.PurlPackage.Package == nil {
+			break
+		}
+
+		return e.complexity.PurlPackage.Package(childComplexity), true
+
+	case "PurlPackage.purl":
+		if e.complexity.PurlPackage.Purl == nil {
+			break
+		}
+
+		return e.complexity.PurlPackage.Purl(childComplexity), true
+
+	case "Query.artifacts":
+		if e.complexity.Query.Artifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(*model.ArtifactSpec)), true
+
+	case "Query.artifactsList":
+		if e.complexity.Query.ArtifactsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifactsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArtifactsList(childComplexity, args["artifactSpec"].(*model.ArtifactSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.builders":
+		if e.complexity.Query.Builders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_builders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(*model.BuilderSpec)), true
+
+	case "Query.CertifyBad":
+		if e.complexity.Query.CertifyBad == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(*model.CertifyBadSpec)), true
+
+	case "Query.CertifyGood":
+		if e.complexity.Query.CertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyGood(childComplexity, args["certifyGoodSpec"].(*model.CertifyGoodSpec)), true
+
+	case "Query.CertifyLegal":
+		if e.complexity.Query.CertifyLegal == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyLegal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyLegal(childComplexity, args["certifyLegalSpec"].(*model.CertifyLegalSpec)), true
+
+	case "Query.CertifyVEXStatement":
+		if e.complexity.Query.CertifyVEXStatement == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVEXStatement_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(*model.CertifyVEXStatementSpec)), true
+
+	case "Query.CertifyVuln":
+		if e.complexity.Query.CertifyVuln == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVuln_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
+
+	case "Query.findSBOMsByArtifact":
+		if e.complexity.Query.FindSBOMsByArtifact == nil {
+			break
+		}
+
+		args, err := ec.field_Query_findSBOMsByArtifact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FindSBOMsByArtifact(childComplexity, args["algorithm"].(string), args["digest"].(string)), true
+
+	case "Query.HasMetadata":
+		if e.complexity.Query.HasMetadata == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasMetadata(childComplexity, args["hasMetadataSpec"].(*model.HasMetadataSpec)), true
+
+	case "Query.HasSBOM":
+		if e.complexity.Query.HasSbom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSBOM_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSbom(childComplexity, args["hasSBOMSpec"].(*model.HasSBOMSpec)), true
+
+	case "Query.HasSLSA":
+		if e.complexity.Query.HasSlsa == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSLSA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSlsa(childComplexity, args["hasSLSASpec"].(*model.HasSLSASpec)), true
+
+	case "Query.HashEqual":
+		if e.complexity.Query.HashEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(*model.HashEqualSpec)), true
+
+	case "Query.IsDependency":
+		if e.complexity.Query.IsDependency == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
+
+	case "Query.IsOccurrence":
+		if e.complexity.Query.IsOccurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(*model.IsOccurrenceSpec)), true
+
+	case "Query.neighbors":
+		if e.complexity.Query.Neighbors == nil {
+			break
+		}
+
+		args, err := ec.field_Query_neighbors_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Neighbors(childComplexity, args["node"].(string), args["usingOnly"].([]model.Edge)), true
+
+	case "Query.packages":
+		if e.complexity.Query.Packages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+
+	case "Query.packagesByPurls":
+		if e.complexity.Query.PackagesByPurls == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packagesByPurls_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PackagesByPurls(childComplexity, args["purls"].([]string)), true
+
+	case "Query.path":
+		if e.complexity.Query.Path == nil {
+			break
+		}
+
+		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Path(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int), args["usingOnly"].([]model.Edge)), true
+
+	case "Query.PkgEqual":
+		if e.complexity.Query.PkgEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PkgEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PkgEqual(childComplexity, args["pkgEqualSpec"].(*model.PkgEqualSpec)), true
+
+	case "Query.PointOfContact":
+		if e.complexity.Query.PointOfContact == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PointOfContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PointOfContact(childComplexity, args["pointOfContactSpec"].(*model.PointOfContactSpec)), true
+
+	case "Query.scorecards":
+		if e.complexity.Query.Scorecards == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(*model.CertifyScorecardSpec)), true
+
+	case "Query.sources":
+		if e.complexity.Query.Sources == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
+
+	case "Query.transitiveDependencies":
+		if e.complexity.Query.TransitiveDependencies == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transitiveDependencies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TransitiveDependencies(childComplexity, args["pkgSpec"].(model.PkgSpec), args["maxDepth"].(int)), true
+
+	case "Query.VulnEqual":
+		if e.complexity.Query.VulnEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Query_VulnEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnEqual(childComplexity, args["vulnEqualSpec"].(*model.VulnEqualSpec)), true
+
+	case "Query.vulnerabilities":
+		if e.complexity.Query.Vulnerabilities == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(*model.VulnerabilitySpec)), true
+
+	case "SLSA.buildType":
+		if e.complexity.SLSA.BuildType == nil {
+			break
+		}
+
+		return e.complexity.SLSA.BuildType(childComplexity), true
+
+	case "SLSA.builtBy":
+		if e.complexity.SLSA.BuiltBy == nil {
+			break
+		}
+
+		return e.complexity.SLSA.BuiltBy(childComplexity), true
+
+	case "SLSA.builtFrom":
+		if e.complexity.SLSA.BuiltFrom == nil {
+			break
+		}
+
+		return e.complexity.SLSA.BuiltFrom(childComplexity), true
+
+	case "SLSA.collector":
+		if e.complexity.SLSA.Collector == nil {
+			break
+		}
+
+		return e.complexity.SLSA.Collector(childComplexity), true
+
+	case "SLSA.finishedOn":
+		if e.complexity.SLSA.FinishedOn == nil {
+			break
+		}
+
+		return e.complexity.SLSA.FinishedOn(childComplexity), true
+
+	case "SLSA.origin":
+		if e.complexity.SLSA.Origin == nil {
+			break
+		}
+
+		return e.complexity.SLSA.Origin(childComplexity), true
+
+	case "SLSA.slsaPredicate":
+		if e.complexity.SLSA.SlsaPredicate == nil {
+			break
+		}
+
+		return e.complexity.SLSA.SlsaPredicate(childComplexity), true
+
+	case "SLSA.slsaVersion":
+		if e.complexity.SLSA.SlsaVersion == nil {
+			break
+		}
+
+		return e.complexity.SLSA.SlsaVersion(childComplexity), true
+
+	case "SLSA.startedOn":
+		if e.complexity.SLSA.StartedOn == nil {
+			break
+		}
+
+		return e.complexity.SLSA.StartedOn(childComplexity), true
+
+	case "SLSAPredicate.key":
+		if e.complexity.SLSAPredicate.Key == nil {
+			break
+		}
+
+		return e.complexity.SLSAPredicate.Key(childComplexity), true
+
+	case "SLSAPredicate.value":
+		if e.complexity.SLSAPredicate.Value == nil {
+			break
+		}
+
+		return e.complexity.SLSAPredicate.Value(childComplexity), true
+
+	case "ScanMetadata.collector":
+		if e.complexity.ScanMetadata.Collector == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.Collector(childComplexity), true
+
+	case "ScanMetadata.dbUri":
+		if e.complexity.ScanMetadata.DbURI == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.DbURI(childComplexity), true
+
+	case "ScanMetadata.dbVersion":
+		if e.complexity.ScanMetadata.DbVersion == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.DbVersion(childComplexity), true
+
+	case "ScanMetadata.origin":
+		if e.complexity.ScanMetadata.Origin == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.Origin(childComplexity), true
+
+	case "ScanMetadata.scannerUri":
+		if e.complexity.ScanMetadata.ScannerURI == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.ScannerURI(childComplexity), true
+
+	case "ScanMetadata.scannerVersion":
+		if e.complexity.ScanMetadata.ScannerVersion == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.ScannerVersion(childComplexity), true
+
+	case "ScanMetadata.timeScanned":
+		if e.complexity.ScanMetadata.TimeScanned == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.TimeScanned(childComplexity), true
+
+	case "Scorecard.aggregateScore":
+		if e.complexity.Scorecard.AggregateScore == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.AggregateScore(childComplexity), true
+
+	case "Scorecard.checks":
+		if e.complexity.Scorecard.Checks == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Checks(childComplexity), true
+
+	case "Scorecard.collector":
+		if e.complexity.Scorecard.Collector == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Collector(childComplexity), true
+
+	case "Scorecard.commit":
+		if e.complexity.Scorecard.Commit == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Commit(childComplexity), true
+
+	case "Scorecard.origin":
+		if e.complexity.Scorecard.Origin == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Origin(childComplexity), true
+
+	case "Scorecard.scorecardVersion":
+		if e.complexity.Scorecard.ScorecardVersion == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.ScorecardVersion(childComplexity), true
+
+	case "Scorecard.timeScanned":
+		if e.complexity.Scorecard.TimeScanned == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.TimeScanned(childComplexity), true
+
+	case "ScorecardCheck.check":
+		if e.complexity.ScorecardCheck.Check == nil {
+			break
+		}
+
+		return e.complexity.ScorecardCheck.Check(childComplexity), true
+
+	case "ScorecardCheck.score":
+		if e.complexity.ScorecardCheck.Score == nil {
+			break
+		}
+
+		return e.complexity.ScorecardCheck.Score(childComplexity), true
+
+	case "ScorecardPayload.aggregate_score":
+		if e.complexity.ScorecardPayload.AggregateScore == nil {
+			break
+		}
+
+		return e.complexity.ScorecardPayload.AggregateScore(childComplexity), true
+
+	case "ScorecardPayload.commit":
+		if e.complexity.ScorecardPayload.Commit == nil {
+			break
+		}
+
+		return e.complexity.ScorecardPayload.Commit(childComplexity), true
+
+	case "ScorecardPayload.repo":
+		if e.complexity.ScorecardPayload.Repo == nil {
+			break
+		}
+
+		return e.complexity.ScorecardPayload.Repo(childComplexity), true
+
+	case "ScorecardPayload.scorecard_commit":
+		if e.complexity.ScorecardPayload.ScorecardCommit == nil {
+			break
+		}
+
+		return e.complexity.ScorecardPayload.ScorecardCommit(childComplexity), true
+
+	case "ScorecardPayload.scorecard_version":
+		if e.complexity.ScorecardPayload.ScorecardVersion == nil {
+			break
+		}
+
+		return e.complexity.ScorecardPayload.ScorecardVersion(childComplexity), true
+
+	case "Source.id":
+		if e.complexity.Source.ID == nil {
+			break
+		}
+
+		return e.complexity.Source.ID(childComplexity), true
+
+	case "Source.namespaces":
+		if e.complexity.Source.Namespaces == nil {
+			break
+		}
+
+		return e.complexity.Source.Namespaces(childComplexity), true
+
+	case "Source.type":
+		if e.complexity.Source.Type == nil {
+			break
+		}
+
+		return e.complexity.Source.Type(childComplexity), true
+
+	case "SourceName.commit":
+		if e.complexity.SourceName.Commit == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Commit(childComplexity), true
+
+	case "SourceName.id":
+		if e.complexity.SourceName.ID == nil {
+			break
+		}
+
+		return e.complexity.SourceName.ID(childComplexity), true
+
+	case "SourceName.name":
+		if e.complexity.SourceName.Name == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Name(childComplexity), true
+
+	case "SourceName.tag":
+		if e.complexity.SourceName.Tag == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Tag(childComplexity), true
+
+	case "SourceNamespace.id":
+		if e.complexity.SourceNamespace.ID == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.ID(childComplexity), true
+
+	case "SourceNamespace.names":
+		if e.complexity.SourceNamespace.Names == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.Names(childComplexity), true
+
+	case "SourceNamespace.namespace":
+		if e.complexity.SourceNamespace.Namespace == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.Namespace(childComplexity), true
+
+	case "Subscription.artifactIngested":
+		if e.complexity.Subscription.ArtifactIngested == nil {
+			break
+		}
+
+		return e.complexity.Subscription.ArtifactIngested(childComplexity), true
+
+	case "VEXInvocation.eventID":
+		if e.complexity.VEXInvocation.EventID == nil {
+			break
+		}
+
+		return e.complexity.VEXInvocation.EventID(childComplexity), true
+
+	case "VEXInvocation.parameters":
+		if e.complexity.VEXInvocation.Parameters == nil {
+			break
+		}
+
+		return e.complexity.VEXInvocation.Parameters(childComplexity), true
+
+	case "VEXInvocation.producerID":
+		if e.complexity.VEXInvocation.ProducerID == nil {
+			break
+		}
+
+		return e.complexity.VEXInvocation.ProducerID(childComplexity), true
+
+	case "VEXInvocation.scannedOn":
+		if e.complexity.VEXInvocation.ScannedOn == nil {
+			break
+		}
+
+		return e.complexity.VEXInvocation.ScannedOn(childComplexity), true
+
+	case "VEXInvocation.uri":
+		if e.complexity.VEXInvocation.URI == nil {
+			break
+		}
+
+		return e.complexity.VEXInvocation.URI(childComplexity), true
+
+	case "VEXPayload.invocation":
+		if e.complexity.VEXPayload.Invocation == nil {
+			break
+		}
+
+		return e.complexity.VEXPayload.Invocation(childComplexity), true
+
+	case "VEXPayload.scanner":
+		if e.complexity.VEXPayload.Scanner == nil {
+			break
+		}
+
+		return e.complexity.VEXPayload.Scanner(childComplexity), true
+
+	case "VEXPayload.vulnerabilities":
+		if e.complexity.VEXPayload.Vulnerabilities == nil {
+			break
+		}
+
+		return e.complexity.VEXPayload.Vulnerabilities(childComplexity), true
+
+	case "VEXScanner.db_uri":
+		if e.complexity.VEXScanner.DbURI == nil {
+			break
+		}
+
+		return e.complexity.VEXScanner.DbURI(childComplexity), true
+
+	case "VEXScanner.db_version":
+		if e.complexity.VEXScanner.DbVersion == nil {
+			break
+		}
+
+		return e.complexity.VEXScanner.DbVersion(childComplexity), true
+
+	case "VEXScanner.uri":
+		if e.complexity.VEXScanner.URI == nil {
+			break
+		}
+
+		return e.complexity.VEXScanner.URI(childComplexity), true
+
+	case "VEXScanner.version":
+		if e.complexity.VEXScanner.Version == nil {
+			break
+		}
+
+		return e.complexity.VEXScanner.Version(childComplexity), true
+
+	case "VEXVulnerability.aliases":
+		if e.complexity.VEXVulnerability.Aliases == nil {
+			break
+		}
+
+		return e.complexity.VEXVulnerability.Aliases(childComplexity), true
+
+	case "VEXVulnerability.id":
+		if e.complexity.VEXVulnerability.ID == nil {
+			break
+		}
+
+		return e.complexity.VEXVulnerability.ID(childComplexity), true
+
+	case "VulnEqual.collector":
+		if e.complexity.VulnEqual.Collector == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Collector(childComplexity), true
+
+	case "VulnEqual.id":
+		if e.complexity.VulnEqual.ID == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.ID(childComplexity), true
+
+	case "VulnEqual.justification":
+		if e.complexity.VulnEqual.Justification == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Justification(childComplexity), true
+
+	case "VulnEqual.origin":
+		if e.complexity.VulnEqual.Origin == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Origin(childComplexity), true
+
+	case "VulnEqual.vulnerabilities":
+		if e.complexity.VulnEqual.Vulnerabilities == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Vulnerabilities(childComplexity), true
+
+	case "Vulnerability.id":
+		if e.complexity.Vulnerability.ID == nil {
+			break
+		}
+
+		return e.complexity.Vulnerability.ID(childComplexity), true
+
+	case "Vulnerability.type":
+		if e.complexity.Vulnerability.Type == nil {
+			break
+		}
+
+		return e.complexity.Vulnerability.Type(childComplexity), true
+
+	case "Vulnerability.vulnerabilityIDs":
+		if e.complexity.Vulnerability.VulnerabilityIDs == nil {
+			break
+		}
+
+		return e.complexity.Vulnerability.VulnerabilityIDs(childComplexity), true
+
+	case "VulnerabilityID.id":
+		if e.complexity.VulnerabilityID.ID == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityID.ID(childComplexity), true
+
+	case "VulnerabilityID.vulnerabilityID":
+		if e.complexity.VulnerabilityID.VulnerabilityID == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityID.VulnerabilityID(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputArtifactInputSpec,
+		ec.unmarshalInputArtifactSpec,
+		ec.unmarshalInputBuilderInputSpec,
+		ec.unmarshalInputBuilderSpec,
+		ec.unmarshalInputCertifyBadInputSpec,
+		ec.unmarshalInputCertifyBadSpec,
+		ec.unmarshalInputCertifyGoodInputSpec,
+		ec.unmarshalInputCertifyGoodSpec,
+		ec.unmarshalInputCertifyLegalInputSpec,
+		ec.unmarshalInputCertifyLegalSpec,
+		ec.unmarshalInputCertifyScorecardSpec,
+		ec.unmarshalInputCertifyVEXStatementSpec,
+		ec.unmarshalInputCertifyVulnSpec,
+		ec.unmarshalInputHasMetadataInputSpec,
+		ec.unmarshalInputHasMetadataSpec,
+		ec.unmarshalInputHasSBOMInputSpec,
+		ec.unmarshalInputHasSBOMSpec,
+		ec.unmarshalInputHasSLSASpec,
+		ec.unmarshalInputHashEqualInputSpec,
+		ec.unmarshalInputHashEqualSpec,
+		ec.unmarshalInputIsDependencyInputSpec,
+		ec.unmarshalInputIsDependencySpec,
+		ec.unmarshalInputIsOccurrenceInputSpec,
+		ec.unmarshalInputIsOccurrenceSpec,
+		ec.unmarshalInputPackageOrArtifactInput,
+		ec.unmarshalInputPackageOrArtifactSpec,
+		ec.unmarshalInputPackageOrSourceInput,
+		ec.unmarshalInputPackageOrSourceSpec,
+		ec.unmarshalInputPackageQualifierInputSpec,
+		ec.unmarshalInputPackageQualifierSpec,
+		ec.unmarshalInputPackageSourceOrArtifactInput,
+		ec.unmarshalInputPackageSourceOrArtifactSpec,
+		ec.unmarshalInputPkgEqualInputSpec,
+		ec.unmarshalInputPkgEqualSpec,
+		ec.unmarshalInputPkgInputSpec,
+		ec.unmarshalInputPkgSpec,
+		ec.unmarshalInputPointOfContactInputSpec,
+		ec.unmarshalInputPointOfContactSpec,
+		ec.unmarshalInputSLSAInputSpec,
+		ec.unmarshalInputSLSAPredicateInputSpec,
+		ec.unmarshalInputScanMetadataInput,
+		ec.unmarshalInputScorecardCheckInputSpec,
+		ec.unmarshalInputScorecardInputSpec,
+		ec.unmarshalInputSourceInputSpec,
+		ec.unmarshalInputSourceSpec,
+		ec.unmarshalInputVexStatementInputSpec,
+		ec.unmarshalInputVulnEqualInputSpec,
+		ec.unmarshalInputVulnEqualSpec,
+		ec.unmarshalInputVulnerabilityInputSpec,
+		ec.unmarshalInputVulnerabilitySpec,
+	)
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(parsedSchema), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
 	}
 	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
 }
 
-var sources = []*ast.Source{
+var sources = []*ast.Source{
+	{Name: "../artifact.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the artifacts.
+
+"""
+Artifact represents the artifact and contains a digest field
+
+Both field are mandatory and canonicalized to be lowercase.
+
+If having a ` + "`" + `checksum` + "`" + ` Go object, ` + "`" + `algorithm` + "`" + ` can be
+` + "`" + `strings.ToLower(string(checksum.Algorithm))` + "`" + ` and ` + "`" + `digest` + "`" + ` can be
+` + "`" + `checksum.Value` + "`" + `.
+"""
+type Artifact {
+  id: ID!
+  algorithm: String!
+  digest: String!
+}
+
+"""
+ArtifactSpec allows filtering the list of artifacts to return.
+
+Both arguments will be canonicalized to lowercase before matching.
+"""
+input ArtifactSpec {
+  id: ID
+  algorithm: String
+  digest: String
+}
+
+"""
+ArtifactInputSpec is the same as Artifact, but used as mutation input.
+
+Both arguments will be canonicalized to lowercase before being stored. The
+digest must be a hex encoded value.
+"""
+input ArtifactInputSpec {
+  algorithm: String!
+  digest: String!
+}
+
+"""
+ArtifactEdge is an artifact in an ArtifactConnection, with the cursor to pass
+as the after argument of artifactsList to get the artifacts following it.
+"""
+type ArtifactEdge {
+  cursor: ID!
+  node: Artifact!
+}
+
+"ArtifactConnection is a page of artifacts returned by artifactsList."
+type ArtifactConnection {
+  edges: [ArtifactEdge!]!
+  pageInfo: PageInfo!
+}
+
+extend type Query {
+  """
+  Returns a page of the artifacts matching the spec.
+
+  At most first artifacts are returned, or all of them if first is not set.
+  Passing the endCursor of a page as after returns the following page.
+  Cursors are opaque and remain valid while new artifacts are ingested.
+  """
+  artifactsList(artifactSpec: ArtifactSpec, after: ID, first: Int): ArtifactConnection!
+}
+
+type Mutation {
+  "Ingests a new artifact and returns it. Ingesting an existing artifact is a no-op."
+  ingestArtifact(artifact: ArtifactInputSpec): Artifact!
+  """
+  Bulk ingestion of artifacts, returning them in the same order as the input.
+  Either all the artifacts are ingested, or none of them if any is invalid.
+  """
+  ingestArtifacts(artifacts: [ArtifactInputSpec!]!): [Artifact!]!
+}
+
+type Subscription {
+  """
+  Streams the artifacts as they are ingested, starting from the ones ingested
+  after subscribing. Ingesting an existing artifact sends no event. Every
+  subscriber receives all the events.
+  """
+  artifactIngested: Artifact!
+}
+`, BuiltIn: false},
+	{Name: "../builder.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the builders of artifacts.
+
+"""
+Builder represents the builder (e.g., FRSCA or GitHub Actions) which produced
+an artifact, as recorded in SLSA provenance.
+
+Builders are identified by their URI.
+"""
+type Builder {
+  id: ID!
+  uri: String!
+}
+
+"BuilderSpec allows filtering the list of builders to return."
+input BuilderSpec {
+  id: ID
+  uri: String
+}
+
+"BuilderInputSpec is the same as Builder, but used as mutation input."
+input BuilderInputSpec {
+  uri: String!
+}
+
+extend type Query {
+  "Returns all builders matching the filter."
+  builders(builderSpec: BuilderSpec): [Builder!]!
+}
+
+extend type Mutation {
+  "Ingests a new builder and returns it. Ingesting an existing builder is a no-op."
+  ingestBuilder(builder: BuilderInputSpec): Builder!
+}
+`, BuiltIn: false},
+	{Name: "../certifyBad.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyBad. It contains the subject (which
+# can be a package, a source or an artifact) known to be bad.
+
+"PackageSourceOrArtifact is a union of Package, Source and Artifact."
+union PackageSourceOrArtifact = Package | Source | Artifact
+
+"""
+PackageSourceOrArtifactSpec allows using PackageSourceOrArtifact union as
+input type to be used in read queries.
+
+At most one of the values can be set to non-nil.
+"""
+input PackageSourceOrArtifactSpec {
+  package: PkgSpec
+  source: SourceSpec
+  artifact: ArtifactSpec
+}
+
+"""
+PackageSourceOrArtifactInput allows using PackageSourceOrArtifact union as
+input type to be used in mutations.
+
+Exactly one of the values must be set to non-nil.
+"""
+input PackageSourceOrArtifactInput {
+  package: PkgInputSpec
+  source: SourceInputSpec
+  artifact: ArtifactInputSpec
+}
+
+"""
+PkgMatchType determines the level of the package trie a certification is
+attached to.
+
+ALL_VERSIONS attaches the certification to the package name, so that it
+applies to all the versions of the package, including the ones ingested
+later. SPECIFIC_VERSION attaches it to the version given in the input.
+"""
+enum PkgMatchType {
+  ALL_VERSIONS
+  SPECIFIC_VERSION
+}
+
+"""
+CertifyBad is an attestation that a package, source or artifact is considered
+bad.
+
+If the subject is a package, it is either a package version or, for
+certifications which apply to all the versions of the package, a package
+name (with no versions).
+
+justification describes why the subject is considered bad.
+"""
+type CertifyBad {
+  id: ID!
+  subject: PackageSourceOrArtifact!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyBadSpec allows filtering the list of CertifyBad to return.
+
+At most one of the package, source and artifact subjects can be specified.
+
+Certifications on a package name apply to all its versions, so they are
+returned for any package filter matching the name, even if the filter also
+specifies a version.
+"""
+input CertifyBadSpec {
+  id: ID
+  subject: PackageSourceOrArtifactSpec
+  justification: String
+  origin: String
+  collector: String
+}
+
+"CertifyBadInputSpec is the same as CertifyBad but for mutation input."
+input CertifyBadInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all CertifyBad attestations matching the filter."
+  CertifyBad(certifyBadSpec: CertifyBadSpec): [CertifyBad!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a package, source or artifact is bad. The subject is ingested
+  too, if it does not exist yet. For package subjects, pkgMatchType selects
+  whether the certification applies to the given version (the default) or to
+  all the versions of the package. Ingesting an existing certification is a
+  no-op.
+  """
+  ingestCertifyBad(subject: PackageSourceOrArtifactInput, pkgMatchType: PkgMatchType, certifyBad: CertifyBadInputSpec): CertifyBad!
+}
+`, BuiltIn: false},
+	{Name: "../certifyGood.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyGood. It is the counterpart of
+# CertifyBad, for subjects known to be good.
+
+"""
+CertifyGood is an attestation that a package, source or artifact is
+considered good.
+
+If the subject is a package, it is either a package version or, for
+certifications which apply to all the versions of the package, a package
+name (with no versions).
+
+justification describes why the subject is considered good.
+"""
+type CertifyGood {
+  id: ID!
+  subject: PackageSourceOrArtifact!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyGoodSpec allows filtering the list of CertifyGood to return.
+
+The subject is matched as in CertifyBadSpec.
+"""
+input CertifyGoodSpec {
+  id: ID
+  subject: PackageSourceOrArtifactSpec
+  justification: String
+  origin: String
+  collector: String
+}
+
+"CertifyGoodInputSpec is the same as CertifyGood but for mutation input."
+input CertifyGoodInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all CertifyGood attestations matching the filter."
+  CertifyGood(certifyGoodSpec: CertifyGoodSpec): [CertifyGood!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a package, source or artifact is good. The subject and
+  pkgMatchType are handled as in ingestCertifyBad. Ingesting an existing
+  certification is a no-op.
+  """
+  ingestCertifyGood(subject: PackageSourceOrArtifactInput, pkgMatchType: PkgMatchType, certifyGood: CertifyGoodInputSpec): CertifyGood!
+}
+`, BuiltIn: false},
+	{Name: "../certifyLegal.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyLegal. It contains the subject
+# (which can be either a package or a source) and the license information
+# found about it.
+
+"""
+CertifyLegal is an attestation of the license information of a package
+version or a source.
+
+declaredLicense is the license declared by the subject (e.g., in its package
+manifest) and discoveredLicense is the license expression of the licenses
+found in its files. attribution is the attribution text (e.g., the copyright
+notices) of the subject and justification describes how the information was
+obtained.
+
+Every scan is recorded as a separate node: scanning the same subject again
+does not replace the results of previous scans.
+"""
+type CertifyLegal {
+  id: ID!
+  subject: PackageOrSource!
+  declaredLicense: String!
+  discoveredLicense: String!
+  attribution: String!
+  justification: String!
+  timeScanned: Time!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyLegalSpec allows filtering the list of CertifyLegal to return.
+
+At most one of the package and source subjects can be specified.
+"""
+input CertifyLegalSpec {
+  id: ID
+  subject: PackageOrSourceSpec
+  declaredLicense: String
+  discoveredLicense: String
+  attribution: String
+  justification: String
+  timeScanned: Time
+  origin: String
+  collector: String
+}
+
+"CertifyLegalInputSpec is the same as CertifyLegal but for mutation input."
+input CertifyLegalInputSpec {
+  declaredLicense: String!
+  discoveredLicense: String!
+  attribution: String!
+  justification: String!
+  timeScanned: Time!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all the license certifications matching the filter."
+  CertifyLegal(certifyLegalSpec: CertifyLegalSpec): [CertifyLegal!]!
+}
+
+extend type Mutation {
+  """
+  Certifies the license information of a package or a source. The subject is
+  ingested too, if it does not exist yet. Ingesting an existing certification
+  is a no-op.
+  """
+  ingestCertifyLegal(subject: PackageOrSourceInput, certifyLegal: CertifyLegalInputSpec): CertifyLegal!
+}
+`, BuiltIn: false},
+	{Name: "../certifyScorecard.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyScorecard. It contains the source
+# repository and the results of an OpenSSF Scorecard run against it.
+
+"""
+CertifyScorecard is an attestation that a source repository has been scanned
+by OpenSSF Scorecard.
+
+Scorecard is run regularly, so a source can have multiple certifications:
+runs for a different commit or at a different time are recorded as separate
+nodes.
+"""
+type CertifyScorecard {
+  id: ID!
+  source: Source!
+  scorecard: Scorecard!
+}
+
+"""
+Scorecard contains the results of a Scorecard run.
+
+aggregateScore is the overall score of the repository and checks contains the
+score of every individual check. commit is the commit of the repository which
+has been scanned, scorecardVersion is the version of the Scorecard tool.
+"""
+type Scorecard {
+  checks: [ScorecardCheck!]!
+  aggregateScore: Float!
+  timeScanned: Time!
+  scorecardVersion: String!
+  commit: String!
+  origin: String!
+  collector: String!
+}
+
+"ScorecardCheck is the score of an individual Scorecard check."
+type ScorecardCheck {
+  check: String!
+  score: Int!
+}
+
+"""
+CertifyScorecardSpec allows filtering the list of CertifyScorecard to return.
+
+minAggregateScore only returns the scorecards with an aggregate score greater
+than or equal to the given value.
+
+If latestOnly is true, only the most recent of the matching scorecards of
+each source is returned. Among the ones scanned at the same time, the first one
+ingested is kept.
+"""
+input CertifyScorecardSpec {
+  id: ID
+  source: SourceSpec
+  minAggregateScore: Float
+  scorecardVersion: String
+  commit: String
+  origin: String
+  collector: String
+  latestOnly: Boolean
+}
+
+"ScorecardInputSpec is the same as Scorecard but for mutation input."
+input ScorecardInputSpec {
+  checks: [ScorecardCheckInputSpec!]!
+  aggregateScore: Float!
+  timeScanned: Time!
+  scorecardVersion: String!
+  commit: String!
+  origin: String!
+  collector: String!
+}
+
+"ScorecardCheckInputSpec is the same as ScorecardCheck but for mutation input."
+input ScorecardCheckInputSpec {
+  check: String!
+  score: Int!
+}
+
+extend type Query {
+  "Returns all Scorecard certifications matching the filter."
+  scorecards(scorecardSpec: CertifyScorecardSpec): [CertifyScorecard!]!
+}
+
+extend type Mutation {
+  """
+  Certifies the Scorecard results of a source repository. The source is
+  ingested too, if it does not exist yet.
+
+  Certifications are identified by the source, the commit and the time of the
+  scan: ingesting a scorecard for an existing (commit, timeScanned) pair of the
+  source is a no-op and returns the existing certification.
+  """
+  ingestScorecard(source: SourceInputSpec, scorecard: ScorecardInputSpec): CertifyScorecard!
+}
+`, BuiltIn: false},
+	{Name: "../certifyVEXStatement.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyVEXStatement. It contains the subject
+# (which can be either a package or an artifact), the vulnerability and the
+# VEX statement about the impact of the vulnerability on the subject.
+
+"""
+VexStatus is the status of a subject with respect to a vulnerability, as
+defined by the VEX specification.
+"""
+enum VexStatus {
+  NOT_AFFECTED
+  AFFECTED
+  FIXED
+  UNDER_INVESTIGATION
+}
+
+"""
+VexJustification explains why a subject is not affected by a vulnerability,
+as defined by the VEX specification. NOT_PROVIDED is used for the other
+statuses, or when the justification is only given in the statement.
+"""
+enum VexJustification {
+  COMPONENT_NOT_PRESENT
+  VULNERABLE_CODE_NOT_PRESENT
+  VULNERABLE_CODE_NOT_IN_EXECUTE_PATH
+  VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY
+  INLINE_MITIGATIONS_ALREADY_EXIST
+  NOT_PROVIDED
+}
+
+"""
+CertifyVEXStatement is an attestation of the impact of a vulnerability on a
+package version or an artifact.
+
+statement is the free form description of the impact (or of the actions
+needed to fix it) and knownSince is the time since which the status holds.
+
+Every statement is recorded as a separate node: stating a new status for the
+same subject and vulnerability does not replace the previous statements, so
+that their history is preserved.
+"""
+type CertifyVEXStatement {
+  id: ID!
+  subject: PackageOrArtifact!
+  vulnerability: Vulnerability!
+  status: VexStatus!
+  vexJustification: VexJustification!
+  statement: String!
+  knownSince: Time!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyVEXStatementSpec allows filtering the list of CertifyVEXStatement to
+return.
+
+At most one of the package and artifact subjects can be specified.
+knownSinceFrom and knownSinceUntil restrict the results to the statements
+known since a time in a window; both bounds are inclusive and optional.
+"""
+input CertifyVEXStatementSpec {
+  id: ID
+  subject: PackageOrArtifactSpec
+  vulnerability: VulnerabilitySpec
+  status: VexStatus
+  vexJustification: VexJustification
+  statement: String
+  knownSinceFrom: Time
+  knownSinceUntil: Time
+  origin: String
+  collector: String
+}
+
+"""
+VexStatementInputSpec is the same as CertifyVEXStatement but for mutation
+input.
+
+A NOT_AFFECTED statement must have a justification, either as
+vexJustification or as statement.
+"""
+input VexStatementInputSpec {
+  status: VexStatus!
+  vexJustification: VexJustification!
+  statement: String!
+  knownSince: Time!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all the VEX statements matching the filter."
+  CertifyVEXStatement(certifyVEXStatementSpec: CertifyVEXStatementSpec): [CertifyVEXStatement!]!
+}
+
+extend type Mutation {
+  """
+  Records a VEX statement about a vulnerability of a package or an artifact.
+  The subject and the vulnerability are ingested too, if they do not exist
+  yet. Ingesting an existing statement is a no-op.
+  """
+  ingestVEXStatement(subject: PackageOrArtifactInput, vulnerability: VulnerabilityInputSpec, vexStatement: VexStatementInputSpec): CertifyVEXStatement!
+}
+`, BuiltIn: false},
+	{Name: "../certifyVuln.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyVuln. It contains the package
+# version, the vulnerability found in it and the details of the scan.
+
+"Time is an RFC3339 timestamp."
+scalar Time
+
+"""
+CertifyVuln is an attestation that a package version is affected by a
+vulnerability, as found by a scan.
+
+Every scan is recorded as a separate node: scanning the same package again
+(e.g., with a newer version of the vulnerability database) does not replace
+the results of previous scans.
+"""
+type CertifyVuln {
+  id: ID!
+  package: Package!
+  vulnerability: Vulnerability!
+  metadata: ScanMetadata!
+}
+
+"""
+ScanMetadata is the metadata attached to vulnerability certifications.
+
+It contains the time of the scan, the URI and version of the vulnerability
+database used by the scanner, and the URI and version of the scanner.
+"""
+type ScanMetadata {
+  timeScanned: Time!
+  dbUri: String!
+  dbVersion: String!
+  scannerUri: String!
+  scannerVersion: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyVulnSpec allows filtering the list of CertifyVuln to return.
+
+timeScannedSince and timeScannedUntil restrict the results to the scans made
+in a time window; both bounds are inclusive and optional.
+
+If latestOnly is true, only the certifications of the most recent of the
+matching scans of each package version are returned, whatever their
+vulnerabilities, so that the findings of the older scans, including the ones a
+newer scan no longer reports, are hidden. All the certifications scanned at
+the time of the most recent one are kept, as a scan finding several
+vulnerabilities is ingested as one certification for each.
+"""
+input CertifyVulnSpec {
+  id: ID
+  package: PkgSpec
+  vulnerability: VulnerabilitySpec
+  timeScannedSince: Time
+  timeScannedUntil: Time
+  dbUri: String
+  dbVersion: String
+  scannerUri: String
+  scannerVersion: String
+  origin: String
+  collector: String
+  latestOnly: Boolean
+}
+
+"ScanMetadataInput is the same as ScanMetadata but for mutation input."
+input ScanMetadataInput {
+  timeScanned: Time!
+  dbUri: String!
+  dbVersion: String!
+  scannerUri: String!
+  scannerVersion: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all vulnerability certifications matching the filter."
+  CertifyVuln(certifyVulnSpec: CertifyVulnSpec): [CertifyVuln!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a package version is affected by a vulnerability. The package
+  and the vulnerability are ingested too, if they do not exist yet. Ingesting
+  the results of an existing scan is a no-op.
+  """
+  ingestCertifyVuln(pkg: PkgInputSpec, vulnerability: VulnerabilityInputSpec, certifyVuln: ScanMetadataInput): CertifyVuln!
+}
+`, BuiltIn: false},
+	{Name: "../hasMetadata.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the HasMetadata. It attaches arbitrary key/value
+# facts to a package, a source or an artifact.
+
+"""
+HasMetadata is an attestation that a package, source or artifact has a certain
+attribute, for the facts which do not warrant a dedicated node.
+
+If the subject is a package, it is either a package version or, for metadata
+which apply to all the versions of the package, a package name (with no
+versions).
+
+key is the name of the attribute and value its value. since is the time from
+which the attribute is known to hold, and justification describes how it was
+determined.
+
+Nodes are deduplicated on the subject, key, value and justification, so a key
+can have several values for the same subject.
+"""
+type HasMetadata {
+  id: ID!
+  subject: PackageSourceOrArtifact!
+  key: String!
+  value: String!
+  since: Time!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+HasMetadataSpec allows filtering the list of HasMetadata to return.
+
+At most one of the package, source and artifact subjects can be specified.
+
+Metadata of a package name apply to all its versions, so they are returned for
+any package filter matching the name, even if the filter also specifies a
+version.
+"""
+input HasMetadataSpec {
+  id: ID
+  subject: PackageSourceOrArtifactSpec
+  key: String
+  value: String
+  since: Time
+  justification: String
+  origin: String
+  collector: String
+}
+
+"HasMetadataInputSpec is the same as HasMetadata but for mutation input."
+input HasMetadataInputSpec {
+  key: String!
+  value: String!
+  since: Time!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all the HasMetadata matching the filter."
+  HasMetadata(hasMetadataSpec: HasMetadataSpec): [HasMetadata!]!
+}
+
+extend type Mutation {
+  """
+  Attaches metadata to a package, source or artifact. The subject is ingested
+  too, if it does not exist yet. For package subjects, pkgMatchType selects
+  whether the metadata apply to the given version (the default) or to all the
+  versions of the package. Ingesting metadata with the same subject, key,
+  value and justification as an existing node returns that node.
+  """
+  ingestHasMetadata(subject: PackageSourceOrArtifactInput, pkgMatchType: PkgMatchType, hasMetadata: HasMetadataInputSpec): HasMetadata!
+}
+`, BuiltIn: false},
+	{Name: "../hasSBOM.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the HasSBOM. It contains the subject (which can
+# be either a package or an artifact) and the details of the SBOM document
+# describing it.
+
+"PackageOrArtifact is a union of Package and Artifact."
+union PackageOrArtifact = Package | Artifact
+
+"""
+PackageOrArtifactSpec allows using PackageOrArtifact union as input type to be
+used in read queries.
+
+Exactly one of the value must be set to non-nil.
+"""
+input PackageOrArtifactSpec {
+  package: PkgSpec
+  artifact: ArtifactSpec
+}
+
+"""
+PackageOrArtifactInput allows using PackageOrArtifact union as input type to
+be used in mutations.
+
+Exactly one of the value must be set to non-nil.
+"""
+input PackageOrArtifactInput {
+  package: PkgInputSpec
+  artifact: ArtifactInputSpec
+}
+
+"""
+HasSBOM is an attestation that a package or an artifact is described by an
+SBOM document.
+
+The subject is a package version or an artifact. A subject can have multiple
+SBOMs (e.g., generated by different tools or over time), each recorded as a
+separate node.
+
+uri is the location of the SBOM document, downloadLocation is the location
+from which the SBOM document was fetched and algorithm and digest identify
+the contents of the document.
+"""
+type HasSBOM {
+  id: ID!
+  subject: PackageOrArtifact!
+  uri: String!
+  algorithm: String!
+  digest: String!
+  downloadLocation: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+HasSBOMSpec allows filtering the list of HasSBOM to return.
+
+At most one of the package and artifact subjects can be specified.
+"""
+input HasSBOMSpec {
+  id: ID
+  subject: PackageOrArtifactSpec
+  uri: String
+  algorithm: String
+  digest: String
+  downloadLocation: String
+  origin: String
+  collector: String
+}
+
+"HasSBOMInputSpec is the same as HasSBOM but for mutation input."
+input HasSBOMInputSpec {
+  uri: String!
+  algorithm: String!
+  digest: String!
+  downloadLocation: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all SBOM certifications."
+  HasSBOM(hasSBOMSpec: HasSBOMSpec): [HasSBOM!]!
+  """
+  Returns the SBOMs which may ship the artifact: the ones whose subject is the
+  artifact itself, or a package or artifact reached from it through
+  IsOccurrence edges and dependencies, each SBOM being returned once. An
+  unknown artifact has no SBOM.
+  """
+  findSBOMsByArtifact(algorithm: String!, digest: String!): [HasSBOM!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a package or artifact has an SBOM. The subject is ingested
+  too, if it does not exist yet. Ingesting an existing certification is a
+  no-op.
+  """
+  ingestHasSBOM(subject: PackageOrArtifactInput, hasSBOM: HasSBOMInputSpec): HasSBOM!
+}
+`, BuiltIn: false},
+	{Name: "../hasSLSA.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the HasSLSA. It contains the artifact built
+# and the SLSA provenance of the build.
+
+"""
+HasSLSA records that a subject artifact has SLSA provenance, linking it to the
+builder which produced it.
+"""
+type HasSLSA {
+  id: ID!
+  subject: Artifact!
+  slsa: SLSA!
+}
+
+"""
+SLSA contains the SLSA provenance of a build.
+
+builtFrom are the materials of the build and builtBy is the builder which ran
+it. slsaPredicate is the rest of the predicate of the provenance, flattened to
+key/value pairs. startedOn and finishedOn are not set if the provenance does
+not record them.
+"""
+type SLSA {
+  builtFrom: [Artifact!]!
+  builtBy: Builder!
+  buildType: String!
+  slsaPredicate: [SLSAPredicate!]!
+  slsaVersion: String!
+  startedOn: Time
+  finishedOn: Time
+  origin: String!
+  collector: String!
+}
+
+"SLSAPredicate is a key/value pair of the predicate of a SLSA provenance."
+type SLSAPredicate {
+  key: String!
+  value: String!
+}
+
+"""
+HasSLSASpec allows filtering the list of HasSLSA to return.
+
+Every builtFrom filter must match one of the materials of the build.
+"""
+input HasSLSASpec {
+  id: ID
+  subject: ArtifactSpec
+  builtFrom: [ArtifactSpec]
+  builtBy: BuilderSpec
+  buildType: String
+  slsaVersion: String
+  origin: String
+  collector: String
+}
+
+"SLSAInputSpec is the same as SLSA but for mutation input."
+input SLSAInputSpec {
+  buildType: String!
+  slsaPredicate: [SLSAPredicateInputSpec!]!
+  slsaVersion: String!
+  startedOn: Time
+  finishedOn: Time
+  origin: String!
+  collector: String!
+}
+
+"SLSAPredicateInputSpec is the same as SLSAPredicate but for mutation input."
+input SLSAPredicateInputSpec {
+  key: String!
+  value: String!
+}
+
+extend type Query {
+  "Returns all SLSA attestations matching the filter."
+  HasSLSA(hasSLSASpec: HasSLSASpec): [HasSLSA!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a subject artifact has been built by the builder from the
+  materials in builtFrom. The artifacts and the builder are ingested too, if
+  they do not exist yet.
+
+  Attestations are identified by all their fields except startedOn and
+  finishedOn: ingesting an existing attestation is a no-op and returns it.
+  """
+  ingestSLSA(subject: ArtifactInputSpec, builtFrom: [ArtifactInputSpec!]!, builtBy: BuilderInputSpec, slsa: SLSAInputSpec): HasSLSA!
+}
+`, BuiltIn: false},
+	{Name: "../hashEqual.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the HashEqual. It contains the artifacts which
+# are known to have the same contents.
+
+"""
+HashEqual is an attestation that a set of artifacts are identical.
+
+This is used when different tools identify the same file by digests computed
+with different algorithms (e.g., sha1 and sha256). The relation is symmetric:
+the order of the artifacts is not significant.
+"""
+type HashEqual {
+  id: ID!
+  artifacts: [Artifact!]!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+HashEqualSpec allows filtering the list of HashEqual to return.
+
+At most two artifacts can be specified. Every artifact filter must match a
+different artifact of the HashEqual, regardless of the order.
+
+If expandTransitive is true, the HashEqual matching the spec are followed by
+all the ones transitively linked to their artifacts, which match the
+justification, origin and collector filters: the results are the equalities
+of the whole equivalence classes of the artifacts.
+"""
+input HashEqualSpec {
+  id: ID
+  artifacts: [ArtifactSpec]
+  justification: String
+  origin: String
+  collector: String
+  expandTransitive: Boolean
+}
+
+"HashEqualInputSpec is the same as HashEqual but for mutation input."
+input HashEqualInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all artifact equality certifications matching the filter."
+  HashEqual(hashEqualSpec: HashEqualSpec): [HashEqual!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that two artifacts are identical. The artifacts are ingested too,
+  if they do not exist yet. Ingesting an existing certification, with the
+  artifacts in either order, is a no-op.
+  """
+  ingestHashEqual(artifact: ArtifactInputSpec, equalArtifact: ArtifactInputSpec, hashEqual: HashEqualInputSpec): HashEqual!
+}
+`, BuiltIn: false},
+	{Name: "../isDependency.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the IsDependency. It contains the package which
+# has the dependency, the package it depends on and the details of the
+# dependency.
+
+"DependencyType determines the type of the dependency."
+enum DependencyType {
+  "direct dependency"
+  DIRECT
+  "indirect dependency"
+  INDIRECT
+  "type not known/not specified"
+  UNKNOWN
+}
+
+"""
+IsDependency is an attestation that a package depends on another package.
+
+package is the package version which has the dependency. dependentPackage is
+the package it depends on, at the package name level, as a dependency is
+usually expressed as a range of versions instead of a single version.
+
+versionRange is the range of versions of dependentPackage which satisfy the
+dependency, stored verbatim as it was found in the document declaring the
+dependency. The syntax of the range is specific to each package ecosystem.
+
+justification describes why the dependency exists, origin is the document the
+dependency was extracted from and collector is the collector which ingested
+it.
+"""
+type IsDependency {
+  id: ID!
+  package: Package!
+  dependentPackage: Package!
+  versionRange: String!
+  dependencyType: DependencyType!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+IsDependencySpec allows filtering the list of IsDependency to return.
+
+The dependentPackage filter only looks at the type, namespace and name of the
+package, the version and subpath filters are ignored.
+"""
+input IsDependencySpec {
+  id: ID
+  package: PkgSpec
+  dependentPackage: PkgSpec
+  versionRange: String
+  dependencyType: DependencyType
+  justification: String
+  origin: String
+  collector: String
+}
+
+"IsDependencyInputSpec is the same as IsDependency but for mutation input."
+input IsDependencyInputSpec {
+  versionRange: String!
+  dependencyType: DependencyType!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all package dependencies that match the filter."
+  IsDependency(isDependencySpec: IsDependencySpec): [IsDependency!]!
+  """
+  Returns the package names the packages matching pkgSpec depend on, directly
+  or through other dependencies, each being returned once. The walk follows at
+  most maxDepth IsDependency edges, which must be positive, and ends on
+  dependency cycles.
+
+  The dependencies are package names, and the version ranges of the
+  dependencies are not interpreted, as their syntax depends on the ecosystem.
+  So the walk goes on from all the versions of each dependency, including the
+  ones outside of its version range, and may return more packages than the
+  ones actually installed.
+  """
+  transitiveDependencies(pkgSpec: PkgSpec!, maxDepth: Int!): [Package!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that pkg depends on depPkg. Both packages are ingested too, if
+  they do not exist yet. The version, qualifiers and subpath of depPkg are
+  ignored. Ingesting an existing dependency is a no-op.
+  """
+  ingestIsDependency(pkg: PkgInputSpec, depPkg: PkgInputSpec, dependency: IsDependencyInputSpec): IsDependency!
+}
+`, BuiltIn: false},
+	{Name: "../isOccurrence.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the IsOccurrence. It contains the subject
+# (which can be either a package or a source) and the artifact the subject is
+# materialized as.
+
+"PackageOrSource is a union of Package and Source."
+union PackageOrSource = Package | Source
+
+"""
+PackageOrSourceSpec allows using PackageOrSource union as input type to be
+used in read queries.
+
+Exactly one of the value must be set to non-nil.
+"""
+input PackageOrSourceSpec {
+  package: PkgSpec
+  source: SourceSpec
+}
+
+"""
+PackageOrSourceInput allows using PackageOrSource union as input type to be
+used in mutations.
+
+Exactly one of the value must be set to non-nil.
+"""
+input PackageOrSourceInput {
+  package: PkgInputSpec
+  source: SourceInputSpec
+}
+
+"""
+IsOccurrence is an attestation that a package version or a source is
+materialized as an artifact.
+
+For example, a binary built from a source repository, or the archive
+downloaded for a package version, are occurrences of the source or package in
+the form of the artifact.
+
+justification describes how the occurrence was determined and origin is the
+document it was extracted from.
+"""
+type IsOccurrence {
+  id: ID!
+  subject: PackageOrSource!
+  artifact: Artifact!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+IsOccurrenceSpec allows filtering the list of IsOccurrence to return.
+
+At most one of the package and source subjects can be specified.
+"""
+input IsOccurrenceSpec {
+  id: ID
+  subject: PackageOrSourceSpec
+  artifact: ArtifactSpec
+  justification: String
+  origin: String
+  collector: String
+}
+
+"IsOccurrenceInputSpec is the same as IsOccurrence but for mutation input."
+input IsOccurrenceInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all occurrences that match the filter."
+  IsOccurrence(isOccurrenceSpec: IsOccurrenceSpec): [IsOccurrence!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that a package or source is materialized as an artifact. The
+  subject and the artifact are ingested too, if they do not exist yet.
+
+  Occurrences are identified by the subject, the artifact and the
+  justification: ingesting an occurrence which only differs in origin or
+  collector from an existing one is a no-op and returns the existing one.
+  """
+  ingestIsOccurrence(subject: PackageOrSourceInput, artifact: ArtifactInputSpec, occurrence: IsOccurrenceInputSpec): IsOccurrence!
+}
+`, BuiltIn: false},
+	{Name: "../package.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the package trie/tree. This tree is a derivative
+# of the pURL specification where it has a type, namespace, name and finally a
+# version, qualifiers and subpath.
+
+"""
+Package represents a package.
+
+In the pURL representation, each Package matches a ` + "`" + `pkg:<type>` + "`" + ` partial pURL.
+The ` + "`" + `type` + "`" + ` field matches the pURL types but we might also use ` + "`" + `"guac"` + "`" + ` for the
+cases where the pURL representation is not complete or when we have custom
+rules.
+
+This node is a singleton: backends guarantee that there is exactly one node
+with the same ` + "`" + `type` + "`" + ` value.
+
+Also note that this is named ` + "`" + `Package` + "`" + `, not ` + "`" + `PackageType` + "`" + `. This is only to make
+queries more readable.
+"""
+type Package {
+  id: ID!
+  type: String!
+  namespaces: [PackageNamespace!]!
+}
+
+"""
+PackageNamespace is a namespace for packages.
+
+In the pURL representation, each PackageNamespace matches the
+` + "`" + `pkg:<type>/<namespace>/` + "`" + ` partial pURL.
+
+Namespaces are optional and type specific. Because they are optional, we use
+empty string to denote missing namespaces.
+"""
+type PackageNamespace {
+  id: ID!
+  namespace: String!
+  names: [PackageName!]!
+}
+
+"""
+PackageName is a name for packages.
+
+In the pURL representation, each PackageName matches the
+` + "`" + `pkg:<type>/<namespace>/<name>` + "`" + ` pURL.
+
+Names are always mandatory.
+
+This is the first node in the trie that can be referred to by other parts of
+GUAC.
+"""
+type PackageName {
+  id: ID!
+  name: String!
+  versions: [PackageVersion!]!
+}
+
+"""
+PackageVersion is a package version.
+
+In the pURL representation, each PackageName matches the
+` + "`" + `pkg:<type>/<namespace>/<name>@<version>` + "`" + ` pURL.
+
+Versions are optional and each Package type defines own rules for handling them.
+For this level of GUAC, these are just opaque strings.
+
+This node can be referred to by other parts of GUAC.
+
+Subpath and qualifiers are optional. Lack of qualifiers is represented by an
+empty list and lack of subpath by empty string (to be consistent with
+optionality of namespace and version). Two nodes that have different qualifiers
+and/or subpath but the same version mean different packages in the trie
+(respectively). Similarly, two nodes that have different version but the same
+qualifiers and subpath also mean different packages in the trie.
+"""
+type PackageVersion {
+  id: ID!
+  version: String!
+  qualifiers: [PackageQualifier!]!
+  subpath: String!
+}
+
+"""
+PackageQualifier is a qualifier for a package, a key-value pair.
+
+In the pURL representation, it is a part of the ` + "`" + `<qualifiers>` + "`" + ` part of the
+` + "`" + `pkg:<type>/<namespace>/<name>@<version>?<qualifiers>` + "`" + ` pURL.
+
+Qualifiers are optional, each Package type defines own rules for handling them,
+and multiple qualifiers could be attached to the same package.
+
+This node cannot be directly referred by other parts of GUAC.
+"""
+type PackageQualifier {
+  key: String!
+  value: String!
+}
+
+"""
+PkgSpec allows filtering the list of packages to return.
+
+Each field matches a qualifier from pURL. Use ` + "`" + `null` + "`" + ` to match on all values at
+that level. For example, to get all packages in GUAC backend, use a PkgSpec
+where every field is ` + "`" + `null` + "`" + `.
+
+Empty string at a field means matching with the empty string.
+
+Only the nodes that match the filter, together with all the nodes on the path
+from the root of the trie to them, are returned.
+
+A version matches ` + "`" + `qualifiers` + "`" + ` if it has all of the listed qualifiers, and
+possibly others. If ` + "`" + `matchOnlyEmptyQualifiers` + "`" + ` is ` + "`" + `true` + "`" + `, ` + "`" + `qualifiers` + "`" + ` is
+ignored and only the versions without any qualifier match. Either field makes
+the spec filter on the version level.
+
+Instead of the individual fields, the ` + "`" + `packages` + "`" + ` query accepts a pURL in
+` + "`" + `purl` + "`" + `, which is converted to the equivalent filter. Setting both ` + "`" + `purl` + "`" + ` and
+any other field is an error. The qualifiers of the pURL are matched like the
+ones in ` + "`" + `qualifiers` + "`" + `.
+
+The fields are canonicalized like the ones of PkgInputSpec before matching.
+Without a ` + "`" + `type` + "`" + `, the namespace and name are only trimmed.
+"""
+input PkgSpec {
+  type: String
+  namespace: String
+  name: String
+  version: String
+  qualifiers: [PackageQualifierSpec!] = []
+  matchOnlyEmptyQualifiers: Boolean = false
+  subpath: String
+  purl: String
+}
+
+"""
+PackageQualifierSpec is the same as PackageQualifier, but usable as query
+input. A ` + "`" + `null` + "`" + ` value matches the qualifiers with the key and any value.
+"""
+input PackageQualifierSpec {
+  key: String!
+  value: String
+}
+
+"""
+PkgInputSpec specifies a package for a mutation.
+
+This is different than PkgSpec because we want to encode mandatory fields:
+` + "`" + `type` + "`" + ` and ` + "`" + `name` + "`" + `. All optional fields are given empty default values.
+
+The fields are trimmed and canonicalized before being stored, following the
+pURL specification: the type is lowercase, and so are the namespace and name
+of the types where they are case insensitive, e.g. ` + "`" + `npm` + "`" + ` but not ` + "`" + `maven` + "`" + `.
+Qualifiers get lowercase keys and are sorted, the ones with an empty value
+being dropped, so semantically identical packages are the same node.
+"""
+input PkgInputSpec {
+  type: String!
+  namespace: String
+  name: String!
+  version: String
+  qualifiers: [PackageQualifierInputSpec!]
+  subpath: String
+}
+
+"""
+PackageQualifierInputSpec is the same as PackageQualifier, but usable as
+mutation input.
+
+GraphQL does not allow the same type to be both input and output.
+"""
+input PackageQualifierInputSpec {
+  key: String!
+  value: String!
+}
+
+"""
+PurlPackage is the package resolved for a purl by the packagesByPurls query.
+"""
+type PurlPackage {
+  purl: String!
+  "The path in the trie to the package, as for a PkgSpec filter on the purl."
+  package: Package!
+}
+
+extend type Query {
+  "Returns all packages matching a filter."
+  packages(pkgSpec: PkgSpec): [Package!]!
+  """
+  Resolves a batch of purls at once, as if each was used in a PkgSpec filter,
+  in the order of the input. The purls without any matching package are left
+  out. The invalid purls are reported as errors, the others still being
+  resolved.
+  """
+  packagesByPurls(purls: [String!]!): [PurlPackage!]!
+}
+
+extend type Mutation {
+  """
+  Ingests a new package and returns the path in the trie to the corresponding
+  version node. Ingesting an existing package is a no-op.
+  """
+  ingestPackage(pkg: PkgInputSpec): Package!
+  """
+  Bulk ingestion of packages, returning them in the same order as the input.
+  The nodes shared by the packages are created once, and either all the
+  packages are ingested, or none of them if any is invalid.
+  """
+  ingestPackages(pkgs: [PkgInputSpec!]!): [Package!]!
+}
+`, BuiltIn: false},
+	{Name: "../pagination.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL types shared by the paginated queries.
+
+"""
+PageInfo describes a page of results of a paginated query.
+
+hasNextPage is true if there are results after this page. endCursor is the
+cursor of the last result of the page, which can be passed as the after
+argument of the query to get the next page. It is null if the page is empty.
+"""
+type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: ID
+}
+`, BuiltIn: false},
+	{Name: "../path.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for walking the nodes of the GUAC trees.
+
+"""
+Node is any of the nodes of the software trees and of the evidence trees.
+
+Nodes in the package, source and vulnerability tries are returned as the path
+from the root of their trie down to them.
+"""
+union Node =
+    Package
+  | Source
+  | Artifact
+  | Builder
+  | Vulnerability
+  | CertifyBad
+  | CertifyGood
+  | CertifyLegal
+  | CertifyScorecard
+  | CertifyVEXStatement
+  | CertifyVuln
+  | HashEqual
+  | HasMetadata
+  | HasSBOM
+  | HasSLSA
+  | IsDependency
+  | IsOccurrence
+  | PkgEqual
+  | PointOfContact
+  | VulnEqual
+
+"""
+Edge is the label of an edge between two nodes, used to restrict the edges
+traversed by path and neighbors.
+
+The trie labels connect the nodes of the package, source and vulnerability
+tries to their parent. Every other label connects an evidence node to each of
+the nodes it references, like a CertifyVuln to its package version and to its
+vulnerability ID.
+"""
+enum Edge {
+  PACKAGE_TRIE
+  SOURCE_TRIE
+  VULNERABILITY_TRIE
+  CERTIFY_BAD
+  CERTIFY_GOOD
+  CERTIFY_LEGAL
+  CERTIFY_SCORECARD
+  CERTIFY_VEX_STATEMENT
+  CERTIFY_VULN
+  HASH_EQUAL
+  HAS_METADATA
+  HAS_SBOM
+  HAS_SLSA
+  IS_DEPENDENCY
+  IS_OCCURRENCE
+  PKG_EQUAL
+  POINT_OF_CONTACT
+  VULN_EQUAL
+}
+
+extend type Query {
+  """
+  Returns the nodes along a shortest path from subject to target, both
+  included, or an empty list if target cannot be reached.
+
+  At most maxPathLength edges are traversed, and only the ones with a label in
+  usingOnly. An empty usingOnly allows all the edges.
+  """
+  path(subject: ID!, target: ID!, maxPathLength: Int!, usingOnly: [Edge!]!): [Node!]!
+  """
+  Returns the nodes directly connected to node through an edge with a label in
+  usingOnly. An empty usingOnly allows all the edges.
+  """
+  neighbors(node: ID!, usingOnly: [Edge!]!): [Node!]!
+}
+`, BuiltIn: false},
+	{Name: "../pkgEqual.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the PkgEqual. It contains the packages which are
+# known to be the same package, named by different identifiers.
+
+"""
+PkgEqual is an attestation that a set of packages are the same package.
+
+This is used when the same package is named by different purls (e.g., a
+pkg:maven and a pkg:oci purl). The relation is symmetric: both packages must
+be versions and the order in which they are ingested is not significant.
+"""
+type PkgEqual {
+  id: ID!
+  packages: [Package!]!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+PkgEqualSpec allows filtering the list of PkgEqual to return.
+
+At most two packages can be specified. Every package filter must match a
+different package of the PkgEqual, regardless of the order. The packages of
+the results are returned in the order of the filters, so the package matching
+the first filter is always the first one.
+
+If expandTransitive is true, the PkgEqual matching the spec are followed by
+all the ones transitively linked to their packages, which match the
+justification, origin and collector filters: the results are the equalities
+of the whole equivalence classes of the packages.
+"""
+input PkgEqualSpec {
+  id: ID
+  packages: [PkgSpec]
+  justification: String
+  origin: String
+  collector: String
+  expandTransitive: Boolean
+}
+
+"PkgEqualInputSpec is the same as PkgEqual but for mutation input."
+input PkgEqualInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all package equality certifications matching the filter."
+  PkgEqual(pkgEqualSpec: PkgEqualSpec): [PkgEqual!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that two packages are the same. The packages are ingested too, if
+  they do not exist yet. Ingesting an existing certification, with the
+  packages in either order, is a no-op.
+  """
+  ingestPkgEqual(pkg: PkgInputSpec, otherPackage: PkgInputSpec, pkgEqual: PkgEqualInputSpec): PkgEqual!
+}
+`, BuiltIn: false},
+	{Name: "../pointOfContact.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the PointOfContact. It links a package, a source
+# or an artifact to the contact information of its owners.
+
+"""
+PointOfContact records who to contact about a package, source or artifact.
+
+If the subject is a package, it is either a package version or, for contacts
+which apply to all the versions of the package, a package name (with no
+versions).
+
+email is the email address of the contact and info describes their role (e.g.
+"maintainer" or "security team"). since is the time from which the contact
+information is known to be valid, and justification describes how it was
+obtained.
+"""
+type PointOfContact {
+  id: ID!
+  subject: PackageSourceOrArtifact!
+  email: String!
+  info: String!
+  since: Time!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+PointOfContactSpec allows filtering the list of PointOfContact to return.
+
+At most one of the package, source and artifact subjects can be specified.
+
+Contacts for a package name apply to all its versions, so they are returned
+for any package filter matching the name, even if the filter also specifies a
+version.
+"""
+input PointOfContactSpec {
+  id: ID
+  subject: PackageSourceOrArtifactSpec
+  email: String
+  info: String
+  since: Time
+  justification: String
+  origin: String
+  collector: String
+}
+
+"PointOfContactInputSpec is the same as PointOfContact but for mutation input."
+input PointOfContactInputSpec {
+  email: String!
+  info: String!
+  since: Time!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all the points of contact matching the filter."
+  PointOfContact(pointOfContactSpec: PointOfContactSpec): [PointOfContact!]!
+}
+
+extend type Mutation {
+  """
+  Adds a point of contact for a package, source or artifact. The subject is
+  ingested too, if it does not exist yet. For package subjects, pkgMatchType
+  selects whether the contact applies to the given version (the default) or to
+  all the versions of the package. Ingesting an existing contact is a no-op.
+  """
+  ingestPointOfContact(subject: PackageSourceOrArtifactInput, pkgMatchType: PkgMatchType, pointOfContact: PointOfContactInputSpec): PointOfContact!
+}
+`, BuiltIn: false},
 	{Name: "../schema.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
@@ -706,83 +4517,11 @@ interface NodeInfo {
   collectorInfo: String
 }
 
-"""
-Artifact nodes represent artifacts. These are files on disk (cf. Package).
-There could be artifacts not included in any package.
-"""
-type Artifact implements NodeInfo {
-  """
-  digest is the identifier of an artifact. It is in the format
-  ` + "`" + `algorithm:value` + "`" + ` where ` + "`" + `algorithm` + "`" + ` is the hashing algorithm (e.g. "sha1")
-  and ` + "`" + `value` + "`" + ` is the digest obtained by using this algorithm.
-
-  Note: in the future we might change this to contain a collection of hashes,
-  at which point we will need other ways to resolve an artifact given a digest.
-  """
-  digest: String!
-  "name of the artifact"
-  name: String
-  "tags associated with the artifact"
-  tags: [String!]!
-
-  # Inherited fields from NodeInfo
-  sourceInfo: String
-  collectorInfo: String
-
-  # Links to other nodes
-  builtBy: [Builder!]!
-  dependsOn: [ArtifactOrPackage!]!
-}
-
-"""
-Package nodes represent packages. These are packages from a package repository
-(cf. Artifact). Upon installing a package one or multiple artifacts could be
-generated.
-"""
-type Package implements NodeInfo {
-  """
-  purl is the Package identifier, in purl format. Uniquely identifies the package.
-  """
-  purl: String!
-  "name of the package"
-  name: String
-  "version of the package"
-  version: String
-  "digests for the package"
-  digest: [String!]!
-  "CPEs represent CPEs for the package"
-  CPEs: [String!]!
-  "tags associated with the artifact"
-  tags: [String!]!
-
-  # Inherited fields from NodeInfo
-  sourceInfo: String
-  collectorInfo: String
-
-  # Links to other nodes
-  contains: [Artifact!]!
-  dependsOn: [ArtifactOrPackage!]!
-}
-
 """
 Currently artifacts and packages can depend on each other. Hence, we need a union for this edge.
 """
 union ArtifactOrPackage = Artifact | Package
 
-"""
-Builder nodes represent builders of artifacts (from provenance documents).
-"""
-type Builder implements NodeInfo {
-  "type is type of builder. Coupled with id, it uniquely identifies the builder."
-  type: String!
-  "id is the id of builder. Coupled with type, it uniquely identifies the builder."
-  id: String!  # Note: not ID! at this time
-
-  # Inherited fields from NodeInfo
-  sourceInfo: String
-  collectorInfo: String
-}
-
 """
 Attestation nodes represent attestations about artifacts and packages.
 """
@@ -882,19 +4621,6 @@ type ScorecardPayload {
   # TODO: need to add individual scores but need to think on how to keep them up to date
 }
 
-# TODO: maybe move to separate schema?
-"""
-Vulnerability nodes represent vulnerability information
-"""
-type Vulnerability implements NodeInfo {
-  "id is the id of a vulnerability (cve??)"
-  id: String!  # Note: not ID! at this time
-
-  # Inherited fields from NodeInfo
-  sourceInfo: String
-  collectorInfo: String
-}
-
 # TODO: maybe move to separate schema?
 """
 VEXPayload are payloads commonly found in VEX attestations.
@@ -947,7 +4673,294 @@ The queries that we support.
 Subject to frequent changes
 """
 type Query {
-  artifacts: [Artifact!]!
+  "Returns all artifacts matching the filter."
+  artifacts(artifactSpec: ArtifactSpec): [Artifact!]!
+}
+`, BuiltIn: false},
+	{Name: "../source.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the source trie/tree. This tree has a type
+# (e.g., ` + "`" + `git` + "`" + `, ` + "`" + `svn` + "`" + `), a namespace (the location of the repository) and, at
+# the bottom, a name which can be pinned to a specific tag or commit.
+
+"""
+Source represents a source.
+
+This can be the version control system that is being used.
+
+This node is a singleton: backends guarantee that there is exactly one node
+with the same ` + "`" + `type` + "`" + ` value.
+
+Also note that this is named ` + "`" + `Source` + "`" + `, not ` + "`" + `SourceType` + "`" + `. This is only to make
+queries more readable.
+"""
+type Source {
+  id: ID!
+  type: String!
+  namespaces: [SourceNamespace!]!
+}
+
+"""
+SourceNamespace is a namespace for sources.
+
+This is the location of the repository (such as github/gitlab/bitbucket).
+
+The ` + "`" + `namespace` + "`" + ` field is mandatory.
+"""
+type SourceNamespace {
+  id: ID!
+  namespace: String!
+  names: [SourceName!]!
+}
+
+"""
+SourceName is a url of the repository and its tag or commit.
+
+The ` + "`" + `name` + "`" + ` field is mandatory. The ` + "`" + `tag` + "`" + ` and ` + "`" + `commit` + "`" + ` fields are optional, but
+it is an error to specify both.
+
+This is the only source trie node that can be referenced by other parts of
+GUAC.
+"""
+type SourceName {
+  id: ID!
+  name: String!
+  tag: String
+  commit: String
+}
+
+"""
+SourceSpec allows filtering the list of sources to return.
+
+Empty string at a field means matching with the empty string. Missing field
+means retrieving all possible matches.
+
+It is an error to specify both ` + "`" + `tag` + "`" + ` and ` + "`" + `commit` + "`" + ` fields, except if both are
+set as empty string (in which case the returned sources are only those for
+which there is no tag/commit information).
+
+All the fields are trimmed. The type is canonicalized to lowercase, replacing
+the full names of the version control systems by their commands (e.g.,
+` + "`" + `mercurial` + "`" + ` by ` + "`" + `hg` + "`" + `), and the commit is canonicalized to lowercase.
+"""
+input SourceSpec {
+  type: String
+  namespace: String
+  name: String
+  tag: String
+  commit: String
+}
+
+"""
+SourceInputSpec specifies a source for a mutation.
+
+This is different than SourceSpec because we want to encode that all fields
+except tag and commit are mandatory fields. All optional fields are given
+empty default values.
+
+It is an error to set both ` + "`" + `tag` + "`" + ` and ` + "`" + `commit` + "`" + ` fields to values different than
+the default.
+
+All the fields are trimmed. The type is canonicalized to lowercase, replacing
+the full names of the version control systems by their commands (e.g.,
+` + "`" + `mercurial` + "`" + ` by ` + "`" + `hg` + "`" + `), and the commit is canonicalized to lowercase.
+"""
+input SourceInputSpec {
+  type: String!
+  namespace: String!
+  name: String!
+  tag: String
+  commit: String
+}
+
+extend type Query {
+  "Returns all sources matching a filter."
+  sources(sourceSpec: SourceSpec): [Source!]!
+}
+
+extend type Mutation {
+  """
+  Ingests a new source and returns the path in the trie to the corresponding
+  name node. Ingesting an existing source is a no-op.
+  """
+  ingestSource(source: SourceInputSpec): Source!
+  """
+  Bulk ingestion of sources, returning them in the same order as the input.
+  The nodes shared by the sources are created once, and either all the
+  sources are ingested, or none of them if any is invalid.
+  """
+  ingestSources(sources: [SourceInputSpec!]!): [Source!]!
+}
+`, BuiltIn: false},
+	{Name: "../vulnEqual.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the VulnEqual. It contains the vulnerabilities
+# which are known to be the same.
+
+"""
+VulnEqual is an attestation that two vulnerabilities are the same.
+
+This is used when different databases identify the same vulnerability with
+different IDs, usually of different types (e.g., a CVE, a GHSA and an OSV
+ID). The relation is symmetric: the order of the vulnerabilities is not
+significant.
+"""
+type VulnEqual {
+  id: ID!
+  vulnerabilities: [Vulnerability!]!
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+VulnEqualSpec allows filtering the list of VulnEqual to return.
+
+At most two vulnerabilities can be specified. Every vulnerability filter must
+match a different vulnerability of the VulnEqual, regardless of the order.
+"""
+input VulnEqualSpec {
+  id: ID
+  vulnerabilities: [VulnerabilitySpec]
+  justification: String
+  origin: String
+  collector: String
+}
+
+"VulnEqualInputSpec is the same as VulnEqual but for mutation input."
+input VulnEqualInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all vulnerability equality certifications matching the filter."
+  VulnEqual(vulnEqualSpec: VulnEqualSpec): [VulnEqual!]!
+}
+
+extend type Mutation {
+  """
+  Certifies that two vulnerabilities are the same. The vulnerabilities are
+  ingested too, if they do not exist yet. Ingesting an existing
+  certification, with the vulnerabilities in either order, is a no-op.
+  """
+  ingestVulnEqual(vulnerability: VulnerabilityInputSpec, otherVulnerability: VulnerabilityInputSpec, vulnEqual: VulnEqualInputSpec): VulnEqual!
+}
+`, BuiltIn: false},
+	{Name: "../vulnerability.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the vulnerability trie/tree. This tree has a
+# type (the database or naming scheme of the vulnerability, e.g., ` + "`" + `cve` + "`" + `,
+# ` + "`" + `ghsa` + "`" + `, ` + "`" + `osv` + "`" + `) and, at the bottom, the identifier of the vulnerability in
+# that scheme.
+
+"""
+Vulnerability represents the root of the vulnerability trie/tree.
+
+This node is a singleton: backends guarantee that there is exactly one node
+with the same ` + "`" + `type` + "`" + ` value.
+
+The ` + "`" + `type` + "`" + ` field is canonicalized to be lowercase.
+"""
+type Vulnerability {
+  id: ID!
+  type: String!
+  vulnerabilityIDs: [VulnerabilityID!]!
+}
+
+"""
+VulnerabilityID is the identifier of a vulnerability inside a type (e.g.,
+` + "`" + `cve-2023-1234` + "`" + ` for the ` + "`" + `cve` + "`" + ` type).
+
+The ` + "`" + `vulnerabilityID` + "`" + ` field is canonicalized to be lowercase.
+
+This is the only vulnerability trie node that can be referenced by other
+parts of GUAC.
+"""
+type VulnerabilityID {
+  id: ID!
+  vulnerabilityID: String!
+}
+
+"""
+VulnerabilitySpec allows filtering the list of vulnerabilities to return.
+
+Both fields are canonicalized to lowercase before matching.
+"""
+input VulnerabilitySpec {
+  id: ID
+  type: String
+  vulnerabilityID: String
+}
+
+"""
+VulnerabilityInputSpec specifies a vulnerability for a mutation.
+
+Both fields are canonicalized to lowercase before being stored.
+"""
+input VulnerabilityInputSpec {
+  type: String!
+  vulnerabilityID: String!
+}
+
+extend type Query {
+  "Returns all vulnerabilities matching a filter."
+  vulnerabilities(vulnSpec: VulnerabilitySpec): [Vulnerability!]!
+}
+
+extend type Mutation {
+  """
+  Ingests a new vulnerability and returns the path in the trie to the
+  corresponding vulnerability ID node. Ingesting an existing vulnerability is a
+  no-op.
+  """
+  ingestVulnerability(vuln: VulnerabilityInputSpec): Vulnerability!
 }
 `, BuiltIn: false},
 }
@@ -19,6 +19,7 @@ package main
 // development. Do not use in production!
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,9 +27,17 @@ import (
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/metrics"
 	neo4j "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
+	"github.com/guacsec/guac/pkg/assembler/backends/otel"
+	"github.com/guacsec/guac/pkg/assembler/backends/retry"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 const defaultPort = "8080"
@@ -40,26 +49,61 @@ func main() {
 	}
 
 	// TODO: use viper and flags
-	args := neo4j.Neo4jCredentials{
-		User:   "neo4j",
-		Pass:   "s3cr3t",
-		Realm:  "neo4j",
-		DBAddr: "neo4j://localhost:7687",
+	var backend backends.Backend
+	var err error
+	if dsn := os.Getenv("POSTGRES_DSN"); dsn != "" {
+		backend, err = ent.New(context.Background(), &ent.PostgresConfig{DSN: dsn})
+		if err != nil {
+			fmt.Printf("Error creating Postgres Backend: %v", err)
+			os.Exit(1)
+		}
+	} else {
+		args := neo4j.Neo4jConfig{
+			User:   "neo4j",
+			Pass:   "s3cr3t",
+			Realm:  "neo4j",
+			DBAddr: "neo4j://localhost:7687",
+		}
+		if os.Getenv("CONTENT_IDS") != "" {
+			// Give the same IDs to the nodes as any other backend.
+			args.IDStrategy = backends.ContentIDs
+		}
+		backend, err = neo4j.New(context.Background(), &args)
+		if err != nil {
+			fmt.Printf("Error creating Neo4J Backend: %v", err)
+			os.Exit(1)
+		}
+		// Retry the deadlocks and leader switches outlasting the
+		// retries of the driver.
+		backend = retry.Wrap(backend, retry.RetryOptions{})
 	}
-	backend, err := neo4j.GetBackend(&args)
-	if err != nil {
-		fmt.Printf("Error creating Neo4J Backend: %v", err)
-		os.Exit(1)
+
+	if os.Getenv("TRACE_BACKEND") != "" {
+		// Log a span for each call of the backend.
+		logger := logging.FromContext(logging.WithLogger(context.Background()))
+		provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(otel.NewLogExporter(logger)))
+		backend = otel.Wrap(backend, provider.Tracer("github.com/guacsec/guac/pkg/assembler/backends"))
 	}
 
+	// Count the calls of the backend, for the Prometheus scrapers.
+	registry := prometheus.NewRegistry()
+	backend = metrics.Wrap(backend, registry)
+
 	topResolver := resolvers.Resolver{Backend: backend}
 
 	config := generated.Config{Resolvers: &topResolver}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv.SetErrorPresenter(resolvers.ErrorPresenter)
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
 	http.Handle("/query", srv)
+	http.Handle("/healthz", resolvers.HealthHandler(backend, resolvers.DefaultHealthTimeout))
+	http.Handle("/export", resolvers.ExportHandler(backend))
+	http.Handle("/metrics", metrics.Handler(registry))
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
+	log.Printf("health of the backend is reported at http://localhost:%s/healthz", port)
+	log.Printf("subgraphs are exported as NDJSON by posting to http://localhost:%s/export", port)
+	log.Printf("metrics of the backend are served at http://localhost:%s/metrics", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
 }
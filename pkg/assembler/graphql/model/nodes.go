@@ -2,6 +2,13 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
 // Currently artifacts and packages can depend on each other. Hence, we need a union for this edge.
 type ArtifactOrPackage interface {
 	IsArtifactOrPackage()
@@ -15,6 +22,14 @@ type MetadataPayload interface {
 	IsMetadataPayload()
 }
 
+// Node is any of the nodes of the software trees and of the evidence trees.
+//
+// Nodes in the package, source and vulnerability tries are returned as the path
+// from the root of their trie down to them.
+type Node interface {
+	IsNode()
+}
+
 // NodeInfo contains fields that are common for any GUAC node. These are metadata
 // information that allows identifying the collector details from which a node
 // gets created after parsing a document.
@@ -28,38 +43,73 @@ type NodeInfo interface {
 	GetCollectorInfo() *string
 }
 
-// Artifact nodes represent artifacts. These are files on disk (cf. Package).
-// There could be artifacts not included in any package.
+// PackageOrArtifact is a union of Package and Artifact.
+type PackageOrArtifact interface {
+	IsPackageOrArtifact()
+}
+
+// PackageOrSource is a union of Package and Source.
+type PackageOrSource interface {
+	IsPackageOrSource()
+}
+
+// PackageSourceOrArtifact is a union of Package, Source and Artifact.
+type PackageSourceOrArtifact interface {
+	IsPackageSourceOrArtifact()
+}
+
+// Artifact represents the artifact and contains a digest field
+//
+// Both field are mandatory and canonicalized to be lowercase.
+//
+// If having a `checksum` Go object, `algorithm` can be
+// `strings.ToLower(string(checksum.Algorithm))` and `digest` can be
+// `checksum.Value`.
 type Artifact struct {
-	// digest is the identifier of an artifact. It is in the format
-	// `algorithm:value` where `algorithm` is the hashing algorithm (e.g. "sha1")
-	// and `value` is the digest obtained by using this algorithm.
-	//
-	// Note: in the future we might change this to contain a collection of hashes,
-	// at which point we will need other ways to resolve an artifact given a digest.
-	Digest string `json:"digest"`
-	// name of the artifact
-	Name *string `json:"name"`
-	// tags associated with the artifact
-	Tags          []string            `json:"tags"`
-	SourceInfo    *string             `json:"sourceInfo"`
-	CollectorInfo *string             `json:"collectorInfo"`
-	BuiltBy       []*Builder          `json:"builtBy"`
-	DependsOn     []ArtifactOrPackage `json:"dependsOn"`
+	ID        string `json:"id"`
+	Algorithm string `json:"algorithm"`
+	Digest    string `json:"digest"`
 }
 
-func (Artifact) IsNodeInfo() {}
+func (Artifact) IsPackageSourceOrArtifact() {}
 
-// sourceInfo is the file location for the document from which the node was
-// created.
-func (this Artifact) GetSourceInfo() *string { return this.SourceInfo }
+func (Artifact) IsPackageOrArtifact() {}
 
-// collectorInfo is the collector from which the file that created the node came
-// from
-func (this Artifact) GetCollectorInfo() *string { return this.CollectorInfo }
+func (Artifact) IsNode() {}
 
 func (Artifact) IsArtifactOrPackage() {}
 
+// ArtifactConnection is a page of artifacts returned by artifactsList.
+type ArtifactConnection struct {
+	Edges    []*ArtifactEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+// ArtifactEdge is an artifact in an ArtifactConnection, with the cursor to pass
+// as the after argument of artifactsList to get the artifacts following it.
+type ArtifactEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Artifact `json:"node"`
+}
+
+// ArtifactInputSpec is the same as Artifact, but used as mutation input.
+//
+// Both arguments will be canonicalized to lowercase before being stored. The
+// digest must be a hex encoded value.
+type ArtifactInputSpec struct {
+	Algorithm string `json:"algorithm"`
+	Digest    string `json:"digest"`
+}
+
+// ArtifactSpec allows filtering the list of artifacts to return.
+//
+// Both arguments will be canonicalized to lowercase before matching.
+type ArtifactSpec struct {
+	ID        *string `json:"id"`
+	Algorithm *string `json:"algorithm"`
+	Digest    *string `json:"digest"`
+}
+
 // Attestation nodes represent attestations about artifacts and packages.
 type Attestation struct {
 	// digest is the identifier of an attestation, uniquely identifying it.
@@ -89,25 +139,435 @@ func (this Attestation) GetSourceInfo() *string { return this.SourceInfo }
 // from
 func (this Attestation) GetCollectorInfo() *string { return this.CollectorInfo }
 
-// Builder nodes represent builders of artifacts (from provenance documents).
+// Builder represents the builder (e.g., FRSCA or GitHub Actions) which produced
+// an artifact, as recorded in SLSA provenance.
+//
+// Builders are identified by their URI.
 type Builder struct {
-	// type is type of builder. Coupled with id, it uniquely identifies the builder.
-	Type string `json:"type"`
-	// id is the id of builder. Coupled with type, it uniquely identifies the builder.
-	ID            string  `json:"id"`
-	SourceInfo    *string `json:"sourceInfo"`
-	CollectorInfo *string `json:"collectorInfo"`
+	ID  string `json:"id"`
+	URI string `json:"uri"`
 }
 
-func (Builder) IsNodeInfo() {}
+func (Builder) IsNode() {}
 
-// sourceInfo is the file location for the document from which the node was
-// created.
-func (this Builder) GetSourceInfo() *string { return this.SourceInfo }
+// BuilderInputSpec is the same as Builder, but used as mutation input.
+type BuilderInputSpec struct {
+	URI string `json:"uri"`
+}
 
-// collectorInfo is the collector from which the file that created the node came
-// from
-func (this Builder) GetCollectorInfo() *string { return this.CollectorInfo }
+// BuilderSpec allows filtering the list of builders to return.
+type BuilderSpec struct {
+	ID  *string `json:"id"`
+	URI *string `json:"uri"`
+}
+
+// CertifyBad is an attestation that a package, source or artifact is considered
+// bad.
+//
+// If the subject is a package, it is either a package version or, for
+// certifications which apply to all the versions of the package, a package
+// name (with no versions).
+//
+// justification describes why the subject is considered bad.
+type CertifyBad struct {
+	ID            string                  `json:"id"`
+	Subject       PackageSourceOrArtifact `json:"subject"`
+	Justification string                  `json:"justification"`
+	Origin        string                  `json:"origin"`
+	Collector     string                  `json:"collector"`
+}
+
+func (CertifyBad) IsNode() {}
+
+// CertifyBadInputSpec is the same as CertifyBad but for mutation input.
+type CertifyBadInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// CertifyBadSpec allows filtering the list of CertifyBad to return.
+//
+// At most one of the package, source and artifact subjects can be specified.
+//
+// Certifications on a package name apply to all its versions, so they are
+// returned for any package filter matching the name, even if the filter also
+// specifies a version.
+type CertifyBadSpec struct {
+	ID            *string                      `json:"id"`
+	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
+	Justification *string                      `json:"justification"`
+	Origin        *string                      `json:"origin"`
+	Collector     *string                      `json:"collector"`
+}
+
+// CertifyGood is an attestation that a package, source or artifact is
+// considered good.
+//
+// If the subject is a package, it is either a package version or, for
+// certifications which apply to all the versions of the package, a package
+// name (with no versions).
+//
+// justification describes why the subject is considered good.
+type CertifyGood struct {
+	ID            string                  `json:"id"`
+	Subject       PackageSourceOrArtifact `json:"subject"`
+	Justification string                  `json:"justification"`
+	Origin        string                  `json:"origin"`
+	Collector     string                  `json:"collector"`
+}
+
+func (CertifyGood) IsNode() {}
+
+// CertifyGoodInputSpec is the same as CertifyGood but for mutation input.
+type CertifyGoodInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// CertifyGoodSpec allows filtering the list of CertifyGood to return.
+//
+// The subject is matched as in CertifyBadSpec.
+type CertifyGoodSpec struct {
+	ID            *string                      `json:"id"`
+	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
+	Justification *string                      `json:"justification"`
+	Origin        *string                      `json:"origin"`
+	Collector     *string                      `json:"collector"`
+}
+
+// CertifyLegal is an attestation of the license information of a package
+// version or a source.
+//
+// declaredLicense is the license declared by the subject (e.g., in its package
+// manifest) and discoveredLicense is the license expression of the licenses
+// found in its files. attribution is the attribution text (e.g., the copyright
+// notices) of the subject and justification describes how the information was
+// obtained.
+//
+// Every scan is recorded as a separate node: scanning the same subject again
+// does not replace the results of previous scans.
+type CertifyLegal struct {
+	ID                string          `json:"id"`
+	Subject           PackageOrSource `json:"subject"`
+	DeclaredLicense   string          `json:"declaredLicense"`
+	DiscoveredLicense string          `json:"discoveredLicense"`
+	Attribution       string          `json:"attribution"`
+	Justification     string          `json:"justification"`
+	TimeScanned       time.Time       `json:"timeScanned"`
+	Origin            string          `json:"origin"`
+	Collector         string          `json:"collector"`
+}
+
+func (CertifyLegal) IsNode() {}
+
+// CertifyLegalInputSpec is the same as CertifyLegal but for mutation input.
+type CertifyLegalInputSpec struct {
+	DeclaredLicense   string    `json:"declaredLicense"`
+	DiscoveredLicense string    `json:"discoveredLicense"`
+	Attribution       string    `json:"attribution"`
+	Justification     string    `json:"justification"`
+	TimeScanned       time.Time `json:"timeScanned"`
+	Origin            string    `json:"origin"`
+	Collector         string    `json:"collector"`
+}
+
+// CertifyLegalSpec allows filtering the list of CertifyLegal to return.
+//
+// At most one of the package and source subjects can be specified.
+type CertifyLegalSpec struct {
+	ID                *string              `json:"id"`
+	Subject           *PackageOrSourceSpec `json:"subject"`
+	DeclaredLicense   *string              `json:"declaredLicense"`
+	DiscoveredLicense *string              `json:"discoveredLicense"`
+	Attribution       *string              `json:"attribution"`
+	Justification     *string              `json:"justification"`
+	TimeScanned       *time.Time           `json:"timeScanned"`
+	Origin            *string              `json:"origin"`
+	Collector         *string              `json:"collector"`
+}
+
+// CertifyScorecard is an attestation that a source repository has been scanned
+// by OpenSSF Scorecard.
+//
+// Scorecard is run regularly, so a source can have multiple certifications:
+// runs for a different commit or at a different time are recorded as separate
+// nodes.
+type CertifyScorecard struct {
+	ID        string     `json:"id"`
+	Source    *Source    `json:"source"`
+	Scorecard *Scorecard `json:"scorecard"`
+}
+
+func (CertifyScorecard) IsNode() {}
+
+// CertifyScorecardSpec allows filtering the list of CertifyScorecard to return.
+//
+// minAggregateScore only returns the scorecards with an aggregate score greater
+// than or equal to the given value.
+//
+// If latestOnly is true, only the most recent of the matching scorecards of
+// each source is returned. Among the ones scanned at the same time, the first one
+// ingested is kept.
+type CertifyScorecardSpec struct {
+	ID                *string     `json:"id"`
+	Source            *SourceSpec `json:"source"`
+	MinAggregateScore *float64    `json:"minAggregateScore"`
+	ScorecardVersion  *string     `json:"scorecardVersion"`
+	Commit            *string     `json:"commit"`
+	Origin            *string     `json:"origin"`
+	Collector         *string     `json:"collector"`
+	LatestOnly        *bool       `json:"latestOnly"`
+}
+
+// CertifyVEXStatement is an attestation of the impact of a vulnerability on a
+// package version or an artifact.
+//
+// statement is the free form description of the impact (or of the actions
+// needed to fix it) and knownSince is the time since which the status holds.
+//
+// Every statement is recorded as a separate node: stating a new status for the
+// same subject and vulnerability does not replace the previous statements, so
+// that their history is preserved.
+type CertifyVEXStatement struct {
+	ID               string            `json:"id"`
+	Subject          PackageOrArtifact `json:"subject"`
+	Vulnerability    *Vulnerability    `json:"vulnerability"`
+	Status           VexStatus         `json:"status"`
+	VexJustification VexJustification  `json:"vexJustification"`
+	Statement        string            `json:"statement"`
+	KnownSince       time.Time         `json:"knownSince"`
+	Origin           string            `json:"origin"`
+	Collector        string            `json:"collector"`
+}
+
+func (CertifyVEXStatement) IsNode() {}
+
+// CertifyVEXStatementSpec allows filtering the list of CertifyVEXStatement to
+// return.
+//
+// At most one of the package and artifact subjects can be specified.
+// knownSinceFrom and knownSinceUntil restrict the results to the statements
+// known since a time in a window; both bounds are inclusive and optional.
+type CertifyVEXStatementSpec struct {
+	ID               *string                `json:"id"`
+	Subject          *PackageOrArtifactSpec `json:"subject"`
+	Vulnerability    *VulnerabilitySpec     `json:"vulnerability"`
+	Status           *VexStatus             `json:"status"`
+	VexJustification *VexJustification      `json:"vexJustification"`
+	Statement        *string                `json:"statement"`
+	KnownSinceFrom   *time.Time             `json:"knownSinceFrom"`
+	KnownSinceUntil  *time.Time             `json:"knownSinceUntil"`
+	Origin           *string                `json:"origin"`
+	Collector        *string                `json:"collector"`
+}
+
+// CertifyVuln is an attestation that a package version is affected by a
+// vulnerability, as found by a scan.
+//
+// Every scan is recorded as a separate node: scanning the same package again
+// (e.g., with a newer version of the vulnerability database) does not replace
+// the results of previous scans.
+type CertifyVuln struct {
+	ID            string         `json:"id"`
+	Package       *Package       `json:"package"`
+	Vulnerability *Vulnerability `json:"vulnerability"`
+	Metadata      *ScanMetadata  `json:"metadata"`
+}
+
+func (CertifyVuln) IsNode() {}
+
+// CertifyVulnSpec allows filtering the list of CertifyVuln to return.
+//
+// timeScannedSince and timeScannedUntil restrict the results to the scans made
+// in a time window; both bounds are inclusive and optional.
+//
+// If latestOnly is true, only the certifications of the most recent of the
+// matching scans of each package version are returned, whatever their
+// vulnerabilities, so that the findings of the older scans, including the ones a
+// newer scan no longer reports, are hidden. All the certifications scanned at
+// the time of the most recent one are kept, as a scan finding several
+// vulnerabilities is ingested as one certification for each.
+type CertifyVulnSpec struct {
+	ID               *string            `json:"id"`
+	Package          *PkgSpec           `json:"package"`
+	Vulnerability    *VulnerabilitySpec `json:"vulnerability"`
+	TimeScannedSince *time.Time         `json:"timeScannedSince"`
+	TimeScannedUntil *time.Time         `json:"timeScannedUntil"`
+	DbURI            *string            `json:"dbUri"`
+	DbVersion        *string            `json:"dbVersion"`
+	ScannerURI       *string            `json:"scannerUri"`
+	ScannerVersion   *string            `json:"scannerVersion"`
+	Origin           *string            `json:"origin"`
+	Collector        *string            `json:"collector"`
+	LatestOnly       *bool              `json:"latestOnly"`
+}
+
+// HasMetadata is an attestation that a package, source or artifact has a certain
+// attribute, for the facts which do not warrant a dedicated node.
+//
+// If the subject is a package, it is either a package version or, for metadata
+// which apply to all the versions of the package, a package name (with no
+// versions).
+//
+// key is the name of the attribute and value its value. since is the time from
+// which the attribute is known to hold, and justification describes how it was
+// determined.
+//
+// Nodes are deduplicated on the subject, key, value and justification, so a key
+// can have several values for the same subject.
+type HasMetadata struct {
+	ID            string                  `json:"id"`
+	Subject       PackageSourceOrArtifact `json:"subject"`
+	Key           string                  `json:"key"`
+	Value         string                  `json:"value"`
+	Since         time.Time               `json:"since"`
+	Justification string                  `json:"justification"`
+	Origin        string                  `json:"origin"`
+	Collector     string                  `json:"collector"`
+}
+
+func (HasMetadata) IsNode() {}
+
+// HasMetadataInputSpec is the same as HasMetadata but for mutation input.
+type HasMetadataInputSpec struct {
+	Key           string    `json:"key"`
+	Value         string    `json:"value"`
+	Since         time.Time `json:"since"`
+	Justification string    `json:"justification"`
+	Origin        string    `json:"origin"`
+	Collector     string    `json:"collector"`
+}
+
+// HasMetadataSpec allows filtering the list of HasMetadata to return.
+//
+// At most one of the package, source and artifact subjects can be specified.
+//
+// Metadata of a package name apply to all its versions, so they are returned for
+// any package filter matching the name, even if the filter also specifies a
+// version.
+type HasMetadataSpec struct {
+	ID            *string                      `json:"id"`
+	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
+	Key           *string                      `json:"key"`
+	Value         *string                      `json:"value"`
+	Since         *time.Time                   `json:"since"`
+	Justification *string                      `json:"justification"`
+	Origin        *string                      `json:"origin"`
+	Collector     *string                      `json:"collector"`
+}
+
+// HasSBOM is an attestation that a package or an artifact is described by an
+// SBOM document.
+//
+// The subject is a package version or an artifact. A subject can have multiple
+// SBOMs (e.g., generated by different tools or over time), each recorded as a
+// separate node.
+//
+// uri is the location of the SBOM document, downloadLocation is the location
+// from which the SBOM document was fetched and algorithm and digest identify
+// the contents of the document.
+type HasSbom struct {
+	ID               string            `json:"id"`
+	Subject          PackageOrArtifact `json:"subject"`
+	URI              string            `json:"uri"`
+	Algorithm        string            `json:"algorithm"`
+	Digest           string            `json:"digest"`
+	DownloadLocation string            `json:"downloadLocation"`
+	Origin           string            `json:"origin"`
+	Collector        string            `json:"collector"`
+}
+
+func (HasSbom) IsNode() {}
+
+// HasSBOMInputSpec is the same as HasSBOM but for mutation input.
+type HasSBOMInputSpec struct {
+	URI              string `json:"uri"`
+	Algorithm        string `json:"algorithm"`
+	Digest           string `json:"digest"`
+	DownloadLocation string `json:"downloadLocation"`
+	Origin           string `json:"origin"`
+	Collector        string `json:"collector"`
+}
+
+// HasSBOMSpec allows filtering the list of HasSBOM to return.
+//
+// At most one of the package and artifact subjects can be specified.
+type HasSBOMSpec struct {
+	ID               *string                `json:"id"`
+	Subject          *PackageOrArtifactSpec `json:"subject"`
+	URI              *string                `json:"uri"`
+	Algorithm        *string                `json:"algorithm"`
+	Digest           *string                `json:"digest"`
+	DownloadLocation *string                `json:"downloadLocation"`
+	Origin           *string                `json:"origin"`
+	Collector        *string                `json:"collector"`
+}
+
+// HasSLSA records that a subject artifact has SLSA provenance, linking it to the
+// builder which produced it.
+type HasSlsa struct {
+	ID      string    `json:"id"`
+	Subject *Artifact `json:"subject"`
+	Slsa    *Slsa     `json:"slsa"`
+}
+
+func (HasSlsa) IsNode() {}
+
+// HasSLSASpec allows filtering the list of HasSLSA to return.
+//
+// Every builtFrom filter must match one of the materials of the build.
+type HasSLSASpec struct {
+	ID          *string         `json:"id"`
+	Subject     *ArtifactSpec   `json:"subject"`
+	BuiltFrom   []*ArtifactSpec `json:"builtFrom"`
+	BuiltBy     *BuilderSpec    `json:"builtBy"`
+	BuildType   *string         `json:"buildType"`
+	SlsaVersion *string         `json:"slsaVersion"`
+	Origin      *string         `json:"origin"`
+	Collector   *string         `json:"collector"`
+}
+
+// HashEqual is an attestation that a set of artifacts are identical.
+//
+// This is used when different tools identify the same file by digests computed
+// with different algorithms (e.g., sha1 and sha256). The relation is symmetric:
+// the order of the artifacts is not significant.
+type HashEqual struct {
+	ID            string      `json:"id"`
+	Artifacts     []*Artifact `json:"artifacts"`
+	Justification string      `json:"justification"`
+	Origin        string      `json:"origin"`
+	Collector     string      `json:"collector"`
+}
+
+func (HashEqual) IsNode() {}
+
+// HashEqualInputSpec is the same as HashEqual but for mutation input.
+type HashEqualInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// HashEqualSpec allows filtering the list of HashEqual to return.
+//
+// At most two artifacts can be specified. Every artifact filter must match a
+// different artifact of the HashEqual, regardless of the order.
+//
+// If expandTransitive is true, the HashEqual matching the spec are followed by
+// all the ones transitively linked to their artifacts, which match the
+// justification, origin and collector filters: the results are the equalities
+// of the whole equivalence classes of the artifacts.
+type HashEqualSpec struct {
+	ID               *string         `json:"id"`
+	Artifacts        []*ArtifactSpec `json:"artifacts"`
+	Justification    *string         `json:"justification"`
+	Origin           *string         `json:"origin"`
+	Collector        *string         `json:"collector"`
+	ExpandTransitive *bool           `json:"expandTransitive"`
+}
 
 // Identity nodes are ....
 type Identity struct {
@@ -139,6 +599,95 @@ func (this Identity) GetSourceInfo() *string { return this.SourceInfo }
 // from
 func (this Identity) GetCollectorInfo() *string { return this.CollectorInfo }
 
+// IsDependency is an attestation that a package depends on another package.
+//
+// package is the package version which has the dependency. dependentPackage is
+// the package it depends on, at the package name level, as a dependency is
+// usually expressed as a range of versions instead of a single version.
+//
+// versionRange is the range of versions of dependentPackage which satisfy the
+// dependency, stored verbatim as it was found in the document declaring the
+// dependency. The syntax of the range is specific to each package ecosystem.
+//
+// justification describes why the dependency exists, origin is the document the
+// dependency was extracted from and collector is the collector which ingested
+// it.
+type IsDependency struct {
+	ID               string         `json:"id"`
+	Package          *Package       `json:"package"`
+	DependentPackage *Package       `json:"dependentPackage"`
+	VersionRange     string         `json:"versionRange"`
+	DependencyType   DependencyType `json:"dependencyType"`
+	Justification    string         `json:"justification"`
+	Origin           string         `json:"origin"`
+	Collector        string         `json:"collector"`
+}
+
+func (IsDependency) IsNode() {}
+
+// IsDependencyInputSpec is the same as IsDependency but for mutation input.
+type IsDependencyInputSpec struct {
+	VersionRange   string         `json:"versionRange"`
+	DependencyType DependencyType `json:"dependencyType"`
+	Justification  string         `json:"justification"`
+	Origin         string         `json:"origin"`
+	Collector      string         `json:"collector"`
+}
+
+// IsDependencySpec allows filtering the list of IsDependency to return.
+//
+// The dependentPackage filter only looks at the type, namespace and name of the
+// package, the version and subpath filters are ignored.
+type IsDependencySpec struct {
+	ID               *string         `json:"id"`
+	Package          *PkgSpec        `json:"package"`
+	DependentPackage *PkgSpec        `json:"dependentPackage"`
+	VersionRange     *string         `json:"versionRange"`
+	DependencyType   *DependencyType `json:"dependencyType"`
+	Justification    *string         `json:"justification"`
+	Origin           *string         `json:"origin"`
+	Collector        *string         `json:"collector"`
+}
+
+// IsOccurrence is an attestation that a package version or a source is
+// materialized as an artifact.
+//
+// For example, a binary built from a source repository, or the archive
+// downloaded for a package version, are occurrences of the source or package in
+// the form of the artifact.
+//
+// justification describes how the occurrence was determined and origin is the
+// document it was extracted from.
+type IsOccurrence struct {
+	ID            string          `json:"id"`
+	Subject       PackageOrSource `json:"subject"`
+	Artifact      *Artifact       `json:"artifact"`
+	Justification string          `json:"justification"`
+	Origin        string          `json:"origin"`
+	Collector     string          `json:"collector"`
+}
+
+func (IsOccurrence) IsNode() {}
+
+// IsOccurrenceInputSpec is the same as IsOccurrence but for mutation input.
+type IsOccurrenceInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// IsOccurrenceSpec allows filtering the list of IsOccurrence to return.
+//
+// At most one of the package and source subjects can be specified.
+type IsOccurrenceSpec struct {
+	ID            *string              `json:"id"`
+	Subject       *PackageOrSourceSpec `json:"subject"`
+	Artifact      *ArtifactSpec        `json:"artifact"`
+	Justification *string              `json:"justification"`
+	Origin        *string              `json:"origin"`
+	Collector     *string              `json:"collector"`
+}
+
 // Metadata nodes represent metadata about an artifact. These are extracted from attestations.
 type Metadata struct {
 	// type is type of metadata. Coupled with id, it uniquely identifies the metadata.
@@ -162,40 +711,438 @@ func (this Metadata) GetSourceInfo() *string { return this.SourceInfo }
 // from
 func (this Metadata) GetCollectorInfo() *string { return this.CollectorInfo }
 
-// Package nodes represent packages. These are packages from a package repository
-// (cf. Artifact). Upon installing a package one or multiple artifacts could be
-// generated.
+// Package represents a package.
+//
+// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
+// The `type` field matches the pURL types but we might also use `"guac"` for the
+// cases where the pURL representation is not complete or when we have custom
+// rules.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Package`, not `PackageType`. This is only to make
+// queries more readable.
 type Package struct {
-	// purl is the Package identifier, in purl format. Uniquely identifies the package.
-	Purl string `json:"purl"`
-	// name of the package
-	Name *string `json:"name"`
-	// version of the package
-	Version *string `json:"version"`
-	// digests for the package
-	Digest []string `json:"digest"`
-	// CPEs represent CPEs for the package
-	CPEs []string `json:"CPEs"`
-	// tags associated with the artifact
-	Tags          []string            `json:"tags"`
-	SourceInfo    *string             `json:"sourceInfo"`
-	CollectorInfo *string             `json:"collectorInfo"`
-	Contains      []*Artifact         `json:"contains"`
-	DependsOn     []ArtifactOrPackage `json:"dependsOn"`
+	ID         string              `json:"id"`
+	Type       string              `json:"type"`
+	Namespaces []*PackageNamespace `json:"namespaces"`
 }
 
-func (Package) IsNodeInfo() {}
+func (Package) IsPackageSourceOrArtifact() {}
 
-// sourceInfo is the file location for the document from which the node was
-// created.
-func (this Package) GetSourceInfo() *string { return this.SourceInfo }
+func (Package) IsPackageOrArtifact() {}
 
-// collectorInfo is the collector from which the file that created the node came
-// from
-func (this Package) GetCollectorInfo() *string { return this.CollectorInfo }
+func (Package) IsPackageOrSource() {}
+
+func (Package) IsNode() {}
 
 func (Package) IsArtifactOrPackage() {}
 
+// PackageName is a name for packages.
+//
+// In the pURL representation, each PackageName matches the
+// `pkg:<type>/<namespace>/<name>` pURL.
+//
+// Names are always mandatory.
+//
+// This is the first node in the trie that can be referred to by other parts of
+// GUAC.
+type PackageName struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Versions []*PackageVersion `json:"versions"`
+}
+
+// PackageNamespace is a namespace for packages.
+//
+// In the pURL representation, each PackageNamespace matches the
+// `pkg:<type>/<namespace>/` partial pURL.
+//
+// Namespaces are optional and type specific. Because they are optional, we use
+// empty string to denote missing namespaces.
+type PackageNamespace struct {
+	ID        string         `json:"id"`
+	Namespace string         `json:"namespace"`
+	Names     []*PackageName `json:"names"`
+}
+
+// PackageOrArtifactInput allows using PackageOrArtifact union as input type to
+// be used in mutations.
+//
+// Exactly one of the value must be set to non-nil.
+type PackageOrArtifactInput struct {
+	Package  *PkgInputSpec      `json:"package"`
+	Artifact *ArtifactInputSpec `json:"artifact"`
+}
+
+// PackageOrArtifactSpec allows using PackageOrArtifact union as input type to be
+// used in read queries.
+//
+// Exactly one of the value must be set to non-nil.
+type PackageOrArtifactSpec struct {
+	Package  *PkgSpec      `json:"package"`
+	Artifact *ArtifactSpec `json:"artifact"`
+}
+
+// PackageOrSourceInput allows using PackageOrSource union as input type to be
+// used in mutations.
+//
+// Exactly one of the value must be set to non-nil.
+type PackageOrSourceInput struct {
+	Package *PkgInputSpec    `json:"package"`
+	Source  *SourceInputSpec `json:"source"`
+}
+
+// PackageOrSourceSpec allows using PackageOrSource union as input type to be
+// used in read queries.
+//
+// Exactly one of the value must be set to non-nil.
+type PackageOrSourceSpec struct {
+	Package *PkgSpec    `json:"package"`
+	Source  *SourceSpec `json:"source"`
+}
+
+// PackageQualifier is a qualifier for a package, a key-value pair.
+//
+// In the pURL representation, it is a part of the `<qualifiers>` part of the
+// `pkg:<type>/<namespace>/<name>@<version>?<qualifiers>` pURL.
+//
+// Qualifiers are optional, each Package type defines own rules for handling them,
+// and multiple qualifiers could be attached to the same package.
+//
+// This node cannot be directly referred by other parts of GUAC.
+type PackageQualifier struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PackageQualifierInputSpec is the same as PackageQualifier, but usable as
+// mutation input.
+//
+// GraphQL does not allow the same type to be both input and output.
+type PackageQualifierInputSpec struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PackageQualifierSpec is the same as PackageQualifier, but usable as query
+// input. A `null` value matches the qualifiers with the key and any value.
+type PackageQualifierSpec struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+// PackageSourceOrArtifactInput allows using PackageSourceOrArtifact union as
+// input type to be used in mutations.
+//
+// Exactly one of the values must be set to non-nil.
+type PackageSourceOrArtifactInput struct {
+	Package  *PkgInputSpec      `json:"package"`
+	Source   *SourceInputSpec   `json:"source"`
+	Artifact *ArtifactInputSpec `json:"artifact"`
+}
+
+// PackageSourceOrArtifactSpec allows using PackageSourceOrArtifact union as
+// input type to be used in read queries.
+//
+// At most one of the values can be set to non-nil.
+type PackageSourceOrArtifactSpec struct {
+	Package  *PkgSpec      `json:"package"`
+	Source   *SourceSpec   `json:"source"`
+	Artifact *ArtifactSpec `json:"artifact"`
+}
+
+// PackageVersion is a package version.
+//
+// In the pURL representation, each PackageName matches the
+// `pkg:<type>/<namespace>/<name>@<version>` pURL.
+//
+// Versions are optional and each Package type defines own rules for handling them.
+// For this level of GUAC, these are just opaque strings.
+//
+// This node can be referred to by other parts of GUAC.
+//
+// Subpath and qualifiers are optional. Lack of qualifiers is represented by an
+// empty list and lack of subpath by empty string (to be consistent with
+// optionality of namespace and version). Two nodes that have different qualifiers
+// and/or subpath but the same version mean different packages in the trie
+// (respectively). Similarly, two nodes that have different version but the same
+// qualifiers and subpath also mean different packages in the trie.
+type PackageVersion struct {
+	ID         string              `json:"id"`
+	Version    string              `json:"version"`
+	Qualifiers []*PackageQualifier `json:"qualifiers"`
+	Subpath    string              `json:"subpath"`
+}
+
+// PageInfo describes a page of results of a paginated query.
+//
+// hasNextPage is true if there are results after this page. endCursor is the
+// cursor of the last result of the page, which can be passed as the after
+// argument of the query to get the next page. It is null if the page is empty.
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// PkgEqual is an attestation that a set of packages are the same package.
+//
+// This is used when the same package is named by different purls (e.g., a
+// pkg:maven and a pkg:oci purl). The relation is symmetric: both packages must
+// be versions and the order in which they are ingested is not significant.
+type PkgEqual struct {
+	ID            string     `json:"id"`
+	Packages      []*Package `json:"packages"`
+	Justification string     `json:"justification"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+}
+
+func (PkgEqual) IsNode() {}
+
+// PkgEqualInputSpec is the same as PkgEqual but for mutation input.
+type PkgEqualInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// PkgEqualSpec allows filtering the list of PkgEqual to return.
+//
+// At most two packages can be specified. Every package filter must match a
+// different package of the PkgEqual, regardless of the order. The packages of
+// the results are returned in the order of the filters, so the package matching
+// the first filter is always the first one.
+//
+// If expandTransitive is true, the PkgEqual matching the spec are followed by
+// all the ones transitively linked to their packages, which match the
+// justification, origin and collector filters: the results are the equalities
+// of the whole equivalence classes of the packages.
+type PkgEqualSpec struct {
+	ID               *string    `json:"id"`
+	Packages         []*PkgSpec `json:"packages"`
+	Justification    *string    `json:"justification"`
+	Origin           *string    `json:"origin"`
+	Collector        *string    `json:"collector"`
+	ExpandTransitive *bool      `json:"expandTransitive"`
+}
+
+// PkgInputSpec specifies a package for a mutation.
+//
+// This is different than PkgSpec because we want to encode mandatory fields:
+// `type` and `name`. All optional fields are given empty default values.
+//
+// The fields are trimmed and canonicalized before being stored, following the
+// pURL specification: the type is lowercase, and so are the namespace and name
+// of the types where they are case insensitive, e.g. `npm` but not `maven`.
+// Qualifiers get lowercase keys and are sorted, the ones with an empty value
+// being dropped, so semantically identical packages are the same node.
+type PkgInputSpec struct {
+	Type       string                       `json:"type"`
+	Namespace  *string                      `json:"namespace"`
+	Name       string                       `json:"name"`
+	Version    *string                      `json:"version"`
+	Qualifiers []*PackageQualifierInputSpec `json:"qualifiers"`
+	Subpath    *string                      `json:"subpath"`
+}
+
+// PkgSpec allows filtering the list of packages to return.
+//
+// Each field matches a qualifier from pURL. Use `null` to match on all values at
+// that level. For example, to get all packages in GUAC backend, use a PkgSpec
+// where every field is `null`.
+//
+// Empty string at a field means matching with the empty string.
+//
+// Only the nodes that match the filter, together with all the nodes on the path
+// from the root of the trie to them, are returned.
+//
+// A version matches `qualifiers` if it has all of the listed qualifiers, and
+// possibly others. If `matchOnlyEmptyQualifiers` is `true`, `qualifiers` is
+// ignored and only the versions without any qualifier match. Either field makes
+// the spec filter on the version level.
+//
+// Instead of the individual fields, the `packages` query accepts a pURL in
+// `purl`, which is converted to the equivalent filter. Setting both `purl` and
+// any other field is an error. The qualifiers of the pURL are matched like the
+// ones in `qualifiers`.
+//
+// The fields are canonicalized like the ones of PkgInputSpec before matching.
+// Without a `type`, the namespace and name are only trimmed.
+type PkgSpec struct {
+	Type                     *string                 `json:"type"`
+	Namespace                *string                 `json:"namespace"`
+	Name                     *string                 `json:"name"`
+	Version                  *string                 `json:"version"`
+	Qualifiers               []*PackageQualifierSpec `json:"qualifiers"`
+	MatchOnlyEmptyQualifiers *bool                   `json:"matchOnlyEmptyQualifiers"`
+	Subpath                  *string                 `json:"subpath"`
+	Purl                     *string                 `json:"purl"`
+}
+
+// PointOfContact records who to contact about a package, source or artifact.
+//
+// If the subject is a package, it is either a package version or, for contacts
+// which apply to all the versions of the package, a package name (with no
+// versions).
+//
+// email is the email address of the contact and info describes their role (e.g.
+// "maintainer" or "security team"). since is the time from which the contact
+// information is known to be valid, and justification describes how it was
+// obtained.
+type PointOfContact struct {
+	ID            string                  `json:"id"`
+	Subject       PackageSourceOrArtifact `json:"subject"`
+	Email         string                  `json:"email"`
+	Info          string                  `json:"info"`
+	Since         time.Time               `json:"since"`
+	Justification string                  `json:"justification"`
+	Origin        string                  `json:"origin"`
+	Collector     string                  `json:"collector"`
+}
+
+func (PointOfContact) IsNode() {}
+
+// PointOfContactInputSpec is the same as PointOfContact but for mutation input.
+type PointOfContactInputSpec struct {
+	Email         string    `json:"email"`
+	Info          string    `json:"info"`
+	Since         time.Time `json:"since"`
+	Justification string    `json:"justification"`
+	Origin        string    `json:"origin"`
+	Collector     string    `json:"collector"`
+}
+
+// PointOfContactSpec allows filtering the list of PointOfContact to return.
+//
+// At most one of the package, source and artifact subjects can be specified.
+//
+// Contacts for a package name apply to all its versions, so they are returned
+// for any package filter matching the name, even if the filter also specifies a
+// version.
+type PointOfContactSpec struct {
+	ID            *string                      `json:"id"`
+	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
+	Email         *string                      `json:"email"`
+	Info          *string                      `json:"info"`
+	Since         *time.Time                   `json:"since"`
+	Justification *string                      `json:"justification"`
+	Origin        *string                      `json:"origin"`
+	Collector     *string                      `json:"collector"`
+}
+
+// PurlPackage is the package resolved for a purl by the packagesByPurls query.
+type PurlPackage struct {
+	Purl string `json:"purl"`
+	// The path in the trie to the package, as for a PkgSpec filter on the purl.
+	Package *Package `json:"package"`
+}
+
+// SLSA contains the SLSA provenance of a build.
+//
+// builtFrom are the materials of the build and builtBy is the builder which ran
+// it. slsaPredicate is the rest of the predicate of the provenance, flattened to
+// key/value pairs. startedOn and finishedOn are not set if the provenance does
+// not record them.
+type Slsa struct {
+	BuiltFrom     []*Artifact      `json:"builtFrom"`
+	BuiltBy       *Builder         `json:"builtBy"`
+	BuildType     string           `json:"buildType"`
+	SlsaPredicate []*SLSAPredicate `json:"slsaPredicate"`
+	SlsaVersion   string           `json:"slsaVersion"`
+	StartedOn     *time.Time       `json:"startedOn"`
+	FinishedOn    *time.Time       `json:"finishedOn"`
+	Origin        string           `json:"origin"`
+	Collector     string           `json:"collector"`
+}
+
+// SLSAInputSpec is the same as SLSA but for mutation input.
+type SLSAInputSpec struct {
+	BuildType     string                    `json:"buildType"`
+	SlsaPredicate []*SLSAPredicateInputSpec `json:"slsaPredicate"`
+	SlsaVersion   string                    `json:"slsaVersion"`
+	StartedOn     *time.Time                `json:"startedOn"`
+	FinishedOn    *time.Time                `json:"finishedOn"`
+	Origin        string                    `json:"origin"`
+	Collector     string                    `json:"collector"`
+}
+
+// SLSAPredicate is a key/value pair of the predicate of a SLSA provenance.
+type SLSAPredicate struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SLSAPredicateInputSpec is the same as SLSAPredicate but for mutation input.
+type SLSAPredicateInputSpec struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ScanMetadata is the metadata attached to vulnerability certifications.
+//
+// It contains the time of the scan, the URI and version of the vulnerability
+// database used by the scanner, and the URI and version of the scanner.
+type ScanMetadata struct {
+	TimeScanned    time.Time `json:"timeScanned"`
+	DbURI          string    `json:"dbUri"`
+	DbVersion      string    `json:"dbVersion"`
+	ScannerURI     string    `json:"scannerUri"`
+	ScannerVersion string    `json:"scannerVersion"`
+	Origin         string    `json:"origin"`
+	Collector      string    `json:"collector"`
+}
+
+// ScanMetadataInput is the same as ScanMetadata but for mutation input.
+type ScanMetadataInput struct {
+	TimeScanned    time.Time `json:"timeScanned"`
+	DbURI          string    `json:"dbUri"`
+	DbVersion      string    `json:"dbVersion"`
+	ScannerURI     string    `json:"scannerUri"`
+	ScannerVersion string    `json:"scannerVersion"`
+	Origin         string    `json:"origin"`
+	Collector      string    `json:"collector"`
+}
+
+// Scorecard contains the results of a Scorecard run.
+//
+// aggregateScore is the overall score of the repository and checks contains the
+// score of every individual check. commit is the commit of the repository which
+// has been scanned, scorecardVersion is the version of the Scorecard tool.
+type Scorecard struct {
+	Checks           []*ScorecardCheck `json:"checks"`
+	AggregateScore   float64           `json:"aggregateScore"`
+	TimeScanned      time.Time         `json:"timeScanned"`
+	ScorecardVersion string            `json:"scorecardVersion"`
+	Commit           string            `json:"commit"`
+	Origin           string            `json:"origin"`
+	Collector        string            `json:"collector"`
+}
+
+// ScorecardCheck is the score of an individual Scorecard check.
+type ScorecardCheck struct {
+	Check string `json:"check"`
+	Score int    `json:"score"`
+}
+
+// ScorecardCheckInputSpec is the same as ScorecardCheck but for mutation input.
+type ScorecardCheckInputSpec struct {
+	Check string `json:"check"`
+	Score int    `json:"score"`
+}
+
+// ScorecardInputSpec is the same as Scorecard but for mutation input.
+type ScorecardInputSpec struct {
+	Checks           []*ScorecardCheckInputSpec `json:"checks"`
+	AggregateScore   float64                    `json:"aggregateScore"`
+	TimeScanned      time.Time                  `json:"timeScanned"`
+	ScorecardVersion string                     `json:"scorecardVersion"`
+	Commit           string                     `json:"commit"`
+	Origin           string                     `json:"origin"`
+	Collector        string                     `json:"collector"`
+}
+
 // ScorecardPayload are payloads of Scorecards metadata.
 type ScorecardPayload struct {
 	Repo             string  `json:"repo"`
@@ -207,6 +1154,92 @@ type ScorecardPayload struct {
 
 func (ScorecardPayload) IsMetadataPayload() {}
 
+// Source represents a source.
+//
+// This can be the version control system that is being used.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Source`, not `SourceType`. This is only to make
+// queries more readable.
+type Source struct {
+	ID         string             `json:"id"`
+	Type       string             `json:"type"`
+	Namespaces []*SourceNamespace `json:"namespaces"`
+}
+
+func (Source) IsPackageSourceOrArtifact() {}
+
+func (Source) IsPackageOrSource() {}
+
+func (Source) IsNode() {}
+
+// SourceInputSpec specifies a source for a mutation.
+//
+// This is different than SourceSpec because we want to encode that all fields
+// except tag and commit are mandatory fields. All optional fields are given
+// empty default values.
+//
+// It is an error to set both `tag` and `commit` fields to values different than
+// the default.
+//
+// All the fields are trimmed. The type is canonicalized to lowercase, replacing
+// the full names of the version control systems by their commands (e.g.,
+// `mercurial` by `hg`), and the commit is canonicalized to lowercase.
+type SourceInputSpec struct {
+	Type      string  `json:"type"`
+	Namespace string  `json:"namespace"`
+	Name      string  `json:"name"`
+	Tag       *string `json:"tag"`
+	Commit    *string `json:"commit"`
+}
+
+// SourceName is a url of the repository and its tag or commit.
+//
+// The `name` field is mandatory. The `tag` and `commit` fields are optional, but
+// it is an error to specify both.
+//
+// This is the only source trie node that can be referenced by other parts of
+// GUAC.
+type SourceName struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Tag    *string `json:"tag"`
+	Commit *string `json:"commit"`
+}
+
+// SourceNamespace is a namespace for sources.
+//
+// This is the location of the repository (such as github/gitlab/bitbucket).
+//
+// The `namespace` field is mandatory.
+type SourceNamespace struct {
+	ID        string        `json:"id"`
+	Namespace string        `json:"namespace"`
+	Names     []*SourceName `json:"names"`
+}
+
+// SourceSpec allows filtering the list of sources to return.
+//
+// Empty string at a field means matching with the empty string. Missing field
+// means retrieving all possible matches.
+//
+// It is an error to specify both `tag` and `commit` fields, except if both are
+// set as empty string (in which case the returned sources are only those for
+// which there is no tag/commit information).
+//
+// All the fields are trimmed. The type is canonicalized to lowercase, replacing
+// the full names of the version control systems by their commands (e.g.,
+// `mercurial` by `hg`), and the commit is canonicalized to lowercase.
+type SourceSpec struct {
+	Type      *string `json:"type"`
+	Namespace *string `json:"namespace"`
+	Name      *string `json:"name"`
+	Tag       *string `json:"tag"`
+	Commit    *string `json:"commit"`
+}
+
 // VEXInvocation contains details about a VEX scan invocation
 type VEXInvocation struct {
 	Parameters []string `json:"parameters"`
@@ -242,20 +1275,367 @@ type VEXVulnerability struct {
 	Aliases []string `json:"aliases"`
 }
 
-// Vulnerability nodes represent vulnerability information
+// VexStatementInputSpec is the same as CertifyVEXStatement but for mutation
+// input.
+//
+// A NOT_AFFECTED statement must have a justification, either as
+// vexJustification or as statement.
+type VexStatementInputSpec struct {
+	Status           VexStatus        `json:"status"`
+	VexJustification VexJustification `json:"vexJustification"`
+	Statement        string           `json:"statement"`
+	KnownSince       time.Time        `json:"knownSince"`
+	Origin           string           `json:"origin"`
+	Collector        string           `json:"collector"`
+}
+
+// VulnEqual is an attestation that two vulnerabilities are the same.
+//
+// This is used when different databases identify the same vulnerability with
+// different IDs, usually of different types (e.g., a CVE, a GHSA and an OSV
+// ID). The relation is symmetric: the order of the vulnerabilities is not
+// significant.
+type VulnEqual struct {
+	ID              string           `json:"id"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+	Justification   string           `json:"justification"`
+	Origin          string           `json:"origin"`
+	Collector       string           `json:"collector"`
+}
+
+func (VulnEqual) IsNode() {}
+
+// VulnEqualInputSpec is the same as VulnEqual but for mutation input.
+type VulnEqualInputSpec struct {
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// VulnEqualSpec allows filtering the list of VulnEqual to return.
+//
+// At most two vulnerabilities can be specified. Every vulnerability filter must
+// match a different vulnerability of the VulnEqual, regardless of the order.
+type VulnEqualSpec struct {
+	ID              *string              `json:"id"`
+	Vulnerabilities []*VulnerabilitySpec `json:"vulnerabilities"`
+	Justification   *string              `json:"justification"`
+	Origin          *string              `json:"origin"`
+	Collector       *string              `json:"collector"`
+}
+
+// Vulnerability represents the root of the vulnerability trie/tree.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// The `type` field is canonicalized to be lowercase.
 type Vulnerability struct {
-	// id is the id of a vulnerability (cve??)
-	ID            string  `json:"id"`
-	SourceInfo    *string `json:"sourceInfo"`
-	CollectorInfo *string `json:"collectorInfo"`
+	ID               string             `json:"id"`
+	Type             string             `json:"type"`
+	VulnerabilityIDs []*VulnerabilityID `json:"vulnerabilityIDs"`
 }
 
-func (Vulnerability) IsNodeInfo() {}
+func (Vulnerability) IsNode() {}
 
-// sourceInfo is the file location for the document from which the node was
-// created.
-func (this Vulnerability) GetSourceInfo() *string { return this.SourceInfo }
+// VulnerabilityID is the identifier of a vulnerability inside a type (e.g.,
+// `cve-2023-1234` for the `cve` type).
+//
+// The `vulnerabilityID` field is canonicalized to be lowercase.
+//
+// This is the only vulnerability trie node that can be referenced by other
+// parts of GUAC.
+type VulnerabilityID struct {
+	ID              string `json:"id"`
+	VulnerabilityID string `json:"vulnerabilityID"`
+}
 
-// collectorInfo is the collector from which the file that created the node came
-// from
-func (this Vulnerability) GetCollectorInfo() *string { return this.CollectorInfo }
+// VulnerabilityInputSpec specifies a vulnerability for a mutation.
+//
+// Both fields are canonicalized to lowercase before being stored.
+type VulnerabilityInputSpec struct {
+	Type            string `json:"type"`
+	VulnerabilityID string `json:"vulnerabilityID"`
+}
+
+// VulnerabilitySpec allows filtering the list of vulnerabilities to return.
+//
+// Both fields are canonicalized to lowercase before matching.
+type VulnerabilitySpec struct {
+	ID              *string `json:"id"`
+	Type            *string `json:"type"`
+	VulnerabilityID *string `json:"vulnerabilityID"`
+}
+
+// DependencyType determines the type of the dependency.
+type DependencyType string
+
+const (
+	// direct dependency
+	DependencyTypeDirect DependencyType = "DIRECT"
+	// indirect dependency
+	DependencyTypeIndirect DependencyType = "INDIRECT"
+	// type not known/not specified
+	DependencyTypeUnknown DependencyType = "UNKNOWN"
+)
+
+var AllDependencyType = []DependencyType{
+	DependencyTypeDirect,
+	DependencyTypeIndirect,
+	DependencyTypeUnknown,
+}
+
+func (e DependencyType) IsValid() bool {
+	switch e {
+	case DependencyTypeDirect, DependencyTypeIndirect, DependencyTypeUnknown:
+		return true
+	}
+	return false
+}
+
+func (e DependencyType) String() string {
+	return string(e)
+}
+
+func (e *DependencyType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DependencyType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DependencyType", str)
+	}
+	return nil
+}
+
+func (e DependencyType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Edge is the label of an edge between two nodes, used to restrict the edges
+// traversed by path and neighbors.
+//
+// The trie labels connect the nodes of the package, source and vulnerability
+// tries to their parent. Every other label connects an evidence node to each of
+// the nodes it references, like a CertifyVuln to its package version and to its
+// vulnerability ID.
+type Edge string
+
+const (
+	EdgePackageTrie         Edge = "PACKAGE_TRIE"
+	EdgeSourceTrie          Edge = "SOURCE_TRIE"
+	EdgeVulnerabilityTrie   Edge = "VULNERABILITY_TRIE"
+	EdgeCertifyBad          Edge = "CERTIFY_BAD"
+	EdgeCertifyGood         Edge = "CERTIFY_GOOD"
+	EdgeCertifyLegal        Edge = "CERTIFY_LEGAL"
+	EdgeCertifyScorecard    Edge = "CERTIFY_SCORECARD"
+	EdgeCertifyVexStatement Edge = "CERTIFY_VEX_STATEMENT"
+	EdgeCertifyVuln         Edge = "CERTIFY_VULN"
+	EdgeHashEqual           Edge = "HASH_EQUAL"
+	EdgeHasMetadata         Edge = "HAS_METADATA"
+	EdgeHasSbom             Edge = "HAS_SBOM"
+	EdgeHasSlsa             Edge = "HAS_SLSA"
+	EdgeIsDependency        Edge = "IS_DEPENDENCY"
+	EdgeIsOccurrence        Edge = "IS_OCCURRENCE"
+	EdgePkgEqual            Edge = "PKG_EQUAL"
+	EdgePointOfContact      Edge = "POINT_OF_CONTACT"
+	EdgeVulnEqual           Edge = "VULN_EQUAL"
+)
+
+var AllEdge = []Edge{
+	EdgePackageTrie,
+	EdgeSourceTrie,
+	EdgeVulnerabilityTrie,
+	EdgeCertifyBad,
+	EdgeCertifyGood,
+	EdgeCertifyLegal,
+	EdgeCertifyScorecard,
+	EdgeCertifyVexStatement,
+	EdgeCertifyVuln,
+	EdgeHashEqual,
+	EdgeHasMetadata,
+	EdgeHasSbom,
+	EdgeHasSlsa,
+	EdgeIsDependency,
+	EdgeIsOccurrence,
+	EdgePkgEqual,
+	EdgePointOfContact,
+	EdgeVulnEqual,
+}
+
+func (e Edge) IsValid() bool {
+	switch e {
+	case EdgePackageTrie, EdgeSourceTrie, EdgeVulnerabilityTrie, EdgeCertifyBad, EdgeCertifyGood, EdgeCertifyLegal, EdgeCertifyScorecard, EdgeCertifyVexStatement, EdgeCertifyVuln, EdgeHashEqual, EdgeHasMetadata, EdgeHasSbom, EdgeHasSlsa, EdgeIsDependency, EdgeIsOccurrence, EdgePkgEqual, EdgePointOfContact, EdgeVulnEqual:
+		return true
+	}
+	return false
+}
+
+func (e Edge) String() string {
+	return string(e)
+}
+
+func (e *Edge) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Edge(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Edge", str)
+	}
+	return nil
+}
+
+func (e Edge) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// PkgMatchType determines the level of the package trie a certification is
+// attached to.
+//
+// ALL_VERSIONS attaches the certification to the package name, so that it
+// applies to all the versions of the package, including the ones ingested
+// later. SPECIFIC_VERSION attaches it to the version given in the input.
+type PkgMatchType string
+
+const (
+	PkgMatchTypeAllVersions     PkgMatchType = "ALL_VERSIONS"
+	PkgMatchTypeSpecificVersion PkgMatchType = "SPECIFIC_VERSION"
+)
+
+var AllPkgMatchType = []PkgMatchType{
+	PkgMatchTypeAllVersions,
+	PkgMatchTypeSpecificVersion,
+}
+
+func (e PkgMatchType) IsValid() bool {
+	switch e {
+	case PkgMatchTypeAllVersions, PkgMatchTypeSpecificVersion:
+		return true
+	}
+	return false
+}
+
+func (e PkgMatchType) String() string {
+	return string(e)
+}
+
+func (e *PkgMatchType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PkgMatchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PkgMatchType", str)
+	}
+	return nil
+}
+
+func (e PkgMatchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VexJustification explains why a subject is not affected by a vulnerability,
+// as defined by the VEX specification. NOT_PROVIDED is used for the other
+// statuses, or when the justification is only given in the statement.
+type VexJustification string
+
+const (
+	VexJustificationComponentNotPresent                         VexJustification = "COMPONENT_NOT_PRESENT"
+	VexJustificationVulnerableCodeNotPresent                    VexJustification = "VULNERABLE_CODE_NOT_PRESENT"
+	VexJustificationVulnerableCodeNotInExecutePath              VexJustification = "VULNERABLE_CODE_NOT_IN_EXECUTE_PATH"
+	VexJustificationVulnerableCodeCannotBeControlledByAdversary VexJustification = "VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY"
+	VexJustificationInlineMitigationsAlreadyExist               VexJustification = "INLINE_MITIGATIONS_ALREADY_EXIST"
+	VexJustificationNotProvided                                 VexJustification = "NOT_PROVIDED"
+)
+
+var AllVexJustification = []VexJustification{
+	VexJustificationComponentNotPresent,
+	VexJustificationVulnerableCodeNotPresent,
+	VexJustificationVulnerableCodeNotInExecutePath,
+	VexJustificationVulnerableCodeCannotBeControlledByAdversary,
+	VexJustificationInlineMitigationsAlreadyExist,
+	VexJustificationNotProvided,
+}
+
+func (e VexJustification) IsValid() bool {
+	switch e {
+	case VexJustificationComponentNotPresent, VexJustificationVulnerableCodeNotPresent, VexJustificationVulnerableCodeNotInExecutePath, VexJustificationVulnerableCodeCannotBeControlledByAdversary, VexJustificationInlineMitigationsAlreadyExist, VexJustificationNotProvided:
+		return true
+	}
+	return false
+}
+
+func (e VexJustification) String() string {
+	return string(e)
+}
+
+func (e *VexJustification) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VexJustification(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VexJustification", str)
+	}
+	return nil
+}
+
+func (e VexJustification) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VexStatus is the status of a subject with respect to a vulnerability, as
+// defined by the VEX specification.
+type VexStatus string
+
+const (
+	VexStatusNotAffected        VexStatus = "NOT_AFFECTED"
+	VexStatusAffected           VexStatus = "AFFECTED"
+	VexStatusFixed              VexStatus = "FIXED"
+	VexStatusUnderInvestigation VexStatus = "UNDER_INVESTIGATION"
+)
+
+var AllVexStatus = []VexStatus{
+	VexStatusNotAffected,
+	VexStatusAffected,
+	VexStatusFixed,
+	VexStatusUnderInvestigation,
+}
+
+func (e VexStatus) IsValid() bool {
+	switch e {
+	case VexStatusNotAffected, VexStatusAffected, VexStatusFixed, VexStatusUnderInvestigation:
+		return true
+	}
+	return false
+}
+
+func (e VexStatus) String() string {
+	return string(e)
+}
+
+func (e *VexStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VexStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VexStatus", str)
+	}
+	return nil
+}
+
+func (e VexStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
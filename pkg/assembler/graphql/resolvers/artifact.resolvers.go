@@ -0,0 +1,41 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestArtifact is the resolver for the ingestArtifact field.
+func (r *mutationResolver) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	return r.Backend.IngestArtifact(ctx, artifact)
+}
+
+// IngestArtifacts is the resolver for the ingestArtifacts field.
+func (r *mutationResolver) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	return r.Backend.IngestArtifacts(ctx, artifacts)
+}
+
+// ArtifactsList is the resolver for the artifactsList field.
+func (r *queryResolver) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	return r.Backend.ArtifactsList(ctx, artifactSpec, after, first)
+}
+
+// ArtifactIngested is the resolver for the artifactIngested field.
+func (r *subscriptionResolver) ArtifactIngested(ctx context.Context) (<-chan *model.Artifact, error) {
+	return r.Backend.SubscribeArtifacts(ctx)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type mutationResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
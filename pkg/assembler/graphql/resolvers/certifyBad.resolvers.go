@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestCertifyBad is the resolver for the ingestCertifyBad field.
+func (r *mutationResolver) IngestCertifyBad(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, certifyBad *model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	return r.Backend.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+}
+
+// CertifyBad is the resolver for the CertifyBad field.
+func (r *queryResolver) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return r.Backend.CertifyBad(ctx, certifyBadSpec)
+}
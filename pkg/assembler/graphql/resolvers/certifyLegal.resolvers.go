@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestCertifyLegal is the resolver for the ingestCertifyLegal field.
+func (r *mutationResolver) IngestCertifyLegal(ctx context.Context, subject *model.PackageOrSourceInput, certifyLegal *model.CertifyLegalInputSpec) (*model.CertifyLegal, error) {
+	return r.Backend.IngestCertifyLegal(ctx, subject, certifyLegal)
+}
+
+// CertifyLegal is the resolver for the CertifyLegal field.
+func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return r.Backend.CertifyLegal(ctx, certifyLegalSpec)
+}
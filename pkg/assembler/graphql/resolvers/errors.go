@@ -0,0 +1,59 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The codes set as the "code" extension of the errors returned by the
+// backends, so that clients can tell them apart without parsing the messages.
+const (
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeNotFound         = "NOT_FOUND"
+	CodeDuplicate        = "DUPLICATE"
+)
+
+// ErrorPresenter is the error presenter of the GraphQL server. On top of the
+// default presentation, it maps the errors of the backends to a "code"
+// extension, and sets the "field" extension of the validation errors to the
+// offending field.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var validationErr *backends.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		setExtension(gqlErr, "code", CodeValidationFailed)
+		setExtension(gqlErr, "field", validationErr.Field)
+	case errors.Is(err, backends.ErrNotFound):
+		setExtension(gqlErr, "code", CodeNotFound)
+	case errors.Is(err, backends.ErrDuplicate):
+		setExtension(gqlErr, "code", CodeDuplicate)
+	}
+	return gqlErr
+}
+
+func setExtension(err *gqlerror.Error, key string, value interface{}) {
+	if err.Extensions == nil {
+		err.Extensions = map[string]interface{}{}
+	}
+	err.Extensions[key] = value
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/documents"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ExportHandler serves the subgraph reachable from the subjects matching the
+// PackageSourceOrArtifactSpec posted as JSON as an NDJSON stream of GUAC
+// ingest documents, see documents.ExportSubgraph. An empty body exports all
+// the trees. Invalid specs are answered 400. The documents are sent as they
+// are exported, so an export failing after the first one aborts the response.
+func ExportHandler(backend backends.Backend) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var rootSpec *model.PackageSourceOrArtifactSpec
+		if err := json.NewDecoder(r.Body).Decode(&rootSpec); err != nil && err != io.EOF {
+			http.Error(w, "invalid spec: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		// http.Error replaces the content type of the errors sent before
+		// the first line.
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		sw := &exportWriter{w: w}
+		export, err := documents.ExportSubgraph(r.Context(), backend, rootSpec)
+		if err == nil {
+			_, err = io.Copy(sw, export)
+		}
+		if err == nil {
+			return
+		}
+		if sw.written {
+			// The status is sent, so the stream is cut short for the
+			// client not to take it for the whole subgraph.
+			log.Printf("export failed: %v", err)
+			panic(http.ErrAbortHandler)
+		}
+		var validationErr *backends.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Printf("export failed: %v", err)
+		http.Error(w, "export failed", http.StatusInternalServerError)
+	})
+}
+
+// exportWriter sends the NDJSON stream to the client as it is written,
+// remembering whether the status has been sent with the first line.
+type exportWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	e.written = true
+	return e.w.Write(p)
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/inmem"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestExportHandler(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcd"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.IngestBuilder(ctx, &model.BuilderInputSpec{URI: "https://github.com/actions/runner"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{{
+		name:       "artifact",
+		method:     http.MethodPost,
+		body:       `{"artifact": {"digest": "abcd"}}`,
+		wantStatus: http.StatusOK,
+		wantBody:   `{"artifact":{"algorithm":"sha256","digest":"abcd"}}` + "\n",
+	}, {
+		name:       "all the trees",
+		method:     http.MethodPost,
+		wantStatus: http.StatusOK,
+		// The builder is not referenced by any evidence, so it is not
+		// reachable from the packages, sources and artifacts.
+		wantBody: `{"artifact":{"algorithm":"sha256","digest":"abcd"}}` + "\n",
+	}, {
+		name:       "conflicting subjects",
+		method:     http.MethodPost,
+		body:       `{"package": {}, "artifact": {}}`,
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "malformed spec",
+		method:     http.MethodPost,
+		body:       `{"artifact"`,
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "GET",
+		method:     http.MethodGet,
+		wantStatus: http.StatusMethodNotAllowed,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ExportHandler(b).ServeHTTP(w, httptest.NewRequest(tt.method, "/export", strings.NewReader(tt.body)))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ExportHandler() status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("ExportHandler() body = %s, want %s", w.Body, tt.wantBody)
+			}
+		})
+	}
+}
+
+// neighborsFailingBackend fails to walk the edges of the nodes.
+type neighborsFailingBackend struct {
+	backends.Backend
+}
+
+func (neighborsFailingBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestExportHandler_FailureAfterFirstDocument(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.New(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcd"}); err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Errorf("ExportHandler() panicked with %v, want %v", r, http.ErrAbortHandler)
+		}
+		// The artifact was sent before walking its edges.
+		if want := `{"artifact":{"algorithm":"sha256","digest":"abcd"}}` + "\n"; w.Body.String() != want {
+			t.Errorf("ExportHandler() body = %s, want %s", w.Body, want)
+		}
+	}()
+	ExportHandler(neighborsFailingBackend{b}).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/export", nil))
+}
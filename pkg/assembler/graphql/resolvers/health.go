@@ -0,0 +1,51 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// DefaultHealthTimeout bounds the ping of the backend when the request has no
+// earlier deadline.
+const DefaultHealthTimeout = 5 * time.Second
+
+// HealthHandler answers the liveness and readiness probes of the GraphQL
+// server. It pings the backend and answers 200 if it is reachable within
+// timeout and 503 otherwise. A non-positive timeout is DefaultHealthTimeout.
+func HealthHandler(backend backends.Backend, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		timeout = DefaultHealthTimeout
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		if err := backend.Ping(ctx); err != nil {
+			log.Printf("backend health check failed: %v", err)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("unavailable\n"))
+			return
+		}
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
@@ -0,0 +1,70 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// pingBackend only implements Ping, by calling ping.
+type pingBackend struct {
+	backends.Backend
+	ping func(ctx context.Context) error
+}
+
+func (b *pingBackend) Ping(ctx context.Context) error {
+	return b.ping(ctx)
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		ping       func(ctx context.Context) error
+		wantStatus int
+	}{{
+		name:       "reachable",
+		ping:       func(ctx context.Context) error { return nil },
+		wantStatus: http.StatusOK,
+	}, {
+		name:       "unreachable",
+		ping:       func(ctx context.Context) error { return errors.New("connection refused") },
+		wantStatus: http.StatusServiceUnavailable,
+	}, {
+		name: "hung",
+		ping: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		wantStatus: http.StatusServiceUnavailable,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := HealthHandler(&pingBackend{ping: tt.ping}, 10*time.Millisecond)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("HealthHandler() status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestIsDependency is the resolver for the ingestIsDependency field.
+func (r *mutationResolver) IngestIsDependency(ctx context.Context, pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependency *model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	return r.Backend.IngestIsDependency(ctx, pkg, depPkg, dependency)
+}
+
+// IsDependency is the resolver for the IsDependency field.
+func (r *queryResolver) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return r.Backend.IsDependency(ctx, isDependencySpec)
+}
+
+// TransitiveDependencies is the resolver for the transitiveDependencies field.
+func (r *queryResolver) TransitiveDependencies(ctx context.Context, pkgSpec model.PkgSpec, maxDepth int) ([]*model.Package, error) {
+	spec, err := helpers.ExpandPkgSpecPurl(&pkgSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("TransitiveDependencies :: %s", err)
+	}
+	return r.Backend.TransitiveDependencies(ctx, spec, maxDepth)
+}
@@ -0,0 +1,57 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestPackage is the resolver for the ingestPackage field.
+func (r *mutationResolver) IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error) {
+	return r.Backend.IngestPackage(ctx, pkg)
+}
+
+// IngestPackages is the resolver for the ingestPackages field.
+func (r *mutationResolver) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return r.Backend.IngestPackages(ctx, pkgs)
+}
+
+// Packages is the resolver for the packages field.
+func (r *queryResolver) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	pkgSpec, err := helpers.ExpandPkgSpecPurl(pkgSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("Packages :: %s", err)
+	}
+	return r.Backend.Packages(ctx, pkgSpec)
+}
+
+// PackagesByPurls is the resolver for the packagesByPurls field.
+func (r *queryResolver) PackagesByPurls(ctx context.Context, purls []string) ([]*model.PurlPackage, error) {
+	found, err := r.Backend.PackagesByPurls(ctx, purls)
+	if err != nil {
+		var validationErr *backends.ValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, err
+		}
+		// The invalid purls do not prevent the others from resolving.
+		graphql.AddError(ctx, err)
+	}
+	out := make([]*model.PurlPackage, 0, len(found))
+	seen := map[string]bool{}
+	for _, purl := range purls {
+		if p, ok := found[purl]; ok && !seen[purl] {
+			out = append(out, &model.PurlPackage{Purl: purl, Package: p})
+			seen[purl] = true
+		}
+	}
+	return out, nil
+}
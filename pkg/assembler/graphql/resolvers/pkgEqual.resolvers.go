@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestPkgEqual is the resolver for the ingestPkgEqual field.
+func (r *mutationResolver) IngestPkgEqual(ctx context.Context, pkg *model.PkgInputSpec, otherPackage *model.PkgInputSpec, pkgEqual *model.PkgEqualInputSpec) (*model.PkgEqual, error) {
+	return r.Backend.IngestPkgEqual(ctx, pkg, otherPackage, pkgEqual)
+}
+
+// PkgEqual is the resolver for the PkgEqual field.
+func (r *queryResolver) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return r.Backend.PkgEqual(ctx, pkgEqualSpec)
+}
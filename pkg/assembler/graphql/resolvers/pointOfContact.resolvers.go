@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestPointOfContact is the resolver for the ingestPointOfContact field.
+func (r *mutationResolver) IngestPointOfContact(ctx context.Context, subject *model.PackageSourceOrArtifactInput, pkgMatchType *model.PkgMatchType, pointOfContact *model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return r.Backend.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+}
+
+// PointOfContact is the resolver for the PointOfContact field.
+func (r *queryResolver) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return r.Backend.PointOfContact(ctx, pointOfContactSpec)
+}
@@ -12,8 +12,8 @@ import (
 )
 
 // Artifacts is the resolver for the artifacts field.
-func (r *queryResolver) Artifacts(ctx context.Context) ([]*model.Artifact, error) {
-	return r.Backend.Artifacts(ctx)
+func (r *queryResolver) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return r.Backend.Artifacts(ctx, artifactSpec)
 }
 
 // Query returns generated.QueryResolver implementation.
@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.22
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestVulnEqual is the resolver for the ingestVulnEqual field.
+func (r *mutationResolver) IngestVulnEqual(ctx context.Context, vulnerability *model.VulnerabilityInputSpec, otherVulnerability *model.VulnerabilityInputSpec, vulnEqual *model.VulnEqualInputSpec) (*model.VulnEqual, error) {
+	return r.Backend.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+}
+
+// VulnEqual is the resolver for the VulnEqual field.
+func (r *queryResolver) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return r.Backend.VulnEqual(ctx, vulnEqualSpec)
+}
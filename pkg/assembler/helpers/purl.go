@@ -0,0 +1,125 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package helpers contains functions converting between the GraphQL model
+// and other representations of the GUAC nodes.
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	purl "github.com/package-url/packageurl-go"
+)
+
+// PurlToPkg converts a package URL to the input spec of the package.
+// Qualifiers are returned sorted by key, as in the canonical form of the purl.
+func PurlToPkg(p string) (*model.PkgInputSpec, error) {
+	u, err := purl.FromString(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid purl %q: %w", p, err)
+	}
+
+	pkg := &model.PkgInputSpec{
+		Type:      u.Type,
+		Namespace: nilIfEmpty(u.Namespace),
+		Name:      u.Name,
+		Version:   nilIfEmpty(u.Version),
+		Subpath:   nilIfEmpty(u.Subpath),
+	}
+	for _, q := range u.Qualifiers {
+		pkg.Qualifiers = append(pkg.Qualifiers, &model.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+	}
+	return pkg, nil
+}
+
+// PkgToPurl is the inverse of PurlToPkg.
+func PkgToPurl(pkg *model.PkgInputSpec) string {
+	qualifiers := map[string]string{}
+	for _, q := range pkg.Qualifiers {
+		qualifiers[q.Key] = q.Value
+	}
+	u := purl.NewPackageURL(pkg.Type, derefOrEmpty(pkg.Namespace), pkg.Name,
+		derefOrEmpty(pkg.Version), purl.QualifiersFromMap(qualifiers), derefOrEmpty(pkg.Subpath))
+	return u.ToString()
+}
+
+// PurlToPkgSpec converts a package URL to a filter matching the package. The
+// version and subpath are only matched if the purl has them, so a purl
+// without version matches all the versions of the package. Likewise, the
+// qualifiers of the purl are matched among the ones of the versions, which
+// may have others.
+func PurlToPkgSpec(p string) (*model.PkgSpec, error) {
+	pkg, err := PurlToPkg(p)
+	if err != nil {
+		return nil, err
+	}
+	var qualifiers []*model.PackageQualifierSpec
+	for _, q := range pkg.Qualifiers {
+		value := q.Value
+		qualifiers = append(qualifiers, &model.PackageQualifierSpec{Key: q.Key, Value: &value})
+	}
+	namespace := derefOrEmpty(pkg.Namespace)
+	return &model.PkgSpec{
+		Type:       &pkg.Type,
+		Namespace:  &namespace,
+		Name:       &pkg.Name,
+		Version:    pkg.Version,
+		Qualifiers: qualifiers,
+		Subpath:    pkg.Subpath,
+	}, nil
+}
+
+// ExpandPkgSpecPurl returns the filter to use for a spec which might have its
+// purl field set. The purl is converted by PurlToPkgSpec, and setting it
+// together with any of the individual fields is an error.
+func ExpandPkgSpecPurl(pkgSpec *model.PkgSpec) (*model.PkgSpec, error) {
+	if pkgSpec == nil || pkgSpec.Purl == nil {
+		return pkgSpec, nil
+	}
+	if pkgSpec.Type != nil || pkgSpec.Namespace != nil || pkgSpec.Name != nil || pkgSpec.Version != nil || pkgSpec.Subpath != nil ||
+		len(pkgSpec.Qualifiers) > 0 || pkgSpec.MatchOnlyEmptyQualifiers != nil {
+		return nil, fmt.Errorf("cannot filter on both purl and package fields")
+	}
+	return PurlToPkgSpec(*pkgSpec.Purl)
+}
+
+// GuacPkgPurl returns the purl identifying a package which has no purl of its
+// own, e.g. an SBOM component listed only by name. The package is put under
+// the "pkg" namespace of the "guac" purl type, followed by the namespace of the
+// package if it has one:
+//
+//	pkg:guac/pkg/<namespace>/<name>@<version>
+func GuacPkgPurl(namespace, name, version string) string {
+	ns := "pkg"
+	if namespace != "" {
+		ns += "/" + namespace
+	}
+	return purl.NewPackageURL("guac", ns, name, version, nil, "").ToString()
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,236 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestPurlToPkg(t *testing.T) {
+	tests := []struct {
+		name string
+		purl string
+		want *model.PkgInputSpec
+		// wantPurl is the canonical form of the purl, if different.
+		wantPurl string
+	}{{
+		name: "golang module with slashes in the namespace",
+		purl: "pkg:golang/github.com/foo/bar@v1.2.3",
+		want: &model.PkgInputSpec{
+			Type:      "golang",
+			Namespace: ptrfrom("github.com/foo"),
+			Name:      "bar",
+			Version:   ptrfrom("v1.2.3"),
+		},
+	}, {
+		name: "golang module with subpath",
+		purl: "pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+		want: &model.PkgInputSpec{
+			Type:      "golang",
+			Namespace: ptrfrom("google.golang.org"),
+			Name:      "genproto",
+			Subpath:   ptrfrom("googleapis/api/annotations"),
+		},
+	}, {
+		name: "qualifiers",
+		purl: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+		want: &model.PkgInputSpec{
+			Type:      "deb",
+			Namespace: ptrfrom("debian"),
+			Name:      "curl",
+			Version:   ptrfrom("7.50.3-1"),
+			Qualifiers: []*model.PackageQualifierInputSpec{
+				{Key: "arch", Value: "i386"},
+				{Key: "distro", Value: "jessie"},
+			},
+		},
+	}, {
+		name: "unsorted qualifiers",
+		purl: "pkg:deb/debian/curl@7.50.3-1?distro=jessie&arch=i386",
+		want: &model.PkgInputSpec{
+			Type:      "deb",
+			Namespace: ptrfrom("debian"),
+			Name:      "curl",
+			Version:   ptrfrom("7.50.3-1"),
+			Qualifiers: []*model.PackageQualifierInputSpec{
+				{Key: "arch", Value: "i386"},
+				{Key: "distro", Value: "jessie"},
+			},
+		},
+		wantPurl: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+	}, {
+		name: "encoded namespace",
+		purl: "pkg:npm/%40angular/animation@12.3.1",
+		want: &model.PkgInputSpec{
+			Type:      "npm",
+			Namespace: ptrfrom("@angular"),
+			Name:      "animation",
+			Version:   ptrfrom("12.3.1"),
+		},
+	}, {
+		name: "encoded version and qualifiers",
+		purl: "pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=ghcr.io%2Fdebian&tag=bullseye",
+		want: &model.PkgInputSpec{
+			Type:    "oci",
+			Name:    "debian",
+			Version: ptrfrom("sha256:244fd47e07d10"),
+			Qualifiers: []*model.PackageQualifierInputSpec{
+				{Key: "repository_url", Value: "ghcr.io/debian"},
+				{Key: "tag", Value: "bullseye"},
+			},
+		},
+	}, {
+		name: "encoded space in name",
+		purl: "pkg:generic/my%20package@1.0",
+		want: &model.PkgInputSpec{
+			Type:    "generic",
+			Name:    "my package",
+			Version: ptrfrom("1.0"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PurlToPkg(tt.purl)
+			if err != nil {
+				t.Fatalf("PurlToPkg() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PurlToPkg() mismatch (-want +got):\n%s", diff)
+			}
+			wantPurl := tt.purl
+			if tt.wantPurl != "" {
+				wantPurl = tt.wantPurl
+			}
+			if p := PkgToPurl(got); p != wantPurl {
+				t.Errorf("PkgToPurl() = %q, want %q", p, wantPurl)
+			}
+		})
+	}
+}
+
+func TestPurlToPkgInvalid(t *testing.T) {
+	for _, p := range []string{"", "golang/github.com/foo/bar", "pkg:golang", "pkg:npm/%zz@1.0"} {
+		if _, err := PurlToPkg(p); err == nil {
+			t.Errorf("PurlToPkg(%q) did not return an error", p)
+		}
+	}
+}
+
+func TestExpandPkgSpecPurl(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *model.PkgSpec
+		want    *model.PkgSpec
+		wantErr bool
+	}{{
+		name: "nil spec",
+	}, {
+		name: "no purl",
+		spec: &model.PkgSpec{Name: ptrfrom("bar")},
+		want: &model.PkgSpec{Name: ptrfrom("bar")},
+	}, {
+		name: "golang purl",
+		spec: &model.PkgSpec{Purl: ptrfrom("pkg:golang/github.com/foo/bar@v1.2.3")},
+		want: &model.PkgSpec{
+			Type:      ptrfrom("golang"),
+			Namespace: ptrfrom("github.com/foo"),
+			Name:      ptrfrom("bar"),
+			Version:   ptrfrom("v1.2.3"),
+		},
+	}, {
+		name: "purl without version and namespace",
+		spec: &model.PkgSpec{Purl: ptrfrom("pkg:pypi/django#subpath")},
+		want: &model.PkgSpec{
+			Type:      ptrfrom("pypi"),
+			Namespace: ptrfrom(""),
+			Name:      ptrfrom("django"),
+			Subpath:   ptrfrom("subpath"),
+		},
+	}, {
+		name:    "purl and fields",
+		spec:    &model.PkgSpec{Purl: ptrfrom("pkg:pypi/django@1.11.1"), Version: ptrfrom("1.11.1")},
+		wantErr: true,
+	}, {
+		name: "purl with qualifiers",
+		spec: &model.PkgSpec{Purl: ptrfrom("pkg:deb/debian/curl@7.50.3-1?arch=i386")},
+		want: &model.PkgSpec{
+			Type:       ptrfrom("deb"),
+			Namespace:  ptrfrom("debian"),
+			Name:       ptrfrom("curl"),
+			Version:    ptrfrom("7.50.3-1"),
+			Qualifiers: []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom("i386")}},
+		},
+	}, {
+		name:    "purl and qualifiers",
+		spec:    &model.PkgSpec{Purl: ptrfrom("pkg:deb/debian/curl@7.50.3-1"), MatchOnlyEmptyQualifiers: ptrfrom(true)},
+		wantErr: true,
+	}, {
+		name:    "invalid purl",
+		spec:    &model.PkgSpec{Purl: ptrfrom("django")},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandPkgSpecPurl(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandPkgSpecPurl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandPkgSpecPurl() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGuacPkgPurl(t *testing.T) {
+	tests := []struct {
+		namespace, name, version string
+		want                     string
+		wantPkg                  *model.PkgInputSpec
+	}{{
+		name:    "libfoo",
+		version: "1.0",
+		want:    "pkg:guac/pkg/libfoo@1.0",
+		wantPkg: &model.PkgInputSpec{Type: "guac", Namespace: ptrfrom("pkg"), Name: "libfoo", Version: ptrfrom("1.0")},
+	}, {
+		namespace: "org.acme",
+		name:      "lib foo",
+		want:      "pkg:guac/pkg/org.acme/lib%20foo",
+		wantPkg:   &model.PkgInputSpec{Type: "guac", Namespace: ptrfrom("pkg/org.acme"), Name: "lib foo"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := GuacPkgPurl(tt.namespace, tt.name, tt.version)
+			if got != tt.want {
+				t.Errorf("GuacPkgPurl() = %q, want %q", got, tt.want)
+			}
+			pkg, err := PurlToPkg(got)
+			if err != nil {
+				t.Fatalf("PurlToPkg() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPkg, pkg); diff != "" {
+				t.Errorf("PurlToPkg() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -205,6 +205,51 @@ func (bn BuilderNode) IdentifiablePropertyNames() []string {
 	return []string{"type", "id"}
 }
 
+// HasSLSANode is a node that represents the SLSA provenance of the artifacts
+// it is linked to. The materials and byproducts of the build are stored as
+// parallel lists of URIs and digests.
+type HasSLSANode struct {
+	// Digest is the digest of the attestation, which identifies the node
+	Digest           string
+	SlsaVersion      string
+	BuildType        string
+	BuilderId        string
+	MaterialURIs     []string
+	MaterialDigests  []string
+	ByproductURIs    []string
+	ByproductDigests []string
+	NodeData         objectMetadata
+}
+
+func (hn HasSLSANode) Type() string {
+	return "HasSLSA"
+}
+
+func (hn HasSLSANode) Properties() map[string]interface{} {
+	properties := make(map[string]interface{})
+	properties["digest"] = strings.ToLower(hn.Digest)
+	properties["slsa_version"] = hn.SlsaVersion
+	properties["build_type"] = hn.BuildType
+	properties["builder_id"] = hn.BuilderId
+	properties["material_uris"] = append([]string{}, hn.MaterialURIs...)
+	properties["material_digests"] = toLower(hn.MaterialDigests...)
+	properties["byproduct_uris"] = append([]string{}, hn.ByproductURIs...)
+	properties["byproduct_digests"] = toLower(hn.ByproductDigests...)
+	hn.NodeData.addProperties(properties)
+	return properties
+}
+
+func (hn HasSLSANode) PropertyNames() []string {
+	fields := []string{"digest", "slsa_version", "build_type", "builder_id",
+		"material_uris", "material_digests", "byproduct_uris", "byproduct_digests"}
+	fields = append(fields, hn.NodeData.getProperties()...)
+	return fields
+}
+
+func (hn HasSLSANode) IdentifiablePropertyNames() []string {
+	return []string{"digest"}
+}
+
 // MetadataNode is a node that represents metadata about an artifact/package
 type MetadataNode struct {
 	MetadataType string
@@ -443,6 +488,34 @@ func (e ContainsEdge) IdentifiablePropertyNames() []string {
 	return []string{}
 }
 
+// IsOccurrenceEdge is an edge that represents the fact that an
+// `ArtifactNode` is an occurrence of a `PackageNode`, e.g. because the SBOM
+// lists the hash of the package
+type IsOccurrenceEdge struct {
+	PackageNode  PackageNode
+	ArtifactNode ArtifactNode
+}
+
+func (e IsOccurrenceEdge) Type() string {
+	return "IsOccurrence"
+}
+
+func (e IsOccurrenceEdge) Nodes() (v, u GuacNode) {
+	return e.ArtifactNode, e.PackageNode
+}
+
+func (e IsOccurrenceEdge) Properties() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (e IsOccurrenceEdge) PropertyNames() []string {
+	return []string{}
+}
+
+func (e IsOccurrenceEdge) IdentifiablePropertyNames() []string {
+	return []string{}
+}
+
 // MetadataFor is an edge that represents the fact that an
 // a metadata node represents metadata for an `ArtifactNode/PackageNode`
 // Only one of each side of the edge should be defined.
@@ -513,3 +586,57 @@ func (e VulnerableEdge) PropertyNames() []string {
 func (e VulnerableEdge) IdentifiablePropertyNames() []string {
 	return []string{}
 }
+
+// HasSLSASubjectEdge is an edge that represents the fact that a `HasSLSANode`
+// is the provenance of an `ArtifactNode`
+type HasSLSASubjectEdge struct {
+	HasSLSANode  HasSLSANode
+	ArtifactNode ArtifactNode
+}
+
+func (e HasSLSASubjectEdge) Type() string {
+	return "SLSASubject"
+}
+
+func (e HasSLSASubjectEdge) Nodes() (v, u GuacNode) {
+	return e.HasSLSANode, e.ArtifactNode
+}
+
+func (e HasSLSASubjectEdge) Properties() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (e HasSLSASubjectEdge) PropertyNames() []string {
+	return []string{}
+}
+
+func (e HasSLSASubjectEdge) IdentifiablePropertyNames() []string {
+	return []string{}
+}
+
+// HasSLSABuiltByEdge is an edge that represents the fact that the build
+// described by a `HasSLSANode` has been run by a `BuilderNode`
+type HasSLSABuiltByEdge struct {
+	HasSLSANode HasSLSANode
+	BuilderNode BuilderNode
+}
+
+func (e HasSLSABuiltByEdge) Type() string {
+	return "SLSABuiltBy"
+}
+
+func (e HasSLSABuiltByEdge) Nodes() (v, u GuacNode) {
+	return e.HasSLSANode, e.BuilderNode
+}
+
+func (e HasSLSABuiltByEdge) Properties() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (e HasSLSABuiltByEdge) PropertyNames() []string {
+	return []string{}
+}
+
+func (e HasSLSABuiltByEdge) IdentifiablePropertyNames() []string {
+	return []string{}
+}
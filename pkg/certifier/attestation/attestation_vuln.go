@@ -30,6 +30,11 @@ const (
 	PredicateVuln = "https://in-toto.io/attestation/vuln/v0.1"
 )
 
+// NoVuln is the vulnerability ID of the single result of a scan which found no
+// vulnerability. It distinguishes the artifacts which were scanned and found
+// clean from the ones which were never scanned.
+const NoVuln = "noVuln"
+
 // VulnerabilityStatement defines the statement header and the vulnerability predicate
 type VulnerabilityStatement struct {
 	intoto.StatementHeader
@@ -47,5 +47,7 @@ type ErrHandler func(error) bool
 type CertifierType string
 
 const (
-	CertifierOSV CertifierType = "OSV"
+	CertifierOSV            CertifierType = "OSV"
+	CertifierDepsDev        CertifierType = "DEPS_DEV"
+	CertifierClearlyDefined CertifierType = "CLEARLY_DEFINED"
 )
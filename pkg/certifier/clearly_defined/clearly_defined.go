@@ -0,0 +1,220 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	URI       string = "clearlydefined.io"
+	INVOC_URI string = "guac"
+)
+
+var ErrClearlyDefinedComponentTypeMismatch error = fmt.Errorf("rootComponent type is not *root_package.PackageComponent")
+
+// providers maps the purl types to the ClearlyDefined type and provider of
+// their coordinates. The purl types which are not listed have no coordinate.
+var providers = map[string]string{
+	"npm":       "npm/npmjs",
+	"maven":     "maven/mavencentral",
+	"pypi":      "pypi/pypi",
+	"gem":       "gem/rubygems",
+	"nuget":     "nuget/nuget",
+	"cargo":     "crate/cratesio",
+	"golang":    "go/golang",
+	"composer":  "composer/packagist",
+	"cocoapods": "pod/cocoapods",
+	"deb":       "deb/debian",
+	"github":    "git/github",
+	"gitlab":    "git/gitlab",
+}
+
+// PackageLegal is the CLEARLY_DEFINED document emitted for a package, with
+// the license information of its ClearlyDefined definition.
+type PackageLegal struct {
+	Purl       string `json:"purl"`
+	Coordinate string `json:"coordinate"`
+	// DeclaredLicense is the SPDX expression of the license declared by
+	// the package.
+	DeclaredLicense string `json:"declaredLicense"`
+	// DiscoveredLicense is the conjunction of the SPDX expressions of the
+	// licenses found in the files of the package.
+	DiscoveredLicense string `json:"discoveredLicense"`
+	// Attribution is the list of the copyright holders, one per line.
+	Attribution string    `json:"attribution"`
+	TimeScanned time.Time `json:"timeScanned"`
+}
+
+// Config configures the ClearlyDefined certifier. The zero value of each
+// field selects its default.
+type Config struct {
+	// BaseURL is the URL of the ClearlyDefined API, ClearlyDefinedURL by
+	// default.
+	BaseURL string
+	// HTTPClient is the client used for the requests, http.DefaultClient by
+	// default.
+	HTTPClient *http.Client
+	// CacheTTL is the duration for which the definitions are cached,
+	// DefaultCacheTTL by default.
+	CacheTTL time.Duration
+}
+
+type clearlyDefinedCertifier struct {
+	client *clearlyDefinedClient
+}
+
+// NewClearlyDefinedCertifier initializes the ClearlyDefined certifier. The
+// certifier is safe to share between components, so that they all use the
+// same cache.
+func NewClearlyDefinedCertifier(config Config) certifier.Certifier {
+	return &clearlyDefinedCertifier{
+		client: newClearlyDefinedClient(config),
+	}
+}
+
+// CertifyComponent takes in the root component from the gauc database and
+// queries ClearlyDefined for each package of the tree. A CLEARLY_DEFINED
+// document is generated for each package which has a definition.
+//
+// Packages whose type has no ClearlyDefined coordinate are skipped.
+func (c *clearlyDefinedCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	component, ok := rootComponent.(*root_package.PackageComponent)
+	if !ok {
+		return ErrClearlyDefinedComponentTypeMismatch
+	}
+	return c.certifyHelper(ctx, component, docChannel, map[string]bool{})
+}
+
+// certifyHelper certifies the package of the component and then recursively
+// its dependencies. The visited map is used to query each package once.
+func (c *clearlyDefinedCertifier) certifyHelper(ctx context.Context, component *root_package.PackageComponent, docChannel chan<- *processor.Document,
+	visited map[string]bool) error {
+	if visited[component.Package.Purl] {
+		return nil
+	}
+	visited[component.Package.Purl] = true
+
+	if err := c.certifyPackage(ctx, component.Package.Purl, docChannel); err != nil {
+		return err
+	}
+	for _, dep := range component.DepPackages {
+		if err := c.certifyHelper(ctx, dep, docChannel, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clearlyDefinedCertifier) certifyPackage(ctx context.Context, purl string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	coordinate, err := purlToCoordinate(purl)
+	if err != nil {
+		logger.Warnf("skipping package %s: %v", purl, err)
+		return nil
+	}
+
+	def, err := c.client.definition(ctx, coordinate)
+	if errors.Is(err, errNotFound) {
+		logger.Infof("package %s not found on ClearlyDefined", purl)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	doc, err := generateDocument(purl, coordinate, def, c.client.now())
+	if err != nil {
+		return err
+	}
+	docChannel <- doc
+	return nil
+}
+
+// purlToCoordinate returns the ClearlyDefined coordinate of the package
+// version of the purl, as type/provider/namespace/name/revision, or an error
+// if the package type has no coordinate or the purl has no version.
+func purlToCoordinate(purl string) (string, error) {
+	pkg, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return "", err
+	}
+	provider, ok := providers[pkg.Type]
+	if !ok {
+		return "", fmt.Errorf("package type %q has no ClearlyDefined coordinate", pkg.Type)
+	}
+	if pkg.Version == nil || *pkg.Version == "" {
+		return "", fmt.Errorf("purl has no version")
+	}
+
+	// Missing namespaces are written as "-". The go namespaces are module
+	// paths, which have to be escaped to fit in a single segment.
+	namespace := "-"
+	if pkg.Namespace != nil && *pkg.Namespace != "" {
+		namespace = url.PathEscape(*pkg.Namespace)
+	}
+	return strings.Join([]string{provider, namespace, url.PathEscape(pkg.Name), url.PathEscape(*pkg.Version)}, "/"), nil
+}
+
+// generateDocument returns the document with the license information of the
+// definition. Definitions without harvest date are considered scanned at
+// now.
+func generateDocument(purl, coordinate string, def *definition, now time.Time) (*processor.Document, error) {
+	timeScanned := now.UTC()
+	if def.Meta.Updated != "" {
+		updated, err := time.Parse(time.RFC3339, def.Meta.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("invalid update time of the ClearlyDefined definition of %s: %w", coordinate, err)
+		}
+		timeScanned = updated.UTC()
+	}
+
+	legal := PackageLegal{
+		Purl:              purl,
+		Coordinate:        coordinate,
+		DeclaredLicense:   def.Licensed.Declared,
+		DiscoveredLicense: strings.Join(def.Licensed.Facets.Core.Discovered.Expressions, " AND "),
+		Attribution:       strings.Join(def.Licensed.Facets.Core.Attribution.Parties, "\n"),
+		TimeScanned:       timeScanned,
+	}
+	payload, err := json.Marshal(legal)
+	if err != nil {
+		return nil, err
+	}
+	return &processor.Document{
+		Blob:   payload,
+		Type:   processor.DocumentClearlyDefined,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: INVOC_URI,
+			Source:    URI,
+		},
+	}, nil
+}
@@ -0,0 +1,214 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const testDefinition = `{
+  "described": {"releaseDate": "2022-03-17"},
+  "licensed": {
+    "declared": "MIT",
+    "facets": {
+      "core": {
+        "attribution": {"parties": ["Copyright (c) 2014 TJ Holowaychuk", "Copyright (c) 2018 Josh Junon"]},
+        "discovered": {"expressions": ["MIT", "Apache-2.0"]}
+      }
+    }
+  },
+  "_meta": {"updated": "2023-03-06T00:00:00Z"}
+}`
+
+// testUnharvestedDefinition is the answer of ClearlyDefined for coordinates
+// which have not been harvested.
+const testUnharvestedDefinition = `{"described": {"tools": []}, "licensed": {"toolScore": {"total": 0}}}`
+
+func Test_purlToCoordinate(t *testing.T) {
+	tests := []struct {
+		purl    string
+		want    string
+		wantErr bool
+	}{{
+		purl: "pkg:npm/%40babel/core@7.21.0",
+		want: "npm/npmjs/@babel/core/7.21.0",
+	}, {
+		purl: "pkg:npm/debug@4.3.4",
+		want: "npm/npmjs/-/debug/4.3.4",
+	}, {
+		purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1",
+		want: "maven/mavencentral/org.apache.logging.log4j/log4j-core/2.17.1",
+	}, {
+		purl: "pkg:pypi/requests@2.28.2",
+		want: "pypi/pypi/-/requests/2.28.2",
+	}, {
+		purl: "pkg:cargo/serde@1.0.152",
+		want: "crate/cratesio/-/serde/1.0.152",
+	}, {
+		purl: "pkg:golang/github.com/google/uuid@v1.3.0",
+		want: "go/golang/github.com%2Fgoogle/uuid/v1.3.0",
+	}, {
+		purl: "pkg:github/guacsec/guac@5835544ca568b757a8ecae5c153f317e5736700e",
+		want: "git/github/guacsec/guac/5835544ca568b757a8ecae5c153f317e5736700e",
+	}, {
+		purl:    "pkg:oci/debian@sha256%3A244fd47e07d10",
+		wantErr: true,
+	}, {
+		purl:    "pkg:npm/debug",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			got, err := purlToCoordinate(tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("purlToCoordinate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("purlToCoordinate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestServer serves the definitions of the coordinates, answering 404 for
+// the other paths. The number of requests is counted in requests.
+func newTestServer(t *testing.T, definitions map[string]string, requests *int32) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		body, ok := definitions[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func collectDocuments(t *testing.T, c *clearlyDefinedCertifier, rootComponent interface{}) []*processor.Document {
+	t.Helper()
+	ctx := logging.WithLogger(context.Background())
+	docChan := make(chan *processor.Document, 10)
+	if err := c.CertifyComponent(ctx, rootComponent, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+func TestClearlyDefinedCertifier_CertifyComponent(t *testing.T) {
+	var requests int32
+	s := newTestServer(t, map[string]string{
+		"/definitions/npm/npmjs/-/debug/4.3.4":     testDefinition,
+		"/definitions/npm/npmjs/-/unharvested/1.0": testUnharvestedDefinition,
+	}, &requests)
+	c := NewClearlyDefinedCertifier(Config{BaseURL: s.URL}).(*clearlyDefinedCertifier)
+
+	rootComponent := &root_package.PackageComponent{
+		Package: assembler.PackageNode{Purl: "pkg:npm/debug@4.3.4"},
+		DepPackages: []*root_package.PackageComponent{
+			// No coordinate, skipped.
+			{Package: assembler.PackageNode{Purl: "pkg:oci/debian@sha256%3A244fd47e07d10"}},
+			// Not harvested by ClearlyDefined, skipped.
+			{Package: assembler.PackageNode{Purl: "pkg:npm/unharvested@1.0"}},
+			// Unknown to ClearlyDefined, skipped.
+			{Package: assembler.PackageNode{Purl: "pkg:npm/unknown@1.0.0"}},
+		},
+	}
+	docs := collectDocuments(t, c, rootComponent)
+	if len(docs) != 1 {
+		t.Fatalf("CertifyComponent() generated %d documents, want 1", len(docs))
+	}
+	if docs[0].Type != processor.DocumentClearlyDefined || docs[0].Format != processor.FormatJSON {
+		t.Errorf("document is %s/%s, want ClearlyDefined JSON", docs[0].Type, docs[0].Format)
+	}
+	var got PackageLegal
+	if err := json.Unmarshal(docs[0].Blob, &got); err != nil {
+		t.Fatalf("failed to unmarshal document: %v", err)
+	}
+	want := PackageLegal{
+		Purl:              "pkg:npm/debug@4.3.4",
+		Coordinate:        "npm/npmjs/-/debug/4.3.4",
+		DeclaredLicense:   "MIT",
+		DiscoveredLicense: "MIT AND Apache-2.0",
+		Attribution:       "Copyright (c) 2014 TJ Holowaychuk\nCopyright (c) 2018 Josh Junon",
+		TimeScanned:       time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("document = %+v, want %+v", got, want)
+	}
+	if requests != 3 {
+		t.Errorf("sent %d requests, want 3", requests)
+	}
+}
+
+func TestClearlyDefinedCertifier_ComponentTypeMismatch(t *testing.T) {
+	c := NewClearlyDefinedCertifier(Config{})
+	err := c.CertifyComponent(context.Background(), "pkg:npm/debug@4.3.4", make(chan *processor.Document))
+	if err != ErrClearlyDefinedComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrClearlyDefinedComponentTypeMismatch)
+	}
+}
+
+func TestClearlyDefinedClient_Cache(t *testing.T) {
+	var requests int32
+	s := newTestServer(t, map[string]string{
+		"/definitions/npm/npmjs/-/debug/4.3.4": testDefinition,
+	}, &requests)
+	c := newClearlyDefinedClient(Config{BaseURL: s.URL, CacheTTL: time.Minute})
+	now := time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	get := func(coordinate string, wantErr error) {
+		t.Helper()
+		if _, err := c.definition(ctx, coordinate); err != wantErr {
+			t.Fatalf("definition(%s) error = %v, want %v", coordinate, err, wantErr)
+		}
+	}
+	get("npm/npmjs/-/debug/4.3.4", nil)
+	get("npm/npmjs/-/unknown/1.0.0", errNotFound)
+	// Both the definition and the missing definition are cached.
+	get("npm/npmjs/-/debug/4.3.4", nil)
+	get("npm/npmjs/-/unknown/1.0.0", errNotFound)
+	if requests != 2 {
+		t.Errorf("sent %d requests before the TTL, want 2", requests)
+	}
+
+	now = now.Add(time.Minute)
+	get("npm/npmjs/-/debug/4.3.4", nil)
+	if requests != 3 {
+		t.Errorf("sent %d requests after the TTL, want 3", requests)
+	}
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// ClearlyDefinedURL is the base URL of the ClearlyDefined API.
+	ClearlyDefinedURL string = "https://api.clearlydefined.io"
+	// DefaultCacheTTL is the default duration for which the definitions are
+	// cached.
+	DefaultCacheTTL time.Duration = time.Hour
+)
+
+// errNotFound is returned when ClearlyDefined has no definition for the
+// requested coordinate.
+var errNotFound = errors.New("not found on ClearlyDefined")
+
+// definition is the subset of the ClearlyDefined definitions used by the
+// certifier.
+type definition struct {
+	Licensed struct {
+		Declared string `json:"declared"`
+		Facets   struct {
+			Core struct {
+				Attribution struct {
+					Parties []string `json:"parties"`
+				} `json:"attribution"`
+				Discovered struct {
+					Expressions []string `json:"expressions"`
+				} `json:"discovered"`
+			} `json:"core"`
+		} `json:"facets"`
+	} `json:"licensed"`
+	Meta struct {
+		Updated string `json:"updated"`
+	} `json:"_meta"`
+}
+
+// cacheEntry is a cached definition, nil if ClearlyDefined has none for the
+// coordinate.
+type cacheEntry struct {
+	definition *definition
+	expires    time.Time
+}
+
+// clearlyDefinedClient sends the requests to ClearlyDefined, caching the
+// definitions by coordinate so that packages shared by several components are
+// only requested once per TTL.
+type clearlyDefinedClient struct {
+	baseURL    string
+	httpClient *http.Client
+	ttl        time.Duration
+	now        func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]cacheEntry
+}
+
+func newClearlyDefinedClient(config Config) *clearlyDefinedClient {
+	c := &clearlyDefinedClient{
+		baseURL:    config.BaseURL,
+		httpClient: config.HTTPClient,
+		ttl:        config.CacheTTL,
+		now:        time.Now,
+		cache:      map[string]cacheEntry{},
+	}
+	if c.baseURL == "" {
+		c.baseURL = ClearlyDefinedURL
+	}
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+	if c.ttl <= 0 {
+		c.ttl = DefaultCacheTTL
+	}
+	return c
+}
+
+// definition returns the definition of the coordinate, from the cache if it
+// has not expired. Missing definitions are cached too.
+func (c *clearlyDefinedClient) definition(ctx context.Context, coordinate string) (*definition, error) {
+	c.lock.Lock()
+	entry, ok := c.cache[coordinate]
+	c.lock.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		if entry.definition == nil {
+			return nil, errNotFound
+		}
+		return entry.definition, nil
+	}
+
+	def, err := c.get(ctx, coordinate)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.cache[coordinate] = cacheEntry{definition: def, expires: c.now().Add(c.ttl)}
+	c.lock.Unlock()
+	return def, err
+}
+
+// get requests the definition of the coordinate. ClearlyDefined answers with
+// an empty definition for the coordinates it has not harvested, which is
+// reported as errNotFound.
+func (c *clearlyDefinedClient) get(ctx context.Context, coordinate string) (*definition, error) {
+	path := "/definitions/" + coordinate
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ClearlyDefined request %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var def definition
+		if err := json.NewDecoder(resp.Body).Decode(&def); err != nil {
+			return nil, fmt.Errorf("failed to decode ClearlyDefined response for %s: %w", path, err)
+		}
+		if def.Licensed.Declared == "" && len(def.Licensed.Facets.Core.Discovered.Expressions) == 0 {
+			return nil, errNotFound
+		}
+		return &def, nil
+	case http.StatusNotFound:
+		return nil, errNotFound
+	}
+	return nil, fmt.Errorf("ClearlyDefined request %s failed with status %s", path, resp.Status)
+}
@@ -0,0 +1,239 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DepsDevURL is the base URL of the deps.dev API.
+	DepsDevURL string = "https://api.deps.dev"
+	// DefaultQPS is the default limit of requests per second sent to deps.dev.
+	DefaultQPS float64 = 10
+
+	defaultMaxRetries int           = 5
+	defaultBackoff    time.Duration = time.Second
+)
+
+// errNotFound is returned when deps.dev has no data for the requested
+// package, version or project.
+var errNotFound = errors.New("not found on deps.dev")
+
+// versionKey identifies a package version in deps.dev.
+type versionKey struct {
+	System  string `json:"system"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// The types below are the subset of the deps.dev v3alpha responses used by
+// the certifier.
+
+type versionResponse struct {
+	VersionKey      versionKey `json:"versionKey"`
+	RelatedProjects []struct {
+		ProjectKey struct {
+			ID string `json:"id"`
+		} `json:"projectKey"`
+		RelationType string `json:"relationType"`
+	} `json:"relatedProjects"`
+}
+
+type dependenciesResponse struct {
+	Nodes []struct {
+		VersionKey versionKey `json:"versionKey"`
+		Relation   string     `json:"relation"`
+	} `json:"nodes"`
+	Edges []struct {
+		FromNode    int    `json:"fromNode"`
+		ToNode      int    `json:"toNode"`
+		Requirement string `json:"requirement"`
+	} `json:"edges"`
+}
+
+type projectResponse struct {
+	Scorecard *struct {
+		Date       string `json:"date"`
+		Repository struct {
+			Name   string `json:"name"`
+			Commit string `json:"commit"`
+		} `json:"repository"`
+		Scorecard struct {
+			Version string `json:"version"`
+			Commit  string `json:"commit"`
+		} `json:"scorecard"`
+		Checks []struct {
+			Name          string `json:"name"`
+			Documentation struct {
+				ShortDescription string `json:"shortDescription"`
+				URL              string `json:"url"`
+			} `json:"documentation"`
+			Score   int      `json:"score"`
+			Reason  string   `json:"reason"`
+			Details []string `json:"details"`
+		} `json:"checks"`
+		OverallScore float64 `json:"overallScore"`
+	} `json:"scorecard"`
+}
+
+// depsDevClient sends the requests to deps.dev, waiting for the rate limiter
+// before each of them and backing off when deps.dev answers with 429.
+type depsDevClient struct {
+	baseURL    string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	maxRetries int
+	backoff    time.Duration
+}
+
+func newDepsDevClient(config Config) *depsDevClient {
+	qps := config.QPS
+	if qps <= 0 {
+		qps = DefaultQPS
+	}
+	c := &depsDevClient{
+		baseURL:    config.BaseURL,
+		httpClient: config.HTTPClient,
+		limiter:    rate.NewLimiter(rate.Limit(qps), 1),
+		maxRetries: config.MaxRetries,
+		backoff:    config.Backoff,
+	}
+	if c.baseURL == "" {
+		c.baseURL = DepsDevURL
+	}
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+	if c.maxRetries <= 0 {
+		c.maxRetries = defaultMaxRetries
+	}
+	if c.backoff <= 0 {
+		c.backoff = defaultBackoff
+	}
+	return c
+}
+
+func versionPath(key versionKey) string {
+	return "/v3alpha/systems/" + url.PathEscape(key.System) +
+		"/packages/" + url.PathEscape(key.Name) +
+		"/versions/" + url.PathEscape(key.Version)
+}
+
+func (c *depsDevClient) version(ctx context.Context, key versionKey) (*versionResponse, error) {
+	var resp versionResponse
+	if err := c.get(ctx, versionPath(key), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *depsDevClient) dependencies(ctx context.Context, key versionKey) (*dependenciesResponse, error) {
+	var resp dependenciesResponse
+	if err := c.get(ctx, versionPath(key)+":dependencies", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *depsDevClient) project(ctx context.Context, projectID string) (*projectResponse, error) {
+	var resp projectResponse
+	if err := c.get(ctx, "/v3alpha/projects/"+url.PathEscape(projectID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// get decodes the JSON response to the request for path into out. Requests
+// answered with 429 are retried up to maxRetries times, waiting for the
+// delay in the Retry-After header or, if there is none, for an exponential
+// backoff.
+func (c *depsDevClient) get(ctx context.Context, path string, out interface{}) error {
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		retry, wait, err := c.getOnce(ctx, path, out, backoff)
+		if !retry {
+			return err
+		}
+		if attempt == c.maxRetries {
+			return fmt.Errorf("deps.dev request %s still rate limited after %d retries", path, c.maxRetries)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// getOnce sends a single request, returning whether it has to be retried and
+// after which delay.
+func (c *depsDevClient) getOnce(ctx context.Context, path string, out interface{}, backoff time.Duration) (bool, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, 0, fmt.Errorf("deps.dev request %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return false, 0, fmt.Errorf("failed to decode deps.dev response for %s: %w", path, err)
+		}
+		return false, 0, nil
+	case http.StatusNotFound:
+		return false, 0, errNotFound
+	case http.StatusTooManyRequests:
+		return true, retryAfter(resp.Header.Get("Retry-After"), backoff), nil
+	}
+	return false, 0, fmt.Errorf("deps.dev request %s failed with status %s", path, resp.Status)
+}
+
+// retryAfter returns the delay requested by the Retry-After header, which is
+// either a number of seconds or a date, or backoff if it is missing or
+// invalid.
+func retryAfter(header string, backoff time.Duration) time.Duration {
+	if header == "" {
+		return backoff
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return backoff
+}
@@ -0,0 +1,351 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	URI       string = "deps.dev"
+	INVOC_URI string = "guac"
+)
+
+var ErrDepsDevComponentTypeMismatch error = fmt.Errorf("rootComponent type is not *root_package.PackageComponent")
+
+// systems maps the purl types to the names of the deps.dev package systems.
+var systems = map[string]string{
+	"npm":    "NPM",
+	"golang": "GO",
+	"maven":  "MAVEN",
+	"pypi":   "PYPI",
+	"cargo":  "CARGO",
+}
+
+// purlTypes is the inverse of systems.
+var purlTypes = map[string]string{
+	"NPM":   "npm",
+	"GO":    "golang",
+	"MAVEN": "maven",
+	"PYPI":  "pypi",
+	"CARGO": "cargo",
+}
+
+// PackageDependencies is the DEPS_DEV document emitted for a package, with
+// the edges of its resolved dependency graph as reported by deps.dev. The
+// packages are identified by their purls.
+type PackageDependencies struct {
+	Purl         string           `json:"purl"`
+	Dependencies []DependencyEdge `json:"dependencies"`
+}
+
+// DependencyEdge is an edge of the dependency graph, from the dependent
+// package to its dependency.
+type DependencyEdge struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Requirement string `json:"requirement,omitempty"`
+}
+
+// Config configures the deps.dev certifier. The zero value of each field
+// selects its default.
+type Config struct {
+	// BaseURL is the URL of the deps.dev API, DepsDevURL by default.
+	BaseURL string
+	// HTTPClient is the client used for the requests, http.DefaultClient by
+	// default.
+	HTTPClient *http.Client
+	// QPS is the maximum number of requests sent per second, DefaultQPS by
+	// default.
+	QPS float64
+	// MaxRetries is the number of times a rate limited request is retried.
+	MaxRetries int
+	// Backoff is the delay before the first retry of a rate limited request
+	// without Retry-After header. It doubles on each retry.
+	Backoff time.Duration
+}
+
+type depsDevCertifier struct {
+	client *depsDevClient
+}
+
+// NewDepsDevCertifier initializes the deps.dev certifier. The certifier is
+// safe to share between components, so that they all go through the same
+// rate limiter.
+func NewDepsDevCertifier(config Config) certifier.Certifier {
+	return &depsDevCertifier{
+		client: newDepsDevClient(config),
+	}
+}
+
+// CertifyComponent takes in the root component from the gauc database and
+// queries deps.dev for each package of the tree. A DEPS_DEV document is
+// generated with the dependencies of each package, and a scorecard document
+// for each source repository of the package which has scorecard data.
+//
+// Packages whose type is not supported by deps.dev are skipped.
+func (d *depsDevCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	component, ok := rootComponent.(*root_package.PackageComponent)
+	if !ok {
+		return ErrDepsDevComponentTypeMismatch
+	}
+	return d.certifyHelper(ctx, component, docChannel, map[string]bool{})
+}
+
+// certifyHelper certifies the package of the component and then recursively
+// its dependencies. The visited map is used to query each package once.
+func (d *depsDevCertifier) certifyHelper(ctx context.Context, component *root_package.PackageComponent, docChannel chan<- *processor.Document,
+	visited map[string]bool) error {
+	if visited[component.Package.Purl] {
+		return nil
+	}
+	visited[component.Package.Purl] = true
+
+	if err := d.certifyPackage(ctx, component.Package.Purl, docChannel); err != nil {
+		return err
+	}
+	for _, dep := range component.DepPackages {
+		if err := d.certifyHelper(ctx, dep, docChannel, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *depsDevCertifier) certifyPackage(ctx context.Context, purl string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	key, err := purlToVersionKey(purl)
+	if err != nil {
+		logger.Warnf("skipping package %s: %v", purl, err)
+		return nil
+	}
+
+	version, err := d.client.version(ctx, key)
+	if errors.Is(err, errNotFound) {
+		logger.Infof("package %s not found on deps.dev", purl)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deps, err := d.client.dependencies(ctx, key)
+	switch {
+	case errors.Is(err, errNotFound):
+		logger.Infof("no dependencies of package %s found on deps.dev", purl)
+	case err != nil:
+		return err
+	default:
+		doc, err := generateDependenciesDocument(purl, deps)
+		if err != nil {
+			return err
+		}
+		docChannel <- doc
+	}
+
+	for _, related := range version.RelatedProjects {
+		if related.RelationType != "SOURCE_REPO" {
+			continue
+		}
+		project, err := d.client.project(ctx, related.ProjectKey.ID)
+		if errors.Is(err, errNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if project.Scorecard == nil || project.Scorecard.Repository.Commit == "" || len(project.Scorecard.Checks) == 0 {
+			continue
+		}
+		doc, err := generateScorecardDocument(project)
+		if err != nil {
+			return err
+		}
+		docChannel <- doc
+	}
+	return nil
+}
+
+// purlToVersionKey returns the deps.dev key of the package version of the
+// purl, or an error if deps.dev does not support the package type or the
+// purl has no version.
+func purlToVersionKey(purl string) (versionKey, error) {
+	pkg, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return versionKey{}, err
+	}
+	system, ok := systems[pkg.Type]
+	if !ok {
+		return versionKey{}, fmt.Errorf("package type %q is not supported by deps.dev", pkg.Type)
+	}
+	if pkg.Version == nil {
+		return versionKey{}, fmt.Errorf("purl has no version")
+	}
+
+	name := pkg.Name
+	if pkg.Namespace != nil {
+		switch system {
+		case "MAVEN":
+			name = *pkg.Namespace + ":" + name
+		case "NPM", "GO":
+			name = *pkg.Namespace + "/" + name
+		}
+	}
+	return versionKey{System: system, Name: name, Version: *pkg.Version}, nil
+}
+
+// versionKeyToPurl is the inverse of purlToVersionKey.
+func versionKeyToPurl(key versionKey) (string, error) {
+	purlType, ok := purlTypes[key.System]
+	if !ok {
+		return "", fmt.Errorf("deps.dev system %q is not supported", key.System)
+	}
+
+	pkg := &model.PkgInputSpec{Type: purlType, Name: key.Name, Version: &key.Version}
+	var sep string
+	switch key.System {
+	case "MAVEN":
+		sep = ":"
+	case "NPM", "GO":
+		sep = "/"
+	}
+	if i := strings.LastIndex(key.Name, sep); sep != "" && i > 0 {
+		namespace := key.Name[:i]
+		pkg.Namespace = &namespace
+		pkg.Name = key.Name[i+1:]
+	}
+	return helpers.PkgToPurl(pkg), nil
+}
+
+func generateDependenciesDocument(purl string, deps *dependenciesResponse) (*processor.Document, error) {
+	purls := make([]string, len(deps.Nodes))
+	for i, node := range deps.Nodes {
+		p, err := versionKeyToPurl(node.VersionKey)
+		if err != nil {
+			return nil, err
+		}
+		purls[i] = p
+	}
+	if len(deps.Nodes) > 0 && deps.Nodes[0].Relation == "SELF" {
+		// Keep the purl of the certified package as it is in GUAC.
+		purls[0] = purl
+	}
+
+	packageDeps := PackageDependencies{
+		Purl:         purl,
+		Dependencies: []DependencyEdge{},
+	}
+	for _, edge := range deps.Edges {
+		if edge.FromNode < 0 || edge.FromNode >= len(purls) || edge.ToNode < 0 || edge.ToNode >= len(purls) {
+			return nil, fmt.Errorf("invalid dependency edge %d -> %d for package %s", edge.FromNode, edge.ToNode, purl)
+		}
+		packageDeps.Dependencies = append(packageDeps.Dependencies, DependencyEdge{
+			From:        purls[edge.FromNode],
+			To:          purls[edge.ToNode],
+			Requirement: edge.Requirement,
+		})
+	}
+
+	payload, err := json.Marshal(packageDeps)
+	if err != nil {
+		return nil, err
+	}
+	return newDocument(payload, processor.DocumentDepsDev), nil
+}
+
+// scorecardResult has the layout of the JSON scorecard results, as parsed by
+// the scorecard document processor and parser.
+type scorecardResult struct {
+	Date string `json:"date"`
+	Repo struct {
+		Name   string `json:"name"`
+		Commit string `json:"commit"`
+	} `json:"repo"`
+	Scorecard struct {
+		Version string `json:"version"`
+		Commit  string `json:"commit"`
+	} `json:"scorecard"`
+	AggregateScore float64          `json:"score"`
+	Checks         []scorecardCheck `json:"checks"`
+	Metadata       []string         `json:"metadata"`
+}
+
+type scorecardCheck struct {
+	Details       []string `json:"details"`
+	Score         int      `json:"score"`
+	Reason        string   `json:"reason"`
+	Name          string   `json:"name"`
+	Documentation struct {
+		URL   string `json:"url"`
+		Short string `json:"short"`
+	} `json:"documentation"`
+}
+
+func generateScorecardDocument(project *projectResponse) (*processor.Document, error) {
+	s := project.Scorecard
+	result := scorecardResult{
+		Date:           s.Date,
+		AggregateScore: s.OverallScore,
+		Checks:         []scorecardCheck{},
+	}
+	result.Repo.Name = s.Repository.Name
+	result.Repo.Commit = s.Repository.Commit
+	result.Scorecard.Version = s.Scorecard.Version
+	result.Scorecard.Commit = s.Scorecard.Commit
+	for _, c := range s.Checks {
+		check := scorecardCheck{
+			Details: c.Details,
+			Score:   c.Score,
+			Reason:  c.Reason,
+			Name:    c.Name,
+		}
+		check.Documentation.URL = c.Documentation.URL
+		check.Documentation.Short = c.Documentation.ShortDescription
+		result.Checks = append(result.Checks, check)
+	}
+
+	payload, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return newDocument(payload, processor.DocumentScorecard), nil
+}
+
+func newDocument(payload []byte, docType processor.DocumentType) *processor.Document {
+	return &processor.Document{
+		Blob:   payload,
+		Type:   docType,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: INVOC_URI,
+			Source:    URI,
+		},
+	}
+}
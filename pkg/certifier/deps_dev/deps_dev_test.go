@@ -0,0 +1,293 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	testVersionResponse = `{
+  "versionKey": {"system": "NPM", "name": "@babel/core", "version": "7.21.0"},
+  "relatedProjects": [
+    {"projectKey": {"id": "github.com/babel/babel"}, "relationType": "SOURCE_REPO"},
+    {"projectKey": {"id": "github.com/babel/other"}, "relationType": "ISSUE_TRACKER"}
+  ]
+}`
+	testDependenciesResponse = `{
+  "nodes": [
+    {"versionKey": {"system": "NPM", "name": "@babel/core", "version": "7.21.0"}, "relation": "SELF"},
+    {"versionKey": {"system": "NPM", "name": "debug", "version": "4.3.4"}, "relation": "DIRECT"},
+    {"versionKey": {"system": "NPM", "name": "ms", "version": "2.1.2"}, "relation": "INDIRECT"}
+  ],
+  "edges": [
+    {"fromNode": 0, "toNode": 1, "requirement": "^4.1.0"},
+    {"fromNode": 1, "toNode": 2, "requirement": "2.1.2"}
+  ]
+}`
+	testProjectResponse = `{
+  "projectKey": {"id": "github.com/babel/babel"},
+  "scorecard": {
+    "date": "2023-03-06T00:00:00Z",
+    "repository": {"name": "github.com/babel/babel", "commit": "5835544ca568b757a8ecae5c153f317e5736700e"},
+    "scorecard": {"version": "v4.10.2", "commit": "7cd6406aef0b80a819402e631919293d5eb6adcf"},
+    "checks": [
+      {"name": "Code-Review", "documentation": {"shortDescription": "Determines if the project requires code review.", "url": "https://example.com/code-review"}, "score": 8, "reason": "found 2 unreviewed changesets", "details": null}
+    ],
+    "overallScore": 6.5
+  }
+}`
+)
+
+func Test_purlToVersionKey(t *testing.T) {
+	tests := []struct {
+		purl    string
+		want    versionKey
+		wantErr bool
+	}{{
+		purl: "pkg:npm/%40babel/core@7.21.0",
+		want: versionKey{System: "NPM", Name: "@babel/core", Version: "7.21.0"},
+	}, {
+		purl: "pkg:npm/debug@4.3.4",
+		want: versionKey{System: "NPM", Name: "debug", Version: "4.3.4"},
+	}, {
+		purl: "pkg:golang/github.com/google/uuid@v1.3.0",
+		want: versionKey{System: "GO", Name: "github.com/google/uuid", Version: "v1.3.0"},
+	}, {
+		purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1",
+		want: versionKey{System: "MAVEN", Name: "org.apache.logging.log4j:log4j-core", Version: "2.17.1"},
+	}, {
+		purl: "pkg:pypi/requests@2.28.2",
+		want: versionKey{System: "PYPI", Name: "requests", Version: "2.28.2"},
+	}, {
+		purl: "pkg:cargo/serde@1.0.152",
+		want: versionKey{System: "CARGO", Name: "serde", Version: "1.0.152"},
+	}, {
+		purl:    "pkg:deb/debian/curl@7.74.0",
+		wantErr: true,
+	}, {
+		purl:    "pkg:npm/debug",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			got, err := purlToVersionKey(tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("purlToVersionKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("purlToVersionKey() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			purl, err := versionKeyToPurl(got)
+			if err != nil {
+				t.Fatalf("versionKeyToPurl() error = %v", err)
+			}
+			if back, _ := purlToVersionKey(purl); back != tt.want {
+				t.Errorf("versionKeyToPurl() = %s, does not round trip", purl)
+			}
+		})
+	}
+}
+
+// newTestServer serves the responses for the paths, answering 404 for the
+// other paths.
+func newTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func collectDocuments(t *testing.T, c *depsDevCertifier, rootComponent interface{}) []*processor.Document {
+	t.Helper()
+	ctx := logging.WithLogger(context.Background())
+	docChan := make(chan *processor.Document, 10)
+	if err := c.CertifyComponent(ctx, rootComponent, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+func TestDepsDevCertifier_CertifyComponent(t *testing.T) {
+	s := newTestServer(t, map[string]string{
+		"/v3alpha/systems/NPM/packages/@babel/core/versions/7.21.0":              testVersionResponse,
+		"/v3alpha/systems/NPM/packages/@babel/core/versions/7.21.0:dependencies": testDependenciesResponse,
+		"/v3alpha/projects/github.com/babel/babel":                               testProjectResponse,
+	})
+	c := NewDepsDevCertifier(Config{BaseURL: s.URL, QPS: 1000}).(*depsDevCertifier)
+
+	rootComponent := &root_package.PackageComponent{
+		Package: assembler.PackageNode{Purl: "pkg:npm/%40babel/core@7.21.0"},
+		DepPackages: []*root_package.PackageComponent{
+			// Unsupported by deps.dev, skipped.
+			{Package: assembler.PackageNode{Purl: "pkg:deb/debian/curl@7.74.0"}},
+			// Unknown to deps.dev, skipped.
+			{Package: assembler.PackageNode{Purl: "pkg:npm/unknown@1.0.0"}},
+		},
+	}
+	docs := collectDocuments(t, c, rootComponent)
+	if len(docs) != 2 {
+		t.Fatalf("CertifyComponent() generated %d documents, want 2", len(docs))
+	}
+
+	if docs[0].Type != processor.DocumentDepsDev || docs[0].Format != processor.FormatJSON {
+		t.Errorf("first document is %s/%s, want dependencies", docs[0].Type, docs[0].Format)
+	}
+	var gotDeps PackageDependencies
+	if err := json.Unmarshal(docs[0].Blob, &gotDeps); err != nil {
+		t.Fatalf("failed to unmarshal dependencies: %v", err)
+	}
+	wantDeps := PackageDependencies{
+		Purl: "pkg:npm/%40babel/core@7.21.0",
+		Dependencies: []DependencyEdge{
+			{From: "pkg:npm/%40babel/core@7.21.0", To: "pkg:npm/debug@4.3.4", Requirement: "^4.1.0"},
+			{From: "pkg:npm/debug@4.3.4", To: "pkg:npm/ms@2.1.2", Requirement: "2.1.2"},
+		},
+	}
+	if !reflect.DeepEqual(gotDeps, wantDeps) {
+		t.Errorf("dependencies = %+v, want %+v", gotDeps, wantDeps)
+	}
+
+	if docs[1].Type != processor.DocumentScorecard || docs[1].Format != processor.FormatJSON {
+		t.Errorf("second document is %s/%s, want scorecard", docs[1].Type, docs[1].Format)
+	}
+	var gotScorecard scorecardResult
+	if err := json.Unmarshal(docs[1].Blob, &gotScorecard); err != nil {
+		t.Fatalf("failed to unmarshal scorecard: %v", err)
+	}
+	if gotScorecard.Repo.Name != "github.com/babel/babel" ||
+		gotScorecard.Repo.Commit != "5835544ca568b757a8ecae5c153f317e5736700e" ||
+		gotScorecard.AggregateScore != 6.5 ||
+		len(gotScorecard.Checks) != 1 || gotScorecard.Checks[0].Name != "Code-Review" || gotScorecard.Checks[0].Score != 8 {
+		t.Errorf("unexpected scorecard %+v", gotScorecard)
+	}
+}
+
+func TestDepsDevCertifier_ComponentTypeMismatch(t *testing.T) {
+	c := NewDepsDevCertifier(Config{})
+	err := c.CertifyComponent(context.Background(), "pkg:npm/debug@4.3.4", make(chan *processor.Document))
+	if err != ErrDepsDevComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrDepsDevComponentTypeMismatch)
+	}
+}
+
+func TestDepsDevClient_BacksOffOnTooManyRequests(t *testing.T) {
+	tests := []struct {
+		name         string
+		limitedTimes int32
+		maxRetries   int
+		wantRequests int32
+		wantErr      bool
+	}{{
+		name:         "retried until success",
+		limitedTimes: 2,
+		maxRetries:   3,
+		wantRequests: 3,
+	}, {
+		name:         "too many retries",
+		limitedTimes: 10,
+		maxRetries:   2,
+		wantRequests: 3,
+		wantErr:      true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= tt.limitedTimes {
+					// An invalid Retry-After falls back to the backoff.
+					w.Header().Set("Retry-After", "soon")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				_, _ = w.Write([]byte(testVersionResponse))
+			}))
+			defer s.Close()
+
+			c := newDepsDevClient(Config{BaseURL: s.URL, QPS: 1000, MaxRetries: tt.maxRetries, Backoff: time.Millisecond})
+			_, err := c.version(context.Background(), versionKey{System: "NPM", Name: "@babel/core", Version: "7.21.0"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("version() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestDepsDevClient_RateLimit(t *testing.T) {
+	s := newTestServer(t, nil)
+	c := newDepsDevClient(Config{BaseURL: s.URL, QPS: 20})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.project(context.Background(), "github.com/babel/babel"); err != errNotFound {
+			t.Fatalf("project() error = %v, want %v", err, errNotFound)
+		}
+	}
+	// The first request is sent right away, the two others wait for 50ms
+	// each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("3 requests at 20 QPS took %v", elapsed)
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	backoff := time.Second
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{header: "", want: backoff},
+		{header: "3", want: 3 * time.Second},
+		{header: "-1", want: backoff},
+		{header: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0},
+		{header: "later", want: backoff},
+	}
+	for _, tt := range tests {
+		if got := retryAfter(tt.header, backoff); got != tt.want {
+			t.Errorf("retryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
@@ -22,6 +22,7 @@ package osv_query
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -83,6 +84,18 @@ func MakePURLRequest(purl string) *Query {
 	}
 }
 
+// MakePackageRequest makes a request for the version of the package named
+// name in the OSV ecosystem.
+func MakePackageRequest(name, ecosystem, version string) *Query {
+	return &Query{
+		Package: Package{
+			Name:      name,
+			Ecosystem: ecosystem,
+		},
+		Version: version,
+	}
+}
+
 // From: https://stackoverflow.com/a/72408490
 func chunkBy[T any](items []T, chunkSize int) [][]T {
 	var _chunks = make([][]T, 0, (len(items)/chunkSize)+1)
@@ -107,36 +120,55 @@ func checkResponseError(resp *http.Response) error {
 }
 
 func MakeRequest(request BatchedQuery) (*BatchedResponse, error) {
+	return MakeRequestWithClient(context.Background(), http.DefaultClient, QueryEndpoint, request)
+}
+
+// MakeRequestWithClient is like MakeRequest, posting the queries to endpoint
+// with client.
+func MakeRequestWithClient(ctx context.Context, client *http.Client, endpoint string, request BatchedQuery) (*BatchedResponse, error) {
 	// API has a limit of 1000 bulk query per request
 	queryChunks := chunkBy(request.Queries, MaxQueriesPerRequest)
 	var totalOsvResp BatchedResponse
 
 	for _, queries := range queryChunks {
-		requestBytes, err := json.Marshal(BatchedQuery{Queries: queries})
+		osvResp, err := makeChunkRequest(ctx, client, endpoint, queries)
 		if err != nil {
 			return nil, err
 		}
-		requestBuf := bytes.NewBuffer(requestBytes)
+		totalOsvResp.Results = append(totalOsvResp.Results, osvResp.Results...)
+	}
 
-		resp, err := http.Post(QueryEndpoint, "application/json", requestBuf)
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close() // nolint: errcheck
+	return &totalOsvResp, nil
+}
 
-		if err := checkResponseError(resp); err != nil {
-			return nil, err
-		}
+func makeChunkRequest(ctx context.Context, client *http.Client, endpoint string, queries []*Query) (*BatchedResponse, error) {
+	requestBytes, err := json.Marshal(BatchedQuery{Queries: queries})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
 
-		var osvResp BatchedResponse
-		decoder := json.NewDecoder(resp.Body)
-		err = decoder.Decode(&osvResp)
-		if err != nil {
-			return nil, err
-		}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint: errcheck
 
-		totalOsvResp.Results = append(totalOsvResp.Results, osvResp.Results...)
+	if err := checkResponseError(resp); err != nil {
+		return nil, err
 	}
 
-	return &totalOsvResp, nil
+	var osvResp BatchedResponse
+	decoder := json.NewDecoder(resp.Body)
+	if err := decoder.Decode(&osvResp); err != nil {
+		return nil, err
+	}
+	if len(osvResp.Results) != len(queries) {
+		return nil, fmt.Errorf("server returned %d results for %d queries", len(osvResp.Results), len(queries))
+	}
+	return &osvResp, nil
 }
@@ -19,15 +19,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/certifier"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
 	"github.com/guacsec/guac/pkg/certifier/osv/internal/osv_query"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
 	intoto "github.com/in-toto/in-toto-golang/in_toto"
 	slsa "github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/v0.2"
 	osv_scanner "golang.org/x/vuln/osv"
@@ -38,17 +41,45 @@ const (
 	VERSION     string = "0.0.14"
 	INVOC_URI   string = "guac"
 	PRODUCER_ID string = "guacsec/guac"
+	// DB_URI and DB_VERSION identify the OSV database, which is only
+	// versioned through its API.
+	DB_URI     string = "https://osv.dev"
+	DB_VERSION string = "v1"
 )
 
 var ErrOSVComponenetTypeMismatch error = fmt.Errorf("rootComponent type is not *certifier.Component")
 
+// ecosystems maps the purl types to the OSV ecosystem of their packages. The
+// packages whose type is not listed cannot be queried.
+var ecosystems = map[string]string{
+	"apk":      "Alpine",
+	"cargo":    "crates.io",
+	"composer": "Packagist",
+	"conan":    "ConanCenter",
+	"deb":      "Debian",
+	"gem":      "RubyGems",
+	"golang":   "Go",
+	"hex":      "Hex",
+	"maven":    "Maven",
+	"npm":      "npm",
+	"nuget":    "NuGet",
+	"pub":      "Pub",
+	"pypi":     "PyPI",
+}
+
 type osvCertifier struct {
 	rootComponents *root_package.PackageComponent
+	// endpoint and client are used for the OSV queries.
+	endpoint string
+	client   *http.Client
 }
 
 // NewOSVCertificationParser initializes the OSVCertifier
 func NewOSVCertificationParser() certifier.Certifier {
-	return &osvCertifier{}
+	return &osvCertifier{
+		endpoint: osv_query.QueryEndpoint,
+		client:   http.DefaultClient,
+	}
 }
 
 // CertifyComponent takes in the root component from the gauc database and does a recursive scan
@@ -100,16 +131,11 @@ func (o *osvCertifier) certifyHelper(ctx context.Context, topLevel *root_package
 		}
 	}
 
-	i := 0
-	for i < len(packNodes) {
-		query, lastIndex := getQuery(i, packNodes)
-		vulns, err := getVulnerabilities(query, docChannel)
-		if err != nil {
-			return nil, err
-		}
-		i = lastIndex
-		totalDepVul = append(totalDepVul, vulns...)
+	vulns, err := o.getVulnerabilities(ctx, packNodes, docChannel)
+	if err != nil {
+		return nil, err
 	}
+	totalDepVul = append(totalDepVul, vulns...)
 
 	doc, err := generateDocument(topLevel.Package.Purl, topLevel.Package.Digest, totalDepVul)
 	if err != nil {
@@ -121,45 +147,78 @@ func (o *osvCertifier) certifyHelper(ctx context.Context, topLevel *root_package
 	return totalDepVul, nil
 }
 
-func getQuery(lastIndex int, packNodes []assembler.PackageNode) (osv_query.BatchedQuery, int) {
-	var query osv_query.BatchedQuery
-	var stoppedIndex int
-	j := 1
-	// limit of 1000 per batch query
-	for i := lastIndex; i < len(packNodes); i++ {
-		purlQuery := osv_query.MakePURLRequest(packNodes[i].Purl)
-		purlQuery.Package.PURL = packNodes[i].Purl
-		purlQuery.Package.Digest = packNodes[i].Digest
-		query.Queries = append(query.Queries, purlQuery)
-		j++
-		if j == 1000 {
-			stoppedIndex = i
-			return query, stoppedIndex
+// getVulnerabilities queries OSV for the packages, in batches of at most
+// osv_query.MaxQueriesPerRequest queries, and generates an attestation for
+// each of them. The packages whose type has no OSV ecosystem are skipped.
+func (o *osvCertifier) getVulnerabilities(ctx context.Context, packNodes []assembler.PackageNode, docChannel chan<- *processor.Document) ([]osv_scanner.Entry, error) {
+	logger := logging.FromContext(ctx)
+
+	var queried []assembler.PackageNode
+	var queries []*osv_query.Query
+	for _, pack := range packNodes {
+		purlQuery, err := purlToQuery(pack.Purl)
+		if err != nil {
+			logger.Warnf("skipping package %s: %v", pack.Purl, err)
+			continue
 		}
+		queried = append(queried, pack)
+		queries = append(queries, purlQuery)
 	}
-	stoppedIndex = len(packNodes)
-	return query, stoppedIndex
-}
 
-func getVulnerabilities(query osv_query.BatchedQuery, docChannel chan<- *processor.Document) ([]osv_scanner.Entry, error) {
-
-	resp, err := osv_query.MakeRequest(query)
-	if err != nil {
-		return nil, fmt.Errorf("scan failed: %v", err)
-	}
 	totalDepVul := []osv_scanner.Entry{}
-	for i, query := range query.Queries {
-		response := resp.Results[i]
-		totalDepVul = append(totalDepVul, response.Vulns...)
-		doc, err := generateDocument(query.Package.PURL, query.Package.Digest, response.Vulns)
+	for start := 0; start < len(queries); start += osv_query.MaxQueriesPerRequest {
+		end := start + osv_query.MaxQueriesPerRequest
+		if end > len(queries) {
+			end = len(queries)
+		}
+		resp, err := osv_query.MakeRequestWithClient(ctx, o.client, o.endpoint, osv_query.BatchedQuery{Queries: queries[start:end]})
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("scan failed: %v", err)
+		}
+		for i, response := range resp.Results {
+			pack := queried[start+i]
+			totalDepVul = append(totalDepVul, response.Vulns...)
+			doc, err := generateDocument(pack.Purl, pack.Digest, response.Vulns)
+			if err != nil {
+				return nil, err
+			}
+			docChannel <- doc
 		}
-		docChannel <- doc
 	}
 	return totalDepVul, nil
 }
 
+// purlToQuery returns the OSV query for the package version of the purl, or
+// an error if the package type has no OSV ecosystem or the purl has no
+// version.
+func purlToQuery(purl string) (*osv_query.Query, error) {
+	pkg, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return nil, err
+	}
+	ecosystem, ok := ecosystems[pkg.Type]
+	if !ok {
+		return nil, fmt.Errorf("package type %q has no OSV ecosystem", pkg.Type)
+	}
+	if pkg.Version == nil || *pkg.Version == "" {
+		return nil, fmt.Errorf("purl has no version")
+	}
+
+	// The OSV names include the namespace, except for the distributions
+	// whose namespace is the distribution itself.
+	name := pkg.Name
+	if pkg.Namespace != nil && *pkg.Namespace != "" {
+		switch pkg.Type {
+		case "maven":
+			name = *pkg.Namespace + ":" + pkg.Name
+		case "apk", "deb":
+		default:
+			name = *pkg.Namespace + "/" + pkg.Name
+		}
+	}
+	return osv_query.MakePackageRequest(name, ecosystem, *pkg.Version), nil
+}
+
 func generateDocument(purl string, digest []string, vulns []osv_scanner.Entry) (*processor.Document, error) {
 	payload, err := json.Marshal(createAttestation(purl, digest, vulns))
 	if err != nil {
@@ -194,6 +253,10 @@ func createAttestation(packageURL string, digests []string, vulns []osv_scanner.
 			Scanner: attestation_vuln.Scanner{
 				Uri:     URI,
 				Version: VERSION,
+				Database: attestation_vuln.DB{
+					Uri:     DB_URI,
+					Version: DB_VERSION,
+				},
 			},
 			Metadata: attestation_vuln.Metadata{
 				ScannedOn: &currentTime,
@@ -223,5 +286,8 @@ func createAttestation(packageURL string, digests []string, vulns []osv_scanner.
 			VulnerabilityId: vuln.ID,
 		})
 	}
+	if len(vulns) == 0 {
+		attestation.Predicate.Scanner.Result = []attestation_vuln.Result{{VulnerabilityId: attestation_vuln.NoVuln}}
+	}
 	return attestation
 }
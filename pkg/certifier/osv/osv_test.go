@@ -17,6 +17,10 @@ package osv
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -26,6 +30,7 @@ import (
 
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/osv/internal/osv_query"
 	intoto "github.com/in-toto/in-toto-golang/in_toto"
 	slsa "github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/v0.2"
 	osv_scanner "golang.org/x/vuln/osv"
@@ -179,9 +184,10 @@ func Test_createAttestation(t *testing.T) {
 						ProducerID: PRODUCER_ID,
 					},
 					Scanner: attestation_vuln.Scanner{
-						Uri:     URI,
-						Version: VERSION,
-						Result:  []attestation_vuln.Result{{VulnerabilityId: "testId"}},
+						Uri:      URI,
+						Version:  VERSION,
+						Database: attestation_vuln.DB{Uri: DB_URI, Version: DB_VERSION},
+						Result:   []attestation_vuln.Result{{VulnerabilityId: "testId"}},
 					},
 					Metadata: attestation_vuln.Metadata{
 						ScannedOn: &currentTime,
@@ -213,8 +219,11 @@ func Test_createAttestation(t *testing.T) {
 						ProducerID: PRODUCER_ID,
 					},
 					Scanner: attestation_vuln.Scanner{
-						Uri:     URI,
-						Version: VERSION,
+						Uri:      URI,
+						Version:  VERSION,
+						Database: attestation_vuln.DB{Uri: DB_URI, Version: DB_VERSION},
+						// Scans without vulnerabilities point at the sentinel.
+						Result: []attestation_vuln.Result{{VulnerabilityId: attestation_vuln.NoVuln}},
 					},
 					Metadata: attestation_vuln.Metadata{
 						ScannedOn: &currentTime,
@@ -281,3 +290,131 @@ func TestCertifyHelperStackOverflow(t *testing.T) {
 		t.Errorf("Function did not return an error, but it took too long to execute, which indicates stack overflow")
 	}
 }
+
+func Test_purlToQuery(t *testing.T) {
+	tests := []struct {
+		purl    string
+		want    *osv_query.Query
+		wantErr bool
+	}{{
+		purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1",
+		want: osv_query.MakePackageRequest("org.apache.logging.log4j:log4j-core", "Maven", "2.8.1"),
+	}, {
+		purl: "pkg:npm/%40babel/core@7.21.0",
+		want: osv_query.MakePackageRequest("@babel/core", "npm", "7.21.0"),
+	}, {
+		purl: "pkg:golang/github.com/google/uuid@v1.3.0",
+		want: osv_query.MakePackageRequest("github.com/google/uuid", "Go", "v1.3.0"),
+	}, {
+		purl: "pkg:pypi/requests@2.28.2",
+		want: osv_query.MakePackageRequest("requests", "PyPI", "2.28.2"),
+	}, {
+		purl: "pkg:deb/debian/curl@7.74.0-1.3",
+		want: osv_query.MakePackageRequest("curl", "Debian", "7.74.0-1.3"),
+	}, {
+		purl:    "pkg:oci/vul-image-latest?repository_url=grc.io",
+		wantErr: true,
+	}, {
+		purl:    "pkg:npm/debug",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			got, err := purlToQuery(tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("purlToQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("purlToQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestServer answers the OSV batch queries with the vulnerabilities of the
+// package names in vulns, and records the size of every batch in batches.
+func newTestServer(t *testing.T, vulns map[string][]string, batches *[]int) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var query osv_query.BatchedQuery
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*batches = append(*batches, len(query.Queries))
+		var resp osv_query.BatchedResponse
+		for _, q := range query.Queries {
+			var response osv_query.Response
+			for _, id := range vulns[q.Package.Name] {
+				response.Vulns = append(response.Vulns, osv_scanner.Entry{ID: id})
+			}
+			resp.Results = append(resp.Results, response)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestOSVCertifier_batches(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	// The root has one more dependency than fits in a batch, plus one which
+	// cannot be queried.
+	root := &root_package.PackageComponent{
+		Package: assembler.PackageNode{Purl: "pkg:oci/vul-image-latest?repository_url=grc.io"},
+	}
+	for i := 0; i < osv_query.MaxQueriesPerRequest+1; i++ {
+		root.DepPackages = append(root.DepPackages, &root_package.PackageComponent{
+			Package: assembler.PackageNode{Purl: fmt.Sprintf("pkg:npm/package-%d@1.0.0", i)},
+		})
+	}
+	root.DepPackages = append(root.DepPackages, &root_package.PackageComponent{
+		Package: assembler.PackageNode{Purl: "pkg:oci/unsupported@sha256%3A244fd47e07d10"},
+	})
+
+	var batches []int
+	s := newTestServer(t, map[string][]string{"package-1": {"GHSA-7rjr-3q55-vv33"}}, &batches)
+	o := &osvCertifier{endpoint: s.URL, client: s.Client()}
+
+	docChan := make(chan *processor.Document, osv_query.MaxQueriesPerRequest+2)
+	if err := o.CertifyComponent(ctx, root, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+
+	if want := []int{osv_query.MaxQueriesPerRequest, 1}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("CertifyComponent() sent batches of %v queries, want %v", batches, want)
+	}
+
+	results := map[string][]string{}
+	for d := range docChan {
+		var statement attestation_vuln.VulnerabilityStatement
+		if err := json.Unmarshal(d.Blob, &statement); err != nil {
+			t.Fatalf("failed to unmarshal document: %v", err)
+		}
+		if got := statement.Predicate.Scanner.Database; got != (attestation_vuln.DB{Uri: DB_URI, Version: DB_VERSION}) {
+			t.Errorf("document for %s has database %v", statement.Subject[0].Name, got)
+		}
+		for _, r := range statement.Predicate.Scanner.Result {
+			results[statement.Subject[0].Name] = append(results[statement.Subject[0].Name], r.VulnerabilityId)
+		}
+	}
+	// Every queried dependency and the root get a document, the packages
+	// scanned clean pointing at the sentinel.
+	if len(results) != osv_query.MaxQueriesPerRequest+2 {
+		t.Errorf("CertifyComponent() generated %d documents, want %d", len(results), osv_query.MaxQueriesPerRequest+2)
+	}
+	for purl, want := range map[string][]string{
+		"pkg:npm/package-0@1.0.0": {attestation_vuln.NoVuln},
+		"pkg:npm/package-1@1.0.0": {"GHSA-7rjr-3q55-vv33"},
+		root.Package.Purl:         {"GHSA-7rjr-3q55-vv33"},
+	} {
+		if !reflect.DeepEqual(results[purl], want) {
+			t.Errorf("document for %s has results %v, want %v", purl, results[purl], want)
+		}
+	}
+	if _, ok := results["pkg:oci/unsupported@sha256%3A244fd47e07d10"]; ok {
+		t.Errorf("CertifyComponent() generated a document for a package without OSV ecosystem")
+	}
+}
@@ -0,0 +1,216 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	typesv2 "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	gcblob "gocloud.dev/blob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+)
+
+const (
+	BlobCollector = "BlobCollector"
+
+	// listPageSize is the number of objects listed per request.
+	listPageSize = 1000
+)
+
+// errNotInitialized is returned by the collectors built without a bucket.
+var errNotInitialized = errors.New("blob collector not initialized")
+
+// blobCollector collects the objects of an S3 or GCS bucket. Each object is
+// collected once per ETag, so in polling mode only the new and updated
+// objects are collected again.
+type blobCollector struct {
+	bucketURL string
+	prefix    string
+	bucket    *gcblob.Bucket
+	// readAll downloads an object, bucket.ReadAll unless replaced by the
+	// tests.
+	readAll func(ctx context.Context, key string) ([]byte, error)
+	// seen maps the keys of the collected objects to their ETag.
+	seen     map[string]string
+	poll     bool
+	interval time.Duration
+}
+
+// NewBlobCollector initializes the collector for the objects under the bucket
+// URL, which is either s3://bucket/prefix or gs://bucket/prefix. The query
+// parameters of the URL are passed to the bucket (e.g. region for S3), and
+// the credentials come from the default credential chain of the cloud SDK.
+// When polling, the interval between two listings must be positive.
+func NewBlobCollector(ctx context.Context, bucketURL string, poll bool, interval time.Duration) (*blobCollector, error) {
+	if poll && interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s: it must be positive", interval)
+	}
+	u, err := url.Parse(bucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bucket URL %q: %w", bucketURL, err)
+	}
+	if u.Scheme != "s3" && u.Scheme != "gs" {
+		return nil, fmt.Errorf("unsupported bucket URL %q: expected an s3:// or gs:// URL", bucketURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("bucket URL %q has no bucket name", bucketURL)
+	}
+	prefix := strings.TrimPrefix(u.Path, "/")
+	u.Path = ""
+
+	bucket, err := gcblob.OpenBucket(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %q: %w", bucketURL, err)
+	}
+	return newBlobCollector(bucketURL, bucket, prefix, poll, interval), nil
+}
+
+func newBlobCollector(bucketURL string, bucket *gcblob.Bucket, prefix string, poll bool, interval time.Duration) *blobCollector {
+	return &blobCollector{
+		bucketURL: bucketURL,
+		prefix:    prefix,
+		bucket:    bucket,
+		readAll:   bucket.ReadAll,
+		seen:      map[string]string{},
+		poll:      poll,
+		interval:  interval,
+	}
+}
+
+// Type is the collector type of the collector
+func (b *blobCollector) Type() string {
+	return BlobCollector
+}
+
+// RetrieveArtifacts get the artifacts from the collector source based on
+// polling or one time. The objects which cannot be downloaded are logged and
+// skipped: in polling mode they are retried on the next poll, and in one time
+// mode an error counting them is returned once all the other objects are
+// collected.
+func (b *blobCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if b.bucket == nil {
+		return errNotInitialized
+	}
+	if !b.poll {
+		failed, err := b.collect(ctx, docChannel)
+		if err != nil {
+			return err
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to collect %d objects from %s", failed, b.bucketURL)
+		}
+		return nil
+	}
+
+	for {
+		if _, err := b.collect(ctx, docChannel); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.interval):
+		}
+	}
+}
+
+// collect lists all the pages of objects under the prefix and emits the
+// objects whose ETag changed since they were last collected. It returns the
+// number of objects which could not be downloaded.
+func (b *blobCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) (int, error) {
+	logger := logging.FromContext(ctx)
+	failed := 0
+	opts := &gcblob.ListOptions{Prefix: b.prefix}
+	for token := gcblob.FirstPageToken; ; {
+		objects, next, err := b.bucket.ListPage(ctx, token, listPageSize, opts)
+		if err == io.EOF {
+			return failed, nil
+		}
+		if err != nil {
+			return failed, fmt.Errorf("failed to list objects of %s: %w", b.bucketURL, err)
+		}
+		for _, obj := range objects {
+			if obj.IsDir {
+				continue
+			}
+			tag := etag(obj)
+			if seen, ok := b.seen[obj.Key]; ok && seen == tag {
+				continue
+			}
+			payload, err := b.readAll(ctx, obj.Key)
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return failed, ctxErr
+				}
+				logger.Warnf("failed to retrieve object: %s from bucket: %s, error: %v", obj.Key, b.bucketURL, err)
+				failed++
+				continue
+			}
+			doc := &processor.Document{
+				Blob:   payload,
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{
+					Collector: BlobCollector,
+					Source:    obj.Key,
+				},
+			}
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return failed, ctx.Err()
+			}
+			b.seen[obj.Key] = tag
+		}
+		if len(next) == 0 {
+			return failed, nil
+		}
+		token = next
+	}
+}
+
+// etag returns the ETag of the object as reported by S3 or GCS. For the
+// other drivers, which have no ETag, the MD5 and modification time of the
+// object are used instead.
+func etag(obj *gcblob.ListObject) string {
+	var s3Object s3.Object
+	if obj.As(&s3Object) {
+		return aws.StringValue(s3Object.ETag)
+	}
+	var s3v2Object typesv2.Object
+	if obj.As(&s3v2Object) {
+		return awsv2.ToString(s3v2Object.ETag)
+	}
+	var gcsObject storage.ObjectAttrs
+	if obj.As(&gcsObject) {
+		return gcsObject.Etag
+	}
+	return hex.EncodeToString(obj.MD5) + "@" + obj.ModTime.UTC().Format(time.RFC3339Nano)
+}
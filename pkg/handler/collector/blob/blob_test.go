@@ -0,0 +1,235 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	gcblob "gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestNewBlobCollector(t *testing.T) {
+	for _, bucketURL := range []string{
+		"file:///tmp/bucket",
+		"s3:///prefix",
+		"gs://%zz",
+	} {
+		if _, err := NewBlobCollector(context.Background(), bucketURL, false, time.Second); err == nil {
+			t.Errorf("NewBlobCollector(%q) did not return an error", bucketURL)
+		}
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := NewBlobCollector(context.Background(), "s3://bucket", true, interval); err == nil {
+			t.Errorf("NewBlobCollector() polling every %s did not return an error", interval)
+		}
+	}
+}
+
+// newTestBucket returns a memory bucket with the objects.
+func newTestBucket(t *testing.T, objects map[string]string) *gcblob.Bucket {
+	t.Helper()
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	t.Cleanup(func() { _ = bucket.Close() })
+	for key, content := range objects {
+		if err := bucket.WriteAll(ctx, key, []byte(content), nil); err != nil {
+			t.Fatalf("WriteAll(%s) error = %v", key, err)
+		}
+	}
+	return bucket
+}
+
+// collectSources runs a single pass of the collector, returning the sources
+// of the collected documents.
+func collectSources(t *testing.T, b *blobCollector) ([]string, int) {
+	t.Helper()
+	docChan := make(chan *processor.Document, 2000)
+	failed, err := b.collect(logging.WithLogger(context.Background()), docChan)
+	if err != nil {
+		t.Fatalf("collect() error = %v", err)
+	}
+	close(docChan)
+	var sources []string
+	for d := range docChan {
+		sources = append(sources, d.SourceInformation.Source)
+	}
+	return sources, failed
+}
+
+func TestBlobCollector_RetrieveArtifacts(t *testing.T) {
+	bucket := newTestBucket(t, map[string]string{
+		"sboms/a.json":   "a",
+		"sboms/b.json":   "b",
+		"other/c.json":   "c",
+		"sboms/d/e.json": "e",
+	})
+	b := newBlobCollector("mem://bucket/sboms/", bucket, "sboms/", false, time.Second)
+
+	docChan := make(chan *processor.Document, 10)
+	if err := b.RetrieveArtifacts(logging.WithLogger(context.Background()), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var got []*processor.Document
+	for d := range docChan {
+		got = append(got, d)
+	}
+	doc := func(key, content string) *processor.Document {
+		return &processor.Document{
+			Blob:   []byte(content),
+			Type:   processor.DocumentUnknown,
+			Format: processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{
+				Collector: BlobCollector,
+				Source:    key,
+			},
+		}
+	}
+	want := []*processor.Document{
+		doc("sboms/a.json", "a"),
+		doc("sboms/b.json", "b"),
+		doc("sboms/d/e.json", "e"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RetrieveArtifacts() = %v, want %v", got, want)
+	}
+}
+
+func TestBlobCollector_Pagination(t *testing.T) {
+	objects := map[string]string{}
+	for i := 0; i < listPageSize+10; i++ {
+		objects[fmt.Sprintf("sbom-%04d.json", i)] = "sbom"
+	}
+	b := newBlobCollector("mem://bucket", newTestBucket(t, objects), "", false, time.Second)
+	if sources, _ := collectSources(t, b); len(sources) != len(objects) {
+		t.Errorf("collected %d objects, want %d", len(sources), len(objects))
+	}
+}
+
+func TestBlobCollector_SeenObjects(t *testing.T) {
+	ctx := context.Background()
+	bucket := newTestBucket(t, map[string]string{
+		"a.json": "a",
+		"b.json": "b",
+	})
+	b := newBlobCollector("mem://bucket", bucket, "", true, time.Second)
+
+	if sources, _ := collectSources(t, b); !reflect.DeepEqual(sources, []string{"a.json", "b.json"}) {
+		t.Errorf("first poll collected %v", sources)
+	}
+	if sources, _ := collectSources(t, b); len(sources) != 0 {
+		t.Errorf("second poll collected the unchanged objects %v", sources)
+	}
+
+	if err := bucket.WriteAll(ctx, "b.json", []byte("updated"), nil); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+	if err := bucket.WriteAll(ctx, "c.json", []byte("c"), nil); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+	if sources, _ := collectSources(t, b); !reflect.DeepEqual(sources, []string{"b.json", "c.json"}) {
+		t.Errorf("third poll collected %v, want the updated and new objects", sources)
+	}
+}
+
+func TestBlobCollector_ObjectErrors(t *testing.T) {
+	bucket := newTestBucket(t, map[string]string{
+		"a.json": "a",
+		"b.json": "b",
+		"c.json": "c",
+	})
+	b := newBlobCollector("mem://bucket", bucket, "", false, time.Second)
+	failing := true
+	b.readAll = func(ctx context.Context, key string) ([]byte, error) {
+		if key == "b.json" && failing {
+			return nil, errors.New("access denied")
+		}
+		return bucket.ReadAll(ctx, key)
+	}
+
+	sources, failed := collectSources(t, b)
+	if !reflect.DeepEqual(sources, []string{"a.json", "c.json"}) || failed != 1 {
+		t.Errorf("collect() = %v, %d failed, want the other objects and 1 failed", sources, failed)
+	}
+	// The failed object is not marked as seen, so it is retried.
+	failing = false
+	if sources, _ := collectSources(t, b); !reflect.DeepEqual(sources, []string{"b.json"}) {
+		t.Errorf("retry collected %v, want the failed object", sources)
+	}
+
+	failing = true
+	b = newBlobCollector("mem://bucket", bucket, "", false, time.Second)
+	b.readAll = func(ctx context.Context, key string) ([]byte, error) {
+		return nil, errors.New("access denied")
+	}
+	if err := b.RetrieveArtifacts(logging.WithLogger(context.Background()), make(chan *processor.Document, 10)); err == nil {
+		t.Errorf("RetrieveArtifacts() did not report the failed objects")
+	}
+}
+
+func TestBlobCollector_PollingStopsWithContext(t *testing.T) {
+	b := newBlobCollector("mem://bucket", newTestBucket(t, map[string]string{"a.json": "a"}), "", true, time.Millisecond)
+	ctx, cancel := context.WithTimeout(logging.WithLogger(context.Background()), 20*time.Millisecond)
+	defer cancel()
+	docChan := make(chan *processor.Document, 10)
+	if err := b.RetrieveArtifacts(ctx, docChan); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(docChan) != 1 {
+		t.Errorf("polling collected %d documents, want 1", len(docChan))
+	}
+}
+
+func TestBlobCollector_StopsWithoutConsumer(t *testing.T) {
+	b := newBlobCollector("mem://bucket", newTestBucket(t, map[string]string{"a.json": "a"}), "", false, time.Second)
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	done := make(chan error, 1)
+	go func() {
+		// Nothing reads the documents sent on the channel.
+		_, err := b.collect(ctx, make(chan *processor.Document))
+		done <- err
+	}()
+	// Let the collector block on sending the document.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("collect() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("collect() did not return after the context was canceled")
+	}
+	// The object was not emitted, so it is collected again.
+	if len(b.seen) != 0 {
+		t.Errorf("seen objects = %v, want none", b.seen)
+	}
+}
+
+func TestBlobCollector_NotInitialized(t *testing.T) {
+	b := &blobCollector{}
+	if err := b.RetrieveArtifacts(context.Background(), make(chan *processor.Document)); err != errNotInitialized {
+		t.Errorf("RetrieveArtifacts() error = %v, want %v", err, errNotInitialized)
+	}
+}
@@ -0,0 +1,427 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git_collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	CollectorGitSource = "GitSourceCollector"
+
+	// headRef is the name under which the HEAD commit is tracked in the
+	// state.
+	headRef = "HEAD"
+	// remoteBranchPrefix is the prefix of the branches of the remote in the
+	// local clone.
+	remoteBranchPrefix = "refs/remotes/origin/"
+)
+
+// SourceConfig configures the collector of the sources of a git repository.
+type SourceConfig struct {
+	// URL is the remote of the repository, either as an URL or in the scp
+	// syntax of SSH (e.g., git@github.com:guacsec/guac.git).
+	URL string
+	// Dir is the directory where the repository is cloned, and fetched at
+	// the next runs. If empty, the repository is cloned in memory at each
+	// run.
+	Dir string
+	// Depth limits the history fetched for each ref. Use 1 for a shallow
+	// clone and 0 for the full history.
+	Depth int
+	// Include are the glob patterns, as for path.Match, of the short names
+	// of the tags and branches to emit (e.g., "v1.*" or "main"). If empty,
+	// all the tags and no branches are emitted. The HEAD commit is always
+	// emitted.
+	Include []string
+	// SSHKeyFile is the private key authenticating to SSH remotes, which
+	// is decrypted with SSHKeyPassword if set.
+	SSHKeyFile     string
+	SSHKeyPassword string
+	// Token authenticates to HTTPS remotes, being sent as password of the
+	// basic authentication. It is ignored if SSHKeyFile is set.
+	Token string
+	// StateFile persists the commits of the refs emitted by the previous
+	// runs, keyed on URL, so that only the refs which changed since are
+	// emitted. If empty, the state is only kept while the collector runs.
+	StateFile string
+	// Poll keeps the collector running, fetching the remote every Interval,
+	// which must then be positive.
+	Poll     bool
+	Interval time.Duration
+}
+
+// Source is the payload of the SOURCE documents emitted by the git source
+// collector: the source of the repository at a ref. Its fields are the ones
+// of the sources of the GraphQL API.
+type Source struct {
+	Type      string `json:"type"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Tag is set for the sources of tags, along with the commit it
+	// resolves to.
+	Tag    *string `json:"tag,omitempty"`
+	Commit string  `json:"commit"`
+	// Ref is the full name of the ref of the source, or HEAD.
+	Ref string `json:"ref"`
+}
+
+// gitSourceCollector emits a Source document for each tag of a git
+// repository, and for its HEAD commit. Each ref is only emitted again once it
+// points to another commit.
+type gitSourceCollector struct {
+	config SourceConfig
+	auth   transport.AuthMethod
+	// source holds the type, namespace and name of the sources.
+	source Source
+	// state holds the commits emitted by the previous runs, when there is no
+	// state file.
+	state map[string]map[string]string
+}
+
+// NewGitSourceCollector returns the collector of the sources of the
+// repository at config.URL.
+func NewGitSourceCollector(config SourceConfig) (*gitSourceCollector, error) {
+	if config.Poll && config.Interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s: it must be positive", config.Interval)
+	}
+	endpoint, err := transport.NewEndpoint(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid git remote %q: %w", config.URL, err)
+	}
+	source, err := sourceFromEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	for _, pattern := range config.Include {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid include pattern %q: %w", pattern, err)
+		}
+	}
+	g := &gitSourceCollector{config: config, source: source, state: map[string]map[string]string{}}
+	switch {
+	case config.SSHKeyFile != "":
+		user := endpoint.User
+		if user == "" {
+			user = "git"
+		}
+		g.auth, err = ssh.NewPublicKeysFromFile(user, config.SSHKeyFile, config.SSHKeyPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SSH key: %w", err)
+		}
+	case config.Token != "":
+		g.auth = &http.BasicAuth{Username: "guac", Password: config.Token}
+	}
+	return g, nil
+}
+
+// sourceFromEndpoint returns the type, namespace and name of the sources of
+// the remote: the namespace is the host and the path up to the repository,
+// whose name is the last element of the path.
+func sourceFromEndpoint(endpoint *transport.Endpoint) (Source, error) {
+	dir, name := path.Split(strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git"))
+	if name == "" {
+		return Source{}, fmt.Errorf("git remote %q has no repository name", endpoint.String())
+	}
+	namespace := strings.TrimSuffix(dir, "/")
+	if endpoint.Host != "" {
+		namespace = strings.TrimSuffix(endpoint.Host+"/"+namespace, "/")
+	}
+	return Source{Type: "git", Namespace: namespace, Name: name}, nil
+}
+
+// RetrieveArtifacts emits the sources of the refs which changed since the
+// previous run, then waits for the next poll if polling, until ctx is done.
+func (g *gitSourceCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		if err := g.collect(ctx, docChannel); err != nil {
+			return err
+		}
+		if !g.config.Poll {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(g.config.Interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (g *gitSourceCollector) Type() string {
+	return CollectorGitSource
+}
+
+func (g *gitSourceCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	repo, err := g.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", g.config.URL, err)
+	}
+	sources, err := g.sources(repo)
+	if err != nil {
+		return err
+	}
+
+	states, err := g.loadStates()
+	if err != nil {
+		return err
+	}
+	previous := states[g.config.URL]
+	state := map[string]string{}
+	refs := make([]string, 0, len(sources))
+	for ref := range sources {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	for i, ref := range refs {
+		source := sources[ref]
+		if previous[ref] == source.Commit {
+			state[ref] = source.Commit
+			continue
+		}
+		blob, err := json.Marshal(source)
+		if err != nil {
+			return err
+		}
+		logger.Debugf("emitting source of %s at %s", ref, source.Commit)
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentSource,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: CollectorGitSource,
+				Source:    g.config.URL,
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			// The refs which were not emitted keep their previous
+			// commits, so that the next run emits them.
+			for _, ref := range refs[i:] {
+				if commit, ok := previous[ref]; ok {
+					state[ref] = commit
+				}
+			}
+			states[g.config.URL] = state
+			if err := g.saveStates(states); err != nil {
+				return err
+			}
+			return ctx.Err()
+		}
+		state[ref] = source.Commit
+	}
+	states[g.config.URL] = state
+	return g.saveStates(states)
+}
+
+// fetch clones the repository, or fetches its updates if it has already been
+// cloned to the directory.
+func (g *gitSourceCollector) fetch(ctx context.Context) (*git.Repository, error) {
+	cloneOptions := &git.CloneOptions{
+		URL:   g.config.URL,
+		Auth:  g.auth,
+		Depth: g.config.Depth,
+		Tags:  git.AllTags,
+	}
+	if g.config.Dir == "" {
+		return git.CloneContext(ctx, memory.NewStorage(), nil, cloneOptions)
+	}
+
+	repo, err := git.PlainOpen(g.config.Dir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		return git.PlainCloneContext(ctx, g.config.Dir, true, cloneOptions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = repo.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: git.DefaultRemoteName,
+		RefSpecs: []config.RefSpec{
+			config.RefSpec("+refs/heads/*:" + remoteBranchPrefix + "*"),
+			// Moved tags are updated too.
+			"+refs/tags/*:refs/tags/*",
+		},
+		Auth:  g.auth,
+		Depth: g.config.Depth,
+		Tags:  git.AllTags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// sources returns the sources to emit, keyed by ref.
+func (g *gitSourceCollector) sources(repo *git.Repository) (map[string]*Source, error) {
+	sources := map[string]*Source{}
+
+	// The local HEAD is not moved by the fetches, but the remote branch it
+	// points to is.
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	headCommit := head.Hash()
+	if remote, err := repo.Reference(plumbing.ReferenceName(remoteBranchPrefix+head.Name().Short()), true); err == nil {
+		headCommit = remote.Hash()
+	}
+	sources[headRef] = g.newSource(headRef, headCommit, nil)
+
+	refs, err := repo.References()
+	if err != nil {
+		return nil, err
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+		name := ref.Name()
+		switch {
+		case name.IsTag():
+			tag := name.Short()
+			if len(g.config.Include) > 0 && !g.included(tag) {
+				return nil
+			}
+			commit, err := tagCommit(repo, ref)
+			if err != nil {
+				return fmt.Errorf("failed to resolve tag %s: %w", tag, err)
+			}
+			if !commit.IsZero() {
+				sources[name.String()] = g.newSource(name.String(), commit, &tag)
+			}
+		case strings.HasPrefix(name.String(), remoteBranchPrefix):
+			branch := strings.TrimPrefix(name.String(), remoteBranchPrefix)
+			if g.included(branch) {
+				branchRef := plumbing.NewBranchReferenceName(branch).String()
+				sources[branchRef] = g.newSource(branchRef, ref.Hash(), nil)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sources, nil
+}
+
+// included returns true if the short name of a ref matches one of the
+// include patterns.
+func (g *gitSourceCollector) included(name string) bool {
+	for _, pattern := range g.config.Include {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *gitSourceCollector) newSource(ref string, commit plumbing.Hash, tag *string) *Source {
+	source := g.source
+	source.Tag = tag
+	source.Commit = commit.String()
+	source.Ref = ref
+	return &source
+}
+
+// tagCommit returns the commit of the tag, following the annotated tags. The
+// zero hash is returned for the tags of other objects.
+func tagCommit(repo *git.Repository, ref *plumbing.Reference) (plumbing.Hash, error) {
+	tag, err := repo.TagObject(ref.Hash())
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		// Lightweight tag.
+		return ref.Hash(), nil
+	}
+	for err == nil && tag.TargetType == plumbing.TagObject {
+		tag, err = repo.TagObject(tag.Target)
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if tag.TargetType != plumbing.CommitObject {
+		return plumbing.ZeroHash, nil
+	}
+	return tag.Target, nil
+}
+
+// loadStates returns the commits of the refs emitted by the previous runs, by
+// URL.
+func (g *gitSourceCollector) loadStates() (map[string]map[string]string, error) {
+	if g.config.StateFile == "" {
+		return g.state, nil
+	}
+	states := map[string]map[string]string{}
+	data, err := os.ReadFile(g.config.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf("invalid state file %s: %w", g.config.StateFile, err)
+	}
+	return states, nil
+}
+
+// saveStates replaces the state file, if any, by the states.
+func (g *gitSourceCollector) saveStates(states map[string]map[string]string) error {
+	if g.config.StateFile == "" {
+		g.state = states
+		return nil
+	}
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Written to a temporary file first, so that a crash cannot leave a
+	// truncated state.
+	tmp, err := os.CreateTemp(filepath.Dir(g.config.StateFile), filepath.Base(g.config.StateFile)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), g.config.StateFile)
+}
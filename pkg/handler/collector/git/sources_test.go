@@ -0,0 +1,301 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git_collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// testOrigin is a local repository used as remote by the tests.
+type testOrigin struct {
+	t    *testing.T
+	dir  string
+	repo *git.Repository
+}
+
+func newTestOrigin(t *testing.T) *testOrigin {
+	t.Helper()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("PlainInit() error = %v", err)
+	}
+	return &testOrigin{t: t, dir: dir, repo: repo}
+}
+
+var signature = &object.Signature{Name: "guac", Email: "guac@example.com", When: time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC)}
+
+func (o *testOrigin) commit(message string) plumbing.Hash {
+	o.t.Helper()
+	w, err := o.repo.Worktree()
+	if err != nil {
+		o.t.Fatalf("Worktree() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(o.dir, "README.md"), []byte(message), 0o644); err != nil {
+		o.t.Fatal(err)
+	}
+	if _, err := w.Add("README.md"); err != nil {
+		o.t.Fatalf("Add() error = %v", err)
+	}
+	hash, err := w.Commit(message, &git.CommitOptions{Author: signature})
+	if err != nil {
+		o.t.Fatalf("Commit() error = %v", err)
+	}
+	return hash
+}
+
+func (o *testOrigin) tag(name string, commit plumbing.Hash, annotated bool) {
+	o.t.Helper()
+	var opts *git.CreateTagOptions
+	if annotated {
+		opts = &git.CreateTagOptions{Tagger: signature, Message: name}
+	}
+	if _, err := o.repo.CreateTag(name, commit, opts); err != nil {
+		o.t.Fatalf("CreateTag() error = %v", err)
+	}
+}
+
+func (o *testOrigin) branch(name string, commit plumbing.Hash) {
+	o.t.Helper()
+	if err := o.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(name), commit)); err != nil {
+		o.t.Fatalf("SetReference() error = %v", err)
+	}
+}
+
+// collectSources runs the collector once, returning the emitted sources by
+// ref.
+func collectSources(t *testing.T, g *gitSourceCollector) map[string]Source {
+	t.Helper()
+	ctx := logging.WithLogger(context.Background())
+	docChan := make(chan *processor.Document, 100)
+	if err := g.RetrieveArtifacts(ctx, docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	sources := map[string]Source{}
+	for d := range docChan {
+		if d.Type != processor.DocumentSource || d.Format != processor.FormatJSON {
+			t.Errorf("document is %s/%s, want SOURCE JSON", d.Type, d.Format)
+		}
+		if d.SourceInformation.Collector != CollectorGitSource {
+			t.Errorf("document collector = %s, want %s", d.SourceInformation.Collector, CollectorGitSource)
+		}
+		var s Source
+		if err := json.Unmarshal(d.Blob, &s); err != nil {
+			t.Fatalf("failed to unmarshal document: %v", err)
+		}
+		sources[s.Ref] = s
+	}
+	return sources
+}
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestGitSourceCollector(t *testing.T) {
+	origin := newTestOrigin(t)
+	first := origin.commit("first")
+	origin.tag("v0.1.0", first, false)
+	second := origin.commit("second")
+	origin.tag("v0.2.0", second, true)
+	origin.branch("dev", first)
+
+	source, err := sourceFromEndpoint(&transport.Endpoint{Protocol: "file", Path: origin.dir})
+	if err != nil {
+		t.Fatalf("sourceFromEndpoint() error = %v", err)
+	}
+	withRef := func(ref, commit string, tag *string) Source {
+		s := source
+		s.Ref = ref
+		s.Commit = commit
+		s.Tag = tag
+		return s
+	}
+
+	config := SourceConfig{
+		URL:       origin.dir,
+		Dir:       filepath.Join(t.TempDir(), "clone"),
+		Depth:     1,
+		StateFile: filepath.Join(t.TempDir(), "state.json"),
+	}
+	g, err := NewGitSourceCollector(config)
+	if err != nil {
+		t.Fatalf("NewGitSourceCollector() error = %v", err)
+	}
+	if g.Type() != CollectorGitSource {
+		t.Errorf("Type() = %s, want %s", g.Type(), CollectorGitSource)
+	}
+	want := map[string]Source{
+		"HEAD":             withRef("HEAD", second.String(), nil),
+		"refs/tags/v0.1.0": withRef("refs/tags/v0.1.0", first.String(), ptrfrom("v0.1.0")),
+		// The annotated tag resolves to the commit.
+		"refs/tags/v0.2.0": withRef("refs/tags/v0.2.0", second.String(), ptrfrom("v0.2.0")),
+	}
+	if diff := cmp.Diff(want, collectSources(t, g)); diff != "" {
+		t.Errorf("first run unexpected sources (-want +got):\n%s", diff)
+	}
+
+	// Nothing changed.
+	if got := collectSources(t, g); len(got) != 0 {
+		t.Errorf("second run emitted %v, want nothing", got)
+	}
+
+	// Only the moved HEAD and the new tag are emitted.
+	third := origin.commit("third")
+	origin.tag("v0.3.0", third, true)
+	want = map[string]Source{
+		"HEAD":             withRef("HEAD", third.String(), nil),
+		"refs/tags/v0.3.0": withRef("refs/tags/v0.3.0", third.String(), ptrfrom("v0.3.0")),
+	}
+	if diff := cmp.Diff(want, collectSources(t, g)); diff != "" {
+		t.Errorf("run after commit unexpected sources (-want +got):\n%s", diff)
+	}
+
+	// The state is persisted across collectors.
+	g, err = NewGitSourceCollector(config)
+	if err != nil {
+		t.Fatalf("NewGitSourceCollector() error = %v", err)
+	}
+	if got := collectSources(t, g); len(got) != 0 {
+		t.Errorf("run of a new collector emitted %v, want nothing", got)
+	}
+
+	// Branches and tags are filtered by the include patterns, with an
+	// in-memory clone and state.
+	g, err = NewGitSourceCollector(SourceConfig{URL: origin.dir, Include: []string{"dev", "v0.1.*"}})
+	if err != nil {
+		t.Fatalf("NewGitSourceCollector() error = %v", err)
+	}
+	want = map[string]Source{
+		"HEAD":             withRef("HEAD", third.String(), nil),
+		"refs/heads/dev":   withRef("refs/heads/dev", first.String(), nil),
+		"refs/tags/v0.1.0": withRef("refs/tags/v0.1.0", first.String(), ptrfrom("v0.1.0")),
+	}
+	if diff := cmp.Diff(want, collectSources(t, g)); diff != "" {
+		t.Errorf("run with include patterns unexpected sources (-want +got):\n%s", diff)
+	}
+	if got := collectSources(t, g); len(got) != 0 {
+		t.Errorf("second in-memory run emitted %v, want nothing", got)
+	}
+}
+
+func Test_sourceFromEndpoint(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    Source
+		wantErr bool
+	}{{
+		url:  "https://github.com/guacsec/guac.git",
+		want: Source{Type: "git", Namespace: "github.com/guacsec", Name: "guac"},
+	}, {
+		url:  "git@github.com:guacsec/guac.git",
+		want: Source{Type: "git", Namespace: "github.com/guacsec", Name: "guac"},
+	}, {
+		url:  "ssh://git@gitlab.com/group/subgroup/project",
+		want: Source{Type: "git", Namespace: "gitlab.com/group/subgroup", Name: "project"},
+	}, {
+		url:  "file:///srv/git/guac.git",
+		want: Source{Type: "git", Namespace: "srv/git", Name: "guac"},
+	}, {
+		url:     "https://github.com/",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			endpoint, err := transport.NewEndpoint(tt.url)
+			if err != nil {
+				t.Fatalf("NewEndpoint() error = %v", err)
+			}
+			got, err := sourceFromEndpoint(endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sourceFromEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("sourceFromEndpoint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitSourceCollector_StopsWithoutConsumer(t *testing.T) {
+	origin := newTestOrigin(t)
+	first := origin.commit("first")
+	origin.tag("v0.1.0", first, false)
+	origin.tag("v0.2.0", first, false)
+	g, err := NewGitSourceCollector(SourceConfig{URL: origin.dir})
+	if err != nil {
+		t.Fatalf("NewGitSourceCollector() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	defer cancel()
+	docChan := make(chan *processor.Document)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- g.RetrieveArtifacts(ctx, docChan)
+	}()
+	// Only the source of HEAD, the first ref, is received.
+	select {
+	case <-docChan:
+	case err := <-errChan:
+		t.Fatalf("RetrieveArtifacts() returned %v before emitting a source", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("RetrieveArtifacts() did not emit a source")
+	}
+	cancel()
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RetrieveArtifacts() did not return after the context was canceled")
+	}
+
+	// The tags, which were not received, are emitted by the next run.
+	got := collectSources(t, g)
+	if _, ok := got["HEAD"]; ok || len(got) != 2 {
+		t.Errorf("run after the cancellation emitted %v, want the tags", got)
+	}
+}
+
+func TestNewGitSourceCollectorErrors(t *testing.T) {
+	for name, config := range map[string]SourceConfig{
+		"invalid pattern":   {URL: "https://github.com/guacsec/guac", Include: []string{"["}},
+		"missing SSH key":   {URL: "git@github.com:guacsec/guac.git", SSHKeyFile: filepath.Join(t.TempDir(), "id_rsa")},
+		"zero interval":     {URL: "https://github.com/guacsec/guac", Poll: true},
+		"negative interval": {URL: "https://github.com/guacsec/guac", Poll: true, Interval: -time.Second},
+	} {
+		if _, err := NewGitSourceCollector(config); err == nil {
+			t.Errorf("NewGitSourceCollector() with %s did not return an error", name)
+		}
+	}
+}
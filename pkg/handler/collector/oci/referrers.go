@@ -0,0 +1,259 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types"
+	"github.com/regclient/regclient/types/manifest"
+	"github.com/regclient/regclient/types/ref"
+)
+
+const (
+	OCIReferrersCollector = "OCIReferrersCollector"
+)
+
+// fallbackSuffixes are the suffixes of the tags where attestations and
+// signatures are stored, as `sha256-<digest>.<suffix>`, by registries which do
+// not support the referrers API.
+var fallbackSuffixes = []string{"sig", "att"}
+
+const (
+	mediaTypeInToto       = "application/vnd.in-toto+json"
+	mediaTypeDSSEEnvelope = "application/vnd.dsse.envelope.v1+json"
+	mediaTypeEmpty        = "application/vnd.oci.empty.v1+json"
+)
+
+type ociReferrersCollector struct {
+	images []string
+	rc     *regclient.RegClient
+	// checkedReferrers holds the digests of the referrer manifests which have
+	// already been collected, so that polling only emits new ones.
+	checkedReferrers map[string]bool
+	poll             bool
+	interval         time.Duration
+}
+
+// NewOCIReferrersCollector initializes a collector for the in-toto and DSSE
+// attestations attached to the given images (as `repo:tag` or
+// `repo@digest`) through the OCI referrers API. Registries are accessed with
+// the credentials of the Docker configuration and credential helpers. When
+// polling, the interval must be positive.
+func NewOCIReferrersCollector(ctx context.Context, images []string, poll bool, interval time.Duration) (*ociReferrersCollector, error) {
+	if poll && interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s: it must be positive", interval)
+	}
+	return &ociReferrersCollector{
+		images:           images,
+		rc:               regclient.New(regclient.WithDockerCreds(), regclient.WithDockerCerts()),
+		checkedReferrers: map[string]bool{},
+		poll:             poll,
+		interval:         interval,
+	}, nil
+}
+
+// RetrieveArtifacts get the attestations of the images based on polling or
+// one time
+func (o *ociReferrersCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		for _, image := range o.images {
+			if err := o.collectImage(ctx, image, docChannel); err != nil {
+				return err
+			}
+		}
+		if !o.poll {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.interval):
+		}
+	}
+}
+
+func (o *ociReferrersCollector) collectImage(ctx context.Context, image string, docChannel chan<- *processor.Document) error {
+	r, err := ref.New(image)
+	if err != nil {
+		return err
+	}
+	defer o.rc.Close(ctx, r)
+
+	return o.collectManifest(ctx, r, docChannel)
+}
+
+// collectManifest collects the attestations referring to the manifest and,
+// for multi-arch image indexes, the ones referring to each of the platform
+// specific manifests.
+func (o *ociReferrersCollector) collectManifest(ctx context.Context, r ref.Ref, docChannel chan<- *processor.Document) error {
+	m, err := o.rc.ManifestGet(ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to get manifest %s: %w", r.CommonName(), err)
+	}
+	subject := r
+	subject.Tag = ""
+	subject.Digest = manifest.GetDigest(m).String()
+
+	if err := o.collectReferrers(ctx, subject, docChannel); err != nil {
+		return err
+	}
+
+	if m.IsList() {
+		mi, ok := m.(manifest.Indexer)
+		if !ok {
+			return fmt.Errorf("reference %s is not a known index media type", r.CommonName())
+		}
+		descriptors, err := mi.GetManifestList()
+		if err != nil {
+			return err
+		}
+		for _, desc := range descriptors {
+			platform := subject
+			platform.Digest = desc.Digest.String()
+			if err := o.collectManifest(ctx, platform, docChannel); err != nil {
+				return fmt.Errorf("failed retrieving platform specific manifest: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// collectReferrers emits the attestations referring to the subject. If the
+// registry does not support the referrers API or returns no referrers, the
+// tags following the cosign naming convention are used instead, keeping only
+// their in-toto and DSSE layers: the signatures are not attestations.
+func (o *ociReferrersCollector) collectReferrers(ctx context.Context, subject ref.Ref, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	rl, err := o.rc.ReferrerList(ctx, subject)
+	if err != nil {
+		logger.Debugf("referrers API not available for %s, falling back to tags: %v", subject.CommonName(), err)
+	}
+	if err == nil && len(rl.Descriptors) > 0 {
+		for _, desc := range rl.Descriptors {
+			// the artifact type is not always set, in which case the layers
+			// have to be checked
+			if !isUnsetArtifactType(desc.ArtifactType) && !isAttestationMediaType(desc.ArtifactType) {
+				continue
+			}
+			r := subject
+			r.Digest = desc.Digest.String()
+			if err := o.collectLayers(ctx, r, isAttestationMediaType(desc.ArtifactType), docChannel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, suffix := range fallbackSuffixes {
+		r := subject
+		r.Digest = ""
+		r.Tag = strings.Replace(subject.Digest, ":", "-", 1) + "." + suffix
+		err := o.collectLayers(ctx, r, false, docChannel)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			// the tag does not exist if nothing has been attached to
+			// the image, log error and continue
+			logger.Debugf("failed to collect %s: %v", r.CommonName(), err)
+		}
+	}
+	return nil
+}
+
+// collectLayers emits the layers of the referrer manifest r, only keeping the
+// in-toto and DSSE ones unless all is set. Manifests which have already been
+// collected are skipped.
+func (o *ociReferrersCollector) collectLayers(ctx context.Context, r ref.Ref, all bool, docChannel chan<- *processor.Document) error {
+	m, err := o.rc.ManifestGet(ctx, r)
+	if err != nil {
+		return err
+	}
+	digest := manifest.GetDigest(m).String()
+	if o.checkedReferrers[digest] {
+		return nil
+	}
+
+	mi, ok := m.(manifest.Imager)
+	if !ok {
+		return fmt.Errorf("reference %s is not a known image media type", r.CommonName())
+	}
+	layers, err := mi.GetLayers()
+	if err != nil {
+		return err
+	}
+	for i, layer := range layers {
+		if !all && !isAttestationMediaType(layer.MediaType) {
+			continue
+		}
+		blob, err := o.rc.BlobGet(ctx, r, layer)
+		if err != nil {
+			return fmt.Errorf("failed pulling layer %d: %w", i, err)
+		}
+		content, err := blob.RawBody()
+		if err != nil {
+			return err
+		}
+
+		doc := &processor.Document{
+			Blob:   content,
+			Type:   processor.DocumentUnknown,
+			Format: processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{
+				Collector: string(OCIReferrersCollector),
+				Source:    r.CommonName(),
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	o.checkedReferrers[digest] = true
+	return nil
+}
+
+// isUnsetArtifactType returns true if the artifact type of a referrer does not
+// tell what it contains. Registries report the config media type of image
+// manifests as their artifact type.
+func isUnsetArtifactType(artifactType string) bool {
+	return artifactType == "" ||
+		artifactType == types.MediaTypeOCI1ImageConfig ||
+		artifactType == mediaTypeEmpty
+}
+
+// isAttestationMediaType returns true for the media types of in-toto
+// statements, including the predicate specific ones like
+// `application/vnd.in-toto.provenance+dsse`, and DSSE envelopes.
+func isAttestationMediaType(mediaType string) bool {
+	return mediaType == mediaTypeInToto ||
+		strings.HasPrefix(mediaType, "application/vnd.in-toto.") ||
+		mediaType == mediaTypeDSSEEnvelope
+}
+
+// Type is the collector type of the collector
+func (o *ociReferrersCollector) Type() string {
+	return OCIReferrersCollector
+}
@@ -0,0 +1,226 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/opencontainers/go-digest"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types"
+	"github.com/regclient/regclient/types/manifest"
+	v1 "github.com/regclient/regclient/types/oci/v1"
+	"github.com/regclient/regclient/types/platform"
+	"github.com/regclient/regclient/types/ref"
+)
+
+// testLayout builds images and their referrers in an OCI layout directory,
+// which regclient reads like a registry through `ocidir://` references.
+type testLayout struct {
+	t    *testing.T
+	ctx  context.Context
+	rc   *regclient.RegClient
+	repo string
+}
+
+func newTestLayout(t *testing.T) *testLayout {
+	return &testLayout{
+		t:    t,
+		ctx:  context.Background(),
+		rc:   regclient.New(),
+		repo: "ocidir://" + strings.ToLower(t.TempDir()),
+	}
+}
+
+func (l *testLayout) ref(reference string) ref.Ref {
+	r, err := ref.New(l.repo + reference)
+	if err != nil {
+		l.t.Fatalf("ref.New() error = %v", err)
+	}
+	return r
+}
+
+func (l *testLayout) putBlob(content string, mediaType string) types.Descriptor {
+	desc := types.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromString(content),
+		Size:      int64(len(content)),
+	}
+	if _, err := l.rc.BlobPut(l.ctx, l.ref(""), desc, bytes.NewReader([]byte(content))); err != nil {
+		l.t.Fatalf("BlobPut() error = %v", err)
+	}
+	return desc
+}
+
+// putManifest pushes the manifest under the reference, which is either a tag
+// (`:tag`) or empty to push by digest, and returns its descriptor.
+func (l *testLayout) putManifest(reference string, orig interface{}) types.Descriptor {
+	m, err := manifest.New(manifest.WithOrig(orig))
+	if err != nil {
+		l.t.Fatalf("manifest.New() error = %v", err)
+	}
+	r := l.ref(reference)
+	if reference == "" {
+		r.Digest = m.GetDescriptor().Digest.String()
+	}
+	if err := l.rc.ManifestPut(l.ctx, r, m); err != nil {
+		l.t.Fatalf("ManifestPut() error = %v", err)
+	}
+	return m.GetDescriptor()
+}
+
+// putImage pushes an image manifest, which is a referrer of subject if set.
+func (l *testLayout) putImage(reference string, configMediaType string, layers []types.Descriptor, subject *types.Descriptor) types.Descriptor {
+	return l.putManifest(reference, v1.Manifest{
+		Versioned: v1.ManifestSchemaVersion,
+		MediaType: types.MediaTypeOCI1Manifest,
+		Config:    l.putBlob("{}", configMediaType),
+		Layers:    layers,
+		Subject:   subject,
+	})
+}
+
+func TestOCIReferrersCollector(t *testing.T) {
+	l := newTestLayout(t)
+
+	// A multi-arch image with an attestation on each platform, and an SBOM
+	// which is not collected.
+	amd64 := l.putImage("", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("amd64", types.MediaTypeOCI1LayerGzip)}, nil)
+	arm64 := l.putImage("", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("arm64", types.MediaTypeOCI1LayerGzip)}, nil)
+	l.putManifest(":multi", v1.Index{
+		Versioned: v1.IndexSchemaVersion,
+		MediaType: types.MediaTypeOCI1ManifestList,
+		Manifests: []types.Descriptor{withPlatform(amd64, "amd64"), withPlatform(arm64, "arm64")},
+	})
+	l.putImage("", mediaTypeInToto, []types.Descriptor{l.putBlob("amd64 provenance", mediaTypeDSSEEnvelope)}, &amd64)
+	l.putImage("", types.MediaTypeOCI1ImageConfig, []types.Descriptor{
+		l.putBlob("arm64 provenance", "application/vnd.in-toto.provenance+dsse"),
+		l.putBlob("arm64 notes", "text/plain"),
+	}, &arm64)
+	l.putImage("", "application/spdx+json", []types.Descriptor{l.putBlob("amd64 sbom", "application/spdx+json")}, &amd64)
+
+	// An image without referrers, with a cosign signature, which is not
+	// collected, and a cosign attestation.
+	legacy := l.putImage(":legacy", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("legacy", types.MediaTypeOCI1LayerGzip)}, nil)
+	legacyTag := ":" + strings.Replace(legacy.Digest.String(), ":", "-", 1)
+	l.putImage(legacyTag+".sig", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("legacy signature", "application/vnd.dev.cosign.simplesigning.v1+json")}, nil)
+	l.putImage(legacyTag+".att", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("legacy attestation", mediaTypeDSSEEnvelope)}, nil)
+
+	ctx := context.Background()
+	c, err := NewOCIReferrersCollector(ctx, []string{l.repo + ":multi", l.repo + ":legacy"}, false, 0)
+	if err != nil {
+		t.Fatalf("NewOCIReferrersCollector() error = %v", err)
+	}
+	got := collectBlobs(t, c)
+	want := []string{"amd64 provenance", "arm64 provenance", "legacy attestation"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RetrieveArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Attestations which have already been collected are not emitted again.
+	l.putImage("", mediaTypeInToto, []types.Descriptor{l.putBlob("amd64 vex", mediaTypeInToto)}, &amd64)
+	got = collectBlobs(t, c)
+	if diff := cmp.Diff([]string{"amd64 vex"}, got); diff != "" {
+		t.Errorf("RetrieveArtifacts() second run mismatch (-want +got):\n%s", diff)
+	}
+
+	if c.Type() != OCIReferrersCollector {
+		t.Errorf("Type() = %s, want %s", c.Type(), OCIReferrersCollector)
+	}
+}
+
+func TestOCIReferrersCollector_StopsWithoutConsumer(t *testing.T) {
+	l := newTestLayout(t)
+	image := l.putImage(":image", types.MediaTypeOCI1ImageConfig, []types.Descriptor{l.putBlob("image", types.MediaTypeOCI1LayerGzip)}, nil)
+	l.putImage("", mediaTypeInToto, []types.Descriptor{
+		l.putBlob("provenance", mediaTypeInToto),
+		l.putBlob("vex", mediaTypeInToto),
+	}, &image)
+	c, err := NewOCIReferrersCollector(context.Background(), []string{l.repo + ":image"}, false, 0)
+	if err != nil {
+		t.Fatalf("NewOCIReferrersCollector() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChan := make(chan *processor.Document)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.RetrieveArtifacts(ctx, docChan)
+	}()
+	// Only the first layer is received.
+	select {
+	case <-docChan:
+	case err := <-errChan:
+		t.Fatalf("RetrieveArtifacts() returned %v before emitting a document", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("RetrieveArtifacts() did not emit a document")
+	}
+	cancel()
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RetrieveArtifacts() did not return after the context was canceled")
+	}
+	// The referrer is collected again by the next run.
+	if len(c.checkedReferrers) != 0 {
+		t.Errorf("checked referrers = %v after the cancellation, want none", c.checkedReferrers)
+	}
+}
+
+func TestNewOCIReferrersCollector(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := NewOCIReferrersCollector(context.Background(), []string{"ghcr.io/guacsec/guac:latest"}, true, interval); err == nil {
+			t.Errorf("NewOCIReferrersCollector() with a poll interval of %s did not return an error", interval)
+		}
+	}
+}
+
+func withPlatform(desc types.Descriptor, architecture string) types.Descriptor {
+	desc.Platform = &platform.Platform{OS: "linux", Architecture: architecture}
+	return desc
+}
+
+// collectBlobs runs the collector and returns the sorted contents of the
+// documents.
+func collectBlobs(t *testing.T, c *ociReferrersCollector) []string {
+	t.Helper()
+	docChan := make(chan *processor.Document, 10)
+	if err := c.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var blobs []string
+	for doc := range docChan {
+		if doc.SourceInformation.Collector != OCIReferrersCollector {
+			t.Errorf("document collector = %s, want %s", doc.SourceInformation.Collector, OCIReferrersCollector)
+		}
+		blobs = append(blobs, string(doc.Blob))
+	}
+	sort.Strings(blobs)
+	return blobs
+}
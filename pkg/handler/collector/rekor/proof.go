@@ -0,0 +1,218 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Prefixes of the hashes of the leaves and of the interior nodes of the
+// Merkle tree of the log, as defined by RFC 6962.
+const (
+	leafHashPrefix = 0x00
+	nodeHashPrefix = 0x01
+)
+
+// signaturePrefix starts the signature lines of the signed notes.
+const signaturePrefix = "\u2014 "
+
+var errInvalidProof = errors.New("invalid inclusion proof")
+
+func hashLeaf(body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafHashPrefix})
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+func hashChildren(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodeHashPrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// verifyInclusion checks that proof is the audit path of the leaf hash at
+// index in the tree of the given size whose root is rootHash, following the
+// algorithm of section 2.1.3.2 of RFC 9162.
+func verifyInclusion(index, size int64, leafHash []byte, proof [][]byte, rootHash []byte) error {
+	if index < 0 || index >= size {
+		return fmt.Errorf("%w: index %d is not in a tree of size %d", errInvalidProof, index, size)
+	}
+	fn, sn := index, size-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return fmt.Errorf("%w: proof has more than %d hashes", errInvalidProof, len(proof)-1)
+		}
+		if fn&1 == 1 || fn == sn {
+			r = hashChildren(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = hashChildren(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 {
+		return fmt.Errorf("%w: proof is too short", errInvalidProof)
+	}
+	if !bytes.Equal(r, rootHash) {
+		return fmt.Errorf("%w: computed root hash %x, want %x", errInvalidProof, r, rootHash)
+	}
+	return nil
+}
+
+// logKey is the public key of a log, signing its checkpoints.
+type logKey struct {
+	key crypto.PublicKey
+	// hash is the hash identifying the key in the signatures of the
+	// checkpoints: the first 4 bytes of the SHA-256 of its PKIX encoding.
+	hash []byte
+}
+
+// parseLogKey parses the PEM encoded PKIX public key of a log, which must be
+// an ECDSA or Ed25519 key.
+func parseLogKey(content []byte) (*logKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("log public key is not PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log public key: %w", err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported log public key of type %T", key)
+	}
+	hash := sha256.Sum256(block.Bytes)
+	return &logKey{key: key, hash: hash[:4]}, nil
+}
+
+// verify checks that the signed note has a signature by the key. The text
+// of the note ends at its first blank line, which is followed by the
+// signature lines: an em dash, the name of the signer and the base64 encoded
+// key hash and signature.
+func (k *logKey) verify(note string) error {
+	text, signatures, ok := strings.Cut(note, "\n\n")
+	if !ok {
+		return fmt.Errorf("%w: checkpoint is not signed", errInvalidProof)
+	}
+	message := []byte(text + "\n")
+	for _, line := range strings.Split(strings.TrimSuffix(signatures, "\n"), "\n") {
+		if !strings.HasPrefix(line, signaturePrefix) {
+			continue
+		}
+		fields := strings.Fields(line[len(signaturePrefix):])
+		if len(fields) != 2 {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(signature) <= len(k.hash) || !bytes.Equal(signature[:len(k.hash)], k.hash) {
+			continue
+		}
+		signature = signature[len(k.hash):]
+		switch key := k.key.(type) {
+		case *ecdsa.PublicKey:
+			digest := sha256.Sum256(message)
+			ok = ecdsa.VerifyASN1(key, digest[:], signature)
+		case ed25519.PublicKey:
+			ok = ed25519.Verify(key, message, signature)
+		}
+		if ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: checkpoint is not signed by the log", errInvalidProof)
+}
+
+// verifyEntry checks the inclusion proof of the entry with the given UUID,
+// whose last 64 characters are the hex encoded leaf hash of its body. The
+// root hash of the proof must be the one of its checkpoint, which must be
+// signed by the key of the log, so that the proof does not only rely on the
+// response of the log.
+func verifyEntry(uuid string, entry *logEntry, key *logKey) error {
+	if entry.Verification == nil || entry.Verification.InclusionProof == nil {
+		return fmt.Errorf("%w: entry has no inclusion proof", errInvalidProof)
+	}
+	proof := entry.Verification.InclusionProof
+	leafHash := hashLeaf(entry.Body)
+	if len(uuid) < sha256.Size*2 || !strings.EqualFold(uuid[len(uuid)-sha256.Size*2:], hex.EncodeToString(leafHash)) {
+		return fmt.Errorf("%w: leaf hash %x does not match UUID %s", errInvalidProof, leafHash, uuid)
+	}
+	rootHash, err := hex.DecodeString(proof.RootHash)
+	if err != nil {
+		return fmt.Errorf("%w: root hash: %v", errInvalidProof, err)
+	}
+	hashes := make([][]byte, 0, len(proof.Hashes))
+	for _, h := range proof.Hashes {
+		b, err := hex.DecodeString(h)
+		if err != nil {
+			return fmt.Errorf("%w: hash: %v", errInvalidProof, err)
+		}
+		hashes = append(hashes, b)
+	}
+	if proof.Checkpoint == "" {
+		return fmt.Errorf("%w: proof has no checkpoint", errInvalidProof)
+	}
+	if err := key.verify(proof.Checkpoint); err != nil {
+		return err
+	}
+	if err := verifyCheckpoint(proof.Checkpoint, proof.TreeSize, rootHash); err != nil {
+		return err
+	}
+	return verifyInclusion(proof.LogIndex, proof.TreeSize, leafHash, hashes, rootHash)
+}
+
+// verifyCheckpoint checks that the checkpoint, a signed note whose second and
+// third lines are the tree size and the base64 encoded root hash, is for the
+// given tree.
+func verifyCheckpoint(checkpoint string, size int64, rootHash []byte) error {
+	lines := strings.SplitN(checkpoint, "\n", 4)
+	if len(lines) < 3 {
+		return fmt.Errorf("%w: malformed checkpoint", errInvalidProof)
+	}
+	checkpointSize, err := strconv.ParseInt(lines[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: checkpoint tree size: %v", errInvalidProof, err)
+	}
+	checkpointRoot, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil {
+		return fmt.Errorf("%w: checkpoint root hash: %v", errInvalidProof, err)
+	}
+	if checkpointSize != size || !bytes.Equal(checkpointRoot, rootHash) {
+		return fmt.Errorf("%w: checkpoint is for another tree", errInvalidProof)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// testTree returns the root hash of the tree of the leaves and the audit path
+// of the leaf at index m, computed as defined by section 2.1.3.1 of RFC 9162.
+func testTree(leaves [][]byte, m int) ([]byte, [][]byte) {
+	if len(leaves) == 1 {
+		return hashLeaf(leaves[0]), nil
+	}
+	k := 1
+	for k*2 < len(leaves) {
+		k *= 2
+	}
+	if m < k {
+		left, path := testTree(leaves[:k], m)
+		right, _ := testTree(leaves[k:], 0)
+		return hashChildren(left, right), append(path, right)
+	}
+	left, _ := testTree(leaves[:k], 0)
+	right, path := testTree(leaves[k:], m-k)
+	return hashChildren(left, right), append(path, left)
+}
+
+func Test_verifyInclusion(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		var leaves [][]byte
+		for i := 0; i < size; i++ {
+			leaves = append(leaves, []byte(fmt.Sprintf("leaf %d", i)))
+		}
+		for i := 0; i < size; i++ {
+			root, path := testTree(leaves, i)
+			leaf := hashLeaf(leaves[i])
+			if err := verifyInclusion(int64(i), int64(size), leaf, path, root); err != nil {
+				t.Errorf("verifyInclusion(%d, %d) error = %v", i, size, err)
+			}
+			if err := verifyInclusion(int64(i), int64(size), hashLeaf([]byte("other")), path, root); !errors.Is(err, errInvalidProof) {
+				t.Errorf("verifyInclusion(%d, %d) of another leaf error = %v, want %v", i, size, err, errInvalidProof)
+			}
+			if size > 1 {
+				if err := verifyInclusion(int64(i), int64(size), leaf, path[:len(path)-1], root); !errors.Is(err, errInvalidProof) {
+					t.Errorf("verifyInclusion(%d, %d) of a truncated path error = %v, want %v", i, size, err, errInvalidProof)
+				}
+			}
+		}
+	}
+	if err := verifyInclusion(3, 3, hashLeaf(nil), nil, hashLeaf(nil)); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyInclusion() of an index out of the tree error = %v, want %v", err, errInvalidProof)
+	}
+}
+
+// newLogKey returns a new key of a log and the function signing the text of
+// a checkpoint with it.
+func newLogKey(t *testing.T, ed bool) (*logKey, func(text string) string) {
+	t.Helper()
+	var public crypto.PublicKey
+	var sign func(message []byte) []byte
+	if ed {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		public = pub
+		sign = func(message []byte) []byte { return ed25519.Sign(priv, message) }
+	} else {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		public = &priv.PublicKey
+		sign = func(message []byte) []byte {
+			digest := sha256.Sum256(message)
+			signature, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return signature
+		}
+	}
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := parseLogKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, func(text string) string {
+		signature := append(append([]byte{}, key.hash...), sign([]byte(text))...)
+		return text + "\n" + signaturePrefix + "test.log " + base64.StdEncoding.EncodeToString(signature) + "\n"
+	}
+}
+
+func Test_logKey_verify(t *testing.T) {
+	const text = "test.log - 1\n5\nrGG9GFI//ahVFPLp1EGhY8VBIVTbuAy3ZPGLbxwV52g=\n"
+	for _, ed := range []bool{false, true} {
+		key, sign := newLogKey(t, ed)
+		other, signOther := newLogKey(t, ed)
+		note := sign(text)
+		if err := key.verify(note); err != nil {
+			t.Errorf("verify() error = %v", err)
+		}
+		// The signatures by other keys are ignored.
+		if err := key.verify(signOther(text) + strings.SplitN(note, "\n\n", 2)[1]); err != nil {
+			t.Errorf("verify() of a note signed twice error = %v", err)
+		}
+		if err := other.verify(note); !errors.Is(err, errInvalidProof) {
+			t.Errorf("verify() by another key error = %v, want %v", err, errInvalidProof)
+		}
+		if err := key.verify(strings.Replace(note, "\n5\n", "\n6\n", 1)); !errors.Is(err, errInvalidProof) {
+			t.Errorf("verify() of a modified note error = %v, want %v", err, errInvalidProof)
+		}
+		if err := key.verify(text); !errors.Is(err, errInvalidProof) {
+			t.Errorf("verify() of an unsigned note error = %v, want %v", err, errInvalidProof)
+		}
+	}
+}
+
+func Test_verifyEntry(t *testing.T) {
+	var entries map[string]*logEntry
+	readTestdata(t, "entries.json", &entries)
+	key, err := parseLogKey(readLogKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, uuid := range []string{provenanceUUID, hashedRekordUUID, dsseUUID} {
+		if err := verifyEntry(uuid, entries[uuid], key); err != nil {
+			t.Errorf("verifyEntry(%s) error = %v", uuid, err)
+		}
+	}
+	if err := verifyEntry(tamperedUUID, entries[tamperedUUID], key); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() of the tampered entry error = %v, want %v", err, errInvalidProof)
+	}
+	// The body must be the one of the UUID.
+	if err := verifyEntry(dsseUUID, entries[provenanceUUID], key); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() of another entry error = %v, want %v", err, errInvalidProof)
+	}
+
+	withCheckpoint := func(checkpoint string) *logEntry {
+		entry := *entries[provenanceUUID]
+		proof := *entry.Verification.InclusionProof
+		proof.Checkpoint = checkpoint
+		entry.Verification = &struct {
+			InclusionProof *inclusionProof `json:"inclusionProof"`
+		}{&proof}
+		return &entry
+	}
+	checkpoint := entries[provenanceUUID].Verification.InclusionProof.Checkpoint
+	text := checkpoint[:strings.Index(checkpoint, "\n\n")+1]
+	// A log may serve a proof consistent with its own checkpoint, which is
+	// only trusted once its signature is verified.
+	forgedKey, sign := newLogKey(t, false)
+	forged := withCheckpoint(sign(text))
+	if err := verifyEntry(provenanceUUID, forged, forgedKey); err != nil {
+		t.Errorf("verifyEntry() with the checkpoint signed by the forged key error = %v", err)
+	}
+	if err := verifyEntry(provenanceUUID, forged, key); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() with the checkpoint signed by another key error = %v, want %v", err, errInvalidProof)
+	}
+	otherTree := withCheckpoint(sign(strings.Replace(text, "\n5\n", "\n6\n", 1)))
+	if err := verifyEntry(provenanceUUID, otherTree, forgedKey); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() with the checkpoint of another tree error = %v, want %v", err, errInvalidProof)
+	}
+	if err := verifyEntry(provenanceUUID, withCheckpoint(""), key); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() without checkpoint error = %v, want %v", err, errInvalidProof)
+	}
+
+	entry := *entries[provenanceUUID]
+	entry.Verification = nil
+	if err := verifyEntry(provenanceUUID, &entry, key); !errors.Is(err, errInvalidProof) {
+		t.Errorf("verifyEntry() without proof error = %v, want %v", err, errInvalidProof)
+	}
+}
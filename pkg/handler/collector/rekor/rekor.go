@@ -0,0 +1,390 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	RekorCollector = "RekorCollector"
+
+	// DefaultURL is the public instance of rekor run by sigstore.
+	DefaultURL = "https://rekor.sigstore.dev"
+	// DefaultLogPublicKey is the public key of the log at DefaultURL,
+	// signing its checkpoints.
+	DefaultLogPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE2G2Y+2tabdTV5BcGiBIx0a9fAFwr
+kBbmLSGtks4L3qX6yYY0zufBnhC8Ur/iy55GhWP/9A/bY2LhC30M9+RYtw==
+-----END PUBLIC KEY-----
+`
+	// DefaultPageSize is the number of entries retrieved by request when
+	// Config.PageSize is not set, which is also the most rekor returns.
+	DefaultPageSize = 10
+
+	indexPath   = "/api/v1/index/retrieve"
+	entriesPath = "/api/v1/log/entries/retrieve"
+)
+
+var errInvalidAttestation = errors.New("invalid attestation")
+
+// attestationKinds are the kinds of rekor entries whose attestation is an
+// in-toto statement.
+var attestationKinds = map[string]bool{
+	"intoto": true,
+	"dsse":   true,
+}
+
+// PublicKey is a public key signing the entries to collect.
+type PublicKey struct {
+	// Format is the format of the key as named by rekor: x509, pgp, ssh,
+	// minisign or tuf.
+	Format string
+	// Content is the key, e.g. PEM encoded for x509.
+	Content []byte
+}
+
+// Config configures the collector of the attestations stored in a rekor
+// transparency log.
+type Config struct {
+	// URL is the rekor instance to query, DefaultURL if empty.
+	URL string
+	// LogPublicKey is the PEM encoded public key of the log, verifying the
+	// checkpoints of the inclusion proofs. It is required unless URL is
+	// DefaultURL, whose key is DefaultLogPublicKey.
+	LogPublicKey []byte
+	// Digests are the digests of the artifacts whose entries are
+	// collected, as algorithm:hex (e.g., sha256:b5bb9d80...).
+	Digests []string
+	// PublicKeys are the keys whose entries are collected.
+	PublicKeys []PublicKey
+	// PageSize is the number of entries retrieved by request,
+	// DefaultPageSize if not set.
+	PageSize int
+	// Client sends the requests to rekor, http.DefaultClient if nil.
+	Client *http.Client
+	// Poll keeps the collector running, searching the log every Interval,
+	// which must then be positive, for new entries.
+	Poll     bool
+	Interval time.Duration
+}
+
+// rekorCollector emits the in-toto statements attested by the intoto and
+// dsse entries of a rekor log which match the digests or keys of its config.
+// Each entry is only emitted once its inclusion proof is verified and its
+// attestation matches its body, and only once while the collector runs.
+type rekorCollector struct {
+	config Config
+	logKey *logKey
+	// seen holds the UUIDs of the entries already handled.
+	seen map[string]bool
+}
+
+// NewRekorCollector returns the collector of the entries of config.URL
+// matching config.Digests or config.PublicKeys.
+func NewRekorCollector(config Config) (*rekorCollector, error) {
+	if config.URL == "" {
+		config.URL = DefaultURL
+	}
+	u, err := url.Parse(config.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid rekor URL %q", config.URL)
+	}
+	config.URL = strings.TrimSuffix(config.URL, "/")
+	if len(config.LogPublicKey) == 0 {
+		if config.URL != DefaultURL {
+			return nil, fmt.Errorf("no public key for the log at %s", config.URL)
+		}
+		config.LogPublicKey = []byte(DefaultLogPublicKey)
+	}
+	key, err := parseLogKey(config.LogPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Digests) == 0 && len(config.PublicKeys) == 0 {
+		return nil, fmt.Errorf("no digest nor public key to search rekor for")
+	}
+	for _, digest := range config.Digests {
+		algorithm, value, ok := strings.Cut(digest, ":")
+		if _, err := hex.DecodeString(value); !ok || algorithm == "" || value == "" || err != nil {
+			return nil, fmt.Errorf("invalid digest %q, want algorithm:hex", digest)
+		}
+	}
+	for _, key := range config.PublicKeys {
+		if key.Format == "" || len(key.Content) == 0 {
+			return nil, fmt.Errorf("public keys must have a format and a content")
+		}
+	}
+	if config.PageSize < 0 {
+		return nil, fmt.Errorf("invalid page size %d", config.PageSize)
+	}
+	if config.PageSize == 0 {
+		config.PageSize = DefaultPageSize
+	}
+	if config.Poll && config.Interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s: it must be positive", config.Interval)
+	}
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	return &rekorCollector{config: config, logKey: key, seen: map[string]bool{}}, nil
+}
+
+// RetrieveArtifacts collects the documents from the collector. It emits each collected
+// document through the channel to be collected and processed by the upstream processor.
+// The function should block until all the artifacts are collected and return a nil error
+// or return an error from the collector crashing. This function can keep running and check
+// for new artifacts as they are being uploaded by polling on an interval or run once and
+// grab all the artifacts and end.
+func (r *rekorCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		if err := r.collect(ctx, docChannel); err != nil {
+			return err
+		}
+		if !r.config.Poll {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.Interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (r *rekorCollector) Type() string {
+	return RekorCollector
+}
+
+// indexQuery is the body of the requests searching the index of the log.
+type indexQuery struct {
+	Hash      string          `json:"hash,omitempty"`
+	PublicKey *indexPublicKey `json:"publicKey,omitempty"`
+}
+
+type indexPublicKey struct {
+	Format  string `json:"format"`
+	Content []byte `json:"content"`
+}
+
+// logEntry is an entry of the log as returned by rekor. The body is the
+// canonicalized entry, whose hash is the leaf of the Merkle tree.
+type logEntry struct {
+	Body           []byte `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+	Verification   *struct {
+		InclusionProof *inclusionProof `json:"inclusionProof"`
+	} `json:"verification"`
+	Attestation *struct {
+		Data []byte `json:"data"`
+	} `json:"attestation"`
+}
+
+// inclusionProof proves that an entry is included in the tree of the log.
+// LogIndex is the index of the entry in the tree, which differs from the
+// index of the entry in the log when it has been sharded.
+type inclusionProof struct {
+	Checkpoint string   `json:"checkpoint"`
+	Hashes     []string `json:"hashes"`
+	LogIndex   int64    `json:"logIndex"`
+	RootHash   string   `json:"rootHash"`
+	TreeSize   int64    `json:"treeSize"`
+}
+
+func (r *rekorCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	var queries []indexQuery
+	for _, digest := range r.config.Digests {
+		queries = append(queries, indexQuery{Hash: strings.ToLower(digest)})
+	}
+	for _, key := range r.config.PublicKeys {
+		queries = append(queries, indexQuery{PublicKey: &indexPublicKey{Format: key.Format, Content: key.Content}})
+	}
+	var uuids []string
+	pending := map[string]bool{}
+	for _, query := range queries {
+		var found []string
+		if err := r.post(ctx, indexPath, query, &found); err != nil {
+			return fmt.Errorf("failed to search rekor index: %w", err)
+		}
+		for _, uuid := range found {
+			uuid = strings.ToLower(uuid)
+			if r.seen[uuid] || pending[uuid] {
+				continue
+			}
+			pending[uuid] = true
+			uuids = append(uuids, uuid)
+		}
+	}
+
+	for start := 0; start < len(uuids); start += r.config.PageSize {
+		end := start + r.config.PageSize
+		if end > len(uuids) {
+			end = len(uuids)
+		}
+		page := uuids[start:end]
+		var response []map[string]*logEntry
+		if err := r.post(ctx, entriesPath, map[string][]string{"entryUUIDs": page}, &response); err != nil {
+			return fmt.Errorf("failed to retrieve rekor entries: %w", err)
+		}
+		// Entries are keyed on their leaf hash, as rekor may return the
+		// UUIDs without the ID of the tree.
+		entries := map[string]*logEntry{}
+		for _, m := range response {
+			for uuid, entry := range m {
+				entries[leafHashOf(uuid)] = entry
+			}
+		}
+		for _, uuid := range page {
+			entry, ok := entries[leafHashOf(uuid)]
+			if !ok || entry == nil {
+				logger.Warnf("rekor did not return entry %s", uuid)
+				continue
+			}
+			// Entries failing verification are not marked as seen, so
+			// that they are verified again at the next poll.
+			if err := verifyEntry(uuid, entry, r.logKey); err != nil {
+				logger.Warnf("skipping rekor entry %s: %v", uuid, err)
+				continue
+			}
+			doc, err := r.document(uuid, entry)
+			if errors.Is(err, errInvalidAttestation) {
+				logger.Warnf("skipping rekor entry %s: %v", uuid, err)
+				continue
+			}
+			r.seen[uuid] = true
+			if err != nil {
+				logger.Debugf("skipping rekor entry %s: %v", uuid, err)
+				continue
+			}
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// entryBody is the part of the body of the entries identifying their
+// attestation: the hash of the payload of the envelope, which is the
+// attestation stored by rekor. It is in the content of the intoto entries
+// and at the top of the spec of the dsse entries.
+type entryBody struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Content struct {
+			PayloadHash *entryHash `json:"payloadHash"`
+		} `json:"content"`
+		PayloadHash *entryHash `json:"payloadHash"`
+	} `json:"spec"`
+}
+
+type entryHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+// document returns the document of the attestation of the entry, failing
+// for the entries which do not attest an in-toto statement. The attestation
+// is not part of the body proven to be in the log, so it must have the
+// payload hash of the body, or errInvalidAttestation is returned.
+func (r *rekorCollector) document(uuid string, entry *logEntry) (*processor.Document, error) {
+	var body entryBody
+	if err := json.Unmarshal(entry.Body, &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal body: %w", err)
+	}
+	if !attestationKinds[body.Kind] {
+		return nil, fmt.Errorf("entry of kind %q has no in-toto statement", body.Kind)
+	}
+	if entry.Attestation == nil || len(entry.Attestation.Data) == 0 {
+		return nil, fmt.Errorf("attestation is not stored in the log")
+	}
+	payloadHash := body.Spec.PayloadHash
+	if payloadHash == nil {
+		payloadHash = body.Spec.Content.PayloadHash
+	}
+	if payloadHash == nil || payloadHash.Algorithm != "sha256" {
+		return nil, fmt.Errorf("%w: body has no sha256 payload hash", errInvalidAttestation)
+	}
+	digest := sha256.Sum256(entry.Attestation.Data)
+	if !strings.EqualFold(payloadHash.Value, hex.EncodeToString(digest[:])) {
+		return nil, fmt.Errorf("%w: attestation hash %x does not match payload hash %s", errInvalidAttestation, digest, payloadHash.Value)
+	}
+	return &processor.Document{
+		Blob:   entry.Attestation.Data,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: RekorCollector,
+			Source:    r.config.URL + "/api/v1/log/entries/" + uuid,
+		},
+	}, nil
+}
+
+// post sends the request as JSON to the path of the rekor API, decoding the
+// JSON response into response.
+func (r *rekorCollector) post(ctx context.Context, path string, request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.URL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := r.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("rekor returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// leafHashOf returns the leaf hash of the UUID of an entry, which is
+// prefixed by the ID of the tree for the sharded logs.
+func leafHashOf(uuid string) string {
+	uuid = strings.ToLower(uuid)
+	if n := len(uuid); n > 64 {
+		return uuid[n-64:]
+	}
+	return uuid
+}
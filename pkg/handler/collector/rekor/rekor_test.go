@@ -0,0 +1,374 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	// testDigest is the digest of the subject of the recorded entries.
+	testDigest = "sha256:8d8b2b1e4c6ae4e3b9bbd13bd0b5a0de2f4c0b4a3d7ab4bb5a70d03b5f1a8cd4"
+
+	// UUIDs of the recorded entries.
+	provenanceUUID   = "24296fb24b8ad77a7f06164ddf3f2c7a16330476ad7c3ae87e0960f04da0776d90c34d42f43a6765"
+	hashedRekordUUID = "24296fb24b8ad77ac1abc8e12fc740b512f570bc824ca0b0bc2536c38058cfee4d5a9decbcc52d10"
+	tamperedUUID     = "24296fb24b8ad77a73251480e1d151295e78802a7c2d2be8ae425c3a6875dba7841ef048e347f7c6"
+	dsseUUID         = "24296fb24b8ad77af59eca023eca71e09e6a7e0c95356780917de7b2dfb7df599029b3cf2860eeec"
+)
+
+var testKey = PublicKey{Format: "x509", Content: []byte("-----BEGIN PUBLIC KEY-----")}
+
+// readLogKey returns the key of the test log, signing the checkpoints of the
+// recorded entries.
+func readLogKey(t *testing.T) []byte {
+	t.Helper()
+	b, err := os.ReadFile("testdata/log.pub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func readTestdata(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	b, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTestServer serves the responses recorded from a log of 5 entries: the
+// digest matches an intoto, a hashedrekord and an intoto entry whose proof is
+// tampered, and the key a dsse entry. The requests retrieving entries are
+// counted in entryRequests. The checkpoints of the proofs are signed by the
+// key of testdata/log.pub.
+func newTestServer(t *testing.T, entryRequests *int32) *httptest.Server {
+	t.Helper()
+	var entries map[string]json.RawMessage
+	var digestUUIDs, keyUUIDs []string
+	readTestdata(t, "entries.json", &entries)
+	readTestdata(t, "index-digest.json", &digestUUIDs)
+	readTestdata(t, "index-key.json", &keyUUIDs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(indexPath, func(w http.ResponseWriter, r *http.Request) {
+		var query indexQuery
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		found := []string{}
+		switch {
+		case query.Hash == testDigest:
+			found = digestUUIDs
+		case query.PublicKey != nil && reflect.DeepEqual(*query.PublicKey, indexPublicKey(testKey)):
+			found = keyUUIDs
+		}
+		_ = json.NewEncoder(w).Encode(found)
+	})
+	mux.HandleFunc(entriesPath, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(entryRequests, 1)
+		var query struct {
+			EntryUUIDs []string `json:"entryUUIDs"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(query.EntryUUIDs) > DefaultPageSize {
+			http.Error(w, "too many entries", http.StatusUnprocessableEntity)
+			return
+		}
+		response := []map[string]json.RawMessage{}
+		for _, uuid := range query.EntryUUIDs {
+			if entry, ok := entries[uuid]; ok {
+				response = append(response, map[string]json.RawMessage{uuid: entry})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func collect(t *testing.T, c *rekorCollector) []*processor.Document {
+	t.Helper()
+	docChan := make(chan *processor.Document, 10)
+	if err := c.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+func TestRekorCollector_RetrieveArtifacts(t *testing.T) {
+	var attestations map[string]string
+	readTestdata(t, "attestations.json", &attestations)
+
+	var entryRequests int32
+	s := newTestServer(t, &entryRequests)
+	c, err := NewRekorCollector(Config{
+		URL:          s.URL + "/",
+		LogPublicKey: readLogKey(t),
+		Digests:      []string{strings.ToUpper(testDigest)},
+		PublicKeys:   []PublicKey{testKey},
+		PageSize:     2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The hashedrekord entry has no attestation and the proof of the
+	// tampered entry does not verify, so they are not emitted.
+	want := []*processor.Document{{
+		Blob:   []byte(attestations["provenance"]),
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: RekorCollector,
+			Source:    s.URL + "/api/v1/log/entries/" + provenanceUUID,
+		},
+	}, {
+		Blob:   []byte(attestations["vsa"]),
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: RekorCollector,
+			Source:    s.URL + "/api/v1/log/entries/" + dsseUUID,
+		},
+	}}
+	got := collect(t, c)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RetrieveArtifacts() emitted %s, want %s", sources(got), sources(want))
+	}
+	// The 4 entries are retrieved in 2 pages.
+	if entryRequests != 2 {
+		t.Errorf("sent %d requests retrieving entries, want 2", entryRequests)
+	}
+
+	// Only the tampered entry is retrieved again.
+	if got := collect(t, c); len(got) != 0 {
+		t.Errorf("second RetrieveArtifacts() emitted %s, want none", sources(got))
+	}
+	if entryRequests != 3 {
+		t.Errorf("sent %d requests retrieving entries, want 3", entryRequests)
+	}
+	var seen []string
+	for uuid := range c.seen {
+		seen = append(seen, uuid)
+	}
+	sort.Strings(seen)
+	wantSeen := []string{provenanceUUID, hashedRekordUUID, dsseUUID}
+	sort.Strings(wantSeen)
+	if !reflect.DeepEqual(seen, wantSeen) {
+		t.Errorf("seen entries = %v, want %v", seen, wantSeen)
+	}
+}
+
+func TestRekorCollector_PollCanceled(t *testing.T) {
+	var entryRequests int32
+	s := newTestServer(t, &entryRequests)
+	c, err := NewRekorCollector(Config{
+		URL:          s.URL,
+		LogPublicKey: readLogKey(t),
+		Digests:      []string{testDigest},
+		PublicKeys:   []PublicKey{testKey},
+		Poll:         true,
+		Interval:     time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChan := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.RetrieveArtifacts(ctx, docChan)
+	}()
+	// The collector waits for the next poll once the 2 attestations are
+	// emitted.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-docChan:
+		case err := <-errChan:
+			t.Fatalf("RetrieveArtifacts() returned %v before emitting the attestations", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("RetrieveArtifacts() did not emit the attestations")
+		}
+	}
+	cancel()
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RetrieveArtifacts() did not return after the context was canceled")
+	}
+}
+
+func TestRekorCollector_document(t *testing.T) {
+	var entries map[string]*logEntry
+	readTestdata(t, "entries.json", &entries)
+	c, err := NewRekorCollector(Config{Digests: []string{testDigest}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The intoto and dsse entries have the payload hash at different places.
+	for _, uuid := range []string{provenanceUUID, dsseUUID} {
+		if _, err := c.document(uuid, entries[uuid]); err != nil {
+			t.Errorf("document(%s) error = %v", uuid, err)
+		}
+	}
+	if _, err := c.document(hashedRekordUUID, entries[hashedRekordUUID]); err == nil || errors.Is(err, errInvalidAttestation) {
+		t.Errorf("document() of the hashedrekord entry error = %v, want an entry without statement", err)
+	}
+	// The attestation of an entry proven to be in the log must be the one
+	// of its body.
+	for _, uuid := range []string{provenanceUUID, dsseUUID} {
+		entry := *entries[uuid]
+		entry.Attestation = entries[tamperedUUID].Attestation
+		if _, err := c.document(uuid, &entry); !errors.Is(err, errInvalidAttestation) {
+			t.Errorf("document(%s) of another attestation error = %v, want %v", uuid, err, errInvalidAttestation)
+		}
+	}
+}
+
+func TestRekorCollector_ServerError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+	c, err := NewRekorCollector(Config{URL: s.URL, LogPublicKey: readLogKey(t), Digests: []string{testDigest}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.RetrieveArtifacts(context.Background(), make(chan *processor.Document, 1))
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("RetrieveArtifacts() error = %v, want the status of the server", err)
+	}
+}
+
+func TestNewRekorCollector(t *testing.T) {
+	logKey := readLogKey(t)
+	tests := []struct {
+		name    string
+		config  Config
+		wantURL string
+		wantErr bool
+	}{{
+		name:    "default URL",
+		config:  Config{Digests: []string{testDigest}},
+		wantURL: DefaultURL,
+	}, {
+		name:    "private instance",
+		config:  Config{URL: "http://rekor.internal:3000/", LogPublicKey: logKey, PublicKeys: []PublicKey{testKey}},
+		wantURL: "http://rekor.internal:3000",
+	}, {
+		name:    "private instance without log key",
+		config:  Config{URL: "http://rekor.internal:3000/", PublicKeys: []PublicKey{testKey}},
+		wantErr: true,
+	}, {
+		name:    "invalid log key",
+		config:  Config{LogPublicKey: testKey.Content, Digests: []string{testDigest}},
+		wantErr: true,
+	}, {
+		name:    "invalid URL",
+		config:  Config{URL: "rekor.internal", Digests: []string{testDigest}},
+		wantErr: true,
+	}, {
+		name:    "no subject",
+		config:  Config{},
+		wantErr: true,
+	}, {
+		name:    "digest without algorithm",
+		config:  Config{Digests: []string{"8d8b2b1e4c6a"}},
+		wantErr: true,
+	}, {
+		name:    "digest not hex encoded",
+		config:  Config{Digests: []string{"sha256:guac"}},
+		wantErr: true,
+	}, {
+		name:    "key without format",
+		config:  Config{PublicKeys: []PublicKey{{Content: testKey.Content}}},
+		wantErr: true,
+	}, {
+		name:    "negative page size",
+		config:  Config{Digests: []string{testDigest}, PageSize: -1},
+		wantErr: true,
+	}, {
+		name:    "zero poll interval",
+		config:  Config{Digests: []string{testDigest}, Poll: true},
+		wantErr: true,
+	}, {
+		name:    "negative poll interval",
+		config:  Config{Digests: []string{testDigest}, Poll: true, Interval: -time.Second},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewRekorCollector(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRekorCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if c.config.URL != tt.wantURL {
+				t.Errorf("URL = %s, want %s", c.config.URL, tt.wantURL)
+			}
+			if c.logKey == nil {
+				t.Errorf("no log key")
+			}
+			if c.config.PageSize != DefaultPageSize {
+				t.Errorf("PageSize = %d, want %d", c.config.PageSize, DefaultPageSize)
+			}
+		})
+	}
+}
+
+func sources(docs []*processor.Document) []string {
+	var out []string
+	for _, d := range docs {
+		out = append(out, d.SourceInformation.Source)
+	}
+	return out
+}
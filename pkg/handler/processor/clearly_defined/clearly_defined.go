@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/certifier/clearly_defined"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// ClearlyDefinedProcessor processes the CLEARLY_DEFINED documents generated
+// by the ClearlyDefined certifier. Currently only supports JSON documents
+type ClearlyDefinedProcessor struct {
+}
+
+func (p *ClearlyDefinedProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentClearlyDefined {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentClearlyDefined, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var legal clearly_defined.PackageLegal
+		if err := json.Unmarshal(d.Blob, &legal); err != nil {
+			return err
+		}
+		if legal.Purl == "" {
+			return fmt.Errorf("missing required purl field")
+		}
+		if legal.DeclaredLicense == "" && legal.DiscoveredLicense == "" {
+			return fmt.Errorf("missing license information")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of ClearlyDefined document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *ClearlyDefinedProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentClearlyDefined {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentClearlyDefined, d.Type)
+	}
+
+	// ClearlyDefined documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
@@ -0,0 +1,117 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var testLegal = []byte(`{
+  "purl": "pkg:npm/debug@4.3.4",
+  "coordinate": "npm/npmjs/-/debug/4.3.4",
+  "declaredLicense": "MIT",
+  "discoveredLicense": "MIT",
+  "attribution": "Copyright (c) 2014 TJ Holowaychuk",
+  "timeScanned": "2023-03-06T00:00:00Z"
+}`)
+
+func TestClearlyDefinedProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "ClearlyDefined document",
+		doc: processor.Document{
+			Blob:   testLegal,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentClearlyDefined,
+		},
+		expected: []*processor.Document{},
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   testLegal,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := ClearlyDefinedProcessor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("ClearlyDefinedProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ClearlyDefinedProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClearlyDefinedProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid ClearlyDefined document",
+		doc: processor.Document{
+			Blob:   testLegal,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentClearlyDefined,
+		},
+	}, {
+		name: "missing purl",
+		doc: processor.Document{
+			Blob:   []byte(`{"declaredLicense": "MIT"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentClearlyDefined,
+		},
+		expectErr: true,
+	}, {
+		name: "missing license",
+		doc: processor.Document{
+			Blob:   []byte(`{"purl": "pkg:npm/debug@4.3.4"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentClearlyDefined,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid format supported",
+		doc: processor.Document{
+			Blob:   testLegal,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentClearlyDefined,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := ClearlyDefinedProcessor{}
+			err := d.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("ClearlyDefinedProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -17,32 +17,77 @@ package cyclonedx
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
 // CycloneDXProcessor processes CycloneDXProcessor documents.
-// Currently only supports CycloneDX-JSON documents
+// Supports CycloneDX-JSON and CycloneDX-XML documents
 type CycloneDXProcessor struct {
 }
 
+const (
+	// specVersion1_5 is the version of the CycloneDX 1.5 specification, which
+	// cyclonedx-go does not know about yet.
+	specVersion1_5 = "1.5"
+
+	// xmlNamespacePrefix prefixes the XML namespaces of all the versions of
+	// the CycloneDX specification.
+	xmlNamespacePrefix = "http://cyclonedx.org/schema/bom/"
+)
+
 func (p *CycloneDXProcessor) ValidateSchema(d *processor.Document) error {
 	if d.Type != processor.DocumentCycloneDX {
 		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentCycloneDX, d.Type)
 	}
 
-	switch d.Format {
+	_, err := DecodeBOM(d.Blob, d.Format)
+	return err
+}
+
+// DecodeBOM decodes a CycloneDX document in the JSON or XML format.
+//
+// cyclonedx-go rejects the documents with a specification version it does
+// not know, which includes 1.5. These are decoded as 1.4 documents instead,
+// ignoring the fields added in 1.5, and the SpecVersion of the returned BOM
+// is left unset.
+func DecodeBOM(blob []byte, format processor.FormatType) (*cdx.BOM, error) {
+	switch format {
 	case processor.FormatJSON:
-		reader := bytes.NewReader(d.Blob)
+		// The outer SpecVersion shadows the one of the BOM, so that it is
+		// only decoded for the known versions.
+		var doc struct {
+			cdx.BOM
+			SpecVersion string `json:"specVersion"`
+		}
+		if err := json.Unmarshal(blob, &doc); err != nil {
+			return nil, err
+		}
+		if doc.SpecVersion != specVersion1_5 {
+			if err := doc.BOM.SpecVersion.UnmarshalJSON([]byte(strconv.Quote(doc.SpecVersion))); err != nil {
+				return nil, err
+			}
+		}
+		return &doc.BOM, nil
+	case processor.FormatXML:
+		// The XML documents carry their version in the namespace, which
+		// cyclonedx-go does not check.
 		bom := new(cdx.BOM)
-		decoder := cdx.NewBOMDecoder(reader, cdx.BOMFileFormatJSON)
-		err := decoder.Decode(bom)
-		return err
+		if err := cdx.NewBOMDecoder(bytes.NewReader(blob), cdx.BOMFileFormatXML).Decode(bom); err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(bom.XMLNS, xmlNamespacePrefix) {
+			return nil, fmt.Errorf("unexpected CycloneDX XML namespace %q", bom.XMLNS)
+		}
+		return bom, nil
 	}
 
-	return fmt.Errorf("unable to support parsing of CycloneDX document format: %v", d.Format)
+	return nil, fmt.Errorf("unable to support parsing of CycloneDX document format: %v", format)
 }
 
 func (p *CycloneDXProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
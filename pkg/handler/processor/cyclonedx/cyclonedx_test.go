@@ -66,6 +66,25 @@ func TestCycloneDXProcessor_Unpack(t *testing.T) {
 	}
 }
 
+var (
+	cycloneDX15JSON = []byte(`{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.5",
+		"version": 1,
+		"metadata": {"lifecycles": [{"phase": "build"}]},
+		"components": [{"type": "machine-learning-model", "name": "resnet-50", "purl": "pkg:huggingface/microsoft/resnet-50@1.0"}]
+	}`)
+	cycloneDX15XML = []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<bom xmlns="http://cyclonedx.org/schema/bom/1.5" version="1">
+  <components>
+    <component type="library">
+      <name>lodash</name>
+      <purl>pkg:npm/lodash@4.17.21</purl>
+    </component>
+  </components>
+</bom>`)
+)
+
 func TestCycloneDXProcessor_ValidateSchema(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -98,6 +117,33 @@ func TestCycloneDXProcessor_ValidateSchema(t *testing.T) {
 			SourceInformation: processor.SourceInformation{},
 		},
 		expectErr: false,
+	}, {
+		name: "valid CycloneDX 1.5 JSON document",
+		doc: processor.Document{
+			Blob:              cycloneDX15JSON,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentCycloneDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: false,
+	}, {
+		name: "valid CycloneDX 1.5 XML document",
+		doc: processor.Document{
+			Blob:              cycloneDX15XML,
+			Format:            processor.FormatXML,
+			Type:              processor.DocumentCycloneDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: false,
+	}, {
+		name: "XML document with another namespace",
+		doc: processor.Document{
+			Blob:              []byte(`<bom xmlns="http://example.com/bom"></bom>`),
+			Format:            processor.FormatXML,
+			Type:              processor.DocumentCycloneDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: true,
 	}, {
 		name: "invalid CycloneDX document",
 		doc: processor.Document{
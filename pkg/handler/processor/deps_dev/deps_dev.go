@@ -0,0 +1,69 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/certifier/deps_dev"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// DepsDevProcessor processes the DEPS_DEV documents generated by the deps.dev
+// certifier. Currently only supports JSON documents
+type DepsDevProcessor struct {
+}
+
+func (p *DepsDevProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentDepsDev {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDepsDev, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var packageDeps deps_dev.PackageDependencies
+		if err := json.Unmarshal(d.Blob, &packageDeps); err != nil {
+			return err
+		}
+		if packageDeps.Purl == "" {
+			return fmt.Errorf("missing required purl field")
+		}
+		for _, dep := range packageDeps.Dependencies {
+			if dep.From == "" || dep.To == "" {
+				return fmt.Errorf("missing purl in dependency edge")
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of deps.dev document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *DepsDevProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentDepsDev {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDepsDev, d.Type)
+	}
+
+	// deps.dev documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
@@ -0,0 +1,115 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var testDependencies = []byte(`{
+  "purl": "pkg:npm/%40babel/core@7.21.0",
+  "dependencies": [
+    {"from": "pkg:npm/%40babel/core@7.21.0", "to": "pkg:npm/debug@4.3.4", "requirement": "^4.1.0"}
+  ]
+}`)
+
+func TestDepsDevProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "deps.dev document",
+		doc: processor.Document{
+			Blob:   testDependencies,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDepsDev,
+		},
+		expected: []*processor.Document{},
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   testDependencies,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DepsDevProcessor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("DepsDevProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("DepsDevProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDepsDevProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid deps.dev document",
+		doc: processor.Document{
+			Blob:   testDependencies,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDepsDev,
+		},
+	}, {
+		name: "missing purl",
+		doc: processor.Document{
+			Blob:   []byte(`{"dependencies": []}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDepsDev,
+		},
+		expectErr: true,
+	}, {
+		name: "missing dependency purl",
+		doc: processor.Document{
+			Blob:   []byte(`{"purl": "pkg:npm/debug@4.3.4", "dependencies": [{"from": "pkg:npm/debug@4.3.4"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDepsDev,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid format supported",
+		doc: processor.Document{
+			Blob:   testDependencies,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentDepsDev,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DepsDevProcessor{}
+			err := d.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("DepsDevProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -45,6 +45,14 @@ func Test_cyclonedxTypeGuesser_GuessDocumentType(t *testing.T) {
 		name:     "valid distroless cyclonedx Document",
 		blob:     testdata.CycloneDXDistrolessExample,
 		expected: processor.DocumentCycloneDX,
+	}, {
+		name: "valid cyclonedx 1.5 Document",
+		blob: []byte(`{
+			"bomFormat": "CycloneDX",
+			"specVersion": "1.5",
+			"version": 1
+		}`),
+		expected: processor.DocumentCycloneDX,
 	}, {
 		name:     "valid alpine cyclonedx Document",
 		blob:     testdata.CycloneDXExampleAlpine,
@@ -60,3 +68,40 @@ func Test_cyclonedxTypeGuesser_GuessDocumentType(t *testing.T) {
 		})
 	}
 }
+
+func Test_cyclonedxTypeGuesser_GuessDocumentTypeXML(t *testing.T) {
+	testCases := []struct {
+		name     string
+		blob     []byte
+		expected processor.DocumentType
+	}{{
+		name: "valid cyclonedx XML Document",
+		blob: []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<bom xmlns="http://cyclonedx.org/schema/bom/1.4" version="1">
+  <components>
+    <component type="library">
+      <name>lodash</name>
+      <purl>pkg:npm/lodash@4.17.21</purl>
+    </component>
+  </components>
+</bom>`),
+		expected: processor.DocumentCycloneDX,
+	}, {
+		name:     "XML Document with another namespace",
+		blob:     []byte(`<bom xmlns="http://example.com/bom"></bom>`),
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "other XML Document",
+		blob:     []byte(`<project><name>guac</name></project>`),
+		expected: processor.DocumentUnknown,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &cycloneDXTypeGuesser{}
+			f := guesser.GuessDocumentType(tt.blob, processor.FormatXML)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
@@ -16,10 +16,8 @@
 package guesser
 
 import (
-	"bytes"
-
-	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/cyclonedx"
 )
 
 type cycloneDXTypeGuesser struct{}
@@ -29,18 +27,21 @@ const (
 )
 
 func (_ *cycloneDXTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
-	reader := bytes.NewReader(blob)
 	switch format {
 	case processor.FormatJSON:
 		// Decode the BOM
-		bom := new(cdx.BOM)
-		decoder := cdx.NewBOMDecoder(reader, cdx.BOMFileFormatJSON)
-		err := decoder.Decode(bom)
+		bom, err := cyclonedx.DecodeBOM(blob, format)
 		if err == nil {
 			if bom.BOMFormat == cycloneDXFormat {
 				return processor.DocumentCycloneDX
 			}
 		}
+	case processor.FormatXML:
+		// XML documents have no bomFormat, they are recognized by their
+		// namespace when decoding.
+		if _, err := cyclonedx.DecodeBOM(blob, format); err == nil {
+			return processor.DocumentCycloneDX
+		}
 	}
 	return processor.DocumentUnknown
 }
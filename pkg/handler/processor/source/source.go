@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+
+	git_collector "github.com/guacsec/guac/pkg/handler/collector/git"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// SourceProcessor processes the SOURCE documents generated by the git source
+// collector. Currently only supports JSON documents
+type SourceProcessor struct {
+}
+
+func (p *SourceProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentSource {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSource, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var source git_collector.Source
+		if err := json.Unmarshal(d.Blob, &source); err != nil {
+			return err
+		}
+		if source.Type == "" || source.Namespace == "" || source.Name == "" {
+			return fmt.Errorf("missing required type, namespace or name field")
+		}
+		if source.Commit == "" {
+			return fmt.Errorf("missing required commit field")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of source document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *SourceProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentSource {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSource, d.Type)
+	}
+
+	// Source documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var testSource = []byte(`{
+  "type": "git",
+  "namespace": "github.com/guacsec",
+  "name": "guac",
+  "tag": "v0.1.0",
+  "commit": "5835544ca568b757a8ecae5c153f317e5736700e",
+  "ref": "refs/tags/v0.1.0"
+}`)
+
+func TestSourceProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "source document",
+		doc: processor.Document{
+			Blob:   testSource,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSource,
+		},
+		expected: []*processor.Document{},
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   testSource,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := SourceProcessor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SourceProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("SourceProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSourceProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid source document",
+		doc: processor.Document{
+			Blob:   testSource,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSource,
+		},
+	}, {
+		name: "missing commit",
+		doc: processor.Document{
+			Blob:   []byte(`{"type": "git", "namespace": "github.com/guacsec", "name": "guac", "ref": "HEAD"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSource,
+		},
+		expectErr: true,
+	}, {
+		name: "missing name",
+		doc: processor.Document{
+			Blob:   []byte(`{"type": "git", "namespace": "github.com/guacsec", "commit": "5835544ca568b757a8ecae5c153f317e5736700e"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSource,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid JSON",
+		doc: processor.Document{
+			Blob:   []byte(`{`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSource,
+		},
+		expectErr: true,
+	}, {
+		name: "unsupported format",
+		doc: processor.Document{
+			Blob:   testSource,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentSource,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := SourceProcessor{}
+			if err := d.ValidateSchema(&tt.doc); (err != nil) != tt.expectErr {
+				t.Errorf("SourceProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
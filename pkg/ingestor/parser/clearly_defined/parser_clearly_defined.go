@@ -0,0 +1,122 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/clearly_defined"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	purl "github.com/package-url/packageurl-go"
+)
+
+// justification is the justification of the license information retrieved
+// from ClearlyDefined.
+const justification = "Retrieved from ClearlyDefined"
+
+type clearlyDefinedParser struct {
+	legalNodes []assembler.MetadataNode
+	// packageNodes should have a 1:1 mapping to the index of legalNodes.
+	packageNodes []assembler.PackageNode
+}
+
+// NewClearlyDefinedParser initializes the clearlyDefinedParser
+func NewClearlyDefinedParser() common.DocumentParser {
+	return &clearlyDefinedParser{
+		legalNodes:   []assembler.MetadataNode{},
+		packageNodes: []assembler.PackageNode{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *clearlyDefinedParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentClearlyDefined {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentClearlyDefined, doc.Type)
+	}
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var legal clearly_defined.PackageLegal
+		if err := json.Unmarshal(doc.Blob, &legal); err != nil {
+			return err
+		}
+		u, err := purl.FromString(legal.Purl)
+		if err != nil {
+			return fmt.Errorf("invalid purl %q: %w", legal.Purl, err)
+		}
+		p.legalNodes = append(p.legalNodes, getMetadataNode(&legal, doc.SourceInformation))
+		p.packageNodes = append(p.packageNodes, assembler.PackageNode{
+			Name:     u.Name,
+			Version:  u.Version,
+			Purl:     legal.Purl,
+			NodeData: *assembler.NewObjectMetadata(doc.SourceInformation),
+		})
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of ClearlyDefined document format: %v", doc.Format)
+}
+
+// getMetadataNode returns the CertifyLegal metadata node of the license
+// information. Each scan of a coordinate is a distinct node.
+func getMetadataNode(legal *clearly_defined.PackageLegal, source processor.SourceInformation) assembler.MetadataNode {
+	return assembler.MetadataNode{
+		MetadataType: "CertifyLegal",
+		ID:           fmt.Sprintf("%v:%v", legal.Coordinate, legal.TimeScanned.UTC().Format(time.RFC3339)),
+		Details: map[string]interface{}{
+			"declared_license":   legal.DeclaredLicense,
+			"discovered_license": legal.DiscoveredLicense,
+			"attribution":        legal.Attribution,
+			"justification":      justification,
+			"time_scanned":       legal.TimeScanned.UTC().Format(time.RFC3339),
+			"origin":             source.Source,
+			"collector":          source.Collector,
+		},
+	}
+}
+
+// CreateNodes creates the GuacNode for the graph inputs
+func (p *clearlyDefinedParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
+	nodes := []assembler.GuacNode{}
+	for _, n := range p.legalNodes {
+		nodes = append(nodes, n)
+	}
+	for _, n := range p.packageNodes {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// CreateEdges creates the GuacEdges that form the relationship for the graph inputs
+func (p *clearlyDefinedParser) CreateEdges(ctx context.Context, foundIdentities []assembler.IdentityNode) []assembler.GuacEdge {
+	edges := []assembler.GuacEdge{}
+	for i, l := range p.legalNodes {
+		edges = append(edges, assembler.MetadataForEdge{
+			MetadataNode: l,
+			ForPackage:   p.packageNodes[i],
+		})
+	}
+	return edges
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *clearlyDefinedParser) GetIdentities(ctx context.Context) []assembler.IdentityNode {
+	return nil
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clearly_defined
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_clearlyDefinedParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	source := processor.SourceInformation{Collector: "guac", Source: "clearlydefined.io"}
+	debug := assembler.PackageNode{
+		Name:     "debug",
+		Version:  "4.3.4",
+		Purl:     "pkg:npm/debug@4.3.4",
+		NodeData: *assembler.NewObjectMetadata(source),
+	}
+	legal := assembler.MetadataNode{
+		MetadataType: "CertifyLegal",
+		ID:           "npm/npmjs/-/debug/4.3.4:2023-03-06T00:00:00Z",
+		Details: map[string]interface{}{
+			"declared_license":   "MIT",
+			"discovered_license": "MIT AND Apache-2.0",
+			"attribution":        "Copyright (c) 2014 TJ Holowaychuk",
+			"justification":      "Retrieved from ClearlyDefined",
+			"time_scanned":       "2023-03-06T00:00:00Z",
+			"origin":             "clearlydefined.io",
+			"collector":          "guac",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		doc       *processor.Document
+		wantNodes []assembler.GuacNode
+		wantEdges []assembler.GuacEdge
+		wantErr   bool
+	}{{
+		name: "license information",
+		doc: &processor.Document{
+			Blob: []byte(`{
+  "purl": "pkg:npm/debug@4.3.4",
+  "coordinate": "npm/npmjs/-/debug/4.3.4",
+  "declaredLicense": "MIT",
+  "discoveredLicense": "MIT AND Apache-2.0",
+  "attribution": "Copyright (c) 2014 TJ Holowaychuk",
+  "timeScanned": "2023-03-06T00:00:00Z"
+}`),
+			Type:              processor.DocumentClearlyDefined,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantNodes: []assembler.GuacNode{legal, debug},
+		wantEdges: []assembler.GuacEdge{
+			assembler.MetadataForEdge{MetadataNode: legal, ForPackage: debug},
+		},
+	}, {
+		name: "invalid purl",
+		doc: &processor.Document{
+			Blob:              []byte(`{"purl": "npm/debug", "declaredLicense": "MIT"}`),
+			Type:              processor.DocumentClearlyDefined,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewClearlyDefinedParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("clearlyDefinedParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if nodes := p.CreateNodes(ctx); !reflect.DeepEqual(nodes, tt.wantNodes) {
+				t.Errorf("clearlyDefinedParser.CreateNodes() = %v, want %v", nodes, tt.wantNodes)
+			}
+			if edges := p.CreateEdges(ctx, nil); !reflect.DeepEqual(edges, tt.wantEdges) {
+				t.Errorf("clearlyDefinedParser.CreateEdges() = %v, want %v", edges, tt.wantEdges)
+			}
+		})
+	}
+}
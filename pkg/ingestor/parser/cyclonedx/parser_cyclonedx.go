@@ -17,13 +17,14 @@ package cyclonedx
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	cdxprocessor "github.com/guacsec/guac/pkg/handler/processor/cyclonedx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 )
 
@@ -36,6 +37,9 @@ type cyclonedxParser struct {
 type component struct {
 	curPackage  assembler.PackageNode
 	depPackages []*component
+	// artifacts are the occurrences of the package for the hashes listed
+	// in the component.
+	artifacts []assembler.ArtifactNode
 }
 
 func NewCycloneDXParser() common.DocumentParser {
@@ -51,9 +55,19 @@ func (c *cyclonedxParser) CreateNodes(ctx context.Context) []assembler.GuacNode
 	for _, p := range c.rootComponent.depPackages {
 		nodes = append(nodes, p.curPackage)
 	}
+	for _, p := range c.components() {
+		for _, a := range p.artifacts {
+			nodes = append(nodes, a)
+		}
+	}
 	return nodes
 }
 
+// components returns the root component followed by all the other ones.
+func (c *cyclonedxParser) components() []*component {
+	return append([]*component{&c.rootComponent}, c.rootComponent.depPackages...)
+}
+
 func addEdges(curPkg component, edges *[]assembler.GuacEdge, visited map[string]bool) {
 	// this could happen if we image purl creation fails for rootPackage
 	// we need better solution to support different image name formats in SBOM
@@ -78,7 +92,7 @@ func addEdges(curPkg component, edges *[]assembler.GuacEdge, visited map[string]
 // Parse breaks out the document into the graph components
 func (c *cyclonedxParser) Parse(ctx context.Context, doc *processor.Document) error {
 	c.doc = doc
-	cdxBom, err := parseCycloneDXBOM(doc.Blob)
+	cdxBom, err := cdxprocessor.DecodeBOM(doc.Blob, doc.Format)
 	if err != nil {
 		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
 	}
@@ -97,65 +111,112 @@ func (c *cyclonedxParser) CreateEdges(ctx context.Context, foundIdentities []ass
 	edges := []assembler.GuacEdge{}
 	visited := make(map[string]bool)
 	addEdges(c.rootComponent, &edges, visited)
+	for _, p := range c.components() {
+		for _, a := range p.artifacts {
+			edges = append(edges, assembler.IsOccurrenceEdge{PackageNode: p.curPackage, ArtifactNode: a})
+		}
+	}
 	return edges
 }
 
 func (c *cyclonedxParser) addRootPackage(cdxBom *cdx.BOM) {
+	if cdxBom.Metadata == nil || cdxBom.Metadata.Component == nil {
+		return
+	}
+	rootComp := cdxBom.Metadata.Component
+	rootPackage := assembler.PackageNode{}
+	rootPackage.Name = rootComp.Name
+	rootPackage.NodeData = *assembler.NewObjectMetadata(c.doc.SourceInformation)
+	if rootComp.PackageURL != "" {
+		rootPackage.Purl = rootComp.PackageURL
+		rootPackage.Version = rootComp.Version
+		rootPackage.Tags = []string{string(rootComp.Type)}
+	} else if purl := imagePurl(rootComp.Name, rootComp.Version); purl != "" {
+		rootPackage.Purl = purl
+		rootPackage.Version = rootComp.Version
+		rootPackage.Digest = append(rootPackage.Digest, rootComp.Version)
+		rootPackage.Tags = []string{"CONTAINER"}
+	} else {
+		rootPackage.Purl = helpers.GuacPkgPurl(rootComp.Group, rootComp.Name, rootComp.Version)
+		rootPackage.Version = rootComp.Version
+		rootPackage.Tags = []string{string(rootComp.Type)}
+	}
+	c.rootComponent = component{
+		curPackage:  rootPackage,
+		depPackages: []*component{},
+		artifacts:   c.hashArtifacts(rootComp.Hashes, rootPackage.Purl),
+	}
+}
+
+// imagePurl returns the oci purl of a container image named like
+// "gcr.io/distroless/static:nonroot" or "library/debian:latest", or an empty
+// string if the name is not an image name.
+func imagePurl(name, version string) string {
 	// oci purl: pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=ghcr.io/debian&tag=bullseye
-	if cdxBom.Metadata.Component != nil {
-		rootPackage := assembler.PackageNode{}
-		rootPackage.Name = cdxBom.Metadata.Component.Name
-		rootPackage.NodeData = *assembler.NewObjectMetadata(c.doc.SourceInformation)
-		if cdxBom.Metadata.Component.PackageURL != "" {
-			rootPackage.Purl = cdxBom.Metadata.Component.PackageURL
-			rootPackage.Version = cdxBom.Metadata.Component.Version
-			rootPackage.Tags = []string{string(cdxBom.Metadata.Component.Type)}
-		} else {
-			splitImage := strings.Split(cdxBom.Metadata.Component.Name, "/")
-			if len(splitImage) == 3 {
-				// example: gcr.io/distroless/static:nonroot
-				splitTag := strings.Split(splitImage[2], ":")
-				if len(splitTag) == 2 {
-					rootPackage.Purl = "pkg:oci/" + splitTag[0] + "@" + cdxBom.Metadata.Component.Version +
-						"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitTag[0] + "&tag=" + splitTag[1]
-				} else {
-					// no tag specified
-					rootPackage.Purl = "pkg:oci/" + splitImage[2] + "@" + cdxBom.Metadata.Component.Version +
-						"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitImage[2] + "&tag="
-				}
-			} else if len(splitImage) == 2 {
-				// example: library/debian:latest
-				splitTag := strings.Split(splitImage[1], ":")
-				if len(splitTag) == 2 {
-					rootPackage.Purl = "pkg:oci/" + splitTag[0] + "@" + cdxBom.Metadata.Component.Version +
-						"?repository_url=" + splitImage[0] + "/" + splitTag[0] + "&tag=" + splitTag[1]
-				} else {
-					// no tag specified
-					rootPackage.Purl = "pkg:oci/" + splitImage[1] + "@" + cdxBom.Metadata.Component.Version +
-						"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "&tag="
-				}
-			}
-			rootPackage.Version = cdxBom.Metadata.Component.Version
-			rootPackage.Digest = append(rootPackage.Digest, cdxBom.Metadata.Component.Version)
-			rootPackage.Tags = []string{"CONTAINER"}
+	splitImage := strings.Split(name, "/")
+	if len(splitImage) == 3 {
+		// example: gcr.io/distroless/static:nonroot
+		splitTag := strings.Split(splitImage[2], ":")
+		if len(splitTag) == 2 {
+			return "pkg:oci/" + splitTag[0] + "@" + version +
+				"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitTag[0] + "&tag=" + splitTag[1]
 		}
-		c.rootComponent = component{
-			curPackage:  rootPackage,
-			depPackages: []*component{},
+		// no tag specified
+		return "pkg:oci/" + splitImage[2] + "@" + version +
+			"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitImage[2] + "&tag="
+	} else if len(splitImage) == 2 {
+		// example: library/debian:latest
+		splitTag := strings.Split(splitImage[1], ":")
+		if len(splitTag) == 2 {
+			return "pkg:oci/" + splitTag[0] + "@" + version +
+				"?repository_url=" + splitImage[0] + "/" + splitTag[0] + "&tag=" + splitTag[1]
 		}
+		// no tag specified
+		return "pkg:oci/" + splitImage[1] + "@" + version +
+			"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "&tag="
 	}
+	return ""
 }
 
 func (c *cyclonedxParser) addPackages(cdxBom *cdx.BOM) {
-	for _, comp := range *cdxBom.Components {
+	if cdxBom.Components != nil {
+		c.addComponents(*cdxBom.Components)
+	}
+
+	if cdxBom.Dependencies == nil {
+		return
+	}
+	for _, deps := range *cdxBom.Dependencies {
+		currPkg, found := c.pkgMap[deps.Ref]
+		if !found {
+			continue
+		}
+		if deps.Dependencies != nil {
+			for _, depPkg := range *deps.Dependencies {
+				if depPkg, exist := c.pkgMap[depPkg]; exist {
+					currPkg.depPackages = append(currPkg.depPackages, depPkg)
+				}
+			}
+		}
+	}
+}
+
+// addComponents adds the packages of the components and of their nested
+// components. All of them are dependencies of the root component.
+func (c *cyclonedxParser) addComponents(components []cdx.Component) {
+	for _, comp := range components {
 		// skipping over the "operating-system" type as it does not contain
 		// the required purl for package node. Currently there is no use-case
 		// to capture OS for GUAC.
 		if comp.Type != cdx.ComponentTypeOS {
+			purl := comp.PackageURL
+			if purl == "" {
+				purl = helpers.GuacPkgPurl(comp.Group, comp.Name, comp.Version)
+			}
 			curPkg := assembler.PackageNode{
 				Name: comp.Name,
 				// Digest: []string{comp.Version},
-				Purl:     comp.PackageURL,
+				Purl:     purl,
 				Version:  comp.Version,
 				NodeData: *assembler.NewObjectMetadata(c.doc.SourceInformation),
 			}
@@ -165,34 +226,41 @@ func (c *cyclonedxParser) addPackages(cdxBom *cdx.BOM) {
 			parentPkg := component{
 				curPackage:  curPkg,
 				depPackages: []*component{},
+				artifacts:   c.hashArtifacts(comp.Hashes, purl),
 			}
 			c.rootComponent.depPackages = append(c.rootComponent.depPackages, &parentPkg)
 			c.pkgMap[comp.BOMRef] = &parentPkg
 		}
+		if comp.Components != nil {
+			c.addComponents(*comp.Components)
+		}
 	}
+}
 
-	if cdxBom.Dependencies == nil {
-		return
+// hashArtifacts returns an artifact for each hash of a component, named after
+// the purl of its package.
+func (c *cyclonedxParser) hashArtifacts(hashes *[]cdx.Hash, purl string) []assembler.ArtifactNode {
+	if hashes == nil {
+		return nil
 	}
-	for _, deps := range *cdxBom.Dependencies {
-		currPkg, found := c.pkgMap[deps.Ref]
-		if !found {
-			continue
-		}
-		if deps.Dependencies != nil {
-			for _, depPkg := range *deps.Dependencies {
-				if depPkg, exist := c.pkgMap[depPkg]; exist {
-					currPkg.depPackages = append(currPkg.depPackages, depPkg)
-				}
-			}
-		}
+	var artifacts []assembler.ArtifactNode
+	for _, h := range *hashes {
+		artifacts = append(artifacts, assembler.ArtifactNode{
+			Name:     purl,
+			Digest:   hashAlgorithm(h.Algorithm) + ":" + strings.ToLower(h.Value),
+			NodeData: *assembler.NewObjectMetadata(c.doc.SourceInformation),
+		})
 	}
+	return artifacts
 }
 
-func parseCycloneDXBOM(d []byte) (*cdx.BOM, error) {
-	bom := cdx.BOM{}
-	if err := json.Unmarshal(d, &bom); err != nil {
-		return nil, err
+// hashAlgorithm returns the name of a CycloneDX hash algorithm in the form
+// used for the artifact digests, e.g. "sha256" for "SHA-256" but "sha3-256"
+// for "SHA3-256".
+func hashAlgorithm(alg cdx.HashAlgorithm) string {
+	a := strings.ToLower(string(alg))
+	if strings.HasPrefix(a, "sha-") {
+		return "sha" + strings.TrimPrefix(a, "sha-")
 	}
-	return &bom, nil
+	return a
 }
@@ -17,6 +17,10 @@ package cyclonedx
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -99,6 +103,112 @@ func Test_cyclonedxParser(t *testing.T) {
 		})
 	}
 }
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// graphSummary is the form in which the nodes and edges created by the
+// parser are stored in the golden files.
+type graphSummary struct {
+	Nodes []nodeSummary `json:"nodes"`
+	Edges []edgeSummary `json:"edges"`
+}
+
+type nodeSummary struct {
+	Type       string                 `json:"type"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type edgeSummary struct {
+	Type string      `json:"type"`
+	From nodeSummary `json:"from"`
+	To   nodeSummary `json:"to"`
+}
+
+func summarizeNode(n assembler.GuacNode) nodeSummary {
+	return nodeSummary{Type: n.Type(), Properties: n.Properties()}
+}
+
+// Test_cyclonedxParserGolden checks the graph of the same documents in the
+// JSON and XML formats against a golden file per specification version.
+func Test_cyclonedxParserGolden(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name   string
+		file   string
+		format processor.FormatType
+		golden string
+	}{{
+		name:   "CycloneDX 1.4 JSON",
+		file:   "cyclonedx-1.4.json",
+		format: processor.FormatJSON,
+		golden: "cyclonedx-1.4.golden",
+	}, {
+		name:   "CycloneDX 1.4 XML",
+		file:   "cyclonedx-1.4.xml",
+		format: processor.FormatXML,
+		golden: "cyclonedx-1.4.golden",
+	}, {
+		name:   "CycloneDX 1.5 JSON",
+		file:   "cyclonedx-1.5.json",
+		format: processor.FormatJSON,
+		golden: "cyclonedx-1.5.golden",
+	}, {
+		name:   "CycloneDX 1.5 XML",
+		file:   "cyclonedx-1.5.xml",
+		format: processor.FormatXML,
+		golden: "cyclonedx-1.5.golden",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read input: %v", err)
+			}
+			doc := &processor.Document{
+				Blob:   blob,
+				Type:   processor.DocumentCycloneDX,
+				Format: tt.format,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			}
+			s := NewCycloneDXParser()
+			if err := s.Parse(ctx, doc); err != nil {
+				t.Fatalf("cyclonedxParser.Parse() error = %v", err)
+			}
+
+			summary := graphSummary{Nodes: []nodeSummary{}, Edges: []edgeSummary{}}
+			for _, n := range s.CreateNodes(ctx) {
+				summary.Nodes = append(summary.Nodes, summarizeNode(n))
+			}
+			for _, e := range s.CreateEdges(ctx, nil) {
+				v, u := e.Nodes()
+				summary.Edges = append(summary.Edges, edgeSummary{Type: e.Type(), From: summarizeNode(v), To: summarizeNode(u)})
+			}
+			got, err := json.MarshalIndent(summary, "", "  ")
+			if err != nil {
+				t.Fatalf("failed to marshal the graph: %v", err)
+			}
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("cyclonedx graph = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
 func Test_addEdgesRecursive(t *testing.T) {
 	packageA := component{curPackage: assembler.PackageNode{Name: "A"}}
 	packageB := component{curPackage: assembler.PackageNode{Name: "B"}}
@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/deps_dev"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	purl "github.com/package-url/packageurl-go"
+)
+
+type depsDevParser struct {
+	doc          *processor.Document
+	packageNodes []assembler.PackageNode
+	// packages maps the purls to the index of their node in packageNodes.
+	packages map[string]int
+	edges    []assembler.DependsOnEdge
+}
+
+// NewDepsDevParser initializes the depsDevParser
+func NewDepsDevParser() common.DocumentParser {
+	return &depsDevParser{
+		packageNodes: []assembler.PackageNode{},
+		packages:     map[string]int{},
+		edges:        []assembler.DependsOnEdge{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *depsDevParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentDepsDev {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDepsDev, doc.Type)
+	}
+	p.doc = doc
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var packageDeps deps_dev.PackageDependencies
+		if err := json.Unmarshal(doc.Blob, &packageDeps); err != nil {
+			return err
+		}
+		if _, err := p.packageNode(packageDeps.Purl); err != nil {
+			return err
+		}
+		for _, dep := range packageDeps.Dependencies {
+			from, err := p.packageNode(dep.From)
+			if err != nil {
+				return err
+			}
+			to, err := p.packageNode(dep.To)
+			if err != nil {
+				return err
+			}
+			p.edges = append(p.edges, assembler.DependsOnEdge{
+				PackageNode:       from,
+				PackageDependency: to,
+			})
+		}
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of deps.dev document format: %v", doc.Format)
+}
+
+// packageNode returns the node of the package with the purl, adding it to
+// the package nodes the first time it is seen.
+func (p *depsDevParser) packageNode(purlString string) (assembler.PackageNode, error) {
+	if i, ok := p.packages[purlString]; ok {
+		return p.packageNodes[i], nil
+	}
+	u, err := purl.FromString(purlString)
+	if err != nil {
+		return assembler.PackageNode{}, fmt.Errorf("invalid purl %q: %w", purlString, err)
+	}
+	n := assembler.PackageNode{
+		Name:     u.Name,
+		Version:  u.Version,
+		Purl:     purlString,
+		NodeData: *assembler.NewObjectMetadata(p.doc.SourceInformation),
+	}
+	p.packages[purlString] = len(p.packageNodes)
+	p.packageNodes = append(p.packageNodes, n)
+	return n, nil
+}
+
+// CreateNodes creates the GuacNode for the graph inputs
+func (p *depsDevParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
+	nodes := []assembler.GuacNode{}
+	for _, n := range p.packageNodes {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// CreateEdges creates the GuacEdges that form the relationship for the graph inputs
+func (p *depsDevParser) CreateEdges(ctx context.Context, foundIdentities []assembler.IdentityNode) []assembler.GuacEdge {
+	edges := []assembler.GuacEdge{}
+	for _, e := range p.edges {
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *depsDevParser) GetIdentities(ctx context.Context) []assembler.IdentityNode {
+	return nil
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deps_dev
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_depsDevParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	source := processor.SourceInformation{Collector: "guac", Source: "deps.dev"}
+	babel := assembler.PackageNode{
+		Name:     "core",
+		Version:  "7.21.0",
+		Purl:     "pkg:npm/%40babel/core@7.21.0",
+		NodeData: *assembler.NewObjectMetadata(source),
+	}
+	debug := assembler.PackageNode{
+		Name:     "debug",
+		Version:  "4.3.4",
+		Purl:     "pkg:npm/debug@4.3.4",
+		NodeData: *assembler.NewObjectMetadata(source),
+	}
+	ms := assembler.PackageNode{
+		Name:     "ms",
+		Version:  "2.1.2",
+		Purl:     "pkg:npm/ms@2.1.2",
+		NodeData: *assembler.NewObjectMetadata(source),
+	}
+
+	tests := []struct {
+		name      string
+		doc       *processor.Document
+		wantNodes []assembler.GuacNode
+		wantEdges []assembler.GuacEdge
+		wantErr   bool
+	}{{
+		name: "dependency graph",
+		doc: &processor.Document{
+			Blob: []byte(`{
+  "purl": "pkg:npm/%40babel/core@7.21.0",
+  "dependencies": [
+    {"from": "pkg:npm/%40babel/core@7.21.0", "to": "pkg:npm/debug@4.3.4", "requirement": "^4.1.0"},
+    {"from": "pkg:npm/debug@4.3.4", "to": "pkg:npm/ms@2.1.2", "requirement": "2.1.2"}
+  ]
+}`),
+			Type:              processor.DocumentDepsDev,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantNodes: []assembler.GuacNode{babel, debug, ms},
+		wantEdges: []assembler.GuacEdge{
+			assembler.DependsOnEdge{PackageNode: babel, PackageDependency: debug},
+			assembler.DependsOnEdge{PackageNode: debug, PackageDependency: ms},
+		},
+	}, {
+		name: "no dependencies",
+		doc: &processor.Document{
+			Blob:              []byte(`{"purl": "pkg:npm/ms@2.1.2", "dependencies": []}`),
+			Type:              processor.DocumentDepsDev,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantNodes: []assembler.GuacNode{ms},
+		wantEdges: []assembler.GuacEdge{},
+	}, {
+		name: "invalid purl",
+		doc: &processor.Document{
+			Blob:              []byte(`{"purl": "npm/ms", "dependencies": []}`),
+			Type:              processor.DocumentDepsDev,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewDepsDevParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("depsDevParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if nodes := p.CreateNodes(ctx); !reflect.DeepEqual(nodes, tt.wantNodes) {
+				t.Errorf("depsDevParser.CreateNodes() = %v, want %v", nodes, tt.wantNodes)
+			}
+			if edges := p.CreateEdges(ctx, nil); !reflect.DeepEqual(edges, tt.wantEdges) {
+				t.Errorf("depsDevParser.CreateEdges() = %v, want %v", edges, tt.wantEdges)
+			}
+		})
+	}
+}
@@ -18,19 +18,29 @@ package slsa
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/in-toto/in-toto-golang/in_toto"
+	slsa02 "github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/v0.2"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
 )
 
 const (
 	algorithmSHA256 string = "sha256"
+
+	payloadTypeInToto         = "application/vnd.in-toto+json"
+	statementInTotoV1         = "https://in-toto.io/Statement/v1"
+	predicateSLSAProvenanceV1 = "https://slsa.dev/provenance/v1"
+	slsaVersionV02            = "v0.2"
+	slsaVersionV1             = "v1.0"
 )
 
 type slsaParser struct {
@@ -39,6 +49,7 @@ type slsaParser struct {
 	dependencies []assembler.ArtifactNode
 	attestations []assembler.AttestationNode
 	builders     []assembler.BuilderNode
+	hasSLSAs     []assembler.HasSLSANode
 }
 
 // NewSLSAParser initializes the slsaParser
@@ -48,26 +59,91 @@ func NewSLSAParser() common.DocumentParser {
 		dependencies: []assembler.ArtifactNode{},
 		attestations: []assembler.AttestationNode{},
 		builders:     []assembler.BuilderNode{},
+		hasSLSAs:     []assembler.HasSLSANode{},
 	}
 }
 
-// Parse breaks out the document into the graph components
+// statement is an in-toto statement, with the predicate left to be decoded
+// according to its type.
+type statement struct {
+	Type          string            `json:"_type"`
+	PredicateType string            `json:"predicateType"`
+	Subject       []in_toto.Subject `json:"subject"`
+	Predicate     json.RawMessage   `json:"predicate"`
+}
+
+// resource is a material or byproduct of a build.
+type resource struct {
+	URI    string
+	Digest map[string]string
+}
+
+// provenance holds the parts of a SLSA provenance predicate which are common
+// to all the supported versions.
+type provenance struct {
+	version    string
+	builderID  string
+	buildType  string
+	materials  []resource
+	byproducts []resource
+}
+
+// Parse breaks out the document into the graph components. The document is
+// either an in-toto statement or a DSSE envelope containing one.
 func (s *slsaParser) Parse(ctx context.Context, doc *processor.Document) error {
 	s.doc = doc
-	statement, err := parseSlsaPredicate(doc.Blob)
+	payload, err := unwrapEnvelope(doc.Blob)
+	if err != nil {
+		return err
+	}
+	stmt, err := parseStatement(payload)
+	if err != nil {
+		return fmt.Errorf("failed to parse slsa predicate: %w", err)
+	}
+	provenance, err := parseSlsaPredicate(stmt)
 	if err != nil {
 		return fmt.Errorf("failed to parse slsa predicate: %w", err)
 	}
-	s.getSubject(statement)
-	s.getDependency(statement)
+	s.getSubject(stmt)
+	s.getDependency(provenance)
 	s.getAttestation(doc.Blob)
-	s.getBuilder(statement)
+	s.getBuilder(provenance)
+	s.getHasSLSA(provenance)
 	return nil
 }
 
-func (s *slsaParser) getSubject(statement *in_toto.ProvenanceStatement) {
+// unwrapEnvelope returns the payload of the DSSE envelope in blob, checking
+// that it is an in-toto statement. If blob is not an envelope, it is returned
+// as is.
+func unwrapEnvelope(blob []byte) ([]byte, error) {
+	var envelope dsse.Envelope
+	if err := json.Unmarshal(blob, &envelope); err != nil || envelope.Payload == "" {
+		return blob, nil
+	}
+	if envelope.PayloadType != payloadTypeInToto {
+		return nil, fmt.Errorf("unsupported DSSE payload type %q, expected %q", envelope.PayloadType, payloadTypeInToto)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode DSSE payload: %w", err)
+	}
+	return payload, nil
+}
+
+func parseStatement(p []byte) (*statement, error) {
+	stmt := statement{}
+	if err := json.Unmarshal(p, &stmt); err != nil {
+		return nil, err
+	}
+	if stmt.Type != in_toto.StatementInTotoV01 && stmt.Type != statementInTotoV1 {
+		return nil, fmt.Errorf("unsupported statement type %q", stmt.Type)
+	}
+	return &stmt, nil
+}
+
+func (s *slsaParser) getSubject(stmt *statement) {
 	// append artifact node for the subjects
-	for _, sub := range statement.Subject {
+	for _, sub := range stmt.Subject {
 		for alg, ds := range sub.Digest {
 			s.subjects = append(s.subjects, assembler.ArtifactNode{
 				Name: sub.Name, Digest: alg + ":" + strings.Trim(ds, "'"), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
@@ -75,13 +151,12 @@ func (s *slsaParser) getSubject(statement *in_toto.ProvenanceStatement) {
 	}
 }
 
-func (s *slsaParser) getDependency(statement *in_toto.ProvenanceStatement) {
+func (s *slsaParser) getDependency(provenance *provenance) {
 	// append dependency nodes for the materials
-	for _, mat := range statement.Predicate.Materials {
-		for alg, ds := range mat.Digest {
-
+	for _, mat := range provenance.materials {
+		for _, alg := range sortedAlgorithms(mat.Digest) {
 			s.dependencies = append(s.dependencies, assembler.ArtifactNode{
-				Name: mat.URI, Digest: alg + ":" + strings.Trim(ds, "'"), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
+				Name: mat.URI, Digest: alg + ":" + strings.Trim(mat.Digest[alg], "'"), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
 		}
 	}
 }
@@ -92,18 +167,134 @@ func (s *slsaParser) getAttestation(blob []byte) {
 		FilePath: s.doc.SourceInformation.Source, Digest: algorithmSHA256 + ":" + hex.EncodeToString(h[:]), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
 }
 
-func (s *slsaParser) getBuilder(statement *in_toto.ProvenanceStatement) {
+func (s *slsaParser) getBuilder(provenance *provenance) {
 	// append builder node for builder
 	s.builders = append(s.builders, assembler.BuilderNode{
-		BuilderType: statement.Predicate.BuildType, BuilderId: statement.Predicate.Builder.ID, NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
+		BuilderType: provenance.buildType, BuilderId: provenance.builderID, NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
 }
 
-func parseSlsaPredicate(p []byte) (*in_toto.ProvenanceStatement, error) {
-	predicate := in_toto.ProvenanceStatement{}
+func (s *slsaParser) getHasSLSA(provenance *provenance) {
+	// append a HasSLSA node for the provenance, identified by the attestation
+	hasSLSA := assembler.HasSLSANode{
+		Digest:           s.attestations[0].Digest,
+		SlsaVersion:      provenance.version,
+		BuildType:        provenance.buildType,
+		BuilderId:        provenance.builderID,
+		MaterialURIs:     []string{},
+		MaterialDigests:  []string{},
+		ByproductURIs:    []string{},
+		ByproductDigests: []string{},
+		NodeData:         *assembler.NewObjectMetadata(s.doc.SourceInformation),
+	}
+	for _, mat := range provenance.materials {
+		for _, alg := range sortedAlgorithms(mat.Digest) {
+			hasSLSA.MaterialURIs = append(hasSLSA.MaterialURIs, mat.URI)
+			hasSLSA.MaterialDigests = append(hasSLSA.MaterialDigests, alg+":"+strings.Trim(mat.Digest[alg], "'"))
+		}
+	}
+	for _, b := range provenance.byproducts {
+		if len(b.Digest) == 0 {
+			hasSLSA.ByproductURIs = append(hasSLSA.ByproductURIs, b.URI)
+			hasSLSA.ByproductDigests = append(hasSLSA.ByproductDigests, "")
+		}
+		for _, alg := range sortedAlgorithms(b.Digest) {
+			hasSLSA.ByproductURIs = append(hasSLSA.ByproductURIs, b.URI)
+			hasSLSA.ByproductDigests = append(hasSLSA.ByproductDigests, alg+":"+strings.Trim(b.Digest[alg], "'"))
+		}
+	}
+	s.hasSLSAs = append(s.hasSLSAs, hasSLSA)
+}
+
+// sortedAlgorithms returns the algorithms of a digest set in a stable order.
+func sortedAlgorithms(digest map[string]string) []string {
+	algorithms := make([]string, 0, len(digest))
+	for alg := range digest {
+		algorithms = append(algorithms, alg)
+	}
+	sort.Strings(algorithms)
+	return algorithms
+}
+
+// parseSlsaPredicate decodes the predicate of the statement according to its
+// SLSA version. Other predicate types are an error.
+func parseSlsaPredicate(stmt *statement) (*provenance, error) {
+	switch stmt.PredicateType {
+	case slsa02.PredicateSLSAProvenance:
+		return parseSlsaV02Predicate(stmt.Predicate)
+	case predicateSLSAProvenanceV1:
+		return parseSlsaV1Predicate(stmt.Predicate)
+	default:
+		return nil, fmt.Errorf("unsupported predicate type %q", stmt.PredicateType)
+	}
+}
+
+func parseSlsaV02Predicate(p []byte) (*provenance, error) {
+	predicate := slsa02.ProvenancePredicate{}
+	if err := json.Unmarshal(p, &predicate); err != nil {
+		return nil, err
+	}
+	provenance := &provenance{
+		version:   slsaVersionV02,
+		builderID: predicate.Builder.ID,
+		buildType: predicate.BuildType,
+	}
+	for _, mat := range predicate.Materials {
+		provenance.materials = append(provenance.materials, resource{URI: mat.URI, Digest: mat.Digest})
+	}
+	return provenance, nil
+}
+
+// slsaV1Predicate is the SLSA v1.0 provenance predicate, limited to the fields
+// used by the parser.
+type slsaV1Predicate struct {
+	BuildDefinition struct {
+		BuildType            string               `json:"buildType"`
+		ResolvedDependencies []slsaV1ResourceDesc `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+		Byproducts []slsaV1ResourceDesc `json:"byproducts"`
+	} `json:"runDetails"`
+}
+
+// slsaV1ResourceDesc is a resource descriptor of SLSA v1.0. Resources without
+// URI are identified by their name.
+type slsaV1ResourceDesc struct {
+	URI    string            `json:"uri"`
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+func (r slsaV1ResourceDesc) toResource() resource {
+	uri := r.URI
+	if uri == "" {
+		uri = r.Name
+	}
+	return resource{URI: uri, Digest: r.Digest}
+}
+
+func parseSlsaV1Predicate(p []byte) (*provenance, error) {
+	predicate := slsaV1Predicate{}
 	if err := json.Unmarshal(p, &predicate); err != nil {
 		return nil, err
 	}
-	return &predicate, nil
+	if predicate.BuildDefinition.BuildType == "" || predicate.RunDetails.Builder.ID == "" {
+		return nil, fmt.Errorf("SLSA v1.0 predicate is missing the build type or builder id")
+	}
+	provenance := &provenance{
+		version:   slsaVersionV1,
+		builderID: predicate.RunDetails.Builder.ID,
+		buildType: predicate.BuildDefinition.BuildType,
+	}
+	for _, dep := range predicate.BuildDefinition.ResolvedDependencies {
+		provenance.materials = append(provenance.materials, dep.toResource())
+	}
+	for _, b := range predicate.RunDetails.Byproducts {
+		provenance.byproducts = append(provenance.byproducts, b.toResource())
+	}
+	return provenance, nil
 }
 
 // CreateNodes creates the GuacNode for the graph inputs
@@ -121,6 +312,9 @@ func (s *slsaParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
 	for _, b := range s.builders {
 		nodes = append(nodes, b)
 	}
+	for _, h := range s.hasSLSAs {
+		nodes = append(nodes, h)
+	}
 	return nodes
 }
 
@@ -143,6 +337,14 @@ func (s *slsaParser) CreateEdges(ctx context.Context, foundIdentities []assemble
 			edges = append(edges, assembler.DependsOnEdge{ArtifactNode: sub, ArtifactDependency: d})
 		}
 	}
+	for _, h := range s.hasSLSAs {
+		for _, sub := range s.subjects {
+			edges = append(edges, assembler.HasSLSASubjectEdge{HasSLSANode: h, ArtifactNode: sub})
+		}
+		for _, build := range s.builders {
+			edges = append(edges, assembler.HasSLSABuiltByEdge{HasSLSANode: h, BuilderNode: build})
+		}
+	}
 	return edges
 }
 
@@ -17,7 +17,12 @@ package slsa
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -26,6 +31,8 @@ import (
 	"github.com/guacsec/guac/pkg/logging"
 )
 
+var update = flag.Bool("update", false, "update the golden files")
+
 func Test_slsaParser(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	tests := []struct {
@@ -61,3 +68,122 @@ func Test_slsaParser(t *testing.T) {
 		})
 	}
 }
+
+// graphSummary is the form in which the nodes and edges created by the
+// parser are stored in the golden files.
+type graphSummary struct {
+	Nodes []nodeSummary `json:"nodes"`
+	Edges []edgeSummary `json:"edges"`
+}
+
+type nodeSummary struct {
+	Type       string                 `json:"type"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type edgeSummary struct {
+	Type string      `json:"type"`
+	From nodeSummary `json:"from"`
+	To   nodeSummary `json:"to"`
+}
+
+func summarizeNode(n assembler.GuacNode) nodeSummary {
+	return nodeSummary{Type: n.Type(), Properties: n.Properties()}
+}
+
+func Test_slsaParserGolden(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name string
+		file string
+	}{{
+		name: "SLSA v0.2 in DSSE envelope",
+		file: "slsa-v0.2-dsse.json",
+	}, {
+		name: "SLSA v1.0 in DSSE envelope",
+		file: "slsa-v1.0-dsse.json",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read input: %v", err)
+			}
+			doc := &processor.Document{
+				Blob:   blob,
+				Type:   processor.DocumentITE6SLSA,
+				Format: processor.FormatJSON,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    tt.file,
+				},
+			}
+			s := NewSLSAParser()
+			if err := s.Parse(ctx, doc); err != nil {
+				t.Fatalf("slsa.Parse() error = %v", err)
+			}
+
+			summary := graphSummary{Nodes: []nodeSummary{}, Edges: []edgeSummary{}}
+			for _, n := range s.CreateNodes(ctx) {
+				summary.Nodes = append(summary.Nodes, summarizeNode(n))
+			}
+			for _, e := range s.CreateEdges(ctx, nil) {
+				v, u := e.Nodes()
+				summary.Edges = append(summary.Edges, edgeSummary{Type: e.Type(), From: summarizeNode(v), To: summarizeNode(u)})
+			}
+			got, err := json.MarshalIndent(summary, "", "  ")
+			if err != nil {
+				t.Fatalf("failed to marshal the graph: %v", err)
+			}
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", strings.TrimSuffix(tt.file, ".json")+".golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("slsa graph = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func Test_slsaParserErrors(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{{
+		name:    "unsupported predicate type",
+		file:    "unsupported-predicate.json",
+		wantErr: `unsupported predicate type "https://cyclonedx.org/bom"`,
+	}, {
+		name:    "unsupported payload type",
+		file:    "unsupported-payload-type.json",
+		wantErr: `unsupported DSSE payload type "application/vnd.cncf.notary.payload.v1+json"`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read input: %v", err)
+			}
+			doc := &processor.Document{
+				Blob:   blob,
+				Type:   processor.DocumentITE6SLSA,
+				Format: processor.FormatJSON,
+			}
+			err = NewSLSAParser().Parse(ctx, doc)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("slsa.Parse() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	git_collector "github.com/guacsec/guac/pkg/handler/collector/git"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// sourceParser parses the SOURCE documents of the git source collector. Like
+// the repositories of the scorecards, the sources are artifacts named by the
+// URI of the repository with the commit as digest.
+type sourceParser struct {
+	artifactNodes []assembler.ArtifactNode
+}
+
+// NewSourceParser initializes the sourceParser
+func NewSourceParser() common.DocumentParser {
+	return &sourceParser{
+		artifactNodes: []assembler.ArtifactNode{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *sourceParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentSource {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSource, doc.Type)
+	}
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var source git_collector.Source
+		if err := json.Unmarshal(doc.Blob, &source); err != nil {
+			return err
+		}
+		p.artifactNodes = append(p.artifactNodes, getArtifactNode(&source, doc.SourceInformation))
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of source document format: %v", doc.Format)
+}
+
+func getArtifactNode(s *git_collector.Source, source processor.SourceInformation) assembler.ArtifactNode {
+	n := assembler.ArtifactNode{
+		Name:     fmt.Sprintf("%s+%s/%s", s.Type, s.Namespace, s.Name),
+		Digest:   "sha1:" + s.Commit,
+		NodeData: *assembler.NewObjectMetadata(source),
+	}
+	if s.Tag != nil {
+		n.Tags = []string{*s.Tag}
+	}
+	return n
+}
+
+// CreateNodes creates the GuacNode for the graph inputs
+func (p *sourceParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
+	nodes := []assembler.GuacNode{}
+	for _, n := range p.artifactNodes {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// CreateEdges creates the GuacEdges that form the relationship for the graph inputs
+func (p *sourceParser) CreateEdges(ctx context.Context, foundIdentities []assembler.IdentityNode) []assembler.GuacEdge {
+	return []assembler.GuacEdge{}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *sourceParser) GetIdentities(ctx context.Context) []assembler.IdentityNode {
+	return nil
+}
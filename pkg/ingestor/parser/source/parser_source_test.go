@@ -0,0 +1,91 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_sourceParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	source := processor.SourceInformation{Collector: "GitSourceCollector", Source: "https://github.com/guacsec/guac"}
+
+	tests := []struct {
+		name      string
+		doc       *processor.Document
+		wantNodes []assembler.GuacNode
+		wantErr   bool
+	}{{
+		name: "tag",
+		doc: &processor.Document{
+			Blob:              []byte(`{"type": "git", "namespace": "github.com/guacsec", "name": "guac", "tag": "v0.1.0", "commit": "5835544ca568b757a8ecae5c153f317e5736700e", "ref": "refs/tags/v0.1.0"}`),
+			Type:              processor.DocumentSource,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantNodes: []assembler.GuacNode{assembler.ArtifactNode{
+			Name:     "git+github.com/guacsec/guac",
+			Digest:   "sha1:5835544ca568b757a8ecae5c153f317e5736700e",
+			Tags:     []string{"v0.1.0"},
+			NodeData: *assembler.NewObjectMetadata(source),
+		}},
+	}, {
+		name: "HEAD",
+		doc: &processor.Document{
+			Blob:              []byte(`{"type": "git", "namespace": "github.com/guacsec", "name": "guac", "commit": "5835544ca568b757a8ecae5c153f317e5736700e", "ref": "HEAD"}`),
+			Type:              processor.DocumentSource,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantNodes: []assembler.GuacNode{assembler.ArtifactNode{
+			Name:     "git+github.com/guacsec/guac",
+			Digest:   "sha1:5835544ca568b757a8ecae5c153f317e5736700e",
+			NodeData: *assembler.NewObjectMetadata(source),
+		}},
+	}, {
+		name: "incorrect type",
+		doc: &processor.Document{
+			Blob:   []byte(`{}`),
+			Type:   processor.DocumentUnknown,
+			Format: processor.FormatJSON,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewSourceParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sourceParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if nodes := p.CreateNodes(ctx); !reflect.DeepEqual(nodes, tt.wantNodes) {
+				t.Errorf("sourceParser.CreateNodes() = %v, want %v", nodes, tt.wantNodes)
+			}
+			if edges := p.CreateEdges(ctx, nil); len(edges) != 0 {
+				t.Errorf("sourceParser.CreateEdges() = %v, want no edges", edges)
+			}
+		})
+	}
+}
@@ -31,11 +31,23 @@ import (
 	"github.com/spdx/tools-golang/spdx/v2_2"
 )
 
+// spdxRelationshipMetadata is the metadata type of the relationships which
+// are not mapped to edges.
+const spdxRelationshipMetadata = "spdx_relationship"
+
+// spdxDocumentID is the ID of the element of the SPDX document itself, without
+// the SPDXRef- prefix.
+const spdxDocumentID = "DOCUMENT"
+
 type spdxParser struct {
 	doc      *processor.Document
 	packages map[string][]assembler.PackageNode
 	files    map[string][]assembler.ArtifactNode
-	spdxDoc  *v2_2.Document
+	// described are the IDs of the elements described by the document, the
+	// subjects of the SBOM
+	described []string
+	metadata  []assembler.MetadataNode
+	spdxDoc   *v2_2.Document
 }
 
 func NewSpdxParser() common.DocumentParser {
@@ -52,11 +64,26 @@ func (s *spdxParser) Parse(ctx context.Context, doc *processor.Document) error {
 		return fmt.Errorf("failed to parse SPDX document: %w", err)
 	}
 	s.spdxDoc = spdxDoc
+	s.getDescribed()
 	s.getPackages()
 	s.getFiles()
+	s.getRelationshipMetadata()
 	return nil
 }
 
+// getDescribed collects the elements described by the document, through
+// either DESCRIBES relationships of the document or DESCRIBED_BY ones to it.
+func (s *spdxParser) getDescribed() {
+	for _, rel := range s.spdxDoc.Relationships {
+		switch {
+		case rel.Relationship == spdx_common.TypeRelationshipDescribe && string(rel.RefA.ElementRefID) == spdxDocumentID:
+			s.described = append(s.described, "SPDXRef-"+string(rel.RefB.ElementRefID))
+		case rel.Relationship == spdx_common.TypeRelationshipDescribeBy && string(rel.RefB.ElementRefID) == spdxDocumentID:
+			s.described = append(s.described, "SPDXRef-"+string(rel.RefA.ElementRefID))
+		}
+	}
+}
+
 // creating top level package manually until https://github.com/anchore/syft/issues/1241 is resolved
 func (s *spdxParser) getTopLevelPackage() {
 	// oci purl: pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=ghcr.io/debian&tag=bullseye
@@ -79,7 +106,11 @@ func (s *spdxParser) getTopLevelPackage() {
 }
 
 func (s *spdxParser) getPackages() {
-	s.getTopLevelPackage()
+	// The top level package is only needed if the document does not say
+	// what it describes.
+	if len(s.described) == 0 {
+		s.getTopLevelPackage()
+	}
 	for _, pac := range s.spdxDoc.Packages {
 		currentPackage := assembler.PackageNode{}
 		currentPackage.Name = pac.PackageName
@@ -120,6 +151,43 @@ func (s *spdxParser) getFiles() {
 	}
 }
 
+// isMappedRelationship returns true if the relationship type is turned into
+// edges, or selects the subjects of the SBOM.
+func isMappedRelationship(relationship string) bool {
+	switch relationship {
+	case spdx_common.TypeRelationshipContains,
+		spdx_common.TypeRelationshipDependsOn,
+		spdx_common.TypeRelationshipGeneratedFrom,
+		spdx_common.TypeRelationshipDescribe,
+		spdx_common.TypeRelationshipDescribeBy:
+		return true
+	}
+	return false
+}
+
+// getRelationshipMetadata records the relationships of the other types as
+// metadata, so that they are not lost.
+func (s *spdxParser) getRelationshipMetadata() {
+	for _, rel := range s.spdxDoc.Relationships {
+		if isMappedRelationship(rel.Relationship) {
+			continue
+		}
+		s.metadata = append(s.metadata, s.getRelationshipMetadataNode(rel))
+	}
+}
+
+func (s *spdxParser) getRelationshipMetadataNode(rel *v2_2.Relationship) assembler.MetadataNode {
+	return assembler.MetadataNode{
+		MetadataType: spdxRelationshipMetadata,
+		ID:           s.spdxDoc.DocumentNamespace + "#" + string(rel.RefA.ElementRefID) + ":" + rel.Relationship + ":" + string(rel.RefB.ElementRefID),
+		Details: map[string]interface{}{
+			"relationship":         rel.Relationship,
+			"spdx_element_id":      "SPDXRef-" + string(rel.RefA.ElementRefID),
+			"related_spdx_element": "SPDXRef-" + string(rel.RefB.ElementRefID),
+		},
+	}
+}
+
 func getTags(f *v2_2.File) []string {
 	return f.FileTypes
 }
@@ -145,6 +213,9 @@ func (s *spdxParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
 			nodes = append(nodes, fileNode)
 		}
 	}
+	for _, metadataNode := range s.metadata {
+		nodes = append(nodes, metadataNode)
+	}
 	return nodes
 }
 
@@ -165,14 +236,34 @@ func (s *spdxParser) getFileElement(elementID string) []assembler.ArtifactNode {
 func (s *spdxParser) CreateEdges(ctx context.Context, foundIdentities []assembler.IdentityNode) []assembler.GuacEdge {
 	logger := logging.FromContext(ctx)
 	edges := []assembler.GuacEdge{}
-	toplevel := s.getPackageElement("SPDXRef-DOCUMENT")
-	// adding top level package edge manually for all depends on package
-	if toplevel != nil {
-		edges = append(edges, createTopLevelEdges(toplevel[0], s.packages, s.files)...)
+	// adding top level edges manually from the subjects of the SBOM to all
+	// the other packages and files
+	if len(s.described) == 0 {
+		if toplevel := s.getPackageElement("SPDXRef-DOCUMENT"); toplevel != nil {
+			edges = append(edges, createTopLevelEdges("SPDXRef-DOCUMENT", toplevel[0], s.packages, s.files)...)
+		}
+	}
+	for _, id := range s.described {
+		for _, packNode := range s.getPackageElement(id) {
+			edges = append(edges, createTopLevelEdges(id, packNode, s.packages, s.files)...)
+		}
+		for _, fileNode := range s.getFileElement(id) {
+			edges = append(edges, createTopLevelEdges(id, fileNode, s.packages, s.files)...)
+		}
 	}
 	for _, rel := range s.spdxDoc.Relationships {
 		foundPackNodes := s.getPackageElement("SPDXRef-" + string(rel.RefA.ElementRefID))
 		foundFileNodes := s.getFileElement("SPDXRef-" + string(rel.RefA.ElementRefID))
+		if !isMappedRelationship(rel.Relationship) {
+			metadataNode := s.getRelationshipMetadataNode(rel)
+			for _, packNode := range foundPackNodes {
+				edges = append(edges, assembler.MetadataForEdge{MetadataNode: metadataNode, ForPackage: packNode})
+			}
+			for _, fileNode := range foundFileNodes {
+				edges = append(edges, assembler.MetadataForEdge{MetadataNode: metadataNode, ForArtifact: fileNode})
+			}
+			continue
+		}
 		relatedPackNodes := s.getPackageElement("SPDXRef-" + string(rel.RefB.ElementRefID))
 		relatedFileNodes := s.getFileElement("SPDXRef-" + string(rel.RefB.ElementRefID))
 		for _, packNode := range foundPackNodes {
@@ -199,27 +290,32 @@ func (s *spdxParser) CreateEdges(ctx context.Context, foundIdentities []assemble
 	return edges
 }
 
-func createTopLevelEdges(toplevel assembler.PackageNode, packages map[string][]assembler.PackageNode, files map[string][]assembler.ArtifactNode) []assembler.GuacEdge {
+// createTopLevelEdges creates the edges from toplevel, the element with the
+// ID topLevelID, to all the other packages and files, skipping the packages
+// with the same purl as toplevel.
+func createTopLevelEdges(topLevelID string, toplevel assembler.GuacNode, packages map[string][]assembler.PackageNode, files map[string][]assembler.ArtifactNode) []assembler.GuacEdge {
+	topLevelPurl := ""
+	if p, ok := toplevel.(assembler.PackageNode); ok {
+		topLevelPurl = p.Purl
+	}
 	edges := []assembler.GuacEdge{}
-	for _, packNodes := range packages {
+	for id, packNodes := range packages {
+		if id == topLevelID {
+			continue
+		}
 		for _, packNode := range packNodes {
-			if packNode.Purl != toplevel.Purl {
-				e := assembler.DependsOnEdge{
-					PackageNode:       toplevel,
-					PackageDependency: packNode,
-				}
-				edges = append(edges, e)
+			if topLevelPurl == "" || packNode.Purl != topLevelPurl {
+				edges = append(edges, getDependsOnEdge(toplevel, packNode))
 			}
 		}
 	}
 
-	for _, fileNodes := range files {
+	for id, fileNodes := range files {
+		if id == topLevelID {
+			continue
+		}
 		for _, fileNode := range fileNodes {
-			e := assembler.DependsOnEdge{
-				PackageNode:        toplevel,
-				ArtifactDependency: fileNode,
-			}
-			edges = append(edges, e)
+			edges = append(edges, getDependsOnEdge(toplevel, fileNode))
 		}
 	}
 
@@ -245,11 +341,18 @@ func getEdgeByType(relationship string, foundNode assembler.GuacNode, relatedNod
 		return getContainsEdge(foundNode, relatedNode)
 	case spdx_common.TypeRelationshipDependsOn:
 		return getDependsOnEdge(foundNode, relatedNode), nil
+	case spdx_common.TypeRelationshipGeneratedFrom:
+		return getGeneratedFromEdge(foundNode, relatedNode)
 	}
 	return nil, nil
 }
 
+// getContainsEdge maps a package containing a package to a dependency, and a
+// package containing a file to a contains edge.
 func getContainsEdge(foundNode assembler.GuacNode, relatedNode assembler.GuacNode) (assembler.GuacEdge, error) {
+	if foundNode.Type() == "Package" && relatedNode.Type() == "Package" {
+		return getDependsOnEdge(foundNode, relatedNode), nil
+	}
 	e := assembler.ContainsEdge{}
 	if foundNode.Type() == "Package" {
 		e.PackageNode = foundNode.(assembler.PackageNode)
@@ -264,6 +367,18 @@ func getContainsEdge(foundNode assembler.GuacNode, relatedNode assembler.GuacNod
 	return e, nil
 }
 
+// getGeneratedFromEdge maps a file generated from a package, such as a
+// binary built from its sources, to an occurrence of the package.
+func getGeneratedFromEdge(foundNode assembler.GuacNode, relatedNode assembler.GuacNode) (assembler.GuacEdge, error) {
+	if foundNode.Type() != "Artifact" || relatedNode.Type() != "Package" {
+		return nil, errors.New("node type mismatch during generated from edge creation")
+	}
+	return assembler.IsOccurrenceEdge{
+		PackageNode:  relatedNode.(assembler.PackageNode),
+		ArtifactNode: foundNode.(assembler.ArtifactNode),
+	}, nil
+}
+
 func getDependsOnEdge(foundNode assembler.GuacNode, relatedNode assembler.GuacNode) assembler.GuacEdge {
 	e := assembler.DependsOnEdge{}
 	if foundNode.Type() == "Package" {
@@ -47,6 +47,20 @@ func Test_spdxParser(t *testing.T) {
 		wantNodes: testdata.SpdxNodes,
 		wantEdges: testdata.SpdxEdges,
 		wantErr:   false,
+	}, {
+		name: "SPDX document with all the relationship types",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleRelationships,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantNodes: testdata.SpdxRelationshipsNodes,
+		wantEdges: testdata.SpdxRelationshipsEdges,
+		wantErr:   false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {